@@ -70,6 +70,19 @@ func main() {
 		logger.Fatal(ctx, "Failed to configure log output", log.Error(err))
 	}
 
+	// When invoked as the migrate one-shot (`thunderid migrate up|status|down`), migrate the
+	// database schemas and exit without starting the HTTP server.
+	if isMigrateInvocation() {
+		if err := runMigrate(ctx, logger, serverHome); err != nil {
+			logger.Error(ctx, "Schema migration failed; exiting", log.Error(err))
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Refuse to run against database schemas that are older or newer than this server supports.
+	verifySchemaVersions(ctx, logger, serverHome)
+
 	// Initialize the cache manager.
 	cacheManager := cache.Initialize(cfg.Cache, cfg.Server.Identifier)
 
@@ -120,7 +133,7 @@ func main() {
 		if !ok {
 			logger.Fatal(ctx, "Runtime crypto provider does not support TLS material retrieval")
 		}
-		tlsConfig := loadCertConfig(ctx, logger, tlsConfigProvider)
+		tlsConfig := loadCertConfig(ctx, logger, cfg, tlsConfigProvider)
 		ln = createTLSListener(ctx, logger, server, tlsConfig)
 	}
 
@@ -202,16 +215,24 @@ func initThunderConfigurations(ctx context.Context, logger *log.Logger, serverHo
 	return cfg
 }
 
-// loadCertConfig loads the TLS material via the runtime crypto provider.
-func loadCertConfig(ctx context.Context, logger *log.Logger, runtimeSvc common.TLSConfigProvider) *tls.Config {
+// loadCertConfig loads the TLS material via the runtime crypto provider. When client certificates are
+// enabled the handshake requests one without verifying it; mutual-TLS client authentication verifies the
+// certificate against the client's registration, which self-signed certificates could never pass here.
+func loadCertConfig(ctx context.Context, logger *log.Logger, cfg *config.Config,
+	runtimeSvc common.TLSConfigProvider) *tls.Config {
 	mat, err := runtimeSvc.GetTLSMaterial(ctx)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to load TLS material", log.Error(err))
 	}
+	clientAuth := tls.NoClientCert
+	if cfg.TLS.ClientCertificate.Request {
+		clientAuth = tls.RequestClientCert
+	}
 	// #nosec G402 -- MinVersion is set to TLS 1.2 or higher by GetTLSMaterial
 	return &tls.Config{
 		Certificates: []tls.Certificate{mat.Certificate},
 		MinVersion:   mat.MinVersion,
+		ClientAuth:   clientAuth,
 	}
 }
 
@@ -234,12 +255,18 @@ func createHTTPServer(ctx context.Context, logger *log.Logger, cfg *config.Confi
 	securityMiddleware := createSecurityMiddleware(ctx, logger, mux, jwtService, revocationEnforcer)
 
 	// Build the middleware chain with proper execution order.
-	// Request flow: CorrelationID (outermost) -> SecurityHeaders -> AccessLog -> Security -> Route Handler (innermost)
+	// Request flow: CorrelationID (outermost) -> ClientIP -> SecurityHeaders -> AccessLog -> Security ->
+	// Route Handler (innermost)
 	// Note: Middlewares are wrapped in reverse order - the last added will execute first.
 	// The Gate and Console frontend paths are always excluded from the access log to keep it
 	// focused on API traffic. Additional prefixes can be excluded via log.access.exclude_paths.
 	handler := log.AccessLogHandler(logger, accessLogExcludePaths(cfg.Log.Access.ExcludePaths), securityMiddleware)
 	handler = middleware.SecurityHeadersMiddleware()(handler)
+	trustedProxies, err := middleware.ParseTrustedProxies(cfg.Server.SecurityConfig.TrustedProxies)
+	if err != nil {
+		logger.Fatal(ctx, "Invalid trusted proxies in configuration", log.Error(err))
+	}
+	handler = middleware.ClientIPMiddleware(trustedProxies)(handler)
 	handler = middleware.CorrelationIDMiddleware(handler)
 
 	// Build the server address using hostname and port from the configurations.
@@ -0,0 +1,163 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"text/tabwriter"
+
+	"github.com/thunder-id/thunderid/internal/system/database/migration"
+	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// migrateSubcommand is the first positional argument that selects the schema migration one-shot
+// instead of starting the long-running server.
+const migrateSubcommand = "migrate"
+
+// Actions of the migrate subcommand.
+const (
+	migrateActionUp     = "up"
+	migrateActionStatus = "status"
+	migrateActionDown   = "down"
+)
+
+// migrateOptions holds the parsed options of the migrate subcommand.
+type migrateOptions struct {
+	action   string
+	database string
+	steps    int
+}
+
+// isMigrateInvocation reports whether the process was started as the migrate one-shot
+// (e.g. `thunderid migrate up`).
+func isMigrateInvocation() bool {
+	return flag.Arg(0) == migrateSubcommand
+}
+
+// runMigrate parses the migrate subcommand options, runs the requested action against the configured
+// databases, and closes the database connections. It does not start an HTTP listener.
+func runMigrate(ctx context.Context, logger *log.Logger, serverHome string) error {
+	defer closeDatabases(ctx, logger)
+
+	opts, err := parseMigrateOptions(flag.Args()[1:])
+	if err != nil {
+		return err
+	}
+	migrationSvc, err := migration.Initialize(path.Join(serverHome, "dbscripts"))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := migrationSvc.Close(); err != nil {
+			logger.Warn(ctx, "Failed to close the migration database clients", log.Error(err))
+		}
+	}()
+
+	switch opts.action {
+	case migrateActionStatus:
+		statuses, err := migrationSvc.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printMigrationStatus(statuses)
+		return nil
+	case migrateActionDown:
+		result, err := migrationSvc.Down(ctx, opts.database, opts.steps)
+		if result != nil {
+			printMigrationResult(*result, "Reverted")
+		}
+		return err
+	default:
+		results, err := migrationSvc.Up(ctx)
+		for _, result := range results {
+			if !result.Baselined && len(result.Migrations) == 0 {
+				fmt.Printf("%s: schema is up to date\n", result.Database)
+			}
+			printMigrationResult(result, "Applied")
+		}
+		return err
+	}
+}
+
+// parseMigrateOptions parses the action and flags of the migrate subcommand:
+//
+//	migrate up
+//	migrate status
+//	migrate down --database <name> [--steps N]
+func parseMigrateOptions(args []string) (migrateOptions, error) {
+	if len(args) == 0 {
+		return migrateOptions{}, fmt.Errorf("missing migrate action: expected %s, %s or %s",
+			migrateActionUp, migrateActionStatus, migrateActionDown)
+	}
+	opts := migrateOptions{action: args[0]}
+	if opts.action != migrateActionUp && opts.action != migrateActionStatus && opts.action != migrateActionDown {
+		return migrateOptions{}, fmt.Errorf("unknown migrate action %q: expected %s, %s or %s",
+			opts.action, migrateActionUp, migrateActionStatus, migrateActionDown)
+	}
+
+	fs := flag.NewFlagSet(migrateSubcommand+" "+opts.action, flag.ContinueOnError)
+	fs.StringVar(&opts.database, "database", "", "Database to revert migrations of (down only)")
+	fs.IntVar(&opts.steps, "steps", 1, "Number of migrations to revert (down only)")
+	if err := fs.Parse(args[1:]); err != nil {
+		return migrateOptions{}, err
+	}
+
+	if opts.action == migrateActionDown {
+		if opts.database == "" {
+			return migrateOptions{}, fmt.Errorf("migrate down requires --database")
+		}
+		if opts.steps < 1 {
+			return migrateOptions{}, fmt.Errorf("--steps must be at least 1")
+		}
+	}
+	return opts, nil
+}
+
+// verifySchemaVersions stops the server when a database is not at the schema version it requires.
+func verifySchemaVersions(ctx context.Context, logger *log.Logger, serverHome string) {
+	migrationSvc, err := migration.Initialize(path.Join(serverHome, "dbscripts"))
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize the schema version check", log.Error(err))
+	}
+	err = migrationSvc.CheckCompatibility(ctx)
+	if closeErr := migrationSvc.Close(); closeErr != nil {
+		logger.Warn(ctx, "Failed to close the migration database clients", log.Error(closeErr))
+	}
+	if err != nil {
+		logger.Fatal(ctx, "Database schema is not compatible with this server", log.Error(err))
+	}
+}
+
+// printMigrationStatus prints the schema version of each database.
+func printMigrationStatus(statuses []migration.DatabaseStatus) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "DATABASE\tCURRENT\tREQUIRED\tPENDING\tSTATE")
+	for _, status := range statuses {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\n", status.Database, status.CurrentVersion,
+			status.RequiredVersion, len(status.Pending), status.State())
+	}
+	_ = w.Flush()
+}
+
+// printMigrationResult prints the migrations applied to or reverted from a database.
+func printMigrationResult(result migration.MigrationResult, verb string) {
+	if result.Baselined {
+		fmt.Printf("%s: recorded the baseline schema version\n", result.Database)
+	}
+	for _, m := range result.Migrations {
+		fmt.Printf("%s: %s migration %d (%s)\n", result.Database, verb, m.Version, m.Description)
+	}
+}
+
+// closeDatabases closes the database connections opened by a one-shot subcommand.
+func closeDatabases(ctx context.Context, logger *log.Logger) {
+	if err := dbprovider.GetDBProviderCloser().Close(); err != nil {
+		logger.Error(ctx, "Error closing database connections", log.Error(err))
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type MigrateCmdTestSuite struct {
+	suite.Suite
+}
+
+func TestMigrateCmdTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateCmdTestSuite))
+}
+
+func (suite *MigrateCmdTestSuite) TestParseMigrateOptions_Up() {
+	opts, err := parseMigrateOptions([]string{"up"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), migrateActionUp, opts.action)
+}
+
+func (suite *MigrateCmdTestSuite) TestParseMigrateOptions_Down() {
+	opts, err := parseMigrateOptions([]string{"down", "--database", "configdb", "--steps", "2"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), migrateActionDown, opts.action)
+	assert.Equal(suite.T(), "configdb", opts.database)
+	assert.Equal(suite.T(), 2, opts.steps)
+}
+
+func (suite *MigrateCmdTestSuite) TestParseMigrateOptions_DownDefaultsToOneStep() {
+	opts, err := parseMigrateOptions([]string{"down", "--database", "entitydb"})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, opts.steps)
+}
+
+func (suite *MigrateCmdTestSuite) TestParseMigrateOptions_Errors() {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"MissingAction", []string{}},
+		{"UnknownAction", []string{"sideways"}},
+		{"DownWithoutDatabase", []string{"down"}},
+		{"DownWithInvalidSteps", []string{"down", "--database", "configdb", "--steps", "0"}},
+		{"UnknownFlag", []string{"status", "--verbose"}},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			_, err := parseMigrateOptions(tc.args)
+			assert.Error(suite.T(), err)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/thunder-id/thunderid/internal/accountsecurity"
 	"github.com/thunder-id/thunderid/internal/actorprovider"
 	"github.com/thunder-id/thunderid/internal/agent"
 	"github.com/thunder-id/thunderid/internal/application"
@@ -22,17 +23,23 @@ import (
 	authnConsent "github.com/thunder-id/thunderid/internal/authn/consent"
 	"github.com/thunder-id/thunderid/internal/authn/github"
 	"github.com/thunder-id/thunderid/internal/authn/google"
+	"github.com/thunder-id/thunderid/internal/authn/lockout"
 	"github.com/thunder-id/thunderid/internal/authn/magiclink"
 	authnOAuth "github.com/thunder-id/thunderid/internal/authn/oauth"
 	authnOIDC "github.com/thunder-id/thunderid/internal/authn/oidc"
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/passwordpolicy"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
 	"github.com/thunder-id/thunderid/internal/authnprovider/defaultprovider"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/authnprovider/restprovider"
 	"github.com/thunder-id/thunderid/internal/authz"
+	"github.com/thunder-id/thunderid/internal/authz/policy"
+	"github.com/thunder-id/thunderid/internal/authz/rebac"
 	"github.com/thunder-id/thunderid/internal/authzen"
+	"github.com/thunder-id/thunderid/internal/captcha"
 	"github.com/thunder-id/thunderid/internal/cert"
 	"github.com/thunder-id/thunderid/internal/connection"
 	"github.com/thunder-id/thunderid/internal/consent"
@@ -42,6 +49,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	flowanalytics "github.com/thunder-id/thunderid/internal/flow/analytics"
 	flowconfig "github.com/thunder-id/thunderid/internal/flow/config"
 	flowcore "github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
@@ -50,7 +58,9 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/graphbuilder"
 	"github.com/thunder-id/thunderid/internal/flow/interceptor"
 	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/flow/rollout"
 	flowsession "github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/flow/simulation"
 	"github.com/thunder-id/thunderid/internal/group"
 	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
@@ -67,6 +77,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/role"
 	"github.com/thunder-id/thunderid/internal/runtimestore"
 	"github.com/thunder-id/thunderid/internal/serverconfig"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/cmodels"
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -92,6 +103,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/services"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/internal/system/webhook"
 	"github.com/thunder-id/thunderid/internal/user"
 	"github.com/thunder-id/thunderid/internal/vc/credential"
 	"github.com/thunder-id/thunderid/internal/vc/presentation"
@@ -181,8 +193,22 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Initialize entity provider
 	entityProvider := entityprovider.InitializeEntityProvider(entityService)
 
+	runtimeStoreProvider, transactioner, err := runtimestore.Initialize(runtime.Config.Database.RuntimeTransient.Type,
+		runtime.Config.Server.Identifier)
+	fatalOnError(ctx, logger, err, "Failed to initialize runtime store")
+
+	// Initialize account lockout service. Failed credential attempts are counted in the runtime store.
+	lockoutService := lockout.Initialize(runtimeStoreProvider, observabilitySvc, runtime.Config.AccountLockout)
+
+	passwordPolicyService, err := passwordpolicy.Initialize(entityService, hashService, runtime.Config.PasswordPolicy)
+	fatalOnError(ctx, logger, err, "Failed to initialize PasswordPolicyService")
+
+	// Sub-resources of /users/{id} owned by other packages are registered on this router once those
+	// packages are initialized.
+	userSubResources := user.NewSubResourceRouter()
 	userService, ouUserResolver, userExporter, err := user.Initialize(
-		mux, entityService, ouService, entityTypeService, ouAuthzService,
+		mux, entityService, ouService, entityTypeService, ouAuthzService, lockoutService, passwordPolicyService,
+		userSubResources,
 	)
 	fatalOnError(ctx, logger, err, "Failed to initialize UserService")
 	exporters = append(exporters, userExporter)
@@ -208,7 +234,14 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	ouService.SetOUGroupResolver(ouGroupResolver)
 	ouService.SetOURoleResolver(ouRoleResolver)
 
-	authZService := authz.Initialize(roleService)
+	policyService, policyExporter, err := policy.Initialize(mux)
+	fatalOnError(ctx, logger, err, "Failed to initialize authorization policy service")
+	exporters = append(exporters, policyExporter)
+
+	rebacService := rebac.Initialize(mux, groupService)
+
+	authZService, err := authz.Initialize(roleService, policyService, rebacService, entityProvider, ouService)
+	fatalOnError(ctx, logger, err, "Failed to initialize AuthorizationService")
 
 	idpService, err := idp.Initialize(cacheManager, entityTypeService)
 	fatalOnError(ctx, logger, err, "Failed to initialize IDPService")
@@ -244,24 +277,25 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		providers.IDPTypeGitHub: githubAuthnService,
 	}
 
-	runtimeStoreProvider, transactioner, err := runtimestore.Initialize(runtime.Config.Database.RuntimeTransient.Type,
-		runtime.Config.Server.Identifier)
-	fatalOnError(ctx, logger, err, "Failed to initialize runtime store")
-
 	// Initialize passkey service
 	passkeyService := passkey.Initialize(entityService, runtimeStoreProvider)
 
 	// Shared DPoP verifier (and its JTI replay cache) so OAuth and OpenID4VCI
 	// share JTI replay protection.
 	oauthCfg := oauthconfig.FromServerRuntime()
-	dpopVerifier := dpop.Initialize(oauthCfg, jti.Initialize(runtimeStoreProvider), runtimeCryptoSvc)
+	jtiStore := jti.Initialize(runtimeStoreProvider)
+	dpopVerifier := dpop.Initialize(oauthCfg, jtiStore, runtimeCryptoSvc)
+
+	// Initialize TOTP service. Used codes are tracked in the JTI store for replay protection.
+	totpService := totp.Initialize(entityService, hashService, configCryptoSvc, runtimeStoreProvider, jtiStore,
+		runtime.Config.TOTP)
 
 	openid4vpSvc, openid4vpDefSvc, openid4vciCredSvc, exporters :=
 		initializeVCServices(ctx, logger, mux, runtimeCryptoSvc, configCryptoSvc, jwtService, userService,
 			ouService, dpopVerifier, runtimeStoreProvider, exporters)
 
 	defaultProvider := defaultprovider.Initialize(entityService, passkeyService,
-		otpCoreService, magicLinkService, openid4vpSvc, federatedAuths)
+		otpCoreService, totpService, magicLinkService, openid4vpSvc, federatedAuths, lockoutService)
 
 	customProviders := map[string]providers.CustomAuthnProvider{}
 	restCfg := runtime.Config.AuthnProvider.Rest
@@ -289,7 +323,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	// AuthZEN access-evaluation endpoints are Direct API endpoints, so they reuse the Direct Auth
 	// guard created by the authn service.
-	authzen.Initialize(mux, authZService, entityProvider, resourceService, directAuthGuard)
+	authzen.Initialize(mux, authZService, rebacService, entityProvider, resourceService, directAuthGuard)
 
 	attributeCacheService := attributecache.Initialize(runtimeStoreProvider, runtimeCryptoSvc,
 		runtime.Config.AttributeCache.Encryption.Enabled)
@@ -333,6 +367,14 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	sessionService, sessionCfg := initSessionService(ctx, serverConfigService,
 		runtime.Config.Server.Identifier, sessionRevoker, logger)
 	flowConfig.Session = sessionCfg
+
+	// Register the credential and SSO session management APIs for users and administrators.
+	accountsecurity.Initialize(mux, userSubResources, entityService, ouAuthzService, passkeyService, totpService,
+		sessionService)
+
+	captchaProvider, err := captcha.Initialize(runtime.Config.Captcha)
+	fatalOnError(ctx, logger, err, "Failed to initialize captcha provider")
+
 	flowFactory, execRegistry, interceptorRegistry, graphBuilder := initializeFlowCoreAndExecutor(ctx, logger,
 		cacheManager, executor.ExecutorDependencies{
 			OUService:             ouService,
@@ -362,8 +404,12 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 			ResourceService:       resourceServerProvider,
 			UserService:           userService,
 			CriteriaRevoker:       revocationSvc,
+			PasswordPolicy:        passwordPolicyService,
+			LockoutService:        lockoutService,
+		},
+		interceptor.InterceptorDependencies{
+			CaptchaService: captchaProvider,
 		},
-		interceptor.InterceptorDependencies{},
 		flowConfig,
 	)
 
@@ -396,7 +442,8 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Inject the consent service into the consent enforcer. It is wired here rather than at enforcer
 	// construction because it depends on the inbound client service, which is only available after the
 	// flow services (which themselves depend on the enforcer) are initialized.
-	consentEnforcer.SetConsentService(initConsentService(ctx, logger, inboundClientService))
+	consentEnforcer.SetConsentService(initConsentService(ctx, logger, mux, inboundClientService, revocationSvc,
+		entityService, ouAuthzService))
 
 	// TODO: Remove entityService dependency after finalizing declarative resource loading pattern
 	applicationService, applicationExporter, err := application.Initialize(
@@ -428,6 +475,23 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}, applicationService, agentService, flowMgtService, roleAssignmentService, groupService,
 		ouService, ouUserResolver, ouGroupResolver, resourceService)
 
+	// Initialize the audit trail and wire its recorder into the services whose management API
+	// mutations are audited.
+	_, auditRecorder := audit.Initialize(mux, runtime.Config.Server.Identifier, observabilitySvc)
+	registerAuditRecorder(auditRecorder, auditConsumers{
+		user:           userService,
+		group:          groupService,
+		role:           roleService,
+		roleAssignment: roleAssignmentService,
+		application:    applicationService,
+		idp:            idpService,
+		flow:           flowMgtService,
+		ou:             ouService,
+	})
+
+	// Initialize the webhook subscriber and its management API.
+	_ = webhook.Initialize(mux, runtime.Config.Server.Identifier, jwtService, configCryptoSvc, observabilitySvc)
+
 	// Initialize design resolve service for theme and layout resolution
 	designResolveService := resolve.Initialize(mux, themeMgtService, layoutMgtService, applicationService)
 
@@ -463,14 +527,27 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	)
 
 	attestationProvider := initAttestationProvider(ctx, logger, runtimeCryptoSvc)
+	// Register the flow rollout API. The rollout service selects the flow version a new execution is served
+	// and resolves the version a running execution is pinned to.
+	rolloutService := rollout.Initialize(mux, runtime.Config.Server.Identifier, flowMgtService)
 	flowExecService, err := flowexec.Initialize(mux, flowMgtService, actorProvider,
 		execRegistry, interceptorRegistry, observabilitySvc, runtimeCryptoSvc, attestationProvider,
-		graphBuilder, jwtService, runtimeStoreProvider, transactioner, serverConfigService, flowConfig)
+		graphBuilder, jwtService, runtimeStoreProvider, transactioner, serverConfigService, rolloutService,
+		flowConfig)
 	fatalOnError(ctx, logger, err, "Failed to initialize flow execution service")
 
+	// Register the flow simulation API. Simulated runs resolve the flows invoked by CALL nodes through the
+	// flow management service.
+	simulation.Initialize(mux, runtime.Config.Server.Identifier, flowMgtService,
+		flowexec.NewFlowSimulator(execRegistry, graphBuilder, flowMgtService))
+
+	// Register the flow analytics API. When enabled, its aggregator rolls the flow events up into funnel
+	// counters.
+	_ = flowanalytics.Initialize(mux, runtime.Config.Server.Identifier, flowMgtService, observabilitySvc)
+
 	// Initialize OAuth services.
 	err = oauth.Initialize(mux, actorProvider, authnProvider, jwtService, jweService,
-		flowExecService, observabilitySvc, runtimeCryptoSvc, ouService, attributeCacheService, authZService,
+		flowExecService, sessionService, observabilitySvc, runtimeCryptoSvc, ouService, attributeCacheService, authZService,
 		resourceServerProvider, i18nService, idpService, dpopVerifier,
 		runtimeStoreProvider, transactioner, revocationEnforcer, revocationSvc, oauthCfg)
 	fatalOnError(ctx, logger, err, "Failed to initialize OAuth services")
@@ -532,6 +609,30 @@ func registerDependencyRegistry(consumers dependencyConsumers, providers ...reso
 	consumers.resource.SetDependencyRegistry(registry)
 }
 
+// auditConsumers groups the services that record their management API mutations in the audit trail.
+type auditConsumers struct {
+	user           user.UserServiceInterface
+	group          group.GroupServiceInterface
+	role           role.RoleServiceInterface
+	roleAssignment role.RoleAssignmentServiceInterface
+	application    application.ApplicationServiceInterface
+	idp            idp.IDPServiceInterface
+	flow           flowmgt.FlowMgtServiceInterface
+	ou             ou.ConfigurableOUService
+}
+
+// registerAuditRecorder wires the audit recorder into the consuming services.
+func registerAuditRecorder(recorder *audit.Recorder, consumers auditConsumers) {
+	consumers.user.SetAuditRecorder(recorder)
+	consumers.group.SetAuditRecorder(recorder)
+	consumers.role.SetAuditRecorder(recorder)
+	consumers.roleAssignment.SetAuditRecorder(recorder)
+	consumers.application.SetAuditRecorder(recorder)
+	consumers.idp.SetAuditRecorder(recorder)
+	consumers.flow.SetAuditRecorder(recorder)
+	consumers.ou.SetAuditRecorder(recorder)
+}
+
 // unregisterServices unregisters all services that require cleanup during shutdown.
 func unregisterServices() {
 	observabilitySvc.Shutdown()
@@ -575,10 +676,15 @@ func readSessionConfig(ctx context.Context, svc serverconfig.ServerConfigService
 }
 
 // initConsentService initializes the consent service backed by the inbound client service, which
-// satisfies consent.InboundClientProvider directly.
-func initConsentService(ctx context.Context, logger *log.Logger,
-	inboundClientService inboundclient.InboundClientServiceInterface) consent.ConsentServiceInterface {
-	consentService, err := consent.Initialize(inboundClientService)
+// satisfies consent.InboundClientProvider directly. Revoking a consent revokes the tokens issued
+// under it through the criteria revoker. The administrative consent API is scoped to the users the
+// caller may manage through the entity and authorization services.
+func initConsentService(ctx context.Context, logger *log.Logger, mux *http.ServeMux,
+	inboundClientService inboundclient.InboundClientServiceInterface,
+	criteriaRevoker revocation.CriteriaRevokerInterface, entityService entity.EntityServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface) consent.ConsentServiceInterface {
+	consentService, err := consent.Initialize(mux, inboundClientService, criteriaRevoker, entityService,
+		authzService)
 	fatalOnError(ctx, logger, err, "Failed to initialize consent service")
 	return consentService
 }
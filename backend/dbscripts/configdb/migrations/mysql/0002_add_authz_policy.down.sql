@@ -0,0 +1 @@
+DROP TABLE "AUTHZ_POLICY";
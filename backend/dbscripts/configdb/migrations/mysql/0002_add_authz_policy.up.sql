@@ -0,0 +1,20 @@
+-- Table to store attribute-based authorization policies.
+CREATE TABLE "AUTHZ_POLICY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    HANDLE VARCHAR(255) NOT NULL,
+    NAME VARCHAR(255),
+    DESCRIPTION VARCHAR(255),
+    EFFECT VARCHAR(16) NOT NULL,
+    RESOURCE_SERVER_ID VARCHAR(36) NOT NULL DEFAULT '',
+    TARGET JSON,
+    CONDITIONS JSON,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Each authorization policy handle is unique per deployment.
+CREATE UNIQUE INDEX idx_authz_policy_handle ON "AUTHZ_POLICY" (DEPLOYMENT_ID, HANDLE);
+
+-- Index for loading the policies applicable to a resource server.
+CREATE INDEX idx_authz_policy_resource_server ON "AUTHZ_POLICY" (DEPLOYMENT_ID, RESOURCE_SERVER_ID);
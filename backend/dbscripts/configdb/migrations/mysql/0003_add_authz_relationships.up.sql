@@ -0,0 +1,28 @@
+-- Table to store the relationship-based authorization model, one row per object type.
+CREATE TABLE "AUTHZ_RELATION_TYPE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    NAME VARCHAR(255) NOT NULL,
+    RELATIONS JSON NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (DEPLOYMENT_ID, NAME)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store relationship tuples (object#relation@subject).
+CREATE TABLE "AUTHZ_RELATION_TUPLE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OBJECT_TYPE VARCHAR(255) NOT NULL,
+    OBJECT_ID VARCHAR(255) NOT NULL,
+    RELATION VARCHAR(255) NOT NULL,
+    SUBJECT_TYPE VARCHAR(255) NOT NULL,
+    SUBJECT_ID VARCHAR(255) NOT NULL,
+    SUBJECT_RELATION VARCHAR(255) NOT NULL DEFAULT '',
+    -- A key on the full tuple exceeds the InnoDB index size limit, so uniqueness is enforced on its hash.
+    TUPLE_HASH CHAR(64) AS (SHA2(JSON_ARRAY(OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID,
+        SUBJECT_RELATION), 256)) STORED,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE (DEPLOYMENT_ID, TUPLE_HASH)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for finding the tuples of a subject.
+CREATE INDEX idx_authz_relation_tuple_subject ON "AUTHZ_RELATION_TUPLE" (DEPLOYMENT_ID, SUBJECT_TYPE, SUBJECT_ID);
@@ -0,0 +1,17 @@
+-- Table to store the webhook endpoints observability events are delivered to. SECRET holds the HMAC
+-- signing secret encrypted with the configuration crypto key; it is empty for JWS-signed endpoints.
+CREATE TABLE "WEBHOOK_ENDPOINT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    NAME VARCHAR(255) NOT NULL,
+    URL VARCHAR(2048) NOT NULL,
+    CATEGORIES JSON NOT NULL,
+    SIGNATURE_TYPE VARCHAR(16) NOT NULL,
+    SECRET TEXT,
+    ENABLED BOOLEAN DEFAULT TRUE NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for loading the webhook endpoints of a deployment.
+CREATE INDEX idx_webhook_endpoint_deployment ON "WEBHOOK_ENDPOINT" (DEPLOYMENT_ID);
@@ -0,0 +1,10 @@
+-- Table to store the version rollouts of flows. A rollout routes a share of the new executions of a flow to a
+-- candidate version while the rest keep running the stable version.
+CREATE TABLE "FLOW_ROLLOUT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    ROLLOUT JSON NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (FLOW_ID, DEPLOYMENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
@@ -0,0 +1,2 @@
+DROP TABLE "AUTHZ_RELATION_TUPLE";
+DROP TABLE "AUTHZ_RELATION_TYPE";
@@ -0,0 +1 @@
+DROP TABLE "WEBHOOK_ENDPOINT";
@@ -0,0 +1,17 @@
+-- Table to store the webhook endpoints observability events are delivered to. SECRET holds the HMAC
+-- signing secret encrypted with the configuration crypto key; it is empty for JWS-signed endpoints.
+CREATE TABLE "WEBHOOK_ENDPOINT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    NAME VARCHAR(255) NOT NULL,
+    URL VARCHAR(2048) NOT NULL,
+    CATEGORIES JSONB NOT NULL,
+    SIGNATURE_TYPE VARCHAR(16) NOT NULL,
+    SECRET TEXT,
+    ENABLED BOOLEAN DEFAULT TRUE NOT NULL,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Index for loading the webhook endpoints of a deployment.
+CREATE INDEX idx_webhook_endpoint_deployment ON "WEBHOOK_ENDPOINT" (DEPLOYMENT_ID);
@@ -0,0 +1,10 @@
+-- Table to store the version rollouts of flows. A rollout routes a share of the new executions of a flow to a
+-- candidate version while the rest keep running the stable version.
+CREATE TABLE "FLOW_ROLLOUT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    ROLLOUT JSONB NOT NULL,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (FLOW_ID, DEPLOYMENT_ID)
+);
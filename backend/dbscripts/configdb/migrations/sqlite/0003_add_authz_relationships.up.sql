@@ -0,0 +1,25 @@
+-- Table to store the relationship-based authorization model, one row per object type.
+CREATE TABLE "AUTHZ_RELATION_TYPE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    NAME VARCHAR(255) NOT NULL,
+    RELATIONS TEXT NOT NULL,
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    UPDATED_AT TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (DEPLOYMENT_ID, NAME)
+);
+
+-- Table to store relationship tuples (object#relation@subject).
+CREATE TABLE "AUTHZ_RELATION_TUPLE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OBJECT_TYPE VARCHAR(255) NOT NULL,
+    OBJECT_ID VARCHAR(255) NOT NULL,
+    RELATION VARCHAR(255) NOT NULL,
+    SUBJECT_TYPE VARCHAR(255) NOT NULL,
+    SUBJECT_ID VARCHAR(255) NOT NULL,
+    SUBJECT_RELATION VARCHAR(255) NOT NULL DEFAULT '',
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (DEPLOYMENT_ID, OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION)
+);
+
+-- Index for finding the tuples of a subject.
+CREATE INDEX idx_authz_relation_tuple_subject ON "AUTHZ_RELATION_TUPLE" (DEPLOYMENT_ID, SUBJECT_TYPE, SUBJECT_ID);
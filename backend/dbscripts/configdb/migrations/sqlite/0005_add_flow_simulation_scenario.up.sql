@@ -0,0 +1,15 @@
+-- Table to store the saved simulation scenarios of flows. Scenarios are replayed as a regression suite
+-- when a flow is updated.
+CREATE TABLE "FLOW_SIMULATION_SCENARIO" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    NAME VARCHAR(255) NOT NULL,
+    DESCRIPTION VARCHAR(1024),
+    SCENARIO TEXT NOT NULL,
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    UPDATED_AT TEXT DEFAULT (datetime('now'))
+);
+
+-- Index for loading the simulation scenarios of a flow.
+CREATE INDEX idx_flow_simulation_scenario_flow ON "FLOW_SIMULATION_SCENARIO" (DEPLOYMENT_ID, FLOW_ID);
@@ -0,0 +1,10 @@
+-- Table to store the version rollouts of flows. A rollout routes a share of the new executions of a flow to a
+-- candidate version while the rest keep running the stable version.
+CREATE TABLE "FLOW_ROLLOUT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    ROLLOUT TEXT NOT NULL,
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    UPDATED_AT TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (FLOW_ID, DEPLOYMENT_ID)
+);
@@ -0,0 +1,476 @@
+-- Identifiers are double quoted as in the other scripts. Run this script with the same SQL mode the server
+-- uses for its connections.
+SET SESSION sql_mode = 'ANSI_QUOTES,PIPES_AS_CONCAT,STRICT_TRANS_TABLES,NO_ZERO_DATE,NO_ENGINE_SUBSTITUTION';
+
+-- Table to store Entity Schemas (user/agent categories)
+CREATE TABLE "ENTITY_TYPES" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ID          VARCHAR(36) PRIMARY KEY,
+    CATEGORY    VARCHAR(50) NOT NULL,
+    NAME        VARCHAR(100) NOT NULL,
+    OU_ID       VARCHAR(36) NOT NULL,
+    ALLOW_SELF_REGISTRATION BOOLEAN DEFAULT FALSE NOT NULL,
+    SCHEMA_DEF  JSON NOT NULL,
+    SYSTEM_ATTRIBUTES JSON,
+    CREATED_AT  DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT  DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE (NAME, CATEGORY, DEPLOYMENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for deployment + category + OU-based entity type lookups
+CREATE INDEX idx_entity_schemas_deployment_category_ou ON "ENTITY_TYPES" (DEPLOYMENT_ID, CATEGORY, OU_ID);
+
+-- Table to store Roles
+CREATE TABLE "ROLE" (
+    DEPLOYMENT_ID           VARCHAR(255) NOT NULL,
+    ID                  VARCHAR(36) PRIMARY KEY,
+    OU_ID               VARCHAR(36) NOT NULL,
+    NAME                VARCHAR(50) NOT NULL,
+    DESCRIPTION         VARCHAR(255),
+    CREATED_AT          DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT          DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    CONSTRAINT unique_role_ou_name UNIQUE (OU_ID, NAME, DEPLOYMENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for deployment + OU lookups (supports UNIQUE constraint checks)
+CREATE INDEX idx_role_ou_deployment ON "ROLE" (DEPLOYMENT_ID, OU_ID);
+
+-- Table to store Role permissions
+CREATE TABLE "ROLE_PERMISSION" (
+    DEPLOYMENT_ID       VARCHAR(255) NOT NULL,
+    ROLE_ID             VARCHAR(36) NOT NULL,
+    RESOURCE_SERVER_ID  VARCHAR(36) NOT NULL,
+    PERMISSION          VARCHAR(1000) NOT NULL,
+    -- A key on the full PERMISSION exceeds the InnoDB index size limit, so uniqueness is enforced on its hash.
+    PERMISSION_HASH     CHAR(64) AS (SHA2(PERMISSION, 256)) STORED,
+    CREATED_AT          DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE (ROLE_ID, DEPLOYMENT_ID, RESOURCE_SERVER_ID, PERMISSION_HASH),
+    FOREIGN KEY (ROLE_ID) REFERENCES "ROLE" (ID) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for resource server queries with deployment isolation on ROLE_PERMISSION
+CREATE INDEX idx_role_permission_resource_server ON "ROLE_PERMISSION" (RESOURCE_SERVER_ID, DEPLOYMENT_ID);
+
+-- Table to store Role assignments (to entities and groups)
+CREATE TABLE "ROLE_ASSIGNMENT" (
+    DEPLOYMENT_ID       VARCHAR(255) NOT NULL,
+    ROLE_ID         VARCHAR(36) NOT NULL,
+    ASSIGNEE_TYPE   VARCHAR(6)  NOT NULL CHECK (ASSIGNEE_TYPE IN ('entity', 'group')),
+    ASSIGNEE_ID     VARCHAR(36) NOT NULL,
+    CREATED_AT      DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT      DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (ROLE_ID, DEPLOYMENT_ID, ASSIGNEE_TYPE, ASSIGNEE_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store theme configurations.
+CREATE TABLE "THEME" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    DISPLAY_NAME VARCHAR(255) NOT NULL,
+    HANDLE VARCHAR(255) NOT NULL,
+    DESCRIPTION VARCHAR(512),
+    THEME JSON NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE (DEPLOYMENT_ID, HANDLE)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for deployment isolation on THEME
+CREATE INDEX idx_theme_deployment_id ON "THEME" (DEPLOYMENT_ID);
+
+-- Unique index for theme handle per deployment
+CREATE UNIQUE INDEX idx_theme_handle_deployment ON "THEME" (HANDLE, DEPLOYMENT_ID);
+
+-- Table to store layout configurations.
+CREATE TABLE "LAYOUT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    DISPLAY_NAME VARCHAR(255) NOT NULL,
+    HANDLE VARCHAR(255) NOT NULL,
+    DESCRIPTION VARCHAR(512),
+    LAYOUT JSON NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE (DEPLOYMENT_ID, HANDLE)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for deployment isolation on LAYOUT
+CREATE INDEX idx_layout_deployment_id ON "LAYOUT" (DEPLOYMENT_ID);
+
+-- Unique index for layout handle per deployment
+CREATE UNIQUE INDEX idx_layout_handle_deployment ON "LAYOUT" (HANDLE, DEPLOYMENT_ID);
+
+-- Table to store inbound client configurations for an entity.
+CREATE TABLE "INBOUND_CLIENT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ENTITY_ID VARCHAR(36) PRIMARY KEY,
+    AUTH_FLOW_ID VARCHAR(100) NOT NULL,
+    REGISTRATION_FLOW_ID VARCHAR(100),
+    IS_REGISTRATION_FLOW_ENABLED CHAR(1) DEFAULT '1',
+    RECOVERY_FLOW_ID VARCHAR(100),
+    IS_RECOVERY_FLOW_ENABLED CHAR(1) DEFAULT '0',
+    SIGNOUT_FLOW_ID VARCHAR(100),
+    THEME_ID VARCHAR(36),
+    LAYOUT_ID VARCHAR(36),
+    PROPERTIES JSON
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for efficient lookups by theme.
+CREATE INDEX idx_inbound_client_theme_id ON "INBOUND_CLIENT"(THEME_ID);
+
+-- Index for efficient lookups by layout.
+CREATE INDEX idx_inbound_client_layout_id ON "INBOUND_CLIENT"(LAYOUT_ID);
+
+-- Table to store OAuth inbound profile for an entity.
+CREATE TABLE "OAUTH_INBOUND_PROFILE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ENTITY_ID VARCHAR(36) NOT NULL,
+    OAUTH_CONFIG JSON,
+    PRIMARY KEY (ENTITY_ID, DEPLOYMENT_ID),
+    FOREIGN KEY (ENTITY_ID) REFERENCES "INBOUND_CLIENT"(ENTITY_ID) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store identity providers.
+CREATE TABLE "IDP" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    NAME VARCHAR(255) NOT NULL,
+    DESCRIPTION VARCHAR(500),
+    TYPE VARCHAR(20) NOT NULL,
+    PROPERTIES JSON,
+    ATTRIBUTE_CONFIGURATION JSON,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for name-based IDP lookups
+CREATE INDEX idx_idp_name_deployment ON "IDP" (DEPLOYMENT_ID, NAME);
+
+-- Table to store notification senders.
+CREATE TABLE "NOTIFICATION_SENDER" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    NAME VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    DESCRIPTION VARCHAR(500),
+    TYPE VARCHAR(20) NOT NULL,
+    PROVIDER VARCHAR(20) NOT NULL,
+    PROPERTIES JSON,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for name-based notification sender lookups
+CREATE INDEX idx_notification_sender_name_deployment ON "NOTIFICATION_SENDER" (DEPLOYMENT_ID, NAME);
+
+-- Table to store certificates associated with various entities.
+CREATE TABLE "CERTIFICATE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    REF_TYPE VARCHAR(20) NOT NULL,
+    REF_ID VARCHAR(36) NOT NULL,
+    TYPE VARCHAR(20) NOT NULL,
+    VALUE TEXT NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE (REF_TYPE, REF_ID, DEPLOYMENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store resource servers.
+CREATE TABLE "RESOURCE_SERVER" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    OU_ID VARCHAR(36) NOT NULL,
+    NAME VARCHAR(100) NOT NULL,
+    DESCRIPTION TEXT,
+    IDENTIFIER VARCHAR(2048) NOT NULL,
+    IDENTIFIER_HASH CHAR(64) AS (SHA2(IDENTIFIER, 256)) STORED,
+    TYPE VARCHAR(20) CHECK (TYPE IS NULL OR TYPE IN ('API', 'MCP', 'CUSTOM')),
+    PROPERTIES JSON,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE (OU_ID, NAME, DEPLOYMENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for name-based resource server lookups
+CREATE INDEX idx_resource_server_name_deployment ON "RESOURCE_SERVER" (DEPLOYMENT_ID, NAME);
+
+-- Unique constraint: Resource server identifier must be unique per deployment. A key on the full
+-- IDENTIFIER exceeds the InnoDB index size limit, so uniqueness is enforced on its hash.
+CREATE UNIQUE INDEX uq_resource_server_identifier
+    ON "RESOURCE_SERVER"(IDENTIFIER_HASH, DEPLOYMENT_ID);
+
+-- Table to store resources within resource servers.
+CREATE TABLE "RESOURCE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    RESOURCE_SERVER_ID VARCHAR(36) NOT NULL,
+    PARENT_RESOURCE_ID VARCHAR(36),
+    -- Partial indexes are not supported, so root-level resources are keyed under an empty parent.
+    PARENT_RESOURCE_KEY VARCHAR(36) AS (COALESCE(PARENT_RESOURCE_ID, '')) STORED,
+    NAME VARCHAR(100) NOT NULL,
+    HANDLE VARCHAR(100) NOT NULL,
+    DESCRIPTION TEXT,
+    PERMISSION VARCHAR(1000) NOT NULL,
+    PROPERTIES JSON,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+
+    FOREIGN KEY (RESOURCE_SERVER_ID)
+        REFERENCES "RESOURCE_SERVER"(ID)
+        ON DELETE RESTRICT
+        ON UPDATE CASCADE,
+    -- IDs are never updated; a cascading action is not allowed on the base column of a stored generated column.
+    FOREIGN KEY (PARENT_RESOURCE_ID)
+        REFERENCES "RESOURCE"(ID)
+        ON DELETE RESTRICT
+        ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for resource server + deployment queries (list, count, and handle checks)
+CREATE INDEX idx_resource_server_deployment ON "RESOURCE" (RESOURCE_SERVER_ID, DEPLOYMENT_ID);
+
+-- Unique constraint: Resource handle must be unique under the same parent per deployment. Root-level
+-- resources share the empty parent key, so their handles are unique per resource server per deployment.
+CREATE UNIQUE INDEX uq_resource_handle_parent
+    ON "RESOURCE"(RESOURCE_SERVER_ID, PARENT_RESOURCE_KEY, HANDLE, DEPLOYMENT_ID);
+
+-- Table to store actions at resource server or resource level.
+CREATE TABLE "ACTION" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    RESOURCE_SERVER_ID VARCHAR(36) NOT NULL,
+    RESOURCE_ID VARCHAR(36),
+    -- Partial indexes are not supported, so server-level actions are keyed under an empty resource.
+    RESOURCE_KEY VARCHAR(36) AS (COALESCE(RESOURCE_ID, '')) STORED,
+    NAME VARCHAR(100) NOT NULL,
+    HANDLE VARCHAR(100) NOT NULL,
+    DESCRIPTION TEXT,
+    PERMISSION VARCHAR(1000) NOT NULL,
+    PROPERTIES JSON,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+
+    FOREIGN KEY (RESOURCE_SERVER_ID)
+        REFERENCES "RESOURCE_SERVER"(ID)
+        ON DELETE RESTRICT
+        ON UPDATE CASCADE,
+    -- IDs are never updated; a cascading action is not allowed on the base column of a stored generated column.
+    FOREIGN KEY (RESOURCE_ID)
+        REFERENCES "RESOURCE"(ID)
+        ON DELETE RESTRICT
+        ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for action list/count queries filtered by resource server + deployment + resource
+CREATE INDEX idx_action_server_deployment ON "ACTION" (RESOURCE_SERVER_ID, DEPLOYMENT_ID, RESOURCE_ID);
+
+-- Unique constraint: Action handles must be unique per resource per deployment. Server-level actions
+-- share the empty resource key, so their handles are unique per resource server per deployment.
+CREATE UNIQUE INDEX uq_action_handle
+    ON "ACTION"(RESOURCE_SERVER_ID, RESOURCE_KEY, HANDLE, DEPLOYMENT_ID);
+
+-- Table to store active flow definitions
+CREATE TABLE "FLOW" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    HANDLE VARCHAR(100) NOT NULL,
+    NAME VARCHAR(100) NOT NULL,
+    FLOW_TYPE VARCHAR(50) NOT NULL,
+    ACTIVE_VERSION INTEGER NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE (HANDLE, FLOW_TYPE, DEPLOYMENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for flow type + deployment queries
+CREATE INDEX idx_flow_type_deployment ON "FLOW" (DEPLOYMENT_ID, FLOW_TYPE);
+
+-- Table to store flow version history
+CREATE TABLE "FLOW_VERSION" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    VERSION INTEGER NOT NULL,
+    NODES JSON NOT NULL,
+    INTERCEPTORS JSON,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (FLOW_ID, VERSION, DEPLOYMENT_ID),
+    FOREIGN KEY (FLOW_ID)
+        REFERENCES "FLOW"(ID)
+        ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store i18n translations
+CREATE TABLE "TRANSLATION" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    MESSAGE_KEY     VARCHAR(255) NOT NULL,
+    LANGUAGE_CODE   VARCHAR(10) NOT NULL,
+    NAMESPACE       VARCHAR(50) NOT NULL DEFAULT 'default',
+    VALUE           TEXT NOT NULL,
+    CREATED_AT      DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT      DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (DEPLOYMENT_ID, NAMESPACE, MESSAGE_KEY, LANGUAGE_CODE)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for efficient language and namespace combination lookups
+CREATE INDEX idx_translation_lang_namespace ON "TRANSLATION" (DEPLOYMENT_ID, LANGUAGE_CODE);
+
+-- Table to store OpenID4VP presentation definitions.
+CREATE TABLE "PRESENTATION_DEFINITION" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    HANDLE VARCHAR(255) NOT NULL,
+    OU_ID VARCHAR(36) NOT NULL,
+    NAME VARCHAR(255),
+    DESCRIPTION VARCHAR(255),
+    VCT VARCHAR(512) NOT NULL,
+    FORMAT VARCHAR(64) NOT NULL DEFAULT 'dc+sd-jwt',
+    CLAIMS JSON,
+    ENFORCE_TRUSTED_ISSUER BOOLEAN,
+    TRUSTED_AUTHORITIES JSON,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Each presentation definition handle is unique per deployment.
+CREATE UNIQUE INDEX idx_openid4vp_pd_handle ON "PRESENTATION_DEFINITION" (DEPLOYMENT_ID, HANDLE);
+
+-- Table to store attribute-based authorization policies.
+CREATE TABLE "AUTHZ_POLICY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    HANDLE VARCHAR(255) NOT NULL,
+    NAME VARCHAR(255),
+    DESCRIPTION VARCHAR(255),
+    EFFECT VARCHAR(16) NOT NULL,
+    RESOURCE_SERVER_ID VARCHAR(36) NOT NULL DEFAULT '',
+    TARGET JSON,
+    CONDITIONS JSON,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Each authorization policy handle is unique per deployment.
+CREATE UNIQUE INDEX idx_authz_policy_handle ON "AUTHZ_POLICY" (DEPLOYMENT_ID, HANDLE);
+
+-- Index for loading the policies applicable to a resource server.
+CREATE INDEX idx_authz_policy_resource_server ON "AUTHZ_POLICY" (DEPLOYMENT_ID, RESOURCE_SERVER_ID);
+
+-- Table to store the relationship-based authorization model, one row per object type.
+CREATE TABLE "AUTHZ_RELATION_TYPE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    NAME VARCHAR(255) NOT NULL,
+    RELATIONS JSON NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (DEPLOYMENT_ID, NAME)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store relationship tuples (object#relation@subject).
+CREATE TABLE "AUTHZ_RELATION_TUPLE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OBJECT_TYPE VARCHAR(255) NOT NULL,
+    OBJECT_ID VARCHAR(255) NOT NULL,
+    RELATION VARCHAR(255) NOT NULL,
+    SUBJECT_TYPE VARCHAR(255) NOT NULL,
+    SUBJECT_ID VARCHAR(255) NOT NULL,
+    SUBJECT_RELATION VARCHAR(255) NOT NULL DEFAULT '',
+    -- A key on the full tuple exceeds the InnoDB index size limit, so uniqueness is enforced on its hash.
+    TUPLE_HASH CHAR(64) AS (SHA2(JSON_ARRAY(OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID,
+        SUBJECT_RELATION), 256)) STORED,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE (DEPLOYMENT_ID, TUPLE_HASH)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for finding the tuples of a subject.
+CREATE INDEX idx_authz_relation_tuple_subject ON "AUTHZ_RELATION_TUPLE" (DEPLOYMENT_ID, SUBJECT_TYPE, SUBJECT_ID);
+
+-- Table to store OpenID4VCI credential configurations.
+CREATE TABLE "CREDENTIAL_CONFIGURATION" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    HANDLE VARCHAR(255) NOT NULL,
+    OU_ID VARCHAR(36) NOT NULL,
+    NAME VARCHAR(255),
+    DESCRIPTION VARCHAR(255),
+    FORMAT VARCHAR(64) NOT NULL DEFAULT 'dc+sd-jwt',
+    VCT VARCHAR(512) NOT NULL,
+    CLAIMS JSON,
+    DISPLAY JSON,
+    VALIDITY_SECONDS INTEGER,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Each credential configuration handle is unique per deployment.
+CREATE UNIQUE INDEX idx_openid4vci_cc_handle ON "CREDENTIAL_CONFIGURATION" (DEPLOYMENT_ID, HANDLE);
+
+-- Table to store server-wide configuration
+CREATE TABLE "SERVER_CONFIG" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    NAME          VARCHAR(255) NOT NULL,
+    VALUE         JSON        NOT NULL,
+    CREATED_AT    DATETIME(6)  DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT    DATETIME(6)  DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (DEPLOYMENT_ID, NAME)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store the webhook endpoints observability events are delivered to. SECRET holds the HMAC
+-- signing secret encrypted with the configuration crypto key; it is empty for JWS-signed endpoints.
+CREATE TABLE "WEBHOOK_ENDPOINT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    NAME VARCHAR(255) NOT NULL,
+    URL VARCHAR(2048) NOT NULL,
+    CATEGORIES JSON NOT NULL,
+    SIGNATURE_TYPE VARCHAR(16) NOT NULL,
+    SECRET TEXT,
+    ENABLED BOOLEAN DEFAULT TRUE NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for loading the webhook endpoints of a deployment.
+CREATE INDEX idx_webhook_endpoint_deployment ON "WEBHOOK_ENDPOINT" (DEPLOYMENT_ID);
+
+-- Table to store the saved simulation scenarios of flows. Scenarios are replayed as a regression suite
+-- when a flow is updated.
+CREATE TABLE "FLOW_SIMULATION_SCENARIO" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    NAME VARCHAR(255) NOT NULL,
+    DESCRIPTION VARCHAR(1024),
+    SCENARIO JSON NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for loading the simulation scenarios of a flow.
+CREATE INDEX idx_flow_simulation_scenario_flow ON "FLOW_SIMULATION_SCENARIO" (DEPLOYMENT_ID, FLOW_ID);
+
+-- Table to store the version rollouts of flows. A rollout routes a share of the new executions of a flow to a
+-- candidate version while the rest keep running the stable version.
+CREATE TABLE "FLOW_ROLLOUT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    ROLLOUT JSON NOT NULL,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (FLOW_ID, DEPLOYMENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to track the schema version of this database. This script creates the baseline version; later
+-- versions are applied with the migrate subcommand from the scripts under migrations/.
+CREATE TABLE "SCHEMA_VERSION" (
+    VERSION INTEGER PRIMARY KEY,
+    DESCRIPTION VARCHAR(255) NOT NULL,
+    CHECKSUM VARCHAR(64) NOT NULL,
+    APPLIED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (1, 'baseline', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (2, 'add_authz_policy', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (3, 'add_authz_relationships', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (4, 'add_webhook_endpoint', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (5, 'add_flow_simulation_scenario', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (6, 'add_flow_rollout', '');
@@ -333,6 +333,53 @@ CREATE TABLE "PRESENTATION_DEFINITION" (
 -- Each presentation definition handle is unique per deployment.
 CREATE UNIQUE INDEX idx_openid4vp_pd_handle ON "PRESENTATION_DEFINITION" (DEPLOYMENT_ID, HANDLE);
 
+-- Table to store attribute-based authorization policies.
+CREATE TABLE "AUTHZ_POLICY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    HANDLE VARCHAR(255) NOT NULL,
+    NAME VARCHAR(255),
+    DESCRIPTION VARCHAR(255),
+    EFFECT VARCHAR(16) NOT NULL,
+    RESOURCE_SERVER_ID VARCHAR(36) NOT NULL DEFAULT '',
+    TARGET JSONB,
+    CONDITIONS JSONB,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Each authorization policy handle is unique per deployment.
+CREATE UNIQUE INDEX idx_authz_policy_handle ON "AUTHZ_POLICY" (DEPLOYMENT_ID, HANDLE);
+
+-- Index for loading the policies applicable to a resource server.
+CREATE INDEX idx_authz_policy_resource_server ON "AUTHZ_POLICY" (DEPLOYMENT_ID, RESOURCE_SERVER_ID);
+
+-- Table to store the relationship-based authorization model, one row per object type.
+CREATE TABLE "AUTHZ_RELATION_TYPE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    NAME VARCHAR(255) NOT NULL,
+    RELATIONS JSONB NOT NULL,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (DEPLOYMENT_ID, NAME)
+);
+
+-- Table to store relationship tuples (object#relation@subject).
+CREATE TABLE "AUTHZ_RELATION_TUPLE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OBJECT_TYPE VARCHAR(255) NOT NULL,
+    OBJECT_ID VARCHAR(255) NOT NULL,
+    RELATION VARCHAR(255) NOT NULL,
+    SUBJECT_TYPE VARCHAR(255) NOT NULL,
+    SUBJECT_ID VARCHAR(255) NOT NULL,
+    SUBJECT_RELATION VARCHAR(255) NOT NULL DEFAULT '',
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (DEPLOYMENT_ID, OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION)
+);
+
+-- Index for finding the tuples of a subject.
+CREATE INDEX idx_authz_relation_tuple_subject ON "AUTHZ_RELATION_TUPLE" (DEPLOYMENT_ID, SUBJECT_TYPE, SUBJECT_ID);
+
 -- Table to store OpenID4VCI credential configurations.
 CREATE TABLE "CREDENTIAL_CONFIGURATION" (
     DEPLOYMENT_ID VARCHAR(255) NOT NULL,
@@ -362,3 +409,64 @@ CREATE TABLE "SERVER_CONFIG" (
     UPDATED_AT    TIMESTAMPTZ  DEFAULT NOW(),
     PRIMARY KEY (DEPLOYMENT_ID, NAME)
 );
+
+-- Table to store the webhook endpoints observability events are delivered to. SECRET holds the HMAC
+-- signing secret encrypted with the configuration crypto key; it is empty for JWS-signed endpoints.
+CREATE TABLE "WEBHOOK_ENDPOINT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    NAME VARCHAR(255) NOT NULL,
+    URL VARCHAR(2048) NOT NULL,
+    CATEGORIES JSONB NOT NULL,
+    SIGNATURE_TYPE VARCHAR(16) NOT NULL,
+    SECRET TEXT,
+    ENABLED BOOLEAN DEFAULT TRUE NOT NULL,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Index for loading the webhook endpoints of a deployment.
+CREATE INDEX idx_webhook_endpoint_deployment ON "WEBHOOK_ENDPOINT" (DEPLOYMENT_ID);
+
+-- Table to store the saved simulation scenarios of flows. Scenarios are replayed as a regression suite
+-- when a flow is updated.
+CREATE TABLE "FLOW_SIMULATION_SCENARIO" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    NAME VARCHAR(255) NOT NULL,
+    DESCRIPTION VARCHAR(1024),
+    SCENARIO JSONB NOT NULL,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Index for loading the simulation scenarios of a flow.
+CREATE INDEX idx_flow_simulation_scenario_flow ON "FLOW_SIMULATION_SCENARIO" (DEPLOYMENT_ID, FLOW_ID);
+
+-- Table to store the version rollouts of flows. A rollout routes a share of the new executions of a flow to a
+-- candidate version while the rest keep running the stable version.
+CREATE TABLE "FLOW_ROLLOUT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    ROLLOUT JSONB NOT NULL,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (FLOW_ID, DEPLOYMENT_ID)
+);
+
+-- Table to track the schema version of this database. This script creates the baseline version; later
+-- versions are applied with the migrate subcommand from the scripts under migrations/.
+CREATE TABLE "SCHEMA_VERSION" (
+    VERSION INTEGER PRIMARY KEY,
+    DESCRIPTION VARCHAR(255) NOT NULL,
+    CHECKSUM VARCHAR(64) NOT NULL,
+    APPLIED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (1, 'baseline', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (2, 'add_authz_policy', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (3, 'add_authz_relationships', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (4, 'add_webhook_endpoint', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (5, 'add_flow_simulation_scenario', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (6, 'add_flow_rollout', '');
@@ -0,0 +1,93 @@
+-- Identifiers are double quoted as in the other scripts. Run this script with the same SQL mode the server
+-- uses for its connections.
+SET SESSION sql_mode = 'ANSI_QUOTES,PIPES_AS_CONCAT,STRICT_TRANS_TABLES,NO_ZERO_DATE,NO_ENGINE_SUBSTITUTION';
+
+-- Table to store Organization Units
+CREATE TABLE "ORGANIZATION_UNIT" (
+    DEPLOYMENT_ID       VARCHAR(255) NOT NULL,
+    OU_ID           VARCHAR(36) PRIMARY KEY,
+    PARENT_ID       VARCHAR(36),
+    HANDLE          VARCHAR(100)        NOT NULL,
+    NAME            VARCHAR(100)        NOT NULL,
+    DESCRIPTION     VARCHAR(255),
+    METADATA         JSON,
+    CREATED_AT      DATETIME(6) NOT NULL,
+    UPDATED_AT      DATETIME(6) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for handle-based OU lookups
+CREATE INDEX idx_ou_handle_parent ON "ORGANIZATION_UNIT" (DEPLOYMENT_ID, HANDLE, PARENT_ID);
+
+-- Table to store Entities (unified identity principals: users, applications, agents)
+CREATE TABLE "ENTITY" (
+    DEPLOYMENT_ID       VARCHAR(255) NOT NULL,
+    ID                  VARCHAR(36)  PRIMARY KEY,
+    CATEGORY            VARCHAR(50)  NOT NULL,
+    TYPE                VARCHAR(50)  NOT NULL,
+    STATE               VARCHAR(50)  NOT NULL,
+    OU_ID               VARCHAR(36)  NOT NULL,
+    ATTRIBUTES          JSON,
+    SYSTEM_ATTRIBUTES   JSON,
+    CREDENTIALS         JSON,
+    SYSTEM_CREDENTIALS  JSON,
+    CREATED_AT          DATETIME(6) NOT NULL,
+    UPDATED_AT          DATETIME(6) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for category-based entity listing
+CREATE INDEX idx_entity_category_deployment ON "ENTITY" (DEPLOYMENT_ID, CATEGORY);
+
+-- Composite index for OU-based entity listing
+CREATE INDEX idx_entity_ou_deployment ON "ENTITY" (DEPLOYMENT_ID, OU_ID);
+
+-- Table to store Groups
+CREATE TABLE "GROUP" (
+    DEPLOYMENT_ID       VARCHAR(255) NOT NULL,
+    ID              VARCHAR(36)        PRIMARY KEY,
+    OU_ID           VARCHAR(36)        NOT NULL,
+    NAME            VARCHAR(50)        NOT NULL,
+    DESCRIPTION     VARCHAR(255),
+    CREATED_AT      DATETIME(6) NOT NULL,
+    UPDATED_AT      DATETIME(6) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for name conflict checks within an OU
+CREATE INDEX idx_group_name_ou_deployment ON "GROUP" (DEPLOYMENT_ID, OU_ID, NAME);
+
+-- Table to store Group member assignments
+CREATE TABLE "GROUP_MEMBER_REFERENCE" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    GROUP_ID    VARCHAR(36) NOT NULL,
+    MEMBER_TYPE VARCHAR(6)  NOT NULL CHECK (MEMBER_TYPE IN ('entity', 'group')),
+    MEMBER_ID   VARCHAR(36) NOT NULL,
+    CREATED_AT  DATETIME(6) NOT NULL,
+    UPDATED_AT  DATETIME(6) NOT NULL,
+    PRIMARY KEY (GROUP_ID, MEMBER_TYPE, MEMBER_ID, DEPLOYMENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store indexed entity identifiers for fast lookups (authentication, identification)
+CREATE TABLE "ENTITY_IDENTIFIER" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ENTITY_ID       VARCHAR(36)  NOT NULL,
+    NAME            VARCHAR(255) NOT NULL,
+    VALUE           TEXT         NOT NULL,
+    SOURCE          VARCHAR(50)  NOT NULL,
+    CREATED_AT      DATETIME(6) NOT NULL,
+    PRIMARY KEY (ENTITY_ID, DEPLOYMENT_ID, NAME),
+    FOREIGN KEY (ENTITY_ID) REFERENCES "ENTITY" (ID) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for fast identifier lookups (primary use case for authentication). VALUE is a TEXT column, so only
+-- its prefix is indexed.
+CREATE INDEX idx_entity_identifier_lookup ON "ENTITY_IDENTIFIER" (NAME, VALUE(255));
+
+-- Table to track the schema version of this database. This script creates the baseline version; later
+-- versions are applied with the migrate subcommand from the scripts under migrations/.
+CREATE TABLE "SCHEMA_VERSION" (
+    VERSION INTEGER PRIMARY KEY,
+    DESCRIPTION VARCHAR(255) NOT NULL,
+    CHECKSUM VARCHAR(64) NOT NULL,
+    APPLIED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (1, 'baseline', '');
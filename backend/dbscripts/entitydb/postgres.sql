@@ -75,3 +75,14 @@ CREATE TABLE "ENTITY_IDENTIFIER" (
 
 -- Index for fast identifier lookups (primary use case for authentication)
 CREATE INDEX idx_entity_identifier_lookup ON "ENTITY_IDENTIFIER" (NAME, VALUE);
+
+-- Table to track the schema version of this database. This script creates the baseline version; later
+-- versions are applied with the migrate subcommand from the scripts under migrations/.
+CREATE TABLE "SCHEMA_VERSION" (
+    VERSION INTEGER PRIMARY KEY,
+    DESCRIPTION VARCHAR(255) NOT NULL,
+    CHECKSUM VARCHAR(64) NOT NULL,
+    APPLIED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (1, 'baseline', '');
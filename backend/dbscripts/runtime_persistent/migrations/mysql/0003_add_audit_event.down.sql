@@ -0,0 +1 @@
+DROP TABLE "AUDIT_EVENT";
@@ -0,0 +1 @@
+DROP TABLE "FLOW_ANALYTICS";
@@ -0,0 +1,2 @@
+DROP TABLE "PAIRWISE_SALT";
+DROP TABLE "PAIRWISE_SUBJECT";
@@ -0,0 +1,2 @@
+DROP TABLE "AUDIT_EVENT";
+DROP FUNCTION reject_audit_event_modification();
@@ -0,0 +1,29 @@
+-- Table to store the webhook delivery queue. Each row is one observability event queued for one
+-- webhook endpoint. PENDING rows are delivered once NEXT_ATTEMPT_AT has passed; a failed attempt
+-- pushes NEXT_ATTEMPT_AT back with exponential backoff, and a delivery that exhausts its attempts is
+-- moved to the dead-letter list (DEAD) until EXPIRY_TIME. Delivered rows are deleted. Part of the
+-- database.runtime_persistent classification: queued deliveries must survive a runtime database flush
+-- and a server restart.
+CREATE TABLE "WEBHOOK_DELIVERY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) NOT NULL,
+    ENDPOINT_ID VARCHAR(36) NOT NULL,
+    EVENT_ID VARCHAR(255) NOT NULL,
+    EVENT_TYPE VARCHAR(100) NOT NULL,
+    PAYLOAD TEXT NOT NULL,
+    STATUS VARCHAR(16) NOT NULL,
+    ATTEMPTS INTEGER NOT NULL DEFAULT 0,
+    NEXT_ATTEMPT_AT TIMESTAMP NOT NULL,
+    LAST_STATUS_CODE INTEGER,
+    LAST_ERROR VARCHAR(1024),
+    CREATED_AT TIMESTAMP NOT NULL,
+    UPDATED_AT TIMESTAMP NOT NULL,
+    EXPIRY_TIME TIMESTAMP,
+    PRIMARY KEY (DEPLOYMENT_ID, ID)
+);
+
+-- Index for loading the deliveries of an endpoint that are due, and its dead-letter list.
+CREATE INDEX idx_webhook_delivery_endpoint ON "WEBHOOK_DELIVERY" (DEPLOYMENT_ID, ENDPOINT_ID, STATUS, NEXT_ATTEMPT_AT);
+
+-- Index for expiry time on WEBHOOK_DELIVERY (supports cleanup of expired dead letters).
+CREATE INDEX idx_webhook_delivery_expiry_time ON "WEBHOOK_DELIVERY" (EXPIRY_TIME);
@@ -0,0 +1,20 @@
+-- Table to store the flow analytics counters. Each row is one counter of a flow version in an hourly
+-- bucket: a flow-level counter (NODE_ID is empty) of started, completed and failed executions, or a
+-- node-level counter of entries, completions, prompts, failures and the latency histogram of a node.
+-- Counters are incremented by the flow analytics subscriber and removed after EXPIRY_TIME. Part of the
+-- database.runtime_persistent classification: the counters must survive a runtime database flush.
+CREATE TABLE "FLOW_ANALYTICS" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    FLOW_VERSION INTEGER NOT NULL,
+    BUCKET_START TIMESTAMP NOT NULL,
+    NODE_ID VARCHAR(255) NOT NULL,
+    METRIC VARCHAR(32) NOT NULL,
+    NODE_TYPE VARCHAR(50) NOT NULL,
+    METRIC_VALUE BIGINT NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID, FLOW_ID, BUCKET_START, FLOW_VERSION, NODE_ID, METRIC)
+);
+
+-- Index for expiry time on FLOW_ANALYTICS (supports cleanup of expired counters).
+CREATE INDEX idx_flow_analytics_expiry_time ON "FLOW_ANALYTICS" (EXPIRY_TIME);
@@ -0,0 +1,24 @@
+-- Table to map pairwise subject identifiers back to the user they were issued for (OIDC Core §8.1).
+-- A pairwise sub is a one-way hash of the sector and user id, so this lookup is what lets an
+-- id_token_hint or subject_token carrying one be resolved to the internal user. Part of the
+-- database.runtime_persistent classification: the mapping has no expiry and must survive a runtime
+-- database flush.
+CREATE TABLE "PAIRWISE_SUBJECT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    SECTOR_IDENTIFIER VARCHAR(255) NOT NULL,
+    SUBJECT VARCHAR(255) NOT NULL,
+    USER_ID VARCHAR(36) NOT NULL,
+    CREATED_AT DATETIME NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID, SECTOR_IDENTIFIER, SUBJECT)
+);
+
+-- Table to store the secret salt mixed into pairwise subject identifiers when none is configured. The
+-- first server node to start generates it; the others read it back. Part of the
+-- database.runtime_persistent classification: losing it changes every pairwise sub, so it must survive
+-- a runtime database flush.
+CREATE TABLE "PAIRWISE_SALT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    SALT VARCHAR(255) NOT NULL,
+    CREATED_AT DATETIME NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID)
+);
@@ -0,0 +1,38 @@
+-- Table to store the audit trail of management API mutations. Each row records who changed which
+-- resource, from where and how, with the before/after values of the changed fields (credential values
+-- redacted). Part of the database.runtime_persistent classification: the trail has no expiry and must
+-- survive a runtime database flush. The table is append-only; the triggers below reject updates and
+-- deletes.
+CREATE TABLE "AUDIT_EVENT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    EVENT_ID VARCHAR(36) NOT NULL,
+    EVENT_TIME DATETIME NOT NULL,
+    ACTION VARCHAR(16) NOT NULL,
+    RESOURCE_TYPE VARCHAR(50) NOT NULL,
+    RESOURCE_ID VARCHAR(255) NOT NULL,
+    ACTOR_ID VARCHAR(255),
+    CORRELATION_ID VARCHAR(255),
+    SOURCE_IP VARCHAR(64),
+    CHANGES TEXT,
+    PRIMARY KEY (DEPLOYMENT_ID, EVENT_ID)
+);
+
+-- Index for searching the audit trail by time, the default sort order.
+CREATE INDEX idx_audit_event_time ON "AUDIT_EVENT" (DEPLOYMENT_ID, EVENT_TIME);
+
+-- Index for searching the audit trail of a resource.
+CREATE INDEX idx_audit_event_resource ON "AUDIT_EVENT" (DEPLOYMENT_ID, RESOURCE_TYPE, RESOURCE_ID);
+
+-- Index for searching the audit trail of an actor.
+CREATE INDEX idx_audit_event_actor ON "AUDIT_EVENT" (DEPLOYMENT_ID, ACTOR_ID);
+
+-- Rejects any modification of a recorded audit event.
+CREATE TRIGGER trg_audit_event_no_update BEFORE UPDATE ON "AUDIT_EVENT"
+BEGIN
+    SELECT RAISE(ABORT, 'AUDIT_EVENT is append-only');
+END;
+
+CREATE TRIGGER trg_audit_event_no_delete BEFORE DELETE ON "AUDIT_EVENT"
+BEGIN
+    SELECT RAISE(ABORT, 'AUDIT_EVENT is append-only');
+END;
@@ -0,0 +1,123 @@
+-- Copyright 2026 The ThunderID Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- ============================================================
+-- Stored procedure: purge expired runtime_persistent rows in bounded batches.
+--
+-- Unlike runtime_transient, runtime_persistent data is authoritative and must survive a
+-- runtime_transient flush; only rows past their EXPIRY_TIME are safe to delete. A revoked
+-- token's row is removable once the token itself would have naturally expired.
+--
+-- Deletes expired rows in batches of p_batch_size (1000 when NULL or not positive),
+-- committing after each batch to keep locks short on large tables. Must run as a
+-- top-level CALL (the per-batch COMMIT ends any outer transaction).
+--
+-- Run once manually (ad-hoc / on-demand):
+--   mysql -h <host> -P <port> -u <user> -p<pass> <runtime_persistent> \
+--     -e "CALL cleanup_expired_runtime_persistent_data(NULL);"
+--
+--   -- Optional: override the batch size (rows deleted per batch):
+--   -e "CALL cleanup_expired_runtime_persistent_data(500);"
+--
+-- Scheduled execution options:
+--
+--   1. Event scheduler (RECOMMENDED, requires event_scheduler=ON):
+--      CREATE EVENT cleanup_runtime_persistent_expired
+--        ON SCHEDULE EVERY 60 MINUTE
+--        DO CALL cleanup_expired_runtime_persistent_data(NULL);
+--      -- To verify: SHOW EVENTS WHERE Name = 'cleanup_runtime_persistent_expired';
+--      -- To remove: DROP EVENT cleanup_runtime_persistent_expired;
+--
+--   2. Kubernetes CronJob: call CALL cleanup_expired_runtime_persistent_data(NULL)
+--      via a mysql client container on the desired schedule.
+--
+--   3. OS cron (every 60 minutes):
+-- --      */60 * * * * mysql mysql -h <host> -P <port> -u <user> -p<pass> <runtime_persistent> \
+-- --        -e "CALL cleanup_expired_runtime_persistent_data(NULL);" \
+-- --        >> /var/log/thunderid-operation-cleanup.log 2>&1
+-- ============================================================
+
+SET SESSION sql_mode = 'ANSI_QUOTES,PIPES_AS_CONCAT,STRICT_TRANS_TABLES,NO_ZERO_DATE,NO_ENGINE_SUBSTITUTION';
+
+DROP PROCEDURE IF EXISTS cleanup_expired_runtime_persistent_data;
+
+DELIMITER //
+
+CREATE PROCEDURE cleanup_expired_runtime_persistent_data(IN p_batch_size INT)
+BEGIN
+    DECLARE v_now     DATETIME(6) DEFAULT UTC_TIMESTAMP(6);
+    DECLARE v_deleted INT DEFAULT 1;
+
+    -- Guard against a batch size that would disable batching or make no progress.
+    IF p_batch_size IS NULL OR p_batch_size <= 0 THEN
+        SET p_batch_size = 1000;
+    END IF;
+
+    WHILE v_deleted > 0 DO
+        DELETE FROM "REVOKED_TOKEN" WHERE EXPIRY_TIME < v_now ORDER BY EXPIRY_TIME LIMIT p_batch_size;
+        SET v_deleted = ROW_COUNT();
+        COMMIT;
+    END WHILE;
+
+    -- SSO sessions past their absolute deadline, together with their context and participant
+    -- children. A session is live only while now < IDLE_EXPIRES_AT AND now < ABSOLUTE_EXPIRES_AT, so
+    -- a row past ABSOLUTE_EXPIRES_AT can never resume and is safe to delete; idle-expired-but-absolute-
+    -- live rows are left for a later sweep (the resolver already rejects them). There is no FK cascade
+    -- between the three SSO tables and a multi-table DELETE cannot take a LIMIT, so each batch collects
+    -- its victims (located via idx_sso_session_absolute_expires_at) into a temporary table and deletes
+    -- the children and the parents by joining against it.
+    DROP TEMPORARY TABLE IF EXISTS sso_session_victims;
+    CREATE TEMPORARY TABLE sso_session_victims (
+        SESSION_ID VARCHAR(36) NOT NULL,
+        DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+        PRIMARY KEY (SESSION_ID, DEPLOYMENT_ID)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+    SET v_deleted = 1;
+    WHILE v_deleted > 0 DO
+        DELETE FROM sso_session_victims;
+        INSERT INTO sso_session_victims (SESSION_ID, DEPLOYMENT_ID)
+            SELECT SESSION_ID, DEPLOYMENT_ID
+            FROM "SSO_SESSION"
+            WHERE ABSOLUTE_EXPIRES_AT <= v_now
+            ORDER BY ABSOLUTE_EXPIRES_AT
+            LIMIT p_batch_size;
+
+        DELETE c FROM "SSO_SESSION_CONTEXT" c
+            JOIN sso_session_victims v ON c.SESSION_ID = v.SESSION_ID AND c.DEPLOYMENT_ID = v.DEPLOYMENT_ID;
+        DELETE p FROM "SSO_SESSION_PARTICIPANT" p
+            JOIN sso_session_victims v ON p.SESSION_ID = v.SESSION_ID AND p.DEPLOYMENT_ID = v.DEPLOYMENT_ID;
+        DELETE s FROM "SSO_SESSION" s
+            JOIN sso_session_victims v ON s.SESSION_ID = v.SESSION_ID AND s.DEPLOYMENT_ID = v.DEPLOYMENT_ID;
+        SET v_deleted = ROW_COUNT();
+        COMMIT;
+    END WHILE;
+
+    DROP TEMPORARY TABLE IF EXISTS sso_session_victims;
+
+    SET v_deleted = 1;
+    WHILE v_deleted > 0 DO
+        DELETE FROM "REVOCATION_CRITERIA" WHERE EXPIRY_TIME < v_now ORDER BY EXPIRY_TIME LIMIT p_batch_size;
+        SET v_deleted = ROW_COUNT();
+        COMMIT;
+    END WHILE;
+
+    -- Dead-lettered webhook deliveries past their retention. Pending deliveries have no EXPIRY_TIME
+    -- and are never swept.
+    SET v_deleted = 1;
+    WHILE v_deleted > 0 DO
+        DELETE FROM "WEBHOOK_DELIVERY" WHERE EXPIRY_TIME < v_now ORDER BY EXPIRY_TIME LIMIT p_batch_size;
+        SET v_deleted = ROW_COUNT();
+        COMMIT;
+    END WHILE;
+
+    -- Flow analytics counters past their retention.
+    SET v_deleted = 1;
+    WHILE v_deleted > 0 DO
+        DELETE FROM "FLOW_ANALYTICS" WHERE EXPIRY_TIME < v_now ORDER BY EXPIRY_TIME LIMIT p_batch_size;
+        SET v_deleted = ROW_COUNT();
+        COMMIT;
+    END WHILE;
+END //
+
+DELIMITER ;
@@ -0,0 +1,263 @@
+-- Copyright 2026 The ThunderID Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Identifiers are double quoted as in the other scripts. Run this script with the same SQL mode the server
+-- uses for its connections.
+SET SESSION sql_mode = 'ANSI_QUOTES,PIPES_AS_CONCAT,STRICT_TRANS_TABLES,NO_ZERO_DATE,NO_ENGINE_SUBSTITUTION';
+
+-- Table to store revoked token JTIs (single-token revocation deny list).
+-- Part of the database.runtime_persistent classification: authoritative authorization
+-- enforcement state that must survive a runtime database flush.
+CREATE TABLE "REVOKED_TOKEN" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) NOT NULL PRIMARY KEY,
+    JTI VARCHAR(255) NOT NULL,
+    REVOCATION_REASON VARCHAR(30) NOT NULL CHECK (REVOCATION_REASON IN ('explicit', 'refresh_rotation')),
+    REVOKED_AT DATETIME(6) NOT NULL,
+    EXPIRY_TIME DATETIME(6) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Unique index backs the hot deny-list lookup by (deployment, jti) and enforces idempotent revocation writes.
+CREATE UNIQUE INDEX idx_revoked_token_jti_deployment ON "REVOKED_TOKEN" (DEPLOYMENT_ID, JTI);
+
+-- Index for expiry time on REVOKED_TOKEN (supports cleanup and expiry checks).
+CREATE INDEX idx_revoked_token_expiry_time ON "REVOKED_TOKEN" (EXPIRY_TIME);
+
+-- Table to store criteria-based (many-token) revocations: a generalized attribute deny list.
+-- CRITERION_TYPE names the dimension ('token_family' today; subject/client/consent are future types)
+-- and CRITERION_VALUE holds the revoked value (the tfid for 'token_family'). Part of the
+-- database.runtime_persistent classification: authoritative enforcement state that must survive a
+-- runtime database flush.
+CREATE TABLE "REVOCATION_CRITERIA" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) NOT NULL PRIMARY KEY,
+    CRITERION_TYPE VARCHAR(30) NOT NULL,
+    CRITERION_VALUE VARCHAR(255) NOT NULL,
+    REASON VARCHAR(30) NOT NULL,
+    REVOKED_AT DATETIME(6) NOT NULL,
+    EXPIRY_TIME DATETIME(6) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Unique index backs the hot lookup by (deployment, type, value) and enforces idempotent writes.
+CREATE UNIQUE INDEX idx_revocation_criteria_lookup
+    ON "REVOCATION_CRITERIA" (DEPLOYMENT_ID, CRITERION_TYPE, CRITERION_VALUE);
+
+-- Index for expiry time on REVOCATION_CRITERIA (supports cleanup and expiry checks).
+CREATE INDEX idx_revocation_criteria_expiry_time ON "REVOCATION_CRITERIA" (EXPIRY_TIME);
+
+-- Table to store SSO sessions, grouped by flow (FLOW_ID) and resolved by an opaque handle.
+-- Part of the database.runtime_persistent classification: persistent session state that must survive a
+-- runtime database flush.
+CREATE TABLE "SSO_SESSION" (
+    SESSION_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    SUBJECT_ID VARCHAR(36) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    FLOW_VERSION INTEGER NOT NULL,
+    FLOW_EXECUTION_ID VARCHAR(255) NOT NULL,
+    HANDLE_ID VARCHAR(255) NOT NULL,
+    AUTHENTICATED_AT DATETIME(6) NOT NULL,
+    CREATED_AT DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    LAST_ACTIVE_AT DATETIME(6) NOT NULL,
+    IDLE_EXPIRES_AT DATETIME(6),
+    ABSOLUTE_EXPIRES_AT DATETIME(6),
+    STATE VARCHAR(50) NOT NULL,
+    VERSION INTEGER NOT NULL,
+    UPDATED_AT DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (SESSION_ID, DEPLOYMENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Unique index for handle lookup on SSO_SESSION (one session per handle, per deployment)
+CREATE UNIQUE INDEX idx_sso_session_handle_id ON "SSO_SESSION" (HANDLE_ID, DEPLOYMENT_ID);
+
+-- Unique index enforcing one session per establishing flow execution (per deployment). Lets
+-- concurrent joins in a single flow execution converge on one session instead of duplicating it.
+CREATE UNIQUE INDEX idx_sso_session_flow_execution ON "SSO_SESSION" (FLOW_EXECUTION_ID, DEPLOYMENT_ID);
+
+-- Index for absolute expiry on SSO_SESSION (supports cleanup)
+CREATE INDEX idx_sso_session_absolute_expires_at ON "SSO_SESSION" (ABSOLUTE_EXPIRES_AT);
+
+-- Table to store the durable session context for an SSO session, one row per checkpoint.
+CREATE TABLE "SSO_SESSION_CONTEXT" (
+    SESSION_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    CHECKPOINT_ID VARCHAR(255) NOT NULL,
+    CONTEXT MEDIUMTEXT,
+    CONTEXT_VERSION INTEGER NOT NULL,
+    CREATED_AT DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (SESSION_ID, DEPLOYMENT_ID, CHECKPOINT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to record the applications participating in an SSO session (1:many by SESSION_ID).
+CREATE TABLE "SSO_SESSION_PARTICIPANT" (
+    SESSION_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    APP_ID VARCHAR(36) NOT NULL,
+    TFID VARCHAR(36),
+    FIRST_JOINED_AT DATETIME(6) NOT NULL,
+    LAST_ACTIVE_AT DATETIME(6) NOT NULL,
+    PRIMARY KEY (SESSION_ID, DEPLOYMENT_ID, APP_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store consent records.
+CREATE TABLE "CONSENT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) NOT NULL PRIMARY KEY,
+    GROUP_ID VARCHAR(36) NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    VALIDITY_TIME DATETIME(6),
+    PURPOSES JSON,
+    CREATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for group + status consent search.
+CREATE INDEX idx_consent_group_status ON "CONSENT" (DEPLOYMENT_ID, GROUP_ID, STATUS);
+
+-- Table to store the authorization records of a consent (1:many by CONSENT_ID).
+-- USER_ID is normalized out of the consent row so consents can be searched by user.
+CREATE TABLE "CONSENT_AUTHORIZATION" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) NOT NULL PRIMARY KEY,
+    CONSENT_ID VARCHAR(36) NOT NULL,
+    USER_ID VARCHAR(36) NOT NULL,
+    TYPE VARCHAR(20) NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    UPDATED_TIME DATETIME(6),
+    FOREIGN KEY (CONSENT_ID) REFERENCES "CONSENT" (ID) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Composite index for user-based consent search (join CONSENT_AUTHORIZATION -> CONSENT).
+CREATE INDEX idx_consent_authz_user ON "CONSENT_AUTHORIZATION" (DEPLOYMENT_ID, USER_ID);
+
+-- Index for loading a consent's authorization records.
+CREATE INDEX idx_consent_authz_consent ON "CONSENT_AUTHORIZATION" (CONSENT_ID, DEPLOYMENT_ID);
+
+-- Table to map pairwise subject identifiers back to the user they were issued for (OIDC Core §8.1).
+-- A pairwise sub is a one-way hash of the sector and user id, so this lookup is what lets an
+-- id_token_hint or subject_token carrying one be resolved to the internal user. Part of the
+-- database.runtime_persistent classification: the mapping has no expiry and must survive a runtime
+-- database flush.
+CREATE TABLE "PAIRWISE_SUBJECT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    SECTOR_IDENTIFIER VARCHAR(255) NOT NULL,
+    SUBJECT VARCHAR(255) NOT NULL,
+    USER_ID VARCHAR(36) NOT NULL,
+    CREATED_AT DATETIME(6) NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID, SECTOR_IDENTIFIER, SUBJECT)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store the secret salt mixed into pairwise subject identifiers when none is configured. The
+-- first server node to start generates it; the others read it back. Part of the
+-- database.runtime_persistent classification: losing it changes every pairwise sub, so it must survive
+-- a runtime database flush.
+CREATE TABLE "PAIRWISE_SALT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    SALT VARCHAR(255) NOT NULL,
+    CREATED_AT DATETIME(6) NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Table to store the audit trail of management API mutations. Each row records who changed which
+-- resource, from where and how, with the before/after values of the changed fields (credential values
+-- redacted). Part of the database.runtime_persistent classification: the trail has no expiry and must
+-- survive a runtime database flush. The table is append-only; the triggers below reject updates and
+-- deletes.
+CREATE TABLE "AUDIT_EVENT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    EVENT_ID VARCHAR(36) NOT NULL,
+    EVENT_TIME DATETIME(6) NOT NULL,
+    ACTION VARCHAR(16) NOT NULL,
+    RESOURCE_TYPE VARCHAR(50) NOT NULL,
+    RESOURCE_ID VARCHAR(255) NOT NULL,
+    ACTOR_ID VARCHAR(255),
+    CORRELATION_ID VARCHAR(255),
+    SOURCE_IP VARCHAR(64),
+    CHANGES JSON,
+    PRIMARY KEY (DEPLOYMENT_ID, EVENT_ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for searching the audit trail by time, the default sort order.
+CREATE INDEX idx_audit_event_time ON "AUDIT_EVENT" (DEPLOYMENT_ID, EVENT_TIME);
+
+-- Index for searching the audit trail of a resource.
+CREATE INDEX idx_audit_event_resource ON "AUDIT_EVENT" (DEPLOYMENT_ID, RESOURCE_TYPE, RESOURCE_ID);
+
+-- Index for searching the audit trail of an actor.
+CREATE INDEX idx_audit_event_actor ON "AUDIT_EVENT" (DEPLOYMENT_ID, ACTOR_ID);
+
+-- Reject any modification of a recorded audit event. A trigger covers a single event, so updates and
+-- deletes have one trigger each.
+CREATE TRIGGER trg_audit_event_no_update
+    BEFORE UPDATE ON "AUDIT_EVENT"
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'AUDIT_EVENT is append-only';
+
+CREATE TRIGGER trg_audit_event_no_delete
+    BEFORE DELETE ON "AUDIT_EVENT"
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'AUDIT_EVENT is append-only';
+
+-- Table to store the webhook delivery queue. Each row is one observability event queued for one
+-- webhook endpoint. PENDING rows are delivered once NEXT_ATTEMPT_AT has passed; a failed attempt
+-- pushes NEXT_ATTEMPT_AT back with exponential backoff, and a delivery that exhausts its attempts is
+-- moved to the dead-letter list (DEAD) until EXPIRY_TIME. Delivered rows are deleted. Part of the
+-- database.runtime_persistent classification: queued deliveries must survive a runtime database flush
+-- and a server restart.
+CREATE TABLE "WEBHOOK_DELIVERY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) NOT NULL,
+    ENDPOINT_ID VARCHAR(36) NOT NULL,
+    EVENT_ID VARCHAR(255) NOT NULL,
+    EVENT_TYPE VARCHAR(100) NOT NULL,
+    PAYLOAD MEDIUMTEXT NOT NULL,
+    STATUS VARCHAR(16) NOT NULL,
+    ATTEMPTS INTEGER NOT NULL DEFAULT 0,
+    NEXT_ATTEMPT_AT DATETIME(6) NOT NULL,
+    LAST_STATUS_CODE INTEGER,
+    LAST_ERROR VARCHAR(1024),
+    CREATED_AT DATETIME(6) NOT NULL,
+    UPDATED_AT DATETIME(6) NOT NULL,
+    EXPIRY_TIME DATETIME(6),
+    PRIMARY KEY (DEPLOYMENT_ID, ID)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for loading the deliveries of an endpoint that are due, and its dead-letter list.
+CREATE INDEX idx_webhook_delivery_endpoint ON "WEBHOOK_DELIVERY" (DEPLOYMENT_ID, ENDPOINT_ID, STATUS, NEXT_ATTEMPT_AT);
+
+-- Index for expiry time on WEBHOOK_DELIVERY (supports cleanup of expired dead letters).
+CREATE INDEX idx_webhook_delivery_expiry_time ON "WEBHOOK_DELIVERY" (EXPIRY_TIME);
+
+-- Table to store the flow analytics counters. Each row is one counter of a flow version in an hourly
+-- bucket: a flow-level counter (NODE_ID is empty) of started, completed and failed executions, or a
+-- node-level counter of entries, completions, prompts, failures and the latency histogram of a node.
+-- Counters are incremented by the flow analytics subscriber and removed after EXPIRY_TIME. Part of the
+-- database.runtime_persistent classification: the counters must survive a runtime database flush.
+CREATE TABLE "FLOW_ANALYTICS" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    FLOW_VERSION INTEGER NOT NULL,
+    BUCKET_START DATETIME(6) NOT NULL,
+    NODE_ID VARCHAR(255) NOT NULL,
+    METRIC VARCHAR(32) NOT NULL,
+    NODE_TYPE VARCHAR(50) NOT NULL,
+    METRIC_VALUE BIGINT NOT NULL,
+    EXPIRY_TIME DATETIME(6) NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID, FLOW_ID, BUCKET_START, FLOW_VERSION, NODE_ID, METRIC)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for expiry time on FLOW_ANALYTICS (supports cleanup of expired counters).
+CREATE INDEX idx_flow_analytics_expiry_time ON "FLOW_ANALYTICS" (EXPIRY_TIME);
+
+-- Table to track the schema version of this database. This script creates the baseline version; later
+-- versions are applied with the migrate subcommand from the scripts under migrations/.
+CREATE TABLE "SCHEMA_VERSION" (
+    VERSION INTEGER PRIMARY KEY,
+    DESCRIPTION VARCHAR(255) NOT NULL,
+    CHECKSUM VARCHAR(64) NOT NULL,
+    APPLIED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (1, 'baseline', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (2, 'add_pairwise_subject', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (3, 'add_audit_event', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (4, 'add_webhook_delivery', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (5, 'add_flow_analytics', '');
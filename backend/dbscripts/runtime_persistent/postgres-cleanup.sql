@@ -113,5 +113,28 @@ BEGIN
         COMMIT;
         EXIT WHEN v_deleted = 0;
     END LOOP;
+
+    -- Dead-lettered webhook deliveries past their retention. Pending deliveries have no EXPIRY_TIME
+    -- and are never swept.
+    LOOP
+        DELETE FROM "WEBHOOK_DELIVERY"
+        WHERE ctid IN (
+            SELECT ctid FROM "WEBHOOK_DELIVERY" WHERE EXPIRY_TIME < v_now LIMIT p_batch_size
+        );
+        GET DIAGNOSTICS v_deleted = ROW_COUNT;
+        COMMIT;
+        EXIT WHEN v_deleted = 0;
+    END LOOP;
+
+    -- Flow analytics counters past their retention.
+    LOOP
+        DELETE FROM "FLOW_ANALYTICS"
+        WHERE ctid IN (
+            SELECT ctid FROM "FLOW_ANALYTICS" WHERE EXPIRY_TIME < v_now LIMIT p_batch_size
+        );
+        GET DIAGNOSTICS v_deleted = ROW_COUNT;
+        COMMIT;
+        EXIT WHEN v_deleted = 0;
+    END LOOP;
 END;
 $$;
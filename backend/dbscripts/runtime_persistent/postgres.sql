@@ -128,3 +128,133 @@ CREATE INDEX idx_consent_authz_user ON "CONSENT_AUTHORIZATION" (DEPLOYMENT_ID, U
 
 -- Index for loading a consent's authorization records.
 CREATE INDEX idx_consent_authz_consent ON "CONSENT_AUTHORIZATION" (CONSENT_ID, DEPLOYMENT_ID);
+
+-- Table to map pairwise subject identifiers back to the user they were issued for (OIDC Core §8.1).
+-- A pairwise sub is a one-way hash of the sector and user id, so this lookup is what lets an
+-- id_token_hint or subject_token carrying one be resolved to the internal user. Part of the
+-- database.runtime_persistent classification: the mapping has no expiry and must survive a runtime
+-- database flush.
+CREATE TABLE "PAIRWISE_SUBJECT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    SECTOR_IDENTIFIER VARCHAR(255) NOT NULL,
+    SUBJECT VARCHAR(255) NOT NULL,
+    USER_ID VARCHAR(36) NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID, SECTOR_IDENTIFIER, SUBJECT)
+);
+
+-- Table to store the secret salt mixed into pairwise subject identifiers when none is configured. The
+-- first server node to start generates it; the others read it back. Part of the
+-- database.runtime_persistent classification: losing it changes every pairwise sub, so it must survive
+-- a runtime database flush.
+CREATE TABLE "PAIRWISE_SALT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    SALT VARCHAR(255) NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID)
+);
+
+-- Table to store the audit trail of management API mutations. Each row records who changed which
+-- resource, from where and how, with the before/after values of the changed fields (credential values
+-- redacted). Part of the database.runtime_persistent classification: the trail has no expiry and must
+-- survive a runtime database flush. The table is append-only; the triggers below reject updates and
+-- deletes.
+CREATE TABLE "AUDIT_EVENT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    EVENT_ID VARCHAR(36) NOT NULL,
+    EVENT_TIME TIMESTAMP NOT NULL,
+    ACTION VARCHAR(16) NOT NULL,
+    RESOURCE_TYPE VARCHAR(50) NOT NULL,
+    RESOURCE_ID VARCHAR(255) NOT NULL,
+    ACTOR_ID VARCHAR(255),
+    CORRELATION_ID VARCHAR(255),
+    SOURCE_IP VARCHAR(64),
+    CHANGES JSONB,
+    PRIMARY KEY (DEPLOYMENT_ID, EVENT_ID)
+);
+
+-- Index for searching the audit trail by time, the default sort order.
+CREATE INDEX idx_audit_event_time ON "AUDIT_EVENT" (DEPLOYMENT_ID, EVENT_TIME);
+
+-- Index for searching the audit trail of a resource.
+CREATE INDEX idx_audit_event_resource ON "AUDIT_EVENT" (DEPLOYMENT_ID, RESOURCE_TYPE, RESOURCE_ID);
+
+-- Index for searching the audit trail of an actor.
+CREATE INDEX idx_audit_event_actor ON "AUDIT_EVENT" (DEPLOYMENT_ID, ACTOR_ID);
+
+-- Rejects any modification of a recorded audit event.
+CREATE FUNCTION reject_audit_event_modification() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'AUDIT_EVENT is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_event_append_only
+    BEFORE UPDATE OR DELETE ON "AUDIT_EVENT"
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_event_modification();
+
+-- Table to store the webhook delivery queue. Each row is one observability event queued for one
+-- webhook endpoint. PENDING rows are delivered once NEXT_ATTEMPT_AT has passed; a failed attempt
+-- pushes NEXT_ATTEMPT_AT back with exponential backoff, and a delivery that exhausts its attempts is
+-- moved to the dead-letter list (DEAD) until EXPIRY_TIME. Delivered rows are deleted. Part of the
+-- database.runtime_persistent classification: queued deliveries must survive a runtime database flush
+-- and a server restart.
+CREATE TABLE "WEBHOOK_DELIVERY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) NOT NULL,
+    ENDPOINT_ID VARCHAR(36) NOT NULL,
+    EVENT_ID VARCHAR(255) NOT NULL,
+    EVENT_TYPE VARCHAR(100) NOT NULL,
+    PAYLOAD TEXT NOT NULL,
+    STATUS VARCHAR(16) NOT NULL,
+    ATTEMPTS INTEGER NOT NULL DEFAULT 0,
+    NEXT_ATTEMPT_AT TIMESTAMP NOT NULL,
+    LAST_STATUS_CODE INTEGER,
+    LAST_ERROR VARCHAR(1024),
+    CREATED_AT TIMESTAMP NOT NULL,
+    UPDATED_AT TIMESTAMP NOT NULL,
+    EXPIRY_TIME TIMESTAMP,
+    PRIMARY KEY (DEPLOYMENT_ID, ID)
+);
+
+-- Index for loading the deliveries of an endpoint that are due, and its dead-letter list.
+CREATE INDEX idx_webhook_delivery_endpoint ON "WEBHOOK_DELIVERY" (DEPLOYMENT_ID, ENDPOINT_ID, STATUS, NEXT_ATTEMPT_AT);
+
+-- Index for expiry time on WEBHOOK_DELIVERY (supports cleanup of expired dead letters).
+CREATE INDEX idx_webhook_delivery_expiry_time ON "WEBHOOK_DELIVERY" (EXPIRY_TIME);
+
+-- Table to store the flow analytics counters. Each row is one counter of a flow version in an hourly
+-- bucket: a flow-level counter (NODE_ID is empty) of started, completed and failed executions, or a
+-- node-level counter of entries, completions, prompts, failures and the latency histogram of a node.
+-- Counters are incremented by the flow analytics subscriber and removed after EXPIRY_TIME. Part of the
+-- database.runtime_persistent classification: the counters must survive a runtime database flush.
+CREATE TABLE "FLOW_ANALYTICS" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    FLOW_VERSION INTEGER NOT NULL,
+    BUCKET_START TIMESTAMP NOT NULL,
+    NODE_ID VARCHAR(255) NOT NULL,
+    METRIC VARCHAR(32) NOT NULL,
+    NODE_TYPE VARCHAR(50) NOT NULL,
+    METRIC_VALUE BIGINT NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID, FLOW_ID, BUCKET_START, FLOW_VERSION, NODE_ID, METRIC)
+);
+
+-- Index for expiry time on FLOW_ANALYTICS (supports cleanup of expired counters).
+CREATE INDEX idx_flow_analytics_expiry_time ON "FLOW_ANALYTICS" (EXPIRY_TIME);
+
+-- Table to track the schema version of this database. This script creates the baseline version; later
+-- versions are applied with the migrate subcommand from the scripts under migrations/.
+CREATE TABLE "SCHEMA_VERSION" (
+    VERSION INTEGER PRIMARY KEY,
+    DESCRIPTION VARCHAR(255) NOT NULL,
+    CHECKSUM VARCHAR(64) NOT NULL,
+    APPLIED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (1, 'baseline', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (2, 'add_pairwise_subject', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (3, 'add_audit_event', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (4, 'add_webhook_delivery', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (5, 'add_flow_analytics', '');
@@ -0,0 +1 @@
+-- RUNTIME_STORE is not partitioned on this database type; there is nothing to revert.
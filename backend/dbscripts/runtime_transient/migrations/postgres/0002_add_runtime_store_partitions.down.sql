@@ -0,0 +1,6 @@
+DROP TABLE "RUNTIME_STORE_LOCKOUT_COUNTER";
+DROP TABLE "RUNTIME_STORE_TOTP_ENROLLMENT";
+DROP TABLE "RUNTIME_STORE_DEVICE_USER_CODE";
+DROP TABLE "RUNTIME_STORE_DEVICE_CODE";
+DROP TABLE "RUNTIME_STORE_LOGOUT_FRONTCHANNEL";
+DROP TABLE "RUNTIME_STORE_AUTHZ_RESP";
@@ -0,0 +1,8 @@
+-- Partitions of RUNTIME_STORE for the namespaces of authorization responses, front-channel logout, device
+-- authorization, TOTP enrollment and account lockout.
+CREATE TABLE "RUNTIME_STORE_AUTHZ_RESP" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('authz:resp');
+CREATE TABLE "RUNTIME_STORE_LOGOUT_FRONTCHANNEL" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('logout:frontchannel');
+CREATE TABLE "RUNTIME_STORE_DEVICE_CODE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('device:code');
+CREATE TABLE "RUNTIME_STORE_DEVICE_USER_CODE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('device:user_code');
+CREATE TABLE "RUNTIME_STORE_TOTP_ENROLLMENT" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('totp:enrollment');
+CREATE TABLE "RUNTIME_STORE_LOCKOUT_COUNTER" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('lockout:counter');
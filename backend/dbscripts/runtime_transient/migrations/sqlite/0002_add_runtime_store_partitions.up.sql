@@ -0,0 +1,2 @@
+-- RUNTIME_STORE is not partitioned on this database type, so the new namespaces need no schema change.
+-- The version is kept so that every database type is at the same schema version.
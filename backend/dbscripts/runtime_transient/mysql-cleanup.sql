@@ -0,0 +1,61 @@
+-- Copyright 2026 The ThunderID Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- ============================================================
+-- Stored procedure: purge expired runtime_transient rows in bounded batches.
+--
+-- Deletes expired rows in batches of p_batch_size (1000 when NULL or not positive),
+-- committing after each batch to keep locks short on large tables. Must run as a
+-- top-level CALL (the per-batch COMMIT ends any outer transaction).
+--
+-- Run once manually (ad-hoc / on-demand):
+--   mysql -h <host> -P <port> -u <user> -p<pass> <runtime_transient> \
+--     -e "CALL cleanup_expired_runtime_transient_data(NULL);"
+--
+--   -- Optional: override the batch size (rows deleted per batch):
+--   -e "CALL cleanup_expired_runtime_transient_data(500);"
+--
+-- Scheduled execution options:
+--
+--   1. Event scheduler (RECOMMENDED, requires event_scheduler=ON):
+--      CREATE EVENT cleanup_runtime_transient_expired
+--        ON SCHEDULE EVERY 60 MINUTE
+--        DO CALL cleanup_expired_runtime_transient_data(NULL);
+--      -- To verify: SHOW EVENTS WHERE Name = 'cleanup_runtime_transient_expired';
+--      -- To remove: DROP EVENT cleanup_runtime_transient_expired;
+--
+--   2. Kubernetes CronJob: call CALL cleanup_expired_runtime_transient_data(NULL)
+--      via a mysql client container on the desired schedule.
+--
+--   3. OS cron (every 60 minutes):
+-- --      */60 * * * * mysql mysql -h <host> -P <port> -u <user> -p<pass> <runtime_transient> \
+-- --        -e "CALL cleanup_expired_runtime_transient_data(NULL);" \
+-- --        >> /var/log/thunderid-cleanup.log 2>&1
+-- ============================================================
+
+SET SESSION sql_mode = 'ANSI_QUOTES,PIPES_AS_CONCAT,STRICT_TRANS_TABLES,NO_ZERO_DATE,NO_ENGINE_SUBSTITUTION';
+
+DROP PROCEDURE IF EXISTS cleanup_expired_runtime_transient_data;
+
+DELIMITER //
+
+CREATE PROCEDURE cleanup_expired_runtime_transient_data(IN p_batch_size INT)
+BEGIN
+    DECLARE v_now     DATETIME(6) DEFAULT UTC_TIMESTAMP(6);
+    DECLARE v_deleted INT DEFAULT 1;
+
+    -- Guard against a batch size that would disable batching or make no progress.
+    IF p_batch_size IS NULL OR p_batch_size <= 0 THEN
+        SET p_batch_size = 1000;
+    END IF;
+
+    -- ORDER BY EXPIRY_TIME lets the batch be located via an index scan.
+    WHILE v_deleted > 0 DO
+        DELETE FROM "RUNTIME_STORE"
+        WHERE EXPIRY_TIME < v_now ORDER BY EXPIRY_TIME LIMIT p_batch_size;
+        SET v_deleted = ROW_COUNT();
+        COMMIT;
+    END WHILE;
+END //
+
+DELIMITER ;
@@ -0,0 +1,32 @@
+-- Identifiers are double quoted as in the other scripts. Run this script with the same SQL mode the server
+-- uses for its connections.
+SET SESSION sql_mode = 'ANSI_QUOTES,PIPES_AS_CONCAT,STRICT_TRANS_TABLES,NO_ZERO_DATE,NO_ENGINE_SUBSTITUTION';
+
+-- Table to store generic runtime key-value entries, isolated by NAMESPACE. Namespaces and keys are
+-- server-generated ASCII values; storing them as ASCII keeps the primary key within the InnoDB index
+-- size limit.
+CREATE TABLE "RUNTIME_STORE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    NAMESPACE     VARCHAR(64)  CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+    "KEY"         VARCHAR(512) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+    VALUE         JSON         NOT NULL,
+    EXPIRY_TIME   DATETIME(6),
+    CREATED_AT    DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    UPDATED_AT    DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (DEPLOYMENT_ID, NAMESPACE, "KEY")
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Index for expiry time on RUNTIME_STORE (supports cleanup and expiry checks)
+CREATE INDEX idx_runtime_store_expiry_time ON "RUNTIME_STORE" (EXPIRY_TIME);
+
+-- Table to track the schema version of this database. This script creates the baseline version; later
+-- versions are applied with the migrate subcommand from the scripts under migrations/.
+CREATE TABLE "SCHEMA_VERSION" (
+    VERSION INTEGER PRIMARY KEY,
+    DESCRIPTION VARCHAR(255) NOT NULL,
+    CHECKSUM VARCHAR(64) NOT NULL,
+    APPLIED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (1, 'baseline', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (2, 'add_runtime_store_partitions', '');
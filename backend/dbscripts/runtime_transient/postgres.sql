@@ -11,19 +11,38 @@ CREATE TABLE "RUNTIME_STORE" (
 ) PARTITION BY LIST (NAMESPACE);
 
 -- One child partition per providers.RuntimeStoreNamespace constant.
--- Adding a new namespace constant REQUIRES adding a matching partition here.
+-- Adding a new namespace constant REQUIRES adding a matching partition here and in a migration under
+-- migrations/postgres.
 CREATE TABLE "RUNTIME_STORE_ATTRIBUTE_CACHE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('attribute:cache');
 CREATE TABLE "RUNTIME_STORE_FLOW_STATE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('flow:state');
 CREATE TABLE "RUNTIME_STORE_AUTHZ_CODE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('authz:code');
 CREATE TABLE "RUNTIME_STORE_AUTHZ_REQ"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('authz:req');
+CREATE TABLE "RUNTIME_STORE_AUTHZ_RESP" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('authz:resp');
 CREATE TABLE "RUNTIME_STORE_LOGOUT_REQ" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('logout:req');
+CREATE TABLE "RUNTIME_STORE_LOGOUT_FRONTCHANNEL" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('logout:frontchannel');
 CREATE TABLE "RUNTIME_STORE_PAR_REQ"    PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('par:req');
 CREATE TABLE "RUNTIME_STORE_CIBA_REQ"   PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('ciba:req');
+CREATE TABLE "RUNTIME_STORE_DEVICE_CODE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('device:code');
+CREATE TABLE "RUNTIME_STORE_DEVICE_USER_CODE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('device:user_code');
 CREATE TABLE "RUNTIME_STORE_JTI_TOKEN"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('jti:token');
 CREATE TABLE "RUNTIME_STORE_VCI_NONCE"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('vci:nonce');
 CREATE TABLE "RUNTIME_STORE_VCI_OFFER"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('vci:offer');
 CREATE TABLE "RUNTIME_STORE_VP_STATE"   PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('vp:state');
 CREATE TABLE "RUNTIME_STORE_WEBAUTHN_SESSION" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('webauthn:session');
+CREATE TABLE "RUNTIME_STORE_TOTP_ENROLLMENT" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('totp:enrollment');
+CREATE TABLE "RUNTIME_STORE_LOCKOUT_COUNTER" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('lockout:counter');
 
 -- Index for expiry time on RUNTIME_STORE (propagates to all partitions; supports cleanup and expiry checks)
 CREATE INDEX idx_runtime_store_expiry_time ON "RUNTIME_STORE" (EXPIRY_TIME);
+
+-- Table to track the schema version of this database. This script creates the baseline version; later
+-- versions are applied with the migrate subcommand from the scripts under migrations/.
+CREATE TABLE "SCHEMA_VERSION" (
+    VERSION INTEGER PRIMARY KEY,
+    DESCRIPTION VARCHAR(255) NOT NULL,
+    CHECKSUM VARCHAR(64) NOT NULL,
+    APPLIED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (1, 'baseline', '');
+INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES (2, 'add_runtime_store_partitions', '');
@@ -0,0 +1,104 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package accountsecurity
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for credential and session management operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request format is invalid.
+	ErrorInvalidRequestFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UAS-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorUserNotFound is the error returned when the user does not exist.
+	ErrorUserNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UAS-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.user_not_found",
+			DefaultValue: "User not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.user_not_found_description",
+			DefaultValue: "The user with the specified id does not exist",
+		},
+	}
+	// ErrorCredentialNotFound is the error returned when the user has no credential with the given id.
+	ErrorCredentialNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UAS-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.credential_not_found",
+			DefaultValue: "Credential not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.credential_not_found_description",
+			DefaultValue: "The user has no enrolled credential with the specified id",
+		},
+	}
+	// ErrorInvalidCredentialName is the error returned when a credential name is empty or too long.
+	ErrorInvalidCredentialName = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UAS-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.invalid_credential_name",
+			DefaultValue: "Invalid credential name",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.invalid_credential_name_description",
+			DefaultValue: "The credential name must be non-empty and at most 64 characters long",
+		},
+	}
+	// ErrorCredentialNotRenamable is the error returned when renaming a credential that has no name.
+	ErrorCredentialNotRenamable = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UAS-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.credential_not_renamable",
+			DefaultValue: "Credential cannot be renamed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.credential_not_renamable_description",
+			DefaultValue: "Only passkeys can be given a name",
+		},
+	}
+	// ErrorSessionNotFound is the error returned when the user has no live session with the given id.
+	ErrorSessionNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UAS-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.session_not_found",
+			DefaultValue: "Session not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.session_not_found_description",
+			DefaultValue: "The user has no session with the specified id",
+		},
+	}
+	// ErrorAuthenticationRequired is the error returned when a self-service request carries no
+	// authenticated user.
+	ErrorAuthenticationRequired = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "UAS-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.authentication_required",
+			DefaultValue: "Authentication required",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.accountsecurityservice.authentication_required_description",
+			DefaultValue: "The request must be made by an authenticated user",
+		},
+	}
+)
@@ -0,0 +1,248 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package accountsecurity
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const handlerLoggerComponentName = "AccountSecurityHandler"
+
+// accountSecurityHandler handles the self-service and administrative credential and session
+// management API requests.
+type accountSecurityHandler struct {
+	service AccountSecurityServiceInterface
+}
+
+// newAccountSecurityHandler creates a new instance of accountSecurityHandler.
+func newAccountSecurityHandler(service AccountSecurityServiceInterface) *accountSecurityHandler {
+	return &accountSecurityHandler{service: service}
+}
+
+// HandleSelfCredentialListRequest lists the credentials enrolled by the authenticated user.
+func (h *accountSecurityHandler) HandleSelfCredentialListRequest(w http.ResponseWriter, r *http.Request) {
+	if userID, ok := h.selfUserID(w, r); ok {
+		h.writeCredentialList(w, r, userID)
+	}
+}
+
+// HandleSelfCredentialUpdateRequest renames a passkey of the authenticated user.
+func (h *accountSecurityHandler) HandleSelfCredentialUpdateRequest(w http.ResponseWriter, r *http.Request) {
+	if userID, ok := h.selfUserID(w, r); ok {
+		h.renameCredential(w, r, userID, r.PathValue("id"))
+	}
+}
+
+// HandleSelfCredentialDeleteRequest removes a credential of the authenticated user.
+func (h *accountSecurityHandler) HandleSelfCredentialDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if userID, ok := h.selfUserID(w, r); ok {
+		h.deleteCredential(w, r, userID, r.PathValue("id"))
+	}
+}
+
+// HandleSelfSessionListRequest lists the live SSO sessions of the authenticated user.
+func (h *accountSecurityHandler) HandleSelfSessionListRequest(w http.ResponseWriter, r *http.Request) {
+	if userID, ok := h.selfUserID(w, r); ok {
+		h.writeSessionList(w, r, userID)
+	}
+}
+
+// HandleSelfSessionDeleteRequest signs the authenticated user out of one SSO session.
+func (h *accountSecurityHandler) HandleSelfSessionDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if userID, ok := h.selfUserID(w, r); ok {
+		h.terminateSession(w, r, userID, r.PathValue("id"))
+	}
+}
+
+// HandleCredentialListRequest lists the credentials enrolled by the user identified by the path.
+func (h *accountSecurityHandler) HandleCredentialListRequest(w http.ResponseWriter, r *http.Request) {
+	if userID, ok := h.adminUserID(w, r, security.ActionReadUser); ok {
+		h.writeCredentialList(w, r, userID)
+	}
+}
+
+// HandleCredentialUpdateRequest renames a passkey of the user identified by the path.
+func (h *accountSecurityHandler) HandleCredentialUpdateRequest(w http.ResponseWriter, r *http.Request) {
+	if userID, ok := h.adminUserID(w, r, security.ActionUpdateUser); ok {
+		h.renameCredential(w, r, userID, r.PathValue("itemId"))
+	}
+}
+
+// HandleCredentialDeleteRequest removes a credential of the user identified by the path.
+func (h *accountSecurityHandler) HandleCredentialDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if userID, ok := h.adminUserID(w, r, security.ActionUpdateUser); ok {
+		h.deleteCredential(w, r, userID, r.PathValue("itemId"))
+	}
+}
+
+// HandleSessionListRequest lists the live SSO sessions of the user identified by the path.
+func (h *accountSecurityHandler) HandleSessionListRequest(w http.ResponseWriter, r *http.Request) {
+	if userID, ok := h.adminUserID(w, r, security.ActionReadUser); ok {
+		h.writeSessionList(w, r, userID)
+	}
+}
+
+// HandleSessionDeleteRequest signs the user identified by the path out of one SSO session.
+func (h *accountSecurityHandler) HandleSessionDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if userID, ok := h.adminUserID(w, r, security.ActionUpdateUser); ok {
+		h.terminateSession(w, r, userID, r.PathValue("itemId"))
+	}
+}
+
+// selfUserID returns the authenticated user, writing an error response when there is none.
+func (h *accountSecurityHandler) selfUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		handleError(r.Context(), w, &ErrorAuthenticationRequired)
+		return "", false
+	}
+	return userID, true
+}
+
+// adminUserID returns the user identified by the path once the caller is verified to be allowed to
+// perform the action on them, writing an error response otherwise.
+func (h *accountSecurityHandler) adminUserID(
+	w http.ResponseWriter, r *http.Request, action security.Action,
+) (string, bool) {
+	userID := r.PathValue("id")
+	if strings.TrimSpace(userID) == "" {
+		handleError(r.Context(), w, &ErrorUserNotFound)
+		return "", false
+	}
+	if svcErr := h.service.CheckUserAccess(r.Context(), userID, action); svcErr != nil {
+		handleError(r.Context(), w, svcErr)
+		return "", false
+	}
+	return userID, true
+}
+
+// writeCredentialList lists the user's credentials and writes them as the response.
+func (h *accountSecurityHandler) writeCredentialList(w http.ResponseWriter, r *http.Request, userID string) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	credentials, svcErr := h.service.ListCredentials(ctx, userID)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, CredentialListResponse{
+		TotalResults: len(credentials),
+		Credentials:  credentials,
+	})
+
+	logger.Debug(ctx, "Credential list response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// renameCredential renames one of the user's passkeys from the request body.
+func (h *accountSecurityHandler) renameCredential(
+	w http.ResponseWriter, r *http.Request, userID, credentialID string,
+) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	updateRequest, err := sysutils.DecodeJSONBody[CredentialUpdateRequest](r)
+	if err != nil {
+		handleError(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	if svcErr := h.service.RenameCredential(ctx, userID, credentialID, updateRequest.Name); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+
+	logger.Debug(ctx, "Credential rename response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// deleteCredential removes one of the user's credentials.
+func (h *accountSecurityHandler) deleteCredential(
+	w http.ResponseWriter, r *http.Request, userID, credentialID string,
+) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	if svcErr := h.service.DeleteCredential(ctx, userID, credentialID); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+
+	logger.Debug(ctx, "Credential delete response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// writeSessionList lists the user's live sessions and writes them as the response.
+func (h *accountSecurityHandler) writeSessionList(w http.ResponseWriter, r *http.Request, userID string) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	sessions, svcErr := h.service.ListSessions(ctx, userID)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, SessionListResponse{
+		TotalResults: len(sessions),
+		Sessions:     sessions,
+	})
+
+	logger.Debug(ctx, "Session list response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// terminateSession signs the user out of one of their sessions.
+func (h *accountSecurityHandler) terminateSession(
+	w http.ResponseWriter, r *http.Request, userID, sessionID string,
+) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	if svcErr := h.service.TerminateSession(ctx, userID, sessionID); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+
+	logger.Debug(ctx, "Session terminate response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// handleError writes the HTTP error response for the given service error.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	var statusCode int
+	if svcErr.Type == tidcommon.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorUserNotFound.Code, ErrorCredentialNotFound.Code, ErrorSessionNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorAuthenticationRequired.Code:
+			statusCode = http.StatusUnauthorized
+		case tidcommon.ErrorUnauthorized.Code:
+			statusCode = http.StatusForbidden
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	} else {
+		statusCode = http.StatusInternalServerError
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+}
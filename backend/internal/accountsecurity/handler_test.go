@@ -0,0 +1,156 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package accountsecurity
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+func withTestSubject(r *http.Request, userID string) *http.Request {
+	authCtx := security.NewSecurityContextForTest(userID, "", "", nil, nil)
+	return r.WithContext(security.WithSecurityContextTest(r.Context(), authCtx))
+}
+
+func TestHandleSelfCredentialListRequest_Success(t *testing.T) {
+	mockSvc := NewAccountSecurityServiceInterfaceMock(t)
+	mockSvc.On("ListCredentials", mock.Anything, testUserID).Return([]Credential{
+		{ID: "cred-1", Type: CredentialTypePasskey, Name: "Laptop"},
+	}, nil)
+
+	handler := newAccountSecurityHandler(mockSvc)
+	req := withTestSubject(httptest.NewRequest(http.MethodGet, "/users/me/credentials", nil), testUserID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfCredentialListRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp CredentialListResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 1, resp.TotalResults)
+	require.Equal(t, "Laptop", resp.Credentials[0].Name)
+}
+
+func TestHandleSelfCredentialListRequest_Unauthenticated(t *testing.T) {
+	mockSvc := NewAccountSecurityServiceInterfaceMock(t)
+	handler := newAccountSecurityHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/me/credentials", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfCredentialListRequest(rr, req)
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	require.Equal(t, ErrorAuthenticationRequired.Code, errResp.Code)
+}
+
+func TestHandleSelfCredentialUpdateRequest(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		svcErr         *tidcommon.ServiceError
+		expectedStatus int
+	}{
+		{"Success", `{"name":"Phone"}`, nil, http.StatusNoContent},
+		{"InvalidName", `{"name":""}`, &ErrorInvalidCredentialName, http.StatusBadRequest},
+		{"NotFound", `{"name":"Phone"}`, &ErrorCredentialNotFound, http.StatusNotFound},
+		{"MalformedBody", `{`, nil, http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSvc := NewAccountSecurityServiceInterfaceMock(t)
+			if tc.body != `{` {
+				var body CredentialUpdateRequest
+				require.NoError(t, json.Unmarshal([]byte(tc.body), &body))
+				mockSvc.On("RenameCredential", mock.Anything, testUserID, "cred-1", body.Name).Return(tc.svcErr)
+			}
+
+			handler := newAccountSecurityHandler(mockSvc)
+			req := httptest.NewRequest(http.MethodPut, "/users/me/credentials/cred-1", strings.NewReader(tc.body))
+			req.SetPathValue("id", "cred-1")
+			req = withTestSubject(req, testUserID)
+			rr := httptest.NewRecorder()
+
+			handler.HandleSelfCredentialUpdateRequest(rr, req)
+
+			require.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleSelfSessionDeleteRequest(t *testing.T) {
+	testCases := []struct {
+		name           string
+		svcErr         *tidcommon.ServiceError
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"NotFound", &ErrorSessionNotFound, http.StatusNotFound},
+		{"ServerError", &tidcommon.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSvc := NewAccountSecurityServiceInterfaceMock(t)
+			mockSvc.On("TerminateSession", mock.Anything, testUserID, "ref-1").Return(tc.svcErr)
+
+			handler := newAccountSecurityHandler(mockSvc)
+			req := httptest.NewRequest(http.MethodDelete, "/users/me/sessions/ref-1", nil)
+			req.SetPathValue("id", "ref-1")
+			req = withTestSubject(req, testUserID)
+			rr := httptest.NewRecorder()
+
+			handler.HandleSelfSessionDeleteRequest(rr, req)
+
+			require.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleSessionListRequest_ChecksReadAccess(t *testing.T) {
+	mockSvc := NewAccountSecurityServiceInterfaceMock(t)
+	mockSvc.On("CheckUserAccess", mock.Anything, "user-456", security.ActionReadUser).Return(nil)
+	mockSvc.On("ListSessions", mock.Anything, "user-456").Return([]Session{{ID: "ref-1"}}, nil)
+
+	handler := newAccountSecurityHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/user-456/sessions", nil)
+	req.SetPathValue("id", "user-456")
+	rr := httptest.NewRecorder()
+
+	handler.HandleSessionListRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp SessionListResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, "ref-1", resp.Sessions[0].ID)
+}
+
+func TestHandleCredentialDeleteRequest_Forbidden(t *testing.T) {
+	mockSvc := NewAccountSecurityServiceInterfaceMock(t)
+	mockSvc.On("CheckUserAccess", mock.Anything, "user-456", security.ActionUpdateUser).
+		Return(&tidcommon.ErrorUnauthorized)
+
+	handler := newAccountSecurityHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodDelete, "/users/user-456/credentials/cred-1", nil)
+	req.SetPathValue("id", "user-456")
+	req.SetPathValue("itemId", "cred-1")
+	rr := httptest.NewRecorder()
+
+	handler.HandleCredentialDeleteRequest(rr, req)
+
+	require.Equal(t, http.StatusForbidden, rr.Code)
+	mockSvc.AssertNotCalled(t, "DeleteCredential", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package accountsecurity provides self-service and administrative management of the credentials a
+// user has enrolled (passkeys and the authenticator app) and of the user's SSO sessions.
+package accountsecurity
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
+	"github.com/thunder-id/thunderid/internal/entity"
+	flowsession "github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// Initialize constructs the account security service, registers the self-service routes under
+// /users/me and the administrative routes as sub-resources of /users/{id}.
+func Initialize(
+	mux *http.ServeMux,
+	userSubResources *user.SubResourceRouter,
+	entityService entity.EntityServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	passkeyService passkey.PasskeyServiceInterface,
+	totpService totp.TOTPServiceInterface,
+	sessionService flowsession.Service,
+) AccountSecurityServiceInterface {
+	service := newAccountSecurityService(entityService, authzService, passkeyService, totpService, sessionService)
+
+	handler := newAccountSecurityHandler(service)
+	registerRoutes(mux, userSubResources, handler)
+	return service
+}
+
+// registerRoutes registers the credential and session management routes.
+func registerRoutes(mux *http.ServeMux, userSubResources *user.SubResourceRouter,
+	handler *accountSecurityHandler) {
+	optsSelfList := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/credentials",
+		handler.HandleSelfCredentialListRequest, optsSelfList))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/credentials",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfList))
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/sessions",
+		handler.HandleSelfSessionListRequest, optsSelfList))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/sessions",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfList))
+
+	optsSelfItem := middleware.CORSOptions{
+		AllowedMethods:   []string{"PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("PUT /users/me/credentials/{id}",
+		handler.HandleSelfCredentialUpdateRequest, optsSelfItem))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/credentials/{id}",
+		handler.HandleSelfCredentialDeleteRequest, optsSelfItem))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/credentials/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfItem))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/sessions/{id}",
+		handler.HandleSelfSessionDeleteRequest, optsSelfItem))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/sessions/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfItem))
+
+	// The /users/ catch-all handlers own CORS for the administrative sub-resource routes.
+	userSubResources.Handle("GET credentials", handler.HandleCredentialListRequest)
+	userSubResources.Handle("PUT credentials/{itemId}", handler.HandleCredentialUpdateRequest)
+	userSubResources.Handle("DELETE credentials/{itemId}", handler.HandleCredentialDeleteRequest)
+	userSubResources.Handle("GET sessions", handler.HandleSessionListRequest)
+	userSubResources.Handle("DELETE sessions/{itemId}", handler.HandleSessionDeleteRequest)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package accountsecurity
+
+// CredentialType identifies the kind of an enrolled credential.
+type CredentialType string
+
+const (
+	// CredentialTypePasskey is a WebAuthn passkey. A user may hold several.
+	CredentialTypePasskey CredentialType = "passkey"
+	// CredentialTypeTOTP is an authenticator app. A user holds at most one, identified by totpCredentialID.
+	CredentialTypeTOTP CredentialType = "totp"
+)
+
+// totpCredentialID is the fixed id of the user's authenticator app enrollment.
+const totpCredentialID = "totp"
+
+// Credential is an enrolled second-factor or passwordless credential of a user. It carries no
+// secret material.
+type Credential struct {
+	ID         string         `json:"id"`
+	Type       CredentialType `json:"type"`
+	Name       string         `json:"name,omitempty"`
+	Transports []string       `json:"transports,omitempty"`
+	CreatedAt  int64          `json:"createdAt,omitempty"`
+	LastUsedAt int64          `json:"lastUsedAt,omitempty"`
+	// RemainingRecoveryCodes is set for an authenticator app only.
+	RemainingRecoveryCodes *int `json:"remainingRecoveryCodes,omitempty"`
+}
+
+// CredentialListResponse is the response body of a credential list request.
+type CredentialListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	Credentials  []Credential `json:"credentials"`
+}
+
+// CredentialUpdateRequest is the request body of a credential rename request.
+type CredentialUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+// SessionApplication is an application that participates in a session.
+type SessionApplication struct {
+	ApplicationID string `json:"applicationId"`
+	FirstJoinedAt int64  `json:"firstJoinedAt"`
+	LastActiveAt  int64  `json:"lastActiveAt"`
+}
+
+// Session is a live SSO session of a user. Its id is a public reference, distinct from the session
+// cookie value and the internal session id.
+type Session struct {
+	ID              string               `json:"id"`
+	AuthenticatedAt int64                `json:"authenticatedAt"`
+	CreatedAt       int64                `json:"createdAt"`
+	LastActiveAt    int64                `json:"lastActiveAt"`
+	ExpiresAt       int64                `json:"expiresAt,omitempty"`
+	Applications    []SessionApplication `json:"applications"`
+}
+
+// SessionListResponse is the response body of a session list request.
+type SessionListResponse struct {
+	TotalResults int       `json:"totalResults"`
+	Sessions     []Session `json:"sessions"`
+}
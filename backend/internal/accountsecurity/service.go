@@ -0,0 +1,263 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package accountsecurity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
+	"github.com/thunder-id/thunderid/internal/entity"
+	flowsession "github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+const loggerComponentName = "AccountSecurityService"
+
+// AccountSecurityServiceInterface defines the operations for managing the credentials a user has
+// enrolled and the SSO sessions a user holds.
+type AccountSecurityServiceInterface interface {
+	// ListCredentials returns the passkeys and the authenticator app enrolled by the user.
+	ListCredentials(ctx context.Context, userID string) ([]Credential, *tidcommon.ServiceError)
+	// RenameCredential sets the display name of one of the user's passkeys.
+	RenameCredential(ctx context.Context, userID, credentialID, name string) *tidcommon.ServiceError
+	// DeleteCredential removes an enrolled credential, such as the passkey of a lost device.
+	DeleteCredential(ctx context.Context, userID, credentialID string) *tidcommon.ServiceError
+	// ListSessions returns the user's live SSO sessions with the applications participating in them.
+	ListSessions(ctx context.Context, userID string) ([]Session, *tidcommon.ServiceError)
+	// TerminateSession signs the user out of one SSO session, revoking the tokens issued in it.
+	TerminateSession(ctx context.Context, userID, sessionID string) *tidcommon.ServiceError
+	// CheckUserAccess verifies that the user exists and that the caller may perform the action on
+	// them. It guards the administrative endpoints, which act on a user other than the caller.
+	CheckUserAccess(ctx context.Context, userID string, action security.Action) *tidcommon.ServiceError
+}
+
+// accountSecurityService is the default implementation of AccountSecurityServiceInterface.
+type accountSecurityService struct {
+	entityService  entity.EntityServiceInterface
+	authzService   sysauthz.SystemAuthorizationServiceInterface
+	passkeyService passkey.PasskeyServiceInterface
+	totpService    totp.TOTPServiceInterface
+	sessionService flowsession.Service
+	logger         *log.Logger
+}
+
+// newAccountSecurityService creates a new instance of accountSecurityService.
+func newAccountSecurityService(
+	entityService entity.EntityServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	passkeyService passkey.PasskeyServiceInterface,
+	totpService totp.TOTPServiceInterface,
+	sessionService flowsession.Service,
+) AccountSecurityServiceInterface {
+	return &accountSecurityService{
+		entityService:  entityService,
+		authzService:   authzService,
+		passkeyService: passkeyService,
+		totpService:    totpService,
+		sessionService: sessionService,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ListCredentials returns the passkeys and the authenticator app enrolled by the user.
+func (s *accountSecurityService) ListCredentials(
+	ctx context.Context, userID string,
+) ([]Credential, *tidcommon.ServiceError) {
+	passkeys, svcErr := s.passkeyService.ListCredentials(ctx, userID)
+	if svcErr != nil {
+		return nil, s.mapPasskeyError(ctx, svcErr)
+	}
+
+	credentials := make([]Credential, 0, len(passkeys)+1)
+	for _, pk := range passkeys {
+		credentials = append(credentials, Credential{
+			ID:         pk.ID,
+			Type:       CredentialTypePasskey,
+			Name:       pk.Name,
+			Transports: pk.Transports,
+			CreatedAt:  pk.CreatedAt,
+			LastUsedAt: pk.LastUsedAt,
+		})
+	}
+
+	enrollment, svcErr := s.totpService.GetEnrollment(ctx, userID)
+	if svcErr != nil {
+		if svcErr.Code == totp.ErrorNotEnrolled.Code {
+			return credentials, nil
+		}
+		return nil, s.mapTOTPError(ctx, svcErr)
+	}
+	remaining := enrollment.RemainingRecoveryCodes
+	credentials = append(credentials, Credential{
+		ID:                     totpCredentialID,
+		Type:                   CredentialTypeTOTP,
+		CreatedAt:              enrollment.EnrolledAt,
+		RemainingRecoveryCodes: &remaining,
+	})
+	return credentials, nil
+}
+
+// RenameCredential sets the display name of one of the user's passkeys. The authenticator app has
+// no name.
+func (s *accountSecurityService) RenameCredential(
+	ctx context.Context, userID, credentialID, name string,
+) *tidcommon.ServiceError {
+	if credentialID == totpCredentialID {
+		return &ErrorCredentialNotRenamable
+	}
+	if svcErr := s.passkeyService.RenameCredential(ctx, userID, credentialID, name); svcErr != nil {
+		return s.mapPasskeyError(ctx, svcErr)
+	}
+
+	s.logger.Debug(ctx, "Renamed passkey", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// DeleteCredential removes an enrolled credential. Removing the authenticator app also discards the
+// remaining recovery codes.
+func (s *accountSecurityService) DeleteCredential(
+	ctx context.Context, userID, credentialID string,
+) *tidcommon.ServiceError {
+	if credentialID == totpCredentialID {
+		if svcErr := s.totpService.RemoveEnrollment(ctx, userID); svcErr != nil {
+			if svcErr.Code == totp.ErrorNotEnrolled.Code {
+				return &ErrorCredentialNotFound
+			}
+			return s.mapTOTPError(ctx, svcErr)
+		}
+	} else if svcErr := s.passkeyService.DeleteCredential(ctx, userID, credentialID); svcErr != nil {
+		return s.mapPasskeyError(ctx, svcErr)
+	}
+
+	s.logger.Debug(ctx, "Deleted credential", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// ListSessions returns the user's live SSO sessions with the applications participating in them.
+func (s *accountSecurityService) ListSessions(ctx context.Context, userID string) (
+	[]Session, *tidcommon.ServiceError) {
+	subjectSessions, err := s.sessionService.ListBySubject(ctx, userID, time.Now())
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list user sessions", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	sessions := make([]Session, 0, len(subjectSessions))
+	for _, subjectSession := range subjectSessions {
+		sessions = append(sessions, toSession(subjectSession))
+	}
+	return sessions, nil
+}
+
+// TerminateSession signs the user out of one SSO session. The tokens issued to the applications that
+// participated in the session are revoked and the applications are notified of the logout.
+func (s *accountSecurityService) TerminateSession(
+	ctx context.Context, userID, sessionID string,
+) *tidcommon.ServiceError {
+	terminated, err := s.sessionService.TerminateByReference(ctx, userID, sessionID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to terminate user session", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if terminated == nil {
+		return &ErrorSessionNotFound
+	}
+
+	s.logger.Debug(ctx, "Terminated user session", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// CheckUserAccess verifies that the user exists and that the caller may perform the action on them.
+func (s *accountSecurityService) CheckUserAccess(
+	ctx context.Context, userID string, action security.Action,
+) *tidcommon.ServiceError {
+	userEntity, err := s.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return &ErrorUserNotFound
+		}
+		s.logger.Error(ctx, "Failed to retrieve user", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if userEntity.Category != providers.EntityCategoryUser {
+		return &ErrorUserNotFound
+	}
+
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, action,
+		&sysauthz.ActionContext{ResourceType: security.ResourceTypeUser, OUID: userEntity.OUID, ResourceID: userID})
+	if svcErr != nil {
+		s.logger.Error(ctx, "Failed to check authorization for action",
+			log.String("action", string(action)), log.Any("error", svcErr))
+		return &tidcommon.InternalServerError
+	}
+	if !allowed {
+		return &tidcommon.ErrorUnauthorized
+	}
+	return nil
+}
+
+// mapPasskeyError translates a passkey service error into the error reported by this API.
+func (s *accountSecurityService) mapPasskeyError(
+	ctx context.Context, svcErr *tidcommon.ServiceError,
+) *tidcommon.ServiceError {
+	switch svcErr.Code {
+	case passkey.ErrorCredentialNotFound.Code, passkey.ErrorEmptyCredentialID.Code:
+		return &ErrorCredentialNotFound
+	case passkey.ErrorInvalidCredentialName.Code:
+		return &ErrorInvalidCredentialName
+	case passkey.ErrorUserNotFound.Code, passkey.ErrorEmptyUserIdentifier.Code:
+		return &ErrorUserNotFound
+	}
+	s.logger.Error(ctx, "Passkey credential operation failed", log.String("code", svcErr.Code))
+	return &tidcommon.InternalServerError
+}
+
+// mapTOTPError translates a TOTP service error into the error reported by this API.
+func (s *accountSecurityService) mapTOTPError(
+	ctx context.Context, svcErr *tidcommon.ServiceError,
+) *tidcommon.ServiceError {
+	switch svcErr.Code {
+	case totp.ErrorUserNotFound.Code, totp.ErrorInvalidRequest.Code:
+		return &ErrorUserNotFound
+	}
+	s.logger.Error(ctx, "Authenticator app operation failed", log.String("code", svcErr.Code))
+	return &tidcommon.InternalServerError
+}
+
+// toSession converts a subject session into its API representation. The session expires at the
+// earlier of its idle and absolute deadlines.
+func toSession(subjectSession flowsession.SubjectSession) Session {
+	sess := subjectSession.Session
+	session := Session{
+		ID:              subjectSession.Reference,
+		AuthenticatedAt: sess.AuthenticatedAt.Unix(),
+		CreatedAt:       sess.CreatedAt.Unix(),
+		LastActiveAt:    sess.LastActiveAt.Unix(),
+		Applications:    make([]SessionApplication, 0, len(subjectSession.Participants)),
+	}
+	for _, deadline := range []time.Time{sess.IdleExpiresAt, sess.AbsoluteExpiresAt} {
+		if !deadline.IsZero() && (session.ExpiresAt == 0 || deadline.Unix() < session.ExpiresAt) {
+			session.ExpiresAt = deadline.Unix()
+		}
+	}
+	for _, participant := range subjectSession.Participants {
+		session.Applications = append(session.Applications, SessionApplication{
+			ApplicationID: participant.AppID,
+			FirstJoinedAt: participant.FirstJoinedAt.Unix(),
+			LastActiveAt:  participant.LastActiveAt.Unix(),
+		})
+	}
+	return session
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package accountsecurity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
+	"github.com/thunder-id/thunderid/internal/entity"
+	flowsession "github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/passkeymock"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/totpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/sessionmock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+const testUserID = "user-123"
+
+type AccountSecurityServiceTestSuite struct {
+	suite.Suite
+	mockEntityService  *entitymock.EntityServiceInterfaceMock
+	mockAuthzService   *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockPasskeyService *passkeymock.PasskeyServiceInterfaceMock
+	mockTOTPService    *totpmock.TOTPServiceInterfaceMock
+	mockSessionService *sessionmock.ServiceMock
+	service            AccountSecurityServiceInterface
+}
+
+func TestAccountSecurityServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountSecurityServiceTestSuite))
+}
+
+func (suite *AccountSecurityServiceTestSuite) SetupTest() {
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockAuthzService = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockPasskeyService = passkeymock.NewPasskeyServiceInterfaceMock(suite.T())
+	suite.mockTOTPService = totpmock.NewTOTPServiceInterfaceMock(suite.T())
+	suite.mockSessionService = sessionmock.NewServiceMock(suite.T())
+	suite.service = newAccountSecurityService(suite.mockEntityService, suite.mockAuthzService,
+		suite.mockPasskeyService, suite.mockTOTPService, suite.mockSessionService)
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestListCredentials_PasskeysAndAuthenticatorApp() {
+	suite.mockPasskeyService.On("ListCredentials", mock.Anything, testUserID).Return([]passkey.PasskeyCredential{
+		{ID: "cred-1", Name: "Laptop", CreatedAt: 100, LastUsedAt: 200},
+	}, nil)
+	suite.mockTOTPService.On("GetEnrollment", mock.Anything, testUserID).
+		Return(&totp.TOTPEnrollment{EnrolledAt: 300, RemainingRecoveryCodes: 8}, nil)
+
+	credentials, svcErr := suite.service.ListCredentials(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Require().Len(credentials, 2)
+	suite.Equal(Credential{ID: "cred-1", Type: CredentialTypePasskey, Name: "Laptop", CreatedAt: 100,
+		LastUsedAt: 200}, credentials[0])
+	suite.Equal(totpCredentialID, credentials[1].ID)
+	suite.Equal(CredentialTypeTOTP, credentials[1].Type)
+	suite.Equal(int64(300), credentials[1].CreatedAt)
+	suite.Equal(8, *credentials[1].RemainingRecoveryCodes)
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestListCredentials_NoAuthenticatorApp() {
+	suite.mockPasskeyService.On("ListCredentials", mock.Anything, testUserID).
+		Return([]passkey.PasskeyCredential{}, nil)
+	suite.mockTOTPService.On("GetEnrollment", mock.Anything, testUserID).Return(nil, &totp.ErrorNotEnrolled)
+
+	credentials, svcErr := suite.service.ListCredentials(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Empty(credentials)
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestListCredentials_UserNotFound() {
+	suite.mockPasskeyService.On("ListCredentials", mock.Anything, testUserID).
+		Return(nil, &passkey.ErrorUserNotFound)
+
+	credentials, svcErr := suite.service.ListCredentials(context.Background(), testUserID)
+
+	suite.Nil(credentials)
+	suite.Equal(ErrorUserNotFound.Code, svcErr.Code)
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestRenameCredential() {
+	testCases := []struct {
+		name         string
+		credentialID string
+		passkeyErr   *tidcommon.ServiceError
+		expectedErr  *tidcommon.ServiceError
+	}{
+		{"Success", "cred-1", nil, nil},
+		{"InvalidName", "cred-1", &passkey.ErrorInvalidCredentialName, &ErrorInvalidCredentialName},
+		{"NotFound", "cred-1", &passkey.ErrorCredentialNotFound, &ErrorCredentialNotFound},
+		{"ServerError", "cred-1", &tidcommon.InternalServerError, &tidcommon.InternalServerError},
+		{"AuthenticatorApp", totpCredentialID, nil, &ErrorCredentialNotRenamable},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.credentialID != totpCredentialID {
+				suite.mockPasskeyService.On("RenameCredential", mock.Anything, testUserID, tc.credentialID, "Phone").
+					Return(tc.passkeyErr)
+			}
+
+			svcErr := suite.service.RenameCredential(context.Background(), testUserID, tc.credentialID, "Phone")
+
+			if tc.expectedErr == nil {
+				suite.Nil(svcErr)
+			} else {
+				suite.Equal(tc.expectedErr.Code, svcErr.Code)
+			}
+		})
+	}
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestDeleteCredential_Passkey() {
+	suite.mockPasskeyService.On("DeleteCredential", mock.Anything, testUserID, "cred-1").Return(nil)
+
+	suite.Nil(suite.service.DeleteCredential(context.Background(), testUserID, "cred-1"))
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestDeleteCredential_AuthenticatorApp() {
+	suite.mockTOTPService.On("RemoveEnrollment", mock.Anything, testUserID).Return(nil)
+
+	suite.Nil(suite.service.DeleteCredential(context.Background(), testUserID, totpCredentialID))
+	suite.mockPasskeyService.AssertNotCalled(suite.T(), "DeleteCredential", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestDeleteCredential_AuthenticatorAppNotEnrolled() {
+	suite.mockTOTPService.On("RemoveEnrollment", mock.Anything, testUserID).Return(&totp.ErrorNotEnrolled)
+
+	svcErr := suite.service.DeleteCredential(context.Background(), testUserID, totpCredentialID)
+
+	suite.Equal(ErrorCredentialNotFound.Code, svcErr.Code)
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestListSessions_ConvertsSessions() {
+	now := time.Now()
+	suite.mockSessionService.On("ListBySubject", mock.Anything, testUserID, mock.Anything).
+		Return([]flowsession.SubjectSession{{
+			Reference: "ref-1",
+			Session: flowsession.Session{
+				SessionID:         "internal-id",
+				HandleID:          "secret-handle",
+				AuthenticatedAt:   now.Add(-2 * time.Hour),
+				CreatedAt:         now.Add(-2 * time.Hour),
+				LastActiveAt:      now.Add(-time.Minute),
+				IdleExpiresAt:     now.Add(time.Hour),
+				AbsoluteExpiresAt: now.Add(6 * time.Hour),
+			},
+			Participants: []flowsession.Participant{{AppID: "app-1", FirstJoinedAt: now.Add(-2 * time.Hour)}},
+		}}, nil)
+
+	sessions, svcErr := suite.service.ListSessions(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Require().Len(sessions, 1)
+	suite.Equal("ref-1", sessions[0].ID)
+	suite.Equal(now.Add(time.Hour).Unix(), sessions[0].ExpiresAt, "the earlier deadline is reported")
+	suite.Require().Len(sessions[0].Applications, 1)
+	suite.Equal("app-1", sessions[0].Applications[0].ApplicationID)
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestListSessions_StoreError() {
+	suite.mockSessionService.On("ListBySubject", mock.Anything, testUserID, mock.Anything).
+		Return(nil, errors.New("db down"))
+
+	sessions, svcErr := suite.service.ListSessions(context.Background(), testUserID)
+
+	suite.Nil(sessions)
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestTerminateSession_Success() {
+	suite.mockSessionService.On("TerminateByReference", mock.Anything, testUserID, "ref-1").
+		Return(&flowsession.Session{SessionID: "internal-id"}, nil)
+
+	suite.Nil(suite.service.TerminateSession(context.Background(), testUserID, "ref-1"))
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestTerminateSession_NotFound() {
+	suite.mockSessionService.On("TerminateByReference", mock.Anything, testUserID, "ref-1").Return(nil, nil)
+
+	svcErr := suite.service.TerminateSession(context.Background(), testUserID, "ref-1")
+
+	suite.Equal(ErrorSessionNotFound.Code, svcErr.Code)
+}
+
+func (suite *AccountSecurityServiceTestSuite) TestCheckUserAccess() {
+	testCases := []struct {
+		name        string
+		entity      *providers.Entity
+		entityErr   error
+		allowed     bool
+		expectedErr *tidcommon.ServiceError
+	}{
+		{"Allowed", &providers.Entity{ID: testUserID, Category: providers.EntityCategoryUser, OUID: "ou-1"},
+			nil, true, nil},
+		{"Denied", &providers.Entity{ID: testUserID, Category: providers.EntityCategoryUser, OUID: "ou-1"},
+			nil, false, &tidcommon.ErrorUnauthorized},
+		{"NotFound", nil, entity.ErrEntityNotFound, false, &ErrorUserNotFound},
+		{"NotAUser", &providers.Entity{ID: testUserID, Category: providers.EntityCategoryApp}, nil, false,
+			&ErrorUserNotFound},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockEntityService.On("GetEntity", mock.Anything, testUserID).Return(tc.entity, tc.entityErr)
+			if tc.entity != nil && tc.entity.Category == providers.EntityCategoryUser {
+				suite.mockAuthzService.On("IsActionAllowed", mock.Anything, security.ActionUpdateUser,
+					mock.MatchedBy(func(actionCtx *sysauthz.ActionContext) bool {
+						return actionCtx.ResourceType == security.ResourceTypeUser && actionCtx.OUID == "ou-1" &&
+							actionCtx.ResourceID == testUserID
+					})).
+					Return(tc.allowed, nil)
+			}
+
+			svcErr := suite.service.CheckUserAccess(context.Background(), testUserID, security.ActionUpdateUser)
+
+			if tc.expectedErr == nil {
+				suite.Nil(svcErr)
+			} else {
+				suite.Equal(tc.expectedErr.Code, svcErr.Code)
+			}
+		})
+	}
+}
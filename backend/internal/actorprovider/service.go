@@ -160,17 +160,29 @@ func toProviderOAuthClient(c *providers.OAuthClient) *providers.OAuthClient {
 		PKCERequired:                       c.PKCERequired,
 		PublicClient:                       c.PublicClient,
 		RequirePushedAuthorizationRequests: c.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         c.RequireSignedRequestObject,
+		RequestURIs:                        c.RequestURIs,
+		BackchannelLogoutURI:               c.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   c.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              c.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  c.FrontchannelLogoutSessionRequired,
 		DPoPBoundAccessTokens:              c.DPoPBoundAccessTokens,
+		TLSClientAuth:                      c.TLSClientAuth,
+		MTLSBoundAccessTokens:              c.MTLSBoundAccessTokens,
+		SubjectType:                        c.SubjectType,
+		SectorIdentifierURI:                c.SectorIdentifierURI,
 		IncludeActClaim:                    c.IncludeActClaim,
 		EntityCategory:                     c.EntityCategory,
 		Token:                              c.Token,
 		Scopes:                             c.Scopes,
 		UserInfo:                           c.UserInfo,
+		AuthorizationResponse:              c.AuthorizationResponse,
 		ScopeClaims:                        c.ScopeClaims,
 		Certificate:                        c.Certificate,
 		AcrValues:                          c.AcrValues,
 	}
 	client.GrantTypes = append(client.GrantTypes, c.GrantTypes...)
 	client.ResponseTypes = append(client.ResponseTypes, c.ResponseTypes...)
+	client.ResponseModes = append(client.ResponseModes, c.ResponseModes...)
 	return client
 }
@@ -1245,7 +1245,9 @@ func requiresClientSecret(cfg *providers.OAuthConfigWithSecret) bool {
 		providers.TokenEndpointAuthMethodClientSecretPost:
 		return true
 	case providers.TokenEndpointAuthMethodNone,
-		providers.TokenEndpointAuthMethodPrivateKeyJWT:
+		providers.TokenEndpointAuthMethodPrivateKeyJWT,
+		providers.TokenEndpointAuthMethodTLSClientAuth,
+		providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
 		return false
 	}
 	// Default to client_secret_basic when unspecified.
@@ -1421,7 +1423,17 @@ func buildOAuthProfile(configs []providers.InboundAuthConfigWithSecret) *provide
 		PKCERequired:                       cfg.PKCERequired,
 		PublicClient:                       cfg.PublicClient,
 		RequirePushedAuthorizationRequests: cfg.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         cfg.RequireSignedRequestObject,
+		RequestURIs:                        cfg.RequestURIs,
+		BackchannelLogoutURI:               cfg.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   cfg.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              cfg.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  cfg.FrontchannelLogoutSessionRequired,
 		DPoPBoundAccessTokens:              cfg.DPoPBoundAccessTokens,
+		TLSClientAuth:                      cfg.TLSClientAuth,
+		MTLSBoundAccessTokens:              cfg.MTLSBoundAccessTokens,
+		SubjectType:                        string(cfg.SubjectType),
+		SectorIdentifierURI:                cfg.SectorIdentifierURI,
 		IncludeActClaim:                    cfg.IncludeActClaim,
 		Certificate:                        cfg.Certificate,
 		Token:                              cfg.Token,
@@ -1447,7 +1459,17 @@ func oauthProfileToComplete(clientID string, p *providers.OAuthProfile) *provide
 		PKCERequired:                       p.PKCERequired,
 		PublicClient:                       p.PublicClient,
 		RequirePushedAuthorizationRequests: p.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         p.RequireSignedRequestObject,
+		RequestURIs:                        p.RequestURIs,
+		BackchannelLogoutURI:               p.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   p.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              p.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  p.FrontchannelLogoutSessionRequired,
 		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
+		TLSClientAuth:                      p.TLSClientAuth,
+		MTLSBoundAccessTokens:              p.MTLSBoundAccessTokens,
+		SubjectType:                        providers.SubjectType(p.SubjectType),
+		SectorIdentifierURI:                p.SectorIdentifierURI,
 		IncludeActClaim:                    p.IncludeActClaim,
 		Certificate:                        p.Certificate,
 		Token:                              p.Token,
@@ -1631,6 +1653,56 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.agentservice.private_key_jwt_requires_certificate_description",
 			DefaultValue: "private_key_jwt authentication method requires a certificate",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthTLSClientAuthRequiresSubject):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.tls_client_auth_requires_subject_description",
+			DefaultValue: "tls_client_auth authentication method requires exactly one certificate subject",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidTLSClientAuthSubject):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_tls_client_auth_subject_description",
+			DefaultValue: "tls_client_auth certificate subject is malformed",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSelfSignedTLSClientAuthRequiresCertificate):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.self_signed_tls_client_auth_requires_certificate_description",
+			DefaultValue: "self_signed_tls_client_auth authentication method requires a certificate",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthMutualTLSCannotHaveClientSecret):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.mutual_tls_cannot_have_client_secret_description",
+			DefaultValue: "mutual-TLS authentication methods cannot have a client secret",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSignedRequestObjectRequiresCertificate):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.signed_request_object_requires_certificate_description",
+			DefaultValue: "requiring signed request objects needs a certificate to verify them",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidRequestURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_request_uri_description",
+			DefaultValue: "request URIs must be absolute https URIs",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidLogoutURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_logout_uri_description",
+			DefaultValue: "logout URIs must be absolute http or https URIs without a fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidSubjectType):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_subject_type_description",
+			DefaultValue: "subject type must be either 'public' or 'pairwise'",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidSectorIdentifierURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_sector_identifier_uri_description",
+			DefaultValue: "sector identifier URI must be an absolute https URI without a fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthPairwiseRequiresSectorIdentifier):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.pairwise_requires_sector_identifier_description",
+			DefaultValue: "pairwise subject type requires a sector identifier URI unless all redirect URIs share one host",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthCertificateRequiresClientID):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.agentservice.certificate_requires_client_id_description",
@@ -1376,6 +1376,15 @@ func (suite *AgentServiceTestSuite) TestTranslateOAuthValidationError() {
 		{"PrivateKeyJWTRequiresCertificate", inboundclient.ErrOAuthPrivateKeyJWTRequiresCertificate,
 			ErrorInvalidOAuthConfiguration.Code,
 			"error.agentservice.private_key_jwt_requires_certificate_description"},
+		{"SignedRequestObjectRequiresCertificate", inboundclient.ErrOAuthSignedRequestObjectRequiresCertificate,
+			ErrorInvalidOAuthConfiguration.Code,
+			"error.agentservice.signed_request_object_requires_certificate_description"},
+		{"InvalidRequestURI", inboundclient.ErrOAuthInvalidRequestURI,
+			ErrorInvalidOAuthConfiguration.Code,
+			"error.agentservice.invalid_request_uri_description"},
+		{"InvalidLogoutURI", inboundclient.ErrOAuthInvalidLogoutURI,
+			ErrorInvalidOAuthConfiguration.Code,
+			"error.agentservice.invalid_logout_uri_description"},
 		{"CertificateRequiresClientID", inboundclient.ErrOAuthCertificateRequiresClientID,
 			ErrorInvalidOAuthConfiguration.Code,
 			"error.agentservice.certificate_requires_client_id_description"},
@@ -205,15 +205,27 @@ func parseToApplicationDTO(data []byte) (*model.ApplicationDTO, error) {
 					PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
 					GrantTypes:                         config.OAuthConfig.GrantTypes,
 					ResponseTypes:                      config.OAuthConfig.ResponseTypes,
+					ResponseModes:                      config.OAuthConfig.ResponseModes,
 					TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 					PKCERequired:                       config.OAuthConfig.PKCERequired,
 					PublicClient:                       config.OAuthConfig.PublicClient,
 					RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+					RequireSignedRequestObject:         config.OAuthConfig.RequireSignedRequestObject,
+					RequestURIs:                        config.OAuthConfig.RequestURIs,
+					BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+					BackchannelLogoutSessionRequired:   config.OAuthConfig.BackchannelLogoutSessionRequired,
+					FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
+					FrontchannelLogoutSessionRequired:  config.OAuthConfig.FrontchannelLogoutSessionRequired,
 					DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+					TLSClientAuth:                      config.OAuthConfig.TLSClientAuth,
+					MTLSBoundAccessTokens:              config.OAuthConfig.MTLSBoundAccessTokens,
+					SubjectType:                        config.OAuthConfig.SubjectType,
+					SectorIdentifierURI:                config.OAuthConfig.SectorIdentifierURI,
 					IncludeActClaim:                    config.OAuthConfig.IncludeActClaim,
 					Token:                              config.OAuthConfig.Token,
 					Scopes:                             config.OAuthConfig.Scopes,
 					UserInfo:                           config.OAuthConfig.UserInfo,
+					AuthorizationResponse:              config.OAuthConfig.AuthorizationResponse,
 					ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 					Certificate:                        config.OAuthConfig.Certificate,
 				},
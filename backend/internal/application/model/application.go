@@ -55,22 +55,22 @@ type BasicApplicationDTO struct {
 
 // ApplicationProcessedDTO represents the processed data transfer object for application service operations.
 type ApplicationProcessedDTO struct {
-	ID          string          `yaml:"id,omitempty"`
-	OUID        string          `yaml:"ouId,omitempty"`
-	Name        string          `yaml:"name,omitempty"`
-	Description string          `yaml:"description,omitempty"`
-	Type        ApplicationType `yaml:"type,omitempty"`
-	Template    string          `yaml:"template,omitempty"`
-
-	URL       string `yaml:"url,omitempty"`
-	LogoURL   string `yaml:"logoUrl,omitempty"`
-	TosURI    string `yaml:"tosUri,omitempty"`
-	PolicyURI string `yaml:"policyUri,omitempty"`
-	Contacts  []string
+	ID          string          `json:"id,omitempty" yaml:"id,omitempty"`
+	OUID        string          `json:"ouId,omitempty" yaml:"ouId,omitempty"`
+	Name        string          `json:"name,omitempty" yaml:"name,omitempty"`
+	Description string          `json:"description,omitempty" yaml:"description,omitempty"`
+	Type        ApplicationType `json:"type,omitempty" yaml:"type,omitempty"`
+	Template    string          `json:"template,omitempty" yaml:"template,omitempty"`
+
+	URL       string   `json:"url,omitempty" yaml:"url,omitempty"`
+	LogoURL   string   `json:"logoUrl,omitempty" yaml:"logoUrl,omitempty"`
+	TosURI    string   `json:"tosUri,omitempty" yaml:"tosUri,omitempty"`
+	PolicyURI string   `json:"policyUri,omitempty" yaml:"policyUri,omitempty"`
+	Contacts  []string `json:"contacts,omitempty"`
 
 	providers.InboundAuthProfile `yaml:",inline"`
-	InboundAuthConfig            []inboundmodel.InboundAuthConfigProcessed `yaml:"inboundAuthConfig,omitempty"`
-	Metadata                     map[string]interface{}                    `yaml:"metadata,omitempty"`
+	InboundAuthConfig            []inboundmodel.InboundAuthConfigProcessed `json:"inboundAuthConfig,omitempty" yaml:"inboundAuthConfig,omitempty"`
+	Metadata                     map[string]interface{}                    `json:"metadata,omitempty" yaml:"metadata,omitempty"`
 }
 
 // ApplicationRequest represents the request structure for creating or updating an application.
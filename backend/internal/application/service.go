@@ -19,6 +19,7 @@ import (
 	oauthutils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/serverconfig"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/cors"
@@ -48,6 +49,7 @@ type ApplicationServiceInterface interface {
 	GetResourceDependencies(
 		ctx context.Context, resourceType, id string) ([]resourcedependency.ResourceDependency, error)
 	SetDependencyRegistry(r resourcedependency.Registry)
+	SetAuditRecorder(recorder *audit.Recorder)
 }
 
 // ApplicationService is the default implementation of the ApplicationServiceInterface.
@@ -60,6 +62,7 @@ type applicationService struct {
 	cryptoSvc            providers.RuntimeCryptoProvider
 	dependencyRegistry   resourcedependency.Registry
 	serverConfigService  serverconfig.ServerConfigService
+	auditRecorder        *audit.Recorder
 }
 
 // newApplicationService creates a new instance of ApplicationService.
@@ -197,6 +200,7 @@ func (as *applicationService) CreateApplication(ctx context.Context, app *model.
 		inboundAuthConfig, oauthToken, userInfo, scopeClaims)
 	// Surface the Flow Secret once, on creation only.
 	returnDTO.FlowSecret = flowSecret
+	as.auditRecorder.RecordCreate(ctx, audit.ResourceTypeApplication, appID, returnDTO)
 	return returnDTO, nil
 }
 
@@ -429,6 +433,7 @@ func (as *applicationService) UpdateApplication(ctx context.Context, appID strin
 	}
 
 	as.syncPasskeyOriginsToCORS(ctx, processedDTO.PasskeyAllowedOrigins)
+	as.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeApplication, appID, existingApp, processedDTO)
 
 	appForReturn := *app
 	appForReturn.AuthFlowID = inboundClient.AuthFlowID
@@ -571,7 +576,9 @@ func appRequiresClientSecret(cfg *providers.OAuthConfigWithSecret) bool {
 		providers.TokenEndpointAuthMethodClientSecretPost:
 		return true
 	case providers.TokenEndpointAuthMethodNone,
-		providers.TokenEndpointAuthMethodPrivateKeyJWT:
+		providers.TokenEndpointAuthMethodPrivateKeyJWT,
+		providers.TokenEndpointAuthMethodTLSClientAuth,
+		providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
 		return false
 	}
 	// Default to requiring a secret when method is unspecified.
@@ -585,12 +592,19 @@ func (as *applicationService) SetDependencyRegistry(r resourcedependency.Registr
 	as.dependencyRegistry = r
 }
 
+// SetAuditRecorder injects the recorder that application mutations are audited through. Called by
+// servicemanager once the audit service is initialized.
+func (as *applicationService) SetAuditRecorder(recorder *audit.Recorder) {
+	as.auditRecorder = recorder
+}
+
 func (as *applicationService) DeleteApplication(ctx context.Context, appID string) *tidcommon.ServiceError {
 	if appID == "" {
 		return &ErrorInvalidApplicationID
 	}
 
-	if existing, epErr := as.entityProvider.GetEntity(appID); epErr != nil {
+	existing, epErr := as.entityProvider.GetEntity(appID)
+	if epErr != nil {
 		if epErr.Code != entityprovider.ErrorCodeEntityNotFound {
 			as.logger.Error(ctx, "Failed to load entity before delete",
 				log.String("appID", appID), log.Error(epErr))
@@ -635,6 +649,7 @@ func (as *applicationService) DeleteApplication(ctx context.Context, appID strin
 			log.String("appID", appID), log.Error(epErr))
 		return &tidcommon.InternalServerError
 	}
+	as.auditRecorder.RecordDelete(ctx, audit.ResourceTypeApplication, appID, existing)
 
 	return as.deleteLocalizedVariants(ctx, appID)
 }
@@ -978,16 +993,28 @@ func buildOAuthProfileFromProcessed(inboundAuth inboundmodel.InboundAuthConfigPr
 		PostLogoutRedirectURIs:             oa.PostLogoutRedirectURIs,
 		GrantTypes:                         sysutils.ConvertToStringSlice(oa.GrantTypes),
 		ResponseTypes:                      sysutils.ConvertToStringSlice(oa.ResponseTypes),
+		ResponseModes:                      sysutils.ConvertToStringSlice(oa.ResponseModes),
 		TokenEndpointAuthMethod:            string(oa.TokenEndpointAuthMethod),
 		PKCERequired:                       oa.PKCERequired,
 		PublicClient:                       oa.PublicClient,
 		RequirePushedAuthorizationRequests: oa.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         oa.RequireSignedRequestObject,
+		RequestURIs:                        oa.RequestURIs,
+		BackchannelLogoutURI:               oa.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   oa.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              oa.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  oa.FrontchannelLogoutSessionRequired,
 		DPoPBoundAccessTokens:              oa.DPoPBoundAccessTokens,
+		TLSClientAuth:                      oa.TLSClientAuth,
+		MTLSBoundAccessTokens:              oa.MTLSBoundAccessTokens,
+		SubjectType:                        string(oa.SubjectType),
+		SectorIdentifierURI:                oa.SectorIdentifierURI,
 		IncludeActClaim:                    oa.IncludeActClaim,
 		Scopes:                             oa.Scopes,
 		ScopeClaims:                        oa.ScopeClaims,
 		Token:                              oa.Token,
 		UserInfo:                           oa.UserInfo,
+		AuthorizationResponse:              oa.AuthorizationResponse,
 		Certificate:                        oa.Certificate,
 		AcrValues:                          oa.AcrValues,
 	}
@@ -1297,6 +1324,9 @@ func (as *applicationService) translateInboundClientError(ctx context.Context, e
 	if svcErr := translateUserInfoValidationError(err); svcErr != nil {
 		return svcErr
 	}
+	if svcErr := translateAuthorizationResponseValidationError(err); svcErr != nil {
+		return svcErr
+	}
 	if svcErr := translateIDTokenValidationError(err); svcErr != nil {
 		return svcErr
 	}
@@ -1333,6 +1363,11 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 		return &ErrorInvalidGrantType
 	case errors.Is(err, inboundclient.ErrOAuthInvalidResponseType):
 		return &ErrorInvalidResponseType
+	case errors.Is(err, inboundclient.ErrOAuthInvalidResponseMode):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_response_mode_description",
+			DefaultValue: "One or more provided response modes are invalid",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthClientCredentialsCannotUseResponseTypes):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.applicationservice.client_credentials_cannot_use_response_types_description",
@@ -1367,6 +1402,56 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.applicationservice.private_key_jwt_requires_certificate_description",
 			DefaultValue: "private_key_jwt authentication method requires a certificate",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthTLSClientAuthRequiresSubject):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.tls_client_auth_requires_subject_description",
+			DefaultValue: "tls_client_auth authentication method requires exactly one certificate subject",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidTLSClientAuthSubject):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_tls_client_auth_subject_description",
+			DefaultValue: "tls_client_auth certificate subject is malformed",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSelfSignedTLSClientAuthRequiresCertificate):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.self_signed_tls_client_auth_requires_certificate_description",
+			DefaultValue: "self_signed_tls_client_auth authentication method requires a certificate",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthMutualTLSCannotHaveClientSecret):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.mutual_tls_cannot_have_client_secret_description",
+			DefaultValue: "mutual-TLS authentication methods cannot have a client secret",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSignedRequestObjectRequiresCertificate):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.signed_request_object_requires_certificate_description",
+			DefaultValue: "requiring signed request objects needs a certificate to verify them",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidRequestURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_request_uri_description",
+			DefaultValue: "request URIs must be absolute https URIs",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidLogoutURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_logout_uri_description",
+			DefaultValue: "logout URIs must be absolute http or https URIs without a fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidSubjectType):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_subject_type_description",
+			DefaultValue: "subject type must be either 'public' or 'pairwise'",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidSectorIdentifierURI):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_sector_identifier_uri_description",
+			DefaultValue: "sector identifier URI must be an absolute https URI without a fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthPairwiseRequiresSectorIdentifier):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.pairwise_requires_sector_identifier_description",
+			DefaultValue: "pairwise subject type requires a sector identifier URI unless all redirect URIs share one host",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthCertificateRequiresClientID):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.applicationservice.certificate_requires_client_id_description",
@@ -1484,6 +1569,50 @@ func translateUserInfoValidationError(err error) *tidcommon.ServiceError {
 	return nil
 }
 
+// translateAuthorizationResponseValidationError maps OAuth JWT-secured authorization response
+// validation sentinels to application-service errors.
+func translateAuthorizationResponseValidationError(err error) *tidcommon.ServiceError {
+	switch {
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseUnsupportedSigningAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_unsupported_signing_alg_description",
+			DefaultValue: "authorization response signing algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_unsupported_encryption_alg_description",
+			DefaultValue: "authorization response encryption algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_unsupported_encryption_enc_description",
+			DefaultValue: "authorization response content-encryption algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_encryption_alg_requires_enc_description",
+			DefaultValue: "authorizationResponse encryptionEnc is required when encryptionAlg is set",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_encryption_enc_requires_alg_description",
+			DefaultValue: "authorizationResponse encryptionAlg is required when encryptionEnc is set",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseEncryptionRequiresCertificate):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.applicationservice.authorization_response_encryption_requires_certificate_description",
+			DefaultValue: "a certificate (JWKS or JWKS_URI) is required " +
+				"when authorization response encryption is configured",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.authorization_response_jwks_uri_not_ssrf_safe_description",
+			DefaultValue: "authorization response JWKS URI must be a publicly reachable HTTPS URL",
+		})
+	}
+	return nil
+}
+
 // translateIDTokenValidationError maps OAuth ID token validation sentinels to
 // application-service errors.
 func translateIDTokenValidationError(err error) *tidcommon.ServiceError {
@@ -1849,15 +1978,27 @@ func buildApplicationResponse(dto *model.ApplicationProcessedDTO) *providers.App
 					PostLogoutRedirectURIs:             oauthAppConfig.PostLogoutRedirectURIs,
 					GrantTypes:                         oauthAppConfig.GrantTypes,
 					ResponseTypes:                      oauthAppConfig.ResponseTypes,
+					ResponseModes:                      oauthAppConfig.ResponseModes,
 					TokenEndpointAuthMethod:            oauthAppConfig.TokenEndpointAuthMethod,
 					PKCERequired:                       oauthAppConfig.PKCERequired,
 					PublicClient:                       oauthAppConfig.PublicClient,
 					RequirePushedAuthorizationRequests: oauthAppConfig.RequirePushedAuthorizationRequests,
+					RequireSignedRequestObject:         oauthAppConfig.RequireSignedRequestObject,
+					RequestURIs:                        oauthAppConfig.RequestURIs,
+					BackchannelLogoutURI:               oauthAppConfig.BackchannelLogoutURI,
+					BackchannelLogoutSessionRequired:   oauthAppConfig.BackchannelLogoutSessionRequired,
+					FrontchannelLogoutURI:              oauthAppConfig.FrontchannelLogoutURI,
+					FrontchannelLogoutSessionRequired:  oauthAppConfig.FrontchannelLogoutSessionRequired,
 					DPoPBoundAccessTokens:              oauthAppConfig.DPoPBoundAccessTokens,
+					TLSClientAuth:                      oauthAppConfig.TLSClientAuth,
+					MTLSBoundAccessTokens:              oauthAppConfig.MTLSBoundAccessTokens,
+					SubjectType:                        oauthAppConfig.SubjectType,
+					SectorIdentifierURI:                oauthAppConfig.SectorIdentifierURI,
 					IncludeActClaim:                    oauthAppConfig.IncludeActClaim,
 					Token:                              oauthAppConfig.Token,
 					Scopes:                             oauthAppConfig.Scopes,
 					UserInfo:                           oauthAppConfig.UserInfo,
+					AuthorizationResponse:              oauthAppConfig.AuthorizationResponse,
 					ScopeClaims:                        oauthAppConfig.ScopeClaims,
 					AcrValues:                          oauthAppConfig.AcrValues,
 				},
@@ -1984,15 +2125,27 @@ func buildOAuthInboundAuthConfigProcessedDTO(
 			PostLogoutRedirectURIs:             inboundAuthConfig.OAuthConfig.PostLogoutRedirectURIs,
 			GrantTypes:                         inboundAuthConfig.OAuthConfig.GrantTypes,
 			ResponseTypes:                      inboundAuthConfig.OAuthConfig.ResponseTypes,
+			ResponseModes:                      inboundAuthConfig.OAuthConfig.ResponseModes,
 			TokenEndpointAuthMethod:            inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod,
 			PKCERequired:                       inboundAuthConfig.OAuthConfig.PKCERequired,
 			PublicClient:                       inboundAuthConfig.OAuthConfig.PublicClient,
 			RequirePushedAuthorizationRequests: inboundAuthConfig.OAuthConfig.RequirePushedAuthorizationRequests,
+			RequireSignedRequestObject:         inboundAuthConfig.OAuthConfig.RequireSignedRequestObject,
+			RequestURIs:                        inboundAuthConfig.OAuthConfig.RequestURIs,
+			BackchannelLogoutURI:               inboundAuthConfig.OAuthConfig.BackchannelLogoutURI,
+			BackchannelLogoutSessionRequired:   inboundAuthConfig.OAuthConfig.BackchannelLogoutSessionRequired,
+			FrontchannelLogoutURI:              inboundAuthConfig.OAuthConfig.FrontchannelLogoutURI,
+			FrontchannelLogoutSessionRequired:  inboundAuthConfig.OAuthConfig.FrontchannelLogoutSessionRequired,
 			DPoPBoundAccessTokens:              inboundAuthConfig.OAuthConfig.DPoPBoundAccessTokens,
+			TLSClientAuth:                      inboundAuthConfig.OAuthConfig.TLSClientAuth,
+			MTLSBoundAccessTokens:              inboundAuthConfig.OAuthConfig.MTLSBoundAccessTokens,
+			SubjectType:                        inboundAuthConfig.OAuthConfig.SubjectType,
+			SectorIdentifierURI:                inboundAuthConfig.OAuthConfig.SectorIdentifierURI,
 			IncludeActClaim:                    inboundAuthConfig.OAuthConfig.IncludeActClaim,
 			Token:                              oauthToken,
 			Scopes:                             inboundAuthConfig.OAuthConfig.Scopes,
 			UserInfo:                           userInfo,
+			AuthorizationResponse:              inboundAuthConfig.OAuthConfig.AuthorizationResponse,
 			ScopeClaims:                        scopeClaims,
 			Certificate:                        certificate,
 			AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
@@ -2050,15 +2203,27 @@ func buildReturnApplicationDTO(
 				PostLogoutRedirectURIs:             inboundAuthConfig.OAuthConfig.PostLogoutRedirectURIs,
 				GrantTypes:                         inboundAuthConfig.OAuthConfig.GrantTypes,
 				ResponseTypes:                      inboundAuthConfig.OAuthConfig.ResponseTypes,
+				ResponseModes:                      inboundAuthConfig.OAuthConfig.ResponseModes,
 				TokenEndpointAuthMethod:            inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod,
 				PKCERequired:                       inboundAuthConfig.OAuthConfig.PKCERequired,
 				PublicClient:                       inboundAuthConfig.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: inboundAuthConfig.OAuthConfig.RequirePushedAuthorizationRequests,
+				RequireSignedRequestObject:         inboundAuthConfig.OAuthConfig.RequireSignedRequestObject,
+				RequestURIs:                        inboundAuthConfig.OAuthConfig.RequestURIs,
+				BackchannelLogoutURI:               inboundAuthConfig.OAuthConfig.BackchannelLogoutURI,
+				BackchannelLogoutSessionRequired:   inboundAuthConfig.OAuthConfig.BackchannelLogoutSessionRequired,
+				FrontchannelLogoutURI:              inboundAuthConfig.OAuthConfig.FrontchannelLogoutURI,
+				FrontchannelLogoutSessionRequired:  inboundAuthConfig.OAuthConfig.FrontchannelLogoutSessionRequired,
 				DPoPBoundAccessTokens:              inboundAuthConfig.OAuthConfig.DPoPBoundAccessTokens,
+				TLSClientAuth:                      inboundAuthConfig.OAuthConfig.TLSClientAuth,
+				MTLSBoundAccessTokens:              inboundAuthConfig.OAuthConfig.MTLSBoundAccessTokens,
+				SubjectType:                        inboundAuthConfig.OAuthConfig.SubjectType,
+				SectorIdentifierURI:                inboundAuthConfig.OAuthConfig.SectorIdentifierURI,
 				IncludeActClaim:                    inboundAuthConfig.OAuthConfig.IncludeActClaim,
 				Token:                              oauthToken,
 				Scopes:                             inboundAuthConfig.OAuthConfig.Scopes,
 				UserInfo:                           userInfo,
+				AuthorizationResponse:              inboundAuthConfig.OAuthConfig.AuthorizationResponse,
 				ScopeClaims:                        scopeClaims,
 				Certificate:                        oauthCert,
 				AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
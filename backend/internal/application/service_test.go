@@ -3456,6 +3456,12 @@ func (suite *ServiceTestSuite) TestTranslateOAuthValidationError() {
 			wantCode:    ErrorInvalidResponseType.Code,
 			wantDescKey: "error.applicationservice.invalid_response_type_description",
 		},
+		{
+			name:        "InvalidResponseMode",
+			err:         inboundclient.ErrOAuthInvalidResponseMode,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.invalid_response_mode_description",
+		},
 		{
 			name:        "ClientCredentialsCannotUseResponseTypes",
 			err:         inboundclient.ErrOAuthClientCredentialsCannotUseResponseTypes,
@@ -3498,6 +3504,24 @@ func (suite *ServiceTestSuite) TestTranslateOAuthValidationError() {
 			wantCode:    ErrorInvalidOAuthConfiguration.Code,
 			wantDescKey: "error.applicationservice.private_key_jwt_requires_certificate_description",
 		},
+		{
+			name:        "SignedRequestObjectRequiresCertificate",
+			err:         inboundclient.ErrOAuthSignedRequestObjectRequiresCertificate,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.signed_request_object_requires_certificate_description",
+		},
+		{
+			name:        "InvalidRequestURI",
+			err:         inboundclient.ErrOAuthInvalidRequestURI,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.invalid_request_uri_description",
+		},
+		{
+			name:        "InvalidLogoutURI",
+			err:         inboundclient.ErrOAuthInvalidLogoutURI,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.invalid_logout_uri_description",
+		},
 		{
 			name:        "CertificateRequiresClientID",
 			err:         inboundclient.ErrOAuthCertificateRequiresClientID,
@@ -3639,6 +3663,60 @@ func (suite *ServiceTestSuite) TestTranslateUserInfoValidationError() {
 	suite.Nil(translateUserInfoValidationError(errors.New("unknown")))
 }
 
+func (suite *ServiceTestSuite) TestTranslateAuthorizationResponseValidationError() {
+	cases := []struct {
+		name        string
+		err         error
+		wantDescKey string
+	}{
+		{
+			name:        "UnsupportedSigningAlg",
+			err:         inboundclient.ErrOAuthAuthorizationResponseUnsupportedSigningAlg,
+			wantDescKey: "error.applicationservice.authorization_response_unsupported_signing_alg_description",
+		},
+		{
+			name:        "UnsupportedEncryptionAlg",
+			err:         inboundclient.ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg,
+			wantDescKey: "error.applicationservice.authorization_response_unsupported_encryption_alg_description",
+		},
+		{
+			name:        "UnsupportedEncryptionEnc",
+			err:         inboundclient.ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc,
+			wantDescKey: "error.applicationservice.authorization_response_unsupported_encryption_enc_description",
+		},
+		{
+			name:        "EncryptionAlgRequiresEnc",
+			err:         inboundclient.ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc,
+			wantDescKey: "error.applicationservice.authorization_response_encryption_alg_requires_enc_description",
+		},
+		{
+			name:        "EncryptionEncRequiresAlg",
+			err:         inboundclient.ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg,
+			wantDescKey: "error.applicationservice.authorization_response_encryption_enc_requires_alg_description",
+		},
+		{
+			name: "EncryptionRequiresCertificate",
+			err:  inboundclient.ErrOAuthAuthorizationResponseEncryptionRequiresCertificate,
+			wantDescKey: "error.applicationservice." +
+				"authorization_response_encryption_requires_certificate_description",
+		},
+		{
+			name:        "JWKSURINotSSRFSafe",
+			err:         inboundclient.ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe,
+			wantDescKey: "error.applicationservice.authorization_response_jwks_uri_not_ssrf_safe_description",
+		},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			svcErr := translateAuthorizationResponseValidationError(tc.err)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(ErrorInvalidOAuthConfiguration.Code, svcErr.Code)
+			suite.Equal(tc.wantDescKey, svcErr.ErrorDescription.Key)
+		})
+	}
+	suite.Nil(translateAuthorizationResponseValidationError(errors.New("unknown")))
+}
+
 func (suite *ServiceTestSuite) TestTranslateIDTokenValidationError() {
 	cases := []struct {
 		name        string
@@ -207,6 +207,33 @@ func (s *consentEnforcerService) RecordConsent(ctx context.Context, ouID, appID,
 	return convertToProvidersConsent(consentRecord), nil
 }
 
+// GetActiveConsentID implements providers.ConsentProvider.GetActiveConsentID. It resolves the same
+// record RecordConsent would update, so a grant is always tied to the consent that governs it.
+func (s *consentEnforcerService) GetActiveConsentID(ctx context.Context, appID, userID string) (
+	string, *tidcommon.ServiceError) {
+	logger := s.logger.With(log.String("appID", appID), log.MaskedString(log.LoggerKeyUserID, userID))
+
+	filter := consent.ConsentFilter{
+		GroupID:       appID,
+		UserID:        userID,
+		ConsentStatus: consent.ConsentStatusActive,
+	}
+	existingConsents, svcErr := s.consentService.SearchConsents(ctx, filter)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			logger.Debug(ctx, "Client error from consent service when searching active consents",
+				log.Any("error", svcErr))
+			return "", &ErrorConsentSearchFailed
+		}
+		logger.Error(ctx, "Failed to search active consents", log.Any("error", svcErr))
+		return "", &tidcommon.InternalServerError
+	}
+	if len(existingConsents) == 0 {
+		return "", nil
+	}
+	return existingConsents[0].ID, nil
+}
+
 // updateExistingConsent updates an existing consent record by merging new decisions into it.
 // The existing record's approved elements are preserved, and new decisions override.
 // Returns the updated consent record.
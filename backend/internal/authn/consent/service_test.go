@@ -388,6 +388,53 @@ func buildSessionTokenWithPayload(payload map[string]interface{}) string {
 		base64.RawURLEncoding.EncodeToString(payloadJSON) + ".fake-sig"
 }
 
+func (s *ConsentEnforcerServiceTestSuite) TestGetActiveConsentID_Found() {
+	s.mockConsentSvc.On("SearchConsents", mock.Anything, consent.ConsentFilter{
+		GroupID:       "app1",
+		UserID:        "user1",
+		ConsentStatus: consent.ConsentStatusActive,
+	}).Return([]*consent.Consent{{ID: "consent-1", GroupID: "app1"}}, nil)
+
+	consentID, svcErr := s.service.GetActiveConsentID(context.Background(), "app1", "user1")
+
+	s.Nil(svcErr)
+	s.Equal("consent-1", consentID)
+}
+
+func (s *ConsentEnforcerServiceTestSuite) TestGetActiveConsentID_NoneActive() {
+	s.mockConsentSvc.On("SearchConsents", mock.Anything, mock.Anything).Return([]*consent.Consent{}, nil)
+
+	consentID, svcErr := s.service.GetActiveConsentID(context.Background(), "app1", "user1")
+
+	s.Nil(svcErr)
+	s.Empty(consentID)
+}
+
+func (s *ConsentEnforcerServiceTestSuite) TestGetActiveConsentID_SearchErrors() {
+	testCases := []struct {
+		name         string
+		svcErr       *tidcommon.ServiceError
+		expectedCode string
+	}{
+		{"ClientError", &tidcommon.ServiceError{Type: tidcommon.ClientErrorType, Code: "CONSENT-4002"},
+			ErrorConsentSearchFailed.Code},
+		{"ServerError", &tidcommon.ServiceError{Type: tidcommon.ServerErrorType, Code: "CONSENT-5002"},
+			tidcommon.InternalServerError.Code},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockConsentSvc.On("SearchConsents", mock.Anything, mock.Anything).Return(nil, tc.svcErr).Once()
+
+			consentID, svcErr := s.service.GetActiveConsentID(context.Background(), "app1", "user1")
+
+			s.Empty(consentID)
+			s.NotNil(svcErr)
+			s.Equal(tc.expectedCode, svcErr.Code)
+		})
+	}
+}
+
 func (s *ConsentEnforcerServiceTestSuite) TestCreateConsentSessionToken_GenerateJWTFails() {
 	promptData := &providers.ConsentPromptData{
 		Purposes: []providers.ConsentPurposePrompt{{PurposeName: "purpose-1",
@@ -76,6 +76,20 @@ var (
 			DefaultValue: "The provided credentials contain a credential type that is reserved for internal use",
 		},
 	}
+	// ErrorAccountLocked is the error when the account or the client IP address is temporarily locked
+	// after repeated failed authentication attempts.
+	ErrorAccountLocked = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTH-CRED-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authnservice.account_locked",
+			DefaultValue: "Account locked",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authnservice.account_locked_description",
+			DefaultValue: "The account is temporarily locked due to repeated failed authentication attempts",
+		},
+	}
 	// ErrorOTPAuthenticationFailed is the error when the OTP authentication attempt fails.
 	ErrorOTPAuthenticationFailed = tidcommon.ServiceError{
 		Type: tidcommon.ClientErrorType,
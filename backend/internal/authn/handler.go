@@ -408,6 +408,8 @@ func (
 			status = http.StatusUnauthorized
 		case common.ErrorUserNotFound.Code:
 			status = http.StatusNotFound
+		case ErrorAccountLocked.Code:
+			status = http.StatusTooManyRequests
 		default:
 			status = http.StatusBadRequest
 		}
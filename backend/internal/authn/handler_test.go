@@ -235,6 +235,20 @@ func (suite *AuthenticationHandlerTestSuite) TestHandleCredentialsAuthRequestSer
 			expectedStatusCode: http.StatusNotFound,
 			expectedErrorCode:  common.ErrorUserNotFound.Code,
 		},
+		{
+			name: "AccountLocked",
+			authRequest: map[string]interface{}{
+				"identifiers": map[string]interface{}{
+					"username": "testuser",
+				},
+				"credentials": map[string]interface{}{
+					"password": "wrongpass",
+				},
+			},
+			serviceError:       &ErrorAccountLocked,
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedErrorCode:  ErrorAccountLocked.Code,
+		},
 		{
 			name: "ClientError",
 			authRequest: map[string]interface{}{
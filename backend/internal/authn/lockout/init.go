@@ -0,0 +1,18 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lockout
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize initializes the account lockout service.
+func Initialize(
+	runtimeStore providers.RuntimeStoreProvider,
+	observabilitySvc providers.ObservabilityProvider,
+	cfg config.AccountLockoutConfig,
+) LockoutServiceInterface {
+	return newLockoutService(newLockoutStore(runtimeStore), observabilitySvc, cfg)
+}
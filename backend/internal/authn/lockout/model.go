@@ -0,0 +1,30 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lockout
+
+import "time"
+
+// LockStatus describes whether credential authentication is currently blocked for a user or the
+// client IP address of the request.
+type LockStatus struct {
+	Locked      bool
+	LockedUntil time.Time
+}
+
+// counterRevisionField is the JSON field of counterState that conditional updates compare.
+const counterRevisionField = "revision"
+
+// counterState is the failed-attempt state stored for a single user or client IP address.
+type counterState struct {
+	// Revision changes with every update of the counter, so that concurrent updates can be detected.
+	Revision string `json:"revision"`
+	// Failures is the number of failed attempts within the current window.
+	Failures int `json:"failures"`
+	// WindowStart is the Unix time of the first failed attempt in the current window.
+	WindowStart int64 `json:"windowStart"`
+	// LockCount is the number of consecutive lockouts, used to grow the lockout duration.
+	LockCount int `json:"lockCount"`
+	// LockedUntil is the Unix time at which the current lockout ends.
+	LockedUntil int64 `json:"lockedUntil"`
+}
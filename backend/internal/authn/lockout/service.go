@@ -0,0 +1,435 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lockout implements account lockout and progressive throttling for credential authentication.
+package lockout
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+)
+
+const (
+	// loggerComponentName is the component name for logging.
+	loggerComponentName = "LockoutService"
+
+	defaultMaxFailedAttempts         = 5
+	defaultIPMaxFailedAttempts       = 50
+	defaultFailureWindowSeconds      = 900
+	defaultLockoutDurationSeconds    = 300
+	defaultMaxLockoutDurationSeconds = 86400
+	defaultBackoffMultiplier         = 2
+
+	// maxUpdateAttempts bounds the retries of a counter update that loses a race to a concurrent one.
+	maxUpdateAttempts = 10
+
+	// userKeyPrefix and ipKeyPrefix scope the counters of users and client IP addresses.
+	userKeyPrefix = "user:"
+	ipKeyPrefix   = "ip:"
+	// factorKeyPrefix scopes the counters of a user for a single authentication factor.
+	factorKeyPrefix = "factor:"
+)
+
+// FactorTOTP identifies the counters of wrong authenticator-app and recovery codes.
+const FactorTOTP = "totp"
+
+// lockoutFactors lists the authentication factors whose counters are cleared when a user is unlocked.
+var lockoutFactors = []string{FactorTOTP}
+
+// errUpdateContention is returned when a counter keeps changing under a concurrent update.
+var errUpdateContention = errors.New("lockout counter update contention")
+
+// LockoutServiceInterface defines the interface for tracking failed credential attempts and locking
+// out users and client IP addresses that exceed the configured thresholds.
+type LockoutServiceInterface interface {
+	// IsEnabled reports whether account lockout is enabled.
+	IsEnabled() bool
+	// CheckLocked reports whether the user, or the client IP address of the request, is locked out.
+	CheckLocked(ctx context.Context, userID string) (*LockStatus, *tidcommon.ServiceError)
+	// RecordFailure records a failed attempt for the user and the client IP address of the request,
+	// and locks either of them once its threshold is reached.
+	RecordFailure(ctx context.Context, userID string) (*LockStatus, *tidcommon.ServiceError)
+	// RecordSuccess clears the failed attempts and the lockout history of the user.
+	RecordSuccess(ctx context.Context, userID string) *tidcommon.ServiceError
+	// Unlock lifts the lockout of the user and clears its failed attempts.
+	Unlock(ctx context.Context, userID string) *tidcommon.ServiceError
+	// CheckFactorLocked reports whether the user is locked out of the authentication factor.
+	CheckFactorLocked(ctx context.Context, factor, userID string) (*LockStatus, *tidcommon.ServiceError)
+	// RecordFactorFailure records a failed attempt of the authentication factor for the user, and locks
+	// the user out of the factor once maxFailures is reached.
+	RecordFactorFailure(ctx context.Context, factor, userID string, maxFailures int) (
+		*LockStatus, *tidcommon.ServiceError)
+	// RecordFactorSuccess clears the failed attempts of the authentication factor for the user.
+	RecordFactorSuccess(ctx context.Context, factor, userID string) *tidcommon.ServiceError
+}
+
+// lockoutService is the default implementation of LockoutServiceInterface.
+type lockoutService struct {
+	store             lockoutStoreInterface
+	observabilitySvc  providers.ObservabilityProvider
+	enabled           bool
+	maxFailures       int
+	ipMaxFailures     int
+	windowSeconds     int64
+	baseLockSeconds   int64
+	maxLockSeconds    int64
+	backoffMultiplier float64
+	now               func() time.Time
+	logger            *log.Logger
+}
+
+// newLockoutService creates a new instance of the lockout service. Unset configuration values fall
+// back to the defaults.
+func newLockoutService(
+	store lockoutStoreInterface,
+	observabilitySvc providers.ObservabilityProvider,
+	cfg config.AccountLockoutConfig,
+) LockoutServiceInterface {
+	svc := &lockoutService{
+		store:             store,
+		observabilitySvc:  observabilitySvc,
+		enabled:           cfg.Enabled,
+		maxFailures:       cfg.MaxFailedAttempts,
+		ipMaxFailures:     cfg.IPMaxFailedAttempts,
+		windowSeconds:     int64(cfg.FailureWindowSeconds),
+		baseLockSeconds:   int64(cfg.LockoutDurationSeconds),
+		maxLockSeconds:    int64(cfg.MaxLockoutDurationSeconds),
+		backoffMultiplier: cfg.BackoffMultiplier,
+		now:               time.Now,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+	if svc.maxFailures <= 0 {
+		svc.maxFailures = defaultMaxFailedAttempts
+	}
+	if svc.ipMaxFailures <= 0 {
+		svc.ipMaxFailures = defaultIPMaxFailedAttempts
+	}
+	if svc.windowSeconds <= 0 {
+		svc.windowSeconds = defaultFailureWindowSeconds
+	}
+	if svc.baseLockSeconds <= 0 {
+		svc.baseLockSeconds = defaultLockoutDurationSeconds
+	}
+	if svc.maxLockSeconds <= 0 {
+		svc.maxLockSeconds = defaultMaxLockoutDurationSeconds
+	}
+	if svc.maxLockSeconds < svc.baseLockSeconds {
+		svc.maxLockSeconds = svc.baseLockSeconds
+	}
+	if svc.backoffMultiplier < 1 {
+		svc.backoffMultiplier = defaultBackoffMultiplier
+	}
+	return svc
+}
+
+// IsEnabled reports whether account lockout is enabled.
+func (s *lockoutService) IsEnabled() bool {
+	return s.enabled
+}
+
+// CheckLocked reports whether the user, or the client IP address of the request, is locked out.
+// Lockouts end on their own once the lockout duration has passed.
+func (s *lockoutService) CheckLocked(ctx context.Context, userID string) (*LockStatus, *tidcommon.ServiceError) {
+	if !s.enabled {
+		return &LockStatus{}, nil
+	}
+
+	now := s.now().Unix()
+	for _, key := range s.counterKeys(ctx, userID) {
+		state, err := s.store.getState(ctx, key)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to read lockout counter", log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		if state != nil && state.LockedUntil > now {
+			return &LockStatus{Locked: true, LockedUntil: time.Unix(state.LockedUntil, 0)}, nil
+		}
+	}
+	return &LockStatus{}, nil
+}
+
+// RecordFailure records a failed attempt for the user and the client IP address of the request.
+// Reaching the threshold within the failure window locks the counter for the lockout duration, which
+// grows by the back-off multiplier with each consecutive lockout up to the maximum duration.
+func (s *lockoutService) RecordFailure(ctx context.Context, userID string) (*LockStatus, *tidcommon.ServiceError) {
+	status := &LockStatus{}
+	if !s.enabled {
+		return status, nil
+	}
+
+	now := s.now().Unix()
+	for _, key := range s.counterKeys(ctx, userID) {
+		threshold := s.maxFailures
+		if strings.HasPrefix(key, ipKeyPrefix) {
+			threshold = s.ipMaxFailures
+		}
+		state, newlyLocked, err := s.recordKeyFailure(ctx, key, threshold, now)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to update lockout counter", log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		if newlyLocked {
+			s.logger.Debug(ctx, "Lockout threshold reached", log.Int("lockCount", state.LockCount))
+			s.publishLockEvent(ctx, event.EventTypeAccountLocked, userID, state)
+		}
+
+		if state.LockedUntil > now && !status.Locked {
+			status.Locked = true
+			status.LockedUntil = time.Unix(state.LockedUntil, 0)
+		}
+	}
+	return status, nil
+}
+
+// RecordSuccess clears the failed attempts and the lockout history of the user. The counter of the
+// client IP address is kept so that one valid account cannot reset throttling for the address.
+func (s *lockoutService) RecordSuccess(ctx context.Context, userID string) *tidcommon.ServiceError {
+	if !s.enabled || userID == "" {
+		return nil
+	}
+
+	if err := s.store.deleteState(ctx, userKeyPrefix+userID); err != nil {
+		s.logger.Error(ctx, "Failed to clear lockout counter", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
+// Unlock lifts the lockout of the user and clears its failed attempts, including those of the
+// authentication factors.
+func (s *lockoutService) Unlock(ctx context.Context, userID string) *tidcommon.ServiceError {
+	if userID == "" {
+		return nil
+	}
+	for _, factor := range lockoutFactors {
+		if err := s.store.deleteState(ctx, factorKey(factor, userID)); err != nil {
+			s.logger.Error(ctx, "Failed to clear lockout counter", log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+	}
+
+	key := userKeyPrefix + userID
+	state, err := s.store.getState(ctx, key)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to read lockout counter", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if state == nil {
+		return nil
+	}
+	if err := s.store.deleteState(ctx, key); err != nil {
+		s.logger.Error(ctx, "Failed to clear lockout counter", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	if state.LockedUntil > s.now().Unix() {
+		s.logger.Debug(ctx, "Account unlocked", log.MaskedString(log.LoggerKeyUserID, userID))
+		s.publishLockEvent(ctx, event.EventTypeAccountUnlocked, userID, nil)
+	}
+	return nil
+}
+
+// CheckFactorLocked reports whether the user is locked out of the authentication factor. Factor
+// counters limit the guessing of codes across flow executions, so they apply even when account
+// lockout is disabled.
+func (s *lockoutService) CheckFactorLocked(ctx context.Context, factor, userID string) (
+	*LockStatus, *tidcommon.ServiceError) {
+	if userID == "" {
+		return &LockStatus{}, nil
+	}
+
+	state, err := s.store.getState(ctx, factorKey(factor, userID))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to read lockout counter", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if state != nil && state.LockedUntil > s.now().Unix() {
+		return &LockStatus{Locked: true, LockedUntil: time.Unix(state.LockedUntil, 0)}, nil
+	}
+	return &LockStatus{}, nil
+}
+
+// RecordFactorFailure records a failed attempt of the authentication factor for the user. Reaching
+// maxFailures within the failure window locks the user out of the factor, with the same durations and
+// back-off as account lockout. The counter is separate from the one of the user, so a successful
+// password does not clear it.
+func (s *lockoutService) RecordFactorFailure(ctx context.Context, factor, userID string, maxFailures int) (
+	*LockStatus, *tidcommon.ServiceError) {
+	if userID == "" {
+		return &LockStatus{}, nil
+	}
+	if maxFailures <= 0 {
+		maxFailures = s.maxFailures
+	}
+
+	now := s.now().Unix()
+	state, newlyLocked, err := s.recordKeyFailure(ctx, factorKey(factor, userID), maxFailures, now)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to update lockout counter", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if newlyLocked {
+		s.logger.Debug(ctx, "Factor lockout threshold reached", log.String("factor", factor),
+			log.Int("lockCount", state.LockCount))
+	}
+	if state.LockedUntil > now {
+		return &LockStatus{Locked: true, LockedUntil: time.Unix(state.LockedUntil, 0)}, nil
+	}
+	return &LockStatus{}, nil
+}
+
+// RecordFactorSuccess clears the failed attempts and the lockout history of the authentication factor
+// for the user.
+func (s *lockoutService) RecordFactorSuccess(ctx context.Context, factor, userID string) *tidcommon.ServiceError {
+	if userID == "" {
+		return nil
+	}
+
+	if err := s.store.deleteState(ctx, factorKey(factor, userID)); err != nil {
+		s.logger.Error(ctx, "Failed to clear lockout counter", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
+// factorKey returns the counter key of the user for the authentication factor.
+func factorKey(factor, userID string) string {
+	return factorKeyPrefix + factor + ":" + userID
+}
+
+// counterKeys returns the counter keys for the user and the client IP address of the request.
+func (s *lockoutService) counterKeys(ctx context.Context, userID string) []string {
+	keys := make([]string, 0, 2)
+	if userID != "" {
+		keys = append(keys, userKeyPrefix+userID)
+	}
+	if clientIP := syscontext.GetClientIP(ctx); clientIP != "" {
+		keys = append(keys, ipKeyPrefix+clientIP)
+	}
+	return keys
+}
+
+// recordKeyFailure adds a failed attempt to a single counter. The counter is read, updated and written
+// back only if no concurrent attempt changed it in between; a lost race is retried on the fresh state,
+// so concurrent failures are never lost. Failures are also counted while the counter is locked, so that
+// reaching the threshold again extends the lockout. Returns the resulting state and whether the attempt
+// locked the counter.
+func (s *lockoutService) recordKeyFailure(ctx context.Context, key string, threshold int, now int64) (
+	*counterState, bool, error) {
+	for range maxUpdateAttempts {
+		current, err := s.store.getState(ctx, key)
+		if err != nil {
+			return nil, false, err
+		}
+		next := &counterState{Revision: "1"}
+		if current != nil {
+			*next = *current
+			next.Revision = nextRevision(current.Revision)
+		}
+		newlyLocked := s.registerFailure(next, threshold, now)
+
+		var stored bool
+		if current == nil {
+			stored, err = s.store.createState(ctx, key, next, s.ttl(next, now))
+		} else {
+			stored, err = s.store.swapState(ctx, key, current.Revision, next)
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		if !stored {
+			continue
+		}
+		// The swap keeps the TTL of the window; a lockout must outlive it.
+		if newlyLocked && current != nil {
+			if err := s.store.extendTTL(ctx, key, s.ttl(next, now)); err != nil {
+				return nil, false, err
+			}
+		}
+		return next, newlyLocked, nil
+	}
+	return nil, false, errUpdateContention
+}
+
+// nextRevision returns the revision that follows the given counter revision.
+func nextRevision(revision string) string {
+	n, _ := strconv.ParseInt(revision, 10, 64)
+	return strconv.FormatInt(n+1, 10)
+}
+
+// registerFailure adds a failed attempt to the counter state and locks it once the threshold is
+// reached. A counter that is already locked is locked again for the next, longer duration.
+// Returns true when the attempt locked the counter.
+func (s *lockoutService) registerFailure(state *counterState, threshold int, now int64) bool {
+	if state.WindowStart == 0 || now-state.WindowStart >= s.windowSeconds {
+		state.Failures = 0
+		state.WindowStart = now
+	}
+	state.Failures++
+	if state.Failures < threshold {
+		return false
+	}
+
+	state.LockCount++
+	state.LockedUntil = max(state.LockedUntil, now+s.lockDuration(state.LockCount))
+	state.Failures = 0
+	state.WindowStart = 0
+	return true
+}
+
+// lockDuration returns the duration of the given consecutive lockout in seconds.
+func (s *lockoutService) lockDuration(lockCount int) int64 {
+	duration := float64(s.baseLockSeconds) * math.Pow(s.backoffMultiplier, float64(lockCount-1))
+	if duration > float64(s.maxLockSeconds) {
+		return s.maxLockSeconds
+	}
+	return int64(duration)
+}
+
+// ttl returns how long the counter state must be kept. The lockout history outlives the lockout by
+// the maximum lockout duration so that repeated lockouts back off.
+func (s *lockoutService) ttl(state *counterState, now int64) int64 {
+	if state.LockCount == 0 {
+		return s.windowSeconds
+	}
+	remaining := state.LockedUntil - now
+	if remaining < 0 {
+		remaining = 0
+	}
+	return max(remaining+s.maxLockSeconds, s.windowSeconds)
+}
+
+// publishLockEvent publishes an account lock or unlock event.
+func (s *lockoutService) publishLockEvent(ctx context.Context, eventType providers.EventType,
+	userID string, state *counterState) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(syscontext.GetTraceID(ctx), string(eventType), event.ComponentAuthHandler).
+		WithStatus(providers.StatusSuccess)
+	if userID != "" {
+		evt.WithData(event.DataKey.UserID, userID)
+	}
+	if clientIP := syscontext.GetClientIP(ctx); clientIP != "" {
+		evt.WithData(event.DataKey.ClientIP, clientIP)
+	}
+	if state != nil {
+		evt.WithData(event.DataKey.LockedUntil, time.Unix(state.LockedUntil, 0).UTC().Format(time.RFC3339))
+		evt.WithData(event.DataKey.LockCount, strconv.Itoa(state.LockCount))
+	}
+
+	s.observabilitySvc.PublishEvent(ctx, evt)
+}
@@ -0,0 +1,435 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lockout
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/tests/mocks/observabilityprovidermock"
+)
+
+const (
+	testUserID   = "user123"
+	testClientIP = "192.0.2.10"
+	testUserKey  = userKeyPrefix + testUserID
+	testIPKey    = ipKeyPrefix + testClientIP
+	testTOTPKey  = factorKeyPrefix + FactorTOTP + ":" + testUserID
+)
+
+var testTime = time.Unix(1700000000, 0)
+
+type LockoutServiceTestSuite struct {
+	suite.Suite
+	mockStore         *lockoutStoreInterfaceMock
+	mockObservability *observabilityprovidermock.ObservabilityProviderMock
+	service           *lockoutService
+}
+
+func TestLockoutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LockoutServiceTestSuite))
+}
+
+func (suite *LockoutServiceTestSuite) SetupTest() {
+	suite.mockStore = newLockoutStoreInterfaceMock(suite.T())
+	suite.mockObservability = observabilityprovidermock.NewObservabilityProviderMock(suite.T())
+
+	svc := newLockoutService(suite.mockStore, suite.mockObservability, config.AccountLockoutConfig{
+		Enabled:                   true,
+		MaxFailedAttempts:         3,
+		IPMaxFailedAttempts:       10,
+		FailureWindowSeconds:      600,
+		LockoutDurationSeconds:    60,
+		MaxLockoutDurationSeconds: 300,
+		BackoffMultiplier:         2,
+	}).(*lockoutService)
+	svc.now = func() time.Time { return testTime }
+	suite.service = svc
+}
+
+func (suite *LockoutServiceTestSuite) TestNewLockoutService_Defaults() {
+	svc := newLockoutService(suite.mockStore, nil, config.AccountLockoutConfig{}).(*lockoutService)
+
+	suite.False(svc.IsEnabled())
+	suite.Equal(defaultMaxFailedAttempts, svc.maxFailures)
+	suite.Equal(defaultIPMaxFailedAttempts, svc.ipMaxFailures)
+	suite.Equal(int64(defaultFailureWindowSeconds), svc.windowSeconds)
+	suite.Equal(int64(defaultLockoutDurationSeconds), svc.baseLockSeconds)
+	suite.Equal(int64(defaultMaxLockoutDurationSeconds), svc.maxLockSeconds)
+	suite.Equal(float64(defaultBackoffMultiplier), svc.backoffMultiplier)
+}
+
+func (suite *LockoutServiceTestSuite) TestDisabled_NoStoreAccess() {
+	suite.service.enabled = false
+
+	status, svcErr := suite.service.CheckLocked(context.Background(), testUserID)
+	suite.Nil(svcErr)
+	suite.False(status.Locked)
+
+	status, svcErr = suite.service.RecordFailure(context.Background(), testUserID)
+	suite.Nil(svcErr)
+	suite.False(status.Locked)
+
+	suite.Nil(suite.service.RecordSuccess(context.Background(), testUserID))
+}
+
+func (suite *LockoutServiceTestSuite) TestCheckLocked_NotLocked() {
+	ctx := syscontext.WithClientIP(context.Background(), testClientIP)
+	suite.mockStore.On("getState", ctx, testUserKey).Return(nil, nil)
+	suite.mockStore.On("getState", ctx, testIPKey).Return(&counterState{Failures: 2}, nil)
+
+	status, svcErr := suite.service.CheckLocked(ctx, testUserID)
+
+	suite.Nil(svcErr)
+	suite.False(status.Locked)
+}
+
+func (suite *LockoutServiceTestSuite) TestCheckLocked_UserLocked() {
+	lockedUntil := testTime.Unix() + 30
+	suite.mockStore.On("getState", mock.Anything, testUserKey).
+		Return(&counterState{LockCount: 1, LockedUntil: lockedUntil}, nil)
+
+	status, svcErr := suite.service.CheckLocked(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(status.Locked)
+	suite.Equal(lockedUntil, status.LockedUntil.Unix())
+}
+
+func (suite *LockoutServiceTestSuite) TestCheckLocked_IPLocked() {
+	ctx := syscontext.WithClientIP(context.Background(), testClientIP)
+	suite.mockStore.On("getState", ctx, testUserKey).Return(nil, nil)
+	suite.mockStore.On("getState", ctx, testIPKey).
+		Return(&counterState{LockCount: 1, LockedUntil: testTime.Unix() + 30}, nil)
+
+	status, svcErr := suite.service.CheckLocked(ctx, testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(status.Locked)
+}
+
+func (suite *LockoutServiceTestSuite) TestCheckLocked_ExpiredLockAutoUnlocks() {
+	suite.mockStore.On("getState", mock.Anything, testUserKey).
+		Return(&counterState{LockCount: 1, LockedUntil: testTime.Unix() - 1}, nil)
+
+	status, svcErr := suite.service.CheckLocked(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.False(status.Locked)
+}
+
+func (suite *LockoutServiceTestSuite) TestCheckLocked_StoreError() {
+	suite.mockStore.On("getState", mock.Anything, testUserKey).Return(nil, errors.New("store error"))
+
+	status, svcErr := suite.service.CheckLocked(context.Background(), testUserID)
+
+	suite.Nil(status)
+	suite.NotNil(svcErr)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFailure_BelowThreshold() {
+	suite.mockStore.On("getState", mock.Anything, testUserKey).Return(nil, nil)
+	suite.mockStore.On("createState", mock.Anything, testUserKey, &counterState{
+		Revision: "1", Failures: 1, WindowStart: testTime.Unix(),
+	}, int64(600)).Return(true, nil)
+
+	status, svcErr := suite.service.RecordFailure(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.False(status.Locked)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFailure_WindowExpiredResetsCount() {
+	suite.mockStore.On("getState", mock.Anything, testUserKey).
+		Return(&counterState{Revision: "4", Failures: 2, WindowStart: testTime.Unix() - 601}, nil)
+	suite.mockStore.On("swapState", mock.Anything, testUserKey, "4", &counterState{
+		Revision: "5", Failures: 1, WindowStart: testTime.Unix(),
+	}).Return(true, nil)
+
+	status, svcErr := suite.service.RecordFailure(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.False(status.Locked)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFailure_ThresholdLocksAndPublishesEvent() {
+	suite.mockStore.On("getState", mock.Anything, testUserKey).
+		Return(&counterState{Revision: "2", Failures: 2, WindowStart: testTime.Unix() - 10}, nil)
+	suite.mockStore.On("swapState", mock.Anything, testUserKey, "2", &counterState{
+		Revision: "3", LockCount: 1, LockedUntil: testTime.Unix() + 60,
+	}).Return(true, nil)
+	suite.mockStore.On("extendTTL", mock.Anything, testUserKey, int64(600)).Return(nil)
+	suite.mockObservability.On("IsEnabled").Return(true)
+	suite.mockObservability.On("PublishEvent", mock.Anything, mock.MatchedBy(func(evt *providers.Event) bool {
+		return evt.Type == string(event.EventTypeAccountLocked) &&
+			evt.Data[event.DataKey.UserID] == testUserID &&
+			evt.Data[event.DataKey.LockCount] == "1"
+	})).Return()
+
+	status, svcErr := suite.service.RecordFailure(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(status.Locked)
+	suite.Equal(testTime.Unix()+60, status.LockedUntil.Unix())
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFailure_ExponentialBackoffIsCapped() {
+	suite.mockStore.On("getState", mock.Anything, testUserKey).
+		Return(&counterState{Revision: "7", Failures: 2, WindowStart: testTime.Unix(), LockCount: 2}, nil)
+	// The third lockout would last 60 * 2^2 = 240 seconds.
+	suite.mockStore.On("swapState", mock.Anything, testUserKey, "7", &counterState{
+		Revision: "8", LockCount: 3, LockedUntil: testTime.Unix() + 240,
+	}).Return(true, nil)
+	suite.mockStore.On("extendTTL", mock.Anything, testUserKey, int64(600)).Return(nil)
+	suite.mockObservability.On("IsEnabled").Return(false)
+
+	status, svcErr := suite.service.RecordFailure(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(status.Locked)
+
+	suite.Equal(int64(60), suite.service.lockDuration(1))
+	suite.Equal(int64(120), suite.service.lockDuration(2))
+	suite.Equal(int64(300), suite.service.lockDuration(4))
+	suite.Equal(int64(300), suite.service.lockDuration(10))
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFailure_CountsWhileLocked() {
+	lockedUntil := testTime.Unix() + 30
+	suite.mockStore.On("getState", mock.Anything, testUserKey).
+		Return(&counterState{Revision: "4", LockCount: 1, LockedUntil: lockedUntil}, nil)
+	suite.mockStore.On("swapState", mock.Anything, testUserKey, "4", &counterState{
+		Revision: "5", Failures: 1, WindowStart: testTime.Unix(), LockCount: 1, LockedUntil: lockedUntil,
+	}).Return(true, nil)
+
+	status, svcErr := suite.service.RecordFailure(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(status.Locked)
+	suite.Equal(lockedUntil, status.LockedUntil.Unix())
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFailure_ThresholdWhileLockedExtendsLockout() {
+	suite.mockStore.On("getState", mock.Anything, testUserKey).Return(&counterState{
+		Revision: "6", Failures: 2, WindowStart: testTime.Unix() - 10, LockCount: 1,
+		LockedUntil: testTime.Unix() + 30,
+	}, nil)
+	// The second lockout lasts 60 * 2 = 120 seconds from now.
+	suite.mockStore.On("swapState", mock.Anything, testUserKey, "6", &counterState{
+		Revision: "7", LockCount: 2, LockedUntil: testTime.Unix() + 120,
+	}).Return(true, nil)
+	suite.mockStore.On("extendTTL", mock.Anything, testUserKey, int64(600)).Return(nil)
+	suite.mockObservability.On("IsEnabled").Return(false)
+
+	status, svcErr := suite.service.RecordFailure(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(status.Locked)
+	suite.Equal(testTime.Unix()+120, status.LockedUntil.Unix())
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFailure_IPThreshold() {
+	ctx := syscontext.WithClientIP(context.Background(), testClientIP)
+	suite.mockStore.On("getState", ctx, testUserKey).Return(nil, nil)
+	suite.mockStore.On("createState", ctx, testUserKey, mock.Anything, int64(600)).Return(true, nil)
+	suite.mockStore.On("getState", ctx, testIPKey).
+		Return(&counterState{Revision: "9", Failures: 9, WindowStart: testTime.Unix()}, nil)
+	suite.mockStore.On("swapState", ctx, testIPKey, "9", &counterState{
+		Revision: "10", LockCount: 1, LockedUntil: testTime.Unix() + 60,
+	}).Return(true, nil)
+	suite.mockStore.On("extendTTL", ctx, testIPKey, int64(600)).Return(nil)
+	suite.mockObservability.On("IsEnabled").Return(true)
+	suite.mockObservability.On("PublishEvent", ctx, mock.MatchedBy(func(evt *providers.Event) bool {
+		return evt.Data[event.DataKey.ClientIP] == testClientIP
+	})).Return()
+
+	status, svcErr := suite.service.RecordFailure(ctx, testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(status.Locked)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFailure_StoreError() {
+	suite.mockStore.On("getState", mock.Anything, testUserKey).Return(nil, nil)
+	suite.mockStore.On("createState", mock.Anything, testUserKey, mock.Anything, mock.Anything).
+		Return(false, errors.New("store error"))
+
+	status, svcErr := suite.service.RecordFailure(context.Background(), testUserID)
+
+	suite.Nil(status)
+	suite.NotNil(svcErr)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFailure_RetriesLostRace() {
+	suite.mockStore.On("getState", mock.Anything, testUserKey).Return(nil, nil).Once()
+	suite.mockStore.On("createState", mock.Anything, testUserKey, mock.Anything, mock.Anything).
+		Return(false, nil).Once()
+	suite.mockStore.On("getState", mock.Anything, testUserKey).
+		Return(&counterState{Revision: "1", Failures: 1, WindowStart: testTime.Unix()}, nil).Once()
+	suite.mockStore.On("swapState", mock.Anything, testUserKey, "1", &counterState{
+		Revision: "2", Failures: 2, WindowStart: testTime.Unix(),
+	}).Return(true, nil).Once()
+
+	status, svcErr := suite.service.RecordFailure(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.False(status.Locked)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFailure_PersistentContention() {
+	suite.mockStore.On("getState", mock.Anything, testUserKey).
+		Return(&counterState{Revision: "1", Failures: 1, WindowStart: testTime.Unix()}, nil)
+	suite.mockStore.On("swapState", mock.Anything, testUserKey, "1", mock.Anything).Return(false, nil)
+
+	status, svcErr := suite.service.RecordFailure(context.Background(), testUserID)
+
+	suite.Nil(status)
+	suite.NotNil(svcErr)
+	suite.mockStore.AssertNumberOfCalls(suite.T(), "swapState", maxUpdateAttempts)
+}
+
+// TestRecordFailure_ConcurrentFailuresAllCount runs concurrent failures against the in-memory runtime
+// store and checks that none of them is lost.
+func (suite *LockoutServiceTestSuite) TestRecordFailure_ConcurrentFailuresAllCount() {
+	store := newLockoutStore(inmemory.Initialize("test-deployment"))
+	svc := newLockoutService(store, nil, config.AccountLockoutConfig{
+		Enabled: true, MaxFailedAttempts: 100, FailureWindowSeconds: 600,
+	}).(*lockoutService)
+
+	const workers = 8
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			_, svcErr := svc.RecordFailure(context.Background(), testUserID)
+			suite.Nil(svcErr)
+		}()
+	}
+	wg.Wait()
+
+	state, err := store.getState(context.Background(), testUserKey)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(state)
+	suite.Equal(workers, state.Failures)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordSuccess_ClearsUserCounterOnly() {
+	ctx := syscontext.WithClientIP(context.Background(), testClientIP)
+	suite.mockStore.On("deleteState", ctx, testUserKey).Return(nil)
+
+	suite.Nil(suite.service.RecordSuccess(ctx, testUserID))
+	suite.mockStore.AssertNotCalled(suite.T(), "deleteState", ctx, testIPKey)
+}
+
+func (suite *LockoutServiceTestSuite) TestUnlock_LockedUserPublishesEvent() {
+	suite.mockStore.On("deleteState", mock.Anything, testTOTPKey).Return(nil)
+	suite.mockStore.On("getState", mock.Anything, testUserKey).
+		Return(&counterState{LockCount: 1, LockedUntil: testTime.Unix() + 30}, nil)
+	suite.mockStore.On("deleteState", mock.Anything, testUserKey).Return(nil)
+	suite.mockObservability.On("IsEnabled").Return(true)
+	suite.mockObservability.On("PublishEvent", mock.Anything, mock.MatchedBy(func(evt *providers.Event) bool {
+		return evt.Type == string(event.EventTypeAccountUnlocked) &&
+			evt.Data[event.DataKey.UserID] == testUserID
+	})).Return()
+
+	suite.Nil(suite.service.Unlock(context.Background(), testUserID))
+}
+
+func (suite *LockoutServiceTestSuite) TestUnlock_NoCounter() {
+	suite.mockStore.On("deleteState", mock.Anything, testTOTPKey).Return(nil)
+	suite.mockStore.On("getState", mock.Anything, testUserKey).Return(nil, nil)
+
+	suite.Nil(suite.service.Unlock(context.Background(), testUserID))
+}
+
+func (suite *LockoutServiceTestSuite) TestUnlock_StoreError() {
+	suite.mockStore.On("deleteState", mock.Anything, testTOTPKey).Return(nil)
+	suite.mockStore.On("getState", mock.Anything, testUserKey).
+		Return(&counterState{LockCount: 1, LockedUntil: testTime.Unix() + 30}, nil)
+	suite.mockStore.On("deleteState", mock.Anything, testUserKey).Return(errors.New("store error"))
+
+	suite.NotNil(suite.service.Unlock(context.Background(), testUserID))
+}
+
+func (suite *LockoutServiceTestSuite) TestCheckFactorLocked_Locked() {
+	suite.mockStore.On("getState", mock.Anything, testTOTPKey).
+		Return(&counterState{LockCount: 1, LockedUntil: testTime.Unix() + 30}, nil)
+
+	status, svcErr := suite.service.CheckFactorLocked(context.Background(), FactorTOTP, testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(status.Locked)
+	suite.Equal(time.Unix(testTime.Unix()+30, 0), status.LockedUntil)
+}
+
+func (suite *LockoutServiceTestSuite) TestCheckFactorLocked_IgnoresUserLockout() {
+	suite.mockStore.On("getState", mock.Anything, testTOTPKey).Return(nil, nil)
+
+	status, svcErr := suite.service.CheckFactorLocked(context.Background(), FactorTOTP, testUserID)
+
+	suite.Nil(svcErr)
+	suite.False(status.Locked)
+	suite.mockStore.AssertNotCalled(suite.T(), "getState", mock.Anything, testUserKey)
+}
+
+// TestRecordFactorFailure_LocksAtThresholdWhenLockoutDisabled checks that factor counters apply even
+// when account lockout is disabled.
+func (suite *LockoutServiceTestSuite) TestRecordFactorFailure_LocksAtThresholdWhenLockoutDisabled() {
+	suite.service.enabled = false
+	suite.mockStore.On("getState", mock.Anything, testTOTPKey).
+		Return(&counterState{Revision: "4", Failures: 4, WindowStart: testTime.Unix()}, nil)
+	suite.mockStore.On("swapState", mock.Anything, testTOTPKey, "4", &counterState{
+		Revision: "5", LockCount: 1, LockedUntil: testTime.Unix() + 60,
+	}).Return(true, nil)
+	suite.mockStore.On("extendTTL", mock.Anything, testTOTPKey, int64(600)).Return(nil)
+
+	ctx := syscontext.WithClientIP(context.Background(), testClientIP)
+	status, svcErr := suite.service.RecordFactorFailure(ctx, FactorTOTP, testUserID, 5)
+
+	suite.Nil(svcErr)
+	suite.True(status.Locked)
+	suite.mockStore.AssertNotCalled(suite.T(), "getState", mock.Anything, testUserKey)
+	suite.mockStore.AssertNotCalled(suite.T(), "getState", mock.Anything, testIPKey)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFactorFailure_BelowThreshold() {
+	suite.mockStore.On("getState", mock.Anything, testTOTPKey).Return(nil, nil)
+	suite.mockStore.On("createState", mock.Anything, testTOTPKey, &counterState{
+		Revision: "1", Failures: 1, WindowStart: testTime.Unix(),
+	}, int64(600)).Return(true, nil)
+
+	status, svcErr := suite.service.RecordFactorFailure(context.Background(), FactorTOTP, testUserID, 5)
+
+	suite.Nil(svcErr)
+	suite.False(status.Locked)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFactorFailure_StoreError() {
+	suite.mockStore.On("getState", mock.Anything, testTOTPKey).Return(nil, errors.New("store error"))
+
+	status, svcErr := suite.service.RecordFactorFailure(context.Background(), FactorTOTP, testUserID, 5)
+
+	suite.Nil(status)
+	suite.NotNil(svcErr)
+}
+
+func (suite *LockoutServiceTestSuite) TestRecordFactorSuccess_ClearsFactorCounterOnly() {
+	suite.mockStore.On("deleteState", mock.Anything, testTOTPKey).Return(nil)
+
+	suite.Nil(suite.service.RecordFactorSuccess(context.Background(), FactorTOTP, testUserID))
+	suite.mockStore.AssertNotCalled(suite.T(), "deleteState", mock.Anything, testUserKey)
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package lockout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// lockoutStoreInterface defines the interface for failed-attempt counter storage. Counters are
+// updated optimistically: a new counter is created only if absent, and an existing one is replaced
+// only if its revision is unchanged since it was read.
+type lockoutStoreInterface interface {
+	getState(ctx context.Context, key string) (*counterState, error)
+	createState(ctx context.Context, key string, state *counterState, ttlSeconds int64) (bool, error)
+	swapState(ctx context.Context, key, expectedRevision string, state *counterState) (bool, error)
+	extendTTL(ctx context.Context, key string, ttlSeconds int64) error
+	deleteState(ctx context.Context, key string) error
+}
+
+// lockoutStore adapts a runtime store provider to failed-attempt counter storage. Counters are
+// stored under the lockout namespace, keyed by scope and subject, as a serialized counterState.
+type lockoutStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newLockoutStore creates a lockout counter store backed by the given runtime store provider.
+func newLockoutStore(store providers.RuntimeStoreProvider) lockoutStoreInterface {
+	return &lockoutStore{store: store}
+}
+
+// getState retrieves the counter state. Returns (nil, nil) when the counter is absent or expired.
+func (s *lockoutStore) getState(ctx context.Context, key string) (*counterState, error) {
+	data, err := s.store.Get(ctx, providers.NamespaceLockout, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lockout counter: %w", err)
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var state counterState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal lockout counter: %w", err)
+	}
+	return &state, nil
+}
+
+// createState stores the counter state with the given TTL unless a counter already exists. Returns
+// true when the state was stored.
+func (s *lockoutStore) createState(ctx context.Context, key string, state *counterState,
+	ttlSeconds int64) (bool, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal lockout counter: %w", err)
+	}
+
+	return s.store.PutIfNotExists(ctx, providers.NamespaceLockout, key, data, ttlSeconds)
+}
+
+// swapState replaces the counter state, keeping its TTL, only if the stored revision still equals
+// expectedRevision. Returns true when the state was replaced.
+func (s *lockoutStore) swapState(ctx context.Context, key, expectedRevision string,
+	state *counterState) (bool, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal lockout counter: %w", err)
+	}
+
+	return s.store.CompareFieldAndSwap(ctx, providers.NamespaceLockout, key, counterRevisionField,
+		expectedRevision, data)
+}
+
+// extendTTL resets the TTL of the counter state.
+func (s *lockoutStore) extendTTL(ctx context.Context, key string, ttlSeconds int64) error {
+	return s.store.ExtendTTL(ctx, providers.NamespaceLockout, key, ttlSeconds)
+}
+
+// deleteState removes the counter state.
+func (s *lockoutStore) deleteState(ctx context.Context, key string) error {
+	return s.store.Delete(ctx, providers.NamespaceLockout, key)
+}
@@ -206,4 +206,17 @@ var (
 			DefaultValue: "No credentials found for the user. Please register a credential first",
 		},
 	}
+	// ErrorInvalidCredentialName is returned when a passkey display name is empty or too long.
+	ErrorInvalidCredentialName = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSK-1016",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passkeyservice.invalid_credential_name",
+			DefaultValue: "Invalid credential name",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passkeyservice.invalid_credential_name_description",
+			DefaultValue: "The credential name must be non-empty and at most 64 characters long",
+		},
+	}
 )
@@ -3,6 +3,8 @@
 
 package passkey
 
+import "encoding/json"
+
 // AuthenticatorSelection represents criteria for selecting authenticators during registration.
 type AuthenticatorSelection struct {
 	AuthenticatorAttachment string
@@ -100,6 +102,38 @@ type PasskeyFinishRequest struct {
 	Assertion           string
 }
 
+// PasskeyCredential represents a registered passkey as exposed to credential management.
+type PasskeyCredential struct {
+	ID         string
+	Name       string
+	Transports []string
+	CreatedAt  int64
+	LastUsedAt int64
+}
+
+// passkeyMetadata holds the management attributes stored alongside a WebAuthn credential.
+type passkeyMetadata struct {
+	Name       string `json:"name,omitempty"`
+	CreatedAt  int64  `json:"createdAt,omitempty"`
+	LastUsedAt int64  `json:"lastUsedAt,omitempty"`
+}
+
+// storedPasskey is the persisted form of a passkey: the WebAuthn credential with its management
+// metadata flattened into the same JSON object.
+type storedPasskey struct {
+	webauthnCredential
+	passkeyMetadata
+}
+
+// UnmarshalJSON decodes both halves of the stored passkey. It is required because the embedded
+// credential's own UnmarshalJSON would otherwise be promoted and drop the metadata.
+func (s *storedPasskey) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &s.webauthnCredential); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &s.passkeyMetadata)
+}
+
 // webauthnUserInterface defines the interface for WebAuthn user operations.
 type webauthnUserInterface interface {
 	WebAuthnID() []byte
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -27,6 +28,9 @@ const (
 
 	// CredentialType is the credential type key that identifies passkey credentials in the provider chain.
 	CredentialType = authnprovidercm.CredentialTypePasskey
+
+	// maxCredentialNameLength is the maximum length of a passkey display name.
+	maxCredentialNameLength = 64
 )
 
 // PasskeyServiceInterface defines the interface for passkey authentication and registration operations.
@@ -46,6 +50,11 @@ type PasskeyServiceInterface interface {
 	FinishAuthentication(
 		ctx context.Context, req *PasskeyAuthenticationFinishRequest,
 	) (*common.AuthnResult, *tidcommon.ServiceError)
+
+	// Credential management methods
+	ListCredentials(ctx context.Context, userID string) ([]PasskeyCredential, *tidcommon.ServiceError)
+	RenameCredential(ctx context.Context, userID, credentialID, name string) *tidcommon.ServiceError
+	DeleteCredential(ctx context.Context, userID, credentialID string) *tidcommon.ServiceError
 }
 
 // passkeyService is the default implementation of PasskeyServiceInterface.
@@ -503,6 +512,144 @@ func (w *passkeyService) FinishAuthentication(ctx context.Context, req *PasskeyA
 	}, nil
 }
 
+// ListCredentials returns the passkeys registered for a user.
+func (w *passkeyService) ListCredentials(
+	ctx context.Context, userID string,
+) ([]PasskeyCredential, *tidcommon.ServiceError) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, &ErrorEmptyUserIdentifier
+	}
+
+	entries, svcErr := w.getStoredPasskeyEntries(ctx, userID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	credentials := make([]PasskeyCredential, 0, len(entries))
+	for _, stored := range w.decodeStoredPasskeys(ctx, userID, entries) {
+		transports := make([]string, 0, len(stored.Transport))
+		for _, transport := range stored.Transport {
+			transports = append(transports, string(transport))
+		}
+		credentials = append(credentials, PasskeyCredential{
+			ID:         encodeCredentialID(stored.ID),
+			Name:       stored.Name,
+			Transports: transports,
+			CreatedAt:  stored.CreatedAt,
+			LastUsedAt: stored.LastUsedAt,
+		})
+	}
+	return credentials, nil
+}
+
+// RenameCredential sets the display name of one of a user's passkeys.
+func (w *passkeyService) RenameCredential(
+	ctx context.Context, userID, credentialID, name string,
+) *tidcommon.ServiceError {
+	if strings.TrimSpace(userID) == "" {
+		return &ErrorEmptyUserIdentifier
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > maxCredentialNameLength {
+		return &ErrorInvalidCredentialName
+	}
+
+	return w.rewritePasskeyEntries(ctx, userID, credentialID,
+		func(stored *storedPasskey, entry entity.StoredCredential) (*entity.StoredCredential, error) {
+			stored.Name = name
+			value, err := json.Marshal(stored)
+			if err != nil {
+				return nil, err
+			}
+			entry.Value = string(value)
+			return &entry, nil
+		})
+}
+
+// DeleteCredential removes one of a user's passkeys. Removing the last passkey clears the
+// passkey credential type from the user altogether.
+func (w *passkeyService) DeleteCredential(
+	ctx context.Context, userID, credentialID string,
+) *tidcommon.ServiceError {
+	if strings.TrimSpace(userID) == "" {
+		return &ErrorEmptyUserIdentifier
+	}
+
+	return w.rewritePasskeyEntries(ctx, userID, credentialID,
+		func(*storedPasskey, entity.StoredCredential) (*entity.StoredCredential, error) {
+			return nil, nil
+		})
+}
+
+// rewritePasskeyEntries applies the given change to the passkey identified by credentialID and
+// persists the resulting set. The change returns the replacement entry, or nil to drop it.
+func (w *passkeyService) rewritePasskeyEntries(
+	ctx context.Context, userID, credentialID string,
+	change func(stored *storedPasskey, entry entity.StoredCredential) (*entity.StoredCredential, error),
+) *tidcommon.ServiceError {
+	logger := w.logger.With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(credentialID) == "" {
+		return &ErrorEmptyCredentialID
+	}
+
+	entries, svcErr := w.getStoredPasskeyEntries(ctx, userID)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	found := false
+	updatedEntries := make([]entity.StoredCredential, 0, len(entries))
+	for _, entry := range entries {
+		var stored storedPasskey
+		if found || json.Unmarshal([]byte(entry.Value), &stored) != nil ||
+			encodeCredentialID(stored.ID) != credentialID {
+			updatedEntries = append(updatedEntries, entry)
+			continue
+		}
+
+		found = true
+		replacement, err := change(&stored, entry)
+		if err != nil {
+			logger.Error(ctx, "Failed to marshal passkey credential",
+				log.MaskedString("entityID", userID), log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		if replacement != nil {
+			updatedEntries = append(updatedEntries, *replacement)
+		}
+	}
+	if !found {
+		return &ErrorCredentialNotFound
+	}
+
+	if len(updatedEntries) == 0 {
+		if err := w.entityService.RemoveSystemCredentials(ctx, userID, CredentialType); err != nil {
+			logger.Error(ctx, "Failed to remove passkey credentials",
+				log.MaskedString("entityID", userID), log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		return nil
+	}
+
+	payload, err := json.Marshal(map[string][]entity.StoredCredential{
+		CredentialType: updatedEntries,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal passkey credentials", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if err := w.entityService.UpdateSystemCredentials(ctx, userID, payload); err != nil {
+		logger.Error(ctx, "Failed to update passkey credentials",
+			log.MaskedString("entityID", userID), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	logger.Debug(ctx, "Updated passkey credentials",
+		log.MaskedString("entityID", userID), log.String("credentialID", credentialID))
+	return nil
+}
+
 // getEntity retrieves an entity by ID, mapping entity-layer errors to passkey service errors.
 func (w *passkeyService) getEntity(
 	ctx context.Context, entityID string,
@@ -565,13 +712,37 @@ func (w *passkeyService) decodePasskeyCredentials(ctx context.Context,
 	return credentials
 }
 
+// decodeStoredPasskeys converts stored passkey entries into passkeys with their management
+// metadata, skipping any entries with empty or malformed values.
+func (w *passkeyService) decodeStoredPasskeys(ctx context.Context,
+	entityID string, entries []entity.StoredCredential,
+) []storedPasskey {
+	logger := w.logger.With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	passkeys := make([]storedPasskey, 0, len(entries))
+	for _, entry := range entries {
+		var stored storedPasskey
+		if err := json.Unmarshal([]byte(entry.Value), &stored); err != nil {
+			logger.Error(ctx, "Failed to unmarshal passkey credential",
+				log.MaskedString("entityID", entityID),
+				log.Error(err))
+			continue
+		}
+		passkeys = append(passkeys, stored)
+	}
+	return passkeys
+}
+
 // storePasskeyCredential appends a new passkey credential to the entity's stored set.
 func (w *passkeyService) storePasskeyCredential(
 	ctx context.Context, entityID string, credential *webauthnCredential,
 ) error {
 	logger := w.logger.With(log.String(log.LoggerKeyComponentName, loggerComponentName))
 
-	credentialJSON, err := json.Marshal(credential)
+	credentialJSON, err := json.Marshal(storedPasskey{
+		webauthnCredential: *credential,
+		passkeyMetadata:    passkeyMetadata{CreatedAt: time.Now().Unix()},
+	})
 	if err != nil {
 		logger.Error(ctx, "Failed to marshal credential",
 			log.MaskedString("entityID", entityID),
@@ -608,8 +779,9 @@ func (w *passkeyService) storePasskeyCredential(
 	return nil
 }
 
-// updatePasskeyCredential updates an existing passkey credential, preserving the storage
-// metadata (StorageAlgo, StorageAlgoParams) of the original entry.
+// updatePasskeyCredential updates an existing passkey credential after a successful authentication,
+// preserving the storage metadata (StorageAlgo, StorageAlgoParams) and the passkey name of the
+// original entry and recording the time of use.
 func (w *passkeyService) updatePasskeyCredential(
 	ctx context.Context, entityID string, updatedCredential *webauthnCredential,
 ) error {
@@ -624,8 +796,8 @@ func (w *passkeyService) updatePasskeyCredential(
 	updatedEntries := make([]entity.StoredCredential, 0, len(existingEntries))
 
 	for _, entry := range existingEntries {
-		var credential webauthnCredential
-		if err := json.Unmarshal([]byte(entry.Value), &credential); err != nil {
+		var stored storedPasskey
+		if err := json.Unmarshal([]byte(entry.Value), &stored); err != nil {
 			logger.Warn(ctx, "Failed to unmarshal credential, keeping original",
 				log.MaskedString("entityID", entityID),
 				log.Error(err))
@@ -633,8 +805,13 @@ func (w *passkeyService) updatePasskeyCredential(
 			continue
 		}
 
-		if string(credential.ID) == string(updatedCredential.ID) {
-			credentialJSON, marshalErr := json.Marshal(updatedCredential)
+		if string(stored.ID) == string(updatedCredential.ID) {
+			metadata := stored.passkeyMetadata
+			metadata.LastUsedAt = time.Now().Unix()
+			credentialJSON, marshalErr := json.Marshal(storedPasskey{
+				webauthnCredential: *updatedCredential,
+				passkeyMetadata:    metadata,
+			})
 			if marshalErr != nil {
 				logger.Error(ctx, "Failed to marshal updated credential",
 					log.MaskedString("entityID", entityID),
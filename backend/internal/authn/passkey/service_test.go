@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
@@ -1665,3 +1666,152 @@ func (suite *WebAuthnServiceTestSuite) TestFinishAuthentication_WebAuthnInitErro
 	suite.NotNil(svcErr)
 	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
 }
+
+func (suite *WebAuthnServiceTestSuite) storedPasskeyEntry(id, name string) entity.StoredCredential {
+	value, _ := json.Marshal(storedPasskey{
+		webauthnCredential: webauthnCredential{ID: []byte(id), PublicKey: []byte("pk-" + id)},
+		passkeyMetadata:    passkeyMetadata{Name: name, CreatedAt: 100},
+	})
+	return entity.StoredCredential{Value: string(value)}
+}
+
+func (suite *WebAuthnServiceTestSuite) TestUpdateWebAuthnCredentialInDB_PreservesMetadata() {
+	entry := suite.storedPasskeyEntry("credential123", "Laptop")
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, "passkey").
+		Return([]entity.StoredCredential{entry}, nil).Once()
+
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID, mock.MatchedBy(
+		func(credentialsJSON json.RawMessage) bool {
+			var credMap map[string][]entity.StoredCredential
+			if err := json.Unmarshal(credentialsJSON, &credMap); err != nil || len(credMap["passkey"]) != 1 {
+				return false
+			}
+			var stored storedPasskey
+			_ = json.Unmarshal([]byte(credMap["passkey"][0].Value), &stored)
+			return stored.Name == "Laptop" && stored.CreatedAt == 100 && stored.LastUsedAt > 0 &&
+				stored.Authenticator.SignCount == 7
+		})).Return(nil).Once()
+
+	err := suite.service.updatePasskeyCredential(context.Background(), testUserID, &webauthnCredential{
+		ID:            []byte("credential123"),
+		Authenticator: authenticator{SignCount: 7},
+	})
+
+	suite.NoError(err)
+}
+
+func (suite *WebAuthnServiceTestSuite) TestListCredentials_Success() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, "passkey").
+		Return([]entity.StoredCredential{
+			suite.storedPasskeyEntry("cred-1", "Laptop"),
+			{Value: "{invalid}"},
+		}, nil).Once()
+
+	credentials, svcErr := suite.service.ListCredentials(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Len(credentials, 1)
+	suite.Equal(base64.RawURLEncoding.EncodeToString([]byte("cred-1")), credentials[0].ID)
+	suite.Equal("Laptop", credentials[0].Name)
+	suite.Equal(int64(100), credentials[0].CreatedAt)
+}
+
+func (suite *WebAuthnServiceTestSuite) TestListCredentials_UserNotFound() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, "passkey").
+		Return(nil, entity.ErrEntityNotFound).Once()
+
+	credentials, svcErr := suite.service.ListCredentials(context.Background(), testUserID)
+
+	suite.Nil(credentials)
+	suite.Equal(ErrorUserNotFound.Code, svcErr.Code)
+}
+
+func (suite *WebAuthnServiceTestSuite) TestRenameCredential_Success() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, "passkey").
+		Return([]entity.StoredCredential{
+			suite.storedPasskeyEntry("cred-1", "Laptop"),
+			suite.storedPasskeyEntry("cred-2", "Phone"),
+		}, nil).Once()
+
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID, mock.MatchedBy(
+		func(credentialsJSON json.RawMessage) bool {
+			var credMap map[string][]entity.StoredCredential
+			if err := json.Unmarshal(credentialsJSON, &credMap); err != nil || len(credMap["passkey"]) != 2 {
+				return false
+			}
+			var first, second storedPasskey
+			_ = json.Unmarshal([]byte(credMap["passkey"][0].Value), &first)
+			_ = json.Unmarshal([]byte(credMap["passkey"][1].Value), &second)
+			return first.Name == "Laptop" && second.Name == "Security key" &&
+				string(second.PublicKey) == "pk-cred-2"
+		})).Return(nil).Once()
+
+	svcErr := suite.service.RenameCredential(context.Background(), testUserID,
+		base64.RawURLEncoding.EncodeToString([]byte("cred-2")), "  Security key ")
+
+	suite.Nil(svcErr)
+}
+
+func (suite *WebAuthnServiceTestSuite) TestRenameCredential_InvalidName() {
+	for _, name := range []string{"", "   ", strings.Repeat("a", maxCredentialNameLength+1)} {
+		svcErr := suite.service.RenameCredential(context.Background(), testUserID, "cred", name)
+		suite.Equal(ErrorInvalidCredentialName.Code, svcErr.Code)
+	}
+}
+
+func (suite *WebAuthnServiceTestSuite) TestRenameCredential_NotFound() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, "passkey").
+		Return([]entity.StoredCredential{suite.storedPasskeyEntry("cred-1", "Laptop")}, nil).Once()
+
+	svcErr := suite.service.RenameCredential(context.Background(), testUserID, "unknown", "Phone")
+
+	suite.Equal(ErrorCredentialNotFound.Code, svcErr.Code)
+}
+
+func (suite *WebAuthnServiceTestSuite) TestDeleteCredential_KeepsRemaining() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, "passkey").
+		Return([]entity.StoredCredential{
+			suite.storedPasskeyEntry("cred-1", "Laptop"),
+			suite.storedPasskeyEntry("cred-2", "Phone"),
+		}, nil).Once()
+
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID, mock.MatchedBy(
+		func(credentialsJSON json.RawMessage) bool {
+			var credMap map[string][]entity.StoredCredential
+			if err := json.Unmarshal(credentialsJSON, &credMap); err != nil || len(credMap["passkey"]) != 1 {
+				return false
+			}
+			var stored storedPasskey
+			_ = json.Unmarshal([]byte(credMap["passkey"][0].Value), &stored)
+			return string(stored.ID) == "cred-2"
+		})).Return(nil).Once()
+
+	svcErr := suite.service.DeleteCredential(context.Background(), testUserID,
+		base64.RawURLEncoding.EncodeToString([]byte("cred-1")))
+
+	suite.Nil(svcErr)
+}
+
+func (suite *WebAuthnServiceTestSuite) TestDeleteCredential_LastCredentialRemovesType() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, "passkey").
+		Return([]entity.StoredCredential{suite.storedPasskeyEntry("cred-1", "Laptop")}, nil).Once()
+	suite.mockEntityService.On("RemoveSystemCredentials", mock.Anything, testUserID, "passkey").
+		Return(nil).Once()
+
+	svcErr := suite.service.DeleteCredential(context.Background(), testUserID,
+		base64.RawURLEncoding.EncodeToString([]byte("cred-1")))
+
+	suite.Nil(svcErr)
+}
+
+func (suite *WebAuthnServiceTestSuite) TestDeleteCredential_StoreError() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, "passkey").
+		Return([]entity.StoredCredential{suite.storedPasskeyEntry("cred-1", "Laptop")}, nil).Once()
+	suite.mockEntityService.On("RemoveSystemCredentials", mock.Anything, testUserID, "passkey").
+		Return(assert.AnError).Once()
+
+	svcErr := suite.service.DeleteCredential(context.Background(), testUserID,
+		base64.RawURLEncoding.EncodeToString([]byte("cred-1")))
+
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
@@ -136,6 +136,11 @@ func decodeBase64(s string) ([]byte, error) {
 	return base64.StdEncoding.DecodeString(s)
 }
 
+// encodeCredentialID encodes a raw credential ID in the unpadded base64url form WebAuthn clients use.
+func encodeCredentialID(id []byte) string {
+	return base64.RawURLEncoding.EncodeToString(id)
+}
+
 // validateRegistrationStartRequest validates the registration start request.
 func validateRegistrationStartRequest(req *PasskeyRegistrationStartRequest) *tidcommon.ServiceError {
 	if strings.TrimSpace(req.UserID) == "" {
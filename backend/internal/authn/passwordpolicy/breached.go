@@ -0,0 +1,214 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec // SHA-1 is the hash of the breached-password corpora and range APIs.
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+const (
+	// breachedSourceFile reads the breached-password corpus from a local file.
+	breachedSourceFile = "file"
+	// breachedSourceRangeAPI queries the breached-password corpus through an HTTP range API.
+	breachedSourceRangeAPI = "range_api"
+
+	// hashPrefixLength is the number of leading hex characters of the SHA-1 hash sent to the range API.
+	hashPrefixLength = 5
+
+	// hashPrefixBuckets is the number of distinct five-character hash prefixes, which index the
+	// buckets of a breached-password file.
+	hashPrefixBuckets = 1 << (hashPrefixLength * 4)
+
+	defaultBreachedTimeoutSeconds = 5
+	defaultBreachedMaxFileSizeMB  = 512
+)
+
+// breachedPasswordCheckerInterface looks a password up in a breached-password corpus.
+type breachedPasswordCheckerInterface interface {
+	isBreached(ctx context.Context, password string) (bool, error)
+}
+
+// newBreachedPasswordChecker creates the breached-password checker of the configured source.
+func newBreachedPasswordChecker(cfg config.BreachedPasswordConfig) (breachedPasswordCheckerInterface, error) {
+	switch cfg.Source {
+	case breachedSourceFile:
+		if cfg.FilePath == "" {
+			return nil, errors.New("breached password file path is not configured")
+		}
+		maxSizeMB := cfg.MaxFileSizeMB
+		if maxSizeMB <= 0 {
+			maxSizeMB = defaultBreachedMaxFileSizeMB
+		}
+		return newFileBreachedChecker(cfg.FilePath, int64(maxSizeMB)<<20)
+	case breachedSourceRangeAPI:
+		if cfg.RangeAPIURL == "" {
+			return nil, errors.New("breached password range API URL is not configured")
+		}
+		timeout := cfg.TimeoutSeconds
+		if timeout <= 0 {
+			timeout = defaultBreachedTimeoutSeconds
+		}
+		return &rangeAPIBreachedChecker{
+			baseURL:    cfg.RangeAPIURL,
+			httpClient: syshttp.NewHTTPClientWithTimeout(time.Duration(timeout) * time.Second),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported breached password source %q", cfg.Source)
+	}
+}
+
+// fileBreachedChecker looks passwords up in a local corpus of SHA-1 hashes, one per line,
+// optionally followed by ":COUNT". The file is loaded at startup into hashes sorted by value, and
+// bucketOffsets indexes them by five-character hash prefix, so a lookup only searches the hashes
+// that share the prefix of the password's hash.
+type fileBreachedChecker struct {
+	hashes        [][sha1.Size]byte
+	bucketOffsets []uint32
+}
+
+// newFileBreachedChecker loads and indexes the corpus file. Files larger than maxSize bytes are
+// rejected rather than loaded into memory.
+func newFileBreachedChecker(filePath string, maxSize int64) (*fileBreachedChecker, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached password file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read breached password file: %w", err)
+	}
+	if info.Size() > maxSize {
+		return nil, fmt.Errorf("breached password file is %d bytes, larger than the %d byte limit",
+			info.Size(), maxSize)
+	}
+
+	var hashes [][sha1.Size]byte
+	scanner := bufio.NewScanner(io.LimitReader(file, maxSize))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		entry, ok := parseHashEntry(scanner.Text())
+		if !ok {
+			continue
+		}
+		var hash [sha1.Size]byte
+		if len(entry) != hex.EncodedLen(sha1.Size) {
+			return nil, fmt.Errorf("breached password file line %d is not a SHA-1 hash", lineNumber)
+		}
+		if _, err := hex.Decode(hash[:], []byte(entry)); err != nil {
+			return nil, fmt.Errorf("breached password file line %d is not a SHA-1 hash", lineNumber)
+		}
+		hashes = append(hashes, hash)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read breached password file: %w", err)
+	}
+
+	slices.SortFunc(hashes, func(a, b [sha1.Size]byte) int { return bytes.Compare(a[:], b[:]) })
+	bucketOffsets := make([]uint32, hashPrefixBuckets+1)
+	for _, hash := range hashes {
+		bucketOffsets[hashBucket(hash)+1]++
+	}
+	for i := 1; i < len(bucketOffsets); i++ {
+		bucketOffsets[i] += bucketOffsets[i-1]
+	}
+	return &fileBreachedChecker{hashes: hashes, bucketOffsets: bucketOffsets}, nil
+}
+
+// isBreached reports whether the SHA-1 hash of the password is listed in the corpus file.
+func (c *fileBreachedChecker) isBreached(_ context.Context, password string) (bool, error) {
+	hash := sha1.Sum([]byte(password)) //nolint:gosec // See the import above.
+	bucket := hashBucket(hash)
+	bucketHashes := c.hashes[c.bucketOffsets[bucket]:c.bucketOffsets[bucket+1]]
+	_, found := slices.BinarySearchFunc(bucketHashes, hash, func(a, b [sha1.Size]byte) int {
+		return bytes.Compare(a[:], b[:])
+	})
+	return found, nil
+}
+
+// hashBucket returns the bucket of a hash: the value of its first five hex characters.
+func hashBucket(hash [sha1.Size]byte) int {
+	return int(hash[0])<<12 | int(hash[1])<<4 | int(hash[2])>>4
+}
+
+// rangeAPIBreachedChecker looks passwords up through a k-anonymity range API. Only the first five
+// characters of the SHA-1 hash leave the server, and the API returns the suffixes of every listed
+// hash that shares the prefix.
+type rangeAPIBreachedChecker struct {
+	baseURL    string
+	httpClient syshttp.HTTPClientInterface
+}
+
+// isBreached reports whether the range API lists the SHA-1 hash of the password.
+func (c *rangeAPIBreachedChecker) isBreached(ctx context.Context, password string) (bool, error) {
+	hash := sha1Hex(password)
+	prefix, suffix := hash[:hashPrefixLength], hash[hashPrefixLength:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+prefix, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create range API request: %w", err)
+	}
+	// Padded responses keep the response size from revealing the prefix.
+	req.Header.Set("Add-Padding", "true")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to query range API: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return false, fmt.Errorf("range API returned status %d", resp.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		entry, count, _ := strings.Cut(line, ":")
+		// Padding entries carry a count of zero.
+		if strings.EqualFold(entry, suffix) && strings.TrimSpace(count) != "0" {
+			return true, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("failed to read range API response: %w", err)
+	}
+	return false, nil
+}
+
+// sha1Hex returns the upper-case hex SHA-1 hash of the password.
+func sha1Hex(password string) string {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // See the import above.
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// parseHashEntry returns the upper-case hash of a corpus line in the "HASH" or "HASH:COUNT" form.
+func parseHashEntry(line string) (string, bool) {
+	entry, _, _ := strings.Cut(strings.TrimSpace(line), ":")
+	if entry == "" {
+		return "", false
+	}
+	return strings.ToUpper(entry), true
+}
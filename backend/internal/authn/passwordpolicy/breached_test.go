@@ -0,0 +1,174 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+// passwordSHA1 is the SHA-1 hash of "password".
+const passwordSHA1 = "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8"
+
+type BreachedCheckerTestSuite struct {
+	suite.Suite
+}
+
+func TestBreachedCheckerTestSuite(t *testing.T) {
+	suite.Run(t, new(BreachedCheckerTestSuite))
+}
+
+func (suite *BreachedCheckerTestSuite) TestNewBreachedPasswordChecker_Validation() {
+	testCases := []struct {
+		name string
+		cfg  config.BreachedPasswordConfig
+	}{
+		{"MissingFilePath", config.BreachedPasswordConfig{Source: breachedSourceFile}},
+		{"MissingRangeAPIURL", config.BreachedPasswordConfig{Source: breachedSourceRangeAPI}},
+		{"UnsupportedSource", config.BreachedPasswordConfig{Source: "ldap"}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := newBreachedPasswordChecker(tc.cfg)
+			suite.Error(err)
+		})
+	}
+}
+
+func (suite *BreachedCheckerTestSuite) TestFileBreachedChecker() {
+	path := filepath.Join(suite.T().TempDir(), "breached.txt")
+	content := "0000000000000000000000000000000000000000:3\n" + strings.ToLower(passwordSHA1) + ":9545824\n"
+	suite.Require().NoError(os.WriteFile(path, []byte(content), 0o600))
+
+	checker, err := newBreachedPasswordChecker(config.BreachedPasswordConfig{
+		Source: breachedSourceFile, FilePath: path,
+	})
+	suite.Require().NoError(err)
+
+	breached, err := checker.isBreached(context.Background(), "password")
+	suite.NoError(err)
+	suite.True(breached)
+
+	breached, err = checker.isBreached(context.Background(), testPassword)
+	suite.NoError(err)
+	suite.False(breached)
+}
+
+func (suite *BreachedCheckerTestSuite) TestFileBreachedChecker_SharedPrefix() {
+	path := filepath.Join(suite.T().TempDir(), "breached.txt")
+	// The first entry shares the five-character prefix of the hash of "password".
+	content := passwordSHA1[:5] + "00000000000000000000000000000000000:1\n" +
+		"FFFFF00000000000000000000000000000000000\n\n" + passwordSHA1 + "\n"
+	suite.Require().NoError(os.WriteFile(path, []byte(content), 0o600))
+
+	checker, err := newFileBreachedChecker(path, 1<<20)
+	suite.Require().NoError(err)
+	suite.Len(checker.hashes, 3)
+
+	breached, err := checker.isBreached(context.Background(), "password")
+	suite.NoError(err)
+	suite.True(breached)
+}
+
+func (suite *BreachedCheckerTestSuite) TestFileBreachedChecker_LoadFailures() {
+	dir := suite.T().TempDir()
+	oversized := filepath.Join(dir, "oversized.txt")
+	suite.Require().NoError(os.WriteFile(oversized, []byte(passwordSHA1+":1\n"+passwordSHA1+":1\n"), 0o600))
+	shortHash := filepath.Join(dir, "short-hash.txt")
+	suite.Require().NoError(os.WriteFile(shortHash, []byte(passwordSHA1[:39]+":1\n"), 0o600))
+	tooLong := filepath.Join(dir, "too-long.txt")
+	suite.Require().NoError(os.WriteFile(tooLong, []byte(passwordSHA1+"00:1\n"), 0o600))
+	notHex := filepath.Join(dir, "not-hex.txt")
+	suite.Require().NoError(os.WriteFile(notHex, []byte(passwordSHA1[:39]+"Z\n"), 0o600))
+
+	testCases := []struct {
+		name     string
+		filePath string
+		maxSize  int64
+	}{
+		{"MissingFile", filepath.Join(dir, "missing.txt"), 1 << 20},
+		{"Oversized", oversized, 60},
+		{"ShortHash", shortHash, 1 << 20},
+		{"LongHash", tooLong, 1 << 20},
+		{"NotHex", notHex, 1 << 20},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := newFileBreachedChecker(tc.filePath, tc.maxSize)
+			suite.Error(err)
+		})
+	}
+}
+
+func (suite *BreachedCheckerTestSuite) rangeAPIResponse(status int, body string) *http.Response {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func (suite *BreachedCheckerTestSuite) TestRangeAPIBreachedChecker() {
+	testCases := []struct {
+		name     string
+		body     string
+		expected bool
+	}{
+		{"Listed", "003D68EB55068C33ACE09247EE4C639306B:3\r\n" + passwordSHA1[5:] + ":9545824\r\n", true},
+		{"Padding", passwordSHA1[5:] + ":0\r\n", false},
+		{"NotListed", "003D68EB55068C33ACE09247EE4C639306B:3\r\n", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			httpClient := httpmock.NewHTTPClientInterfaceMock(suite.T())
+			httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.String() == "https://breach.example.com/range/"+passwordSHA1[:5] &&
+					req.Header.Get("Add-Padding") == "true"
+			})).Return(suite.rangeAPIResponse(http.StatusOK, tc.body), nil).Once()
+			checker := &rangeAPIBreachedChecker{
+				baseURL:    "https://breach.example.com/range/",
+				httpClient: httpClient,
+			}
+
+			breached, err := checker.isBreached(context.Background(), "password")
+
+			suite.NoError(err)
+			suite.Equal(tc.expected, breached)
+		})
+	}
+}
+
+func (suite *BreachedCheckerTestSuite) TestRangeAPIBreachedChecker_Failures() {
+	testCases := []struct {
+		name string
+		resp *http.Response
+		err  error
+	}{
+		{"RequestError", nil, errors.New("connection refused")},
+		{"UnexpectedStatus", suite.rangeAPIResponse(http.StatusTooManyRequests, ""), nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			httpClient := httpmock.NewHTTPClientInterfaceMock(suite.T())
+			httpClient.On("Do", mock.Anything).Return(tc.resp, tc.err).Once()
+			checker := &rangeAPIBreachedChecker{baseURL: "https://breach.example.com/range/", httpClient: httpClient}
+
+			_, err := checker.isBreached(context.Background(), "password")
+
+			suite.Error(err)
+		})
+	}
+}
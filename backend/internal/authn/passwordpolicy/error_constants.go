@@ -0,0 +1,103 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for the password policy service.
+var (
+	// ErrorUserNotFound is returned when the user does not exist.
+	ErrorUserNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.user_not_found",
+			DefaultValue: "User not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.user_not_found_description",
+			DefaultValue: "The specified user does not exist",
+		},
+	}
+	// ErrorPasswordTooShort is returned when the password is shorter than the minimum length.
+	ErrorPasswordTooShort = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_too_short",
+			DefaultValue: "Password too short",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_too_short_description",
+			DefaultValue: "The password must be at least {{param(minLength)}} characters long",
+		},
+	}
+	// ErrorPasswordTooLong is returned when the password is longer than the maximum length.
+	ErrorPasswordTooLong = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_too_long",
+			DefaultValue: "Password too long",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_too_long_description",
+			DefaultValue: "The password must be at most {{param(maxLength)}} characters long",
+		},
+	}
+	// ErrorPasswordTooWeak is returned when the password lacks a required class of characters.
+	ErrorPasswordTooWeak = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_too_weak",
+			DefaultValue: "Password too weak",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_too_weak_description",
+			DefaultValue: "The password must contain at least {{param(count)}} {{param(characterClass)}} characters",
+		},
+	}
+	// ErrorPasswordReused is returned when the password matches one of the previous passwords.
+	ErrorPasswordReused = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_reused",
+			DefaultValue: "Password reused",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_reused_description",
+			DefaultValue: "The password must not match any of the last {{param(historyCount)}} passwords",
+		},
+	}
+	// ErrorPasswordChangedRecently is returned when the password is changed before its minimum age.
+	ErrorPasswordChangedRecently = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_changed_recently",
+			DefaultValue: "Password changed recently",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_changed_recently_description",
+			DefaultValue: "The password was changed too recently. Please try again later",
+		},
+	}
+	// ErrorPasswordBreached is returned when the password appears in the breached-password corpus.
+	ErrorPasswordBreached = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PWP-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_breached",
+			DefaultValue: "Password breached",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.passwordpolicyservice.password_breached_description",
+			DefaultValue: "The password has appeared in a data breach. Please choose a different password",
+		},
+	}
+)
@@ -0,0 +1,19 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+)
+
+// Initialize initializes the password policy service.
+func Initialize(
+	entitySvc entity.EntityServiceInterface,
+	hashSvc cryptolib.HashServiceInterface,
+	cfg config.PasswordPolicyConfig,
+) (PasswordPolicyServiceInterface, error) {
+	return newPasswordPolicyService(entitySvc, hashSvc, cfg)
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"github.com/thunder-id/thunderid/internal/entity"
+)
+
+// passwordHistory is the password history of a user, stored as the value of the password history
+// system credential. Hashes are ordered from the most recent password to the oldest.
+type passwordHistory struct {
+	ChangedAt int64                     `json:"changedAt"`
+	Hashes    []entity.StoredCredential `json:"hashes,omitempty"`
+}
@@ -0,0 +1,370 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package passwordpolicy enforces password complexity, history, age and breached-password rules.
+package passwordpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// loggerComponentName is the component name for logging.
+	loggerComponentName = "PasswordPolicyService"
+
+	// CredentialType is the system credential type that holds the password history of a user.
+	CredentialType = authnprovidercm.CredentialTypePasswordHistory
+
+	defaultCredentialAttribute = "password"
+)
+
+// PasswordPolicyServiceInterface defines the interface for enforcing the password policy of a user.
+type PasswordPolicyServiceInterface interface {
+	// IsEnabled reports whether password policies are enforced.
+	IsEnabled() bool
+	// ValidateCredentials checks the password among the given plaintext credentials against the
+	// policy of the user. The minimum age is only checked when enforceMinAge is set.
+	ValidateCredentials(ctx context.Context, userID string, credentials map[string]string,
+		enforceMinAge bool) *tidcommon.ServiceError
+	// RecordCredentialChange records the password among the given plaintext credentials in the
+	// password history of the user and restarts its expiry period.
+	RecordCredentialChange(ctx context.Context, userID string, credentials map[string]string) *tidcommon.ServiceError
+	// IsPasswordExpired reports whether the password of the user is older than its maximum age.
+	IsPasswordExpired(ctx context.Context, userID string) (bool, *tidcommon.ServiceError)
+}
+
+// passwordPolicyService is the default implementation of PasswordPolicyServiceInterface.
+type passwordPolicyService struct {
+	entityService       entity.EntityServiceInterface
+	hashService         cryptolib.HashServiceInterface
+	breachedChecker     breachedPasswordCheckerInterface
+	enabled             bool
+	credentialAttribute string
+	defaultRules        config.PasswordPolicyRules
+	userTypeRules       map[string]config.PasswordPolicyRules
+	ouRules             map[string]config.PasswordPolicyRules
+	now                 func() time.Time
+	logger              *log.Logger
+}
+
+// newPasswordPolicyService creates a new instance of the password policy service. The breached
+// password checker is only created when a policy checks for breached passwords.
+func newPasswordPolicyService(
+	entitySvc entity.EntityServiceInterface,
+	hashSvc cryptolib.HashServiceInterface,
+	cfg config.PasswordPolicyConfig,
+) (PasswordPolicyServiceInterface, error) {
+	svc := &passwordPolicyService{
+		entityService:       entitySvc,
+		hashService:         hashSvc,
+		enabled:             cfg.Enabled,
+		credentialAttribute: cfg.CredentialAttribute,
+		defaultRules:        cfg.Default,
+		userTypeRules:       cfg.UserTypes,
+		ouRules:             cfg.OrganizationUnits,
+		now:                 time.Now,
+		logger:              log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+	if svc.credentialAttribute == "" {
+		svc.credentialAttribute = defaultCredentialAttribute
+	}
+
+	if svc.enabled && svc.checksBreached() {
+		checker, err := newBreachedPasswordChecker(cfg.BreachedPassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize breached password checker: %w", err)
+		}
+		svc.breachedChecker = checker
+	}
+	return svc, nil
+}
+
+// IsEnabled reports whether password policies are enforced.
+func (s *passwordPolicyService) IsEnabled() bool {
+	return s.enabled
+}
+
+// ValidateCredentials checks the password among the given plaintext credentials against the policy
+// of the user. Credentials without a password are accepted as is.
+func (s *passwordPolicyService) ValidateCredentials(ctx context.Context, userID string,
+	credentials map[string]string, enforceMinAge bool) *tidcommon.ServiceError {
+	password, ok := credentials[s.credentialAttribute]
+	if !s.enabled || !ok {
+		return nil
+	}
+
+	rules, svcErr := s.resolveRules(ctx, userID)
+	if svcErr != nil {
+		return svcErr
+	}
+	if svcErr := checkComplexity(password, rules); svcErr != nil {
+		return svcErr
+	}
+
+	checkMinAge := enforceMinAge && rules.MinAgeSeconds > 0
+	if checkMinAge || rules.HistoryCount > 0 {
+		history, svcErr := s.getHistory(ctx, userID)
+		if svcErr != nil {
+			return svcErr
+		}
+		if checkMinAge && history.ChangedAt > 0 &&
+			s.now().Unix()-history.ChangedAt < int64(rules.MinAgeSeconds) {
+			s.logger.Debug(ctx, "Password changed before its minimum age", log.MaskedString(log.LoggerKeyUserID, userID))
+			return &ErrorPasswordChangedRecently
+		}
+		if rules.HistoryCount > 0 && s.matchesHistory(password, history, rules.HistoryCount) {
+			s.logger.Debug(ctx, "Password matches a previous password", log.MaskedString(log.LoggerKeyUserID, userID))
+			return ErrorPasswordReused.WithParams(map[string]string{
+				"historyCount": strconv.Itoa(rules.HistoryCount),
+			})
+		}
+	}
+
+	if rules.CheckBreached && s.breachedChecker != nil {
+		breached, err := s.breachedChecker.isBreached(ctx, password)
+		if err != nil {
+			// An unavailable corpus must not block password changes.
+			s.logger.Warn(ctx, "Failed to check the breached password corpus", log.Error(err))
+		} else if breached {
+			s.logger.Debug(ctx, "Password found in the breached password corpus",
+				log.MaskedString(log.LoggerKeyUserID, userID))
+			return &ErrorPasswordBreached
+		}
+	}
+	return nil
+}
+
+// RecordCredentialChange records the password among the given plaintext credentials in the password
+// history of the user and restarts its expiry period. Only as many hashes as the history count of
+// the policy are kept.
+func (s *passwordPolicyService) RecordCredentialChange(ctx context.Context, userID string,
+	credentials map[string]string) *tidcommon.ServiceError {
+	password, ok := credentials[s.credentialAttribute]
+	if !s.enabled || !ok {
+		return nil
+	}
+
+	rules, svcErr := s.resolveRules(ctx, userID)
+	if svcErr != nil {
+		return svcErr
+	}
+	history, svcErr := s.getHistory(ctx, userID)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	history.ChangedAt = s.now().Unix()
+	if rules.HistoryCount > 0 {
+		credential, err := s.hashService.Generate([]byte(password))
+		if err != nil {
+			s.logger.Error(ctx, "Failed to hash password for the password history", log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		hashes := append([]entity.StoredCredential{{
+			StorageAlgo:       credential.Algorithm,
+			StorageAlgoParams: credential.Parameters,
+			Value:             credential.Hash,
+		}}, history.Hashes...)
+		history.Hashes = hashes[:min(len(hashes), rules.HistoryCount)]
+	} else {
+		history.Hashes = nil
+	}
+
+	if err := s.storeHistory(ctx, userID, history); err != nil {
+		s.logger.Error(ctx, "Failed to store password history", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
+// IsPasswordExpired reports whether the password of the user is older than its maximum age. The age
+// is counted from the last password change recorded while policies were enforced, so passwords set
+// before that never expire.
+func (s *passwordPolicyService) IsPasswordExpired(ctx context.Context,
+	userID string) (bool, *tidcommon.ServiceError) {
+	if !s.enabled {
+		return false, nil
+	}
+
+	rules, svcErr := s.resolveRules(ctx, userID)
+	if svcErr != nil {
+		return false, svcErr
+	}
+	if rules.MaxAgeSeconds <= 0 {
+		return false, nil
+	}
+
+	history, svcErr := s.getHistory(ctx, userID)
+	if svcErr != nil {
+		return false, svcErr
+	}
+	if history.ChangedAt == 0 {
+		return false, nil
+	}
+	return s.now().Unix()-history.ChangedAt >= int64(rules.MaxAgeSeconds), nil
+}
+
+// resolveRules returns the policy of the user. The policy of the organization unit of the user takes
+// precedence over the policy of its user type, which takes precedence over the default policy.
+func (s *passwordPolicyService) resolveRules(ctx context.Context,
+	userID string) (config.PasswordPolicyRules, *tidcommon.ServiceError) {
+	if len(s.ouRules) == 0 && len(s.userTypeRules) == 0 {
+		return s.defaultRules, nil
+	}
+
+	e, err := s.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			s.logger.Debug(ctx, "User not found", log.MaskedString(log.LoggerKeyUserID, userID))
+			return config.PasswordPolicyRules{}, &ErrorUserNotFound
+		}
+		s.logger.Error(ctx, "Failed to retrieve user", log.Error(err))
+		return config.PasswordPolicyRules{}, &tidcommon.InternalServerError
+	}
+	return s.rulesFor(e), nil
+}
+
+// rulesFor returns the policy that applies to the entity.
+func (s *passwordPolicyService) rulesFor(e *providers.Entity) config.PasswordPolicyRules {
+	if rules, ok := s.ouRules[e.OUID]; ok {
+		return rules
+	}
+	if rules, ok := s.userTypeRules[e.Type]; ok {
+		return rules
+	}
+	return s.defaultRules
+}
+
+// checksBreached reports whether any of the configured policies checks for breached passwords.
+func (s *passwordPolicyService) checksBreached() bool {
+	if s.defaultRules.CheckBreached {
+		return true
+	}
+	for _, rules := range s.userTypeRules {
+		if rules.CheckBreached {
+			return true
+		}
+	}
+	for _, rules := range s.ouRules {
+		if rules.CheckBreached {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesHistory reports whether the password matches one of the most recent historyCount passwords.
+func (s *passwordPolicyService) matchesHistory(password string, history *passwordHistory, historyCount int) bool {
+	for _, stored := range history.Hashes[:min(len(history.Hashes), historyCount)] {
+		ref := cryptolib.Credential{
+			Algorithm:  stored.StorageAlgo,
+			Hash:       stored.Value,
+			Parameters: stored.StorageAlgoParams,
+		}
+		if ok, err := s.hashService.Verify([]byte(password), ref); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getHistory loads the password history of a user. Users without a recorded password change have an
+// empty history.
+func (s *passwordPolicyService) getHistory(ctx context.Context,
+	userID string) (*passwordHistory, *tidcommon.ServiceError) {
+	entries, err := s.entityService.GetCredentialsByType(ctx, userID, CredentialType)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil, &ErrorUserNotFound
+		}
+		s.logger.Error(ctx, "Failed to retrieve password history", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	history := &passwordHistory{}
+	if len(entries) == 0 || entries[0].Value == "" {
+		return history, nil
+	}
+	if err := json.Unmarshal([]byte(entries[0].Value), history); err != nil {
+		s.logger.Error(ctx, "Failed to unmarshal password history", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return history, nil
+}
+
+// storeHistory replaces the stored password history of a user.
+func (s *passwordPolicyService) storeHistory(ctx context.Context, userID string, history *passwordHistory) error {
+	historyJSON, err := json.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("failed to marshal password history: %w", err)
+	}
+	payload, err := json.Marshal(map[string][]entity.StoredCredential{
+		CredentialType: {{Value: string(historyJSON)}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal password history credential: %w", err)
+	}
+	return s.entityService.UpdateSystemCredentials(ctx, userID, payload)
+}
+
+// checkComplexity checks the length and character classes of the password against the policy.
+func checkComplexity(password string, rules config.PasswordPolicyRules) *tidcommon.ServiceError {
+	length := utf8.RuneCountInString(password)
+	if rules.MinLength > 0 && length < rules.MinLength {
+		return ErrorPasswordTooShort.WithParams(map[string]string{"minLength": strconv.Itoa(rules.MinLength)})
+	}
+	if rules.MaxLength > 0 && length > rules.MaxLength {
+		return ErrorPasswordTooLong.WithParams(map[string]string{"maxLength": strconv.Itoa(rules.MaxLength)})
+	}
+
+	var upper, lower, digits, special int
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		case unicode.IsDigit(r):
+			digits++
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			special++
+		}
+	}
+
+	for _, class := range []struct {
+		name     string
+		count    int
+		required int
+	}{
+		{"uppercase", upper, rules.MinUppercase},
+		{"lowercase", lower, rules.MinLowercase},
+		{"digit", digits, rules.MinDigits},
+		{"special", special, rules.MinSpecial},
+	} {
+		if class.count < class.required {
+			return ErrorPasswordTooWeak.WithParams(map[string]string{
+				"count":          strconv.Itoa(class.required),
+				"characterClass": class.name,
+			})
+		}
+	}
+	return nil
+}
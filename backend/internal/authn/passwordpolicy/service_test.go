@@ -0,0 +1,364 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package passwordpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/hashmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+)
+
+const (
+	testUserID   = "user123"
+	testPassword = "Str0ng!Passw0rd"
+)
+
+var testTime = time.Unix(1700000000, 0)
+
+type PasswordPolicyServiceTestSuite struct {
+	suite.Suite
+	mockEntityService   *entitymock.EntityServiceInterfaceMock
+	mockHashService     *hashmock.HashServiceInterfaceMock
+	mockBreachedChecker *breachedPasswordCheckerInterfaceMock
+	service             *passwordPolicyService
+}
+
+func TestPasswordPolicyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PasswordPolicyServiceTestSuite))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) SetupTest() {
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockHashService = hashmock.NewHashServiceInterfaceMock(suite.T())
+	suite.mockBreachedChecker = newBreachedPasswordCheckerInterfaceMock(suite.T())
+
+	suite.service = &passwordPolicyService{
+		entityService:       suite.mockEntityService,
+		hashService:         suite.mockHashService,
+		breachedChecker:     suite.mockBreachedChecker,
+		enabled:             true,
+		credentialAttribute: defaultCredentialAttribute,
+		defaultRules: config.PasswordPolicyRules{
+			MinLength:    8,
+			MaxLength:    64,
+			MinUppercase: 1,
+			MinLowercase: 1,
+			MinDigits:    1,
+			MinSpecial:   1,
+		},
+		now:    func() time.Time { return testTime },
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+func (suite *PasswordPolicyServiceTestSuite) passwordCredentials(password string) map[string]string {
+	return map[string]string{defaultCredentialAttribute: password}
+}
+
+func (suite *PasswordPolicyServiceTestSuite) mockHistory(history *passwordHistory) {
+	historyJSON, err := json.Marshal(history)
+	suite.Require().NoError(err)
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return([]entity.StoredCredential{{Value: string(historyJSON)}}, nil).Once()
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestNewPasswordPolicyService_Defaults() {
+	svc, err := newPasswordPolicyService(suite.mockEntityService, suite.mockHashService,
+		config.PasswordPolicyConfig{Enabled: true})
+
+	suite.Require().NoError(err)
+	impl := svc.(*passwordPolicyService)
+	suite.Equal(defaultCredentialAttribute, impl.credentialAttribute)
+	suite.Nil(impl.breachedChecker)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestNewPasswordPolicyService_InvalidBreachedSource() {
+	_, err := newPasswordPolicyService(suite.mockEntityService, suite.mockHashService,
+		config.PasswordPolicyConfig{
+			Enabled: true,
+			UserTypes: map[string]config.PasswordPolicyRules{
+				"customer": {CheckBreached: true},
+			},
+			BreachedPassword: config.BreachedPasswordConfig{Source: "unknown"},
+		})
+
+	suite.Error(err)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_Disabled() {
+	suite.service.enabled = false
+
+	suite.Nil(suite.service.ValidateCredentials(context.Background(), testUserID,
+		suite.passwordCredentials("weak"), true))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_NoPassword() {
+	suite.Nil(suite.service.ValidateCredentials(context.Background(), testUserID,
+		map[string]string{"pin": "1234"}, true))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_Complexity() {
+	testCases := []struct {
+		name     string
+		password string
+		expected string
+	}{
+		{"TooShort", "Ab1!", ErrorPasswordTooShort.Code},
+		{"TooLong", "Ab1!" + strings.Repeat("a", 61), ErrorPasswordTooLong.Code},
+		{"NoUppercase", "str0ng!passw0rd", ErrorPasswordTooWeak.Code},
+		{"NoLowercase", "STR0NG!PASSW0RD", ErrorPasswordTooWeak.Code},
+		{"NoDigit", "Strong!Password", ErrorPasswordTooWeak.Code},
+		{"NoSpecial", "Str0ngPassw0rd", ErrorPasswordTooWeak.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			svcErr := suite.service.ValidateCredentials(context.Background(), testUserID,
+				suite.passwordCredentials(tc.password), false)
+
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_Success() {
+	suite.Nil(suite.service.ValidateCredentials(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword), true))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_ChangedRecently() {
+	suite.service.defaultRules.MinAgeSeconds = 3600
+	suite.mockHistory(&passwordHistory{ChangedAt: testTime.Unix() - 60})
+
+	svcErr := suite.service.ValidateCredentials(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword), true)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorPasswordChangedRecently.Code, svcErr.Code)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_MinAgeNotEnforced() {
+	suite.service.defaultRules.MinAgeSeconds = 3600
+
+	suite.Nil(suite.service.ValidateCredentials(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword), false))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_Reused() {
+	suite.service.defaultRules.HistoryCount = 2
+	suite.mockHistory(&passwordHistory{
+		ChangedAt: testTime.Unix(),
+		Hashes:    []entity.StoredCredential{{Value: "hash1"}, {Value: "hash2"}, {Value: "hash3"}},
+	})
+	suite.mockHashService.On("Verify", []byte(testPassword), cryptolib.Credential{Hash: "hash1"}).
+		Return(false, nil).Once()
+	suite.mockHashService.On("Verify", []byte(testPassword), cryptolib.Credential{Hash: "hash2"}).
+		Return(true, nil).Once()
+
+	svcErr := suite.service.ValidateCredentials(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword), false)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorPasswordReused.Code, svcErr.Code)
+	suite.Equal("2", svcErr.ErrorDescription.Params["historyCount"])
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_OutsideHistory() {
+	suite.service.defaultRules.HistoryCount = 1
+	suite.mockHistory(&passwordHistory{
+		ChangedAt: testTime.Unix(),
+		Hashes:    []entity.StoredCredential{{Value: "hash1"}, {Value: "hash2"}},
+	})
+	suite.mockHashService.On("Verify", []byte(testPassword), cryptolib.Credential{Hash: "hash1"}).
+		Return(false, nil).Once()
+
+	suite.Nil(suite.service.ValidateCredentials(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword), false))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_Breached() {
+	suite.service.defaultRules.CheckBreached = true
+	suite.mockBreachedChecker.On("isBreached", mock.Anything, testPassword).Return(true, nil).Once()
+
+	svcErr := suite.service.ValidateCredentials(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword), false)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorPasswordBreached.Code, svcErr.Code)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_BreachedLookupFails() {
+	suite.service.defaultRules.CheckBreached = true
+	suite.mockBreachedChecker.On("isBreached", mock.Anything, testPassword).
+		Return(false, errors.New("unavailable")).Once()
+
+	suite.Nil(suite.service.ValidateCredentials(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword), false))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_ResolvesPolicy() {
+	suite.service.userTypeRules = map[string]config.PasswordPolicyRules{
+		"employee": {MinLength: 20},
+	}
+	suite.service.ouRules = map[string]config.PasswordPolicyRules{
+		"ou-admins": {MinLength: 30},
+	}
+
+	testCases := []struct {
+		name      string
+		entity    providers.Entity
+		minLength string
+	}{
+		{"OrganizationUnit", providers.Entity{ID: testUserID, Type: "employee", OUID: "ou-admins"}, "30"},
+		{"UserType", providers.Entity{ID: testUserID, Type: "employee", OUID: "ou-other"}, "20"},
+		{"Default", providers.Entity{ID: testUserID, Type: "customer", OUID: "ou-other"}, ""},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			e := tc.entity
+			suite.mockEntityService.On("GetEntity", mock.Anything, testUserID).Return(&e, nil).Once()
+
+			svcErr := suite.service.ValidateCredentials(context.Background(), testUserID,
+				suite.passwordCredentials(testPassword), false)
+
+			if tc.minLength == "" {
+				suite.Nil(svcErr)
+				return
+			}
+			suite.Require().NotNil(svcErr)
+			suite.Equal(ErrorPasswordTooShort.Code, svcErr.Code)
+			suite.Equal(tc.minLength, svcErr.ErrorDescription.Params["minLength"])
+		})
+	}
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestValidateCredentials_UserNotFound() {
+	suite.service.userTypeRules = map[string]config.PasswordPolicyRules{"employee": {}}
+	suite.mockEntityService.On("GetEntity", mock.Anything, testUserID).
+		Return(nil, entity.ErrEntityNotFound).Once()
+
+	svcErr := suite.service.ValidateCredentials(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword), false)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorUserNotFound.Code, svcErr.Code)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestRecordCredentialChange() {
+	suite.service.defaultRules.HistoryCount = 2
+	suite.mockHistory(&passwordHistory{
+		ChangedAt: testTime.Unix() - 1000,
+		Hashes:    []entity.StoredCredential{{Value: "hash1"}, {Value: "hash2"}},
+	})
+	suite.mockHashService.On("Generate", []byte(testPassword)).
+		Return(cryptolib.Credential{Algorithm: cryptolib.PBKDF2, Hash: "newhash"}, nil).Once()
+
+	var stored passwordHistory
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID, mock.Anything).
+		Run(func(args mock.Arguments) {
+			var creds map[string][]entity.StoredCredential
+			suite.Require().NoError(json.Unmarshal(args.Get(2).(json.RawMessage), &creds))
+			suite.Require().Len(creds[CredentialType], 1)
+			suite.Require().NoError(json.Unmarshal([]byte(creds[CredentialType][0].Value), &stored))
+		}).Return(nil).Once()
+
+	svcErr := suite.service.RecordCredentialChange(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword))
+
+	suite.Nil(svcErr)
+	suite.Equal(testTime.Unix(), stored.ChangedAt)
+	suite.Require().Len(stored.Hashes, 2)
+	suite.Equal("newhash", stored.Hashes[0].Value)
+	suite.Equal("hash1", stored.Hashes[1].Value)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestRecordCredentialChange_WithoutHistory() {
+	suite.mockHistory(&passwordHistory{Hashes: []entity.StoredCredential{{Value: "hash1"}}})
+
+	var stored passwordHistory
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID, mock.Anything).
+		Run(func(args mock.Arguments) {
+			var creds map[string][]entity.StoredCredential
+			suite.Require().NoError(json.Unmarshal(args.Get(2).(json.RawMessage), &creds))
+			suite.Require().NoError(json.Unmarshal([]byte(creds[CredentialType][0].Value), &stored))
+		}).Return(nil).Once()
+
+	suite.Nil(suite.service.RecordCredentialChange(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword)))
+	suite.Equal(testTime.Unix(), stored.ChangedAt)
+	suite.Empty(stored.Hashes)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestRecordCredentialChange_StoreFails() {
+	suite.mockHistory(&passwordHistory{})
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID, mock.Anything).
+		Return(errors.New("db error")).Once()
+
+	svcErr := suite.service.RecordCredentialChange(context.Background(), testUserID,
+		suite.passwordCredentials(testPassword))
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestIsPasswordExpired() {
+	suite.service.defaultRules.MaxAgeSeconds = 86400
+
+	testCases := []struct {
+		name      string
+		changedAt int64
+		expected  bool
+	}{
+		{"Expired", testTime.Unix() - 86400, true},
+		{"NotExpired", testTime.Unix() - 3600, false},
+		{"NeverRecorded", 0, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockHistory(&passwordHistory{ChangedAt: tc.changedAt})
+
+			expired, svcErr := suite.service.IsPasswordExpired(context.Background(), testUserID)
+
+			suite.Nil(svcErr)
+			suite.Equal(tc.expected, expired)
+		})
+	}
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestIsPasswordExpired_NoMaxAge() {
+	expired, svcErr := suite.service.IsPasswordExpired(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.False(expired)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestIsPasswordExpired_HistoryLookupFails() {
+	suite.service.defaultRules.MaxAgeSeconds = 86400
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(nil, errors.New("db error")).Once()
+
+	_, svcErr := suite.service.IsPasswordExpired(context.Background(), testUserID)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
@@ -597,6 +597,8 @@ func (as *authenticationService) mapCredentialsAuthnError(ctx context.Context, s
 		return &common.ErrorUserNotFound
 	case authnprovidermgr.ErrorInvalidRequest.Code:
 		return &ErrorEmptyAttributesOrCredentials
+	case authnprovidermgr.ErrorAccountLocked.Code:
+		return &ErrorAccountLocked
 	default:
 		logger.Error(ctx, "Error occurred while authenticating with credentials",
 			log.String("errorCode", svcErr.Code), log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
@@ -2229,6 +2229,9 @@ func (suite *AuthenticationServiceTestSuite) TestMapCredentialsAuthnErrorCases()
 	err = suite.service.mapCredentialsAuthnError(context.Background(), &authnprovidermgr.ErrorInvalidRequest, logger)
 	suite.Equal(ErrorEmptyAttributesOrCredentials.Code, err.Code)
 
+	err = suite.service.mapCredentialsAuthnError(context.Background(), &authnprovidermgr.ErrorAccountLocked, logger)
+	suite.Equal(ErrorAccountLocked.Code, err.Code)
+
 	err = suite.service.mapCredentialsAuthnError(context.Background(), &tidcommon.InternalServerError, logger)
 	suite.Equal(tidcommon.InternalServerError.Code, err.Code)
 }
@@ -0,0 +1,77 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for the TOTP authentication service.
+var (
+	// ErrorInvalidRequest is returned when a required field of the request is missing.
+	ErrorInvalidRequest = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TOT-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.totpservice.invalid_request",
+			DefaultValue: "Invalid request",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.totpservice.invalid_request_description",
+			DefaultValue: "The user ID and a TOTP or recovery code are required",
+		},
+	}
+	// ErrorUserNotFound is returned when the user does not exist.
+	ErrorUserNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TOT-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.totpservice.user_not_found",
+			DefaultValue: "User not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.totpservice.user_not_found_description",
+			DefaultValue: "The specified user does not exist",
+		},
+	}
+	// ErrorSessionExpired is returned when the enrollment session is missing or has expired.
+	ErrorSessionExpired = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TOT-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.totpservice.session_expired",
+			DefaultValue: "Session expired",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.totpservice.session_expired_description",
+			DefaultValue: "The enrollment session has expired. Please start the enrollment again",
+		},
+	}
+	// ErrorInvalidCode is returned when a TOTP or recovery code is incorrect, expired or already used.
+	ErrorInvalidCode = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TOT-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.totpservice.invalid_code",
+			DefaultValue: "Invalid code",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.totpservice.invalid_code_description",
+			DefaultValue: "The provided code is incorrect, expired or has already been used",
+		},
+	}
+	// ErrorNotEnrolled is returned when the user has not enrolled an authenticator app.
+	ErrorNotEnrolled = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TOT-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.totpservice.not_enrolled",
+			DefaultValue: "Authenticator app not enrolled",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.totpservice.not_enrolled_description",
+			DefaultValue: "The user has not enrolled an authenticator app",
+		},
+	}
+)
@@ -0,0 +1,25 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize initializes the TOTP authentication service.
+func Initialize(
+	entitySvc entity.EntityServiceInterface,
+	hashSvc cryptolib.HashServiceInterface,
+	cryptoSvc kmprovider.ConfigCryptoProvider,
+	runtimeStore providers.RuntimeStoreProvider,
+	jtiStore jti.JTIStoreInterface,
+	cfg config.TOTPConfig,
+) TOTPServiceInterface {
+	return newTOTPService(entitySvc, hashSvc, cryptoSvc, newSessionStore(runtimeStore), jtiStore, cfg)
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"github.com/thunder-id/thunderid/internal/entity"
+)
+
+// TOTPEnrollmentStartRequest is the request to start enrolling an authenticator app for a user.
+type TOTPEnrollmentStartRequest struct {
+	UserID string
+	// AccountName is the account label shown in the authenticator app. Defaults to the username,
+	// then the email address, then the user ID of the user.
+	AccountName string
+}
+
+// TOTPEnrollmentStartData carries the material the user needs to set up the authenticator app.
+// The recovery codes are returned only once, in plaintext, and must be shown to the user.
+type TOTPEnrollmentStartData struct {
+	Secret          string   `json:"secret"`
+	ProvisioningURI string   `json:"provisioningUri"`
+	RecoveryCodes   []string `json:"recoveryCodes"`
+	SessionToken    string   `json:"sessionToken"`
+}
+
+// TOTPEnrollmentFinishRequest confirms an enrollment with a code generated by the authenticator app.
+type TOTPEnrollmentFinishRequest struct {
+	SessionToken string
+	Code         string
+}
+
+// TOTPAuthenticationRequest authenticates a user with either a TOTP code or a recovery code.
+type TOTPAuthenticationRequest struct {
+	UserID       string
+	Code         string
+	RecoveryCode string
+}
+
+// TOTPEnrollment describes the authenticator app enrolled by a user, without any secret material.
+type TOTPEnrollment struct {
+	EnrolledAt             int64
+	RemainingRecoveryCodes int
+}
+
+// totpCredential is the stored form of an enrolled authenticator app. The shared secret is
+// encrypted and the recovery codes are hashed.
+type totpCredential struct {
+	Secret        string                    `json:"secret"`
+	Digits        int                       `json:"digits"`
+	PeriodSeconds int                       `json:"periodSeconds"`
+	RecoveryCodes []entity.StoredCredential `json:"recoveryCodes"`
+	EnrolledAt    int64                     `json:"enrolledAt,omitempty"`
+}
+
+// enrollmentSession holds a pending enrollment until the user confirms it with a valid code.
+type enrollmentSession struct {
+	UserID        string                    `json:"userId"`
+	Secret        string                    `json:"secret"`
+	RecoveryCodes []entity.StoredCredential `json:"recoveryCodes"`
+}
@@ -0,0 +1,501 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package totp implements the TOTP (RFC 6238) authenticator-app authentication service.
+package totp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/authn/common"
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// loggerComponentName is the component name for logging.
+	loggerComponentName = "TOTPService"
+
+	// CredentialType is the credential type key that identifies TOTP credentials in the provider
+	// chain. The enrolled authenticator app is stored under the same key in the system credentials.
+	CredentialType = authnprovidercm.CredentialTypeTOTP
+
+	defaultDigits            = 6
+	defaultPeriodSeconds     = 30
+	defaultSkew              = 1
+	defaultRecoveryCodeCount = 10
+
+	// enrollmentTTLSeconds is how long a pending enrollment waits for confirmation.
+	enrollmentTTLSeconds = 300
+	// sessionKeyLength is the number of random bytes in an enrollment session key.
+	sessionKeyLength = 32
+
+	// codeReplayNamespace and recoveryCodeReplayNamespace scope the JTI store entries used for
+	// replay protection.
+	codeReplayNamespace         = "totp:code"
+	recoveryCodeReplayNamespace = "totp:recovery"
+	// recoveryCodeReplayWindow bounds how long a consumed recovery code is tracked. The code is
+	// removed from the stored credential on use, so the entry only covers concurrent attempts.
+	recoveryCodeReplayWindow = time.Hour
+)
+
+// TOTPServiceInterface defines the interface for TOTP enrollment and authentication operations.
+type TOTPServiceInterface interface {
+	// Enrollment methods
+	StartEnrollment(
+		ctx context.Context, req *TOTPEnrollmentStartRequest,
+	) (*TOTPEnrollmentStartData, *tidcommon.ServiceError)
+	FinishEnrollment(
+		ctx context.Context, req *TOTPEnrollmentFinishRequest,
+	) (*common.AuthnResult, *tidcommon.ServiceError)
+
+	// Authentication methods
+	Authenticate(
+		ctx context.Context, req *TOTPAuthenticationRequest,
+	) (*common.AuthnResult, *tidcommon.ServiceError)
+
+	// Enrollment management methods
+	GetEnrollment(ctx context.Context, userID string) (*TOTPEnrollment, *tidcommon.ServiceError)
+	RemoveEnrollment(ctx context.Context, userID string) *tidcommon.ServiceError
+}
+
+// totpService is the default implementation of TOTPServiceInterface.
+type totpService struct {
+	entityService entity.EntityServiceInterface
+	hashService   cryptolib.HashServiceInterface
+	cryptoService kmprovider.ConfigCryptoProvider
+	sessionStore  sessionStoreInterface
+	jtiStore      jti.JTIStoreInterface
+	issuer        string
+	digits        int
+	periodSeconds int
+	skew          int
+	recoveryCodes int
+	now           func() time.Time
+	logger        *log.Logger
+}
+
+// newTOTPService creates a new instance of the TOTP service. Unset configuration values fall back
+// to the RFC 6238 defaults of six digits, a 30 second period and one period of clock drift.
+func newTOTPService(
+	entitySvc entity.EntityServiceInterface,
+	hashSvc cryptolib.HashServiceInterface,
+	cryptoSvc kmprovider.ConfigCryptoProvider,
+	sessionStore sessionStoreInterface,
+	jtiStore jti.JTIStoreInterface,
+	cfg config.TOTPConfig,
+) TOTPServiceInterface {
+	svc := &totpService{
+		entityService: entitySvc,
+		hashService:   hashSvc,
+		cryptoService: cryptoSvc,
+		sessionStore:  sessionStore,
+		jtiStore:      jtiStore,
+		issuer:        cfg.Issuer,
+		digits:        cfg.Digits,
+		periodSeconds: cfg.PeriodSeconds,
+		skew:          cfg.Skew,
+		recoveryCodes: cfg.RecoveryCodeCount,
+		now:           time.Now,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+	if svc.digits < 6 || svc.digits > 8 {
+		svc.digits = defaultDigits
+	}
+	if svc.periodSeconds <= 0 {
+		svc.periodSeconds = defaultPeriodSeconds
+	}
+	if svc.skew < 0 {
+		svc.skew = defaultSkew
+	}
+	if svc.recoveryCodes <= 0 {
+		svc.recoveryCodes = defaultRecoveryCodeCount
+	}
+	return svc
+}
+
+// StartEnrollment generates a new shared secret and recovery codes for a user and holds them in a
+// pending enrollment until FinishEnrollment confirms that the authenticator app was set up.
+func (s *totpService) StartEnrollment(
+	ctx context.Context, req *TOTPEnrollmentStartRequest,
+) (*TOTPEnrollmentStartData, *tidcommon.ServiceError) {
+	if req == nil || req.UserID == "" {
+		return nil, &ErrorInvalidRequest
+	}
+	s.logger.Debug(ctx, "Starting TOTP enrollment", log.MaskedString("userID", req.UserID))
+
+	coreEntity, svcErr := s.getEntity(ctx, req.UserID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate TOTP secret", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	recoveryCodes, err := generateRecoveryCodes(s.recoveryCodes)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate recovery codes", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	hashedCodes := make([]entity.StoredCredential, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		hashed, err := s.hashService.Generate([]byte(normalizeRecoveryCode(code)))
+		if err != nil {
+			s.logger.Error(ctx, "Failed to hash recovery code", log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		hashedCodes = append(hashedCodes, entity.StoredCredential{
+			StorageAlgo:       hashed.Algorithm,
+			StorageAlgoParams: hashed.Parameters,
+			Value:             hashed.Hash,
+		})
+	}
+
+	sessionKey, err := generateSessionKey()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate enrollment session key", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	session := &enrollmentSession{
+		UserID:        req.UserID,
+		Secret:        secret,
+		RecoveryCodes: hashedCodes,
+	}
+	if err := s.sessionStore.storeSession(ctx, sessionKey, session, enrollmentTTLSeconds); err != nil {
+		s.logger.Error(ctx, "Failed to store TOTP enrollment session", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	accountName := req.AccountName
+	if accountName == "" {
+		accountName = resolveAccountName(coreEntity.ID, coreEntity.Attributes)
+	}
+
+	return &TOTPEnrollmentStartData{
+		Secret:          secret,
+		ProvisioningURI: buildProvisioningURI(s.issuer, accountName, secret, s.digits, s.periodSeconds),
+		RecoveryCodes:   recoveryCodes,
+		SessionToken:    sessionKey,
+	}, nil
+}
+
+// FinishEnrollment verifies a code generated from the pending secret and stores the authenticator
+// app and recovery codes, replacing any earlier enrollment of the user.
+func (s *totpService) FinishEnrollment(
+	ctx context.Context, req *TOTPEnrollmentFinishRequest,
+) (*common.AuthnResult, *tidcommon.ServiceError) {
+	if req == nil || req.SessionToken == "" || req.Code == "" {
+		return nil, &ErrorInvalidRequest
+	}
+
+	session, err := s.sessionStore.retrieveSession(ctx, req.SessionToken)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to retrieve TOTP enrollment session", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if session == nil {
+		return nil, &ErrorSessionExpired
+	}
+
+	secret, err := decodeSecret(session.Secret)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to decode pending TOTP secret", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if svcErr := s.verifyCode(ctx, session.UserID, secret, s.digits, s.periodSeconds, req.Code); svcErr != nil {
+		return nil, svcErr
+	}
+
+	encryptedSecret, err := s.cryptoService.Encrypt(ctx, []byte(session.Secret))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to encrypt TOTP secret", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	credential := &totpCredential{
+		Secret:        string(encryptedSecret),
+		Digits:        s.digits,
+		PeriodSeconds: s.periodSeconds,
+		RecoveryCodes: session.RecoveryCodes,
+		EnrolledAt:    s.now().Unix(),
+	}
+	if err := s.storeCredential(ctx, session.UserID, credential); err != nil {
+		s.logger.Error(ctx, "Failed to store TOTP credential", log.MaskedString("userID", session.UserID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	if err := s.sessionStore.deleteSession(ctx, req.SessionToken); err != nil {
+		s.logger.Warn(ctx, "Failed to delete TOTP enrollment session", log.Error(err))
+	}
+
+	s.logger.Debug(ctx, "TOTP enrollment completed", log.MaskedString("userID", session.UserID))
+	return newAuthnResult(session.UserID), nil
+}
+
+// Authenticate verifies a TOTP code, or a recovery code when no TOTP code is given, for the user.
+// A recovery code is consumed on successful use.
+func (s *totpService) Authenticate(
+	ctx context.Context, req *TOTPAuthenticationRequest,
+) (*common.AuthnResult, *tidcommon.ServiceError) {
+	if req == nil || req.UserID == "" || (req.Code == "" && req.RecoveryCode == "") {
+		return nil, &ErrorInvalidRequest
+	}
+
+	credential, svcErr := s.getCredential(ctx, req.UserID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if req.Code == "" {
+		if svcErr := s.consumeRecoveryCode(ctx, req.UserID, credential, req.RecoveryCode); svcErr != nil {
+			return nil, svcErr
+		}
+		return newAuthnResult(req.UserID), nil
+	}
+
+	plainSecret, err := s.cryptoService.Decrypt(ctx, []byte(credential.Secret))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to decrypt TOTP secret", log.MaskedString("userID", req.UserID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	secret, err := decodeSecret(string(plainSecret))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to decode TOTP secret", log.MaskedString("userID", req.UserID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if svcErr := s.verifyCode(ctx, req.UserID, secret, credential.Digits, credential.PeriodSeconds,
+		req.Code); svcErr != nil {
+		return nil, svcErr
+	}
+	return newAuthnResult(req.UserID), nil
+}
+
+// verifyCode checks the code against the time steps within the allowed drift window and records the
+// matched step in the JTI store, so that each code is accepted at most once.
+func (s *totpService) verifyCode(ctx context.Context, userID string, secret []byte,
+	digits, periodSeconds int, code string) *tidcommon.ServiceError {
+	if len(code) != digits {
+		return &ErrorInvalidCode
+	}
+
+	period := int64(periodSeconds)
+	currentStep := s.now().Unix() / period
+	for offset := -s.skew; offset <= s.skew; offset++ {
+		step := currentStep + int64(offset)
+		if step < 0 {
+			continue
+		}
+		expected := generateCode(secret, uint64(step), digits)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) != 1 {
+			continue
+		}
+
+		// The step can match again until it falls out of the drift window.
+		expiry := time.Unix((step+int64(s.skew)+1)*period, 0)
+		inserted, err := s.jtiStore.RecordJTI(ctx, codeReplayNamespace,
+			userID+":"+strconv.FormatInt(step, 10), expiry)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to record TOTP code use", log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		if !inserted {
+			s.logger.Debug(ctx, "Rejected replayed TOTP code", log.MaskedString("userID", userID))
+			return &ErrorInvalidCode
+		}
+		return nil
+	}
+
+	s.logger.Debug(ctx, "TOTP code did not match", log.MaskedString("userID", userID))
+	return &ErrorInvalidCode
+}
+
+// consumeRecoveryCode verifies a recovery code and removes it from the stored credential.
+func (s *totpService) consumeRecoveryCode(ctx context.Context, userID string,
+	credential *totpCredential, recoveryCode string) *tidcommon.ServiceError {
+	normalized := normalizeRecoveryCode(recoveryCode)
+	for i, stored := range credential.RecoveryCodes {
+		ok, err := s.hashService.Verify([]byte(normalized), cryptolib.Credential{
+			Algorithm:  stored.StorageAlgo,
+			Hash:       stored.Value,
+			Parameters: stored.StorageAlgoParams,
+		})
+		if err != nil || !ok {
+			continue
+		}
+
+		// Guard against concurrent use of the same code before the removal below is persisted.
+		inserted, err := s.jtiStore.RecordJTI(ctx, recoveryCodeReplayNamespace,
+			userID+":"+stored.Value, s.now().Add(recoveryCodeReplayWindow))
+		if err != nil {
+			s.logger.Error(ctx, "Failed to record recovery code use", log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		if !inserted {
+			return &ErrorInvalidCode
+		}
+
+		remaining := make([]entity.StoredCredential, 0, len(credential.RecoveryCodes)-1)
+		remaining = append(remaining, credential.RecoveryCodes[:i]...)
+		remaining = append(remaining, credential.RecoveryCodes[i+1:]...)
+		credential.RecoveryCodes = remaining
+		if err := s.storeCredential(ctx, userID, credential); err != nil {
+			s.logger.Error(ctx, "Failed to remove used recovery code", log.MaskedString("userID", userID),
+				log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+
+		s.logger.Debug(ctx, "Recovery code used", log.MaskedString("userID", userID),
+			log.Int("remainingRecoveryCodes", len(remaining)))
+		return nil
+	}
+
+	s.logger.Debug(ctx, "Recovery code did not match", log.MaskedString("userID", userID))
+	return &ErrorInvalidCode
+}
+
+// GetEnrollment describes the authenticator app enrolled by the user. Returns ErrorNotEnrolled when
+// the user has no authenticator app.
+func (s *totpService) GetEnrollment(
+	ctx context.Context, userID string,
+) (*TOTPEnrollment, *tidcommon.ServiceError) {
+	if userID == "" {
+		return nil, &ErrorInvalidRequest
+	}
+
+	credential, svcErr := s.getCredential(ctx, userID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return &TOTPEnrollment{
+		EnrolledAt:             credential.EnrolledAt,
+		RemainingRecoveryCodes: len(credential.RecoveryCodes),
+	}, nil
+}
+
+// RemoveEnrollment removes the authenticator app and the remaining recovery codes of the user.
+func (s *totpService) RemoveEnrollment(ctx context.Context, userID string) *tidcommon.ServiceError {
+	if _, svcErr := s.GetEnrollment(ctx, userID); svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.entityService.RemoveSystemCredentials(ctx, userID, CredentialType); err != nil {
+		s.logger.Error(ctx, "Failed to remove TOTP credential", log.MaskedString("userID", userID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "TOTP enrollment removed", log.MaskedString("userID", userID))
+	return nil
+}
+
+// getEntity retrieves an entity by ID, mapping entity-layer errors to TOTP service errors.
+func (s *totpService) getEntity(
+	ctx context.Context, entityID string,
+) (*providers.Entity, *tidcommon.ServiceError) {
+	e, err := s.entityService.GetEntity(ctx, entityID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			s.logger.Debug(ctx, "Entity not found", log.MaskedString("entityID", entityID))
+			return nil, &ErrorUserNotFound
+		}
+		s.logger.Error(ctx, "Failed to retrieve entity", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return e, nil
+}
+
+// getCredential loads the enrolled authenticator app of a user.
+func (s *totpService) getCredential(
+	ctx context.Context, userID string,
+) (*totpCredential, *tidcommon.ServiceError) {
+	entries, err := s.entityService.GetCredentialsByType(ctx, userID, CredentialType)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil, &ErrorUserNotFound
+		}
+		s.logger.Error(ctx, "Failed to retrieve TOTP credential", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if len(entries) == 0 || entries[0].Value == "" {
+		return nil, &ErrorNotEnrolled
+	}
+
+	var credential totpCredential
+	if err := json.Unmarshal([]byte(entries[0].Value), &credential); err != nil {
+		s.logger.Error(ctx, "Failed to unmarshal TOTP credential", log.MaskedString("userID", userID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if credential.Digits == 0 {
+		credential.Digits = defaultDigits
+	}
+	if credential.PeriodSeconds == 0 {
+		credential.PeriodSeconds = defaultPeriodSeconds
+	}
+	return &credential, nil
+}
+
+// storeCredential replaces the stored authenticator app of a user.
+func (s *totpService) storeCredential(ctx context.Context, userID string, credential *totpCredential) error {
+	credentialJSON, err := json.Marshal(credential)
+	if err != nil {
+		return fmt.Errorf("failed to marshal TOTP credential: %w", err)
+	}
+	payload, err := json.Marshal(map[string][]entity.StoredCredential{
+		CredentialType: {{Value: string(credentialJSON)}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal TOTP credentials: %w", err)
+	}
+	return s.entityService.UpdateSystemCredentials(ctx, userID, payload)
+}
+
+// resolveAccountName picks the account label shown in the authenticator app.
+func resolveAccountName(entityID string, attributes json.RawMessage) string {
+	var attrs map[string]interface{}
+	if len(attributes) > 0 && json.Unmarshal(attributes, &attrs) == nil {
+		for _, key := range []string{"username", "email"} {
+			if value, ok := attrs[key].(string); ok && value != "" {
+				return value
+			}
+		}
+	}
+	return entityID
+}
+
+// generateSessionKey returns a random enrollment session key.
+func generateSessionKey() (string, error) {
+	bytes := make([]byte, sessionKeyLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// newAuthnResult builds the authentication result for a verified user.
+func newAuthnResult(userID string) *common.AuthnResult {
+	return &common.AuthnResult{
+		Token:               map[string]interface{}{common.UserAttributeUserID: userID},
+		AuthenticatedClaims: map[string]interface{}{common.UserAttributeUserID: userID},
+	}
+}
@@ -0,0 +1,397 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/hashmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/jtimock"
+)
+
+const (
+	testUserID       = "user123"
+	testSessionToken = "session_token_123"
+	// testSecret is the base32 encoding of the RFC 6238 SHA-1 test secret "12345678901234567890".
+	//nolint:gosec // Test vector, not a credential
+	testSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	// testCode is the six digit code of testSecret at testTime.
+	testCode          = "081804"
+	testEncryptedData = "encrypted-secret"
+)
+
+// testTime is 1111111109 seconds past the epoch, one of the RFC 6238 test vector times.
+var testTime = time.Unix(1111111109, 0)
+
+type TOTPServiceTestSuite struct {
+	suite.Suite
+	mockEntityService *entitymock.EntityServiceInterfaceMock
+	mockHashService   *hashmock.HashServiceInterfaceMock
+	mockCryptoService *cryptomock.ConfigCryptoProviderMock
+	mockSessionStore  *sessionStoreInterfaceMock
+	mockJTIStore      *jtimock.JTIStoreInterfaceMock
+	service           *totpService
+}
+
+func TestTOTPServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TOTPServiceTestSuite))
+}
+
+func (suite *TOTPServiceTestSuite) SetupTest() {
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockHashService = hashmock.NewHashServiceInterfaceMock(suite.T())
+	suite.mockCryptoService = cryptomock.NewConfigCryptoProviderMock(suite.T())
+	suite.mockSessionStore = newSessionStoreInterfaceMock(suite.T())
+	suite.mockJTIStore = jtimock.NewJTIStoreInterfaceMock(suite.T())
+
+	suite.service = &totpService{
+		entityService: suite.mockEntityService,
+		hashService:   suite.mockHashService,
+		cryptoService: suite.mockCryptoService,
+		sessionStore:  suite.mockSessionStore,
+		jtiStore:      suite.mockJTIStore,
+		issuer:        "ThunderID",
+		digits:        defaultDigits,
+		periodSeconds: defaultPeriodSeconds,
+		skew:          defaultSkew,
+		recoveryCodes: 3,
+		now:           func() time.Time { return testTime },
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+func (suite *TOTPServiceTestSuite) storedCredential(recoveryCodes ...entity.StoredCredential) []entity.StoredCredential {
+	credentialJSON, err := json.Marshal(totpCredential{
+		Secret:        testEncryptedData,
+		Digits:        defaultDigits,
+		PeriodSeconds: defaultPeriodSeconds,
+		RecoveryCodes: recoveryCodes,
+	})
+	suite.Require().NoError(err)
+	return []entity.StoredCredential{{Value: string(credentialJSON)}}
+}
+
+func (suite *TOTPServiceTestSuite) TestNewTOTPService_AppliesDefaults() {
+	svc := newTOTPService(nil, nil, nil, nil, nil, config.TOTPConfig{Digits: 4, Skew: -1}).(*totpService)
+
+	suite.Equal(defaultDigits, svc.digits)
+	suite.Equal(defaultPeriodSeconds, svc.periodSeconds)
+	suite.Equal(defaultSkew, svc.skew)
+	suite.Equal(defaultRecoveryCodeCount, svc.recoveryCodes)
+}
+
+func (suite *TOTPServiceTestSuite) TestNewTOTPService_KeepsConfiguredValues() {
+	svc := newTOTPService(nil, nil, nil, nil, nil, config.TOTPConfig{
+		Issuer: "Acme", Digits: 8, PeriodSeconds: 60, Skew: 0, RecoveryCodeCount: 5,
+	}).(*totpService)
+
+	suite.Equal("Acme", svc.issuer)
+	suite.Equal(8, svc.digits)
+	suite.Equal(60, svc.periodSeconds)
+	suite.Equal(0, svc.skew)
+	suite.Equal(5, svc.recoveryCodes)
+}
+
+func (suite *TOTPServiceTestSuite) TestStartEnrollment_InvalidRequest() {
+	result, svcErr := suite.service.StartEnrollment(context.Background(), &TOTPEnrollmentStartRequest{})
+
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidRequest.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestStartEnrollment_UserNotFound() {
+	suite.mockEntityService.On("GetEntity", mock.Anything, testUserID).Return(nil, entity.ErrEntityNotFound)
+
+	result, svcErr := suite.service.StartEnrollment(context.Background(),
+		&TOTPEnrollmentStartRequest{UserID: testUserID})
+
+	suite.Nil(result)
+	suite.Equal(ErrorUserNotFound.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestStartEnrollment_Success() {
+	suite.mockEntityService.On("GetEntity", mock.Anything, testUserID).Return(&providers.Entity{
+		ID:         testUserID,
+		Attributes: json.RawMessage(`{"username":"alice"}`),
+	}, nil)
+	suite.mockHashService.On("Generate", mock.Anything).Return(cryptolib.Credential{
+		Algorithm: cryptolib.PBKDF2,
+		Hash:      "hashed",
+	}, nil).Times(3)
+	suite.mockSessionStore.On("storeSession", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(session *enrollmentSession) bool {
+			return session.UserID == testUserID && session.Secret != "" && len(session.RecoveryCodes) == 3 &&
+				session.RecoveryCodes[0].Value == "hashed"
+		}), int64(enrollmentTTLSeconds)).Return(nil)
+
+	result, svcErr := suite.service.StartEnrollment(context.Background(),
+		&TOTPEnrollmentStartRequest{UserID: testUserID})
+
+	suite.Nil(svcErr)
+	suite.NotEmpty(result.Secret)
+	suite.NotEmpty(result.SessionToken)
+	suite.Len(result.RecoveryCodes, 3)
+	suite.True(strings.HasPrefix(result.ProvisioningURI, "otpauth://totp/ThunderID:alice?"))
+	suite.Contains(result.ProvisioningURI, "secret="+result.Secret)
+}
+
+func (suite *TOTPServiceTestSuite) TestStartEnrollment_SessionStoreError() {
+	suite.mockEntityService.On("GetEntity", mock.Anything, testUserID).
+		Return(&providers.Entity{ID: testUserID}, nil)
+	suite.mockHashService.On("Generate", mock.Anything).Return(cryptolib.Credential{Hash: "hashed"}, nil)
+	suite.mockSessionStore.On("storeSession", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("store error"))
+
+	result, svcErr := suite.service.StartEnrollment(context.Background(),
+		&TOTPEnrollmentStartRequest{UserID: testUserID})
+
+	suite.Nil(result)
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestFinishEnrollment_SessionExpired() {
+	suite.mockSessionStore.On("retrieveSession", mock.Anything, testSessionToken).Return(nil, nil)
+
+	result, svcErr := suite.service.FinishEnrollment(context.Background(),
+		&TOTPEnrollmentFinishRequest{SessionToken: testSessionToken, Code: testCode})
+
+	suite.Nil(result)
+	suite.Equal(ErrorSessionExpired.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestFinishEnrollment_InvalidCode() {
+	suite.mockSessionStore.On("retrieveSession", mock.Anything, testSessionToken).
+		Return(&enrollmentSession{UserID: testUserID, Secret: testSecret}, nil)
+
+	result, svcErr := suite.service.FinishEnrollment(context.Background(),
+		&TOTPEnrollmentFinishRequest{SessionToken: testSessionToken, Code: "000000"})
+
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidCode.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestFinishEnrollment_Success() {
+	recoveryCodes := []entity.StoredCredential{{Value: "hashed"}}
+	suite.mockSessionStore.On("retrieveSession", mock.Anything, testSessionToken).
+		Return(&enrollmentSession{UserID: testUserID, Secret: testSecret, RecoveryCodes: recoveryCodes}, nil)
+	suite.mockJTIStore.On("RecordJTI", mock.Anything, codeReplayNamespace, mock.Anything, mock.Anything).
+		Return(true, nil)
+	suite.mockCryptoService.On("Encrypt", mock.Anything, []byte(testSecret)).
+		Return([]byte(testEncryptedData), nil)
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID,
+		mock.MatchedBy(func(payload json.RawMessage) bool {
+			var creds map[string][]entity.StoredCredential
+			if json.Unmarshal(payload, &creds) != nil || len(creds[CredentialType]) != 1 {
+				return false
+			}
+			var stored totpCredential
+			if json.Unmarshal([]byte(creds[CredentialType][0].Value), &stored) != nil {
+				return false
+			}
+			return stored.Secret == testEncryptedData && len(stored.RecoveryCodes) == 1 &&
+				stored.EnrolledAt == testTime.Unix()
+		})).Return(nil)
+	suite.mockSessionStore.On("deleteSession", mock.Anything, testSessionToken).Return(nil)
+
+	result, svcErr := suite.service.FinishEnrollment(context.Background(),
+		&TOTPEnrollmentFinishRequest{SessionToken: testSessionToken, Code: testCode})
+
+	suite.Nil(svcErr)
+	suite.Equal(testUserID, result.AuthenticatedClaims[common.UserAttributeUserID])
+}
+
+func (suite *TOTPServiceTestSuite) TestAuthenticate_InvalidRequest() {
+	result, svcErr := suite.service.Authenticate(context.Background(),
+		&TOTPAuthenticationRequest{UserID: testUserID})
+
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidRequest.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestAuthenticate_NotEnrolled() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return([]entity.StoredCredential{}, nil)
+
+	result, svcErr := suite.service.Authenticate(context.Background(),
+		&TOTPAuthenticationRequest{UserID: testUserID, Code: testCode})
+
+	suite.Nil(result)
+	suite.Equal(ErrorNotEnrolled.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestAuthenticate_Success() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(suite.storedCredential(), nil)
+	suite.mockCryptoService.On("Decrypt", mock.Anything, []byte(testEncryptedData)).
+		Return([]byte(testSecret), nil)
+	suite.mockJTIStore.On("RecordJTI", mock.Anything, codeReplayNamespace, testUserID+":37037036",
+		time.Unix(37037038*30, 0)).Return(true, nil)
+
+	result, svcErr := suite.service.Authenticate(context.Background(),
+		&TOTPAuthenticationRequest{UserID: testUserID, Code: testCode})
+
+	suite.Nil(svcErr)
+	suite.Equal(testUserID, result.AuthenticatedClaims[common.UserAttributeUserID])
+}
+
+func (suite *TOTPServiceTestSuite) TestAuthenticate_AcceptsCodeWithinDriftWindow() {
+	// The code of the previous step is still accepted with a skew of one step.
+	suite.service.now = func() time.Time { return testTime.Add(30 * time.Second) }
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(suite.storedCredential(), nil)
+	suite.mockCryptoService.On("Decrypt", mock.Anything, []byte(testEncryptedData)).
+		Return([]byte(testSecret), nil)
+	suite.mockJTIStore.On("RecordJTI", mock.Anything, codeReplayNamespace, testUserID+":37037036",
+		mock.Anything).Return(true, nil)
+
+	result, svcErr := suite.service.Authenticate(context.Background(),
+		&TOTPAuthenticationRequest{UserID: testUserID, Code: testCode})
+
+	suite.Nil(svcErr)
+	suite.NotNil(result)
+}
+
+func (suite *TOTPServiceTestSuite) TestAuthenticate_RejectsCodeOutsideDriftWindow() {
+	suite.service.now = func() time.Time { return testTime.Add(90 * time.Second) }
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(suite.storedCredential(), nil)
+	suite.mockCryptoService.On("Decrypt", mock.Anything, []byte(testEncryptedData)).
+		Return([]byte(testSecret), nil)
+
+	result, svcErr := suite.service.Authenticate(context.Background(),
+		&TOTPAuthenticationRequest{UserID: testUserID, Code: testCode})
+
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidCode.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestAuthenticate_RejectsReplayedCode() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(suite.storedCredential(), nil)
+	suite.mockCryptoService.On("Decrypt", mock.Anything, []byte(testEncryptedData)).
+		Return([]byte(testSecret), nil)
+	suite.mockJTIStore.On("RecordJTI", mock.Anything, codeReplayNamespace, mock.Anything, mock.Anything).
+		Return(false, nil)
+
+	result, svcErr := suite.service.Authenticate(context.Background(),
+		&TOTPAuthenticationRequest{UserID: testUserID, Code: testCode})
+
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidCode.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestAuthenticate_RecoveryCodeConsumed() {
+	first := entity.StoredCredential{StorageAlgo: cryptolib.PBKDF2, Value: "hash-1"}
+	second := entity.StoredCredential{StorageAlgo: cryptolib.PBKDF2, Value: "hash-2"}
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(suite.storedCredential(first, second), nil)
+	suite.mockHashService.On("Verify", []byte("abcdefghjk"),
+		mock.MatchedBy(func(c cryptolib.Credential) bool { return c.Hash == "hash-1" })).Return(false, nil)
+	suite.mockHashService.On("Verify", []byte("abcdefghjk"),
+		mock.MatchedBy(func(c cryptolib.Credential) bool { return c.Hash == "hash-2" })).Return(true, nil)
+	suite.mockJTIStore.On("RecordJTI", mock.Anything, recoveryCodeReplayNamespace, testUserID+":hash-2",
+		testTime.Add(recoveryCodeReplayWindow)).Return(true, nil)
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID,
+		mock.MatchedBy(func(payload json.RawMessage) bool {
+			var creds map[string][]entity.StoredCredential
+			if json.Unmarshal(payload, &creds) != nil || len(creds[CredentialType]) != 1 {
+				return false
+			}
+			var stored totpCredential
+			if json.Unmarshal([]byte(creds[CredentialType][0].Value), &stored) != nil {
+				return false
+			}
+			return len(stored.RecoveryCodes) == 1 && stored.RecoveryCodes[0].Value == "hash-1"
+		})).Return(nil)
+
+	result, svcErr := suite.service.Authenticate(context.Background(),
+		&TOTPAuthenticationRequest{UserID: testUserID, RecoveryCode: "ABCDE-FGHJK"})
+
+	suite.Nil(svcErr)
+	suite.Equal(testUserID, result.AuthenticatedClaims[common.UserAttributeUserID])
+}
+
+func (suite *TOTPServiceTestSuite) TestAuthenticate_InvalidRecoveryCode() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(suite.storedCredential(entity.StoredCredential{Value: "hash-1"}), nil)
+	suite.mockHashService.On("Verify", mock.Anything, mock.Anything).Return(false, nil)
+
+	result, svcErr := suite.service.Authenticate(context.Background(),
+		&TOTPAuthenticationRequest{UserID: testUserID, RecoveryCode: "wrong-code"})
+
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidCode.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestGetEnrollment_Success() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(suite.storedCredential(
+			entity.StoredCredential{Value: "hash-1"}, entity.StoredCredential{Value: "hash-2"}), nil)
+
+	enrollment, svcErr := suite.service.GetEnrollment(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Equal(2, enrollment.RemainingRecoveryCodes)
+}
+
+func (suite *TOTPServiceTestSuite) TestGetEnrollment_NotEnrolled() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(nil, nil)
+
+	enrollment, svcErr := suite.service.GetEnrollment(context.Background(), testUserID)
+
+	suite.Nil(enrollment)
+	suite.Equal(ErrorNotEnrolled.Code, svcErr.Code)
+}
+
+func (suite *TOTPServiceTestSuite) TestRemoveEnrollment_Success() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(suite.storedCredential(), nil)
+	suite.mockEntityService.On("RemoveSystemCredentials", mock.Anything, testUserID, CredentialType).
+		Return(nil).Once()
+
+	svcErr := suite.service.RemoveEnrollment(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *TOTPServiceTestSuite) TestRemoveEnrollment_NotEnrolled() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(nil, nil)
+
+	svcErr := suite.service.RemoveEnrollment(context.Background(), testUserID)
+
+	suite.Equal(ErrorNotEnrolled.Code, svcErr.Code)
+	suite.mockEntityService.AssertNotCalled(suite.T(), "RemoveSystemCredentials", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *TOTPServiceTestSuite) TestRemoveEnrollment_StoreError() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, CredentialType).
+		Return(suite.storedCredential(), nil)
+	suite.mockEntityService.On("RemoveSystemCredentials", mock.Anything, testUserID, CredentialType).
+		Return(errors.New("db down"))
+
+	svcErr := suite.service.RemoveEnrollment(context.Background(), testUserID)
+
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
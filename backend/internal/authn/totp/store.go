@@ -0,0 +1,75 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// sessionStoreInterface defines the interface for pending TOTP enrollment storage.
+type sessionStoreInterface interface {
+	storeSession(ctx context.Context, sessionKey string, session *enrollmentSession, expirySeconds int64) error
+	retrieveSession(ctx context.Context, sessionKey string) (*enrollmentSession, error)
+	deleteSession(ctx context.Context, sessionKey string) error
+}
+
+// sessionStore adapts a runtime store provider to pending enrollment storage. Sessions are stored
+// under the TOTP enrollment namespace, keyed by session key, as a serialized enrollmentSession.
+type sessionStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newSessionStore creates a TOTP enrollment session store backed by the given runtime store provider.
+func newSessionStore(store providers.RuntimeStoreProvider) sessionStoreInterface {
+	return &sessionStore{store: store}
+}
+
+// storeSession serializes the enrollment session and stores it with the given TTL.
+func (s *sessionStore) storeSession(
+	ctx context.Context, sessionKey string, session *enrollmentSession, expirySeconds int64) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal TOTP enrollment session: %w", err)
+	}
+
+	return s.store.Put(ctx, providers.NamespaceTOTPEnrollment, sessionKey, data, expirySeconds)
+}
+
+// retrieveSession retrieves the enrollment session. Returns (nil, nil) when the session is
+// absent or expired.
+func (s *sessionStore) retrieveSession(ctx context.Context, sessionKey string) (*enrollmentSession, error) {
+	if sessionKey == "" {
+		return nil, nil
+	}
+
+	data, err := s.store.Get(ctx, providers.NamespaceTOTPEnrollment, sessionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TOTP enrollment session: %w", err)
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var session enrollmentSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal TOTP enrollment session: %w", err)
+	}
+	return &session, nil
+}
+
+// deleteSession removes the enrollment session.
+func (s *sessionStore) deleteSession(ctx context.Context, sessionKey string) error {
+	if sessionKey == "" {
+		return nil
+	}
+
+	if err := s.store.Delete(ctx, providers.NamespaceTOTPEnrollment, sessionKey); err != nil {
+		return fmt.Errorf("failed to delete TOTP enrollment session: %w", err)
+	}
+	return nil
+}
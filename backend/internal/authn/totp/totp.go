@@ -0,0 +1,118 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // SHA-1 is mandated by RFC 6238 for authenticator-app compatibility.
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// algorithmSHA1 is the HMAC algorithm advertised in provisioning URIs. Authenticator apps
+	// commonly ignore any other value, so it is the only one supported.
+	algorithmSHA1 = "SHA1"
+	// secretSize is the size of generated shared secrets in bytes (160 bits, as recommended by RFC 4226).
+	secretSize = 20
+	// recoveryCodeLength is the number of characters in a recovery code, excluding the separator.
+	recoveryCodeLength = 10
+	// recoveryCodeAlphabet excludes characters that are easily confused with each other.
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateSecret returns a new random shared secret, base32 encoded without padding.
+func generateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return secretEncoding.EncodeToString(secret), nil
+}
+
+// decodeSecret decodes a base32 shared secret. Lowercase and padded secrets are accepted.
+func decodeSecret(secret string) ([]byte, error) {
+	normalized := strings.TrimRight(strings.ToUpper(strings.TrimSpace(secret)), "=")
+	return secretEncoding.DecodeString(normalized)
+}
+
+// generateCode computes the HOTP value (RFC 4226) of the secret for the given counter.
+func generateCode(secret []byte, counter uint64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := int64(binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff)
+
+	modulo := int64(1)
+	for i := 0; i < digits; i++ {
+		modulo *= 10
+	}
+	code := strconv.FormatInt(value%modulo, 10)
+	return strings.Repeat("0", digits-len(code)) + code
+}
+
+// buildProvisioningURI builds the otpauth URI encoded in the QR code scanned by authenticator apps.
+func buildProvisioningURI(issuer, accountName, secret string, digits, periodSeconds int) string {
+	label := url.PathEscape(accountName)
+	if issuer != "" {
+		label = url.PathEscape(issuer) + ":" + label
+	}
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	if issuer != "" {
+		query.Set("issuer", issuer)
+	}
+	query.Set("algorithm", algorithmSHA1)
+	query.Set("digits", strconv.Itoa(digits))
+	query.Set("period", strconv.Itoa(periodSeconds))
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// generateRecoveryCodes returns count random recovery codes formatted as two groups of five
+// characters, for example "k3m9p-x2c7q".
+func generateRecoveryCodes(count int) ([]string, error) {
+	alphabetSize := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	codes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		var sb strings.Builder
+		for j := 0; j < recoveryCodeLength; j++ {
+			if j == recoveryCodeLength/2 {
+				sb.WriteByte('-')
+			}
+			n, err := rand.Int(rand.Reader, alphabetSize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+			}
+			sb.WriteByte(recoveryCodeAlphabet[n.Int64()])
+		}
+		codes = append(codes, sb.String())
+	}
+	return codes, nil
+}
+
+// normalizeRecoveryCode strips separators and whitespace so that recovery codes are accepted
+// regardless of how the user typed them.
+func normalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+}
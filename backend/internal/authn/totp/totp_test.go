@@ -0,0 +1,108 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TOTPUtilsTestSuite struct {
+	suite.Suite
+}
+
+func TestTOTPUtilsTestSuite(t *testing.T) {
+	suite.Run(t, new(TOTPUtilsTestSuite))
+}
+
+// TestGenerateCode_RFC6238Vectors checks the SHA-1 test vectors from RFC 6238 Appendix B.
+func (suite *TOTPUtilsTestSuite) TestGenerateCode_RFC6238Vectors() {
+	secret := []byte("12345678901234567890")
+	vectors := []struct {
+		unixTime int64
+		expected string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, v := range vectors {
+		suite.Equal(v.expected, generateCode(secret, uint64(v.unixTime/30), 8), "time %d", v.unixTime)
+	}
+}
+
+func (suite *TOTPUtilsTestSuite) TestGenerateCode_SixDigitsKeepsLeadingZeros() {
+	secret := []byte("12345678901234567890")
+
+	suite.Equal("081804", generateCode(secret, uint64(1111111109/30), 6))
+}
+
+func (suite *TOTPUtilsTestSuite) TestGenerateSecret_RoundTrip() {
+	secret, err := generateSecret()
+	suite.NoError(err)
+	suite.NotContains(secret, "=")
+
+	decoded, err := decodeSecret(secret)
+	suite.NoError(err)
+	suite.Len(decoded, secretSize)
+
+	decodedLower, err := decodeSecret(strings.ToLower(secret))
+	suite.NoError(err)
+	suite.Equal(decoded, decodedLower)
+}
+
+func (suite *TOTPUtilsTestSuite) TestBuildProvisioningURI() {
+	uri := buildProvisioningURI("Thunder ID", "alice@example.com", "JBSWY3DPEHPK3PXP", 6, 30)
+
+	parsed, err := url.Parse(uri)
+	suite.NoError(err)
+	suite.Equal("otpauth", parsed.Scheme)
+	suite.Equal("totp", parsed.Host)
+	suite.Equal("/Thunder ID:alice@example.com", parsed.Path)
+	query := parsed.Query()
+	suite.Equal("JBSWY3DPEHPK3PXP", query.Get("secret"))
+	suite.Equal("Thunder ID", query.Get("issuer"))
+	suite.Equal("SHA1", query.Get("algorithm"))
+	suite.Equal("6", query.Get("digits"))
+	suite.Equal("30", query.Get("period"))
+}
+
+func (suite *TOTPUtilsTestSuite) TestBuildProvisioningURI_NoIssuer() {
+	uri := buildProvisioningURI("", "alice", "JBSWY3DPEHPK3PXP", 8, 60)
+
+	parsed, err := url.Parse(uri)
+	suite.NoError(err)
+	suite.Equal("/alice", parsed.Path)
+	suite.False(parsed.Query().Has("issuer"))
+	suite.Equal("8", parsed.Query().Get("digits"))
+}
+
+func (suite *TOTPUtilsTestSuite) TestGenerateRecoveryCodes() {
+	codes, err := generateRecoveryCodes(5)
+	suite.NoError(err)
+	suite.Len(codes, 5)
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		suite.Len(code, recoveryCodeLength+1)
+		suite.Equal(byte('-'), code[recoveryCodeLength/2])
+		for _, r := range strings.ReplaceAll(code, "-", "") {
+			suite.True(strings.ContainsRune(recoveryCodeAlphabet, r))
+		}
+		suite.False(seen[code])
+		seen[code] = true
+	}
+}
+
+func (suite *TOTPUtilsTestSuite) TestNormalizeRecoveryCode() {
+	suite.Equal("abcdefghjk", normalizeRecoveryCode(" ABCDE-fghjk "))
+	suite.Equal("abcdefghjk", normalizeRecoveryCode("abcde fghjk"))
+}
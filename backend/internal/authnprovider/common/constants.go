@@ -19,6 +19,8 @@ const (
 	CredentialTypePasskey = "passkey"
 	// CredentialTypeOTP identifies a one time password.
 	CredentialTypeOTP = "otp"
+	// CredentialTypeTOTP identifies an authenticator-app code or recovery code.
+	CredentialTypeTOTP = "totp"
 	// CredentialTypeFederated identifies an authorization code from an external identity provider.
 	CredentialTypeFederated = "federated"
 	// CredentialTypeMagicLink identifies a magic link token.
@@ -29,6 +31,9 @@ const (
 	CredentialTypeClientSecret = "clientSecret"
 	// CredentialTypeFlowSecret is the flow secret authenticating flow initiation.
 	CredentialTypeFlowSecret = "flowSecret"
+	// CredentialTypePasswordHistory holds the previous password hashes of a user and the time of the
+	// last password change.
+	CredentialTypePasswordHistory = "passwordHistory"
 )
 
 // InternalCredentialTypes are the credential types providers dispatch on by key name. Every
@@ -38,6 +43,7 @@ var InternalCredentialTypes = []string{
 	CredentialTypeProvisionedEntityID,
 	CredentialTypePasskey,
 	CredentialTypeOTP,
+	CredentialTypeTOTP,
 	CredentialTypeFederated,
 	CredentialTypeMagicLink,
 	CredentialTypeOpenID4VP,
@@ -45,12 +51,14 @@ var InternalCredentialTypes = []string{
 	UserAttributeSub,
 }
 
-// SystemCredentialTypes are machine credentials of an application or agent. The entity layer
-// verifies credentials by key name across the merged schema and system credentials, so these
-// authenticate the owning entity through any credential verification path.
+// SystemCredentialTypes are machine credentials of an application or agent, and the system
+// managed credential records of a user. The entity layer verifies credentials by key name across
+// the merged schema and system credentials, so these authenticate the owning entity through any
+// credential verification path.
 var SystemCredentialTypes = []string{
 	CredentialTypeClientSecret,
 	CredentialTypeFlowSecret,
+	CredentialTypePasswordHistory,
 }
 
 // FindReservedCredentialType returns the first credential type reserved for internal use, and
@@ -13,4 +13,5 @@ const (
 	ErrorCodeInvalidRequest       = "AUP-0006"
 	ErrorCodeAmbiguousUser        = "AUP-0007"
 	ErrorCodeEnrollmentFailed     = "AUP-0008"
+	ErrorCodeAccountLocked        = "AUP-0009"
 )
@@ -14,10 +14,12 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/lockout"
 	"github.com/thunder-id/thunderid/internal/authn/magiclink"
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -27,25 +29,31 @@ type defaultAuthnProvider struct {
 	entitySvc        entity.EntityServiceInterface
 	passkeyService   passkey.PasskeyServiceInterface
 	otpService       otp.OTPAuthnServiceInterface
+	totpService      totp.TOTPServiceInterface
 	magicLinkService magiclink.MagicLinkAuthnServiceInterface
 	openid4vpService openid4vp.OpenID4VPServiceInterface
 	federatedAuths   map[providers.IDPType]authncommon.FederatedAuthenticator
+	lockoutService   lockout.LockoutServiceInterface
 	logger           *log.Logger
 }
 
 // newDefaultAuthnProvider creates a new internal user authn provider.
 func newDefaultAuthnProvider(entitySvc entity.EntityServiceInterface,
 	passkeyService passkey.PasskeyServiceInterface, otpService otp.OTPAuthnServiceInterface,
+	totpService totp.TOTPServiceInterface,
 	magicLinkService magiclink.MagicLinkAuthnServiceInterface,
 	openid4vpService openid4vp.OpenID4VPServiceInterface,
-	federatedAuths map[providers.IDPType]authncommon.FederatedAuthenticator) providers.AuthnProviderInterface {
+	federatedAuths map[providers.IDPType]authncommon.FederatedAuthenticator,
+	lockoutService lockout.LockoutServiceInterface) providers.AuthnProviderInterface {
 	return &defaultAuthnProvider{
 		entitySvc:        entitySvc,
 		passkeyService:   passkeyService,
 		otpService:       otpService,
+		totpService:      totpService,
 		magicLinkService: magicLinkService,
 		openid4vpService: openid4vpService,
 		federatedAuths:   federatedAuths,
+		lockoutService:   lockoutService,
 		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DefaultAuthnProvider")),
 	}
 }
@@ -173,6 +181,9 @@ func (p *defaultAuthnProvider) InitiateEnrollment(
 	case authnprovidercm.CredentialTypePasskey:
 		// Expect initData to be a PasskeyRegistrationStartRequest struct
 		return p.initiateEnrollmentWithPasskey(ctx, initData)
+	case authnprovidercm.CredentialTypeTOTP:
+		// Expect initData to be a TOTPEnrollmentStartRequest struct
+		return p.initiateEnrollmentWithTOTP(ctx, initData)
 	default:
 		return nil, p.logAndReturnServerError(ctx, "Unsupported credential type for enrollment initiation",
 			log.String("credentialType", credentialType))
@@ -237,6 +248,26 @@ func (p *defaultAuthnProvider) initiateEnrollmentWithPasskey(
 	return result, nil
 }
 
+func (p *defaultAuthnProvider) initiateEnrollmentWithTOTP(
+	ctx context.Context, initData any) (any, *tidcommon.ServiceError) {
+	req, ok := initData.(*totp.TOTPEnrollmentStartRequest)
+	if !ok || req == nil {
+		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+			"Invalid TOTP enrollment init payload", "The provided TOTP init data is invalid")
+	}
+	result, svcErr := p.totpService.StartEnrollment(ctx, req)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			return nil, newClientError(authnprovidercm.ErrorCodeEnrollmentFailed,
+				svcErr.Error.DefaultValue, svcErr.ErrorDescription.DefaultValue)
+		}
+		return nil, p.logAndReturnServerError(ctx, "TOTP enrollment initiation failed with server error",
+			log.String("error", svcErr.Error.DefaultValue),
+			log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
+	}
+	return result, nil
+}
+
 func (p *defaultAuthnProvider) enrollWithCredential(
 	ctx context.Context,
 	credentials map[string]interface{},
@@ -244,6 +275,9 @@ func (p *defaultAuthnProvider) enrollWithCredential(
 	if passkeyCredential, ok := credentials[authnprovidercm.CredentialTypePasskey]; ok {
 		return p.enrollWithPasskey(ctx, passkeyCredential)
 	}
+	if totpCredential, ok := credentials[authnprovidercm.CredentialTypeTOTP]; ok {
+		return p.enrollWithTOTP(ctx, totpCredential)
+	}
 	return nil, p.logAndReturnServerError(ctx, "Unsupported credential type for enrollment",
 		log.String("credentialTypes", fmt.Sprintf("%v", reflect.ValueOf(credentials).MapKeys())))
 }
@@ -271,6 +305,29 @@ func (p *defaultAuthnProvider) enrollWithPasskey(
 	return result, nil
 }
 
+// enrollWithTOTP confirms an authenticator app enrollment using the TOTP service.
+// The raw credential is expected to be a TOTPEnrollmentFinishRequest struct.
+func (p *defaultAuthnProvider) enrollWithTOTP(
+	ctx context.Context, totpCredential interface{},
+) (*authncommon.AuthnResult, *tidcommon.ServiceError) {
+	req, ok := totpCredential.(*totp.TOTPEnrollmentFinishRequest)
+	if !ok || req == nil {
+		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+			"Invalid TOTP enrollment finish payload", "The provided TOTP enrollment data is invalid")
+	}
+	result, svcErr := p.totpService.FinishEnrollment(ctx, req)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			return nil, newClientError(authnprovidercm.ErrorCodeEnrollmentFailed,
+				svcErr.Error.DefaultValue, svcErr.ErrorDescription.DefaultValue)
+		}
+		return nil, p.logAndReturnServerError(ctx, "TOTP enrollment failed with server error",
+			log.String("error", svcErr.Error.DefaultValue),
+			log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
+	}
+	return result, nil
+}
+
 func (p *defaultAuthnProvider) buildAuthnResult(
 	ctx context.Context, authnResult *authncommon.AuthnResult,
 ) (*providers.AuthnResult, *tidcommon.ServiceError) {
@@ -373,6 +430,9 @@ func (p *defaultAuthnProvider) authenticateWithCredential(
 	if otpCredential, ok := credentials[authnprovidercm.CredentialTypeOTP]; ok {
 		return p.authenticateWithOTP(ctx, otpCredential)
 	}
+	if totpCredential, ok := credentials[authnprovidercm.CredentialTypeTOTP]; ok {
+		return p.authenticateWithTOTP(ctx, totpCredential)
+	}
 	if fedCred, ok := credentials[authnprovidercm.CredentialTypeFederated]; ok {
 		return p.authenticateWithFederated(ctx, fedCred)
 	}
@@ -460,6 +520,33 @@ func (p *defaultAuthnProvider) authenticateWithOTP(
 	return result, nil
 }
 
+// authenticateWithTOTP authenticates the user using the TOTP service.
+// The raw credential is expected to be a TOTPAuthenticationRequest struct.
+func (p *defaultAuthnProvider) authenticateWithTOTP(
+	ctx context.Context, raw interface{},
+) (*authncommon.AuthnResult, *tidcommon.ServiceError) {
+	req, ok := raw.(*totp.TOTPAuthenticationRequest)
+	if !ok || req == nil {
+		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+			"Invalid TOTP payload", "The provided TOTP credential is invalid")
+	}
+	result, authErr := p.totpService.Authenticate(ctx, req)
+	if authErr != nil {
+		if authErr.Type == tidcommon.ClientErrorType {
+			if authErr.Code == totp.ErrorInvalidRequest.Code {
+				return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+					authErr.Error.DefaultValue, authErr.ErrorDescription.DefaultValue)
+			}
+			return nil, newClientError(authnprovidercm.ErrorCodeAuthenticationFailed,
+				authErr.Error.DefaultValue, authErr.ErrorDescription.DefaultValue)
+		}
+		return nil, p.logAndReturnServerError(ctx, "TOTP authentication failed with server error",
+			log.String("error", authErr.Error.DefaultValue),
+			log.String("errorDescription", authErr.ErrorDescription.DefaultValue))
+	}
+	return result, nil
+}
+
 // authenticateWithFederated authenticates the user using a federated identity provider.
 // The raw credential is expected to be a FederatedAuthCredential struct with non-empty IDP ID and authorization code.
 func (p *defaultAuthnProvider) authenticateWithFederated(
@@ -558,9 +645,10 @@ func (p *defaultAuthnProvider) authenticateByUserID(
 		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
 			"Invalid user ID", "The provided userID is invalid")
 	}
-	result, authErr := p.entitySvc.AuthenticateEntityByID(ctx, userIDStr, credentials)
-	if authErr != nil {
-		return nil, p.handleEntityAuthError(ctx, authErr, "Basic authentication by ID failed with server error")
+	result, svcErr := p.authenticateEntityByID(ctx, userIDStr, credentials,
+		"Basic authentication by ID failed with server error")
+	if svcErr != nil {
+		return nil, svcErr
 	}
 	return &authncommon.AuthnResult{
 		Token:               map[string]interface{}{authnprovidercm.UserAttributeUserID: result.EntityID},
@@ -572,9 +660,33 @@ func (p *defaultAuthnProvider) authenticateByUserID(
 func (p *defaultAuthnProvider) authenticateByIdentifiers(
 	ctx context.Context, identifiers, credentials map[string]interface{},
 ) (*authncommon.AuthnResult, *tidcommon.ServiceError) {
-	result, authErr := p.entitySvc.AuthenticateEntity(ctx, identifiers, credentials)
-	if authErr != nil {
-		return nil, p.handleEntityAuthError(ctx, authErr, "Basic authentication failed with server error")
+	if !p.isLockoutEnabled() || len(identifiers) == 0 {
+		result, authErr := p.entitySvc.AuthenticateEntity(ctx, identifiers, credentials)
+		if authErr != nil {
+			return nil, p.handleEntityAuthError(ctx, authErr, "Basic authentication failed with server error")
+		}
+		return &authncommon.AuthnResult{
+			Token:               map[string]interface{}{authnprovidercm.UserAttributeUserID: result.EntityID},
+			AuthenticatedClaims: map[string]interface{}{authnprovidercm.UserAttributeUserID: result.EntityID},
+		}, nil
+	}
+
+	// Identify the user first so that failed attempts are counted against the resolved user.
+	entityID, identifyErr := p.entitySvc.IdentifyEntity(ctx, identifiers)
+	if identifyErr != nil {
+		if errors.Is(identifyErr, entity.ErrEntityNotFound) {
+			// Attempts against unknown users still count towards the client IP address.
+			if svcErr := p.recordFailedAttempt(ctx, ""); svcErr != nil {
+				return nil, svcErr
+			}
+		}
+		return nil, p.handleEntityAuthError(ctx, identifyErr, "Basic authentication failed with server error")
+	}
+
+	result, svcErr := p.authenticateEntityByID(ctx, *entityID, credentials,
+		"Basic authentication failed with server error")
+	if svcErr != nil {
+		return nil, svcErr
 	}
 	return &authncommon.AuthnResult{
 		Token:               map[string]interface{}{authnprovidercm.UserAttributeUserID: result.EntityID},
@@ -582,6 +694,70 @@ func (p *defaultAuthnProvider) authenticateByIdentifiers(
 	}, nil
 }
 
+// authenticateEntityByID verifies the credentials of the entity. When account lockout is enabled,
+// the outcome of the verification updates the failed-attempt counters. A locked user or client IP
+// address is refused before the credentials are checked, so the answer does not depend on the
+// password and a lockout cannot be used to tell a correct guess from a wrong one. Attempts made while
+// locked still count, so continued guessing extends the lockout.
+func (p *defaultAuthnProvider) authenticateEntityByID(
+	ctx context.Context, entityID string, credentials map[string]interface{}, serverMsg string,
+) (*entity.AuthenticateResult, *tidcommon.ServiceError) {
+	if !p.isLockoutEnabled() {
+		result, authErr := p.entitySvc.AuthenticateEntityByID(ctx, entityID, credentials)
+		if authErr != nil {
+			return nil, p.handleEntityAuthError(ctx, authErr, serverMsg)
+		}
+		return result, nil
+	}
+
+	status, svcErr := p.lockoutService.CheckLocked(ctx, entityID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if status.Locked {
+		p.logger.Debug(ctx, "Rejected authentication for a locked account",
+			log.MaskedString(log.LoggerKeyUserID, entityID))
+		if svcErr := p.recordFailedAttempt(ctx, entityID); svcErr != nil {
+			return nil, svcErr
+		}
+		return nil, newAccountLockedError()
+	}
+
+	result, authErr := p.entitySvc.AuthenticateEntityByID(ctx, entityID, credentials)
+	if authErr != nil {
+		if errors.Is(authErr, entity.ErrAuthenticationFailed) {
+			if svcErr := p.recordFailedAttempt(ctx, entityID); svcErr != nil {
+				return nil, svcErr
+			}
+		}
+		return nil, p.handleEntityAuthError(ctx, authErr, serverMsg)
+	}
+
+	if svcErr := p.lockoutService.RecordSuccess(ctx, entityID); svcErr != nil {
+		p.logger.Warn(ctx, "Failed to clear failed authentication attempts",
+			log.MaskedString(log.LoggerKeyUserID, entityID))
+	}
+	return result, nil
+}
+
+// recordFailedAttempt records a failed attempt against the user and the client IP address. The
+// resulting lock state is not surfaced here; the caller reports the attempt as a plain failure.
+func (p *defaultAuthnProvider) recordFailedAttempt(ctx context.Context, entityID string) *tidcommon.ServiceError {
+	_, svcErr := p.lockoutService.RecordFailure(ctx, entityID)
+	return svcErr
+}
+
+// isLockoutEnabled reports whether failed credential attempts are tracked.
+func (p *defaultAuthnProvider) isLockoutEnabled() bool {
+	return p.lockoutService != nil && p.lockoutService.IsEnabled()
+}
+
+// newAccountLockedError returns the error for an attempt against a locked user or client IP address.
+func newAccountLockedError() *tidcommon.ServiceError {
+	return newClientError(authnprovidercm.ErrorCodeAccountLocked,
+		"Account locked", "The account is temporarily locked due to repeated failed authentication attempts")
+}
+
 func (p *defaultAuthnProvider) handleEntityAuthError(
 	ctx context.Context, err error, serverMsg string) *tidcommon.ServiceError {
 	if errors.Is(err, entity.ErrEntityNotFound) {
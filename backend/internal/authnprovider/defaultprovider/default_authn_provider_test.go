@@ -19,14 +19,18 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/lockout"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/commonmock"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/lockoutmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/magiclinkmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/otpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/passkeymock"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/totpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 )
 
@@ -42,7 +46,7 @@ func (suite *DefaultAuthnProviderTestSuite) SetupTest() {
 	suite.mockService = entitymock.NewEntityServiceInterfaceMock(suite.T())
 	suite.mockPasskey = passkeymock.NewPasskeyServiceInterfaceMock(suite.T())
 	suite.mockFederated = commonmock.NewFederatedAuthenticatorMock(suite.T())
-	suite.provider = Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	suite.provider = Initialize(suite.mockService, nil, nil, nil, nil, nil, nil, nil)
 }
 
 func TestDefaultAuthnProviderTestSuite(t *testing.T) {
@@ -400,7 +404,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Provisioning_GetEnt
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_ServerError() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -428,7 +432,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_Serv
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_Success_ThenGetEntity() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -467,7 +471,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_Succ
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_GetEntityFails() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -763,7 +767,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestGetAttributes_InvalidTokenFormat
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_IncorrectOTP() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -784,7 +788,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_IncorrectOTP()
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_InvalidPayload() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": "not-a-map",
@@ -799,7 +803,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_InvalidPayload(
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingSessionToken() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -816,7 +820,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingSessionT
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingOTPValue() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -833,7 +837,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingOTPValue
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ClientError_NonIncorrectOTP() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -859,7 +863,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ClientError_Non
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ServerError() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -887,7 +891,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ServerError() {
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_AuthenticationFailed() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": map[string]interface{}{
@@ -912,7 +916,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_Authentic
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_ServerError() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": map[string]interface{}{
@@ -937,7 +941,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_ServerErr
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_InvalidPayload() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": "not-a-map",
@@ -952,7 +956,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_InvalidPa
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_MissingToken() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := Initialize(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": map[string]interface{}{},
@@ -982,7 +986,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Entit
 				"otp":          "123456",
 			},
 		}
-		return Initialize(suite.mockService, nil, mockOTP, nil, nil, nil), creds, token
+		return Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil), creds, token
 	}
 
 	setupMagicLink := func() (providers.AuthnProviderInterface, map[string]interface{}, map[string]interface{}) {
@@ -999,7 +1003,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Entit
 				"subjectAttribute": "",
 			},
 		}
-		return Initialize(suite.mockService, nil, nil, mockML, nil, nil), creds, token
+		return Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil, nil), creds, token
 	}
 
 	tests := []struct {
@@ -1051,7 +1055,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Ident
 				"otp":          "123456",
 			},
 		}
-		return Initialize(suite.mockService, nil, mockOTP, nil, nil, nil), creds, token
+		return Initialize(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil), creds, token
 	}
 
 	setupMagicLink := func() (providers.AuthnProviderInterface, map[string]interface{}, map[string]interface{}) {
@@ -1068,7 +1072,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Ident
 				"subjectAttribute": "email",
 			},
 		}
-		return Initialize(suite.mockService, nil, nil, mockML, nil, nil), creds, token
+		return Initialize(suite.mockService, nil, nil, nil, mockML, nil, nil, nil), creds, token
 	}
 
 	tests := []struct {
@@ -1102,7 +1106,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Ident
 // --- Passkey authentication tests ---
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_InvalidPayload() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": "not-a-passkey-struct",
@@ -1116,7 +1120,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_InvalidPayl
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_NilPayload() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": (*passkey.PasskeyAuthenticationFinishRequest)(nil),
@@ -1132,7 +1136,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_NilPayload(
 // --- Federated authentication tests ---
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_InvalidPayload() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": "not-a-struct",
@@ -1146,7 +1150,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_InvalidPa
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_NilPayload() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": (*authncommon.FederatedAuthCredential)(nil),
@@ -1160,7 +1164,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_NilPayloa
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingIDPID() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1177,7 +1181,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingID
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingCode() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1194,8 +1198,8 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingCo
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_UnsupportedIDPType() {
-	provider := Initialize(suite.mockService, nil, nil, nil, nil,
-		map[providers.IDPType]authncommon.FederatedAuthenticator{})
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil,
+		map[providers.IDPType]authncommon.FederatedAuthenticator{}, nil)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1221,7 +1225,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_Success() {
 			Token:               passkeyToken,
 			AuthenticatedClaims: map[string]interface{}{"userID": "pk-user-1"},
 		}, nil).Once()
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": &passkey.PasskeyAuthenticationFinishRequest{
@@ -1256,7 +1260,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_AuthFailed(
 			Error:            tidcommon.I18nMessage{DefaultValue: "Passkey auth failed"},
 			ErrorDescription: tidcommon.I18nMessage{DefaultValue: "Invalid passkey credential"},
 		}).Once()
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": &passkey.PasskeyAuthenticationFinishRequest{
@@ -1284,7 +1288,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_Success()
 	federatedAuths := map[providers.IDPType]authncommon.FederatedAuthenticator{
 		providers.IDPType("google"): suite.mockFederated,
 	}
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, federatedAuths)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, federatedAuths, nil)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1327,7 +1331,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_ClientErr
 	federatedAuths := map[providers.IDPType]authncommon.FederatedAuthenticator{
 		providers.IDPType("google"): suite.mockFederated,
 	}
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, federatedAuths)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, federatedAuths, nil)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1356,7 +1360,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_ServerErr
 	federatedAuths := map[providers.IDPType]authncommon.FederatedAuthenticator{
 		providers.IDPType("google"): suite.mockFederated,
 	}
-	provider := Initialize(suite.mockService, nil, nil, nil, nil, federatedAuths)
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, federatedAuths, nil)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1374,7 +1378,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_ServerErr
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestInitiateAuthentication_Passkey() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil, nil)
 	req := &passkey.PasskeyAuthenticationStartRequest{UserID: "user123", RelyingPartyID: "example.com"}
 	startData := &passkey.PasskeyAuthenticationStartData{SessionToken: "sess-1"}
 	suite.mockPasskey.On("StartAuthentication", mock.Anything, req).Return(startData, nil).Once()
@@ -1394,7 +1398,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestInitiateAuthentication_Unsupport
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestInitiateAuthentication_InvalidPayload() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil, nil)
 
 	result, err := provider.InitiateAuthentication(context.Background(), passkey.CredentialType, "bad", nil)
 
@@ -1404,7 +1408,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestInitiateAuthentication_InvalidPa
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestInitiateEnrollment_Passkey() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil, nil)
 	req := &passkey.PasskeyRegistrationStartRequest{UserID: "user123", RelyingPartyID: "example.com"}
 	startData := &passkey.PasskeyRegistrationStartData{SessionToken: "sess-1"}
 	suite.mockPasskey.On("StartRegistration", mock.Anything, req).Return(startData, nil).Once()
@@ -1416,7 +1420,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestInitiateEnrollment_Passkey() {
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestInitiateEnrollment_InvalidPayload() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil, nil)
 
 	result, err := provider.InitiateEnrollment(context.Background(), passkey.CredentialType, 42, nil)
 
@@ -1426,7 +1430,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestInitiateEnrollment_InvalidPayloa
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestEnroll_Passkey_Success() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil, nil)
 	req := &passkey.PasskeyRegistrationFinishRequest{CredentialID: "cred-1"}
 	credentials := map[string]interface{}{"passkey": req}
 	suite.mockPasskey.On("FinishRegistration", mock.Anything, req).
@@ -1459,8 +1463,83 @@ func (suite *DefaultAuthnProviderTestSuite) TestEnroll_NilCredentials() {
 	suite.Equal(authnprovidercm.ErrorCodeEnrollmentFailed, err.Code)
 }
 
+// --- TOTP tests ---
+
+func (suite *DefaultAuthnProviderTestSuite) TestInitiateEnrollment_TOTP() {
+	mockTOTP := totpmock.NewTOTPServiceInterfaceMock(suite.T())
+	provider := Initialize(suite.mockService, nil, nil, mockTOTP, nil, nil, nil, nil)
+	req := &totp.TOTPEnrollmentStartRequest{UserID: "user123"}
+	startData := &totp.TOTPEnrollmentStartData{SessionToken: "sess-1"}
+	mockTOTP.On("StartEnrollment", mock.Anything, req).Return(startData, nil).Once()
+
+	result, err := provider.InitiateEnrollment(context.Background(), totp.CredentialType, req, nil)
+
+	suite.Nil(err)
+	suite.Equal(startData, result)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestEnroll_TOTP_InvalidCode() {
+	mockTOTP := totpmock.NewTOTPServiceInterfaceMock(suite.T())
+	provider := Initialize(suite.mockService, nil, nil, mockTOTP, nil, nil, nil, nil)
+	req := &totp.TOTPEnrollmentFinishRequest{SessionToken: "sess-1", Code: "000000"}
+	mockTOTP.On("FinishEnrollment", mock.Anything, req).Return(nil, &totp.ErrorInvalidCode).Once()
+
+	result, err := provider.Enroll(context.Background(), nil, map[string]interface{}{"totp": req}, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeEnrollmentFailed, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TOTP_Success() {
+	mockTOTP := totpmock.NewTOTPServiceInterfaceMock(suite.T())
+	provider := Initialize(suite.mockService, nil, nil, mockTOTP, nil, nil, nil, nil)
+	req := &totp.TOTPAuthenticationRequest{UserID: "user123", Code: "123456"}
+	mockTOTP.On("Authenticate", mock.Anything, req).
+		Return(&authncommon.AuthnResult{
+			Token:               map[string]interface{}{"userID": "user123"},
+			AuthenticatedClaims: map[string]interface{}{"userID": "user123"},
+		}, nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user123").Return(&providers.Entity{
+		ID:         "user123",
+		Category:   providers.EntityCategoryUser,
+		Type:       "customer",
+		OUID:       "ou1",
+		Attributes: json.RawMessage(`{}`),
+	}, nil).Once()
+
+	result, err := provider.Authenticate(context.Background(), nil, map[string]interface{}{"totp": req}, nil)
+
+	suite.Nil(err)
+	suite.Equal("user123", result.EntityReference.EntityID)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TOTP_InvalidCode() {
+	mockTOTP := totpmock.NewTOTPServiceInterfaceMock(suite.T())
+	provider := Initialize(suite.mockService, nil, nil, mockTOTP, nil, nil, nil, nil)
+	req := &totp.TOTPAuthenticationRequest{UserID: "user123", Code: "000000"}
+	mockTOTP.On("Authenticate", mock.Anything, req).Return(nil, &totp.ErrorInvalidCode).Once()
+
+	result, err := provider.Authenticate(context.Background(), nil, map[string]interface{}{"totp": req}, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TOTP_InvalidPayload() {
+	provider := Initialize(suite.mockService, nil, nil, nil, nil, nil, nil, nil)
+
+	result, err := provider.Authenticate(context.Background(), nil,
+		map[string]interface{}{"totp": "not-a-request-struct"}, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeInvalidRequest, err.Code)
+}
+
 func (suite *DefaultAuthnProviderTestSuite) TestEnroll_Passkey_InvalidPayload() {
-	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := Initialize(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil, nil)
 	credentials := map[string]interface{}{"passkey": "not-a-request-struct"}
 
 	result, err := provider.Enroll(context.Background(), nil, credentials, nil)
@@ -1563,3 +1642,136 @@ func (suite *DefaultAuthnProviderTestSuite) parseFile(filename string) *ast.File
 	suite.Require().NoError(err, "failed to parse %s", filename)
 	return file
 }
+
+// --- Account lockout tests ---
+
+func (suite *DefaultAuthnProviderTestSuite) newLockoutProvider() (
+	providers.AuthnProviderInterface, *lockoutmock.LockoutServiceInterfaceMock) {
+	mockLockout := lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+	mockLockout.On("IsEnabled").Return(true).Maybe()
+	return Initialize(suite.mockService, nil, nil, nil, nil, nil, nil, mockLockout), mockLockout
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_LockedUserRejected() {
+	provider, mockLockout := suite.newLockoutProvider()
+	identifiers := map[string]interface{}{"username": "testuser"}
+	credentials := map[string]interface{}{"password": "password123"}
+	entityID := "user123"
+
+	suite.mockService.On("IdentifyEntity", mock.Anything, identifiers).Return(&entityID, nil).Once()
+	mockLockout.On("CheckLocked", mock.Anything, entityID).Return(&lockout.LockStatus{Locked: true}, nil).Once()
+	mockLockout.On("RecordFailure", mock.Anything, entityID).
+		Return(&lockout.LockStatus{Locked: true}, nil).Once()
+
+	result, err := provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAccountLocked, err.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "AuthenticateEntityByID", mock.Anything, mock.Anything,
+		mock.Anything)
+	mockLockout.AssertNotCalled(suite.T(), "RecordSuccess", mock.Anything, mock.Anything)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_LockedUserResponseIndependentOfPassword() {
+	provider, mockLockout := suite.newLockoutProvider()
+	identifiers := map[string]interface{}{"username": "testuser"}
+	entityID := "user123"
+	suite.mockService.On("IdentifyEntity", mock.Anything, identifiers).Return(&entityID, nil).Twice()
+	mockLockout.On("CheckLocked", mock.Anything, entityID).Return(&lockout.LockStatus{Locked: true}, nil).Twice()
+	mockLockout.On("RecordFailure", mock.Anything, entityID).
+		Return(&lockout.LockStatus{Locked: true}, nil).Twice()
+
+	_, correctErr := provider.Authenticate(context.Background(), identifiers,
+		map[string]interface{}{"password": "password123"}, nil)
+	_, wrongErr := provider.Authenticate(context.Background(), identifiers,
+		map[string]interface{}{"password": "wrongpassword"}, nil)
+
+	suite.Require().NotNil(correctErr)
+	suite.Equal(correctErr, wrongErr)
+	suite.mockService.AssertNotCalled(suite.T(), "AuthenticateEntityByID", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_FailureRecorded() {
+	provider, mockLockout := suite.newLockoutProvider()
+	identifiers := map[string]interface{}{"userID": "user123"}
+	credentials := map[string]interface{}{"password": "wrongpassword"}
+
+	mockLockout.On("CheckLocked", mock.Anything, "user123").Return(&lockout.LockStatus{}, nil).Once()
+	suite.mockService.On("AuthenticateEntityByID", mock.Anything, "user123", credentials).
+		Return(nil, entity.ErrAuthenticationFailed).Once()
+	mockLockout.On("RecordFailure", mock.Anything, "user123").Return(&lockout.LockStatus{}, nil).Once()
+
+	result, err := provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_FailureLockingAccountNotRevealed() {
+	provider, mockLockout := suite.newLockoutProvider()
+	identifiers := map[string]interface{}{"userID": "user123"}
+	credentials := map[string]interface{}{"password": "wrongpassword"}
+
+	mockLockout.On("CheckLocked", mock.Anything, "user123").Return(&lockout.LockStatus{}, nil).Once()
+	suite.mockService.On("AuthenticateEntityByID", mock.Anything, "user123", credentials).
+		Return(nil, entity.ErrAuthenticationFailed).Once()
+	mockLockout.On("RecordFailure", mock.Anything, "user123").
+		Return(&lockout.LockStatus{Locked: true}, nil).Once()
+
+	result, err := provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_UnknownUserCountsTowardsIP() {
+	provider, mockLockout := suite.newLockoutProvider()
+	identifiers := map[string]interface{}{"username": "nobody"}
+	credentials := map[string]interface{}{"password": "password123"}
+
+	suite.mockService.On("IdentifyEntity", mock.Anything, identifiers).Return(nil, entity.ErrEntityNotFound).Once()
+	mockLockout.On("RecordFailure", mock.Anything, "").Return(&lockout.LockStatus{}, nil).Once()
+
+	result, err := provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeUserNotFound, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_SuccessClearsFailures() {
+	provider, mockLockout := suite.newLockoutProvider()
+	identifiers := map[string]interface{}{"userID": "user123"}
+	credentials := map[string]interface{}{"password": "password123"}
+
+	mockLockout.On("CheckLocked", mock.Anything, "user123").Return(&lockout.LockStatus{}, nil).Once()
+	suite.mockService.On("AuthenticateEntityByID", mock.Anything, "user123", credentials).
+		Return(&entity.AuthenticateResult{EntityID: "user123", EntityCategory: providers.EntityCategoryUser}, nil).
+		Once()
+	mockLockout.On("RecordSuccess", mock.Anything, "user123").Return(nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user123").
+		Return(&providers.Entity{ID: "user123", Category: providers.EntityCategoryUser}, nil).Maybe()
+
+	result, err := provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(err)
+	suite.NotNil(result)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Lockout_CheckServerError() {
+	provider, mockLockout := suite.newLockoutProvider()
+	identifiers := map[string]interface{}{"userID": "user123"}
+	credentials := map[string]interface{}{"password": "password123"}
+
+	mockLockout.On("CheckLocked", mock.Anything, "user123").Return(nil, &tidcommon.InternalServerError).Once()
+
+	result, err := provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(tidcommon.ServerErrorType, err.Type)
+}
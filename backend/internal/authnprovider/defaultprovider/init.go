@@ -8,10 +8,12 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/lockout"
 	"github.com/thunder-id/thunderid/internal/authn/magiclink"
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
 	"github.com/thunder-id/thunderid/internal/entity"
 )
 
@@ -22,8 +24,11 @@ const Name = "default"
 // Initialize constructs the default authn provider.
 func Initialize(entitySvc entity.EntityServiceInterface,
 	passkeySvc passkey.PasskeyServiceInterface, otpSvc otp.OTPAuthnServiceInterface,
+	totpSvc totp.TOTPServiceInterface,
 	magicLinkSvc magiclink.MagicLinkAuthnServiceInterface,
 	openid4vpSvc openid4vp.OpenID4VPServiceInterface,
-	federatedAuths map[providers.IDPType]authncommon.FederatedAuthenticator) providers.AuthnProviderInterface {
-	return newDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc, magicLinkSvc, openid4vpSvc, federatedAuths)
+	federatedAuths map[providers.IDPType]authncommon.FederatedAuthenticator,
+	lockoutSvc lockout.LockoutServiceInterface) providers.AuthnProviderInterface {
+	return newDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc, totpSvc, magicLinkSvc, openid4vpSvc, federatedAuths,
+		lockoutSvc)
 }
@@ -113,4 +113,18 @@ var (
 			DefaultValue: "The entity reference fetch was rejected by the provider",
 		},
 	}
+	// ErrorAccountLocked is returned when the provider rejects the authentication because the user
+	// or the client IP address is locked out after repeated failed attempts.
+	ErrorAccountLocked = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTHN-MGR-1011",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authnmgrservice.account_locked",
+			DefaultValue: "Account locked",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authnmgrservice.account_locked_description",
+			DefaultValue: "The account is temporarily locked due to repeated failed authentication attempts",
+		},
+	}
 )
@@ -188,6 +188,10 @@ func (m *authnProviderManager) AuthenticateUser(ctx context.Context, identifiers
 			m.logger.Debug(ctx, "authentication failed with invalid request error from provider",
 				log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
 			return authUser, nil, &ErrorInvalidRequest
+		case authnprovidercm.ErrorCodeAccountLocked:
+			m.logger.Debug(ctx, "authentication failed with account locked error from provider",
+				log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
+			return authUser, nil, &ErrorAccountLocked
 		default:
 			m.logger.Debug(ctx, "authentication failed with client error from provider",
 				log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
@@ -0,0 +1,501 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/authz/policy"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// conditionResult is the outcome of evaluating a policy condition against a request.
+type conditionResult int
+
+const (
+	// conditionFalse reports that the condition does not hold.
+	conditionFalse conditionResult = iota
+	// conditionTrue reports that the condition holds.
+	conditionTrue
+	// conditionIndeterminate reports that an attribute the condition needs is absent, so whether it
+	// holds cannot be decided.
+	conditionIndeterminate
+)
+
+// abacEngine implements Attribute-Based Access Control (ABAC) authorization.
+// It evaluates the declarative policies managed by the policy service over the subject, resource
+// server, permission and context attributes of each request. Within the engine, a matching DENY
+// policy overrides any matching PERMIT policy; a request no policy matches is not applicable.
+// A DENY policy whose conditions are indeterminate denies as well, so that leaving an attribute out
+// of the request cannot escape a deny rule; an indeterminate PERMIT policy does not permit.
+type abacEngine struct {
+	policyService  policy.PolicyServiceInterface
+	entityProvider entityprovider.EntityProviderInterface
+	ouService      ou.OrganizationUnitServiceInterface
+	now            func() time.Time
+}
+
+// NewABACEngine creates a new ABAC authorization engine. The entity provider resolves the stored
+// attributes and organization unit of the subject, and the organization unit service resolves
+// organization unit hierarchy; either may be nil, in which case the attributes they back are absent.
+func NewABACEngine(
+	policyService policy.PolicyServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) AuthorizationEngine {
+	return &abacEngine{
+		policyService:  policyService,
+		entityProvider: entityProvider,
+		ouService:      ouService,
+		now:            time.Now,
+	}
+}
+
+// EvaluateAccess evaluates a single fine-grained access request.
+func (e *abacEngine) EvaluateAccess(
+	ctx context.Context,
+	request AccessEvaluationRequest,
+) (*AccessEvaluationResponse, error) {
+	response, err := e.EvaluateAccessBatch(ctx, AccessEvaluationsRequest{
+		Evaluations: []AccessEvaluationRequest{request},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Evaluations) == 0 {
+		return &AccessEvaluationResponse{}, nil
+	}
+	return &response.Evaluations[0], nil
+}
+
+// EvaluateAccessBatch evaluates multiple fine-grained access requests against the applicable policies.
+func (e *abacEngine) EvaluateAccessBatch(
+	ctx context.Context,
+	request AccessEvaluationsRequest,
+) (*AccessEvaluationsResponse, error) {
+	if len(request.Evaluations) == 0 {
+		return &AccessEvaluationsResponse{Evaluations: []AccessEvaluationResponse{}}, nil
+	}
+
+	policiesByResourceServer := make(map[string][]policy.PolicyDTO)
+	entities := make(map[string]*providers.Entity)
+	evaluations := make([]AccessEvaluationResponse, len(request.Evaluations))
+	for i, evaluation := range request.Evaluations {
+		resourceServerID := evaluation.ResourceServer.ID
+		policies, ok := policiesByResourceServer[resourceServerID]
+		if !ok {
+			fetched, svcErr := e.policyService.GetApplicablePolicies(ctx, resourceServerID)
+			if svcErr != nil {
+				return nil, fmt.Errorf("policy service error: %s", svcErr.Error.DefaultValue)
+			}
+			policies = fetched
+			policiesByResourceServer[resourceServerID] = policies
+		}
+
+		attrs := &requestAttributes{request: evaluation, engine: e, entities: entities}
+		response, err := e.evaluatePolicies(ctx, policies, attrs)
+		if err != nil {
+			return nil, err
+		}
+		evaluations[i] = response
+	}
+	return &AccessEvaluationsResponse{Evaluations: evaluations}, nil
+}
+
+// evaluatePolicies combines the policies matching the request with deny-overrides. Indeterminate
+// DENY policies count as matching; indeterminate PERMIT policies do not.
+func (e *abacEngine) evaluatePolicies(
+	ctx context.Context,
+	policies []policy.PolicyDTO,
+	attrs *requestAttributes,
+) (AccessEvaluationResponse, error) {
+	permitted := false
+	for _, p := range policies {
+		if !targetMatches(p.Target, attrs.request) {
+			continue
+		}
+		result, err := e.conditionsHold(ctx, p.Conditions, attrs)
+		if err != nil {
+			return AccessEvaluationResponse{}, fmt.Errorf("failed to evaluate policy %s: %w", p.Handle, err)
+		}
+		if p.Effect == policy.PolicyEffectDeny {
+			if result != conditionFalse {
+				return AccessEvaluationResponse{Decision: false}, nil
+			}
+			continue
+		}
+		if result == conditionTrue {
+			permitted = true
+		}
+	}
+	if permitted {
+		return AccessEvaluationResponse{Decision: true}, nil
+	}
+	return AccessEvaluationResponse{NotApplicable: true}, nil
+}
+
+// targetMatches reports whether the request falls within the policy target.
+func targetMatches(target policy.PolicyTarget, request AccessEvaluationRequest) bool {
+	if target.ResourceServerID != "" && target.ResourceServerID != request.ResourceServer.ID {
+		return false
+	}
+	if len(target.Permissions) > 0 && !slices.Contains(target.Permissions, request.Permission.Name) {
+		return false
+	}
+	if len(target.SubjectTypes) > 0 && !slices.Contains(target.SubjectTypes, request.Subject.Type) {
+		return false
+	}
+	return true
+}
+
+// conditionsHold combines the conditions of a policy. The policy does not apply when any condition
+// does not hold, and is indeterminate when none fails but some are indeterminate.
+func (e *abacEngine) conditionsHold(
+	ctx context.Context,
+	conditions []policy.PolicyCondition,
+	attrs *requestAttributes,
+) (conditionResult, error) {
+	combined := conditionTrue
+	for _, condition := range conditions {
+		result, err := e.conditionHolds(ctx, condition, attrs)
+		if err != nil {
+			return conditionFalse, err
+		}
+		if result == conditionFalse {
+			return conditionFalse, nil
+		}
+		if result == conditionIndeterminate {
+			combined = conditionIndeterminate
+		}
+	}
+	return combined, nil
+}
+
+// conditionHolds evaluates a single condition. A condition over an absent attribute is indeterminate,
+// except exists and notExists.
+func (e *abacEngine) conditionHolds(
+	ctx context.Context,
+	condition policy.PolicyCondition,
+	attrs *requestAttributes,
+) (conditionResult, error) {
+	if condition.Operator == policy.OperatorTimeBetween {
+		return e.timeBetween(condition, attrs)
+	}
+
+	value, found, err := attrs.resolve(condition.Attribute)
+	if err != nil {
+		return conditionFalse, err
+	}
+	switch condition.Operator {
+	case policy.OperatorExists:
+		return toConditionResult(found), nil
+	case policy.OperatorNotExists:
+		return toConditionResult(!found), nil
+	}
+	if !found {
+		return conditionIndeterminate, nil
+	}
+
+	expected := condition.Values
+	if condition.ValueFrom != "" {
+		other, otherFound, err := attrs.resolve(condition.ValueFrom)
+		if err != nil {
+			return conditionFalse, err
+		}
+		if !otherFound {
+			return conditionIndeterminate, nil
+		}
+		expected = toStrings(other)
+	}
+	actual := toStrings(value)
+
+	switch condition.Operator {
+	case policy.OperatorEquals, policy.OperatorIn:
+		return toConditionResult(containsAny(expected, actual)), nil
+	case policy.OperatorNotEquals, policy.OperatorNotIn:
+		return toConditionResult(!containsAny(expected, actual)), nil
+	case policy.OperatorGreaterThan, policy.OperatorLessThan:
+		return toConditionResult(compareNumbers(condition.Operator, actual, expected)), nil
+	case policy.OperatorIPInRange:
+		return toConditionResult(ipInRange(actual, expected)), nil
+	case policy.OperatorInOU:
+		inOU, err := e.inOU(ctx, actual, expected)
+		return toConditionResult(inOU), err
+	default:
+		return conditionFalse, fmt.Errorf("unsupported condition operator %q", condition.Operator)
+	}
+}
+
+// toConditionResult converts a decided condition outcome to a conditionResult.
+func toConditionResult(held bool) conditionResult {
+	if held {
+		return conditionTrue
+	}
+	return conditionFalse
+}
+
+// timeBetween evaluates a timeBetween condition against the attribute timestamp or the current time.
+func (e *abacEngine) timeBetween(condition policy.PolicyCondition, attrs *requestAttributes) (conditionResult, error) {
+	if len(condition.Values) < 2 {
+		return conditionFalse, fmt.Errorf("timeBetween requires a start and an end time")
+	}
+	instant := e.now()
+	if condition.Attribute != "" {
+		value, found, err := attrs.resolve(condition.Attribute)
+		if err != nil {
+			return conditionFalse, err
+		}
+		if !found {
+			return conditionIndeterminate, nil
+		}
+		parsed, parseErr := time.Parse(time.RFC3339, fmt.Sprint(value))
+		if parseErr != nil {
+			return conditionIndeterminate, nil
+		}
+		instant = parsed
+	}
+
+	location := time.UTC
+	if len(condition.Values) > 2 {
+		loaded, err := time.LoadLocation(condition.Values[2])
+		if err != nil {
+			return conditionFalse, fmt.Errorf("invalid timeBetween timezone: %w", err)
+		}
+		location = loaded
+	}
+	start, err := time.Parse(policy.TimeOfDayLayout, condition.Values[0])
+	if err != nil {
+		return conditionFalse, fmt.Errorf("invalid timeBetween start: %w", err)
+	}
+	end, err := time.Parse(policy.TimeOfDayLayout, condition.Values[1])
+	if err != nil {
+		return conditionFalse, fmt.Errorf("invalid timeBetween end: %w", err)
+	}
+
+	local := instant.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+	if startMinute <= endMinute {
+		return toConditionResult(minute >= startMinute && minute < endMinute), nil
+	}
+	// The window wraps past midnight.
+	return toConditionResult(minute >= startMinute || minute < endMinute), nil
+}
+
+// inOU reports whether any of the organization units is one of the expected units or a descendant of one.
+func (e *abacEngine) inOU(ctx context.Context, ouIDs, expected []string) (bool, error) {
+	if containsAny(expected, ouIDs) {
+		return true, nil
+	}
+	if e.ouService == nil {
+		return false, nil
+	}
+	for _, ouID := range ouIDs {
+		for _, parentID := range expected {
+			isParent, svcErr := e.ouService.IsParent(ctx, parentID, ouID)
+			if svcErr != nil {
+				return false, fmt.Errorf("organization unit service error: %s", svcErr.Error.DefaultValue)
+			}
+			if isParent {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// requestAttributes resolves the attribute paths of a policy condition against a single request.
+// Subject entities are loaded lazily and shared across the batch through the entities cache.
+type requestAttributes struct {
+	request  AccessEvaluationRequest
+	engine   *abacEngine
+	entities map[string]*providers.Entity
+}
+
+// resolve returns the value of the dotted attribute path and whether it is present.
+func (a *requestAttributes) resolve(path string) (interface{}, bool, error) {
+	segments := strings.Split(path, ".")
+	if len(segments) < 2 {
+		return nil, false, nil
+	}
+	switch segments[0] {
+	case "subject":
+		return a.resolveSubject(segments[1:])
+	case "resourceServer":
+		if segments[1] == "id" {
+			return stringValue(a.request.ResourceServer.ID)
+		}
+		return lookupProperties(a.request.ResourceServer.Properties, segments[1:])
+	case "permission":
+		if segments[1] == "name" {
+			return stringValue(a.request.Permission.Name)
+		}
+		return lookupProperties(a.request.Permission.Properties, segments[1:])
+	case "context":
+		value, found := lookup(a.request.Context, segments[1:])
+		return value, found, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// resolveSubject resolves an attribute path rooted at the subject.
+func (a *requestAttributes) resolveSubject(segments []string) (interface{}, bool, error) {
+	subject := a.request.Subject
+	switch segments[0] {
+	case "id":
+		return stringValue(subject.ID)
+	case "type":
+		return stringValue(subject.Type)
+	case "groupIds":
+		return subject.GroupIDs, len(subject.GroupIDs) > 0, nil
+	case "properties":
+		return lookupProperties(subject.Properties, segments)
+	case "ouId":
+		entity, err := a.subjectEntity()
+		if err != nil || entity == nil {
+			return nil, false, err
+		}
+		return stringValue(entity.OUID)
+	case "attributes":
+		entity, err := a.subjectEntity()
+		if err != nil || entity == nil || len(entity.Attributes) == 0 {
+			return nil, false, err
+		}
+		var attributes map[string]interface{}
+		if err := json.Unmarshal(entity.Attributes, &attributes); err != nil {
+			return nil, false, fmt.Errorf("failed to parse subject attributes: %w", err)
+		}
+		value, found := lookup(attributes, segments[1:])
+		return value, found, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// subjectEntity loads the stored entity of the subject, returning nil when it is not available.
+func (a *requestAttributes) subjectEntity() (*providers.Entity, error) {
+	subjectID := a.request.Subject.ID
+	if a.engine.entityProvider == nil || subjectID == "" {
+		return nil, nil
+	}
+	if entity, ok := a.entities[subjectID]; ok {
+		return entity, nil
+	}
+	entity, epErr := a.engine.entityProvider.GetEntity(subjectID)
+	if epErr != nil {
+		if epErr.Code != entityprovider.ErrorCodeEntityNotFound &&
+			epErr.Code != entityprovider.ErrorCodeNotImplemented {
+			return nil, fmt.Errorf("entity provider error: %s", epErr.Error())
+		}
+		entity = nil
+	}
+	a.entities[subjectID] = entity
+	return entity, nil
+}
+
+// lookupProperties resolves a "properties.<path>" suffix against a properties map.
+func lookupProperties(properties map[string]interface{}, segments []string) (interface{}, bool, error) {
+	if len(segments) < 2 || segments[0] != "properties" {
+		return nil, false, nil
+	}
+	value, found := lookup(properties, segments[1:])
+	return value, found, nil
+}
+
+// lookup walks a nested map along the path segments.
+func lookup(values map[string]interface{}, segments []string) (interface{}, bool) {
+	var current interface{} = values
+	for _, segment := range segments {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[segment]
+		if !ok || current == nil {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// stringValue reports a string attribute as present when it is non-empty.
+func stringValue(s string) (interface{}, bool, error) {
+	return s, s != "", nil
+}
+
+// toStrings flattens a scalar or list attribute value to its string forms.
+func toStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			out = append(out, fmt.Sprint(item))
+		}
+		return out
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+// containsAny reports whether any of the actual values is one of the expected values.
+func containsAny(expected, actual []string) bool {
+	for _, a := range actual {
+		if slices.Contains(expected, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareNumbers reports whether any numeric actual value is greater (or less) than the expected value.
+func compareNumbers(operator policy.ConditionOperator, actual, expected []string) bool {
+	if len(expected) == 0 {
+		return false
+	}
+	bound, err := strconv.ParseFloat(expected[0], 64)
+	if err != nil {
+		return false
+	}
+	for _, a := range actual {
+		n, err := strconv.ParseFloat(a, 64)
+		if err != nil {
+			continue
+		}
+		if (operator == policy.OperatorGreaterThan && n > bound) ||
+			(operator == policy.OperatorLessThan && n < bound) {
+			return true
+		}
+	}
+	return false
+}
+
+// ipInRange reports whether any of the IP addresses falls within one of the CIDR ranges.
+func ipInRange(addresses, cidrs []string) bool {
+	for _, address := range addresses {
+		ip := net.ParseIP(strings.TrimSpace(address))
+		if ip == nil {
+			continue
+		}
+		for _, cidr := range cidrs {
+			_, network, err := net.ParseCIDR(cidr)
+			if err == nil && network.Contains(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
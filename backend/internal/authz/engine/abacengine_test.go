@@ -0,0 +1,312 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/authz/policy"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/authz/policymock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+)
+
+const testResourceServerID = "rs-1"
+
+type ABACEngineTestSuite struct {
+	suite.Suite
+	mockPolicyService  *policymock.PolicyServiceInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockOUService      *oumock.OrganizationUnitServiceInterfaceMock
+	engine             *abacEngine
+}
+
+func TestABACEngineTestSuite(t *testing.T) {
+	suite.Run(t, new(ABACEngineTestSuite))
+}
+
+func (suite *ABACEngineTestSuite) SetupTest() {
+	suite.mockPolicyService = policymock.NewPolicyServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.engine = NewABACEngine(suite.mockPolicyService, suite.mockEntityProvider,
+		suite.mockOUService).(*abacEngine)
+	suite.engine.now = func() time.Time { return time.Date(2026, 3, 2, 10, 30, 0, 0, time.UTC) }
+}
+
+func (suite *ABACEngineTestSuite) givenPolicies(policies ...policy.PolicyDTO) {
+	suite.mockPolicyService.On("GetApplicablePolicies", mock.Anything, testResourceServerID).
+		Return(policies, nil).Once()
+}
+
+func (suite *ABACEngineTestSuite) evaluate(request AccessEvaluationRequest) *AccessEvaluationResponse {
+	request.ResourceServer.ID = testResourceServerID
+	result, err := suite.engine.EvaluateAccess(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func permitWhen(conditions ...policy.PolicyCondition) policy.PolicyDTO {
+	return policy.PolicyDTO{Handle: "permit", Effect: policy.PolicyEffectPermit, Conditions: conditions}
+}
+
+func denyWhen(conditions ...policy.PolicyCondition) policy.PolicyDTO {
+	return policy.PolicyDTO{Handle: "deny", Effect: policy.PolicyEffectDeny, Conditions: conditions}
+}
+
+func (suite *ABACEngineTestSuite) TestNoPoliciesIsNotApplicable() {
+	suite.givenPolicies()
+
+	result := suite.evaluate(AccessEvaluationRequest{Subject: Subject{ID: testUserID1}})
+
+	suite.False(result.Decision)
+	suite.True(result.NotApplicable)
+}
+
+func (suite *ABACEngineTestSuite) TestPermitOnSubjectProperty() {
+	suite.givenPolicies(permitWhen(policy.PolicyCondition{
+		Attribute: "subject.properties.department", Operator: policy.OperatorEquals, Values: []string{"finance"},
+	}))
+
+	result := suite.evaluate(AccessEvaluationRequest{
+		Subject: Subject{ID: testUserID1, Properties: map[string]interface{}{"department": "finance"}},
+	})
+
+	suite.True(result.Decision)
+	suite.False(result.NotApplicable)
+}
+
+func (suite *ABACEngineTestSuite) TestDenyOverridesPermitWithinEngine() {
+	suite.givenPolicies(
+		permitWhen(),
+		denyWhen(policy.PolicyCondition{
+			Attribute: "context.ip", Operator: policy.OperatorIPInRange, Values: []string{"203.0.113.0/24"},
+		}),
+	)
+
+	result := suite.evaluate(AccessEvaluationRequest{
+		Subject: Subject{ID: testUserID1},
+		Context: map[string]interface{}{"ip": "203.0.113.7"},
+	})
+
+	suite.False(result.Decision)
+	suite.False(result.NotApplicable)
+}
+
+func (suite *ABACEngineTestSuite) TestDenyAppliesWhenAttributeMissing() {
+	suite.givenPolicies(
+		permitWhen(),
+		denyWhen(policy.PolicyCondition{
+			Attribute: "context.ip", Operator: policy.OperatorIPInRange, Values: []string{"203.0.113.0/24"},
+		}),
+	)
+
+	result := suite.evaluate(AccessEvaluationRequest{Subject: Subject{ID: testUserID1}})
+
+	suite.False(result.Decision)
+	suite.False(result.NotApplicable)
+}
+
+func (suite *ABACEngineTestSuite) TestDenyDoesNotApplyWhenAnotherConditionFails() {
+	suite.givenPolicies(denyWhen(
+		policy.PolicyCondition{
+			Attribute: "subject.type", Operator: policy.OperatorEquals, Values: []string{"contractor"},
+		},
+		policy.PolicyCondition{
+			Attribute: "context.ip", Operator: policy.OperatorIPInRange, Values: []string{"203.0.113.0/24"},
+		},
+	))
+
+	result := suite.evaluate(AccessEvaluationRequest{Subject: Subject{ID: testUserID1, Type: "employee"}})
+
+	suite.True(result.NotApplicable)
+}
+
+func (suite *ABACEngineTestSuite) TestPermitDoesNotApplyWhenAttributeMissing() {
+	suite.givenPolicies(permitWhen(policy.PolicyCondition{
+		Attribute: "context.ip", Operator: policy.OperatorNotIn, Values: []string{"203.0.113.7"},
+	}))
+
+	result := suite.evaluate(AccessEvaluationRequest{Subject: Subject{ID: testUserID1}})
+
+	suite.False(result.Decision)
+	suite.True(result.NotApplicable)
+}
+
+func (suite *ABACEngineTestSuite) TestTargetFiltersPermissionAndSubjectType() {
+	p := permitWhen()
+	p.Target = policy.PolicyTarget{Permissions: []string{"invoice:approve"}, SubjectTypes: []string{"employee"}}
+	suite.givenPolicies(p)
+
+	result := suite.evaluate(AccessEvaluationRequest{
+		Subject:    Subject{ID: testUserID1, Type: "contractor"},
+		Permission: Permission{Name: "invoice:approve"},
+	})
+
+	suite.True(result.NotApplicable)
+}
+
+func (suite *ABACEngineTestSuite) TestTimeBetweenWrapsPastMidnight() {
+	suite.givenPolicies(denyWhen(policy.PolicyCondition{
+		Operator: policy.OperatorTimeBetween, Values: []string{"18:00", "08:00"},
+	}))
+
+	// 10:30 UTC falls outside the after-hours window, so the deny policy does not match.
+	suite.True(suite.evaluate(AccessEvaluationRequest{}).NotApplicable)
+
+	suite.engine.now = func() time.Time { return time.Date(2026, 3, 2, 23, 15, 0, 0, time.UTC) }
+	suite.givenPolicies(denyWhen(policy.PolicyCondition{
+		Operator: policy.OperatorTimeBetween, Values: []string{"18:00", "08:00"},
+	}))
+	result := suite.evaluate(AccessEvaluationRequest{})
+	suite.False(result.Decision)
+	suite.False(result.NotApplicable)
+}
+
+func (suite *ABACEngineTestSuite) TestTimeBetweenUsesTimezoneAndAttribute() {
+	suite.givenPolicies(permitWhen(policy.PolicyCondition{
+		Attribute: "context.requestTime", Operator: policy.OperatorTimeBetween,
+		Values: []string{"09:00", "17:00", "Asia/Colombo"},
+	}))
+
+	// 05:00 UTC is 10:30 in Asia/Colombo.
+	result := suite.evaluate(AccessEvaluationRequest{
+		Context: map[string]interface{}{"requestTime": "2026-03-02T05:00:00Z"},
+	})
+
+	suite.True(result.Decision)
+}
+
+func (suite *ABACEngineTestSuite) TestSubjectAttributesFromEntityProvider() {
+	suite.givenPolicies(permitWhen(policy.PolicyCondition{
+		Attribute: "subject.attributes.clearance", Operator: policy.OperatorGreaterThan, Values: []string{"2"},
+	}))
+	suite.mockEntityProvider.On("GetEntity", testUserID1).
+		Return(&providers.Entity{ID: testUserID1, Attributes: []byte(`{"clearance":3}`)}, nil).Once()
+
+	result := suite.evaluate(AccessEvaluationRequest{Subject: Subject{ID: testUserID1}})
+
+	suite.True(result.Decision)
+}
+
+func (suite *ABACEngineTestSuite) TestSubjectEntityLoadedOncePerBatch() {
+	suite.givenPolicies(permitWhen(policy.PolicyCondition{
+		Attribute: "subject.ouId", Operator: policy.OperatorEquals, Values: []string{"ou-1"},
+	}))
+	suite.mockEntityProvider.On("GetEntity", testUserID1).
+		Return(&providers.Entity{ID: testUserID1, OUID: "ou-1"}, nil).Once()
+
+	request := AccessEvaluationRequest{
+		Subject:        Subject{ID: testUserID1},
+		ResourceServer: ResourceServer{ID: testResourceServerID},
+	}
+	result, err := suite.engine.EvaluateAccessBatch(context.Background(), AccessEvaluationsRequest{
+		Evaluations: []AccessEvaluationRequest{request, request},
+	})
+
+	suite.Require().NoError(err)
+	suite.Len(result.Evaluations, 2)
+	suite.True(result.Evaluations[0].Decision)
+	suite.True(result.Evaluations[1].Decision)
+}
+
+func (suite *ABACEngineTestSuite) TestMissingEntityIsAbsentAttribute() {
+	suite.givenPolicies(denyWhen(policy.PolicyCondition{
+		Attribute: "subject.ouId", Operator: policy.OperatorNotExists,
+	}))
+	suite.mockEntityProvider.On("GetEntity", testUserID1).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", "")).Once()
+
+	result := suite.evaluate(AccessEvaluationRequest{Subject: Subject{ID: testUserID1}})
+
+	suite.False(result.Decision)
+	suite.False(result.NotApplicable)
+}
+
+func (suite *ABACEngineTestSuite) TestEntityProviderErrorFailsEvaluation() {
+	suite.givenPolicies(permitWhen(policy.PolicyCondition{
+		Attribute: "subject.ouId", Operator: policy.OperatorExists,
+	}))
+	suite.mockEntityProvider.On("GetEntity", testUserID1).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeSystemError, "boom", "")).Once()
+
+	_, err := suite.engine.EvaluateAccess(context.Background(), AccessEvaluationRequest{
+		Subject:        Subject{ID: testUserID1},
+		ResourceServer: ResourceServer{ID: testResourceServerID},
+	})
+
+	suite.Error(err)
+}
+
+func (suite *ABACEngineTestSuite) TestInOUMatchesDescendant() {
+	suite.givenPolicies(permitWhen(policy.PolicyCondition{
+		Attribute: "subject.ouId", Operator: policy.OperatorInOU, Values: []string{"finance"},
+	}))
+	suite.mockEntityProvider.On("GetEntity", testUserID1).
+		Return(&providers.Entity{ID: testUserID1, OUID: "payroll"}, nil).Once()
+	suite.mockOUService.On("IsParent", mock.Anything, "finance", "payroll").Return(true, nil).Once()
+
+	result := suite.evaluate(AccessEvaluationRequest{Subject: Subject{ID: testUserID1}})
+
+	suite.True(result.Decision)
+}
+
+func (suite *ABACEngineTestSuite) TestValueFromComparesTwoAttributes() {
+	suite.givenPolicies(permitWhen(policy.PolicyCondition{
+		Attribute: "subject.properties.region", Operator: policy.OperatorEquals,
+		ValueFrom: "resourceServer.properties.region",
+	}))
+
+	result := suite.evaluate(AccessEvaluationRequest{
+		Subject:        Subject{Properties: map[string]interface{}{"region": "eu"}},
+		ResourceServer: ResourceServer{Properties: map[string]interface{}{"region": "eu"}},
+	})
+
+	suite.True(result.Decision)
+}
+
+func (suite *ABACEngineTestSuite) TestPermissionPropertiesAndGroups() {
+	suite.givenPolicies(permitWhen(
+		policy.PolicyCondition{
+			Attribute: "permission.properties.risk", Operator: policy.OperatorIn, Values: []string{"low", "medium"},
+		},
+		policy.PolicyCondition{
+			Attribute: "subject.groupIds", Operator: policy.OperatorNotIn, Values: []string{"suspended"},
+		},
+	))
+
+	result := suite.evaluate(AccessEvaluationRequest{
+		Subject:    Subject{GroupIDs: []string{"staff"}},
+		Permission: Permission{Name: "report:read", Properties: map[string]interface{}{"risk": "low"}},
+	})
+
+	suite.True(result.Decision)
+}
+
+func (suite *ABACEngineTestSuite) TestPolicyServiceError() {
+	suite.mockPolicyService.On("GetApplicablePolicies", mock.Anything, testResourceServerID).
+		Return(nil, &tidcommon.InternalServerError).Once()
+
+	_, err := suite.engine.EvaluateAccess(context.Background(), AccessEvaluationRequest{
+		ResourceServer: ResourceServer{ID: testResourceServerID},
+	})
+
+	suite.Error(err)
+}
+
+func (suite *ABACEngineTestSuite) TestEvaluateAccessBatchEmpty() {
+	result, err := suite.engine.EvaluateAccessBatch(context.Background(), AccessEvaluationsRequest{})
+
+	suite.NoError(err)
+	suite.Empty(result.Evaluations)
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"fmt"
+)
+
+// CombiningAlgorithm determines how the decisions of multiple engines are combined.
+type CombiningAlgorithm string
+
+const (
+	// CombiningAlgorithmDenyOverrides denies when any engine denies, otherwise permits when any engine permits.
+	CombiningAlgorithmDenyOverrides CombiningAlgorithm = "deny-overrides"
+	// CombiningAlgorithmPermitOverrides permits when any engine permits, otherwise denies when any engine denies.
+	CombiningAlgorithmPermitOverrides CombiningAlgorithm = "permit-overrides"
+)
+
+// IsValid reports whether the algorithm is one of the supported combining algorithms.
+func (a CombiningAlgorithm) IsValid() bool {
+	return a == CombiningAlgorithmDenyOverrides || a == CombiningAlgorithmPermitOverrides
+}
+
+// combiningEngine evaluates every request with each of its engines and combines their decisions.
+// Engine responses marked not applicable take no part in the combination; when no engine is
+// applicable, the combined response is not applicable as well.
+type combiningEngine struct {
+	algorithm CombiningAlgorithm
+	engines   []AuthorizationEngine
+}
+
+// NewCombiningEngine creates an authorization engine that combines the decisions of the given engines.
+func NewCombiningEngine(algorithm CombiningAlgorithm, engines ...AuthorizationEngine) AuthorizationEngine {
+	return &combiningEngine{
+		algorithm: algorithm,
+		engines:   engines,
+	}
+}
+
+// EvaluateAccess evaluates a single fine-grained access request.
+func (e *combiningEngine) EvaluateAccess(
+	ctx context.Context,
+	request AccessEvaluationRequest,
+) (*AccessEvaluationResponse, error) {
+	response, err := e.EvaluateAccessBatch(ctx, AccessEvaluationsRequest{
+		Evaluations: []AccessEvaluationRequest{request},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Evaluations) == 0 {
+		return &AccessEvaluationResponse{}, nil
+	}
+	return &response.Evaluations[0], nil
+}
+
+// EvaluateAccessBatch evaluates multiple fine-grained access requests with every engine and combines
+// the decisions per request.
+func (e *combiningEngine) EvaluateAccessBatch(
+	ctx context.Context,
+	request AccessEvaluationsRequest,
+) (*AccessEvaluationsResponse, error) {
+	if len(request.Evaluations) == 0 {
+		return &AccessEvaluationsResponse{Evaluations: []AccessEvaluationResponse{}}, nil
+	}
+
+	results := make([][]AccessEvaluationResponse, 0, len(e.engines))
+	for _, engine := range e.engines {
+		response, err := engine.EvaluateAccessBatch(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		if response == nil || len(response.Evaluations) != len(request.Evaluations) {
+			return nil, fmt.Errorf("authorization engine returned an incomplete batch response")
+		}
+		results = append(results, response.Evaluations)
+	}
+
+	evaluations := make([]AccessEvaluationResponse, len(request.Evaluations))
+	for i := range request.Evaluations {
+		decisions := make([]AccessEvaluationResponse, 0, len(results))
+		for _, result := range results {
+			decisions = append(decisions, result[i])
+		}
+		evaluations[i] = e.combine(decisions)
+	}
+	return &AccessEvaluationsResponse{Evaluations: evaluations}, nil
+}
+
+// combine merges the decisions of the engines for a single request. The combined response carries
+// the context of the first engine response that agrees with the combined decision.
+func (e *combiningEngine) combine(decisions []AccessEvaluationResponse) AccessEvaluationResponse {
+	var permit, deny *AccessEvaluationResponse
+	for i := range decisions {
+		switch {
+		case decisions[i].Decision:
+			if permit == nil {
+				permit = &decisions[i]
+			}
+		case !decisions[i].NotApplicable:
+			if deny == nil {
+				deny = &decisions[i]
+			}
+		}
+	}
+
+	first, second := deny, permit
+	if e.algorithm == CombiningAlgorithmPermitOverrides {
+		first, second = permit, deny
+	}
+	if first != nil {
+		return AccessEvaluationResponse{Decision: first.Decision, Context: first.Context}
+	}
+	if second != nil {
+		return AccessEvaluationResponse{Decision: second.Decision, Context: second.Context}
+	}
+	return AccessEvaluationResponse{NotApplicable: true}
+}
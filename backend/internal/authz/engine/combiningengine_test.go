@@ -0,0 +1,127 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// staticEngine returns the same response for every evaluation in a batch.
+type staticEngine struct {
+	response AccessEvaluationResponse
+	err      error
+	short    bool
+}
+
+func (e *staticEngine) EvaluateAccess(
+	ctx context.Context, request AccessEvaluationRequest,
+) (*AccessEvaluationResponse, error) {
+	response := e.response
+	return &response, e.err
+}
+
+func (e *staticEngine) EvaluateAccessBatch(
+	ctx context.Context, request AccessEvaluationsRequest,
+) (*AccessEvaluationsResponse, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	evaluations := make([]AccessEvaluationResponse, 0, len(request.Evaluations))
+	for range request.Evaluations {
+		evaluations = append(evaluations, e.response)
+	}
+	if e.short {
+		evaluations = evaluations[:0]
+	}
+	return &AccessEvaluationsResponse{Evaluations: evaluations}, nil
+}
+
+var (
+	permitEngine        = &staticEngine{response: AccessEvaluationResponse{Decision: true}}
+	denyEngine          = &staticEngine{response: AccessEvaluationResponse{Context: map[string]interface{}{"by": "deny"}}}
+	notApplicableEngine = &staticEngine{response: AccessEvaluationResponse{NotApplicable: true}}
+)
+
+type CombiningEngineTestSuite struct {
+	suite.Suite
+}
+
+func TestCombiningEngineTestSuite(t *testing.T) {
+	suite.Run(t, new(CombiningEngineTestSuite))
+}
+
+func (suite *CombiningEngineTestSuite) evaluate(
+	algorithm CombiningAlgorithm, engines ...AuthorizationEngine,
+) *AccessEvaluationResponse {
+	result, err := NewCombiningEngine(algorithm, engines...).
+		EvaluateAccess(context.Background(), AccessEvaluationRequest{})
+	suite.Require().NoError(err)
+	return result
+}
+
+func (suite *CombiningEngineTestSuite) TestDenyOverrides() {
+	result := suite.evaluate(CombiningAlgorithmDenyOverrides, permitEngine, denyEngine)
+
+	suite.False(result.Decision)
+	suite.False(result.NotApplicable)
+	suite.Equal("deny", result.Context["by"])
+}
+
+func (suite *CombiningEngineTestSuite) TestPermitOverrides() {
+	result := suite.evaluate(CombiningAlgorithmPermitOverrides, denyEngine, permitEngine)
+
+	suite.True(result.Decision)
+}
+
+func (suite *CombiningEngineTestSuite) TestNotApplicableIsIgnored() {
+	suite.True(suite.evaluate(CombiningAlgorithmDenyOverrides, notApplicableEngine, permitEngine).Decision)
+	suite.False(suite.evaluate(CombiningAlgorithmPermitOverrides, notApplicableEngine, denyEngine).Decision)
+}
+
+func (suite *CombiningEngineTestSuite) TestAllNotApplicable() {
+	result := suite.evaluate(CombiningAlgorithmDenyOverrides, notApplicableEngine, notApplicableEngine)
+
+	suite.False(result.Decision)
+	suite.True(result.NotApplicable)
+}
+
+func (suite *CombiningEngineTestSuite) TestEngineErrorIsReturned() {
+	failing := &staticEngine{err: errors.New("engine failure")}
+
+	_, err := NewCombiningEngine(CombiningAlgorithmDenyOverrides, permitEngine, failing).
+		EvaluateAccess(context.Background(), AccessEvaluationRequest{})
+
+	suite.Error(err)
+}
+
+func (suite *CombiningEngineTestSuite) TestIncompleteBatchResponse() {
+	_, err := NewCombiningEngine(CombiningAlgorithmDenyOverrides, &staticEngine{short: true}).
+		EvaluateAccessBatch(context.Background(), AccessEvaluationsRequest{
+			Evaluations: []AccessEvaluationRequest{{}},
+		})
+
+	suite.Error(err)
+}
+
+func (suite *CombiningEngineTestSuite) TestBatchCombinesPerEvaluation() {
+	result, err := NewCombiningEngine(CombiningAlgorithmDenyOverrides, notApplicableEngine, permitEngine).
+		EvaluateAccessBatch(context.Background(), AccessEvaluationsRequest{
+			Evaluations: []AccessEvaluationRequest{{}, {}},
+		})
+
+	suite.Require().NoError(err)
+	suite.Len(result.Evaluations, 2)
+	suite.True(result.Evaluations[0].Decision)
+	suite.True(result.Evaluations[1].Decision)
+}
+
+func (suite *CombiningEngineTestSuite) TestCombiningAlgorithmIsValid() {
+	suite.True(CombiningAlgorithmDenyOverrides.IsValid())
+	suite.True(CombiningAlgorithmPermitOverrides.IsValid())
+	suite.False(CombiningAlgorithm("first-applicable").IsValid())
+}
@@ -36,6 +36,13 @@ type ResourceServer struct {
 	Properties map[string]interface{}
 }
 
+// Resource identifies the individual object an access evaluation is about. Both fields are empty
+// when the request names only a resource server and permission.
+type Resource struct {
+	Type string
+	ID   string
+}
+
 // Permission identifies the permission string being evaluated.
 type Permission struct {
 	Name       string
@@ -46,14 +53,18 @@ type Permission struct {
 type AccessEvaluationRequest struct {
 	Subject        Subject
 	ResourceServer ResourceServer
+	Resource       Resource
 	Permission     Permission
 	Context        map[string]interface{}
 }
 
 // AccessEvaluationResponse represents a single fine-grained access evaluation response.
+// NotApplicable reports that the engine has no rule covering the request; Decision is false in that case,
+// but combining engines let other engines decide instead of treating it as an explicit deny.
 type AccessEvaluationResponse struct {
-	Decision bool
-	Context  map[string]interface{}
+	Decision      bool
+	NotApplicable bool
+	Context       map[string]interface{}
 }
 
 // AccessEvaluationsRequest represents a batched fine-grained access evaluation request.
@@ -2,8 +2,8 @@
 // SPDX-License-Identifier: Apache-2.0
 
 // Package engine provides authorization engine implementations.
-// It includes various authorization engines such as RBAC (Role-Based Access Control)
-// that delegate authorization decisions to the appropriate services.
+// It includes various authorization engines such as RBAC (Role-Based Access Control) and
+// ABAC (Attribute-Based Access Control), and a combining engine that merges their decisions.
 package engine
 
 import (
@@ -69,10 +69,12 @@ func (e *rbacEngine) EvaluateAccessBatch(
 			return nil, fmt.Errorf("role service error: %s", svcErr.Error)
 		}
 
+		// RBAC has no deny rules, so a permission no role grants is not applicable rather than denied.
 		for _, index := range group.indexes {
-			permission := request.Evaluations[index].Permission.Name
+			permitted := slices.Contains(authorizedPerms, request.Evaluations[index].Permission.Name)
 			evaluations[index] = AccessEvaluationResponse{
-				Decision: slices.Contains(authorizedPerms, permission),
+				Decision:      permitted,
+				NotApplicable: !permitted,
 			}
 		}
 	}
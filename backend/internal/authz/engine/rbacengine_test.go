@@ -66,6 +66,7 @@ func (suite *RBACEngineTestSuite) TestEvaluateAccessDenied() {
 	suite.Nil(err)
 	suite.NotNil(result)
 	suite.False(result.Decision)
+	suite.True(result.NotApplicable)
 }
 
 func (suite *RBACEngineTestSuite) TestEvaluateAccessBatchPreservesOrder() {
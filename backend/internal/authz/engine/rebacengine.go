@@ -0,0 +1,78 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/authz/rebac"
+)
+
+// rebacEngine implements Relationship-Based Access Control (ReBAC) authorization.
+// It treats the requested permission as a relation and checks whether the subject holds that
+// relation on the requested resource through the relation tuples and authorization model managed
+// by the relationship service. A request without a resource, or naming a resource type or relation
+// the model does not define, is not applicable; a relation the subject lacks is not applicable as
+// well, so that other engines may still grant access.
+type rebacEngine struct {
+	rebacService rebac.ReBACServiceInterface
+}
+
+// NewReBACEngine creates a new ReBAC authorization engine.
+func NewReBACEngine(rebacService rebac.ReBACServiceInterface) AuthorizationEngine {
+	return &rebacEngine{rebacService: rebacService}
+}
+
+// EvaluateAccess evaluates a single fine-grained access request.
+func (e *rebacEngine) EvaluateAccess(
+	ctx context.Context,
+	request AccessEvaluationRequest,
+) (*AccessEvaluationResponse, error) {
+	response, err := e.EvaluateAccessBatch(ctx, AccessEvaluationsRequest{
+		Evaluations: []AccessEvaluationRequest{request},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Evaluations) == 0 {
+		return &AccessEvaluationResponse{}, nil
+	}
+	return &response.Evaluations[0], nil
+}
+
+// EvaluateAccessBatch evaluates multiple fine-grained access requests against the relation tuples.
+func (e *rebacEngine) EvaluateAccessBatch(
+	ctx context.Context,
+	request AccessEvaluationsRequest,
+) (*AccessEvaluationsResponse, error) {
+	evaluations := make([]AccessEvaluationResponse, len(request.Evaluations))
+	for i, evaluation := range request.Evaluations {
+		if evaluation.Resource.Type == "" || evaluation.Resource.ID == "" ||
+			evaluation.Permission.Name == "" || evaluation.Subject.ID == "" {
+			evaluations[i] = AccessEvaluationResponse{NotApplicable: true}
+			continue
+		}
+
+		response, svcErr := e.rebacService.Check(ctx, rebac.CheckRequest{
+			Object:   rebac.ObjectReference{Type: evaluation.Resource.Type, ID: evaluation.Resource.ID},
+			Relation: evaluation.Permission.Name,
+			Subject:  rebac.SubjectReference{Type: evaluation.Subject.Type, ID: evaluation.Subject.ID},
+			GroupIDs: evaluation.Subject.GroupIDs,
+		})
+		if svcErr != nil {
+			if svcErr.Code == rebac.ErrorTypeNotFound.Code || svcErr.Code == rebac.ErrorUnknownRelation.Code {
+				evaluations[i] = AccessEvaluationResponse{NotApplicable: true}
+				continue
+			}
+			return nil, fmt.Errorf("relationship service error: %s", svcErr.Error.DefaultValue)
+		}
+		if response.Allowed {
+			evaluations[i] = AccessEvaluationResponse{Decision: true}
+		} else {
+			evaluations[i] = AccessEvaluationResponse{NotApplicable: true}
+		}
+	}
+	return &AccessEvaluationsResponse{Evaluations: evaluations}, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/authz/rebac"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/tests/mocks/authz/rebacmock"
+)
+
+type ReBACEngineTestSuite struct {
+	suite.Suite
+	mockReBACService *rebacmock.ReBACServiceInterfaceMock
+	engine           AuthorizationEngine
+}
+
+func TestReBACEngineTestSuite(t *testing.T) {
+	suite.Run(t, new(ReBACEngineTestSuite))
+}
+
+func (suite *ReBACEngineTestSuite) SetupTest() {
+	suite.mockReBACService = rebacmock.NewReBACServiceInterfaceMock(suite.T())
+	suite.engine = NewReBACEngine(suite.mockReBACService)
+}
+
+func documentRequest(subjectID, permission string) AccessEvaluationRequest {
+	return AccessEvaluationRequest{
+		Subject:    Subject{Type: "user", ID: subjectID, GroupIDs: []string{"eng"}},
+		Resource:   Resource{Type: "document", ID: "d1"},
+		Permission: Permission{Name: permission},
+	}
+}
+
+func (suite *ReBACEngineTestSuite) TestEvaluateAccessPermitsRelatedSubject() {
+	suite.mockReBACService.On("Check", mock.Anything, rebac.CheckRequest{
+		Object:   rebac.ObjectReference{Type: "document", ID: "d1"},
+		Relation: "viewer",
+		Subject:  rebac.SubjectReference{Type: "user", ID: "alice"},
+		GroupIDs: []string{"eng"},
+	}).Return(&rebac.CheckResponse{Allowed: true}, nil)
+
+	result, err := suite.engine.EvaluateAccess(context.Background(), documentRequest("alice", "viewer"))
+
+	suite.Require().NoError(err)
+	suite.True(result.Decision)
+	suite.False(result.NotApplicable)
+}
+
+func (suite *ReBACEngineTestSuite) TestEvaluateAccessBatchNotApplicable() {
+	suite.mockReBACService.On("Check", mock.Anything, mock.MatchedBy(func(req rebac.CheckRequest) bool {
+		return req.Subject.ID == "bob"
+	})).Return(&rebac.CheckResponse{Allowed: false}, nil)
+	suite.mockReBACService.On("Check", mock.Anything, mock.MatchedBy(func(req rebac.CheckRequest) bool {
+		return req.Relation == "commenter"
+	})).Return(nil, &rebac.ErrorUnknownRelation)
+
+	withoutResource := documentRequest("alice", "viewer")
+	withoutResource.Resource = Resource{}
+	result, err := suite.engine.EvaluateAccessBatch(context.Background(), AccessEvaluationsRequest{
+		Evaluations: []AccessEvaluationRequest{
+			withoutResource,
+			documentRequest("bob", "viewer"),
+			documentRequest("alice", "commenter"),
+		},
+	})
+
+	suite.Require().NoError(err)
+	suite.Len(result.Evaluations, 3)
+	for _, evaluation := range result.Evaluations {
+		suite.False(evaluation.Decision)
+		suite.True(evaluation.NotApplicable)
+	}
+}
+
+func (suite *ReBACEngineTestSuite) TestEvaluateAccessServiceError() {
+	suite.mockReBACService.On("Check", mock.Anything, mock.Anything).
+		Return(nil, &tidcommon.InternalServerError)
+
+	_, err := suite.engine.EvaluateAccess(context.Background(), documentRequest("alice", "viewer"))
+
+	suite.Error(err)
+}
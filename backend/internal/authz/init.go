@@ -4,13 +4,67 @@
 package authz
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/thunder-id/thunderid/internal/authz/engine"
+	"github.com/thunder-id/thunderid/internal/authz/policy"
+	"github.com/thunder-id/thunderid/internal/authz/rebac"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
-// Initialize creates and initializes the authorization service with the RBAC engine.
-func Initialize(roleService role.RoleServiceInterface) providers.AuthorizationProvider {
-	rbacEngine := engine.NewRBACEngine(roleService)
-	return newAuthorizationService(rbacEngine)
+const (
+	engineRBAC  = "rbac"
+	engineABAC  = "abac"
+	engineReBAC = "rebac"
+)
+
+// Initialize creates and initializes the authorization service with the engines configured under
+// authorization.engines. The RBAC engine is used alone when none are configured; multiple engines are
+// composed with the configured authorization.combining_algorithm (deny-overrides by default).
+func Initialize(
+	roleService role.RoleServiceInterface,
+	policyService policy.PolicyServiceInterface,
+	rebacService rebac.ReBACServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) (providers.AuthorizationProvider, error) {
+	authzConfig := config.GetServerRuntime().Config.Authorization
+
+	engineNames := authzConfig.Engines
+	if len(engineNames) == 0 {
+		engineNames = []string{engineRBAC}
+	}
+	engines := make([]engine.AuthorizationEngine, 0, len(engineNames))
+	for _, name := range engineNames {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case engineRBAC:
+			engines = append(engines, engine.NewRBACEngine(roleService))
+		case engineABAC:
+			engines = append(engines, engine.NewABACEngine(policyService, entityProvider, ouService))
+		case engineReBAC:
+			engines = append(engines, engine.NewReBACEngine(rebacService))
+		default:
+			return nil, fmt.Errorf("invalid authorization engine %q: must be one of %q, %q or %q",
+				name, engineRBAC, engineABAC, engineReBAC)
+		}
+	}
+	if len(engines) == 1 {
+		return newAuthorizationService(engines[0]), nil
+	}
+
+	algorithm := engine.CombiningAlgorithm(strings.ToLower(strings.TrimSpace(authzConfig.CombiningAlgorithm)))
+	if algorithm == "" {
+		algorithm = engine.CombiningAlgorithmDenyOverrides
+	}
+	if !algorithm.IsValid() {
+		return nil, fmt.Errorf("invalid authorization combining algorithm %q: must be one of %q or %q",
+			authzConfig.CombiningAlgorithm, engine.CombiningAlgorithmDenyOverrides,
+			engine.CombiningAlgorithmPermitOverrides)
+	}
+	return newAuthorizationService(engine.NewCombiningEngine(algorithm, engines...)), nil
 }
@@ -0,0 +1,57 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/authz/policymock"
+	"github.com/thunder-id/thunderid/tests/mocks/authz/rebacmock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+)
+
+func setupAuthorizationConfig(t *testing.T, authzConfig config.AuthorizationConfig) {
+	t.Helper()
+	config.ResetServerRuntime()
+	t.Cleanup(config.ResetServerRuntime)
+	require.NoError(t, config.InitializeServerRuntime("", &config.Config{Authorization: authzConfig}))
+}
+
+func TestInitialize_Engines(t *testing.T) {
+	cases := map[string]config.AuthorizationConfig{
+		"default":          {},
+		"rbac only":        {Engines: []string{"rbac"}},
+		"rbac and abac":    {Engines: []string{"rbac", "abac"}},
+		"all engines":      {Engines: []string{"rbac", "abac", "rebac"}},
+		"permit-overrides": {Engines: []string{" RBAC ", "abac"}, CombiningAlgorithm: "Permit-Overrides"},
+	}
+	for name, authzConfig := range cases {
+		t.Run(name, func(t *testing.T) {
+			setupAuthorizationConfig(t, authzConfig)
+			provider, err := Initialize(rolemock.NewRoleServiceInterfaceMock(t),
+				policymock.NewPolicyServiceInterfaceMock(t), rebacmock.NewReBACServiceInterfaceMock(t), nil, nil)
+			require.NoError(t, err)
+			assert.NotNil(t, provider)
+		})
+	}
+}
+
+func TestInitialize_InvalidConfiguration(t *testing.T) {
+	cases := map[string]config.AuthorizationConfig{
+		"unknown engine":    {Engines: []string{"rbac", "pbac"}},
+		"unknown algorithm": {Engines: []string{"rbac", "abac"}, CombiningAlgorithm: "first-applicable"},
+	}
+	for name, authzConfig := range cases {
+		t.Run(name, func(t *testing.T) {
+			setupAuthorizationConfig(t, authzConfig)
+			_, err := Initialize(rolemock.NewRoleServiceInterfaceMock(t),
+				policymock.NewPolicyServiceInterfaceMock(t), rebacmock.NewReBACServiceInterfaceMock(t), nil, nil)
+			assert.Error(t, err)
+		})
+	}
+}
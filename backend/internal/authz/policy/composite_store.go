@@ -0,0 +1,167 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"errors"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+)
+
+// compositePolicyStore implements a composite store that combines file-based (immutable) and
+// database (mutable) stores.
+// - Read operations query both stores and merge results
+// - Write operations (Create/Update/Delete) only affect the database store
+// - Declarative policies (from YAML files) cannot be modified or deleted
+type compositePolicyStore struct {
+	fileStore policyStoreInterface
+	dbStore   policyStoreInterface
+}
+
+// newCompositePolicyStore creates a new composite store with both file-based and database stores.
+func newCompositePolicyStore(fileStore, dbStore policyStoreInterface) *compositePolicyStore {
+	return &compositePolicyStore{
+		fileStore: fileStore,
+		dbStore:   dbStore,
+	}
+}
+
+// CreatePolicy creates a new policy in the database store only.
+func (c *compositePolicyStore) CreatePolicy(ctx context.Context, dto PolicyDTO) error {
+	return c.dbStore.CreatePolicy(ctx, dto)
+}
+
+// GetPolicyByID retrieves a policy by ID from either store.
+// Checks the database store first, then falls back to the file store.
+func (c *compositePolicyStore) GetPolicyByID(ctx context.Context, id string) (*PolicyDTO, error) {
+	return declarativeresource.CompositeGetHelper(
+		func() (*PolicyDTO, error) { return c.dbStore.GetPolicyByID(ctx, id) },
+		func() (*PolicyDTO, error) { return c.fileStore.GetPolicyByID(ctx, id) },
+		ErrNotFound,
+	)
+}
+
+// GetPolicyByHandle retrieves a policy by handle from either store.
+// Checks the database store first, then falls back to the file store.
+func (c *compositePolicyStore) GetPolicyByHandle(ctx context.Context, handle string) (*PolicyDTO, error) {
+	return declarativeresource.CompositeGetHelper(
+		func() (*PolicyDTO, error) { return c.dbStore.GetPolicyByHandle(ctx, handle) },
+		func() (*PolicyDTO, error) { return c.fileStore.GetPolicyByHandle(ctx, handle) },
+		ErrNotFound,
+	)
+}
+
+// ListPolicies retrieves policies from both stores and merges the results.
+func (c *compositePolicyStore) ListPolicies(ctx context.Context) ([]PolicyDTO, error) {
+	dbPolicies, err := c.dbStore.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filePolicies, err := c.fileStore.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mergeWithLimit(dbPolicies, filePolicies)
+}
+
+// ListPoliciesByResourceServer retrieves the policies applicable to the resource server from both
+// stores and merges the results.
+func (c *compositePolicyStore) ListPoliciesByResourceServer(ctx context.Context, resourceServerID string) (
+	[]PolicyDTO, error) {
+	dbPolicies, err := c.dbStore.ListPoliciesByResourceServer(ctx, resourceServerID)
+	if err != nil {
+		return nil, err
+	}
+	filePolicies, err := c.fileStore.ListPoliciesByResourceServer(ctx, resourceServerID)
+	if err != nil {
+		return nil, err
+	}
+	return mergeWithLimit(dbPolicies, filePolicies)
+}
+
+// UpdatePolicy updates a policy in the database store only.
+// Returns ErrPolicyIsImmutable if the policy is declarative (exists in file store).
+func (c *compositePolicyStore) UpdatePolicy(ctx context.Context, dto PolicyDTO) error {
+	return declarativeresource.CompositeUpdateHelper(
+		dto,
+		func(p PolicyDTO) string { return p.ID },
+		func(id string) (bool, error) { return c.existsInFileStore(ctx, id) },
+		func(p PolicyDTO) error { return c.dbStore.UpdatePolicy(ctx, p) },
+		ErrPolicyIsImmutable,
+	)
+}
+
+// DeletePolicy deletes a policy from the database store only.
+// Returns ErrPolicyIsImmutable if the policy is declarative (exists in file store).
+func (c *compositePolicyStore) DeletePolicy(ctx context.Context, id string) error {
+	return declarativeresource.CompositeDeleteHelper(
+		id,
+		func(id string) (bool, error) { return c.existsInFileStore(ctx, id) },
+		func(id string) error { return c.dbStore.DeletePolicy(ctx, id) },
+		ErrPolicyIsImmutable,
+	)
+}
+
+// IsPolicyDeclarative reports whether the policy is file-based (immutable).
+func (c *compositePolicyStore) IsPolicyDeclarative(ctx context.Context, id string) (bool, error) {
+	return c.existsInFileStore(ctx, id)
+}
+
+// existsInFileStore reports whether the id exists in the immutable file store.
+func (c *compositePolicyStore) existsInFileStore(ctx context.Context, id string) (bool, error) {
+	_, err := c.fileStore.GetPolicyByID(ctx, id)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	return false, err
+}
+
+// mergeWithLimit merges policies from both stores, enforcing the composite-store record limit.
+func mergeWithLimit(dbPolicies, filePolicies []PolicyDTO) ([]PolicyDTO, error) {
+	policies, limitExceeded, err := declarativeresource.CompositeMergeListHelperWithLimit(
+		func() (int, error) { return len(dbPolicies), nil },
+		func() (int, error) { return len(filePolicies), nil },
+		func(int) ([]PolicyDTO, error) { return dbPolicies, nil },
+		func(int) ([]PolicyDTO, error) { return filePolicies, nil },
+		mergeAndDeduplicate,
+		len(dbPolicies)+len(filePolicies),
+		0,
+		serverconst.MaxCompositeStoreRecords,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if limitExceeded {
+		return nil, ErrResultLimitExceededInCompositeMode
+	}
+	return policies, nil
+}
+
+// mergeAndDeduplicate merges policies from both stores and removes duplicates by ID.
+// Database policies take precedence over file-based policies with the same ID.
+func mergeAndDeduplicate(dbPolicies, filePolicies []PolicyDTO) []PolicyDTO {
+	seen := make(map[string]bool, len(dbPolicies))
+	result := make([]PolicyDTO, 0, len(dbPolicies)+len(filePolicies))
+
+	for i := range dbPolicies {
+		if !seen[dbPolicies[i].ID] {
+			seen[dbPolicies[i].ID] = true
+			result = append(result, dbPolicies[i])
+		}
+	}
+
+	for i := range filePolicies {
+		if !seen[filePolicies[i].ID] {
+			seen[filePolicies[i].ID] = true
+			result = append(result, filePolicies[i])
+		}
+	}
+
+	return result
+}
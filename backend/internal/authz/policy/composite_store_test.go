@@ -0,0 +1,91 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CompositePolicyStoreTestSuite struct {
+	suite.Suite
+	fileStore *policyStoreInterfaceMock
+	dbStore   *policyStoreInterfaceMock
+	store     *compositePolicyStore
+	ctx       context.Context
+}
+
+func TestCompositePolicyStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(CompositePolicyStoreTestSuite))
+}
+
+func (s *CompositePolicyStoreTestSuite) SetupTest() {
+	s.fileStore = newPolicyStoreInterfaceMock(s.T())
+	s.dbStore = newPolicyStoreInterfaceMock(s.T())
+	s.store = newCompositePolicyStore(s.fileStore, s.dbStore)
+	s.ctx = context.Background()
+}
+
+func (s *CompositePolicyStoreTestSuite) TestCreateGoesToDatabase() {
+	dto := PolicyDTO{ID: "pol-1", Handle: "h"}
+	s.dbStore.EXPECT().CreatePolicy(s.ctx, dto).Return(nil)
+
+	s.NoError(s.store.CreatePolicy(s.ctx, dto))
+}
+
+func (s *CompositePolicyStoreTestSuite) TestGetPolicyByIDFallsBackToFileStore() {
+	s.dbStore.EXPECT().GetPolicyByID(s.ctx, "pol-1").Return(nil, ErrNotFound)
+	s.fileStore.EXPECT().GetPolicyByID(s.ctx, "pol-1").Return(&PolicyDTO{ID: "pol-1"}, nil)
+
+	got, err := s.store.GetPolicyByID(s.ctx, "pol-1")
+
+	s.Require().NoError(err)
+	s.Equal("pol-1", got.ID)
+}
+
+func (s *CompositePolicyStoreTestSuite) TestListPoliciesByResourceServerMergesAndDeduplicates() {
+	s.dbStore.EXPECT().ListPoliciesByResourceServer(s.ctx, "rs-1").
+		Return([]PolicyDTO{{ID: "pol-1", Handle: "db"}}, nil)
+	s.fileStore.EXPECT().ListPoliciesByResourceServer(s.ctx, "rs-1").
+		Return([]PolicyDTO{{ID: "pol-1", Handle: "file"}, {ID: "pol-2", Handle: "file-2"}}, nil)
+
+	policies, err := s.store.ListPoliciesByResourceServer(s.ctx, "rs-1")
+
+	s.Require().NoError(err)
+	s.Equal([]PolicyDTO{{ID: "pol-1", Handle: "db"}, {ID: "pol-2", Handle: "file-2"}}, policies)
+}
+
+func (s *CompositePolicyStoreTestSuite) TestListPoliciesDatabaseError() {
+	s.dbStore.EXPECT().ListPolicies(s.ctx).Return(nil, errors.New("db error"))
+
+	_, err := s.store.ListPolicies(s.ctx)
+
+	s.Error(err)
+}
+
+func (s *CompositePolicyStoreTestSuite) TestUpdateDeclarativePolicyIsImmutable() {
+	s.fileStore.EXPECT().GetPolicyByID(s.ctx, "pol-1").Return(&PolicyDTO{ID: "pol-1"}, nil)
+
+	err := s.store.UpdatePolicy(s.ctx, PolicyDTO{ID: "pol-1"})
+
+	s.ErrorIs(err, ErrPolicyIsImmutable)
+}
+
+func (s *CompositePolicyStoreTestSuite) TestDeleteMutablePolicy() {
+	s.fileStore.EXPECT().GetPolicyByID(s.ctx, "pol-1").Return(nil, ErrNotFound)
+	s.dbStore.EXPECT().DeletePolicy(s.ctx, "pol-1").Return(nil)
+
+	s.NoError(s.store.DeletePolicy(s.ctx, "pol-1"))
+}
+
+func (s *CompositePolicyStoreTestSuite) TestIsPolicyDeclarativePropagatesError() {
+	s.fileStore.EXPECT().GetPolicyByID(s.ctx, "pol-1").Return(nil, ErrPolicyDataCorrupted)
+
+	_, err := s.store.IsPolicyDeclarative(s.ctx, "pol-1")
+
+	s.ErrorIs(err, ErrPolicyDataCorrupted)
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	resourceTypeAuthorizationPolicy = "authorization_policy"
+	paramTypeAuthorizationPolicy    = "AuthorizationPolicy"
+)
+
+// policyExporter implements declarativeresource.ResourceExporter for authorization policies,
+// reading them through the service.
+type policyExporter struct {
+	service PolicyServiceInterface
+}
+
+// newPolicyExporter creates a new authorization policy exporter.
+func newPolicyExporter(service PolicyServiceInterface) *policyExporter {
+	return &policyExporter{service: service}
+}
+
+// GetResourceType returns the resource type identifier for authorization policies.
+func (e *policyExporter) GetResourceType() string {
+	return resourceTypeAuthorizationPolicy
+}
+
+// GetParameterizerType returns the parameterizer type name for authorization policies.
+func (e *policyExporter) GetParameterizerType() string {
+	return paramTypeAuthorizationPolicy
+}
+
+// GetAllResourceIDs returns the IDs of all mutable (database-backed) policies, excluding any
+// declarative (file-based) policies.
+func (e *policyExporter) GetAllResourceIDs(ctx context.Context) ([]string, *tidcommon.ServiceError) {
+	policies, err := e.service.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(policies))
+	for _, dto := range policies {
+		isDeclarative, svcErr := e.service.IsPolicyDeclarative(ctx, dto.ID)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		if !isDeclarative {
+			ids = append(ids, dto.ID)
+		}
+	}
+	return ids, nil
+}
+
+// GetResourceByID retrieves a policy by its ID for export.
+// The handle is the stable identifier and is returned as the resource name.
+func (e *policyExporter) GetResourceByID(ctx context.Context, id string) (
+	interface{}, string, *tidcommon.ServiceError,
+) {
+	dto, err := e.service.GetPolicy(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	return dto, dto.Handle, nil
+}
+
+// ValidateResource validates a policy resource prior to export, extracting its handle as the
+// stable resource name.
+func (e *policyExporter) ValidateResource(ctx context.Context,
+	resource interface{}, id string, logger *log.Logger) (string, *declarativeresource.ExportError) {
+	dto, ok := resource.(*PolicyDTO)
+	if !ok {
+		return "", declarativeresource.CreateTypeError(resourceTypeAuthorizationPolicy, id)
+	}
+
+	if err := declarativeresource.ValidateResourceName(ctx,
+		dto.Handle, resourceTypeAuthorizationPolicy, id, "AUTHZ_POLICY_VALIDATION_ERROR", logger); err != nil {
+		return "", err
+	}
+
+	return dto.Handle, nil
+}
+
+// GetResourceRules returns the parameterization rules for authorization policies. Policies carry
+// no secrets or deployment-specific fields, so no field is parameterized.
+func (e *policyExporter) GetResourceRules() *declarativeresource.ResourceRules {
+	return &declarativeresource.ResourceRules{}
+}
+
+// loadDeclarativeResources loads declarative authorization policy resources from files.
+func loadDeclarativeResources(store declarativeresource.Storer) error {
+	resourceConfig := declarativeresource.ResourceConfig{
+		ResourceType:  "AuthorizationPolicy",
+		DirectoryName: "authorization_policies",
+		Parser:        parseToPolicyDTOWrapper,
+		Validator:     validatePolicyWrapper,
+		IDExtractor: func(dto interface{}) string {
+			return dto.(*PolicyDTO).ID
+		},
+	}
+
+	loader := declarativeresource.NewResourceLoader(resourceConfig, store)
+	if err := loader.LoadResources(); err != nil {
+		return fmt.Errorf("failed to load authorization policy resources: %w", err)
+	}
+
+	return nil
+}
+
+// parseToPolicyDTOWrapper wraps parseToPolicyDTO to match the expected signature.
+func parseToPolicyDTOWrapper(data []byte) (interface{}, error) {
+	return parseToPolicyDTO(data)
+}
+
+// parseToPolicyDTO unmarshals YAML data into a policy DTO.
+func parseToPolicyDTO(data []byte) (*PolicyDTO, error) {
+	var dto PolicyDTO
+	if err := yaml.Unmarshal(data, &dto); err != nil {
+		return nil, err
+	}
+	return &dto, nil
+}
+
+// validatePolicyWrapper wraps validatePolicy to match ResourceConfig.Validator signature.
+func validatePolicyWrapper(dto interface{}) error {
+	p, ok := dto.(*PolicyDTO)
+	if !ok {
+		return fmt.Errorf("invalid type: expected *PolicyDTO")
+	}
+	if p.ID == "" {
+		return fmt.Errorf("authorization policy ID is required")
+	}
+	if svcErr := validatePolicy(p); svcErr != nil {
+		return fmt.Errorf("validation failed: %s", svcErr.Error.DefaultValue)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+type PolicyExporterTestSuite struct {
+	suite.Suite
+	service  *PolicyServiceInterfaceMock
+	exporter *policyExporter
+	logger   *log.Logger
+}
+
+func TestPolicyExporterTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyExporterTestSuite))
+}
+
+func (s *PolicyExporterTestSuite) SetupTest() {
+	s.service = NewPolicyServiceInterfaceMock(s.T())
+	s.exporter = newPolicyExporter(s.service)
+	s.logger = log.GetLogger()
+}
+
+func (s *PolicyExporterTestSuite) TestResourceTypes() {
+	s.Equal("authorization_policy", s.exporter.GetResourceType())
+	s.Equal("AuthorizationPolicy", s.exporter.GetParameterizerType())
+	s.NotNil(s.exporter.GetResourceRules())
+}
+
+func (s *PolicyExporterTestSuite) TestGetAllResourceIDsExcludesDeclarative() {
+	ctx := context.Background()
+	s.service.EXPECT().ListPolicies(ctx).Return([]PolicyDTO{{ID: "db"}, {ID: "file"}}, nil)
+	s.service.EXPECT().IsPolicyDeclarative(ctx, "db").Return(false, nil)
+	s.service.EXPECT().IsPolicyDeclarative(ctx, "file").Return(true, nil)
+
+	ids, svcErr := s.exporter.GetAllResourceIDs(ctx)
+
+	s.Nil(svcErr)
+	s.Equal([]string{"db"}, ids)
+}
+
+func (s *PolicyExporterTestSuite) TestGetResourceByID() {
+	ctx := context.Background()
+	dto := testPolicy()
+	s.service.EXPECT().GetPolicy(ctx, "pol-1").Return(&dto, nil)
+
+	resource, name, svcErr := s.exporter.GetResourceByID(ctx, "pol-1")
+
+	s.Nil(svcErr)
+	s.Equal("business-hours", name)
+	s.Equal(&dto, resource)
+}
+
+func (s *PolicyExporterTestSuite) TestValidateResource() {
+	dto := testPolicy()
+	name, err := s.exporter.ValidateResource(context.Background(), &dto, "pol-1", s.logger)
+	s.Nil(err)
+	s.Equal("business-hours", name)
+
+	_, err = s.exporter.ValidateResource(context.Background(), "not-a-policy", "pol-1", s.logger)
+	s.Require().NotNil(err)
+	s.Equal("INVALID_TYPE", err.Code)
+}
+
+func (s *PolicyExporterTestSuite) TestParseToPolicyDTO() {
+	yamlDoc := []byte(`
+id: pol-1
+handle: finance-only
+effect: PERMIT
+target:
+  resourceServerId: rs-1
+  permissions: [invoice:approve]
+conditions:
+  - attribute: subject.ouId
+    operator: inOU
+    values: [finance-ou]
+  - attribute: context.ip
+    operator: ipInRange
+    values: [10.0.0.0/8]
+`)
+
+	dto, err := parseToPolicyDTO(yamlDoc)
+
+	s.Require().NoError(err)
+	s.Equal("finance-only", dto.Handle)
+	s.Equal([]string{"invoice:approve"}, dto.Target.Permissions)
+	s.Require().Len(dto.Conditions, 2)
+	s.Equal(OperatorIPInRange, dto.Conditions[1].Operator)
+	s.NoError(validatePolicyWrapper(dto))
+}
+
+func (s *PolicyExporterTestSuite) TestValidatePolicyWrapperRejectsInvalid() {
+	s.Error(validatePolicyWrapper("not-a-policy"))
+	s.Error(validatePolicyWrapper(&PolicyDTO{Handle: "h", Effect: PolicyEffectPermit}))
+	s.Error(validatePolicyWrapper(&PolicyDTO{ID: "pol-1", Handle: "h", Effect: "MAYBE"}))
+}
+
+func (s *PolicyExporterTestSuite) TestLoadDeclarativeResourcesNoResources() {
+	config.ResetServerRuntime()
+	s.T().Cleanup(config.ResetServerRuntime)
+	s.Require().NoError(config.InitializeServerRuntime(s.T().TempDir(), &config.Config{}))
+
+	fileStore := newPolicyFileBasedStore()
+	s.Require().NoError(fileStore.GenericFileBasedStore.ClearByType())
+
+	s.Require().NoError(loadDeclarativeResources(&policyStorer{store: fileStore}))
+}
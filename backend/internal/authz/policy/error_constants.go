@@ -0,0 +1,141 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"errors"
+	"net/http"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Internal sentinel errors for the composite policy store.
+var (
+	// ErrPolicyIsImmutable is returned when trying to modify or delete an immutable (file-based) policy.
+	ErrPolicyIsImmutable = errors.New("authorization policy is immutable")
+
+	// ErrResultLimitExceededInCompositeMode is the internal sentinel error returned
+	// when composite store results exceed the configured limit.
+	ErrResultLimitExceededInCompositeMode = errors.New("result limit exceeded in composite mode")
+
+	// ErrPolicyDataCorrupted is returned when declarative store data is malformed.
+	ErrPolicyDataCorrupted = errors.New("authorization policy data is corrupted")
+)
+
+// Client-facing API errors for the policy management endpoints.
+var (
+	// ErrorInvalidRequest indicates a malformed create/update request.
+	ErrorInvalidRequest = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZP-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.policyservice.invalid_request",
+			DefaultValue: "Invalid request",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.policyservice.invalid_request_description",
+			DefaultValue: "The policy request is missing required fields or is malformed",
+		},
+	}
+
+	// ErrorPolicyNotFound indicates the policy does not exist.
+	ErrorPolicyNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZP-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.policyservice.policy_not_found",
+			DefaultValue: "Policy not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.policyservice.policy_not_found_description",
+			DefaultValue: "No authorization policy exists for the supplied identifier",
+		},
+	}
+
+	// ErrorPolicyAlreadyExists indicates the handle is already in use.
+	ErrorPolicyAlreadyExists = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZP-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.policyservice.policy_already_exists",
+			DefaultValue: "Policy already exists",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.policyservice.policy_already_exists_description",
+			DefaultValue: "An authorization policy with the supplied handle already exists",
+		},
+	}
+
+	// ErrorInvalidEffect indicates the policy effect is not PERMIT or DENY.
+	ErrorInvalidEffect = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZP-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.policyservice.invalid_effect",
+			DefaultValue: "Invalid policy effect",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.policyservice.invalid_effect_description",
+			DefaultValue: "The policy effect must be either PERMIT or DENY",
+		},
+	}
+
+	// ErrorInvalidCondition indicates a policy condition is malformed.
+	ErrorInvalidCondition = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZP-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.policyservice.invalid_condition",
+			DefaultValue: "Invalid policy condition",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.policyservice.invalid_condition_description",
+			DefaultValue: "A policy condition has an unknown operator, an unsupported attribute " +
+				"or values that do not suit the operator",
+		},
+	}
+
+	// ErrorPolicyImmutable indicates the policy is declarative (file-based) and cannot be
+	// modified or deleted via the management API.
+	ErrorPolicyImmutable = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZP-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.policyservice.policy_immutable",
+			DefaultValue: "Policy is immutable",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.policyservice.policy_immutable_description",
+			DefaultValue: "The authorization policy is defined in declarative configuration " +
+				"and cannot be modified or deleted",
+		},
+	}
+
+	// ErrorResultLimitExceeded indicates the merged composite-store result set exceeds the supported maximum.
+	ErrorResultLimitExceeded = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZP-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.policyservice.result_limit_exceeded",
+			DefaultValue: "Result limit exceeded",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.policyservice.result_limit_exceeded_description",
+			DefaultValue: "The number of authorization policies exceeds the supported limit in " +
+				"hybrid mode",
+		},
+	}
+)
+
+// policyClientErrorStatus maps a client-facing policy error to its HTTP status.
+func policyClientErrorStatus(code string) int {
+	switch code {
+	case ErrorPolicyNotFound.Code:
+		return http.StatusNotFound
+	case ErrorPolicyAlreadyExists.Code, ErrorPolicyImmutable.Code:
+		return http.StatusConflict
+	default:
+		return http.StatusBadRequest
+	}
+}
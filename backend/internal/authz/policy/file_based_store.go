@@ -0,0 +1,127 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/declarative_resource/entity"
+)
+
+type policyFileBasedStore struct {
+	*declarativeresource.GenericFileBasedStore
+}
+
+// newPolicyFileBasedStore creates a new instance of a file-based store.
+func newPolicyFileBasedStore() *policyFileBasedStore {
+	genericStore := declarativeresource.NewGenericFileBasedStore(entity.KeyTypeAuthorizationPolicy)
+	return &policyFileBasedStore{
+		GenericFileBasedStore: genericStore,
+	}
+}
+
+// CreatePolicy stores a policy in the file-based store. In declarative and composite modes the
+// loader writes resources through this method (resources loaded from YAML are immutable;
+// management writes route to the database store).
+func (f *policyFileBasedStore) CreatePolicy(_ context.Context, dto PolicyDTO) error {
+	return f.GenericFileBasedStore.Create(dto.ID, &dto)
+}
+
+// policyStorer adapts the file-based store to declarativeresource.Storer so the resource
+// loader can write parsed policies through the (id, data) entry point.
+type policyStorer struct {
+	store *policyFileBasedStore
+}
+
+// Create implements declarativeresource.Storer for the resource loader.
+func (s *policyStorer) Create(id string, data interface{}) error {
+	dto, ok := data.(*PolicyDTO)
+	if !ok {
+		return ErrPolicyDataCorrupted
+	}
+	if dto.ID == "" {
+		dto.ID = id
+	}
+	return s.store.GenericFileBasedStore.Create(id, dto)
+}
+
+// GetPolicyByID retrieves a policy by ID from the file-based store.
+func (f *policyFileBasedStore) GetPolicyByID(_ context.Context, id string) (*PolicyDTO, error) {
+	data, err := f.GenericFileBasedStore.Get(id)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	dto, ok := data.(*PolicyDTO)
+	if !ok {
+		declarativeresource.LogTypeAssertionError("authorization policy", id)
+		return nil, ErrPolicyDataCorrupted
+	}
+	return dto, nil
+}
+
+// GetPolicyByHandle retrieves a policy by handle from the file-based store.
+func (f *policyFileBasedStore) GetPolicyByHandle(_ context.Context, handle string) (*PolicyDTO, error) {
+	data, err := f.GenericFileBasedStore.GetByField(handle, func(d interface{}) string {
+		return d.(*PolicyDTO).Handle
+	})
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	return data.(*PolicyDTO), nil
+}
+
+// ListPolicies retrieves all policies from the file-based store.
+func (f *policyFileBasedStore) ListPolicies(_ context.Context) ([]PolicyDTO, error) {
+	list, err := f.GenericFileBasedStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]PolicyDTO, 0, len(list))
+	for _, item := range list {
+		if dto, ok := item.Data.(*PolicyDTO); ok {
+			policies = append(policies, *dto)
+		}
+	}
+	return policies, nil
+}
+
+// ListPoliciesByResourceServer retrieves the policies targeting the given resource server, or every
+// resource server, from the file-based store.
+func (f *policyFileBasedStore) ListPoliciesByResourceServer(ctx context.Context, resourceServerID string) (
+	[]PolicyDTO, error) {
+	policies, err := f.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return filterByResourceServer(policies, resourceServerID), nil
+}
+
+// UpdatePolicy is not supported in the file-based store.
+func (f *policyFileBasedStore) UpdatePolicy(_ context.Context, _ PolicyDTO) error {
+	return ErrPolicyIsImmutable
+}
+
+// DeletePolicy is not supported in the file-based store.
+func (f *policyFileBasedStore) DeletePolicy(_ context.Context, _ string) error {
+	return ErrPolicyIsImmutable
+}
+
+// IsPolicyDeclarative reports whether the given id exists in the file-based store.
+func (f *policyFileBasedStore) IsPolicyDeclarative(_ context.Context, id string) (bool, error) {
+	_, err := f.GenericFileBasedStore.Get(id)
+	return err == nil, nil
+}
+
+// filterByResourceServer keeps the policies that target the given resource server or every resource server.
+func filterByResourceServer(policies []PolicyDTO, resourceServerID string) []PolicyDTO {
+	filtered := make([]PolicyDTO, 0, len(policies))
+	for _, p := range policies {
+		if p.Target.ResourceServerID == "" || p.Target.ResourceServerID == resourceServerID {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
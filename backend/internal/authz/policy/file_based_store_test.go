@@ -0,0 +1,108 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PolicyFileBasedStoreTestSuite struct {
+	suite.Suite
+	store  *policyFileBasedStore
+	storer *policyStorer
+	ctx    context.Context
+}
+
+func TestPolicyFileBasedStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyFileBasedStoreTestSuite))
+}
+
+func (s *PolicyFileBasedStoreTestSuite) SetupTest() {
+	fileStore := newPolicyFileBasedStore()
+	// The file store is backed by the singleton entity store; clear this key type
+	// so each test starts from a clean slate.
+	s.Require().NoError(fileStore.GenericFileBasedStore.ClearByType())
+	s.store = fileStore
+	s.storer = &policyStorer{store: fileStore}
+	s.ctx = context.Background()
+}
+
+func (s *PolicyFileBasedStoreTestSuite) seed(id, handle, resourceServerID string) {
+	err := s.storer.Create(id, &PolicyDTO{
+		ID:     id,
+		Handle: handle,
+		Effect: PolicyEffectPermit,
+		Target: PolicyTarget{ResourceServerID: resourceServerID},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *PolicyFileBasedStoreTestSuite) TestStorerCreateAndGet() {
+	s.seed("pol-1", "finance-only", "rs-1")
+
+	byID, err := s.store.GetPolicyByID(s.ctx, "pol-1")
+	s.Require().NoError(err)
+	s.Equal("finance-only", byID.Handle)
+
+	byHandle, err := s.store.GetPolicyByHandle(s.ctx, "finance-only")
+	s.Require().NoError(err)
+	s.Equal("pol-1", byHandle.ID)
+}
+
+func (s *PolicyFileBasedStoreTestSuite) TestStorerCreateBackfillsID() {
+	s.Require().NoError(s.storer.Create("pol-backfill", &PolicyDTO{Handle: "h", Effect: PolicyEffectDeny}))
+
+	got, err := s.store.GetPolicyByID(s.ctx, "pol-backfill")
+	s.Require().NoError(err)
+	s.Equal("pol-backfill", got.ID)
+}
+
+func (s *PolicyFileBasedStoreTestSuite) TestStorerCreateRejectsWrongType() {
+	s.ErrorIs(s.storer.Create("pol-1", "not-a-policy"), ErrPolicyDataCorrupted)
+}
+
+func (s *PolicyFileBasedStoreTestSuite) TestGetNotFound() {
+	_, err := s.store.GetPolicyByID(s.ctx, "missing")
+	s.ErrorIs(err, ErrNotFound)
+
+	_, err = s.store.GetPolicyByHandle(s.ctx, "missing")
+	s.ErrorIs(err, ErrNotFound)
+}
+
+func (s *PolicyFileBasedStoreTestSuite) TestListPoliciesByResourceServer() {
+	s.seed("pol-1", "rs-1-policy", "rs-1")
+	s.seed("pol-2", "rs-2-policy", "rs-2")
+	s.seed("pol-3", "global-policy", "")
+
+	policies, err := s.store.ListPoliciesByResourceServer(s.ctx, "rs-1")
+	s.Require().NoError(err)
+
+	handles := make([]string, 0, len(policies))
+	for _, p := range policies {
+		handles = append(handles, p.Handle)
+	}
+	s.ElementsMatch([]string{"rs-1-policy", "global-policy"}, handles)
+}
+
+func (s *PolicyFileBasedStoreTestSuite) TestWritesAreImmutable() {
+	s.seed("pol-1", "finance-only", "")
+
+	s.ErrorIs(s.store.UpdatePolicy(s.ctx, PolicyDTO{ID: "pol-1"}), ErrPolicyIsImmutable)
+	s.ErrorIs(s.store.DeletePolicy(s.ctx, "pol-1"), ErrPolicyIsImmutable)
+}
+
+func (s *PolicyFileBasedStoreTestSuite) TestIsPolicyDeclarative() {
+	s.seed("pol-1", "finance-only", "")
+
+	isDeclarative, err := s.store.IsPolicyDeclarative(s.ctx, "pol-1")
+	s.NoError(err)
+	s.True(isDeclarative)
+
+	isDeclarative, err = s.store.IsPolicyDeclarative(s.ctx, "missing")
+	s.NoError(err)
+	s.False(isDeclarative)
+}
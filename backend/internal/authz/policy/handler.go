@@ -0,0 +1,154 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const policiesPath = "/authorization/policies"
+
+// policyHandler serves the management API for authorization policies.
+type policyHandler struct {
+	service PolicyServiceInterface
+}
+
+// newPolicyHandler builds the policy management HTTP handler.
+func newPolicyHandler(service PolicyServiceInterface) *policyHandler {
+	return &policyHandler{service: service}
+}
+
+// HandleCreate creates a policy.
+func (h *policyHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	req, err := sysutils.DecodeJSONBody[policyRequest](r)
+	if err != nil {
+		writePolicyError(r.Context(), w, &ErrorInvalidRequest)
+		return
+	}
+	created, svcErr := h.service.CreatePolicy(r.Context(), requestToDTO(req))
+	if svcErr != nil {
+		writePolicyError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusCreated, created)
+}
+
+// HandleList returns a minimal summary of all policies.
+func (h *policyHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	policies, svcErr := h.service.ListPolicies(r.Context())
+	if svcErr != nil {
+		writePolicyError(r.Context(), w, svcErr)
+		return
+	}
+	summaries := make([]PolicyList, 0, len(policies))
+	for _, p := range policies {
+		summaries = append(summaries, toSummary(p))
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, summaries)
+}
+
+// HandleGet returns a single policy.
+func (h *policyHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(r.PathValue("id"))
+	if id == "" {
+		writePolicyError(r.Context(), w, &ErrorInvalidRequest)
+		return
+	}
+	dto, svcErr := h.service.GetPolicy(r.Context(), id)
+	if svcErr != nil {
+		writePolicyError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, dto)
+}
+
+// HandleUpdate updates a policy.
+func (h *policyHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(r.PathValue("id"))
+	if id == "" {
+		writePolicyError(r.Context(), w, &ErrorInvalidRequest)
+		return
+	}
+	req, err := sysutils.DecodeJSONBody[policyRequest](r)
+	if err != nil {
+		writePolicyError(r.Context(), w, &ErrorInvalidRequest)
+		return
+	}
+	updated, svcErr := h.service.UpdatePolicy(r.Context(), id, requestToDTO(req))
+	if svcErr != nil {
+		writePolicyError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, updated)
+}
+
+// HandleDelete deletes a policy.
+func (h *policyHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(r.PathValue("id"))
+	if id == "" {
+		writePolicyError(r.Context(), w, &ErrorInvalidRequest)
+		return
+	}
+	if svcErr := h.service.DeletePolicy(r.Context(), id); svcErr != nil {
+		writePolicyError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// requestToDTO maps and sanitizes an API request to a managed DTO.
+func requestToDTO(req *policyRequest) *PolicyDTO {
+	conditions := make([]PolicyCondition, 0, len(req.Conditions))
+	for _, c := range req.Conditions {
+		conditions = append(conditions, PolicyCondition{
+			Attribute: sysutils.SanitizeString(c.Attribute),
+			Operator:  ConditionOperator(sysutils.SanitizeString(string(c.Operator))),
+			Values:    sanitizeStrings(c.Values),
+			ValueFrom: sysutils.SanitizeString(c.ValueFrom),
+		})
+	}
+	return &PolicyDTO{
+		Handle:      sysutils.SanitizeString(req.Handle),
+		Name:        sysutils.SanitizeString(req.Name),
+		Description: sysutils.SanitizeString(req.Description),
+		Effect:      PolicyEffect(strings.ToUpper(sysutils.SanitizeString(string(req.Effect)))),
+		Target: PolicyTarget{
+			ResourceServerID: sysutils.SanitizeString(req.Target.ResourceServerID),
+			Permissions:      sanitizeStrings(req.Target.Permissions),
+			SubjectTypes:     sanitizeStrings(req.Target.SubjectTypes),
+		},
+		Conditions: conditions,
+	}
+}
+
+// sanitizeStrings returns a new slice with each input string sanitized, or nil for empty input.
+func sanitizeStrings(in []string) []string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(in))
+	for _, s := range in {
+		out = append(out, sysutils.SanitizeString(s))
+	}
+	return out
+}
+
+// writePolicyError maps a service error to an HTTP status and writes the corresponding error response.
+func writePolicyError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	status := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		status = policyClientErrorStatus(svcErr.Code)
+	}
+	sysutils.WriteErrorResponse(ctx, w, status, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+type PolicyHandlerTestSuite struct {
+	suite.Suite
+	service *PolicyServiceInterfaceMock
+	handler *policyHandler
+}
+
+func TestPolicyHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyHandlerTestSuite))
+}
+
+func (s *PolicyHandlerTestSuite) SetupTest() {
+	s.service = NewPolicyServiceInterfaceMock(s.T())
+	s.handler = newPolicyHandler(s.service)
+}
+
+func (s *PolicyHandlerTestSuite) TestHandleCreateSuccess() {
+	body := `{"handle":"finance-only","effect":"permit",` +
+		`"conditions":[{"attribute":"subject.properties.department","operator":"equals","values":["finance"]}]}`
+	s.service.EXPECT().CreatePolicy(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, dto *PolicyDTO) (*PolicyDTO, *tidcommon.ServiceError) {
+			s.Equal(PolicyEffectPermit, dto.Effect)
+			s.Len(dto.Conditions, 1)
+			dto.ID = "pol-1"
+			return dto, nil
+		})
+
+	req := httptest.NewRequest(http.MethodPost, policiesPath, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.handler.HandleCreate(rec, req)
+
+	s.Equal(http.StatusCreated, rec.Code)
+	s.Contains(rec.Body.String(), "pol-1")
+}
+
+func (s *PolicyHandlerTestSuite) TestHandleCreateInvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, policiesPath, strings.NewReader("not-json"))
+	rec := httptest.NewRecorder()
+	s.handler.HandleCreate(rec, req)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+	s.Contains(rec.Body.String(), ErrorInvalidRequest.Code)
+}
+
+func (s *PolicyHandlerTestSuite) TestHandleCreateConflict() {
+	s.service.EXPECT().CreatePolicy(mock.Anything, mock.Anything).Return(nil, &ErrorPolicyAlreadyExists)
+
+	req := httptest.NewRequest(http.MethodPost, policiesPath, strings.NewReader(`{"handle":"h","effect":"DENY"}`))
+	rec := httptest.NewRecorder()
+	s.handler.HandleCreate(rec, req)
+
+	s.Equal(http.StatusConflict, rec.Code)
+}
+
+func (s *PolicyHandlerTestSuite) TestHandleListReturnsSummaries() {
+	s.service.EXPECT().ListPolicies(mock.Anything).Return([]PolicyDTO{testPolicy()}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, policiesPath, nil)
+	rec := httptest.NewRecorder()
+	s.handler.HandleList(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"resourceServerId":"rs-1"`)
+	s.NotContains(rec.Body.String(), "conditions")
+}
+
+func (s *PolicyHandlerTestSuite) TestHandleGetNotFound() {
+	s.service.EXPECT().GetPolicy(mock.Anything, "missing").Return(nil, &ErrorPolicyNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, policiesPath+"/missing", nil)
+	req.SetPathValue("id", "missing")
+	rec := httptest.NewRecorder()
+	s.handler.HandleGet(rec, req)
+
+	s.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *PolicyHandlerTestSuite) TestHandleUpdateImmutable() {
+	s.service.EXPECT().UpdatePolicy(mock.Anything, "pol-1", mock.Anything).Return(nil, &ErrorPolicyImmutable)
+
+	req := httptest.NewRequest(http.MethodPut, policiesPath+"/pol-1", strings.NewReader(`{"handle":"h","effect":"DENY"}`))
+	req.SetPathValue("id", "pol-1")
+	rec := httptest.NewRecorder()
+	s.handler.HandleUpdate(rec, req)
+
+	s.Equal(http.StatusConflict, rec.Code)
+}
+
+func (s *PolicyHandlerTestSuite) TestHandleDeleteSuccess() {
+	s.service.EXPECT().DeletePolicy(mock.Anything, "pol-1").Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, policiesPath+"/pol-1", nil)
+	req.SetPathValue("id", "pol-1")
+	rec := httptest.NewRecorder()
+	s.handler.HandleDelete(rec, req)
+
+	s.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *PolicyHandlerTestSuite) TestHandleDeleteInternalError() {
+	s.service.EXPECT().DeletePolicy(mock.Anything, "pol-1").Return(&tidcommon.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodDelete, policiesPath+"/pol-1", nil)
+	req.SetPathValue("id", "pol-1")
+	rec := httptest.NewRecorder()
+	s.handler.HandleDelete(rec, req)
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
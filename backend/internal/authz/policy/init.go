@@ -0,0 +1,134 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize builds the policy store and service, registers the management API routes, and
+// returns the service for the ABAC engine to read policies from along with the exporter used for
+// declarative-resource export.
+//
+// Store Selection (based on authorization.store configuration):
+//
+//  1. MUTABLE mode (store: "mutable"): database store only, full CRUD.
+//  2. DECLARATIVE mode (store: "declarative"): file-based store only, read-only;
+//     YAML resources are loaded into the file store.
+//  3. COMPOSITE mode (store: "composite"): file-based (immutable) + database (mutable),
+//     reads merged, writes routed to the database, declarative policies immutable.
+//
+// When authorization.store is unset, it falls back to global declarative_resources.enabled
+// (enabled => declarative, disabled => mutable).
+func Initialize(mux *http.ServeMux) (PolicyServiceInterface, declarativeresource.ResourceExporter, error) {
+	store, err := initializeStore()
+	if err != nil {
+		return nil, nil, err
+	}
+	svc := newPolicyService(store)
+	registerRoutes(mux, newPolicyHandler(svc))
+	return svc, newPolicyExporter(svc), nil
+}
+
+// registerRoutes registers the management endpoints. These are admin-facing and intentionally
+// NOT in the public-paths allowlist, so the platform auth middleware protects them.
+func registerRoutes(mux *http.ServeMux, h *policyHandler) {
+	collectionOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	resourceOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("POST "+policiesPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleCreate)).ServeHTTP, collectionOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+policiesPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleList)).ServeHTTP, collectionOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+policiesPath+"/{id}",
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleGet)).ServeHTTP, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT "+policiesPath+"/{id}",
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleUpdate)).ServeHTTP, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+policiesPath+"/{id}",
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleDelete)).ServeHTTP, resourceOpts))
+
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+policiesPath,
+		func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) }, collectionOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+policiesPath+"/{id}",
+		func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) }, resourceOpts))
+}
+
+// initializeStore builds the policy store based on the configured store mode.
+func initializeStore() (policyStoreInterface, error) {
+	storeMode, err := getPolicyStoreMode()
+	if err != nil {
+		return nil, err
+	}
+
+	switch storeMode {
+	case serverconst.StoreModeComposite:
+		fileStore := newPolicyFileBasedStore()
+		dbStore := newPolicyStore()
+		if err := loadDeclarativeResources(&policyStorer{store: fileStore}); err != nil {
+			return nil, err
+		}
+		return newCompositePolicyStore(fileStore, dbStore), nil
+
+	case serverconst.StoreModeDeclarative:
+		fileStore := newPolicyFileBasedStore()
+		if err := loadDeclarativeResources(&policyStorer{store: fileStore}); err != nil {
+			return nil, err
+		}
+		return fileStore, nil
+
+	default:
+		return newPolicyStore(), nil
+	}
+}
+
+// getPolicyStoreMode determines the store mode for authorization policies.
+//
+// Resolution order:
+//  1. If Authorization.Store is explicitly configured, validate and use it — an unrecognized
+//     value is a hard error so the server cannot boot silently with a mistyped mode.
+//  2. Otherwise, fall back to global DeclarativeResources.Enabled:
+//     - If enabled: return "declarative"
+//     - If disabled: return "mutable"
+//
+// Returns normalized store mode: "mutable", "declarative", or "composite".
+func getPolicyStoreMode() (serverconst.StoreMode, error) {
+	cfg := config.GetServerRuntime().Config
+	if cfg.Authorization.Store != "" {
+		mode := serverconst.StoreMode(strings.ToLower(strings.TrimSpace(cfg.Authorization.Store)))
+		switch mode {
+		case serverconst.StoreModeMutable, serverconst.StoreModeDeclarative, serverconst.StoreModeComposite:
+			return mode, nil
+		default:
+			return "", fmt.Errorf("invalid authorization policy store mode %q: must be one of %q, %q, or %q",
+				cfg.Authorization.Store,
+				serverconst.StoreModeMutable,
+				serverconst.StoreModeDeclarative,
+				serverconst.StoreModeComposite,
+			)
+		}
+	}
+
+	if declarativeresource.IsDeclarativeModeEnabled() {
+		return serverconst.StoreModeDeclarative, nil
+	}
+
+	return serverconst.StoreModeMutable, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+)
+
+func setupPolicyConfig(t *testing.T, store string, declarativeEnabled bool) {
+	t.Helper()
+	config.ResetServerRuntime()
+	t.Cleanup(config.ResetServerRuntime)
+	require.NoError(t, config.InitializeServerRuntime("", &config.Config{
+		Authorization:        config.AuthorizationConfig{Store: store},
+		DeclarativeResources: config.DeclarativeResources{Enabled: declarativeEnabled},
+	}))
+}
+
+func TestGetPolicyStoreMode_Explicit(t *testing.T) {
+	cases := map[string]serverconst.StoreMode{
+		"mutable":       serverconst.StoreModeMutable,
+		"declarative":   serverconst.StoreModeDeclarative,
+		"composite":     serverconst.StoreModeComposite,
+		"  Composite  ": serverconst.StoreModeComposite,
+	}
+	for store, want := range cases {
+		setupPolicyConfig(t, store, false)
+		mode, err := getPolicyStoreMode()
+		require.NoError(t, err)
+		assert.Equal(t, want, mode, store)
+	}
+}
+
+func TestGetPolicyStoreMode_InvalidIsError(t *testing.T) {
+	setupPolicyConfig(t, "bogus", true)
+	_, err := getPolicyStoreMode()
+	assert.Error(t, err)
+}
+
+func TestGetPolicyStoreMode_FallbackDeclarativeEnabled(t *testing.T) {
+	setupPolicyConfig(t, "", true)
+	mode, err := getPolicyStoreMode()
+	require.NoError(t, err)
+	assert.Equal(t, serverconst.StoreModeDeclarative, mode)
+}
+
+func TestGetPolicyStoreMode_FallbackDeclarativeDisabled(t *testing.T) {
+	setupPolicyConfig(t, "", false)
+	mode, err := getPolicyStoreMode()
+	require.NoError(t, err)
+	assert.Equal(t, serverconst.StoreModeMutable, mode)
+}
+
+func TestRegisterRoutesRegistersEndpoints(t *testing.T) {
+	mux := http.NewServeMux()
+	registerRoutes(mux, newPolicyHandler(NewPolicyServiceInterfaceMock(t)))
+
+	// The OPTIONS preflight handlers respond without invoking the service.
+	for _, target := range []string{policiesPath, policiesPath + "/some-id"} {
+		req := httptest.NewRequest(http.MethodOptions, target, nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package policy implements management (CRUD, persistence, declarative loading and the REST API)
+// of attribute-based access control (ABAC) policies. The ABAC authorization engine reads these
+// policies on demand through PolicyServiceInterface.
+package policy
+
+// PolicyEffect is the decision a policy yields when its target and conditions match.
+type PolicyEffect string
+
+const (
+	// PolicyEffectPermit grants access when the policy matches.
+	PolicyEffectPermit PolicyEffect = "PERMIT"
+	// PolicyEffectDeny refuses access when the policy matches.
+	PolicyEffectDeny PolicyEffect = "DENY"
+)
+
+// IsValid reports whether the effect is one of the supported policy effects.
+func (e PolicyEffect) IsValid() bool {
+	return e == PolicyEffectPermit || e == PolicyEffectDeny
+}
+
+// ConditionOperator is the comparison a condition applies to the attribute it references.
+type ConditionOperator string
+
+const (
+	// OperatorEquals matches when the attribute equals the first value.
+	OperatorEquals ConditionOperator = "equals"
+	// OperatorNotEquals matches when the attribute does not equal the first value.
+	OperatorNotEquals ConditionOperator = "notEquals"
+	// OperatorIn matches when the attribute, or any element of a list attribute, is one of the values.
+	OperatorIn ConditionOperator = "in"
+	// OperatorNotIn matches when neither the attribute nor any element of a list attribute is one of the values.
+	OperatorNotIn ConditionOperator = "notIn"
+	// OperatorExists matches when the attribute is present.
+	OperatorExists ConditionOperator = "exists"
+	// OperatorNotExists matches when the attribute is absent.
+	OperatorNotExists ConditionOperator = "notExists"
+	// OperatorGreaterThan matches when the numeric attribute is greater than the first value.
+	OperatorGreaterThan ConditionOperator = "greaterThan"
+	// OperatorLessThan matches when the numeric attribute is less than the first value.
+	OperatorLessThan ConditionOperator = "lessThan"
+	// OperatorIPInRange matches when the IP address attribute falls within one of the CIDR values.
+	OperatorIPInRange ConditionOperator = "ipInRange"
+	// OperatorTimeBetween matches when the time of day falls within the window given as
+	// [start, end] or [start, end, timezone]. Start and end use the HH:MM format; the window
+	// may wrap past midnight. The attribute is an RFC 3339 timestamp and defaults to the current time.
+	OperatorTimeBetween ConditionOperator = "timeBetween"
+	// OperatorInOU matches when the organization unit attribute is one of the values or a descendant of one.
+	OperatorInOU ConditionOperator = "inOU"
+)
+
+// IsValid reports whether the operator is one of the supported condition operators.
+func (o ConditionOperator) IsValid() bool {
+	switch o {
+	case OperatorEquals, OperatorNotEquals, OperatorIn, OperatorNotIn, OperatorExists, OperatorNotExists,
+		OperatorGreaterThan, OperatorLessThan, OperatorIPInRange, OperatorTimeBetween, OperatorInOU:
+		return true
+	default:
+		return false
+	}
+}
+
+// PolicyTarget narrows the requests a policy applies to. Empty fields match any request.
+type PolicyTarget struct {
+	ResourceServerID string   `json:"resourceServerId,omitempty" yaml:"resourceServerId,omitempty"`
+	Permissions      []string `json:"permissions,omitempty" yaml:"permissions,omitempty"`
+	SubjectTypes     []string `json:"subjectTypes,omitempty" yaml:"subjectTypes,omitempty"`
+}
+
+// PolicyCondition is a single predicate over a request attribute. Attributes are dotted paths
+// rooted at subject, resourceServer, permission or context, for example
+// "subject.properties.department", "subject.attributes.clearance", "subject.ouId" or "context.ip".
+// ValueFrom, when set, compares against another request attribute instead of the literal values.
+type PolicyCondition struct {
+	Attribute string            `json:"attribute,omitempty" yaml:"attribute,omitempty"`
+	Operator  ConditionOperator `json:"operator" yaml:"operator"`
+	Values    []string          `json:"values,omitempty" yaml:"values,omitempty"`
+	ValueFrom string            `json:"valueFrom,omitempty" yaml:"valueFrom,omitempty"`
+}
+
+// PolicyDTO is the managed representation of an ABAC policy. A policy applies to requests matching
+// its target and yields its effect when all of its conditions hold.
+type PolicyDTO struct {
+	ID          string            `json:"id" yaml:"id"`
+	Handle      string            `json:"handle" yaml:"handle"`
+	Name        string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Effect      PolicyEffect      `json:"effect" yaml:"effect"`
+	Target      PolicyTarget      `json:"target" yaml:"target,omitempty"`
+	Conditions  []PolicyCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+}
+
+// policyRequest is the API request body for create/update.
+type policyRequest struct {
+	Handle      string            `json:"handle"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Effect      PolicyEffect      `json:"effect"`
+	Target      PolicyTarget      `json:"target"`
+	Conditions  []PolicyCondition `json:"conditions"`
+}
+
+// PolicyList is the minimal projection returned by the list endpoint.
+type PolicyList struct {
+	ID               string       `json:"id"`
+	Handle           string       `json:"handle"`
+	Name             string       `json:"name,omitempty"`
+	Effect           PolicyEffect `json:"effect"`
+	ResourceServerID string       `json:"resourceServerId,omitempty"`
+}
+
+// toSummary projects a full DTO to a list summary.
+func toSummary(dto PolicyDTO) PolicyList {
+	return PolicyList{
+		ID:               dto.ID,
+		Handle:           dto.Handle,
+		Name:             dto.Name,
+		Effect:           dto.Effect,
+		ResourceServerID: dto.Target.ResourceServerID,
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// TimeOfDayLayout is the layout of the start and end values of a timeBetween condition.
+const TimeOfDayLayout = "15:04"
+
+// PolicyServiceInterface manages authorization policies, which the ABAC engine reads on demand.
+type PolicyServiceInterface interface {
+	CreatePolicy(ctx context.Context, dto *PolicyDTO) (*PolicyDTO, *tidcommon.ServiceError)
+	GetPolicy(ctx context.Context, id string) (*PolicyDTO, *tidcommon.ServiceError)
+	ListPolicies(ctx context.Context) ([]PolicyDTO, *tidcommon.ServiceError)
+	// GetApplicablePolicies returns the policies targeting the resource server together with the
+	// policies that target every resource server.
+	GetApplicablePolicies(ctx context.Context, resourceServerID string) ([]PolicyDTO, *tidcommon.ServiceError)
+	UpdatePolicy(ctx context.Context, id string, dto *PolicyDTO) (*PolicyDTO, *tidcommon.ServiceError)
+	DeletePolicy(ctx context.Context, id string) *tidcommon.ServiceError
+	IsPolicyDeclarative(ctx context.Context, id string) (bool, *tidcommon.ServiceError)
+}
+
+type policyService struct {
+	store  policyStoreInterface
+	logger *log.Logger
+	uuid   func() (string, error)
+}
+
+// newPolicyService builds a policy service over the given store.
+func newPolicyService(store policyStoreInterface) PolicyServiceInterface {
+	return &policyService{
+		store:  store,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizationPolicyService")),
+		uuid:   utils.GenerateUUIDv7,
+	}
+}
+
+// CreatePolicy validates, assigns an ID, and persists a new policy.
+func (s *policyService) CreatePolicy(ctx context.Context, dto *PolicyDTO) (*PolicyDTO, *tidcommon.ServiceError) {
+	if svcErr := validatePolicy(dto); svcErr != nil {
+		return nil, svcErr
+	}
+
+	existing, err := s.store.GetPolicyByHandle(ctx, dto.Handle)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		s.logger.Error(ctx, "Failed to check existing policy", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if existing != nil {
+		return nil, &ErrorPolicyAlreadyExists
+	}
+
+	if dto.ID == "" {
+		id, genErr := s.uuid()
+		if genErr != nil {
+			s.logger.Error(ctx, "Failed to generate policy ID", log.Error(genErr))
+			return nil, &tidcommon.InternalServerError
+		}
+		dto.ID = id
+	}
+
+	if err := s.store.CreatePolicy(ctx, *dto); err != nil {
+		s.logger.Error(ctx, "Failed to create policy", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return dto, nil
+}
+
+// GetPolicy returns the policy with the given ID.
+func (s *policyService) GetPolicy(ctx context.Context, id string) (*PolicyDTO, *tidcommon.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorInvalidRequest
+	}
+	dto, err := s.store.GetPolicyByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, &ErrorPolicyNotFound
+		}
+		s.logger.Error(ctx, "Failed to get policy", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return dto, nil
+}
+
+// ListPolicies returns all policies.
+func (s *policyService) ListPolicies(ctx context.Context) ([]PolicyDTO, *tidcommon.ServiceError) {
+	policies, err := s.store.ListPolicies(ctx)
+	if err != nil {
+		if errors.Is(err, ErrResultLimitExceededInCompositeMode) {
+			return nil, &ErrorResultLimitExceeded
+		}
+		s.logger.Error(ctx, "Failed to list policies", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return policies, nil
+}
+
+// GetApplicablePolicies returns the policies that may apply to requests on the resource server.
+func (s *policyService) GetApplicablePolicies(ctx context.Context, resourceServerID string) (
+	[]PolicyDTO, *tidcommon.ServiceError) {
+	policies, err := s.store.ListPoliciesByResourceServer(ctx, resourceServerID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list applicable policies",
+			log.String("resourceServerID", resourceServerID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return policies, nil
+}
+
+// UpdatePolicy validates and persists changes to the policy with the given ID.
+func (s *policyService) UpdatePolicy(ctx context.Context, id string, dto *PolicyDTO) (
+	*PolicyDTO, *tidcommon.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorInvalidRequest
+	}
+	if svcErr := validatePolicy(dto); svcErr != nil {
+		return nil, svcErr
+	}
+
+	existing, err := s.store.GetPolicyByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, &ErrorPolicyNotFound
+		}
+		s.logger.Error(ctx, "Failed to load policy", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	if existing.Handle != dto.Handle {
+		clash, err := s.store.GetPolicyByHandle(ctx, dto.Handle)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			s.logger.Error(ctx, "Failed to check handle uniqueness", log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		if clash != nil {
+			return nil, &ErrorPolicyAlreadyExists
+		}
+	}
+
+	dto.ID = id
+	if err := s.store.UpdatePolicy(ctx, *dto); err != nil {
+		if errors.Is(err, ErrPolicyIsImmutable) {
+			return nil, &ErrorPolicyImmutable
+		}
+		s.logger.Error(ctx, "Failed to update policy", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return dto, nil
+}
+
+// DeletePolicy deletes the policy with the given ID.
+func (s *policyService) DeletePolicy(ctx context.Context, id string) *tidcommon.ServiceError {
+	if strings.TrimSpace(id) == "" {
+		return &ErrorInvalidRequest
+	}
+	if _, err := s.store.GetPolicyByID(ctx, id); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil // idempotent
+		}
+		s.logger.Error(ctx, "Failed to load policy", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if err := s.store.DeletePolicy(ctx, id); err != nil {
+		if errors.Is(err, ErrPolicyIsImmutable) {
+			return &ErrorPolicyImmutable
+		}
+		s.logger.Error(ctx, "Failed to delete policy", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
+// IsPolicyDeclarative reports whether the policy with the given ID is file-based.
+func (s *policyService) IsPolicyDeclarative(ctx context.Context, id string) (bool, *tidcommon.ServiceError) {
+	isDeclarative, err := s.store.IsPolicyDeclarative(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to check if policy is declarative", log.Error(err))
+		return false, &tidcommon.InternalServerError
+	}
+	return isDeclarative, nil
+}
+
+// validatePolicy enforces the required fields of a policy and the shape of its conditions.
+func validatePolicy(dto *PolicyDTO) *tidcommon.ServiceError {
+	if dto == nil || strings.TrimSpace(dto.Handle) == "" {
+		return &ErrorInvalidRequest
+	}
+	if !dto.Effect.IsValid() {
+		return &ErrorInvalidEffect
+	}
+	for _, condition := range dto.Conditions {
+		if !isValidCondition(condition) {
+			return &ErrorInvalidCondition
+		}
+	}
+	return nil
+}
+
+// isValidCondition reports whether the condition references supported attributes and carries
+// the values its operator expects.
+func isValidCondition(c PolicyCondition) bool {
+	if !c.Operator.IsValid() {
+		return false
+	}
+	if c.Operator != OperatorTimeBetween && !IsValidAttribute(c.Attribute) {
+		return false
+	}
+	if c.Operator == OperatorTimeBetween && c.Attribute != "" && !IsValidAttribute(c.Attribute) {
+		return false
+	}
+	if c.ValueFrom != "" {
+		if !IsValidAttribute(c.ValueFrom) || len(c.Values) > 0 {
+			return false
+		}
+		switch c.Operator {
+		case OperatorExists, OperatorNotExists, OperatorIPInRange, OperatorTimeBetween:
+			return false
+		default:
+			return true
+		}
+	}
+
+	switch c.Operator {
+	case OperatorExists, OperatorNotExists:
+		return len(c.Values) == 0
+	case OperatorEquals, OperatorNotEquals:
+		return len(c.Values) == 1
+	case OperatorGreaterThan, OperatorLessThan:
+		if len(c.Values) != 1 {
+			return false
+		}
+		_, err := strconv.ParseFloat(c.Values[0], 64)
+		return err == nil
+	case OperatorIPInRange:
+		for _, cidr := range c.Values {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return false
+			}
+		}
+		return len(c.Values) > 0
+	case OperatorTimeBetween:
+		return isValidTimeWindow(c.Values)
+	default:
+		return len(c.Values) > 0
+	}
+}
+
+// isValidTimeWindow reports whether the values form a [start, end] or [start, end, timezone] window.
+func isValidTimeWindow(values []string) bool {
+	if len(values) != 2 && len(values) != 3 {
+		return false
+	}
+	for _, v := range values[:2] {
+		if _, err := time.Parse(TimeOfDayLayout, v); err != nil {
+			return false
+		}
+	}
+	if len(values) == 3 {
+		if _, err := time.LoadLocation(values[2]); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidAttribute reports whether the dotted path references a request attribute a policy
+// condition can evaluate. Supported paths are subject.{id,type,groupIds,ouId}, subject.properties.*,
+// subject.attributes.*, resourceServer.id, resourceServer.properties.*, permission.name,
+// permission.properties.* and context.*.
+func IsValidAttribute(path string) bool {
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return false
+		}
+	}
+	if len(segments) < 2 {
+		return false
+	}
+
+	field := segments[1]
+	nested := len(segments) > 2
+	switch segments[0] {
+	case "subject":
+		switch field {
+		case "id", "type", "groupIds", "ouId":
+			return !nested
+		case "properties", "attributes":
+			return nested
+		}
+	case "resourceServer":
+		return (field == "id" && !nested) || (field == "properties" && nested)
+	case "permission":
+		return (field == "name" && !nested) || (field == "properties" && nested)
+	case "context":
+		return true
+	}
+	return false
+}
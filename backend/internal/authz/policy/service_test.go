@@ -0,0 +1,219 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+type PolicyServiceTestSuite struct {
+	suite.Suite
+	store   *policyStoreInterfaceMock
+	service *policyService
+	ctx     context.Context
+}
+
+func TestPolicyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyServiceTestSuite))
+}
+
+func (s *PolicyServiceTestSuite) SetupTest() {
+	s.store = newPolicyStoreInterfaceMock(s.T())
+	s.service = &policyService{
+		store:  s.store,
+		logger: log.GetLogger(),
+		uuid:   func() (string, error) { return "generated-id", nil },
+	}
+	s.ctx = context.Background()
+}
+
+func (s *PolicyServiceTestSuite) TestCreatePolicy() {
+	dto := testPolicy()
+	dto.ID = ""
+	s.store.EXPECT().GetPolicyByHandle(s.ctx, "business-hours").Return(nil, ErrNotFound)
+	s.store.EXPECT().CreatePolicy(s.ctx, mock.MatchedBy(func(p PolicyDTO) bool {
+		return p.ID == "generated-id"
+	})).Return(nil)
+
+	created, svcErr := s.service.CreatePolicy(s.ctx, &dto)
+
+	s.Require().Nil(svcErr)
+	s.Equal("generated-id", created.ID)
+}
+
+func (s *PolicyServiceTestSuite) TestCreatePolicyDuplicateHandle() {
+	dto := testPolicy()
+	s.store.EXPECT().GetPolicyByHandle(s.ctx, "business-hours").Return(&PolicyDTO{ID: "other"}, nil)
+
+	_, svcErr := s.service.CreatePolicy(s.ctx, &dto)
+
+	s.Equal(&ErrorPolicyAlreadyExists, svcErr)
+}
+
+func (s *PolicyServiceTestSuite) TestCreatePolicyStoreError() {
+	dto := testPolicy()
+	s.store.EXPECT().GetPolicyByHandle(s.ctx, "business-hours").Return(nil, ErrNotFound)
+	s.store.EXPECT().CreatePolicy(s.ctx, mock.Anything).Return(errors.New("db error"))
+
+	_, svcErr := s.service.CreatePolicy(s.ctx, &dto)
+
+	s.Equal(&tidcommon.InternalServerError, svcErr)
+}
+
+func (s *PolicyServiceTestSuite) TestCreatePolicyValidation() {
+	cases := []struct {
+		name   string
+		mutate func(*PolicyDTO)
+		want   *tidcommon.ServiceError
+	}{
+		{"missing handle", func(p *PolicyDTO) { p.Handle = " " }, &ErrorInvalidRequest},
+		{"invalid effect", func(p *PolicyDTO) { p.Effect = "ALLOW" }, &ErrorInvalidEffect},
+		{"unknown operator", func(p *PolicyDTO) {
+			p.Conditions = []PolicyCondition{{Attribute: "subject.id", Operator: "matches", Values: []string{"x"}}}
+		}, &ErrorInvalidCondition},
+		{"unsupported attribute", func(p *PolicyDTO) {
+			p.Conditions = []PolicyCondition{{Attribute: "subject.name", Operator: OperatorEquals, Values: []string{"x"}}}
+		}, &ErrorInvalidCondition},
+		{"equals needs one value", func(p *PolicyDTO) {
+			p.Conditions = []PolicyCondition{{Attribute: "subject.type", Operator: OperatorEquals}}
+		}, &ErrorInvalidCondition},
+		{"non-numeric comparison", func(p *PolicyDTO) {
+			p.Conditions = []PolicyCondition{{
+				Attribute: "subject.attributes.level", Operator: OperatorGreaterThan, Values: []string{"high"},
+			}}
+		}, &ErrorInvalidCondition},
+		{"invalid CIDR", func(p *PolicyDTO) {
+			p.Conditions = []PolicyCondition{{Attribute: "context.ip", Operator: OperatorIPInRange, Values: []string{"10.0.0"}}}
+		}, &ErrorInvalidCondition},
+		{"invalid time window", func(p *PolicyDTO) {
+			p.Conditions = []PolicyCondition{{Operator: OperatorTimeBetween, Values: []string{"9am", "17:00"}}}
+		}, &ErrorInvalidCondition},
+		{"invalid timezone", func(p *PolicyDTO) {
+			p.Conditions = []PolicyCondition{{Operator: OperatorTimeBetween, Values: []string{"09:00", "17:00", "Mars/Base"}}}
+		}, &ErrorInvalidCondition},
+		{"valueFrom with values", func(p *PolicyDTO) {
+			p.Conditions = []PolicyCondition{{
+				Attribute: "subject.ouId", Operator: OperatorEquals, Values: []string{"x"},
+				ValueFrom: "resourceServer.properties.ouId",
+			}}
+		}, &ErrorInvalidCondition},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			dto := testPolicy()
+			tc.mutate(&dto)
+			_, svcErr := s.service.CreatePolicy(s.ctx, &dto)
+			s.Equal(tc.want, svcErr)
+		})
+	}
+}
+
+func (s *PolicyServiceTestSuite) TestGetPolicyNotFound() {
+	s.store.EXPECT().GetPolicyByID(s.ctx, "missing").Return(nil, ErrNotFound)
+
+	_, svcErr := s.service.GetPolicy(s.ctx, "missing")
+
+	s.Equal(&ErrorPolicyNotFound, svcErr)
+}
+
+func (s *PolicyServiceTestSuite) TestListPoliciesLimitExceeded() {
+	s.store.EXPECT().ListPolicies(s.ctx).Return(nil, ErrResultLimitExceededInCompositeMode)
+
+	_, svcErr := s.service.ListPolicies(s.ctx)
+
+	s.Equal(&ErrorResultLimitExceeded, svcErr)
+}
+
+func (s *PolicyServiceTestSuite) TestGetApplicablePolicies() {
+	s.store.EXPECT().ListPoliciesByResourceServer(s.ctx, "rs-1").Return([]PolicyDTO{testPolicy()}, nil)
+
+	policies, svcErr := s.service.GetApplicablePolicies(s.ctx, "rs-1")
+
+	s.Nil(svcErr)
+	s.Len(policies, 1)
+}
+
+func (s *PolicyServiceTestSuite) TestUpdatePolicyHandleClash() {
+	dto := testPolicy()
+	dto.Handle = "taken"
+	s.store.EXPECT().GetPolicyByID(s.ctx, "pol-1").Return(&PolicyDTO{ID: "pol-1", Handle: "business-hours"}, nil)
+	s.store.EXPECT().GetPolicyByHandle(s.ctx, "taken").Return(&PolicyDTO{ID: "pol-2"}, nil)
+
+	_, svcErr := s.service.UpdatePolicy(s.ctx, "pol-1", &dto)
+
+	s.Equal(&ErrorPolicyAlreadyExists, svcErr)
+}
+
+func (s *PolicyServiceTestSuite) TestUpdatePolicyImmutable() {
+	dto := testPolicy()
+	s.store.EXPECT().GetPolicyByID(s.ctx, "pol-1").Return(&PolicyDTO{ID: "pol-1", Handle: "business-hours"}, nil)
+	s.store.EXPECT().UpdatePolicy(s.ctx, mock.Anything).Return(ErrPolicyIsImmutable)
+
+	_, svcErr := s.service.UpdatePolicy(s.ctx, "pol-1", &dto)
+
+	s.Equal(&ErrorPolicyImmutable, svcErr)
+}
+
+func (s *PolicyServiceTestSuite) TestUpdatePolicySetsID() {
+	dto := testPolicy()
+	dto.ID = "ignored"
+	s.store.EXPECT().GetPolicyByID(s.ctx, "pol-1").Return(&PolicyDTO{ID: "pol-1", Handle: "business-hours"}, nil)
+	s.store.EXPECT().UpdatePolicy(s.ctx, mock.MatchedBy(func(p PolicyDTO) bool { return p.ID == "pol-1" })).
+		Return(nil)
+
+	updated, svcErr := s.service.UpdatePolicy(s.ctx, "pol-1", &dto)
+
+	s.Require().Nil(svcErr)
+	s.Equal("pol-1", updated.ID)
+}
+
+func (s *PolicyServiceTestSuite) TestDeletePolicyIsIdempotent() {
+	s.store.EXPECT().GetPolicyByID(s.ctx, "missing").Return(nil, ErrNotFound)
+
+	s.Nil(s.service.DeletePolicy(s.ctx, "missing"))
+}
+
+func (s *PolicyServiceTestSuite) TestDeletePolicyImmutable() {
+	s.store.EXPECT().GetPolicyByID(s.ctx, "pol-1").Return(&PolicyDTO{ID: "pol-1"}, nil)
+	s.store.EXPECT().DeletePolicy(s.ctx, "pol-1").Return(ErrPolicyIsImmutable)
+
+	s.Equal(&ErrorPolicyImmutable, s.service.DeletePolicy(s.ctx, "pol-1"))
+}
+
+func TestIsValidAttribute(t *testing.T) {
+	cases := map[string]bool{
+		"subject.id":                     true,
+		"subject.groupIds":               true,
+		"subject.ouId":                   true,
+		"subject.properties.department":  true,
+		"subject.attributes.clearance":   true,
+		"resourceServer.id":              true,
+		"resourceServer.properties.tier": true,
+		"permission.name":                true,
+		"permission.properties.risk":     true,
+		"context.ip":                     true,
+		"context.device.trusted":         true,
+		"subject":                        false,
+		"subject.id.extra":               false,
+		"subject.properties":             false,
+		"subject.name":                   false,
+		"resourceServer.name":            false,
+		"permission..risk":               false,
+		"environment.time":               false,
+	}
+	for path, want := range cases {
+		if got := IsValidAttribute(path); got != want {
+			t.Errorf("IsValidAttribute(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
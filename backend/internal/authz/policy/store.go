@@ -0,0 +1,221 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// ErrNotFound is the store-level not-found sentinel.
+var ErrNotFound = errors.New("authorization policy not found")
+
+// policyStoreInterface persists managed authorization policies in configdb.
+type policyStoreInterface interface {
+	CreatePolicy(ctx context.Context, dto PolicyDTO) error
+	GetPolicyByID(ctx context.Context, id string) (*PolicyDTO, error)
+	GetPolicyByHandle(ctx context.Context, handle string) (*PolicyDTO, error)
+	ListPolicies(ctx context.Context) ([]PolicyDTO, error)
+	ListPoliciesByResourceServer(ctx context.Context, resourceServerID string) ([]PolicyDTO, error)
+	UpdatePolicy(ctx context.Context, dto PolicyDTO) error
+	DeletePolicy(ctx context.Context, id string) error
+	IsPolicyDeclarative(ctx context.Context, id string) (bool, error)
+}
+
+type policyStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newPolicyStore returns a configdb-backed authorization policy store.
+func newPolicyStore() policyStoreInterface {
+	return &policyStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreatePolicy inserts a new policy into the config database.
+func (s *policyStore) CreatePolicy(ctx context.Context, dto PolicyDTO) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	targetJSON, conditionsJSON, err := marshalPolicyRules(dto)
+	if err != nil {
+		return err
+	}
+	_, err = dbClient.ExecuteContext(ctx, queryCreatePolicy,
+		dto.ID, dto.Handle, dto.Name, dto.Description, string(dto.Effect), dto.Target.ResourceServerID,
+		targetJSON, conditionsJSON, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to create authorization policy: %w", err)
+	}
+	return nil
+}
+
+// GetPolicyByID returns the policy matching the given ID.
+func (s *policyStore) GetPolicyByID(ctx context.Context, id string) (*PolicyDTO, error) {
+	return s.getOne(ctx, queryGetPolicyByID, id)
+}
+
+// GetPolicyByHandle returns the policy matching the given handle.
+func (s *policyStore) GetPolicyByHandle(ctx context.Context, handle string) (*PolicyDTO, error) {
+	return s.getOne(ctx, queryGetPolicyByHandle, handle)
+}
+
+// getOne runs the given single-row query with the identifier and returns the resulting policy, or ErrNotFound.
+func (s *policyStore) getOne(ctx context.Context, query dbmodel.DBQuery, identifier string) (*PolicyDTO, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, query, identifier, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query authorization policy: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+	return buildPolicyDTOFromRow(results[0])
+}
+
+// ListPolicies returns all policies stored in the config database.
+func (s *policyStore) ListPolicies(ctx context.Context) ([]PolicyDTO, error) {
+	return s.list(ctx, queryListPolicies, s.deploymentID)
+}
+
+// ListPoliciesByResourceServer returns the policies targeting the given resource server together with
+// the policies that target every resource server.
+func (s *policyStore) ListPoliciesByResourceServer(ctx context.Context, resourceServerID string) (
+	[]PolicyDTO, error) {
+	return s.list(ctx, queryListPoliciesByResourceServer, resourceServerID, s.deploymentID)
+}
+
+// list runs the given multi-row query and returns the resulting policies.
+func (s *policyStore) list(ctx context.Context, query dbmodel.DBQuery, args ...interface{}) ([]PolicyDTO, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authorization policies: %w", err)
+	}
+	policies := make([]PolicyDTO, 0, len(results))
+	for _, row := range results {
+		dto, err := buildPolicyDTOFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, *dto)
+	}
+	return policies, nil
+}
+
+// UpdatePolicy persists changes to an existing policy in the config database.
+func (s *policyStore) UpdatePolicy(ctx context.Context, dto PolicyDTO) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	targetJSON, conditionsJSON, err := marshalPolicyRules(dto)
+	if err != nil {
+		return err
+	}
+	_, err = dbClient.ExecuteContext(ctx, queryUpdatePolicy,
+		dto.ID, dto.Handle, dto.Name, dto.Description, string(dto.Effect), dto.Target.ResourceServerID,
+		targetJSON, conditionsJSON, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update authorization policy: %w", err)
+	}
+	return nil
+}
+
+// DeletePolicy removes the policy with the given ID from the config database.
+func (s *policyStore) DeletePolicy(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryDeletePolicy, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete authorization policy: %w", err)
+	}
+	return nil
+}
+
+// IsPolicyDeclarative reports whether the policy is file-based.
+// The database store only holds mutable resources, so this always returns false.
+func (s *policyStore) IsPolicyDeclarative(_ context.Context, _ string) (bool, error) {
+	return false, nil
+}
+
+// marshalPolicyRules serializes the target and conditions to their JSON columns.
+func marshalPolicyRules(dto PolicyDTO) (string, string, error) {
+	targetBytes, err := json.Marshal(dto.Target)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal policy target: %w", err)
+	}
+	conditions := dto.Conditions
+	if conditions == nil {
+		conditions = []PolicyCondition{}
+	}
+	conditionsBytes, err := json.Marshal(conditions)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal policy conditions: %w", err)
+	}
+	return string(targetBytes), string(conditionsBytes), nil
+}
+
+// buildPolicyDTOFromRow reconstructs a DTO from a result row.
+func buildPolicyDTOFromRow(row map[string]interface{}) (*PolicyDTO, error) {
+	dto := &PolicyDTO{
+		ID:          columnString(row["id"]),
+		Handle:      columnString(row["handle"]),
+		Name:        columnString(row["name"]),
+		Description: columnString(row["description"]),
+		Effect:      PolicyEffect(columnString(row["effect"])),
+	}
+	if targetBytes := columnBytes(row["target"]); len(targetBytes) > 0 {
+		if err := json.Unmarshal(targetBytes, &dto.Target); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal policy target: %w", err)
+		}
+	}
+	if conditionsBytes := columnBytes(row["conditions"]); len(conditionsBytes) > 0 {
+		if err := json.Unmarshal(conditionsBytes, &dto.Conditions); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal policy conditions: %w", err)
+		}
+	}
+	return dto, nil
+}
+
+// columnString coerces a result-row value to a string, tolerating string/[]byte.
+func columnString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	default:
+		return ""
+	}
+}
+
+// columnBytes coerces a result-row value to bytes, tolerating []byte/string.
+func columnBytes(v interface{}) []byte {
+	switch t := v.(type) {
+	case []byte:
+		return t
+	case string:
+		return []byte(t)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// DBQuery definitions for the authorization policy config store.
+var (
+	queryCreatePolicy = dbmodel.DBQuery{
+		ID: "AZPQ-POL_MGT-01",
+		Query: `INSERT INTO "AUTHZ_POLICY" ` +
+			`(ID, HANDLE, NAME, DESCRIPTION, EFFECT, RESOURCE_SERVER_ID, TARGET, CONDITIONS, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+	}
+	queryGetPolicyByID = dbmodel.DBQuery{
+		ID: "AZPQ-POL_MGT-02",
+		Query: `SELECT ID, HANDLE, NAME, DESCRIPTION, EFFECT, TARGET, CONDITIONS ` +
+			`FROM "AUTHZ_POLICY" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	queryGetPolicyByHandle = dbmodel.DBQuery{
+		ID: "AZPQ-POL_MGT-03",
+		Query: `SELECT ID, HANDLE, NAME, DESCRIPTION, EFFECT, TARGET, CONDITIONS ` +
+			`FROM "AUTHZ_POLICY" WHERE HANDLE = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	queryListPolicies = dbmodel.DBQuery{
+		ID: "AZPQ-POL_MGT-04",
+		Query: `SELECT ID, HANDLE, NAME, DESCRIPTION, EFFECT, TARGET, CONDITIONS ` +
+			`FROM "AUTHZ_POLICY" WHERE DEPLOYMENT_ID = $1`,
+	}
+	queryUpdatePolicy = dbmodel.DBQuery{
+		ID: "AZPQ-POL_MGT-05",
+		Query: `UPDATE "AUTHZ_POLICY" SET HANDLE = $2, NAME = $3, DESCRIPTION = $4, EFFECT = $5, ` +
+			`RESOURCE_SERVER_ID = $6, TARGET = $7, CONDITIONS = $8, UPDATED_AT = CURRENT_TIMESTAMP ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $9`,
+	}
+	queryDeletePolicy = dbmodel.DBQuery{
+		ID:    "AZPQ-POL_MGT-06",
+		Query: `DELETE FROM "AUTHZ_POLICY" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	queryListPoliciesByResourceServer = dbmodel.DBQuery{
+		ID: "AZPQ-POL_MGT-07",
+		Query: `SELECT ID, HANDLE, NAME, DESCRIPTION, EFFECT, TARGET, CONDITIONS ` +
+			`FROM "AUTHZ_POLICY" WHERE (RESOURCE_SERVER_ID = $1 OR RESOURCE_SERVER_ID = '') ` +
+			`AND DEPLOYMENT_ID = $2`,
+	}
+)
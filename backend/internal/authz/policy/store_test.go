@@ -0,0 +1,150 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type PolicyStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *policyStore
+}
+
+func TestPolicyStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyStoreTestSuite))
+}
+
+func (suite *PolicyStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &policyStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func testPolicy() PolicyDTO {
+	return PolicyDTO{
+		ID:     "pol-1",
+		Handle: "business-hours",
+		Name:   "Business hours",
+		Effect: PolicyEffectDeny,
+		Target: PolicyTarget{ResourceServerID: "rs-1", Permissions: []string{"invoice:approve"}},
+		Conditions: []PolicyCondition{
+			{Operator: OperatorTimeBetween, Values: []string{"18:00", "08:00"}},
+		},
+	}
+}
+
+func policyRow(dto PolicyDTO) map[string]interface{} {
+	target, conditions, _ := marshalPolicyRules(dto)
+	return map[string]interface{}{
+		"id":          dto.ID,
+		"handle":      dto.Handle,
+		"name":        dto.Name,
+		"description": dto.Description,
+		"effect":      string(dto.Effect),
+		"target":      []byte(target),
+		"conditions":  conditions,
+	}
+}
+
+func (suite *PolicyStoreTestSuite) TestCreatePolicy() {
+	dto := testPolicy()
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreatePolicy,
+		"pol-1", "business-hours", "Business hours", "", "DENY", "rs-1",
+		mock.Anything, mock.Anything, testDeploymentID,
+	).Return(int64(1), nil)
+
+	suite.NoError(suite.store.CreatePolicy(context.Background(), dto))
+}
+
+func (suite *PolicyStoreTestSuite) TestCreatePolicyDBClientError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("db error"))
+
+	suite.Error(suite.store.CreatePolicy(context.Background(), testPolicy()))
+}
+
+func (suite *PolicyStoreTestSuite) TestGetPolicyByIDRoundTrip() {
+	dto := testPolicy()
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetPolicyByID, "pol-1", testDeploymentID).
+		Return([]map[string]interface{}{policyRow(dto)}, nil)
+
+	got, err := suite.store.GetPolicyByID(context.Background(), "pol-1")
+
+	suite.Require().NoError(err)
+	suite.Equal(dto, *got)
+}
+
+func (suite *PolicyStoreTestSuite) TestGetPolicyByHandleNotFound() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetPolicyByHandle, "missing", testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	_, err := suite.store.GetPolicyByHandle(context.Background(), "missing")
+
+	suite.ErrorIs(err, ErrNotFound)
+}
+
+func (suite *PolicyStoreTestSuite) TestListPoliciesByResourceServer() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListPoliciesByResourceServer,
+		"rs-1", testDeploymentID).
+		Return([]map[string]interface{}{policyRow(testPolicy())}, nil)
+
+	policies, err := suite.store.ListPoliciesByResourceServer(context.Background(), "rs-1")
+
+	suite.Require().NoError(err)
+	suite.Len(policies, 1)
+	suite.Equal("business-hours", policies[0].Handle)
+}
+
+func (suite *PolicyStoreTestSuite) TestListPoliciesCorruptedRow() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListPolicies, testDeploymentID).
+		Return([]map[string]interface{}{{"id": "pol-1", "conditions": "{not-json"}}, nil)
+
+	_, err := suite.store.ListPolicies(context.Background())
+
+	suite.Error(err)
+}
+
+func (suite *PolicyStoreTestSuite) TestUpdatePolicy() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdatePolicy,
+		"pol-1", "business-hours", "Business hours", "", "DENY", "rs-1",
+		mock.Anything, mock.Anything, testDeploymentID,
+	).Return(int64(1), nil)
+
+	suite.NoError(suite.store.UpdatePolicy(context.Background(), testPolicy()))
+}
+
+func (suite *PolicyStoreTestSuite) TestDeletePolicyExecuteError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeletePolicy, "pol-1", testDeploymentID).
+		Return(int64(0), errors.New("delete failed"))
+
+	suite.Error(suite.store.DeletePolicy(context.Background(), "pol-1"))
+}
+
+func (suite *PolicyStoreTestSuite) TestIsPolicyDeclarative() {
+	isDeclarative, err := suite.store.IsPolicyDeclarative(context.Background(), "pol-1")
+
+	suite.NoError(err)
+	suite.False(isDeclarative)
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	"net/http"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client-facing API errors for the relationship management endpoints.
+var (
+	// ErrorInvalidRequest indicates a malformed request.
+	ErrorInvalidRequest = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZR-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.invalid_request",
+			DefaultValue: "Invalid request",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.invalid_request_description",
+			DefaultValue: "The relationship request is missing required fields or is malformed",
+		},
+	}
+
+	// ErrorTypeNotFound indicates the object type is not defined in the authorization model.
+	ErrorTypeNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZR-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.type_not_found",
+			DefaultValue: "Type not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.type_not_found_description",
+			DefaultValue: "The object type is not defined in the authorization model",
+		},
+	}
+
+	// ErrorInvalidTypeDefinition indicates a type definition with invalid relations or rewrites.
+	ErrorInvalidTypeDefinition = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZR-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.invalid_type_definition",
+			DefaultValue: "Invalid type definition",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.rebacservice.invalid_type_definition_description",
+			DefaultValue: "Relations must have unique names, each rewrite must set exactly one of " +
+				"computedUserset or tupleToUserset, and rewrites must reference relations of the type",
+		},
+	}
+
+	// ErrorUnknownRelation indicates the relation is not defined on the object type.
+	ErrorUnknownRelation = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZR-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.unknown_relation",
+			DefaultValue: "Unknown relation",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.unknown_relation_description",
+			DefaultValue: "The relation is not defined on the object type",
+		},
+	}
+
+	// ErrorInvalidTuple indicates a tuple the authorization model does not allow.
+	ErrorInvalidTuple = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZR-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.invalid_tuple",
+			DefaultValue: "Invalid relation tuple",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.invalid_tuple_description",
+			DefaultValue: "The relation does not accept the subject type directly",
+		},
+	}
+
+	// ErrorResolutionTooDeep indicates the relation graph exceeded the maximum resolution depth.
+	ErrorResolutionTooDeep = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AZR-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.resolution_too_deep",
+			DefaultValue: "Resolution depth exceeded",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.rebacservice.resolution_too_deep_description",
+			DefaultValue: "Resolving the relation exceeded the maximum depth of nested usersets",
+		},
+	}
+)
+
+// rebacClientErrorStatus maps a client error code to its HTTP status.
+func rebacClientErrorStatus(code string) int {
+	if code == ErrorTypeNotFound.Code {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,380 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// maxResolutionDepth bounds how many usersets a single resolution may traverse.
+const maxResolutionDepth = 32
+
+// errResolutionTooDeep is raised when a resolution exceeds maxResolutionDepth.
+var errResolutionTooDeep = errors.New("relation resolution exceeded the maximum depth")
+
+// relationKey identifies a relation on an object during one resolution.
+type relationKey struct {
+	object   ObjectReference
+	relation string
+}
+
+// checker resolves relations for one request. Type definitions, resolved relations and group
+// members are cached for the lifetime of the request so that listings reuse sub-results.
+type checker struct {
+	ctx     context.Context
+	service *rebacService
+	subject SubjectReference
+	// groupIDs holds the subject's groups when supplied by the caller; nil means resolve membership
+	// through the group service.
+	groupIDs map[string]struct{}
+	types    map[string]*TypeDefinition
+	resolved map[relationKey]bool
+	pending  map[relationKey]struct{}
+	// cyclic is set while the current resolution has relied on a relation still being resolved.
+	cyclic bool
+	// expanded holds the relations already visited by expand.
+	expanded map[relationKey]struct{}
+	members  map[string][]group.Member
+}
+
+// newChecker creates a checker for the subject. An empty subject type defaults to DefaultSubjectType.
+func (s *rebacService) newChecker(ctx context.Context, subject SubjectReference, groupIDs []string) *checker {
+	if subject.Type == "" {
+		subject.Type = DefaultSubjectType
+	}
+	c := &checker{
+		ctx:      ctx,
+		service:  s,
+		subject:  subject,
+		types:    make(map[string]*TypeDefinition),
+		resolved: make(map[relationKey]bool),
+		pending:  make(map[relationKey]struct{}),
+		expanded: make(map[relationKey]struct{}),
+		members:  make(map[string][]group.Member),
+	}
+	if groupIDs != nil {
+		c.groupIDs = make(map[string]struct{}, len(groupIDs))
+		for _, id := range groupIDs {
+			c.groupIDs[id] = struct{}{}
+		}
+	}
+	return c
+}
+
+// requireRelation returns a client error unless the object type defines the relation. The built-in
+// group#member relation is always available.
+func (c *checker) requireRelation(objectType, relation string) *tidcommon.ServiceError {
+	if isGroupMembership(objectType, relation) {
+		return nil
+	}
+	typeDef, err := c.typeDefinition(objectType)
+	if err != nil {
+		c.service.logger.Error(c.ctx, "Failed to get relation type", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if typeDef == nil {
+		return &ErrorTypeNotFound
+	}
+	if typeDef.Relation(relation) == nil {
+		return &ErrorUnknownRelation
+	}
+	return nil
+}
+
+// typeDefinition returns the cached type definition, or nil when the type is not defined.
+func (c *checker) typeDefinition(name string) (*TypeDefinition, error) {
+	if typeDef, ok := c.types[name]; ok {
+		return typeDef, nil
+	}
+	typeDef, err := c.service.store.GetType(c.ctx, name)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+		typeDef = nil
+	}
+	c.types[name] = typeDef
+	return typeDef, nil
+}
+
+// check reports whether the checker's subject has the relation on the object. A relation reached
+// again while it is still being resolved contributes nothing, which breaks cycles in the model.
+// Results that depended on such a cut are not cached, since they may be incomplete.
+func (c *checker) check(object ObjectReference, relation string, depth int) (bool, error) {
+	if depth > maxResolutionDepth {
+		return false, errResolutionTooDeep
+	}
+	key := relationKey{object: object, relation: relation}
+	if allowed, ok := c.resolved[key]; ok {
+		return allowed, nil
+	}
+	if _, ok := c.pending[key]; ok {
+		c.cyclic = true
+		return false, nil
+	}
+
+	outerCyclic := c.cyclic
+	c.cyclic = false
+	c.pending[key] = struct{}{}
+	allowed, err := c.evaluate(object, relation, depth)
+	delete(c.pending, key)
+	if err != nil {
+		return false, err
+	}
+	if allowed || !c.cyclic {
+		c.resolved[key] = allowed
+	}
+	c.cyclic = c.cyclic || outerCyclic
+	return allowed, nil
+}
+
+// evaluate resolves a relation without consulting the cache.
+func (c *checker) evaluate(object ObjectReference, relation string, depth int) (bool, error) {
+	if isGroupMembership(object.Type, relation) {
+		isMember, err := c.isGroupMember(object.ID, depth)
+		if err != nil || isMember {
+			return isMember, err
+		}
+	}
+
+	typeDef, err := c.typeDefinition(object.Type)
+	if err != nil || typeDef == nil {
+		return false, err
+	}
+	relationDef := typeDef.Relation(relation)
+	if relationDef == nil {
+		return false, nil
+	}
+
+	if len(relationDef.DirectlyRelatedTypes) > 0 {
+		tuples, err := c.service.store.GetTuples(c.ctx, object, relation)
+		if err != nil {
+			return false, err
+		}
+		for _, tuple := range tuples {
+			if !acceptsSubject(relationDef, tuple.Subject) {
+				continue
+			}
+			if tuple.Subject == c.subject {
+				return true, nil
+			}
+			if tuple.Subject.Relation == "" {
+				continue
+			}
+			allowed, err := c.check(ObjectReference{Type: tuple.Subject.Type, ID: tuple.Subject.ID},
+				tuple.Subject.Relation, depth+1)
+			if err != nil || allowed {
+				return allowed, err
+			}
+		}
+	}
+
+	for _, rewrite := range relationDef.Union {
+		var allowed bool
+		if rewrite.TupleToUserset != nil {
+			allowed, err = c.checkTupleToUserset(object, typeDef, rewrite.TupleToUserset, depth)
+		} else {
+			allowed, err = c.check(object, rewrite.ComputedUserset, depth+1)
+		}
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	return false, nil
+}
+
+// checkTupleToUserset resolves the computed relation on every object related through the tupleset.
+func (c *checker) checkTupleToUserset(object ObjectReference, typeDef *TypeDefinition,
+	rewrite *TupleToUserset, depth int) (bool, error) {
+	tupleset := typeDef.Relation(rewrite.Tupleset)
+	if tupleset == nil {
+		return false, nil
+	}
+	tuples, err := c.service.store.GetTuples(c.ctx, object, rewrite.Tupleset)
+	if err != nil {
+		return false, err
+	}
+	for _, tuple := range tuples {
+		if tuple.Subject.Relation != "" || !acceptsSubject(tupleset, tuple.Subject) {
+			continue
+		}
+		allowed, err := c.check(ObjectReference{Type: tuple.Subject.Type, ID: tuple.Subject.ID},
+			rewrite.ComputedUserset, depth+1)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	return false, nil
+}
+
+// isGroupMember reports whether the checker's subject belongs to the group, directly or through
+// nested groups.
+func (c *checker) isGroupMember(groupID string, depth int) (bool, error) {
+	if c.subject.Type == GroupObjectType && c.subject.ID == groupID && c.subject.Relation == GroupMemberRelation {
+		return true, nil
+	}
+	if c.groupIDs != nil {
+		_, ok := c.groupIDs[groupID]
+		return ok, nil
+	}
+
+	members, err := c.groupMembers(groupID)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range members {
+		if member.Type == group.MemberTypeGroup {
+			if c.subject.Type == GroupObjectType && c.subject.ID == member.ID && c.subject.Relation == "" {
+				return true, nil
+			}
+			isMember, err := c.check(ObjectReference{Type: GroupObjectType, ID: member.ID},
+				GroupMemberRelation, depth+1)
+			if err != nil || isMember {
+				return isMember, err
+			}
+			continue
+		}
+		if string(member.Type) == c.subject.Type && member.ID == c.subject.ID && c.subject.Relation == "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// groupMembers returns the direct members of the group. An unknown group has no members.
+func (c *checker) groupMembers(groupID string) ([]group.Member, error) {
+	if members, ok := c.members[groupID]; ok {
+		return members, nil
+	}
+	if c.service.groupService == nil {
+		return nil, nil
+	}
+
+	members := make([]group.Member, 0)
+	for offset := 0; ; offset += serverconst.MaxPageSize {
+		page, svcErr := c.service.groupService.GetGroupMembers(c.ctx, groupID, serverconst.MaxPageSize, offset,
+			false)
+		if svcErr != nil {
+			if svcErr.Code == group.ErrorGroupNotFound.Code {
+				break
+			}
+			return nil, fmt.Errorf("failed to get members of group %s: %s", groupID, svcErr.Code)
+		}
+		members = append(members, page.Members...)
+		if len(page.Members) == 0 || offset+len(page.Members) >= page.TotalResults {
+			break
+		}
+	}
+	c.members[groupID] = members
+	return members, nil
+}
+
+// expand collects into subjects every single subject that has the relation on the object.
+func (c *checker) expand(object ObjectReference, relation string, depth int,
+	subjects map[SubjectReference]struct{}) error {
+	if depth > maxResolutionDepth {
+		return errResolutionTooDeep
+	}
+	key := relationKey{object: object, relation: relation}
+	if _, ok := c.expanded[key]; ok {
+		return nil
+	}
+	c.expanded[key] = struct{}{}
+
+	if isGroupMembership(object.Type, relation) {
+		members, err := c.groupMembers(object.ID)
+		if err != nil {
+			return err
+		}
+		for _, member := range members {
+			if member.Type == group.MemberTypeGroup {
+				if err := c.expand(ObjectReference{Type: GroupObjectType, ID: member.ID}, GroupMemberRelation,
+					depth+1, subjects); err != nil {
+					return err
+				}
+				continue
+			}
+			subjects[SubjectReference{Type: string(member.Type), ID: member.ID}] = struct{}{}
+		}
+	}
+
+	typeDef, err := c.typeDefinition(object.Type)
+	if err != nil || typeDef == nil {
+		return err
+	}
+	relationDef := typeDef.Relation(relation)
+	if relationDef == nil {
+		return nil
+	}
+
+	if len(relationDef.DirectlyRelatedTypes) > 0 {
+		tuples, err := c.service.store.GetTuples(c.ctx, object, relation)
+		if err != nil {
+			return err
+		}
+		for _, tuple := range tuples {
+			if !acceptsSubject(relationDef, tuple.Subject) {
+				continue
+			}
+			if tuple.Subject.Relation == "" {
+				subjects[tuple.Subject] = struct{}{}
+				continue
+			}
+			if err := c.expand(ObjectReference{Type: tuple.Subject.Type, ID: tuple.Subject.ID},
+				tuple.Subject.Relation, depth+1, subjects); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, rewrite := range relationDef.Union {
+		if rewrite.TupleToUserset == nil {
+			if err := c.expand(object, rewrite.ComputedUserset, depth+1, subjects); err != nil {
+				return err
+			}
+			continue
+		}
+		tupleset := typeDef.Relation(rewrite.TupleToUserset.Tupleset)
+		if tupleset == nil {
+			continue
+		}
+		tuples, err := c.service.store.GetTuples(c.ctx, object, tupleset.Name)
+		if err != nil {
+			return err
+		}
+		for _, tuple := range tuples {
+			if tuple.Subject.Relation != "" || !acceptsSubject(tupleset, tuple.Subject) {
+				continue
+			}
+			if err := c.expand(ObjectReference{Type: tuple.Subject.Type, ID: tuple.Subject.ID},
+				rewrite.TupleToUserset.ComputedUserset, depth+1, subjects); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isGroupMembership reports whether the relation is the built-in group#member relation.
+func isGroupMembership(objectType, relation string) bool {
+	return objectType == GroupObjectType && relation == GroupMemberRelation
+}
+
+// acceptsSubject reports whether the relation accepts the tuple's subject directly. Tuples written
+// before the model changed are ignored once their subject type is no longer allowed.
+func acceptsSubject(relation *RelationDefinition, subject SubjectReference) bool {
+	allowedType := subject.Type
+	if subject.Relation != "" {
+		allowedType += "#" + subject.Relation
+	}
+	return slices.Contains(relation.DirectlyRelatedTypes, allowedType)
+}
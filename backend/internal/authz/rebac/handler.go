@@ -0,0 +1,196 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const relationshipsPath = "/authorization/relationships"
+
+// writeTuplesRequest is the request body for writing and deleting relation tuples.
+type writeTuplesRequest struct {
+	Writes  []RelationTuple `json:"writes"`
+	Deletes []RelationTuple `json:"deletes"`
+}
+
+// rebacHandler serves the management and query API for relationship-based authorization.
+type rebacHandler struct {
+	service ReBACServiceInterface
+}
+
+// newReBACHandler builds the relationship HTTP handler.
+func newReBACHandler(service ReBACServiceInterface) *rebacHandler {
+	return &rebacHandler{service: service}
+}
+
+// HandleListTypes returns every type definition of the authorization model.
+func (h *rebacHandler) HandleListTypes(w http.ResponseWriter, r *http.Request) {
+	types, svcErr := h.service.ListTypes(r.Context())
+	if svcErr != nil {
+		writeReBACError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, types)
+}
+
+// HandleGetType returns a single type definition.
+func (h *rebacHandler) HandleGetType(w http.ResponseWriter, r *http.Request) {
+	typeDef, svcErr := h.service.GetType(r.Context(), strings.TrimSpace(r.PathValue("name")))
+	if svcErr != nil {
+		writeReBACError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, typeDef)
+}
+
+// HandlePutType creates or replaces a type definition. The name in the path takes precedence over
+// the one in the body.
+func (h *rebacHandler) HandlePutType(w http.ResponseWriter, r *http.Request) {
+	req, err := sysutils.DecodeJSONBody[TypeDefinition](r)
+	if err != nil {
+		writeReBACError(r.Context(), w, &ErrorInvalidRequest)
+		return
+	}
+	req.Name = strings.TrimSpace(r.PathValue("name"))
+	typeDef, svcErr := h.service.PutType(r.Context(), req)
+	if svcErr != nil {
+		writeReBACError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, typeDef)
+}
+
+// HandleDeleteType deletes a type definition.
+func (h *rebacHandler) HandleDeleteType(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.service.DeleteType(r.Context(), strings.TrimSpace(r.PathValue("name"))); svcErr != nil {
+		writeReBACError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// HandleListTuples returns one page of the tuples matching the query filters.
+func (h *rebacHandler) HandleListTuples(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit, offset, svcErr := parsePaginationParams(query)
+	if svcErr != nil {
+		writeReBACError(r.Context(), w, svcErr)
+		return
+	}
+	if limit == 0 {
+		limit = serverconst.DefaultPageSize
+	}
+	filter := TupleFilter{
+		ObjectType:  sysutils.SanitizeString(query.Get("objectType")),
+		ObjectID:    sysutils.SanitizeString(query.Get("objectId")),
+		Relation:    sysutils.SanitizeString(query.Get("relation")),
+		SubjectType: sysutils.SanitizeString(query.Get("subjectType")),
+		SubjectID:   sysutils.SanitizeString(query.Get("subjectId")),
+	}
+	tuples, svcErr := h.service.ListTuples(r.Context(), filter, limit, offset)
+	if svcErr != nil {
+		writeReBACError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, tupleListResponse{Tuples: tuples})
+}
+
+// HandleWriteTuples writes and deletes relation tuples.
+func (h *rebacHandler) HandleWriteTuples(w http.ResponseWriter, r *http.Request) {
+	req, err := sysutils.DecodeJSONBody[writeTuplesRequest](r)
+	if err != nil {
+		writeReBACError(r.Context(), w, &ErrorInvalidRequest)
+		return
+	}
+	if svcErr := h.service.WriteTuples(r.Context(), req.Writes, req.Deletes); svcErr != nil {
+		writeReBACError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// HandleCheck reports whether a subject has a relation on an object.
+func (h *rebacHandler) HandleCheck(w http.ResponseWriter, r *http.Request) {
+	req, err := sysutils.DecodeJSONBody[CheckRequest](r)
+	if err != nil {
+		writeReBACError(r.Context(), w, &ErrorInvalidRequest)
+		return
+	}
+	resp, svcErr := h.service.Check(r.Context(), *req)
+	if svcErr != nil {
+		writeReBACError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, resp)
+}
+
+// HandleListObjects lists the objects of a type on which a subject has a relation.
+func (h *rebacHandler) HandleListObjects(w http.ResponseWriter, r *http.Request) {
+	req, err := sysutils.DecodeJSONBody[ListObjectsRequest](r)
+	if err != nil {
+		writeReBACError(r.Context(), w, &ErrorInvalidRequest)
+		return
+	}
+	resp, svcErr := h.service.ListObjects(r.Context(), *req)
+	if svcErr != nil {
+		writeReBACError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, resp)
+}
+
+// HandleListSubjects lists the subjects that have a relation on an object.
+func (h *rebacHandler) HandleListSubjects(w http.ResponseWriter, r *http.Request) {
+	req, err := sysutils.DecodeJSONBody[ListSubjectsRequest](r)
+	if err != nil {
+		writeReBACError(r.Context(), w, &ErrorInvalidRequest)
+		return
+	}
+	resp, svcErr := h.service.ListSubjects(r.Context(), *req)
+	if svcErr != nil {
+		writeReBACError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, resp)
+}
+
+// parsePaginationParams parses the limit and offset query parameters.
+func parsePaginationParams(query url.Values) (int, int, *tidcommon.ServiceError) {
+	limit, offset := 0, 0
+	var err error
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if limit, err = strconv.Atoi(limitStr); err != nil {
+			return 0, 0, &ErrorInvalidRequest
+		}
+	}
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		if offset, err = strconv.Atoi(offsetStr); err != nil {
+			return 0, 0, &ErrorInvalidRequest
+		}
+	}
+	return limit, offset, nil
+}
+
+// writeReBACError maps a service error to an HTTP status and writes the corresponding error response.
+func writeReBACError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	status := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		status = rebacClientErrorStatus(svcErr.Code)
+	}
+	sysutils.WriteErrorResponse(ctx, w, status, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+type ReBACHandlerTestSuite struct {
+	suite.Suite
+	service *ReBACServiceInterfaceMock
+	handler *rebacHandler
+}
+
+func TestReBACHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ReBACHandlerTestSuite))
+}
+
+func (s *ReBACHandlerTestSuite) SetupTest() {
+	s.service = NewReBACServiceInterfaceMock(s.T())
+	s.handler = newReBACHandler(s.service)
+}
+
+func (s *ReBACHandlerTestSuite) TestHandlePutTypeUsesPathName() {
+	s.service.EXPECT().PutType(mock.Anything, mock.MatchedBy(func(typeDef *TypeDefinition) bool {
+		return typeDef.Name == "document" && typeDef.Relations[0].Name == "viewer"
+	})).RunAndReturn(func(_ context.Context, typeDef *TypeDefinition) (*TypeDefinition, *tidcommon.ServiceError) {
+		return typeDef, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPut, relationshipsPath+"/types/document",
+		strings.NewReader(`{"name":"ignored","relations":[{"name":"viewer","directlyRelatedTypes":["user"]}]}`))
+	req.SetPathValue("name", "document")
+	rec := httptest.NewRecorder()
+	s.handler.HandlePutType(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"name":"document"`)
+}
+
+func (s *ReBACHandlerTestSuite) TestHandleGetTypeNotFound() {
+	s.service.EXPECT().GetType(mock.Anything, "project").Return(nil, &ErrorTypeNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, relationshipsPath+"/types/project", nil)
+	req.SetPathValue("name", "project")
+	rec := httptest.NewRecorder()
+	s.handler.HandleGetType(rec, req)
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), ErrorTypeNotFound.Code)
+}
+
+func (s *ReBACHandlerTestSuite) TestHandleListTuplesAppliesFilters() {
+	s.service.EXPECT().ListTuples(mock.Anything, TupleFilter{ObjectType: "document", SubjectID: "alice"}, 5, 10).
+		Return([]RelationTuple{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		relationshipsPath+"/tuples?objectType=document&subjectId=alice&limit=5&offset=10", nil)
+	rec := httptest.NewRecorder()
+	s.handler.HandleListTuples(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"tuples":[]}`, rec.Body.String())
+}
+
+func (s *ReBACHandlerTestSuite) TestHandleListTuplesInvalidLimit() {
+	req := httptest.NewRequest(http.MethodGet, relationshipsPath+"/tuples?limit=abc", nil)
+	rec := httptest.NewRecorder()
+	s.handler.HandleListTuples(rec, req)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ReBACHandlerTestSuite) TestHandleWriteTuples() {
+	s.service.EXPECT().WriteTuples(mock.Anything, []RelationTuple{{
+		Object:   ObjectReference{Type: "document", ID: "d1"},
+		Relation: "viewer",
+		Subject:  SubjectReference{Type: "group", ID: "eng", Relation: "member"},
+	}}, []RelationTuple(nil)).Return(nil)
+
+	req := httptest.NewRequest(http.MethodPost, relationshipsPath+"/tuples", strings.NewReader(
+		`{"writes":[{"object":{"type":"document","id":"d1"},"relation":"viewer",`+
+			`"subject":{"type":"group","id":"eng","relation":"member"}}]}`))
+	rec := httptest.NewRecorder()
+	s.handler.HandleWriteTuples(rec, req)
+
+	s.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *ReBACHandlerTestSuite) TestHandleWriteTuplesInvalidTuple() {
+	s.service.EXPECT().WriteTuples(mock.Anything, mock.Anything, mock.Anything).Return(&ErrorInvalidTuple)
+
+	req := httptest.NewRequest(http.MethodPost, relationshipsPath+"/tuples", strings.NewReader(`{"writes":[{}]}`))
+	rec := httptest.NewRecorder()
+	s.handler.HandleWriteTuples(rec, req)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+	s.Contains(rec.Body.String(), ErrorInvalidTuple.Code)
+}
+
+func (s *ReBACHandlerTestSuite) TestHandleCheck() {
+	s.service.EXPECT().Check(mock.Anything, CheckRequest{
+		Object:   ObjectReference{Type: "document", ID: "d1"},
+		Relation: "viewer",
+		Subject:  SubjectReference{Type: "user", ID: "alice"},
+	}).Return(&CheckResponse{Allowed: true}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, relationshipsPath+"/check", strings.NewReader(
+		`{"object":{"type":"document","id":"d1"},"relation":"viewer","subject":{"type":"user","id":"alice"}}`))
+	rec := httptest.NewRecorder()
+	s.handler.HandleCheck(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"allowed":true}`, rec.Body.String())
+}
+
+func (s *ReBACHandlerTestSuite) TestHandleListObjectsInvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, relationshipsPath+"/list-objects", strings.NewReader("not-json"))
+	rec := httptest.NewRecorder()
+	s.handler.HandleListObjects(rec, req)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+	s.Contains(rec.Body.String(), ErrorInvalidRequest.Code)
+}
+
+func (s *ReBACHandlerTestSuite) TestHandleListSubjectsInternalError() {
+	s.service.EXPECT().ListSubjects(mock.Anything, mock.Anything).Return(nil, &tidcommon.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodPost, relationshipsPath+"/list-subjects", strings.NewReader(
+		`{"object":{"type":"document","id":"d1"},"relation":"viewer"}`))
+	rec := httptest.NewRecorder()
+	s.handler.HandleListSubjects(rec, req)
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
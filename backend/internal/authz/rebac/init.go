@@ -0,0 +1,70 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize builds the relationship store and service, registers the management and query API
+// routes, and returns the service for the ReBAC engine and the AuthZEN search endpoints.
+func Initialize(mux *http.ServeMux, groupService group.GroupServiceInterface) ReBACServiceInterface {
+	svc := newReBACService(newReBACStore(), groupService)
+	registerRoutes(mux, newReBACHandler(svc))
+	return svc
+}
+
+// registerRoutes registers the relationship endpoints. These are admin-facing and intentionally
+// NOT in the public-paths allowlist, so the platform auth middleware protects them.
+func registerRoutes(mux *http.ServeMux, h *rebacHandler) {
+	readOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	typeOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	tupleOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	queryOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	handle := func(pattern string, handler http.HandlerFunc, opts middleware.CORSOptions) {
+		mux.HandleFunc(middleware.WithCORS(pattern,
+			middleware.CorrelationIDMiddleware(handler).ServeHTTP, opts))
+	}
+	handle("GET "+relationshipsPath+"/types", h.HandleListTypes, readOpts)
+	handle("GET "+relationshipsPath+"/types/{name}", h.HandleGetType, typeOpts)
+	handle("PUT "+relationshipsPath+"/types/{name}", h.HandlePutType, typeOpts)
+	handle("DELETE "+relationshipsPath+"/types/{name}", h.HandleDeleteType, typeOpts)
+	handle("GET "+relationshipsPath+"/tuples", h.HandleListTuples, tupleOpts)
+	handle("POST "+relationshipsPath+"/tuples", h.HandleWriteTuples, tupleOpts)
+	handle("POST "+relationshipsPath+"/check", h.HandleCheck, queryOpts)
+	handle("POST "+relationshipsPath+"/list-objects", h.HandleListObjects, queryOpts)
+	handle("POST "+relationshipsPath+"/list-subjects", h.HandleListSubjects, queryOpts)
+
+	noContent := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) }
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+relationshipsPath+"/types", noContent, readOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+relationshipsPath+"/types/{name}", noContent, typeOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+relationshipsPath+"/tuples", noContent, tupleOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+relationshipsPath+"/check", noContent, queryOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+relationshipsPath+"/list-objects", noContent, queryOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+relationshipsPath+"/list-subjects", noContent, queryOpts))
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterRoutesRegistersEndpoints(t *testing.T) {
+	mux := http.NewServeMux()
+	registerRoutes(mux, newReBACHandler(NewReBACServiceInterfaceMock(t)))
+
+	// The OPTIONS preflight handlers respond without invoking the service.
+	for _, target := range []string{
+		relationshipsPath + "/types",
+		relationshipsPath + "/types/document",
+		relationshipsPath + "/tuples",
+		relationshipsPath + "/check",
+		relationshipsPath + "/list-objects",
+		relationshipsPath + "/list-subjects",
+	} {
+		req := httptest.NewRequest(http.MethodOptions, target, nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNoContent, rec.Code, target)
+	}
+}
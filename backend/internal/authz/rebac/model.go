@@ -0,0 +1,146 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rebac implements relationship-based access control (ReBAC) in the style of Zanzibar.
+// Access is derived from relation tuples of the form object#relation@subject, interpreted through an
+// authorization model that declares, per object type, which relations exist and how they are
+// computed from direct tuples, other relations of the same object (computed usersets) and relations
+// of related objects (tuple-to-userset rewrites). Group membership managed by the group service is
+// available to every model as the subject set group:<id>#member.
+package rebac
+
+import "fmt"
+
+const (
+	// GroupObjectType is the object type of groups managed by the group service.
+	GroupObjectType = "group"
+	// GroupMemberRelation is the relation that resolves to the members of a group.
+	GroupMemberRelation = "member"
+)
+
+// TypeDefinition declares the relations of an object type in the authorization model.
+type TypeDefinition struct {
+	Name      string               `json:"name" yaml:"name"`
+	Relations []RelationDefinition `json:"relations" yaml:"relations"`
+}
+
+// Relation returns the definition of the named relation, or nil when the type does not declare it.
+func (t *TypeDefinition) Relation(name string) *RelationDefinition {
+	for i := range t.Relations {
+		if t.Relations[i].Name == name {
+			return &t.Relations[i]
+		}
+	}
+	return nil
+}
+
+// RelationDefinition declares how a relation is derived. A subject has the relation when it is
+// directly related through a tuple, or when any of the rewrites in Union grants it.
+// DirectlyRelatedTypes lists the subjects a tuple may name: an object type such as "user" for a
+// single subject, or "type#relation" such as "group#member" for a subject set. A relation without
+// directly related types is computed only.
+type RelationDefinition struct {
+	Name                 string           `json:"name" yaml:"name"`
+	DirectlyRelatedTypes []string         `json:"directlyRelatedTypes,omitempty" yaml:"directlyRelatedTypes,omitempty"` //nolint:lll
+	Union                []UsersetRewrite `json:"union,omitempty" yaml:"union,omitempty"`
+}
+
+// UsersetRewrite is one way a relation may be granted. Exactly one of the fields is set.
+type UsersetRewrite struct {
+	// ComputedUserset grants the relation to every subject holding the named relation on the same object.
+	ComputedUserset string `json:"computedUserset,omitempty" yaml:"computedUserset,omitempty"`
+	// TupleToUserset grants the relation to every subject holding a relation on a related object.
+	TupleToUserset *TupleToUserset `json:"tupleToUserset,omitempty" yaml:"tupleToUserset,omitempty"`
+}
+
+// TupleToUserset follows the objects related through Tupleset and grants the relation to every
+// subject holding ComputedUserset on them. For example, {tupleset: parent, computedUserset: viewer}
+// on a document grants the relation to the viewers of its parent folder.
+type TupleToUserset struct {
+	Tupleset        string `json:"tupleset" yaml:"tupleset"`
+	ComputedUserset string `json:"computedUserset" yaml:"computedUserset"`
+}
+
+// ObjectReference identifies an object by type and ID.
+type ObjectReference struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// SubjectReference identifies a subject. Relation is empty for a single subject and set for a
+// subject set, such as group:engineering#member.
+type SubjectReference struct {
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	Relation string `json:"relation,omitempty"`
+}
+
+// RelationTuple states that the subject has the relation on the object.
+type RelationTuple struct {
+	Object   ObjectReference  `json:"object"`
+	Relation string           `json:"relation"`
+	Subject  SubjectReference `json:"subject"`
+}
+
+// String renders the tuple in the object#relation@subject notation.
+func (t RelationTuple) String() string {
+	subject := t.Subject.Type + ":" + t.Subject.ID
+	if t.Subject.Relation != "" {
+		subject += "#" + t.Subject.Relation
+	}
+	return fmt.Sprintf("%s:%s#%s@%s", t.Object.Type, t.Object.ID, t.Relation, subject)
+}
+
+// TupleFilter narrows a tuple listing. Empty fields match any value.
+type TupleFilter struct {
+	ObjectType  string
+	ObjectID    string
+	Relation    string
+	SubjectType string
+	SubjectID   string
+}
+
+// CheckRequest asks whether the subject has the relation on the object. GroupIDs, when not nil,
+// lists the groups the subject belongs to, transitively; otherwise membership is resolved through
+// the group service.
+type CheckRequest struct {
+	Object   ObjectReference  `json:"object"`
+	Relation string           `json:"relation"`
+	Subject  SubjectReference `json:"subject"`
+	GroupIDs []string         `json:"-"`
+}
+
+// CheckResponse is the result of a check.
+type CheckResponse struct {
+	Allowed bool `json:"allowed"`
+}
+
+// ListObjectsRequest asks for the objects of a type on which the subject has the relation.
+type ListObjectsRequest struct {
+	ObjectType string           `json:"objectType"`
+	Relation   string           `json:"relation"`
+	Subject    SubjectReference `json:"subject"`
+	GroupIDs   []string         `json:"-"`
+}
+
+// ListObjectsResponse lists the IDs of the matching objects.
+type ListObjectsResponse struct {
+	Objects []string `json:"objects"`
+}
+
+// ListSubjectsRequest asks for the subjects of a type that have the relation on the object.
+type ListSubjectsRequest struct {
+	Object      ObjectReference `json:"object"`
+	Relation    string          `json:"relation"`
+	SubjectType string          `json:"subjectType"`
+}
+
+// ListSubjectsResponse lists the IDs of the matching subjects.
+type ListSubjectsResponse struct {
+	Subjects []string `json:"subjects"`
+}
+
+// tupleListResponse is the API response for a tuple listing.
+type tupleListResponse struct {
+	Tuples []RelationTuple `json:"tuples"`
+}
@@ -0,0 +1,336 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// DefaultSubjectType is assumed for checks and listings whose subject carries no type.
+const DefaultSubjectType = "user"
+
+// ReBACServiceInterface manages the relationship-based authorization model and relation tuples, and
+// answers check, list-objects and list-subjects queries over them.
+type ReBACServiceInterface interface {
+	PutType(ctx context.Context, typeDef *TypeDefinition) (*TypeDefinition, *tidcommon.ServiceError)
+	GetType(ctx context.Context, name string) (*TypeDefinition, *tidcommon.ServiceError)
+	ListTypes(ctx context.Context) ([]TypeDefinition, *tidcommon.ServiceError)
+	DeleteType(ctx context.Context, name string) *tidcommon.ServiceError
+	// WriteTuples writes and then deletes the given tuples. Writing an existing tuple and deleting a
+	// missing one are no-ops.
+	WriteTuples(ctx context.Context, writes, deletes []RelationTuple) *tidcommon.ServiceError
+	ListTuples(ctx context.Context, filter TupleFilter, limit, offset int) (
+		[]RelationTuple, *tidcommon.ServiceError)
+	Check(ctx context.Context, request CheckRequest) (*CheckResponse, *tidcommon.ServiceError)
+	ListObjects(ctx context.Context, request ListObjectsRequest) (*ListObjectsResponse, *tidcommon.ServiceError)
+	ListSubjects(ctx context.Context, request ListSubjectsRequest) (*ListSubjectsResponse, *tidcommon.ServiceError)
+}
+
+type rebacService struct {
+	store        rebacStoreInterface
+	groupService group.GroupServiceInterface
+	logger       *log.Logger
+}
+
+// newReBACService builds a relationship service over the given store and group service.
+func newReBACService(store rebacStoreInterface, groupService group.GroupServiceInterface) ReBACServiceInterface {
+	return &rebacService{
+		store:        store,
+		groupService: groupService,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ReBACService")),
+	}
+}
+
+// PutType creates or replaces a type definition of the authorization model.
+func (s *rebacService) PutType(ctx context.Context, typeDef *TypeDefinition) (
+	*TypeDefinition, *tidcommon.ServiceError) {
+	if typeDef == nil || strings.TrimSpace(typeDef.Name) == "" {
+		return nil, &ErrorInvalidRequest
+	}
+	if !isValidTypeDefinition(typeDef) {
+		return nil, &ErrorInvalidTypeDefinition
+	}
+
+	_, err := s.store.GetType(ctx, typeDef.Name)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		err = s.store.CreateType(ctx, *typeDef)
+	case err == nil:
+		err = s.store.UpdateType(ctx, *typeDef)
+	}
+	if err != nil {
+		s.logger.Error(ctx, "Failed to write relation type", log.String("type", typeDef.Name), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return typeDef, nil
+}
+
+// GetType returns the named type definition.
+func (s *rebacService) GetType(ctx context.Context, name string) (*TypeDefinition, *tidcommon.ServiceError) {
+	if strings.TrimSpace(name) == "" {
+		return nil, &ErrorInvalidRequest
+	}
+	typeDef, err := s.store.GetType(ctx, name)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, &ErrorTypeNotFound
+		}
+		s.logger.Error(ctx, "Failed to get relation type", log.String("type", name), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return typeDef, nil
+}
+
+// ListTypes returns every type definition of the authorization model.
+func (s *rebacService) ListTypes(ctx context.Context) ([]TypeDefinition, *tidcommon.ServiceError) {
+	types, err := s.store.ListTypes(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list relation types", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return types, nil
+}
+
+// DeleteType removes the named type definition. Tuples on objects of the type are kept but no
+// longer grant any relation until the type is defined again.
+func (s *rebacService) DeleteType(ctx context.Context, name string) *tidcommon.ServiceError {
+	if strings.TrimSpace(name) == "" {
+		return &ErrorInvalidRequest
+	}
+	if err := s.store.DeleteType(ctx, name); err != nil {
+		s.logger.Error(ctx, "Failed to delete relation type", log.String("type", name), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
+// WriteTuples validates every tuple against the authorization model, then applies the writes
+// followed by the deletes.
+func (s *rebacService) WriteTuples(ctx context.Context, writes, deletes []RelationTuple) *tidcommon.ServiceError {
+	if len(writes) == 0 && len(deletes) == 0 {
+		return &ErrorInvalidRequest
+	}
+	types := make(map[string]*TypeDefinition)
+	for _, tuple := range writes {
+		if svcErr := s.validateTuple(ctx, types, tuple); svcErr != nil {
+			return svcErr
+		}
+	}
+	for _, tuple := range deletes {
+		if !isCompleteTuple(tuple) {
+			return &ErrorInvalidRequest
+		}
+	}
+
+	for _, tuple := range writes {
+		exists, err := s.store.TupleExists(ctx, tuple)
+		if err == nil && !exists {
+			err = s.store.CreateTuple(ctx, tuple)
+		}
+		if err != nil {
+			s.logger.Error(ctx, "Failed to write relation tuple", log.String("tuple", tuple.String()), log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+	}
+	for _, tuple := range deletes {
+		if err := s.store.DeleteTuple(ctx, tuple); err != nil {
+			s.logger.Error(ctx, "Failed to delete relation tuple", log.String("tuple", tuple.String()), log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+	}
+	return nil
+}
+
+// ListTuples returns one page of the tuples matching the filter.
+func (s *rebacService) ListTuples(ctx context.Context, filter TupleFilter, limit, offset int) (
+	[]RelationTuple, *tidcommon.ServiceError) {
+	if limit <= 0 || limit > serverconst.MaxPageSize || offset < 0 {
+		return nil, &ErrorInvalidRequest
+	}
+	tuples, err := s.store.ListTuples(ctx, filter, limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list relation tuples", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return tuples, nil
+}
+
+// Check reports whether the subject has the relation on the object.
+func (s *rebacService) Check(ctx context.Context, request CheckRequest) (*CheckResponse, *tidcommon.ServiceError) {
+	if !isCompleteObject(request.Object) || request.Relation == "" || request.Subject.ID == "" {
+		return nil, &ErrorInvalidRequest
+	}
+	c := s.newChecker(ctx, request.Subject, request.GroupIDs)
+	if svcErr := c.requireRelation(request.Object.Type, request.Relation); svcErr != nil {
+		return nil, svcErr
+	}
+	allowed, err := c.check(request.Object, request.Relation, 0)
+	if err != nil {
+		return nil, s.resolutionError(ctx, err)
+	}
+	return &CheckResponse{Allowed: allowed}, nil
+}
+
+// ListObjects returns the IDs of the objects of the type on which the subject has the relation.
+// Every object a subject can hold a relation on appears in at least one tuple, so the objects of
+// the type that appear in tuples are checked one by one, sharing resolved sub-results.
+func (s *rebacService) ListObjects(ctx context.Context, request ListObjectsRequest) (
+	*ListObjectsResponse, *tidcommon.ServiceError) {
+	if request.ObjectType == "" || request.Relation == "" || request.Subject.ID == "" {
+		return nil, &ErrorInvalidRequest
+	}
+	c := s.newChecker(ctx, request.Subject, request.GroupIDs)
+	if svcErr := c.requireRelation(request.ObjectType, request.Relation); svcErr != nil {
+		return nil, svcErr
+	}
+
+	candidates, err := s.store.ListObjectIDs(ctx, request.ObjectType)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list relation objects", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	objects := make([]string, 0)
+	for _, id := range candidates {
+		allowed, err := c.check(ObjectReference{Type: request.ObjectType, ID: id}, request.Relation, 0)
+		if err != nil {
+			return nil, s.resolutionError(ctx, err)
+		}
+		if allowed {
+			objects = append(objects, id)
+		}
+	}
+	return &ListObjectsResponse{Objects: objects}, nil
+}
+
+// ListSubjects returns the IDs of the subjects of the type that have the relation on the object.
+func (s *rebacService) ListSubjects(ctx context.Context, request ListSubjectsRequest) (
+	*ListSubjectsResponse, *tidcommon.ServiceError) {
+	if !isCompleteObject(request.Object) || request.Relation == "" {
+		return nil, &ErrorInvalidRequest
+	}
+	subjectType := request.SubjectType
+	if subjectType == "" {
+		subjectType = DefaultSubjectType
+	}
+	c := s.newChecker(ctx, SubjectReference{}, nil)
+	if svcErr := c.requireRelation(request.Object.Type, request.Relation); svcErr != nil {
+		return nil, svcErr
+	}
+
+	subjects := make(map[SubjectReference]struct{})
+	if err := c.expand(request.Object, request.Relation, 0, subjects); err != nil {
+		return nil, s.resolutionError(ctx, err)
+	}
+	ids := make([]string, 0, len(subjects))
+	for subject := range subjects {
+		if subject.Type == subjectType {
+			ids = append(ids, subject.ID)
+		}
+	}
+	slices.Sort(ids)
+	return &ListSubjectsResponse{Subjects: ids}, nil
+}
+
+// resolutionError maps an error raised while resolving relations to a service error.
+func (s *rebacService) resolutionError(ctx context.Context, err error) *tidcommon.ServiceError {
+	if errors.Is(err, errResolutionTooDeep) {
+		return &ErrorResolutionTooDeep
+	}
+	s.logger.Error(ctx, "Failed to resolve relations", log.Error(err))
+	return &tidcommon.InternalServerError
+}
+
+// validateTuple checks that the tuple names a relation of a defined type that accepts its subject.
+func (s *rebacService) validateTuple(ctx context.Context, types map[string]*TypeDefinition,
+	tuple RelationTuple) *tidcommon.ServiceError {
+	if !isCompleteTuple(tuple) {
+		return &ErrorInvalidRequest
+	}
+	typeDef, ok := types[tuple.Object.Type]
+	if !ok {
+		fetched, err := s.store.GetType(ctx, tuple.Object.Type)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return &ErrorTypeNotFound
+			}
+			s.logger.Error(ctx, "Failed to get relation type", log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		typeDef = fetched
+		types[tuple.Object.Type] = typeDef
+	}
+
+	relation := typeDef.Relation(tuple.Relation)
+	if relation == nil {
+		return &ErrorUnknownRelation
+	}
+	if !acceptsSubject(relation, tuple.Subject) {
+		return &ErrorInvalidTuple
+	}
+	return nil
+}
+
+// isCompleteObject reports whether the object reference names both a type and an ID.
+func isCompleteObject(object ObjectReference) bool {
+	return strings.TrimSpace(object.Type) != "" && strings.TrimSpace(object.ID) != ""
+}
+
+// isCompleteTuple reports whether every mandatory field of the tuple is set.
+func isCompleteTuple(tuple RelationTuple) bool {
+	return isCompleteObject(tuple.Object) && strings.TrimSpace(tuple.Relation) != "" &&
+		strings.TrimSpace(tuple.Subject.Type) != "" && strings.TrimSpace(tuple.Subject.ID) != ""
+}
+
+// isValidTypeDefinition reports whether relation names are unique and every rewrite references a
+// relation of the same type. The computed relation of a tuple-to-userset rewrite belongs to the
+// related objects' types and is resolved at evaluation time.
+func isValidTypeDefinition(typeDef *TypeDefinition) bool {
+	names := make(map[string]struct{}, len(typeDef.Relations))
+	for _, relation := range typeDef.Relations {
+		if strings.TrimSpace(relation.Name) == "" {
+			return false
+		}
+		if _, dup := names[relation.Name]; dup {
+			return false
+		}
+		names[relation.Name] = struct{}{}
+	}
+
+	for _, relation := range typeDef.Relations {
+		if len(relation.DirectlyRelatedTypes) == 0 && len(relation.Union) == 0 {
+			return false
+		}
+		for _, allowed := range relation.DirectlyRelatedTypes {
+			objectType, subjectRelation, isSet := strings.Cut(allowed, "#")
+			if objectType == "" || (isSet && subjectRelation == "") {
+				return false
+			}
+		}
+		for _, rewrite := range relation.Union {
+			switch {
+			case rewrite.ComputedUserset != "" && rewrite.TupleToUserset == nil:
+				if _, ok := names[rewrite.ComputedUserset]; !ok {
+					return false
+				}
+			case rewrite.ComputedUserset == "" && rewrite.TupleToUserset != nil:
+				tupleset := typeDef.Relation(rewrite.TupleToUserset.Tupleset)
+				if tupleset == nil || len(tupleset.DirectlyRelatedTypes) == 0 ||
+					rewrite.TupleToUserset.ComputedUserset == "" {
+					return false
+				}
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
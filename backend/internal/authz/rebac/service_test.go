@@ -0,0 +1,369 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/tests/mocks/groupmock"
+)
+
+type ReBACServiceTestSuite struct {
+	suite.Suite
+	store        *rebacStoreInterfaceMock
+	groupService *groupmock.GroupServiceInterfaceMock
+	service      *rebacService
+	ctx          context.Context
+}
+
+func TestReBACServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ReBACServiceTestSuite))
+}
+
+func (s *ReBACServiceTestSuite) SetupTest() {
+	s.store = newReBACStoreInterfaceMock(s.T())
+	s.groupService = groupmock.NewGroupServiceInterfaceMock(s.T())
+	s.service = &rebacService{
+		store:        s.store,
+		groupService: s.groupService,
+		logger:       log.GetLogger(),
+	}
+	s.ctx = context.Background()
+}
+
+// testModel declares documents that inherit viewers from their parent folder, and folders shared
+// with groups.
+func testModel() []TypeDefinition {
+	return []TypeDefinition{
+		{
+			Name: "folder",
+			Relations: []RelationDefinition{
+				{Name: "viewer", DirectlyRelatedTypes: []string{"user", "group#member"}},
+			},
+		},
+		{
+			Name: "document",
+			Relations: []RelationDefinition{
+				{Name: "parent", DirectlyRelatedTypes: []string{"folder"}},
+				{Name: "owner", DirectlyRelatedTypes: []string{"user"}},
+				{
+					Name:                 "editor",
+					DirectlyRelatedTypes: []string{"user", "group#member"},
+					Union:                []UsersetRewrite{{ComputedUserset: "owner"}},
+				},
+				{
+					Name:                 "viewer",
+					DirectlyRelatedTypes: []string{"user"},
+					Union: []UsersetRewrite{
+						{ComputedUserset: "editor"},
+						{TupleToUserset: &TupleToUserset{Tupleset: "parent", ComputedUserset: "viewer"}},
+					},
+				},
+			},
+		},
+		{
+			Name: "team",
+			Relations: []RelationDefinition{
+				{Name: "member", DirectlyRelatedTypes: []string{"user", "team#member"}},
+			},
+		},
+	}
+}
+
+func tuple(objectType, objectID, relation, subjectType, subjectID, subjectRelation string) RelationTuple {
+	return RelationTuple{
+		Object:   ObjectReference{Type: objectType, ID: objectID},
+		Relation: relation,
+		Subject:  SubjectReference{Type: subjectType, ID: subjectID, Relation: subjectRelation},
+	}
+}
+
+func testTuples() []RelationTuple {
+	return []RelationTuple{
+		tuple("folder", "f1", "viewer", "group", "eng", "member"),
+		tuple("document", "d1", "parent", "folder", "f1", ""),
+		tuple("document", "d1", "owner", "user", "alice", ""),
+		tuple("document", "d2", "viewer", "user", "bob", ""),
+		tuple("team", "a", "member", "team", "b", "member"),
+		tuple("team", "b", "member", "team", "a", "member"),
+	}
+}
+
+// useModel serves the test model and tuples from the store mock.
+func (s *ReBACServiceTestSuite) useModel() {
+	s.store.EXPECT().GetType(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, name string) (*TypeDefinition, error) {
+			for _, typeDef := range testModel() {
+				if typeDef.Name == name {
+					return &typeDef, nil
+				}
+			}
+			return nil, ErrNotFound
+		}).Maybe()
+	s.store.EXPECT().GetTuples(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, object ObjectReference, relation string) ([]RelationTuple, error) {
+			matched := make([]RelationTuple, 0)
+			for _, t := range testTuples() {
+				if t.Object == object && t.Relation == relation {
+					matched = append(matched, t)
+				}
+			}
+			return matched, nil
+		}).Maybe()
+}
+
+// useGroups serves the eng group, which contains carol and the nested platform group holding dave.
+func (s *ReBACServiceTestSuite) useGroups() {
+	s.groupService.EXPECT().GetGroupMembers(mock.Anything, "eng", 100, 0, false).Return(&group.MemberListResponse{
+		TotalResults: 2,
+		Members: []group.Member{
+			{ID: "carol", Type: group.MemberTypeUser},
+			{ID: "platform", Type: group.MemberTypeGroup},
+		},
+	}, nil).Maybe()
+	s.groupService.EXPECT().GetGroupMembers(mock.Anything, "platform", 100, 0, false).Return(
+		&group.MemberListResponse{
+			TotalResults: 1,
+			Members:      []group.Member{{ID: "dave", Type: group.MemberTypeUser}},
+		}, nil).Maybe()
+}
+
+func (s *ReBACServiceTestSuite) check(subjectID string, objectID string, groupIDs []string) bool {
+	resp, svcErr := s.service.Check(s.ctx, CheckRequest{
+		Object:   ObjectReference{Type: "document", ID: objectID},
+		Relation: "viewer",
+		Subject:  SubjectReference{ID: subjectID},
+		GroupIDs: groupIDs,
+	})
+	s.Require().Nil(svcErr)
+	return resp.Allowed
+}
+
+func (s *ReBACServiceTestSuite) TestCheckResolvesRewrites() {
+	s.useModel()
+	s.useGroups()
+
+	s.True(s.check("alice", "d1", nil), "owner implies editor implies viewer")
+	s.True(s.check("carol", "d1", nil), "viewer of the parent folder through group membership")
+	s.True(s.check("dave", "d1", nil), "member of a nested group")
+	s.True(s.check("bob", "d2", nil), "direct tuple")
+	s.False(s.check("bob", "d1", nil))
+}
+
+func (s *ReBACServiceTestSuite) TestCheckUsesSuppliedGroups() {
+	s.useModel()
+
+	s.True(s.check("erin", "d1", []string{"eng"}))
+	s.False(s.check("erin", "d1", []string{}))
+	s.groupService.AssertNotCalled(s.T(), "GetGroupMembers", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+}
+
+func (s *ReBACServiceTestSuite) TestCheckBreaksCycles() {
+	s.useModel()
+
+	resp, svcErr := s.service.Check(s.ctx, CheckRequest{
+		Object:   ObjectReference{Type: "team", ID: "a"},
+		Relation: "member",
+		Subject:  SubjectReference{Type: "user", ID: "alice"},
+	})
+
+	s.Require().Nil(svcErr)
+	s.False(resp.Allowed)
+}
+
+func (s *ReBACServiceTestSuite) TestCheckUndefinedModel() {
+	s.useModel()
+
+	_, svcErr := s.service.Check(s.ctx, CheckRequest{
+		Object:   ObjectReference{Type: "project", ID: "p1"},
+		Relation: "viewer",
+		Subject:  SubjectReference{ID: "alice"},
+	})
+	s.Equal(&ErrorTypeNotFound, svcErr)
+
+	_, svcErr = s.service.Check(s.ctx, CheckRequest{
+		Object:   ObjectReference{Type: "document", ID: "d1"},
+		Relation: "commenter",
+		Subject:  SubjectReference{ID: "alice"},
+	})
+	s.Equal(&ErrorUnknownRelation, svcErr)
+}
+
+func (s *ReBACServiceTestSuite) TestCheckGroupServiceError() {
+	s.useModel()
+	s.groupService.EXPECT().GetGroupMembers(mock.Anything, "eng", 100, 0, false).
+		Return(nil, &tidcommon.InternalServerError)
+
+	_, svcErr := s.service.Check(s.ctx, CheckRequest{
+		Object:   ObjectReference{Type: "folder", ID: "f1"},
+		Relation: "viewer",
+		Subject:  SubjectReference{ID: "carol"},
+	})
+
+	s.Equal(&tidcommon.InternalServerError, svcErr)
+}
+
+func (s *ReBACServiceTestSuite) TestListObjects() {
+	s.useModel()
+	s.useGroups()
+	s.store.EXPECT().ListObjectIDs(mock.Anything, "document").Return([]string{"d1", "d2"}, nil)
+
+	resp, svcErr := s.service.ListObjects(s.ctx, ListObjectsRequest{
+		ObjectType: "document",
+		Relation:   "viewer",
+		Subject:    SubjectReference{Type: "user", ID: "carol"},
+	})
+
+	s.Require().Nil(svcErr)
+	s.Equal([]string{"d1"}, resp.Objects)
+}
+
+func (s *ReBACServiceTestSuite) TestListSubjects() {
+	s.useModel()
+	s.useGroups()
+
+	resp, svcErr := s.service.ListSubjects(s.ctx, ListSubjectsRequest{
+		Object:   ObjectReference{Type: "document", ID: "d1"},
+		Relation: "viewer",
+	})
+
+	s.Require().Nil(svcErr)
+	s.Equal([]string{"alice", "carol", "dave"}, resp.Subjects)
+}
+
+func (s *ReBACServiceTestSuite) TestListSubjectsFiltersByType() {
+	s.useModel()
+	s.useGroups()
+
+	resp, svcErr := s.service.ListSubjects(s.ctx, ListSubjectsRequest{
+		Object:      ObjectReference{Type: "document", ID: "d1"},
+		Relation:    "viewer",
+		SubjectType: "app",
+	})
+
+	s.Require().Nil(svcErr)
+	s.Empty(resp.Subjects)
+}
+
+func (s *ReBACServiceTestSuite) TestWriteTuplesIsIdempotent() {
+	s.useModel()
+	existing := tuple("document", "d1", "owner", "user", "alice", "")
+	added := tuple("document", "d1", "editor", "group", "eng", "member")
+	removed := tuple("document", "d2", "viewer", "user", "bob", "")
+	s.store.EXPECT().TupleExists(mock.Anything, existing).Return(true, nil)
+	s.store.EXPECT().TupleExists(mock.Anything, added).Return(false, nil)
+	s.store.EXPECT().CreateTuple(mock.Anything, added).Return(nil)
+	s.store.EXPECT().DeleteTuple(mock.Anything, removed).Return(nil)
+
+	svcErr := s.service.WriteTuples(s.ctx, []RelationTuple{existing, added}, []RelationTuple{removed})
+
+	s.Nil(svcErr)
+}
+
+func (s *ReBACServiceTestSuite) TestWriteTuplesValidation() {
+	s.useModel()
+	cases := []struct {
+		name  string
+		tuple RelationTuple
+		want  *tidcommon.ServiceError
+	}{
+		{"missing subject", tuple("document", "d1", "owner", "user", "", ""), &ErrorInvalidRequest},
+		{"undefined type", tuple("project", "p1", "owner", "user", "alice", ""), &ErrorTypeNotFound},
+		{"undefined relation", tuple("document", "d1", "commenter", "user", "alice", ""), &ErrorUnknownRelation},
+		{"subject set not allowed", tuple("document", "d1", "owner", "group", "eng", "member"), &ErrorInvalidTuple},
+		{"subject type not allowed", tuple("document", "d1", "parent", "user", "alice", ""), &ErrorInvalidTuple},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.want, s.service.WriteTuples(s.ctx, []RelationTuple{tc.tuple}, nil))
+		})
+	}
+	s.Equal(&ErrorInvalidRequest, s.service.WriteTuples(s.ctx, nil, nil))
+}
+
+func (s *ReBACServiceTestSuite) TestWriteTuplesStoreError() {
+	s.useModel()
+	written := tuple("document", "d1", "owner", "user", "alice", "")
+	s.store.EXPECT().TupleExists(mock.Anything, written).Return(false, nil)
+	s.store.EXPECT().CreateTuple(mock.Anything, written).Return(errors.New("db error"))
+
+	s.Equal(&tidcommon.InternalServerError, s.service.WriteTuples(s.ctx, []RelationTuple{written}, nil))
+}
+
+func (s *ReBACServiceTestSuite) TestPutTypeCreatesOrUpdates() {
+	folder := testModel()[0]
+	s.store.EXPECT().GetType(mock.Anything, "folder").Return(nil, ErrNotFound).Once()
+	s.store.EXPECT().CreateType(mock.Anything, folder).Return(nil)
+
+	_, svcErr := s.service.PutType(s.ctx, &folder)
+	s.Nil(svcErr)
+
+	s.store.EXPECT().GetType(mock.Anything, "folder").Return(&folder, nil).Once()
+	s.store.EXPECT().UpdateType(mock.Anything, folder).Return(nil)
+
+	_, svcErr = s.service.PutType(s.ctx, &folder)
+	s.Nil(svcErr)
+}
+
+func (s *ReBACServiceTestSuite) TestPutTypeValidation() {
+	cases := []struct {
+		name      string
+		relations []RelationDefinition
+	}{
+		{"duplicate relation", []RelationDefinition{
+			{Name: "viewer", DirectlyRelatedTypes: []string{"user"}},
+			{Name: "viewer", DirectlyRelatedTypes: []string{"user"}},
+		}},
+		{"empty relation", []RelationDefinition{{Name: "viewer"}}},
+		{"malformed subject set", []RelationDefinition{{Name: "viewer", DirectlyRelatedTypes: []string{"group#"}}}},
+		{"unknown computed relation", []RelationDefinition{
+			{Name: "viewer", Union: []UsersetRewrite{{ComputedUserset: "editor"}}},
+		}},
+		{"rewrite with both fields", []RelationDefinition{
+			{Name: "parent", DirectlyRelatedTypes: []string{"folder"}},
+			{Name: "viewer", Union: []UsersetRewrite{{
+				ComputedUserset: "parent",
+				TupleToUserset:  &TupleToUserset{Tupleset: "parent", ComputedUserset: "viewer"},
+			}}},
+		}},
+		{"computed-only tupleset", []RelationDefinition{
+			{Name: "owner", DirectlyRelatedTypes: []string{"user"}},
+			{Name: "parent", Union: []UsersetRewrite{{ComputedUserset: "owner"}}},
+			{Name: "viewer", Union: []UsersetRewrite{
+				{TupleToUserset: &TupleToUserset{Tupleset: "parent", ComputedUserset: "viewer"}},
+			}},
+		}},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			_, svcErr := s.service.PutType(s.ctx, &TypeDefinition{Name: "document", Relations: tc.relations})
+			s.Equal(&ErrorInvalidTypeDefinition, svcErr)
+		})
+	}
+}
+
+func (s *ReBACServiceTestSuite) TestGetTypeNotFound() {
+	s.store.EXPECT().GetType(mock.Anything, "project").Return(nil, ErrNotFound)
+
+	_, svcErr := s.service.GetType(s.ctx, "project")
+
+	s.Equal(&ErrorTypeNotFound, svcErr)
+}
+
+func (s *ReBACServiceTestSuite) TestListTuplesInvalidPage() {
+	_, svcErr := s.service.ListTuples(s.ctx, TupleFilter{}, 101, 0)
+	s.Equal(&ErrorInvalidRequest, svcErr)
+
+	_, svcErr = s.service.ListTuples(s.ctx, TupleFilter{}, 10, -1)
+	s.Equal(&ErrorInvalidRequest, svcErr)
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// ErrNotFound is the store-level not-found sentinel.
+var ErrNotFound = errors.New("relation type not found")
+
+// rebacStoreInterface persists the authorization model and relation tuples in configdb.
+type rebacStoreInterface interface {
+	CreateTuple(ctx context.Context, tuple RelationTuple) error
+	DeleteTuple(ctx context.Context, tuple RelationTuple) error
+	TupleExists(ctx context.Context, tuple RelationTuple) (bool, error)
+	ListTuples(ctx context.Context, filter TupleFilter, limit, offset int) ([]RelationTuple, error)
+	GetTuples(ctx context.Context, object ObjectReference, relation string) ([]RelationTuple, error)
+	ListObjectIDs(ctx context.Context, objectType string) ([]string, error)
+	CreateType(ctx context.Context, typeDef TypeDefinition) error
+	UpdateType(ctx context.Context, typeDef TypeDefinition) error
+	GetType(ctx context.Context, name string) (*TypeDefinition, error)
+	ListTypes(ctx context.Context) ([]TypeDefinition, error)
+	DeleteType(ctx context.Context, name string) error
+}
+
+type rebacStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newReBACStore returns a configdb-backed relationship store.
+func newReBACStore() rebacStoreInterface {
+	return &rebacStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateTuple inserts a relation tuple.
+func (s *rebacStore) CreateTuple(ctx context.Context, tuple RelationTuple) error {
+	if err := s.executeTuple(ctx, tuple, true); err != nil {
+		return fmt.Errorf("failed to create relation tuple: %w", err)
+	}
+	return nil
+}
+
+// DeleteTuple removes a relation tuple. Deleting a tuple that does not exist is not an error.
+func (s *rebacStore) DeleteTuple(ctx context.Context, tuple RelationTuple) error {
+	if err := s.executeTuple(ctx, tuple, false); err != nil {
+		return fmt.Errorf("failed to delete relation tuple: %w", err)
+	}
+	return nil
+}
+
+// executeTuple runs the insert or delete statement for a tuple.
+func (s *rebacStore) executeTuple(ctx context.Context, tuple RelationTuple, create bool) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	query := queryDeleteTuple
+	if create {
+		query = queryCreateTuple
+	}
+	_, err = dbClient.ExecuteContext(ctx, query, tupleArgs(tuple, s.deploymentID)...)
+	return err
+}
+
+// TupleExists reports whether the exact tuple is stored.
+func (s *rebacStore) TupleExists(ctx context.Context, tuple RelationTuple) (bool, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryCountTuple, tupleArgs(tuple, s.deploymentID)...)
+	if err != nil {
+		return false, fmt.Errorf("failed to check relation tuple: %w", err)
+	}
+	if len(results) == 0 {
+		return false, nil
+	}
+	if count, ok := results[0]["count"].(int64); ok {
+		return count > 0, nil
+	}
+	return false, fmt.Errorf("failed to parse relation tuple count")
+}
+
+// ListTuples returns one page of the tuples matching the filter.
+func (s *rebacStore) ListTuples(ctx context.Context, filter TupleFilter, limit, offset int) (
+	[]RelationTuple, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryListTuples,
+		filter.ObjectType, filter.ObjectID, filter.Relation, filter.SubjectType, filter.SubjectID,
+		s.deploymentID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relation tuples: %w", err)
+	}
+	return buildTuplesFromRows(results), nil
+}
+
+// GetTuples returns the tuples that relate subjects to the object through the relation.
+func (s *rebacStore) GetTuples(ctx context.Context, object ObjectReference, relation string) (
+	[]RelationTuple, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryGetTuplesByObjectRelation,
+		object.Type, object.ID, relation, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relation tuples: %w", err)
+	}
+	return buildTuplesFromRows(results), nil
+}
+
+// ListObjectIDs returns the IDs of the objects of the type that appear in any tuple.
+func (s *rebacStore) ListObjectIDs(ctx context.Context, objectType string) ([]string, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryListObjectIDsByType, objectType, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relation objects: %w", err)
+	}
+	ids := make([]string, 0, len(results))
+	for _, row := range results {
+		ids = append(ids, columnString(row["object_id"]))
+	}
+	return ids, nil
+}
+
+// CreateType inserts a type definition.
+func (s *rebacStore) CreateType(ctx context.Context, typeDef TypeDefinition) error {
+	return s.writeType(ctx, typeDef, true)
+}
+
+// UpdateType replaces the relations of an existing type definition.
+func (s *rebacStore) UpdateType(ctx context.Context, typeDef TypeDefinition) error {
+	return s.writeType(ctx, typeDef, false)
+}
+
+// writeType runs the insert or update statement for a type definition.
+func (s *rebacStore) writeType(ctx context.Context, typeDef TypeDefinition, create bool) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	relations := typeDef.Relations
+	if relations == nil {
+		relations = []RelationDefinition{}
+	}
+	relationsJSON, err := json.Marshal(relations)
+	if err != nil {
+		return fmt.Errorf("failed to marshal relation definitions: %w", err)
+	}
+	query := queryUpdateType
+	if create {
+		query = queryCreateType
+	}
+	if _, err := dbClient.ExecuteContext(ctx, query, typeDef.Name, string(relationsJSON), s.deploymentID); err != nil {
+		return fmt.Errorf("failed to write relation type: %w", err)
+	}
+	return nil
+}
+
+// GetType returns the named type definition, or ErrNotFound.
+func (s *rebacStore) GetType(ctx context.Context, name string) (*TypeDefinition, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryGetType, name, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query relation type: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+	return buildTypeFromRow(results[0])
+}
+
+// ListTypes returns every type definition of the authorization model.
+func (s *rebacStore) ListTypes(ctx context.Context) ([]TypeDefinition, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryListTypes, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relation types: %w", err)
+	}
+	types := make([]TypeDefinition, 0, len(results))
+	for _, row := range results {
+		typeDef, err := buildTypeFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, *typeDef)
+	}
+	return types, nil
+}
+
+// DeleteType removes the named type definition.
+func (s *rebacStore) DeleteType(ctx context.Context, name string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteType, name, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete relation type: %w", err)
+	}
+	return nil
+}
+
+// tupleArgs returns the positional arguments identifying a tuple, followed by the deployment ID.
+func tupleArgs(tuple RelationTuple, deploymentID string) []interface{} {
+	return []interface{}{
+		tuple.Object.Type, tuple.Object.ID, tuple.Relation,
+		tuple.Subject.Type, tuple.Subject.ID, tuple.Subject.Relation, deploymentID,
+	}
+}
+
+// buildTuplesFromRows reconstructs tuples from result rows.
+func buildTuplesFromRows(rows []map[string]interface{}) []RelationTuple {
+	tuples := make([]RelationTuple, 0, len(rows))
+	for _, row := range rows {
+		tuples = append(tuples, RelationTuple{
+			Object: ObjectReference{
+				Type: columnString(row["object_type"]),
+				ID:   columnString(row["object_id"]),
+			},
+			Relation: columnString(row["relation"]),
+			Subject: SubjectReference{
+				Type:     columnString(row["subject_type"]),
+				ID:       columnString(row["subject_id"]),
+				Relation: columnString(row["subject_relation"]),
+			},
+		})
+	}
+	return tuples
+}
+
+// buildTypeFromRow reconstructs a type definition from a result row.
+func buildTypeFromRow(row map[string]interface{}) (*TypeDefinition, error) {
+	typeDef := &TypeDefinition{Name: columnString(row["name"])}
+	if relations := columnString(row["relations"]); relations != "" {
+		if err := json.Unmarshal([]byte(relations), &typeDef.Relations); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal relation definitions: %w", err)
+		}
+	}
+	return typeDef, nil
+}
+
+// columnString coerces a result-row value to a string, tolerating string/[]byte.
+func columnString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// DBQuery definitions for the relationship-based authorization config store.
+var (
+	queryCreateTuple = dbmodel.DBQuery{
+		ID: "AZRQ-REBAC_MGT-01",
+		Query: `INSERT INTO "AUTHZ_RELATION_TUPLE" ` +
+			`(OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	}
+	queryDeleteTuple = dbmodel.DBQuery{
+		ID: "AZRQ-REBAC_MGT-02",
+		Query: `DELETE FROM "AUTHZ_RELATION_TUPLE" WHERE OBJECT_TYPE = $1 AND OBJECT_ID = $2 AND RELATION = $3 ` +
+			`AND SUBJECT_TYPE = $4 AND SUBJECT_ID = $5 AND SUBJECT_RELATION = $6 AND DEPLOYMENT_ID = $7`,
+	}
+	queryCountTuple = dbmodel.DBQuery{
+		ID: "AZRQ-REBAC_MGT-03",
+		Query: `SELECT COUNT(*) AS count FROM "AUTHZ_RELATION_TUPLE" WHERE OBJECT_TYPE = $1 AND OBJECT_ID = $2 ` +
+			`AND RELATION = $3 AND SUBJECT_TYPE = $4 AND SUBJECT_ID = $5 AND SUBJECT_RELATION = $6 ` +
+			`AND DEPLOYMENT_ID = $7`,
+	}
+	queryListTuples = dbmodel.DBQuery{
+		ID: "AZRQ-REBAC_MGT-04",
+		Query: `SELECT OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION ` +
+			`FROM "AUTHZ_RELATION_TUPLE" WHERE ($1 = '' OR OBJECT_TYPE = $1) AND ($2 = '' OR OBJECT_ID = $2) ` +
+			`AND ($3 = '' OR RELATION = $3) AND ($4 = '' OR SUBJECT_TYPE = $4) AND ($5 = '' OR SUBJECT_ID = $5) ` +
+			`AND DEPLOYMENT_ID = $6 ` +
+			`ORDER BY OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION ` +
+			`LIMIT $7 OFFSET $8`,
+	}
+	queryGetTuplesByObjectRelation = dbmodel.DBQuery{
+		ID: "AZRQ-REBAC_MGT-05",
+		Query: `SELECT OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION ` +
+			`FROM "AUTHZ_RELATION_TUPLE" WHERE OBJECT_TYPE = $1 AND OBJECT_ID = $2 AND RELATION = $3 ` +
+			`AND DEPLOYMENT_ID = $4`,
+	}
+	queryListObjectIDsByType = dbmodel.DBQuery{
+		ID: "AZRQ-REBAC_MGT-06",
+		Query: `SELECT DISTINCT OBJECT_ID FROM "AUTHZ_RELATION_TUPLE" ` +
+			`WHERE OBJECT_TYPE = $1 AND DEPLOYMENT_ID = $2 ORDER BY OBJECT_ID`,
+	}
+	queryCreateType = dbmodel.DBQuery{
+		ID:    "AZRQ-REBAC_MGT-07",
+		Query: `INSERT INTO "AUTHZ_RELATION_TYPE" (NAME, RELATIONS, DEPLOYMENT_ID) VALUES ($1, $2, $3)`,
+	}
+	queryUpdateType = dbmodel.DBQuery{
+		ID: "AZRQ-REBAC_MGT-08",
+		Query: `UPDATE "AUTHZ_RELATION_TYPE" SET RELATIONS = $2, UPDATED_AT = CURRENT_TIMESTAMP ` +
+			`WHERE NAME = $1 AND DEPLOYMENT_ID = $3`,
+	}
+	queryGetType = dbmodel.DBQuery{
+		ID:    "AZRQ-REBAC_MGT-09",
+		Query: `SELECT NAME, RELATIONS FROM "AUTHZ_RELATION_TYPE" WHERE NAME = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	queryListTypes = dbmodel.DBQuery{
+		ID:    "AZRQ-REBAC_MGT-10",
+		Query: `SELECT NAME, RELATIONS FROM "AUTHZ_RELATION_TYPE" WHERE DEPLOYMENT_ID = $1 ORDER BY NAME`,
+	}
+	queryDeleteType = dbmodel.DBQuery{
+		ID:    "AZRQ-REBAC_MGT-11",
+		Query: `DELETE FROM "AUTHZ_RELATION_TYPE" WHERE NAME = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,157 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rebac
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type ReBACStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *rebacStore
+}
+
+func TestReBACStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ReBACStoreTestSuite))
+}
+
+func (suite *ReBACStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &rebacStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func testTuple() RelationTuple {
+	return RelationTuple{
+		Object:   ObjectReference{Type: "document", ID: "doc-1"},
+		Relation: "viewer",
+		Subject:  SubjectReference{Type: "group", ID: "eng", Relation: "member"},
+	}
+}
+
+func (suite *ReBACStoreTestSuite) TestCreateTuple() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateTuple,
+		"document", "doc-1", "viewer", "group", "eng", "member", testDeploymentID,
+	).Return(int64(1), nil)
+
+	suite.NoError(suite.store.CreateTuple(context.Background(), testTuple()))
+}
+
+func (suite *ReBACStoreTestSuite) TestDeleteTupleDBClientError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("db error"))
+
+	suite.Error(suite.store.DeleteTuple(context.Background(), testTuple()))
+}
+
+func (suite *ReBACStoreTestSuite) TestTupleExists() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryCountTuple,
+		"document", "doc-1", "viewer", "group", "eng", "member", testDeploymentID,
+	).Return([]map[string]interface{}{{"count": int64(1)}}, nil)
+
+	exists, err := suite.store.TupleExists(context.Background(), testTuple())
+
+	suite.Require().NoError(err)
+	suite.True(exists)
+}
+
+func (suite *ReBACStoreTestSuite) TestListTuples() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListTuples,
+		"document", "", "", "", "", testDeploymentID, 10, 0,
+	).Return([]map[string]interface{}{{
+		"object_type":      "document",
+		"object_id":        []byte("doc-1"),
+		"relation":         "viewer",
+		"subject_type":     "group",
+		"subject_id":       "eng",
+		"subject_relation": "member",
+	}}, nil)
+
+	tuples, err := suite.store.ListTuples(context.Background(), TupleFilter{ObjectType: "document"}, 10, 0)
+
+	suite.Require().NoError(err)
+	suite.Equal([]RelationTuple{testTuple()}, tuples)
+}
+
+func (suite *ReBACStoreTestSuite) TestListObjectIDs() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListObjectIDsByType, "document", testDeploymentID).
+		Return([]map[string]interface{}{{"object_id": "doc-1"}, {"object_id": "doc-2"}}, nil)
+
+	ids, err := suite.store.ListObjectIDs(context.Background(), "document")
+
+	suite.Require().NoError(err)
+	suite.Equal([]string{"doc-1", "doc-2"}, ids)
+}
+
+func (suite *ReBACStoreTestSuite) TestCreateType() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateType,
+		"document", `[{"name":"viewer","directlyRelatedTypes":["user"]}]`, testDeploymentID,
+	).Return(int64(1), nil)
+
+	suite.NoError(suite.store.CreateType(context.Background(), TypeDefinition{
+		Name:      "document",
+		Relations: []RelationDefinition{{Name: "viewer", DirectlyRelatedTypes: []string{"user"}}},
+	}))
+}
+
+func (suite *ReBACStoreTestSuite) TestGetTypeRoundTrip() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetType, "document", testDeploymentID).
+		Return([]map[string]interface{}{{
+			"name":      "document",
+			"relations": []byte(`[{"name":"viewer","union":[{"computedUserset":"editor"}]}]`),
+		}}, nil)
+
+	typeDef, err := suite.store.GetType(context.Background(), "document")
+
+	suite.Require().NoError(err)
+	suite.Equal("document", typeDef.Name)
+	suite.Equal("editor", typeDef.Relations[0].Union[0].ComputedUserset)
+}
+
+func (suite *ReBACStoreTestSuite) TestGetTypeNotFound() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetType, "folder", testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	_, err := suite.store.GetType(context.Background(), "folder")
+
+	suite.ErrorIs(err, ErrNotFound)
+}
+
+func (suite *ReBACStoreTestSuite) TestListTypesCorruptedRow() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListTypes, testDeploymentID).
+		Return([]map[string]interface{}{{"name": "document", "relations": "{not json"}}, nil)
+
+	_, err := suite.store.ListTypes(context.Background())
+
+	suite.Error(err)
+}
+
+func (suite *ReBACStoreTestSuite) TestDeleteTypeExecuteError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteType, "document", testDeploymentID).
+		Return(int64(0), errors.New("exec error"))
+
+	suite.Error(suite.store.DeleteType(context.Background(), "document"))
+}
@@ -78,6 +78,10 @@ func (s *authorizationService) EvaluateAccessBatch(
 func toEngineAccessEvaluationsRequest(request providers.AccessEvaluationsRequest) engine.AccessEvaluationsRequest {
 	evaluations := make([]engine.AccessEvaluationRequest, 0, len(request.Evaluations))
 	for _, evaluation := range request.Evaluations {
+		var resource engine.Resource
+		if evaluation.Resource != nil {
+			resource = engine.Resource{Type: evaluation.Resource.Type, ID: evaluation.Resource.ID}
+		}
 		evaluations = append(evaluations, engine.AccessEvaluationRequest{
 			Subject: engine.Subject{
 				Type:       evaluation.Subject.Type,
@@ -89,6 +93,7 @@ func toEngineAccessEvaluationsRequest(request providers.AccessEvaluationsRequest
 				ID:         evaluation.ResourceServer.ID,
 				Properties: evaluation.ResourceServer.Properties,
 			},
+			Resource: resource,
 			Permission: engine.Permission{
 				Name:       evaluation.Permission.Name,
 				Properties: evaluation.Permission.Properties,
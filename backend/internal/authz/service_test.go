@@ -161,6 +161,29 @@ func (suite *AuthorizationServiceTestSuite) TestEvaluateAccessPassesPropertiesTo
 	suite.True(response.Decision)
 }
 
+func (suite *AuthorizationServiceTestSuite) TestEvaluateAccessPassesResourceToEngine() {
+	request := providers.AccessEvaluationRequest{
+		Subject:        providers.Subject{Type: "user", ID: "user1"},
+		ResourceServer: providers.AccessEvaluationResourceServer{ID: "document"},
+		Resource:       &providers.AccessEvaluationResource{Type: "document", ID: "doc-1"},
+		Permission:     providers.Permission{Name: "read"},
+	}
+
+	suite.mockEngine.On("EvaluateAccessBatch", mock.Anything,
+		mock.MatchedBy(func(req engine.AccessEvaluationsRequest) bool {
+			return len(req.Evaluations) == 1 &&
+				req.Evaluations[0].Resource == engine.Resource{Type: "document", ID: "doc-1"}
+		})).
+		Return(&engine.AccessEvaluationsResponse{
+			Evaluations: []engine.AccessEvaluationResponse{{Decision: true}},
+		}, nil)
+
+	response, err := suite.service.EvaluateAccess(context.Background(), request)
+
+	suite.Nil(err)
+	suite.True(response.Decision)
+}
+
 func (suite *AuthorizationServiceTestSuite) TestEvaluateAccessBatchEmpty() {
 	response, err := suite.service.EvaluateAccessBatch(context.Background(), providers.AccessEvaluationsRequest{})
 
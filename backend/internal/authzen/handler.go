@@ -36,6 +36,8 @@ func (h *handler) HandleMetadataRequest(w http.ResponseWriter, r *http.Request)
 		PolicyDecisionPoint:       baseURL,
 		AccessEvaluationEndpoint:  baseURL + "/access/v1/evaluation",
 		AccessEvaluationsEndpoint: baseURL + "/access/v1/evaluations",
+		SearchSubjectEndpoint:     baseURL + "/access/v1/search/subject",
+		SearchResourceEndpoint:    baseURL + "/access/v1/search/resource",
 		SearchActionEndpoint:      baseURL + "/access/v1/search/action",
 	}
 
@@ -93,6 +95,40 @@ func (h *handler) HandleActionSearchRequest(w http.ResponseWriter, r *http.Reque
 	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, resp)
 }
 
+// HandleSubjectSearchRequest handles an AuthZEN subject search request.
+func (h *handler) HandleSubjectSearchRequest(w http.ResponseWriter, r *http.Request) {
+	req, err := sysutils.DecodeJSONBody[AccessSubjectSearchRequest](r)
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	resp, svcErr := h.service.SearchSubjects(r.Context(), *req)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, resp)
+}
+
+// HandleResourceSearchRequest handles an AuthZEN resource search request.
+func (h *handler) HandleResourceSearchRequest(w http.ResponseWriter, r *http.Request) {
+	req, err := sysutils.DecodeJSONBody[AccessResourceSearchRequest](r)
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	resp, svcErr := h.service.SearchResources(r.Context(), *req)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, resp)
+}
+
 // handleError writes an AuthZEN transport error response for a service error.
 func handleError(w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
 	statusCode := http.StatusInternalServerError
@@ -31,6 +31,10 @@ type testService struct {
 		*AccessEvaluationsResponse, *tidcommon.ServiceError)
 	searchActions func(context.Context, AccessActionSearchRequest) (
 		*AccessSearchResponse, *tidcommon.ServiceError)
+	searchSubjects func(context.Context, AccessSubjectSearchRequest) (
+		*AccessSubjectSearchResponse, *tidcommon.ServiceError)
+	searchResources func(context.Context, AccessResourceSearchRequest) (
+		*AccessResourceSearchResponse, *tidcommon.ServiceError)
 }
 
 func (s *testService) EvaluateAccess(ctx context.Context, request AccessEvaluationRequest) (
@@ -48,6 +52,16 @@ func (s *testService) SearchActions(ctx context.Context, request AccessActionSea
 	return s.searchActions(ctx, request)
 }
 
+func (s *testService) SearchSubjects(ctx context.Context, request AccessSubjectSearchRequest) (
+	*AccessSubjectSearchResponse, *tidcommon.ServiceError) {
+	return s.searchSubjects(ctx, request)
+}
+
+func (s *testService) SearchResources(ctx context.Context, request AccessResourceSearchRequest) (
+	*AccessResourceSearchResponse, *tidcommon.ServiceError) {
+	return s.searchResources(ctx, request)
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
@@ -73,6 +87,8 @@ func (s *HandlerTestSuite) TestHandleMetadataRequestSuccess() {
 	s.Equal("https://pdp.example.com", resp.PolicyDecisionPoint)
 	s.Equal("https://pdp.example.com/access/v1/evaluation", resp.AccessEvaluationEndpoint)
 	s.Equal("https://pdp.example.com/access/v1/evaluations", resp.AccessEvaluationsEndpoint)
+	s.Equal("https://pdp.example.com/access/v1/search/subject", resp.SearchSubjectEndpoint)
+	s.Equal("https://pdp.example.com/access/v1/search/resource", resp.SearchResourceEndpoint)
 	s.Equal("https://pdp.example.com/access/v1/search/action", resp.SearchActionEndpoint)
 }
 
@@ -217,3 +233,79 @@ func (s *HandlerTestSuite) TestHandleActionSearchRequestServiceError() {
 	s.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
 	s.Equal(ErrorMissingResource.Error.DefaultValue, resp["error"])
 }
+
+func (s *HandlerTestSuite) TestHandleSubjectSearchRequestSuccess() {
+	h := newHandler(&testService{
+		searchSubjects: func(_ context.Context, request AccessSubjectSearchRequest) (
+			*AccessSubjectSearchResponse, *tidcommon.ServiceError) {
+			s.Equal("booking1", request.Resource.ID)
+			return &AccessSubjectSearchResponse{
+				Results: []Subject{{Type: "user", ID: "user1"}},
+			}, nil
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/access/v1/search/subject",
+		strings.NewReader(
+			`{"subject":{"type":"user"},"resource":{"type":"booking","id":"booking1"},"action":{"name":"read"}}`))
+	w := httptest.NewRecorder()
+
+	h.HandleSubjectSearchRequest(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	var resp AccessSubjectSearchResponse
+	s.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	s.Equal([]Subject{{Type: "user", ID: "user1"}}, resp.Results)
+}
+
+func (s *HandlerTestSuite) TestHandleSubjectSearchRequestInvalidJSON() {
+	h := newHandler(&testService{})
+	req := httptest.NewRequest(http.MethodPost, "/access/v1/search/subject", strings.NewReader(`{`))
+	w := httptest.NewRecorder()
+
+	h.HandleSubjectSearchRequest(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleResourceSearchRequestSuccess() {
+	h := newHandler(&testService{
+		searchResources: func(_ context.Context, request AccessResourceSearchRequest) (
+			*AccessResourceSearchResponse, *tidcommon.ServiceError) {
+			s.Equal("user1", request.Subject.ID)
+			return &AccessResourceSearchResponse{
+				Results: []Resource{{Type: "booking", ID: "booking1"}},
+			}, nil
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/access/v1/search/resource",
+		strings.NewReader(`{"subject":{"type":"user","id":"user1"},"resource":{"type":"booking"},"action":{"name":"read"}}`))
+	w := httptest.NewRecorder()
+
+	h.HandleResourceSearchRequest(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	var resp AccessResourceSearchResponse
+	s.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	s.Equal([]Resource{{Type: "booking", ID: "booking1"}}, resp.Results)
+}
+
+func (s *HandlerTestSuite) TestHandleResourceSearchRequestServiceError() {
+	h := newHandler(&testService{
+		searchResources: func(_ context.Context, _ AccessResourceSearchRequest) (
+			*AccessResourceSearchResponse, *tidcommon.ServiceError) {
+			return nil, &ErrorMissingAction
+		},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/access/v1/search/resource",
+		strings.NewReader(`{"subject":{"id":"user1"},"resource":{"type":"booking"}}`))
+	w := httptest.NewRecorder()
+
+	h.HandleResourceSearchRequest(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+	var resp map[string]interface{}
+	s.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	s.Equal(ErrorMissingAction.Error.DefaultValue, resp["error"])
+}
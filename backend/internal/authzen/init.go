@@ -6,6 +6,7 @@ package authzen
 import (
 	"net/http"
 
+	"github.com/thunder-id/thunderid/internal/authz/rebac"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -22,11 +23,12 @@ type directAuthGuardProvider interface {
 func Initialize(
 	mux *http.ServeMux,
 	authzService providers.AuthorizationProvider,
+	rebacService rebac.ReBACServiceInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	resourceService resource.ResourceServiceInterface,
 	directAuthGuard directAuthGuardProvider,
 ) AuthZENServiceInterface {
-	service := newService(authzService, entityProvider, resourceService)
+	service := newService(authzService, rebacService, entityProvider, resourceService)
 	handler := newHandler(service)
 	registerRoutes(mux, handler, directAuthGuard)
 	return service
@@ -71,6 +73,16 @@ func registerRoutes(mux *http.ServeMux, h *handler, guard directAuthGuardProvide
 		func(w http.ResponseWriter, _ *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts))
+	directRoute("POST /access/v1/search/subject", h.HandleSubjectSearchRequest)
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /access/v1/search/subject",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	directRoute("POST /access/v1/search/resource", h.HandleResourceSearchRequest)
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /access/v1/search/resource",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
 	directRoute("POST /access/v1/search/action", h.HandleActionSearchRequest)
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /access/v1/search/action",
 		func(w http.ResponseWriter, _ *http.Request) {
@@ -11,7 +11,8 @@ type Subject struct {
 }
 
 // Resource identifies the protected resource in an AuthZEN access evaluation request.
-// Type is the ThunderID resource server identifier. ID is reserved for future instance-based authorization.
+// Type is the ThunderID resource server identifier. ID optionally names an individual object of that
+// type, which the ReBAC engine evaluates against relation tuples of the same object type.
 type Resource struct {
 	Type       string                 `json:"type"`
 	ID         string                 `json:"id"`
@@ -60,10 +61,40 @@ type AccessSearchResponse struct {
 	Results []Action `json:"results"`
 }
 
+// AccessSubjectSearchRequest represents an AuthZEN subject search request. Only the subject type is
+// read from the subject.
+type AccessSubjectSearchRequest struct {
+	Subject  Subject                `json:"subject"`
+	Resource Resource               `json:"resource"`
+	Action   Action                 `json:"action"`
+	Context  map[string]interface{} `json:"context,omitempty"`
+}
+
+// AccessSubjectSearchResponse represents an AuthZEN subject search response.
+type AccessSubjectSearchResponse struct {
+	Results []Subject `json:"results"`
+}
+
+// AccessResourceSearchRequest represents an AuthZEN resource search request. Only the resource type
+// is read from the resource.
+type AccessResourceSearchRequest struct {
+	Subject  Subject                `json:"subject"`
+	Resource Resource               `json:"resource"`
+	Action   Action                 `json:"action"`
+	Context  map[string]interface{} `json:"context,omitempty"`
+}
+
+// AccessResourceSearchResponse represents an AuthZEN resource search response.
+type AccessResourceSearchResponse struct {
+	Results []Resource `json:"results"`
+}
+
 // MetadataResponse represents AuthZEN PDP metadata.
 type MetadataResponse struct {
 	PolicyDecisionPoint       string `json:"policy_decision_point"`
 	AccessEvaluationEndpoint  string `json:"access_evaluation_endpoint"`
 	AccessEvaluationsEndpoint string `json:"access_evaluations_endpoint,omitempty"`
+	SearchSubjectEndpoint     string `json:"search_subject_endpoint,omitempty"`
+	SearchResourceEndpoint    string `json:"search_resource_endpoint,omitempty"`
 	SearchActionEndpoint      string `json:"search_action_endpoint,omitempty"`
 }
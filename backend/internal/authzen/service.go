@@ -13,6 +13,7 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
+	"github.com/thunder-id/thunderid/internal/authz/rebac"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/resource"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
@@ -30,11 +31,18 @@ type AuthZENServiceInterface interface {
 	// SearchActions returns the actions allowed for a subject and resource.
 	SearchActions(ctx context.Context, request AccessActionSearchRequest) (
 		*AccessSearchResponse, *tidcommon.ServiceError)
+	// SearchSubjects returns the subjects allowed to perform an action on a resource.
+	SearchSubjects(ctx context.Context, request AccessSubjectSearchRequest) (
+		*AccessSubjectSearchResponse, *tidcommon.ServiceError)
+	// SearchResources returns the resources of a type on which a subject may perform an action.
+	SearchResources(ctx context.Context, request AccessResourceSearchRequest) (
+		*AccessResourceSearchResponse, *tidcommon.ServiceError)
 }
 
 // authzenService adapts AuthZEN requests to Thunder authorization services.
 type authzenService struct {
 	authzService    providers.AuthorizationProvider
+	rebacService    rebac.ReBACServiceInterface
 	entityProvider  entityprovider.EntityProviderInterface
 	resourceService resource.ResourceServiceInterface
 	logger          *log.Logger
@@ -43,11 +51,13 @@ type authzenService struct {
 // newService creates an AuthZEN service with its dependent services.
 func newService(
 	authzService providers.AuthorizationProvider,
+	rebacService rebac.ReBACServiceInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	resourceService resource.ResourceServiceInterface,
 ) AuthZENServiceInterface {
 	return &authzenService{
 		authzService:    authzService,
+		rebacService:    rebacService,
 		entityProvider:  entityProvider,
 		resourceService: resourceService,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthZENService")),
@@ -303,6 +313,190 @@ func (s *authzenService) SearchActions(ctx context.Context, request AccessAction
 	return &AccessSearchResponse{Results: results}, nil
 }
 
+// SearchSubjects returns the subjects of the requested type that are authorized to perform the action
+// on the resource. Candidates are the subjects related to the resource through relation tuples, where
+// the action names the relation; each candidate is then evaluated by the configured authorization
+// engines so that the results match what an access evaluation would decide.
+func (s *authzenService) SearchSubjects(ctx context.Context, request AccessSubjectSearchRequest) (
+	*AccessSubjectSearchResponse, *tidcommon.ServiceError) {
+	if strings.TrimSpace(request.Resource.Type) == "" {
+		return nil, &ErrorMissingResource
+	}
+	if strings.TrimSpace(request.Resource.ID) == "" {
+		return nil, &ErrorMissingResourceID
+	}
+	if strings.TrimSpace(request.Action.Name) == "" {
+		return nil, &ErrorMissingAction
+	}
+	subjectType := request.Subject.Type
+	if strings.TrimSpace(subjectType) == "" {
+		subjectType = rebac.DefaultSubjectType
+	}
+
+	resourceServerID, svcErr := s.resolveSearchTarget(ctx, request.Resource.Type, request.Action.Name)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if s.rebacService == nil {
+		return &AccessSubjectSearchResponse{Results: []Subject{}}, nil
+	}
+
+	listed, svcErr := s.rebacService.ListSubjects(ctx, rebac.ListSubjectsRequest{
+		Object:      rebac.ObjectReference{Type: request.Resource.Type, ID: request.Resource.ID},
+		Relation:    request.Action.Name,
+		SubjectType: subjectType,
+	})
+	if svcErr != nil {
+		if isUndefinedRelationError(svcErr) {
+			return &AccessSubjectSearchResponse{Results: []Subject{}}, nil
+		}
+		s.logger.Error(ctx, "Failed to list related subjects",
+			log.String("resourceType", request.Resource.Type),
+			log.String("error", svcErr.Error.DefaultValue))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	evaluations := make([]providers.AccessEvaluationRequest, 0, len(listed.Subjects))
+	for _, subjectID := range listed.Subjects {
+		groupIDs, svcErr := s.resolveGroupIDs(ctx, subjectID)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		evaluations = append(evaluations, toAuthzAccessEvaluationRequest(AccessEvaluationRequest{
+			Subject:  Subject{Type: subjectType, ID: subjectID},
+			Resource: request.Resource,
+			Action:   request.Action,
+			Context:  request.Context,
+		}, groupIDs, resourceServerID))
+	}
+
+	decisions, svcErr := s.evaluateCandidates(ctx, evaluations)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	results := make([]Subject, 0, len(decisions))
+	for i, decision := range decisions {
+		if decision {
+			results = append(results, Subject{Type: subjectType, ID: listed.Subjects[i]})
+		}
+	}
+	return &AccessSubjectSearchResponse{Results: results}, nil
+}
+
+// SearchResources returns the resources of the requested type on which the subject is authorized to
+// perform the action. Candidates are the objects of the type the subject is related to through
+// relation tuples, where the action names the relation; each candidate is then evaluated by the
+// configured authorization engines so that the results match what an access evaluation would decide.
+func (s *authzenService) SearchResources(ctx context.Context, request AccessResourceSearchRequest) (
+	*AccessResourceSearchResponse, *tidcommon.ServiceError) {
+	if strings.TrimSpace(request.Subject.ID) == "" {
+		return nil, &ErrorMissingSubject
+	}
+	if strings.TrimSpace(request.Resource.Type) == "" {
+		return nil, &ErrorMissingResource
+	}
+	if strings.TrimSpace(request.Action.Name) == "" {
+		return nil, &ErrorMissingAction
+	}
+	if svcErr := s.validateSubject(ctx, request.Subject); svcErr != nil {
+		return nil, svcErr
+	}
+
+	resourceServerID, svcErr := s.resolveSearchTarget(ctx, request.Resource.Type, request.Action.Name)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if s.rebacService == nil {
+		return &AccessResourceSearchResponse{Results: []Resource{}}, nil
+	}
+
+	groupIDs, svcErr := s.resolveGroupIDs(ctx, request.Subject.ID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	listed, svcErr := s.rebacService.ListObjects(ctx, rebac.ListObjectsRequest{
+		ObjectType: request.Resource.Type,
+		Relation:   request.Action.Name,
+		Subject:    rebac.SubjectReference{Type: request.Subject.Type, ID: request.Subject.ID},
+		GroupIDs:   groupIDs,
+	})
+	if svcErr != nil {
+		if isUndefinedRelationError(svcErr) {
+			return &AccessResourceSearchResponse{Results: []Resource{}}, nil
+		}
+		s.logger.Error(ctx, "Failed to list related resources",
+			log.MaskedString(log.LoggerKeyUserID, request.Subject.ID),
+			log.String("error", svcErr.Error.DefaultValue))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	evaluations := make([]providers.AccessEvaluationRequest, 0, len(listed.Objects))
+	for _, objectID := range listed.Objects {
+		evaluations = append(evaluations, toAuthzAccessEvaluationRequest(AccessEvaluationRequest{
+			Subject:  request.Subject,
+			Resource: Resource{Type: request.Resource.Type, ID: objectID, Properties: request.Resource.Properties},
+			Action:   request.Action,
+			Context:  request.Context,
+		}, groupIDs, resourceServerID))
+	}
+
+	decisions, svcErr := s.evaluateCandidates(ctx, evaluations)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	results := make([]Resource, 0, len(decisions))
+	for i, decision := range decisions {
+		if decision {
+			results = append(results, Resource{Type: request.Resource.Type, ID: listed.Objects[i]})
+		}
+	}
+	return &AccessResourceSearchResponse{Results: results}, nil
+}
+
+// resolveSearchTarget resolves the resource server of a search and verifies the action is registered on it.
+func (s *authzenService) resolveSearchTarget(ctx context.Context, resourceType, actionName string) (
+	string, *tidcommon.ServiceError) {
+	resourceServerID, svcErr := s.resolveResourceServerID(ctx, resourceType)
+	if svcErr != nil {
+		return "", svcErr
+	}
+	if svcErr := s.validateAction(ctx, resourceServerID, actionName); svcErr != nil {
+		return "", svcErr
+	}
+	return resourceServerID, nil
+}
+
+// evaluateCandidates evaluates search candidates in one batch and returns their decisions in order.
+func (s *authzenService) evaluateCandidates(ctx context.Context, evaluations []providers.AccessEvaluationRequest) (
+	[]bool, *tidcommon.ServiceError) {
+	decisions := make([]bool, len(evaluations))
+	if len(evaluations) == 0 {
+		return decisions, nil
+	}
+
+	authzResp, svcErr := s.authzService.EvaluateAccessBatch(ctx, providers.AccessEvaluationsRequest{
+		Evaluations: evaluations,
+	})
+	if svcErr != nil {
+		s.logger.Error(ctx, "Authorization search evaluation failed",
+			log.Int("evaluationCount", len(evaluations)),
+			log.String("error", svcErr.Error.DefaultValue))
+		return nil, &tidcommon.InternalServerError
+	}
+	for i, evaluation := range authzResp.Evaluations {
+		if i < len(decisions) {
+			decisions[i] = evaluation.Decision
+		}
+	}
+	return decisions, nil
+}
+
+// isUndefinedRelationError reports whether the relationship service rejected a query because the
+// authorization model does not define the resource type or relation, in which case nothing matches.
+func isUndefinedRelationError(svcErr *tidcommon.ServiceError) bool {
+	return svcErr.Code == rebac.ErrorTypeNotFound.Code || svcErr.Code == rebac.ErrorUnknownRelation.Code
+}
+
 // getAllPermissionActions returns unique permission-backed actions for a resource server.
 func (s *authzenService) getAllPermissionActions(
 	ctx context.Context,
@@ -546,6 +740,10 @@ func toAuthzAccessEvaluationRequest(
 	groupIDs []string,
 	resourceServerID string,
 ) providers.AccessEvaluationRequest {
+	var resourceRef *providers.AccessEvaluationResource
+	if strings.TrimSpace(request.Resource.ID) != "" {
+		resourceRef = &providers.AccessEvaluationResource{Type: request.Resource.Type, ID: request.Resource.ID}
+	}
 	return providers.AccessEvaluationRequest{
 		Subject: providers.Subject{
 			Type:       request.Subject.Type,
@@ -557,6 +755,7 @@ func toAuthzAccessEvaluationRequest(
 			ID:         resourceServerID,
 			Properties: request.Resource.Properties,
 		},
+		Resource: resourceRef,
 		Permission: providers.Permission{
 			Name:       request.Action.Name,
 			Properties: request.Action.Properties,
@@ -13,9 +13,11 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/authz/rebac"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/resource"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/tests/mocks/authz/rebacmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authzmock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
@@ -32,6 +34,7 @@ const (
 type ServiceTestSuite struct {
 	suite.Suite
 	authzMock          *authzmock.AuthorizationProviderMock
+	rebacMock          *rebacmock.ReBACServiceInterfaceMock
 	entityProviderMock *entityprovidermock.EntityProviderInterfaceMock
 	resourceMock       *resourcemock.ResourceServiceInterfaceMock
 	service            AuthZENServiceInterface
@@ -43,9 +46,10 @@ func TestServiceTestSuite(t *testing.T) {
 
 func (s *ServiceTestSuite) SetupTest() {
 	s.authzMock = authzmock.NewAuthorizationProviderMock(s.T())
+	s.rebacMock = rebacmock.NewReBACServiceInterfaceMock(s.T())
 	s.entityProviderMock = entityprovidermock.NewEntityProviderInterfaceMock(s.T())
 	s.resourceMock = resourcemock.NewResourceServiceInterfaceMock(s.T())
-	s.service = newService(s.authzMock, s.entityProviderMock, s.resourceMock)
+	s.service = newService(s.authzMock, s.rebacMock, s.entityProviderMock, s.resourceMock)
 }
 
 func (s *ServiceTestSuite) mockValidSubject() {
@@ -83,6 +87,7 @@ func (s *ServiceTestSuite) TestEvaluateAccessAllowed() {
 	s.authzMock.On("EvaluateAccess", mock.Anything, providers.AccessEvaluationRequest{
 		Subject:        providers.Subject{Type: "user", ID: "user1", GroupIDs: []string{"group1", "group2"}},
 		ResourceServer: providers.AccessEvaluationResourceServer{ID: testResourceServerID},
+		Resource:       &providers.AccessEvaluationResource{Type: "booking", ID: testBookingResourceID},
 		Permission:     providers.Permission{Name: testBookingReadAction},
 	}).Return(&providers.AccessEvaluationResponse{Decision: true}, nil)
 
@@ -130,6 +135,7 @@ func (s *ServiceTestSuite) TestEvaluateAccessPassesPropertiesToAuthz() {
 			ID:         testResourceServerID,
 			Properties: resourceProperties,
 		},
+		Resource: &providers.AccessEvaluationResource{Type: "booking", ID: testBookingResourceID},
 		Permission: providers.Permission{
 			Name:       testBookingReadAction,
 			Properties: actionProperties,
@@ -157,6 +163,7 @@ func (s *ServiceTestSuite) TestEvaluateAccessDenied() {
 	s.authzMock.On("EvaluateAccess", mock.Anything, providers.AccessEvaluationRequest{
 		Subject:        providers.Subject{Type: "user", ID: "user1", GroupIDs: []string{}},
 		ResourceServer: providers.AccessEvaluationResourceServer{ID: testResourceServerID},
+		Resource:       &providers.AccessEvaluationResource{Type: "booking", ID: testBookingResourceID},
 		Permission:     providers.Permission{Name: "booking:delete"},
 	}).Return(&providers.AccessEvaluationResponse{Decision: false}, nil)
 
@@ -190,6 +197,7 @@ func (s *ServiceTestSuite) TestEvaluateAccessProviderNotImplementedUsesEmptyGrou
 	s.authzMock.On("EvaluateAccess", mock.Anything, providers.AccessEvaluationRequest{
 		Subject:        providers.Subject{Type: "app", ID: "app1", GroupIDs: []string{}},
 		ResourceServer: providers.AccessEvaluationResourceServer{ID: testResourceServerID},
+		Resource:       &providers.AccessEvaluationResource{Type: "report", ID: "report1"},
 		Permission:     providers.Permission{Name: "report:read"},
 	}).Return(&providers.AccessEvaluationResponse{Decision: true}, nil)
 
@@ -213,6 +221,7 @@ func (s *ServiceTestSuite) TestEvaluateAccessSkipsSubjectValidationWhenTypeEmpty
 	s.authzMock.On("EvaluateAccess", mock.Anything, providers.AccessEvaluationRequest{
 		Subject:        providers.Subject{ID: "user1", GroupIDs: []string{}},
 		ResourceServer: providers.AccessEvaluationResourceServer{ID: testResourceServerID},
+		Resource:       &providers.AccessEvaluationResource{Type: "booking", ID: testBookingResourceID},
 		Permission:     providers.Permission{Name: testBookingReadAction},
 	}).Return(&providers.AccessEvaluationResponse{Decision: true}, nil)
 
@@ -261,6 +270,7 @@ func (s *ServiceTestSuite) TestEvaluateAccessAuthorizationFailure() {
 	s.authzMock.On("EvaluateAccess", mock.Anything, providers.AccessEvaluationRequest{
 		Subject:        providers.Subject{Type: "user", ID: "user1", GroupIDs: []string{}},
 		ResourceServer: providers.AccessEvaluationResourceServer{ID: testResourceServerID},
+		Resource:       &providers.AccessEvaluationResource{Type: "booking", ID: testBookingResourceID},
 		Permission:     providers.Permission{Name: testBookingReadAction},
 	}).Return((*providers.AccessEvaluationResponse)(nil), &tidcommon.InternalServerError)
 
@@ -933,6 +943,224 @@ func (s *ServiceTestSuite) TestSearchActionsAuthorizationServiceError() {
 	s.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
 }
 
+func (s *ServiceTestSuite) TestSearchResourcesReturnsConfirmedResources() {
+	req := AccessResourceSearchRequest{
+		Subject:  Subject{Type: "user", ID: "user1"},
+		Resource: Resource{Type: "booking"},
+		Action:   Action{Name: testBookingReadAction},
+	}
+
+	s.mockValidSubject()
+	s.mockResourceServerIdentifier("booking")
+	s.mockValidAction(testBookingReadAction)
+	s.entityProviderMock.On("GetTransitiveEntityGroups", "user1").Return([]providers.EntityGroup{
+		{ID: "group1"},
+	}, nil)
+	s.rebacMock.On("ListObjects", mock.Anything, rebac.ListObjectsRequest{
+		ObjectType: "booking",
+		Relation:   testBookingReadAction,
+		Subject:    rebac.SubjectReference{Type: "user", ID: "user1"},
+		GroupIDs:   []string{"group1"},
+	}).Return(&rebac.ListObjectsResponse{Objects: []string{"booking1", "booking2"}}, nil)
+	s.authzMock.On("EvaluateAccessBatch", mock.Anything,
+		mock.MatchedBy(func(req providers.AccessEvaluationsRequest) bool {
+			return len(req.Evaluations) == 2 &&
+				req.Evaluations[0].Resource.ID == "booking1" &&
+				req.Evaluations[1].Resource.ID == "booking2" &&
+				req.Evaluations[1].Resource.Type == "booking" &&
+				req.Evaluations[1].ResourceServer.ID == testResourceServerID &&
+				req.Evaluations[1].Subject.GroupIDs[0] == "group1"
+		})).
+		Return(&providers.AccessEvaluationsResponse{
+			Evaluations: []providers.AccessEvaluationResponse{
+				{Decision: true},
+				{Decision: false},
+			},
+		}, nil)
+
+	resp, svcErr := s.service.SearchResources(context.Background(), req)
+
+	s.Nil(svcErr)
+	s.Equal([]Resource{{Type: "booking", ID: "booking1"}}, resp.Results)
+}
+
+func (s *ServiceTestSuite) TestSearchResourcesUndefinedTypeReturnsEmptyResults() {
+	req := AccessResourceSearchRequest{
+		Subject:  Subject{ID: "user1"},
+		Resource: Resource{Type: "booking"},
+		Action:   Action{Name: testBookingReadAction},
+	}
+
+	s.mockResourceServerIdentifier("booking")
+	s.mockValidAction(testBookingReadAction)
+	s.entityProviderMock.On("GetTransitiveEntityGroups", "user1").Return([]providers.EntityGroup{}, nil)
+	s.rebacMock.On("ListObjects", mock.Anything, mock.Anything).
+		Return((*rebac.ListObjectsResponse)(nil), &rebac.ErrorTypeNotFound)
+
+	resp, svcErr := s.service.SearchResources(context.Background(), req)
+
+	s.Nil(svcErr)
+	s.Empty(resp.Results)
+	s.authzMock.AssertNotCalled(s.T(), "EvaluateAccessBatch", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestSearchResourcesValidationErrors() {
+	tests := []struct {
+		name string
+		req  AccessResourceSearchRequest
+		code string
+	}{
+		{
+			name: "missing subject",
+			req: AccessResourceSearchRequest{
+				Resource: Resource{Type: "booking"},
+				Action:   Action{Name: testBookingReadAction},
+			},
+			code: ErrorMissingSubject.Code,
+		},
+		{
+			name: "missing resource",
+			req: AccessResourceSearchRequest{
+				Subject: Subject{ID: "user1"},
+				Action:  Action{Name: testBookingReadAction},
+			},
+			code: ErrorMissingResource.Code,
+		},
+		{
+			name: "missing action",
+			req: AccessResourceSearchRequest{
+				Subject:  Subject{ID: "user1"},
+				Resource: Resource{Type: "booking"},
+			},
+			code: ErrorMissingAction.Code,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, svcErr := s.service.SearchResources(context.Background(), tc.req)
+
+			s.Nil(resp)
+			s.NotNil(svcErr)
+			s.Equal(tc.code, svcErr.Code)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestSearchResourcesInvalidAction() {
+	req := AccessResourceSearchRequest{
+		Subject:  Subject{ID: "user1"},
+		Resource: Resource{Type: "booking"},
+		Action:   Action{Name: "booking:unknown"},
+	}
+
+	s.mockResourceServerIdentifier("booking")
+	s.resourceMock.On("ValidatePermissions", mock.Anything, testResourceServerID, []string{"booking:unknown"}).
+		Return([]string{"booking:unknown"}, nil)
+
+	resp, svcErr := s.service.SearchResources(context.Background(), req)
+
+	s.Nil(resp)
+	s.NotNil(svcErr)
+	s.Equal(ErrorInvalidAction.Code, svcErr.Code)
+}
+
+func (s *ServiceTestSuite) TestSearchSubjectsReturnsConfirmedSubjects() {
+	req := AccessSubjectSearchRequest{
+		Subject:  Subject{Type: "user"},
+		Resource: Resource{Type: "booking", ID: testBookingResourceID},
+		Action:   Action{Name: testBookingReadAction},
+	}
+
+	s.mockResourceServerIdentifier("booking")
+	s.mockValidAction(testBookingReadAction)
+	s.rebacMock.On("ListSubjects", mock.Anything, rebac.ListSubjectsRequest{
+		Object:      rebac.ObjectReference{Type: "booking", ID: testBookingResourceID},
+		Relation:    testBookingReadAction,
+		SubjectType: "user",
+	}).Return(&rebac.ListSubjectsResponse{Subjects: []string{"user1", "user2"}}, nil)
+	s.entityProviderMock.On("GetTransitiveEntityGroups", "user1").Return([]providers.EntityGroup{}, nil)
+	s.entityProviderMock.On("GetTransitiveEntityGroups", "user2").Return([]providers.EntityGroup{
+		{ID: "group1"},
+	}, nil)
+	s.authzMock.On("EvaluateAccessBatch", mock.Anything,
+		mock.MatchedBy(func(req providers.AccessEvaluationsRequest) bool {
+			return len(req.Evaluations) == 2 &&
+				req.Evaluations[0].Subject.ID == "user1" &&
+				req.Evaluations[1].Subject.ID == "user2" &&
+				req.Evaluations[1].Subject.GroupIDs[0] == "group1" &&
+				req.Evaluations[1].Resource.ID == testBookingResourceID
+		})).
+		Return(&providers.AccessEvaluationsResponse{
+			Evaluations: []providers.AccessEvaluationResponse{
+				{Decision: false},
+				{Decision: true},
+			},
+		}, nil)
+
+	resp, svcErr := s.service.SearchSubjects(context.Background(), req)
+
+	s.Nil(svcErr)
+	s.Equal([]Subject{{Type: "user", ID: "user2"}}, resp.Results)
+}
+
+func (s *ServiceTestSuite) TestSearchSubjectsValidationErrors() {
+	tests := []struct {
+		name string
+		req  AccessSubjectSearchRequest
+		code string
+	}{
+		{
+			name: "missing resource",
+			req:  AccessSubjectSearchRequest{Action: Action{Name: testBookingReadAction}},
+			code: ErrorMissingResource.Code,
+		},
+		{
+			name: "missing resource id",
+			req: AccessSubjectSearchRequest{
+				Resource: Resource{Type: "booking"},
+				Action:   Action{Name: testBookingReadAction},
+			},
+			code: ErrorMissingResourceID.Code,
+		},
+		{
+			name: "missing action",
+			req: AccessSubjectSearchRequest{
+				Resource: Resource{Type: "booking", ID: testBookingResourceID},
+			},
+			code: ErrorMissingAction.Code,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, svcErr := s.service.SearchSubjects(context.Background(), tc.req)
+
+			s.Nil(resp)
+			s.NotNil(svcErr)
+			s.Equal(tc.code, svcErr.Code)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestSearchSubjectsRelationshipServiceError() {
+	req := AccessSubjectSearchRequest{
+		Resource: Resource{Type: "booking", ID: testBookingResourceID},
+		Action:   Action{Name: testBookingReadAction},
+	}
+
+	s.mockResourceServerIdentifier("booking")
+	s.mockValidAction(testBookingReadAction)
+	s.rebacMock.On("ListSubjects", mock.Anything, mock.Anything).
+		Return((*rebac.ListSubjectsResponse)(nil), &tidcommon.InternalServerError)
+
+	resp, svcErr := s.service.SearchSubjects(context.Background(), req)
+
+	s.Nil(resp)
+	s.NotNil(svcErr)
+	s.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
 func (s *ServiceTestSuite) assertDecisionContext(context map[string]interface{}) {
 	s.NotNil(context)
 	s.Equal("Subject is not authorized to perform the requested action", context["reason"])
@@ -0,0 +1,39 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package captcha
+
+import "time"
+
+// providerType identifies a built-in captcha provider.
+type providerType string
+
+const (
+	// providerTypeRecaptchaV3 is Google reCAPTCHA v3, which scores every token.
+	providerTypeRecaptchaV3 providerType = "recaptcha_v3"
+	// providerTypeHCaptcha is hCaptcha.
+	providerTypeHCaptcha providerType = "hcaptcha"
+	// providerTypeTurnstile is Cloudflare Turnstile.
+	providerTypeTurnstile providerType = "turnstile"
+)
+
+// Default siteverify endpoints of the built-in providers.
+const (
+	recaptchaVerifyURL = "https://www.google.com/recaptcha/api/siteverify"
+	hcaptchaVerifyURL  = "https://api.hcaptcha.com/siteverify"
+	turnstileVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+)
+
+// defaultTimeout is the siteverify request timeout used when none is configured.
+const defaultTimeout = 5 * time.Second
+
+const loggerComponentName = "CaptchaProvider"
+
+// misconfigurationErrorCodes are siteverify error codes that indicate a server-side configuration
+// problem rather than an invalid token. They are common to all built-in providers.
+var misconfigurationErrorCodes = map[string]struct{}{
+	"missing-input-secret": {},
+	"invalid-input-secret": {},
+	"bad-request":          {},
+	"internal-error":       {},
+}
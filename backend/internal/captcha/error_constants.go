@@ -0,0 +1,23 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package captcha
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// ErrorVerificationUnavailable is the error returned when the captcha provider could not be reached
+// or rejected the verification request due to a server-side misconfiguration.
+var ErrorVerificationUnavailable = tidcommon.ServiceError{
+	Code: "CPT-5001",
+	Type: tidcommon.ServerErrorType,
+	Error: tidcommon.I18nMessage{
+		Key:          "error.captchaservice.internal_server_error",
+		DefaultValue: "Internal server error",
+	},
+	ErrorDescription: tidcommon.I18nMessage{
+		Key:          "error.captchaservice.verification_unavailable_description",
+		DefaultValue: "The captcha token could not be verified with the captcha provider",
+	},
+}
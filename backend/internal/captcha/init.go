@@ -0,0 +1,75 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package captcha
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize creates the built-in captcha validation provider configured in cfg. It returns nil
+// when no provider is configured, leaving the captcha interceptor unregistered. The provider is
+// read from the deployment configuration only. Captcha vendors are not registered under
+// /connections, because connections are backed by the IdP and notification sender stores and
+// neither can hold captcha settings.
+func Initialize(cfg config.CaptchaConfig) (providers.CaptchaValidationProvider, error) {
+	if cfg.Provider == "" {
+		return nil, nil
+	}
+	provider, err := newSiteVerifyProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// newSiteVerifyProvider validates cfg and creates a siteverify provider for it.
+func newSiteVerifyProvider(cfg config.CaptchaConfig) (*siteVerifyProvider, error) {
+	pType := providerType(cfg.Provider)
+	var verifyURL string
+	switch pType {
+	case providerTypeRecaptchaV3:
+		verifyURL = recaptchaVerifyURL
+	case providerTypeHCaptcha:
+		verifyURL = hcaptchaVerifyURL
+	case providerTypeTurnstile:
+		verifyURL = turnstileVerifyURL
+	default:
+		return nil, fmt.Errorf("unsupported captcha provider: %s", cfg.Provider)
+	}
+	if cfg.VerifyURL != "" {
+		verifyURL = cfg.VerifyURL
+	}
+
+	if cfg.SecretKey == "" {
+		return nil, errors.New("captcha secret key is required")
+	}
+	if cfg.MinScore < 0 || cfg.MinScore > 1 || cfg.StepUpScore < 0 || cfg.StepUpScore > 1 {
+		return nil, errors.New("captcha scores must be between 0 and 1")
+	}
+
+	timeout := defaultTimeout
+	if cfg.TimeoutSeconds > 0 {
+		timeout = time.Duration(cfg.TimeoutSeconds) * time.Second
+	}
+
+	return &siteVerifyProvider{
+		providerType:      pType,
+		verifyURL:         verifyURL,
+		secretKey:         cfg.SecretKey,
+		minScore:          cfg.MinScore,
+		stepUpScore:       cfg.StepUpScore,
+		expectedHostnames: cfg.ExpectedHostnames,
+		expectedAction:    cfg.ExpectedAction,
+		httpClient:        syshttp.NewHTTPClientWithTimeout(timeout),
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName),
+			log.String("provider", cfg.Provider)),
+	}, nil
+}
@@ -0,0 +1,14 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package captcha
+
+// siteVerifyResponse is the siteverify response body shared by reCAPTCHA, hCaptcha and Turnstile.
+// Score is only returned by score-based providers.
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	Score      *float64 `json:"score,omitempty"`
+	Action     string   `json:"action,omitempty"`
+	Hostname   string   `json:"hostname,omitempty"`
+	ErrorCodes []string `json:"error-codes,omitempty"`
+}
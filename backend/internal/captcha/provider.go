@@ -0,0 +1,129 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package captcha provides the built-in reCAPTCHA v3, hCaptcha and Cloudflare Turnstile
+// implementations of the captcha validation provider.
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// siteVerifyProvider verifies captcha tokens against a siteverify endpoint. reCAPTCHA, hCaptcha and
+// Turnstile share the same protocol: the secret and token are posted as a form, and the verdict is
+// returned as JSON.
+type siteVerifyProvider struct {
+	providerType      providerType
+	verifyURL         string
+	secretKey         string
+	minScore          float64
+	stepUpScore       float64
+	expectedHostnames []string
+	expectedAction    string
+	httpClient        syshttp.HTTPClientInterface
+	logger            *log.Logger
+}
+
+var _ providers.CaptchaValidationProvider = (*siteVerifyProvider)(nil)
+
+// Verify validates the given captcha token with the provider. A token the provider rejects, or one
+// issued for an unexpected hostname or action, or scoring below the minimum score, is reported as a
+// negative verdict. Transport failures and provider misconfiguration are returned as server errors.
+func (p *siteVerifyProvider) Verify(ctx context.Context, token string) (
+	*providers.CaptchaVerificationResult, *tidcommon.ServiceError) {
+	resp, err := p.siteVerify(ctx, token)
+	if err != nil {
+		p.logger.Error(ctx, "Captcha siteverify request failed", log.Error(err))
+		return nil, &ErrorVerificationUnavailable
+	}
+
+	result := &providers.CaptchaVerificationResult{
+		Action:   resp.Action,
+		Hostname: resp.Hostname,
+	}
+	if !resp.Success {
+		for _, code := range resp.ErrorCodes {
+			if _, ok := misconfigurationErrorCodes[code]; ok {
+				p.logger.Error(ctx, "Captcha provider rejected the verification request",
+					log.String("errorCodes", strings.Join(resp.ErrorCodes, ",")))
+				return nil, &ErrorVerificationUnavailable
+			}
+		}
+		p.logger.Debug(ctx, "Captcha provider rejected the token",
+			log.String("errorCodes", strings.Join(resp.ErrorCodes, ",")))
+		return result, nil
+	}
+
+	if len(p.expectedHostnames) > 0 && !slices.Contains(p.expectedHostnames, resp.Hostname) {
+		p.logger.Debug(ctx, "Captcha token was issued for an unexpected hostname",
+			log.String("hostname", resp.Hostname))
+		return result, nil
+	}
+	if p.expectedAction != "" && resp.Action != p.expectedAction {
+		p.logger.Debug(ctx, "Captcha token was issued for an unexpected action",
+			log.String("action", resp.Action))
+		return result, nil
+	}
+
+	if p.providerType == providerTypeRecaptchaV3 {
+		if resp.Score == nil {
+			p.logger.Error(ctx, "Captcha provider returned no score for a score-based token")
+			return nil, &ErrorVerificationUnavailable
+		}
+		result.Score = resp.Score
+		if *resp.Score < p.minScore {
+			p.logger.Debug(ctx, "Captcha token scored below the minimum score")
+			return result, nil
+		}
+		result.StepUpRequired = *resp.Score < p.stepUpScore
+	}
+
+	result.Success = true
+	return result, nil
+}
+
+// siteVerify posts the token to the siteverify endpoint and decodes the verdict.
+func (p *siteVerifyProvider) siteVerify(ctx context.Context, token string) (*siteVerifyResponse, error) {
+	form := url.Values{}
+	form.Set("secret", p.secretKey)
+	form.Set("response", token)
+	if clientIP := syscontext.GetClientIP(ctx); clientIP != "" {
+		form.Set("remoteip", clientIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build siteverify request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpResp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("siteverify request failed: %w", err)
+	}
+	defer func() {
+		_ = httpResp.Body.Close()
+	}()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("siteverify endpoint returned status %d", httpResp.StatusCode)
+	}
+
+	var resp siteVerifyResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("failed to decode siteverify response: %w", err)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package captcha
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+type CaptchaProviderTestSuite struct {
+	suite.Suite
+	httpClient *httpmock.HTTPClientInterfaceMock
+}
+
+func TestCaptchaProviderTestSuite(t *testing.T) {
+	suite.Run(t, new(CaptchaProviderTestSuite))
+}
+
+func (suite *CaptchaProviderTestSuite) SetupSuite() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+}
+
+func (suite *CaptchaProviderTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
+func (suite *CaptchaProviderTestSuite) SetupTest() {
+	suite.httpClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+}
+
+func (suite *CaptchaProviderTestSuite) newProvider(cfg config.CaptchaConfig) *siteVerifyProvider {
+	cfg.SecretKey = "secret"
+	provider, err := newSiteVerifyProvider(cfg)
+	suite.Require().NoError(err)
+	provider.httpClient = suite.httpClient
+	return provider
+}
+
+func (suite *CaptchaProviderTestSuite) respond(status int, body string) {
+	suite.httpClient.On("Do", mock.Anything).
+		Return(&http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}, nil).Once()
+}
+
+func (suite *CaptchaProviderTestSuite) TestInitialize() {
+	testCases := []struct {
+		name      string
+		cfg       config.CaptchaConfig
+		expectNil bool
+		expectErr bool
+	}{
+		{"Disabled", config.CaptchaConfig{}, true, false},
+		{"UnsupportedProvider", config.CaptchaConfig{Provider: "friendly", SecretKey: "s"}, true, true},
+		{"MissingSecret", config.CaptchaConfig{Provider: "turnstile"}, true, true},
+		{"ScoreOutOfRange", config.CaptchaConfig{Provider: "recaptcha_v3", SecretKey: "s", MinScore: 1.5}, true, true},
+		{"ReCaptcha", config.CaptchaConfig{Provider: "recaptcha_v3", SecretKey: "s"}, false, false},
+		{"HCaptcha", config.CaptchaConfig{Provider: "hcaptcha", SecretKey: "s"}, false, false},
+		{"Turnstile", config.CaptchaConfig{Provider: "turnstile", SecretKey: "s"}, false, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			provider, err := Initialize(tc.cfg)
+			suite.Equal(tc.expectErr, err != nil)
+			suite.Equal(tc.expectNil, provider == nil)
+		})
+	}
+}
+
+func (suite *CaptchaProviderTestSuite) TestNewSiteVerifyProvider_VerifyURL() {
+	provider := suite.newProvider(config.CaptchaConfig{Provider: "hcaptcha"})
+	suite.Equal(hcaptchaVerifyURL, provider.verifyURL)
+
+	provider = suite.newProvider(config.CaptchaConfig{Provider: "turnstile", VerifyURL: "https://verify.example.com"})
+	suite.Equal("https://verify.example.com", provider.verifyURL)
+}
+
+func (suite *CaptchaProviderTestSuite) TestVerify_PostsTokenAndClientIP() {
+	provider := suite.newProvider(config.CaptchaConfig{Provider: "turnstile"})
+	suite.httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		if req.Method != http.MethodPost || req.URL.String() != turnstileVerifyURL {
+			return false
+		}
+		if err := req.ParseForm(); err != nil {
+			return false
+		}
+		return req.PostForm.Get("secret") == "secret" && req.PostForm.Get("response") == "token" &&
+			req.PostForm.Get("remoteip") == "203.0.113.7"
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"success":true,"hostname":"login.example.com"}`)),
+	}, nil).Once()
+
+	result, svcErr := provider.Verify(syscontext.WithClientIP(context.Background(), "203.0.113.7"), "token")
+
+	suite.Nil(svcErr)
+	suite.True(result.Success)
+	suite.Equal("login.example.com", result.Hostname)
+	suite.Nil(result.Score)
+	suite.False(result.StepUpRequired)
+}
+
+func (suite *CaptchaProviderTestSuite) TestVerify_Verdicts() {
+	testCases := []struct {
+		name           string
+		cfg            config.CaptchaConfig
+		body           string
+		success        bool
+		stepUpRequired bool
+	}{
+		{
+			name:    "RejectedToken",
+			cfg:     config.CaptchaConfig{Provider: "hcaptcha"},
+			body:    `{"success":false,"error-codes":["invalid-input-response"]}`,
+			success: false,
+		},
+		{
+			name:    "UnexpectedHostname",
+			cfg:     config.CaptchaConfig{Provider: "turnstile", ExpectedHostnames: []string{"login.example.com"}},
+			body:    `{"success":true,"hostname":"evil.example.com"}`,
+			success: false,
+		},
+		{
+			name:    "UnexpectedAction",
+			cfg:     config.CaptchaConfig{Provider: "recaptcha_v3", ExpectedAction: "login"},
+			body:    `{"success":true,"score":0.9,"action":"signup"}`,
+			success: false,
+		},
+		{
+			name:    "BelowMinScore",
+			cfg:     config.CaptchaConfig{Provider: "recaptcha_v3", MinScore: 0.5},
+			body:    `{"success":true,"score":0.2}`,
+			success: false,
+		},
+		{
+			name:           "BelowStepUpScore",
+			cfg:            config.CaptchaConfig{Provider: "recaptcha_v3", MinScore: 0.3, StepUpScore: 0.7},
+			body:           `{"success":true,"score":0.5,"action":"login"}`,
+			success:        true,
+			stepUpRequired: true,
+		},
+		{
+			name:    "HighScore",
+			cfg:     config.CaptchaConfig{Provider: "recaptcha_v3", MinScore: 0.3, StepUpScore: 0.7},
+			body:    `{"success":true,"score":0.9}`,
+			success: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			provider := suite.newProvider(tc.cfg)
+			suite.respond(http.StatusOK, tc.body)
+
+			result, svcErr := provider.Verify(context.Background(), "token")
+
+			suite.Nil(svcErr)
+			suite.Require().NotNil(result)
+			suite.Equal(tc.success, result.Success)
+			suite.Equal(tc.stepUpRequired, result.StepUpRequired)
+		})
+	}
+}
+
+func (suite *CaptchaProviderTestSuite) TestVerify_Failures() {
+	testCases := []struct {
+		name     string
+		provider string
+		setup    func()
+	}{
+		{"RequestError", "turnstile", func() {
+			suite.httpClient.On("Do", mock.Anything).Return(nil, errors.New("timeout")).Once()
+		}},
+		{"UnexpectedStatus", "turnstile", func() { suite.respond(http.StatusBadGateway, "") }},
+		{"MalformedBody", "hcaptcha", func() { suite.respond(http.StatusOK, "<html>") }},
+		{"InvalidSecret", "hcaptcha", func() {
+			suite.respond(http.StatusOK, `{"success":false,"error-codes":["invalid-input-secret"]}`)
+		}},
+		{"MissingScore", "recaptcha_v3", func() { suite.respond(http.StatusOK, `{"success":true}`) }},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			provider := suite.newProvider(config.CaptchaConfig{Provider: tc.provider})
+			tc.setup()
+
+			result, svcErr := provider.Verify(context.Background(), "token")
+
+			suite.Nil(result)
+			suite.Equal(&ErrorVerificationUnavailable, svcErr)
+		})
+	}
+}
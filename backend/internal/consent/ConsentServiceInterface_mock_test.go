@@ -8,6 +8,7 @@ import (
 	"context"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
 
@@ -38,6 +39,71 @@ func (_m *ConsentServiceInterfaceMock) EXPECT() *ConsentServiceInterfaceMock_Exp
 	return &ConsentServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// CheckConsentAccess provides a mock function for the type ConsentServiceInterfaceMock
+func (_mock *ConsentServiceInterfaceMock) CheckConsentAccess(ctx context.Context, consentID string, action security.Action) *common.ServiceError {
+	ret := _mock.Called(ctx, consentID, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckConsentAccess")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, security.Action) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, consentID, action)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// ConsentServiceInterfaceMock_CheckConsentAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckConsentAccess'
+type ConsentServiceInterfaceMock_CheckConsentAccess_Call struct {
+	*mock.Call
+}
+
+// CheckConsentAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - consentID string
+//   - action security.Action
+func (_e *ConsentServiceInterfaceMock_Expecter) CheckConsentAccess(ctx interface{}, consentID interface{}, action interface{}) *ConsentServiceInterfaceMock_CheckConsentAccess_Call {
+	return &ConsentServiceInterfaceMock_CheckConsentAccess_Call{Call: _e.mock.On("CheckConsentAccess", ctx, consentID, action)}
+}
+
+func (_c *ConsentServiceInterfaceMock_CheckConsentAccess_Call) Run(run func(ctx context.Context, consentID string, action security.Action)) *ConsentServiceInterfaceMock_CheckConsentAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 security.Action
+		if args[2] != nil {
+			arg2 = args[2].(security.Action)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ConsentServiceInterfaceMock_CheckConsentAccess_Call) Return(serviceError *common.ServiceError) *ConsentServiceInterfaceMock_CheckConsentAccess_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *ConsentServiceInterfaceMock_CheckConsentAccess_Call) RunAndReturn(run func(ctx context.Context, consentID string, action security.Action) *common.ServiceError) *ConsentServiceInterfaceMock_CheckConsentAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateConsent provides a mock function for the type ConsentServiceInterfaceMock
 func (_mock *ConsentServiceInterfaceMock) CreateConsent(ctx context.Context, consent *ConsentRequest) (*Consent, *common.ServiceError) {
 	ret := _mock.Called(ctx, consent)
@@ -178,6 +244,147 @@ func (_c *ConsentServiceInterfaceMock_ListPurposes_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// RevokeConsent provides a mock function for the type ConsentServiceInterfaceMock
+func (_mock *ConsentServiceInterfaceMock) RevokeConsent(ctx context.Context, consentID string, userID string) *common.ServiceError {
+	ret := _mock.Called(ctx, consentID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeConsent")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, consentID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// ConsentServiceInterfaceMock_RevokeConsent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeConsent'
+type ConsentServiceInterfaceMock_RevokeConsent_Call struct {
+	*mock.Call
+}
+
+// RevokeConsent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - consentID string
+//   - userID string
+func (_e *ConsentServiceInterfaceMock_Expecter) RevokeConsent(ctx interface{}, consentID interface{}, userID interface{}) *ConsentServiceInterfaceMock_RevokeConsent_Call {
+	return &ConsentServiceInterfaceMock_RevokeConsent_Call{Call: _e.mock.On("RevokeConsent", ctx, consentID, userID)}
+}
+
+func (_c *ConsentServiceInterfaceMock_RevokeConsent_Call) Run(run func(ctx context.Context, consentID string, userID string)) *ConsentServiceInterfaceMock_RevokeConsent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ConsentServiceInterfaceMock_RevokeConsent_Call) Return(serviceError *common.ServiceError) *ConsentServiceInterfaceMock_RevokeConsent_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *ConsentServiceInterfaceMock_RevokeConsent_Call) RunAndReturn(run func(ctx context.Context, consentID string, userID string) *common.ServiceError) *ConsentServiceInterfaceMock_RevokeConsent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAccessibleConsents provides a mock function for the type ConsentServiceInterfaceMock
+func (_mock *ConsentServiceInterfaceMock) SearchAccessibleConsents(ctx context.Context, filters ConsentFilter, action security.Action) ([]*Consent, *common.ServiceError) {
+	ret := _mock.Called(ctx, filters, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAccessibleConsents")
+	}
+
+	var r0 []*Consent
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ConsentFilter, security.Action) ([]*Consent, *common.ServiceError)); ok {
+		return returnFunc(ctx, filters, action)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ConsentFilter, security.Action) []*Consent); ok {
+		r0 = returnFunc(ctx, filters, action)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Consent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ConsentFilter, security.Action) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, filters, action)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ConsentServiceInterfaceMock_SearchAccessibleConsents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAccessibleConsents'
+type ConsentServiceInterfaceMock_SearchAccessibleConsents_Call struct {
+	*mock.Call
+}
+
+// SearchAccessibleConsents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters ConsentFilter
+//   - action security.Action
+func (_e *ConsentServiceInterfaceMock_Expecter) SearchAccessibleConsents(ctx interface{}, filters interface{}, action interface{}) *ConsentServiceInterfaceMock_SearchAccessibleConsents_Call {
+	return &ConsentServiceInterfaceMock_SearchAccessibleConsents_Call{Call: _e.mock.On("SearchAccessibleConsents", ctx, filters, action)}
+}
+
+func (_c *ConsentServiceInterfaceMock_SearchAccessibleConsents_Call) Run(run func(ctx context.Context, filters ConsentFilter, action security.Action)) *ConsentServiceInterfaceMock_SearchAccessibleConsents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ConsentFilter
+		if args[1] != nil {
+			arg1 = args[1].(ConsentFilter)
+		}
+		var arg2 security.Action
+		if args[2] != nil {
+			arg2 = args[2].(security.Action)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ConsentServiceInterfaceMock_SearchAccessibleConsents_Call) Return(consents []*Consent, serviceError *common.ServiceError) *ConsentServiceInterfaceMock_SearchAccessibleConsents_Call {
+	_c.Call.Return(consents, serviceError)
+	return _c
+}
+
+func (_c *ConsentServiceInterfaceMock_SearchAccessibleConsents_Call) RunAndReturn(run func(ctx context.Context, filters ConsentFilter, action security.Action) ([]*Consent, *common.ServiceError)) *ConsentServiceInterfaceMock_SearchAccessibleConsents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchConsents provides a mock function for the type ConsentServiceInterfaceMock
 func (_mock *ConsentServiceInterfaceMock) SearchConsents(ctx context.Context, filters ConsentFilter) ([]*Consent, *common.ServiceError) {
 	ret := _mock.Called(ctx, filters)
@@ -286,3 +286,66 @@ func (_c *consentStoreInterfaceMock_UpdateConsent_Call) RunAndReturn(run func(ct
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateConsentStatus provides a mock function for the type consentStoreInterfaceMock
+func (_mock *consentStoreInterfaceMock) UpdateConsentStatus(ctx context.Context, id string, status ConsentStatus) error {
+	ret := _mock.Called(ctx, id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConsentStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ConsentStatus) error); ok {
+		r0 = returnFunc(ctx, id, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// consentStoreInterfaceMock_UpdateConsentStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConsentStatus'
+type consentStoreInterfaceMock_UpdateConsentStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateConsentStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - status ConsentStatus
+func (_e *consentStoreInterfaceMock_Expecter) UpdateConsentStatus(ctx interface{}, id interface{}, status interface{}) *consentStoreInterfaceMock_UpdateConsentStatus_Call {
+	return &consentStoreInterfaceMock_UpdateConsentStatus_Call{Call: _e.mock.On("UpdateConsentStatus", ctx, id, status)}
+}
+
+func (_c *consentStoreInterfaceMock_UpdateConsentStatus_Call) Run(run func(ctx context.Context, id string, status ConsentStatus)) *consentStoreInterfaceMock_UpdateConsentStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 ConsentStatus
+		if args[2] != nil {
+			arg2 = args[2].(ConsentStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *consentStoreInterfaceMock_UpdateConsentStatus_Call) Return(err error) *consentStoreInterfaceMock_UpdateConsentStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *consentStoreInterfaceMock_UpdateConsentStatus_Call) RunAndReturn(run func(ctx context.Context, id string, status ConsentStatus) error) *consentStoreInterfaceMock_UpdateConsentStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -105,4 +105,18 @@ var (
 			DefaultValue: "The provided consent namespace is not a recognized value",
 		},
 	}
+	// ErrorAuthenticationRequired is the error returned when a self-service request carries no
+	// authenticated user.
+	ErrorAuthenticationRequired = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CNS-1008",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.consentservice.authentication_required",
+			DefaultValue: "Authentication required",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.consentservice.authentication_required_description",
+			DefaultValue: "The request must be made by an authenticated user",
+		},
+	}
 )
@@ -0,0 +1,190 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package consent
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const handlerLoggerComponentName = "ConsentHandler"
+
+// Query parameters accepted by the administrative consent list endpoint.
+const (
+	queryParamUserID        = "userId"
+	queryParamApplicationID = "applicationId"
+	queryParamStatus        = "status"
+)
+
+// consentHandler handles the self-service and administrative consent API requests.
+type consentHandler struct {
+	consentService ConsentServiceInterface
+}
+
+// newConsentHandler creates a new instance of consentHandler.
+func newConsentHandler(consentService ConsentServiceInterface) *consentHandler {
+	return &consentHandler{consentService: consentService}
+}
+
+// HandleSelfConsentListRequest lists the consents granted by the authenticated user.
+func (ch *consentHandler) HandleSelfConsentListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := security.GetSubject(ctx)
+	if strings.TrimSpace(userID) == "" {
+		handleError(ctx, w, &ErrorAuthenticationRequired)
+		return
+	}
+
+	ch.writeConsentList(w, r, ConsentFilter{
+		UserID:        userID,
+		GroupID:       r.URL.Query().Get(queryParamApplicationID),
+		ConsentStatus: ConsentStatus(r.URL.Query().Get(queryParamStatus)),
+	})
+
+	logger.Debug(ctx, "Self consent list response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// HandleSelfConsentRevokeRequest revokes a consent granted by the authenticated user.
+func (ch *consentHandler) HandleSelfConsentRevokeRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := security.GetSubject(ctx)
+	if strings.TrimSpace(userID) == "" {
+		handleError(ctx, w, &ErrorAuthenticationRequired)
+		return
+	}
+
+	consentID := r.PathValue("id")
+	if svcErr := ch.consentService.RevokeConsent(ctx, consentID, userID); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+
+	logger.Debug(ctx, "Self consent revoke response sent", log.String("consentID", consentID))
+}
+
+// HandleConsentListRequest lists consents across the users the caller may read, optionally filtered
+// by user, application and status.
+func (ch *consentHandler) HandleConsentListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	query := r.URL.Query()
+	consents, svcErr := ch.consentService.SearchAccessibleConsents(ctx, ConsentFilter{
+		UserID:        query.Get(queryParamUserID),
+		GroupID:       query.Get(queryParamApplicationID),
+		ConsentStatus: ConsentStatus(query.Get(queryParamStatus)),
+	}, security.ActionReadUser)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	writeConsentListResponse(w, r, consents)
+
+	logger.Debug(ctx, "Consent list response sent")
+}
+
+// HandleConsentRevokeRequest revokes a consent on behalf of its user, provided the caller may update
+// every user who authorized it.
+func (ch *consentHandler) HandleConsentRevokeRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	consentID := r.PathValue("id")
+	if svcErr := ch.consentService.CheckConsentAccess(ctx, consentID, security.ActionUpdateUser); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	if svcErr := ch.consentService.RevokeConsent(ctx, consentID, ""); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+
+	logger.Debug(ctx, "Consent revoke response sent", log.String("consentID", consentID))
+}
+
+// writeConsentList searches the consents matching the filter and writes them as the response.
+func (ch *consentHandler) writeConsentList(w http.ResponseWriter, r *http.Request, filter ConsentFilter) {
+	ctx := r.Context()
+	consents, svcErr := ch.consentService.SearchConsents(ctx, filter)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	writeConsentListResponse(w, r, consents)
+}
+
+// writeConsentListResponse writes the consents as the list response.
+func writeConsentListResponse(w http.ResponseWriter, r *http.Request, consents []*Consent) {
+	ctx := r.Context()
+	response := ConsentListResponse{
+		TotalResults: len(consents),
+		Consents:     make([]ConsentResponse, 0, len(consents)),
+	}
+	for _, consent := range consents {
+		response.Consents = append(response.Consents, toConsentResponse(consent))
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, response)
+}
+
+// toConsentResponse converts a consent record into its API representation. The updated time is the
+// latest status change across the consent's authorization records.
+func toConsentResponse(consent *Consent) ConsentResponse {
+	response := ConsentResponse{
+		ID:            consent.ID,
+		ApplicationID: consent.GroupID,
+		Status:        consent.Status,
+		ValidityTime:  consent.ValidityTime,
+		Purposes:      consent.Purposes,
+	}
+	if response.Purposes == nil {
+		response.Purposes = []ConsentPurposeItem{}
+	}
+	for _, authorization := range consent.Authorizations {
+		if authorization.UpdatedTime > response.UpdatedTime {
+			response.UpdatedTime = authorization.UpdatedTime
+		}
+	}
+	return response
+}
+
+// handleError writes the HTTP error response for the given service error.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	var statusCode int
+	if svcErr.Type == tidcommon.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorConsentNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorAuthenticationRequired.Code:
+			statusCode = http.StatusUnauthorized
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	} else {
+		statusCode = http.StatusInternalServerError
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package consent
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const testHandlerUserID = "user-123"
+
+func withTestSubject(r *http.Request, userID string) *http.Request {
+	authCtx := security.NewSecurityContextForTest(userID, "", "", nil, nil)
+	return r.WithContext(security.WithSecurityContextTest(r.Context(), authCtx))
+}
+
+func TestHandleSelfConsentListRequest_Success(t *testing.T) {
+	mockSvc := NewConsentServiceInterfaceMock(t)
+	mockSvc.On("SearchConsents", mock.Anything, ConsentFilter{
+		UserID:        testHandlerUserID,
+		GroupID:       "app1",
+		ConsentStatus: ConsentStatusActive,
+	}).Return([]*Consent{{
+		ID:      "c1",
+		GroupID: "app1",
+		Status:  ConsentStatusActive,
+		Authorizations: []ConsentAuthorization{
+			{ID: "a1", UserID: testHandlerUserID, UpdatedTime: 100},
+			{ID: "a2", UserID: testHandlerUserID, UpdatedTime: 200},
+		},
+	}}, nil)
+
+	handler := newConsentHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/me/consents?applicationId=app1&status=ACTIVE", nil)
+	req = withTestSubject(req, testHandlerUserID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfConsentListRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp ConsentListResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 1, resp.TotalResults)
+	require.Equal(t, "c1", resp.Consents[0].ID)
+	require.Equal(t, "app1", resp.Consents[0].ApplicationID)
+	require.Equal(t, int64(200), resp.Consents[0].UpdatedTime)
+	require.NotNil(t, resp.Consents[0].Purposes)
+}
+
+func TestHandleSelfConsentListRequest_Unauthenticated(t *testing.T) {
+	mockSvc := NewConsentServiceInterfaceMock(t)
+	handler := newConsentHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/me/consents", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfConsentListRequest(rr, req)
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	require.Equal(t, ErrorAuthenticationRequired.Code, errResp.Code)
+}
+
+func TestHandleSelfConsentListRequest_InvalidStatus(t *testing.T) {
+	mockSvc := NewConsentServiceInterfaceMock(t)
+	mockSvc.On("SearchConsents", mock.Anything, mock.Anything).Return(nil, &ErrorInvalidConsentStatus)
+
+	handler := newConsentHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/me/consents?status=BOGUS", nil)
+	req = withTestSubject(req, testHandlerUserID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfConsentListRequest(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandleSelfConsentRevokeRequest_Success(t *testing.T) {
+	mockSvc := NewConsentServiceInterfaceMock(t)
+	mockSvc.On("RevokeConsent", mock.Anything, "c1", testHandlerUserID).Return(nil)
+
+	handler := newConsentHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/consents/c1", nil)
+	req.SetPathValue("id", "c1")
+	req = withTestSubject(req, testHandlerUserID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfConsentRevokeRequest(rr, req)
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestHandleSelfConsentRevokeRequest_NotFound(t *testing.T) {
+	mockSvc := NewConsentServiceInterfaceMock(t)
+	mockSvc.On("RevokeConsent", mock.Anything, "c1", testHandlerUserID).Return(&ErrorConsentNotFound)
+
+	handler := newConsentHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/consents/c1", nil)
+	req.SetPathValue("id", "c1")
+	req = withTestSubject(req, testHandlerUserID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfConsentRevokeRequest(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestHandleConsentListRequest_Filters(t *testing.T) {
+	mockSvc := NewConsentServiceInterfaceMock(t)
+	mockSvc.On("SearchAccessibleConsents", mock.Anything, ConsentFilter{
+		UserID:        "user-456",
+		GroupID:       "app1",
+		ConsentStatus: ConsentStatusRevoked,
+	}, security.ActionReadUser).Return([]*Consent{}, nil)
+
+	handler := newConsentHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/consents?userId=user-456&applicationId=app1&status=REVOKED", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleConsentListRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp ConsentListResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 0, resp.TotalResults)
+	require.Empty(t, resp.Consents)
+}
+
+func TestHandleConsentRevokeRequest(t *testing.T) {
+	testCases := []struct {
+		name           string
+		svcErr         *tidcommon.ServiceError
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"NotFound", &ErrorConsentNotFound, http.StatusNotFound},
+		{"ServerError", &tidcommon.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSvc := NewConsentServiceInterfaceMock(t)
+			mockSvc.On("CheckConsentAccess", mock.Anything, "c1", security.ActionUpdateUser).Return(nil)
+			mockSvc.On("RevokeConsent", mock.Anything, "c1", "").Return(tc.svcErr)
+
+			handler := newConsentHandler(mockSvc)
+			req := httptest.NewRequest(http.MethodDelete, "/consents/c1", nil)
+			req.SetPathValue("id", "c1")
+			rr := httptest.NewRecorder()
+
+			handler.HandleConsentRevokeRequest(rr, req)
+
+			require.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleConsentRevokeRequest_InaccessibleConsent(t *testing.T) {
+	mockSvc := NewConsentServiceInterfaceMock(t)
+	mockSvc.On("CheckConsentAccess", mock.Anything, "c1", security.ActionUpdateUser).Return(&ErrorConsentNotFound)
+
+	handler := newConsentHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodDelete, "/consents/c1", nil)
+	req.SetPathValue("id", "c1")
+	rr := httptest.NewRecorder()
+
+	handler.HandleConsentRevokeRequest(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	mockSvc.AssertNotCalled(t, "RevokeConsent", mock.Anything, mock.Anything, mock.Anything)
+}
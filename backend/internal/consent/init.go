@@ -4,9 +4,67 @@
 // Package consent provides the consent persistence and service layer.
 package consent
 
-// Initialize constructs the consent service.
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/revocation"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+// Initialize constructs the consent service and registers the self-service and administrative
+// consent routes.
 func Initialize(
+	mux *http.ServeMux,
 	inboundClientProvider InboundClientProvider,
+	criteriaRevoker revocation.CriteriaRevoker,
+	entityService entity.EntityServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
 ) (ConsentServiceInterface, error) {
-	return newConsentService(inboundClientProvider)
+	consentService, err := newConsentService(inboundClientProvider, criteriaRevoker, entityService, authzService)
+	if err != nil {
+		return nil, err
+	}
+
+	consentHandler := newConsentHandler(consentService)
+	registerRoutes(mux, consentHandler)
+	return consentService, nil
+}
+
+// registerRoutes registers the consent routes.
+func registerRoutes(mux *http.ServeMux, consentHandler *consentHandler) {
+	optsSelf := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/consents",
+		consentHandler.HandleSelfConsentListRequest, optsSelf))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/consents",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelf))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/consents/{id}",
+		consentHandler.HandleSelfConsentRevokeRequest, optsSelf))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/consents/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelf))
+
+	optsAdmin := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /consents", consentHandler.HandleConsentListRequest, optsAdmin))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /consents", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, optsAdmin))
+	mux.HandleFunc(middleware.WithCORS("DELETE /consents/{id}", consentHandler.HandleConsentRevokeRequest, optsAdmin))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /consents/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, optsAdmin))
 }
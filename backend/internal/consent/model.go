@@ -39,12 +39,14 @@ const (
 	ConsentStatusActive ConsentStatus = "ACTIVE"
 	// ConsentStatusExpired indicates that the consent has expired after its validity time.
 	ConsentStatusExpired ConsentStatus = "EXPIRED"
+	// ConsentStatusRevoked indicates that the consent was withdrawn by the user or an administrator.
+	ConsentStatusRevoked ConsentStatus = "REVOKED"
 )
 
 // IsValid reports whether the status is one of the known consent statuses.
 func (s ConsentStatus) IsValid() bool {
 	switch s {
-	case ConsentStatusActive, ConsentStatusExpired:
+	case ConsentStatusActive, ConsentStatusExpired, ConsentStatusRevoked:
 		return true
 	default:
 		return false
@@ -166,3 +168,19 @@ type ConsentAuthorizationRequest struct {
 	Type   ConsentAuthorizationType
 	Status ConsentAuthorizationStatus
 }
+
+// ConsentResponse is the API representation of a consent record.
+type ConsentResponse struct {
+	ID            string               `json:"id"`
+	ApplicationID string               `json:"applicationId"`
+	Status        ConsentStatus        `json:"status"`
+	ValidityTime  int64                `json:"validityTime,omitempty"`
+	Purposes      []ConsentPurposeItem `json:"purposes"`
+	UpdatedTime   int64                `json:"updatedTime,omitempty"`
+}
+
+// ConsentListResponse is the API response for a consent list request.
+type ConsentListResponse struct {
+	TotalResults int               `json:"totalResults"`
+	Consents     []ConsentResponse `json:"consents"`
+}
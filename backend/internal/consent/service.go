@@ -9,8 +9,12 @@ import (
 	"slices"
 	"time"
 
+	"github.com/thunder-id/thunderid/internal/entity"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/revocation"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -23,6 +27,14 @@ type ConsentServiceInterface interface {
 	UpdateConsent(ctx context.Context, consentID string, consent *ConsentRequest) (
 		*Consent, *tidcommon.ServiceError)
 	SearchConsents(ctx context.Context, filters ConsentFilter) ([]*Consent, *tidcommon.ServiceError)
+	RevokeConsent(ctx context.Context, consentID, userID string) *tidcommon.ServiceError
+	// SearchAccessibleConsents retrieves the consent records matching the given filters that the
+	// caller may manage, which are those whose authorizing users the caller may perform the action on.
+	SearchAccessibleConsents(ctx context.Context, filters ConsentFilter, action security.Action) (
+		[]*Consent, *tidcommon.ServiceError)
+	// CheckConsentAccess verifies that the caller may perform the action on every user who authorized
+	// the consent. A consent the caller may not manage is reported as not found.
+	CheckConsentAccess(ctx context.Context, consentID string, action security.Action) *tidcommon.ServiceError
 }
 
 // InboundClientProvider supplies the inbound client attribute data from which consent purposes are
@@ -42,13 +54,21 @@ type consentService struct {
 	consentStore          consentStoreInterface
 	transactioner         providers.Transactioner
 	inboundClientProvider InboundClientProvider
+	criteriaRevoker       revocation.CriteriaRevoker
+	entityService         entity.EntityServiceInterface
+	authzService          sysauthz.SystemAuthorizationServiceInterface
 	logger                *log.Logger
 }
 
 // newConsentService creates a new consent service backed by the database store. The inbound client
-// provider supplies the persisted inbound client data from which consent purposes are derived.
+// provider supplies the persisted inbound client data from which consent purposes are derived, and
+// the criteria revoker invalidates the tokens issued under a consent when it is revoked. The entity
+// and authorization services scope the administrative operations to the users the caller may manage.
 func newConsentService(
 	inboundClientProvider InboundClientProvider,
+	criteriaRevoker revocation.CriteriaRevoker,
+	entityService entity.EntityServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
 ) (ConsentServiceInterface, error) {
 	consentStore, transactioner, err := newConsentStore()
 	if err != nil {
@@ -59,6 +79,9 @@ func newConsentService(
 		consentStore:          consentStore,
 		transactioner:         transactioner,
 		inboundClientProvider: inboundClientProvider,
+		criteriaRevoker:       criteriaRevoker,
+		entityService:         entityService,
+		authzService:          authzService,
 		logger:                log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ConsentService")),
 	}, nil
 }
@@ -268,11 +291,186 @@ func (c *consentService) SearchConsents(
 	return filtered, nil
 }
 
-// effectiveStatus returns the status a consent presents at the given Unix time. A consent whose
-// validity time has elapsed is reported as expired even if its stored status has not been updated.
-// A non-positive validity time means the consent never expires.
+// SearchAccessibleConsents retrieves the consent records matching the given filters whose authorizing
+// users the caller may all perform the action on. The other consents are left out of the result.
+func (c *consentService) SearchAccessibleConsents(
+	ctx context.Context, filters ConsentFilter, action security.Action,
+) ([]*Consent, *tidcommon.ServiceError) {
+	consents, svcErr := c.SearchConsents(ctx, filters)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	decisions := make(map[string]bool)
+	accessible := make([]*Consent, 0, len(consents))
+	for _, consent := range consents {
+		allowed, svcErr := c.isConsentAccessible(ctx, consent, action, decisions)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		if allowed {
+			accessible = append(accessible, consent)
+		}
+	}
+	return accessible, nil
+}
+
+// CheckConsentAccess verifies that the caller may perform the action on every user who authorized
+// the consent. A consent the caller may not manage is reported as not found so its existence is not
+// disclosed.
+func (c *consentService) CheckConsentAccess(
+	ctx context.Context, consentID string, action security.Action,
+) *tidcommon.ServiceError {
+	if consentID == "" {
+		return &ErrorMissingConsentID
+	}
+
+	existing, err := c.consentStore.GetConsent(ctx, consentID)
+	if err != nil {
+		if errors.Is(err, errConsentNotFound) {
+			c.logger.Debug(ctx, "Consent not found", log.String("id", consentID))
+			return &ErrorConsentNotFound
+		}
+		c.logger.Error(ctx, "Failed to get consent", log.String("id", consentID), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	allowed, svcErr := c.isConsentAccessible(ctx, existing, action, make(map[string]bool))
+	if svcErr != nil {
+		return svcErr
+	}
+	if !allowed {
+		c.logger.Debug(ctx, "Caller may not manage the users of the consent", log.String("id", consentID))
+		return &ErrorConsentNotFound
+	}
+	return nil
+}
+
+// isConsentAccessible reports whether the caller may perform the action on every user who authorized
+// the consent. A consent without authorizing users is not accessible. decisions caches the decision
+// for each user across calls.
+func (c *consentService) isConsentAccessible(ctx context.Context, consent *Consent, action security.Action,
+	decisions map[string]bool) (bool, *tidcommon.ServiceError) {
+	if len(consent.Authorizations) == 0 {
+		return false, nil
+	}
+	for _, authorization := range consent.Authorizations {
+		allowed, cached := decisions[authorization.UserID]
+		if !cached {
+			var svcErr *tidcommon.ServiceError
+			allowed, svcErr = c.isUserAccessible(ctx, authorization.UserID, action)
+			if svcErr != nil {
+				return false, svcErr
+			}
+			decisions[authorization.UserID] = allowed
+		}
+		if !allowed {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// isUserAccessible reports whether the caller may perform the action on the user, given the
+// organization unit the user belongs to. A user that no longer exists is not accessible.
+func (c *consentService) isUserAccessible(
+	ctx context.Context, userID string, action security.Action,
+) (bool, *tidcommon.ServiceError) {
+	userEntity, err := c.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return false, nil
+		}
+		c.logger.Error(ctx, "Failed to retrieve user", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return false, &tidcommon.InternalServerError
+	}
+	if userEntity.Category != providers.EntityCategoryUser {
+		return false, nil
+	}
+
+	allowed, svcErr := c.authzService.IsActionAllowed(ctx, action,
+		&sysauthz.ActionContext{ResourceType: security.ResourceTypeUser, OUID: userEntity.OUID, ResourceID: userID})
+	if svcErr != nil {
+		c.logger.Error(ctx, "Failed to check authorization for action",
+			log.String("action", string(action)), log.Any("error", svcErr))
+		return false, &tidcommon.InternalServerError
+	}
+	return allowed, nil
+}
+
+// RevokeConsent revokes a consent record and invalidates every token issued under it. When userID is
+// set, the consent must have been authorized by that user; a consent belonging to someone else is
+// reported as not found so its existence is not disclosed. Revoking an already revoked consent is a
+// no-op.
+//
+// The token revocation is recorded before the status change, so a failure part way leaves the
+// consent active and the request can simply be retried.
+func (c *consentService) RevokeConsent(ctx context.Context, consentID, userID string) *tidcommon.ServiceError {
+	if consentID == "" {
+		return &ErrorMissingConsentID
+	}
+
+	existing, err := c.consentStore.GetConsent(ctx, consentID)
+	if err != nil {
+		if errors.Is(err, errConsentNotFound) {
+			c.logger.Debug(ctx, "Consent not found", log.String("id", consentID))
+			return &ErrorConsentNotFound
+		}
+		c.logger.Error(ctx, "Failed to get consent", log.String("id", consentID), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if userID != "" && !isAuthorizedBy(existing, userID) {
+		c.logger.Debug(ctx, "Consent is not authorized by the user", log.String("id", consentID),
+			log.MaskedString(log.LoggerKeyUserID, userID))
+		return &ErrorConsentNotFound
+	}
+	if existing.Status == ConsentStatusRevoked {
+		return nil
+	}
+
+	if err := c.criteriaRevoker.RevokeByCriteria(ctx, revocation.CriteriaRevocation{
+		Criterion: revocation.Criterion{Type: revocation.CriterionTypeConsent, Value: consentID},
+		Mode:      revocation.ModeBeforeAction,
+		Cutoff:    time.Now().UTC(),
+		Reason:    revocation.ReasonConsentRevoked,
+	}); err != nil {
+		c.logger.Error(ctx, "Failed to revoke tokens issued under consent", log.String("id", consentID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	err = c.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		return c.consentStore.UpdateConsentStatus(txCtx, consentID, ConsentStatusRevoked)
+	})
+	if err != nil {
+		if errors.Is(err, errConsentNotFound) {
+			return &ErrorConsentNotFound
+		}
+		c.logger.Error(ctx, "Failed to revoke consent", log.String("id", consentID), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	c.logger.Debug(ctx, "Successfully revoked consent", log.String("id", consentID))
+	return nil
+}
+
+// isAuthorizedBy reports whether the given user holds an authorization record on the consent.
+func isAuthorizedBy(consent *Consent, userID string) bool {
+	for _, authorization := range consent.Authorizations {
+		if authorization.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// effectiveStatus returns the status a consent presents at the given Unix time. An active consent
+// whose validity time has elapsed is reported as expired even if its stored status has not been
+// updated. A non-positive validity time means the consent never expires. A revoked consent stays
+// revoked regardless of its validity time.
 func effectiveStatus(status ConsentStatus, validityTime, now int64) ConsentStatus {
-	if validityTime <= 0 || now < validityTime {
+	if status != ConsentStatusActive || validityTime <= 0 || now < validityTime {
 		return status
 	}
 	return ConsentStatusExpired
@@ -12,16 +12,27 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/entity"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/revocation"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
 )
 
 type ConsentServiceTestSuite struct {
 	suite.Suite
 	mockStore          *consentStoreInterfaceMock
 	mockInboundClients *InboundClientProviderMock
+	mockRevoker        *CriteriaRevokerMock
+	mockEntityService  *entitymock.EntityServiceInterfaceMock
+	mockAuthzService   *sysauthzmock.SystemAuthorizationServiceInterfaceMock
 	service            *consentService
 }
 
@@ -32,10 +43,16 @@ func TestConsentServiceTestSuite(t *testing.T) {
 func (s *ConsentServiceTestSuite) SetupTest() {
 	s.mockStore = newConsentStoreInterfaceMock(s.T())
 	s.mockInboundClients = NewInboundClientProviderMock(s.T())
+	s.mockRevoker = NewCriteriaRevokerMock(s.T())
+	s.mockEntityService = entitymock.NewEntityServiceInterfaceMock(s.T())
+	s.mockAuthzService = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(s.T())
 	s.service = &consentService{
 		consentStore:          s.mockStore,
 		transactioner:         transaction.NewNoOpTransactioner(),
 		inboundClientProvider: s.mockInboundClients,
+		criteriaRevoker:       s.mockRevoker,
+		entityService:         s.mockEntityService,
+		authzService:          s.mockAuthzService,
 		logger:                log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ConsentService")),
 	}
 }
@@ -339,6 +356,173 @@ func (s *ConsentServiceTestSuite) TestSearchConsents_StatusFilterAppliesToEffect
 	s.Equal("active", consents[0].ID)
 }
 
+// Administrative access tests
+
+func (s *ConsentServiceTestSuite) consentOf(id string, userIDs ...string) *Consent {
+	consent := &Consent{ID: id, GroupID: "app1", Status: ConsentStatusActive}
+	for _, userID := range userIDs {
+		consent.Authorizations = append(consent.Authorizations, ConsentAuthorization{ID: "a-" + id, UserID: userID})
+	}
+	return consent
+}
+
+func (s *ConsentServiceTestSuite) mockUserInOU(userID, ouID string, action security.Action, allowed bool) {
+	s.mockEntityService.On("GetEntity", mock.Anything, userID).Return(&providers.Entity{
+		ID: userID, Category: providers.EntityCategoryUser, OUID: ouID,
+	}, nil).Once()
+	s.mockAuthzService.On("IsActionAllowed", mock.Anything, action, &sysauthz.ActionContext{
+		ResourceType: security.ResourceTypeUser, OUID: ouID, ResourceID: userID,
+	}).Return(allowed, nil).Once()
+}
+
+func (s *ConsentServiceTestSuite) TestSearchAccessibleConsents_FiltersByUserAccess() {
+	s.mockStore.On("SearchConsents", mock.Anything, ConsentFilter{}).Return([]*Consent{
+		s.consentOf("c1", "user1"),
+		s.consentOf("c2", "user2"),
+		s.consentOf("c3", "user1"),
+		s.consentOf("c4", "deleted-user"),
+		s.consentOf("c5", "user1", "user2"),
+		s.consentOf("c6"),
+	}, nil)
+	s.mockUserInOU("user1", "ou1", security.ActionReadUser, true)
+	s.mockUserInOU("user2", "ou2", security.ActionReadUser, false)
+	s.mockEntityService.On("GetEntity", mock.Anything, "deleted-user").Return(nil, entity.ErrEntityNotFound)
+
+	consents, svcErr := s.service.SearchAccessibleConsents(context.Background(), ConsentFilter{},
+		security.ActionReadUser)
+
+	s.Nil(svcErr)
+	s.Require().Len(consents, 2)
+	s.Equal("c1", consents[0].ID)
+	s.Equal("c3", consents[1].ID)
+}
+
+func (s *ConsentServiceTestSuite) TestSearchAccessibleConsents_AuthorizationError() {
+	s.mockStore.On("SearchConsents", mock.Anything, ConsentFilter{}).Return([]*Consent{s.consentOf("c1", "user1")}, nil)
+	s.mockEntityService.On("GetEntity", mock.Anything, "user1").Return(&providers.Entity{
+		ID: "user1", Category: providers.EntityCategoryUser, OUID: "ou1",
+	}, nil)
+	s.mockAuthzService.On("IsActionAllowed", mock.Anything, security.ActionReadUser, mock.Anything).
+		Return(false, &tidcommon.InternalServerError)
+
+	consents, svcErr := s.service.SearchAccessibleConsents(context.Background(), ConsentFilter{},
+		security.ActionReadUser)
+
+	s.Nil(consents)
+	s.Require().NotNil(svcErr)
+	s.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (s *ConsentServiceTestSuite) TestCheckConsentAccess_Allowed() {
+	s.mockStore.On("GetConsent", mock.Anything, "c1").Return(s.consentOf("c1", "user1"), nil)
+	s.mockUserInOU("user1", "ou1", security.ActionUpdateUser, true)
+
+	s.Nil(s.service.CheckConsentAccess(context.Background(), "c1", security.ActionUpdateUser))
+}
+
+func (s *ConsentServiceTestSuite) TestCheckConsentAccess_UserOutsideCallerOUNotDisclosed() {
+	s.mockStore.On("GetConsent", mock.Anything, "c1").Return(s.consentOf("c1", "user2"), nil)
+	s.mockUserInOU("user2", "ou2", security.ActionUpdateUser, false)
+
+	svcErr := s.service.CheckConsentAccess(context.Background(), "c1", security.ActionUpdateUser)
+
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorConsentNotFound.Code, svcErr.Code)
+}
+
+func (s *ConsentServiceTestSuite) TestCheckConsentAccess_NotFound() {
+	s.mockStore.On("GetConsent", mock.Anything, "c1").Return(nil, errConsentNotFound)
+
+	svcErr := s.service.CheckConsentAccess(context.Background(), "c1", security.ActionUpdateUser)
+
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorConsentNotFound.Code, svcErr.Code)
+}
+
+// RevokeConsent tests
+
+func (s *ConsentServiceTestSuite) userConsent(status ConsentStatus) *Consent {
+	return &Consent{
+		ID:             "c1",
+		GroupID:        "app1",
+		Status:         status,
+		Authorizations: []ConsentAuthorization{{ID: "a1", UserID: "user1"}},
+	}
+}
+
+func (s *ConsentServiceTestSuite) TestRevokeConsent_MissingID() {
+	svcErr := s.service.RevokeConsent(context.Background(), "", "user1")
+
+	s.NotNil(svcErr)
+	s.Equal(ErrorMissingConsentID.Code, svcErr.Code)
+}
+
+func (s *ConsentServiceTestSuite) TestRevokeConsent_NotFound() {
+	s.mockStore.On("GetConsent", mock.Anything, "c1").Return(nil, errConsentNotFound)
+
+	svcErr := s.service.RevokeConsent(context.Background(), "c1", "")
+
+	s.NotNil(svcErr)
+	s.Equal(ErrorConsentNotFound.Code, svcErr.Code)
+}
+
+func (s *ConsentServiceTestSuite) TestRevokeConsent_OtherUsersConsentNotDisclosed() {
+	s.mockStore.On("GetConsent", mock.Anything, "c1").Return(s.userConsent(ConsentStatusActive), nil)
+
+	svcErr := s.service.RevokeConsent(context.Background(), "c1", "user2")
+
+	s.NotNil(svcErr)
+	s.Equal(ErrorConsentNotFound.Code, svcErr.Code)
+	s.mockRevoker.AssertNotCalled(s.T(), "RevokeByCriteria", mock.Anything, mock.Anything)
+}
+
+func (s *ConsentServiceTestSuite) TestRevokeConsent_AlreadyRevoked() {
+	s.mockStore.On("GetConsent", mock.Anything, "c1").Return(s.userConsent(ConsentStatusRevoked), nil)
+
+	svcErr := s.service.RevokeConsent(context.Background(), "c1", "user1")
+
+	s.Nil(svcErr)
+	s.mockRevoker.AssertNotCalled(s.T(), "RevokeByCriteria", mock.Anything, mock.Anything)
+	s.mockStore.AssertNotCalled(s.T(), "UpdateConsentStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ConsentServiceTestSuite) TestRevokeConsent_Success() {
+	s.mockStore.On("GetConsent", mock.Anything, "c1").Return(s.userConsent(ConsentStatusActive), nil)
+	s.mockRevoker.On("RevokeByCriteria", mock.Anything, mock.MatchedBy(func(r revocation.CriteriaRevocation) bool {
+		return r.Criterion.Type == revocation.CriterionTypeConsent && r.Criterion.Value == "c1" &&
+			r.Mode == revocation.ModeBeforeAction && r.Reason == revocation.ReasonConsentRevoked &&
+			!r.Cutoff.IsZero()
+	})).Return(nil)
+	s.mockStore.On("UpdateConsentStatus", mock.Anything, "c1", ConsentStatusRevoked).Return(nil)
+
+	svcErr := s.service.RevokeConsent(context.Background(), "c1", "user1")
+
+	s.Nil(svcErr)
+}
+
+func (s *ConsentServiceTestSuite) TestRevokeConsent_TokenRevocationFailureKeepsConsent() {
+	s.mockStore.On("GetConsent", mock.Anything, "c1").Return(s.userConsent(ConsentStatusActive), nil)
+	s.mockRevoker.On("RevokeByCriteria", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	svcErr := s.service.RevokeConsent(context.Background(), "c1", "")
+
+	s.NotNil(svcErr)
+	s.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+	s.mockStore.AssertNotCalled(s.T(), "UpdateConsentStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ConsentServiceTestSuite) TestRevokeConsent_StoreError() {
+	s.mockStore.On("GetConsent", mock.Anything, "c1").Return(s.userConsent(ConsentStatusActive), nil)
+	s.mockRevoker.On("RevokeByCriteria", mock.Anything, mock.Anything).Return(nil)
+	s.mockStore.On("UpdateConsentStatus", mock.Anything, "c1", ConsentStatusRevoked).
+		Return(errors.New("db down"))
+
+	svcErr := s.service.RevokeConsent(context.Background(), "c1", "")
+
+	s.NotNil(svcErr)
+	s.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
 // effectiveStatus tests
 
 func (s *ConsentServiceTestSuite) TestEffectiveStatus() {
@@ -354,6 +538,7 @@ func (s *ConsentServiceTestSuite) TestEffectiveStatus() {
 		{"not yet expired", ConsentStatusActive, now + 1, ConsentStatusActive},
 		{"boundary equal is expired", ConsentStatusActive, now, ConsentStatusExpired},
 		{"past validity is expired", ConsentStatusActive, now - 1, ConsentStatusExpired},
+		{"revoked stays revoked past validity", ConsentStatusRevoked, now - 1, ConsentStatusRevoked},
 	}
 	for _, tc := range cases {
 		s.Run(tc.name, func() {
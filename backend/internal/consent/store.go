@@ -21,6 +21,7 @@ type consentStoreInterface interface {
 	CreateConsent(ctx context.Context, consent *Consent) error
 	GetConsent(ctx context.Context, id string) (*Consent, error)
 	UpdateConsent(ctx context.Context, consent *Consent) error
+	UpdateConsentStatus(ctx context.Context, id string, status ConsentStatus) error
 	SearchConsents(ctx context.Context, filters ConsentFilter) ([]*Consent, error)
 }
 
@@ -147,6 +148,26 @@ func (s *consentStore) UpdateConsent(ctx context.Context, consent *Consent) erro
 	return s.insertAuthorizations(ctx, dbClient, consent.ID, consent.Authorizations)
 }
 
+// UpdateConsentStatus updates the status of an existing consent record, leaving its purposes and
+// authorization records untouched.
+func (s *consentStore) UpdateConsentStatus(ctx context.Context, id string, status ConsentStatus) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(
+		ctx, QueryUpdateConsentStatus, id, string(status), time.Now().UTC(), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update consent status: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return errConsentNotFound
+	}
+	return nil
+}
+
 // SearchConsents retrieves consent records matching the given filters, each with its authorizations.
 func (s *consentStore) SearchConsents(ctx context.Context, filters ConsentFilter) ([]*Consent, error) {
 	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
@@ -38,6 +38,12 @@ var (
 		ID:    "CNQ-CONSENT_MGT-04",
 		Query: `DELETE FROM "CONSENT_AUTHORIZATION" WHERE CONSENT_ID = $1 AND DEPLOYMENT_ID = $2`,
 	}
+
+	// QueryUpdateConsentStatus is the query to update the status of a consent record.
+	QueryUpdateConsentStatus = dbmodel.DBQuery{
+		ID:    "CNQ-CONSENT_MGT-08",
+		Query: `UPDATE "CONSENT" SET STATUS = $2, UPDATED_AT = $3 WHERE ID = $1 AND DEPLOYMENT_ID = $4`,
+	}
 )
 
 // buildInsertConsentAuthorizationsQuery constructs a single multi-row INSERT for a consent's
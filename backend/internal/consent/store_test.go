@@ -171,6 +171,25 @@ func (s *ConsentStoreTestSuite) TestUpdateConsent_NotFound() {
 	s.ErrorIs(err, errConsentNotFound)
 }
 
+// UpdateConsentStatus
+
+func (s *ConsentStoreTestSuite) TestUpdateConsentStatus_Success() {
+	s.mockDBProvider.On("GetRuntimePersistentDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, QueryUpdateConsentStatus,
+		"c1", string(ConsentStatusRevoked), mock.AnythingOfType("time.Time"), testDeploymentID).
+		Return(int64(1), nil).Once()
+
+	s.NoError(s.store.UpdateConsentStatus(context.Background(), "c1", ConsentStatusRevoked))
+}
+
+func (s *ConsentStoreTestSuite) TestUpdateConsentStatus_NotFound() {
+	s.mockDBProvider.On("GetRuntimePersistentDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", anyArgs(QueryUpdateConsentStatus, 4)...).Return(int64(0), nil).Once()
+
+	err := s.store.UpdateConsentStatus(context.Background(), "c1", ConsentStatusRevoked)
+	s.ErrorIs(err, errConsentNotFound)
+}
+
 // SearchConsents
 
 func (s *ConsentStoreTestSuite) TestSearchConsents_ReturnsResultsWithAuthorizations() {
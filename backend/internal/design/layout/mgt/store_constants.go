@@ -42,6 +42,8 @@ var (
 			`UPDATED_AT = datetime('now') WHERE ID = $4 AND DEPLOYMENT_ID = $5`,
 		Query: `UPDATE "LAYOUT" SET DISPLAY_NAME = $1, DESCRIPTION = $2, LAYOUT = $3, ` +
 			`UPDATED_AT = datetime('now') WHERE ID = $4 AND DEPLOYMENT_ID = $5`,
+		MySQLQuery: `UPDATE "LAYOUT" SET DISPLAY_NAME = $1, DESCRIPTION = $2, LAYOUT = $3, ` +
+			`UPDATED_AT = CURRENT_TIMESTAMP WHERE ID = $4 AND DEPLOYMENT_ID = $5`,
 	}
 
 	// queryDeleteLayout deletes a layout configuration.
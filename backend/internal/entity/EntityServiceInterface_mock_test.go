@@ -1306,6 +1306,132 @@ func (_c *EntityServiceInterfaceMock_LoadIndexedAttributes_Call) RunAndReturn(ru
 	return _c
 }
 
+// RehashCredentials provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) RehashCredentials(ctx context.Context, entityID string, credentials map[string]interface{}) error {
+	ret := _mock.Called(ctx, entityID, credentials)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RehashCredentials")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) error); ok {
+		r0 = returnFunc(ctx, entityID, credentials)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EntityServiceInterfaceMock_RehashCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RehashCredentials'
+type EntityServiceInterfaceMock_RehashCredentials_Call struct {
+	*mock.Call
+}
+
+// RehashCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - credentials map[string]interface{}
+func (_e *EntityServiceInterfaceMock_Expecter) RehashCredentials(ctx interface{}, entityID interface{}, credentials interface{}) *EntityServiceInterfaceMock_RehashCredentials_Call {
+	return &EntityServiceInterfaceMock_RehashCredentials_Call{Call: _e.mock.On("RehashCredentials", ctx, entityID, credentials)}
+}
+
+func (_c *EntityServiceInterfaceMock_RehashCredentials_Call) Run(run func(ctx context.Context, entityID string, credentials map[string]interface{})) *EntityServiceInterfaceMock_RehashCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]interface{}
+		if args[2] != nil {
+			arg2 = args[2].(map[string]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_RehashCredentials_Call) Return(err error) *EntityServiceInterfaceMock_RehashCredentials_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_RehashCredentials_Call) RunAndReturn(run func(ctx context.Context, entityID string, credentials map[string]interface{}) error) *EntityServiceInterfaceMock_RehashCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSystemCredentials provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) RemoveSystemCredentials(ctx context.Context, entityID string, credType string) error {
+	ret := _mock.Called(ctx, entityID, credType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSystemCredentials")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, entityID, credType)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EntityServiceInterfaceMock_RemoveSystemCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSystemCredentials'
+type EntityServiceInterfaceMock_RemoveSystemCredentials_Call struct {
+	*mock.Call
+}
+
+// RemoveSystemCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - credType string
+func (_e *EntityServiceInterfaceMock_Expecter) RemoveSystemCredentials(ctx interface{}, entityID interface{}, credType interface{}) *EntityServiceInterfaceMock_RemoveSystemCredentials_Call {
+	return &EntityServiceInterfaceMock_RemoveSystemCredentials_Call{Call: _e.mock.On("RemoveSystemCredentials", ctx, entityID, credType)}
+}
+
+func (_c *EntityServiceInterfaceMock_RemoveSystemCredentials_Call) Run(run func(ctx context.Context, entityID string, credType string)) *EntityServiceInterfaceMock_RemoveSystemCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_RemoveSystemCredentials_Call) Return(err error) *EntityServiceInterfaceMock_RemoveSystemCredentials_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_RemoveSystemCredentials_Call) RunAndReturn(run func(ctx context.Context, entityID string, credType string) error) *EntityServiceInterfaceMock_RemoveSystemCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchEntities provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) SearchEntities(ctx context.Context, filters map[string]interface{}) ([]providers.Entity, error) {
 	ret := _mock.Called(ctx, filters)
@@ -1798,8 +1924,8 @@ func (_c *EntityServiceInterfaceMock_ValidateEntityIDs_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *EntityServiceInterfaceMock_ValidateEntityIDs_Call) Return(strings []string, err error) *EntityServiceInterfaceMock_ValidateEntityIDs_Call {
-	_c.Call.Return(strings, err)
+func (_c *EntityServiceInterfaceMock_ValidateEntityIDs_Call) Return(ss []string, err error) *EntityServiceInterfaceMock_ValidateEntityIDs_Call {
+	_c.Call.Return(ss, err)
 	return _c
 }
 
@@ -1872,8 +1998,8 @@ func (_c *EntityServiceInterfaceMock_ValidateEntityIDsInOUs_Call) Run(run func(c
 	return _c
 }
 
-func (_c *EntityServiceInterfaceMock_ValidateEntityIDsInOUs_Call) Return(strings []string, err error) *EntityServiceInterfaceMock_ValidateEntityIDsInOUs_Call {
-	_c.Call.Return(strings, err)
+func (_c *EntityServiceInterfaceMock_ValidateEntityIDsInOUs_Call) Return(ss []string, err error) *EntityServiceInterfaceMock_ValidateEntityIDsInOUs_Call {
+	_c.Call.Return(ss, err)
 	return _c
 }
 
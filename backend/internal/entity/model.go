@@ -42,6 +42,24 @@ type StoredCredential struct {
 	Value             string                   `json:"value"`
 }
 
+// newStoredCredential builds the stored form of a hashed credential.
+func newStoredCredential(credential cryptolib.Credential) StoredCredential {
+	return StoredCredential{
+		StorageAlgo:       credential.Algorithm,
+		StorageAlgoParams: credential.Parameters,
+		Value:             credential.Hash,
+	}
+}
+
+// toCredential returns the stored credential as a reference credential for verification.
+func (c StoredCredential) toCredential() cryptolib.Credential {
+	return cryptolib.Credential{
+		Algorithm:  c.StorageAlgo,
+		Hash:       c.Value,
+		Parameters: c.StorageAlgoParams,
+	}
+}
+
 // DeclarativeLoaderConfig configures declarative resource loading for a specific entity category.
 // Consumer packages (e.g., user) provide parser and validator callbacks for type-specific processing.
 type DeclarativeLoaderConfig struct {
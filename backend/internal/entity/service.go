@@ -36,6 +36,7 @@ type EntityServiceInterface interface {
 		plaintextUpdates json.RawMessage) error
 	UpdateSystemCredentials(ctx context.Context, entityID string,
 		plaintextUpdates json.RawMessage) error
+	RemoveSystemCredentials(ctx context.Context, entityID string, credType string) error
 
 	// Identification
 	IdentifyEntity(ctx context.Context, filters map[string]interface{}) (*string, error)
@@ -66,6 +67,7 @@ type EntityServiceInterface interface {
 		credentials map[string]interface{}) (*AuthenticateResult, error)
 	AuthenticateEntityByID(ctx context.Context, entityID string,
 		credentials map[string]interface{}) (*AuthenticateResult, error)
+	RehashCredentials(ctx context.Context, entityID string, credentials map[string]interface{}) error
 
 	// Declarative
 	IsEntityDeclarative(ctx context.Context, entityID string) (bool, error)
@@ -548,16 +550,7 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 		credList := storedCreds[credType]
 		verified := false
 		for _, stored := range credList {
-			ref := cryptolib.Credential{
-				Algorithm: stored.StorageAlgo,
-				Hash:      stored.Value,
-				Parameters: cryptolib.CredParameters{
-					Salt:       stored.StorageAlgoParams.Salt,
-					Iterations: stored.StorageAlgoParams.Iterations,
-					KeySize:    stored.StorageAlgoParams.KeySize,
-				},
-			}
-			ok, verifyErr := s.hashService.Verify([]byte(credValue), ref)
+			ok, verifyErr := s.hashService.Verify([]byte(credValue), stored.toCredential())
 			if verifyErr == nil && ok {
 				verified = true
 				break
@@ -571,6 +564,111 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 	return nil
 }
 
+// RehashCredentials re-hashes verified plaintext credentials whose stored hashes were created with a
+// different algorithm or parameters than the current hash configuration. Only stored entries that
+// match the plaintext value are replaced, and nothing is written when every hash is current.
+// Declarative entities are skipped since their credentials cannot be modified.
+func (s *entityService) RehashCredentials(ctx context.Context, entityID string,
+	credentials map[string]interface{}) error {
+	if entityID == "" || len(credentials) == 0 {
+		return nil
+	}
+	isDeclarative, err := s.store.IsEntityDeclarative(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if isDeclarative {
+		return nil
+	}
+
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.GetEntityWithCredentials(txCtx, entityID)
+		if err != nil {
+			return err
+		}
+
+		schemaCreds, err := s.rehashStoredCredentials(existing.SchemaCredentials, credentials)
+		if err != nil {
+			return err
+		}
+		if schemaCreds != nil {
+			if err := s.store.UpdateCredentials(txCtx, entityID, schemaCreds); err != nil {
+				return err
+			}
+		}
+
+		systemCreds, err := s.rehashStoredCredentials(existing.SystemCredentials, credentials)
+		if err != nil {
+			return err
+		}
+		if systemCreds != nil {
+			return s.store.UpdateSystemCredentials(txCtx, entityID, systemCreds)
+		}
+		return nil
+	})
+}
+
+// rehashStoredCredentials replaces the outdated stored hashes that match the given plaintext
+// credentials. It returns nil when no stored credential was replaced. Credential types without a
+// plaintext value are passed through untouched.
+func (s *entityService) rehashStoredCredentials(storedJSON json.RawMessage,
+	credentials map[string]interface{}) (json.RawMessage, error) {
+	if len(storedJSON) == 0 {
+		return nil, nil
+	}
+	var storedCreds map[string]json.RawMessage
+	if err := json.Unmarshal(storedJSON, &storedCreds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stored credentials: %w", err)
+	}
+
+	changed := false
+	for credType, credValueInterface := range credentials {
+		credValue, ok := credValueInterface.(string)
+		if !ok || credValue == "" {
+			continue
+		}
+		rawList, exists := storedCreds[credType]
+		if !exists {
+			continue
+		}
+		var credList []StoredCredential
+		if err := json.Unmarshal(rawList, &credList); err != nil {
+			continue
+		}
+
+		listChanged := false
+		for i, stored := range credList {
+			ref := stored.toCredential()
+			if !s.hashService.NeedsRehash(ref) {
+				continue
+			}
+			if ok, verifyErr := s.hashService.Verify([]byte(credValue), ref); verifyErr != nil || !ok {
+				continue
+			}
+			credHash, err := s.hashService.Generate([]byte(credValue))
+			if err != nil {
+				return nil, fmt.Errorf("failed to rehash credential %q: %w", credType, err)
+			}
+			credList[i] = newStoredCredential(credHash)
+			listChanged = true
+		}
+		if !listChanged {
+			continue
+		}
+		updatedList, err := json.Marshal(credList)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal rehashed credentials: %w", err)
+		}
+		storedCreds[credType] = updatedList
+		changed = true
+	}
+
+	if !changed {
+		return nil, nil
+	}
+	return json.Marshal(storedCreds)
+}
+
 // UpdateCredentials updates schema-defined credentials (e.g., password) by hashing new
 // plaintext values and merging with existing stored credentials. Payload keys are
 // restricted to fields declared as credentials in the entity's schema.
@@ -793,6 +891,36 @@ func (s *entityService) UpdateSystemCredentials(ctx context.Context, entityID st
 	})
 }
 
+// RemoveSystemCredentials removes every stored system credential of the given type. It is a no-op
+// when the entity holds none of that type.
+func (s *entityService) RemoveSystemCredentials(ctx context.Context, entityID string, credType string) error {
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.GetEntityWithCredentials(txCtx, entityID)
+		if err != nil {
+			return err
+		}
+		if len(existing.SystemCredentials) == 0 {
+			return nil
+		}
+
+		existingCreds := make(map[string]interface{})
+		if err := json.Unmarshal(existing.SystemCredentials, &existingCreds); err != nil {
+			return fmt.Errorf("failed to unmarshal existing credentials: %w", err)
+		}
+		if _, ok := existingCreds[credType]; !ok {
+			return nil
+		}
+		delete(existingCreds, credType)
+
+		remainingJSON, err := json.Marshal(existingCreds)
+		if err != nil {
+			return fmt.Errorf("failed to marshal remaining credentials: %w", err)
+		}
+
+		return s.store.UpdateSystemCredentials(txCtx, entityID, remainingJSON)
+	})
+}
+
 // populateOUHandles resolves OU handles for a slice of entities in-place.
 func (s *entityService) populateOUHandles(ctx context.Context, entities []providers.Entity) {
 	if s.ouService == nil || len(entities) == 0 {
@@ -995,17 +1123,7 @@ func (s *entityService) hashPlaintextCredentials(creds json.RawMessage) (json.Ra
 			if err != nil {
 				return nil, fmt.Errorf("failed to hash credential %q: %w", credType, err)
 			}
-			result[credType] = []StoredCredential{
-				{
-					StorageAlgo: credHash.Algorithm,
-					StorageAlgoParams: cryptolib.CredParameters{
-						Salt:       credHash.Parameters.Salt,
-						Iterations: credHash.Parameters.Iterations,
-						KeySize:    credHash.Parameters.KeySize,
-					},
-					Value: credHash.Hash,
-				},
-			}
+			result[credType] = []StoredCredential{newStoredCredential(credHash)}
 		default:
 			// Already in stored format (array of credential objects) — pass through.
 			result[credType] = credValue
@@ -285,6 +285,40 @@ func (s *ServiceTestSuite) TestUpdateSystemCredentials_Delegates() {
 	s.NoError(s.svc.UpdateSystemCredentials(s.ctx, "e1", creds))
 }
 
+func (s *ServiceTestSuite) TestRemoveSystemCredentials_RemovesOnlyGivenTypes() {
+	e := testEntity("rc1")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e,
+			SystemCredentials: json.RawMessage(`{"passkey":[{"value":"v1"}],"totp":[{"value":"t1"}]}`)}, nil)
+	s.store.On("UpdateSystemCredentials", mock.Anything, e.ID, mock.MatchedBy(func(creds json.RawMessage) bool {
+		var remaining map[string]interface{}
+		if err := json.Unmarshal(creds, &remaining); err != nil {
+			return false
+		}
+		_, hasPasskey := remaining["passkey"]
+		_, hasTOTP := remaining["totp"]
+		return hasPasskey && !hasTOTP
+	})).Return(nil)
+
+	s.NoError(s.svc.RemoveSystemCredentials(s.ctx, e.ID, "totp"))
+}
+
+func (s *ServiceTestSuite) TestRemoveSystemCredentials_AbsentTypeIsNoOp() {
+	e := testEntity("rc2")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SystemCredentials: json.RawMessage(`{"passkey":[{"value":"v1"}]}`)},
+			nil)
+
+	s.NoError(s.svc.RemoveSystemCredentials(s.ctx, e.ID, "totp"))
+	s.store.AssertNotCalled(s.T(), "UpdateSystemCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRemoveSystemCredentials_StoreError() {
+	s.store.On("GetEntityWithCredentials", mock.Anything, "rc3").Return(nil, s.testErr)
+
+	s.Error(s.svc.RemoveSystemCredentials(s.ctx, "rc3", "totp"))
+}
+
 func (s *ServiceTestSuite) TestGetCredentialsByType_NoCredentials() {
 	e := testEntity("ecreds")
 	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
@@ -584,6 +618,68 @@ func (s *ServiceTestSuite) TestAuthenticateEntity_DelegatesToByID() {
 	s.Equal(id, result.EntityID)
 }
 
+func (s *ServiceTestSuite) TestRehashCredentials_ReplacesOutdatedHash() {
+	e := testEntity("rehash-1")
+	systemCreds := json.RawMessage(`{"passkey":[{"credentialId":"abc"}]}`)
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(false, nil)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).Return(&entityWithCredentials{
+		Entity: e, SchemaCredentials: testCredentialsJSON(), SystemCredentials: systemCreds,
+	}, nil)
+	s.hashService.On("NeedsRehash", mock.MatchedBy(func(ref cryptolib.Credential) bool {
+		return ref.Algorithm == cryptolib.PBKDF2 && ref.Hash == "testhash"
+	})).Return(true)
+	s.hashService.On("Verify", []byte("password123"), mock.Anything).Return(true, nil)
+	s.store.On("UpdateCredentials", mock.Anything, e.ID, mock.MatchedBy(func(creds json.RawMessage) bool {
+		var stored map[string][]StoredCredential
+		if err := json.Unmarshal(creds, &stored); err != nil || len(stored["password"]) != 1 {
+			return false
+		}
+		return stored["password"][0].StorageAlgoParams.Iterations == 1 && stored["password"][0].Value == "testhash"
+	})).Return(nil)
+
+	err := s.svc.RehashCredentials(s.ctx, e.ID, map[string]interface{}{"password": "password123"})
+
+	s.NoError(err)
+	s.store.AssertNotCalled(s.T(), "UpdateSystemCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRehashCredentials_CurrentHashNotRewritten() {
+	e := testEntity("rehash-2")
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(false, nil)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: testCredentialsJSON()}, nil)
+	s.hashService.On("NeedsRehash", mock.Anything).Return(false)
+
+	err := s.svc.RehashCredentials(s.ctx, e.ID, map[string]interface{}{"password": "password123"})
+
+	s.NoError(err)
+	s.hashService.AssertNotCalled(s.T(), "Verify", mock.Anything, mock.Anything)
+	s.store.AssertNotCalled(s.T(), "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRehashCredentials_MismatchedValueNotRewritten() {
+	e := testEntity("rehash-3")
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(false, nil)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: testCredentialsJSON()}, nil)
+	s.hashService.On("NeedsRehash", mock.Anything).Return(true)
+	s.hashService.On("Verify", []byte("other"), mock.Anything).Return(false, nil)
+
+	err := s.svc.RehashCredentials(s.ctx, e.ID, map[string]interface{}{"password": "other"})
+
+	s.NoError(err)
+	s.store.AssertNotCalled(s.T(), "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestRehashCredentials_DeclarativeEntitySkipped() {
+	s.store.On("IsEntityDeclarative", mock.Anything, "decl-1").Return(true, nil)
+
+	err := s.svc.RehashCredentials(s.ctx, "decl-1", map[string]interface{}{"password": "password123"})
+
+	s.NoError(err)
+	s.store.AssertNotCalled(s.T(), "GetEntityWithCredentials", mock.Anything, mock.Anything)
+}
+
 // --- SetGroupMembershipProvider / GetTransitiveEntityGroups ---
 
 func (s *ServiceTestSuite) TestGetTransitiveEntityGroups_ProviderNil() {
@@ -140,6 +140,7 @@ func appendOUIDsINClause(
 			Query:         query.Query + denyClause,
 			PostgresQuery: query.PostgresQuery + denyClause,
 			SQLiteQuery:   query.SQLiteQuery + denyClause,
+			MySQLQuery:    query.MySQLQuery + denyClause,
 		}, args
 	}
 	startIdx := len(args) + 1
@@ -165,6 +166,7 @@ func appendOUIDsINClause(
 		Query:         query.Query + inClausePostgres,
 		PostgresQuery: query.PostgresQuery + inClausePostgres,
 		SQLiteQuery:   query.SQLiteQuery + inClauseSQLite,
+		MySQLQuery:    query.MySQLQuery + inClauseSQLite,
 	}, args
 }
 
@@ -192,6 +194,7 @@ func buildEntityCountQueryByOUIDs(
 		Query:         baseQuery,
 		PostgresQuery: baseQuery,
 		SQLiteQuery:   strings.Replace(baseQuery, "$1", "?", 1),
+		MySQLQuery:    strings.Replace(baseQuery, "$1", "?", 1),
 	}
 
 	query, args = appendOUIDsINClause(query, args, ouIDs)
@@ -224,6 +227,7 @@ func buildEntityListQueryByOUIDs(
 			Query:         baseQuery,
 			PostgresQuery: baseQuery,
 			SQLiteQuery:   strings.Replace(baseQuery, "$1", "?", 1),
+			MySQLQuery:    strings.Replace(baseQuery, "$1", "?", 1),
 		}
 		query, args = appendOUIDsINClause(query, args, ouIDs)
 		query, args = utils.AppendDeploymentIDToFilterQuery(query, args, deploymentID)
@@ -239,12 +243,18 @@ func buildEntityListQueryByOUIDs(
 		return model.DBQuery{}, nil, err
 	}
 
+	mysqlQuery, err := buildPaginatedQuery(query.MySQLQuery, len(args), "?")
+	if err != nil {
+		return model.DBQuery{}, nil, err
+	}
+
 	args = append(args, limit, offset)
 	return model.DBQuery{
 		ID:            queryID,
 		Query:         postgresQuery,
 		PostgresQuery: postgresQuery,
 		SQLiteQuery:   sqliteQuery,
+		MySQLQuery:    mysqlQuery,
 	}, args, nil
 }
 
@@ -267,17 +277,20 @@ func buildIdentifyQuery(filters map[string]interface{}, deploymentID string) (mo
 
 	pgQuery := `SELECT ID FROM "ENTITY" WHERE 1=1`
 	sqQuery := `SELECT ID FROM "ENTITY" WHERE 1=1`
+	myQuery := `SELECT ID FROM "ENTITY" WHERE 1=1`
 	args := make([]interface{}, 0, len(keys)+1)
 
 	for i, key := range keys {
-		pg, sq := buildDualColumnConditions("", key, i+1)
+		pg, sq, my := buildDualColumnConditions("", key, i+1)
 		pgQuery += pg
 		sqQuery += sq
+		myQuery += my
 		args = append(args, filters[key])
 	}
 
 	pgQuery += fmt.Sprintf(" AND DEPLOYMENT_ID = $%d", len(keys)+1)
 	sqQuery += " AND DEPLOYMENT_ID = ?"
+	myQuery += " AND DEPLOYMENT_ID = ?"
 	args = append(args, deploymentID)
 
 	return model.DBQuery{
@@ -285,6 +298,7 @@ func buildIdentifyQuery(filters map[string]interface{}, deploymentID string) (mo
 		Query:         pgQuery,
 		PostgresQuery: pgQuery,
 		SQLiteQuery:   sqQuery,
+		MySQLQuery:    myQuery,
 	}, args, nil
 }
 
@@ -413,12 +427,18 @@ func buildEntityListQuery(
 			return model.DBQuery{}, nil, err
 		}
 
+		mysqlQuery, err := buildPaginatedQuery(fq.MySQLQuery, len(args), "?")
+		if err != nil {
+			return model.DBQuery{}, nil, err
+		}
+
 		args = append(args, limit, offset)
 		return model.DBQuery{
 			ID:            queryID,
 			Query:         postgresQuery,
 			PostgresQuery: postgresQuery,
 			SQLiteQuery:   sqliteQuery,
+			MySQLQuery:    mysqlQuery,
 		}, args, nil
 	}
 
@@ -527,6 +547,8 @@ func buildIdentifyQueryHybrid(
 	sqliteQuery += ` INNER JOIN "ENTITY_IDENTIFIER" ia1 ON e.ID = ia1.ENTITY_ID ` +
 		`AND e.DEPLOYMENT_ID = ia1.DEPLOYMENT_ID`
 
+	mysqlQuery := postgresQuery
+
 	indexedKeys := make([]string, 0, len(indexedFilters))
 	for key := range indexedFilters {
 		indexedKeys = append(indexedKeys, key)
@@ -550,6 +572,7 @@ func buildIdentifyQueryHybrid(
 			alias, alias, alias)
 		postgresQuery += joinClause
 		sqliteQuery += joinClause
+		mysqlQuery += joinClause
 		whereConditions = append(whereConditions, fmt.Sprintf("%s.NAME = $%d AND %s.VALUE = $%d",
 			alias, paramIndex, alias, paramIndex+1))
 		args = append(args, indexedKeys[i], fmt.Sprintf("%v", indexedFilters[indexedKeys[i]]))
@@ -558,6 +581,7 @@ func buildIdentifyQueryHybrid(
 
 	postgresQuery += " WHERE " + strings.Join(whereConditions, " AND ")
 	sqliteQuery += " WHERE " + strings.Join(whereConditions, " AND ")
+	mysqlQuery += " WHERE " + strings.Join(whereConditions, " AND ")
 
 	nonIndexedKeys := make([]string, 0, len(nonIndexedFilters))
 	for key := range nonIndexedFilters {
@@ -569,15 +593,17 @@ func buildIdentifyQueryHybrid(
 	sort.Strings(nonIndexedKeys)
 
 	for _, key := range nonIndexedKeys {
-		pg, sq := buildDualColumnConditions("e.", key, paramIndex)
+		pg, sq, my := buildDualColumnConditions("e.", key, paramIndex)
 		postgresQuery += pg
 		sqliteQuery += sq
+		mysqlQuery += my
 		args = append(args, nonIndexedFilters[key])
 		paramIndex++
 	}
 
 	postgresQuery += fmt.Sprintf(" AND e.DEPLOYMENT_ID = $%d", paramIndex)
 	sqliteQuery += " AND e.DEPLOYMENT_ID = ?"
+	mysqlQuery += fmt.Sprintf(" AND e.DEPLOYMENT_ID = $%d", paramIndex)
 	args = append(args, deploymentID)
 
 	query := model.DBQuery{
@@ -585,6 +611,7 @@ func buildIdentifyQueryHybrid(
 		Query:         postgresQuery,
 		PostgresQuery: postgresQuery,
 		SQLiteQuery:   sqliteQuery,
+		MySQLQuery:    mysqlQuery,
 	}
 
 	return query, args, nil
@@ -600,13 +627,17 @@ func buildGetEntitiesByIDsQuery(entityIDs []string, deploymentID string) (model.
 	)
 }
 
-// buildDualColumnConditions returns AND conditions for both Postgres and SQLite that match a key
+// buildDualColumnConditions returns AND conditions for Postgres, SQLite and MySQL that match a key
 // against both ATTRIBUTES and SYSTEM_ATTRIBUTES using COALESCE (one parameter per key).
-func buildDualColumnConditions(tablePrefix, key string, paramIndex int) (pgCond, sqCond string) {
+// The MySQL condition uses the same numbered placeholder as Postgres.
+func buildDualColumnConditions(tablePrefix, key string, paramIndex int) (pgCond, sqCond, myCond string) {
 	attrCol := tablePrefix + AttributesColumn
 	sysCol := tablePrefix + SystemAttributesColumn
 	sqCond = fmt.Sprintf(" AND COALESCE(json_extract(%s, '$.%s'), json_extract(%s, '$.%s')) = ?",
 		sysCol, key, attrCol, key)
+	myCond = fmt.Sprintf(" AND COALESCE(JSON_UNQUOTE(JSON_EXTRACT(%s, '$.%s')), "+
+		"JSON_UNQUOTE(JSON_EXTRACT(%s, '$.%s'))) = $%d",
+		sysCol, key, attrCol, key, paramIndex)
 	if strings.Contains(key, ".") {
 		parts := strings.Split(key, ".")
 		pathArray := "{" + strings.Join(parts, ",") + "}"
@@ -654,10 +685,12 @@ func buildFilterQueryWithOffset(
 
 	postgresQuery := baseQuery
 	sqliteQuery := strings.Replace(baseQuery, "$1", "?", 1)
+	mysqlQuery := sqliteQuery
 
 	for i, key := range keys {
 		postgresQuery += utils.BuildPostgresJSONCondition(columnName, key, paramOffset+i+1)
 		sqliteQuery += utils.BuildSQLiteJSONCondition(columnName, key)
+		mysqlQuery += utils.BuildMySQLJSONCondition(columnName, key)
 		args = append(args, filters[key])
 	}
 
@@ -666,6 +699,7 @@ func buildFilterQueryWithOffset(
 		Query:         postgresQuery,
 		PostgresQuery: postgresQuery,
 		SQLiteQuery:   sqliteQuery,
+		MySQLQuery:    mysqlQuery,
 	}
 
 	return resultQuery, args, nil
@@ -275,3 +275,33 @@ func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryHybrid_NonIndexed_UsesCO
 	s.Contains(q.SQLiteQuery, "json_extract(e.ATTRIBUTES, '$.clientId')")
 	s.Contains(q.SQLiteQuery, "json_extract(e.SYSTEM_ATTRIBUTES, '$.clientId')")
 }
+
+func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_COALESCE_MySQLQuery() {
+	q, _, err := buildIdentifyQuery(map[string]interface{}{"clientId": "app123"}, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.MySQLQuery, "COALESCE(JSON_UNQUOTE(JSON_EXTRACT(SYSTEM_ATTRIBUTES, '$.clientId')), "+
+		"JSON_UNQUOTE(JSON_EXTRACT(ATTRIBUTES, '$.clientId'))) = $1")
+	s.Contains(q.MySQLQuery, "DEPLOYMENT_ID = ?")
+}
+
+func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryHybrid_MySQLQuery_UsesNumberedPlaceholders() {
+	indexed := map[string]interface{}{"email": "a@b.com"}
+	nonIndexed := map[string]interface{}{"clientId": "app123"}
+	q, args, err := buildIdentifyQueryHybrid(indexed, nonIndexed, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.MySQLQuery, "ia1.NAME = $1 AND ia1.VALUE = $2")
+	s.Contains(q.MySQLQuery, "JSON_UNQUOTE(JSON_EXTRACT(e.ATTRIBUTES, '$.clientId'))) = $3")
+	s.Contains(q.MySQLQuery, "e.DEPLOYMENT_ID = $4")
+	s.NotContains(q.MySQLQuery, "?")
+	s.Len(args, 4)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildEntityListQueryByOUIDs_MySQLQuery() {
+	q, args, err := buildEntityListQueryByOUIDs(
+		"user", []string{"ou1", "ou2"}, map[string]interface{}{"email": "a@b.com"}, 10, 0, testDeploymentID)
+	s.NoError(err)
+	s.Equal(`SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES FROM "ENTITY" `+
+		`WHERE CATEGORY = ? AND JSON_UNQUOTE(JSON_EXTRACT(ATTRIBUTES, '$.email')) = ? AND OU_ID IN (?, ?) `+
+		`AND DEPLOYMENT_ID = ? ORDER BY ID LIMIT ? OFFSET ?`, q.MySQLQuery)
+	s.Len(args, 7)
+}
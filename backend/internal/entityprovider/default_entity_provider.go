@@ -167,6 +167,19 @@ func (p *defaultEntityProvider) UpdateSystemCredentials(
 	return nil
 }
 
+// RehashCredentials re-hashes verified plaintext credentials whose stored hashes use an
+// outdated algorithm or parameters.
+func (p *defaultEntityProvider) RehashCredentials(
+	entityID string, credentials map[string]interface{},
+) *EntityProviderError {
+	ctx := security.WithRuntimeContext(context.Background())
+	err := p.entitySvc.RehashCredentials(ctx, entityID, credentials)
+	if err != nil {
+		return mapEntityError(err)
+	}
+	return nil
+}
+
 // GetTransitiveEntityGroups retrieves all groups an entity belongs to, including inherited groups.
 func (p *defaultEntityProvider) GetTransitiveEntityGroups(
 	entityID string,
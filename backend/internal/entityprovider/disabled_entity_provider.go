@@ -73,6 +73,11 @@ func (p *disabledEntityProvider) UpdateSystemCredentials(_ string,
 	return errNotImplemented
 }
 
+func (p *disabledEntityProvider) RehashCredentials(_ string,
+	_ map[string]interface{}) *EntityProviderError {
+	return errNotImplemented
+}
+
 func (p *disabledEntityProvider) GetTransitiveEntityGroups(
 	_ string) ([]providers.EntityGroup, *EntityProviderError) {
 	return nil, errNotImplemented
@@ -47,6 +47,11 @@ type EntityProviderInterface interface {
 	UpdateSystemCredentials(entityID string,
 		credentials json.RawMessage) *EntityProviderError
 
+	// RehashCredentials re-hashes verified plaintext credentials whose stored hashes use an
+	// outdated algorithm or parameters.
+	RehashCredentials(entityID string,
+		credentials map[string]interface{}) *EntityProviderError
+
 	// GetTransitiveEntityGroups retrieves all groups an entity belongs to, including inherited groups.
 	GetTransitiveEntityGroups(entityID string) ([]providers.EntityGroup, *EntityProviderError)
 
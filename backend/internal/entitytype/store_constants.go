@@ -67,14 +67,16 @@ func buildGetEntityTypeListByOUIDsQuery(ouIDs []string) dbmodel.DBQuery {
 	n := len(ouIDs)
 
 	if n == 0 {
+		sqliteQuery := `SELECT ID, CATEGORY, NAME, OU_ID, ALLOW_SELF_REGISTRATION, ` +
+			`SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
+			`WHERE 1=0 AND CATEGORY = ? AND DEPLOYMENT_ID = ? ORDER BY NAME LIMIT ? OFFSET ?`
 		return dbmodel.DBQuery{
 			ID: "ASQ-ENTITY_TYPE-008",
 			PostgresQuery: `SELECT ID, CATEGORY, NAME, OU_ID, ALLOW_SELF_REGISTRATION, ` +
 				`SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
 				`WHERE 1=0 AND CATEGORY = $1 AND DEPLOYMENT_ID = $2 ORDER BY NAME LIMIT $3 OFFSET $4`,
-			SQLiteQuery: `SELECT ID, CATEGORY, NAME, OU_ID, ALLOW_SELF_REGISTRATION, ` +
-				`SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
-				`WHERE 1=0 AND CATEGORY = ? AND DEPLOYMENT_ID = ? ORDER BY NAME LIMIT ? OFFSET ?`,
+			SQLiteQuery: sqliteQuery,
+			MySQLQuery:  sqliteQuery,
 		}
 	}
 
@@ -94,6 +96,10 @@ func buildGetEntityTypeListByOUIDsQuery(ouIDs []string) dbmodel.DBQuery {
 	}
 	sqliteInClause := strings.Join(sqlitePlaceholders, ", ")
 
+	sqliteQuery := `SELECT ID, CATEGORY, NAME, OU_ID, ALLOW_SELF_REGISTRATION, ` +
+		`SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
+		`WHERE OU_ID IN (` + sqliteInClause + `) AND CATEGORY = ? AND DEPLOYMENT_ID = ? ` +
+		`ORDER BY NAME LIMIT ? OFFSET ?`
 	return dbmodel.DBQuery{
 		ID: "ASQ-ENTITY_TYPE-008",
 		PostgresQuery: `SELECT ID, CATEGORY, NAME, OU_ID, ALLOW_SELF_REGISTRATION, ` +
@@ -101,10 +107,8 @@ func buildGetEntityTypeListByOUIDsQuery(ouIDs []string) dbmodel.DBQuery {
 			`WHERE OU_ID IN (` + pgInClause + `) AND CATEGORY = ` + pgCategory +
 			` AND DEPLOYMENT_ID = ` + pgDeploymentID +
 			` ORDER BY NAME LIMIT ` + pgLimit + ` OFFSET ` + pgOffset,
-		SQLiteQuery: `SELECT ID, CATEGORY, NAME, OU_ID, ALLOW_SELF_REGISTRATION, ` +
-			`SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
-			`WHERE OU_ID IN (` + sqliteInClause + `) AND CATEGORY = ? AND DEPLOYMENT_ID = ? ` +
-			`ORDER BY NAME LIMIT ? OFFSET ?`,
+		SQLiteQuery: sqliteQuery,
+		MySQLQuery:  sqliteQuery,
 	}
 }
 
@@ -114,12 +118,14 @@ func buildGetEntityTypeCountByOUIDsQuery(ouIDs []string) dbmodel.DBQuery {
 	n := len(ouIDs)
 
 	if n == 0 {
+		sqliteQuery := `SELECT COUNT(*) AS total FROM "ENTITY_TYPES" ` +
+			`WHERE 1=0 AND CATEGORY = ? AND DEPLOYMENT_ID = ?`
 		return dbmodel.DBQuery{
 			ID: "ASQ-ENTITY_TYPE-009",
 			PostgresQuery: `SELECT COUNT(*) AS total FROM "ENTITY_TYPES" ` +
 				`WHERE 1=0 AND CATEGORY = $1 AND DEPLOYMENT_ID = $2`,
-			SQLiteQuery: `SELECT COUNT(*) AS total FROM "ENTITY_TYPES" ` +
-				`WHERE 1=0 AND CATEGORY = ? AND DEPLOYMENT_ID = ?`,
+			SQLiteQuery: sqliteQuery,
+			MySQLQuery:  sqliteQuery,
 		}
 	}
 
@@ -137,13 +143,15 @@ func buildGetEntityTypeCountByOUIDsQuery(ouIDs []string) dbmodel.DBQuery {
 	}
 	sqliteInClause := strings.Join(sqlitePlaceholders, ", ")
 
+	sqliteQuery := `SELECT COUNT(*) AS total FROM "ENTITY_TYPES" ` +
+		`WHERE OU_ID IN (` + sqliteInClause + `) AND CATEGORY = ? AND DEPLOYMENT_ID = ?`
 	return dbmodel.DBQuery{
 		ID: "ASQ-ENTITY_TYPE-009",
 		PostgresQuery: `SELECT COUNT(*) AS total FROM "ENTITY_TYPES" ` +
 			`WHERE OU_ID IN (` + pgInClause + `) AND CATEGORY = ` + pgCategory +
 			` AND DEPLOYMENT_ID = ` + pgDeploymentID,
-		SQLiteQuery: `SELECT COUNT(*) AS total FROM "ENTITY_TYPES" ` +
-			`WHERE OU_ID IN (` + sqliteInClause + `) AND CATEGORY = ? AND DEPLOYMENT_ID = ?`,
+		SQLiteQuery: sqliteQuery,
+		MySQLQuery:  sqliteQuery,
 	}
 }
 
@@ -153,12 +161,14 @@ func buildGetDisplayAttributesByNamesQuery(names []string) dbmodel.DBQuery {
 	n := len(names)
 
 	if n == 0 {
+		sqliteQuery := `SELECT NAME, SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
+			`WHERE 1=0 AND CATEGORY = ? AND DEPLOYMENT_ID = ?`
 		return dbmodel.DBQuery{
 			ID: "ASQ-ENTITY_TYPE-010",
 			PostgresQuery: `SELECT NAME, SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
 				`WHERE 1=0 AND CATEGORY = $1 AND DEPLOYMENT_ID = $2`,
-			SQLiteQuery: `SELECT NAME, SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
-				`WHERE 1=0 AND CATEGORY = ? AND DEPLOYMENT_ID = ?`,
+			SQLiteQuery: sqliteQuery,
+			MySQLQuery:  sqliteQuery,
 		}
 	}
 
@@ -176,12 +186,14 @@ func buildGetDisplayAttributesByNamesQuery(names []string) dbmodel.DBQuery {
 	}
 	sqliteInClause := strings.Join(sqlitePlaceholders, ", ")
 
+	sqliteQuery := `SELECT NAME, SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
+		`WHERE NAME IN (` + sqliteInClause + `) AND CATEGORY = ? AND DEPLOYMENT_ID = ?`
 	return dbmodel.DBQuery{
 		ID: "ASQ-ENTITY_TYPE-010",
 		PostgresQuery: `SELECT NAME, SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
 			`WHERE NAME IN (` + pgInClause + `) AND CATEGORY = ` + pgCategory +
 			` AND DEPLOYMENT_ID = ` + pgDeploymentID,
-		SQLiteQuery: `SELECT NAME, SYSTEM_ATTRIBUTES FROM "ENTITY_TYPES" ` +
-			`WHERE NAME IN (` + sqliteInClause + `) AND CATEGORY = ? AND DEPLOYMENT_ID = ?`,
+		SQLiteQuery: sqliteQuery,
+		MySQLQuery:  sqliteQuery,
 	}
 }
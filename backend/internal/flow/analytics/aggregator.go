@@ -0,0 +1,288 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const (
+	aggregatorComponentName = "FlowAnalyticsAggregator"
+
+	defaultFlushInterval = 30 * time.Second
+	defaultRetentionDays = 90
+
+	// bucketWidth is the width of the buckets the counters are stored in.
+	bucketWidth = time.Hour
+)
+
+// Flow-level metrics, stored without a node ID.
+const (
+	metricFlowStarted   = "started"
+	metricFlowCompleted = "completed"
+	metricFlowFailed    = "failed"
+)
+
+// Node-level metrics.
+const (
+	metricNodeEntered   = "entered"
+	metricNodeCompleted = "completed"
+	metricNodePrompted  = "prompted"
+	metricNodeFailed    = "failed"
+	// metricLatencySum is the total time the runs of a node took, in milliseconds.
+	metricLatencySum = "latency_sum_ms"
+	// latencyBucketPrefix prefixes the histogram buckets of node run latency. Each bucket counts the runs
+	// that took more than the previous bound and at most its own bound.
+	latencyBucketPrefix = "latency_le_"
+	// metricLatencyOverflow counts the runs that took longer than the largest bound.
+	metricLatencyOverflow = latencyBucketPrefix + "inf"
+)
+
+// latencyBoundsMs are the upper bounds of the node run latency histogram, in milliseconds.
+var latencyBoundsMs = []int64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// aggregatorSettings holds the resolved settings of the aggregator.
+type aggregatorSettings struct {
+	flushInterval time.Duration
+	retention     time.Duration
+}
+
+// newAggregatorSettings resolves the aggregator settings from the configuration, falling back to the
+// defaults for unset values.
+func newAggregatorSettings(cfg engineconfig.ObservabilityFlowAnalyticsConfig) aggregatorSettings {
+	settings := aggregatorSettings{
+		flushInterval: defaultFlushInterval,
+		retention:     defaultRetentionDays * 24 * time.Hour,
+	}
+	if cfg.FlushIntervalSeconds > 0 {
+		settings.flushInterval = time.Duration(cfg.FlushIntervalSeconds) * time.Second
+	}
+	if cfg.RetentionDays > 0 {
+		settings.retention = time.Duration(cfg.RetentionDays) * 24 * time.Hour
+	}
+	return settings
+}
+
+// pendingCount is the part of a counter not yet flushed to the store.
+type pendingCount struct {
+	nodeType string
+	delta    int64
+}
+
+// analyticsAggregator is the observability subscriber that rolls the flow events up into per-version,
+// per-node counters. Counts are kept in memory and periodically added to the counters in the runtime
+// database, so each server node only writes its own deltas.
+type analyticsAggregator struct {
+	id       string
+	store    analyticsStoreInterface
+	settings aggregatorSettings
+	now      func() time.Time
+	logger   *log.Logger
+
+	mu      sync.Mutex
+	pending map[counterKey]pendingCount
+	stop    chan struct{}
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+// newAnalyticsAggregator creates a new analyticsAggregator. Call Initialize to start flushing.
+func newAnalyticsAggregator(store analyticsStoreInterface, settings aggregatorSettings) *analyticsAggregator {
+	return &analyticsAggregator{
+		store:    store,
+		settings: settings,
+		now:      time.Now,
+		logger:   log.GetLogger().With(log.String(log.LoggerKeyComponentName, aggregatorComponentName)),
+		pending:  make(map[counterKey]pendingCount),
+		stop:     make(chan struct{}),
+	}
+}
+
+// GetID returns the unique identifier of the aggregator.
+func (a *analyticsAggregator) GetID() string {
+	return a.id
+}
+
+// GetCategories returns the flow events category.
+func (a *analyticsAggregator) GetCategories() []event.EventCategory {
+	return []event.EventCategory{event.CategoryFlows}
+}
+
+// IsEnabled always returns true; the aggregator is only created when flow analytics is enabled.
+func (a *analyticsAggregator) IsEnabled() bool {
+	return true
+}
+
+// Initialize starts the loop that flushes the counts to the store.
+func (a *analyticsAggregator) Initialize() error {
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate flow analytics aggregator id: %w", err)
+	}
+	a.id = id
+
+	a.wg.Add(1)
+	go a.flushLoop()
+	return nil
+}
+
+// OnEvent counts a flow event against the flow version it belongs to. Events without a flow ID are
+// ignored.
+func (a *analyticsAggregator) OnEvent(evt *providers.Event) error {
+	if evt == nil {
+		return fmt.Errorf("event is nil")
+	}
+	flowID := eventData(evt, event.DataKey.FlowID)
+	if flowID == "" {
+		return nil
+	}
+
+	version, _ := strconv.Atoi(eventData(evt, event.DataKey.FlowVersion))
+	timestamp := evt.Timestamp
+	if timestamp.IsZero() {
+		timestamp = a.now()
+	}
+	flowKey := counterKey{flowID: flowID, version: version, bucket: timestamp.UTC().Truncate(bucketWidth)}
+	nodeKey := flowKey
+	nodeKey.nodeID = eventData(evt, event.DataKey.NodeID)
+	nodeType := eventData(evt, event.DataKey.NodeType)
+
+	switch providers.EventType(evt.Type) {
+	case event.EventTypeFlowStarted:
+		a.add(flowKey, metricFlowStarted, "", 1)
+	case event.EventTypeFlowCompleted:
+		a.add(flowKey, metricFlowCompleted, "", 1)
+	case event.EventTypeFlowFailed:
+		a.add(flowKey, metricFlowFailed, "", 1)
+	case event.EventTypeFlowNodeExecutionStarted:
+		// Later attempts are the same execution returning to the node, so only the first one enters it.
+		if nodeKey.nodeID != "" && eventData(evt, event.DataKey.AttemptNumber) == "1" {
+			a.add(nodeKey, metricNodeEntered, nodeType, 1)
+		}
+	case event.EventTypeFlowNodeExecutionCompleted:
+		if nodeKey.nodeID == "" {
+			return nil
+		}
+		// An incomplete node is waiting for the user's input.
+		if eventData(evt, event.DataKey.NodeStatus) == string(providers.FlowStatusIncomplete) {
+			a.add(nodeKey, metricNodePrompted, nodeType, 1)
+		} else {
+			a.add(nodeKey, metricNodeCompleted, nodeType, 1)
+		}
+		a.addLatency(nodeKey, nodeType, eventData(evt, event.DataKey.DurationMs))
+	case event.EventTypeFlowNodeExecutionFailed:
+		if nodeKey.nodeID == "" {
+			return nil
+		}
+		a.add(nodeKey, metricNodeFailed, nodeType, 1)
+		a.addLatency(nodeKey, nodeType, eventData(evt, event.DataKey.DurationMs))
+	}
+	return nil
+}
+
+// Close stops the flush loop and flushes the remaining counts.
+func (a *analyticsAggregator) Close() error {
+	a.mu.Lock()
+	if a.closed {
+		a.mu.Unlock()
+		return nil
+	}
+	a.closed = true
+	close(a.stop)
+	a.mu.Unlock()
+
+	a.wg.Wait()
+	// Shutdown runs outside any request.
+	a.flush(context.Background())
+	return nil
+}
+
+// add adds delta to the pending count of a metric.
+func (a *analyticsAggregator) add(key counterKey, metric, nodeType string, delta int64) {
+	key.metric = metric
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	count := a.pending[key]
+	count.nodeType = nodeType
+	count.delta += delta
+	a.pending[key] = count
+}
+
+// addLatency records the duration of a node run in the latency histogram of the node.
+func (a *analyticsAggregator) addLatency(key counterKey, nodeType, durationMs string) {
+	duration, err := strconv.ParseInt(durationMs, 10, 64)
+	if err != nil || duration < 0 {
+		return
+	}
+	a.add(key, metricLatencySum, nodeType, duration)
+	a.add(key, latencyBucketMetric(duration), nodeType, 1)
+}
+
+// flushLoop periodically flushes the pending counts to the store.
+func (a *analyticsAggregator) flushLoop() {
+	defer a.wg.Done()
+	ticker := time.NewTicker(a.settings.flushInterval)
+	defer ticker.Stop()
+
+	// Flushing runs in the background, outside any request.
+	ctx := context.Background()
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-ticker.C:
+			a.flush(ctx)
+		}
+	}
+}
+
+// flush adds the pending counts to the counters in the store. Counts that fail to flush are kept for the
+// next flush.
+func (a *analyticsAggregator) flush(ctx context.Context) {
+	a.mu.Lock()
+	pending := a.pending
+	a.pending = make(map[counterKey]pendingCount)
+	a.mu.Unlock()
+
+	var failed int
+	var lastErr error
+	for key, count := range pending {
+		expiry := key.bucket.Add(a.settings.retention)
+		if err := a.store.AddToCounter(ctx, key, count.nodeType, count.delta, expiry); err != nil {
+			failed++
+			lastErr = err
+			a.add(key, key.metric, count.nodeType, count.delta)
+		}
+	}
+	if failed > 0 {
+		a.logger.Error(ctx, "Failed to flush flow analytics counters", log.Int("failedCounters", failed),
+			log.Error(lastErr))
+	}
+}
+
+// latencyBucketMetric returns the histogram bucket metric a node run of the given duration counts in.
+func latencyBucketMetric(durationMs int64) string {
+	for _, bound := range latencyBoundsMs {
+		if durationMs <= bound {
+			return latencyBucketPrefix + strconv.FormatInt(bound, 10)
+		}
+	}
+	return metricLatencyOverflow
+}
+
+// eventData returns a string value of the event data, or an empty string when it is not set.
+func eventData(evt *providers.Event, key string) string {
+	value, _ := evt.Data[key].(string)
+	return value
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type AnalyticsAggregatorTestSuite struct {
+	suite.Suite
+	mockStore  *analyticsStoreInterfaceMock
+	aggregator *analyticsAggregator
+	timestamp  time.Time
+}
+
+func TestAnalyticsAggregatorTestSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsAggregatorTestSuite))
+}
+
+func (suite *AnalyticsAggregatorTestSuite) SetupTest() {
+	suite.mockStore = NewanalyticsStoreInterfaceMock(suite.T())
+	settings := newAggregatorSettings(engineconfig.ObservabilityFlowAnalyticsConfig{RetentionDays: 1})
+	suite.aggregator = newAnalyticsAggregator(suite.mockStore, settings)
+	suite.timestamp = testBucket.Add(25 * time.Minute)
+}
+
+// flowEvent builds a flow event of version 2 of the test flow with the given data.
+func (suite *AnalyticsAggregatorTestSuite) flowEvent(eventType providers.EventType,
+	data map[string]interface{}) *providers.Event {
+	evt := &providers.Event{
+		Type:      string(eventType),
+		Timestamp: suite.timestamp,
+		Data:      map[string]interface{}{event.DataKey.FlowID: testFlowID, event.DataKey.FlowVersion: "2"},
+	}
+	for key, value := range data {
+		evt.Data[key] = value
+	}
+	return evt
+}
+
+func (suite *AnalyticsAggregatorTestSuite) pending(nodeID, metric string) pendingCount {
+	return suite.aggregator.pending[counterKey{
+		flowID: testFlowID, version: 2, bucket: testBucket, nodeID: nodeID, metric: metric,
+	}]
+}
+
+func (suite *AnalyticsAggregatorTestSuite) TestNewAggregatorSettings_Defaults() {
+	settings := newAggregatorSettings(engineconfig.ObservabilityFlowAnalyticsConfig{})
+	suite.Equal(defaultFlushInterval, settings.flushInterval)
+	suite.Equal(defaultRetentionDays*24*time.Hour, settings.retention)
+
+	settings = newAggregatorSettings(engineconfig.ObservabilityFlowAnalyticsConfig{
+		FlushIntervalSeconds: 5, RetentionDays: 7,
+	})
+	suite.Equal(5*time.Second, settings.flushInterval)
+	suite.Equal(7*24*time.Hour, settings.retention)
+}
+
+func (suite *AnalyticsAggregatorTestSuite) TestGetCategories() {
+	suite.Equal([]event.EventCategory{event.CategoryFlows}, suite.aggregator.GetCategories())
+	suite.True(suite.aggregator.IsEnabled())
+}
+
+func (suite *AnalyticsAggregatorTestSuite) TestOnEvent_FlowEvents() {
+	suite.NoError(suite.aggregator.OnEvent(suite.flowEvent(event.EventTypeFlowStarted, nil)))
+	suite.NoError(suite.aggregator.OnEvent(suite.flowEvent(event.EventTypeFlowStarted, nil)))
+	suite.NoError(suite.aggregator.OnEvent(suite.flowEvent(event.EventTypeFlowCompleted, nil)))
+	suite.NoError(suite.aggregator.OnEvent(suite.flowEvent(event.EventTypeFlowFailed, nil)))
+
+	suite.Equal(int64(2), suite.pending("", metricFlowStarted).delta)
+	suite.Equal(int64(1), suite.pending("", metricFlowCompleted).delta)
+	suite.Equal(int64(1), suite.pending("", metricFlowFailed).delta)
+}
+
+func (suite *AnalyticsAggregatorTestSuite) TestOnEvent_NodeEvents() {
+	node := map[string]interface{}{event.DataKey.NodeID: "prompt", event.DataKey.NodeType: "PROMPT"}
+	withData := func(data map[string]interface{}) map[string]interface{} {
+		for key, value := range node {
+			data[key] = value
+		}
+		return data
+	}
+
+	events := []*providers.Event{
+		suite.flowEvent(event.EventTypeFlowNodeExecutionStarted,
+			withData(map[string]interface{}{event.DataKey.AttemptNumber: "1"})),
+		suite.flowEvent(event.EventTypeFlowNodeExecutionCompleted, withData(map[string]interface{}{
+			event.DataKey.NodeStatus: string(providers.FlowStatusIncomplete), event.DataKey.DurationMs: "3",
+		})),
+		suite.flowEvent(event.EventTypeFlowNodeExecutionStarted,
+			withData(map[string]interface{}{event.DataKey.AttemptNumber: "2"})),
+		suite.flowEvent(event.EventTypeFlowNodeExecutionCompleted, withData(map[string]interface{}{
+			event.DataKey.NodeStatus: string(providers.FlowStatusComplete), event.DataKey.DurationMs: "40",
+		})),
+		suite.flowEvent(event.EventTypeFlowNodeExecutionFailed, withData(map[string]interface{}{
+			event.DataKey.NodeStatus: string(providers.FlowStatusError), event.DataKey.DurationMs: "20000",
+		})),
+	}
+	for _, evt := range events {
+		suite.NoError(suite.aggregator.OnEvent(evt))
+	}
+
+	suite.Equal(pendingCount{nodeType: "PROMPT", delta: 1}, suite.pending("prompt", metricNodeEntered))
+	suite.Equal(int64(1), suite.pending("prompt", metricNodePrompted).delta)
+	suite.Equal(int64(1), suite.pending("prompt", metricNodeCompleted).delta)
+	suite.Equal(int64(1), suite.pending("prompt", metricNodeFailed).delta)
+	suite.Equal(int64(20043), suite.pending("prompt", metricLatencySum).delta)
+	suite.Equal(int64(1), suite.pending("prompt", "latency_le_5").delta)
+	suite.Equal(int64(1), suite.pending("prompt", "latency_le_50").delta)
+	suite.Equal(int64(1), suite.pending("prompt", metricLatencyOverflow).delta)
+}
+
+func (suite *AnalyticsAggregatorTestSuite) TestOnEvent_IgnoresEventsWithoutFlowID() {
+	evt := suite.flowEvent(event.EventTypeFlowStarted, nil)
+	delete(evt.Data, event.DataKey.FlowID)
+
+	suite.NoError(suite.aggregator.OnEvent(evt))
+	suite.Empty(suite.aggregator.pending)
+}
+
+func (suite *AnalyticsAggregatorTestSuite) TestOnEvent_NilEvent() {
+	suite.Error(suite.aggregator.OnEvent(nil))
+}
+
+func (suite *AnalyticsAggregatorTestSuite) TestFlush() {
+	suite.NoError(suite.aggregator.OnEvent(suite.flowEvent(event.EventTypeFlowStarted, nil)))
+	key := counterKey{flowID: testFlowID, version: 2, bucket: testBucket, metric: metricFlowStarted}
+	suite.mockStore.On("AddToCounter", mock.Anything, key, "", int64(1), testBucket.Add(24*time.Hour)).
+		Return(nil).Once()
+
+	suite.aggregator.flush(context.Background())
+	suite.Empty(suite.aggregator.pending)
+}
+
+func (suite *AnalyticsAggregatorTestSuite) TestFlush_KeepsFailedCounts() {
+	suite.NoError(suite.aggregator.OnEvent(suite.flowEvent(event.EventTypeFlowStarted, nil)))
+	suite.mockStore.On("AddToCounter", mock.Anything, mock.Anything, "", int64(1), mock.Anything).
+		Return(errors.New("db down")).Once()
+
+	suite.aggregator.flush(context.Background())
+	suite.Equal(int64(1), suite.pending("", metricFlowStarted).delta)
+
+	suite.NoError(suite.aggregator.OnEvent(suite.flowEvent(event.EventTypeFlowStarted, nil)))
+	suite.mockStore.On("AddToCounter", mock.Anything, mock.Anything, "", int64(2), mock.Anything).
+		Return(nil).Once()
+	suite.aggregator.flush(context.Background())
+	suite.Empty(suite.aggregator.pending)
+}
+
+func (suite *AnalyticsAggregatorTestSuite) TestClose_FlushesPendingCounts() {
+	suite.Require().NoError(suite.aggregator.Initialize())
+	suite.NotEmpty(suite.aggregator.GetID())
+	suite.NoError(suite.aggregator.OnEvent(suite.flowEvent(event.EventTypeFlowCompleted, nil)))
+	suite.mockStore.On("AddToCounter", mock.Anything, mock.Anything, "", int64(1), mock.Anything).
+		Return(nil).Once()
+
+	suite.NoError(suite.aggregator.Close())
+	suite.NoError(suite.aggregator.Close())
+	suite.Empty(suite.aggregator.pending)
+}
+
+func (suite *AnalyticsAggregatorTestSuite) TestLatencyBucketMetric() {
+	suite.Equal("latency_le_5", latencyBucketMetric(0))
+	suite.Equal("latency_le_10", latencyBucketMetric(6))
+	suite.Equal("latency_le_10000", latencyBucketMetric(10000))
+	suite.Equal(metricLatencyOverflow, latencyBucketMetric(10001))
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for flow analytics operations.
+var (
+	// ErrorInvalidTimeRange indicates a malformed time range or one that does not end after it starts.
+	ErrorInvalidTimeRange = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FAN-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowanalyticsservice.invalid_time_range",
+			DefaultValue: "Invalid time range",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowanalyticsservice.invalid_time_range_description",
+			DefaultValue: "The from and to parameters must be RFC 3339 timestamps and from must be before to",
+		},
+	}
+	// ErrorInvalidInterval indicates an unsupported bucket interval.
+	ErrorInvalidInterval = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FAN-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowanalyticsservice.invalid_interval",
+			DefaultValue: "Invalid interval",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowanalyticsservice.invalid_interval_description",
+			DefaultValue: "The interval must be either hour or day",
+		},
+	}
+	// ErrorInvalidVersion indicates a version filter that is not a positive integer.
+	ErrorInvalidVersion = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FAN-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowanalyticsservice.invalid_version",
+			DefaultValue: "Invalid version",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowanalyticsservice.invalid_version_description",
+			DefaultValue: "The version must be a positive integer",
+		},
+	}
+	// ErrorTimeRangeTooLarge indicates a time range that spans more buckets than a response may hold.
+	ErrorTimeRangeTooLarge = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FAN-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowanalyticsservice.time_range_too_large",
+			DefaultValue: "Time range too large",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowanalyticsservice.time_range_too_large_description",
+			DefaultValue: "The time range spans more than 744 buckets; use a shorter range or a larger interval",
+		},
+	}
+)
@@ -0,0 +1,103 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	analyticsPath = "/flows/{flowId}/analytics"
+
+	// defaultTimeRange is the time range reported when the request does not set one.
+	defaultTimeRange = 24 * time.Hour
+)
+
+// analyticsHandler serves the flow analytics API.
+type analyticsHandler struct {
+	service AnalyticsServiceInterface
+	now     func() time.Time
+}
+
+// newAnalyticsHandler creates a new instance of analyticsHandler.
+func newAnalyticsHandler(service AnalyticsServiceInterface) *analyticsHandler {
+	return &analyticsHandler{service: service, now: time.Now}
+}
+
+// HandleGetAnalytics returns the funnel report of a flow. The from and to query parameters bound the time
+// range and default to the last 24 hours; interval sets the bucket width and defaults to an hour; version
+// limits the report to one flow version.
+func (h *analyticsHandler) HandleGetAnalytics(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	query, svcErr := h.parseQuery(r)
+	if svcErr != nil {
+		writeAnalyticsError(r.Context(), w, svcErr)
+		return
+	}
+	analytics, svcErr := h.service.GetFlowAnalytics(r.Context(), flowID, query)
+	if svcErr != nil {
+		writeAnalyticsError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, analytics)
+}
+
+// parseQuery reads the analytics query from the query parameters of the request.
+func (h *analyticsHandler) parseQuery(r *http.Request) (AnalyticsQuery, *tidcommon.ServiceError) {
+	params := r.URL.Query()
+	query := AnalyticsQuery{Interval: IntervalHour, To: h.now()}
+
+	if value := strings.TrimSpace(params.Get("interval")); value != "" {
+		query.Interval = value
+	}
+	if value := strings.TrimSpace(params.Get("to")); value != "" {
+		to, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return query, &ErrorInvalidTimeRange
+		}
+		query.To = to
+	}
+	query.From = query.To.Add(-defaultTimeRange)
+	if value := strings.TrimSpace(params.Get("from")); value != "" {
+		from, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return query, &ErrorInvalidTimeRange
+		}
+		query.From = from
+	}
+	if value := strings.TrimSpace(params.Get("version")); value != "" {
+		version, err := strconv.Atoi(value)
+		if err != nil || version <= 0 {
+			return query, &ErrorInvalidVersion
+		}
+		query.Version = version
+	}
+	return query, nil
+}
+
+// writeAnalyticsError maps a service error to an HTTP status and writes the corresponding error response.
+func writeAnalyticsError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	status := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		status = http.StatusBadRequest
+		if svcErr.Code == flowmgt.ErrorFlowNotFound.Code {
+			status = http.StatusNotFound
+		}
+	}
+	sysutils.WriteErrorResponse(ctx, w, status, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type AnalyticsHandlerTestSuite struct {
+	suite.Suite
+	mockService *AnalyticsServiceInterfaceMock
+	mux         *http.ServeMux
+	now         time.Time
+}
+
+func TestAnalyticsHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsHandlerTestSuite))
+}
+
+func (suite *AnalyticsHandlerTestSuite) SetupTest() {
+	suite.mockService = NewAnalyticsServiceInterfaceMock(suite.T())
+	suite.now = time.Date(2026, 3, 5, 12, 30, 0, 0, time.UTC)
+	handler := newAnalyticsHandler(suite.mockService)
+	handler.now = func() time.Time { return suite.now }
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, handler)
+}
+
+func (suite *AnalyticsHandlerTestSuite) serve(method, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *AnalyticsHandlerTestSuite) errorCode(w *httptest.ResponseRecorder) string {
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	return errResp.Code
+}
+
+func (suite *AnalyticsHandlerTestSuite) TestHandleGetAnalytics_Defaults() {
+	query := AnalyticsQuery{From: suite.now.Add(-24 * time.Hour), To: suite.now, Interval: IntervalHour}
+	suite.mockService.On("GetFlowAnalytics", mock.Anything, testFlowID, query).
+		Return(&FlowAnalytics{FlowID: testFlowID, Interval: IntervalHour}, nil)
+
+	w := suite.serve(http.MethodGet, "/flows/flow-1/analytics")
+
+	suite.Equal(http.StatusOK, w.Code)
+	var analytics FlowAnalytics
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &analytics))
+	suite.Equal(testFlowID, analytics.FlowID)
+}
+
+func (suite *AnalyticsHandlerTestSuite) TestHandleGetAnalytics_QueryParameters() {
+	query := AnalyticsQuery{
+		From:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+		Interval: IntervalDay,
+		Version:  3,
+	}
+	suite.mockService.On("GetFlowAnalytics", mock.Anything, testFlowID, mock.MatchedBy(func(q AnalyticsQuery) bool {
+		return q.From.Equal(query.From) && q.To.Equal(query.To) && q.Interval == query.Interval &&
+			q.Version == query.Version
+	})).Return(&FlowAnalytics{FlowID: testFlowID}, nil)
+
+	w := suite.serve(http.MethodGet,
+		"/flows/flow-1/analytics?from=2026-03-01T00:00:00Z&to=2026-03-04T00:00:00Z&interval=day&version=3")
+
+	suite.Equal(http.StatusOK, w.Code)
+}
+
+func (suite *AnalyticsHandlerTestSuite) TestHandleGetAnalytics_InvalidParameters() {
+	cases := map[string]string{
+		"/flows/flow-1/analytics?from=yesterday": ErrorInvalidTimeRange.Code,
+		"/flows/flow-1/analytics?to=2026-03-04":  ErrorInvalidTimeRange.Code,
+		"/flows/flow-1/analytics?version=latest": ErrorInvalidVersion.Code,
+		"/flows/flow-1/analytics?version=0":      ErrorInvalidVersion.Code,
+	}
+	for path, code := range cases {
+		w := suite.serve(http.MethodGet, path)
+		suite.Equal(http.StatusBadRequest, w.Code, path)
+		suite.Equal(code, suite.errorCode(w), path)
+	}
+}
+
+func (suite *AnalyticsHandlerTestSuite) TestHandleGetAnalytics_Errors() {
+	cases := []struct {
+		svcErr *tidcommon.ServiceError
+		status int
+	}{
+		{&flowmgt.ErrorFlowNotFound, http.StatusNotFound},
+		{&ErrorTimeRangeTooLarge, http.StatusBadRequest},
+		{&tidcommon.InternalServerError, http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.mockService.On("GetFlowAnalytics", mock.Anything, testFlowID, mock.Anything).
+			Return(nil, tc.svcErr).Once()
+
+		w := suite.serve(http.MethodGet, "/flows/flow-1/analytics")
+		suite.Equal(tc.status, w.Code)
+		suite.Equal(tc.svcErr.Code, suite.errorCode(w))
+	}
+}
+
+func (suite *AnalyticsHandlerTestSuite) TestOptions() {
+	w := suite.serve(http.MethodOptions, "/flows/flow-1/analytics")
+	suite.Equal(http.StatusNoContent, w.Code)
+}
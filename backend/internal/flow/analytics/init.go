@@ -0,0 +1,73 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package analytics provides flow funnel analytics: the observability subscriber that rolls the flow
+// events up into per-version, per-node counters and latency histograms in the runtime database, and the
+// API that reports them as time-bucketed funnels.
+package analytics
+
+import (
+	"context"
+	"net/http"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+)
+
+// Initialize constructs the flow analytics service and registers its routes. When
+// observability.output.flow_analytics is enabled, it also starts the aggregator and subscribes it to the
+// observability publisher; the publisher closes it on shutdown.
+func Initialize(
+	mux *http.ServeMux,
+	deploymentID string,
+	flowMgtService flowmgt.FlowMgtServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) AnalyticsServiceInterface {
+	analyticsConfig := config.GetServerRuntime().Config.Observability.Output.FlowAnalytics
+	store := newAnalyticsStore(deploymentID)
+	if analyticsConfig.Enabled {
+		startAggregator(store, newAggregatorSettings(analyticsConfig), observabilitySvc)
+	}
+
+	analyticsService := newAnalyticsService(store, flowMgtService)
+	registerRoutes(mux, newAnalyticsHandler(analyticsService))
+	return analyticsService
+}
+
+// startAggregator starts the flow analytics aggregator and subscribes it to the observability publisher.
+func startAggregator(store analyticsStoreInterface, settings aggregatorSettings,
+	observabilitySvc observability.ObservabilityServiceInterface) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, aggregatorComponentName))
+	// The aggregator starts during application startup, outside any request.
+	ctx := context.Background()
+
+	if observabilitySvc == nil || observabilitySvc.GetPublisher() == nil {
+		logger.Warn(ctx, "Flow analytics is enabled but observability is disabled; no flow events will be counted")
+		return
+	}
+
+	aggregator := newAnalyticsAggregator(store, settings)
+	if err := aggregator.Initialize(); err != nil {
+		logger.Error(ctx, "Failed to start the flow analytics aggregator", log.Error(err))
+		return
+	}
+	observabilitySvc.GetPublisher().Subscribe(aggregator)
+}
+
+// registerRoutes registers the flow analytics routes.
+func registerRoutes(mux *http.ServeMux, h *analyticsHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+analyticsPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleGetAnalytics)).ServeHTTP, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+analyticsPath,
+		func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) }, opts))
+}
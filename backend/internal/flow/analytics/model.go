@@ -0,0 +1,116 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import "time"
+
+// Bucket intervals of the flow analytics API.
+const (
+	// IntervalHour groups the counters of a flow into hourly buckets.
+	IntervalHour = "hour"
+	// IntervalDay groups the counters of a flow into daily buckets, starting at midnight UTC.
+	IntervalDay = "day"
+)
+
+// AnalyticsQuery selects the executions a funnel report covers.
+type AnalyticsQuery struct {
+	// From is the start of the time range. It is aligned down to the start of its bucket.
+	From time.Time
+	// To is the end of the time range. It is aligned up to the end of its bucket.
+	To time.Time
+	// Interval is the width of the buckets, either IntervalHour or IntervalDay.
+	Interval string
+	// Version limits the report to executions of one flow version. When zero, every version is reported.
+	Version int
+}
+
+// FlowAnalytics is the funnel report of a flow over a time range.
+type FlowAnalytics struct {
+	FlowID   string    `json:"flowId"`
+	Version  int       `json:"version,omitempty"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Interval string    `json:"interval"`
+	// Total is the funnel of the whole time range.
+	Total Funnel `json:"total"`
+	// Versions holds the execution counts of each flow version that ran in the time range.
+	Versions []VersionCounts `json:"versions"`
+	// Buckets holds the funnel of each bucket in the time range, including the buckets without executions.
+	Buckets []FunnelBucket `json:"buckets"`
+}
+
+// Funnel holds the execution counts of a flow and the counts of each node the executions reached. Nodes
+// are ordered by the number of executions that entered them, so the order follows the path most users
+// take through the flow.
+type Funnel struct {
+	Flow  FlowCounts   `json:"flow"`
+	Nodes []NodeFunnel `json:"nodes"`
+}
+
+// FunnelBucket is the funnel of the executions in one bucket.
+type FunnelBucket struct {
+	Start time.Time `json:"start"`
+	Funnel
+}
+
+// VersionCounts holds the execution counts of one flow version.
+type VersionCounts struct {
+	Version int        `json:"version"`
+	Flow    FlowCounts `json:"flow"`
+}
+
+// FlowCounts holds the number of executions of a flow that started, completed and failed. Abandoned is
+// the number of started executions that neither completed nor failed; it includes the executions still in
+// progress.
+type FlowCounts struct {
+	Started   int64 `json:"started"`
+	Completed int64 `json:"completed"`
+	Failed    int64 `json:"failed"`
+	Abandoned int64 `json:"abandoned"`
+}
+
+// NodeFunnel holds the counts of one node. Entered counts the executions that reached the node, and
+// DropOff the entered executions that did not complete it. Prompted counts the times the node asked the
+// user for input.
+type NodeFunnel struct {
+	NodeID    string           `json:"nodeId"`
+	NodeType  string           `json:"nodeType,omitempty"`
+	Entered   int64            `json:"entered"`
+	Completed int64            `json:"completed"`
+	Prompted  int64            `json:"prompted"`
+	Failed    int64            `json:"failed"`
+	DropOff   int64            `json:"dropOff"`
+	Latency   LatencyHistogram `json:"latency"`
+}
+
+// LatencyHistogram is the distribution of the time a node took to run, in milliseconds.
+type LatencyHistogram struct {
+	Count   int64           `json:"count"`
+	AvgMs   int64           `json:"avgMs"`
+	Buckets []LatencyBucket `json:"buckets"`
+}
+
+// LatencyBucket holds the number of node runs that took at most LeMs milliseconds. Counts are cumulative;
+// the last bucket has no LeMs and counts every run.
+type LatencyBucket struct {
+	LeMs  int64 `json:"leMs,omitempty"`
+	Count int64 `json:"count"`
+}
+
+// counterKey identifies a counter of a flow version in an hourly bucket. Flow-level counters have no
+// node ID.
+type counterKey struct {
+	flowID  string
+	version int
+	bucket  time.Time
+	nodeID  string
+	metric  string
+}
+
+// counter is a persisted counter.
+type counter struct {
+	counterKey
+	nodeType string
+	value    int64
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	loggerComponentName = "FlowAnalyticsService"
+
+	// maxBuckets is the number of buckets a report may hold: a month of hourly buckets.
+	maxBuckets = 744
+)
+
+// AnalyticsServiceInterface defines the funnel reports of flows built from the flow analytics counters.
+type AnalyticsServiceInterface interface {
+	// GetFlowAnalytics returns the funnel report of a flow over the time range of the query.
+	GetFlowAnalytics(ctx context.Context, flowID string, query AnalyticsQuery) (
+		*FlowAnalytics, *tidcommon.ServiceError)
+}
+
+// analyticsService is the default implementation of AnalyticsServiceInterface.
+type analyticsService struct {
+	store          analyticsStoreInterface
+	flowMgtService flowmgt.FlowMgtServiceInterface
+	logger         *log.Logger
+}
+
+// newAnalyticsService creates a new instance of analyticsService.
+func newAnalyticsService(store analyticsStoreInterface,
+	flowMgtService flowmgt.FlowMgtServiceInterface) AnalyticsServiceInterface {
+	return &analyticsService{
+		store:          store,
+		flowMgtService: flowMgtService,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetFlowAnalytics returns the funnel report of a flow over the time range of the query. The range is
+// widened to whole buckets, and every bucket in it is reported, including the ones without executions.
+func (s *analyticsService) GetFlowAnalytics(ctx context.Context, flowID string, query AnalyticsQuery) (
+	*FlowAnalytics, *tidcommon.ServiceError) {
+	var width time.Duration
+	switch query.Interval {
+	case IntervalHour:
+		width = time.Hour
+	case IntervalDay:
+		width = 24 * time.Hour
+	default:
+		return nil, &ErrorInvalidInterval
+	}
+	if query.Version < 0 {
+		return nil, &ErrorInvalidVersion
+	}
+	if query.From.IsZero() || query.To.IsZero() || !query.From.Before(query.To) {
+		return nil, &ErrorInvalidTimeRange
+	}
+
+	from := query.From.UTC().Truncate(width)
+	to := query.To.UTC().Truncate(width)
+	if to.Before(query.To) {
+		to = to.Add(width)
+	}
+	bucketCount := int(to.Sub(from) / width)
+	if bucketCount > maxBuckets {
+		return nil, &ErrorTimeRangeTooLarge
+	}
+
+	if _, svcErr := s.flowMgtService.GetFlow(ctx, flowID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	counters, err := s.store.ListCounters(ctx, flowID, query.Version, from, to)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list flow analytics counters", log.String("flowID", flowID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	total := newFunnelBuilder()
+	buckets := make([]*funnelBuilder, bucketCount)
+	for i := range buckets {
+		buckets[i] = newFunnelBuilder()
+	}
+	versions := make(map[int]*FlowCounts)
+	for _, c := range counters {
+		index := int(c.bucket.UTC().Truncate(width).Sub(from) / width)
+		if index < 0 || index >= bucketCount {
+			continue
+		}
+		total.add(c)
+		buckets[index].add(c)
+		if c.nodeID == "" {
+			if versions[c.version] == nil {
+				versions[c.version] = &FlowCounts{}
+			}
+			addFlowCount(versions[c.version], c)
+		}
+	}
+
+	analytics := &FlowAnalytics{
+		FlowID:   flowID,
+		Version:  query.Version,
+		From:     from,
+		To:       to,
+		Interval: query.Interval,
+		Total:    total.build(),
+		Versions: make([]VersionCounts, 0, len(versions)),
+		Buckets:  make([]FunnelBucket, 0, bucketCount),
+	}
+	for version, counts := range versions {
+		counts.Abandoned = abandoned(*counts)
+		analytics.Versions = append(analytics.Versions, VersionCounts{Version: version, Flow: *counts})
+	}
+	slices.SortFunc(analytics.Versions, func(a, b VersionCounts) int { return a.Version - b.Version })
+	for i, bucket := range buckets {
+		analytics.Buckets = append(analytics.Buckets, FunnelBucket{
+			Start:  from.Add(time.Duration(i) * width),
+			Funnel: bucket.build(),
+		})
+	}
+	return analytics, nil
+}
+
+// funnelBuilder sums the counters of a funnel.
+type funnelBuilder struct {
+	flow  FlowCounts
+	nodes map[string]*nodeBuilder
+}
+
+// nodeBuilder sums the counters of a node.
+type nodeBuilder struct {
+	funnel     NodeFunnel
+	latencySum int64
+	// latencyCounts holds the runs in each latency histogram bucket, keyed by the bucket bound; the
+	// overflow bucket is keyed by zero.
+	latencyCounts map[int64]int64
+}
+
+// newFunnelBuilder creates an empty funnelBuilder.
+func newFunnelBuilder() *funnelBuilder {
+	return &funnelBuilder{nodes: make(map[string]*nodeBuilder)}
+}
+
+// add adds a counter to the funnel.
+func (b *funnelBuilder) add(c counter) {
+	if c.nodeID == "" {
+		addFlowCount(&b.flow, c)
+		return
+	}
+
+	node := b.nodes[c.nodeID]
+	if node == nil {
+		node = &nodeBuilder{
+			funnel:        NodeFunnel{NodeID: c.nodeID},
+			latencyCounts: make(map[int64]int64),
+		}
+		b.nodes[c.nodeID] = node
+	}
+	if node.funnel.NodeType == "" {
+		node.funnel.NodeType = c.nodeType
+	}
+
+	switch c.metric {
+	case metricNodeEntered:
+		node.funnel.Entered += c.value
+	case metricNodeCompleted:
+		node.funnel.Completed += c.value
+	case metricNodePrompted:
+		node.funnel.Prompted += c.value
+	case metricNodeFailed:
+		node.funnel.Failed += c.value
+	case metricLatencySum:
+		node.latencySum += c.value
+	case metricLatencyOverflow:
+		node.latencyCounts[0] += c.value
+	default:
+		bound, err := strconv.ParseInt(strings.TrimPrefix(c.metric, latencyBucketPrefix), 10, 64)
+		if err == nil && strings.HasPrefix(c.metric, latencyBucketPrefix) && bound > 0 {
+			node.latencyCounts[bound] += c.value
+		}
+	}
+}
+
+// build returns the funnel, with the nodes ordered by the executions that entered them.
+func (b *funnelBuilder) build() Funnel {
+	funnel := Funnel{Flow: b.flow, Nodes: make([]NodeFunnel, 0, len(b.nodes))}
+	funnel.Flow.Abandoned = abandoned(b.flow)
+	for _, node := range b.nodes {
+		funnel.Nodes = append(funnel.Nodes, node.build())
+	}
+	slices.SortFunc(funnel.Nodes, func(a, b NodeFunnel) int {
+		if a.Entered != b.Entered {
+			if a.Entered > b.Entered {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.NodeID, b.NodeID)
+	})
+	return funnel
+}
+
+// build returns the node funnel with its drop-off and cumulative latency histogram.
+func (n *nodeBuilder) build() NodeFunnel {
+	funnel := n.funnel
+	funnel.DropOff = max(funnel.Entered-funnel.Completed, 0)
+
+	histogram := LatencyHistogram{Buckets: make([]LatencyBucket, 0, len(latencyBoundsMs)+1)}
+	for _, bound := range latencyBoundsMs {
+		histogram.Count += n.latencyCounts[bound]
+		histogram.Buckets = append(histogram.Buckets, LatencyBucket{LeMs: bound, Count: histogram.Count})
+	}
+	histogram.Count += n.latencyCounts[0]
+	histogram.Buckets = append(histogram.Buckets, LatencyBucket{Count: histogram.Count})
+	if histogram.Count > 0 {
+		histogram.AvgMs = n.latencySum / histogram.Count
+	}
+	funnel.Latency = histogram
+	return funnel
+}
+
+// addFlowCount adds a flow-level counter to the flow counts.
+func addFlowCount(counts *FlowCounts, c counter) {
+	switch c.metric {
+	case metricFlowStarted:
+		counts.Started += c.value
+	case metricFlowCompleted:
+		counts.Completed += c.value
+	case metricFlowFailed:
+		counts.Failed += c.value
+	}
+}
+
+// abandoned returns the started executions that neither completed nor failed.
+func abandoned(counts FlowCounts) int64 {
+	return max(counts.Started-counts.Completed-counts.Failed, 0)
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
+)
+
+type AnalyticsServiceTestSuite struct {
+	suite.Suite
+	mockStore   *analyticsStoreInterfaceMock
+	mockFlowMgt *flowmgtmock.FlowMgtServiceInterfaceMock
+	service     AnalyticsServiceInterface
+}
+
+func TestAnalyticsServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsServiceTestSuite))
+}
+
+func (suite *AnalyticsServiceTestSuite) SetupTest() {
+	suite.mockStore = NewanalyticsStoreInterfaceMock(suite.T())
+	suite.mockFlowMgt = flowmgtmock.NewFlowMgtServiceInterfaceMock(suite.T())
+	suite.service = newAnalyticsService(suite.mockStore, suite.mockFlowMgt)
+}
+
+func (suite *AnalyticsServiceTestSuite) onGetFlow() {
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).
+		Return(&providers.CompleteFlowDefinition{ID: testFlowID}, nil)
+}
+
+// flowCounter builds a flow-level counter of the test flow.
+func flowCounter(version int, bucket time.Time, metric string, value int64) counter {
+	return counter{
+		counterKey: counterKey{flowID: testFlowID, version: version, bucket: bucket, metric: metric},
+		value:      value,
+	}
+}
+
+// nodeCounter builds a node-level counter of version 1 of the test flow.
+func nodeCounter(bucket time.Time, nodeID, metric string, value int64) counter {
+	return counter{
+		counterKey: counterKey{flowID: testFlowID, version: 1, bucket: bucket, nodeID: nodeID, metric: metric},
+		nodeType:   "PROMPT",
+		value:      value,
+	}
+}
+
+func (suite *AnalyticsServiceTestSuite) TestGetFlowAnalytics_HourlyFunnel() {
+	from := testBucket.Add(10 * time.Minute)
+	to := testBucket.Add(2*time.Hour + 5*time.Minute)
+	next := testBucket.Add(time.Hour)
+	suite.onGetFlow()
+	suite.mockStore.On("ListCounters", mock.Anything, testFlowID, 0, testBucket, testBucket.Add(3*time.Hour)).
+		Return([]counter{
+			flowCounter(1, testBucket, metricFlowStarted, 10),
+			flowCounter(1, testBucket, metricFlowCompleted, 6),
+			flowCounter(1, testBucket, metricFlowFailed, 1),
+			flowCounter(2, next, metricFlowStarted, 4),
+			nodeCounter(testBucket, "credentials", metricNodeEntered, 10),
+			nodeCounter(testBucket, "credentials", metricNodeCompleted, 7),
+			nodeCounter(testBucket, "credentials", metricNodePrompted, 12),
+			nodeCounter(testBucket, "credentials", "latency_le_5", 3),
+			nodeCounter(testBucket, "credentials", "latency_le_50", 1),
+			nodeCounter(testBucket, "credentials", metricLatencyOverflow, 1),
+			nodeCounter(testBucket, "credentials", metricLatencySum, 25000),
+			nodeCounter(testBucket, "otp", metricNodeEntered, 7),
+			nodeCounter(testBucket, "otp", metricNodeCompleted, 9),
+			nodeCounter(next, "otp", metricNodeFailed, 2),
+		}, nil)
+
+	analytics, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, AnalyticsQuery{
+		From: from, To: to, Interval: IntervalHour,
+	})
+	suite.Require().Nil(svcErr)
+
+	suite.Equal(testBucket, analytics.From)
+	suite.Equal(testBucket.Add(3*time.Hour), analytics.To)
+	suite.Equal(FlowCounts{Started: 14, Completed: 6, Failed: 1, Abandoned: 7}, analytics.Total.Flow)
+	suite.Equal([]VersionCounts{
+		{Version: 1, Flow: FlowCounts{Started: 10, Completed: 6, Failed: 1, Abandoned: 3}},
+		{Version: 2, Flow: FlowCounts{Started: 4, Abandoned: 4}},
+	}, analytics.Versions)
+
+	suite.Require().Len(analytics.Total.Nodes, 2)
+	credentials := analytics.Total.Nodes[0]
+	suite.Equal("credentials", credentials.NodeID)
+	suite.Equal("PROMPT", credentials.NodeType)
+	suite.Equal(int64(3), credentials.DropOff)
+	suite.Equal(int64(12), credentials.Prompted)
+	suite.Equal(int64(5), credentials.Latency.Count)
+	suite.Equal(int64(5000), credentials.Latency.AvgMs)
+	suite.Len(credentials.Latency.Buckets, len(latencyBoundsMs)+1)
+	suite.Equal(LatencyBucket{LeMs: 5, Count: 3}, credentials.Latency.Buckets[0])
+	suite.Equal(LatencyBucket{LeMs: 50, Count: 4}, credentials.Latency.Buckets[3])
+	suite.Equal(LatencyBucket{Count: 5}, credentials.Latency.Buckets[len(latencyBoundsMs)])
+	otp := analytics.Total.Nodes[1]
+	suite.Equal("otp", otp.NodeID)
+	suite.Equal(int64(0), otp.DropOff)
+	suite.Equal(int64(2), otp.Failed)
+
+	suite.Require().Len(analytics.Buckets, 3)
+	suite.Equal(testBucket, analytics.Buckets[0].Start)
+	suite.Equal(int64(10), analytics.Buckets[0].Flow.Started)
+	suite.Len(analytics.Buckets[0].Nodes, 2)
+	suite.Equal(next, analytics.Buckets[1].Start)
+	suite.Equal(int64(4), analytics.Buckets[1].Flow.Started)
+	suite.Len(analytics.Buckets[1].Nodes, 1)
+	suite.Equal(FlowCounts{}, analytics.Buckets[2].Flow)
+	suite.Empty(analytics.Buckets[2].Nodes)
+	suite.NotNil(analytics.Buckets[2].Nodes)
+}
+
+func (suite *AnalyticsServiceTestSuite) TestGetFlowAnalytics_DailyVersion() {
+	day := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	suite.onGetFlow()
+	suite.mockStore.On("ListCounters", mock.Anything, testFlowID, 1, day, day.Add(48*time.Hour)).
+		Return([]counter{
+			flowCounter(1, day.Add(3*time.Hour), metricFlowStarted, 2),
+			flowCounter(1, day.Add(30*time.Hour), metricFlowStarted, 5),
+		}, nil)
+
+	analytics, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, AnalyticsQuery{
+		From: day.Add(time.Hour), To: day.Add(47 * time.Hour), Interval: IntervalDay, Version: 1,
+	})
+	suite.Require().Nil(svcErr)
+	suite.Equal(1, analytics.Version)
+	suite.Require().Len(analytics.Buckets, 2)
+	suite.Equal(int64(2), analytics.Buckets[0].Flow.Started)
+	suite.Equal(int64(5), analytics.Buckets[1].Flow.Started)
+	suite.Equal(int64(7), analytics.Total.Flow.Started)
+}
+
+func (suite *AnalyticsServiceTestSuite) TestGetFlowAnalytics_InvalidQuery() {
+	cases := []struct {
+		name     string
+		query    AnalyticsQuery
+		expected string
+	}{
+		{"interval", AnalyticsQuery{From: testBucket, To: testBucket.Add(time.Hour), Interval: "week"},
+			ErrorInvalidInterval.Code},
+		{"version", AnalyticsQuery{From: testBucket, To: testBucket.Add(time.Hour), Interval: IntervalHour,
+			Version: -1}, ErrorInvalidVersion.Code},
+		{"reversed range", AnalyticsQuery{From: testBucket, To: testBucket, Interval: IntervalHour},
+			ErrorInvalidTimeRange.Code},
+		{"missing from", AnalyticsQuery{To: testBucket, Interval: IntervalHour}, ErrorInvalidTimeRange.Code},
+		{"too many buckets", AnalyticsQuery{From: testBucket, To: testBucket.Add(745 * time.Hour),
+			Interval: IntervalHour}, ErrorTimeRangeTooLarge.Code},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			_, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, tc.query)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *AnalyticsServiceTestSuite) TestGetFlowAnalytics_FlowNotFound() {
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(nil, &flowmgt.ErrorFlowNotFound)
+
+	_, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, AnalyticsQuery{
+		From: testBucket, To: testBucket.Add(time.Hour), Interval: IntervalHour,
+	})
+	suite.Require().NotNil(svcErr)
+	suite.Equal(flowmgt.ErrorFlowNotFound.Code, svcErr.Code)
+}
+
+func (suite *AnalyticsServiceTestSuite) TestGetFlowAnalytics_StoreError() {
+	suite.onGetFlow()
+	suite.mockStore.On("ListCounters", mock.Anything, testFlowID, 0, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db down"))
+
+	_, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, AnalyticsQuery{
+		From: testBucket, To: testBucket.Add(time.Hour), Interval: IntervalHour,
+	})
+	suite.Require().NotNil(svcErr)
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
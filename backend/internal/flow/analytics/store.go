@@ -0,0 +1,140 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// analyticsStoreInterface persists the flow analytics counters in the runtime persistent database.
+type analyticsStoreInterface interface {
+	// AddToCounter adds delta to a counter, creating the counter with the given node type and expiry
+	// when it does not exist yet.
+	AddToCounter(ctx context.Context, key counterKey, nodeType string, delta int64, expiry time.Time) error
+	// ListCounters returns the counters of a flow in the hourly buckets that start in [from, to). When
+	// version is zero, the counters of every version are returned.
+	ListCounters(ctx context.Context, flowID string, version int, from, to time.Time) ([]counter, error)
+}
+
+// analyticsStore implements analyticsStoreInterface against the runtime persistent database.
+type analyticsStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAnalyticsStore creates a new analyticsStore.
+func newAnalyticsStore(deploymentID string) analyticsStoreInterface {
+	return &analyticsStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// AddToCounter adds delta to a counter, creating the counter with the given node type and expiry when it
+// does not exist yet. Every server node flushes its own deltas, so when the insert of a new counter loses
+// the race to another node, the delta is added to the counter that node created.
+func (s *analyticsStore) AddToCounter(ctx context.Context, key counterKey, nodeType string, delta int64,
+	expiry time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryIncrementCounter, delta, s.deploymentID, key.flowID,
+		key.bucket.UTC(), key.version, key.nodeID, key.metric)
+	if err != nil {
+		return fmt.Errorf("failed to update flow analytics counter: %w", err)
+	}
+	if rows > 0 {
+		return nil
+	}
+
+	_, insertErr := dbClient.ExecuteContext(ctx, queryInsertCounter, s.deploymentID, key.flowID, key.version,
+		key.bucket.UTC(), key.nodeID, key.metric, nodeType, delta, expiry.UTC())
+	if insertErr == nil {
+		return nil
+	}
+	rows, err = dbClient.ExecuteContext(ctx, queryIncrementCounter, delta, s.deploymentID, key.flowID,
+		key.bucket.UTC(), key.version, key.nodeID, key.metric)
+	if err != nil {
+		return fmt.Errorf("failed to update flow analytics counter: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("failed to insert flow analytics counter: %w", insertErr)
+	}
+	return nil
+}
+
+// ListCounters returns the counters of a flow in the hourly buckets that start in [from, to). When version
+// is zero, the counters of every version are returned.
+func (s *analyticsStore) ListCounters(ctx context.Context, flowID string, version int, from, to time.Time) (
+	[]counter, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	query, args := queryListCounters, []interface{}{s.deploymentID, flowID, from.UTC(), to.UTC()}
+	if version > 0 {
+		query, args = queryListVersionCounters, append(args, version)
+	}
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query flow analytics counters: %w", err)
+	}
+
+	counters := make([]counter, 0, len(results))
+	for _, row := range results {
+		bucket, err := sysutils.ParseDBTimeField(row["bucket_start"], "bucket_start")
+		if err != nil {
+			return nil, err
+		}
+		counters = append(counters, counter{
+			counterKey: counterKey{
+				flowID:  flowID,
+				version: int(columnInt64(row["flow_version"])),
+				bucket:  bucket,
+				nodeID:  columnString(row["node_id"]),
+				metric:  columnString(row["metric"]),
+			},
+			nodeType: columnString(row["node_type"]),
+			value:    columnInt64(row["metric_value"]),
+		})
+	}
+	return counters, nil
+}
+
+// columnString coerces a result-row value to a string, tolerating string/[]byte.
+func columnString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	default:
+		return ""
+	}
+}
+
+// columnInt64 coerces an integer result-row value to an int64, tolerating the []byte values some drivers
+// return for BIGINT columns.
+func columnInt64(v interface{}) int64 {
+	switch t := v.(type) {
+	case int64:
+		return t
+	case int:
+		return int64(t)
+	case []byte:
+		n, _ := strconv.ParseInt(string(t), 10, 64)
+		return n
+	default:
+		return 0
+	}
+}
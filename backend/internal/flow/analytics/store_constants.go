@@ -0,0 +1,34 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// DBQuery definitions for the flow analytics counters in the runtime persistent database.
+var (
+	queryIncrementCounter = dbmodel.DBQuery{
+		ID: "FAQ-01",
+		Query: `UPDATE "FLOW_ANALYTICS" SET METRIC_VALUE = METRIC_VALUE + $1 ` +
+			`WHERE DEPLOYMENT_ID = $2 AND FLOW_ID = $3 AND BUCKET_START = $4 AND FLOW_VERSION = $5 ` +
+			`AND NODE_ID = $6 AND METRIC = $7`,
+	}
+	queryInsertCounter = dbmodel.DBQuery{
+		ID: "FAQ-02",
+		Query: `INSERT INTO "FLOW_ANALYTICS" (DEPLOYMENT_ID, FLOW_ID, FLOW_VERSION, BUCKET_START, NODE_ID, ` +
+			`METRIC, NODE_TYPE, METRIC_VALUE, EXPIRY_TIME) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+	}
+	queryListCounters = dbmodel.DBQuery{
+		ID: "FAQ-03",
+		Query: `SELECT FLOW_VERSION, BUCKET_START, NODE_ID, METRIC, NODE_TYPE, METRIC_VALUE FROM "FLOW_ANALYTICS" ` +
+			`WHERE DEPLOYMENT_ID = $1 AND FLOW_ID = $2 AND BUCKET_START >= $3 AND BUCKET_START < $4`,
+	}
+	queryListVersionCounters = dbmodel.DBQuery{
+		ID: "FAQ-04",
+		Query: `SELECT FLOW_VERSION, BUCKET_START, NODE_ID, METRIC, NODE_TYPE, METRIC_VALUE FROM "FLOW_ANALYTICS" ` +
+			`WHERE DEPLOYMENT_ID = $1 AND FLOW_ID = $2 AND BUCKET_START >= $3 AND BUCKET_START < $4 ` +
+			`AND FLOW_VERSION = $5`,
+	}
+)
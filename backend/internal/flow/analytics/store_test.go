@@ -0,0 +1,165 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment-id"
+	testFlowID       = "flow-1"
+)
+
+var testBucket = time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+
+type AnalyticsStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *analyticsStore
+	key            counterKey
+	expiry         time.Time
+}
+
+func TestAnalyticsStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsStoreTestSuite))
+}
+
+func (suite *AnalyticsStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &analyticsStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+	suite.key = counterKey{flowID: testFlowID, version: 2, bucket: testBucket, nodeID: "prompt", metric: "entered"}
+	suite.expiry = testBucket.Add(24 * time.Hour)
+}
+
+func (suite *AnalyticsStoreTestSuite) onIncrement() *mock.Call {
+	return suite.mockDBClient.On("ExecuteContext", mock.Anything, queryIncrementCounter, int64(3),
+		testDeploymentID, testFlowID, testBucket, 2, "prompt", "entered")
+}
+
+func (suite *AnalyticsStoreTestSuite) onInsert() *mock.Call {
+	return suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertCounter, testDeploymentID,
+		testFlowID, 2, testBucket, "prompt", "entered", "PROMPT", int64(3), suite.expiry)
+}
+
+func (suite *AnalyticsStoreTestSuite) TestAddToCounter_UpdatesExisting() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.onIncrement().Return(int64(1), nil).Once()
+
+	err := suite.store.AddToCounter(context.Background(), suite.key, "PROMPT", 3, suite.expiry)
+	suite.NoError(err)
+}
+
+func (suite *AnalyticsStoreTestSuite) TestAddToCounter_InsertsNew() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.onIncrement().Return(int64(0), nil).Once()
+	suite.onInsert().Return(int64(1), nil).Once()
+
+	err := suite.store.AddToCounter(context.Background(), suite.key, "PROMPT", 3, suite.expiry)
+	suite.NoError(err)
+}
+
+func (suite *AnalyticsStoreTestSuite) TestAddToCounter_InsertRaceRetriesUpdate() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.onIncrement().Return(int64(0), nil).Once()
+	suite.onInsert().Return(int64(0), errors.New("duplicate key")).Once()
+	suite.onIncrement().Return(int64(1), nil).Once()
+
+	err := suite.store.AddToCounter(context.Background(), suite.key, "PROMPT", 3, suite.expiry)
+	suite.NoError(err)
+}
+
+func (suite *AnalyticsStoreTestSuite) TestAddToCounter_InsertFails() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.onIncrement().Return(int64(0), nil).Twice()
+	suite.onInsert().Return(int64(0), errors.New("db down")).Once()
+
+	err := suite.store.AddToCounter(context.Background(), suite.key, "PROMPT", 3, suite.expiry)
+	suite.ErrorContains(err, "failed to insert flow analytics counter")
+}
+
+func (suite *AnalyticsStoreTestSuite) TestAddToCounter_UpdateError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.onIncrement().Return(int64(0), errors.New("db down")).Once()
+
+	err := suite.store.AddToCounter(context.Background(), suite.key, "PROMPT", 3, suite.expiry)
+	suite.ErrorContains(err, "failed to update flow analytics counter")
+}
+
+func (suite *AnalyticsStoreTestSuite) TestAddToCounter_DBClientError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(nil, errors.New("no client"))
+
+	err := suite.store.AddToCounter(context.Background(), suite.key, "PROMPT", 3, suite.expiry)
+	suite.ErrorContains(err, "failed to get runtime persistent database client")
+}
+
+func (suite *AnalyticsStoreTestSuite) TestListCounters_AllVersions() {
+	from, to := testBucket, testBucket.Add(2*time.Hour)
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListCounters, testDeploymentID, testFlowID, from, to).
+		Return([]map[string]interface{}{
+			{
+				"flow_version": int64(1), "bucket_start": testBucket, "node_id": "", "metric": "started",
+				"node_type": "", "metric_value": int64(7),
+			},
+			{
+				"flow_version": int64(2), "bucket_start": "2026-03-04 11:00:00", "node_id": []byte("prompt"),
+				"metric": []byte("entered"), "node_type": "PROMPT", "metric_value": []byte("4"),
+			},
+		}, nil)
+
+	counters, err := suite.store.ListCounters(context.Background(), testFlowID, 0, from, to)
+	suite.Require().NoError(err)
+	suite.Require().Len(counters, 2)
+	suite.Equal(counter{
+		counterKey: counterKey{flowID: testFlowID, version: 1, bucket: testBucket, metric: "started"},
+		value:      7,
+	}, counters[0])
+	suite.Equal(counter{
+		counterKey: counterKey{
+			flowID: testFlowID, version: 2, bucket: testBucket.Add(time.Hour), nodeID: "prompt", metric: "entered",
+		},
+		nodeType: "PROMPT",
+		value:    4,
+	}, counters[1])
+}
+
+func (suite *AnalyticsStoreTestSuite) TestListCounters_Version() {
+	from, to := testBucket, testBucket.Add(time.Hour)
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListVersionCounters, testDeploymentID, testFlowID,
+		from, to, 3).Return([]map[string]interface{}{}, nil)
+
+	counters, err := suite.store.ListCounters(context.Background(), testFlowID, 3, from, to)
+	suite.NoError(err)
+	suite.Empty(counters)
+}
+
+func (suite *AnalyticsStoreTestSuite) TestListCounters_InvalidBucket() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListCounters, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return([]map[string]interface{}{{"bucket_start": 42}}, nil)
+
+	_, err := suite.store.ListCounters(context.Background(), testFlowID, 0, testBucket, testBucket.Add(time.Hour))
+	suite.Error(err)
+}
+
+func (suite *AnalyticsStoreTestSuite) TestListCounters_QueryError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListCounters, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+	_, err := suite.store.ListCounters(context.Background(), testFlowID, 0, testBucket, testBucket.Add(time.Hour))
+	suite.ErrorContains(err, "failed to query flow analytics counters")
+}
@@ -30,6 +30,8 @@ const (
 	NodeTypePrompt NodeType = "PROMPT"
 	// NodeTypeCall represents a CALL node that invokes another flow
 	NodeTypeCall NodeType = "CALL"
+	// NodeTypeDecision represents a DECISION node that branches on expressions
+	NodeTypeDecision NodeType = "DECISION"
 )
 
 // NodeStatus defines the status of a node in the flow execution.
@@ -66,6 +68,9 @@ const (
 	DataIDPName = "idpName"
 	// DataConsentPrompt is the key used for the consent prompt data in the flow response.
 	DataConsentPrompt = "consentPrompt"
+	// DataAuthorizationDetails is the key used for the requested authorization details (RFC 9396) shown in
+	// the consent prompt.
+	DataAuthorizationDetails = "authorizationDetails"
 	// DataStepTimeout is the key used for the step expiry timestamp in the flow response.
 	DataStepTimeout = "stepTimeout"
 	// DataInviteLink is the key used for the invite link in the flow response additional data.
@@ -125,6 +130,10 @@ const (
 	// checkpoint it guards. The checkpoint id is that join node's id, so the skip and join of one
 	// checkpoint pair by it. Absent/empty means the node is not part of a checkpoint pair.
 	NodePropertyCheckpointRef = "checkpointRef"
+	// NodePropertyScript holds the source of the script a ScriptExecutor node runs.
+	NodePropertyScript = "script"
+	// NodePropertyScriptTimeout holds the time, in milliseconds, a ScriptExecutor node's script may run for.
+	NodePropertyScriptTimeout = "timeoutMs"
 )
 
 // RuntimeData keys.
@@ -135,6 +144,20 @@ const (
 	RuntimeKeyUserEligibleForProvisioning = "userEligibleForProvisioning"
 	// RuntimeKeyUserAmbiguous indicates the user exists in multiple OUs and requires disambiguation
 	RuntimeKeyUserAmbiguous = "userAmbiguous"
+	// RuntimeKeyAccountLocked indicates that credential authentication was rejected because the user or
+	// the client IP address is locked out after repeated failed attempts
+	RuntimeKeyAccountLocked = "accountLocked"
+	// RuntimeKeyPasswordExpired indicates that the password the user authenticated with is older than
+	// the maximum age of its password policy and must be changed
+	RuntimeKeyPasswordExpired = "passwordExpired"
+	// RuntimeKeyCaptchaScore holds the score a score-based captcha provider assigned to the last verified
+	// captcha token.
+	RuntimeKeyCaptchaScore = "captchaScore"
+	// RuntimeKeyCaptchaStepUp indicates that the last verified captcha token was accepted with a score low
+	// enough to warrant additional verification.
+	RuntimeKeyCaptchaStepUp = "captchaStepUp"
+	// RuntimeKeyScriptOutcome holds the outcome chosen by the most recently executed ScriptExecutor node.
+	RuntimeKeyScriptOutcome = "scriptOutcome"
 	// RuntimeKeyRevocationPlan holds the trusted revocation plan an administrative flow's
 	// pre-processing node produces for the executors that follow. It travels on the engine context's
 	// cross-frame store, so it survives a CALL into another flow.
@@ -151,6 +174,12 @@ const (
 	// RuntimeKeyConsentedPermissions holds the space-separated permission scopes the user has consented to
 	// release to the client, as produced by the ConsentExecutor.
 	RuntimeKeyConsentedPermissions = "consented_permissions"
+	// RuntimeKeyAuthorizationDetails holds the JSON-encoded authorization details (RFC 9396) requested by the
+	// OAuth client, already validated against the bound resource server.
+	RuntimeKeyAuthorizationDetails = "authorization_details"
+	// RuntimeKeyConsentedAuthorizationDetails holds the JSON-encoded authorization details the user approved,
+	// as produced by the ConsentExecutor. It is empty when the user approved none.
+	RuntimeKeyConsentedAuthorizationDetails = "consented_authorization_details"
 	// RuntimeKeyRequiredEssentialAttributes holds the space-separated essential user attributes required for the flow.
 	RuntimeKeyRequiredEssentialAttributes = "required_essential_attributes"
 	// RuntimeKeyRequiredOptionalAttributes holds the space-separated optional user attributes required for the flow.
@@ -159,6 +188,10 @@ const (
 	RuntimeKeyRequiredLocales = "required_locales"
 	// RuntimeKeyConsentID holds the consent record ID after consent has been recorded.
 	RuntimeKeyConsentID = "consent_id"
+	// RuntimeKeyGrantConsentID holds the ID of the consent record the grant is issued under, whether it
+	// was recorded in this flow or was already active. It is stamped onto the auth assertion, and from
+	// there onto the grant's access and refresh tokens, so revoking the consent revokes them.
+	RuntimeKeyGrantConsentID = "grantConsentId"
 	// RuntimeKeyStepTimeout holds the expiry timestamp for the current flow step.
 	RuntimeKeyStepTimeout = "step_timeout"
 	// RuntimeKeyConsentedAttributes holds a space-separated set of attributes that the user has consented to share.
@@ -253,6 +286,10 @@ const (
 	// from the SSO checkpoint snapshot so each flow execution mints a fresh tfid rather than reusing a
 	// prior one on SSO reuse.
 	RuntimeKeyTokenFamilyID = "tokenFamilyId"
+	// RuntimeKeySSOSessionID carries the id of the SSO session the Session node attached this execution
+	// to. It is stamped onto the auth assertion, and from there onto the grant's ID token as the sid
+	// claim, so OIDC back-channel and front-channel logout can name the session that ended.
+	RuntimeKeySSOSessionID = "ssoSessionId"
 	// RuntimeKeyLogoutPromptRequired is set by the OAuth RP-initiated logout layer when a logout was
 	// requested without a valid id_token_hint. A sign-out flow's session sign-out node reads it to
 	// decide whether the End-User must confirm the logout before the session is terminated.
@@ -310,6 +347,9 @@ const (
 	ForwardedDataKeyInputs = "inputs"
 	// ForwardedDataKeyConsentPrompt is the key used to forward consent prompt data to the prompt node
 	ForwardedDataKeyConsentPrompt = "consent_prompt"
+	// ForwardedDataKeyAuthorizationDetails is the key used to forward the requested authorization details
+	// to the prompt node
+	ForwardedDataKeyAuthorizationDetails = "authorization_details"
 	// ForwardedDataKeyActionType holds the action type selected by the user for the immediate next node
 	ForwardedDataKeyActionType = "actionType"
 	// ForwardedDataKeyTemplateData holds template parameters for notification executors
@@ -351,4 +391,5 @@ var ValidNodeTypes = map[string]bool{
 	string(NodeTypeTaskExecution): true,
 	string(NodeTypePrompt):        true,
 	string(NodeTypeCall):          true,
+	string(NodeTypeDecision):      true,
 }
@@ -0,0 +1,113 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// DecisionNodeInterface extends NodeInterface for DECISION nodes, which route the flow to the first
+// branch whose expression evaluates to true, or to a default node when none does.
+type DecisionNodeInterface interface {
+	NodeInterface
+	GetBranches() []DecisionBranch
+	SetBranches(branches []DecisionBranch)
+	GetDefault() string
+	SetDefault(nodeID string)
+}
+
+// decisionNode implements DecisionNodeInterface and represents a DECISION node in the flow graph.
+type decisionNode struct {
+	*node
+	branches    []DecisionBranch
+	defaultNext string
+	logger      *log.Logger
+}
+
+var _ DecisionNodeInterface = (*decisionNode)(nil)
+
+// newDecisionNode creates a new instance of decisionNode with the given parameters.
+func newDecisionNode(id string, properties map[string]interface{}, isStartNode, isFinalNode bool) NodeInterface {
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+	return &decisionNode{
+		node: &node{
+			id:               id,
+			_type:            common.NodeTypeDecision,
+			properties:       properties,
+			isStartNode:      isStartNode,
+			isFinalNode:      isFinalNode,
+			nextNodeList:     []string{},
+			previousNodeList: []string{},
+		},
+		branches: []DecisionBranch{},
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DecisionNode"),
+			log.String(log.LoggerKeyNodeID, id)),
+	}
+}
+
+// Execute evaluates the branches in order and routes to the first one that matches. A branch whose
+// expression fails to evaluate, for example because it reads an input that was never collected, is
+// treated as not matching so that the flow falls through to the default rather than failing.
+func (n *decisionNode) Execute(ctx *providers.NodeContext) (*common.NodeResponse, *tidcommon.ServiceError) {
+	vars := BuildExpressionVariables(ctx)
+
+	nextNodeID := n.defaultNext
+	for _, branch := range n.branches {
+		if branch.Condition == nil {
+			continue
+		}
+		matched, err := branch.Condition.EvalBool(vars)
+		if err != nil {
+			n.logger.Debug(ctx.Context, "Decision branch expression could not be evaluated",
+				log.String("branch", branch.Label), log.Error(err))
+			continue
+		}
+		if matched {
+			n.logger.Debug(ctx.Context, "Decision branch matched", log.String("branch", branch.Label))
+			nextNodeID = branch.Next
+			break
+		}
+	}
+
+	if nextNodeID == "" {
+		n.logger.Error(ctx.Context, "No decision branch matched and no default node is set")
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return &common.NodeResponse{
+		Status:         common.NodeStatusComplete,
+		NextNodeID:     nextNodeID,
+		RuntimeData:    make(map[string]string),
+		AdditionalData: make(map[string]string),
+	}, nil
+}
+
+// GetBranches returns the branches of the DECISION node in evaluation order.
+func (n *decisionNode) GetBranches() []DecisionBranch {
+	return n.branches
+}
+
+// SetBranches sets the branches of the DECISION node in evaluation order.
+func (n *decisionNode) SetBranches(branches []DecisionBranch) {
+	if branches == nil {
+		n.branches = []DecisionBranch{}
+	} else {
+		n.branches = branches
+	}
+}
+
+// GetDefault returns the ID of the node to route to when no branch matches.
+func (n *decisionNode) GetDefault() string {
+	return n.defaultNext
+}
+
+// SetDefault sets the ID of the node to route to when no branch matches.
+func (n *decisionNode) SetDefault(nodeID string) {
+	n.defaultNext = nodeID
+}
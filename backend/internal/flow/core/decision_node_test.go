@@ -0,0 +1,181 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/expression"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type DecisionNodeTestSuite struct {
+	suite.Suite
+}
+
+func TestDecisionNodeTestSuite(t *testing.T) {
+	suite.Run(t, new(DecisionNodeTestSuite))
+}
+
+func (s *DecisionNodeTestSuite) branch(label, source, next string) DecisionBranch {
+	condition, err := expression.Compile(source)
+	s.Require().NoError(err)
+	return DecisionBranch{Label: label, Condition: condition, Next: next}
+}
+
+func (s *DecisionNodeTestSuite) newDecisionNode(branches []DecisionBranch, defaultNext string) NodeInterface {
+	node := newDecisionNode("decision-1", nil, false, false)
+	decisionNode := node.(DecisionNodeInterface)
+	decisionNode.SetBranches(branches)
+	decisionNode.SetDefault(defaultNext)
+	return node
+}
+
+func (s *DecisionNodeTestSuite) newContext() *providers.NodeContext {
+	return &providers.NodeContext{
+		Context:     context.Background(),
+		UserInputs:  map[string]string{},
+		RuntimeData: map[string]string{},
+	}
+}
+
+func (s *DecisionNodeTestSuite) TestNewDecisionNode() {
+	node := newDecisionNode("decision-1", nil, false, false)
+
+	decisionNode, ok := node.(DecisionNodeInterface)
+	s.True(ok, "Node should implement DecisionNodeInterface")
+	s.Equal("decision-1", decisionNode.GetID())
+	s.Equal(common.NodeTypeDecision, decisionNode.GetType())
+	s.NotNil(decisionNode.GetProperties())
+	s.Empty(decisionNode.GetBranches())
+	s.Empty(decisionNode.GetDefault())
+}
+
+func (s *DecisionNodeTestSuite) TestSetBranches_NilResetsToEmpty() {
+	node := s.newDecisionNode([]DecisionBranch{s.branch("a", "true", "next")}, "default")
+	decisionNode := node.(DecisionNodeInterface)
+
+	decisionNode.SetBranches(nil)
+
+	s.NotNil(decisionNode.GetBranches())
+	s.Empty(decisionNode.GetBranches())
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_FirstMatchingBranchWins() {
+	node := s.newDecisionNode([]DecisionBranch{
+		s.branch("no-match", `inputs.country == "US"`, "us-node"),
+		s.branch("first", `inputs.country == "LK"`, "lk-node"),
+		s.branch("second", `true`, "catch-all-node"),
+	}, "default-node")
+	ctx := s.newContext()
+	ctx.UserInputs["country"] = "LK"
+
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+	s.Equal("lk-node", resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_FallsBackToDefault() {
+	node := s.newDecisionNode([]DecisionBranch{
+		s.branch("us", `inputs.country == "US"`, "us-node"),
+	}, "default-node")
+	ctx := s.newContext()
+	ctx.UserInputs["country"] = "LK"
+
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal("default-node", resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_EvaluationErrorTreatedAsNoMatch() {
+	node := s.newDecisionNode([]DecisionBranch{
+		s.branch("missing-input", `inputs.country == "LK"`, "lk-node"),
+		s.branch("non-bool", `inputs`, "non-bool-node"),
+	}, "default-node")
+
+	resp, err := node.Execute(s.newContext())
+
+	s.Nil(err)
+	s.Equal("default-node", resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_NoMatchAndNoDefault() {
+	node := s.newDecisionNode([]DecisionBranch{s.branch("never", `false`, "next")}, "")
+
+	resp, err := node.Execute(s.newContext())
+
+	s.Nil(resp)
+	s.NotNil(err)
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_RuntimeData() {
+	node := s.newDecisionNode([]DecisionBranch{
+		s.branch("step-up", `double(runtime.captchaScore) < 0.5`, "step-up-node"),
+	}, "default-node")
+	ctx := s.newContext()
+	ctx.RuntimeData[common.RuntimeKeyCaptchaScore] = "0.2"
+
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal("step-up-node", resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_RequestContext() {
+	node := s.newDecisionNode([]DecisionBranch{
+		s.branch("internal", `request.ip.inCIDR("10.0.0.0/8") && request.userAgent.contains("iPhone") && `+
+			`"mfa" in request.acrValues`, "internal-node"),
+	}, "default-node")
+	ctx := s.newContext()
+	ctx.Context = sysContext.WithClientIP(context.Background(), "10.1.2.3")
+	ctx.RuntimeData[common.RuntimeKeyRequestedAuthClasses] = "pwd mfa"
+	ctx.SetInitiatorRequest(&providers.InitiatorRequest{
+		Headers: map[string][]string{"User-Agent": {"Mozilla/5.0 (iPhone)"}},
+	})
+
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal("internal-node", resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_ApplicationProperties() {
+	node := s.newDecisionNode([]DecisionBranch{
+		s.branch("gold", `app.id == "app-1" && app.metadata.tier == "gold"`, "gold-node"),
+	}, "default-node")
+	ctx := s.newContext()
+	ctx.Application = providers.Application{ID: "app-1", Metadata: map[string]interface{}{"tier": "gold"}}
+
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal("gold-node", resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_AuthenticatedClaims() {
+	node := s.newDecisionNode([]DecisionBranch{
+		s.branch("verified", `has(claims.email_verified) && claims.email_verified`, "verified-node"),
+	}, "default-node")
+	ctx := s.newContext()
+	ctx.AuthUser.SetStateFor("local", providers.AuthState{
+		EntityReference: &providers.EntityReference{EntityID: "user-1"},
+		Attributes: &providers.AttributesResponse{Attributes: map[string]*providers.AttributeResponse{
+			"email_verified": {Value: true},
+			"ignored":        nil,
+		}},
+	})
+
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal("verified-node", resp.NextNodeID)
+}
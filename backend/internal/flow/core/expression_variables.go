@@ -0,0 +1,80 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Variables exposed to flow expressions.
+const (
+	expressionVarInputs  = "inputs"
+	expressionVarRuntime = "runtime"
+	expressionVarClaims  = "claims"
+	expressionVarRequest = "request"
+	expressionVarApp     = "app"
+)
+
+// BuildExpressionVariables builds the read-only variables that DECISION node expressions and flow
+// scripts are evaluated against:
+//
+//   - inputs: the user inputs collected so far
+//   - runtime: the flow runtime data
+//   - claims: the attributes of the authenticated user, merged across authentication providers
+//   - request: the client ip, userAgent and the requested acrValues
+//   - app: the id, name, type, ouId and metadata of the application
+func BuildExpressionVariables(ctx *providers.NodeContext) map[string]interface{} {
+	request := map[string]interface{}{
+		"ip":        sysContext.GetClientIP(ctx.Context),
+		"userAgent": "",
+		"acrValues": strings.Fields(ctx.RuntimeData[common.RuntimeKeyRequestedAuthClasses]),
+	}
+	if initiator := ctx.GetInitiatorRequest(); initiator != nil {
+		if values := http.Header(initiator.Headers).Values("User-Agent"); len(values) > 0 {
+			request["userAgent"] = values[0]
+		}
+	}
+
+	metadata := ctx.Application.Metadata
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		expressionVarInputs:  ctx.UserInputs,
+		expressionVarRuntime: ctx.RuntimeData,
+		expressionVarClaims:  collectAuthUserClaims(ctx.AuthUser),
+		expressionVarRequest: request,
+		expressionVarApp: map[string]interface{}{
+			"id":       ctx.Application.ID,
+			"name":     ctx.Application.Name,
+			"type":     ctx.Application.Type,
+			"ouId":     ctx.Application.OUID,
+			"metadata": metadata,
+		},
+	}
+}
+
+// collectAuthUserClaims merges the resolved attribute values recorded by every authentication
+// provider. Providers are visited in name order, so a later provider wins on conflicting keys.
+func collectAuthUserClaims(authUser providers.AuthUser) map[string]interface{} {
+	claims := make(map[string]interface{})
+	for _, name := range authUser.ProviderNames() {
+		state, _ := authUser.StateFor(name)
+		if state.Attributes == nil {
+			continue
+		}
+		for key, attr := range state.Attributes.Attributes {
+			if attr != nil {
+				claims[key] = attr.Value
+			}
+		}
+	}
+	return claims
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type ExpressionVariablesTestSuite struct {
+	suite.Suite
+}
+
+func TestExpressionVariablesTestSuite(t *testing.T) {
+	suite.Run(t, new(ExpressionVariablesTestSuite))
+}
+
+func (s *ExpressionVariablesTestSuite) TestBuildExpressionVariables_Defaults() {
+	ctx := &providers.NodeContext{
+		Context:     context.Background(),
+		UserInputs:  map[string]string{"username": "alice"},
+		RuntimeData: map[string]string{common.RuntimeKeyRequestedAuthClasses: "pwd mfa"},
+	}
+
+	vars := BuildExpressionVariables(ctx)
+
+	s.Equal(ctx.UserInputs, vars["inputs"])
+	s.Equal(ctx.RuntimeData, vars["runtime"])
+	s.Equal(map[string]interface{}{}, vars["claims"])
+	s.Equal(map[string]interface{}{"ip": "", "userAgent": "", "acrValues": []string{"pwd", "mfa"}}, vars["request"])
+	s.Equal(map[string]interface{}{}, vars["app"].(map[string]interface{})["metadata"])
+}
+
+func (s *ExpressionVariablesTestSuite) TestCollectAuthUserClaims_LaterProviderWins() {
+	var authUser providers.AuthUser
+	authUser.SetStateFor("a-provider", providers.AuthState{
+		Attributes: &providers.AttributesResponse{Attributes: map[string]*providers.AttributeResponse{
+			"country": {Value: "US"},
+			"email":   {Value: "a@example.com"},
+		}},
+	})
+	authUser.SetStateFor("b-provider", providers.AuthState{
+		Attributes: &providers.AttributesResponse{Attributes: map[string]*providers.AttributeResponse{
+			"country": {Value: "LK"},
+		}},
+	})
+	authUser.SetStateFor("c-provider", providers.AuthState{})
+
+	claims := collectAuthUserClaims(authUser)
+
+	s.Equal(map[string]interface{}{"country": "LK", "email": "a@example.com"}, claims)
+}
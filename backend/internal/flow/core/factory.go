@@ -57,6 +57,8 @@ func (f *flowFactory) CreateNode(id, _type string, properties map[string]interfa
 		return newRepresentationNode(id, nodeType, properties, isStartNode, isFinalNode), nil
 	case common.NodeTypeCall:
 		return newCallNode(id, properties, isStartNode, isFinalNode), nil
+	case common.NodeTypeDecision:
+		return newDecisionNode(id, properties, isStartNode, isFinalNode), nil
 	default:
 		return nil, errors.New("unsupported node type: " + _type)
 	}
@@ -182,6 +184,17 @@ func (f *flowFactory) CloneNode(source NodeInterface) (NodeInterface, error) {
 		}
 	}
 
+	// Copy branches and the default node if the node is a decision node. Compiled conditions are
+	// immutable, so they are shared rather than recompiled.
+	if decisionSource, ok := source.(DecisionNodeInterface); ok {
+		if decisionCopy, ok := nodeCopy.(DecisionNodeInterface); ok {
+			decisionCopy.SetBranches(append([]DecisionBranch{}, decisionSource.GetBranches()...))
+			decisionCopy.SetDefault(decisionSource.GetDefault())
+		} else {
+			return nil, errors.New("mismatch in node types during cloning. copy is not a decision node")
+		}
+	}
+
 	return nodeCopy, nil
 }
 
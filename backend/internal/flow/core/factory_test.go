@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/expression"
 )
 
 type FlowFactoryTestSuite struct {
@@ -532,3 +533,38 @@ func (f *fakeExecutorBackedNode) GetMode() string {
 }
 
 func (f *fakeExecutorBackedNode) SetMode(mode string) {}
+
+func (s *FlowFactoryTestSuite) TestCreateDecisionNode() {
+	node, err := s.factory.CreateNode("decision-1", string(common.NodeTypeDecision),
+		map[string]interface{}{}, false, false)
+
+	s.NoError(err)
+	s.NotNil(node)
+	s.Equal("decision-1", node.GetID())
+	s.Equal(common.NodeTypeDecision, node.GetType())
+
+	_, ok := node.(DecisionNodeInterface)
+	s.True(ok, "Node should implement DecisionNodeInterface")
+}
+
+func (s *FlowFactoryTestSuite) TestCloneDecisionNode() {
+	node, _ := s.factory.CreateNode("decision-1", string(common.NodeTypeDecision), nil, false, false)
+	condition, err := expression.Compile(`inputs.country == "LK"`)
+	s.Require().NoError(err)
+
+	decisionNode := node.(DecisionNodeInterface)
+	decisionNode.SetBranches([]DecisionBranch{{Label: "local", Condition: condition, Next: "local-node"}})
+	decisionNode.SetDefault("default-node")
+
+	clonedNode, err := s.factory.CloneNode(node)
+
+	s.NoError(err)
+	clonedDecisionNode, ok := clonedNode.(DecisionNodeInterface)
+	s.True(ok, "Cloned node should implement DecisionNodeInterface")
+	s.Equal(decisionNode.GetBranches(), clonedDecisionNode.GetBranches())
+	s.Equal("default-node", clonedDecisionNode.GetDefault())
+
+	// Verify independence — replacing the clone's branches does not affect the source
+	clonedDecisionNode.SetBranches(nil)
+	s.Len(decisionNode.GetBranches(), 1)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/expression"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -19,6 +20,14 @@ type NodeCondition struct {
 	OnSkip string
 }
 
+// DecisionBranch is a labeled branch of a DECISION node. The flow continues at Next when Condition
+// evaluates to true.
+type DecisionBranch struct {
+	Label     string
+	Condition *expression.Program
+	Next      string
+}
+
 // Segment represents a contiguous section of a flow graph bounded by display-only prompt nodes.
 type Segment struct {
 	ID          string
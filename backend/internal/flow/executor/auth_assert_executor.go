@@ -5,6 +5,7 @@ package executor
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"slices"
 	"sort"
@@ -202,6 +203,16 @@ func (a *authAssertExecutor) generateAuthAssertion(
 		jwtClaims["authorized_permissions"] = permissions
 	}
 
+	// Include the approved authorization details (see resolveAuthorizationDetailsForClaim).
+	if details := resolveAuthorizationDetailsForClaim(ctx); details != "" {
+		var decoded []interface{}
+		if err := json.Unmarshal([]byte(details), &decoded); err != nil {
+			logger.Error(ctx.Context, "Failed to parse authorization details", log.Error(err))
+			return "", errors.New("something went wrong while generating auth assertion")
+		}
+		jwtClaims[oauth2const.ClaimAuthorizationDetails] = decoded
+	}
+
 	if completedACR, exists := ctx.RuntimeData[common.RuntimeKeySelectedAuthClass]; exists && completedACR != "" {
 		jwtClaims[oauth2const.ClaimCompletedAuthClass] = completedACR
 	}
@@ -218,6 +229,17 @@ func (a *authAssertExecutor) generateAuthAssertion(
 		jwtClaims[oauth2const.ClaimTokenFamilyID] = tokenFamilyID
 	}
 
+	// Carry the consent the grant is issued under (set by the Consent node) so the grant's tokens are
+	// revoked when the user withdraws that consent.
+	if consentID, exists := ctx.RuntimeData[common.RuntimeKeyGrantConsentID]; exists && consentID != "" {
+		jwtClaims[oauth2const.ClaimConsentID] = consentID
+	}
+
+	// Carry the SSO session id (set by the Session node) so the grant's ID token names the session.
+	if sessionID, exists := ctx.RuntimeData[common.RuntimeKeySSOSessionID]; exists && sessionID != "" {
+		jwtClaims[oauth2const.ClaimSessionID] = sessionID
+	}
+
 	requiredAttributes := a.getRequiredUserAttributes(ctx)
 
 	metadata := core.BuildGetAttributesMetadata(ctx)
@@ -640,6 +662,16 @@ func (a *authAssertExecutor) resolvePermissionsForClaim(ctx *providers.NodeConte
 	return ctx.RuntimeData["authorized_permissions"]
 }
 
+// resolveAuthorizationDetailsForClaim returns the JSON-encoded authorization details to embed in the
+// assertion. When the consent step ran, only the details the user approved are returned; otherwise
+// the requested details, which were validated when the request was accepted, are returned as-is.
+func resolveAuthorizationDetailsForClaim(ctx *providers.NodeContext) string {
+	if v, ok := ctx.RuntimeData[common.RuntimeKeyConsentedAuthorizationDetails]; ok {
+		return v
+	}
+	return ctx.RuntimeData[common.RuntimeKeyAuthorizationDetails]
+}
+
 // intersectPermissionSpaceList returns the space-separated set of permissions present in both
 // inputs, preserving the order of `a`. Empty inputs are handled as empty sets.
 func intersectPermissionSpaceList(a, b string) string {
@@ -237,6 +237,36 @@ func (suite *AuthAssertExecutorTestSuite) TestExecute_WithAuthorizedPermissions(
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *AuthAssertExecutorTestSuite) TestExecute_WithAuthorizationDetails() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		AuthUser:    newTestAuthenticatedAuthUser(),
+		RuntimeData: map[string]string{
+			common.RuntimeKeyAuthorizationDetails: `[{"type":"payment_initiation"}]`,
+		},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+		Application:      providers.Application{},
+	}
+
+	suite.setupGetEntityReference("", "")
+	suite.setupGetUserAttributesEmpty()
+
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			details, ok := claims["authorization_details"].([]interface{})
+			return ok && len(details) == 1 &&
+				details[0].(map[string]interface{})["type"] == "payment_initiation"
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *AuthAssertExecutorTestSuite) TestExecute_WithUserAttributes() {
 	ctx := &providers.NodeContext{
 		ExecutionID:      "flow-123",
@@ -1603,6 +1633,23 @@ func (suite *AuthAssertExecutorTestSuite) TestResolvePermissionsForClaim_Interse
 	assert.Equal(suite.T(), "read cancel", got)
 }
 
+// ----- resolveAuthorizationDetailsForClaim -----
+
+func (suite *AuthAssertExecutorTestSuite) TestResolveAuthorizationDetailsForClaim_PrefersConsented() {
+	ctx := &providers.NodeContext{RuntimeData: map[string]string{
+		common.RuntimeKeyConsentedAuthorizationDetails: "",
+		common.RuntimeKeyAuthorizationDetails:          `[{"type":"payment_initiation"}]`,
+	}}
+	assert.Equal(suite.T(), "", resolveAuthorizationDetailsForClaim(ctx))
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestResolveAuthorizationDetailsForClaim_FallsBackToRequested() {
+	ctx := &providers.NodeContext{RuntimeData: map[string]string{
+		common.RuntimeKeyAuthorizationDetails: `[{"type":"payment_initiation"}]`,
+	}}
+	assert.Equal(suite.T(), `[{"type":"payment_initiation"}]`, resolveAuthorizationDetailsForClaim(ctx))
+}
+
 func (suite *AuthAssertExecutorTestSuite) TestIntersectPermissionSpaceList_EmptyInputs() {
 	assert.Equal(suite.T(), "", intersectPermissionSpaceList("", "a b"))
 	assert.Equal(suite.T(), "", intersectPermissionSpaceList("a b", ""))
@@ -158,12 +158,29 @@ func (e *consentExecutor) checkConsent(ctx *providers.NodeContext, execResp *pro
 		return nil, errors.New("failed to resolve consent")
 	}
 
+	// Authorization details are specific to a single request and are never remembered, so they are
+	// always prompted for even when all other consents are active.
+	authorizationDetails := ctx.RuntimeData[common.RuntimeKeyAuthorizationDetails]
+
 	// All consents are active — nothing to prompt
-	if promptData == nil {
+	if promptData == nil && authorizationDetails == "" {
+		// Tie the grant to the active consent so revoking it revokes the grant's tokens.
+		consentID, svcErr := e.consentEnforcer.GetActiveConsentID(ctx.Context, appID, entityRef.EntityID)
+		if svcErr != nil {
+			logger.Error(ctx.Context, "Failed to resolve active consent", log.Any("error", svcErr))
+			return nil, errors.New("failed to resolve active consent")
+		}
+		if consentID != "" {
+			execResp.RuntimeData[common.RuntimeKeyGrantConsentID] = consentID
+		}
+
 		logger.Debug(ctx.Context, "All required consents are active; completing consent executor")
 		execResp.Status = providers.ExecComplete
 		return execResp, nil
 	}
+	if promptData == nil {
+		promptData = &providers.ConsentPromptData{Purposes: []providers.ConsentPurposePrompt{}}
+	}
 
 	// Consent is needed — forward prompt data to the prompt node via ForwardedData
 	promptJSON, err := json.Marshal(promptData.Purposes)
@@ -175,6 +192,16 @@ func (e *consentExecutor) checkConsent(ctx *providers.NodeContext, execResp *pro
 	execResp.ForwardedData[common.ForwardedDataKeyConsentPrompt] = promptData.Purposes
 	execResp.AdditionalData[common.DataConsentPrompt] = string(promptJSON)
 
+	if authorizationDetails != "" {
+		var details []map[string]interface{}
+		if err := json.Unmarshal([]byte(authorizationDetails), &details); err != nil {
+			logger.Error(ctx.Context, "Failed to parse requested authorization details", log.Error(err))
+			return nil, errors.New("failed to prepare consent prompt data")
+		}
+		execResp.ForwardedData[common.ForwardedDataKeyAuthorizationDetails] = details
+		execResp.AdditionalData[common.DataAuthorizationDetails] = authorizationDetails
+	}
+
 	// Store the session token in RuntimeData for validation during consent recording
 	if promptData.SessionToken != "" {
 		execResp.RuntimeData[common.RuntimeKeyConsentSessionToken] = promptData.SessionToken
@@ -231,6 +258,9 @@ func (e *consentExecutor) handleConsentDecisions(ctx *providers.NodeContext, exe
 		logger.Debug(ctx.Context, "Consent prompt timed out; completing without recording consent")
 		execResp.RuntimeData[common.RuntimeKeyConsentedAttributes] = ""
 		execResp.RuntimeData[common.RuntimeKeyConsentedPermissions] = ""
+		if ctx.RuntimeData[common.RuntimeKeyAuthorizationDetails] != "" {
+			execResp.RuntimeData[common.RuntimeKeyConsentedAuthorizationDetails] = ""
+		}
 		execResp.Status = providers.ExecComplete
 		return execResp, nil
 	}
@@ -253,9 +283,30 @@ func (e *consentExecutor) handleConsentDecisions(ctx *providers.NodeContext, exe
 		validityPeriod = ctx.Application.LoginConsent.ValidityPeriod
 	}
 
+	// Requested authorization details are approved or denied as a whole with the consent decision.
+	// Denying them denies the request, since the client asked for that specific authorization.
+	authorizationDetails := ctx.RuntimeData[common.RuntimeKeyAuthorizationDetails]
+	if authorizationDetails != "" {
+		if !decisions.Approved {
+			logger.Debug(ctx.Context, "User denied the requested authorization details")
+			execResp.Status = providers.ExecFailure
+			execResp.Error = &ErrConsentDenied
+			return execResp, nil
+		}
+		execResp.RuntimeData[common.RuntimeKeyConsentedAuthorizationDetails] = authorizationDetails
+	}
+
 	// Retrieve the consent session token from RuntimeData for server-side validation
 	sessionToken := ctx.RuntimeData[common.RuntimeKeyConsentSessionToken]
 
+	// Without a consent session only the authorization details were prompted for, and they are not
+	// recorded as a consent since they apply to this request alone.
+	if sessionToken == "" && authorizationDetails != "" {
+		logger.Debug(ctx.Context, "Authorization details approved; no consent purposes to record")
+		execResp.Status = providers.ExecComplete
+		return execResp, nil
+	}
+
 	// Always record consent decisions (including denials) for audit/compliance purposes.
 	// The session token is used to verify completeness and enforce essential attribute rules
 	consentRecord, svcErr := e.consentEnforcer.RecordConsent(ctx.Context, ouID, appID, userID,
@@ -283,6 +334,7 @@ func (e *consentExecutor) handleConsentDecisions(ctx *providers.NodeContext, exe
 
 	// Store the consent ID in RuntimeData for downstream usage
 	execResp.RuntimeData[common.RuntimeKeyConsentID] = consentRecord.ID
+	execResp.RuntimeData[common.RuntimeKeyGrantConsentID] = consentRecord.ID
 
 	// Derive approved attribute and permission names from the full (merged) consent record so
 	// downstream executors can easily restrict to only consented values without needing to
@@ -182,12 +182,44 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AllConsentsActive()
 	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "", "user-123",
 		[]string{}, []string{"email", "phone"}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil, nil)
+	suite.mockConsentEnforcer.On("GetActiveConsentID", mock.Anything, "app-123", "user-123").
+		Return("consent-1", nil)
 
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), resp)
 	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "consent-1", resp.RuntimeData[common.RuntimeKeyGrantConsentID])
+	_, consentRecorded := resp.RuntimeData[common.RuntimeKeyConsentID]
+	assert.False(suite.T(), consentRecorded)
+}
+
+func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AllConsentsActive_LookupError() {
+	ctx := buildConsentNodeContext()
+	suite.setupDefaultAuthnProviderMocks()
+
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*providers.ExecutorResponse"), mock.Anything).Return(true)
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*providers.ExecutorResponse")).Return(false)
+
+	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "", "user-123",
+		[]string{}, []string{"email", "phone"}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil)
+	suite.mockConsentEnforcer.On("GetActiveConsentID", mock.Anything, "app-123", "user-123").
+		Return("", &tidcommon.InternalServerError)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), resp)
+}
+
+// expectNoActiveConsent expects the active consent lookup made when no prompt is needed, reporting
+// that the user holds no consent record for the application.
+func (suite *ConsentExecutorTestSuite) expectNoActiveConsent() {
+	suite.mockConsentEnforcer.On("GetActiveConsentID", mock.Anything, "app-123", "user-123").Return("", nil)
 }
 
 func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_ForceRepromptFromRuntimeData() {
@@ -205,6 +237,8 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_ForceRepromptFromRun
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything, true, mock.Anything).
 		Return(nil, nil)
 
+	suite.expectNoActiveConsent()
+
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
@@ -225,6 +259,8 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_ForceRepromptDefault
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything, false, mock.Anything).
 		Return(nil, nil)
 
+	suite.expectNoActiveConsent()
+
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
@@ -246,6 +282,8 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_RequiredAttributesFr
 		[]string{}, []string{"email", "name"}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil, nil)
 
+	suite.expectNoActiveConsent()
+
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
@@ -267,6 +305,8 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_RequiredEssentialAnd
 		[]string{"email"}, []string{"name"}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil, nil)
 
+	suite.expectNoActiveConsent()
+
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
@@ -288,6 +328,8 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_NilAssertionConfig()
 		[]string{}, []string{}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil, nil)
 
+	suite.expectNoActiveConsent()
+
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
@@ -312,6 +354,8 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_ExplicitEmptyRuntime
 		[]string{}, []string{}, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil, nil)
 
+	suite.expectNoActiveConsent()
+
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
@@ -1134,6 +1178,77 @@ func (suite *ConsentExecutorTestSuite) TestExecute_HasInputs_NoConsentedElements
 		"Consented attributes should be empty when no elements are approved")
 }
 
+// ----- Execute: authorization details tests -----
+
+const testConsentAuthorizationDetails = `[{"type":"payment_initiation","instructedAmount":{"amount":"10.00"}}]`
+
+func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AuthorizationDetails_PromptsWhenConsentsActive() {
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData[common.RuntimeKeyAuthorizationDetails] = testConsentAuthorizationDetails
+	suite.setupDefaultAuthnProviderMocks()
+
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*providers.ExecutorResponse"), mock.Anything).Return(true)
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*providers.ExecutorResponse")).Return(false)
+
+	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "", "user-123",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), "[]", resp.AdditionalData[common.DataConsentPrompt])
+	assert.Equal(suite.T(), testConsentAuthorizationDetails, resp.AdditionalData[common.DataAuthorizationDetails])
+	assert.NotNil(suite.T(), resp.ForwardedData[common.ForwardedDataKeyAuthorizationDetails])
+}
+
+func (suite *ConsentExecutorTestSuite) TestExecute_HasInputs_AuthorizationDetails_Approved() {
+	decisionsJSON, _ := json.Marshal(providers.ConsentDecisions{Approved: true})
+
+	ctx := buildConsentNodeContext()
+	ctx.UserInputs[userInputConsentDecisions] = string(decisionsJSON)
+	ctx.RuntimeData[common.RuntimeKeyAuthorizationDetails] = testConsentAuthorizationDetails
+	suite.setupDefaultAuthnProviderMocks()
+
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*providers.ExecutorResponse"), mock.Anything).Return(true)
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*providers.ExecutorResponse")).Return(true)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), testConsentAuthorizationDetails,
+		resp.RuntimeData[common.RuntimeKeyConsentedAuthorizationDetails])
+	suite.mockConsentEnforcer.AssertNotCalled(suite.T(), "RecordConsent", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ConsentExecutorTestSuite) TestExecute_HasInputs_AuthorizationDetails_Denied() {
+	decisionsJSON, _ := json.Marshal(providers.ConsentDecisions{Approved: false})
+
+	ctx := buildConsentNodeContext()
+	ctx.UserInputs[userInputConsentDecisions] = string(decisionsJSON)
+	ctx.RuntimeData[common.RuntimeKeyAuthorizationDetails] = testConsentAuthorizationDetails
+	suite.setupDefaultAuthnProviderMocks()
+
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*providers.ExecutorResponse"), mock.Anything).Return(true)
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*providers.ExecutorResponse")).Return(true)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrConsentDenied.Code, resp.Error.Code)
+	assert.NotContains(suite.T(), resp.RuntimeData, common.RuntimeKeyConsentedAuthorizationDetails)
+}
+
 // ----- Execute: with augmented attributes tests -----
 
 func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AugmentedAttributes_GroupsInjected() {
@@ -1160,6 +1275,8 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AugmentedAttributes_
 		}), mock.Anything, mock.Anything).
 		Return(nil, nil)
 
+	suite.expectNoActiveConsent()
+
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
@@ -1195,6 +1312,8 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AugmentedAttributes_
 		}), mock.Anything, mock.Anything).
 		Return(nil, nil)
 
+	suite.expectNoActiveConsent()
+
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
@@ -1224,6 +1343,8 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AugmentedAttributes_
 		}), mock.Anything, mock.Anything).
 		Return(nil, nil)
 
+	suite.expectNoActiveConsent()
+
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
@@ -1255,6 +1376,8 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AugmentedAttributes_
 		}), mock.Anything, mock.Anything).
 		Return(nil, nil)
 
+	suite.expectNoActiveConsent()
+
 	resp, err := suite.executor.Execute(ctx)
 
 	assert.NoError(suite.T(), err)
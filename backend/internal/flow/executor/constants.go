@@ -35,10 +35,12 @@ const (
 	ExecutorNameSession                      = "SessionExecutor"
 	ExecutorNameSessionSignOut               = "SessionSignOutExecutor"
 	ExecutorNameOTPExecutor                  = "OTPExecutor"
+	ExecutorNameTOTP                         = "TOTPExecutor"
 	ExecutorNamePreDelete                    = "PreDeleteExecutor"
 	ExecutorNameCriteriaRevocation           = "CriteriaRevocationExecutor"
 	ExecutorNameSessionRevocation            = "SessionRevocationExecutor"
 	ExecutorNameUserDelete                   = "UserDeleteExecutor"
+	ExecutorNameScript                       = "ScriptExecutor"
 )
 
 // Executor mode constants
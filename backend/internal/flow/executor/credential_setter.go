@@ -6,9 +6,12 @@ package executor
 import (
 	"encoding/json"
 
+	"github.com/thunder-id/thunderid/internal/authn/passwordpolicy"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -17,6 +20,7 @@ type credentialSetter struct {
 	providers.Executor
 	entityProvider entityprovider.EntityProviderInterface
 	authnProvider  providers.AuthnProviderManager
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface
 	logger         *log.Logger
 }
 
@@ -25,6 +29,7 @@ func newCredentialSetter(
 	flowFactory core.FlowFactoryInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	authnProvider providers.AuthnProviderManager,
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface,
 ) *credentialSetter {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CredentialSetter"))
 	base := flowFactory.CreateExecutor(
@@ -50,6 +55,7 @@ func newCredentialSetter(
 		Executor:       base,
 		entityProvider: entityProvider,
 		authnProvider:  authnProvider,
+		passwordPolicy: passwordPolicy,
 		logger:         logger,
 	}
 }
@@ -112,10 +118,27 @@ func (e *credentialSetter) Execute(ctx *providers.NodeContext) (*providers.Execu
 		return execResp, nil
 	}
 
+	credentialMap := map[string]string{credentialKey: credentialValue}
+	if e.passwordPolicy != nil {
+		if svcErr := e.passwordPolicy.ValidateCredentials(ctx.Context, userID, credentialMap, true); svcErr != nil {
+			if svcErr.Type != tidcommon.ClientErrorType {
+				logger.Error(ctx.Context, "Failed to validate credentials against the password policy",
+					log.String("errorCode", svcErr.Code))
+				execResp.Status = providers.ExecFailure
+				execResp.Error = &ErrCredentialSetFailed
+				return execResp, nil
+			}
+			logger.Debug(ctx.Context, "Credentials rejected by the password policy",
+				log.String("errorCode", svcErr.Code))
+			execResp.Status = providers.ExecUserInputRequired
+			execResp.Inputs = requiredInputs
+			execResp.Error = tidcommon.CustomServiceError(ErrPasswordPolicyViolation, svcErr.ErrorDescription)
+			return execResp, nil
+		}
+	}
+
 	// Build credentials
-	credentials, err := json.Marshal(map[string]string{
-		credentialKey: credentialValue,
-	})
+	credentials, err := json.Marshal(credentialMap)
 	if err != nil {
 		logger.Debug(ctx.Context, "Failed to marshal credentials", log.Error(err))
 		execResp.Status = providers.ExecFailure
@@ -133,8 +156,19 @@ func (e *credentialSetter) Execute(ctx *providers.NodeContext) (*providers.Execu
 		return execResp, nil
 	}
 
+	if e.passwordPolicy != nil {
+		if svcErr := e.passwordPolicy.RecordCredentialChange(ctx.Context, userID, credentialMap); svcErr != nil {
+			logger.Warn(ctx.Context, "Failed to record password change in the password history",
+				log.MaskedString(log.LoggerKeyUserID, userID), log.String("errorCode", svcErr.Code))
+		}
+	}
+
 	logger.Debug(ctx.Context, "Successfully set credentials for user",
 		log.MaskedString(log.LoggerKeyUserID, userID))
+	// A new password lifts the change-password requirement of an expired password.
+	if ctx.RuntimeData[common.RuntimeKeyPasswordExpired] == dataValueTrue {
+		execResp.RuntimeData[common.RuntimeKeyPasswordExpired] = dataValueFalse
+	}
 	execResp.Status = providers.ExecComplete
 	return execResp, nil
 }
@@ -6,13 +6,17 @@ package executor
 import (
 	"testing"
 
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/authn/passwordpolicy"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/passwordpolicymock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
@@ -45,7 +49,8 @@ func (suite *CredentialSetterTestSuite) SetupTest() {
 			},
 		}, mock.Anything).Return(suite.mockBaseExecutor)
 
-	suite.executor = newCredentialSetter(suite.mockFlowFactory, suite.mockEntityProvider, suite.mockAuthnProvider)
+	suite.executor = newCredentialSetter(suite.mockFlowFactory, suite.mockEntityProvider, suite.mockAuthnProvider,
+		nil)
 }
 
 func (suite *CredentialSetterTestSuite) TestExecute_Success() {
@@ -81,6 +86,91 @@ func (suite *CredentialSetterTestSuite) TestExecute_Success() {
 	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
 }
 
+func (suite *CredentialSetterTestSuite) TestExecute_PasswordPolicy() {
+	passwordInput := providers.Input{
+		Identifier: userAttributePassword,
+		Type:       providers.InputTypePassword,
+		Required:   true,
+	}
+	credentials := map[string]string{userAttributePassword: "securePass123!"}
+
+	suite.Run("RecordsChange", func() {
+		suite.SetupTest()
+		ctx := &providers.NodeContext{
+			ExecutionID: "test-flow",
+			UserInputs:  credentials,
+			RuntimeData: map[string]string{
+				"userID":                         testUserID,
+				common.RuntimeKeyPasswordExpired: dataValueTrue,
+			},
+		}
+		suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+		suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything, mock.Anything).Return(true)
+		suite.mockBaseExecutor.On("GetUserIDFromContext", ctx, mock.Anything, mock.Anything).Return(testUserID)
+		suite.mockBaseExecutor.On("GetRequiredInputs", ctx).Return([]providers.Input{passwordInput})
+		suite.mockEntityProvider.On("UpdateCredentials", testUserID, mock.Anything).Return(nil).Once()
+		policyMock := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(suite.T())
+		policyMock.On("ValidateCredentials", mock.Anything, testUserID, credentials, true).Return(nil).Once()
+		policyMock.On("RecordCredentialChange", mock.Anything, testUserID, credentials).Return(nil).Once()
+		suite.executor.passwordPolicy = policyMock
+
+		resp, err := suite.executor.Execute(ctx)
+
+		suite.NoError(err)
+		suite.Equal(providers.ExecComplete, resp.Status)
+		suite.Equal(dataValueFalse, resp.RuntimeData[common.RuntimeKeyPasswordExpired])
+	})
+
+	suite.Run("RejectsViolation", func() {
+		suite.SetupTest()
+		ctx := &providers.NodeContext{
+			ExecutionID: "test-flow",
+			UserInputs:  credentials,
+			RuntimeData: map[string]string{"userID": testUserID},
+		}
+		suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+		suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything, mock.Anything).Return(true)
+		suite.mockBaseExecutor.On("GetUserIDFromContext", ctx, mock.Anything, mock.Anything).Return(testUserID)
+		suite.mockBaseExecutor.On("GetRequiredInputs", ctx).Return([]providers.Input{passwordInput})
+		policyMock := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(suite.T())
+		policyMock.On("ValidateCredentials", mock.Anything, testUserID, credentials, true).
+			Return(&passwordpolicy.ErrorPasswordBreached).Once()
+		suite.executor.passwordPolicy = policyMock
+
+		resp, err := suite.executor.Execute(ctx)
+
+		suite.NoError(err)
+		suite.Equal(providers.ExecUserInputRequired, resp.Status)
+		suite.Equal(ErrPasswordPolicyViolation.Code, resp.Error.Code)
+		suite.Equal(passwordpolicy.ErrorPasswordBreached.ErrorDescription.Key, resp.Error.ErrorDescription.Key)
+		suite.Equal([]providers.Input{passwordInput}, resp.Inputs)
+		suite.mockEntityProvider.AssertNotCalled(suite.T(), "UpdateCredentials", mock.Anything, mock.Anything)
+	})
+
+	suite.Run("PolicyServerError", func() {
+		suite.SetupTest()
+		ctx := &providers.NodeContext{
+			ExecutionID: "test-flow",
+			UserInputs:  credentials,
+			RuntimeData: map[string]string{"userID": testUserID},
+		}
+		suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+		suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything, mock.Anything).Return(true)
+		suite.mockBaseExecutor.On("GetUserIDFromContext", ctx, mock.Anything, mock.Anything).Return(testUserID)
+		suite.mockBaseExecutor.On("GetRequiredInputs", ctx).Return([]providers.Input{passwordInput})
+		policyMock := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(suite.T())
+		policyMock.On("ValidateCredentials", mock.Anything, testUserID, credentials, true).
+			Return(&tidcommon.InternalServerError).Once()
+		suite.executor.passwordPolicy = policyMock
+
+		resp, err := suite.executor.Execute(ctx)
+
+		suite.NoError(err)
+		suite.Equal(providers.ExecFailure, resp.Status)
+		suite.Equal(ErrCredentialSetFailed.Code, resp.Error.Code)
+	})
+}
+
 func (suite *CredentialSetterTestSuite) TestExecute_MissingInput() {
 	ctx := &providers.NodeContext{
 		ExecutionID: "test-flow",
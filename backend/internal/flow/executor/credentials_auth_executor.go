@@ -11,8 +11,10 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
+	"github.com/thunder-id/thunderid/internal/authn/passwordpolicy"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -23,6 +25,7 @@ type credentialsAuthExecutor struct {
 	identifyingExecutorInterface
 	entityProvider entityprovider.EntityProviderInterface
 	authnProvider  providers.AuthnProviderManager
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface
 	logger         *log.Logger
 }
 
@@ -34,6 +37,7 @@ func newCredentialsAuthExecutor(
 	flowFactory core.FlowFactoryInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	authnProvider providers.AuthnProviderManager,
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface,
 ) *credentialsAuthExecutor {
 	defaultInputs := []providers.Input{
 		{
@@ -61,6 +65,7 @@ func newCredentialsAuthExecutor(
 		identifyingExecutorInterface: identifyExec,
 		entityProvider:               entityProvider,
 		authnProvider:                authnProvider,
+		passwordPolicy:               passwordPolicy,
 		logger:                       logger,
 	}
 }
@@ -194,6 +199,15 @@ func (b *credentialsAuthExecutor) authenticateUser(ctx *providers.NodeContext,
 	execResp.AuthUser = authUser
 	if svcErr != nil {
 		if svcErr.Type == tidcommon.ClientErrorType {
+			if svcErr.Code == authnprovidermgr.ErrorAccountLocked.Code {
+				// Fail the node so that the flow can branch on the locked state through onFailure.
+				logger.Debug(ctx.Context, "Authentication rejected as the account is locked")
+				execResp.Status = providers.ExecFailure
+				execResp.Error = &ErrAccountLocked
+				execResp.RuntimeData[common.RuntimeKeyAccountLocked] = dataValueTrue
+				return nil
+			}
+
 			execResp.Status = providers.ExecUserInputRequired
 			execResp.Inputs = b.GetRequiredInputs(ctx)
 
@@ -219,5 +233,43 @@ func (b *credentialsAuthExecutor) authenticateUser(ctx *providers.NodeContext,
 		}
 	}
 
+	if userID, ok := authenticatedClaims[userAttributeUserID].(string); ok && userID != "" {
+		b.rehashCredentials(ctx, userID, userCredentials)
+		b.checkPasswordExpiry(ctx, execResp, userID)
+	}
+
 	return nil
 }
+
+// checkPasswordExpiry flags an expired password in the runtime data so that the flow can branch to a
+// change-password step. Failures are logged and do not fail the authentication.
+func (b *credentialsAuthExecutor) checkPasswordExpiry(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse, userID string) {
+	if b.passwordPolicy == nil || !b.passwordPolicy.IsEnabled() {
+		return
+	}
+
+	expired, svcErr := b.passwordPolicy.IsPasswordExpired(ctx.Context, userID)
+	if svcErr != nil {
+		b.logger.Warn(ctx.Context, "Failed to check password expiry",
+			log.String(log.LoggerKeyExecutionID, ctx.ExecutionID),
+			log.MaskedString(log.LoggerKeyUserID, userID), log.String("errorCode", svcErr.Code))
+		return
+	}
+	if expired {
+		b.logger.Debug(ctx.Context, "Password has expired",
+			log.String(log.LoggerKeyExecutionID, ctx.ExecutionID), log.MaskedString(log.LoggerKeyUserID, userID))
+		execResp.RuntimeData[common.RuntimeKeyPasswordExpired] = dataValueTrue
+	}
+}
+
+// rehashCredentials upgrades the stored hashes of the verified credentials when they were created
+// with an outdated algorithm or parameters. Failures are logged and do not fail the authentication.
+func (b *credentialsAuthExecutor) rehashCredentials(ctx *providers.NodeContext, userID string,
+	credentials map[string]interface{}) {
+	if err := b.entityProvider.RehashCredentials(userID, credentials); err != nil {
+		b.logger.Warn(ctx.Context, "Failed to rehash user credentials",
+			log.String(log.LoggerKeyExecutionID, ctx.ExecutionID),
+			log.MaskedString(log.LoggerKeyUserID, userID), log.String("errorCode", string(err.Code)))
+	}
+}
@@ -15,6 +15,8 @@ import (
 
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/passwordpolicymock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
@@ -52,7 +54,7 @@ func (suite *CredentialsAuthExecutorTestSuite) SetupTest() {
 		defaultInputs, []providers.Input{}, mock.Anything).Return(mockExec)
 
 	suite.executor = newCredentialsAuthExecutor(suite.mockFlowFactory, suite.mockEntityProvider,
-		suite.mockAuthnProvider)
+		suite.mockAuthnProvider, nil)
 }
 
 // newCredentialsAuthAuthenticatedUser creates an AuthUser that returns true for IsAuthenticated().
@@ -489,6 +491,115 @@ func (suite *CredentialsAuthExecutorTestSuite) TestAuthenticateUser_Success_With
 	suite.mockAuthnProvider.AssertExpectations(suite.T())
 }
 
+func (suite *CredentialsAuthExecutorTestSuite) TestAuthenticateUser_RehashesCredentialsOfAuthenticatedUser() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userAttributeUsername: "testuser",
+			userAttributePassword: "password123",
+		},
+	}
+
+	execResp := &providers.ExecutorResponse{
+		RuntimeData: make(map[string]string),
+	}
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(newCredentialsAuthAuthenticatedUser(), providers.AuthenticatedClaims{userAttributeUserID: "user-123"}, nil)
+	suite.mockEntityProvider.On("RehashCredentials", "user-123", map[string]interface{}{
+		userAttributePassword: "password123",
+	}).Return(nil)
+
+	err := suite.executor.authenticateUser(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "user-123", execResp.RuntimeData[userAttributeUserID])
+	suite.mockEntityProvider.AssertExpectations(suite.T())
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_RehashFailureDoesNotFailAuthentication() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userAttributeUsername: "testuser",
+			userAttributePassword: "password123",
+		},
+		RuntimeData: make(map[string]string),
+	}
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(newCredentialsAuthAuthenticatedUser(), providers.AuthenticatedClaims{userAttributeUserID: "user-123"}, nil)
+	suite.mockEntityProvider.On("RehashCredentials", "user-123", mock.Anything).
+		Return(entityprovider.NewEntityProviderError(entityprovider.ErrorCodeSystemError, "System error", "failed"))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.True(suite.T(), resp.AuthUser.IsAuthenticated())
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_PasswordExpired_FlagsRuntimeData() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userAttributeUsername: "testuser",
+			userAttributePassword: "password123",
+		},
+		RuntimeData: make(map[string]string),
+	}
+
+	policyMock := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(suite.T())
+	policyMock.On("IsEnabled").Return(true)
+	policyMock.On("IsPasswordExpired", mock.Anything, "user-123").Return(true, nil).Once()
+	suite.executor.passwordPolicy = policyMock
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(newCredentialsAuthAuthenticatedUser(), providers.AuthenticatedClaims{userAttributeUserID: "user-123"}, nil)
+	suite.mockEntityProvider.On("RehashCredentials", "user-123", mock.Anything).Return(nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), dataValueTrue, resp.RuntimeData[common.RuntimeKeyPasswordExpired])
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_PasswordExpiryCheckFailureDoesNotFailAuthentication() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userAttributeUsername: "testuser",
+			userAttributePassword: "password123",
+		},
+		RuntimeData: make(map[string]string),
+	}
+
+	policyMock := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(suite.T())
+	policyMock.On("IsEnabled").Return(true)
+	policyMock.On("IsPasswordExpired", mock.Anything, "user-123").
+		Return(false, &tidcommon.InternalServerError).Once()
+	suite.executor.passwordPolicy = policyMock
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(newCredentialsAuthAuthenticatedUser(), providers.AuthenticatedClaims{userAttributeUserID: "user-123"}, nil)
+	suite.mockEntityProvider.On("RehashCredentials", "user-123", mock.Anything).Return(nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Empty(suite.T(), resp.RuntimeData[common.RuntimeKeyPasswordExpired])
+}
+
 func (suite *CredentialsAuthExecutorTestSuite) TestAuthenticateUser_AuthenticationFlow_NoRedundantIdentifyUser() {
 	ctx := &providers.NodeContext{
 		ExecutionID: "flow-123",
@@ -642,6 +753,29 @@ func (suite *CredentialsAuthExecutorTestSuite) TestGetAuthenticatedUser_ClientEr
 	assert.Len(suite.T(), execResp.Inputs, 2, "Should include both username and password inputs")
 }
 
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_AccountLocked_FailsForBranching() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userAttributeUsername: "testuser",
+			userAttributePassword: "password123",
+		},
+		RuntimeData: make(map[string]string),
+	}
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(
+		providers.AuthUser{}, (providers.AuthenticatedClaims)(nil), &authnprovidermgr.ErrorAccountLocked)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrAccountLocked.Code, resp.Error.Code)
+	assert.Equal(suite.T(), dataValueTrue, resp.RuntimeData[common.RuntimeKeyAccountLocked])
+}
+
 func (suite *CredentialsAuthExecutorTestSuite) TestExecute_PreResolvedUser_RequestsPassword() {
 	ctx := &providers.NodeContext{
 		ExecutionID: "flow-123",
@@ -1208,6 +1208,132 @@ var (
 			DefaultValue: "The user could not be deleted",
 		},
 	}
+
+	// ErrInvalidTOTPCode is returned when the TOTP or recovery code is invalid.
+	ErrInvalidTOTPCode = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1086",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.invalid_totp_code",
+			DefaultValue: "Invalid code",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.invalid_totp_code_desc",
+			DefaultValue: "The authenticator app or recovery code provided is invalid",
+		},
+	}
+
+	// ErrTOTPEnrollmentFailed is returned when authenticator app enrollment fails.
+	ErrTOTPEnrollmentFailed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1087",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.totp_enrollment_failed",
+			DefaultValue: "Authenticator app enrollment failed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.totp_enrollment_failed_desc",
+			DefaultValue: "An error occurred while enrolling the authenticator app",
+		},
+	}
+	// ErrAccountLocked is returned when authentication is rejected because the account is locked
+	ErrAccountLocked = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1088",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.account_locked",
+			DefaultValue: "Account locked",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.account_locked_desc",
+			DefaultValue: "The account is temporarily locked due to repeated failed sign-in attempts. Try again later",
+		},
+	}
+	// ErrPasswordPolicyViolation is returned when a new password does not satisfy the password policy
+	ErrPasswordPolicyViolation = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1089",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.password_policy_violation",
+			DefaultValue: "Password policy violation",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.password_policy_violation_desc",
+			DefaultValue: "The password does not meet the password policy",
+		},
+	}
+
+	// ErrScriptConfigInvalid is returned when the script executor's script or timeout is invalid.
+	ErrScriptConfigInvalid = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1090",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.script_config_invalid",
+			DefaultValue: "Configuration error",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.script_config_invalid_desc",
+			DefaultValue: "The script executor configuration is invalid",
+		},
+	}
+
+	// ErrScriptExecutionFailed is returned when a script stops with an evaluation error or exceeds one of
+	// its limits.
+	ErrScriptExecutionFailed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1091",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.script_execution_failed",
+			DefaultValue: "Script execution failed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.script_execution_failed_desc",
+			DefaultValue: "The script could not be completed",
+		},
+	}
+
+	// ErrScriptFailed is returned when a script fails the node with a fail statement. The description
+	// carries the script's message.
+	ErrScriptFailed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1092",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.script_failed",
+			DefaultValue: "Request denied",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.script_failed_desc",
+			DefaultValue: "{{param(message)}}",
+		},
+	}
+
+	// ErrScriptFailureOutcome is returned when a script chooses the failure outcome without a message.
+	// Like ErrNoLiveSSOSession it is a routing outcome that sends the node to onFailure.
+	ErrScriptFailureOutcome = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1093",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.script_failure_outcome",
+			DefaultValue: "Script failure outcome",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.script_failure_outcome_desc",
+			DefaultValue: "The script chose the failure outcome",
+		},
+	}
+	// ErrMaxTOTPAttemptsReached is returned when the maximum TOTP or recovery code attempts are reached.
+	ErrMaxTOTPAttemptsReached = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1094",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.max_totp_attempts_reached",
+			DefaultValue: "Maximum code attempts reached",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.max_totp_attempts_reached_desc",
+			DefaultValue: "The maximum number of authenticator app or recovery code attempts has been reached",
+		},
+	}
 )
 
 // errAttributeNotUniqueFor returns a ServiceError for a specific attribute that is not unique.
@@ -1226,3 +1352,10 @@ func errMaxOTPAttemptsReachedFor(count int) *tidcommon.ServiceError {
 		"The maximum number of OTP verification attempts (%d) has been reached", count)
 	return &e
 }
+
+// errScriptFailedWith returns a ServiceError carrying the message a script failed the node with.
+func errScriptFailedWith(message string) *tidcommon.ServiceError {
+	e := ErrScriptFailed
+	e.ErrorDescription.Params = map[string]string{"message": message}
+	return &e
+}
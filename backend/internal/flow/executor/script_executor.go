@@ -0,0 +1,115 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package executor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/script"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const scriptLoggerComponentName = "ScriptExecutor"
+
+// scriptExecutor runs a sandboxed script, configured on the node, against the flow context. The
+// script can read the user inputs, runtime data, authenticated user attributes, request and
+// application, and can write runtime data, fail the node or choose an outcome. See the script
+// package for the language and its limits.
+type scriptExecutor struct {
+	providers.Executor
+	logger *log.Logger
+}
+
+var _ providers.Executor = (*scriptExecutor)(nil)
+
+// newScriptExecutor creates a new instance of ScriptExecutor.
+func newScriptExecutor(flowFactory core.FlowFactoryInterface) *scriptExecutor {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, scriptLoggerComponentName),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameScript))
+
+	base := flowFactory.CreateExecutor(ExecutorNameScript, providers.ExecutorTypeUtility,
+		[]providers.Input{}, []providers.Input{}, &providers.ExecutorMeta{
+			SupportedProperties: []providers.ExecutorSupportedProperties{
+				{Property: common.NodePropertyScript, IsRequired: true},
+				{Property: common.NodePropertyScriptTimeout},
+			},
+		})
+
+	return &scriptExecutor{
+		Executor: base,
+		logger:   logger,
+	}
+}
+
+// Execute runs the node's script. The script's outcome is recorded in the runtime data; the
+// failure outcome, with or without a message, routes the node to onFailure and any other outcome
+// completes it. A script that cannot be compiled or that stops with an error fails the node.
+func (s *scriptExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug(ctx.Context, "Executing script executor")
+
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	program, err := compileNodeScript(ctx.NodeProperties)
+	if err != nil {
+		logger.Error(ctx.Context, "Invalid script configuration", log.Error(err))
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrScriptConfigInvalid
+		return execResp, nil
+	}
+	timeout, err := script.ParseTimeout(ctx.NodeProperties[common.NodePropertyScriptTimeout])
+	if err != nil {
+		logger.Error(ctx.Context, "Invalid script configuration", log.Error(err))
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrScriptConfigInvalid
+		return execResp, nil
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx.Context, timeout)
+	defer cancel()
+	result, err := program.Run(runCtx, core.BuildExpressionVariables(ctx))
+	if err != nil {
+		logger.Error(ctx.Context, "Script execution failed", log.Error(err))
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrScriptExecutionFailed
+		return execResp, nil
+	}
+
+	for key, value := range result.RuntimeData {
+		execResp.RuntimeData[key] = value
+	}
+	execResp.RuntimeData[common.RuntimeKeyScriptOutcome] = result.Outcome
+
+	switch {
+	case result.FailureMessage != "":
+		execResp.Status = providers.ExecFailure
+		execResp.Error = errScriptFailedWith(result.FailureMessage)
+	case result.Failed():
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrScriptFailureOutcome
+	default:
+		execResp.Status = providers.ExecComplete
+	}
+
+	logger.Debug(ctx.Context, "Script executor execution completed",
+		log.String("outcome", result.Outcome), log.String("status", string(execResp.Status)))
+
+	return execResp, nil
+}
+
+// compileNodeScript compiles the script configured in the node properties.
+func compileNodeScript(properties map[string]interface{}) (*script.Script, error) {
+	source, ok := properties[common.NodePropertyScript].(string)
+	if !ok {
+		return nil, errors.New("script property must be a string")
+	}
+	return script.Compile(source)
+}
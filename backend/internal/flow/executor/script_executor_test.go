@@ -0,0 +1,159 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type ScriptExecutorTestSuite struct {
+	suite.Suite
+	executor *scriptExecutor
+}
+
+func TestScriptExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(ScriptExecutorTestSuite))
+}
+
+func (suite *ScriptExecutorTestSuite) SetupTest() {
+	mockFlowFactory := coremock.NewFlowFactoryInterfaceMock(suite.T())
+	mockFlowFactory.On("CreateExecutor", ExecutorNameScript, providers.ExecutorTypeUtility,
+		[]providers.Input{}, []providers.Input{}, mock.Anything).
+		Return(newMockExecutor(ExecutorNameScript, providers.ExecutorTypeUtility,
+			[]providers.Input{}, []providers.Input{}))
+	suite.executor = newScriptExecutor(mockFlowFactory)
+}
+
+func (suite *ScriptExecutorTestSuite) newContext(properties map[string]interface{}) *providers.NodeContext {
+	return &providers.NodeContext{
+		Context:        context.Background(),
+		ExecutionID:    "exec-1",
+		UserInputs:     map[string]string{"mobileNumber": "0771234567", "email": "alice@example.com"},
+		RuntimeData:    map[string]string{"attemptCount": "1"},
+		NodeProperties: properties,
+	}
+}
+
+func (suite *ScriptExecutorTestSuite) TestExecute_SetsRuntimeData() {
+	ctx := suite.newContext(map[string]interface{}{
+		common.NodePropertyScript: `
+let mobile = inputs.mobileNumber
+if mobile.startsWith("0") {
+    let mobile = "+94" + mobile.split("0")[1]
+}
+set runtime.mobileNumber = mobile
+set runtime.attemptCount = int(runtime.attemptCount) + 1
+`,
+	})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecComplete, resp.Status)
+	suite.Nil(resp.Error)
+	suite.Equal("+94771234567", resp.RuntimeData["mobileNumber"])
+	suite.Equal("2", resp.RuntimeData["attemptCount"])
+	suite.Equal("success", resp.RuntimeData[common.RuntimeKeyScriptOutcome])
+	suite.Equal("1", ctx.RuntimeData["attemptCount"], "the node context must not be modified")
+}
+
+func (suite *ScriptExecutorTestSuite) TestExecute_Outcome() {
+	ctx := suite.newContext(map[string]interface{}{
+		common.NodePropertyScript: `outcome inputs.email.endsWith("@example.com") ? "internal" : "external"`,
+	})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecComplete, resp.Status)
+	suite.Equal("internal", resp.RuntimeData[common.RuntimeKeyScriptOutcome])
+}
+
+func (suite *ScriptExecutorTestSuite) TestExecute_Fail() {
+	ctx := suite.newContext(map[string]interface{}{
+		common.NodePropertyScript: `
+set runtime.blocked = true
+fail "Sign-ups from " + inputs.email.split("@")[1] + " are not allowed"
+`,
+	})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Require().NotNil(resp.Error)
+	suite.Equal(ErrScriptFailed.Code, resp.Error.Code)
+	suite.Equal("Sign-ups from example.com are not allowed", resp.Error.ErrorDescription.Params["message"])
+	suite.Equal("true", resp.RuntimeData["blocked"])
+	suite.Equal("failure", resp.RuntimeData[common.RuntimeKeyScriptOutcome])
+}
+
+func (suite *ScriptExecutorTestSuite) TestExecute_FailureOutcome() {
+	ctx := suite.newContext(map[string]interface{}{common.NodePropertyScript: `outcome "failure"`})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(&ErrScriptFailureOutcome, resp.Error)
+}
+
+func (suite *ScriptExecutorTestSuite) TestExecute_InvalidConfiguration() {
+	tests := []struct {
+		name       string
+		properties map[string]interface{}
+	}{
+		{"missing script", map[string]interface{}{}},
+		{"non string script", map[string]interface{}{common.NodePropertyScript: 1}},
+		{"syntax error", map[string]interface{}{common.NodePropertyScript: "let x ="}},
+		{"invalid timeout", map[string]interface{}{
+			common.NodePropertyScript:        `outcome "a"`,
+			common.NodePropertyScriptTimeout: float64(60000),
+		}},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			resp, err := suite.executor.Execute(suite.newContext(tc.properties))
+
+			suite.NoError(err)
+			suite.Equal(providers.ExecFailure, resp.Status)
+			suite.Equal(&ErrScriptConfigInvalid, resp.Error)
+		})
+	}
+}
+
+func (suite *ScriptExecutorTestSuite) TestExecute_RuntimeError() {
+	ctx := suite.newContext(map[string]interface{}{
+		common.NodePropertyScript: `set runtime.phone = claims.phone`,
+	})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(&ErrScriptExecutionFailed, resp.Error)
+	suite.Empty(resp.RuntimeData)
+}
+
+func (suite *ScriptExecutorTestSuite) TestExecute_ContextDone() {
+	ctx := suite.newContext(map[string]interface{}{common.NodePropertyScript: `outcome "a"`})
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	ctx.Context = cancelled
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(&ErrScriptExecutionFailed, resp.Error)
+}
@@ -173,6 +173,9 @@ func (e *sessionExecutor) saveCheckpoint(ctx *providers.NodeContext, execResp *p
 	// Publish the session handle as the shared hint so later joins in this execution attach to the
 	// same session directly.
 	execResp.RuntimeData[common.RuntimeKeySSOSessionHandle] = result.Handle
+	if result.SessionID != "" {
+		execResp.RuntimeData[common.RuntimeKeySSOSessionID] = result.SessionID
+	}
 	// Emit the cookie only when this call minted the session, and only now that its first checkpoint
 	// is durably saved — so a context-write failure never leaves a cookie for an empty session.
 	if result.Created {
@@ -268,6 +271,7 @@ func (e *sessionExecutor) loadCheckpoint(ctx *providers.NodeContext, execResp *p
 	if tokenFamilyID != "" {
 		execResp.RuntimeData[common.RuntimeKeyTokenFamilyID] = tokenFamilyID
 	}
+	execResp.RuntimeData[common.RuntimeKeySSOSessionID] = ssoSession.SessionID
 
 	logger.Debug(ctx.Context, "Loaded SSO checkpoint",
 		log.String("flowId", session.SSOInputsFrom(ctx.Context).FlowID),
@@ -291,6 +295,10 @@ var requestScopedSnapshotDenyList = map[string]struct{}{
 	common.RuntimeKeyAuthorizationRequestID:      {},
 	// The token family id is minted fresh per flow execution, so it must not ride a reused snapshot.
 	common.RuntimeKeyTokenFamilyID: {},
+	// The grant's consent belongs to the establishing app; a joining app resolves its own.
+	common.RuntimeKeyGrantConsentID: {},
+	// The session id is published live by the Session node on both the save and load paths.
+	common.RuntimeKeySSOSessionID: {},
 	// applicationId has no shared constant (set as a raw literal in enrichRuntimeData).
 	"applicationId": {},
 }
@@ -111,7 +111,7 @@ func captureSave(sso *sessionmock.ServiceMock, captured *session.SaveCheckpointI
 func (suite *SessionExecutorTestSuite) TestFreshSave() {
 	sso := sessionmock.NewServiceMock(suite.T())
 	var in session.SaveCheckpointInput
-	captureSave(sso, &in, session.SaveCheckpointResult{Handle: "handle-xyz", Created: true})
+	captureSave(sso, &in, session.SaveCheckpointResult{Handle: "handle-xyz", SessionID: "sess-1", Created: true})
 	exec := suite.newExecutor(sso, suite.saveAuthnMock())
 
 	resp, err := exec.Execute(freshCtx())
@@ -142,6 +142,8 @@ func (suite *SessionExecutorTestSuite) TestFreshSave() {
 	suite.Equal("handle-xyz",
 		resp.RuntimeData[common.SSOCheckpointKey(common.RuntimeKeySSOSessionSaved, "session")])
 	suite.Equal("handle-xyz", resp.RuntimeData[common.RuntimeKeySSOSessionHandle])
+	// The session id is published for the auth assertion's sid claim.
+	suite.Equal("sess-1", resp.RuntimeData[common.RuntimeKeySSOSessionID])
 	// The already-authenticated subject is echoed back so the engine keeps it.
 	suite.True(resp.AuthUser.IsAuthenticated())
 }
@@ -353,6 +355,7 @@ func (suite *SessionExecutorTestSuite) TestSSOLoad() {
 	suite.Equal("eng", resp.RuntimeData["department"])
 	// auth_time comes from the lean session and wins over the stale snapshot copy.
 	suite.Equal("1700000000", resp.RuntimeData[common.RuntimeKeyAuthTime])
+	suite.Equal("sess-1", resp.RuntimeData[common.RuntimeKeySSOSessionID])
 }
 
 // TestSSOLoad_PassesForwardedReadsToService is the executor half of the reuse-path read reduction: the
@@ -0,0 +1,368 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package executor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/authn/lockout"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// TOTP input identifiers
+const (
+	userInputTOTPCode     = "totpCode"
+	userInputRecoveryCode = "recoveryCode"
+)
+
+// Runtime and additional data keys
+const (
+	runtimeTOTPSessionToken = "totpSessionToken"
+	// nolint:gosec // G101: These are data keys, not credentials
+	dataKeyTOTPSecret          = "totpSecret"
+	dataKeyTOTPProvisioningURI = "totpProvisioningUri"
+	dataKeyTOTPRecoveryCodes   = "totpRecoveryCodes"
+)
+
+// defaultMaxTOTPAttempts is the number of wrong codes accepted within the lockout failure window before
+// the user is locked out of TOTP.
+const defaultMaxTOTPAttempts = 5
+
+// totpExecutor implements the ExecutorInterface for TOTP authenticator-app enrollment and verification.
+type totpExecutor struct {
+	providers.Executor
+	authnProvider  providers.AuthnProviderManager
+	lockoutService lockout.LockoutServiceInterface
+	logger         *log.Logger
+}
+
+var _ providers.Executor = (*totpExecutor)(nil)
+
+// newTOTPExecutor creates a new instance of TOTPExecutor.
+func newTOTPExecutor(
+	flowFactory core.FlowFactoryInterface,
+	authnProvider providers.AuthnProviderManager,
+	lockoutService lockout.LockoutServiceInterface,
+) *totpExecutor {
+	defaultInputs := []providers.Input{
+		{
+			Ref:        "totp_code_input",
+			Identifier: userInputTOTPCode,
+			Type:       providers.InputTypeOTP,
+			Required:   true,
+		},
+		{
+			Ref:        "recovery_code_input",
+			Identifier: userInputRecoveryCode,
+			Type:       providers.InputTypeText,
+			Required:   false,
+		},
+	}
+	prerequisites := []providers.Input{
+		{
+			Identifier: userAttributeUserID,
+			Type:       "string",
+			Required:   true,
+		},
+	}
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TOTPExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameTOTP))
+
+	base := flowFactory.CreateExecutor(ExecutorNameTOTP, providers.ExecutorTypeAuthentication,
+		defaultInputs, prerequisites, &providers.ExecutorMeta{
+			SupportedModes: []string{
+				ExecutorModeGenerate,
+				ExecutorModeVerify,
+			},
+			SupportedProperties: []providers.ExecutorSupportedProperties{
+				{Property: propertyKeyMaxOTPAttempts},
+			},
+		})
+
+	return &totpExecutor{
+		Executor:       base,
+		authnProvider:  authnProvider,
+		lockoutService: lockoutService,
+		logger:         logger,
+	}
+}
+
+// Execute executes the TOTP executor logic. The generate mode starts enrolling an authenticator app.
+// The verify mode confirms a pending enrollment, or authenticates the user when none is pending.
+func (t *totpExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug(ctx.Context, "Executing TOTP executor")
+
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		AuthUser:       ctx.AuthUser,
+	}
+
+	if !t.ValidatePrerequisites(ctx, execResp, t.authnProvider) {
+		logger.Debug(ctx.Context, "Prerequisites not met for TOTP executor")
+		return execResp, nil
+	}
+
+	switch ctx.ExecutorMode {
+	case ExecutorModeGenerate:
+		return t.executeGenerate(ctx, execResp)
+	case ExecutorModeVerify:
+		if ctx.RuntimeData[runtimeTOTPSessionToken] != "" {
+			return t.executeEnrollmentVerify(ctx, execResp)
+		}
+		return t.executeVerify(ctx, execResp)
+	default:
+		return execResp, fmt.Errorf("invalid executor mode: %s", ctx.ExecutorMode)
+	}
+}
+
+// executeGenerate starts the enrollment of an authenticator app and returns the provisioning URI,
+// the shared secret and the recovery codes to the client.
+func (t *totpExecutor) executeGenerate(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse) (*providers.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	userID := t.GetUserIDFromContext(ctx, execResp, t.authnProvider)
+	if userID == "" {
+		return execResp, errors.New("user ID is not available for TOTP enrollment")
+	}
+	logger.Debug(ctx.Context, "Starting TOTP enrollment", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	startReq := &totp.TOTPEnrollmentStartRequest{UserID: userID}
+	initResponse, svcErr := t.authnProvider.InitiateEnrollment(ctx.Context, totp.CredentialType, startReq, nil)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			logger.Debug(ctx.Context, "Failed to start TOTP enrollment",
+				log.MaskedString(log.LoggerKeyUserID, userID),
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			execResp.Status = providers.ExecFailure
+			execResp.Error = tidcommon.CustomServiceError(ErrTOTPEnrollmentFailed, tidcommon.I18nMessage{
+				Key:          ErrTOTPEnrollmentFailed.ErrorDescription.Key,
+				DefaultValue: "Failed to start TOTP enrollment: " + svcErr.ErrorDescription.DefaultValue,
+			})
+			return execResp, nil
+		}
+		return execResp, fmt.Errorf("failed to start TOTP enrollment: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	startData, ok := initResponse.(*totp.TOTPEnrollmentStartData)
+	if !ok {
+		logger.Error(ctx.Context, "Invalid response type from InitiateEnrollment",
+			log.MaskedString(log.LoggerKeyUserID, userID))
+		return execResp, errors.New("invalid response type from InitiateEnrollment")
+	}
+
+	recoveryCodesJSON, err := json.Marshal(startData.RecoveryCodes)
+	if err != nil {
+		return execResp, fmt.Errorf("failed to marshal recovery codes: %w", err)
+	}
+
+	execResp.RuntimeData[runtimeTOTPSessionToken] = startData.SessionToken
+	execResp.AdditionalData[dataKeyTOTPSecret] = startData.Secret
+	execResp.AdditionalData[dataKeyTOTPProvisioningURI] = startData.ProvisioningURI
+	execResp.AdditionalData[dataKeyTOTPRecoveryCodes] = string(recoveryCodesJSON)
+	execResp.Status = providers.ExecComplete
+
+	logger.Debug(ctx.Context, "TOTP enrollment started", log.MaskedString(log.LoggerKeyUserID, userID))
+	return execResp, nil
+}
+
+// executeEnrollmentVerify confirms a pending enrollment with a code from the authenticator app.
+func (t *totpExecutor) executeEnrollmentVerify(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse) (*providers.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	code := ctx.UserInputs[userInputTOTPCode]
+	if code == "" {
+		logger.Debug(ctx.Context, "TOTP code is not provided for enrollment")
+		execResp.Status = providers.ExecUserInputRequired
+		execResp.Inputs = t.codeInputs(ctx)
+		return execResp, nil
+	}
+
+	userID := t.GetUserIDFromContext(ctx, execResp, t.authnProvider)
+	if userID == "" {
+		return execResp, errors.New("user ID is not available for TOTP enrollment")
+	}
+	if ok, err := t.validateAttempts(ctx, execResp, userID, logger); !ok || err != nil {
+		return execResp, err
+	}
+
+	finishReq := &totp.TOTPEnrollmentFinishRequest{
+		SessionToken: ctx.RuntimeData[runtimeTOTPSessionToken],
+		Code:         code,
+	}
+	credentials := map[string]interface{}{totp.CredentialType: finishReq}
+	authUser, authenticatedClaims, svcErr := t.authnProvider.Enroll(
+		ctx.Context, nil, credentials, nil, nil, execResp.AuthUser)
+	execResp.AuthUser = authUser
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			logger.Debug(ctx.Context, "TOTP enrollment failed",
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			if ok, err := t.recordFailedAttempt(ctx, execResp, userID, logger); !ok || err != nil {
+				return execResp, err
+			}
+			// Return USER_INPUT_REQUIRED to allow retry with a fresh code
+			execResp.Status = providers.ExecUserInputRequired
+			execResp.Inputs = t.codeInputs(ctx)
+			execResp.Error = &ErrInvalidTOTPCode
+			return execResp, nil
+		}
+		return execResp, fmt.Errorf("failed to finish TOTP enrollment: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	for key, value := range authenticatedClaims {
+		execResp.RuntimeData[key] = systemutils.ConvertInterfaceValueToString(value)
+	}
+
+	if svcErr := t.lockoutService.RecordFactorSuccess(ctx.Context, lockout.FactorTOTP, userID); svcErr != nil {
+		return execResp, fmt.Errorf("failed to clear TOTP attempts: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+
+	// Clear session token after successful enrollment
+	execResp.RuntimeData[runtimeTOTPSessionToken] = ""
+
+	execResp.Status = providers.ExecComplete
+	logger.Debug(ctx.Context, "TOTP enrollment completed successfully")
+	return execResp, nil
+}
+
+// executeVerify authenticates the user with a TOTP code or a recovery code.
+func (t *totpExecutor) executeVerify(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse) (*providers.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	code := ctx.UserInputs[userInputTOTPCode]
+	recoveryCode := ctx.UserInputs[userInputRecoveryCode]
+	if code == "" && recoveryCode == "" {
+		logger.Debug(ctx.Context, "TOTP or recovery code is not provided")
+		execResp.Status = providers.ExecUserInputRequired
+		execResp.Inputs = t.GetRequiredInputs(ctx)
+		return execResp, nil
+	}
+
+	userID := t.GetUserIDFromContext(ctx, execResp, t.authnProvider)
+	if userID == "" {
+		return execResp, errors.New("user ID is not available for TOTP verification")
+	}
+	if ok, err := t.validateAttempts(ctx, execResp, userID, logger); !ok || err != nil {
+		return execResp, err
+	}
+
+	authReq := &totp.TOTPAuthenticationRequest{
+		UserID:       userID,
+		Code:         code,
+		RecoveryCode: recoveryCode,
+	}
+	credentials := map[string]interface{}{totp.CredentialType: authReq}
+	authUser, authenticatedClaims, svcErr := t.authnProvider.AuthenticateUser(
+		ctx.Context, nil, credentials, nil, nil, execResp.AuthUser)
+	execResp.AuthUser = authUser
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			logger.Debug(ctx.Context, "TOTP verification failed",
+				log.MaskedString(log.LoggerKeyUserID, userID),
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			if ok, err := t.recordFailedAttempt(ctx, execResp, userID, logger); !ok || err != nil {
+				return execResp, err
+			}
+			// Return USER_INPUT_REQUIRED to allow retry on an invalid code
+			execResp.Status = providers.ExecUserInputRequired
+			execResp.Inputs = t.GetRequiredInputs(ctx)
+			execResp.Error = &ErrInvalidTOTPCode
+			return execResp, nil
+		}
+		logger.Error(ctx.Context, "Failed to verify TOTP code", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+		return execResp, fmt.Errorf("failed to verify TOTP code: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	if svcErr := t.lockoutService.RecordFactorSuccess(ctx.Context, lockout.FactorTOTP, userID); svcErr != nil {
+		return execResp, fmt.Errorf("failed to clear TOTP attempts: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	for key, value := range authenticatedClaims {
+		execResp.RuntimeData[key] = systemutils.ConvertInterfaceValueToString(value)
+	}
+
+	execResp.Status = providers.ExecComplete
+	logger.Debug(ctx.Context, "TOTP verification completed successfully",
+		log.MaskedString(log.LoggerKeyUserID, userID))
+	return execResp, nil
+}
+
+// codeInputs returns the inputs for confirming an enrollment. Recovery codes are not accepted there.
+func (t *totpExecutor) codeInputs(ctx *providers.NodeContext) []providers.Input {
+	inputs := make([]providers.Input, 0, 1)
+	for _, input := range t.GetRequiredInputs(ctx) {
+		if input.Identifier != userInputRecoveryCode {
+			inputs = append(inputs, input)
+		}
+	}
+	return inputs
+}
+
+// validateAttempts fails the node when the user is locked out of TOTP after too many wrong codes. The
+// wrong codes are counted per user across flow executions, so that codes cannot be guessed without
+// bound by starting new flows. Returns false when the node has failed.
+func (t *totpExecutor) validateAttempts(ctx *providers.NodeContext, execResp *providers.ExecutorResponse,
+	userID string, logger *log.Logger) (bool, error) {
+	status, svcErr := t.lockoutService.CheckFactorLocked(ctx.Context, lockout.FactorTOTP, userID)
+	if svcErr != nil {
+		return false, fmt.Errorf("failed to check TOTP lockout: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	if !status.Locked {
+		return true, nil
+	}
+	logger.Debug(ctx.Context, "User is locked out of TOTP", log.MaskedString(log.LoggerKeyUserID, userID))
+	execResp.Status = providers.ExecFailure
+	execResp.Error = &ErrMaxTOTPAttemptsReached
+	return false, nil
+}
+
+// recordFailedAttempt counts a wrong code for the user and fails the node once the user is locked out
+// of TOTP. Returns false when the node has failed.
+func (t *totpExecutor) recordFailedAttempt(ctx *providers.NodeContext, execResp *providers.ExecutorResponse,
+	userID string, logger *log.Logger) (bool, error) {
+	status, svcErr := t.lockoutService.RecordFactorFailure(ctx.Context, lockout.FactorTOTP, userID,
+		t.getMaxAttempts(ctx))
+	if svcErr != nil {
+		return false, fmt.Errorf("failed to record TOTP attempt: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	if !status.Locked {
+		return true, nil
+	}
+	logger.Debug(ctx.Context, "Maximum TOTP attempts reached", log.MaskedString(log.LoggerKeyUserID, userID))
+	execResp.Status = providers.ExecFailure
+	execResp.Error = &ErrMaxTOTPAttemptsReached
+	return false, nil
+}
+
+// getMaxAttempts returns the maximum number of wrong codes from NodeProperties, falling back to
+// defaultMaxTOTPAttempts if not set or invalid.
+func (t *totpExecutor) getMaxAttempts(ctx *providers.NodeContext) int {
+	switch v := ctx.NodeProperties[propertyKeyMaxOTPAttempts].(type) {
+	case string:
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	case int:
+		if v > 0 {
+			return v
+		}
+	case float64:
+		if n := int(v); n > 0 {
+			return n
+		}
+	}
+	return defaultMaxTOTPAttempts
+}
@@ -0,0 +1,332 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/authn/lockout"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/lockoutmock"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+const (
+	testTOTPUserID       = "test-user-456"
+	testTOTPFlowID       = "totp-flow-123"
+	testTOTPSessionToken = "totp-session-token"
+)
+
+type TOTPExecutorTestSuite struct {
+	suite.Suite
+	mockAuthnProvider *managermock.AuthnProviderManagerMock
+	mockFlowFactory   *coremock.FlowFactoryInterfaceMock
+	mockLockout       *lockoutmock.LockoutServiceInterfaceMock
+	executor          *totpExecutor
+}
+
+func TestTOTPExecutorSuite(t *testing.T) {
+	suite.Run(t, new(TOTPExecutorTestSuite))
+}
+
+func (suite *TOTPExecutorTestSuite) SetupTest() {
+	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerMock(suite.T())
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockLockout = lockoutmock.NewLockoutServiceInterfaceMock(suite.T())
+
+	mockExec := createMockTOTPExecutor(suite.T())
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameTOTP, providers.ExecutorTypeAuthentication,
+		mock.Anything, mock.Anything, mock.Anything).Return(mockExec)
+
+	suite.executor = newTOTPExecutor(suite.mockFlowFactory, suite.mockAuthnProvider, suite.mockLockout)
+}
+
+func (suite *TOTPExecutorTestSuite) expectNotLocked() {
+	suite.mockLockout.On("CheckFactorLocked", mock.Anything, lockout.FactorTOTP, testTOTPUserID).
+		Return(&lockout.LockStatus{}, nil).Once()
+}
+
+func createMockTOTPExecutor(t *testing.T) providers.Executor {
+	mockExec := coremock.NewExecutorInterfaceMock(t)
+	mockExec.On("GetName").Return(ExecutorNameTOTP).Maybe()
+	mockExec.On("GetType").Return(providers.ExecutorTypeAuthentication).Maybe()
+	mockExec.On("GetRequiredInputs", mock.Anything).Return([]providers.Input{
+		{Identifier: userInputTOTPCode, Type: providers.InputTypeOTP, Required: true},
+		{Identifier: userInputRecoveryCode, Type: providers.InputTypeText, Required: false},
+	}).Maybe()
+	mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(true).Maybe()
+	mockExec.On("GetUserIDFromContext", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(
+			ctx *providers.NodeContext,
+			_ *providers.ExecutorResponse,
+			_ providers.AuthnProviderManager,
+		) string {
+			return ctx.RuntimeData[userAttributeUserID]
+		}).Maybe()
+	return mockExec
+}
+
+func createTOTPNodeContext(mode string) *providers.NodeContext {
+	return &providers.NodeContext{
+		ExecutionID:  testTOTPFlowID,
+		FlowType:     providers.FlowTypeAuthentication,
+		ExecutorMode: mode,
+		UserInputs:   make(map[string]string),
+		RuntimeData:  map[string]string{userAttributeUserID: testTOTPUserID},
+	}
+}
+
+func (suite *TOTPExecutorTestSuite) TestNewTOTPExecutor() {
+	assert.NotNil(suite.T(), suite.executor)
+	assert.Equal(suite.T(), ExecutorNameTOTP, suite.executor.GetName())
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecute_InvalidMode() {
+	ctx := createTOTPNodeContext("invalid")
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+	assert.NotNil(suite.T(), resp)
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteGenerate_Success() {
+	ctx := createTOTPNodeContext(ExecutorModeGenerate)
+	suite.mockAuthnProvider.On("InitiateEnrollment", mock.Anything, totp.CredentialType,
+		&totp.TOTPEnrollmentStartRequest{UserID: testTOTPUserID}, mock.Anything).
+		Return(&totp.TOTPEnrollmentStartData{
+			Secret:          "JBSWY3DPEHPK3PXP",
+			ProvisioningURI: "otpauth://totp/ThunderID:alice?secret=JBSWY3DPEHPK3PXP",
+			RecoveryCodes:   []string{"abcde-fghjk", "mnpqr-stuvw"},
+			SessionToken:    testTOTPSessionToken,
+		}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), testTOTPSessionToken, resp.RuntimeData[runtimeTOTPSessionToken])
+	assert.Equal(suite.T(), "JBSWY3DPEHPK3PXP", resp.AdditionalData[dataKeyTOTPSecret])
+	assert.Contains(suite.T(), resp.AdditionalData[dataKeyTOTPProvisioningURI], "otpauth://totp/")
+	assert.JSONEq(suite.T(), `["abcde-fghjk","mnpqr-stuvw"]`, resp.AdditionalData[dataKeyTOTPRecoveryCodes])
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteGenerate_ClientError() {
+	ctx := createTOTPNodeContext(ExecutorModeGenerate)
+	suite.mockAuthnProvider.On("InitiateEnrollment", mock.Anything, totp.CredentialType, mock.Anything,
+		mock.Anything).Return(nil, &totp.ErrorUserNotFound)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrTOTPEnrollmentFailed.Code, resp.Error.Code)
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteGenerate_ServerError() {
+	ctx := createTOTPNodeContext(ExecutorModeGenerate)
+	suite.mockAuthnProvider.On("InitiateEnrollment", mock.Anything, totp.CredentialType, mock.Anything,
+		mock.Anything).Return(nil, &tidcommon.InternalServerError)
+
+	_, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteVerify_MissingInputs() {
+	ctx := createTOTPNodeContext(ExecutorModeVerify)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Len(suite.T(), resp.Inputs, 2)
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteVerify_Success() {
+	ctx := createTOTPNodeContext(ExecutorModeVerify)
+	ctx.UserInputs[userInputTOTPCode] = "123456"
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything,
+		map[string]interface{}{totp.CredentialType: &totp.TOTPAuthenticationRequest{
+			UserID: testTOTPUserID, Code: "123456",
+		}}, mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims{userAttributeUserID: testTOTPUserID}, nil)
+	suite.expectNotLocked()
+	suite.mockLockout.On("RecordFactorSuccess", mock.Anything, lockout.FactorTOTP, testTOTPUserID).
+		Return(nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), testTOTPUserID, resp.RuntimeData[userAttributeUserID])
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteVerify_RecoveryCode() {
+	ctx := createTOTPNodeContext(ExecutorModeVerify)
+	ctx.UserInputs[userInputRecoveryCode] = "abcde-fghjk"
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything,
+		map[string]interface{}{totp.CredentialType: &totp.TOTPAuthenticationRequest{
+			UserID: testTOTPUserID, RecoveryCode: "abcde-fghjk",
+		}}, mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims{}, nil)
+	suite.expectNotLocked()
+	suite.mockLockout.On("RecordFactorSuccess", mock.Anything, lockout.FactorTOTP, testTOTPUserID).
+		Return(nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteVerify_InvalidCode() {
+	ctx := createTOTPNodeContext(ExecutorModeVerify)
+	ctx.UserInputs[userInputTOTPCode] = "000000"
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, nil, &totp.ErrorInvalidCode)
+	suite.expectNotLocked()
+	suite.mockLockout.On("RecordFactorFailure", mock.Anything, lockout.FactorTOTP, testTOTPUserID,
+		defaultMaxTOTPAttempts).Return(&lockout.LockStatus{}, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), ErrInvalidTOTPCode.Code, resp.Error.Code)
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteVerify_LockedOutSkipsVerification() {
+	ctx := createTOTPNodeContext(ExecutorModeVerify)
+	ctx.UserInputs[userInputTOTPCode] = "123456"
+	suite.mockLockout.On("CheckFactorLocked", mock.Anything, lockout.FactorTOTP, testTOTPUserID).
+		Return(&lockout.LockStatus{Locked: true, LockedUntil: time.Now().Add(time.Minute)}, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrMaxTOTPAttemptsReached.Code, resp.Error.Code)
+	suite.mockAuthnProvider.AssertNotCalled(suite.T(), "AuthenticateUser", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteVerify_ThresholdReachedFailsNode() {
+	ctx := createTOTPNodeContext(ExecutorModeVerify)
+	ctx.NodeProperties = map[string]interface{}{propertyKeyMaxOTPAttempts: float64(3)}
+	ctx.UserInputs[userInputTOTPCode] = "000000"
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, nil, &totp.ErrorInvalidCode)
+	suite.expectNotLocked()
+	suite.mockLockout.On("RecordFactorFailure", mock.Anything, lockout.FactorTOTP, testTOTPUserID, 3).
+		Return(&lockout.LockStatus{Locked: true, LockedUntil: time.Now().Add(time.Minute)}, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrMaxTOTPAttemptsReached.Code, resp.Error.Code)
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteVerify_LockoutCheckError() {
+	ctx := createTOTPNodeContext(ExecutorModeVerify)
+	ctx.UserInputs[userInputTOTPCode] = "123456"
+	suite.mockLockout.On("CheckFactorLocked", mock.Anything, lockout.FactorTOTP, testTOTPUserID).
+		Return(nil, &tidcommon.InternalServerError).Once()
+
+	_, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+}
+
+// TestExecuteVerify_AttemptsCountedAcrossExecutions checks that wrong codes are still counted when each
+// attempt starts a new flow execution, and that a successful password step does not clear them.
+func (suite *TOTPExecutorTestSuite) TestExecuteVerify_AttemptsCountedAcrossExecutions() {
+	lockoutSvc := lockout.Initialize(inmemory.Initialize("test-deployment"), nil, config.AccountLockoutConfig{
+		Enabled: true, FailureWindowSeconds: 600, LockoutDurationSeconds: 60,
+	})
+	exec := newTOTPExecutor(suite.mockFlowFactory, suite.mockAuthnProvider, lockoutSvc)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, nil, &totp.ErrorInvalidCode).Times(3)
+
+	for range 2 {
+		ctx := createTOTPNodeContext(ExecutorModeVerify)
+		ctx.NodeProperties = map[string]interface{}{propertyKeyMaxOTPAttempts: float64(3)}
+		ctx.UserInputs[userInputTOTPCode] = "000000"
+		resp, err := exec.Execute(ctx)
+		suite.Require().NoError(err)
+		suite.Require().Equal(providers.ExecUserInputRequired, resp.Status)
+		suite.Require().Nil(lockoutSvc.RecordSuccess(context.Background(), testTOTPUserID))
+	}
+
+	ctx := createTOTPNodeContext(ExecutorModeVerify)
+	ctx.NodeProperties = map[string]interface{}{propertyKeyMaxOTPAttempts: float64(3)}
+	ctx.UserInputs[userInputTOTPCode] = "000000"
+	resp, err := exec.Execute(ctx)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrMaxTOTPAttemptsReached.Code, resp.Error.Code)
+
+	ctx = createTOTPNodeContext(ExecutorModeVerify)
+	ctx.UserInputs[userInputTOTPCode] = "123456"
+	resp, err = exec.Execute(ctx)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	suite.mockAuthnProvider.AssertNumberOfCalls(suite.T(), "AuthenticateUser", 3)
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteVerify_ConfirmsPendingEnrollment() {
+	ctx := createTOTPNodeContext(ExecutorModeVerify)
+	ctx.RuntimeData[runtimeTOTPSessionToken] = testTOTPSessionToken
+	ctx.UserInputs[userInputTOTPCode] = "123456"
+	suite.mockAuthnProvider.On("Enroll", mock.Anything, mock.Anything,
+		map[string]interface{}{totp.CredentialType: &totp.TOTPEnrollmentFinishRequest{
+			SessionToken: testTOTPSessionToken, Code: "123456",
+		}}, mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims{}, nil)
+	suite.expectNotLocked()
+	suite.mockLockout.On("RecordFactorSuccess", mock.Anything, lockout.FactorTOTP, testTOTPUserID).
+		Return(nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "", resp.RuntimeData[runtimeTOTPSessionToken])
+}
+
+func (suite *TOTPExecutorTestSuite) TestExecuteVerify_PendingEnrollmentInvalidCode() {
+	ctx := createTOTPNodeContext(ExecutorModeVerify)
+	ctx.RuntimeData[runtimeTOTPSessionToken] = testTOTPSessionToken
+	ctx.UserInputs[userInputTOTPCode] = "000000"
+	suite.mockAuthnProvider.On("Enroll", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, nil, &totp.ErrorInvalidCode)
+	suite.expectNotLocked()
+	suite.mockLockout.On("RecordFactorFailure", mock.Anything, lockout.FactorTOTP, testTOTPUserID,
+		defaultMaxTOTPAttempts).Return(&lockout.LockStatus{}, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), ErrInvalidTOTPCode.Code, resp.Error.Code)
+	assert.Len(suite.T(), resp.Inputs, 1)
+	assert.Equal(suite.T(), userInputTOTPCode, resp.Inputs[0].Identifier)
+}
@@ -0,0 +1,520 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package expression
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// node is an evaluable element of the syntax tree. Nodes are immutable once parsed, so a compiled
+// program can be evaluated concurrently.
+type node interface {
+	eval(vars map[string]interface{}) (interface{}, error)
+}
+
+// literalNode is a constant value.
+type literalNode struct {
+	value interface{}
+}
+
+func (n *literalNode) eval(map[string]interface{}) (interface{}, error) {
+	return n.value, nil
+}
+
+// identNode is a reference to a top-level variable.
+type identNode struct {
+	name string
+}
+
+func (n *identNode) eval(vars map[string]interface{}) (interface{}, error) {
+	v, ok := vars[n.name]
+	if !ok {
+		return nil, fmt.Errorf("undeclared reference to %q", n.name)
+	}
+	return normalize(v), nil
+}
+
+// selectNode reads a field of a map value (a.b).
+type selectNode struct {
+	operand node
+	field   string
+}
+
+func (n *selectNode) eval(vars map[string]interface{}) (interface{}, error) {
+	container, err := n.operand.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := container.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("cannot select field %q from %s", n.field, typeName(container))
+	}
+	v, ok := m[n.field]
+	if !ok {
+		return nil, fmt.Errorf("no such key %q", n.field)
+	}
+	return normalize(v), nil
+}
+
+// indexNode reads a map entry or list element (a[b]).
+type indexNode struct {
+	operand node
+	index   node
+}
+
+func (n *indexNode) eval(vars map[string]interface{}) (interface{}, error) {
+	container, err := n.operand.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	index, err := n.index.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	switch c := container.(type) {
+	case map[string]interface{}:
+		key, ok := index.(string)
+		if !ok {
+			return nil, fmt.Errorf("map index must be a string, got %s", typeName(index))
+		}
+		v, ok := c[key]
+		if !ok {
+			return nil, fmt.Errorf("no such key %q", key)
+		}
+		return normalize(v), nil
+	case []interface{}:
+		i, ok := index.(int64)
+		if !ok {
+			return nil, fmt.Errorf("list index must be an int, got %s", typeName(index))
+		}
+		if i < 0 || i >= int64(len(c)) {
+			return nil, fmt.Errorf("list index %d out of range", i)
+		}
+		return normalize(c[i]), nil
+	default:
+		return nil, fmt.Errorf("cannot index %s", typeName(container))
+	}
+}
+
+// hasNode tests whether a map contains a key without reading the entry.
+type hasNode struct {
+	operand node
+	key     node
+}
+
+func (n *hasNode) eval(vars map[string]interface{}) (interface{}, error) {
+	container, err := n.operand.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	key, err := n.key.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := container.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("has() requires a map operand, got %s", typeName(container))
+	}
+	k, ok := key.(string)
+	if !ok {
+		return nil, fmt.Errorf("has() key must be a string, got %s", typeName(key))
+	}
+	_, present := m[k]
+	return present, nil
+}
+
+// listNode is a list literal.
+type listNode struct {
+	elems []node
+}
+
+func (n *listNode) eval(vars map[string]interface{}) (interface{}, error) {
+	list := make([]interface{}, len(n.elems))
+	for i, elem := range n.elems {
+		v, err := elem.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = v
+	}
+	return list, nil
+}
+
+// callNode invokes a built-in function.
+type callNode struct {
+	name string
+	fn   func(args []interface{}) (interface{}, error)
+	args []node
+}
+
+func (n *callNode) eval(vars map[string]interface{}) (interface{}, error) {
+	args := make([]interface{}, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	v, err := n.fn(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s(): %w", n.name, err)
+	}
+	return v, nil
+}
+
+// conditionalNode is the ternary operator (cond ? then : otherwise).
+type conditionalNode struct {
+	cond      node
+	then      node
+	otherwise node
+}
+
+func (n *conditionalNode) eval(vars map[string]interface{}) (interface{}, error) {
+	cond, err := n.cond.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	b, ok := cond.(bool)
+	if !ok {
+		return nil, fmt.Errorf("condition must be a bool, got %s", typeName(cond))
+	}
+	if b {
+		return n.then.eval(vars)
+	}
+	return n.otherwise.eval(vars)
+}
+
+// logicalNode is && or ||. As in CEL, an error on one side is absorbed when the other side alone
+// decides the result, so "has(a.b) && a.b == 1" and "a.b == 1 || true" both evaluate cleanly.
+type logicalNode struct {
+	op    string
+	left  node
+	right node
+}
+
+func (n *logicalNode) eval(vars map[string]interface{}) (interface{}, error) {
+	// The value that short-circuits the operation: true for ||, false for &&.
+	decisive := n.op == "||"
+
+	left, leftErr := evalBool(n.left, vars)
+	if leftErr == nil && left == decisive {
+		return decisive, nil
+	}
+	right, rightErr := evalBool(n.right, vars)
+	if rightErr == nil && right == decisive {
+		return decisive, nil
+	}
+	if leftErr != nil {
+		return nil, leftErr
+	}
+	if rightErr != nil {
+		return nil, rightErr
+	}
+	return !decisive, nil
+}
+
+// evalBool evaluates n and requires a bool result.
+func evalBool(n node, vars map[string]interface{}) (bool, error) {
+	v, err := n.eval(vars)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected a bool, got %s", typeName(v))
+	}
+	return b, nil
+}
+
+// unaryNode is logical (!) or arithmetic (-) negation.
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+func (n *unaryNode) eval(vars map[string]interface{}) (interface{}, error) {
+	v, err := n.operand.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "!" {
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator ! requires a bool, got %s", typeName(v))
+		}
+		return !b, nil
+	}
+	switch x := v.(type) {
+	case int64:
+		return -x, nil
+	case float64:
+		return -x, nil
+	default:
+		return nil, fmt.Errorf("operator - requires a number, got %s", typeName(v))
+	}
+}
+
+// binaryNode is an arithmetic, comparison or membership operation.
+type binaryNode struct {
+	op    string
+	left  node
+	right node
+}
+
+func (n *binaryNode) eval(vars map[string]interface{}) (interface{}, error) {
+	left, err := n.left.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "<", "<=", ">", ">=":
+		return compare(n.op, left, right)
+	case "in":
+		return contains(right, left)
+	case "+":
+		return add(left, right)
+	default:
+		return arithmetic(n.op, left, right)
+	}
+}
+
+// equal reports whether two values are equal. Ints and doubles compare numerically; values of
+// otherwise different types are never equal.
+func equal(a, b interface{}) bool {
+	ai, aInt := a.(int64)
+	bi, bInt := b.(int64)
+	if aInt && bInt {
+		return ai == bi
+	}
+	af, aNum := toFloat(a)
+	bf, bNum := toFloat(b)
+	if aNum && bNum {
+		return af == bf
+	}
+	if aNum || bNum {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// compare evaluates an ordering operator over two numbers or two strings.
+func compare(op string, a, b interface{}) (bool, error) {
+	var c int
+	var err error
+	if as, ok := a.(string); ok {
+		bs, ok := b.(string)
+		if !ok {
+			return false, fmt.Errorf("cannot compare string with %s", typeName(b))
+		}
+		c = cmp.Compare(as, bs)
+	} else {
+		c, err = compareNumbers(a, b)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	switch op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	default:
+		return c >= 0, nil
+	}
+}
+
+// compareNumbers orders two numbers, returning -1, 0 or 1.
+func compareNumbers(a, b interface{}) (int, error) {
+	ai, aInt := a.(int64)
+	bi, bInt := b.(int64)
+	if aInt && bInt {
+		return cmp.Compare(ai, bi), nil
+	}
+	af, aNum := toFloat(a)
+	bf, bNum := toFloat(b)
+	if !aNum || !bNum {
+		return 0, fmt.Errorf("cannot compare %s with %s", typeName(a), typeName(b))
+	}
+	return cmp.Compare(af, bf), nil
+}
+
+// contains implements the in operator: list membership or map key presence.
+func contains(container, elem interface{}) (bool, error) {
+	switch c := container.(type) {
+	case []interface{}:
+		for _, item := range c {
+			if equal(normalize(item), elem) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case map[string]interface{}:
+		key, ok := elem.(string)
+		if !ok {
+			return false, fmt.Errorf("map membership requires a string key, got %s", typeName(elem))
+		}
+		_, present := c[key]
+		return present, nil
+	default:
+		return false, fmt.Errorf("operator in requires a list or map, got %s", typeName(container))
+	}
+}
+
+// add implements + over numbers, strings and lists.
+func add(a, b interface{}) (interface{}, error) {
+	switch x := a.(type) {
+	case string:
+		y, ok := b.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot add %s to string", typeName(b))
+		}
+		if len(x)+len(y) > MaxStringLength {
+			return nil, errStringTooLong
+		}
+		return x + y, nil
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot add %s to list", typeName(b))
+		}
+		if len(x)+len(y) > MaxListLength {
+			return nil, errListTooLong
+		}
+		out := make([]interface{}, 0, len(x)+len(y))
+		return append(append(out, x...), y...), nil
+	default:
+		return arithmetic("+", a, b)
+	}
+}
+
+var (
+	// errDivisionByZero is returned for integer division or modulus by zero.
+	errDivisionByZero = errors.New("division by zero")
+	// errStringTooLong is returned when an expression would build a string over MaxStringLength.
+	errStringTooLong = fmt.Errorf("string exceeds the maximum length of %d bytes", MaxStringLength)
+	// errListTooLong is returned when an expression would build a list over MaxListLength.
+	errListTooLong = fmt.Errorf("list exceeds the maximum length of %d elements", MaxListLength)
+)
+
+// arithmetic implements + - * / % over numbers. Two ints yield an int; otherwise a double.
+func arithmetic(op string, a, b interface{}) (interface{}, error) {
+	ai, aInt := a.(int64)
+	bi, bInt := b.(int64)
+	if aInt && bInt {
+		switch op {
+		case "+":
+			return ai + bi, nil
+		case "-":
+			return ai - bi, nil
+		case "*":
+			return ai * bi, nil
+		case "/":
+			if bi == 0 {
+				return nil, errDivisionByZero
+			}
+			return ai / bi, nil
+		default:
+			if bi == 0 {
+				return nil, errDivisionByZero
+			}
+			return ai % bi, nil
+		}
+	}
+
+	af, aok := toFloat(a)
+	bf, bok := toFloat(b)
+	if !aok || !bok {
+		return nil, fmt.Errorf("operator %s requires numbers, got %s and %s", op, typeName(a), typeName(b))
+	}
+	switch op {
+	case "+":
+		return af + bf, nil
+	case "-":
+		return af - bf, nil
+	case "*":
+		return af * bf, nil
+	case "/":
+		return af / bf, nil
+	default:
+		return math.Mod(af, bf), nil
+	}
+}
+
+// toFloat converts a numeric value to float64.
+func toFloat(v interface{}) (float64, bool) {
+	switch x := v.(type) {
+	case int64:
+		return float64(x), true
+	case float64:
+		return x, true
+	default:
+		return 0, false
+	}
+}
+
+// normalize converts the Go values callers commonly bind (string maps, string slices, plain ints)
+// into the value model used by the evaluator.
+func normalize(v interface{}) interface{} {
+	switch x := v.(type) {
+	case int:
+		return int64(x)
+	case int32:
+		return int64(x)
+	case float32:
+		return float64(x)
+	case map[string]string:
+		m := make(map[string]interface{}, len(x))
+		for k, s := range x {
+			m[k] = s
+		}
+		return m
+	case []string:
+		list := make([]interface{}, len(x))
+		for i, s := range x {
+			list[i] = s
+		}
+		return list
+	default:
+		return v
+	}
+}
+
+// typeName returns the expression-level type name of v for error messages.
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case int64:
+		return "int"
+	case float64:
+		return "double"
+	case string:
+		return "string"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "map"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package expression implements a small, sandboxed expression language used to make routing
+// decisions in flows. The syntax follows a subset of CEL (the Common Expression Language):
+//
+//	request.ip.inCIDR("10.0.0.0/8") && claims.country in ["LK", "IN"]
+//	has(claims.email_verified) && claims.email_verified == true
+//	double(runtime.captchaScore) < 0.5 ? true : inputs.rememberMe == "true"
+//
+// Expressions cannot loop, assign, perform I/O or call anything outside a fixed set of built-in
+// functions, so evaluation time is bounded by the size of the expression and its inputs. Source
+// length and nesting depth are capped at compile time, and the size of every string and list an
+// expression builds is capped at evaluation time.
+package expression
+
+import (
+	"fmt"
+)
+
+const (
+	// MaxSourceLength is the maximum length, in bytes, of an expression.
+	MaxSourceLength = 4096
+	// MaxNestingDepth is the maximum nesting depth of an expression.
+	MaxNestingDepth = 32
+	// MaxStringLength is the maximum length, in bytes, of a string an expression can build.
+	MaxStringLength = 64 * 1024
+	// MaxListLength is the maximum number of elements in a list an expression can build.
+	MaxListLength = 4096
+)
+
+// Program is a compiled expression. It is immutable and safe for concurrent evaluation.
+type Program struct {
+	source string
+	root   node
+}
+
+// Compile parses source into a Program, reporting syntax errors, unknown functions and arity
+// mismatches. Variable references are resolved at evaluation time.
+func Compile(source string) (*Program, error) {
+	if len(source) > MaxSourceLength {
+		return nil, fmt.Errorf("expression exceeds the maximum length of %d characters", MaxSourceLength)
+	}
+	root, err := parse(source)
+	if err != nil {
+		return nil, err
+	}
+	return &Program{source: source, root: root}, nil
+}
+
+// Source returns the expression text the program was compiled from.
+func (p *Program) Source() string {
+	return p.source
+}
+
+// Eval evaluates the program against vars. Values in vars may be bool, int, int64, float64,
+// string, nil, []string, []interface{}, map[string]string or map[string]interface{}.
+func (p *Program) Eval(vars map[string]interface{}) (interface{}, error) {
+	return p.root.eval(vars)
+}
+
+// EvalBool evaluates the program and requires a bool result.
+func (p *Program) EvalBool(vars map[string]interface{}) (bool, error) {
+	v, err := p.Eval(vars)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must evaluate to a bool, got %s", typeName(v))
+	}
+	return b, nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package expression
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testVars() map[string]interface{} {
+	return map[string]interface{}{
+		"inputs":  map[string]string{"username": "alice", "rememberMe": "true"},
+		"runtime": map[string]string{"captchaScore": "0.3", "attemptCount": "2"},
+		"claims": map[string]interface{}{
+			"email":          "alice@example.com",
+			"email_verified": true,
+			"country":        "LK",
+			"groups":         []string{"admins", "staff"},
+			"age":            42,
+		},
+		"request": map[string]interface{}{
+			"ip":        "10.1.2.3",
+			"userAgent": "Mozilla/5.0 (iPhone)",
+			"acrValues": []string{"mfa"},
+		},
+		"app": map[string]interface{}{
+			"id":       "app-1",
+			"metadata": map[string]interface{}{"tier": "gold"},
+		},
+	}
+}
+
+func TestEvalBool(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		{"string equality", `inputs.username == "alice"`, true},
+		{"single quoted string", `inputs.username == 'alice'`, true},
+		{"inequality", `claims.country != "US"`, true},
+		{"bool claim", `claims.email_verified`, true},
+		{"negation", `!claims.email_verified`, false},
+		{"list membership", `claims.country in ["LK", "IN"]`, true},
+		{"bound list membership", `"admins" in claims.groups`, true},
+		{"map key membership", `"email" in claims`, true},
+		{"int comparison", `claims.age >= 18`, true},
+		{"mixed numeric comparison", `claims.age > 41.5`, true},
+		{"mixed numeric equality", `claims.age == 42.0`, true},
+		{"double conversion", `double(runtime.captchaScore) < 0.5`, true},
+		{"int conversion", `int(runtime.attemptCount) + 1 == 3`, true},
+		{"arithmetic precedence", `1 + 2 * 3 == 7`, true},
+		{"parentheses", `(1 + 2) * 3 == 9`, true},
+		{"modulus", `7 % 3 == 1`, true},
+		{"string ordering", `"a" < "b"`, true},
+		{"string concatenation", `"a" + "b" == "ab"`, true},
+		{"receiver call", `inputs.username.startsWith("al")`, true},
+		{"global call", `endsWith(claims.email, "@example.com")`, true},
+		{"contains", `request.userAgent.contains("iPhone")`, true},
+		{"matches", `claims.email.matches("^[a-z]+@example\\.com$")`, true},
+		{"lower ascii", `"ABC".lowerAscii() == "abc"`, true},
+		{"size of list", `size(claims.groups) == 2`, true},
+		{"size of string", `size("héllo") == 5`, true},
+		{"split", `"a,b".split(",") == ["a", "b"]`, true},
+		{"substring", `"0771234567".substring(1) == "771234567"`, true},
+		{"substring of multibyte string", `substring("héllo", 2) == "llo"`, true},
+		{"cidr match", `request.ip.inCIDR("10.0.0.0/8")`, true},
+		{"cidr mismatch", `inCIDR(request.ip, "192.168.0.0/16")`, false},
+		{"cidr with malformed ip", `inCIDR("not-an-ip", "10.0.0.0/8")`, false},
+		{"has present", `has(claims.email)`, true},
+		{"has absent", `has(claims.phone)`, false},
+		{"has with index", `has(app.metadata["tier"])`, true},
+		{"index access", `app.metadata["tier"] == "gold"`, true},
+		{"list index", `claims.groups[1] == "staff"`, true},
+		{"ternary", `claims.age > 60 ? false : true`, true},
+		{"null equality", `null == null`, true},
+		{"different types are unequal", `claims.age == "42"`, false},
+		{"and absorbs error", `has(claims.phone) && claims.phone == "1"`, false},
+		{"or absorbs error", `claims.phone == "1" || true`, true},
+		{"acr values", `"mfa" in request.acrValues`, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			program, err := Compile(tc.expr)
+			require.NoError(t, err)
+			got, err := program.EvalBool(testVars())
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{"missing key", `claims.phone == "1"`, `no such key "phone"`},
+		{"undeclared variable", `unknown.field`, `undeclared reference to "unknown"`},
+		{"non bool result", `claims.age`, "must evaluate to a bool"},
+		{"select on scalar", `claims.age.value == 1`, "cannot select field"},
+		{"ordering across types", `claims.age < "a"`, "cannot compare"},
+		{"division by zero", `1 / 0 == 1`, "division by zero"},
+		{"bad conversion", `int("abc") == 1`, `int(): cannot convert "abc" to int`},
+		{"invalid cidr", `inCIDR(request.ip, "bad")`, `invalid CIDR "bad"`},
+		{"list index out of range", `claims.groups[5] == "x"`, "out of range"},
+		{"substring index out of range", `"abc".substring(4) == ""`, "substring index 4 is out of range"},
+		{"logical operand type", `1 && true`, "expected a bool"},
+		{"and with both sides failing", `claims.phone == "1" && claims.fax == "2"`, `no such key "phone"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			program, err := Compile(tc.expr)
+			require.NoError(t, err)
+			_, err = program.EvalBool(testVars())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{"empty", ``, "unexpected end of expression"},
+		{"dangling operator", `a ==`, "unexpected end of expression"},
+		{"unbalanced parenthesis", `(a == b`, `expected ")"`},
+		{"trailing token", `a b`, `unexpected "b"`},
+		{"unknown function", `eval("x")`, `unknown function "eval"`},
+		{"wrong arity", `size(a, b)`, "takes 1 argument(s), got 2"},
+		{"has without selection", `has(a)`, "requires a field selection"},
+		{"unterminated string", `a == "x`, "unterminated string"},
+		{"invalid escape", `a == "\q"`, "invalid escape sequence"},
+		{"invalid character", `a = b`, `unexpected character '='`},
+		{"missing ternary branch", `a ? b`, `expected ":"`},
+		{"too long", strings.Repeat("a", MaxSourceLength+1), "maximum length"},
+		{"too deep", strings.Repeat("(", MaxNestingDepth+1) + "a" + strings.Repeat(")", MaxNestingDepth+1),
+			"maximum nesting depth"},
+		{"deep negation", strings.Repeat("!", MaxNestingDepth+1) + "a", "maximum nesting depth"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Compile(tc.expr)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestProgramSource(t *testing.T) {
+	program, err := Compile(`a == 1`)
+	require.NoError(t, err)
+	assert.Equal(t, `a == 1`, program.Source())
+}
+
+func TestEvalValues(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want interface{}
+	}{
+		{"int arithmetic stays int", `7 / 2`, int64(3)},
+		{"double arithmetic", `7.0 / 2`, 3.5},
+		{"unary minus", `-claims.age`, int64(-42)},
+		{"string conversion", `string(claims.age)`, "42"},
+		{"list concatenation", `[1] + [2]`, []interface{}{int64(1), int64(2)}},
+		{"exponent literal", `1e3`, 1000.0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			program, err := Compile(tc.expr)
+			require.NoError(t, err)
+			got, err := program.Eval(testVars())
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestEvalSizeLimits(t *testing.T) {
+	list := make([]interface{}, MaxListLength)
+	for i := range list {
+		list[i] = int64(i)
+	}
+	vars := map[string]interface{}{
+		"s": strings.Repeat("x", MaxStringLength/2+1),
+		"l": list,
+	}
+
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{"string concatenation", `size(s + s) > 0`, "string exceeds the maximum length"},
+		{"list concatenation", `size(l + [1]) > 0`, "list exceeds the maximum length"},
+		{"split", `size((s + "").split("")) > 0`, "list exceeds the maximum length"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			program, err := Compile(tc.expr)
+			require.NoError(t, err)
+			_, err = program.EvalBool(vars)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package expression
+
+import (
+	"fmt"
+	"math"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// function is a built-in function. Every function can be called globally, f(x, y), or with its
+// first argument as the receiver, x.f(y).
+type function struct {
+	arity int
+	call  func(args []interface{}) (interface{}, error)
+}
+
+// functions is the complete set of functions available to expressions. There is deliberately no
+// way to register more at runtime: the set is side-effect free and every function runs in time
+// bounded by the size of its arguments.
+var functions = map[string]function{
+	"size":       {arity: 1, call: fnSize},
+	"startsWith": {arity: 2, call: stringPredicate(strings.HasPrefix)},
+	"endsWith":   {arity: 2, call: stringPredicate(strings.HasSuffix)},
+	"contains":   {arity: 2, call: stringPredicate(strings.Contains)},
+	"matches":    {arity: 2, call: fnMatches},
+	"lowerAscii": {arity: 1, call: stringTransform(strings.ToLower)},
+	"upperAscii": {arity: 1, call: stringTransform(strings.ToUpper)},
+	"trim":       {arity: 1, call: stringTransform(strings.TrimSpace)},
+	"split":      {arity: 2, call: fnSplit},
+	"substring":  {arity: 2, call: fnSubstring},
+	"int":        {arity: 1, call: fnInt},
+	"double":     {arity: 1, call: fnDouble},
+	"string":     {arity: 1, call: fnString},
+	"inCIDR":     {arity: 2, call: fnInCIDR},
+}
+
+// fnSize returns the length of a string (in characters), list or map.
+func fnSize(args []interface{}) (interface{}, error) {
+	switch x := args[0].(type) {
+	case string:
+		return int64(utf8.RuneCountInString(x)), nil
+	case []interface{}:
+		return int64(len(x)), nil
+	case map[string]interface{}:
+		return int64(len(x)), nil
+	default:
+		return nil, fmt.Errorf("unsupported argument type %s", typeName(args[0]))
+	}
+}
+
+// stringPredicate adapts a two-string predicate.
+func stringPredicate(fn func(s, t string) bool) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		s, t, err := twoStrings(args)
+		if err != nil {
+			return nil, err
+		}
+		return fn(s, t), nil
+	}
+}
+
+// stringTransform adapts a one-string transformation.
+func stringTransform(fn func(s string) string) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %s", typeName(args[0]))
+		}
+		return fn(s), nil
+	}
+}
+
+// fnMatches reports whether a string matches an RE2 regular expression. RE2 guarantees matching
+// in time linear in the input, so a pattern cannot be used to stall evaluation.
+func fnMatches(args []interface{}) (interface{}, error) {
+	s, pattern, err := twoStrings(args)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %w", err)
+	}
+	return re.MatchString(s), nil
+}
+
+// fnSplit splits a string around every occurrence of a separator.
+func fnSplit(args []interface{}) (interface{}, error) {
+	s, sep, err := twoStrings(args)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(s, sep)
+	if len(parts) > MaxListLength {
+		return nil, errListTooLong
+	}
+	return normalize(parts), nil
+}
+
+// fnSubstring returns the part of a string from a character index to its end, for example
+// "0771234567".substring(1). The index must lie between 0 and the length of the string.
+func fnSubstring(args []interface{}) (interface{}, error) {
+	s, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("expected a string, got %s", typeName(args[0]))
+	}
+	start, ok := args[1].(int64)
+	if !ok {
+		return nil, fmt.Errorf("expected an int, got %s", typeName(args[1]))
+	}
+	runes := []rune(s)
+	if start < 0 || start > int64(len(runes)) {
+		return nil, fmt.Errorf("substring index %d is out of range", start)
+	}
+	return string(runes[start:]), nil
+}
+
+// fnInt converts a number or numeric string to an int.
+func fnInt(args []interface{}) (interface{}, error) {
+	switch x := args[0].(type) {
+	case int64:
+		return x, nil
+	case float64:
+		if math.IsNaN(x) || x < math.MinInt64 || x >= math.MaxInt64 {
+			return nil, fmt.Errorf("double %v is out of int range", x)
+		}
+		return int64(x), nil
+	case string:
+		v, err := strconv.ParseInt(strings.TrimSpace(x), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %q to int", x)
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %s to int", typeName(args[0]))
+	}
+}
+
+// fnDouble converts a number or numeric string to a double.
+func fnDouble(args []interface{}) (interface{}, error) {
+	switch x := args[0].(type) {
+	case int64:
+		return float64(x), nil
+	case float64:
+		return x, nil
+	case string:
+		v, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %q to double", x)
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %s to double", typeName(args[0]))
+	}
+}
+
+// fnString converts a scalar to its string form.
+func fnString(args []interface{}) (interface{}, error) {
+	switch x := args[0].(type) {
+	case string:
+		return x, nil
+	case int64:
+		return strconv.FormatInt(x, 10), nil
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	default:
+		return nil, fmt.Errorf("cannot convert %s to string", typeName(args[0]))
+	}
+}
+
+// fnInCIDR reports whether an IP address falls within a CIDR range, for example
+// inCIDR(request.ip, "10.0.0.0/8"). An empty or malformed address never matches.
+func fnInCIDR(args []interface{}) (interface{}, error) {
+	ip, cidr, err := twoStrings(args)
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %q", cidr)
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false, nil
+	}
+	return prefix.Contains(addr.Unmap()), nil
+}
+
+// twoStrings extracts two string arguments.
+func twoStrings(args []interface{}) (string, string, error) {
+	s, ok := args[0].(string)
+	if !ok {
+		return "", "", fmt.Errorf("expected a string, got %s", typeName(args[0]))
+	}
+	t, ok := args[1].(string)
+	if !ok {
+		return "", "", fmt.Errorf("expected a string, got %s", typeName(args[1]))
+	}
+	return s, t, nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tokenKind identifies the lexical class of a token.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenInt
+	tokenFloat
+	tokenString
+	tokenOperator
+)
+
+// token is a single lexical unit of an expression.
+type token struct {
+	kind  tokenKind
+	text  string
+	value interface{}
+	pos   int
+}
+
+// operators lists the punctuation recognized by the lexer, longest first so that a two-character
+// operator is never split into two single-character ones.
+var operators = []string{
+	"&&", "||", "==", "!=", "<=", ">=",
+	"(", ")", "[", "]", ",", ".", "?", ":", "!", "-", "+", "*", "/", "%", "<", ">",
+}
+
+// tokenize splits source into tokens. Positions are byte offsets into source.
+func tokenize(source string) ([]token, error) {
+	tokens := make([]token, 0, len(source)/2+1)
+	i := 0
+	for i < len(source) {
+		r, width := utf8.DecodeRuneInString(source[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += width
+		case r == '_' || unicode.IsLetter(r):
+			start := i
+			for i < len(source) {
+				r, width = utf8.DecodeRuneInString(source[i:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				i += width
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: source[start:i], pos: start})
+		case r >= '0' && r <= '9':
+			tok, next, err := scanNumber(source, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			i = next
+		case r == '"' || r == '\'':
+			tok, next, err := scanString(source, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			i = next
+		default:
+			op := matchOperator(source[i:])
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: op, pos: i})
+			i += len(op)
+		}
+	}
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(source)})
+	return tokens, nil
+}
+
+// matchOperator returns the operator at the start of s, or "" when there is none.
+func matchOperator(s string) string {
+	for _, op := range operators {
+		if strings.HasPrefix(s, op) {
+			return op
+		}
+	}
+	return ""
+}
+
+// scanNumber scans an integer or decimal literal starting at start.
+func scanNumber(source string, start int) (token, int, error) {
+	i := start
+	isFloat := false
+	for i < len(source) && source[i] >= '0' && source[i] <= '9' {
+		i++
+	}
+	if i+1 < len(source) && source[i] == '.' && source[i+1] >= '0' && source[i+1] <= '9' {
+		isFloat = true
+		i++
+		for i < len(source) && source[i] >= '0' && source[i] <= '9' {
+			i++
+		}
+	}
+	if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+		j := i + 1
+		if j < len(source) && (source[j] == '+' || source[j] == '-') {
+			j++
+		}
+		if j < len(source) && source[j] >= '0' && source[j] <= '9' {
+			isFloat = true
+			i = j
+			for i < len(source) && source[i] >= '0' && source[i] <= '9' {
+				i++
+			}
+		}
+	}
+
+	text := source[start:i]
+	if isFloat {
+		v, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return token{}, 0, fmt.Errorf("invalid number %q at position %d", text, start)
+		}
+		return token{kind: tokenFloat, text: text, value: v, pos: start}, i, nil
+	}
+	v, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return token{}, 0, fmt.Errorf("invalid number %q at position %d", text, start)
+	}
+	return token{kind: tokenInt, text: text, value: v, pos: start}, i, nil
+}
+
+// scanString scans a single- or double-quoted string literal starting at start. The usual
+// backslash escapes are supported.
+func scanString(source string, start int) (token, int, error) {
+	quote := source[start]
+	var sb strings.Builder
+	i := start + 1
+	for i < len(source) {
+		c := source[i]
+		switch {
+		case c == quote:
+			return token{kind: tokenString, text: source[start : i+1], value: sb.String(), pos: start}, i + 1, nil
+		case c == '\\':
+			if i+1 >= len(source) {
+				return token{}, 0, fmt.Errorf("unterminated string at position %d", start)
+			}
+			switch source[i+1] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case '\\', '\'', '"':
+				sb.WriteByte(source[i+1])
+			default:
+				return token{}, 0, fmt.Errorf("invalid escape sequence \\%c at position %d", source[i+1], i)
+			}
+			i += 2
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return token{}, 0, fmt.Errorf("unterminated string at position %d", start)
+}
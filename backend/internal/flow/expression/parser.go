@@ -0,0 +1,402 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package expression
+
+import (
+	"fmt"
+)
+
+// parser is a recursive-descent parser over a token stream. Operator precedence, from lowest to
+// highest, is: conditional (?:), ||, &&, relations (== != < <= > >= in), additive (+ -),
+// multiplicative (* / %), unary (! -), then member access, indexing and calls.
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+// parse parses source into an evaluable syntax tree.
+func parse(source string) (node, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.unexpected(tok)
+	}
+	return root, nil
+}
+
+// peek returns the current token without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next consumes and returns the current token.
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// acceptOperator consumes the current token when it is the given operator.
+func (p *parser) acceptOperator(op string) bool {
+	tok := p.peek()
+	if tok.kind == tokenOperator && tok.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expectOperator consumes the given operator or reports a syntax error.
+func (p *parser) expectOperator(op string) error {
+	if !p.acceptOperator(op) {
+		tok := p.peek()
+		if tok.kind == tokenEOF {
+			return fmt.Errorf("expected %q at end of expression", op)
+		}
+		return fmt.Errorf("expected %q at position %d, found %q", op, tok.pos, tok.text)
+	}
+	return nil
+}
+
+// unexpected builds a syntax error for tok.
+func (p *parser) unexpected(tok token) error {
+	if tok.kind == tokenEOF {
+		return fmt.Errorf("unexpected end of expression")
+	}
+	return fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+}
+
+// enter guards against pathologically nested input.
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > MaxNestingDepth {
+		return fmt.Errorf("expression exceeds the maximum nesting depth of %d", MaxNestingDepth)
+	}
+	return nil
+}
+
+// leave undoes enter.
+func (p *parser) leave() {
+	p.depth--
+}
+
+// parseExpression parses a conditional expression.
+func (p *parser) parseExpression() (node, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	cond, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.acceptOperator("?") {
+		return cond, nil
+	}
+	then, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectOperator(":"); err != nil {
+		return nil, err
+	}
+	otherwise, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	return &conditionalNode{cond: cond, then: then, otherwise: otherwise}, nil
+}
+
+// parseOr parses a chain of || operations.
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptOperator("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses a chain of && operations.
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseRelation()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptOperator("&&") {
+		right, err := p.parseRelation()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseRelation parses comparisons and the membership operator.
+func (p *parser) parseRelation() (node, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		var op string
+		switch {
+		case tok.kind == tokenOperator && isRelationalOperator(tok.text):
+			op = tok.text
+		case tok.kind == tokenIdent && tok.text == "in":
+			op = "in"
+		default:
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+// isRelationalOperator reports whether op is a comparison operator.
+func isRelationalOperator(op string) bool {
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseAdditive parses + and - operations.
+func (p *parser) parseAdditive() (node, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind != tokenOperator || (tok.text != "+" && tok.text != "-") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: tok.text, left: left, right: right}
+	}
+}
+
+// parseMultiplicative parses *, / and % operations.
+func (p *parser) parseMultiplicative() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind != tokenOperator || (tok.text != "*" && tok.text != "/" && tok.text != "%") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: tok.text, left: left, right: right}
+	}
+}
+
+// parseUnary parses logical negation and arithmetic negation.
+func (p *parser) parseUnary() (node, error) {
+	tok := p.peek()
+	if tok.kind == tokenOperator && (tok.text == "!" || tok.text == "-") {
+		if err := p.enter(); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: tok.text, operand: operand}, nil
+	}
+	return p.parseMember()
+}
+
+// parseMember parses a primary expression followed by any number of field selections, index
+// operations and receiver-style function calls.
+func (p *parser) parseMember() (node, error) {
+	operand, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.acceptOperator("."):
+			name := p.next()
+			if name.kind != tokenIdent {
+				return nil, p.unexpected(name)
+			}
+			if p.acceptOperator("(") {
+				args, err := p.parseArguments(")")
+				if err != nil {
+					return nil, err
+				}
+				operand, err = newCallNode(name, append([]node{operand}, args...))
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+			operand = &selectNode{operand: operand, field: name.text}
+		case p.acceptOperator("["):
+			index, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectOperator("]"); err != nil {
+				return nil, err
+			}
+			operand = &indexNode{operand: operand, index: index}
+		default:
+			return operand, nil
+		}
+	}
+}
+
+// parsePrimary parses literals, identifiers, global function calls, list literals and
+// parenthesized expressions.
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenInt, tokenFloat, tokenString:
+		return &literalNode{value: tok.value}, nil
+	case tokenIdent:
+		return p.parseIdentifier(tok)
+	case tokenOperator:
+		switch tok.text {
+		case "(":
+			inner, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectOperator(")"); err != nil {
+				return nil, err
+			}
+			return inner, nil
+		case "[":
+			elems, err := p.parseArguments("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listNode{elems: elems}, nil
+		}
+	}
+	return nil, p.unexpected(tok)
+}
+
+// parseIdentifier parses a keyword literal, a variable reference or a global function call.
+func (p *parser) parseIdentifier(tok token) (node, error) {
+	switch tok.text {
+	case "true":
+		return &literalNode{value: true}, nil
+	case "false":
+		return &literalNode{value: false}, nil
+	case "null":
+		return &literalNode{value: nil}, nil
+	case "in":
+		return nil, p.unexpected(tok)
+	}
+
+	if !p.acceptOperator("(") {
+		return &identNode{name: tok.text}, nil
+	}
+	args, err := p.parseArguments(")")
+	if err != nil {
+		return nil, err
+	}
+	if tok.text == "has" {
+		return newHasNode(tok, args)
+	}
+	return newCallNode(tok, args)
+}
+
+// parseArguments parses a comma-separated expression list up to and including the closing
+// operator.
+func (p *parser) parseArguments(closing string) ([]node, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	args := make([]node, 0)
+	if p.acceptOperator(closing) {
+		return args, nil
+	}
+	for {
+		arg, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.acceptOperator(closing) {
+			return args, nil
+		}
+		if err := p.expectOperator(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// newHasNode builds the has() presence test. Its single argument must be a field selection or an
+// index operation; the operand is evaluated and the key is tested without being read.
+func newHasNode(tok token, args []node) (node, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("has() at position %d takes exactly one argument", tok.pos)
+	}
+	switch arg := args[0].(type) {
+	case *selectNode:
+		return &hasNode{operand: arg.operand, key: &literalNode{value: arg.field}}, nil
+	case *indexNode:
+		return &hasNode{operand: arg.operand, key: arg.index}, nil
+	default:
+		return nil, fmt.Errorf("has() at position %d requires a field selection such as has(claims.email)",
+			tok.pos)
+	}
+}
+
+// newCallNode resolves a function by name and checks its arity.
+func newCallNode(tok token, args []node) (node, error) {
+	fn, ok := functions[tok.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", tok.text, tok.pos)
+	}
+	if len(args) != fn.arity {
+		return nil, fmt.Errorf("function %q at position %d takes %d argument(s), got %d",
+			tok.text, tok.pos, fn.arity, len(args))
+	}
+	return &callNode{name: tok.text, fn: fn.call, args: args}, nil
+}
@@ -40,6 +40,76 @@ func (_m *GraphBuilderInterfaceMock) EXPECT() *GraphBuilderInterfaceMock_Expecte
 	return &GraphBuilderInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// BuildGraph provides a mock function for the type GraphBuilderInterfaceMock
+func (_mock *GraphBuilderInterfaceMock) BuildGraph(ctx context.Context, flow *providers.CompleteFlowDefinition) (core.GraphInterface, *common.ServiceError) {
+	ret := _mock.Called(ctx, flow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildGraph")
+	}
+
+	var r0 core.GraphInterface
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *providers.CompleteFlowDefinition) (core.GraphInterface, *common.ServiceError)); ok {
+		return returnFunc(ctx, flow)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *providers.CompleteFlowDefinition) core.GraphInterface); ok {
+		r0 = returnFunc(ctx, flow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.GraphInterface)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *providers.CompleteFlowDefinition) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, flow)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// GraphBuilderInterfaceMock_BuildGraph_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildGraph'
+type GraphBuilderInterfaceMock_BuildGraph_Call struct {
+	*mock.Call
+}
+
+// BuildGraph is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flow *providers.CompleteFlowDefinition
+func (_e *GraphBuilderInterfaceMock_Expecter) BuildGraph(ctx interface{}, flow interface{}) *GraphBuilderInterfaceMock_BuildGraph_Call {
+	return &GraphBuilderInterfaceMock_BuildGraph_Call{Call: _e.mock.On("BuildGraph", ctx, flow)}
+}
+
+func (_c *GraphBuilderInterfaceMock_BuildGraph_Call) Run(run func(ctx context.Context, flow *providers.CompleteFlowDefinition)) *GraphBuilderInterfaceMock_BuildGraph_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *providers.CompleteFlowDefinition
+		if args[1] != nil {
+			arg1 = args[1].(*providers.CompleteFlowDefinition)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *GraphBuilderInterfaceMock_BuildGraph_Call) Return(graphInterface core.GraphInterface, serviceError *common.ServiceError) *GraphBuilderInterfaceMock_BuildGraph_Call {
+	_c.Call.Return(graphInterface, serviceError)
+	return _c
+}
+
+func (_c *GraphBuilderInterfaceMock_BuildGraph_Call) RunAndReturn(run func(ctx context.Context, flow *providers.CompleteFlowDefinition) (core.GraphInterface, *common.ServiceError)) *GraphBuilderInterfaceMock_BuildGraph_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGraph provides a mock function for the type GraphBuilderInterfaceMock
 func (_mock *GraphBuilderInterfaceMock) GetGraph(ctx context.Context, flow *providers.CompleteFlowDefinition) (core.GraphInterface, *common.ServiceError) {
 	ret := _mock.Called(ctx, flow)
@@ -27,6 +27,13 @@ import (
 // maxCallDepth is the maximum number of nested call frames allowed
 const maxCallDepth = 10
 
+// nodeObserver is notified of each node the engine runs or skips. Flow simulations use it to trace an
+// execution; returning an error stops the execution with that error.
+type nodeObserver interface {
+	observeNode(node core.NodeInterface, nodeResp *common.NodeResponse, nodeErr *tidcommon.ServiceError,
+		skipped bool) *tidcommon.ServiceError
+}
+
 // flowEngineInterface defines the interface for the flow engine.
 type flowEngineInterface interface {
 	Execute(ctx *EngineContext) (FlowStep, *tidcommon.ServiceError)
@@ -163,6 +170,7 @@ func (fe *flowEngine) executeNodePackage(ctx *EngineContext,
 		Handle:      ctx.SSOHandleIn,
 		FlowID:      ssoFlowID(ctx),
 		FlowVersion: ctx.SSOFlowVersion,
+		ExecutionID: ctx.ExecutionID,
 	})
 	nodeCtx := &providers.NodeContext{
 		Context:           ssoCtx,
@@ -203,6 +211,11 @@ func (fe *flowEngine) executeNodePackage(ctx *EngineContext,
 	if !currentNode.ShouldExecute(nodeCtx) {
 		logger.Debug(ctx.Context, "Skipping node due to unmet condition",
 			log.String("nodeID", currentNode.GetID()))
+		if ctx.observer != nil {
+			if svcErr := ctx.observer.observeNode(currentNode, nil, nil, true); svcErr != nil {
+				return nil, false, svcErr
+			}
+		}
 		nextNode, svcErr := fe.skipToNextNode(ctx, currentNode, logger)
 		if svcErr != nil {
 			return nil, false, svcErr
@@ -247,6 +260,11 @@ func (fe *flowEngine) executeNodePackage(ctx *EngineContext,
 	fe.clearSensitiveInputs(ctx, currentNode)
 
 	recordNodeExecution(ctx, currentNode, nodeResp, nodeErr, executionStartTime, executionEndTime)
+	if ctx.observer != nil {
+		if svcErr := ctx.observer.observeNode(currentNode, nodeResp, nodeErr, false); svcErr != nil {
+			return nil, false, svcErr
+		}
+	}
 
 	// Publish node execution completed or failed event
 	publishNodeExecutionCompletedEvent(
@@ -1406,6 +1424,8 @@ func publishNodeExecutionStartedEvent(
 		WithData(event.DataKey.AttemptNumber, fmt.Sprintf("%d", attemptNumber)).
 		WithData(event.DataKey.EntityID, ctx.AppID)
 
+	withFlowVersionData(ctx, evt)
+
 	obsSvc.PublishEvent(ctx.Context, evt)
 }
 
@@ -1486,6 +1506,8 @@ func publishNodeExecutionCompletedEvent(ctx *EngineContext, node core.NodeInterf
 		evt.WithData(event.DataKey.Error, processNodeResponseErrorForEventPublish(nodeResp))
 	}
 
+	withFlowVersionData(ctx, evt)
+
 	// Add user ID if authenticated
 	if ctx.AuthenticatedUser.IsAuthenticated && ctx.AuthenticatedUser.UserID != "" {
 		evt.WithData(event.DataKey.UserID, ctx.AuthenticatedUser.UserID)
@@ -1510,6 +1532,8 @@ func publishFlowStartedEvent(ctx *EngineContext, obsSvc providers.ObservabilityP
 		WithData(event.DataKey.FlowType, string(ctx.FlowType)).
 		WithData(event.DataKey.EntityID, ctx.AppID)
 
+	withFlowVersionData(ctx, evt)
+
 	// Add user ID if already authenticated
 	if ctx.AuthenticatedUser.IsAuthenticated && ctx.AuthenticatedUser.UserID != "" {
 		evt.WithData(event.DataKey.UserID, ctx.AuthenticatedUser.UserID)
@@ -1543,6 +1567,8 @@ func publishFlowCompletedEvent(
 		WithData(event.DataKey.EntityID, ctx.AppID).
 		WithData(event.DataKey.DurationMs, fmt.Sprintf("%d", durationMs))
 
+	withFlowVersionData(ctx, evt)
+
 	// Add user ID if authenticated
 	if ctx.AuthenticatedUser.IsAuthenticated && ctx.AuthenticatedUser.UserID != "" {
 		evt.WithData(event.DataKey.UserID, ctx.AuthenticatedUser.UserID)
@@ -1577,6 +1603,8 @@ func publishFlowFailedEvent(ctx *EngineContext, svcErr *tidcommon.ServiceError,
 		evt.WithData(event.DataKey.Error, processServiceErrorForEventPublish(svcErr))
 	}
 
+	withFlowVersionData(ctx, evt)
+
 	// Add user ID if authenticated
 	if ctx.AuthenticatedUser.IsAuthenticated && ctx.AuthenticatedUser.UserID != "" {
 		evt.WithData(event.DataKey.UserID, ctx.AuthenticatedUser.UserID)
@@ -1585,6 +1613,20 @@ func publishFlowFailedEvent(ctx *EngineContext, svcErr *tidcommon.ServiceError,
 	obsSvc.PublishEvent(ctx.Context, evt)
 }
 
+// withFlowVersionData adds the flow and version an execution runs to a flow event, along with the
+// rollout variant when the execution was routed by a rollout, so outcomes can be reported per version.
+func withFlowVersionData(ctx *EngineContext, evt *providers.Event) {
+	if ctx.FlowID != "" {
+		evt.WithData(event.DataKey.FlowID, ctx.FlowID)
+	}
+	if ctx.FlowVersion != 0 {
+		evt.WithData(event.DataKey.FlowVersion, fmt.Sprintf("%d", ctx.FlowVersion))
+	}
+	if ctx.RolloutVariant != "" {
+		evt.WithData(event.DataKey.RolloutVariant, ctx.RolloutVariant)
+	}
+}
+
 // processServiceErrorForEventPublish processes a service error to extract relevant information
 // for observability events.
 func processServiceErrorForEventPublish(svcErr *tidcommon.ServiceError) map[string]interface{} {
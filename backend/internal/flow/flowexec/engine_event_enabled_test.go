@@ -16,6 +16,7 @@ import (
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
 	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
 )
@@ -236,6 +237,28 @@ func TestPublishNodeExecutionStartedEvent(t *testing.T) {
 		mockObs.AssertCalled(t, "IsEnabled")
 		mockObs.AssertCalled(t, "PublishEvent", mock.Anything, mock.Anything)
 	})
+
+	t.Run("carries_flow_version", func(t *testing.T) {
+		node := coremock.NewNodeInterfaceMock(t)
+		node.On("GetID").Return("node-003")
+		node.On("GetType").Return(common.NodeTypePrompt)
+
+		ctx := &EngineContext{
+			ExecutionID:      "flow-008",
+			FlowType:         providers.FlowTypeRegistration,
+			FlowID:           "reg-flow",
+			FlowVersion:      4,
+			ExecutionHistory: make(map[string]*providers.NodeExecutionRecord),
+		}
+
+		publishNodeExecutionStartedEvent(ctx, node, mockObs)
+
+		mockObs.AssertCalled(t, "PublishEvent", mock.Anything, mock.MatchedBy(func(evt *providers.Event) bool {
+			return evt.Data[event.DataKey.NodeID] == "node-003" &&
+				evt.Data[event.DataKey.FlowID] == "reg-flow" &&
+				evt.Data[event.DataKey.FlowVersion] == "4"
+		}))
+	})
 }
 
 // TestPublishNodeExecutionCompletedEvent tests the node execution completed event publishing
@@ -283,3 +283,46 @@ var ErrorAdministrationPermissionRequired = tidcommon.ServiceError{
 		DefaultValue: "Administrative flows require the system permission",
 	},
 }
+
+// ErrorInvalidSimulationScenario defines the error returned when a flow simulation scenario is malformed.
+var ErrorInvalidSimulationScenario = tidcommon.ServiceError{
+	Code: "FES-1020",
+	Type: tidcommon.ClientErrorType,
+	Error: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.invalid_simulation_scenario",
+		DefaultValue: "Invalid simulation scenario",
+	},
+	ErrorDescription: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.invalid_simulation_scenario_description",
+		DefaultValue: "The simulation scenario is invalid",
+	},
+}
+
+// ErrorSimulationLimitExceeded defines the error a simulated run ends with when it executes more
+// nodes than a simulation allows, which usually means the flow loops.
+var ErrorSimulationLimitExceeded = tidcommon.ServiceError{
+	Code: "FES-1021",
+	Type: tidcommon.ClientErrorType,
+	Error: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.simulation_limit_exceeded",
+		DefaultValue: "Simulation limit exceeded",
+	},
+	ErrorDescription: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.simulation_limit_exceeded_description",
+		DefaultValue: "The simulated run exceeded the maximum number of node executions",
+	},
+}
+
+// ErrorSimulatedExecutorFailure defines the error reported by an executor stub with a FAILURE outcome.
+var ErrorSimulatedExecutorFailure = tidcommon.ServiceError{
+	Code: "FES-1022",
+	Type: tidcommon.ClientErrorType,
+	Error: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.simulated_executor_failure",
+		DefaultValue: "Simulated failure",
+	},
+	ErrorDescription: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.simulated_executor_failure_description",
+		DefaultValue: "The executor stub reported a failure",
+	},
+}
@@ -31,6 +31,7 @@ func Initialize(
 	storeProvider providers.RuntimeStoreProvider,
 	transactioner providers.Transactioner,
 	serverConfigSvc serverConfigProvider,
+	versionProvider flowVersionProvider,
 	cfg flowconfig.Config,
 ) (FlowExecServiceInterface, error) {
 	flowStore := newFlowStore(storeProvider)
@@ -39,7 +40,7 @@ func Initialize(
 		flowProvider, graphBuilder)
 	flowExecService := newFlowExecService(flowProvider, flowStore, flowEngine,
 		actorProvider, observabilitySvc, transactioner, cryptoSvc, attestationVerifier,
-		graphBuilder, jwtService, serverConfigSvc, cfg)
+		graphBuilder, jwtService, serverConfigSvc, versionProvider, cfg)
 
 	// Mark the SSO cookie Secure unless the deployment is configured to serve over plain HTTP, and
 	// bound its lifetime to the session's configured absolute timeout (same fallback as the session
@@ -86,6 +86,17 @@ type EngineContext struct {
 	// that flow rather than the running sign-out flow. Empty for all other flows. Transient — re-derived
 	// from the application on each context load, never persisted.
 	SessionFlowID string
+	// FlowID and FlowVersion identify the root flow of the execution and the version of its definition
+	// the execution was served. The execution is pinned to that version until it ends, so a flow update
+	// or a rollout change never moves a running execution to another definition.
+	FlowID      string
+	FlowVersion int
+	// RolloutVariant is the variant of the flow rollout the execution was routed to, or empty when no
+	// rollout applied when the execution started.
+	RolloutVariant string
+	// observer, when set, is notified of each node the engine runs or skips. Transient; set only for
+	// flow simulations.
+	observer nodeObserver
 }
 
 // GetInitiatorRequest returns the original HTTP request that triggered the flow.
@@ -324,6 +335,9 @@ type flowContextContent struct {
 	FrameStack            *string `json:"frameStack,omitempty"`
 	SharedRuntimeData     *string `json:"sharedRuntimeData,omitempty"`
 	InitiatorRequest      *string `json:"initiatorRequest,omitempty"`
+	FlowID                string  `json:"flowId,omitempty"`
+	FlowVersion           int     `json:"flowVersion,omitempty"`
+	RolloutVariant        string  `json:"rolloutVariant,omitempty"`
 }
 
 // graphResolverFunc resolves a flow graph by its flow ID. Used during context deserialization to
@@ -339,6 +353,17 @@ func (f *FlowContextDB) GetGraphID(_ context.Context) (string, error) {
 	return content.GraphID, nil
 }
 
+// GetPinnedFlowVersion extracts the root flow ID of the execution and the version of the flow the
+// execution is pinned to from the context JSON. The version is zero for contexts stored before
+// executions were pinned.
+func (f *FlowContextDB) GetPinnedFlowVersion(_ context.Context) (string, int, error) {
+	var content flowContextContent
+	if err := json.Unmarshal([]byte(f.Context), &content); err != nil {
+		return "", 0, err
+	}
+	return content.FlowID, content.FlowVersion, nil
+}
+
 // ToEngineContext converts the database model to the flow engine context.
 func (f *FlowContextDB) ToEngineContext(ctx context.Context,
 	graph core.GraphInterface, resolveGraph graphResolverFunc) (EngineContext, error) {
@@ -500,6 +525,9 @@ func (f *FlowContextDB) ToEngineContext(ctx context.Context,
 		InterceptorSharedData: interceptorSharedData,
 		frameStack:            frameStack,
 		sharedRuntimeData:     sharedRuntimeData,
+		FlowID:                content.FlowID,
+		FlowVersion:           content.FlowVersion,
+		RolloutVariant:        content.RolloutVariant,
 	}
 	engineCtx.SetInitiatorRequest(initiatorRequest)
 
@@ -663,6 +691,9 @@ func (f *FlowContextDB) FromEngineContext(ctx EngineContext) error {
 		FrameStack:            frameStackStr,
 		SharedRuntimeData:     sharedRuntimeDataStr,
 		InitiatorRequest:      initiatorRequestStr,
+		FlowID:                ctx.FlowID,
+		FlowVersion:           ctx.FlowVersion,
+		RolloutVariant:        ctx.RolloutVariant,
 	}
 
 	contextJSON, err := json.Marshal(content)
@@ -1405,3 +1405,47 @@ func (s *ModelTestSuite) TestDeserializeFrameStack_NodeIDNotInGraph() {
 	s.Len(result, 1)
 	s.Nil(result[0].currentNode)
 }
+
+func (s *ModelTestSuite) TestPinnedFlowVersion_RoundTrip() {
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	mockGraph.On("GetID").Return("test-graph-id")
+	mockGraph.On("GetType").Return(providers.FlowTypeAuthentication)
+
+	ctx := EngineContext{
+		Context:          context.Background(),
+		ExecutionID:      "test-exec-id",
+		FlowType:         providers.FlowTypeAuthentication,
+		FlowID:           "flow-1",
+		FlowVersion:      4,
+		RolloutVariant:   "candidate",
+		UserInputs:       map[string]string{},
+		RuntimeData:      map[string]string{},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+		Graph:            mockGraph,
+	}
+
+	dbModel := &FlowContextDB{}
+	s.NoError(dbModel.FromEngineContext(ctx))
+
+	flowID, version, err := dbModel.GetPinnedFlowVersion(context.Background())
+	s.NoError(err)
+	s.Equal("flow-1", flowID)
+	s.Equal(4, version)
+
+	resultCtx, err := dbModel.ToEngineContext(context.Background(), mockGraph, nil)
+	s.NoError(err)
+	s.Equal("flow-1", resultCtx.FlowID)
+	s.Equal(4, resultCtx.FlowVersion)
+	s.Equal("candidate", resultCtx.RolloutVariant)
+}
+
+func (s *ModelTestSuite) TestGetPinnedFlowVersion_NotPinned() {
+	content := flowContextContent{GraphID: "test-graph-id"}
+	ctxJSON, _ := json.Marshal(content)
+	dbModel := &FlowContextDB{ExecutionID: "test-exec-id", Context: string(ctxJSON)}
+
+	flowID, version, err := dbModel.GetPinnedFlowVersion(context.Background())
+	s.NoError(err)
+	s.Empty(flowID)
+	s.Zero(version)
+}
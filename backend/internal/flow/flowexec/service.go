@@ -37,6 +37,16 @@ type serverConfigProvider interface {
 	GetMergedConfig(ctx context.Context, name string) (any, *tidcommon.ServiceError)
 }
 
+// flowVersionProvider serves the versions of flow definitions other than the active one: the version a
+// rollout routes a new execution to, and the version a running execution is pinned to. Defined locally so
+// flowexec does not import the rollout package.
+type flowVersionProvider interface {
+	SelectFlowVersion(ctx context.Context, flow *providers.CompleteFlowDefinition, appID, executionID string) (
+		*providers.CompleteFlowDefinition, string, *tidcommon.ServiceError)
+	GetFlowVersion(ctx context.Context, flowID string, version int) (
+		*providers.CompleteFlowDefinition, *tidcommon.ServiceError)
+}
+
 // flowExecService is the implementation of FlowExecServiceInterface
 type flowExecService struct {
 	flowEngine          flowEngineInterface
@@ -50,6 +60,7 @@ type flowExecService struct {
 	attestationVerifier providers.AttestationProvider
 	jwtService          jwt.JWTServiceInterface
 	serverConfigSvc     serverConfigProvider
+	versionProvider     flowVersionProvider
 	cfg                 flowconfig.Config
 }
 
@@ -64,6 +75,7 @@ func newFlowExecService(flowProvider providers.FlowProvider,
 	graphBuilder graphbuilder.GraphBuilderInterface,
 	jwtService jwt.JWTServiceInterface,
 	serverConfigSvc serverConfigProvider,
+	versionProvider flowVersionProvider,
 	cfg flowconfig.Config) FlowExecServiceInterface {
 	return &flowExecService{
 		flowProvider:        flowProvider,
@@ -77,6 +89,7 @@ func newFlowExecService(flowProvider providers.FlowProvider,
 		graphBuilder:        graphBuilder,
 		jwtService:          jwtService,
 		serverConfigSvc:     serverConfigSvc,
+		versionProvider:     versionProvider,
 		cfg:                 cfg,
 	}
 }
@@ -542,8 +555,22 @@ func (s *flowExecService) initContext(ctx context.Context, requestedFlowID, appI
 	}
 
 	engineCtx.FlowType = flow.FlowType
+	// SSO sessions stay tied to the active version, so a rollout does not invalidate them.
 	engineCtx.SSOFlowVersion = flow.ActiveVersion
-	graph, svcErr := s.graphBuilder.GetGraph(ctx, flow)
+
+	served := flow
+	if s.versionProvider != nil {
+		served, engineCtx.RolloutVariant, svcErr = s.versionProvider.SelectFlowVersion(ctx, flow, appID, executionID)
+		if svcErr != nil {
+			logger.Error(ctx, "Failed to select the flow version to serve",
+				log.String("graphID", graphID), log.String("error", svcErr.Error.DefaultValue))
+			return nil, &tidcommon.InternalServerError
+		}
+	}
+	engineCtx.FlowID = flow.ID
+	engineCtx.FlowVersion = served.ActiveVersion
+
+	graph, svcErr := s.getServedGraph(ctx, flow, served)
 	if svcErr != nil {
 		logger.Error(ctx, "Error retrieving graph from graph builder",
 			log.String("graphID", graphID), log.String("error", svcErr.Error.DefaultValue))
@@ -619,6 +646,21 @@ func (s *flowExecService) loadContextFromStore(ctx context.Context, executionID
 		return nil, &tidcommon.InternalServerError
 	}
 
+	pinnedFlowID, pinnedVersion, err := dbModel.GetPinnedFlowVersion(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to extract pinned flow version from flow context",
+			log.String(log.LoggerKeyExecutionID, executionID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	// versionOf returns the version a flow of the execution runs: the root flow stays on the version the
+	// execution was served, and the flows it calls run their active version.
+	versionOf := func(flowID string) int {
+		if flowID == pinnedFlowID {
+			return pinnedVersion
+		}
+		return 0
+	}
+
 	flow, svcErr := s.flowProvider.GetFlow(ctx, graphID)
 	if svcErr != nil {
 		logger.Error(ctx, "Error retrieving flow graph from flow provider",
@@ -626,7 +668,7 @@ func (s *flowExecService) loadContextFromStore(ctx context.Context, executionID
 		return nil, &tidcommon.InternalServerError
 	}
 
-	graph, svcErr := s.graphBuilder.GetGraph(ctx, flow)
+	graph, svcErr := s.getPinnedGraph(ctx, flow, versionOf(graphID), logger)
 	if svcErr != nil {
 		logger.Error(ctx, "Error retrieving graph from graph builder",
 			log.String("graphID", graphID), log.String("error", svcErr.Error.DefaultValue))
@@ -638,7 +680,7 @@ func (s *flowExecService) loadContextFromStore(ctx context.Context, executionID
 		if svcErr != nil {
 			return nil, fmt.Errorf("failed to get flow %s: %s", flowID, svcErr.Error.DefaultValue)
 		}
-		g, svcErr := s.graphBuilder.GetGraph(rctx, f)
+		g, svcErr := s.getPinnedGraph(rctx, f, versionOf(flowID), logger)
 		if svcErr != nil {
 			return nil, fmt.Errorf("failed to build graph for flow %s: %s", flowID, svcErr.Error.DefaultValue)
 		}
@@ -661,6 +703,35 @@ func (s *flowExecService) loadContextFromStore(ctx context.Context, executionID
 	return &engineContext, nil
 }
 
+// getServedGraph returns the graph of the definition a new execution is served. The active definition
+// uses the cached graph of the flow; any other version is built for the execution.
+func (s *flowExecService) getServedGraph(ctx context.Context, flow, served *providers.CompleteFlowDefinition) (
+	core.GraphInterface, *tidcommon.ServiceError) {
+	if served.ActiveVersion == flow.ActiveVersion {
+		return s.graphBuilder.GetGraph(ctx, flow)
+	}
+	return s.graphBuilder.BuildGraph(ctx, served)
+}
+
+// getPinnedGraph returns the graph of a flow at the version a running execution is pinned to. A zero
+// version, which contexts stored before executions were pinned carry, resolves to the active version.
+// When the pinned version is no longer in the version history the execution continues on the active
+// version.
+func (s *flowExecService) getPinnedGraph(ctx context.Context, flow *providers.CompleteFlowDefinition,
+	version int, logger *log.Logger) (core.GraphInterface, *tidcommon.ServiceError) {
+	if version == 0 || version == flow.ActiveVersion || s.versionProvider == nil {
+		return s.graphBuilder.GetGraph(ctx, flow)
+	}
+
+	pinned, svcErr := s.versionProvider.GetFlowVersion(ctx, flow.ID, version)
+	if svcErr != nil {
+		logger.Warn(ctx, "Pinned flow version is unavailable; continuing on the active version",
+			log.String("flowID", flow.ID), log.Int("version", version), log.String("errorCode", svcErr.Code))
+		return s.graphBuilder.GetGraph(ctx, flow)
+	}
+	return s.graphBuilder.BuildGraph(ctx, pinned)
+}
+
 // setApplicationToContext loads the inbound-client / entity records for the flow's owning entity
 // and assembles a providers.Application view onto engineCtx.Application. Entity-agnostic: works for
 // any entity (application, agent, ...) that has an inbound-client row.
@@ -3420,6 +3420,106 @@ func (s *ServiceTestSuite) TestLoadContextFromStore_GetFlowGraphError() {
 	s.NotNil(svcErr)
 }
 
+func (s *ServiceTestSuite) TestLoadContextFromStore_PinnedFlowVersion() {
+	t := s.T()
+	mockStore := newFlowStoreInterfaceMock(t)
+	mockFlowProvider := NewFlowProviderMock(t)
+	mockGraphBuilder := NewGraphBuilderInterfaceMock(t)
+	mockVersionProvider := newFlowVersionProviderMock(t)
+
+	rawCtx := "{\"executionID\":\"exec-1\",\"flowType\":\"USER_ONBOARDING\"," +
+		"\"graphID\":\"graph-1\",\"flowId\":\"graph-1\",\"flowVersion\":2,\"rolloutVariant\":\"stable\"," +
+		"\"userInputs\":\"{}\",\"runtimeData\":\"{}\",\"executionHistory\":\"{}\"}"
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Return(&FlowContextDB{ExecutionID: "exec-1", Context: rawCtx}, nil)
+
+	active := &providers.CompleteFlowDefinition{ID: "graph-1", ActiveVersion: 3}
+	pinned := &providers.CompleteFlowDefinition{ID: "graph-1", ActiveVersion: 2}
+	mockGraph := coremock.NewGraphInterfaceMock(t)
+	mockGraph.EXPECT().GetType().Return(providers.FlowTypeUserOnboarding).Maybe()
+	mockFlowProvider.EXPECT().GetFlow(mock.Anything, "graph-1").Return(active, nil)
+	mockVersionProvider.EXPECT().GetFlowVersion(mock.Anything, "graph-1", 2).Return(pinned, nil)
+	mockGraphBuilder.EXPECT().BuildGraph(mock.Anything, pinned).Return(mockGraph, nil)
+
+	service := &flowExecService{
+		flowStore:       mockStore,
+		graphBuilder:    mockGraphBuilder,
+		flowProvider:    mockFlowProvider,
+		versionProvider: mockVersionProvider,
+		cfg:             testFlowExecCfg,
+	}
+
+	result, svcErr := service.loadContextFromStore(context.Background(), "exec-1", log.GetLogger())
+	s.Nil(svcErr)
+	s.Equal("graph-1", result.FlowID)
+	s.Equal(2, result.FlowVersion)
+	s.Equal("stable", result.RolloutVariant)
+	s.Equal(3, result.SSOFlowVersion)
+	s.Same(mockGraph, result.Graph)
+}
+
+// ----- getServedGraph / getPinnedGraph -----
+
+func (s *ServiceTestSuite) TestGetServedGraph() {
+	t := s.T()
+	mockGraphBuilder := NewGraphBuilderInterfaceMock(t)
+	service := &flowExecService{graphBuilder: mockGraphBuilder, cfg: testFlowExecCfg}
+	active := &providers.CompleteFlowDefinition{ID: "flow-1", ActiveVersion: 3}
+	candidate := &providers.CompleteFlowDefinition{ID: "flow-1", ActiveVersion: 4}
+	activeGraph := coremock.NewGraphInterfaceMock(t)
+	candidateGraph := coremock.NewGraphInterfaceMock(t)
+	mockGraphBuilder.EXPECT().GetGraph(mock.Anything, active).Return(activeGraph, nil)
+	mockGraphBuilder.EXPECT().BuildGraph(mock.Anything, candidate).Return(candidateGraph, nil)
+
+	graph, svcErr := service.getServedGraph(context.Background(), active, active)
+	s.Nil(svcErr)
+	s.Same(activeGraph, graph)
+
+	graph, svcErr = service.getServedGraph(context.Background(), active, candidate)
+	s.Nil(svcErr)
+	s.Same(candidateGraph, graph)
+}
+
+func (s *ServiceTestSuite) TestGetPinnedGraph_ActiveVersion() {
+	t := s.T()
+	mockGraphBuilder := NewGraphBuilderInterfaceMock(t)
+	service := &flowExecService{
+		graphBuilder:    mockGraphBuilder,
+		versionProvider: newFlowVersionProviderMock(t),
+		cfg:             testFlowExecCfg,
+	}
+	flow := &providers.CompleteFlowDefinition{ID: "flow-1", ActiveVersion: 3}
+	mockGraph := coremock.NewGraphInterfaceMock(t)
+	mockGraphBuilder.EXPECT().GetGraph(mock.Anything, flow).Return(mockGraph, nil).Twice()
+
+	// Contexts stored before executions were pinned carry no version.
+	for _, version := range []int{0, 3} {
+		graph, svcErr := service.getPinnedGraph(context.Background(), flow, version, log.GetLogger())
+		s.Nil(svcErr)
+		s.Same(mockGraph, graph)
+	}
+}
+
+func (s *ServiceTestSuite) TestGetPinnedGraph_UnavailableVersionFallsBackToActive() {
+	t := s.T()
+	mockGraphBuilder := NewGraphBuilderInterfaceMock(t)
+	mockVersionProvider := newFlowVersionProviderMock(t)
+	service := &flowExecService{
+		graphBuilder:    mockGraphBuilder,
+		versionProvider: mockVersionProvider,
+		cfg:             testFlowExecCfg,
+	}
+	flow := &providers.CompleteFlowDefinition{ID: "flow-1", ActiveVersion: 3}
+	mockGraph := coremock.NewGraphInterfaceMock(t)
+	mockVersionProvider.EXPECT().GetFlowVersion(mock.Anything, "flow-1", 1).
+		Return(nil, &tidcommon.InternalServerError)
+	mockGraphBuilder.EXPECT().GetGraph(mock.Anything, flow).Return(mockGraph, nil)
+
+	graph, svcErr := service.getPinnedGraph(context.Background(), flow, 1, log.GetLogger())
+	s.Nil(svcErr)
+	s.Same(mockGraph, graph)
+}
+
 // ----- firstPositiveExpiry -----
 
 func (s *ServiceTestSuite) TestFirstPositiveExpiry_PositiveVWins() {
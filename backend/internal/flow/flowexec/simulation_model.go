@@ -0,0 +1,108 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package flowexec
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+)
+
+// SimulationScenario describes a scripted run of a flow: the user interactions to replay, the canned
+// outcomes of stubbed executors, and optionally the outcome the run is expected to produce.
+type SimulationScenario struct {
+	// AppID is the application the flow runs for. It is only exposed to executors through the runtime
+	// data; the application itself is not loaded.
+	AppID string `json:"appId,omitempty"`
+	// RuntimeData seeds the runtime data of the execution before the first node runs.
+	RuntimeData map[string]string `json:"runtimeData,omitempty"`
+	// Steps are the user interactions submitted, in order, each time the flow stops for input.
+	Steps []SimulationStep `json:"steps,omitempty"`
+	// Stubs are the canned executor outcomes used in place of the real executors.
+	Stubs []ExecutorStub `json:"stubs,omitempty"`
+	// Expect, when set, is evaluated against the result of the run.
+	Expect *SimulationExpectation `json:"expect,omitempty"`
+}
+
+// SimulationStep is a single scripted user interaction.
+type SimulationStep struct {
+	Action string            `json:"action,omitempty"`
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+// ExecutorStub is a canned outcome for an executor. A stub targets either a node by its ID or every
+// node backed by the named executor; a node stub takes precedence. When several stubs target the same
+// node or executor they are used in order, one per execution, and the last one is repeated.
+type ExecutorStub struct {
+	NodeID         string                   `json:"nodeId,omitempty"`
+	Executor       string                   `json:"executor,omitempty"`
+	Status         providers.ExecutorStatus `json:"status,omitempty"`
+	FailureReason  string                   `json:"failureReason,omitempty"`
+	RuntimeData    map[string]string        `json:"runtimeData,omitempty"`
+	AdditionalData map[string]string        `json:"additionalData,omitempty"`
+	RedirectURL    string                   `json:"redirectUrl,omitempty"`
+	Assertion      string                   `json:"assertion,omitempty"`
+	User           *SimulatedUser           `json:"user,omitempty"`
+}
+
+// SimulatedUser is the user a stubbed executor reports as authenticated.
+type SimulatedUser struct {
+	ID         string            `json:"id"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// SimulationExpectation is the outcome a scenario is expected to produce.
+type SimulationExpectation struct {
+	// Status is the expected final flow status.
+	Status providers.FlowStatus `json:"status,omitempty"`
+	// Path lists node IDs that must be executed in this order. Other nodes may run in between.
+	Path []string `json:"path,omitempty"`
+	// RuntimeData lists runtime data entries that must be present with these values at the end of the run.
+	RuntimeData map[string]string `json:"runtimeData,omitempty"`
+	// HasAssertion, when set, states whether the run must end with an assertion.
+	HasAssertion *bool `json:"hasAssertion,omitempty"`
+	// ErrorCode is the expected code of the error the run ends with.
+	ErrorCode string `json:"errorCode,omitempty"`
+}
+
+// SimulationResult is the outcome of a simulated flow run.
+type SimulationResult struct {
+	Status      providers.FlowStatus    `json:"status"`
+	Trace       []SimulationTraceEntry  `json:"trace"`
+	Prompts     []SimulationPrompt      `json:"prompts"`
+	RuntimeData map[string]string       `json:"runtimeData,omitempty"`
+	Assertion   string                  `json:"assertion,omitempty"`
+	Error       *tidcommon.ServiceError `json:"error,omitempty"`
+	// Passed and Failures report the evaluation of the scenario expectation. Passed is nil when the
+	// scenario has no expectation.
+	Passed   *bool    `json:"passed,omitempty"`
+	Failures []string `json:"failures,omitempty"`
+}
+
+// SimulationTraceEntry records a node that ran, or was skipped, during a simulated run.
+type SimulationTraceEntry struct {
+	// Step is the index of the request the node ran in; 0 is the request that started the flow and
+	// step n is the request that submitted the nth scripted step.
+	Step       int                     `json:"step"`
+	NodeID     string                  `json:"nodeId"`
+	NodeType   string                  `json:"nodeType"`
+	Executor   string                  `json:"executor,omitempty"`
+	Stubbed    bool                    `json:"stubbed,omitempty"`
+	Skipped    bool                    `json:"skipped,omitempty"`
+	Status     string                  `json:"status,omitempty"`
+	NextNodeID string                  `json:"nextNodeId,omitempty"`
+	Error      *tidcommon.ServiceError `json:"error,omitempty"`
+}
+
+// SimulationPrompt records a point where the simulated flow stopped for user interaction.
+type SimulationPrompt struct {
+	Step        int                     `json:"step"`
+	NodeID      string                  `json:"nodeId"`
+	Type        common.FlowStepType     `json:"type"`
+	Inputs      []providers.Input       `json:"inputs,omitempty"`
+	Actions     []common.Action         `json:"actions,omitempty"`
+	RedirectURL string                  `json:"redirectUrl,omitempty"`
+	Error       *tidcommon.ServiceError `json:"error,omitempty"`
+}
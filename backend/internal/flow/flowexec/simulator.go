@@ -0,0 +1,459 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package flowexec
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/graphbuilder"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	// maxSimulationSteps is the maximum number of scripted user interactions in a scenario.
+	maxSimulationSteps = 50
+	// maxSimulationNodeExecutions bounds the nodes a simulated run may execute, so that a flow that
+	// loops ends the run instead of spinning.
+	maxSimulationNodeExecutions = 500
+	// simulationAuthProviderName is the provider name under which a stubbed executor records the
+	// simulated user.
+	simulationAuthProviderName = "simulation"
+)
+
+// liveSimulationExecutors are the executors that run for real in a simulation unless a stub targets
+// them. They have no side effects and make no calls outside the server; every other executor is
+// replaced by a stub, which completes by default.
+var liveSimulationExecutors = map[string]struct{}{
+	executor.ExecutorNameScript: {},
+}
+
+// validSimulationStubStatuses are the executor statuses a stub may report.
+var validSimulationStubStatuses = []providers.ExecutorStatus{
+	providers.ExecComplete,
+	providers.ExecUserInputRequired,
+	providers.ExecExternalRedirection,
+	providers.ExecFailure,
+}
+
+// FlowSimulatorInterface runs flow definitions against scripted scenarios without side effects.
+type FlowSimulatorInterface interface {
+	Simulate(ctx context.Context, flow *providers.CompleteFlowDefinition, scenario *SimulationScenario) (
+		*SimulationResult, *tidcommon.ServiceError)
+}
+
+// flowSimulator is the default implementation of FlowSimulatorInterface. Each simulation drives the
+// flow engine over a graph built for that run only, keeps the execution context in memory, skips
+// interceptors and publishes no observability events.
+type flowSimulator struct {
+	executorRegistry executor.ExecutorRegistryInterface
+	graphBuilder     graphbuilder.GraphBuilderInterface
+	flowProvider     providers.FlowProvider
+	logger           *log.Logger
+}
+
+// NewFlowSimulator creates a flow simulator. The flow provider resolves the flows invoked by CALL nodes.
+func NewFlowSimulator(executorRegistry executor.ExecutorRegistryInterface,
+	graphBuilder graphbuilder.GraphBuilderInterface, flowProvider providers.FlowProvider) FlowSimulatorInterface {
+	return &flowSimulator{
+		executorRegistry: executorRegistry,
+		graphBuilder:     graphBuilder,
+		flowProvider:     flowProvider,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowSimulator")),
+	}
+}
+
+// Simulate runs the flow against the scenario. The scenario steps are submitted one by one each time
+// the flow stops for user interaction, until the flow completes or fails or the steps run out. Errors
+// raised while the flow runs end the run and are reported in the result; only an invalid flow or
+// scenario is returned as an error.
+func (s *flowSimulator) Simulate(ctx context.Context, flow *providers.CompleteFlowDefinition,
+	scenario *SimulationScenario) (*SimulationResult, *tidcommon.ServiceError) {
+	if scenario == nil {
+		scenario = &SimulationScenario{}
+	}
+	if svcErr := ValidateSimulationScenario(scenario); svcErr != nil {
+		return nil, svcErr
+	}
+
+	graph, svcErr := s.graphBuilder.BuildGraph(ctx, flow)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	executionID, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate UUID", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	run := newSimulationRun(scenario.Stubs)
+	engine := newFlowEngine(&simulationExecutorRegistry{ExecutorRegistryInterface: s.executorRegistry, run: run},
+		noopInterceptorRunner{}, nil, s.flowProvider, uncachedGraphBuilder{GraphBuilderInterface: s.graphBuilder})
+
+	engineCtx := &EngineContext{
+		Context:     ctx,
+		ExecutionID: executionID,
+		FlowType:    flow.FlowType,
+		AppID:       scenario.AppID,
+		RuntimeData: maps.Clone(scenario.RuntimeData),
+		Graph:       graph,
+		observer:    run,
+	}
+	prepareContext(engineCtx, "", nil)
+
+	result := &SimulationResult{Prompts: make([]SimulationPrompt, 0)}
+	for step := 0; ; step++ {
+		run.step = step
+		flowStep, svcErr := engine.Execute(engineCtx)
+		if svcErr != nil {
+			result.Status = providers.FlowStatusError
+			result.Error = svcErr
+			break
+		}
+		if flowStep.Status != providers.FlowStatusIncomplete {
+			result.Status = flowStep.Status
+			result.Assertion = flowStep.Assertion
+			result.Error = flowStep.Error
+			break
+		}
+
+		result.Prompts = append(result.Prompts, SimulationPrompt{
+			Step:        step,
+			NodeID:      engineCtx.CurrentNode.GetID(),
+			Type:        flowStep.Type,
+			Inputs:      flowStep.Data.Inputs,
+			Actions:     flowStep.Data.Actions,
+			RedirectURL: flowStep.Data.RedirectURL,
+			Error:       flowStep.Error,
+		})
+		if step == len(scenario.Steps) {
+			result.Status = providers.FlowStatusIncomplete
+			break
+		}
+		prepareContext(engineCtx, scenario.Steps[step].Action, scenario.Steps[step].Inputs)
+	}
+
+	result.Trace = run.trace
+	result.RuntimeData = engineCtx.RuntimeData
+	if scenario.Expect != nil {
+		evaluateSimulationExpectation(result, scenario.Expect)
+	}
+	return result, nil
+}
+
+// ValidateSimulationScenario checks the scenario limits and the stub definitions. Simulate validates
+// the scenario itself; callers validate scenarios they store for later runs.
+func ValidateSimulationScenario(scenario *SimulationScenario) *tidcommon.ServiceError {
+	if len(scenario.Steps) > maxSimulationSteps {
+		return tidcommon.CustomServiceError(ErrorInvalidSimulationScenario, tidcommon.I18nMessage{
+			Key:          "error.flowexecservice.invalid_simulation_scenario_too_many_steps_description",
+			DefaultValue: "The scenario has more than {{param(max)}} steps",
+			Params:       map[string]string{"max": fmt.Sprint(maxSimulationSteps)},
+		})
+	}
+	for _, stub := range scenario.Stubs {
+		if (stub.NodeID == "") == (stub.Executor == "") {
+			return tidcommon.CustomServiceError(ErrorInvalidSimulationScenario, tidcommon.I18nMessage{
+				Key:          "error.flowexecservice.invalid_simulation_scenario_stub_target_description",
+				DefaultValue: "Each stub must target either a node or an executor",
+			})
+		}
+		if stub.Status != "" && !slices.Contains(validSimulationStubStatuses, stub.Status) {
+			return tidcommon.CustomServiceError(ErrorInvalidSimulationScenario, tidcommon.I18nMessage{
+				Key:          "error.flowexecservice.invalid_simulation_scenario_stub_status_description",
+				DefaultValue: "Unsupported stub status {{param(status)}}",
+				Params:       map[string]string{"status": string(stub.Status)},
+			})
+		}
+		if stub.Status == providers.ExecExternalRedirection && stub.RedirectURL == "" {
+			return tidcommon.CustomServiceError(ErrorInvalidSimulationScenario, tidcommon.I18nMessage{
+				Key:          "error.flowexecservice.invalid_simulation_scenario_stub_redirect_description",
+				DefaultValue: "A stub with the EXTERNAL_REDIRECTION status must have a redirect URL",
+			})
+		}
+		if stub.User != nil && stub.User.ID == "" {
+			return tidcommon.CustomServiceError(ErrorInvalidSimulationScenario, tidcommon.I18nMessage{
+				Key:          "error.flowexecservice.invalid_simulation_scenario_stub_user_description",
+				DefaultValue: "A stub user must have an ID",
+			})
+		}
+	}
+	return nil
+}
+
+// evaluateSimulationExpectation compares the result against the expectation and records the outcome
+// on the result.
+func evaluateSimulationExpectation(result *SimulationResult, expect *SimulationExpectation) {
+	var failures []string
+	if expect.Status != "" && result.Status != expect.Status {
+		failures = append(failures, fmt.Sprintf("expected status %s, got %s", expect.Status, result.Status))
+	}
+	if missing, ok := firstMissingPathNode(result.Trace, expect.Path); !ok {
+		failures = append(failures, fmt.Sprintf("expected node %s to be executed in the expected order", missing))
+	}
+	for _, key := range slices.Sorted(maps.Keys(expect.RuntimeData)) {
+		if value, ok := result.RuntimeData[key]; !ok || value != expect.RuntimeData[key] {
+			failures = append(failures, fmt.Sprintf("expected runtime data %s to be %q, got %q",
+				key, expect.RuntimeData[key], value))
+		}
+	}
+	if expect.HasAssertion != nil && *expect.HasAssertion != (result.Assertion != "") {
+		if *expect.HasAssertion {
+			failures = append(failures, "expected an assertion, got none")
+		} else {
+			failures = append(failures, "expected no assertion, got one")
+		}
+	}
+	if expect.ErrorCode != "" {
+		errorCode := ""
+		if result.Error != nil {
+			errorCode = result.Error.Code
+		}
+		if errorCode != expect.ErrorCode {
+			failures = append(failures, fmt.Sprintf("expected error %s, got %q", expect.ErrorCode, errorCode))
+		}
+	}
+
+	passed := len(failures) == 0
+	result.Passed = &passed
+	result.Failures = failures
+}
+
+// firstMissingPathNode checks that the executed nodes of the trace contain the path in order. It
+// returns the first path node that could not be matched.
+func firstMissingPathNode(trace []SimulationTraceEntry, path []string) (string, bool) {
+	next := 0
+	for _, entry := range trace {
+		if next == len(path) {
+			break
+		}
+		if !entry.Skipped && entry.NodeID == path[next] {
+			next++
+		}
+	}
+	if next < len(path) {
+		return path[next], false
+	}
+	return "", true
+}
+
+// stubQueue holds the stubs targeting one node or executor. Each execution takes the next stub and
+// the last stub is repeated once the queue is used up.
+type stubQueue struct {
+	stubs []ExecutorStub
+	used  int
+}
+
+// take returns the stub for the next execution.
+func (q *stubQueue) take() *ExecutorStub {
+	stub := &q.stubs[min(q.used, len(q.stubs)-1)]
+	q.used++
+	return stub
+}
+
+// simulationRun holds the state of a single simulated run: the stubs left to use and the trace of
+// the nodes executed so far. It observes the engine to build the trace.
+type simulationRun struct {
+	step          int
+	nodeStubs     map[string]*stubQueue
+	executorStubs map[string]*stubQueue
+	trace         []SimulationTraceEntry
+	executions    int
+}
+
+// newSimulationRun creates the state of a simulated run with the given stubs.
+func newSimulationRun(stubs []ExecutorStub) *simulationRun {
+	run := &simulationRun{
+		nodeStubs:     make(map[string]*stubQueue),
+		executorStubs: make(map[string]*stubQueue),
+		trace:         make([]SimulationTraceEntry, 0),
+	}
+	for _, stub := range stubs {
+		queues, key := run.executorStubs, stub.Executor
+		if stub.NodeID != "" {
+			queues, key = run.nodeStubs, stub.NodeID
+		}
+		if queues[key] == nil {
+			queues[key] = &stubQueue{}
+		}
+		queues[key].stubs = append(queues[key].stubs, stub)
+	}
+	return run
+}
+
+// isStubbed reports whether the executor of the node is replaced by a stub.
+func (r *simulationRun) isStubbed(nodeID, executorName string) bool {
+	if r.nodeStubs[nodeID] != nil || r.executorStubs[executorName] != nil {
+		return true
+	}
+	_, live := liveSimulationExecutors[executorName]
+	return !live
+}
+
+// nextStub returns the stub for the next execution of the node, or nil to use the default outcome.
+func (r *simulationRun) nextStub(nodeID, executorName string) *ExecutorStub {
+	if queue := r.nodeStubs[nodeID]; queue != nil {
+		return queue.take()
+	}
+	if queue := r.executorStubs[executorName]; queue != nil {
+		return queue.take()
+	}
+	return nil
+}
+
+// observeNode records the node in the trace and ends the run once it executes too many nodes.
+func (r *simulationRun) observeNode(node core.NodeInterface, nodeResp *common.NodeResponse,
+	nodeErr *tidcommon.ServiceError, skipped bool) *tidcommon.ServiceError {
+	entry := SimulationTraceEntry{
+		Step:     r.step,
+		NodeID:   node.GetID(),
+		NodeType: string(node.GetType()),
+		Skipped:  skipped,
+	}
+	if execNode, ok := node.(core.ExecutorBackedNodeInterface); ok &&
+		node.GetType() == common.NodeTypeTaskExecution {
+		entry.Executor = execNode.GetExecutorName()
+		entry.Stubbed = !skipped && r.isStubbed(entry.NodeID, entry.Executor)
+	}
+	if nodeResp != nil {
+		entry.Status = string(nodeResp.Status)
+		entry.NextNodeID = nodeResp.NextNodeID
+		entry.Error = nodeResp.Error
+	}
+	if nodeErr != nil {
+		entry.Error = nodeErr
+	}
+	r.trace = append(r.trace, entry)
+
+	r.executions++
+	if r.executions > maxSimulationNodeExecutions {
+		return &ErrorSimulationLimitExceeded
+	}
+	return nil
+}
+
+// simulationExecutorRegistry hands out executors that consult the stubs of a simulated run before
+// running the registered executor.
+type simulationExecutorRegistry struct {
+	executor.ExecutorRegistryInterface
+	run *simulationRun
+}
+
+// GetExecutor returns the registered executor wrapped for the simulated run.
+func (r *simulationExecutorRegistry) GetExecutor(name string) (providers.Executor, error) {
+	exec, err := r.ExecutorRegistryInterface.GetExecutor(name)
+	if err != nil {
+		return nil, err
+	}
+	return &simulatedExecutor{Executor: exec, run: r.run}, nil
+}
+
+// simulatedExecutor runs a live executor as is and replaces any other executor with the stubbed
+// outcome. A stubbed executor still prompts for the node inputs that have not been provided, so a
+// scenario scripts the same interactions as a real user would.
+type simulatedExecutor struct {
+	providers.Executor
+	run *simulationRun
+}
+
+// Execute runs the executor or applies its stub.
+func (e *simulatedExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	name := e.GetName()
+	if !e.run.isStubbed(ctx.CurrentNodeID, name) {
+		return e.Executor.Execute(ctx)
+	}
+
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+	requiredInputs := e.GetRequiredInputs(ctx)
+	if len(requiredInputs) > 0 && !e.HasRequiredInputs(ctx, execResp) {
+		execResp.Status = providers.ExecUserInputRequired
+		return execResp, nil
+	}
+
+	stub := e.run.nextStub(ctx.CurrentNodeID, name)
+	if stub == nil {
+		execResp.Status = providers.ExecComplete
+		return execResp, nil
+	}
+
+	execResp.Status = stub.Status
+	if execResp.Status == "" {
+		execResp.Status = providers.ExecComplete
+	}
+	maps.Copy(execResp.RuntimeData, stub.RuntimeData)
+	maps.Copy(execResp.AdditionalData, stub.AdditionalData)
+	execResp.RedirectURL = stub.RedirectURL
+	execResp.Assertion = stub.Assertion
+	if stub.User != nil {
+		execResp.AuthUser = simulatedAuthUser(stub.User)
+	}
+	switch execResp.Status {
+	case providers.ExecUserInputRequired:
+		execResp.Inputs = requiredInputs
+	case providers.ExecFailure:
+		execResp.Error = simulatedFailure(stub.FailureReason)
+	}
+	return execResp, nil
+}
+
+// simulatedAuthUser builds the authenticated user reported by a stub.
+func simulatedAuthUser(user *SimulatedUser) providers.AuthUser {
+	attributes := make(map[string]*providers.AttributeResponse, len(user.Attributes))
+	for name, value := range user.Attributes {
+		attributes[name] = &providers.AttributeResponse{Value: value}
+	}
+	authUser := providers.AuthUser{}
+	authUser.SetStateFor(simulationAuthProviderName, providers.AuthState{
+		EntityReference: &providers.EntityReference{EntityID: user.ID},
+		Attributes:      &providers.AttributesResponse{Attributes: attributes},
+	})
+	return authUser
+}
+
+// simulatedFailure builds the error reported by a stub with a FAILURE outcome.
+func simulatedFailure(reason string) *tidcommon.ServiceError {
+	if reason == "" {
+		failure := ErrorSimulatedExecutorFailure
+		return &failure
+	}
+	return tidcommon.CustomServiceError(ErrorSimulatedExecutorFailure, tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.simulated_executor_failure_reason_description",
+		DefaultValue: "{{param(reason)}}",
+		Params:       map[string]string{"reason": reason},
+	})
+}
+
+// uncachedGraphBuilder builds a fresh graph for every flow a simulated run resolves, so the executors
+// set on its nodes never leak into the cached graphs used by real executions.
+type uncachedGraphBuilder struct {
+	graphbuilder.GraphBuilderInterface
+}
+
+// GetGraph builds the graph of the flow without consulting or populating the graph cache.
+func (b uncachedGraphBuilder) GetGraph(ctx context.Context, flow *providers.CompleteFlowDefinition) (
+	core.GraphInterface, *tidcommon.ServiceError) {
+	return b.BuildGraph(ctx, flow)
+}
+
+// noopInterceptorRunner lets a simulated run skip interceptors.
+type noopInterceptorRunner struct{}
+
+// runInterceptors completes without running any interceptor.
+func (noopInterceptorRunner) runInterceptors(providers.InterceptorMode, *InterceptorRunnerContext) (
+	*common.InterceptorResponse, *tidcommon.ServiceError) {
+	return &common.InterceptorResponse{Status: common.InterceptorStatusComplete}, nil
+}
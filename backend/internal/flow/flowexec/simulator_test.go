@@ -0,0 +1,292 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package flowexec
+
+import (
+	"context"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/graphbuilder"
+	"github.com/thunder-id/thunderid/internal/flow/interceptor"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+)
+
+type SimulatorTestSuite struct {
+	suite.Suite
+	simulator FlowSimulatorInterface
+}
+
+func TestSimulatorTestSuite(t *testing.T) {
+	suite.Run(t, new(SimulatorTestSuite))
+}
+
+func (s *SimulatorTestSuite) SetupTest() {
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		Server: engineconfig.ServerConfig{Identifier: "test-deployment"},
+	})
+
+	flowFactory, graphCache := core.Initialize(
+		cache.Initialize(config.GetServerRuntime().Config.Cache, "test-deployment"))
+	execRegistry, err := executor.Initialize(
+		executor.ExecutorDependencies{FlowFactory: flowFactory},
+		engineconfig.FlowConfig{Executors: []string{
+			executor.ExecutorNameCredentialsAuth,
+			executor.ExecutorNameScript,
+			executor.ExecutorNameAuthAssert,
+		}})
+	s.Require().NoError(err)
+	interceptorRegistry, err := interceptor.Initialize(
+		interceptor.InterceptorDependencies{FlowFactory: flowFactory}, engineconfig.FlowConfig{})
+	s.Require().NoError(err)
+
+	graphBuilder := graphbuilder.Initialize(flowFactory, execRegistry, interceptorRegistry, graphCache)
+	s.simulator = NewFlowSimulator(execRegistry, graphBuilder, nil)
+}
+
+// loginFlow prompts for credentials, authenticates them, tags the user with a script and issues an
+// assertion. A failed authentication returns to the prompt.
+func loginFlow() *providers.CompleteFlowDefinition {
+	return &providers.CompleteFlowDefinition{
+		ID:       "login-flow",
+		Handle:   "login",
+		Name:     "Login",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes: []providers.NodeDefinition{
+			{ID: "start", Type: "START", OnSuccess: "prompt_credentials"},
+			{
+				ID:   "prompt_credentials",
+				Type: "PROMPT",
+				Prompts: []providers.PromptDefinition{
+					{
+						Inputs: []providers.InputDefinition{
+							{Ref: "input_001", Identifier: "username", Type: "TEXT_INPUT", Required: true},
+							{Ref: "input_002", Identifier: "password", Type: "PASSWORD_INPUT", Required: true},
+						},
+						Action: &providers.ActionDefinition{Ref: "action_001", NextNode: "basic_auth"},
+					},
+				},
+			},
+			{
+				ID:        "basic_auth",
+				Type:      "TASK_EXECUTION",
+				Executor:  &providers.ExecutorDefinition{Name: executor.ExecutorNameCredentialsAuth},
+				OnSuccess: "tag_user",
+				OnFailure: "prompt_credentials",
+			},
+			{
+				ID:       "tag_user",
+				Type:     "TASK_EXECUTION",
+				Executor: &providers.ExecutorDefinition{Name: executor.ExecutorNameScript},
+				Properties: map[string]interface{}{
+					common.NodePropertyScript: `set runtime.tier = ` +
+						`inputs.username.startsWith("vip") ? "gold" : "standard"`,
+				},
+				OnSuccess: "auth_assert",
+			},
+			{
+				ID:        "auth_assert",
+				Type:      "TASK_EXECUTION",
+				Executor:  &providers.ExecutorDefinition{Name: executor.ExecutorNameAuthAssert},
+				OnSuccess: "end",
+			},
+			{ID: "end", Type: "END"},
+		},
+	}
+}
+
+func submitCredentials(username string) SimulationStep {
+	return SimulationStep{
+		Action: "action_001",
+		Inputs: map[string]string{"username": username, "password": "secret"},
+	}
+}
+
+func executedNodes(trace []SimulationTraceEntry) []string {
+	nodes := make([]string, 0, len(trace))
+	for _, entry := range trace {
+		if !entry.Skipped {
+			nodes = append(nodes, entry.NodeID)
+		}
+	}
+	return nodes
+}
+
+func (s *SimulatorTestSuite) TestSimulate_CompletesWithScriptedInputs() {
+	hasAssertion := true
+	scenario := &SimulationScenario{
+		Steps: []SimulationStep{submitCredentials("vip-alice")},
+		Stubs: []ExecutorStub{
+			{Executor: executor.ExecutorNameCredentialsAuth, User: &SimulatedUser{ID: "user-1"}},
+			{NodeID: "auth_assert", Assertion: "simulated-assertion"},
+		},
+		Expect: &SimulationExpectation{
+			Status:       providers.FlowStatusComplete,
+			Path:         []string{"prompt_credentials", "basic_auth", "tag_user", "auth_assert"},
+			RuntimeData:  map[string]string{"tier": "gold"},
+			HasAssertion: &hasAssertion,
+		},
+	}
+
+	result, svcErr := s.simulator.Simulate(context.Background(), loginFlow(), scenario)
+
+	s.Require().Nil(svcErr)
+	s.Equal(providers.FlowStatusComplete, result.Status)
+	s.Equal("simulated-assertion", result.Assertion)
+	s.Equal("gold", result.RuntimeData["tier"])
+	s.Require().NotNil(result.Passed)
+	s.True(*result.Passed, "unexpected failures: %v", result.Failures)
+
+	s.Require().Len(result.Prompts, 1)
+	prompt := result.Prompts[0]
+	s.Equal(0, prompt.Step)
+	s.Equal("prompt_credentials", prompt.NodeID)
+	s.Equal(common.StepTypeView, prompt.Type)
+	s.Len(prompt.Inputs, 2)
+	s.Require().Len(prompt.Actions, 1)
+	s.Equal("action_001", prompt.Actions[0].Ref)
+
+	s.Equal([]string{"start", "prompt_credentials", "prompt_credentials", "basic_auth", "tag_user", "auth_assert",
+		"end"}, executedNodes(result.Trace))
+	for _, entry := range result.Trace {
+		switch entry.NodeID {
+		case "basic_auth", "auth_assert":
+			s.True(entry.Stubbed, entry.NodeID)
+			s.Equal(1, entry.Step)
+		case "tag_user":
+			s.False(entry.Stubbed, "script executors run for real")
+			s.Equal(executor.ExecutorNameScript, entry.Executor)
+		}
+	}
+}
+
+func (s *SimulatorTestSuite) TestSimulate_StopsWhenStepsRunOut() {
+	result, svcErr := s.simulator.Simulate(context.Background(), loginFlow(), &SimulationScenario{})
+
+	s.Require().Nil(svcErr)
+	s.Equal(providers.FlowStatusIncomplete, result.Status)
+	s.Require().Len(result.Prompts, 1)
+	s.Equal("prompt_credentials", result.Prompts[0].NodeID)
+	s.Nil(result.Passed)
+}
+
+func (s *SimulatorTestSuite) TestSimulate_StubsAreUsedInOrder() {
+	scenario := &SimulationScenario{
+		Steps: []SimulationStep{submitCredentials("alice"), submitCredentials("alice")},
+		Stubs: []ExecutorStub{
+			{
+				Executor:      executor.ExecutorNameCredentialsAuth,
+				Status:        providers.ExecFailure,
+				FailureReason: "Invalid credentials",
+			},
+			{Executor: executor.ExecutorNameCredentialsAuth, User: &SimulatedUser{ID: "user-1"}},
+		},
+	}
+
+	result, svcErr := s.simulator.Simulate(context.Background(), loginFlow(), scenario)
+
+	s.Require().Nil(svcErr)
+	s.Equal(providers.FlowStatusComplete, result.Status)
+	s.Equal("standard", result.RuntimeData["tier"])
+	s.Require().Len(result.Prompts, 2)
+	s.Require().NotNil(result.Prompts[1].Error)
+	s.Equal(ErrorSimulatedExecutorFailure.Code, result.Prompts[1].Error.Code)
+	s.Equal("Invalid credentials", result.Prompts[1].Error.ErrorDescription.String())
+}
+
+func (s *SimulatorTestSuite) TestSimulate_ReportsUnmetExpectation() {
+	scenario := &SimulationScenario{
+		Steps: []SimulationStep{submitCredentials("alice")},
+		Expect: &SimulationExpectation{
+			Status:      providers.FlowStatusComplete,
+			Path:        []string{"auth_assert", "tag_user"},
+			RuntimeData: map[string]string{"tier": "gold"},
+		},
+	}
+
+	result, svcErr := s.simulator.Simulate(context.Background(), loginFlow(), scenario)
+
+	s.Require().Nil(svcErr)
+	s.Require().NotNil(result.Passed)
+	s.False(*result.Passed)
+	s.Equal([]string{
+		"expected node tag_user to be executed in the expected order",
+		`expected runtime data tier to be "gold", got "standard"`,
+	}, result.Failures)
+}
+
+func (s *SimulatorTestSuite) TestSimulate_EndsLoopingRun() {
+	flow := loginFlow()
+	// Bounce between two script nodes, so the flow never stops.
+	flow.Nodes[3].OnSuccess = "retag_user"
+	flow.Nodes = append(flow.Nodes, providers.NodeDefinition{
+		ID:         "retag_user",
+		Type:       "TASK_EXECUTION",
+		Executor:   &providers.ExecutorDefinition{Name: executor.ExecutorNameScript},
+		Properties: map[string]interface{}{common.NodePropertyScript: `set runtime.tier = "standard"`},
+		OnSuccess:  "tag_user",
+	})
+	scenario := &SimulationScenario{Steps: []SimulationStep{submitCredentials("alice")}}
+
+	result, svcErr := s.simulator.Simulate(context.Background(), flow, scenario)
+
+	s.Require().Nil(svcErr)
+	s.Equal(providers.FlowStatusError, result.Status)
+	s.Require().NotNil(result.Error)
+	s.Equal(ErrorSimulationLimitExceeded.Code, result.Error.Code)
+}
+
+func (s *SimulatorTestSuite) TestSimulate_InvalidScenario() {
+	testCases := []struct {
+		name string
+		stub ExecutorStub
+	}{
+		{"no target", ExecutorStub{}},
+		{"two targets", ExecutorStub{NodeID: "basic_auth", Executor: executor.ExecutorNameCredentialsAuth}},
+		{"unsupported status", ExecutorStub{NodeID: "basic_auth", Status: providers.ExecRetry}},
+		{"redirect without URL", ExecutorStub{NodeID: "basic_auth", Status: providers.ExecExternalRedirection}},
+		{"user without ID", ExecutorStub{NodeID: "basic_auth", User: &SimulatedUser{}}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result, svcErr := s.simulator.Simulate(context.Background(), loginFlow(),
+				&SimulationScenario{Stubs: []ExecutorStub{tc.stub}})
+
+			s.Nil(result)
+			s.Require().NotNil(svcErr)
+			s.Equal(ErrorInvalidSimulationScenario.Code, svcErr.Code)
+		})
+	}
+}
+
+func (s *SimulatorTestSuite) TestSimulate_TooManySteps() {
+	scenario := &SimulationScenario{Steps: make([]SimulationStep, maxSimulationSteps+1)}
+
+	result, svcErr := s.simulator.Simulate(context.Background(), loginFlow(), scenario)
+
+	s.Nil(result)
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorInvalidSimulationScenario.Code, svcErr.Code)
+}
+
+func (s *SimulatorTestSuite) TestSimulate_InvalidFlow() {
+	flow := loginFlow()
+	flow.Nodes[2].OnSuccess = "missing"
+
+	result, svcErr := s.simulator.Simulate(context.Background(), flow, &SimulationScenario{})
+
+	s.Nil(result)
+	s.Require().NotNil(svcErr)
+	s.Equal(tidcommon.ClientErrorType, svcErr.Type)
+}
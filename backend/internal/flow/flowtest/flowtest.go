@@ -0,0 +1,134 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package flowtest provides helpers for testing flow definitions from Go tests with the flow simulator.
+// Scenarios use the same format as the flow simulation API, so scenarios saved against a flow can be
+// exported and replayed as a regression suite whenever the flow definition changes.
+package flowtest
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	"github.com/thunder-id/thunderid/internal/flow/graphbuilder"
+	"github.com/thunder-id/thunderid/internal/flow/interceptor"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// deploymentID is the deployment the simulator runs in when the test has not initialized the server
+// runtime.
+const deploymentID = "flowtest"
+
+// NewSimulator creates a flow simulator with the given executors registered. Only register the
+// executors the flows under test use; executors that need server services to be constructed are not
+// supported. The server runtime is initialized with an empty configuration when the test has not
+// initialized it.
+func NewSimulator(t testing.TB, executorNames ...string) flowexec.FlowSimulatorInterface {
+	t.Helper()
+
+	if !config.IsServerRuntimeInitialized() {
+		if err := config.InitializeServerRuntime("", &config.Config{
+			Server: engineconfig.ServerConfig{Identifier: deploymentID},
+		}); err != nil {
+			t.Fatalf("failed to initialize the server runtime: %v", err)
+		}
+	}
+	runtime := config.GetServerRuntime()
+
+	cacheManager := cache.Initialize(runtime.Config.Cache, runtime.Config.Server.Identifier)
+	flowFactory, graphCache := core.Initialize(cacheManager)
+	execRegistry, err := executor.Initialize(executor.ExecutorDependencies{FlowFactory: flowFactory},
+		engineconfig.FlowConfig{Executors: executorNames})
+	if err != nil {
+		t.Fatalf("failed to initialize the executors: %v", err)
+	}
+	interceptorRegistry, err := interceptor.Initialize(
+		interceptor.InterceptorDependencies{FlowFactory: flowFactory}, engineconfig.FlowConfig{})
+	if err != nil {
+		t.Fatalf("failed to initialize the interceptors: %v", err)
+	}
+
+	graphBuilder := graphbuilder.Initialize(flowFactory, execRegistry, interceptorRegistry, graphCache)
+	return flowexec.NewFlowSimulator(execRegistry, graphBuilder, nil)
+}
+
+// LoadFlow reads a flow definition from a YAML or JSON file, such as a declarative flow file or a flow
+// returned by the flow management API.
+func LoadFlow(t testing.TB, path string) *providers.CompleteFlowDefinition {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read flow %s: %v", path, err)
+	}
+	var flow providers.CompleteFlowDefinition
+	if err := yaml.Unmarshal(data, &flow); err != nil {
+		t.Fatalf("failed to parse flow %s: %v", path, err)
+	}
+	return &flow
+}
+
+// LoadScenario reads a simulation scenario from a JSON file. The file holds either a scenario or a
+// saved scenario as returned by the simulation scenarios API.
+func LoadScenario(t testing.TB, path string) *flowexec.SimulationScenario {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read scenario %s: %v", path, err)
+	}
+	var saved struct {
+		Scenario *flowexec.SimulationScenario `json:"scenario"`
+	}
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatalf("failed to parse scenario %s: %v", path, err)
+	}
+	if saved.Scenario != nil {
+		return saved.Scenario
+	}
+
+	var scenario flowexec.SimulationScenario
+	if err := json.Unmarshal(data, &scenario); err != nil {
+		t.Fatalf("failed to parse scenario %s: %v", path, err)
+	}
+	return &scenario
+}
+
+// Run simulates the flow against the scenario and returns the result. The test fails when the run
+// cannot start, for instance because the flow or the scenario is invalid.
+func Run(t testing.TB, simulator flowexec.FlowSimulatorInterface, flow *providers.CompleteFlowDefinition,
+	scenario *flowexec.SimulationScenario) *flowexec.SimulationResult {
+	t.Helper()
+
+	result, svcErr := simulator.Simulate(t.Context(), flow, scenario)
+	if svcErr != nil {
+		t.Fatalf("failed to simulate flow %s: %s: %s", flow.Handle, svcErr.Code, svcErr.ErrorDescription.String())
+	}
+	return result
+}
+
+// AssertScenario simulates the flow against the scenario and fails the test for every unmet
+// expectation of the scenario. The scenario must have an expectation.
+func AssertScenario(t testing.TB, simulator flowexec.FlowSimulatorInterface,
+	flow *providers.CompleteFlowDefinition, scenario *flowexec.SimulationScenario) *flowexec.SimulationResult {
+	t.Helper()
+
+	if scenario.Expect == nil {
+		t.Fatalf("scenario for flow %s has no expectation", flow.Handle)
+	}
+	result := Run(t, simulator, flow, scenario)
+	for _, failure := range result.Failures {
+		t.Errorf("flow %s: %s", flow.Handle, failure)
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package flowtest
+
+import (
+	"testing"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+)
+
+type FlowTestTestSuite struct {
+	suite.Suite
+	simulator flowexec.FlowSimulatorInterface
+	flow      *providers.CompleteFlowDefinition
+}
+
+func TestFlowTestTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowTestTestSuite))
+}
+
+func (s *FlowTestTestSuite) SetupTest() {
+	s.simulator = NewSimulator(s.T(), executor.ExecutorNameCredentialsAuth, executor.ExecutorNameScript,
+		executor.ExecutorNameAuthAssert)
+	s.flow = LoadFlow(s.T(), "testdata/login-flow.yaml")
+}
+
+func (s *FlowTestTestSuite) TestLoadFlow() {
+	s.Equal("login", s.flow.Handle)
+	s.Equal(providers.FlowTypeAuthentication, s.flow.FlowType)
+	s.Len(s.flow.Nodes, 6)
+}
+
+func (s *FlowTestTestSuite) TestLoadScenario_SavedScenario() {
+	scenario := LoadScenario(s.T(), "testdata/vip-login.json")
+
+	s.Len(scenario.Steps, 1)
+	s.Require().NotNil(scenario.Expect)
+	s.Equal("gold", scenario.Expect.RuntimeData["tier"])
+}
+
+func (s *FlowTestTestSuite) TestAssertScenario() {
+	for _, path := range []string{"testdata/vip-login.json", "testdata/retry-login.json"} {
+		s.Run(path, func() {
+			result := AssertScenario(s.T(), s.simulator, s.flow, LoadScenario(s.T(), path))
+
+			s.Equal(providers.FlowStatusComplete, result.Status)
+		})
+	}
+}
+
+func (s *FlowTestTestSuite) TestRun_IncompleteScenario() {
+	result := Run(s.T(), s.simulator, s.flow, &flowexec.SimulationScenario{})
+
+	s.Equal(providers.FlowStatusIncomplete, result.Status)
+	s.Require().Len(result.Prompts, 1)
+	s.Equal("prompt_credentials", result.Prompts[0].NodeID)
+}
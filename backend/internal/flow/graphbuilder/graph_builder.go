@@ -15,6 +15,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/expression"
 	"github.com/thunder-id/thunderid/internal/flow/interceptor"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -89,6 +90,29 @@ func (b *graphBuilder) GetGraph(ctx context.Context, flow *providers.CompleteFlo
 	return graph, nil
 }
 
+// BuildGraph builds a new graph from the flow definition without consulting or populating the cache. The
+// returned graph is owned by the caller, so executors assigned to its nodes are never shared with flow
+// executions.
+func (b *graphBuilder) BuildGraph(ctx context.Context, flow *providers.CompleteFlowDefinition) (
+	core.GraphInterface, *tidcommon.ServiceError) {
+	if flow == nil || len(flow.Nodes) == 0 {
+		return nil, tidcommon.CustomServiceError(errorInvalidFlowData, tidcommon.I18nMessage{
+			Key:          "error.flow.graphbuilder.invalid_flow_data_nil_or_empty_description",
+			DefaultValue: "Flow definition is nil or has no nodes",
+		})
+	}
+
+	graph, err := b.buildGraph(ctx, flow)
+	if err != nil {
+		b.logger.Debug(ctx, "Failed to build graph", log.String("flowID", flow.ID), log.Error(err))
+		return nil, tidcommon.CustomServiceError(errorGraphBuildFailure, tidcommon.I18nMessage{
+			Key:          "error.flow.graphbuilder.graph_build_failure_description",
+			DefaultValue: err.Error(),
+		})
+	}
+	return graph, nil
+}
+
 // ValidateGraph builds the graph from the flow definition without caching, used for validation at create/update time.
 func (b *graphBuilder) ValidateGraph(
 	ctx context.Context, flow *providers.CompleteFlowDefinition,
@@ -166,7 +190,8 @@ func (b *graphBuilder) processNode(
 		nodeDef.OnFailure == "" &&
 		len(nodeDef.Prompts) == 0 &&
 		nodeDef.Next == "" &&
-		nodeDef.Flow == nil
+		nodeDef.Flow == nil &&
+		nodeDef.Decision == nil
 
 	// TODO: Temporarily add the call node validation here.
 	// Should be moved to flow validator once implemented.
@@ -202,6 +227,9 @@ func (b *graphBuilder) processNode(
 		return err
 	}
 	b.configureCallNodeReference(nodeDef, node)
+	if err := b.configureDecisionBranches(nodeDef, node, edges); err != nil {
+		return err
+	}
 
 	// Add node to the graph
 	if err := graph.AddNode(node); err != nil {
@@ -324,6 +352,45 @@ func (b *graphBuilder) configureCallNodeReference(nodeDef *providers.NodeDefinit
 	}
 }
 
+// configureDecisionBranches compiles the branch expressions of a DECISION node and records an edge for
+// every branch and for the default node.
+func (b *graphBuilder) configureDecisionBranches(nodeDef *providers.NodeDefinition, node core.NodeInterface,
+	edges map[string][]string) error {
+	decisionNode, isDecisionNode := node.(core.DecisionNodeInterface)
+	if nodeDef.Decision == nil {
+		if isDecisionNode {
+			return fmt.Errorf("DECISION node %s: 'decision' is required", nodeDef.ID)
+		}
+		return nil
+	}
+	if !isDecisionNode {
+		return fmt.Errorf("'decision' field is only valid on DECISION nodes, but node %s is of type %s",
+			nodeDef.ID, nodeDef.Type)
+	}
+
+	branches := make([]core.DecisionBranch, len(nodeDef.Decision.Branches))
+	for i, branchDef := range nodeDef.Decision.Branches {
+		condition, err := expression.Compile(branchDef.Expression)
+		if err != nil {
+			return fmt.Errorf("DECISION node %s, branch %q: invalid expression: %w", nodeDef.ID, branchDef.Label, err)
+		}
+		branches[i] = core.DecisionBranch{
+			Label:     branchDef.Label,
+			Condition: condition,
+			Next:      branchDef.Next,
+		}
+		edges[nodeDef.ID] = append(edges[nodeDef.ID], branchDef.Next)
+	}
+	decisionNode.SetBranches(branches)
+
+	if nodeDef.Decision.Default != "" {
+		decisionNode.SetDefault(nodeDef.Decision.Default)
+		edges[nodeDef.ID] = append(edges[nodeDef.ID], nodeDef.Decision.Default)
+	}
+
+	return nil
+}
+
 // configureNodeInputs configures the inputs for executor-backed nodes.
 // Validation rules on executor inputs are intentionally not propagated:
 // executor inputs are read from runtime context (already validated at the
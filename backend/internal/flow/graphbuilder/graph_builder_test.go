@@ -223,6 +223,70 @@ func (s *GraphBuilderTestSuite) TestGetGraph_CacheSetError() {
 	s.Equal(mockGraph, graph)
 }
 
+// Test BuildGraph method
+
+func (s *GraphBuilderTestSuite) TestBuildGraphUncached_NilFlow() {
+	graph, err := s.builder.BuildGraph(context.Background(), nil)
+
+	s.Nil(graph)
+	s.NotNil(err)
+	s.Equal(errorInvalidFlowData.Code, err.Code)
+}
+
+func (s *GraphBuilderTestSuite) TestBuildGraphUncached_BypassesCache() {
+	flow := &providers.CompleteFlowDefinition{
+		ID:       "flow-1",
+		Handle:   "test-handle",
+		Name:     "Test Flow",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes: []providers.NodeDefinition{
+			{ID: "start", Type: "START"},
+		},
+	}
+
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	mockStartNode := coremock.NewNodeInterfaceMock(s.T())
+
+	// The graph cache mock has no expectations, so any cache access fails the test.
+	s.mockFlowFactory.EXPECT().CreateGraph("flow-1", providers.FlowTypeAuthentication, 0).Return(mockGraph)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"start", "START", map[string]interface{}(nil), false, true).Return(mockStartNode, nil)
+	mockGraph.EXPECT().AddNode(mockStartNode).Return(nil)
+	mockGraph.EXPECT().GetNodes().Return(map[string]core.NodeInterface{"start": mockStartNode})
+	mockStartNode.EXPECT().GetType().Return(common.NodeTypeStart)
+	mockStartNode.EXPECT().GetID().Return("start")
+	mockGraph.EXPECT().SetStartNode("start").Return(nil)
+	mockGraph.EXPECT().SetInterceptors(mock.Anything)
+
+	graph, err := s.builder.BuildGraph(context.Background(), flow)
+
+	s.Nil(err)
+	s.Equal(mockGraph, graph)
+}
+
+func (s *GraphBuilderTestSuite) TestBuildGraphUncached_BuildFailure() {
+	flow := &providers.CompleteFlowDefinition{
+		ID:       "flow-1",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes: []providers.NodeDefinition{
+			{ID: "start", Type: "START"},
+		},
+	}
+
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	s.mockFlowFactory.EXPECT().CreateGraph("flow-1", providers.FlowTypeAuthentication, 0).Return(mockGraph)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"start", "START", map[string]interface{}(nil), false, true).Return(
+		nil, errors.New("node creation error"))
+
+	graph, err := s.builder.BuildGraph(context.Background(), flow)
+
+	s.Nil(graph)
+	s.NotNil(err)
+	s.Equal(errorGraphBuildFailure.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "node creation error")
+}
+
 // Test InvalidateCache method
 
 func (s *GraphBuilderTestSuite) TestInvalidateCache_EmptyFlowID() {
@@ -1914,6 +1978,166 @@ func (s *GraphBuilderTestSuite) TestBuildGraph_CallNode_OnFailureToNonPromptNode
 	s.Nil(err)
 }
 
+// DECISION node tests
+
+func (s *GraphBuilderTestSuite) TestBuildGraph_DecisionNode_ValidDefinition() {
+	flow := &providers.CompleteFlowDefinition{
+		ID:       "flow-1",
+		Handle:   "test-handle",
+		Name:     "Test Flow",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes: []providers.NodeDefinition{
+			{ID: "start", Type: "START", OnSuccess: "decision-1"},
+			{
+				ID:   "decision-1",
+				Type: "DECISION",
+				Decision: &providers.DecisionDefinition{
+					Branches: []providers.DecisionBranchDefinition{
+						{Label: "internal", Expression: `request.ip.inCIDR("10.0.0.0/8")`, Next: "end"},
+					},
+					Default: "end",
+				},
+			},
+			{ID: "end", Type: "END"},
+		},
+	}
+
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	mockStartNode := coremock.NewRepresentationNodeInterfaceMock(s.T())
+	mockDecisionNode := coremock.NewDecisionNodeInterfaceMock(s.T())
+	mockEndNode := coremock.NewRepresentationNodeInterfaceMock(s.T())
+
+	s.mockFlowFactory.EXPECT().CreateGraph(
+		"flow-1", providers.FlowTypeAuthentication, 0).Return(mockGraph)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"start", "START", map[string]interface{}(nil), false, false).Return(mockStartNode, nil)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"decision-1", "DECISION", map[string]interface{}(nil), false, false).Return(mockDecisionNode, nil)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"end", "END", map[string]interface{}(nil), false, true).Return(mockEndNode, nil)
+
+	mockStartNode.EXPECT().SetOnSuccess("decision-1")
+	mockDecisionNode.EXPECT().SetBranches(mock.MatchedBy(func(branches []core.DecisionBranch) bool {
+		return len(branches) == 1 && branches[0].Label == "internal" && branches[0].Next == "end" &&
+			branches[0].Condition != nil
+	}))
+	mockDecisionNode.EXPECT().SetDefault("end")
+	mockDecisionNode.EXPECT().GetType().Return(common.NodeTypeDecision).Maybe()
+
+	mockGraph.EXPECT().AddNode(mockStartNode).Return(nil)
+	mockGraph.EXPECT().AddNode(mockDecisionNode).Return(nil)
+	mockGraph.EXPECT().AddNode(mockEndNode).Return(nil)
+	mockGraph.EXPECT().AddEdge("start", "decision-1").Return(nil)
+	mockGraph.EXPECT().AddEdge("decision-1", "end").Return(nil).Times(2)
+	mockGraph.EXPECT().GetNodes().Return(
+		map[string]core.NodeInterface{"start": mockStartNode, "decision-1": mockDecisionNode, "end": mockEndNode})
+	mockStartNode.EXPECT().GetType().Return(common.NodeTypeStart)
+	mockEndNode.EXPECT().GetType().Return(common.NodeTypeEnd).Maybe()
+	mockStartNode.EXPECT().GetID().Return("start")
+	mockGraph.EXPECT().SetStartNode("start").Return(nil)
+	mockGraph.EXPECT().SetInterceptors(mock.Anything)
+
+	graph, err := s.builder.buildGraph(context.Background(), flow)
+
+	s.NotNil(graph)
+	s.Nil(err)
+}
+
+func (s *GraphBuilderTestSuite) TestBuildGraph_DecisionNode_InvalidExpression() {
+	flow := &providers.CompleteFlowDefinition{
+		ID:       "flow-1",
+		Handle:   "test-handle",
+		Name:     "Test Flow",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes: []providers.NodeDefinition{
+			{
+				ID:   "decision-1",
+				Type: "DECISION",
+				Decision: &providers.DecisionDefinition{
+					Branches: []providers.DecisionBranchDefinition{
+						{Label: "broken", Expression: `inputs.country ==`, Next: "end"},
+					},
+					Default: "end",
+				},
+			},
+			{ID: "end", Type: "END"},
+		},
+	}
+
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	mockDecisionNode := coremock.NewDecisionNodeInterfaceMock(s.T())
+	s.mockFlowFactory.EXPECT().CreateGraph(
+		"flow-1", providers.FlowTypeAuthentication, 0).Return(mockGraph)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"decision-1", "DECISION", map[string]interface{}(nil), false, false).Return(mockDecisionNode, nil)
+
+	graph, err := s.builder.buildGraph(context.Background(), flow)
+
+	s.Nil(graph)
+	s.NotNil(err)
+	s.Contains(err.Error(), "invalid expression")
+}
+
+func (s *GraphBuilderTestSuite) TestBuildGraph_DecisionNode_MissingDecision() {
+	flow := &providers.CompleteFlowDefinition{
+		ID:       "flow-1",
+		Handle:   "test-handle",
+		Name:     "Test Flow",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes: []providers.NodeDefinition{
+			{ID: "decision-1", Type: "DECISION"},
+			{ID: "end", Type: "END"},
+		},
+	}
+
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	mockDecisionNode := coremock.NewDecisionNodeInterfaceMock(s.T())
+	s.mockFlowFactory.EXPECT().CreateGraph(
+		"flow-1", providers.FlowTypeAuthentication, 0).Return(mockGraph)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"decision-1", "DECISION", map[string]interface{}(nil), false, true).Return(mockDecisionNode, nil)
+
+	graph, err := s.builder.buildGraph(context.Background(), flow)
+
+	s.Nil(graph)
+	s.NotNil(err)
+	s.Contains(err.Error(), "'decision' is required")
+}
+
+func (s *GraphBuilderTestSuite) TestBuildGraph_DecisionOnNonDecisionNode() {
+	flow := &providers.CompleteFlowDefinition{
+		ID:       "flow-1",
+		Handle:   "test-handle",
+		Name:     "Test Flow",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes: []providers.NodeDefinition{
+			{
+				ID:        "start",
+				Type:      "START",
+				OnSuccess: "end",
+				Decision: &providers.DecisionDefinition{
+					Branches: []providers.DecisionBranchDefinition{{Label: "a", Expression: "true", Next: "end"}},
+				},
+			},
+			{ID: "end", Type: "END"},
+		},
+	}
+
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	mockStartNode := coremock.NewRepresentationNodeInterfaceMock(s.T())
+	s.mockFlowFactory.EXPECT().CreateGraph(
+		"flow-1", providers.FlowTypeAuthentication, 0).Return(mockGraph)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"start", "START", map[string]interface{}(nil), false, false).Return(mockStartNode, nil)
+	mockStartNode.EXPECT().SetOnSuccess("end").Maybe()
+
+	graph, err := s.builder.buildGraph(context.Background(), flow)
+
+	s.Nil(graph)
+	s.NotNil(err)
+	s.Contains(err.Error(), "only valid on DECISION nodes")
+}
+
 // Invalid regex on a prompt input must fail the build with a useful error message.
 func (s *GraphBuilderTestSuite) TestConfigureNodePrompts_InvalidRegexFailsBuild() {
 	nodeDef := &providers.NodeDefinition{
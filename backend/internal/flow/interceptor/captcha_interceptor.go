@@ -5,6 +5,7 @@ package interceptor
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
@@ -87,6 +88,19 @@ func (c *captchaInterceptor) validateCaptchaToken(ctx *core.InterceptorContext)
 	}
 
 	return &common.InterceptorResponse{
-		Status: common.InterceptorStatusComplete,
+		Status:        common.InterceptorStatusComplete,
+		EngineOutputs: captchaVerdictOutputs(result),
 	}, nil
 }
+
+// captchaVerdictOutputs returns the verdict metadata of an accepted captcha token, recorded in the
+// flow's runtime data so that later nodes can branch on it.
+func captchaVerdictOutputs(result *providers.CaptchaVerificationResult) map[string]string {
+	outputs := map[string]string{
+		common.RuntimeKeyCaptchaStepUp: strconv.FormatBool(result.StepUpRequired),
+	}
+	if result.Score != nil {
+		outputs[common.RuntimeKeyCaptchaScore] = strconv.FormatFloat(*result.Score, 'f', -1, 64)
+	}
+	return outputs
+}
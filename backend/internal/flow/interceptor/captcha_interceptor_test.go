@@ -110,6 +110,45 @@ func (s *CaptchaInterceptorTestSuite) TestExecute_ValidToken_Passes() {
 	assert.Equal(s.T(), common.InterceptorStatusComplete, result.Status)
 }
 
+func (s *CaptchaInterceptorTestSuite) TestExecute_ValidToken_ReportsVerdictMetadata() {
+	testCases := []struct {
+		name     string
+		result   *providers.CaptchaVerificationResult
+		expected map[string]string
+	}{
+		{
+			name:     "Unscored",
+			result:   &providers.CaptchaVerificationResult{Success: true},
+			expected: map[string]string{common.RuntimeKeyCaptchaStepUp: "false"},
+		},
+		{
+			name:   "LowScore",
+			result: &providers.CaptchaVerificationResult{Success: true, Score: ptrFloat(0.3), StepUpRequired: true},
+			expected: map[string]string{
+				common.RuntimeKeyCaptchaScore:  "0.3",
+				common.RuntimeKeyCaptchaStepUp: "true",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.captchaService.On("Verify", mock.Anything, "token-"+tc.name).Return(tc.result, nil).Once()
+			ctx := &core.InterceptorContext{
+				Mode:        providers.InterceptorModePreNode,
+				ExecutionID: "exec-1",
+				UserInputs:  map[string]string{captchaTokenFieldKey: "token-" + tc.name},
+			}
+
+			result, err := s.interceptor.Execute(ctx)
+
+			assert.NoError(s.T(), err)
+			assert.Equal(s.T(), common.InterceptorStatusComplete, result.Status)
+			assert.Equal(s.T(), tc.expected, result.EngineOutputs)
+		})
+	}
+}
+
 func (s *CaptchaInterceptorTestSuite) TestExecute_NegativeVerdict_Fails() {
 	s.captchaService.On("Verify", mock.Anything, "bad-token").
 		Return(&providers.CaptchaVerificationResult{Success: false}, nil)
@@ -173,3 +212,7 @@ func newCaptchaMockFlowFactory(t interface {
 
 	return factoryMock
 }
+
+func ptrFloat(v float64) *float64 {
+	return &v
+}
@@ -9,6 +9,7 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -1058,6 +1059,46 @@ func (_c *FlowMgtServiceInterfaceMock_RestoreFlowVersion_Call) RunAndReturn(run
 	return _c
 }
 
+// SetAuditRecorder provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) SetAuditRecorder(recorder *audit.Recorder) {
+	_mock.Called(recorder)
+	return
+}
+
+// FlowMgtServiceInterfaceMock_SetAuditRecorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAuditRecorder'
+type FlowMgtServiceInterfaceMock_SetAuditRecorder_Call struct {
+	*mock.Call
+}
+
+// SetAuditRecorder is a helper method to define mock.On call
+//   - recorder *audit.Recorder
+func (_e *FlowMgtServiceInterfaceMock_Expecter) SetAuditRecorder(recorder interface{}) *FlowMgtServiceInterfaceMock_SetAuditRecorder_Call {
+	return &FlowMgtServiceInterfaceMock_SetAuditRecorder_Call{Call: _e.mock.On("SetAuditRecorder", recorder)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_SetAuditRecorder_Call) Run(run func(recorder *audit.Recorder)) *FlowMgtServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *audit.Recorder
+		if args[0] != nil {
+			arg0 = args[0].(*audit.Recorder)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_SetAuditRecorder_Call) Return() *FlowMgtServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_SetAuditRecorder_Call) RunAndReturn(run func(recorder *audit.Recorder)) *FlowMgtServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetDependencyRegistry provides a mock function for the type FlowMgtServiceInterfaceMock
 func (_mock *FlowMgtServiceInterfaceMock) SetDependencyRegistry(r resourcedependency.Registry) {
 	_mock.Called(r)
@@ -40,6 +40,76 @@ func (_m *graphBuilderInterfaceMock) EXPECT() *graphBuilderInterfaceMock_Expecte
 	return &graphBuilderInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// BuildGraph provides a mock function for the type graphBuilderInterfaceMock
+func (_mock *graphBuilderInterfaceMock) BuildGraph(ctx context.Context, flow *providers.CompleteFlowDefinition) (core.GraphInterface, *tidcommon.ServiceError) {
+	ret := _mock.Called(ctx, flow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildGraph")
+	}
+
+	var r0 core.GraphInterface
+	var r1 *tidcommon.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *providers.CompleteFlowDefinition) (core.GraphInterface, *tidcommon.ServiceError)); ok {
+		return returnFunc(ctx, flow)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *providers.CompleteFlowDefinition) core.GraphInterface); ok {
+		r0 = returnFunc(ctx, flow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.GraphInterface)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *providers.CompleteFlowDefinition) *tidcommon.ServiceError); ok {
+		r1 = returnFunc(ctx, flow)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*tidcommon.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// graphBuilderInterfaceMock_BuildGraph_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildGraph'
+type graphBuilderInterfaceMock_BuildGraph_Call struct {
+	*mock.Call
+}
+
+// BuildGraph is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flow *providers.CompleteFlowDefinition
+func (_e *graphBuilderInterfaceMock_Expecter) BuildGraph(ctx interface{}, flow interface{}) *graphBuilderInterfaceMock_BuildGraph_Call {
+	return &graphBuilderInterfaceMock_BuildGraph_Call{Call: _e.mock.On("BuildGraph", ctx, flow)}
+}
+
+func (_c *graphBuilderInterfaceMock_BuildGraph_Call) Run(run func(ctx context.Context, flow *providers.CompleteFlowDefinition)) *graphBuilderInterfaceMock_BuildGraph_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *providers.CompleteFlowDefinition
+		if args[1] != nil {
+			arg1 = args[1].(*providers.CompleteFlowDefinition)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *graphBuilderInterfaceMock_BuildGraph_Call) Return(graphInterface core.GraphInterface, serviceError *tidcommon.ServiceError) *graphBuilderInterfaceMock_BuildGraph_Call {
+	_c.Call.Return(graphInterface, serviceError)
+	return _c
+}
+
+func (_c *graphBuilderInterfaceMock_BuildGraph_Call) RunAndReturn(run func(ctx context.Context, flow *providers.CompleteFlowDefinition) (core.GraphInterface, *tidcommon.ServiceError)) *graphBuilderInterfaceMock_BuildGraph_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGraph provides a mock function for the type graphBuilderInterfaceMock
 func (_mock *graphBuilderInterfaceMock) GetGraph(ctx context.Context, flow *providers.CompleteFlowDefinition) (core.GraphInterface, *tidcommon.ServiceError) {
 	ret := _mock.Called(ctx, flow)
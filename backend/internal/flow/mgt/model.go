@@ -65,6 +65,20 @@ type FlowVersion struct {
 	CreatedAt    string                            `json:"createdAt"`
 }
 
+// ToCompleteFlowDefinition returns the flow definition as it was at this version, with the version as its
+// active version.
+func (v *FlowVersion) ToCompleteFlowDefinition() *providers.CompleteFlowDefinition {
+	return &providers.CompleteFlowDefinition{
+		ID:            v.ID,
+		Handle:        v.Handle,
+		Name:          v.Name,
+		FlowType:      providers.FlowType(v.FlowType),
+		ActiveVersion: v.Version,
+		Interceptors:  v.Interceptors,
+		Nodes:         v.Nodes,
+	}
+}
+
 // FlowVersionListResponse represents a list of flow versions.
 type FlowVersionListResponse struct {
 	TotalVersions int                `json:"totalVersions"`
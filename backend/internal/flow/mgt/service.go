@@ -19,6 +19,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/executor"
 	"github.com/thunder-id/thunderid/internal/flow/graphbuilder"
 	"github.com/thunder-id/thunderid/internal/flow/interceptor"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
@@ -55,6 +56,7 @@ type FlowMgtServiceInterface interface {
 	IsValidFlow(ctx context.Context, flowID string, flowType providers.FlowType) (bool, *tidcommon.ServiceError)
 	GetReachableCallTargets(ctx context.Context, flowID string) ([]CallTarget, *tidcommon.ServiceError)
 	SetDependencyRegistry(r resourcedependency.Registry)
+	SetAuditRecorder(recorder *audit.Recorder)
 	GetFlowUsages(ctx context.Context, flowID string) (
 		*resourcedependency.DependenciesResponse, *tidcommon.ServiceError)
 	GetResourceDependencies(
@@ -81,6 +83,7 @@ type flowMgtService struct {
 	compositeStore      *compositeFlowStore
 	transactioner       providers.Transactioner
 	dependencyRegistry  resourcedependency.Registry
+	auditRecorder       *audit.Recorder
 	serverConfigSvc     serverConfigProvider
 	ouSvc               ouProvider
 	logger              *log.Logger
@@ -203,6 +206,7 @@ func (s *flowMgtService) CreateFlow(ctx context.Context, flowDef *FlowDefinition
 	}
 
 	s.logger.Debug(ctx, "Flow created successfully", log.String(logKeyFlowID, flowID))
+	s.auditRecorder.RecordCreate(ctx, audit.ResourceTypeFlow, flowID, createdFlow)
 
 	s.tryInferRegistrationFlow(ctx, flowID, flowDef)
 
@@ -288,6 +292,12 @@ func (s *flowMgtService) SetDependencyRegistry(r resourcedependency.Registry) {
 	s.dependencyRegistry = r
 }
 
+// SetAuditRecorder injects the recorder that flow mutations are audited through. Called by
+// servicemanager once the audit service is initialized.
+func (s *flowMgtService) SetAuditRecorder(recorder *audit.Recorder) {
+	s.auditRecorder = recorder
+}
+
 // GetFlowUsages returns the resources that reference this flow.
 func (s *flowMgtService) GetFlowUsages(
 	ctx context.Context, flowID string) (*resourcedependency.DependenciesResponse, *tidcommon.ServiceError) {
@@ -411,7 +421,7 @@ func (s *flowMgtService) UpdateFlow(ctx context.Context, flowID string, flowDef
 
 	logger := s.logger.With(log.String(logKeyFlowID, flowID))
 
-	var updatedFlow *providers.CompleteFlowDefinition
+	var previousFlow, updatedFlow *providers.CompleteFlowDefinition
 	var validationSvcErr *tidcommon.ServiceError
 	var storeWriteAttempted bool
 	var existingHandle string
@@ -421,6 +431,7 @@ func (s *flowMgtService) UpdateFlow(ctx context.Context, flowID string, flowDef
 		if err != nil {
 			return err
 		}
+		previousFlow = existingFlow
 		existingHandle = existingFlow.Handle
 		existingType = existingFlow.FlowType
 
@@ -486,6 +497,7 @@ func (s *flowMgtService) UpdateFlow(ctx context.Context, flowID string, flowDef
 	}
 
 	logger.Debug(ctx, "Flow updated successfully")
+	s.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeFlow, flowID, previousFlow, updatedFlow)
 
 	return updatedFlow, nil
 }
@@ -519,6 +531,7 @@ func (s *flowMgtService) DeleteFlow(ctx context.Context, flowID string) *tidcomm
 	}
 
 	logger.Debug(ctx, "Flow deleted successfully")
+	s.auditRecorder.RecordDelete(ctx, audit.ResourceTypeFlow, flowID, existingFlow)
 
 	// Invalidate the cached graph since the flow has been deleted
 	s.graphBuilder.InvalidateCache(ctx, flowID)
@@ -621,6 +634,8 @@ func (s *flowMgtService) RestoreFlowVersion(ctx context.Context, flowID string,
 	}
 
 	logger.Debug(ctx, "Flow version restored successfully")
+	s.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeFlow, flowID, nil,
+		map[string]int{"restoredVersion": version})
 
 	// Invalidate the cached graph since a version has been restored
 	s.graphBuilder.InvalidateCache(ctx, flowID)
@@ -34,6 +34,8 @@ var (
 			`UPDATED_AT = datetime('now') WHERE ID = $1 AND DEPLOYMENT_ID = $4`,
 		PostgresQuery: `UPDATE "FLOW" SET NAME = $2, ACTIVE_VERSION = $3, ` +
 			`UPDATED_AT = CURRENT_TIMESTAMP WHERE ID = $1 AND DEPLOYMENT_ID = $4`,
+		MySQLQuery: `UPDATE "FLOW" SET NAME = $2, ACTIVE_VERSION = $3, ` +
+			`UPDATED_AT = CURRENT_TIMESTAMP WHERE ID = $1 AND DEPLOYMENT_ID = $4`,
 	}
 
 	// queryListFlows is the query to retrieves a list of flow definitions.
@@ -122,6 +124,8 @@ var (
 		ID: "FLQ-FLOW_MGT-15",
 		Query: `DELETE FROM "FLOW_VERSION" WHERE FLOW_ID = $1 AND DEPLOYMENT_ID = $2 AND ` +
 			`VERSION = (SELECT MIN(VERSION) FROM "FLOW_VERSION" WHERE FLOW_ID = $1 AND DEPLOYMENT_ID = $2)`,
+		MySQLQuery: `DELETE FROM "FLOW_VERSION" WHERE FLOW_ID = $1 AND DEPLOYMENT_ID = $2 ` +
+			`ORDER BY VERSION LIMIT 1`,
 	}
 
 	// queryCheckFlowExistsByHandle is the query to check if a flow exists by handle and flow type.
@@ -67,8 +67,9 @@ Prerequisites:
 Key Requirements:
 - Handle: Lowercase alphanumeric, dashes/underscores allowed (not at start/end). Unique per flow type.
 - Structure: Must include START and END nodes with at least one functional node in between.
-- Node types: START, END, TASK_EXECUTION, PROMPT.
+- Node types: START, END, TASK_EXECUTION, PROMPT, DECISION.
 - PROMPT nodes: Require 'meta.components' array for UI rendering.
+- DECISION nodes: Route with 'decision.branches' (label, expression, next) evaluated in order, plus 'decision.default'.
 - Transitions: Use onSuccess/onFailure node IDs to define the path.`,
 		InputSchema: getCreateFlowSchema(),
 		Annotations: &mcp.ToolAnnotations{
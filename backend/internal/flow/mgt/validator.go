@@ -10,8 +10,10 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/expression"
 	"github.com/thunder-id/thunderid/internal/flow/graphbuilder"
 	"github.com/thunder-id/thunderid/internal/flow/interceptor"
+	"github.com/thunder-id/thunderid/internal/flow/script"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
@@ -370,6 +372,20 @@ func collectAllNodeReferences(nodes []providers.NodeDefinition) []nodeReference
 				})
 			}
 		}
+		if node.Decision != nil {
+			for _, branch := range node.Decision.Branches {
+				if branch.Next != "" {
+					refs = append(refs, nodeReference{
+						sourceNodeID: node.ID, targetNodeID: branch.Next, fieldName: "decision.branches.next",
+					})
+				}
+			}
+			if node.Decision.Default != "" {
+				refs = append(refs, nodeReference{
+					sourceNodeID: node.ID, targetNodeID: node.Decision.Default, fieldName: "decision.default",
+				})
+			}
+		}
 	}
 	return refs
 }
@@ -503,6 +519,16 @@ func buildAdjacencyList(nodes []providers.NodeDefinition) map[string][]string {
 				adj[node.ID] = append(adj[node.ID], prompt.Action.NextNode)
 			}
 		}
+		if node.Decision != nil {
+			for _, branch := range node.Decision.Branches {
+				if branch.Next != "" {
+					adj[node.ID] = append(adj[node.ID], branch.Next)
+				}
+			}
+			if node.Decision.Default != "" {
+				adj[node.ID] = append(adj[node.ID], node.Decision.Default)
+			}
+		}
 	}
 	return adj
 }
@@ -538,6 +564,14 @@ func (v *flowValidator) validateNodes(
 func (v *flowValidator) validateNodeFormat(
 	node *providers.NodeDefinition, nodeIndex map[string]*providers.NodeDefinition,
 ) *tidcommon.ServiceError {
+	if node.Decision != nil && node.Type != string(common.NodeTypeDecision) {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.decision_on_non_decision_node_description",
+			DefaultValue: "Node '{{param(nodeID)}}' must not have decision unless it is a DECISION node",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+
 	switch node.Type {
 	case string(common.NodeTypeStart):
 		return v.validateStartNode(node)
@@ -549,6 +583,8 @@ func (v *flowValidator) validateNodeFormat(
 		return v.validatePromptNode(node)
 	case string(common.NodeTypeCall):
 		return v.validateCallNode(node)
+	case string(common.NodeTypeDecision):
+		return v.validateDecisionNode(node)
 	}
 	return nil
 }
@@ -832,6 +868,64 @@ func (v *flowValidator) validateCallNode(node *providers.NodeDefinition) *tidcom
 	return nil
 }
 
+// validateDecisionNode validates the format of a DECISION node. Every branch must carry a unique label,
+// an expression that compiles and a target node, and a default node must be set so that the flow
+// always has somewhere to go when no branch matches.
+func (v *flowValidator) validateDecisionNode(node *providers.NodeDefinition) *tidcommon.ServiceError {
+	if node.Decision == nil || len(node.Decision.Branches) == 0 {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.decision_node_missing_branches_description",
+			DefaultValue: "DECISION node '{{param(nodeID)}}' must have at least one decision branch",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.Decision.Default == "" {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.decision_node_missing_default_description",
+			DefaultValue: "DECISION node '{{param(nodeID)}}' must have a default node",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.Executor != nil || len(node.Prompts) > 0 || node.Flow != nil || node.OnSuccess != "" ||
+		node.OnFailure != "" || node.OnIncomplete != "" || node.Next != "" {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key: "error.flowmgtservice.decision_node_has_inapplicable_properties_description",
+			DefaultValue: "DECISION node '{{param(nodeID)}}' must only route through its decision branches " +
+				"and default; executor, prompts, flow, onSuccess, onFailure, onIncomplete and next are not allowed",
+			Params: map[string]string{"nodeID": node.ID},
+		})
+	}
+
+	labels := make(map[string]bool, len(node.Decision.Branches))
+	for _, branch := range node.Decision.Branches {
+		if branch.Label == "" || branch.Next == "" {
+			return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+				Key:          "error.flowmgtservice.decision_branch_incomplete_description",
+				DefaultValue: "Every branch of DECISION node '{{param(nodeID)}}' must have a label and a next node",
+				Params:       map[string]string{"nodeID": node.ID},
+			})
+		}
+		if labels[branch.Label] {
+			return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+				Key:          "error.flowmgtservice.decision_branch_duplicate_label_description",
+				DefaultValue: "DECISION node '{{param(nodeID)}}' has more than one branch labeled '{{param(label)}}'",
+				Params:       map[string]string{"nodeID": node.ID, "label": branch.Label},
+			})
+		}
+		labels[branch.Label] = true
+
+		if _, err := expression.Compile(branch.Expression); err != nil {
+			return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+				Key: "error.flowmgtservice.decision_branch_invalid_expression_description",
+				DefaultValue: "Branch '{{param(label)}}' of DECISION node '{{param(nodeID)}}' has an invalid " +
+					"expression: {{param(error)}}",
+				Params: map[string]string{"nodeID": node.ID, "label": branch.Label, "error": err.Error()},
+			})
+		}
+	}
+	return nil
+}
+
 // ---------------------------------------------------------------------------
 // Scope: Executor validation
 // ---------------------------------------------------------------------------
@@ -882,6 +976,8 @@ func (v *flowValidator) validateExecutorSpecificConstraints(
 		return v.validateSSOCheckExecutor(node, nodeIndex)
 	case executor.ExecutorNameSession:
 		return v.validateSessionExecutor(node, nodes)
+	case executor.ExecutorNameScript:
+		return v.validateScriptExecutor(node)
 	}
 	return nil
 }
@@ -1053,6 +1149,28 @@ func (v *flowValidator) validateSSOCheckExecutor(
 	return nil
 }
 
+// validateScriptExecutor validates that a ScriptExecutor node's script compiles and that its timeout,
+// if set, is within the allowed range, so that a broken script is rejected when the flow is saved
+// rather than when it runs.
+func (v *flowValidator) validateScriptExecutor(node *providers.NodeDefinition) *tidcommon.ServiceError {
+	source, _ := node.Properties[common.NodePropertyScript].(string)
+	if _, err := script.Compile(source); err != nil {
+		return tidcommon.CustomServiceError(ErrorInvalidExecutorConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.script_executor_invalid_script_description",
+			DefaultValue: "ScriptExecutor node '{{param(nodeID)}}' has an invalid script: {{param(error)}}",
+			Params:       map[string]string{"nodeID": node.ID, "error": err.Error()},
+		})
+	}
+	if _, err := script.ParseTimeout(node.Properties[common.NodePropertyScriptTimeout]); err != nil {
+		return tidcommon.CustomServiceError(ErrorInvalidExecutorConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.script_executor_invalid_timeout_description",
+			DefaultValue: "ScriptExecutor node '{{param(nodeID)}}' has an invalid timeoutMs: {{param(error)}}",
+			Params:       map[string]string{"nodeID": node.ID, "error": err.Error()},
+		})
+	}
+	return nil
+}
+
 // validateSessionExecutor validates that a SessionExecutor node is referenced by at least one
 // SSOCheckExecutor via checkpointRef.
 func (v *flowValidator) validateSessionExecutor(
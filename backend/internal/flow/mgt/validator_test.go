@@ -833,6 +833,103 @@ func (s *ValidatorTestSuite) TestValidateCallNode_HasOnIncomplete() {
 	s.Contains(err.ErrorDescription.DefaultValue, "must not have onIncomplete")
 }
 
+// ---------------------------------------------------------------------------
+// validateDecisionNode
+// ---------------------------------------------------------------------------
+
+func validDecisionNode() *providers.NodeDefinition {
+	return &providers.NodeDefinition{
+		ID:   "decision",
+		Type: string(common.NodeTypeDecision),
+		Decision: &providers.DecisionDefinition{
+			Branches: []providers.DecisionBranchDefinition{
+				{Label: "internal", Expression: `request.ip.inCIDR("10.0.0.0/8")`, Next: "task"},
+				{Label: "verified", Expression: `has(claims.email_verified) && claims.email_verified`, Next: "end"},
+			},
+			Default: "end",
+		},
+	}
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_Valid() {
+	err := s.v.validateDecisionNode(validDecisionNode())
+	s.Nil(err)
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_MissingDecision() {
+	node := validDecisionNode()
+	node.Decision = nil
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "at least one decision branch")
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_MissingDefault() {
+	node := validDecisionNode()
+	node.Decision.Default = ""
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "default node")
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_HasOnSuccess() {
+	node := validDecisionNode()
+	node.OnSuccess = "end"
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "onSuccess")
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_BranchMissingNext() {
+	node := validDecisionNode()
+	node.Decision.Branches[0].Next = ""
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "label and a next node")
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_DuplicateLabel() {
+	node := validDecisionNode()
+	node.Decision.Branches[1].Label = "internal"
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Equal("internal", err.ErrorDescription.Params["label"])
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_InvalidExpression() {
+	node := validDecisionNode()
+	node.Decision.Branches[0].Expression = `exec("rm -rf /")`
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.Params["error"], `unknown function "exec"`)
+}
+
+func (s *ValidatorTestSuite) TestValidateNodeFormat_DecisionOnNonDecisionNode() {
+	node := &providers.NodeDefinition{
+		ID: "start", Type: string(common.NodeTypeStart), OnSuccess: "end",
+		Decision: &providers.DecisionDefinition{Default: "end"},
+	}
+	err := s.v.validateNodeFormat(node, nil)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "unless it is a DECISION node")
+}
+
+func (s *ValidatorTestSuite) TestValidateStructure_DecisionBranchesAreEdges() {
+	nodes := minimalValidNodes()
+	nodes[0].OnSuccess = "decision"
+	nodes = append(nodes, *validDecisionNode())
+
+	_, err := s.v.validateStructure(nodes)
+	s.Nil(err)
+
+	nodes[3].Decision.Default = "nonexistent"
+	_, err = s.v.validateStructure(nodes)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeReference.Code, err.Code)
+	s.Equal("decision.default", err.ErrorDescription.Params["fieldName"])
+}
+
 // ---------------------------------------------------------------------------
 // validateInputDefinitions
 // ---------------------------------------------------------------------------
@@ -1862,3 +1959,51 @@ func (s *ValidatorTestSuite) TestValidateSessionExecutor_MultiplePairsValid() {
 	err = s.v.validateSessionExecutor(&nodes[3], nodes)
 	s.Nil(err)
 }
+
+// ---------------------------------------------------------------------------
+// Tests for validateScriptExecutor
+// ---------------------------------------------------------------------------
+
+func scriptNode(properties map[string]interface{}) *providers.NodeDefinition {
+	return &providers.NodeDefinition{
+		ID:         "normalize",
+		Type:       string(common.NodeTypeTaskExecution),
+		Executor:   &providers.ExecutorDefinition{Name: executor.ExecutorNameScript},
+		Properties: properties,
+		OnSuccess:  "end",
+	}
+}
+
+func (s *ValidatorTestSuite) TestValidateScriptExecutor_Valid() {
+	node := scriptNode(map[string]interface{}{
+		common.NodePropertyScript:        "set runtime.mobile = inputs.mobile.trim()",
+		common.NodePropertyScriptTimeout: float64(250),
+	})
+	err := s.v.validateScriptExecutor(node)
+	s.Nil(err)
+}
+
+func (s *ValidatorTestSuite) TestValidateScriptExecutor_MissingScript() {
+	err := s.v.validateScriptExecutor(scriptNode(map[string]interface{}{}))
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidExecutorConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.Params["error"], "script has no statements")
+}
+
+func (s *ValidatorTestSuite) TestValidateScriptExecutor_InvalidScript() {
+	err := s.v.validateScriptExecutor(scriptNode(map[string]interface{}{
+		common.NodePropertyScript: "let x = 1\nset runtime.y = exec(x)",
+	}))
+	s.Require().NotNil(err)
+	s.Equal("normalize", err.ErrorDescription.Params["nodeID"])
+	s.Contains(err.ErrorDescription.Params["error"], `line 2: unknown function "exec"`)
+}
+
+func (s *ValidatorTestSuite) TestValidateScriptExecutor_InvalidTimeout() {
+	err := s.v.validateScriptExecutor(scriptNode(map[string]interface{}{
+		common.NodePropertyScript:        `outcome "a"`,
+		common.NodePropertyScriptTimeout: float64(5000),
+	}))
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "invalid timeoutMs")
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"errors"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Internal store errors.
+var (
+	// ErrNotFound is returned when the flow has no rollout.
+	ErrNotFound = errors.New("flow rollout not found")
+)
+
+// Client errors for flow rollout operations.
+var (
+	// ErrorInvalidRequestFormat indicates a malformed request body.
+	ErrorInvalidRequestFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FRO-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowrolloutservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowrolloutservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorRolloutNotFound indicates the flow has no rollout.
+	ErrorRolloutNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FRO-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowrolloutservice.rollout_not_found",
+			DefaultValue: "Flow rollout not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowrolloutservice.rollout_not_found_description",
+			DefaultValue: "No rollout is in progress for the flow",
+		},
+	}
+	// ErrorInvalidPercentage indicates a rollout percentage outside 0 to 100.
+	ErrorInvalidPercentage = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FRO-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowrolloutservice.invalid_percentage",
+			DefaultValue: "Invalid rollout percentage",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowrolloutservice.invalid_percentage_description",
+			DefaultValue: "The percentage must be between 0 and 100",
+		},
+	}
+	// ErrorInvalidVersions indicates a rollout without a stable version, or with a candidate version
+	// equal to the stable version.
+	ErrorInvalidVersions = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FRO-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowrolloutservice.invalid_versions",
+			DefaultValue: "Invalid rollout versions",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowrolloutservice.invalid_versions_description",
+			DefaultValue: "The stable version is required and the candidate version must differ from it",
+		},
+	}
+)
@@ -0,0 +1,107 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const rolloutPath = "/flows/{flowId}/rollout"
+
+// rolloutHandler serves the flow rollout API.
+type rolloutHandler struct {
+	service RolloutServiceInterface
+}
+
+// newRolloutHandler creates a new instance of rolloutHandler.
+func newRolloutHandler(service RolloutServiceInterface) *rolloutHandler {
+	return &rolloutHandler{service: service}
+}
+
+// HandleGetRollout returns the rollout in progress for a flow.
+func (h *rolloutHandler) HandleGetRollout(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	rollout, svcErr := h.service.GetRollout(r.Context(), flowID)
+	if svcErr != nil {
+		writeRolloutError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, rollout)
+}
+
+// HandleSetRollout starts or replaces the rollout of a flow.
+func (h *rolloutHandler) HandleSetRollout(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	req, err := sysutils.DecodeJSONBody[RolloutConfig](r)
+	if err != nil {
+		writeRolloutError(r.Context(), w, &ErrorInvalidRequestFormat)
+		return
+	}
+	rollout, svcErr := h.service.SetRollout(r.Context(), flowID, sanitizeRolloutConfig(req))
+	if svcErr != nil {
+		writeRolloutError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, rollout)
+}
+
+// HandleDeleteRollout ends the rollout of a flow.
+func (h *rolloutHandler) HandleDeleteRollout(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	if svcErr := h.service.DeleteRollout(r.Context(), flowID); svcErr != nil {
+		writeRolloutError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// sanitizeRolloutConfig returns a copy of the config with blank identifiers dropped and the rest
+// trimmed.
+func sanitizeRolloutConfig(config *RolloutConfig) *RolloutConfig {
+	sanitized := *config
+	sanitized.ApplicationIDs = trimIDs(config.ApplicationIDs)
+	if config.Cohort != nil {
+		sanitized.Cohort = &Cohort{
+			OUIDs:     trimIDs(config.Cohort.OUIDs),
+			UserTypes: trimIDs(config.Cohort.UserTypes),
+		}
+	}
+	return &sanitized
+}
+
+// trimIDs trims the given identifiers and drops the blank ones.
+func trimIDs(ids []string) []string {
+	var trimmed []string
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); id != "" {
+			trimmed = append(trimmed, id)
+		}
+	}
+	return trimmed
+}
+
+// writeRolloutError maps a service error to an HTTP status and writes the corresponding error response.
+func writeRolloutError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	status := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		status = http.StatusBadRequest
+		if svcErr.Code == ErrorRolloutNotFound.Code || svcErr.Code == flowmgt.ErrorFlowNotFound.Code ||
+			svcErr.Code == flowmgt.ErrorVersionNotFound.Code {
+			status = http.StatusNotFound
+		}
+	}
+	sysutils.WriteErrorResponse(ctx, w, status, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type RolloutHandlerTestSuite struct {
+	suite.Suite
+	mockService *RolloutServiceInterfaceMock
+	mux         *http.ServeMux
+}
+
+func TestRolloutHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(RolloutHandlerTestSuite))
+}
+
+func (suite *RolloutHandlerTestSuite) SetupTest() {
+	suite.mockService = NewRolloutServiceInterfaceMock(suite.T())
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, newRolloutHandler(suite.mockService))
+}
+
+func (suite *RolloutHandlerTestSuite) serve(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *RolloutHandlerTestSuite) errorCode(w *httptest.ResponseRecorder) string {
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	return errResp.Code
+}
+
+func (suite *RolloutHandlerTestSuite) TestHandleGetRollout() {
+	suite.mockService.On("GetRollout", mock.Anything, testFlowID).Return(&Rollout{
+		FlowID: testFlowID, RolloutConfig: RolloutConfig{StableVersion: 2, Percentage: 10},
+	}, nil)
+
+	w := suite.serve(http.MethodGet, "/flows/flow-1/rollout", "")
+
+	suite.Equal(http.StatusOK, w.Code)
+	var rollout Rollout
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &rollout))
+	suite.Equal(testFlowID, rollout.FlowID)
+	suite.Equal(2, rollout.StableVersion)
+	suite.Equal(10, rollout.Percentage)
+}
+
+func (suite *RolloutHandlerTestSuite) TestHandleSetRollout_SanitizesConfig() {
+	suite.mockService.On("SetRollout", mock.Anything, testFlowID, mock.MatchedBy(func(c *RolloutConfig) bool {
+		return c.StableVersion == 2 && c.Percentage == 20 && len(c.ApplicationIDs) == 1 &&
+			c.ApplicationIDs[0] == "app-1" && c.Cohort != nil && len(c.Cohort.OUIDs) == 1 &&
+			c.Cohort.OUIDs[0] == "ou-1" && len(c.Cohort.UserTypes) == 0
+	})).Return(&Rollout{FlowID: testFlowID, RolloutConfig: RolloutConfig{StableVersion: 2, Percentage: 20}}, nil)
+
+	w := suite.serve(http.MethodPut, "/flows/flow-1/rollout",
+		`{"stableVersion":2,"percentage":20,"applicationIds":[" app-1 ",""],`+
+			`"cohort":{"ouIds":["ou-1"],"userTypes":[" "]}}`)
+
+	suite.Equal(http.StatusOK, w.Code)
+}
+
+func (suite *RolloutHandlerTestSuite) TestHandleSetRollout_InvalidBody() {
+	w := suite.serve(http.MethodPut, "/flows/flow-1/rollout", "{")
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Equal(ErrorInvalidRequestFormat.Code, suite.errorCode(w))
+}
+
+func (suite *RolloutHandlerTestSuite) TestHandleDeleteRollout() {
+	suite.mockService.On("DeleteRollout", mock.Anything, testFlowID).Return(nil)
+
+	w := suite.serve(http.MethodDelete, "/flows/flow-1/rollout", "")
+
+	suite.Equal(http.StatusNoContent, w.Code)
+}
+
+func (suite *RolloutHandlerTestSuite) TestHandleRollout_ErrorStatuses() {
+	tests := []struct {
+		name   string
+		err    *tidcommon.ServiceError
+		status int
+	}{
+		{"RolloutNotFound", &ErrorRolloutNotFound, http.StatusNotFound},
+		{"FlowNotFound", &flowmgt.ErrorFlowNotFound, http.StatusNotFound},
+		{"VersionNotFound", &flowmgt.ErrorVersionNotFound, http.StatusNotFound},
+		{"InvalidPercentage", &ErrorInvalidPercentage, http.StatusBadRequest},
+		{"InternalError", &tidcommon.InternalServerError, http.StatusInternalServerError},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockService.On("GetRollout", mock.Anything, testFlowID).Return(nil, tc.err)
+
+			w := suite.serve(http.MethodGet, "/flows/flow-1/rollout", "")
+
+			suite.Equal(tc.status, w.Code)
+			suite.Equal(tc.err.Code, suite.errorCode(w))
+		})
+	}
+}
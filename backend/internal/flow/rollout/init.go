@@ -0,0 +1,44 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rollout provides canary and cohort rollouts of flow versions. A rollout routes a share of the
+// new executions of a flow to a candidate version while the rest run the stable version; each execution
+// stays on the version it was served until it ends.
+package rollout
+
+import (
+	"net/http"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize constructs the flow rollout service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	deploymentID string,
+	flowMgtService flowmgt.FlowMgtServiceInterface,
+) RolloutServiceInterface {
+	rolloutService := newRolloutService(newRolloutStore(deploymentID), flowMgtService)
+	registerRoutes(mux, newRolloutHandler(rolloutService))
+	return rolloutService
+}
+
+// registerRoutes registers the flow rollout routes.
+func registerRoutes(mux *http.ServeMux, h *rolloutHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+rolloutPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleGetRollout)).ServeHTTP, opts))
+	mux.HandleFunc(middleware.WithCORS("PUT "+rolloutPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleSetRollout)).ServeHTTP, opts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+rolloutPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleDeleteRollout)).ServeHTTP, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+rolloutPath,
+		func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) }, opts))
+}
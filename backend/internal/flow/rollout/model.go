@@ -0,0 +1,44 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+// Variants of a flow rollout reported on the executions it routes.
+const (
+	// VariantStable marks an execution served the stable version of a flow.
+	VariantStable = "stable"
+	// VariantCandidate marks an execution served the candidate version of a flow.
+	VariantCandidate = "candidate"
+)
+
+// RolloutConfig is the policy a rollout routes the new executions of a flow by. Executions in the cohort
+// or the percentage run the candidate version; the rest run the stable version.
+type RolloutConfig struct {
+	// StableVersion is the version executions outside the rollout run.
+	StableVersion int `json:"stableVersion"`
+	// CandidateVersion is the version rolled out. When zero, the active version of the flow is the
+	// candidate, so an update published while the rollout is in progress only reaches the rollout.
+	CandidateVersion int `json:"candidateVersion,omitempty"`
+	// Percentage is the share of executions, from 0 to 100, that run the candidate version. Executions
+	// started by an authenticated caller are bucketed by the caller's subject, so a caller stays on the
+	// same version; other executions are bucketed by their execution id.
+	Percentage int `json:"percentage"`
+	// Cohort selects callers that always run the candidate version.
+	Cohort *Cohort `json:"cohort,omitempty"`
+	// ApplicationIDs limits the rollout to executions started for these applications. When empty, the
+	// rollout applies to every execution of the flow.
+	ApplicationIDs []string `json:"applicationIds,omitempty"`
+}
+
+// Cohort selects the callers that run the candidate version regardless of the percentage. A caller is in
+// the cohort when its organization unit or user type is listed.
+type Cohort struct {
+	OUIDs     []string `json:"ouIds,omitempty"`
+	UserTypes []string `json:"userTypes,omitempty"`
+}
+
+// Rollout is the rollout in progress for a flow.
+type Rollout struct {
+	FlowID string `json:"flowId"`
+	RolloutConfig
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"slices"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+const (
+	loggerComponentName = "FlowRolloutService"
+
+	// maxPercentage is the percentage that routes every execution to the candidate version.
+	maxPercentage = 100
+	// userTypeAttribute is the token attribute holding the user type of the caller.
+	userTypeAttribute = "userType"
+)
+
+// RolloutServiceInterface defines the management of flow rollouts and the selection of the flow
+// version a new execution is served.
+type RolloutServiceInterface interface {
+	// GetRollout returns the rollout in progress for a flow.
+	GetRollout(ctx context.Context, flowID string) (*Rollout, *tidcommon.ServiceError)
+	// SetRollout starts or replaces the rollout of a flow.
+	SetRollout(ctx context.Context, flowID string, config *RolloutConfig) (*Rollout, *tidcommon.ServiceError)
+	// DeleteRollout ends the rollout of a flow, so new executions run the active version.
+	DeleteRollout(ctx context.Context, flowID string) *tidcommon.ServiceError
+	// SelectFlowVersion returns the definition a new execution of the flow is served, and the rollout
+	// variant it belongs to. The variant is empty when no rollout applies to the execution.
+	SelectFlowVersion(ctx context.Context, flow *providers.CompleteFlowDefinition, appID, executionID string) (
+		*providers.CompleteFlowDefinition, string, *tidcommon.ServiceError)
+	// GetFlowVersion returns the definition of a flow at the given version.
+	GetFlowVersion(ctx context.Context, flowID string, version int) (
+		*providers.CompleteFlowDefinition, *tidcommon.ServiceError)
+}
+
+// rolloutService is the default implementation of RolloutServiceInterface.
+type rolloutService struct {
+	store          rolloutStoreInterface
+	flowMgtService flowmgt.FlowMgtServiceInterface
+	logger         *log.Logger
+}
+
+// newRolloutService creates a new instance of rolloutService.
+func newRolloutService(store rolloutStoreInterface,
+	flowMgtService flowmgt.FlowMgtServiceInterface) RolloutServiceInterface {
+	return &rolloutService{
+		store:          store,
+		flowMgtService: flowMgtService,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetRollout returns the rollout in progress for a flow.
+func (s *rolloutService) GetRollout(ctx context.Context, flowID string) (*Rollout, *tidcommon.ServiceError) {
+	rollout, err := s.store.GetRollout(ctx, flowID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, &ErrorRolloutNotFound
+		}
+		s.logger.Error(ctx, "Failed to get flow rollout", log.String("flowID", flowID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return rollout, nil
+}
+
+// SetRollout starts or replaces the rollout of a flow. The stable and candidate versions must be in the
+// version history of the flow.
+func (s *rolloutService) SetRollout(ctx context.Context, flowID string, config *RolloutConfig) (
+	*Rollout, *tidcommon.ServiceError) {
+	if config.Percentage < 0 || config.Percentage > maxPercentage {
+		return nil, &ErrorInvalidPercentage
+	}
+	if config.StableVersion <= 0 || config.CandidateVersion < 0 ||
+		config.CandidateVersion == config.StableVersion {
+		return nil, &ErrorInvalidVersions
+	}
+
+	if _, svcErr := s.flowMgtService.GetFlow(ctx, flowID); svcErr != nil {
+		return nil, svcErr
+	}
+	for _, version := range []int{config.StableVersion, config.CandidateVersion} {
+		if version == 0 {
+			continue
+		}
+		if _, svcErr := s.flowMgtService.GetFlowVersion(ctx, flowID, version); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	rollout := &Rollout{FlowID: flowID, RolloutConfig: *config}
+	if err := s.store.SaveRollout(ctx, rollout); err != nil {
+		s.logger.Error(ctx, "Failed to save flow rollout", log.String("flowID", flowID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	s.logger.Debug(ctx, "Flow rollout saved", log.String("flowID", flowID),
+		log.Int("stableVersion", config.StableVersion), log.Int("candidateVersion", config.CandidateVersion),
+		log.Int("percentage", config.Percentage))
+	return rollout, nil
+}
+
+// DeleteRollout ends the rollout of a flow, so new executions run the active version. Executions that
+// started during the rollout finish on the version they were served.
+func (s *rolloutService) DeleteRollout(ctx context.Context, flowID string) *tidcommon.ServiceError {
+	if _, svcErr := s.GetRollout(ctx, flowID); svcErr != nil {
+		return svcErr
+	}
+	if err := s.store.DeleteRollout(ctx, flowID); err != nil {
+		s.logger.Error(ctx, "Failed to delete flow rollout", log.String("flowID", flowID), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
+// SelectFlowVersion returns the definition a new execution of the flow is served, and the rollout
+// variant it belongs to. When the version selected by the rollout is no longer in the version history,
+// the execution runs the active version outside the rollout.
+func (s *rolloutService) SelectFlowVersion(ctx context.Context, flow *providers.CompleteFlowDefinition,
+	appID, executionID string) (*providers.CompleteFlowDefinition, string, *tidcommon.ServiceError) {
+	rollout, err := s.store.GetRollout(ctx, flow.ID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return flow, "", nil
+		}
+		s.logger.Error(ctx, "Failed to get flow rollout", log.String("flowID", flow.ID), log.Error(err))
+		return nil, "", &tidcommon.InternalServerError
+	}
+	if len(rollout.ApplicationIDs) > 0 && !slices.Contains(rollout.ApplicationIDs, appID) {
+		return flow, "", nil
+	}
+
+	variant, version := VariantStable, rollout.StableVersion
+	if isCandidate(ctx, rollout, executionID) {
+		variant, version = VariantCandidate, rollout.CandidateVersion
+		if version == 0 {
+			version = flow.ActiveVersion
+		}
+	}
+	if version == flow.ActiveVersion {
+		return flow, variant, nil
+	}
+
+	served, svcErr := s.GetFlowVersion(ctx, flow.ID, version)
+	if svcErr != nil {
+		s.logger.Warn(ctx, "Flow version selected by the rollout is unavailable; serving the active version",
+			log.String("flowID", flow.ID), log.Int("version", version), log.String("errorCode", svcErr.Code))
+		return flow, "", nil
+	}
+	return served, variant, nil
+}
+
+// GetFlowVersion returns the definition of a flow at the given version.
+func (s *rolloutService) GetFlowVersion(ctx context.Context, flowID string, version int) (
+	*providers.CompleteFlowDefinition, *tidcommon.ServiceError) {
+	flowVersion, svcErr := s.flowMgtService.GetFlowVersion(ctx, flowID, version)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return flowVersion.ToCompleteFlowDefinition(), nil
+}
+
+// isCandidate reports whether an execution is routed to the candidate version: the caller is in the
+// cohort, or the execution falls in the rollout percentage. The caller's subject keys the percentage
+// bucket when the execution is started by an authenticated caller, and the execution id otherwise.
+func isCandidate(ctx context.Context, rollout *Rollout, executionID string) bool {
+	if rollout.Cohort != nil {
+		if ouID := security.GetOUID(ctx); ouID != "" && slices.Contains(rollout.Cohort.OUIDs, ouID) {
+			return true
+		}
+		if userType, ok := security.GetAttribute(ctx, userTypeAttribute).(string); ok && userType != "" &&
+			slices.Contains(rollout.Cohort.UserTypes, userType) {
+			return true
+		}
+	}
+
+	key := security.GetSubject(ctx)
+	if key == "" {
+		key = executionID
+	}
+	return bucket(rollout.FlowID, key) < rollout.Percentage
+}
+
+// bucket maps a key to a stable bucket from 0 to 99. The flow id is part of the hash so that a caller
+// lands in independent buckets for the rollouts of different flows.
+func bucket(flowID, key string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(flowID))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % maxPercentage)
+}
@@ -0,0 +1,298 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
+)
+
+const testFlowID = "flow-1"
+
+type RolloutServiceTestSuite struct {
+	suite.Suite
+	mockStore   *rolloutStoreInterfaceMock
+	mockFlowMgt *flowmgtmock.FlowMgtServiceInterfaceMock
+	service     RolloutServiceInterface
+}
+
+func TestRolloutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RolloutServiceTestSuite))
+}
+
+func (suite *RolloutServiceTestSuite) SetupTest() {
+	suite.mockStore = NewrolloutStoreInterfaceMock(suite.T())
+	suite.mockFlowMgt = flowmgtmock.NewFlowMgtServiceInterfaceMock(suite.T())
+	suite.service = newRolloutService(suite.mockStore, suite.mockFlowMgt)
+}
+
+func (suite *RolloutServiceTestSuite) activeFlow() *providers.CompleteFlowDefinition {
+	return &providers.CompleteFlowDefinition{
+		ID:            testFlowID,
+		Handle:        "login",
+		FlowType:      providers.FlowTypeAuthentication,
+		ActiveVersion: 3,
+		Nodes:         []providers.NodeDefinition{{ID: "start", Type: "START", OnSuccess: "end"}, {ID: "end"}},
+	}
+}
+
+func (suite *RolloutServiceTestSuite) flowVersion(version int) *flowmgt.FlowVersion {
+	return &flowmgt.FlowVersion{
+		ID:       testFlowID,
+		Handle:   "login",
+		FlowType: string(providers.FlowTypeAuthentication),
+		Version:  version,
+		Nodes:    []providers.NodeDefinition{{ID: "start", Type: "START", OnSuccess: "end"}},
+	}
+}
+
+// subjectInBucket returns a subject that lands below or above the percentage for the test flow.
+func (suite *RolloutServiceTestSuite) subjectInBucket(percentage int, below bool) string {
+	for i := 0; ; i++ {
+		subject := "user-" + string(rune('a'+i%26)) + string(rune('a'+i/26%26))
+		if (bucket(testFlowID, subject) < percentage) == below {
+			return subject
+		}
+	}
+}
+
+func callerContext(subject, ouID string, attributes map[string]interface{}) context.Context {
+	return security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest(subject, ouID, "token", nil, attributes))
+}
+
+func (suite *RolloutServiceTestSuite) TestGetRollout() {
+	rollout := &Rollout{FlowID: testFlowID, RolloutConfig: RolloutConfig{StableVersion: 2, Percentage: 10}}
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(rollout, nil).Once()
+
+	result, svcErr := suite.service.GetRollout(context.Background(), testFlowID)
+	suite.Nil(svcErr)
+	suite.Equal(rollout, result)
+}
+
+func (suite *RolloutServiceTestSuite) TestGetRollout_Errors() {
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(nil, ErrNotFound).Once()
+	_, svcErr := suite.service.GetRollout(context.Background(), testFlowID)
+	suite.Equal(ErrorRolloutNotFound.Code, svcErr.Code)
+
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(nil, errors.New("db error")).Once()
+	_, svcErr = suite.service.GetRollout(context.Background(), testFlowID)
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *RolloutServiceTestSuite) TestSetRollout_Success() {
+	config := &RolloutConfig{StableVersion: 2, Percentage: 25}
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.activeFlow(), nil)
+	suite.mockFlowMgt.On("GetFlowVersion", mock.Anything, testFlowID, 2).Return(suite.flowVersion(2), nil)
+	suite.mockStore.On("SaveRollout", mock.Anything, &Rollout{FlowID: testFlowID, RolloutConfig: *config}).
+		Return(nil)
+
+	result, svcErr := suite.service.SetRollout(context.Background(), testFlowID, config)
+	suite.Nil(svcErr)
+	suite.Equal(testFlowID, result.FlowID)
+	suite.Equal(25, result.Percentage)
+	suite.mockFlowMgt.AssertNumberOfCalls(suite.T(), "GetFlowVersion", 1)
+}
+
+func (suite *RolloutServiceTestSuite) TestSetRollout_ValidatesCandidateVersion() {
+	config := &RolloutConfig{StableVersion: 2, CandidateVersion: 4, Percentage: 25}
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.activeFlow(), nil)
+	suite.mockFlowMgt.On("GetFlowVersion", mock.Anything, testFlowID, 2).Return(suite.flowVersion(2), nil)
+	suite.mockFlowMgt.On("GetFlowVersion", mock.Anything, testFlowID, 4).Return(nil, &flowmgt.ErrorVersionNotFound)
+
+	_, svcErr := suite.service.SetRollout(context.Background(), testFlowID, config)
+	suite.Equal(flowmgt.ErrorVersionNotFound.Code, svcErr.Code)
+	suite.mockStore.AssertNotCalled(suite.T(), "SaveRollout", mock.Anything, mock.Anything)
+}
+
+func (suite *RolloutServiceTestSuite) TestSetRollout_InvalidConfig() {
+	tests := []struct {
+		name   string
+		config RolloutConfig
+		code   string
+	}{
+		{"NegativePercentage", RolloutConfig{StableVersion: 1, Percentage: -1}, ErrorInvalidPercentage.Code},
+		{"PercentageOver100", RolloutConfig{StableVersion: 1, Percentage: 101}, ErrorInvalidPercentage.Code},
+		{"MissingStable", RolloutConfig{Percentage: 10}, ErrorInvalidVersions.Code},
+		{"NegativeCandidate", RolloutConfig{StableVersion: 1, CandidateVersion: -1}, ErrorInvalidVersions.Code},
+		{"SameVersions", RolloutConfig{StableVersion: 2, CandidateVersion: 2}, ErrorInvalidVersions.Code},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			config := tc.config
+			_, svcErr := suite.service.SetRollout(context.Background(), testFlowID, &config)
+			suite.Equal(tc.code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *RolloutServiceTestSuite) TestSetRollout_FlowNotFound() {
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(nil, &flowmgt.ErrorFlowNotFound)
+
+	_, svcErr := suite.service.SetRollout(context.Background(), testFlowID, &RolloutConfig{StableVersion: 2})
+	suite.Equal(flowmgt.ErrorFlowNotFound.Code, svcErr.Code)
+}
+
+func (suite *RolloutServiceTestSuite) TestSetRollout_StoreError() {
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.activeFlow(), nil)
+	suite.mockFlowMgt.On("GetFlowVersion", mock.Anything, testFlowID, 2).Return(suite.flowVersion(2), nil)
+	suite.mockStore.On("SaveRollout", mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	_, svcErr := suite.service.SetRollout(context.Background(), testFlowID, &RolloutConfig{StableVersion: 2})
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *RolloutServiceTestSuite) TestDeleteRollout() {
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(&Rollout{FlowID: testFlowID}, nil)
+	suite.mockStore.On("DeleteRollout", mock.Anything, testFlowID).Return(nil)
+
+	suite.Nil(suite.service.DeleteRollout(context.Background(), testFlowID))
+}
+
+func (suite *RolloutServiceTestSuite) TestDeleteRollout_NotFound() {
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(nil, ErrNotFound)
+
+	svcErr := suite.service.DeleteRollout(context.Background(), testFlowID)
+	suite.Equal(ErrorRolloutNotFound.Code, svcErr.Code)
+	suite.mockStore.AssertNotCalled(suite.T(), "DeleteRollout", mock.Anything, mock.Anything)
+}
+
+func (suite *RolloutServiceTestSuite) TestSelectFlowVersion_NoRollout() {
+	flow := suite.activeFlow()
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(nil, ErrNotFound)
+
+	served, variant, svcErr := suite.service.SelectFlowVersion(context.Background(), flow, "app-1", "exec-1")
+	suite.Nil(svcErr)
+	suite.Same(flow, served)
+	suite.Empty(variant)
+}
+
+func (suite *RolloutServiceTestSuite) TestSelectFlowVersion_StoreError() {
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(nil, errors.New("db error"))
+
+	_, _, svcErr := suite.service.SelectFlowVersion(context.Background(), suite.activeFlow(), "app-1", "exec-1")
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *RolloutServiceTestSuite) TestSelectFlowVersion_StableVersion() {
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(&Rollout{
+		FlowID: testFlowID, RolloutConfig: RolloutConfig{StableVersion: 2, Percentage: 0},
+	}, nil)
+	suite.mockFlowMgt.On("GetFlowVersion", mock.Anything, testFlowID, 2).Return(suite.flowVersion(2), nil)
+
+	served, variant, svcErr := suite.service.SelectFlowVersion(context.Background(), suite.activeFlow(),
+		"app-1", "exec-1")
+	suite.Nil(svcErr)
+	suite.Equal(VariantStable, variant)
+	suite.Equal(2, served.ActiveVersion)
+	suite.Equal(testFlowID, served.ID)
+}
+
+func (suite *RolloutServiceTestSuite) TestSelectFlowVersion_FullPercentageServesActiveCandidate() {
+	flow := suite.activeFlow()
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(&Rollout{
+		FlowID: testFlowID, RolloutConfig: RolloutConfig{StableVersion: 2, Percentage: 100},
+	}, nil)
+
+	served, variant, svcErr := suite.service.SelectFlowVersion(context.Background(), flow, "app-1", "exec-1")
+	suite.Nil(svcErr)
+	suite.Equal(VariantCandidate, variant)
+	suite.Same(flow, served)
+}
+
+func (suite *RolloutServiceTestSuite) TestSelectFlowVersion_PercentageBucketsBySubject() {
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(&Rollout{
+		FlowID: testFlowID, RolloutConfig: RolloutConfig{StableVersion: 2, CandidateVersion: 4, Percentage: 30},
+	}, nil)
+	suite.mockFlowMgt.On("GetFlowVersion", mock.Anything, testFlowID, 4).Return(suite.flowVersion(4), nil)
+	suite.mockFlowMgt.On("GetFlowVersion", mock.Anything, testFlowID, 2).Return(suite.flowVersion(2), nil)
+
+	inside := callerContext(suite.subjectInBucket(30, true), "", nil)
+	served, variant, svcErr := suite.service.SelectFlowVersion(inside, suite.activeFlow(), "app-1", "exec-1")
+	suite.Nil(svcErr)
+	suite.Equal(VariantCandidate, variant)
+	suite.Equal(4, served.ActiveVersion)
+
+	outside := callerContext(suite.subjectInBucket(30, false), "", nil)
+	served, variant, svcErr = suite.service.SelectFlowVersion(outside, suite.activeFlow(), "app-1", "exec-1")
+	suite.Nil(svcErr)
+	suite.Equal(VariantStable, variant)
+	suite.Equal(2, served.ActiveVersion)
+}
+
+func (suite *RolloutServiceTestSuite) TestSelectFlowVersion_Cohort() {
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(&Rollout{
+		FlowID: testFlowID,
+		RolloutConfig: RolloutConfig{StableVersion: 2, Cohort: &Cohort{
+			OUIDs: []string{"ou-beta"}, UserTypes: []string{"employee"},
+		}},
+	}, nil)
+	suite.mockFlowMgt.On("GetFlowVersion", mock.Anything, testFlowID, 2).Return(suite.flowVersion(2), nil)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		variant string
+	}{
+		{"OUInCohort", callerContext("user-1", "ou-beta", nil), VariantCandidate},
+		{"UserTypeInCohort", callerContext("user-1", "ou-other",
+			map[string]interface{}{userTypeAttribute: "employee"}), VariantCandidate},
+		{"NotInCohort", callerContext("user-1", "ou-other",
+			map[string]interface{}{userTypeAttribute: "customer"}), VariantStable},
+		{"Unauthenticated", context.Background(), VariantStable},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			_, variant, svcErr := suite.service.SelectFlowVersion(tc.ctx, suite.activeFlow(), "app-1", "exec-1")
+			suite.Nil(svcErr)
+			suite.Equal(tc.variant, variant)
+		})
+	}
+}
+
+func (suite *RolloutServiceTestSuite) TestSelectFlowVersion_ApplicationNotInRollout() {
+	flow := suite.activeFlow()
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(&Rollout{
+		FlowID:        testFlowID,
+		RolloutConfig: RolloutConfig{StableVersion: 2, Percentage: 100, ApplicationIDs: []string{"app-2"}},
+	}, nil)
+
+	served, variant, svcErr := suite.service.SelectFlowVersion(context.Background(), flow, "app-1", "exec-1")
+	suite.Nil(svcErr)
+	suite.Same(flow, served)
+	suite.Empty(variant)
+}
+
+func (suite *RolloutServiceTestSuite) TestSelectFlowVersion_SelectedVersionUnavailable() {
+	flow := suite.activeFlow()
+	suite.mockStore.On("GetRollout", mock.Anything, testFlowID).Return(&Rollout{
+		FlowID: testFlowID, RolloutConfig: RolloutConfig{StableVersion: 2},
+	}, nil)
+	suite.mockFlowMgt.On("GetFlowVersion", mock.Anything, testFlowID, 2).Return(nil, &flowmgt.ErrorVersionNotFound)
+
+	served, variant, svcErr := suite.service.SelectFlowVersion(context.Background(), flow, "app-1", "exec-1")
+	suite.Nil(svcErr)
+	suite.Same(flow, served)
+	suite.Empty(variant)
+}
+
+func (suite *RolloutServiceTestSuite) TestBucket_IsStableAndBounded() {
+	suite.Equal(bucket(testFlowID, "user-1"), bucket(testFlowID, "user-1"))
+	for _, key := range []string{"", "user-1", "user-2", "0190f5a2-7c1e-7b3a-9d4e-5f6a7b8c9d0e"} {
+		b := bucket(testFlowID, key)
+		suite.GreaterOrEqual(b, 0)
+		suite.Less(b, maxPercentage)
+	}
+}
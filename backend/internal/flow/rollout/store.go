@@ -0,0 +1,120 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// rolloutStoreInterface persists the rollouts of flows in the config database.
+type rolloutStoreInterface interface {
+	// GetRollout returns the rollout of a flow, or ErrNotFound.
+	GetRollout(ctx context.Context, flowID string) (*Rollout, error)
+	// SaveRollout creates or replaces the rollout of a flow.
+	SaveRollout(ctx context.Context, rollout *Rollout) error
+	// DeleteRollout removes the rollout of a flow.
+	DeleteRollout(ctx context.Context, flowID string) error
+}
+
+// rolloutStore implements rolloutStoreInterface against the config database.
+type rolloutStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newRolloutStore creates a new rolloutStore.
+func newRolloutStore(deploymentID string) rolloutStoreInterface {
+	return &rolloutStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// GetRollout returns the rollout of a flow, or ErrNotFound.
+func (s *rolloutStore) GetRollout(ctx context.Context, flowID string) (*Rollout, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryGetRollout, flowID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query flow rollout: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+
+	rollout := &Rollout{FlowID: columnString(results[0]["flow_id"])}
+	if err := json.Unmarshal(columnBytes(results[0]["rollout"]), &rollout.RolloutConfig); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rollout of flow %s: %w", rollout.FlowID, err)
+	}
+	return rollout, nil
+}
+
+// SaveRollout creates or replaces the rollout of a flow. The existing rollout is updated in place, and
+// the rollout is inserted when the flow has none.
+func (s *rolloutStore) SaveRollout(ctx context.Context, rollout *Rollout) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	configJSON, err := json.Marshal(rollout.RolloutConfig)
+	if err != nil {
+		return fmt.Errorf("failed to marshal flow rollout: %w", err)
+	}
+
+	updated, err := dbClient.ExecuteContext(ctx, queryUpdateRollout, rollout.FlowID, string(configJSON),
+		s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update flow rollout: %w", err)
+	}
+	if updated > 0 {
+		return nil
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateRollout, rollout.FlowID, string(configJSON),
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to create flow rollout: %w", err)
+	}
+	return nil
+}
+
+// DeleteRollout removes the rollout of a flow.
+func (s *rolloutStore) DeleteRollout(ctx context.Context, flowID string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteRollout, flowID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete flow rollout: %w", err)
+	}
+	return nil
+}
+
+// columnString coerces a result-row value to a string, tolerating string/[]byte.
+func columnString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	default:
+		return ""
+	}
+}
+
+// columnBytes coerces a result-row value to bytes, tolerating []byte/string.
+func columnBytes(v interface{}) []byte {
+	switch t := v.(type) {
+	case []byte:
+		return t
+	case string:
+		return []byte(t)
+	default:
+		return nil
+	}
+}
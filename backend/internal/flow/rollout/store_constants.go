@@ -0,0 +1,29 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// DBQuery definitions for the flow rollout store.
+var (
+	queryCreateRollout = dbmodel.DBQuery{
+		ID:    "FRQ-RO-01",
+		Query: `INSERT INTO "FLOW_ROLLOUT" (FLOW_ID, ROLLOUT, DEPLOYMENT_ID) VALUES ($1, $2, $3)`,
+	}
+	queryGetRollout = dbmodel.DBQuery{
+		ID:    "FRQ-RO-02",
+		Query: `SELECT FLOW_ID, ROLLOUT FROM "FLOW_ROLLOUT" WHERE FLOW_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	queryUpdateRollout = dbmodel.DBQuery{
+		ID: "FRQ-RO-03",
+		Query: `UPDATE "FLOW_ROLLOUT" SET ROLLOUT = $2, UPDATED_AT = CURRENT_TIMESTAMP ` +
+			`WHERE FLOW_ID = $1 AND DEPLOYMENT_ID = $3`,
+	}
+	queryDeleteRollout = dbmodel.DBQuery{
+		ID:    "FRQ-RO-04",
+		Query: `DELETE FROM "FLOW_ROLLOUT" WHERE FLOW_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,125 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type RolloutStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *rolloutStore
+}
+
+func TestRolloutStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RolloutStoreTestSuite))
+}
+
+func (suite *RolloutStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &rolloutStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func (suite *RolloutStoreTestSuite) TestGetRollout_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetRollout, testFlowID, testDeploymentID).
+		Return([]map[string]interface{}{{
+			"flow_id": testFlowID,
+			"rollout": []byte(`{"stableVersion":2,"percentage":10,"cohort":{"ouIds":["ou-1"]}}`),
+		}}, nil)
+
+	rollout, err := suite.store.GetRollout(context.Background(), testFlowID)
+	suite.Require().NoError(err)
+	suite.Equal(testFlowID, rollout.FlowID)
+	suite.Equal(2, rollout.StableVersion)
+	suite.Equal(10, rollout.Percentage)
+	suite.Equal([]string{"ou-1"}, rollout.Cohort.OUIDs)
+}
+
+func (suite *RolloutStoreTestSuite) TestGetRollout_NotFound() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetRollout, testFlowID, testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	_, err := suite.store.GetRollout(context.Background(), testFlowID)
+	suite.ErrorIs(err, ErrNotFound)
+}
+
+func (suite *RolloutStoreTestSuite) TestGetRollout_InvalidJSON() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetRollout, testFlowID, testDeploymentID).
+		Return([]map[string]interface{}{{"flow_id": testFlowID, "rollout": "{"}}, nil)
+
+	_, err := suite.store.GetRollout(context.Background(), testFlowID)
+	suite.ErrorContains(err, "failed to unmarshal rollout")
+}
+
+func (suite *RolloutStoreTestSuite) TestGetRollout_DBClientError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("db error"))
+
+	_, err := suite.store.GetRollout(context.Background(), testFlowID)
+	suite.ErrorContains(err, "failed to get database client")
+}
+
+func (suite *RolloutStoreTestSuite) TestSaveRollout_UpdatesExisting() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateRollout, testFlowID,
+		`{"stableVersion":2,"percentage":10}`, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.SaveRollout(context.Background(), &Rollout{
+		FlowID: testFlowID, RolloutConfig: RolloutConfig{StableVersion: 2, Percentage: 10},
+	})
+	suite.NoError(err)
+	suite.mockDBClient.AssertNumberOfCalls(suite.T(), "ExecuteContext", 1)
+}
+
+func (suite *RolloutStoreTestSuite) TestSaveRollout_CreatesMissing() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateRollout, testFlowID,
+		`{"stableVersion":2,"percentage":10}`, testDeploymentID).Return(int64(0), nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateRollout, testFlowID,
+		`{"stableVersion":2,"percentage":10}`, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.SaveRollout(context.Background(), &Rollout{
+		FlowID: testFlowID, RolloutConfig: RolloutConfig{StableVersion: 2, Percentage: 10},
+	})
+	suite.NoError(err)
+}
+
+func (suite *RolloutStoreTestSuite) TestSaveRollout_UpdateError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateRollout, mock.Anything, mock.Anything,
+		mock.Anything).Return(int64(0), errors.New("db error"))
+
+	err := suite.store.SaveRollout(context.Background(), &Rollout{FlowID: testFlowID})
+	suite.ErrorContains(err, "failed to update flow rollout")
+}
+
+func (suite *RolloutStoreTestSuite) TestDeleteRollout() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteRollout, testFlowID, testDeploymentID).
+		Return(int64(1), nil)
+
+	suite.NoError(suite.store.DeleteRollout(context.Background(), testFlowID))
+}
+
+func (suite *RolloutStoreTestSuite) TestDeleteRollout_Error() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteRollout, testFlowID, testDeploymentID).
+		Return(int64(0), errors.New("db error"))
+
+	suite.ErrorContains(suite.store.DeleteRollout(context.Background(), testFlowID), "failed to delete flow rollout")
+}
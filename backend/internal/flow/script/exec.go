@@ -0,0 +1,214 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package script
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/flow/expression"
+)
+
+// runtimeVariable is the variable that holds the flow runtime data.
+const runtimeVariable = "runtime"
+
+// outcomePattern matches valid outcome names.
+var outcomePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]{0,63}$`)
+
+// statement is a single executable statement. exec reports done when the statement ends the script.
+type statement interface {
+	exec(r *runner) (done bool, err error)
+}
+
+// runner holds the state of a single script execution.
+type runner struct {
+	ctx     context.Context
+	vars    map[string]interface{}
+	inputs  map[string]bool
+	runtime map[string]string
+	result  *Result
+}
+
+// newRunner creates a runner over a private copy of vars.
+func newRunner(ctx context.Context, vars map[string]interface{}) *runner {
+	r := &runner{
+		ctx:     ctx,
+		vars:    make(map[string]interface{}, len(vars)),
+		inputs:  make(map[string]bool, len(vars)),
+		runtime: make(map[string]string),
+		result: &Result{
+			Outcome:     OutcomeSuccess,
+			RuntimeData: make(map[string]string),
+		},
+	}
+	for name, value := range vars {
+		r.vars[name] = value
+		r.inputs[name] = true
+	}
+	if runtime, ok := vars[runtimeVariable].(map[string]string); ok {
+		maps.Copy(r.runtime, runtime)
+	}
+	r.vars[runtimeVariable] = r.runtime
+	return r
+}
+
+// execBlock executes statements in order, checking the context before each one.
+func (r *runner) execBlock(body []statement) (bool, error) {
+	for _, stmt := range body {
+		if err := r.ctx.Err(); err != nil {
+			return true, fmt.Errorf("script did not complete within its time limit: %w", err)
+		}
+		done, err := stmt.exec(r)
+		if err != nil || done {
+			return done, err
+		}
+	}
+	return false, nil
+}
+
+// letStatement binds a local variable. A reassignment gives a new value to a variable that an
+// earlier let bound.
+type letStatement struct {
+	line     int
+	name     string
+	value    *expression.Program
+	reassign bool
+}
+
+func (s *letStatement) exec(r *runner) (bool, error) {
+	if r.inputs[s.name] {
+		return true, fmt.Errorf("line %d: cannot assign to read-only variable %q", s.line, s.name)
+	}
+	if _, bound := r.vars[s.name]; s.reassign && !bound {
+		return true, fmt.Errorf("line %d: cannot assign to %q before it is bound with let", s.line, s.name)
+	}
+	value, err := s.value.Eval(r.vars)
+	if err != nil {
+		return true, fmt.Errorf("line %d: %w", s.line, err)
+	}
+	r.vars[s.name] = value
+	return false, nil
+}
+
+// setStatement writes a runtime data entry.
+type setStatement struct {
+	line  int
+	key   string
+	value *expression.Program
+}
+
+func (s *setStatement) exec(r *runner) (bool, error) {
+	value, err := s.value.Eval(r.vars)
+	if err != nil {
+		return true, fmt.Errorf("line %d: %w", s.line, err)
+	}
+	text, err := scalarString(value)
+	if err != nil {
+		return true, fmt.Errorf("line %d: runtime.%s %w", s.line, s.key, err)
+	}
+	if len(text) > MaxOutputLength {
+		return true, fmt.Errorf("line %d: runtime.%s exceeds the maximum length of %d characters",
+			s.line, s.key, MaxOutputLength)
+	}
+	if _, exists := r.result.RuntimeData[s.key]; !exists && len(r.result.RuntimeData) >= MaxOutputs {
+		return true, fmt.Errorf("line %d: script exceeds the maximum of %d runtime data entries",
+			s.line, MaxOutputs)
+	}
+	r.result.RuntimeData[s.key] = text
+	r.runtime[s.key] = text
+	return false, nil
+}
+
+// conditionalBlock is one if or else if branch of an if statement.
+type conditionalBlock struct {
+	condition *expression.Program
+	body      []statement
+}
+
+// ifStatement runs the first branch whose condition holds, or the else block.
+type ifStatement struct {
+	line      int
+	branches  []conditionalBlock
+	otherwise []statement
+}
+
+func (s *ifStatement) exec(r *runner) (bool, error) {
+	for _, branch := range s.branches {
+		matched, err := branch.condition.EvalBool(r.vars)
+		if err != nil {
+			return true, fmt.Errorf("line %d: %w", s.line, err)
+		}
+		if matched {
+			return r.execBlock(branch.body)
+		}
+	}
+	return r.execBlock(s.otherwise)
+}
+
+// failStatement ends the script on the failure path with a message.
+type failStatement struct {
+	line    int
+	message *expression.Program
+}
+
+func (s *failStatement) exec(r *runner) (bool, error) {
+	value, err := s.message.Eval(r.vars)
+	if err != nil {
+		return true, fmt.Errorf("line %d: %w", s.line, err)
+	}
+	message, ok := value.(string)
+	if !ok || message == "" {
+		return true, fmt.Errorf("line %d: fail message must be a non-empty string", s.line)
+	}
+	if len(message) > MaxOutputLength {
+		return true, fmt.Errorf("line %d: fail message exceeds the maximum length of %d characters",
+			s.line, MaxOutputLength)
+	}
+	r.result.Outcome = OutcomeFailure
+	r.result.FailureMessage = message
+	return true, nil
+}
+
+// outcomeStatement ends the script with a chosen outcome.
+type outcomeStatement struct {
+	line    int
+	outcome *expression.Program
+}
+
+func (s *outcomeStatement) exec(r *runner) (bool, error) {
+	value, err := s.outcome.Eval(r.vars)
+	if err != nil {
+		return true, fmt.Errorf("line %d: %w", s.line, err)
+	}
+	outcome, ok := value.(string)
+	if !ok || !outcomePattern.MatchString(outcome) {
+		return true, fmt.Errorf("line %d: outcome must be a name of letters, digits, '_' and '-', got %v",
+			s.line, value)
+	}
+	r.result.Outcome = outcome
+	return true, nil
+}
+
+// scalarString converts a string, number or bool to its runtime data form.
+func scalarString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case nil:
+		return "", fmt.Errorf("must be a string, number or bool, got null")
+	case []interface{}:
+		return "", fmt.Errorf("must be a string, number or bool, got a list")
+	default:
+		return "", fmt.Errorf("must be a string, number or bool, got a map")
+	}
+}
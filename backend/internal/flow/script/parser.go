@@ -0,0 +1,226 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package script
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/flow/expression"
+)
+
+const runtimeTargetPrefix = "runtime."
+
+// identifierPattern matches local variable names and runtime data keys.
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// reservedNames cannot be used as local variable names.
+var reservedNames = map[string]bool{
+	"let": true, "set": true, "if": true, "else": true, "fail": true, "outcome": true,
+	"true": true, "false": true, "null": true, "in": true, "has": true,
+}
+
+// sourceLine is a non-blank, non-comment line of a script.
+type sourceLine struct {
+	number int
+	text   string
+}
+
+// parser builds the statement tree of a script from its lines.
+type parser struct {
+	lines      []sourceLine
+	pos        int
+	statements int
+}
+
+// parse splits source into lines and parses them into a statement list.
+func parse(source string) ([]statement, error) {
+	p := &parser{}
+	for i, raw := range strings.Split(source, "\n") {
+		text := strings.TrimSpace(raw)
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		p.lines = append(p.lines, sourceLine{number: i + 1, text: text})
+	}
+
+	body, err := p.parseBlock(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, fmt.Errorf("line %d: unexpected %q", p.lines[p.pos].number, p.lines[p.pos].text)
+	}
+	return body, nil
+}
+
+// parseBlock parses statements until the end of the script or a line starting with "}", which is
+// left for the caller to consume.
+func (p *parser) parseBlock(depth int) ([]statement, error) {
+	body := make([]statement, 0)
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if strings.HasPrefix(line.text, "}") {
+			return body, nil
+		}
+		p.pos++
+
+		p.statements++
+		if p.statements > MaxStatements {
+			return nil, fmt.Errorf("line %d: script exceeds the maximum of %d statements",
+				line.number, MaxStatements)
+		}
+
+		stmt, err := p.parseStatement(line, depth)
+		if err != nil {
+			return nil, err
+		}
+		body = append(body, stmt)
+	}
+	return body, nil
+}
+
+// parseStatement parses the statement that starts on line.
+func (p *parser) parseStatement(line sourceLine, depth int) (statement, error) {
+	keyword, rest, _ := strings.Cut(line.text, " ")
+	rest = strings.TrimSpace(rest)
+
+	switch keyword {
+	case "let":
+		name, value, err := parseAssignment(line, rest)
+		if err != nil {
+			return nil, err
+		}
+		if !identifierPattern.MatchString(name) || reservedNames[name] {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", line.number, name)
+		}
+		return &letStatement{line: line.number, name: name, value: value}, nil
+	case "set":
+		target, value, err := parseAssignment(line, rest)
+		if err != nil {
+			return nil, err
+		}
+		key, ok := strings.CutPrefix(target, runtimeTargetPrefix)
+		if !ok || !identifierPattern.MatchString(key) {
+			return nil, fmt.Errorf("line %d: set target must be of the form runtime.<key>, got %q",
+				line.number, target)
+		}
+		return &setStatement{line: line.number, key: key, value: value}, nil
+	case "fail":
+		message, err := compileExpression(line, rest)
+		if err != nil {
+			return nil, err
+		}
+		return &failStatement{line: line.number, message: message}, nil
+	case "outcome":
+		outcome, err := compileExpression(line, rest)
+		if err != nil {
+			return nil, err
+		}
+		return &outcomeStatement{line: line.number, outcome: outcome}, nil
+	case "if":
+		return p.parseIf(line, depth)
+	default:
+		if identifierPattern.MatchString(keyword) && !reservedNames[keyword] &&
+			strings.HasPrefix(rest, "=") && !strings.HasPrefix(rest, "==") {
+			_, value, err := parseAssignment(line, line.text)
+			if err != nil {
+				return nil, err
+			}
+			return &letStatement{line: line.number, name: keyword, value: value, reassign: true}, nil
+		}
+		return nil, fmt.Errorf("line %d: expected a let, set, if, fail, outcome or assignment statement, found %q",
+			line.number, line.text)
+	}
+}
+
+// parseIf parses an if statement together with its else if and else blocks.
+func (p *parser) parseIf(line sourceLine, depth int) (statement, error) {
+	if depth+1 > MaxBlockDepth {
+		return nil, fmt.Errorf("line %d: script exceeds the maximum block depth of %d", line.number, MaxBlockDepth)
+	}
+
+	stmt := &ifStatement{line: line.number}
+	header := line
+	for {
+		condition, err := parseBlockHeader(header, strings.TrimPrefix(header.text, "if"))
+		if err != nil {
+			return nil, err
+		}
+		body, err := p.parseBranchBody(header, depth)
+		if err != nil {
+			return nil, err
+		}
+		stmt.branches = append(stmt.branches, conditionalBlock{condition: condition, body: body})
+
+		closing := p.lines[p.pos]
+		p.pos++
+		rest := strings.TrimSpace(strings.TrimPrefix(closing.text, "}"))
+		switch {
+		case rest == "":
+			return stmt, nil
+		case strings.HasPrefix(rest, "else if "):
+			header = sourceLine{number: closing.number, text: strings.TrimPrefix(rest, "else ")}
+		case strings.TrimSpace(strings.TrimPrefix(rest, "else")) == "{":
+			otherwise, err := p.parseBranchBody(closing, depth)
+			if err != nil {
+				return nil, err
+			}
+			end := p.lines[p.pos]
+			p.pos++
+			if end.text != "}" {
+				return nil, fmt.Errorf("line %d: unexpected %q after else block", end.number, end.text)
+			}
+			stmt.otherwise = otherwise
+			return stmt, nil
+		default:
+			return nil, fmt.Errorf("line %d: unexpected %q after if block", closing.number, closing.text)
+		}
+	}
+}
+
+// parseBranchBody parses the block opened on header and checks that it is closed.
+func (p *parser) parseBranchBody(header sourceLine, depth int) ([]statement, error) {
+	body, err := p.parseBlock(depth + 1)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos >= len(p.lines) {
+		return nil, fmt.Errorf("line %d: block is not closed with \"}\"", header.number)
+	}
+	return body, nil
+}
+
+// parseBlockHeader extracts and compiles the condition of an "if <condition> {" line.
+func parseBlockHeader(line sourceLine, text string) (*expression.Program, error) {
+	text = strings.TrimSpace(text)
+	condition, ok := strings.CutSuffix(text, "{")
+	if !ok {
+		return nil, fmt.Errorf("line %d: expected \"{\" at the end of the line", line.number)
+	}
+	return compileExpression(line, strings.TrimSpace(condition))
+}
+
+// parseAssignment splits "<target> = <expr>" and compiles the expression.
+func parseAssignment(line sourceLine, text string) (string, *expression.Program, error) {
+	target, value, ok := strings.Cut(text, "=")
+	if !ok || strings.HasPrefix(value, "=") {
+		return "", nil, fmt.Errorf("line %d: expected \"=\" in assignment", line.number)
+	}
+	program, err := compileExpression(line, strings.TrimSpace(value))
+	if err != nil {
+		return "", nil, err
+	}
+	return strings.TrimSpace(target), program, nil
+}
+
+// compileExpression compiles an expression, prefixing errors with the line number.
+func compileExpression(line sourceLine, source string) (*expression.Program, error) {
+	program, err := expression.Compile(source)
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", line.number, err)
+	}
+	return program, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package script implements the sandboxed scripting language run by the flow ScriptExecutor. A
+// script is a sequence of line-oriented statements whose expressions are written in the language of
+// the expression package:
+//
+//	# Normalize the mobile number before it is verified.
+//	let mobile = inputs.mobileNumber.trim()
+//	if mobile.startsWith("0") {
+//	    mobile = "+94" + mobile.substring(1)
+//	}
+//	set runtime.mobileNumber = mobile
+//	if has(claims.email) && claims.email.endsWith("@blocked.example") {
+//	    fail "Sign-ups from this domain are not allowed"
+//	}
+//	outcome size(mobile) > 12 ? "international" : "local"
+//
+// Statements are:
+//
+//   - let name = expr: binds a local variable that later expressions can read.
+//   - name = expr: gives a new value to a variable bound by an earlier let.
+//   - set runtime.key = expr: writes a string, number or bool to the flow runtime data.
+//   - if expr { ... } else if expr { ... } else { ... }: runs the first block whose condition holds.
+//   - fail expr: stops the script and fails the node with the given message.
+//   - outcome expr: stops the script and completes the node with the given outcome.
+//
+// Scripts have no loops, function definitions or I/O, and the input variables are read-only, so
+// every script terminates and can only affect the flow through its result. Execution is further
+// bounded by the limits below and by the deadline of the context it runs under.
+package script
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// MaxSourceLength is the maximum length, in bytes, of a script.
+	MaxSourceLength = 16 * 1024
+	// MaxStatements is the maximum number of statements in a script, counting nested ones.
+	MaxStatements = 256
+	// MaxBlockDepth is the maximum nesting depth of if blocks.
+	MaxBlockDepth = 8
+	// MaxOutputs is the maximum number of runtime data entries a script can write.
+	MaxOutputs = 32
+	// MaxOutputLength is the maximum length, in bytes, of a runtime data value or failure message.
+	MaxOutputLength = 4096
+
+	// DefaultTimeout is the time a script may run for when no timeout is configured.
+	DefaultTimeout = 100 * time.Millisecond
+	// MaxTimeout is the longest timeout that can be configured for a script.
+	MaxTimeout = time.Second
+)
+
+const (
+	// OutcomeSuccess is the outcome of a script that ends without an outcome or fail statement.
+	OutcomeSuccess = "success"
+	// OutcomeFailure is the outcome of a script that ends with a fail statement. A script can also
+	// choose it explicitly to take the failure path without a message.
+	OutcomeFailure = "failure"
+)
+
+// Script is a compiled script. It is immutable and safe for concurrent use.
+type Script struct {
+	source string
+	body   []statement
+}
+
+// Result is the effect of running a script.
+type Result struct {
+	// Outcome is the outcome chosen by the script, OutcomeSuccess when it chose none or
+	// OutcomeFailure when it failed.
+	Outcome string
+	// FailureMessage is the message given to the fail statement, if the script failed.
+	FailureMessage string
+	// RuntimeData holds the runtime data entries written by the script.
+	RuntimeData map[string]string
+}
+
+// Failed reports whether the script took the failure path.
+func (r *Result) Failed() bool {
+	return r.Outcome == OutcomeFailure
+}
+
+// Compile parses source into a Script, reporting syntax errors and invalid expressions together
+// with the line they occur on.
+func Compile(source string) (*Script, error) {
+	if len(source) > MaxSourceLength {
+		return nil, fmt.Errorf("script exceeds the maximum length of %d characters", MaxSourceLength)
+	}
+	body, err := parse(source)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("script has no statements")
+	}
+	return &Script{source: source, body: body}, nil
+}
+
+// Source returns the source the script was compiled from.
+func (s *Script) Source() string {
+	return s.source
+}
+
+// ParseTimeout converts a configured timeout, in milliseconds, to a duration. A nil value yields
+// DefaultTimeout; anything other than a whole number of milliseconds between 1 and MaxTimeout is
+// rejected.
+func ParseTimeout(value interface{}) (time.Duration, error) {
+	var millis float64
+	switch v := value.(type) {
+	case nil:
+		return DefaultTimeout, nil
+	case float64:
+		millis = v
+	case int:
+		millis = float64(v)
+	case int64:
+		millis = float64(v)
+	default:
+		return 0, fmt.Errorf("timeout must be a number of milliseconds")
+	}
+	if millis != math.Trunc(millis) || millis < 1 || millis > float64(MaxTimeout.Milliseconds()) {
+		return 0, fmt.Errorf("timeout must be a whole number of milliseconds between 1 and %d",
+			MaxTimeout.Milliseconds())
+	}
+	return time.Duration(millis) * time.Millisecond, nil
+}
+
+// Run executes the script against vars, which it never modifies. The runtime data map under the
+// "runtime" variable, if any, is copied so that statements after a set see the value it wrote.
+// Run stops with an error when a statement fails to evaluate, when a limit is exceeded or when
+// ctx is done.
+func (s *Script) Run(ctx context.Context, vars map[string]interface{}) (*Result, error) {
+	r := newRunner(ctx, vars)
+	if _, err := r.execBlock(s.body); err != nil {
+		return nil, err
+	}
+	return r.result, nil
+}
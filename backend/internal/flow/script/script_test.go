@@ -0,0 +1,299 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package script
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testVars() map[string]interface{} {
+	return map[string]interface{}{
+		"inputs":  map[string]string{"mobileNumber": " 771234567 ", "email": "alice@blocked.example"},
+		"runtime": map[string]string{"attemptCount": "2"},
+		"claims":  map[string]interface{}{"country": "LK"},
+	}
+}
+
+func run(t *testing.T, source string) *Result {
+	t.Helper()
+	s, err := Compile(source)
+	require.NoError(t, err)
+	result, err := s.Run(context.Background(), testVars())
+	require.NoError(t, err)
+	return result
+}
+
+func TestRun_SetRuntimeData(t *testing.T) {
+	result := run(t, `
+# Normalize the mobile number.
+let mobile = inputs.mobileNumber.trim()
+if !mobile.startsWith("+") {
+    let mobile = "+94" + mobile
+}
+set runtime.mobileNumber = mobile
+set runtime.attemptCount = int(runtime.attemptCount) + 1
+set runtime.nextAttempt = int(runtime.attemptCount) + 1
+set runtime.international = size(mobile) > 12
+`)
+
+	assert.Equal(t, OutcomeSuccess, result.Outcome)
+	assert.False(t, result.Failed())
+	assert.Equal(t, map[string]string{
+		"mobileNumber":  "+94771234567",
+		"attemptCount":  "3",
+		"nextAttempt":   "4",
+		"international": "false",
+	}, result.RuntimeData)
+}
+
+// documentedExample is the ScriptExecutor example of the flow configuration guide.
+const documentedExample = `let mobile = inputs.mobileNumber.trim()
+if mobile.startsWith("0") {
+  mobile = "+94" + mobile.substring(1)
+}
+if has(claims.email) && claims.email.endsWith("@blocked.example") {
+  fail "Sign-ups from this domain are not allowed"
+}
+set runtime.mobileNumber = mobile`
+
+func TestRun_DocumentedExample(t *testing.T) {
+	tests := []struct {
+		name        string
+		mobile      string
+		email       string
+		wantMobile  string
+		wantFailure string
+	}{
+		{"local number", " 0771234567 ", "alice@example.com", "+94771234567", ""},
+		{"international number", "+94771234567", "alice@example.com", "+94771234567", ""},
+		{"number with inner zeros", "0710020030", "alice@example.com", "+94710020030", ""},
+		{"blocked domain", "0771234567", "mallory@blocked.example", "", "Sign-ups from this domain are not allowed"},
+	}
+
+	s, err := Compile(documentedExample)
+	require.NoError(t, err)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := s.Run(context.Background(), map[string]interface{}{
+				"inputs": map[string]string{"mobileNumber": tc.mobile},
+				"claims": map[string]interface{}{"email": tc.email},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantFailure, result.FailureMessage)
+			assert.Equal(t, tc.wantMobile, result.RuntimeData["mobileNumber"])
+		})
+	}
+}
+
+func TestRun_Reassignment(t *testing.T) {
+	result := run(t, `
+let count = int(runtime.attemptCount)
+if count < 3 {
+    count = count + 1
+}
+set runtime.attemptCount = count
+`)
+
+	assert.Equal(t, map[string]string{"attemptCount": "3"}, result.RuntimeData)
+}
+
+func TestRun_DoesNotModifyInputs(t *testing.T) {
+	vars := testVars()
+	s, err := Compile(`set runtime.attemptCount = "9"`)
+	require.NoError(t, err)
+
+	_, err = s.Run(context.Background(), vars)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"attemptCount": "2"}, vars["runtime"])
+}
+
+func TestRun_Fail(t *testing.T) {
+	result := run(t, `
+set runtime.checked = true
+if inputs.email.endsWith("@blocked.example") {
+    fail "Sign-ups from " + inputs.email.split("@")[1] + " are not allowed"
+}
+set runtime.unreachable = true
+`)
+
+	assert.True(t, result.Failed())
+	assert.Equal(t, OutcomeFailure, result.Outcome)
+	assert.Equal(t, "Sign-ups from blocked.example are not allowed", result.FailureMessage)
+	assert.Equal(t, map[string]string{"checked": "true"}, result.RuntimeData)
+}
+
+func TestRun_Outcome(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{"explicit", `outcome "step_up"`, "step_up"},
+		{"computed", `outcome claims.country == "LK" ? "local" : "foreign"`, "local"},
+		{"failure without message", `outcome "failure"`, OutcomeFailure},
+		{"else if branch", "if claims.country == \"US\" {\n outcome \"us\"\n} else if claims.country == \"LK\" {\n" +
+			" outcome \"lk\"\n} else {\n outcome \"other\"\n}", "lk"},
+		{"else branch", "if false {\n outcome \"a\"\n} else {\n outcome \"b\"\n}", "b"},
+		{"stops at first outcome", "outcome \"first\"\noutcome \"second\"", "first"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, run(t, tc.source).Outcome)
+		})
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{"evaluation error", `set runtime.x = claims.missing`, `line 1: no such key "missing"`},
+		{"non scalar runtime value", `set runtime.x = [1]`, "runtime.x must be a string, number or bool, got a list"},
+		{"null runtime value", `set runtime.x = null`, "got null"},
+		{"read-only variable", `let inputs = 1`, `cannot assign to read-only variable "inputs"`},
+		{"reassign read-only variable", `inputs = 1`, `cannot assign to read-only variable "inputs"`},
+		{"reassign unbound variable", `x = 1`, `cannot assign to "x" before it is bound with let`},
+		{"non string fail message", `fail 1`, "fail message must be a non-empty string"},
+		{"invalid outcome", `outcome "not valid"`, "outcome must be a name"},
+		{"non bool condition", "if 1 {\n}", "must evaluate to a bool"},
+		{"output too long", "let s = \"" + strings.Repeat("x", MaxOutputLength/2+1) + "\"\nset runtime.x = s + s",
+			"runtime.x exceeds the maximum length"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := Compile(tc.source)
+			require.NoError(t, err)
+			_, err = s.Run(context.Background(), testVars())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestRun_TooManyOutputs(t *testing.T) {
+	var b strings.Builder
+	for i := 0; i <= MaxOutputs; i++ {
+		fmt.Fprintf(&b, "set runtime.key%d = %d\n", i, i)
+	}
+	s, err := Compile(b.String())
+	require.NoError(t, err)
+
+	_, err = s.Run(context.Background(), testVars())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "runtime data entries")
+}
+
+func TestRun_MemoryBounded(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("let s = \"xxxxxxxxxxxxxxxx\"\n")
+	for i := 0; i < 20; i++ {
+		b.WriteString("let s = s + s\n")
+	}
+	s, err := Compile(b.String())
+	require.NoError(t, err)
+
+	_, err = s.Run(context.Background(), testVars())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "string exceeds the maximum length")
+}
+
+func TestRun_ContextDone(t *testing.T) {
+	s, err := Compile(`set runtime.x = 1`)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = s.Run(ctx, testVars())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "time limit")
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{"empty", "  \n# only a comment\n", "script has no statements"},
+		{"too long", strings.Repeat("#", MaxSourceLength+1), "maximum length"},
+		{"unknown statement", `print("x")`, `line 1: expected a let, set, if, fail, outcome or assignment statement`},
+		{"missing assignment", `let x`, `line 1: expected "="`},
+		{"equality instead of assignment", `let x == 1`, `expected "="`},
+		{"equality statement", `x == 1`, "expected a let, set, if, fail, outcome or assignment statement"},
+		{"reserved variable name", `let if = 1`, `invalid variable name "if"`},
+		{"invalid variable name", `let a.b = 1`, `invalid variable name "a.b"`},
+		{"set without runtime prefix", `set x = 1`, "runtime.<key>"},
+		{"invalid expression", "\nlet x = 1 +", "line 2: unexpected end of expression"},
+		{"unknown function", `fail exec("x")`, `unknown function "exec"`},
+		{"missing brace", "if true\n}", `expected "{"`},
+		{"unclosed block", "if true {\nlet x = 1", "block is not closed"},
+		{"stray brace", "}", `line 1: unexpected "}"`},
+		{"dangling else", "else {\n}", "expected a let, set, if, fail, outcome or assignment statement"},
+		{"junk after block", "if true {\n} then", `unexpected "} then" after if block`},
+		{"junk after else", "if true {\n} else {\n} x", `unexpected "} x" after else block`},
+		{"too many statements", strings.Repeat("let x = 1\n", MaxStatements+1), "maximum of 256 statements"},
+		{"too deep", strings.Repeat("if true {\n", MaxBlockDepth+1) + strings.Repeat("}\n", MaxBlockDepth+1),
+			"maximum block depth"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Compile(tc.source)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestScriptSource(t *testing.T) {
+	s, err := Compile(`outcome "a"`)
+	require.NoError(t, err)
+	assert.Equal(t, `outcome "a"`, s.Source())
+}
+
+func TestParseTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    time.Duration
+		wantErr bool
+	}{
+		{"default", nil, DefaultTimeout, false},
+		{"json number", float64(250), 250 * time.Millisecond, false},
+		{"int", 1000, time.Second, false},
+		{"int64", int64(5), 5 * time.Millisecond, false},
+		{"fractional", 1.5, 0, true},
+		{"zero", 0, 0, true},
+		{"over the maximum", 1001, 0, true},
+		{"string", "100", 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseTimeout(tc.value)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -112,6 +112,80 @@ func (_c *ServiceMock_FindCheckpoint_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// ListBySubject provides a mock function for the type ServiceMock
+func (_mock *ServiceMock) ListBySubject(ctx context.Context, subjectID string, now time.Time) ([]SubjectSession, error) {
+	ret := _mock.Called(ctx, subjectID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBySubject")
+	}
+
+	var r0 []SubjectSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]SubjectSession, error)); ok {
+		return returnFunc(ctx, subjectID, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) []SubjectSession); ok {
+		r0 = returnFunc(ctx, subjectID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]SubjectSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, subjectID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ServiceMock_ListBySubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBySubject'
+type ServiceMock_ListBySubject_Call struct {
+	*mock.Call
+}
+
+// ListBySubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subjectID string
+//   - now time.Time
+func (_e *ServiceMock_Expecter) ListBySubject(ctx interface{}, subjectID interface{}, now interface{}) *ServiceMock_ListBySubject_Call {
+	return &ServiceMock_ListBySubject_Call{Call: _e.mock.On("ListBySubject", ctx, subjectID, now)}
+}
+
+func (_c *ServiceMock_ListBySubject_Call) Run(run func(ctx context.Context, subjectID string, now time.Time)) *ServiceMock_ListBySubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ServiceMock_ListBySubject_Call) Return(subjectSessions []SubjectSession, err error) *ServiceMock_ListBySubject_Call {
+	_c.Call.Return(subjectSessions, err)
+	return _c
+}
+
+func (_c *ServiceMock_ListBySubject_Call) RunAndReturn(run func(ctx context.Context, subjectID string, now time.Time) ([]SubjectSession, error)) *ServiceMock_ListBySubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadCheckpoint provides a mock function for the type ServiceMock
 func (_mock *ServiceMock) LoadCheckpoint(ctx context.Context, in LoadCheckpointInput) (*Session, *SessionContext, error) {
 	ret := _mock.Called(ctx, in)
@@ -340,6 +414,46 @@ func (_c *ServiceMock_SaveCheckpoint_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// SetLogoutNotifier provides a mock function for the type ServiceMock
+func (_mock *ServiceMock) SetLogoutNotifier(notifier LogoutNotifier) {
+	_mock.Called(notifier)
+	return
+}
+
+// ServiceMock_SetLogoutNotifier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLogoutNotifier'
+type ServiceMock_SetLogoutNotifier_Call struct {
+	*mock.Call
+}
+
+// SetLogoutNotifier is a helper method to define mock.On call
+//   - notifier LogoutNotifier
+func (_e *ServiceMock_Expecter) SetLogoutNotifier(notifier interface{}) *ServiceMock_SetLogoutNotifier_Call {
+	return &ServiceMock_SetLogoutNotifier_Call{Call: _e.mock.On("SetLogoutNotifier", notifier)}
+}
+
+func (_c *ServiceMock_SetLogoutNotifier_Call) Run(run func(notifier LogoutNotifier)) *ServiceMock_SetLogoutNotifier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 LogoutNotifier
+		if args[0] != nil {
+			arg0 = args[0].(LogoutNotifier)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ServiceMock_SetLogoutNotifier_Call) Return() *ServiceMock_SetLogoutNotifier_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ServiceMock_SetLogoutNotifier_Call) RunAndReturn(run func(notifier LogoutNotifier)) *ServiceMock_SetLogoutNotifier_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Terminate provides a mock function for the type ServiceMock
 func (_mock *ServiceMock) Terminate(ctx context.Context, handle string, flowID string) (*Session, error) {
 	ret := _mock.Called(ctx, handle, flowID)
@@ -414,6 +528,80 @@ func (_c *ServiceMock_Terminate_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// TerminateByReference provides a mock function for the type ServiceMock
+func (_mock *ServiceMock) TerminateByReference(ctx context.Context, subjectID string, reference string) (*Session, error) {
+	ret := _mock.Called(ctx, subjectID, reference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TerminateByReference")
+	}
+
+	var r0 *Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*Session, error)); ok {
+		return returnFunc(ctx, subjectID, reference)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *Session); ok {
+		r0 = returnFunc(ctx, subjectID, reference)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, subjectID, reference)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ServiceMock_TerminateByReference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TerminateByReference'
+type ServiceMock_TerminateByReference_Call struct {
+	*mock.Call
+}
+
+// TerminateByReference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subjectID string
+//   - reference string
+func (_e *ServiceMock_Expecter) TerminateByReference(ctx interface{}, subjectID interface{}, reference interface{}) *ServiceMock_TerminateByReference_Call {
+	return &ServiceMock_TerminateByReference_Call{Call: _e.mock.On("TerminateByReference", ctx, subjectID, reference)}
+}
+
+func (_c *ServiceMock_TerminateByReference_Call) Run(run func(ctx context.Context, subjectID string, reference string)) *ServiceMock_TerminateByReference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ServiceMock_TerminateByReference_Call) Return(session *Session, err error) *ServiceMock_TerminateByReference_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *ServiceMock_TerminateByReference_Call) RunAndReturn(run func(ctx context.Context, subjectID string, reference string) (*Session, error)) *ServiceMock_TerminateByReference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TerminateBySubject provides a mock function for the type ServiceMock
 func (_mock *ServiceMock) TerminateBySubject(ctx context.Context, subjectID string) error {
 	ret := _mock.Called(ctx, subjectID)
@@ -93,6 +93,27 @@ type SSOInputs struct {
 	FlowID string
 	// FlowVersion is the current active version of the flow definition.
 	FlowVersion int
+	// ExecutionID is the current flow execution's id.
+	ExecutionID string
+}
+
+// EndedSession is a terminated session together with the applications that participated in it, as
+// handed to a LogoutNotifier.
+type EndedSession struct {
+	Session      Session
+	Participants []Participant
+	// ExecutionID is the flow execution that ended the session, or empty when it was not ended from
+	// within a flow.
+	ExecutionID string
+}
+
+// SubjectSession is a live session of a subject together with the applications participating in it,
+// as listed for the subject's self-service and administrative session views.
+type SubjectSession struct {
+	// Reference is the public identifier of the session, derived from its internal id.
+	Reference    string
+	Session      Session
+	Participants []Participant
 }
 
 type ssoInputsContextKey struct{}
@@ -12,6 +12,8 @@ package session
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -56,12 +58,28 @@ type Service interface {
 	// checkpoint contexts and participants, all in one transaction, so nothing is left that could back
 	// SSO or hold live grants. When flowID is non-empty the handle must belong to that flow, guarding
 	// against ending a session grouped under a different flow. It is idempotent, returning (nil, nil)
-	// when no session matches the handle, and returns the deleted session on success.
+	// when no session matches the handle, and returns the deleted session on success. Once the
+	// termination commits, the logout notifier (when one is set) is told about the ended session.
 	Terminate(ctx context.Context, handle, flowID string) (*Session, error)
 	// TerminateBySubject ends every SSO session belonging to the subject, revoking the subject's
 	// grants first so no session is deleted while its tokens remain live. It is idempotent, returning
 	// nil when the subject holds no sessions.
 	TerminateBySubject(ctx context.Context, subjectID string) error
+
+	// ListBySubject returns the subject's live sessions (ACTIVE and within their deadlines at now),
+	// each with its participating applications and the public reference that identifies it to
+	// TerminateByReference. Neither the internal session id nor the handle is meant to leave the
+	// server; callers present the session by its reference.
+	ListBySubject(ctx context.Context, subjectID string, now time.Time) ([]SubjectSession, error)
+	// TerminateByReference ends the subject's session identified by reference, exactly as Terminate
+	// does (revoking the participants' token families and notifying the logout notifier). It returns
+	// (nil, nil) when the subject holds no session with that reference.
+	TerminateByReference(ctx context.Context, subjectID, reference string) (*Session, error)
+
+	// SetLogoutNotifier injects the notifier told about every session Terminate or TerminateBySubject
+	// ends. It is wired after construction because the notifier lives in the OAuth layer, which is
+	// initialized after the flow engine that depends on this service.
+	SetLogoutNotifier(notifier LogoutNotifier)
 }
 
 // LoadCheckpointInput carries what a Session join needs to restore a checkpoint. Session and Context
@@ -103,13 +121,14 @@ type SaveCheckpointInput struct {
 	TokenFamilyID string
 }
 
-// SaveCheckpointResult reports the outcome of a save. Handle is the session's handle; Created is
-// true only when this call minted the session (so the caller emits the cookie); Skipped is true
-// when the save was declined because of a subject mismatch.
+// SaveCheckpointResult reports the outcome of a save. Handle is the session's handle and SessionID its
+// internal id; Created is true only when this call minted the session (so the caller emits the
+// cookie); Skipped is true when the save was declined because of a subject mismatch.
 type SaveCheckpointResult struct {
-	Handle  string
-	Created bool
-	Skipped bool
+	Handle    string
+	SessionID string
+	Created   bool
+	Skipped   bool
 }
 
 // CriteriaRevoker revokes a token family (one authorization grant) by its id. It is injected so session
@@ -119,6 +138,14 @@ type CriteriaRevoker interface {
 	RevokeTokenFamily(ctx context.Context, tokenFamilyID string) error
 }
 
+// LogoutNotifier is told when sessions end, so the protocol layer can notify the applications that
+// participated in them (OIDC back-channel and front-channel logout). It is injected so the session
+// package does not depend on the OAuth implementation. It runs after the termination has committed
+// and cannot fail it.
+type LogoutNotifier interface {
+	SessionsEnded(ctx context.Context, ended []EndedSession)
+}
+
 // service is the store-backed implementation of Service.
 type service struct {
 	store           sessionStore
@@ -127,6 +154,8 @@ type service struct {
 	criteriaRevoker CriteriaRevoker
 	timeouts        Timeouts
 	logger          *log.Logger
+
+	logoutNotifier LogoutNotifier
 }
 
 var _ Service = (*service)(nil)
@@ -197,7 +226,7 @@ func (s *service) SaveCheckpoint(ctx context.Context, in SaveCheckpointInput) (S
 	}
 
 	s.logger.Debug(ctx, "Saved SSO checkpoint", log.String("checkpoint", in.Checkpoint))
-	return SaveCheckpointResult{Handle: target.HandleID, Created: created}, nil
+	return SaveCheckpointResult{Handle: target.HandleID, SessionID: target.SessionID, Created: created}, nil
 }
 
 // LoadCheckpoint implements Service.
@@ -303,6 +332,10 @@ func (s *service) Terminate(ctx context.Context, handle, flowID string) (*Sessio
 	// (SSO_SESSION_PARTICIPANT). Repeated calls are idempotent: once the row is gone, GetByHandle
 	// returns nil above. Token families are revoked first, in the same transaction, so a crash can
 	// never orphan live tokens for a deleted session.
+	ended, err := s.collectEndedSessions(ctx, []Session{*sess})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session participants for termination: %w", err)
+	}
 	if txErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		if revErr := s.revokeSessionFamilies(txCtx, sess.SessionID); revErr != nil {
 			return revErr
@@ -319,6 +352,7 @@ func (s *service) Terminate(ctx context.Context, handle, flowID string) (*Sessio
 	}
 
 	s.logger.Debug(ctx, "Terminated SSO session", log.String("flowId", sess.FlowID))
+	s.notifySessionsEnded(ctx, ended)
 	return sess, nil
 }
 
@@ -347,6 +381,10 @@ func (s *service) TerminateBySubject(ctx context.Context, subjectID string) erro
 	if len(sessions) == 0 {
 		return nil
 	}
+	ended, err := s.collectEndedSessions(ctx, sessions)
+	if err != nil {
+		return fmt.Errorf("failed to list session participants for termination: %w", err)
+	}
 
 	if txErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		for _, sess := range sessions {
@@ -366,9 +404,95 @@ func (s *service) TerminateBySubject(ctx context.Context, subjectID string) erro
 	}
 
 	s.logger.Debug(ctx, "Terminated all SSO sessions for subject", log.Int("sessionCount", len(sessions)))
+	s.notifySessionsEnded(ctx, ended)
 	return nil
 }
 
+// ListBySubject implements Service.
+func (s *service) ListBySubject(ctx context.Context, subjectID string, now time.Time) ([]SubjectSession, error) {
+	if subjectID == "" {
+		return nil, nil
+	}
+	sessions, err := s.store.ListBySubject(ctx, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions by subject: %w", err)
+	}
+
+	live := make([]SubjectSession, 0, len(sessions))
+	for _, sess := range sessions {
+		if sess.State != StateActive || expired(sess.IdleExpiresAt, now) || expired(sess.AbsoluteExpiresAt, now) {
+			continue
+		}
+		participants, err := s.store.ListBySessionID(ctx, sess.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list session participants: %w", err)
+		}
+		live = append(live, SubjectSession{
+			Reference:    sessionReference(sess.SessionID),
+			Session:      sess,
+			Participants: participants,
+		})
+	}
+	return live, nil
+}
+
+// TerminateByReference implements Service. The reference is matched against every session of the
+// subject, live or not, so a session that has just expired can still be cleaned up.
+func (s *service) TerminateByReference(ctx context.Context, subjectID, reference string) (*Session, error) {
+	if subjectID == "" || reference == "" {
+		return nil, nil
+	}
+	sessions, err := s.store.ListBySubject(ctx, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions by subject: %w", err)
+	}
+	for _, sess := range sessions {
+		if sessionReference(sess.SessionID) == reference {
+			return s.Terminate(ctx, sess.HandleID, "")
+		}
+	}
+	return nil, nil
+}
+
+// SetLogoutNotifier implements Service.
+func (s *service) SetLogoutNotifier(notifier LogoutNotifier) {
+	s.logoutNotifier = notifier
+}
+
+// collectEndedSessions reads the participants of the sessions about to be terminated, so the logout
+// notifier can still reach them once the participant rows are deleted. It reads nothing when no
+// notifier is wired.
+func (s *service) collectEndedSessions(ctx context.Context, sessions []Session) ([]EndedSession, error) {
+	if s.logoutNotifier == nil {
+		return nil, nil
+	}
+	executionID := SSOInputsFrom(ctx).ExecutionID
+	ended := make([]EndedSession, 0, len(sessions))
+	for _, sess := range sessions {
+		participants, err := s.store.ListBySessionID(ctx, sess.SessionID)
+		if err != nil {
+			return nil, err
+		}
+		ended = append(ended, EndedSession{Session: sess, Participants: participants, ExecutionID: executionID})
+	}
+	return ended, nil
+}
+
+// notifySessionsEnded hands the terminated sessions to the logout notifier, when one is wired.
+func (s *service) notifySessionsEnded(ctx context.Context, ended []EndedSession) {
+	if s.logoutNotifier == nil || len(ended) == 0 {
+		return
+	}
+	s.logoutNotifier.SessionsEnded(ctx, ended)
+}
+
+// sessionReference derives the public reference of a session from its internal id. It is a one-way
+// digest, so presenting it to the subject exposes neither the internal id nor the handle.
+func sessionReference(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
 // revokeSessionFamilies revokes the token family of every application participating in the session,
 // so signing out of a login drops all of that login's grants. It is a no-op when no family revoker is
 // wired. A participant recorded before tfid was introduced (empty tfid) is skipped by the revoker.
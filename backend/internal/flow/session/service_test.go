@@ -661,6 +661,71 @@ func (suite *ServiceTestSuite) TestTerminate_DifferentFlowErrors() {
 	suite.Nil(got)
 }
 
+// Participants are read before the deletes and handed to the logout notifier only once the
+// termination has committed, together with the execution that ended the session.
+func (suite *ServiceTestSuite) TestTerminate_NotifiesLogoutNotifier() {
+	svc, m := suite.newService()
+	notifier := NewLogoutNotifierMock(suite.T())
+	svc.SetLogoutNotifier(notifier)
+	participants := []Participant{{SessionID: "sess-1", AppID: "app-1"}}
+
+	m.store.EXPECT().GetByHandle(mock.Anything, "handle-abc").Return(liveStoreSession(), nil)
+	m.store.EXPECT().ListBySessionID(mock.Anything, "sess-1").Return(participants, nil).Once()
+	runTx(m)
+	m.store.EXPECT().DeleteSession(mock.Anything, "sess-1").Return(nil)
+	m.store.EXPECT().Delete(mock.Anything, "sess-1").Return(nil)
+	m.store.EXPECT().DeleteBySessionID(mock.Anything, "sess-1").Return(nil)
+	notifier.EXPECT().SessionsEnded(mock.Anything, mock.MatchedBy(func(ended []EndedSession) bool {
+		return len(ended) == 1 && ended[0].Session.SessionID == "sess-1" &&
+			len(ended[0].Participants) == 1 && ended[0].ExecutionID == "exec-1"
+	})).Once()
+
+	ctx := WithSSOInputs(context.Background(), SSOInputs{Handle: "handle-abc", ExecutionID: "exec-1"})
+	_, err := svc.Terminate(ctx, "handle-abc", "flow-1")
+
+	suite.Require().NoError(err)
+}
+
+func (suite *ServiceTestSuite) TestTerminate_FailureDoesNotNotify() {
+	svc, m := suite.newService()
+	notifier := NewLogoutNotifierMock(suite.T())
+	svc.SetLogoutNotifier(notifier)
+
+	m.store.EXPECT().GetByHandle(mock.Anything, "handle-abc").Return(liveStoreSession(), nil)
+	m.store.EXPECT().ListBySessionID(mock.Anything, "sess-1").Return(nil, nil)
+	runTx(m)
+	m.store.EXPECT().DeleteSession(mock.Anything, mock.Anything).Return(errors.New("store down"))
+
+	_, err := svc.Terminate(context.Background(), "handle-abc", "flow-1")
+
+	suite.Require().Error(err)
+	notifier.AssertNotCalled(suite.T(), "SessionsEnded", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestTerminateBySubject_NotifiesLogoutNotifier() {
+	svc, m := suite.newService()
+	notifier := NewLogoutNotifierMock(suite.T())
+	svc.SetLogoutNotifier(notifier)
+
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{
+		{SessionID: "sess-1", SubjectID: "user-1"},
+		{SessionID: "sess-2", SubjectID: "user-1"},
+	}, nil)
+	runTx(m)
+	for _, sessionID := range []string{"sess-1", "sess-2"} {
+		m.store.EXPECT().ListBySessionID(mock.Anything, sessionID).
+			Return([]Participant{{SessionID: sessionID, AppID: "app-1"}}, nil)
+		m.store.EXPECT().DeleteSession(mock.Anything, sessionID).Return(nil)
+		m.store.EXPECT().Delete(mock.Anything, sessionID).Return(nil)
+		m.store.EXPECT().DeleteBySessionID(mock.Anything, sessionID).Return(nil)
+	}
+	notifier.EXPECT().SessionsEnded(mock.Anything, mock.MatchedBy(func(ended []EndedSession) bool {
+		return len(ended) == 2 && ended[1].Session.SessionID == "sess-2"
+	})).Once()
+
+	suite.Require().NoError(svc.TerminateBySubject(context.Background(), "user-1"))
+}
+
 func (suite *ServiceTestSuite) TestTerminate_DeleteError() {
 	svc, m := suite.newService()
 	m.store.EXPECT().GetByHandle(mock.Anything, "handle-abc").Return(liveStoreSession(), nil)
@@ -672,3 +737,72 @@ func (suite *ServiceTestSuite) TestTerminate_DeleteError() {
 	suite.Require().Error(err)
 	suite.Contains(err.Error(), "failed to terminate session")
 }
+
+// --- ListBySubject / TerminateByReference ---
+
+func (suite *ServiceTestSuite) TestListBySubject_ReturnsLiveSessionsWithParticipants() {
+	svc, m := suite.newService()
+	now := time.Now()
+	live := *liveStoreSession()
+	live.IdleExpiresAt = now.Add(time.Hour)
+	idleExpired := *liveStoreSession()
+	idleExpired.SessionID = "sess-2"
+	idleExpired.IdleExpiresAt = now.Add(-time.Minute)
+	ended := *liveStoreSession()
+	ended.SessionID = "sess-3"
+	ended.State = StateEnded
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{live, idleExpired, ended}, nil)
+	m.store.EXPECT().ListBySessionID(mock.Anything, "sess-1").Return([]Participant{
+		{SessionID: "sess-1", AppID: "app-1"},
+	}, nil)
+
+	got, err := svc.ListBySubject(context.Background(), "user-1", now)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(got, 1)
+	suite.Equal("sess-1", got[0].Session.SessionID)
+	suite.Equal(sessionReference("sess-1"), got[0].Reference)
+	suite.NotContains(got[0].Reference, "sess-1", "the reference does not reveal the internal id")
+	suite.Len(got[0].Participants, 1)
+}
+
+func (suite *ServiceTestSuite) TestListBySubject_StoreError() {
+	svc, m := suite.newService()
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return(nil, errors.New("db down"))
+
+	got, err := svc.ListBySubject(context.Background(), "user-1", time.Now())
+
+	suite.Require().Error(err)
+	suite.Nil(got)
+}
+
+func (suite *ServiceTestSuite) TestTerminateByReference_TerminatesMatchingSession() {
+	svc, m := suite.newService()
+	other := *liveStoreSession()
+	other.SessionID = "sess-2"
+	other.HandleID = "handle-other"
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{other, *liveStoreSession()}, nil)
+	m.store.EXPECT().GetByHandle(mock.Anything, "handle-abc").Return(liveStoreSession(), nil)
+	runTx(m)
+	m.store.EXPECT().DeleteSession(mock.Anything, "sess-1").Return(nil)
+	m.store.EXPECT().Delete(mock.Anything, "sess-1").Return(nil)
+	m.store.EXPECT().DeleteBySessionID(mock.Anything, "sess-1").Return(nil)
+
+	got, err := svc.TerminateByReference(context.Background(), "user-1", sessionReference("sess-1"))
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(got)
+	suite.Equal("sess-1", got.SessionID)
+	m.store.AssertNotCalled(suite.T(), "GetByHandle", mock.Anything, "handle-other")
+}
+
+func (suite *ServiceTestSuite) TestTerminateByReference_UnknownReferenceIsNoOp() {
+	svc, m := suite.newService()
+	m.store.EXPECT().ListBySubject(mock.Anything, "user-1").Return([]Session{*liveStoreSession()}, nil)
+
+	got, err := svc.TerminateByReference(context.Background(), "user-1", sessionReference("sess-9"))
+
+	suite.Require().NoError(err)
+	suite.Nil(got)
+	m.tx.AssertNotCalled(suite.T(), "Transact", mock.Anything, mock.Anything)
+}
@@ -11,7 +11,8 @@ var (
 	// queryCreateSession inserts a new SSO session. It is idempotent per establishing flow execution:
 	// on a FLOW_EXECUTION_ID conflict it does nothing, so concurrent joins in one execution converge
 	// on the single session that won the race (the caller re-reads it via queryGetSessionByExecutionID).
-	// The ON CONFLICT ... DO NOTHING form is valid in both PostgreSQL and SQLite.
+	// The ON CONFLICT ... DO NOTHING form is valid in both PostgreSQL and SQLite; MySQL uses a no-op
+	// ON DUPLICATE KEY UPDATE so that errors other than the conflict still surface.
 	queryCreateSession = model.DBQuery{
 		ID: "SSO-SESS-01",
 		Query: `INSERT INTO "SSO_SESSION" (SESSION_ID, DEPLOYMENT_ID, SUBJECT_ID, FLOW_ID, FLOW_VERSION, ` +
@@ -19,6 +20,11 @@ var (
 			`AUTHENTICATED_AT, CREATED_AT, LAST_ACTIVE_AT, IDLE_EXPIRES_AT, ABSOLUTE_EXPIRES_AT, STATE, VERSION) ` +
 			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ` +
 			`ON CONFLICT (FLOW_EXECUTION_ID, DEPLOYMENT_ID) DO NOTHING`,
+		MySQLQuery: `INSERT INTO "SSO_SESSION" (SESSION_ID, DEPLOYMENT_ID, SUBJECT_ID, FLOW_ID, FLOW_VERSION, ` +
+			`FLOW_EXECUTION_ID, HANDLE_ID, ` +
+			`AUTHENTICATED_AT, CREATED_AT, LAST_ACTIVE_AT, IDLE_EXPIRES_AT, ABSOLUTE_EXPIRES_AT, STATE, VERSION) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ` +
+			`ON DUPLICATE KEY UPDATE SESSION_ID = SESSION_ID`,
 	}
 
 	// queryGetSessionByHandle fetches a session by its opaque handle ID. Liveness checks
@@ -53,13 +59,17 @@ var (
 
 	// queryCreateSessionContext upserts a checkpoint's session context. Re-saving the same checkpoint
 	// (re-execution or a concurrent request) overwrites it rather than erroring on the primary key.
-	// The ON CONFLICT ... DO UPDATE form is valid in both PostgreSQL and SQLite.
+	// The ON CONFLICT ... DO UPDATE form is valid in both PostgreSQL and SQLite; MySQL uses
+	// ON DUPLICATE KEY UPDATE.
 	queryCreateSessionContext = model.DBQuery{
 		ID: "SSO-SESS-05",
 		Query: `INSERT INTO "SSO_SESSION_CONTEXT" (SESSION_ID, DEPLOYMENT_ID, CHECKPOINT_ID, CONTEXT, ` +
 			`CONTEXT_VERSION) VALUES ($1, $2, $3, $4, $5) ` +
 			`ON CONFLICT (SESSION_ID, DEPLOYMENT_ID, CHECKPOINT_ID) DO UPDATE SET ` +
 			`CONTEXT = excluded.CONTEXT, CONTEXT_VERSION = excluded.CONTEXT_VERSION`,
+		MySQLQuery: `INSERT INTO "SSO_SESSION_CONTEXT" (SESSION_ID, DEPLOYMENT_ID, CHECKPOINT_ID, CONTEXT, ` +
+			`CONTEXT_VERSION) VALUES ($1, $2, $3, $4, $5) ` +
+			`ON DUPLICATE KEY UPDATE CONTEXT = VALUES(CONTEXT), CONTEXT_VERSION = VALUES(CONTEXT_VERSION)`,
 	}
 
 	// queryGetSessionContextByCheckpoint fetches one checkpoint's session context for a session.
@@ -78,7 +88,8 @@ var (
 	// queryUpsertParticipant records an application as a participant of a session, refreshing
 	// LAST_ACTIVE_AT and the current-grant TFID (but preserving FIRST_JOINED_AT) when the application
 	// has already joined. TFID moves to the latest grant so logout revokes the most recent family.
-	// The ON CONFLICT ... DO UPDATE form is valid in both PostgreSQL and SQLite.
+	// The ON CONFLICT ... DO UPDATE form is valid in both PostgreSQL and SQLite; MySQL uses
+	// ON DUPLICATE KEY UPDATE.
 	queryUpsertParticipant = model.DBQuery{
 		ID: "SSO-SESS-09",
 		Query: `INSERT INTO "SSO_SESSION_PARTICIPANT" ` +
@@ -86,6 +97,10 @@ var (
 			`VALUES ($1, $2, $3, $4, $5, $6) ` +
 			`ON CONFLICT (SESSION_ID, DEPLOYMENT_ID, APP_ID) DO UPDATE SET ` +
 			`LAST_ACTIVE_AT = excluded.LAST_ACTIVE_AT, TFID = excluded.TFID`,
+		MySQLQuery: `INSERT INTO "SSO_SESSION_PARTICIPANT" ` +
+			`(SESSION_ID, DEPLOYMENT_ID, APP_ID, FIRST_JOINED_AT, LAST_ACTIVE_AT, TFID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6) ` +
+			`ON DUPLICATE KEY UPDATE LAST_ACTIVE_AT = VALUES(LAST_ACTIVE_AT), TFID = VALUES(TFID)`,
 	}
 
 	// queryListParticipantsBySessionID returns the applications that have joined a session, oldest
@@ -0,0 +1,101 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"errors"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Internal store errors.
+var (
+	// ErrNotFound is returned when the requested scenario does not exist.
+	ErrNotFound = errors.New("simulation scenario not found")
+)
+
+// Client errors for flow simulation operations.
+var (
+	// ErrorInvalidRequestFormat indicates a malformed request body.
+	ErrorInvalidRequestFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FSM-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorScenarioNotFound indicates the saved scenario does not exist for the flow.
+	ErrorScenarioNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FSM-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.scenario_not_found",
+			DefaultValue: "Simulation scenario not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.scenario_not_found_description",
+			DefaultValue: "No simulation scenario exists for the supplied identifier in this flow",
+		},
+	}
+	// ErrorInvalidScenarioName indicates a missing or over-long scenario name, or an over-long
+	// description.
+	ErrorInvalidScenarioName = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FSM-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.invalid_scenario_name",
+			DefaultValue: "Invalid scenario name",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.invalid_scenario_name_description",
+			DefaultValue: "The name is required and at most 255 characters; the description is at most 1024",
+		},
+	}
+	// ErrorInvalidScenarioSelection indicates a simulation request without exactly one of an inline
+	// scenario and a saved scenario id.
+	ErrorInvalidScenarioSelection = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FSM-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.invalid_scenario_selection",
+			DefaultValue: "Invalid scenario selection",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.invalid_scenario_selection_description",
+			DefaultValue: "Provide either an inline scenario or the id of a saved scenario",
+		},
+	}
+	// ErrorInvalidFlowSelection indicates a request that selects both a stored version and a draft
+	// definition of the flow.
+	ErrorInvalidFlowSelection = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FSM-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.invalid_flow_selection",
+			DefaultValue: "Invalid flow selection",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.invalid_flow_selection_description",
+			DefaultValue: "Provide either a flow version or a draft definition, not both",
+		},
+	}
+	// ErrorScenarioRequired indicates a saved scenario request without a scenario.
+	ErrorScenarioRequired = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FSM-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.scenario_required",
+			DefaultValue: "Scenario required",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.flowsimulationservice.scenario_required_description",
+			DefaultValue: "A saved scenario must define its steps and the outcome it expects",
+		},
+	}
+)
@@ -0,0 +1,163 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	simulatePath  = "/flows/{flowId}/simulate"
+	scenariosPath = "/flows/{flowId}/simulation-scenarios"
+)
+
+// simulationHandler serves the flow simulation API.
+type simulationHandler struct {
+	service SimulationServiceInterface
+}
+
+// newSimulationHandler creates a new instance of simulationHandler.
+func newSimulationHandler(service SimulationServiceInterface) *simulationHandler {
+	return &simulationHandler{service: service}
+}
+
+// HandleSimulate simulates a flow against a scenario.
+func (h *simulationHandler) HandleSimulate(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	req, err := sysutils.DecodeJSONBody[SimulationRequest](r)
+	if err != nil {
+		writeSimulationError(r.Context(), w, &ErrorInvalidRequestFormat)
+		return
+	}
+	req.ScenarioID = strings.TrimSpace(req.ScenarioID)
+	result, svcErr := h.service.Simulate(r.Context(), flowID, req)
+	if svcErr != nil {
+		writeSimulationError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, result)
+}
+
+// HandleRunScenarios replays the saved scenarios of a flow.
+func (h *simulationHandler) HandleRunScenarios(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	selection := &FlowSelection{}
+	if r.ContentLength != 0 {
+		decoded, err := sysutils.DecodeJSONBody[FlowSelection](r)
+		if err != nil {
+			writeSimulationError(r.Context(), w, &ErrorInvalidRequestFormat)
+			return
+		}
+		selection = decoded
+	}
+	result, svcErr := h.service.RunScenarios(r.Context(), flowID, selection)
+	if svcErr != nil {
+		writeSimulationError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, result)
+}
+
+// HandleCreateScenario saves a scenario against a flow.
+func (h *simulationHandler) HandleCreateScenario(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	req, err := sysutils.DecodeJSONBody[SavedScenarioRequest](r)
+	if err != nil {
+		writeSimulationError(r.Context(), w, &ErrorInvalidRequestFormat)
+		return
+	}
+	scenario, svcErr := h.service.CreateScenario(r.Context(), flowID, sanitizeScenarioRequest(req))
+	if svcErr != nil {
+		writeSimulationError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusCreated, scenario)
+}
+
+// HandleListScenarios returns the saved scenarios of a flow.
+func (h *simulationHandler) HandleListScenarios(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	scenarios, svcErr := h.service.ListScenarios(r.Context(), flowID)
+	if svcErr != nil {
+		writeSimulationError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, scenarios)
+}
+
+// HandleGetScenario returns a saved scenario of a flow.
+func (h *simulationHandler) HandleGetScenario(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	id := strings.TrimSpace(r.PathValue("scenarioId"))
+	scenario, svcErr := h.service.GetScenario(r.Context(), flowID, id)
+	if svcErr != nil {
+		writeSimulationError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, scenario)
+}
+
+// HandleUpdateScenario replaces a saved scenario of a flow.
+func (h *simulationHandler) HandleUpdateScenario(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	id := strings.TrimSpace(r.PathValue("scenarioId"))
+	req, err := sysutils.DecodeJSONBody[SavedScenarioRequest](r)
+	if err != nil {
+		writeSimulationError(r.Context(), w, &ErrorInvalidRequestFormat)
+		return
+	}
+	scenario, svcErr := h.service.UpdateScenario(r.Context(), flowID, id, sanitizeScenarioRequest(req))
+	if svcErr != nil {
+		writeSimulationError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, scenario)
+}
+
+// HandleDeleteScenario removes a saved scenario of a flow.
+func (h *simulationHandler) HandleDeleteScenario(w http.ResponseWriter, r *http.Request) {
+	flowID := strings.TrimSpace(r.PathValue("flowId"))
+	id := strings.TrimSpace(r.PathValue("scenarioId"))
+	if svcErr := h.service.DeleteScenario(r.Context(), flowID, id); svcErr != nil {
+		writeSimulationError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// sanitizeScenarioRequest returns a copy of the request with its free-text fields sanitized. The
+// scenario is used verbatim, since its inputs and expected values are compared as given.
+func sanitizeScenarioRequest(req *SavedScenarioRequest) *SavedScenarioRequest {
+	return &SavedScenarioRequest{
+		Name:        sysutils.SanitizeString(req.Name),
+		Description: sysutils.SanitizeString(req.Description),
+		Scenario:    req.Scenario,
+	}
+}
+
+// writeSimulationError maps a service error to an HTTP status and writes the corresponding error
+// response.
+func writeSimulationError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	status := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		status = http.StatusBadRequest
+		if svcErr.Code == ErrorScenarioNotFound.Code || svcErr.Code == flowmgt.ErrorFlowNotFound.Code ||
+			svcErr.Code == flowmgt.ErrorVersionNotFound.Code {
+			status = http.StatusNotFound
+		}
+	}
+	sysutils.WriteErrorResponse(ctx, w, status, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
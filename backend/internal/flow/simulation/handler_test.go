@@ -0,0 +1,131 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type SimulationHandlerTestSuite struct {
+	suite.Suite
+	mockService *SimulationServiceInterfaceMock
+	mux         *http.ServeMux
+}
+
+func TestSimulationHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SimulationHandlerTestSuite))
+}
+
+func (suite *SimulationHandlerTestSuite) SetupTest() {
+	suite.mockService = NewSimulationServiceInterfaceMock(suite.T())
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, newSimulationHandler(suite.mockService))
+}
+
+func (suite *SimulationHandlerTestSuite) serve(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *SimulationHandlerTestSuite) TestHandleSimulate_Success() {
+	suite.mockService.On("Simulate", mock.Anything, testFlowID, mock.MatchedBy(func(req *SimulationRequest) bool {
+		return req.Version != nil && *req.Version == 2 && req.Scenario != nil && len(req.Scenario.Steps) == 1
+	})).Return(&flowexec.SimulationResult{Status: "COMPLETE"}, nil)
+
+	w := suite.serve(http.MethodPost, "/flows/flow-1/simulate",
+		`{"version":2,"scenario":{"steps":[{"action":"action_001","inputs":{"username":"alice"}}]}}`)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var result flowexec.SimulationResult
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	suite.Equal("COMPLETE", string(result.Status))
+}
+
+func (suite *SimulationHandlerTestSuite) TestHandleSimulate_InvalidBody() {
+	w := suite.serve(http.MethodPost, "/flows/flow-1/simulate", "{")
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (suite *SimulationHandlerTestSuite) TestHandleSimulate_ErrorStatuses() {
+	tests := []struct {
+		name     string
+		svcErr   *tidcommon.ServiceError
+		expected int
+	}{
+		{"FlowNotFound", &flowmgt.ErrorFlowNotFound, http.StatusNotFound},
+		{"VersionNotFound", &flowmgt.ErrorVersionNotFound, http.StatusNotFound},
+		{"ScenarioNotFound", &ErrorScenarioNotFound, http.StatusNotFound},
+		{"InvalidSelection", &ErrorInvalidScenarioSelection, http.StatusBadRequest},
+		{"ServerError", &tidcommon.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.mockService.On("Simulate", mock.Anything, testFlowID, mock.Anything).Return(nil, tc.svcErr).Once()
+
+			w := suite.serve(http.MethodPost, "/flows/flow-1/simulate", `{"scenarioId":"sc-1"}`)
+			suite.Equal(tc.expected, w.Code)
+		})
+	}
+}
+
+func (suite *SimulationHandlerTestSuite) TestHandleRunScenarios_EmptyBody() {
+	suite.mockService.On("RunScenarios", mock.Anything, testFlowID, &FlowSelection{}).
+		Return(&SuiteResult{Total: 1, Passed: 1}, nil)
+
+	w := suite.serve(http.MethodPost, "/flows/flow-1/simulation-scenarios/run", "")
+
+	suite.Equal(http.StatusOK, w.Code)
+	var result SuiteResult
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	suite.Equal(1, result.Passed)
+}
+
+func (suite *SimulationHandlerTestSuite) TestHandleCreateScenario_SanitizesName() {
+	isSanitized := mock.MatchedBy(func(req *SavedScenarioRequest) bool {
+		return req.Name == "Happy path" && req.Scenario != nil
+	})
+	suite.mockService.On("CreateScenario", mock.Anything, testFlowID, isSanitized).
+		Return(&SavedScenario{ID: "sc-1", FlowID: testFlowID, Name: "Happy path"}, nil)
+
+	w := suite.serve(http.MethodPost, "/flows/flow-1/simulation-scenarios",
+		`{"name":"  Happy path ","scenario":{"expect":{"status":"COMPLETE"}}}`)
+
+	suite.Equal(http.StatusCreated, w.Code)
+}
+
+func (suite *SimulationHandlerTestSuite) TestHandleGetScenario_NotFound() {
+	suite.mockService.On("GetScenario", mock.Anything, testFlowID, "sc-1").Return(nil, &ErrorScenarioNotFound)
+
+	w := suite.serve(http.MethodGet, "/flows/flow-1/simulation-scenarios/sc-1", "")
+
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *SimulationHandlerTestSuite) TestHandleDeleteScenario_Success() {
+	suite.mockService.On("DeleteScenario", mock.Anything, testFlowID, "sc-1").Return(nil)
+
+	w := suite.serve(http.MethodDelete, "/flows/flow-1/simulation-scenarios/sc-1", "")
+
+	suite.Equal(http.StatusNoContent, w.Code)
+}
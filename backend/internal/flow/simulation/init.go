@@ -0,0 +1,73 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package simulation provides the flow simulation API, which runs flow definitions against scripted
+// scenarios with stubbed executors, and the management of the scenarios saved against each flow as
+// its regression suite.
+package simulation
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize constructs the flow simulation service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	deploymentID string,
+	flowMgtService flowmgt.FlowMgtServiceInterface,
+	simulator flowexec.FlowSimulatorInterface,
+) SimulationServiceInterface {
+	simulationService := newSimulationService(newScenarioStore(deploymentID), flowMgtService, simulator)
+	registerRoutes(mux, newSimulationHandler(simulationService))
+	return simulationService
+}
+
+// registerRoutes registers the flow simulation routes.
+func registerRoutes(mux *http.ServeMux, h *simulationHandler) {
+	collectionOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	resourceOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	actionOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	resourcePath := scenariosPath + "/{scenarioId}"
+	runPath := scenariosPath + "/run"
+
+	mux.HandleFunc(middleware.WithCORS("POST "+simulatePath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleSimulate)).ServeHTTP, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+runPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleRunScenarios)).ServeHTTP, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+scenariosPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleCreateScenario)).ServeHTTP, collectionOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+scenariosPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleListScenarios)).ServeHTTP, collectionOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+resourcePath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleGetScenario)).ServeHTTP, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT "+resourcePath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleUpdateScenario)).ServeHTTP, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+resourcePath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleDeleteScenario)).ServeHTTP, resourceOpts))
+
+	noContent := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) }
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+simulatePath, noContent, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+runPath, noContent, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+scenariosPath, noContent, collectionOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+resourcePath, noContent, resourceOpts))
+}
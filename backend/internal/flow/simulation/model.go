@@ -0,0 +1,73 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+)
+
+// FlowDraft is an unsaved definition of a flow. It is simulated in place of the stored definition,
+// keeping the handle, name and type of the stored flow.
+type FlowDraft struct {
+	Interceptors []providers.InterceptorDefinition `json:"interceptors,omitempty"`
+	Nodes        []providers.NodeDefinition        `json:"nodes"`
+}
+
+// FlowSelection selects the definition of a flow to simulate. The active version is used when
+// neither a version nor a draft is given.
+type FlowSelection struct {
+	Version    *int       `json:"version,omitempty"`
+	Definition *FlowDraft `json:"definition,omitempty"`
+}
+
+// SimulationRequest is the request body for simulating a flow against a single scenario.
+type SimulationRequest struct {
+	FlowSelection
+	// Scenario is an inline scenario to run. Exactly one of Scenario and ScenarioID is required.
+	Scenario *flowexec.SimulationScenario `json:"scenario,omitempty"`
+	// ScenarioID is the id of a saved scenario of the flow to run.
+	ScenarioID string `json:"scenarioId,omitempty"`
+}
+
+// SavedScenario is a simulation scenario saved against a flow, replayed as part of the regression
+// suite of the flow.
+type SavedScenario struct {
+	ID          string                      `json:"id"`
+	FlowID      string                      `json:"flowId"`
+	Name        string                      `json:"name"`
+	Description string                      `json:"description,omitempty"`
+	Scenario    flowexec.SimulationScenario `json:"scenario"`
+}
+
+// SavedScenarioRequest is the request body for saving or updating a simulation scenario.
+type SavedScenarioRequest struct {
+	Name        string                       `json:"name"`
+	Description string                       `json:"description,omitempty"`
+	Scenario    *flowexec.SimulationScenario `json:"scenario"`
+}
+
+// SavedScenarioListResponse is the response body for listing the saved scenarios of a flow.
+type SavedScenarioListResponse struct {
+	TotalResults int             `json:"totalResults"`
+	Scenarios    []SavedScenario `json:"scenarios"`
+}
+
+// SuiteResult reports a run of all saved scenarios of a flow.
+type SuiteResult struct {
+	Total   int              `json:"total"`
+	Passed  int              `json:"passed"`
+	Failed  int              `json:"failed"`
+	Results []ScenarioResult `json:"results"`
+}
+
+// ScenarioResult reports the run of one saved scenario in a suite.
+type ScenarioResult struct {
+	ScenarioID string                     `json:"scenarioId"`
+	Name       string                     `json:"name"`
+	Passed     bool                       `json:"passed"`
+	Failures   []string                   `json:"failures,omitempty"`
+	Result     *flowexec.SimulationResult `json:"result"`
+}
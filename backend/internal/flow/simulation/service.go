@@ -0,0 +1,264 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"context"
+	"errors"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	loggerComponentName = "FlowSimulationService"
+
+	// maxNameLength is the size of the NAME column.
+	maxNameLength = 255
+	// maxDescriptionLength is the size of the DESCRIPTION column.
+	maxDescriptionLength = 1024
+)
+
+// SimulationServiceInterface defines the flow simulation operations and the management of the saved
+// scenarios of flows.
+type SimulationServiceInterface interface {
+	// Simulate runs the selected definition of a flow against an inline or saved scenario.
+	Simulate(ctx context.Context, flowID string, req *SimulationRequest) (
+		*flowexec.SimulationResult, *tidcommon.ServiceError)
+	// RunScenarios runs the selected definition of a flow against every saved scenario of the flow.
+	RunScenarios(ctx context.Context, flowID string, selection *FlowSelection) (
+		*SuiteResult, *tidcommon.ServiceError)
+	// CreateScenario saves a scenario against a flow.
+	CreateScenario(ctx context.Context, flowID string, req *SavedScenarioRequest) (
+		*SavedScenario, *tidcommon.ServiceError)
+	// GetScenario returns a saved scenario of a flow.
+	GetScenario(ctx context.Context, flowID, id string) (*SavedScenario, *tidcommon.ServiceError)
+	// ListScenarios returns the saved scenarios of a flow.
+	ListScenarios(ctx context.Context, flowID string) (*SavedScenarioListResponse, *tidcommon.ServiceError)
+	// UpdateScenario replaces a saved scenario of a flow.
+	UpdateScenario(ctx context.Context, flowID, id string, req *SavedScenarioRequest) (
+		*SavedScenario, *tidcommon.ServiceError)
+	// DeleteScenario removes a saved scenario of a flow.
+	DeleteScenario(ctx context.Context, flowID, id string) *tidcommon.ServiceError
+}
+
+// simulationService is the default implementation of SimulationServiceInterface.
+type simulationService struct {
+	store          scenarioStoreInterface
+	flowMgtService flowmgt.FlowMgtServiceInterface
+	simulator      flowexec.FlowSimulatorInterface
+	logger         *log.Logger
+}
+
+// newSimulationService creates a new instance of simulationService.
+func newSimulationService(store scenarioStoreInterface, flowMgtService flowmgt.FlowMgtServiceInterface,
+	simulator flowexec.FlowSimulatorInterface) SimulationServiceInterface {
+	return &simulationService{
+		store:          store,
+		flowMgtService: flowMgtService,
+		simulator:      simulator,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// Simulate runs the selected definition of a flow against an inline or saved scenario.
+func (s *simulationService) Simulate(ctx context.Context, flowID string, req *SimulationRequest) (
+	*flowexec.SimulationResult, *tidcommon.ServiceError) {
+	if (req.Scenario == nil) == (req.ScenarioID == "") {
+		return nil, &ErrorInvalidScenarioSelection
+	}
+
+	flow, svcErr := s.resolveFlow(ctx, flowID, &req.FlowSelection)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	scenario := req.Scenario
+	if scenario == nil {
+		saved, svcErr := s.GetScenario(ctx, flowID, req.ScenarioID)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		scenario = &saved.Scenario
+	}
+	return s.simulator.Simulate(ctx, flow, scenario)
+}
+
+// RunScenarios runs the selected definition of a flow against every saved scenario of the flow. A
+// scenario whose run fails to start, for instance because the draft definition is invalid, fails the
+// whole suite.
+func (s *simulationService) RunScenarios(ctx context.Context, flowID string, selection *FlowSelection) (
+	*SuiteResult, *tidcommon.ServiceError) {
+	flow, svcErr := s.resolveFlow(ctx, flowID, selection)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	scenarios, err := s.store.ListScenarios(ctx, flowID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list simulation scenarios", log.String("flowID", flowID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	suite := &SuiteResult{Total: len(scenarios), Results: make([]ScenarioResult, 0, len(scenarios))}
+	for i := range scenarios {
+		saved := &scenarios[i]
+		result, svcErr := s.simulator.Simulate(ctx, flow, &saved.Scenario)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+
+		passed := result.Passed != nil && *result.Passed
+		if passed {
+			suite.Passed++
+		} else {
+			suite.Failed++
+		}
+		suite.Results = append(suite.Results, ScenarioResult{
+			ScenarioID: saved.ID,
+			Name:       saved.Name,
+			Passed:     passed,
+			Failures:   result.Failures,
+			Result:     result,
+		})
+	}
+	return suite, nil
+}
+
+// CreateScenario saves a scenario against a flow.
+func (s *simulationService) CreateScenario(ctx context.Context, flowID string, req *SavedScenarioRequest) (
+	*SavedScenario, *tidcommon.ServiceError) {
+	if svcErr := validateScenarioRequest(req); svcErr != nil {
+		return nil, svcErr
+	}
+	if _, svcErr := s.flowMgtService.GetFlow(ctx, flowID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate simulation scenario id", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	scenario := &SavedScenario{
+		ID:          id,
+		FlowID:      flowID,
+		Name:        req.Name,
+		Description: req.Description,
+		Scenario:    *req.Scenario,
+	}
+	if err := s.store.CreateScenario(ctx, scenario); err != nil {
+		s.logger.Error(ctx, "Failed to create simulation scenario", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return scenario, nil
+}
+
+// GetScenario returns a saved scenario of a flow.
+func (s *simulationService) GetScenario(ctx context.Context, flowID, id string) (
+	*SavedScenario, *tidcommon.ServiceError) {
+	scenario, err := s.store.GetScenario(ctx, flowID, id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, &ErrorScenarioNotFound
+		}
+		s.logger.Error(ctx, "Failed to get simulation scenario", log.String("id", id), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return scenario, nil
+}
+
+// ListScenarios returns the saved scenarios of a flow.
+func (s *simulationService) ListScenarios(ctx context.Context, flowID string) (
+	*SavedScenarioListResponse, *tidcommon.ServiceError) {
+	if _, svcErr := s.flowMgtService.GetFlow(ctx, flowID); svcErr != nil {
+		return nil, svcErr
+	}
+	scenarios, err := s.store.ListScenarios(ctx, flowID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list simulation scenarios", log.String("flowID", flowID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return &SavedScenarioListResponse{TotalResults: len(scenarios), Scenarios: scenarios}, nil
+}
+
+// UpdateScenario replaces a saved scenario of a flow.
+func (s *simulationService) UpdateScenario(ctx context.Context, flowID, id string, req *SavedScenarioRequest) (
+	*SavedScenario, *tidcommon.ServiceError) {
+	if svcErr := validateScenarioRequest(req); svcErr != nil {
+		return nil, svcErr
+	}
+	scenario, svcErr := s.GetScenario(ctx, flowID, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	scenario.Name = req.Name
+	scenario.Description = req.Description
+	scenario.Scenario = *req.Scenario
+	if err := s.store.UpdateScenario(ctx, scenario); err != nil {
+		s.logger.Error(ctx, "Failed to update simulation scenario", log.String("id", id), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return scenario, nil
+}
+
+// DeleteScenario removes a saved scenario of a flow.
+func (s *simulationService) DeleteScenario(ctx context.Context, flowID, id string) *tidcommon.ServiceError {
+	if _, svcErr := s.GetScenario(ctx, flowID, id); svcErr != nil {
+		return svcErr
+	}
+	if err := s.store.DeleteScenario(ctx, flowID, id); err != nil {
+		s.logger.Error(ctx, "Failed to delete simulation scenario", log.String("id", id), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
+// resolveFlow returns the definition of the flow to simulate: the requested version, the draft
+// definition applied to the stored flow, or the active version of the flow.
+func (s *simulationService) resolveFlow(ctx context.Context, flowID string, selection *FlowSelection) (
+	*providers.CompleteFlowDefinition, *tidcommon.ServiceError) {
+	if selection.Version != nil && selection.Definition != nil {
+		return nil, &ErrorInvalidFlowSelection
+	}
+
+	flow, svcErr := s.flowMgtService.GetFlow(ctx, flowID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if selection.Version != nil {
+		version, svcErr := s.flowMgtService.GetFlowVersion(ctx, flowID, *selection.Version)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		return version.ToCompleteFlowDefinition(), nil
+	}
+
+	if selection.Definition != nil {
+		draft := *flow
+		draft.Interceptors = selection.Definition.Interceptors
+		draft.Nodes = selection.Definition.Nodes
+		return &draft, nil
+	}
+	return flow, nil
+}
+
+// validateScenarioRequest checks the fields of a saved scenario. A saved scenario must state the
+// outcome it expects, since it is replayed as a regression check.
+func validateScenarioRequest(req *SavedScenarioRequest) *tidcommon.ServiceError {
+	if req.Name == "" || len(req.Name) > maxNameLength || len(req.Description) > maxDescriptionLength {
+		return &ErrorInvalidScenarioName
+	}
+	if req.Scenario == nil || req.Scenario.Expect == nil {
+		return &ErrorScenarioRequired
+	}
+	return flowexec.ValidateSimulationScenario(req.Scenario)
+}
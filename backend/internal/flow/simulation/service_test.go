@@ -0,0 +1,274 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
+)
+
+const testFlowID = "flow-1"
+
+type SimulationServiceTestSuite struct {
+	suite.Suite
+	mockStore     *scenarioStoreInterfaceMock
+	mockFlowMgt   *flowmgtmock.FlowMgtServiceInterfaceMock
+	mockSimulator *flowexecmock.FlowSimulatorInterfaceMock
+	service       SimulationServiceInterface
+}
+
+func TestSimulationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SimulationServiceTestSuite))
+}
+
+func (suite *SimulationServiceTestSuite) SetupTest() {
+	suite.mockStore = NewscenarioStoreInterfaceMock(suite.T())
+	suite.mockFlowMgt = flowmgtmock.NewFlowMgtServiceInterfaceMock(suite.T())
+	suite.mockSimulator = flowexecmock.NewFlowSimulatorInterfaceMock(suite.T())
+	suite.service = newSimulationService(suite.mockStore, suite.mockFlowMgt, suite.mockSimulator)
+}
+
+func (suite *SimulationServiceTestSuite) storedFlow() *providers.CompleteFlowDefinition {
+	return &providers.CompleteFlowDefinition{
+		ID:            testFlowID,
+		Handle:        "login",
+		Name:          "Login",
+		FlowType:      providers.FlowTypeAuthentication,
+		ActiveVersion: 3,
+		Nodes:         []providers.NodeDefinition{{ID: "start", Type: "START", OnSuccess: "end"}, {ID: "end"}},
+	}
+}
+
+func (suite *SimulationServiceTestSuite) savedScenario(id string) *SavedScenario {
+	return &SavedScenario{
+		ID:       id,
+		FlowID:   testFlowID,
+		Name:     "Scenario " + id,
+		Scenario: flowexec.SimulationScenario{Expect: &flowexec.SimulationExpectation{Status: "COMPLETE"}},
+	}
+}
+
+func (suite *SimulationServiceTestSuite) validRequest() *SavedScenarioRequest {
+	return &SavedScenarioRequest{
+		Name: "Happy path",
+		Scenario: &flowexec.SimulationScenario{
+			Steps:  []flowexec.SimulationStep{{Action: "action_001"}},
+			Expect: &flowexec.SimulationExpectation{Status: "COMPLETE"},
+		},
+	}
+}
+
+func (suite *SimulationServiceTestSuite) TestSimulate_InlineScenarioOnActiveVersion() {
+	scenario := &flowexec.SimulationScenario{}
+	result := &flowexec.SimulationResult{Status: "COMPLETE"}
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.storedFlow(), nil)
+	suite.mockSimulator.On("Simulate", mock.Anything, mock.MatchedBy(func(f *providers.CompleteFlowDefinition) bool {
+		return f.ActiveVersion == 3 && len(f.Nodes) == 2
+	}), scenario).Return(result, nil)
+
+	actual, svcErr := suite.service.Simulate(context.Background(), testFlowID, &SimulationRequest{Scenario: scenario})
+	suite.Nil(svcErr)
+	suite.Equal(result, actual)
+}
+
+func (suite *SimulationServiceTestSuite) TestSimulate_SavedScenarioOnVersion() {
+	version := 2
+	saved := suite.savedScenario("sc-1")
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.storedFlow(), nil)
+	suite.mockFlowMgt.On("GetFlowVersion", mock.Anything, testFlowID, 2).Return(&flowmgt.FlowVersion{
+		ID: testFlowID, Handle: "login", FlowType: "AUTHENTICATION", Version: 2,
+		Nodes: []providers.NodeDefinition{{ID: "start"}},
+	}, nil)
+	suite.mockStore.On("GetScenario", mock.Anything, testFlowID, "sc-1").Return(saved, nil)
+	suite.mockSimulator.On("Simulate", mock.Anything, mock.MatchedBy(func(f *providers.CompleteFlowDefinition) bool {
+		return f.ActiveVersion == 2 && f.FlowType == providers.FlowTypeAuthentication && len(f.Nodes) == 1
+	}), &saved.Scenario).Return(&flowexec.SimulationResult{}, nil)
+
+	_, svcErr := suite.service.Simulate(context.Background(), testFlowID, &SimulationRequest{
+		FlowSelection: FlowSelection{Version: &version},
+		ScenarioID:    "sc-1",
+	})
+	suite.Nil(svcErr)
+}
+
+func (suite *SimulationServiceTestSuite) TestSimulate_DraftDefinition() {
+	draftNodes := []providers.NodeDefinition{{ID: "start"}, {ID: "prompt"}, {ID: "end"}}
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.storedFlow(), nil)
+	suite.mockSimulator.On("Simulate", mock.Anything, mock.MatchedBy(func(f *providers.CompleteFlowDefinition) bool {
+		return f.Handle == "login" && len(f.Nodes) == 3
+	}), mock.Anything).Return(&flowexec.SimulationResult{}, nil)
+
+	_, svcErr := suite.service.Simulate(context.Background(), testFlowID, &SimulationRequest{
+		FlowSelection: FlowSelection{Definition: &FlowDraft{Nodes: draftNodes}},
+		Scenario:      &flowexec.SimulationScenario{},
+	})
+	suite.Nil(svcErr)
+}
+
+func (suite *SimulationServiceTestSuite) TestSimulate_InvalidSelection() {
+	version := 1
+	tests := []struct {
+		name     string
+		req      *SimulationRequest
+		expected *tidcommon.ServiceError
+	}{
+		{"NoScenario", &SimulationRequest{}, &ErrorInvalidScenarioSelection},
+		{"BothScenarios", &SimulationRequest{Scenario: &flowexec.SimulationScenario{}, ScenarioID: "sc-1"},
+			&ErrorInvalidScenarioSelection},
+		{"VersionAndDraft", &SimulationRequest{
+			FlowSelection: FlowSelection{Version: &version, Definition: &FlowDraft{}},
+			Scenario:      &flowexec.SimulationScenario{},
+		}, &ErrorInvalidFlowSelection},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			_, svcErr := suite.service.Simulate(context.Background(), testFlowID, tc.req)
+			suite.Equal(tc.expected, svcErr)
+		})
+	}
+}
+
+func (suite *SimulationServiceTestSuite) TestSimulate_FlowNotFound() {
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(nil, &flowmgt.ErrorFlowNotFound)
+
+	_, svcErr := suite.service.Simulate(context.Background(), testFlowID,
+		&SimulationRequest{Scenario: &flowexec.SimulationScenario{}})
+	suite.Equal(&flowmgt.ErrorFlowNotFound, svcErr)
+}
+
+func (suite *SimulationServiceTestSuite) TestRunScenarios_ReportsEachScenario() {
+	passed, failed := true, false
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.storedFlow(), nil)
+	suite.mockStore.On("ListScenarios", mock.Anything, testFlowID).
+		Return([]SavedScenario{*suite.savedScenario("sc-1"), *suite.savedScenario("sc-2")}, nil)
+	suite.mockSimulator.On("Simulate", mock.Anything, mock.Anything, mock.Anything).
+		Return(&flowexec.SimulationResult{Passed: &passed}, nil).Once()
+	suite.mockSimulator.On("Simulate", mock.Anything, mock.Anything, mock.Anything).
+		Return(&flowexec.SimulationResult{Passed: &failed, Failures: []string{"expected status COMPLETE"}}, nil).
+		Once()
+
+	result, svcErr := suite.service.RunScenarios(context.Background(), testFlowID, &FlowSelection{})
+	suite.Nil(svcErr)
+	suite.Equal(2, result.Total)
+	suite.Equal(1, result.Passed)
+	suite.Equal(1, result.Failed)
+	suite.True(result.Results[0].Passed)
+	suite.Equal("sc-2", result.Results[1].ScenarioID)
+	suite.Equal([]string{"expected status COMPLETE"}, result.Results[1].Failures)
+}
+
+func (suite *SimulationServiceTestSuite) TestRunScenarios_InvalidDraft() {
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.storedFlow(), nil)
+	suite.mockStore.On("ListScenarios", mock.Anything, testFlowID).
+		Return([]SavedScenario{*suite.savedScenario("sc-1")}, nil)
+	invalidFlow := &tidcommon.ServiceError{Type: tidcommon.ClientErrorType, Code: "FLG-1002"}
+	suite.mockSimulator.On("Simulate", mock.Anything, mock.Anything, mock.Anything).Return(nil, invalidFlow)
+
+	_, svcErr := suite.service.RunScenarios(context.Background(), testFlowID,
+		&FlowSelection{Definition: &FlowDraft{}})
+	suite.Equal(invalidFlow, svcErr)
+}
+
+func (suite *SimulationServiceTestSuite) TestCreateScenario_Success() {
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.storedFlow(), nil)
+	suite.mockStore.On("CreateScenario", mock.Anything, mock.MatchedBy(func(s *SavedScenario) bool {
+		return s.ID != "" && s.FlowID == testFlowID && s.Name == "Happy path" && len(s.Scenario.Steps) == 1
+	})).Return(nil)
+
+	scenario, svcErr := suite.service.CreateScenario(context.Background(), testFlowID, suite.validRequest())
+	suite.Nil(svcErr)
+	suite.Equal("Happy path", scenario.Name)
+}
+
+func (suite *SimulationServiceTestSuite) TestCreateScenario_ValidationErrors() {
+	tests := []struct {
+		name     string
+		modify   func(req *SavedScenarioRequest)
+		expected string
+	}{
+		{"EmptyName", func(req *SavedScenarioRequest) { req.Name = "" }, ErrorInvalidScenarioName.Code},
+		{"LongName", func(req *SavedScenarioRequest) { req.Name = strings.Repeat("a", maxNameLength+1) },
+			ErrorInvalidScenarioName.Code},
+		{"NoScenario", func(req *SavedScenarioRequest) { req.Scenario = nil }, ErrorScenarioRequired.Code},
+		{"NoExpectation", func(req *SavedScenarioRequest) { req.Scenario.Expect = nil },
+			ErrorScenarioRequired.Code},
+		{"InvalidStub", func(req *SavedScenarioRequest) {
+			req.Scenario.Stubs = []flowexec.ExecutorStub{{}}
+		}, flowexec.ErrorInvalidSimulationScenario.Code},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			req := suite.validRequest()
+			tc.modify(req)
+			_, svcErr := suite.service.CreateScenario(context.Background(), testFlowID, req)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *SimulationServiceTestSuite) TestCreateScenario_StoreError() {
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.storedFlow(), nil)
+	suite.mockStore.On("CreateScenario", mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	_, svcErr := suite.service.CreateScenario(context.Background(), testFlowID, suite.validRequest())
+	suite.Equal(&tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *SimulationServiceTestSuite) TestGetScenario_NotFound() {
+	suite.mockStore.On("GetScenario", mock.Anything, testFlowID, "sc-1").Return(nil, ErrNotFound)
+
+	_, svcErr := suite.service.GetScenario(context.Background(), testFlowID, "sc-1")
+	suite.Equal(&ErrorScenarioNotFound, svcErr)
+}
+
+func (suite *SimulationServiceTestSuite) TestListScenarios() {
+	suite.mockFlowMgt.On("GetFlow", mock.Anything, testFlowID).Return(suite.storedFlow(), nil)
+	suite.mockStore.On("ListScenarios", mock.Anything, testFlowID).
+		Return([]SavedScenario{*suite.savedScenario("sc-1")}, nil)
+
+	list, svcErr := suite.service.ListScenarios(context.Background(), testFlowID)
+	suite.Nil(svcErr)
+	suite.Equal(1, list.TotalResults)
+}
+
+func (suite *SimulationServiceTestSuite) TestUpdateScenario_Success() {
+	suite.mockStore.On("GetScenario", mock.Anything, testFlowID, "sc-1").Return(suite.savedScenario("sc-1"), nil)
+	suite.mockStore.On("UpdateScenario", mock.Anything, mock.MatchedBy(func(s *SavedScenario) bool {
+		return s.ID == "sc-1" && s.Name == "Happy path"
+	})).Return(nil)
+
+	scenario, svcErr := suite.service.UpdateScenario(context.Background(), testFlowID, "sc-1", suite.validRequest())
+	suite.Nil(svcErr)
+	suite.Equal("Happy path", scenario.Name)
+}
+
+func (suite *SimulationServiceTestSuite) TestDeleteScenario_NotFound() {
+	suite.mockStore.On("GetScenario", mock.Anything, testFlowID, "sc-1").Return(nil, ErrNotFound)
+
+	svcErr := suite.service.DeleteScenario(context.Background(), testFlowID, "sc-1")
+	suite.Equal(&ErrorScenarioNotFound, svcErr)
+}
+
+func (suite *SimulationServiceTestSuite) TestDeleteScenario_Success() {
+	suite.mockStore.On("GetScenario", mock.Anything, testFlowID, "sc-1").Return(suite.savedScenario("sc-1"), nil)
+	suite.mockStore.On("DeleteScenario", mock.Anything, testFlowID, "sc-1").Return(nil)
+
+	suite.Nil(suite.service.DeleteScenario(context.Background(), testFlowID, "sc-1"))
+}
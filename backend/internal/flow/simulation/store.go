@@ -0,0 +1,163 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// scenarioStoreInterface persists the saved simulation scenarios of flows in the config database.
+type scenarioStoreInterface interface {
+	// CreateScenario inserts a saved scenario.
+	CreateScenario(ctx context.Context, scenario *SavedScenario) error
+	// GetScenario returns the saved scenario of a flow with the given id, or ErrNotFound.
+	GetScenario(ctx context.Context, flowID, id string) (*SavedScenario, error)
+	// ListScenarios returns the saved scenarios of a flow, oldest first.
+	ListScenarios(ctx context.Context, flowID string) ([]SavedScenario, error)
+	// UpdateScenario persists changes to a saved scenario.
+	UpdateScenario(ctx context.Context, scenario *SavedScenario) error
+	// DeleteScenario removes a saved scenario of a flow.
+	DeleteScenario(ctx context.Context, flowID, id string) error
+}
+
+// scenarioStore implements scenarioStoreInterface against the config database.
+type scenarioStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newScenarioStore creates a new scenarioStore.
+func newScenarioStore(deploymentID string) scenarioStoreInterface {
+	return &scenarioStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// CreateScenario inserts a saved scenario.
+func (s *scenarioStore) CreateScenario(ctx context.Context, scenario *SavedScenario) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	scenarioJSON, err := json.Marshal(scenario.Scenario)
+	if err != nil {
+		return fmt.Errorf("failed to marshal simulation scenario: %w", err)
+	}
+	_, err = dbClient.ExecuteContext(ctx, queryCreateScenario, scenario.ID, scenario.FlowID, scenario.Name,
+		scenario.Description, string(scenarioJSON), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to create simulation scenario: %w", err)
+	}
+	return nil
+}
+
+// GetScenario returns the saved scenario of a flow with the given id, or ErrNotFound.
+func (s *scenarioStore) GetScenario(ctx context.Context, flowID, id string) (*SavedScenario, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryGetScenario, id, flowID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query simulation scenario: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+	return buildScenarioFromRow(results[0])
+}
+
+// ListScenarios returns the saved scenarios of a flow, oldest first.
+func (s *scenarioStore) ListScenarios(ctx context.Context, flowID string) ([]SavedScenario, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryListScenarios, flowID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list simulation scenarios: %w", err)
+	}
+	scenarios := make([]SavedScenario, 0, len(results))
+	for _, row := range results {
+		scenario, err := buildScenarioFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		scenarios = append(scenarios, *scenario)
+	}
+	return scenarios, nil
+}
+
+// UpdateScenario persists changes to a saved scenario.
+func (s *scenarioStore) UpdateScenario(ctx context.Context, scenario *SavedScenario) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	scenarioJSON, err := json.Marshal(scenario.Scenario)
+	if err != nil {
+		return fmt.Errorf("failed to marshal simulation scenario: %w", err)
+	}
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateScenario, scenario.ID, scenario.FlowID, scenario.Name,
+		scenario.Description, string(scenarioJSON), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update simulation scenario: %w", err)
+	}
+	return nil
+}
+
+// DeleteScenario removes a saved scenario of a flow.
+func (s *scenarioStore) DeleteScenario(ctx context.Context, flowID, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteScenario, id, flowID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete simulation scenario: %w", err)
+	}
+	return nil
+}
+
+// buildScenarioFromRow constructs a SavedScenario from a result row.
+func buildScenarioFromRow(row map[string]interface{}) (*SavedScenario, error) {
+	scenario := &SavedScenario{
+		ID:          columnString(row["id"]),
+		FlowID:      columnString(row["flow_id"]),
+		Name:        columnString(row["name"]),
+		Description: columnString(row["description"]),
+	}
+	if err := json.Unmarshal(columnBytes(row["scenario"]), &scenario.Scenario); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal simulation scenario %s: %w", scenario.ID, err)
+	}
+	return scenario, nil
+}
+
+// columnString coerces a result-row value to a string, tolerating string/[]byte.
+func columnString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	default:
+		return ""
+	}
+}
+
+// columnBytes coerces a result-row value to bytes, tolerating []byte/string.
+func columnBytes(v interface{}) []byte {
+	switch t := v.(type) {
+	case []byte:
+		return t
+	case string:
+		return []byte(t)
+	default:
+		return nil
+	}
+}
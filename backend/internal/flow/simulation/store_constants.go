@@ -0,0 +1,36 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// DBQuery definitions for the saved simulation scenario store.
+var (
+	queryCreateScenario = dbmodel.DBQuery{
+		ID: "FSQ-SC-01",
+		Query: `INSERT INTO "FLOW_SIMULATION_SCENARIO" (ID, FLOW_ID, NAME, DESCRIPTION, SCENARIO, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6)`,
+	}
+	queryGetScenario = dbmodel.DBQuery{
+		ID: "FSQ-SC-02",
+		Query: `SELECT ID, FLOW_ID, NAME, DESCRIPTION, SCENARIO FROM "FLOW_SIMULATION_SCENARIO" ` +
+			`WHERE ID = $1 AND FLOW_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+	queryListScenarios = dbmodel.DBQuery{
+		ID: "FSQ-SC-03",
+		Query: `SELECT ID, FLOW_ID, NAME, DESCRIPTION, SCENARIO FROM "FLOW_SIMULATION_SCENARIO" ` +
+			`WHERE FLOW_ID = $1 AND DEPLOYMENT_ID = $2 ORDER BY CREATED_AT, ID`,
+	}
+	queryUpdateScenario = dbmodel.DBQuery{
+		ID: "FSQ-SC-04",
+		Query: `UPDATE "FLOW_SIMULATION_SCENARIO" SET NAME = $3, DESCRIPTION = $4, SCENARIO = $5, ` +
+			`UPDATED_AT = CURRENT_TIMESTAMP WHERE ID = $1 AND FLOW_ID = $2 AND DEPLOYMENT_ID = $6`,
+	}
+	queryDeleteScenario = dbmodel.DBQuery{
+		ID:    "FSQ-SC-05",
+		Query: `DELETE FROM "FLOW_SIMULATION_SCENARIO" WHERE ID = $1 AND FLOW_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+)
@@ -0,0 +1,95 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type ScenarioStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *scenarioStore
+}
+
+func TestScenarioStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ScenarioStoreTestSuite))
+}
+
+func (suite *ScenarioStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &scenarioStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func (suite *ScenarioStoreTestSuite) TestCreateScenario_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateScenario, "sc-1", testFlowID, "Happy path",
+		"", `{"expect":{"status":"COMPLETE"}}`, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.CreateScenario(context.Background(), &SavedScenario{
+		ID: "sc-1", FlowID: testFlowID, Name: "Happy path",
+		Scenario: flowexec.SimulationScenario{Expect: &flowexec.SimulationExpectation{Status: "COMPLETE"}},
+	})
+	suite.NoError(err)
+}
+
+func (suite *ScenarioStoreTestSuite) TestCreateScenario_DBClientError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("db error"))
+
+	err := suite.store.CreateScenario(context.Background(), &SavedScenario{ID: "sc-1"})
+	suite.ErrorContains(err, "failed to get database client")
+}
+
+func (suite *ScenarioStoreTestSuite) TestGetScenario_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetScenario, "sc-1", testFlowID, testDeploymentID).
+		Return([]map[string]interface{}{{
+			"id": "sc-1", "flow_id": testFlowID, "name": "Happy path", "description": []byte("Logs in"),
+			"scenario": []byte(`{"steps":[{"action":"action_001"}]}`),
+		}}, nil)
+
+	scenario, err := suite.store.GetScenario(context.Background(), testFlowID, "sc-1")
+	suite.Require().NoError(err)
+	suite.Equal("Logs in", scenario.Description)
+	suite.Require().Len(scenario.Scenario.Steps, 1)
+	suite.Equal("action_001", scenario.Scenario.Steps[0].Action)
+}
+
+func (suite *ScenarioStoreTestSuite) TestGetScenario_NotFound() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetScenario, "sc-1", testFlowID, testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	_, err := suite.store.GetScenario(context.Background(), testFlowID, "sc-1")
+	suite.ErrorIs(err, ErrNotFound)
+}
+
+func (suite *ScenarioStoreTestSuite) TestListScenarios_InvalidScenario() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListScenarios, testFlowID, testDeploymentID).
+		Return([]map[string]interface{}{{"id": "sc-1", "scenario": "{"}}, nil)
+
+	_, err := suite.store.ListScenarios(context.Background(), testFlowID)
+	suite.ErrorContains(err, "failed to unmarshal simulation scenario sc-1")
+}
+
+func (suite *ScenarioStoreTestSuite) TestDeleteScenario_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteScenario, "sc-1", testFlowID,
+		testDeploymentID).Return(int64(1), nil)
+
+	suite.NoError(suite.store.DeleteScenario(context.Background(), testFlowID, "sc-1"))
+}
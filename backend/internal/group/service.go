@@ -17,6 +17,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
@@ -52,6 +53,7 @@ type GroupServiceInterface interface {
 		ctx context.Context, resourceType, id string) ([]resourcedependency.ResourceDependency, error)
 	CascadeDeleteDependencies(ctx context.Context, resourceType, id string) (int, error)
 	SetDependencyRegistry(r resourcedependency.Registry)
+	SetAuditRecorder(recorder *audit.Recorder)
 }
 
 // groupService is the default implementation of the GroupServiceInterface.
@@ -63,6 +65,7 @@ type groupService struct {
 	transactioner      providers.Transactioner
 	authzService       sysauthz.SystemAuthorizationServiceInterface
 	dependencyRegistry resourcedependency.Registry
+	auditRecorder      *audit.Recorder
 }
 
 // newGroupServiceWithStore creates a new instance of GroupService with an externally provided store.
@@ -357,6 +360,7 @@ func (gs *groupService) CreateGroup(ctx context.Context, request CreateGroupRequ
 		return nil, svcErr
 	}
 	createdGroup.Members = resolvedMembers
+	gs.auditRecorder.RecordCreate(ctx, audit.ResourceTypeGroup, createdGroup.ID, createdGroup)
 
 	logger.Debug(ctx, "Successfully created group",
 		log.String("id", createdGroup.ID), log.String("name", createdGroup.Name))
@@ -467,7 +471,7 @@ func (gs *groupService) UpdateGroup(
 		return nil, &ErrorImmutableGroup
 	}
 
-	var updatedGroup *Group
+	var previousGroup, updatedGroup *Group
 	var capturedSvcErr *tidcommon.ServiceError
 
 	err := gs.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -482,6 +486,7 @@ func (gs *groupService) UpdateGroup(
 		}
 
 		existingGroup := convertGroupDAOToGroup(existingGroupDAO)
+		previousGroup = &existingGroup
 		updateOUID := existingGroupDAO.OUID
 
 		if gs.isOrganizationUnitChanged(existingGroup, request) {
@@ -553,6 +558,10 @@ func (gs *groupService) UpdateGroup(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	// Members are not changed by an update, so they are left out of the recorded states.
+	previousGroup.Members = nil
+	gs.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeGroup, groupID, previousGroup, updatedGroup)
+
 	logger.Debug(ctx, "Successfully updated group",
 		log.String("id", groupID), log.String("name", request.Name))
 	return updatedGroup, nil
@@ -565,6 +574,12 @@ func (gs *groupService) SetDependencyRegistry(r resourcedependency.Registry) {
 	gs.dependencyRegistry = r
 }
 
+// SetAuditRecorder injects the recorder that group mutations are audited through. Called by
+// servicemanager once the audit service is initialized.
+func (gs *groupService) SetAuditRecorder(recorder *audit.Recorder) {
+	gs.auditRecorder = recorder
+}
+
 func (gs *groupService) DeleteGroup(ctx context.Context, groupID string) *tidcommon.ServiceError {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
 	logger.Debug(ctx, "Deleting group", log.String("id", groupID))
@@ -624,6 +639,8 @@ func (gs *groupService) DeleteGroup(ctx context.Context, groupID string) *tidcom
 		return &tidcommon.InternalServerError
 	}
 
+	gs.auditRecorder.RecordDelete(ctx, audit.ResourceTypeGroup, groupID, convertGroupDAOToGroup(existingGroupDAO))
+
 	logger.Debug(ctx, "Successfully deleted group", log.String("id", groupID))
 	return nil
 }
@@ -911,6 +928,9 @@ func (gs *groupService) modifyGroupMembers(
 	}
 
 	updatedGroup := convertGroupDAOToGroup(updatedGroupDAO)
+	gs.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeGroup, groupID,
+		convertGroupDAOToGroup(existingGroup), updatedGroup)
+
 	resolvedMembers, svcErr := gs.resolveMembers(ctx, updatedGroup.Members, false, logger)
 	if svcErr != nil {
 		return nil, svcErr
@@ -17,6 +17,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/entity"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
@@ -24,6 +25,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/auditmock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
@@ -2982,3 +2984,30 @@ func (suite *GroupServiceTestSuite) TestDeleteGroup_AbortedWhenCascadeFails() {
 	suite.Require().NotNil(err)
 	storeMock.AssertNotCalled(suite.T(), "DeleteGroup", mock.Anything, mock.Anything)
 }
+
+func (suite *GroupServiceTestSuite) TestDeleteGroup_RecordsAuditEvent() {
+	storeMock := newGroupStoreInterfaceMock(suite.T())
+	storeMock.On("IsGroupDeclarative", mock.Anything, "grp-001").Return(false, nil).Once()
+	storeMock.On("GetGroup", mock.Anything, "grp-001").
+		Return(GroupDAO{ID: "grp-001", Name: "admins", OUID: testOUID1}, nil).Once()
+	storeMock.On("DeleteGroup", mock.Anything, "grp-001").Return(nil).Once()
+
+	auditMock := auditmock.NewAuditServiceInterfaceMock(suite.T())
+	auditMock.On("RecordEvent", mock.Anything, mock.MatchedBy(func(event *audit.AuditEvent) bool {
+		return event.Action == audit.ActionDelete && event.ResourceType == audit.ResourceTypeGroup &&
+			event.ResourceID == "grp-001" && event.Changes["name"].Before == "admins" &&
+			event.Changes["name"].After == nil
+	})).Return((*tidcommon.ServiceError)(nil)).Once()
+
+	service := &groupService{
+		authzService:       newAllowAllAuthz(suite.T()),
+		groupStore:         storeMock,
+		transactioner:      &stubTransactioner{},
+		dependencyRegistry: noopDepRegistry{},
+		auditRecorder:      audit.NewRecorder(auditMock),
+	}
+
+	err := service.DeleteGroup(context.Background(), "grp-001")
+
+	suite.Require().Nil(err)
+}
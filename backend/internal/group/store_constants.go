@@ -56,6 +56,7 @@ func buildGetGroupsCountByOUIDsQuery(
 			Query:         "SELECT 0 WHERE 1=0",
 			PostgresQuery: "SELECT 0 WHERE 1=0",
 			SQLiteQuery:   "SELECT 0 WHERE 1=0",
+			MySQLQuery:    "SELECT 0 FROM DUAL WHERE 1=0",
 		}, []interface{}{}
 	}
 
@@ -197,6 +198,10 @@ var (
 			`(GROUP_ID, MEMBER_TYPE, MEMBER_ID, DEPLOYMENT_ID, CREATED_AT, UPDATED_AT) ` +
 			`VALUES ($1, $2, $3, $4, $5, $6) ` +
 			`ON CONFLICT (GROUP_ID, MEMBER_TYPE, MEMBER_ID, DEPLOYMENT_ID) DO NOTHING`,
+		MySQLQuery: `INSERT INTO "GROUP_MEMBER_REFERENCE" ` +
+			`(GROUP_ID, MEMBER_TYPE, MEMBER_ID, DEPLOYMENT_ID, CREATED_AT, UPDATED_AT) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6) ` +
+			`ON DUPLICATE KEY UPDATE MEMBER_ID = MEMBER_ID`,
 	}
 
 	// QueryCheckGroupNameConflict is the query to check if a group name conflicts within the same organization unit.
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -565,6 +566,46 @@ func (_c *IDPServiceInterfaceMock_GetIdentityProvidersByProperty_Call) RunAndRet
 	return _c
 }
 
+// SetAuditRecorder provides a mock function for the type IDPServiceInterfaceMock
+func (_mock *IDPServiceInterfaceMock) SetAuditRecorder(recorder *audit.Recorder) {
+	_mock.Called(recorder)
+	return
+}
+
+// IDPServiceInterfaceMock_SetAuditRecorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAuditRecorder'
+type IDPServiceInterfaceMock_SetAuditRecorder_Call struct {
+	*mock.Call
+}
+
+// SetAuditRecorder is a helper method to define mock.On call
+//   - recorder *audit.Recorder
+func (_e *IDPServiceInterfaceMock_Expecter) SetAuditRecorder(recorder interface{}) *IDPServiceInterfaceMock_SetAuditRecorder_Call {
+	return &IDPServiceInterfaceMock_SetAuditRecorder_Call{Call: _e.mock.On("SetAuditRecorder", recorder)}
+}
+
+func (_c *IDPServiceInterfaceMock_SetAuditRecorder_Call) Run(run func(recorder *audit.Recorder)) *IDPServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *audit.Recorder
+		if args[0] != nil {
+			arg0 = args[0].(*audit.Recorder)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *IDPServiceInterfaceMock_SetAuditRecorder_Call) Return() *IDPServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *IDPServiceInterfaceMock_SetAuditRecorder_Call) RunAndReturn(run func(recorder *audit.Recorder)) *IDPServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetDependencyRegistry provides a mock function for the type IDPServiceInterfaceMock
 func (_mock *IDPServiceInterfaceMock) SetDependencyRegistry(r resourcedependency.Registry) {
 	_mock.Called(r)
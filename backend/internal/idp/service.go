@@ -14,6 +14,7 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -38,6 +39,7 @@ type IDPServiceInterface interface {
 	DeleteIdentityProvider(ctx context.Context, idpID string) *tidcommon.ServiceError
 	GetIDPUsages(ctx context.Context, idpID string) (*resourcedependency.DependenciesResponse, *tidcommon.ServiceError)
 	SetDependencyRegistry(r resourcedependency.Registry)
+	SetAuditRecorder(recorder *audit.Recorder)
 	ApplySchemaAwareDefaults(ctx context.Context, idp *providers.IDPDTO)
 }
 
@@ -47,6 +49,7 @@ type idpService struct {
 	entityTypeService  entitytype.EntityTypeServiceInterface
 	transactioner      providers.Transactioner
 	dependencyRegistry resourcedependency.Registry
+	auditRecorder      *audit.Recorder
 	logger             *log.Logger
 	uuidGenerator      func() (string, error)
 }
@@ -142,6 +145,7 @@ func (is *idpService) CreateIdentityProvider(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	is.auditRecorder.RecordCreate(ctx, audit.ResourceTypeIdentityProvider, idp.ID, idpAuditState(idp))
 	return idp, nil
 }
 
@@ -254,6 +258,7 @@ func (is *idpService) UpdateIdentityProvider(
 
 	idp.ID = idpID
 	var svcErr *tidcommon.ServiceError
+	var previousIDP *providers.IDPDTO
 	err := is.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		// Check if the identity provider exists
 		existingIDP, err := is.idpStore.GetIdentityProvider(txCtx, idpID)
@@ -264,6 +269,7 @@ func (is *idpService) UpdateIdentityProvider(
 			}
 			return err
 		}
+		previousIDP = existingIDP
 
 		// If the name is being updated, check whether another IdP with the same name exists
 		if existingIDP.Name != idp.Name {
@@ -297,6 +303,8 @@ func (is *idpService) UpdateIdentityProvider(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	is.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeIdentityProvider, idpID,
+		idpAuditState(previousIDP), idpAuditState(idp))
 	return idp, nil
 }
 
@@ -319,9 +327,10 @@ func (is *idpService) DeleteIdentityProvider(ctx context.Context, idpID string)
 	}
 
 	var svcErr *tidcommon.ServiceError
+	var deletedIDP *providers.IDPDTO
 	err := is.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		// Check if the identity provider exists
-		_, err := is.idpStore.GetIdentityProvider(txCtx, idpID)
+		existingIDP, err := is.idpStore.GetIdentityProvider(txCtx, idpID)
 		if err != nil {
 			if errors.Is(err, ErrIDPNotFound) {
 				return nil
@@ -338,6 +347,7 @@ func (is *idpService) DeleteIdentityProvider(ctx context.Context, idpID string)
 			}
 			return err
 		}
+		deletedIDP = existingIDP
 		return nil
 	})
 
@@ -349,6 +359,9 @@ func (is *idpService) DeleteIdentityProvider(ctx context.Context, idpID string)
 		return &tidcommon.InternalServerError
 	}
 
+	if deletedIDP != nil {
+		is.auditRecorder.RecordDelete(ctx, audit.ResourceTypeIdentityProvider, idpID, idpAuditState(deletedIDP))
+	}
 	return nil
 }
 
@@ -526,6 +539,39 @@ func (is *idpService) SetDependencyRegistry(r resourcedependency.Registry) {
 	is.dependencyRegistry = r
 }
 
+// SetAuditRecorder injects the recorder that identity provider mutations are audited through. Called
+// by servicemanager once the audit service is initialized.
+func (is *idpService) SetAuditRecorder(recorder *audit.Recorder) {
+	is.auditRecorder = recorder
+}
+
+// idpAuditState returns the recorded state of an identity provider. Properties are flattened to their
+// values, as they do not marshal on their own, and secret property values are redacted.
+func idpAuditState(idp *providers.IDPDTO) map[string]interface{} {
+	if idp == nil {
+		return nil
+	}
+
+	properties := make(map[string]string, len(idp.Properties))
+	for i := range idp.Properties {
+		property := &idp.Properties[i]
+		if property.IsSecret() {
+			properties[property.GetName()] = audit.RedactedValue
+			continue
+		}
+		value, _ := property.GetValue()
+		properties[property.GetName()] = value
+	}
+
+	return map[string]interface{}{
+		"name":                   idp.Name,
+		"description":            idp.Description,
+		"type":                   idp.Type,
+		"properties":             properties,
+		"attributeConfiguration": idp.AttributeConfiguration,
+	}
+}
+
 // GetIDPUsages returns the resources that reference this identity provider, such as flows that use
 // it. It is informational — it drives the pre-delete confirmation dialog and does not gate deletion
 // on the server (deletion is gated separately by ensureNoBlockingDependencies).
@@ -53,5 +53,7 @@ var (
 			`WHERE PROPERTIES->$1->>'value' = $2 AND DEPLOYMENT_ID = $3`,
 		SQLiteQuery: `SELECT ID, NAME, DESCRIPTION, TYPE, PROPERTIES, ATTRIBUTE_CONFIGURATION FROM "IDP" ` +
 			`WHERE json_extract(PROPERTIES, '$.' || $1 || '.value') = $2 AND DEPLOYMENT_ID = $3`,
+		MySQLQuery: `SELECT ID, NAME, DESCRIPTION, TYPE, PROPERTIES, ATTRIBUTE_CONFIGURATION FROM "IDP" ` +
+			`WHERE JSON_UNQUOTE(JSON_EXTRACT(PROPERTIES, CONCAT('$.', $1, '.value'))) = $2 AND DEPLOYMENT_ID = $3`,
 	}
 )
@@ -72,6 +72,8 @@ var (
 	ErrOAuthInvalidGrantType = errors.New("invalid grant type")
 	// ErrOAuthInvalidResponseType is returned when an unsupported response type is specified.
 	ErrOAuthInvalidResponseType = errors.New("invalid response type")
+	// ErrOAuthInvalidResponseMode is returned when an unsupported response mode is specified.
+	ErrOAuthInvalidResponseMode = errors.New("invalid response mode")
 	// ErrOAuthClientCredentialsCannotUseResponseTypes is returned when client_credentials uses response types.
 	ErrOAuthClientCredentialsCannotUseResponseTypes = errors.New("client_credentials grant cannot use response types")
 	// ErrOAuthAuthCodeRequiresCodeResponseType is returned when authorization_code grant lacks code response type.
@@ -90,6 +92,34 @@ var (
 	ErrOAuthDefaultAudienceTooLong = errors.New("default audience exceeds the maximum allowed length")
 	// ErrOAuthPrivateKeyJWTRequiresCertificate is returned when private_key_jwt is used without a certificate.
 	ErrOAuthPrivateKeyJWTRequiresCertificate = errors.New("private_key_jwt requires a certificate")
+	// ErrOAuthTLSClientAuthRequiresSubject is returned when tls_client_auth is used without exactly one
+	// expected certificate subject.
+	ErrOAuthTLSClientAuthRequiresSubject = errors.New("tls_client_auth requires exactly one certificate subject")
+	// ErrOAuthInvalidTLSClientAuthSubject is returned when a tls_client_auth subject alternative name is malformed.
+	ErrOAuthInvalidTLSClientAuthSubject = errors.New("invalid tls_client_auth certificate subject")
+	// ErrOAuthSelfSignedTLSClientAuthRequiresCertificate is returned when self_signed_tls_client_auth is used
+	// without a certificate.
+	ErrOAuthSelfSignedTLSClientAuthRequiresCertificate = errors.New(
+		"self_signed_tls_client_auth requires a certificate")
+	// ErrOAuthMutualTLSCannotHaveClientSecret is returned when a mutual-TLS auth method is used with a client secret.
+	ErrOAuthMutualTLSCannotHaveClientSecret = errors.New("mutual-TLS authentication cannot have a client secret")
+	// ErrOAuthSignedRequestObjectRequiresCertificate is returned when signed request objects are required
+	// without a certificate to verify them.
+	ErrOAuthSignedRequestObjectRequiresCertificate = errors.New("signed request objects require a certificate")
+	// ErrOAuthInvalidRequestURI is returned when a registered request URI is not an absolute https URI.
+	ErrOAuthInvalidRequestURI = errors.New("invalid request URI")
+	// ErrOAuthInvalidLogoutURI is returned when a back-channel or front-channel logout URI is not an absolute
+	// http(s) URI without a fragment.
+	ErrOAuthInvalidLogoutURI = errors.New("invalid logout URI")
+	// ErrOAuthInvalidSubjectType is returned when an unsupported subject identifier type is specified.
+	ErrOAuthInvalidSubjectType = errors.New("invalid subject type")
+	// ErrOAuthInvalidSectorIdentifierURI is returned when the sector identifier URI is not an absolute https
+	// URI without a fragment.
+	ErrOAuthInvalidSectorIdentifierURI = errors.New("invalid sector identifier URI")
+	// ErrOAuthPairwiseRequiresSectorIdentifier is returned when a pairwise client has neither a sector
+	// identifier URI nor redirect URIs on a single host to derive its sector from.
+	ErrOAuthPairwiseRequiresSectorIdentifier = errors.New(
+		"pairwise subject type requires a sector identifier URI or redirect URIs on a single host")
 	// ErrOAuthCertificateRequiresClientID is returned when a certificate is provided without an OAuth client ID.
 	ErrOAuthCertificateRequiresClientID = errors.New("certificate requires an OAuth client ID")
 	// ErrOAuthPrivateKeyJWTCannotHaveClientSecret is returned when private_key_jwt is used with a client secret.
@@ -148,6 +178,34 @@ var (
 	ErrOAuthUserInfoAlgRequiresResponseType = errors.New(
 		"userinfo responseType is required when signingAlg or encryptionAlg is set")
 
+	// ErrOAuthAuthorizationResponseUnsupportedSigningAlg is returned when the authorization response
+	// signing algorithm is not supported.
+	ErrOAuthAuthorizationResponseUnsupportedSigningAlg = errors.New(
+		"unsupported authorization response signing algorithm")
+	// ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg is returned when the authorization response
+	// encryption algorithm is not supported.
+	ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg = errors.New(
+		"unsupported authorization response encryption algorithm")
+	// ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc is returned when the authorization response
+	// content-encryption algorithm is not supported.
+	ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc = errors.New(
+		"unsupported authorization response content-encryption algorithm")
+	// ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc is returned when encryptionAlg is set without
+	// encryptionEnc.
+	ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc = errors.New(
+		"authorizationResponse encryptionEnc is required when encryptionAlg is set")
+	// ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg is returned when encryptionEnc is set without
+	// encryptionAlg.
+	ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg = errors.New(
+		"authorizationResponse encryptionAlg is required when encryptionEnc is set")
+	// ErrOAuthAuthorizationResponseEncryptionRequiresCertificate is returned when authorization response
+	// encryption has no certificate.
+	ErrOAuthAuthorizationResponseEncryptionRequiresCertificate = errors.New(
+		"authorization response encryption requires a certificate (JWKS or JWKS_URI)")
+	// ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe is returned when the JWKS URI fails SSRF safety checks.
+	ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe = errors.New(
+		"authorization response JWKS URI must be a publicly reachable HTTPS URL")
+
 	// ErrOAuthIDTokenUnsupportedEncryptionAlg is returned when the ID token encryption algorithm is not supported.
 	ErrOAuthIDTokenUnsupportedEncryptionAlg = errors.New("unsupported ID token encryption algorithm")
 	// ErrOAuthIDTokenUnsupportedEncryptionEnc is returned when the ID token content-encryption
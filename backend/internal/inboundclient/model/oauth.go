@@ -14,23 +14,35 @@ import (
 // Empty slice/map fields are omitted; booleans are always serialized in both JSON and YAML for
 // explicit semantics.
 type OAuthConfig struct {
-	ClientID                           string                            `json:"clientId,omitempty"                 yaml:"clientId,omitempty"`
-	RedirectURIs                       []string                          `json:"redirectUris,omitempty"             yaml:"redirectUris,omitempty"`
-	PostLogoutRedirectURIs             []string                          `json:"postLogoutRedirectUris,omitempty"   yaml:"postLogoutRedirectUris,omitempty"`
-	GrantTypes                         []providers.GrantType             `json:"grantTypes,omitempty"               yaml:"grantTypes,omitempty"`
-	ResponseTypes                      []providers.ResponseType          `json:"responseTypes,omitempty"            yaml:"responseTypes,omitempty"`
-	TokenEndpointAuthMethod            providers.TokenEndpointAuthMethod `json:"tokenEndpointAuthMethod,omitempty"  yaml:"tokenEndpointAuthMethod,omitempty"`
-	PKCERequired                       bool                              `json:"pkceRequired"                       yaml:"pkceRequired"`
-	PublicClient                       bool                              `json:"publicClient"                       yaml:"publicClient"`
-	RequirePushedAuthorizationRequests bool                              `json:"requirePushedAuthorizationRequests" yaml:"requirePushedAuthorizationRequests"`
-	DPoPBoundAccessTokens              bool                              `json:"dpopBoundAccessTokens"              yaml:"dpopBoundAccessTokens"`
-	IncludeActClaim                    bool                              `json:"includeActClaim"                    yaml:"includeActClaim"`
-	Token                              *providers.OAuthTokenConfig       `json:"token,omitempty"                    yaml:"token,omitempty"`
-	Scopes                             []string                          `json:"scopes,omitempty"                   yaml:"scopes,omitempty"`
-	UserInfo                           *providers.UserInfoConfig         `json:"userInfo,omitempty"                 yaml:"userInfo,omitempty"`
-	ScopeClaims                        map[string][]string               `json:"scopeClaims,omitempty"              yaml:"scopeClaims,omitempty"`
-	Certificate                        *providers.Certificate            `json:"certificate,omitempty"              yaml:"certificate,omitempty"`
-	AcrValues                          []string                          `json:"acrValues,omitempty"                yaml:"acrValues,omitempty"`
+	ClientID                           string                                 `json:"clientId,omitempty"                 yaml:"clientId,omitempty"`
+	RedirectURIs                       []string                               `json:"redirectUris,omitempty"             yaml:"redirectUris,omitempty"`
+	PostLogoutRedirectURIs             []string                               `json:"postLogoutRedirectUris,omitempty"   yaml:"postLogoutRedirectUris,omitempty"`
+	GrantTypes                         []providers.GrantType                  `json:"grantTypes,omitempty"               yaml:"grantTypes,omitempty"`
+	ResponseTypes                      []providers.ResponseType               `json:"responseTypes,omitempty"            yaml:"responseTypes,omitempty"`
+	ResponseModes                      []providers.ResponseMode               `json:"responseModes,omitempty"            yaml:"responseModes,omitempty"`
+	TokenEndpointAuthMethod            providers.TokenEndpointAuthMethod      `json:"tokenEndpointAuthMethod,omitempty"  yaml:"tokenEndpointAuthMethod,omitempty"`
+	PKCERequired                       bool                                   `json:"pkceRequired"                       yaml:"pkceRequired"`
+	PublicClient                       bool                                   `json:"publicClient"                       yaml:"publicClient"`
+	RequirePushedAuthorizationRequests bool                                   `json:"requirePushedAuthorizationRequests" yaml:"requirePushedAuthorizationRequests"`
+	RequireSignedRequestObject         bool                                   `json:"requireSignedRequestObject"         yaml:"requireSignedRequestObject"`
+	RequestURIs                        []string                               `json:"requestUris,omitempty"              yaml:"requestUris,omitempty"`
+	BackchannelLogoutURI               string                                 `json:"backchannelLogoutUri,omitempty"     yaml:"backchannelLogoutUri,omitempty"`
+	BackchannelLogoutSessionRequired   bool                                   `json:"backchannelLogoutSessionRequired"   yaml:"backchannelLogoutSessionRequired"`
+	FrontchannelLogoutURI              string                                 `json:"frontchannelLogoutUri,omitempty"    yaml:"frontchannelLogoutUri,omitempty"`
+	FrontchannelLogoutSessionRequired  bool                                   `json:"frontchannelLogoutSessionRequired"  yaml:"frontchannelLogoutSessionRequired"`
+	DPoPBoundAccessTokens              bool                                   `json:"dpopBoundAccessTokens"              yaml:"dpopBoundAccessTokens"`
+	TLSClientAuth                      *providers.TLSClientAuthConfig         `json:"tlsClientAuth,omitempty"            yaml:"tlsClientAuth,omitempty"`
+	MTLSBoundAccessTokens              bool                                   `json:"mtlsBoundAccessTokens"              yaml:"mtlsBoundAccessTokens"`
+	SubjectType                        providers.SubjectType                  `json:"subjectType,omitempty"              yaml:"subjectType,omitempty"`
+	SectorIdentifierURI                string                                 `json:"sectorIdentifierUri,omitempty"      yaml:"sectorIdentifierUri,omitempty"`
+	IncludeActClaim                    bool                                   `json:"includeActClaim"                    yaml:"includeActClaim"`
+	Token                              *providers.OAuthTokenConfig            `json:"token,omitempty"                    yaml:"token,omitempty"`
+	Scopes                             []string                               `json:"scopes,omitempty"                   yaml:"scopes,omitempty"`
+	UserInfo                           *providers.UserInfoConfig              `json:"userInfo,omitempty"                 yaml:"userInfo,omitempty"`
+	AuthorizationResponse              *providers.AuthorizationResponseConfig `json:"authorizationResponse,omitempty" yaml:"authorizationResponse,omitempty"`
+	ScopeClaims                        map[string][]string                    `json:"scopeClaims,omitempty"              yaml:"scopeClaims,omitempty"`
+	Certificate                        *providers.Certificate                 `json:"certificate,omitempty"              yaml:"certificate,omitempty"`
+	AcrValues                          []string                               `json:"acrValues,omitempty"                yaml:"acrValues,omitempty"`
 }
 
 // InboundAuthConfig is the wire output wrapper (GET responses).
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"slices"
 	"strings"
 
@@ -573,12 +574,23 @@ func BuildOAuthClient(
 		PKCERequired:                       p.PKCERequired,
 		PublicClient:                       p.PublicClient,
 		RequirePushedAuthorizationRequests: p.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         p.RequireSignedRequestObject,
+		RequestURIs:                        p.RequestURIs,
+		BackchannelLogoutURI:               p.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   p.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              p.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  p.FrontchannelLogoutSessionRequired,
 		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
+		TLSClientAuth:                      p.TLSClientAuth,
+		MTLSBoundAccessTokens:              p.MTLSBoundAccessTokens,
+		SubjectType:                        providers.SubjectType(p.SubjectType),
+		SectorIdentifierURI:                p.SectorIdentifierURI,
 		IncludeActClaim:                    p.IncludeActClaim,
 		Scopes:                             p.Scopes,
 		ScopeClaims:                        p.ScopeClaims,
 		Token:                              p.Token,
 		UserInfo:                           p.UserInfo,
+		AuthorizationResponse:              p.AuthorizationResponse,
 		Certificate:                        p.Certificate,
 		AcrValues:                          p.AcrValues,
 	}
@@ -588,6 +600,9 @@ func BuildOAuthClient(
 	for _, rt := range p.ResponseTypes {
 		client.ResponseTypes = append(client.ResponseTypes, providers.ResponseType(rt))
 	}
+	for _, rm := range p.ResponseModes {
+		client.ResponseModes = append(client.ResponseModes, providers.ResponseMode(rm))
+	}
 	return client
 }
 
@@ -796,6 +811,15 @@ func validateOAuthProfile(p *providers.OAuthProfile, hasClientSecret bool,
 	if err := validateTokenEndpointAuthMethod(p, hasClientSecret); err != nil {
 		return err
 	}
+	if err := validateRequestObjectConfig(p); err != nil {
+		return err
+	}
+	if err := validateLogoutURIs(p); err != nil {
+		return err
+	}
+	if err := validateSubjectType(p); err != nil {
+		return err
+	}
 	if p.PublicClient {
 		if err := validatePublicClient(p); err != nil {
 			return err
@@ -804,6 +828,9 @@ func validateOAuthProfile(p *providers.OAuthProfile, hasClientSecret bool,
 	if err := validateUserInfoConfig(p, cryptoProvider, jweService); err != nil {
 		return err
 	}
+	if err := validateAuthorizationResponseConfig(p, cryptoProvider, jweService); err != nil {
+		return err
+	}
 	if err := validateIDTokenConfig(p, jweService); err != nil {
 		return err
 	}
@@ -813,6 +840,96 @@ func validateOAuthProfile(p *providers.OAuthProfile, hasClientSecret bool,
 	return nil
 }
 
+// validateRequestObjectConfig validates the signed request object (JAR) configuration. Request objects
+// are verified with a key from the client's certificate, so requiring them needs a certificate. Request
+// objects are only fetched from registered request URIs, which must be absolute https URIs.
+func validateRequestObjectConfig(p *providers.OAuthProfile) error {
+	if p.RequireSignedRequestObject && (p.Certificate == nil || p.Certificate.Type == "") {
+		return ErrOAuthSignedRequestObjectRequiresCertificate
+	}
+	for _, requestURI := range p.RequestURIs {
+		parsedURI, err := sysutils.ParseURL(requestURI)
+		if err != nil || parsedURI.Scheme != "https" || parsedURI.Host == "" {
+			return ErrOAuthInvalidRequestURI
+		}
+	}
+	return nil
+}
+
+// validateLogoutURIs validates the OIDC back-channel and front-channel logout URIs. Both must be absolute
+// http(s) URIs without a fragment.
+func validateLogoutURIs(p *providers.OAuthProfile) error {
+	for _, logoutURI := range []string{p.BackchannelLogoutURI, p.FrontchannelLogoutURI} {
+		if logoutURI == "" {
+			continue
+		}
+		parsedURI, err := sysutils.ParseURL(logoutURI)
+		if err != nil || (parsedURI.Scheme != "http" && parsedURI.Scheme != "https") ||
+			parsedURI.Host == "" || parsedURI.Fragment != "" {
+			return ErrOAuthInvalidLogoutURI
+		}
+	}
+	return nil
+}
+
+// validateSubjectType validates the subject identifier type and sector identifier URI. A pairwise client
+// must have a sector to compute its subject identifiers for: either a sector identifier URI, or redirect
+// URIs that all share one host.
+func validateSubjectType(p *providers.OAuthProfile) error {
+	if p.SubjectType != "" && !providers.SubjectType(p.SubjectType).IsValid() {
+		return ErrOAuthInvalidSubjectType
+	}
+	if p.SectorIdentifierURI != "" {
+		parsedURI, err := sysutils.ParseURL(p.SectorIdentifierURI)
+		if err != nil || parsedURI.Scheme != "https" || parsedURI.Host == "" || parsedURI.Fragment != "" {
+			return ErrOAuthInvalidSectorIdentifierURI
+		}
+	}
+	if providers.SubjectType(p.SubjectType) == providers.SubjectTypePairwise {
+		if _, err := providers.SectorIdentifier(p.SectorIdentifierURI, p.RedirectURIs); err != nil {
+			return ErrOAuthPairwiseRequiresSectorIdentifier
+		}
+	}
+	return nil
+}
+
+// validateAuthorizationResponseConfig validates the JWT-secured authorization response (JARM) configuration.
+func validateAuthorizationResponseConfig(p *providers.OAuthProfile, cryptoProvider providers.RuntimeCryptoProvider,
+	jweService jwe.JWEServiceInterface) error {
+	if p.AuthorizationResponse == nil {
+		return nil
+	}
+	cfg := p.AuthorizationResponse
+
+	if cfg.SigningAlg != "" && !slices.Contains(cryptoProvider.GetSupportedSigningAlgorithms(), cfg.SigningAlg) {
+		return ErrOAuthAuthorizationResponseUnsupportedSigningAlg
+	}
+	if cfg.EncryptionEnc != "" && cfg.EncryptionAlg == "" {
+		return ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg
+	}
+	if cfg.EncryptionAlg == "" {
+		return nil
+	}
+	if !slices.Contains(jweService.SupportedKeyEncryptionAlgorithms(), cfg.EncryptionAlg) {
+		return ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg
+	}
+	if cfg.EncryptionEnc == "" {
+		return ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc
+	}
+	if !slices.Contains(jweService.SupportedContentEncryptionAlgorithms(), cfg.EncryptionEnc) {
+		return ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc
+	}
+	if p.Certificate == nil || p.Certificate.Type == "" {
+		return ErrOAuthAuthorizationResponseEncryptionRequiresCertificate
+	}
+	if p.Certificate.Type == cert.CertificateTypeJWKSURI {
+		if err := syshttp.IsSSRFSafeURL(p.Certificate.Value); err != nil {
+			return ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe
+		}
+	}
+	return nil
+}
+
 // maxDefaultAudienceLength bounds the access token default audience, a single audience identifier
 // (typically a URI), to a sane length.
 const maxDefaultAudienceLength = 2048
@@ -1022,6 +1139,11 @@ func validateGrantAndResponseTypes(p *providers.OAuthProfile) error {
 	if err != nil {
 		return err
 	}
+	for _, responseMode := range p.ResponseModes {
+		if !providers.ResponseMode(responseMode).IsValid() {
+			return ErrOAuthInvalidResponseMode
+		}
+	}
 	if len(p.GrantTypes) == 1 &&
 		slices.Contains(p.GrantTypes, string(providers.GrantTypeClientCredentials)) &&
 		len(p.ResponseTypes) > 0 {
@@ -1064,6 +1186,21 @@ func validateTokenEndpointAuthMethod(p *providers.OAuthProfile, hasClientSecret
 		if hasClientSecret {
 			return ErrOAuthPrivateKeyJWTCannotHaveClientSecret
 		}
+	case providers.TokenEndpointAuthMethodTLSClientAuth:
+		if err := validateTLSClientAuthConfig(p.TLSClientAuth); err != nil {
+			return err
+		}
+		if hasClientSecret {
+			return ErrOAuthMutualTLSCannotHaveClientSecret
+		}
+	case providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
+		// The client's self-signed certificate is registered through its JWKS (RFC 8705 §2.2.2).
+		if !hasCert {
+			return ErrOAuthSelfSignedTLSClientAuthRequiresCertificate
+		}
+		if hasClientSecret {
+			return ErrOAuthMutualTLSCannotHaveClientSecret
+		}
 	case providers.TokenEndpointAuthMethodClientSecretBasic, providers.TokenEndpointAuthMethodClientSecretPost:
 		// A certificate is allowed: it carries the client's public key for token encryption
 		// (JWE / NESTED_JWT), independent of how the client authenticates.
@@ -1090,6 +1227,35 @@ func validateTokenEndpointAuthMethod(p *providers.OAuthProfile, hasClientSecret
 	return nil
 }
 
+// validateTLSClientAuthConfig validates the certificate subject of a tls_client_auth client. Exactly one
+// subject field must be set (RFC 8705 §2.1.2) and SAN values must be well-formed.
+func validateTLSClientAuthConfig(cfg *providers.TLSClientAuthConfig) error {
+	if cfg == nil {
+		return ErrOAuthTLSClientAuthRequiresSubject
+	}
+	set := 0
+	for _, value := range []string{cfg.SubjectDN, cfg.SANDNS, cfg.SANURI, cfg.SANIP, cfg.SANEmail} {
+		if strings.TrimSpace(value) != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return ErrOAuthTLSClientAuthRequiresSubject
+	}
+	if cfg.SANIP != "" && net.ParseIP(cfg.SANIP) == nil {
+		return ErrOAuthInvalidTLSClientAuthSubject
+	}
+	if cfg.SANURI != "" {
+		if parsedURI, err := sysutils.ParseURL(cfg.SANURI); err != nil || parsedURI.Scheme == "" {
+			return ErrOAuthInvalidTLSClientAuthSubject
+		}
+	}
+	if cfg.SANEmail != "" && !strings.Contains(cfg.SANEmail, "@") {
+		return ErrOAuthInvalidTLSClientAuthSubject
+	}
+	return nil
+}
+
 // validateAllowedGrantTypes rejects grant types not permitted by the deployment's configured
 // oauth.allowed_grant_types allow-list. An empty allow-list permits all grant types.
 func validateWithAllowedGrantTypes(grantTypes []string) error {
@@ -787,6 +787,73 @@ func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_
 	assert.ErrorIs(suite.T(), err, ErrOAuthPrivateKeyJWTRequiresCertificate)
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidateRequestObjectConfig() {
+	assert.NoError(suite.T(), validateRequestObjectConfig(&providers.OAuthProfile{}))
+	assert.ErrorIs(suite.T(), validateRequestObjectConfig(&providers.OAuthProfile{RequireSignedRequestObject: true}),
+		ErrOAuthSignedRequestObjectRequiresCertificate)
+	assert.NoError(suite.T(), validateRequestObjectConfig(&providers.OAuthProfile{
+		RequireSignedRequestObject: true,
+		Certificate:                &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: "{}"},
+	}))
+	assert.NoError(suite.T(), validateRequestObjectConfig(&providers.OAuthProfile{
+		RequestURIs: []string{"https://client.example.com/request.jwt#v1"},
+	}))
+	for _, requestURI := range []string{"http://client.example.com/request.jwt", "/request.jwt", "https://"} {
+		assert.ErrorIs(suite.T(), validateRequestObjectConfig(&providers.OAuthProfile{
+			RequestURIs: []string{requestURI},
+		}), ErrOAuthInvalidRequestURI, requestURI)
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateLogoutURIs() {
+	assert.NoError(suite.T(), validateLogoutURIs(&providers.OAuthProfile{}))
+	assert.NoError(suite.T(), validateLogoutURIs(&providers.OAuthProfile{
+		BackchannelLogoutURI:  "https://rp.example.com/logout/backchannel",
+		FrontchannelLogoutURI: "https://rp.example.com/logout/frontchannel?tenant=a",
+	}))
+	for _, logoutURI := range []string{"/logout", "myapp://logout", "https://rp.example.com/logout#frag", "https://"} {
+		assert.ErrorIs(suite.T(), validateLogoutURIs(&providers.OAuthProfile{BackchannelLogoutURI: logoutURI}),
+			ErrOAuthInvalidLogoutURI, logoutURI)
+		assert.ErrorIs(suite.T(), validateLogoutURIs(&providers.OAuthProfile{FrontchannelLogoutURI: logoutURI}),
+			ErrOAuthInvalidLogoutURI, logoutURI)
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateSubjectType() {
+	pairwise := string(providers.SubjectTypePairwise)
+	testCases := []struct {
+		name    string
+		profile *providers.OAuthProfile
+		wantErr error
+	}{
+		{"Unset", &providers.OAuthProfile{}, nil},
+		{"Public", &providers.OAuthProfile{SubjectType: string(providers.SubjectTypePublic)}, nil},
+		{"Unsupported", &providers.OAuthProfile{SubjectType: "ephemeral"}, ErrOAuthInvalidSubjectType},
+		{"PairwiseSingleRedirectHost", &providers.OAuthProfile{SubjectType: pairwise,
+			RedirectURIs: []string{"https://rp.example.com/cb", "https://rp.example.com/cb2"}}, nil},
+		{"PairwiseMultipleRedirectHosts", &providers.OAuthProfile{SubjectType: pairwise,
+			RedirectURIs: []string{"https://a.example.com/cb", "https://b.example.com/cb"}},
+			ErrOAuthPairwiseRequiresSectorIdentifier},
+		{"PairwiseSectorIdentifierURI", &providers.OAuthProfile{SubjectType: pairwise,
+			SectorIdentifierURI: "https://sector.example.com/redirects.json",
+			RedirectURIs:        []string{"https://a.example.com/cb", "https://b.example.com/cb"}}, nil},
+		{"PairwiseWithoutRedirectURIs", &providers.OAuthProfile{SubjectType: pairwise},
+			ErrOAuthPairwiseRequiresSectorIdentifier},
+		{"HTTPSectorIdentifierURI", &providers.OAuthProfile{
+			SectorIdentifierURI: "http://sector.example.com/redirects.json"}, ErrOAuthInvalidSectorIdentifierURI},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateSubjectType(tc.profile)
+			if tc.wantErr == nil {
+				suite.NoError(err)
+				return
+			}
+			suite.ErrorIs(err, tc.wantErr)
+		})
+	}
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_PrivateKeyJWTWithSecret() {
 	p := &providers.OAuthProfile{
 		TokenEndpointAuthMethod: "private_key_jwt",
@@ -796,6 +863,47 @@ func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_
 	assert.ErrorIs(suite.T(), err, ErrOAuthPrivateKeyJWTCannotHaveClientSecret)
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_TLSClientAuth() {
+	withSubject := func(subject *providers.TLSClientAuthConfig) *providers.OAuthProfile {
+		return &providers.OAuthProfile{TokenEndpointAuthMethod: "tls_client_auth", TLSClientAuth: subject}
+	}
+
+	for _, subject := range []*providers.TLSClientAuthConfig{
+		{SubjectDN: "CN=client-1,O=Example Corp,C=US"},
+		{SANDNS: "client.example.com"},
+		{SANURI: "spiffe://example.com/client"},
+		{SANIP: "2001:db8::1"},
+		{SANEmail: "client@example.com"},
+	} {
+		assert.NoError(suite.T(), validateTokenEndpointAuthMethod(withSubject(subject), false))
+	}
+
+	assert.ErrorIs(suite.T(), validateTokenEndpointAuthMethod(withSubject(nil), false),
+		ErrOAuthTLSClientAuthRequiresSubject)
+	assert.ErrorIs(suite.T(), validateTokenEndpointAuthMethod(withSubject(&providers.TLSClientAuthConfig{
+		SANDNS: "client.example.com", SANEmail: "client@example.com",
+	}), false), ErrOAuthTLSClientAuthRequiresSubject)
+	for _, subject := range []*providers.TLSClientAuthConfig{
+		{SANIP: "not-an-ip"}, {SANURI: "no-scheme"}, {SANEmail: "client.example.com"},
+	} {
+		assert.ErrorIs(suite.T(), validateTokenEndpointAuthMethod(withSubject(subject), false),
+			ErrOAuthInvalidTLSClientAuthSubject)
+	}
+	assert.ErrorIs(suite.T(), validateTokenEndpointAuthMethod(withSubject(&providers.TLSClientAuthConfig{
+		SANDNS: "client.example.com",
+	}), true), ErrOAuthMutualTLSCannotHaveClientSecret)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_SelfSignedTLSClientAuth() {
+	p := &providers.OAuthProfile{TokenEndpointAuthMethod: "self_signed_tls_client_auth"}
+	assert.ErrorIs(suite.T(), validateTokenEndpointAuthMethod(p, false),
+		ErrOAuthSelfSignedTLSClientAuthRequiresCertificate)
+
+	p.Certificate = &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: "{}"}
+	assert.NoError(suite.T(), validateTokenEndpointAuthMethod(p, false))
+	assert.ErrorIs(suite.T(), validateTokenEndpointAuthMethod(p, true), ErrOAuthMutualTLSCannotHaveClientSecret)
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_NoneRequiresPublicClient() {
 	p := &providers.OAuthProfile{TokenEndpointAuthMethod: "none"}
 	err := validateTokenEndpointAuthMethod(p, false)
@@ -975,6 +1083,105 @@ func (suite *InboundClientServiceTestSuite) TestValidateUserInfoConfig_JWKSURISS
 		ErrOAuthUserInfoJWKSURINotSSRFSafe)
 }
 
+// validateAuthorizationResponseConfig
+
+func (suite *InboundClientServiceTestSuite) TestValidateAuthorizationResponseConfig_HappyPaths() {
+	testCases := []*providers.OAuthProfile{
+		{},
+		{AuthorizationResponse: &providers.AuthorizationResponseConfig{}},
+		{AuthorizationResponse: &providers.AuthorizationResponseConfig{SigningAlg: "RS256"}},
+		{
+			Certificate: &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: "{}"},
+			AuthorizationResponse: &providers.AuthorizationResponseConfig{
+				SigningAlg: "RS256", EncryptionAlg: "RSA-OAEP-256", EncryptionEnc: "A256GCM",
+			},
+		},
+	}
+	for _, p := range testCases {
+		assert.NoError(suite.T(), validateAuthorizationResponseConfig(p, suite.cryptoMock, suite.jweService))
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateAuthorizationResponseConfig_ErrorPaths() {
+	jwksCert := &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: "{}"}
+	testCases := []struct {
+		name        string
+		profile     *providers.OAuthProfile
+		expectedErr error
+	}{
+		{
+			name: "UnsupportedSigningAlg",
+			profile: &providers.OAuthProfile{
+				AuthorizationResponse: &providers.AuthorizationResponseConfig{SigningAlg: "BOGUS"},
+			},
+			expectedErr: ErrOAuthAuthorizationResponseUnsupportedSigningAlg,
+		},
+		{
+			name: "EncryptionEncWithoutAlg",
+			profile: &providers.OAuthProfile{
+				AuthorizationResponse: &providers.AuthorizationResponseConfig{EncryptionEnc: "A256GCM"},
+			},
+			expectedErr: ErrOAuthAuthorizationResponseEncryptionEncRequiresAlg,
+		},
+		{
+			name: "UnsupportedEncryptionAlg",
+			profile: &providers.OAuthProfile{
+				Certificate: jwksCert,
+				AuthorizationResponse: &providers.AuthorizationResponseConfig{
+					EncryptionAlg: "BOGUS", EncryptionEnc: "A256GCM",
+				},
+			},
+			expectedErr: ErrOAuthAuthorizationResponseUnsupportedEncryptionAlg,
+		},
+		{
+			name: "EncryptionAlgWithoutEnc",
+			profile: &providers.OAuthProfile{
+				Certificate:           jwksCert,
+				AuthorizationResponse: &providers.AuthorizationResponseConfig{EncryptionAlg: "RSA-OAEP-256"},
+			},
+			expectedErr: ErrOAuthAuthorizationResponseEncryptionAlgRequiresEnc,
+		},
+		{
+			name: "UnsupportedEncryptionEnc",
+			profile: &providers.OAuthProfile{
+				Certificate: jwksCert,
+				AuthorizationResponse: &providers.AuthorizationResponseConfig{
+					EncryptionAlg: "RSA-OAEP-256", EncryptionEnc: "BOGUS",
+				},
+			},
+			expectedErr: ErrOAuthAuthorizationResponseUnsupportedEncryptionEnc,
+		},
+		{
+			name: "EncryptionRequiresCertificate",
+			profile: &providers.OAuthProfile{
+				AuthorizationResponse: &providers.AuthorizationResponseConfig{
+					EncryptionAlg: "RSA-OAEP-256", EncryptionEnc: "A256GCM",
+				},
+			},
+			expectedErr: ErrOAuthAuthorizationResponseEncryptionRequiresCertificate,
+		},
+		{
+			name: "JWKSURINotSSRFSafe",
+			profile: &providers.OAuthProfile{
+				Certificate: &inboundmodel.Certificate{
+					Type: cert.CertificateTypeJWKSURI, Value: "http://127.0.0.1/jwks",
+				},
+				AuthorizationResponse: &providers.AuthorizationResponseConfig{
+					EncryptionAlg: "RSA-OAEP-256", EncryptionEnc: "A256GCM",
+				},
+			},
+			expectedErr: ErrOAuthAuthorizationResponseJWKSURINotSSRFSafe,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			assert.ErrorIs(suite.T(),
+				validateAuthorizationResponseConfig(tc.profile, suite.cryptoMock, suite.jweService), tc.expectedErr)
+		})
+	}
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidateUserInfoConfig_JWSWithoutSigningAlg() {
 	p := &providers.OAuthProfile{
 		UserInfo: &providers.UserInfoConfig{ResponseType: providers.UserInfoResponseTypeJWS},
@@ -1983,6 +2190,24 @@ func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_In
 	assert.ErrorIs(suite.T(), validateGrantAndResponseTypes(p), ErrOAuthInvalidResponseType)
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_InvalidResponseMode() {
+	p := &providers.OAuthProfile{
+		GrantTypes:    []string{"authorization_code"},
+		ResponseTypes: []string{"code"},
+		ResponseModes: []string{"form_post", "web_message"},
+	}
+	assert.ErrorIs(suite.T(), validateGrantAndResponseTypes(p), ErrOAuthInvalidResponseMode)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_ValidResponseModes() {
+	p := &providers.OAuthProfile{
+		GrantTypes:    []string{"authorization_code"},
+		ResponseTypes: []string{"code"},
+		ResponseModes: []string{"query", "fragment", "form_post", "jwt", "form_post.jwt"},
+	}
+	assert.NoError(suite.T(), validateGrantAndResponseTypes(p))
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_ClientCredsWithResponseType() {
 	p := &providers.OAuthProfile{
 		GrantTypes:    []string{"client_credentials"},
@@ -45,6 +45,9 @@ var (
 			`UPDATED_AT = datetime('now') WHERE ID = $5 AND TYPE = $6 AND DEPLOYMENT_ID = $7`,
 		Query: `UPDATE "NOTIFICATION_SENDER" SET NAME = $1, DESCRIPTION = $2, PROVIDER = $3, PROPERTIES = $4, ` +
 			`UPDATED_AT = datetime('now') WHERE ID = $5 AND TYPE = $6 AND DEPLOYMENT_ID = $7`,
+		MySQLQuery: `UPDATE "NOTIFICATION_SENDER" ` +
+			`SET NAME = $1, DESCRIPTION = $2, PROVIDER = $3, PROPERTIES = $4, ` +
+			`UPDATED_AT = CURRENT_TIMESTAMP WHERE ID = $5 AND TYPE = $6 AND DEPLOYMENT_ID = $7`,
 	}
 
 	// queryDeleteNotificationSender is the query to delete a notification sender
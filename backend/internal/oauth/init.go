@@ -10,11 +10,13 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	flowsession "github.com/thunder-id/thunderid/internal/flow/session"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/jwks"
 	oauth2authz "github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/callback"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/granthandlers"
@@ -22,7 +24,9 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	oauth2logout "github.com/thunder-id/thunderid/internal/oauth/oauth2/logout"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/token"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
@@ -42,6 +46,7 @@ func Initialize(
 	jwtService jwt.JWTServiceInterface,
 	jweService jwe.JWEServiceInterface,
 	flowExecService flowexec.FlowExecServiceInterface,
+	sessionService flowsession.Service,
 	observabilitySvc providers.ObservabilityProvider,
 	runtimeCrypto providers.RuntimeCryptoProvider,
 	ouService providers.OrganizationUnitProvider,
@@ -65,6 +70,7 @@ func Initialize(
 	scopeValidator := scope.Initialize()
 	discoveryService := discovery.Initialize(mux, runtimeCrypto, jweService, cfg)
 	jtiStore := jti.Initialize(runtimeStore)
+	pairwiseService := pairwise.Initialize(actorProvider, cfg)
 	// The revocation services are constructed by the service manager, not here: the session service
 	// needs the same criteria revoker, and it is wired before the OAuth engine. This registers the
 	// RFC 7009 routes against the already-built service.
@@ -77,11 +83,13 @@ func Initialize(
 	}
 
 	tokenBuilder, tokenValidator := tokenservice.Initialize(
-		cfg, jwtService, jweService, resolver, idpService, enforcementService, jtiStore)
+		cfg, jwtService, jweService, resolver, idpService, enforcementService, jtiStore, pairwiseService)
+	requestObjects := requestobject.Initialize(jwtService, resolver, httpClient, cfg)
 	parService := par.Initialize(mux, actorProvider, authnProvider, jwtService, discoveryService,
-		resourceService, dpopVerifier, cfg, runtimeStore, jtiStore)
+		resourceService, dpopVerifier, requestObjects, cfg, runtimeStore, jtiStore)
 	oauth2AuthzService, err := oauth2authz.Initialize(mux, actorProvider, resourceService,
-		jwtService, flowExecService, parService, revocationSvc, cfg, runtimeStore, transactioner)
+		jwtService, jweService, resolver, flowExecService, parService, requestObjects, revocationSvc, cfg,
+		runtimeStore, transactioner)
 	if err != nil {
 		return err
 	}
@@ -90,25 +98,33 @@ func Initialize(
 	if len(cfg.OAuth.AllowedGrantTypes) == 0 ||
 		slices.Contains(cfg.OAuth.AllowedGrantTypes, string(providers.GrantTypeCIBA)) {
 		cibaService = ciba.Initialize(mux, jwtService, actorProvider, authnProvider, flowExecService,
+			discoveryService, resourceService, runtimeStore, jtiStore, pairwiseService, cfg)
+	}
+
+	var deviceService device.DeviceServiceInterface
+	if len(cfg.OAuth.AllowedGrantTypes) == 0 ||
+		slices.Contains(cfg.OAuth.AllowedGrantTypes, string(providers.GrantTypeDeviceCode)) {
+		deviceService = device.Initialize(mux, jwtService, actorProvider, authnProvider, flowExecService,
 			discoveryService, resourceService, runtimeStore, jtiStore, cfg)
 	}
 
 	grantHandlerProvider := granthandlers.Initialize(
 		jwtService, oauth2AuthzService, tokenBuilder, tokenValidator,
 		attributeCacheSvc, ouService, authzService, actorProvider, resourceService,
-		cibaService, revocationSvc, revocationSvc, cfg)
+		cibaService, deviceService, revocationSvc, revocationSvc, cfg)
 
 	token.Initialize(mux, jwtService, actorProvider, authnProvider, grantHandlerProvider,
 		scopeValidator, observabilitySvc, discoveryService, dpopVerifier, jtiStore, cfg)
 	introspect.Initialize(mux, jwtService, actorProvider, authnProvider, discoveryService, tokenValidator,
-		jtiStore, cfg.JWT.Leeway)
+		jtiStore, pairwiseService, cfg.JWT.Leeway)
 	userinfo.Initialize(mux, jwtService, jweService, resolver,
 		tokenValidator, actorProvider, attributeCacheSvc,
-		discoveryService, dpopVerifier, cfg)
-	callback.Initialize(mux, oauth2AuthzService, cibaService, cfg)
+		discoveryService, dpopVerifier, pairwiseService, cfg)
+	callback.Initialize(mux, oauth2AuthzService, cibaService, deviceService, cfg)
 
 	if cfg.OAuth.Logout.IsEnabled() {
-		oauth2logout.Initialize(mux, jwtService, actorProvider, flowExecService, runtimeStore, cfg)
+		oauth2logout.Initialize(mux, jwtService, actorProvider, flowExecService, sessionService, runtimeStore,
+			httpClient, observabilitySvc, pairwiseService, cfg)
 	}
 	return nil
 }
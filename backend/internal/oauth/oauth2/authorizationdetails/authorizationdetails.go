@@ -0,0 +1,184 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package authorizationdetails provides shared helpers for rich authorization requests (RFC 9396)
+// across the authorization, pushed authorization, CIBA and token endpoints.
+package authorizationdetails
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+)
+
+// Parse decodes the authorization_details request parameter. The value must be a non-empty JSON
+// array of objects, each with a string type member (RFC 9396 §2). An empty value yields no details.
+func Parse(raw string) ([]providers.AuthorizationDetail, *model.ErrorResponse) {
+	if raw == "" {
+		return nil, nil
+	}
+	var details []providers.AuthorizationDetail
+	if err := json.Unmarshal([]byte(raw), &details); err != nil || len(details) == 0 {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidAuthorizationDetails,
+			ErrorDescription: "The authorization_details parameter must be a non-empty JSON array of objects",
+		}
+	}
+	for _, detail := range details {
+		if detail.Type() == "" {
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorInvalidAuthorizationDetails,
+				ErrorDescription: "Each authorization details object must have a type",
+			}
+		}
+	}
+	return details, nil
+}
+
+// Encode returns the JSON encoding of the authorization details, or an empty string when there are
+// none.
+func Encode(details []providers.AuthorizationDetail) string {
+	if len(details) == 0 {
+		return ""
+	}
+	encoded, err := json.Marshal(details)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+// FromClaim converts the value of an authorization_details JWT claim back to authorization details.
+// Returns false when the value is not an array of objects.
+func FromClaim(value interface{}) ([]providers.AuthorizationDetail, bool) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, false
+	}
+	details := make([]providers.AuthorizationDetail, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		details = append(details, providers.AuthorizationDetail(obj))
+	}
+	return details, true
+}
+
+// ResolveAudienceBinding decides the single resource server an access token binds to, as
+// resourceindicators.ResolveAudienceBinding does, and validates the requested authorization details
+// against it. A request that carries authorization details is always bound to a resource server,
+// since the details are interpreted by the resource server that registered their types.
+func ResolveAudienceBinding(
+	ctx context.Context,
+	resourceService providers.ResourceServerProvider,
+	resources []string,
+	permissionScopes []string,
+	details []providers.AuthorizationDetail,
+) (*providers.ResourceServer, *model.ErrorResponse) {
+	if len(details) == 0 {
+		return resourceindicators.ResolveAudienceBinding(ctx, resourceService, resources, permissionScopes)
+	}
+	targetRS, errResp := resourceindicators.ResolveTargetResourceServer(ctx, resourceService, resources)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if errResp := Validate(ctx, resourceService, targetRS.ID, details); errResp != nil {
+		return nil, errResp
+	}
+	return targetRS, nil
+}
+
+// Validate checks the authorization details against the types registered on the resource server.
+func Validate(
+	ctx context.Context,
+	resourceService providers.ResourceServerProvider,
+	resourceServerID string,
+	details []providers.AuthorizationDetail,
+) *model.ErrorResponse {
+	if len(details) == 0 {
+		return nil
+	}
+	svcErr := resourceService.ValidateAuthorizationDetails(ctx, resourceServerID, details)
+	if svcErr == nil {
+		return nil
+	}
+	if svcErr.Type == tidcommon.ServerErrorType {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate authorization details",
+		}
+	}
+	return &model.ErrorResponse{
+		Error:            constants.ErrorInvalidAuthorizationDetails,
+		ErrorDescription: "The authorization details are not supported by the resource server",
+	}
+}
+
+// Narrow resolves the authorization details for a token request against those granted. When the
+// request carries no authorization_details, the granted details are returned unchanged; otherwise
+// the requested details must be a subset of the granted ones and are returned in their place.
+func Narrow(
+	raw string, granted []providers.AuthorizationDetail,
+) ([]providers.AuthorizationDetail, *model.ErrorResponse) {
+	requested, errResp := Parse(raw)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if requested == nil {
+		return granted, nil
+	}
+	if !IsSubset(requested, granted) {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidAuthorizationDetails,
+			ErrorDescription: "The requested authorization details exceed those granted",
+		}
+	}
+	return requested, nil
+}
+
+// IsSubset reports whether every requested authorization details object was granted. Objects are
+// compared by value, so a token request can only narrow the grant by omitting whole objects
+// (RFC 9396 §6.1).
+func IsSubset(requested, granted []providers.AuthorizationDetail) bool {
+	normalizedGranted := make([]interface{}, 0, len(granted))
+	for _, detail := range granted {
+		normalizedGranted = append(normalizedGranted, normalize(detail))
+	}
+	for _, detail := range requested {
+		normalized := normalize(detail)
+		found := false
+		for _, candidate := range normalizedGranted {
+			if reflect.DeepEqual(normalized, candidate) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// normalize converts an authorization details object to the generic form produced by encoding/json
+// so that objects decoded from different sources compare equal.
+func normalize(detail providers.AuthorizationDetail) interface{} {
+	data, err := json.Marshal(detail)
+	if err != nil {
+		return nil
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil
+	}
+	return normalized
+}
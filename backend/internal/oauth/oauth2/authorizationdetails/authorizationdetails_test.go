@@ -0,0 +1,153 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authorizationdetails
+
+import (
+	"context"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+)
+
+const testPaymentDetails = `[{"type":"payment_initiation","instructedAmount":{"currency":"EUR","amount":"10.00"}}]`
+
+type AuthorizationDetailsTestSuite struct {
+	suite.Suite
+	mockResourceService *resourcemock.ResourceServiceInterfaceMock
+}
+
+func TestAuthorizationDetailsTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationDetailsTestSuite))
+}
+
+func (suite *AuthorizationDetailsTestSuite) SetupTest() {
+	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+}
+
+// Parse tests
+
+func (suite *AuthorizationDetailsTestSuite) TestParse_Empty() {
+	details, errResp := Parse("")
+	assert.Nil(suite.T(), errResp)
+	assert.Nil(suite.T(), details)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestParse_Valid() {
+	details, errResp := Parse(testPaymentDetails)
+	assert.Nil(suite.T(), errResp)
+	assert.Len(suite.T(), details, 1)
+	assert.Equal(suite.T(), "payment_initiation", details[0].Type())
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestParse_Invalid() {
+	for _, raw := range []string{`{"type":"a"}`, `[]`, `not-json`, `[{"actions":["read"]}]`, `[{"type":1}]`} {
+		details, errResp := Parse(raw)
+		assert.Nil(suite.T(), details, raw)
+		assert.NotNil(suite.T(), errResp, raw)
+		assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error, raw)
+	}
+}
+
+// Encode and FromClaim tests
+
+func (suite *AuthorizationDetailsTestSuite) TestEncodeAndFromClaim_RoundTrip() {
+	details, _ := Parse(testPaymentDetails)
+	assert.Equal(suite.T(), "", Encode(nil))
+
+	claim := []interface{}{map[string]interface{}(details[0])}
+	decoded, ok := FromClaim(claim)
+	assert.True(suite.T(), ok)
+	assert.JSONEq(suite.T(), testPaymentDetails, Encode(decoded))
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestFromClaim_Invalid() {
+	_, ok := FromClaim("payment_initiation")
+	assert.False(suite.T(), ok)
+	_, ok = FromClaim([]interface{}{"payment_initiation"})
+	assert.False(suite.T(), ok)
+}
+
+// ResolveAudienceBinding tests
+
+func (suite *AuthorizationDetailsTestSuite) TestResolveAudienceBinding_NoDetailsNoScopes() {
+	rs, errResp := ResolveAudienceBinding(context.Background(), suite.mockResourceService, nil, nil, nil)
+	assert.Nil(suite.T(), errResp)
+	assert.Nil(suite.T(), rs)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestResolveAudienceBinding_DetailsUseDefaultResourceServer() {
+	details, _ := Parse(testPaymentDetails)
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, "").
+		Return(&providers.ResourceServer{ID: "rs-default"}, nil)
+	suite.mockResourceService.On("ValidateAuthorizationDetails", mock.Anything, "rs-default", details).
+		Return(nil)
+
+	rs, errResp := ResolveAudienceBinding(context.Background(), suite.mockResourceService, nil, nil, details)
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), "rs-default", rs.ID)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestResolveAudienceBinding_DetailsRejected() {
+	details, _ := Parse(testPaymentDetails)
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, "https://api.example.com").
+		Return(&providers.ResourceServer{ID: "rs-1"}, nil)
+	suite.mockResourceService.On("ValidateAuthorizationDetails", mock.Anything, "rs-1", details).
+		Return(&tidcommon.ServiceError{Type: tidcommon.ClientErrorType, Code: "RES-1025"})
+
+	rs, errResp := ResolveAudienceBinding(context.Background(), suite.mockResourceService,
+		[]string{"https://api.example.com"}, nil, details)
+	assert.Nil(suite.T(), rs)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+}
+
+func (suite *AuthorizationDetailsTestSuite) TestValidate_ServerError() {
+	details, _ := Parse(testPaymentDetails)
+	suite.mockResourceService.On("ValidateAuthorizationDetails", mock.Anything, "rs-1", details).
+		Return(&tidcommon.InternalServerError)
+
+	errResp := Validate(context.Background(), suite.mockResourceService, "rs-1", details)
+	assert.Equal(suite.T(), constants.ErrorServerError, errResp.Error)
+}
+
+// IsSubset tests
+
+func (suite *AuthorizationDetailsTestSuite) TestIsSubset() {
+	granted, _ := Parse(`[{"type":"a","actions":["read"]},{"type":"b","locations":["https://x"]}]`)
+	narrowed, _ := Parse(`[{"type":"b","locations":["https://x"]}]`)
+	widened, _ := Parse(`[{"type":"a","actions":["read","write"]}]`)
+
+	assert.True(suite.T(), IsSubset(nil, granted))
+	assert.True(suite.T(), IsSubset(granted, granted))
+	assert.True(suite.T(), IsSubset(narrowed, granted))
+	assert.False(suite.T(), IsSubset(widened, granted))
+	assert.False(suite.T(), IsSubset(narrowed, nil))
+}
+
+// Narrow tests
+
+func (suite *AuthorizationDetailsTestSuite) TestNarrow() {
+	granted, _ := Parse(`[{"type":"a"},{"type":"b"}]`)
+
+	details, errResp := Narrow("", granted)
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), granted, details)
+
+	details, errResp = Narrow(`[{"type":"b"}]`, granted)
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), "b", details[0].Type())
+
+	_, errResp = Narrow(`[{"type":"c"}]`, granted)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+
+	_, errResp = Narrow(`[{"type":"a"}]`, nil)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// authzResponseKeyRandomBytes is the number of random bytes for a parked response key (32 bytes = 256 bits).
+const authzResponseKeyRandomBytes = 32
+
+// authorizationResponseStoreInterface defines the interface for parking form_post authorization
+// responses until the user agent redeems them.
+type authorizationResponseStoreInterface interface {
+	AddResponse(ctx context.Context, response encodedAuthorizationResponse) (string, error)
+	TakeResponse(ctx context.Context, key string) (*encodedAuthorizationResponse, error)
+}
+
+// authorizationResponseStore is the runtime-store-backed implementation of
+// authorizationResponseStoreInterface.
+type authorizationResponseStore struct {
+	storeProvider providers.RuntimeStoreProvider
+}
+
+// newAuthorizationResponseStore creates a new runtime-store-backed authorization response store.
+func newAuthorizationResponseStore(storeProvider providers.RuntimeStoreProvider) authorizationResponseStoreInterface {
+	return &authorizationResponseStore{
+		storeProvider: storeProvider,
+	}
+}
+
+// AddResponse parks an encoded authorization response and returns the random key that redeems it.
+func (s *authorizationResponseStore) AddResponse(
+	ctx context.Context, response encodedAuthorizationResponse,
+) (string, error) {
+	b := make([]byte, authzResponseKeyRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	key := base64.RawURLEncoding.EncodeToString(b)
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal authorization response: %w", err)
+	}
+
+	ttlSeconds := int64(formPostResponseValidity.Seconds())
+	if err := s.storeProvider.Put(ctx, providers.NamespaceAuthzResp, key, data, ttlSeconds); err != nil {
+		return "", fmt.Errorf("failed to store authorization response: %w", err)
+	}
+
+	return key, nil
+}
+
+// TakeResponse atomically retrieves and removes a parked authorization response. A parked response
+// can be redeemed only once; errAuthResponseNotFound is returned for unknown, expired or already
+// redeemed keys.
+func (s *authorizationResponseStore) TakeResponse(
+	ctx context.Context, key string,
+) (*encodedAuthorizationResponse, error) {
+	if key == "" {
+		return nil, errAuthResponseNotFound
+	}
+
+	data, err := s.storeProvider.Take(ctx, providers.NamespaceAuthzResp, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve authorization response: %w", err)
+	}
+	if data == nil {
+		return nil, errAuthResponseNotFound
+	}
+
+	var response encodedAuthorizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal authorization response: %w", err)
+	}
+	return &response, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/runtimestoreprovidermock"
+)
+
+// AuthorizationResponseStoreTestSuite exercises the authorizationResponseStore adapter against a
+// real in-memory runtime store, verifying the single-use add/take semantics.
+type AuthorizationResponseStoreTestSuite struct {
+	suite.Suite
+	store    authorizationResponseStoreInterface
+	response encodedAuthorizationResponse
+}
+
+func TestAuthorizationResponseStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationResponseStoreTestSuite))
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) SetupTest() {
+	suite.store = newAuthorizationResponseStore(inmemory.Initialize("test-deployment"))
+	suite.response = encodedAuthorizationResponse{
+		RedirectURI: "https://client.example.com/callback",
+		FormPost:    true,
+		FormParams:  map[string]string{"code": "test-code", "state": "test-state"},
+	}
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestAddAndTakeResponse() {
+	key, err := suite.store.AddResponse(context.Background(), suite.response)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), key)
+
+	taken, err := suite.store.TakeResponse(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.response, *taken)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestAddResponse_GeneratesUniqueKeys() {
+	key1, err1 := suite.store.AddResponse(context.Background(), suite.response)
+	key2, err2 := suite.store.AddResponse(context.Background(), suite.response)
+
+	assert.NoError(suite.T(), err1)
+	assert.NoError(suite.T(), err2)
+	assert.NotEqual(suite.T(), key1, key2)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestTakeResponse_SingleUse() {
+	key, err := suite.store.AddResponse(context.Background(), suite.response)
+	assert.NoError(suite.T(), err)
+
+	_, err = suite.store.TakeResponse(context.Background(), key)
+	assert.NoError(suite.T(), err)
+
+	taken, err := suite.store.TakeResponse(context.Background(), key)
+	assert.ErrorIs(suite.T(), err, errAuthResponseNotFound)
+	assert.Nil(suite.T(), taken)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestTakeResponse_UnknownOrEmptyKey() {
+	for _, key := range []string{"", "unknown-key"} {
+		taken, err := suite.store.TakeResponse(context.Background(), key)
+		assert.ErrorIs(suite.T(), err, errAuthResponseNotFound)
+		assert.Nil(suite.T(), taken)
+	}
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestAddResponse_StoreError() {
+	storeMock := runtimestoreprovidermock.NewRuntimeStoreProviderMock(suite.T())
+	storeMock.EXPECT().Put(mock.Anything, providers.NamespaceAuthzResp, mock.Anything, mock.Anything,
+		int64(formPostResponseValidity.Seconds())).Return(errors.New("store down"))
+	store := newAuthorizationResponseStore(storeMock)
+
+	key, err := store.AddResponse(context.Background(), suite.response)
+
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), key)
+}
+
+func (suite *AuthorizationResponseStoreTestSuite) TestTakeResponse_StoreError() {
+	storeMock := runtimestoreprovidermock.NewRuntimeStoreProviderMock(suite.T())
+	storeMock.EXPECT().Take(mock.Anything, providers.NamespaceAuthzResp, "key-1").
+		Return(nil, errors.New("store down"))
+	store := newAuthorizationResponseStore(storeMock)
+
+	taken, err := store.TakeResponse(context.Background(), "key-1")
+
+	assert.Error(suite.T(), err)
+	assert.NotErrorIs(suite.T(), err, errAuthResponseNotFound)
+	assert.Nil(suite.T(), taken)
+}
@@ -9,6 +9,14 @@ import "time"
 // oauth.authorization_request.validity_period is not configured.
 const defaultAuthzRequestValidity = 60 * time.Minute
 
+// formPostResponseValidity is how long a parked form_post authorization response can be redeemed by
+// the user agent. The hand-off is immediate, so it is kept short.
+const formPostResponseValidity = 60 * time.Second
+
+// authzResponseKeyParam is the query parameter of the authorization response endpoint carrying the key
+// of a parked form_post response.
+const authzResponseKeyParam = "id"
+
 // Authorization code states.
 const (
 	AuthCodeStateActive   = "ACTIVE"
@@ -15,6 +15,10 @@ var errAuthorizationCodeAlreadyConsumed = errors.New("authorization code already
 // errAuthRequestNotFound is returned when an authorization request context is not found in the store.
 var errAuthRequestNotFound = errors.New("authorization request context not found")
 
+// errAuthResponseNotFound is returned when a parked form_post authorization response is not found in the
+// store, because it was never parked, has expired or was already redeemed.
+var errAuthResponseNotFound = errors.New("authorization response not found")
+
 // errAssertionClaimInvalid is returned when a claim in the flow assertion has an unexpected shape
 // (e.g. wrong JSON type). It distinguishes client-facing input errors from genuine internal decode failures.
 var errAssertionClaimInvalid = errors.New("assertion claim is invalid")
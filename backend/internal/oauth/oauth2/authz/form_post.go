@@ -0,0 +1,81 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+)
+
+// formPostTemplate renders the OAuth 2.0 Form Post Response Mode page: a form carrying the response
+// parameters that submits itself to the client's redirect URI. The submit button is shown only when
+// scripts are disabled.
+var formPostTemplate = template.Must(template.New("form_post").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Submit This Form</title></head>
+<body>
+<form method="post" action="{{.Action}}">
+{{- range .Fields}}
+<input type="hidden" name="{{.Name}}" value="{{.Value}}"/>
+{{- end}}
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+<script nonce="{{.Nonce}}">document.forms[0].submit();</script>
+</body>
+</html>
+`))
+
+// formPostField is a single hidden field of the form_post page.
+type formPostField struct {
+	Name  string
+	Value string
+}
+
+// formPostPage is the data rendered into formPostTemplate.
+type formPostPage struct {
+	Action string
+	Fields []formPostField
+	Nonce  string
+}
+
+// writeFormPostResponse renders the auto-submitting form_post page for the encoded response. The
+// page carries its own restrictive Content-Security-Policy that allows only its inline script, and it
+// must not be cached since it carries the authorization response.
+func writeFormPostResponse(w http.ResponseWriter, encoded *encodedAuthorizationResponse) error {
+	nonceBytes := make([]byte, 16)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return fmt.Errorf("failed to generate script nonce: %w", err)
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(nonceBytes)
+
+	page := formPostPage{
+		Action: encoded.RedirectURI,
+		Fields: make([]formPostField, 0, len(encoded.FormParams)),
+		Nonce:  nonce,
+	}
+	for name, value := range encoded.FormParams {
+		page.Fields = append(page.Fields, formPostField{Name: name, Value: value})
+	}
+	sort.Slice(page.Fields, func(i, j int) bool { return page.Fields[i].Name < page.Fields[j].Name })
+
+	var body bytes.Buffer
+	if err := formPostTemplate.Execute(&body, page); err != nil {
+		return fmt.Errorf("failed to render form_post page: %w", err)
+	}
+
+	w.Header().Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeHTML)
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
+	w.Header().Set("Content-Security-Policy",
+		fmt.Sprintf("default-src 'none'; script-src 'nonce-%s'; base-uri 'none'; frame-ancestors 'none'", nonce))
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(body.Bytes())
+	return err
+}
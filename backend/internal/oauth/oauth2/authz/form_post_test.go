@@ -0,0 +1,53 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteFormPostResponse(t *testing.T) {
+	rr := httptest.NewRecorder()
+
+	err := writeFormPostResponse(rr, &encodedAuthorizationResponse{
+		RedirectURI: "https://client.example.com/callback?tenant=a",
+		FormPost:    true,
+		FormParams:  map[string]string{"state": "s1", "code": "c1"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	csp := rr.Header().Get("Content-Security-Policy")
+	assert.Contains(t, csp, "default-src 'none'")
+	assert.Contains(t, csp, "frame-ancestors 'none'")
+
+	body := rr.Body.String()
+	assert.Contains(t, body, `action="https://client.example.com/callback?tenant=a"`)
+	// Fields are rendered in a stable order.
+	assert.Less(t, strings.Index(body, `name="code"`), strings.Index(body, `name="state"`))
+	// The script nonce matches the one allowed by the policy.
+	nonce := strings.TrimSuffix(strings.SplitN(csp, "'nonce-", 2)[1], "'; base-uri 'none'; frame-ancestors 'none'")
+	assert.Contains(t, body, `<script nonce="`+nonce+`">`)
+}
+
+func TestWriteFormPostResponse_EscapesValues(t *testing.T) {
+	rr := httptest.NewRecorder()
+
+	err := writeFormPostResponse(rr, &encodedAuthorizationResponse{
+		RedirectURI: "javascript:alert(1)",
+		FormPost:    true,
+		FormParams:  map[string]string{"state": `"><script>alert(1)</script>`},
+	})
+
+	assert.NoError(t, err)
+	body := rr.Body.String()
+	assert.NotContains(t, body, `<script>alert(1)</script>`)
+	assert.NotContains(t, body, `action="javascript:`)
+}
@@ -21,21 +21,25 @@ import (
 type AuthorizeHandlerInterface interface {
 	HandleAuthorizeGetRequest(w http.ResponseWriter, r *http.Request)
 	HandleAuthCallbackPostRequest(w http.ResponseWriter, r *http.Request)
+	HandleAuthorizationResponseGetRequest(w http.ResponseWriter, r *http.Request)
 }
 
 // authorizeHandler implements the AuthorizeHandlerInterface for handling OAuth2 authorization requests.
 type authorizeHandler struct {
-	cfg          oauthconfig.Config
-	authZService AuthorizeServiceInterface
-	logger       *log.Logger
+	cfg             oauthconfig.Config
+	authZService    AuthorizeServiceInterface
+	responseEncoder responseModeEncoderInterface
+	logger          *log.Logger
 }
 
 // newAuthorizeHandler creates a new instance of authorizeHandler with injected dependencies.
-func newAuthorizeHandler(authZService AuthorizeServiceInterface, cfg oauthconfig.Config) AuthorizeHandlerInterface {
+func newAuthorizeHandler(authZService AuthorizeServiceInterface, responseEncoder responseModeEncoderInterface,
+	cfg oauthconfig.Config) AuthorizeHandlerInterface {
 	return &authorizeHandler{
-		cfg:          cfg,
-		authZService: authZService,
-		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeHandler")),
+		cfg:             cfg,
+		authZService:    authZService,
+		responseEncoder: responseEncoder,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeHandler")),
 	}
 }
 
@@ -50,21 +54,19 @@ func (ah *authorizeHandler) HandleAuthorizeGetRequest(w http.ResponseWriter, r *
 	result, authErr := ah.authZService.HandleInitialAuthorizationRequest(ctx, oAuthMessage)
 	if authErr != nil {
 		if authErr.SendErrorToClient {
-			queryParams := map[string]string{
-				oauth2const.RequestParamError:            authErr.Code,
-				oauth2const.RequestParamErrorDescription: authErr.Message,
-				oauth2const.RequestParamIss:              ah.cfg.JWT.Issuer,
-			}
-			if authErr.State != "" {
-				queryParams[oauth2const.RequestParamState] = authErr.State
-			}
-			redirectURI, err := oauth2utils.GetURIWithQueryParams(authErr.ClientRedirectURI, queryParams)
+			encoded, err := ah.encodeClientErrorResponse(ctx, authErr)
 			if err != nil {
 				ah.logger.Error(ctx, "Failed to construct client redirect URI", log.Error(err))
 				ah.redirectToErrorPage(w, r, oauth2const.ErrorServerError, "Failed to process authorization request")
 				return
 			}
-			http.Redirect(w, r, redirectURI, http.StatusFound)
+			if encoded.FormPost {
+				if err := writeFormPostResponse(w, encoded); err != nil {
+					ah.logger.Error(ctx, "Failed to write form_post response", log.Error(err))
+				}
+				return
+			}
+			http.Redirect(w, r, encoded.RedirectURI, http.StatusFound)
 			return
 		}
 		ah.redirectToErrorPage(w, r, authErr.Code, authErr.Message)
@@ -109,6 +111,30 @@ func (ah *authorizeHandler) HandleAuthCallbackPostRequest(w http.ResponseWriter,
 	}
 }
 
+// HandleAuthorizationResponseGetRequest handles the GET request for a parked form_post authorization
+// response. The user agent is sent here after the authentication flow completes, and the response is
+// delivered to the client's redirect URI through an auto-submitting form.
+func (ah *authorizeHandler) HandleAuthorizationResponseGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	key := r.URL.Query().Get(authzResponseKeyParam)
+
+	encoded, err := ah.responseEncoder.TakeFormPostResponse(ctx, key)
+	if err != nil {
+		if errors.Is(err, errAuthResponseNotFound) {
+			ah.logger.Debug(ctx, "Authorization response not found or expired")
+			ah.redirectToErrorPage(w, r, oauth2const.ErrorInvalidRequest, "Invalid authorization response")
+			return
+		}
+		ah.logger.Error(ctx, "Failed to retrieve authorization response", log.Error(err))
+		ah.redirectToErrorPage(w, r, oauth2const.ErrorServerError, "Failed to process authorization request")
+		return
+	}
+
+	if err := writeFormPostResponse(w, encoded); err != nil {
+		ah.logger.Error(ctx, "Failed to write form_post response", log.Error(err))
+	}
+}
+
 // getOAuthMessage extracts the OAuth message from the request and response writer.
 func (ah *authorizeHandler) getOAuthMessage(r *http.Request, w http.ResponseWriter) *OAuthMessage {
 	logger := ah.logger
@@ -305,16 +331,7 @@ func (
 // client's registered redirect URI.
 func (ah *authorizeHandler) writeAuthZResponseToClientRedirect(
 	ctx context.Context, w http.ResponseWriter, authErr *AuthorizationError) {
-	queryParams := map[string]string{
-		oauth2const.RequestParamError:            authErr.Code,
-		oauth2const.RequestParamErrorDescription: authErr.Message,
-		oauth2const.RequestParamIss:              ah.cfg.JWT.Issuer,
-	}
-	if authErr.State != "" {
-		queryParams[oauth2const.RequestParamState] = authErr.State
-	}
-
-	redirectURI, err := oauth2utils.GetURIWithQueryParams(authErr.ClientRedirectURI, queryParams)
+	redirectURI, err := ah.getClientErrorRedirectURI(ctx, authErr)
 	if err != nil {
 		ah.logger.Error(ctx, "Failed to construct client redirect URI", log.Error(err))
 		ah.writeAuthZResponseToErrorPage(ctx, w, oauth2const.ErrorServerError,
@@ -324,3 +341,35 @@ func (ah *authorizeHandler) writeAuthZResponseToClientRedirect(
 
 	ah.writeAuthZResponse(ctx, w, redirectURI)
 }
+
+// getClientErrorRedirectURI returns the URI that delivers the authorization error to the client in
+// the requested response mode.
+func (ah *authorizeHandler) getClientErrorRedirectURI(
+	ctx context.Context, authErr *AuthorizationError) (string, error) {
+	encoded, err := ah.encodeClientErrorResponse(ctx, authErr)
+	if err != nil {
+		return "", err
+	}
+	return ah.responseEncoder.ToRedirectURI(ctx, encoded)
+}
+
+// encodeClientErrorResponse encodes the authorization error response for the client's redirect URI
+// in the requested response mode.
+func (ah *authorizeHandler) encodeClientErrorResponse(
+	ctx context.Context, authErr *AuthorizationError) (*encodedAuthorizationResponse, error) {
+	params := map[string]string{
+		oauth2const.RequestParamError:            authErr.Code,
+		oauth2const.RequestParamErrorDescription: authErr.Message,
+		oauth2const.RequestParamIss:              ah.cfg.JWT.Issuer,
+	}
+	if authErr.State != "" {
+		params[oauth2const.RequestParamState] = authErr.State
+	}
+
+	return ah.responseEncoder.Encode(ctx, &authorizationResponse{
+		RedirectURI:  authErr.ClientRedirectURI,
+		ClientID:     authErr.ClientID,
+		ResponseMode: authErr.ResponseMode,
+		Params:       params,
+	})
+}
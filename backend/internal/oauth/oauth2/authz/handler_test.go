@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -21,6 +22,7 @@ import (
 
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/tests/testhelpers"
 )
@@ -71,7 +73,10 @@ func (suite *AuthorizeHandlerTestSuite) SetupTest() {
 	_ = config.InitializeServerRuntime("test", testConfig)
 
 	suite.mockAuthzService = NewAuthorizeServiceInterfaceMock(suite.T())
-	suite.handler = newAuthorizeHandler(suite.mockAuthzService, authorizeServiceCfgFromRuntime()).(*authorizeHandler)
+	cfg := authorizeServiceCfgFromRuntime()
+	suite.handler = newAuthorizeHandler(suite.mockAuthzService,
+		newResponseModeEncoder(cfg, nil, nil, nil, nil, newAuthorizationResponseStore(
+			inmemory.Initialize("test-deployment"))), cfg).(*authorizeHandler)
 }
 
 func (suite *AuthorizeHandlerTestSuite) TearDownTest() {
@@ -80,7 +85,7 @@ func (suite *AuthorizeHandlerTestSuite) TearDownTest() {
 
 func (suite *AuthorizeHandlerTestSuite) TestnewAuthorizeHandler() {
 	mockSvc := NewAuthorizeServiceInterfaceMock(suite.T())
-	handler := newAuthorizeHandler(mockSvc, testhelpers.OAuthConfig())
+	handler := newAuthorizeHandler(mockSvc, newResponseModeEncoderInterfaceMock(suite.T()), testhelpers.OAuthConfig())
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*AuthorizeHandlerInterface)(nil), handler)
 }
@@ -301,6 +306,62 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_ServiceErr
 	assert.Contains(suite.T(), location, "iss=https%3A%2F%2Flocalhost%3A8090")
 }
 
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_ServiceErrorFragmentToClient() {
+	authErr := &AuthorizationError{
+		Code:              oauth2const.ErrorInvalidRequest,
+		Message:           "Invalid response type",
+		SendErrorToClient: true,
+		ClientRedirectURI: "https://client.example.com/callback",
+		State:             "test-state",
+		ClientID:          "test-client",
+		ResponseMode:      "fragment",
+	}
+	suite.mockAuthzService.EXPECT().HandleInitialAuthorizationRequest(mock.Anything, mock.Anything).Return(nil, authErr)
+
+	req := httptest.NewRequest("GET", "/oauth2/authorize?client_id=test-client&response_mode=fragment", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	location, err := url.Parse(rr.Header().Get("Location"))
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), location.RawQuery)
+	fragment, err := url.ParseQuery(location.Fragment)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, fragment.Get(oauth2const.RequestParamError))
+	assert.Equal(suite.T(), "test-state", fragment.Get(oauth2const.RequestParamState))
+	assert.Equal(suite.T(), "https://localhost:8090", fragment.Get(oauth2const.RequestParamIss))
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_ServiceErrorFormPostToClient() {
+	authErr := &AuthorizationError{
+		Code:              oauth2const.ErrorInvalidRequest,
+		Message:           "Invalid response type",
+		SendErrorToClient: true,
+		ClientRedirectURI: "https://client.example.com/callback",
+		State:             "test-state",
+		ClientID:          "test-client",
+		ResponseMode:      "form_post",
+	}
+	suite.mockAuthzService.EXPECT().HandleInitialAuthorizationRequest(mock.Anything, mock.Anything).Return(nil, authErr)
+
+	req := httptest.NewRequest("GET", "/oauth2/authorize?client_id=test-client&response_mode=form_post", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	assert.Empty(suite.T(), rr.Header().Get("Location"))
+	assert.Contains(suite.T(), rr.Header().Get("Content-Type"), "text/html")
+	assert.Equal(suite.T(), "no-store", rr.Header().Get("Cache-Control"))
+	assert.Contains(suite.T(), rr.Header().Get("Content-Security-Policy"), "script-src 'nonce-")
+	body := rr.Body.String()
+	assert.Contains(suite.T(), body, `action="https://client.example.com/callback"`)
+	assert.Contains(suite.T(), body, `name="error" value="invalid_request"`)
+	assert.Contains(suite.T(), body, `name="state" value="test-state"`)
+}
+
 func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_IssAlwaysPresent() {
 	// RFC 9207 §2: iss is unconditional. State is absent here to confirm iss appears regardless.
 	authErr := &AuthorizationError{
@@ -421,6 +482,78 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Servic
 	assert.Contains(suite.T(), resp.RedirectURI, "iss=https%3A%2F%2Flocalhost%3A8090")
 }
 
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_ServiceErrorFormPostToClient() {
+	authErr := &AuthorizationError{
+		Code:              oauth2const.ErrorAccessDenied,
+		Message:           "User denied the request",
+		State:             "test-state",
+		SendErrorToClient: true,
+		ClientRedirectURI: "https://client.example.com/callback",
+		ClientID:          "test-client",
+		ResponseMode:      "form_post",
+	}
+	suite.mockAuthzService.EXPECT().HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion").
+		Return("", authErr)
+
+	jsonData, _ := json.Marshal(AuthZPostRequest{AuthID: testAuthID, Assertion: "test-assertion"})
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/auth/callback", bytes.NewReader(jsonData))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthCallbackPostRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	var resp AuthZPostResponse
+	assert.NoError(suite.T(), json.NewDecoder(rr.Body).Decode(&resp))
+	responseURL, err := url.Parse(resp.RedirectURI)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), oauth2const.OAuth2AuthorizationResponseEndpoint, responseURL.Path)
+	assert.NotContains(suite.T(), resp.RedirectURI, "error=")
+
+	// The user agent redeems the parked response, which is rendered as an auto-submitting form.
+	req = httptest.NewRequest(http.MethodGet, resp.RedirectURI, nil)
+	rr = httptest.NewRecorder()
+	suite.handler.HandleAuthorizationResponseGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	assert.Contains(suite.T(), body, `action="https://client.example.com/callback"`)
+	assert.Contains(suite.T(), body, `name="error" value="access_denied"`)
+	assert.Contains(suite.T(), body, `name="iss" value="https://localhost:8090"`)
+
+	// A parked response can be redeemed only once.
+	rr = httptest.NewRecorder()
+	suite.handler.HandleAuthorizationResponseGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	assert.Contains(suite.T(), rr.Header().Get("Location"), "https://localhost:3000/error")
+	assert.Contains(suite.T(), rr.Header().Get("Location"), "errorCode=invalid_request")
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizationResponseGetRequest_UnknownKey() {
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/authorize/response?id=unknown", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizationResponseGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	assert.Contains(suite.T(), rr.Header().Get("Location"), "errorCode=invalid_request")
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizationResponseGetRequest_StoreError() {
+	mockEncoder := newResponseModeEncoderInterfaceMock(suite.T())
+	mockEncoder.EXPECT().TakeFormPostResponse(mock.Anything, "key-1").Return(nil, errors.New("store down"))
+	handler := newAuthorizeHandler(suite.mockAuthzService, mockEncoder, authorizeServiceCfgFromRuntime())
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/authorize/response?id=key-1", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleAuthorizationResponseGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	assert.Contains(suite.T(), rr.Header().Get("Location"), "errorCode=server_error")
+}
+
 func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_ClientErrorIssAlwaysPresent() {
 	// RFC 9207 §2: iss is unconditional. Confirm iss is present even when state is absent.
 	authErr := &AuthorizationError{
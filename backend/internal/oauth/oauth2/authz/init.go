@@ -8,8 +8,12 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -20,8 +24,11 @@ func Initialize(
 	actorProvider providers.ActorProvider,
 	resourceService providers.ResourceServerProvider,
 	jwtService jwt.JWTServiceInterface,
+	jweService jwe.JWEServiceInterface,
+	jwksResolver *jwksresolver.Resolver,
 	flowExecService flowexec.FlowExecServiceInterface,
 	parService par.PARServiceInterface,
+	requestObjects requestobject.RequestObjectResolverInterface,
 	criteriaRevoker revocation.CriteriaRevokerInterface,
 	cfg oauthconfig.Config,
 	storeProvider providers.RuntimeStoreProvider,
@@ -30,16 +37,22 @@ func Initialize(
 	authzCodeStore := newAuthorizationCodeStore(storeProvider)
 	authzReqStore := newAuthorizationRequestStore(storeProvider, cfg.OAuth.AuthorizationRequest.ValidityPeriod)
 
+	authzRespStore := newAuthorizationResponseStore(storeProvider)
+	responseEncoder := newResponseModeEncoder(
+		cfg, actorProvider, jwtService, jweService, jwksResolver, authzRespStore)
+
 	authzService := newAuthorizeService(
 		actorProvider, resourceService, jwtService, flowExecService,
-		authzCodeStore, authzReqStore, parService, transactioner, criteriaRevoker, cfg,
+		authzCodeStore, authzReqStore, parService, requestObjects, transactioner, criteriaRevoker,
+		responseEncoder, cfg,
 	)
-	authzHandler := newAuthorizeHandler(authzService, cfg)
+	authzHandler := newAuthorizeHandler(authzService, responseEncoder, cfg)
 	registerRoutes(mux, authzHandler)
 	return authzService, nil
 }
 
-// registerRoutes registers the GET /oauth2/authorize route. The POST /oauth2/auth/callback
+// registerRoutes registers the GET /oauth2/authorize route and the GET /oauth2/authorize/response
+// route that delivers form_post responses. The POST /oauth2/auth/callback
 // route is registered by the callback package which dispatches by grant type.
 //
 // Clickjacking protection (X-Frame-Options and CSP frame-ancestors, per RFC 9700 §4.16) is applied
@@ -48,4 +61,6 @@ func registerRoutes(mux *http.ServeMux, authzHandler AuthorizeHandlerInterface)
 	// CORS MUST NOT be enabled on the authorization endpoint.
 	// The client redirects the user agent to it; it is not accessed directly via XHR/fetch.
 	mux.HandleFunc("GET /oauth2/authorize", authzHandler.HandleAuthorizeGetRequest)
+	mux.HandleFunc("GET "+oauth2const.OAuth2AuthorizationResponseEndpoint,
+		authzHandler.HandleAuthorizationResponseGetRequest)
 }
@@ -79,7 +79,7 @@ func (suite *InitTestSuite) TestInitialize() {
 		mux,
 		actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil),
 		suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil, nil, testhelpers.OAuthConfig(),
+		suite.mockJWTService, nil, nil, suite.mockFlowExecService, nil, nil, nil, testhelpers.OAuthConfig(),
 		inmemory.Initialize("test-deployment"), transaction.NewNoOpTransactioner(),
 	)
 
@@ -95,7 +95,7 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 		mux,
 		actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil),
 		suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil, nil, testhelpers.OAuthConfig(),
+		suite.mockJWTService, nil, nil, suite.mockFlowExecService, nil, nil, nil, testhelpers.OAuthConfig(),
 		inmemory.Initialize("test-deployment"), transaction.NewNoOpTransactioner(),
 	)
 	assert.NoError(suite.T(), err)
@@ -104,6 +104,10 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 	// POST /oauth2/auth/callback is now registered by the callback package, not authz.
 	_, pattern := mux.Handler(&http.Request{Method: "GET", URL: &url.URL{Path: "/oauth2/authorize"}})
 	assert.Contains(suite.T(), pattern, "/oauth2/authorize")
+
+	// Verify that the GET /oauth2/authorize/response route is registered for form_post responses.
+	_, pattern = mux.Handler(&http.Request{Method: "GET", URL: &url.URL{Path: "/oauth2/authorize/response"}})
+	assert.Equal(suite.T(), "GET /oauth2/authorize/response", pattern)
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_CORSConfiguration() {
@@ -113,7 +117,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_CORSConfiguration() {
 		mux,
 		actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil),
 		suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil, nil, testhelpers.OAuthConfig(),
+		suite.mockJWTService, nil, nil, suite.mockFlowExecService, nil, nil, nil, testhelpers.OAuthConfig(),
 		inmemory.Initialize("test-deployment"), transaction.NewNoOpTransactioner(),
 	)
 	assert.NoError(suite.T(), err)
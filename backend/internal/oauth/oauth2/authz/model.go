@@ -7,6 +7,7 @@ import (
 	"time"
 
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
 // OAuthMessage represents the OAuth message.
@@ -44,6 +45,16 @@ type AuthorizationCode struct {
 	// assertion. It is stamped onto the access and refresh tokens issued for this code so revocation
 	// can target the whole family. Empty when the login flow issued no tfid (e.g. pre-rollout tokens).
 	TokenFamilyID string
+	// ConsentID is the consent record the grant is issued under, carried on the flow assertion. It is
+	// stamped onto the access and refresh tokens issued for this code so revoking the consent revokes
+	// them. Empty when the login flow has no Consent node.
+	ConsentID string
+	// SessionID is the SSO session the login flow attached to, carried on the flow assertion. It is
+	// stamped onto the ID token issued for this code as the sid claim. Empty when the flow has no
+	// Session node.
+	SessionID string
+	// AuthorizationDetails are the authorization details (RFC 9396) approved for this code.
+	AuthorizationDetails []providers.AuthorizationDetail
 }
 
 // AuthZPostRequest represents the request body for the authorization POST request.
@@ -69,6 +80,8 @@ type AuthorizationError struct {
 	SendErrorToClient bool   // if true, redirect error to client's redirect_uri rather than the error page
 	ClientRedirectURI string // populated when SendErrorToClient is true
 	State             string // from the original request
+	ClientID          string // the client the error is reported to; audience of a JWT-secured response
+	ResponseMode      string // response_mode of the original request; empty uses the default mode
 }
 
 // assertionClaims represents the claims extracted from the flow assertion JWT.
@@ -79,5 +92,8 @@ type assertionClaims struct {
 	completedACR           string
 	authorizationRequestID string
 	tokenFamilyID          string
+	consentID              string
+	sessionID              string
 	flowErrorType          string
+	authorizationDetails   []providers.AuthorizationDetail
 }
@@ -19,7 +19,7 @@ import (
 // ValidateAuthorizationRequestParams validates the common authorization request parameters
 // shared by both the standard authorize endpoint and the PAR endpoint.
 //
-// This validates: prompt, grant_type, response_type, PKCE, nonce, and dpop_jkt.
+// This validates: prompt, grant_type, response_type, response_mode, PKCE, nonce, and dpop_jkt.
 // Callers are responsible for validating client_id and redirect_uri before calling this
 // function, since those validations have endpoint-specific error handling semantics
 // (e.g., the authorize endpoint must not redirect errors when the redirect_uri is invalid).
@@ -58,7 +58,7 @@ func ValidateAuthorizationRequestParams(
 	if !oauthApp.IsAllowedResponseType(responseType) {
 		return constants.ErrorUnsupportedResponseType, "Unsupported response type"
 	}
-	if !constants.IsSupportedResponseMode(responseMode) {
+	if !constants.IsSupportedResponseMode(responseMode) || !oauthApp.IsAllowedResponseMode(responseMode) {
 		return constants.ErrorInvalidRequest, "Unsupported response_mode parameter"
 	}
 
@@ -84,8 +84,37 @@ func (suite *AuthzValidationTestSuite) TestValidateParams_QueryResponseMode() {
 	assert.Empty(suite.T(), errMsg)
 }
 
+func (suite *AuthzValidationTestSuite) TestValidateParams_SupportedResponseModes() {
+	for _, responseMode := range providers.SupportedResponseModes {
+		suite.T().Run(string(responseMode), func(t *testing.T) {
+			params := suite.validParams()
+			params.Set(constants.RequestParamResponseMode, string(responseMode))
+
+			errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.oauthApp, "")
+
+			assert.Empty(t, errCode)
+			assert.Empty(t, errMsg)
+		})
+	}
+}
+
+func (suite *AuthzValidationTestSuite) TestValidateParams_ResponseModeNotAllowedForClient() {
+	suite.oauthApp.ResponseModes = []providers.ResponseMode{providers.ResponseModeFormPostJWT}
+	params := suite.validParams()
+	params.Set(constants.RequestParamResponseMode, string(providers.ResponseModeFragment))
+
+	errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.oauthApp, "")
+
+	assert.Equal(suite.T(), constants.ErrorInvalidRequest, errCode)
+	assert.Equal(suite.T(), "Unsupported response_mode parameter", errMsg)
+
+	params.Set(constants.RequestParamResponseMode, string(providers.ResponseModeFormPostJWT))
+	errCode, _ = ValidateAuthorizationRequestParams(params, suite.oauthApp, "")
+	assert.Empty(suite.T(), errCode)
+}
+
 func (suite *AuthzValidationTestSuite) TestValidateParams_UnsupportedResponseMode() {
-	for _, responseMode := range []string{"fragment", "form_post"} {
+	for _, responseMode := range []string{"web_message", "query.jws"} {
 		suite.T().Run(responseMode, func(t *testing.T) {
 			params := suite.validParams()
 			params.Set(constants.RequestParamResponseMode, responseMode)
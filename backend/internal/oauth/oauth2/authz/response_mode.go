@@ -0,0 +1,242 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// authorizationResponse is an authorization response, success or error, to be delivered to the
+// client's redirect URI using the response mode requested by the client.
+type authorizationResponse struct {
+	RedirectURI  string
+	ClientID     string
+	ResponseMode string
+	Params       map[string]string
+}
+
+// encodedAuthorizationResponse is an authorization response encoded for its response mode. A
+// form_post response carries the fields to post to RedirectURI; any other response carries the
+// complete redirect URI.
+type encodedAuthorizationResponse struct {
+	RedirectURI string            `json:"redirectUri"`
+	FormPost    bool              `json:"formPost,omitempty"`
+	FormParams  map[string]string `json:"formParams,omitempty"`
+}
+
+// responseModeEncoderInterface defines the interface for encoding authorization responses for the
+// response mode requested by the client.
+type responseModeEncoderInterface interface {
+	Encode(ctx context.Context, resp *authorizationResponse) (*encodedAuthorizationResponse, error)
+	ToRedirectURI(ctx context.Context, encoded *encodedAuthorizationResponse) (string, error)
+	TakeFormPostResponse(ctx context.Context, key string) (*encodedAuthorizationResponse, error)
+}
+
+// responseModeEncoder implements responseModeEncoderInterface. It supports the query and fragment
+// response modes, the form_post response mode and their JWT-secured (JARM) variants.
+type responseModeEncoder struct {
+	cfg           oauthconfig.Config
+	actorProvider providers.ActorProvider
+	jwtService    jwt.JWTServiceInterface
+	jweService    jwe.JWEServiceInterface
+	jwksResolver  *jwksresolver.Resolver
+	responseStore authorizationResponseStoreInterface
+	logger        *log.Logger
+}
+
+// newResponseModeEncoder creates a new instance of responseModeEncoder with injected dependencies.
+func newResponseModeEncoder(
+	cfg oauthconfig.Config,
+	actorProvider providers.ActorProvider,
+	jwtService jwt.JWTServiceInterface,
+	jweService jwe.JWEServiceInterface,
+	jwksResolver *jwksresolver.Resolver,
+	responseStore authorizationResponseStoreInterface,
+) responseModeEncoderInterface {
+	return &responseModeEncoder{
+		cfg:           cfg,
+		actorProvider: actorProvider,
+		jwtService:    jwtService,
+		jweService:    jweService,
+		jwksResolver:  jwksResolver,
+		responseStore: responseStore,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ResponseModeEncoder")),
+	}
+}
+
+// Encode encodes the authorization response for its response mode. A JWT-secured response mode
+// replaces the response parameters with a single signed, and optionally encrypted, response
+// parameter before it is delivered using the base mode.
+func (e *responseModeEncoder) Encode(
+	ctx context.Context, resp *authorizationResponse,
+) (*encodedAuthorizationResponse, error) {
+	if resp == nil || resp.RedirectURI == "" {
+		return nil, errors.New("authorization response has no redirect URI")
+	}
+
+	responseMode := providers.ResponseMode(resp.ResponseMode)
+	params := resp.Params
+	if responseMode.IsJWTSecured() {
+		// Validate the error parameters before they are sealed into the JWT, since they are no
+		// longer checked when the redirect URI is built.
+		if _, err := oauth2utils.GetURIWithQueryParams(resp.RedirectURI, resp.Params); err != nil {
+			return nil, err
+		}
+		token, err := e.secureResponse(ctx, resp)
+		if err != nil {
+			return nil, err
+		}
+		params = map[string]string{oauth2const.RequestParamResponse: token}
+	}
+
+	switch responseMode.Transport() {
+	case providers.ResponseModeFormPost:
+		return &encodedAuthorizationResponse{
+			RedirectURI: resp.RedirectURI,
+			FormPost:    true,
+			FormParams:  params,
+		}, nil
+	case providers.ResponseModeFragment:
+		redirectURI, err := getURIWithFragmentParams(resp.RedirectURI, params)
+		if err != nil {
+			return nil, err
+		}
+		return &encodedAuthorizationResponse{RedirectURI: redirectURI}, nil
+	default:
+		redirectURI, err := oauth2utils.GetURIWithQueryParams(resp.RedirectURI, params)
+		if err != nil {
+			return nil, err
+		}
+		return &encodedAuthorizationResponse{RedirectURI: redirectURI}, nil
+	}
+}
+
+// ToRedirectURI returns a URI the user agent can be sent to for the encoded response. A form_post
+// response cannot be expressed as a redirect, so it is parked in the store and the returned URI
+// points at the authorization response endpoint, which renders the auto-submitting form.
+func (e *responseModeEncoder) ToRedirectURI(
+	ctx context.Context, encoded *encodedAuthorizationResponse,
+) (string, error) {
+	if !encoded.FormPost {
+		return encoded.RedirectURI, nil
+	}
+
+	key, err := e.responseStore.AddResponse(ctx, *encoded)
+	if err != nil {
+		return "", err
+	}
+	return utils.GetURIWithQueryParams(e.cfg.BaseURL+oauth2const.OAuth2AuthorizationResponseEndpoint,
+		map[string]string{authzResponseKeyParam: key})
+}
+
+// TakeFormPostResponse redeems a parked form_post response. A response can be redeemed only once.
+func (e *responseModeEncoder) TakeFormPostResponse(
+	ctx context.Context, key string,
+) (*encodedAuthorizationResponse, error) {
+	return e.responseStore.TakeResponse(ctx, key)
+}
+
+// secureResponse builds the JWT-secured authorization response (JARM). The response parameters are
+// carried as claims of a JWT signed by the server, audience-restricted to the client and short-lived.
+// When the client registered an encryption algorithm, the signed JWT is nested in a JWE encrypted to
+// the client's key.
+func (e *responseModeEncoder) secureResponse(ctx context.Context, resp *authorizationResponse) (string, error) {
+	if resp.ClientID == "" {
+		return "", errors.New("JWT-secured authorization response requires a client ID")
+	}
+
+	app, svcErr := e.actorProvider.GetOAuthClientByClientID(ctx, resp.ClientID)
+	if svcErr != nil || app == nil {
+		return "", errors.New("failed to resolve the client for the JWT-secured authorization response")
+	}
+	var jarmCfg providers.AuthorizationResponseConfig
+	if app.AuthorizationResponse != nil {
+		jarmCfg = *app.AuthorizationResponse
+	}
+
+	claims := make(map[string]interface{}, len(resp.Params)+1)
+	for key, value := range resp.Params {
+		// The issuer is carried in the iss claim set by the JWT service.
+		if key == oauth2const.RequestParamIss {
+			continue
+		}
+		claims[key] = value
+	}
+	claims["aud"] = resp.ClientID
+
+	token, _, jwtErr := e.jwtService.GenerateJWT(ctx, "", e.cfg.JWT.Issuer,
+		oauth2const.JARMDefaultValiditySeconds, claims, jwt.TokenTypeJWT, jarmCfg.SigningAlg)
+	if jwtErr != nil {
+		return "", fmt.Errorf("failed to sign authorization response: %s", jwtErr.Error.DefaultValue)
+	}
+	if jarmCfg.EncryptionAlg == "" {
+		return token, nil
+	}
+
+	if e.jweService == nil || e.jwksResolver == nil {
+		return "", errors.New("authorization response encryption is not available")
+	}
+	rpKey, rpKID, resolveErr := e.jwksResolver.ResolveEncryptionKey(
+		ctx, app.Certificate, jarmCfg.EncryptionAlg, jwksresolver.KeyUseStrictEnc)
+	if resolveErr != nil {
+		e.logger.Debug(ctx, "Failed to resolve the client encryption key",
+			log.String("client_id", resp.ClientID), log.String("error", resolveErr.Error.DefaultValue))
+		return "", errors.New("failed to resolve the client encryption key")
+	}
+	compact, encErr := e.jweService.Encrypt(ctx,
+		[]byte(token),
+		&providers.KeyRef{PublicKeyJWK: rpKey},
+		jarmCfg.EncryptionAlg,
+		jwe.ContentEncAlgorithm(jarmCfg.EncryptionEnc),
+		"JWT",
+		rpKID,
+	)
+	if encErr != nil {
+		return "", errors.New("failed to encrypt authorization response")
+	}
+	return compact, nil
+}
+
+// getURIWithFragmentParams constructs a URI with the given parameters encoded in its fragment.
+func getURIWithFragmentParams(uri string, params map[string]string) (string, error) {
+	// Build the query form first so the error parameters are validated as in the query mode.
+	if _, err := oauth2utils.GetURIWithQueryParams(uri, params); err != nil {
+		return "", err
+	}
+
+	parsedURL, err := utils.ParseURL(uri)
+	if err != nil {
+		return "", errors.New("failed to parse the return URI: " + err.Error())
+	}
+	fragment := url.Values{}
+	for key, value := range params {
+		fragment.Set(key, value)
+	}
+	parsedURL.Fragment = ""
+	parsedURL.RawFragment = ""
+	return parsedURL.String() + "#" + fragment.Encode(), nil
+}
+
+// responseModeForError returns the response mode used to report an authorization error. An error is
+// reported using the requested response mode only when it is supported and allowed for the client;
+// otherwise the default mode is used, since the request itself may be what is invalid.
+func responseModeForError(responseMode string, app *providers.OAuthClient) string {
+	if !oauth2const.IsSupportedResponseMode(responseMode) || app == nil || !app.IsAllowedResponseMode(responseMode) {
+		return ""
+	}
+	return responseMode
+}
@@ -0,0 +1,317 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/url"
+	"testing"
+
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	certmodel "github.com/thunder-id/thunderid/internal/cert"
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwemock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+const testResponseRedirectURI = "https://client.example.com/callback"
+
+type ResponseModeEncoderTestSuite struct {
+	suite.Suite
+	cfg               oauthconfig.Config
+	mockActorProvider *actorprovidermock.ActorProviderMock
+	mockJWTService    *jwtmock.JWTServiceInterfaceMock
+	mockJWEService    *jwemock.JWEServiceInterfaceMock
+	encoder           *responseModeEncoder
+}
+
+func TestResponseModeEncoderTestSuite(t *testing.T) {
+	suite.Run(t, new(ResponseModeEncoderTestSuite))
+}
+
+func (suite *ResponseModeEncoderTestSuite) SetupTest() {
+	suite.cfg = oauthconfig.Config{
+		BaseURL: "https://localhost:8090",
+		JWT:     engineconfig.JWTConfig{Issuer: "https://localhost:8090"},
+	}
+	suite.mockActorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockJWEService = jwemock.NewJWEServiceInterfaceMock(suite.T())
+	suite.encoder = newResponseModeEncoder(suite.cfg, suite.mockActorProvider, suite.mockJWTService,
+		suite.mockJWEService, jwksresolver.Initialize(nil),
+		newAuthorizationResponseStore(inmemory.Initialize("test-deployment"))).(*responseModeEncoder)
+}
+
+func (suite *ResponseModeEncoderTestSuite) testResponse(responseMode string) *authorizationResponse {
+	return &authorizationResponse{
+		RedirectURI:  testResponseRedirectURI,
+		ClientID:     "test-client",
+		ResponseMode: responseMode,
+		Params: map[string]string{
+			"code":                        "test-code",
+			oauth2const.RequestParamState: "test-state",
+			oauth2const.RequestParamIss:   "https://localhost:8090",
+		},
+	}
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_DefaultAndQueryModes() {
+	for _, responseMode := range []string{"", "query"} {
+		encoded, err := suite.encoder.Encode(context.Background(), suite.testResponse(responseMode))
+
+		assert.NoError(suite.T(), err)
+		assert.False(suite.T(), encoded.FormPost)
+		parsed, parseErr := url.Parse(encoded.RedirectURI)
+		assert.NoError(suite.T(), parseErr)
+		assert.Equal(suite.T(), "test-code", parsed.Query().Get("code"))
+		assert.Equal(suite.T(), "test-state", parsed.Query().Get(oauth2const.RequestParamState))
+		assert.Empty(suite.T(), parsed.Fragment)
+	}
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_FragmentMode() {
+	resp := suite.testResponse("fragment")
+	resp.RedirectURI = testResponseRedirectURI + "?tenant=a#ignored"
+
+	encoded, err := suite.encoder.Encode(context.Background(), resp)
+
+	assert.NoError(suite.T(), err)
+	parsed, parseErr := url.Parse(encoded.RedirectURI)
+	assert.NoError(suite.T(), parseErr)
+	assert.Equal(suite.T(), "tenant=a", parsed.RawQuery)
+	fragment, parseErr := url.ParseQuery(parsed.Fragment)
+	assert.NoError(suite.T(), parseErr)
+	assert.Equal(suite.T(), "test-code", fragment.Get("code"))
+	assert.Equal(suite.T(), "test-state", fragment.Get(oauth2const.RequestParamState))
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_FormPostMode() {
+	encoded, err := suite.encoder.Encode(context.Background(), suite.testResponse("form_post"))
+
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), encoded.FormPost)
+	assert.Equal(suite.T(), testResponseRedirectURI, encoded.RedirectURI)
+	assert.Equal(suite.T(), "test-code", encoded.FormParams["code"])
+	assert.Equal(suite.T(), "test-state", encoded.FormParams[oauth2const.RequestParamState])
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_MissingRedirectURI() {
+	resp := suite.testResponse("")
+	resp.RedirectURI = ""
+
+	encoded, err := suite.encoder.Encode(context.Background(), resp)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), encoded)
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_JWTSecuredModes() {
+	testCases := []struct {
+		responseMode string
+		assertFunc   func(encoded *encodedAuthorizationResponse)
+	}{
+		{"jwt", func(encoded *encodedAuthorizationResponse) {
+			parsed, _ := url.Parse(encoded.RedirectURI)
+			assert.Equal(suite.T(), "signed.jarm.token", parsed.Query().Get(oauth2const.RequestParamResponse))
+			assert.Empty(suite.T(), parsed.Query().Get("code"))
+		}},
+		{"query.jwt", func(encoded *encodedAuthorizationResponse) {
+			parsed, _ := url.Parse(encoded.RedirectURI)
+			assert.Equal(suite.T(), "signed.jarm.token", parsed.Query().Get(oauth2const.RequestParamResponse))
+		}},
+		{"fragment.jwt", func(encoded *encodedAuthorizationResponse) {
+			parsed, _ := url.Parse(encoded.RedirectURI)
+			fragment, _ := url.ParseQuery(parsed.Fragment)
+			assert.Equal(suite.T(), "signed.jarm.token", fragment.Get(oauth2const.RequestParamResponse))
+		}},
+		{"form_post.jwt", func(encoded *encodedAuthorizationResponse) {
+			assert.True(suite.T(), encoded.FormPost)
+			assert.Equal(suite.T(), map[string]string{oauth2const.RequestParamResponse: "signed.jarm.token"},
+				encoded.FormParams)
+		}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.responseMode, func() {
+			suite.SetupTest()
+			suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client").
+				Return(&providers.OAuthClient{ClientID: "test-client"}, nil)
+			suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, "", "https://localhost:8090",
+				oauth2const.JARMDefaultValiditySeconds, mock.Anything, jwt.TokenTypeJWT, "").
+				Run(func(_ context.Context, _, _ string, _ int64, claims map[string]interface{}, _, _ string) {
+					assert.Equal(suite.T(), "test-client", claims["aud"])
+					assert.Equal(suite.T(), "test-code", claims["code"])
+					assert.Equal(suite.T(), "test-state", claims[oauth2const.RequestParamState])
+					_, hasIss := claims[oauth2const.RequestParamIss]
+					assert.False(suite.T(), hasIss)
+				}).
+				Return("signed.jarm.token", int64(0), nil)
+
+			encoded, err := suite.encoder.Encode(context.Background(), suite.testResponse(tc.responseMode))
+
+			assert.NoError(suite.T(), err)
+			tc.assertFunc(encoded)
+		})
+	}
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_JWTSecured_UsesClientSigningAlg() {
+	suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client").
+		Return(&providers.OAuthClient{
+			ClientID:              "test-client",
+			AuthorizationResponse: &providers.AuthorizationResponseConfig{SigningAlg: "PS256"},
+		}, nil)
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, "", mock.Anything, mock.Anything, mock.Anything,
+		jwt.TokenTypeJWT, "PS256").Return("signed.jarm.token", int64(0), nil)
+
+	_, err := suite.encoder.Encode(context.Background(), suite.testResponse("jwt"))
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_JWTSecured_Encrypted() {
+	privateKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client").
+		Return(&providers.OAuthClient{
+			ClientID: "test-client",
+			AuthorizationResponse: &providers.AuthorizationResponseConfig{
+				EncryptionAlg: "RSA-OAEP-256",
+				EncryptionEnc: "A256GCM",
+			},
+			Certificate: &providers.Certificate{
+				Type:  certmodel.CertificateTypeJWKS,
+				Value: rsaPublicKeyToEncJWKS(&privateKey.PublicKey),
+			},
+		}, nil)
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, "", mock.Anything, mock.Anything, mock.Anything,
+		jwt.TokenTypeJWT, "").Return("signed.jarm.token", int64(0), nil)
+	suite.mockJWEService.EXPECT().Encrypt(mock.Anything, []byte("signed.jarm.token"), mock.Anything,
+		"RSA-OAEP-256", jwe.ContentEncAlgorithm("A256GCM"), "JWT", "").
+		Return("encrypted.jarm.token", nil)
+
+	encoded, err := suite.encoder.Encode(context.Background(), suite.testResponse("jwt"))
+
+	assert.NoError(suite.T(), err)
+	parsed, _ := url.Parse(encoded.RedirectURI)
+	assert.Equal(suite.T(), "encrypted.jarm.token", parsed.Query().Get(oauth2const.RequestParamResponse))
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_JWTSecured_EncryptionFailure() {
+	privateKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client").
+		Return(&providers.OAuthClient{
+			ClientID: "test-client",
+			AuthorizationResponse: &providers.AuthorizationResponseConfig{
+				EncryptionAlg: "RSA-OAEP-256",
+				EncryptionEnc: "A256GCM",
+			},
+			Certificate: &providers.Certificate{
+				Type:  certmodel.CertificateTypeJWKS,
+				Value: rsaPublicKeyToEncJWKS(&privateKey.PublicKey),
+			},
+		}, nil)
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, "", mock.Anything, mock.Anything, mock.Anything,
+		jwt.TokenTypeJWT, "").Return("signed.jarm.token", int64(0), nil)
+	suite.mockJWEService.EXPECT().Encrypt(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return("", &tidcommon.InternalServerError)
+
+	encoded, err := suite.encoder.Encode(context.Background(), suite.testResponse("jwt"))
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), encoded)
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_JWTSecured_SigningFailure() {
+	suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client").
+		Return(&providers.OAuthClient{ClientID: "test-client"}, nil)
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return("", int64(0), &tidcommon.InternalServerError)
+
+	encoded, err := suite.encoder.Encode(context.Background(), suite.testResponse("jwt"))
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), encoded)
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_JWTSecured_UnknownClient() {
+	suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client").
+		Return(nil, &tidcommon.InternalServerError)
+
+	encoded, err := suite.encoder.Encode(context.Background(), suite.testResponse("jwt"))
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), encoded)
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestEncode_JWTSecured_MissingClientID() {
+	resp := suite.testResponse("jwt")
+	resp.ClientID = ""
+
+	encoded, err := suite.encoder.Encode(context.Background(), resp)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), encoded)
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestToRedirectURI() {
+	redirectURI, err := suite.encoder.ToRedirectURI(context.Background(),
+		&encodedAuthorizationResponse{RedirectURI: testResponseRedirectURI + "?code=abc"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testResponseRedirectURI+"?code=abc", redirectURI)
+
+	encoded := &encodedAuthorizationResponse{
+		RedirectURI: testResponseRedirectURI,
+		FormPost:    true,
+		FormParams:  map[string]string{"code": "abc"},
+	}
+	redirectURI, err = suite.encoder.ToRedirectURI(context.Background(), encoded)
+	assert.NoError(suite.T(), err)
+	parsed, _ := url.Parse(redirectURI)
+	assert.Equal(suite.T(), "localhost:8090", parsed.Host)
+	assert.Equal(suite.T(), oauth2const.OAuth2AuthorizationResponseEndpoint, parsed.Path)
+
+	taken, err := suite.encoder.TakeFormPostResponse(context.Background(), parsed.Query().Get(authzResponseKeyParam))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), encoded, taken)
+}
+
+func (suite *ResponseModeEncoderTestSuite) TestResponseModeForError() {
+	app := &providers.OAuthClient{ResponseModes: []providers.ResponseMode{providers.ResponseModeFormPost}}
+
+	assert.Equal(suite.T(), "form_post", responseModeForError("form_post", app))
+	assert.Equal(suite.T(), "", responseModeForError("fragment", app))
+	assert.Equal(suite.T(), "", responseModeForError("web_message", app))
+	assert.Equal(suite.T(), "", responseModeForError("form_post", nil))
+	assert.Equal(suite.T(), "fragment.jwt", responseModeForError("fragment.jwt", &providers.OAuthClient{}))
+}
+
+func rsaPublicKeyToEncJWKS(pub *rsa.PublicKey) string {
+	key := map[string]interface{}{
+		"kty": "RSA",
+		"use": "enc",
+		"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}
+	b, _ := json.Marshal(map[string]interface{}{"keys": []interface{}{key}})
+	return string(b)
+}
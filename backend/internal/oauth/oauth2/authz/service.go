@@ -17,10 +17,12 @@ import (
 	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz/requestvalidator"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
@@ -49,10 +51,12 @@ type authorizeService struct {
 	authCodeStore   AuthorizationCodeStoreInterface
 	authReqStore    authorizationRequestStoreInterface
 	parService      par.PARServiceInterface
+	requestObjects  requestobject.RequestObjectResolverInterface
 	jwtService      jwt.JWTServiceInterface
 	flowExecService flowexec.FlowExecServiceInterface
 	transactioner   providers.Transactioner
 	criteriaRevoker revocation.CriteriaRevokerInterface
+	responseEncoder responseModeEncoderInterface
 	logger          *log.Logger
 }
 
@@ -65,8 +69,10 @@ func newAuthorizeService(
 	authCodeStore AuthorizationCodeStoreInterface,
 	authReqStore authorizationRequestStoreInterface,
 	parService par.PARServiceInterface,
+	requestObjects requestobject.RequestObjectResolverInterface,
 	transactioner providers.Transactioner,
 	criteriaRevoker revocation.CriteriaRevokerInterface,
+	responseEncoder responseModeEncoderInterface,
 	cfg oauthconfig.Config,
 ) AuthorizeServiceInterface {
 	return &authorizeService{
@@ -77,10 +83,12 @@ func newAuthorizeService(
 		authCodeStore:   authCodeStore,
 		authReqStore:    authReqStore,
 		parService:      parService,
+		requestObjects:  requestObjects,
 		jwtService:      jwtService,
 		flowExecService: flowExecService,
 		transactioner:   transactioner,
 		criteriaRevoker: criteriaRevoker,
+		responseEncoder: responseEncoder,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeService")),
 	}
 }
@@ -188,12 +196,14 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 		}
 	}
 
-	// If request_uri is present, resolve the pushed authorization request.
-	if requestURI != "" {
+	// If request_uri references a pushed authorization request, resolve it. Any other request_uri
+	// references a request object and is resolved with the request parameters below.
+	if par.IsPushedAuthorizationRequestURI(requestURI) {
 		return as.handlePARAuthorizationRequest(ctx, requestURI, clientID, app)
 	}
 
-	// Enforce PAR requirement: if PAR is required (per-client or global), reject requests without request_uri.
+	// Enforce PAR requirement: if PAR is required (per-client or global), reject requests that do not
+	// reference a pushed authorization request.
 	if app.RequiresPAR() {
 		return nil, &AuthorizationError{
 			Code:    oauth2const.ErrorInvalidRequest,
@@ -201,6 +211,20 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 		}
 	}
 
+	// Resolve a signed request object, whose parameters take precedence over the plain ones. The
+	// redirect URI is not yet trusted, so a failure is not reported to the client.
+	requestParams, errCode, errMsg := as.requestObjects.Resolve(ctx, queryParams, app)
+	if errCode != "" {
+		return nil, &AuthorizationError{
+			Code:    errCode,
+			Message: errMsg,
+		}
+	}
+	if queryParams.Get(oauth2const.RequestParamRequest) != "" || requestURI != "" {
+		msg.RequestQueryParams = requestParams
+		msg.Resources = requestParams[oauth2const.RequestParamResource]
+	}
+
 	initiatorReq := &providers.InitiatorRequest{
 		Headers:     utils.FilterSensitiveHeaders(msg.RequestHeaders),
 		QueryParams: msg.RequestQueryParams,
@@ -244,6 +268,7 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 	scope := queryParams.Get(oauth2const.RequestParamScope)
 	state := queryParams.Get(oauth2const.RequestParamState)
 	responseType := queryParams.Get(oauth2const.RequestParamResponseType)
+	responseMode := queryParams.Get(oauth2const.RequestParamResponseMode)
 
 	// Extract PKCE parameters.
 	codeChallenge := queryParams.Get(oauth2const.RequestParamCodeChallenge)
@@ -285,6 +310,16 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		}
 	}
 
+	// Parse the authorization_details parameter if present.
+	authorizationDetails, detailsErr := authorizationdetails.Parse(
+		queryParams.Get(oauth2const.RequestParamAuthorizationDetails))
+	if detailsErr != nil {
+		return nil, &AuthorizationError{
+			Code:    detailsErr.Error,
+			Message: detailsErr.ErrorDescription,
+		}
+	}
+
 	// Validate the authorization request.
 	sendErrorToApp, errorCode, errorMessage := as.authZValidator.validateInitialAuthorizationRequest(ctx, msg, app)
 	if errorCode != "" {
@@ -296,6 +331,8 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		if sendErrorToApp && redirectURI != "" {
 			authErr.SendErrorToClient = true
 			authErr.ClientRedirectURI = redirectURI
+			authErr.ClientID = app.ClientID
+			authErr.ResponseMode = responseModeForError(responseMode, app)
 		}
 		return nil, authErr
 	}
@@ -313,6 +350,7 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		RedirectURI:         redirectURI,
 		RedirectURIProvided: redirectURI != "",
 		ResponseType:        responseType,
+		ResponseMode:        responseMode,
 		StandardScopes:      oidcScopes,
 		PermissionScopes:    nonOidcScopes,
 		CodeChallenge:       codeChallenge,
@@ -325,6 +363,8 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		MaxAge:              maxAge,
 		DPoPJkt:             dpopJkt,
 		Prompt:              prompt,
+
+		AuthorizationDetails: authorizationDetails,
 	}
 
 	// Set the redirect URI if not provided in the request. Invalid cases are already handled at this point.
@@ -354,9 +394,10 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	// scopeless requests stay unbound and their audience is the client_id. A permission-bearing
 	// request resolves an explicit resource or the configured default, rejecting with invalid_target
 	// when neither is available. The resolved resource server id is threaded into the flow so the
-	// authorization executor scopes its permission evaluation to it.
-	targetRS, errResp := resourceindicators.ResolveAudienceBinding(
-		ctx, as.resourceService, oauthParams.Resources, oauthParams.PermissionScopes)
+	// authorization executor scopes its permission evaluation to it. A request carrying
+	// authorization details is always bound, and the details are validated against the target.
+	targetRS, errResp := authorizationdetails.ResolveAudienceBinding(ctx, as.resourceService,
+		oauthParams.Resources, oauthParams.PermissionScopes, oauthParams.AuthorizationDetails)
 	if errResp != nil {
 		return nil, &AuthorizationError{
 			Code:              errResp.Error,
@@ -364,6 +405,8 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 			SendErrorToClient: oauthParams.RedirectURI != "",
 			ClientRedirectURI: oauthParams.RedirectURI,
 			State:             oauthParams.State,
+			ClientID:          oauthParams.ClientID,
+			ResponseMode:      oauthParams.ResponseMode,
 		}
 	}
 	resourceServerIdentifier := ""
@@ -377,6 +420,8 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 				SendErrorToClient: oauthParams.RedirectURI != "",
 				ClientRedirectURI: oauthParams.RedirectURI,
 				State:             oauthParams.State,
+				ClientID:          oauthParams.ClientID,
+				ResponseMode:      oauthParams.ResponseMode,
 			}
 		}
 		oauthParams.PermissionScopes = downscoped
@@ -402,6 +447,8 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 			SendErrorToClient: true,
 			ClientRedirectURI: oauthParams.RedirectURI,
 			State:             oauthParams.State,
+			ClientID:          oauthParams.ClientID,
+			ResponseMode:      oauthParams.ResponseMode,
 		}
 	}
 
@@ -425,6 +472,9 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	if oauthParams.MaxAge != "" {
 		runtimeData[flowcm.RuntimeKeyMaxAge] = oauthParams.MaxAge
 	}
+	if len(oauthParams.AuthorizationDetails) > 0 {
+		runtimeData[flowcm.RuntimeKeyAuthorizationDetails] = authorizationdetails.Encode(oauthParams.AuthorizationDetails)
+	}
 	flowInitCtx := &flowexec.FlowInitContext{
 		ApplicationID:    app.ID,
 		FlowType:         string(providers.FlowTypeAuthentication),
@@ -442,6 +492,8 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 			SendErrorToClient: true,
 			ClientRedirectURI: oauthParams.RedirectURI,
 			State:             oauthParams.State,
+			ClientID:          oauthParams.ClientID,
+			ResponseMode:      oauthParams.ResponseMode,
 		}
 	}
 
@@ -462,6 +514,8 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 			SendErrorToClient: true,
 			ClientRedirectURI: oauthParams.RedirectURI,
 			State:             oauthParams.State,
+			ClientID:          oauthParams.ClientID,
+			ResponseMode:      oauthParams.ResponseMode,
 		}
 	}
 	if parsedRedirectURI.Scheme == "http" {
@@ -549,6 +603,8 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 				SendErrorToClient: true,
 				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
 				State:             authRequestCtx.OAuthParameters.State,
+				ClientID:          authRequestCtx.OAuthParameters.ClientID,
+				ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 			}
 			return errors.New("assertion not bound to authorization request")
 		}
@@ -560,6 +616,8 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 				SendErrorToClient: true,
 				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
 				State:             authRequestCtx.OAuthParameters.State,
+				ClientID:          authRequestCtx.OAuthParameters.ClientID,
+				ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 			}
 			return errors.New("user ID is empty")
 		}
@@ -578,6 +636,8 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 					SendErrorToClient: true,
 					ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
 					State:             authRequestCtx.OAuthParameters.State,
+					ClientID:          authRequestCtx.OAuthParameters.ClientID,
+					ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 				}
 				return err
 			}
@@ -593,6 +653,9 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 			authRequestCtx.OAuthParameters.PermissionScopes = []string{}
 		}
 
+		// Only the authorization details approved during the flow are carried to the code.
+		authRequestCtx.OAuthParameters.AuthorizationDetails = claims.authorizationDetails
+
 		// Generate the authorization code.
 		authzCode, err := createAuthorizationCode(as.cfg, authRequestCtx, &claims, authTime)
 		if err != nil {
@@ -602,6 +665,8 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 				SendErrorToClient: true,
 				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
 				State:             authRequestCtx.OAuthParameters.State,
+				ClientID:          authRequestCtx.OAuthParameters.ClientID,
+				ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 			}
 			return err
 		}
@@ -614,19 +679,26 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 				SendErrorToClient: true,
 				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
 				State:             authRequestCtx.OAuthParameters.State,
+				ClientID:          authRequestCtx.OAuthParameters.ClientID,
+				ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 			}
 			return persistErr
 		}
 
-		// Construct the redirect URI with the authorization code.
-		queryParams := map[string]string{
+		// Construct the redirect URI carrying the authorization code in the requested response mode.
+		responseParams := map[string]string{
 			"code":                      authzCode.Code,
 			oauth2const.RequestParamIss: as.cfg.JWT.Issuer,
 		}
 		if authRequestCtx.OAuthParameters.State != "" {
-			queryParams[oauth2const.RequestParamState] = authRequestCtx.OAuthParameters.State
+			responseParams[oauth2const.RequestParamState] = authRequestCtx.OAuthParameters.State
 		}
-		redirectURI, err = oauth2utils.GetURIWithQueryParams(authzCode.RedirectURI, queryParams)
+		redirectURI, err = as.buildResponseRedirectURI(ctx, &authorizationResponse{
+			RedirectURI:  authzCode.RedirectURI,
+			ClientID:     authRequestCtx.OAuthParameters.ClientID,
+			ResponseMode: authRequestCtx.OAuthParameters.ResponseMode,
+			Params:       responseParams,
+		})
 		if err != nil {
 			authErr = &AuthorizationError{
 				Code:              oauth2const.ErrorServerError,
@@ -634,6 +706,8 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 				SendErrorToClient: true,
 				ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
 				State:             authRequestCtx.OAuthParameters.State,
+				ClientID:          authRequestCtx.OAuthParameters.ClientID,
+				ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 			}
 			return err
 		}
@@ -658,6 +732,17 @@ func (as *authorizeService) handleSuccessCallback(ctx context.Context, authID st
 	return redirectURI, nil
 }
 
+// buildResponseRedirectURI encodes the authorization response for its response mode and returns the
+// URI the user agent is redirected to.
+func (as *authorizeService) buildResponseRedirectURI(
+	ctx context.Context, resp *authorizationResponse) (string, error) {
+	encoded, err := as.responseEncoder.Encode(ctx, resp)
+	if err != nil {
+		return "", err
+	}
+	return as.responseEncoder.ToRedirectURI(ctx, encoded)
+}
+
 // handleFailedCallback constructs the OAuth error response for a verified error assertion. The
 // assertion is bound to this authorization request before the request context is loaded, since
 // loading consumes it and an assertion minted for another request must not burn a live authID.
@@ -700,6 +785,8 @@ func (as *authorizeService) handleFailedCallback(
 		SendErrorToClient: sendToClient,
 		ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
 		State:             authRequestCtx.OAuthParameters.State,
+		ClientID:          authRequestCtx.OAuthParameters.ClientID,
+		ResponseMode:      authRequestCtx.OAuthParameters.ResponseMode,
 	}
 }
 
@@ -754,6 +841,23 @@ func decodeAttributesFromAssertion(assertion string) (assertionClaims, time.Time
 		claims.tokenFamilyID = v
 	}
 
+	if v, ok := payload[oauth2const.ClaimConsentID].(string); ok {
+		claims.consentID = v
+	}
+
+	if v, ok := payload[oauth2const.ClaimSessionID].(string); ok {
+		claims.sessionID = v
+	}
+
+	if v, ok := payload[oauth2const.ClaimAuthorizationDetails]; ok {
+		details, ok := authorizationdetails.FromClaim(v)
+		if !ok {
+			return assertionClaims{}, time.Time{}, fmt.Errorf(
+				"%w: 'authorization_details' claim is not an array of objects", errAssertionClaimInvalid)
+		}
+		claims.authorizationDetails = details
+	}
+
 	if v, ok := payload[flowcm.ClaimFlowErrorType].(string); ok {
 		claims.flowErrorType = v
 	}
@@ -845,6 +949,10 @@ func createAuthorizationCode(
 		CompletedACR:        claims.completedACR,
 		DPoPJkt:             authRequestCtx.OAuthParameters.DPoPJkt,
 		TokenFamilyID:       tokenFamilyID,
+		ConsentID:           claims.consentID,
+		SessionID:           claims.sessionID,
+
+		AuthorizationDetails: authRequestCtx.OAuthParameters.AuthorizationDetails,
 	}, nil
 }
 
@@ -901,9 +1009,9 @@ func appendOIDCAttributes(oidcScopes []string, claimsRequest *oauth2model.Claims
 	app *providers.OAuthClient, essentialAttributes, optionalAttributes map[string]bool) {
 	var idTokenAllowedSet map[string]bool
 	if app.Token != nil {
-		idTokenAllowedSet = buildIDTokenAllowedSet(app.Token.IDToken)
+		idTokenAllowedSet = oauth2utils.BuildIDTokenAllowedSet(app.Token.IDToken)
 	}
-	userInfoAllowedSet := buildUserInfoAllowedSet(app.UserInfo)
+	userInfoAllowedSet := oauth2utils.BuildUserInfoAllowedSet(app.UserInfo)
 
 	appendAttributesFromClaimsParameter(claimsRequest, idTokenAllowedSet, userInfoAllowedSet,
 		essentialAttributes, optionalAttributes)
@@ -912,30 +1020,6 @@ func appendOIDCAttributes(oidcScopes []string, claimsRequest *oauth2model.Claims
 		optionalAttributes)
 }
 
-// buildIDTokenAllowedSet creates a set of allowed attributes for ID token.
-func buildIDTokenAllowedSet(idTokenConfig *providers.IDTokenConfig) map[string]bool {
-	if idTokenConfig == nil || len(idTokenConfig.UserAttributes) == 0 {
-		return nil
-	}
-	allowedSet := make(map[string]bool, len(idTokenConfig.UserAttributes))
-	for _, attr := range idTokenConfig.UserAttributes {
-		allowedSet[attr] = true
-	}
-	return allowedSet
-}
-
-// buildUserInfoAllowedSet creates a set of allowed attributes for UserInfo.
-func buildUserInfoAllowedSet(userInfoConfig *providers.UserInfoConfig) map[string]bool {
-	if userInfoConfig == nil || len(userInfoConfig.UserAttributes) == 0 {
-		return nil
-	}
-	allowedSet := make(map[string]bool, len(userInfoConfig.UserAttributes))
-	for _, attr := range userInfoConfig.UserAttributes {
-		allowedSet[attr] = true
-	}
-	return allowedSet
-}
-
 // appendAttributesFromClaimsParameter appends user attributes requested via the claims parameter.
 func appendAttributesFromClaimsParameter(claimsRequest *oauth2model.ClaimsRequest,
 	idTokenAllowedSet, userInfoAllowedSet, essentialAttributes, optionalAttributes map[string]bool) {
@@ -974,29 +1058,12 @@ func appendAttributesFromClaimsParameter(claimsRequest *oauth2model.ClaimsReques
 func appendAttributesFromScopes(oidcScopes []string, app *providers.OAuthClient,
 	idTokenAllowedSet, userInfoAllowedSet map[string]bool, optionalAttributes map[string]bool) {
 	for _, scope := range oidcScopes {
-		scopeAttributes := resolveScopeAttributes(scope, app.ScopeClaims)
+		scopeAttributes := oauth2utils.ResolveScopeAttributes(scope, app.ScopeClaims)
 		appendAttributesForScope(scopeAttributes,
 			idTokenAllowedSet, userInfoAllowedSet, optionalAttributes)
 	}
 }
 
-// resolveScopeAttributes resolves attributes for a scope, checking app-specific mappings first.
-func resolveScopeAttributes(scope string, scopeAttributesMapping map[string][]string) []string {
-	// Check app-specific scope attributes mapping first
-	if scopeAttributesMapping != nil {
-		if appAttributes, exists := scopeAttributesMapping[scope]; exists {
-			return appAttributes
-		}
-	}
-
-	// Fall back to standard OIDC scopes
-	if standardScope, exists := oauth2const.StandardOIDCScopes[scope]; exists {
-		return standardScope.Claims
-	}
-
-	return nil
-}
-
 // appendAttributesForScope appends attributes for a particular scope, allow-listed for either the
 // ID token or the UserInfo endpoint.
 // When using scopes, all attributes are treated as optional since there is no way to determine
@@ -26,9 +26,12 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -37,6 +40,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/requestobjectmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/revocationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 )
@@ -156,10 +160,14 @@ func (suite *AuthorizeServiceTestSuite) newService() *authorizeService {
 		authZValidator:  suite.mockValidator,
 		authCodeStore:   suite.mockAuthzCodeStore,
 		authReqStore:    suite.mockAuthReqStore,
+		requestObjects: requestobject.Initialize(suite.mockJWTService, jwksresolver.Initialize(nil), nil,
+			authorizeServiceCfgFromRuntime()),
 		jwtService:      suite.mockJWTService,
 		flowExecService: suite.mockFlowExecService,
 		transactioner:   &stubTransactioner{},
-		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeServiceTest")),
+		responseEncoder: newResponseModeEncoder(authorizeServiceCfgFromRuntime(), inboundClient,
+			suite.mockJWTService, nil, nil, newAuthorizationResponseStore(inmemory.Initialize("test-deployment"))),
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeServiceTest")),
 	}
 }
 
@@ -287,6 +295,41 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Va
 	assert.Equal(suite.T(), "test-state", authErr.State)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_ValidationError_ResponseMode() {
+	testCases := []struct {
+		name         string
+		allowedModes []providers.ResponseMode
+		responseMode string
+		expectedMode string
+	}{
+		{"SupportedMode", nil, "fragment", "fragment"},
+		{"ModeNotAllowedForClient", []providers.ResponseMode{providers.ResponseModeQuery}, "fragment", ""},
+		{"UnsupportedMode", nil, "web_message", ""},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			app := suite.testApp()
+			app.ResponseModes = tc.allowedModes
+			suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").
+				Return(app, nil)
+			suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
+				Return(true, oauth2const.ErrorInvalidRequest, "Invalid request")
+
+			msg := suite.testMsg()
+			msg.RequestQueryParams[oauth2const.RequestParamResponseMode] = []string{tc.responseMode}
+			svc := suite.newService()
+			_, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+			assert.NotNil(suite.T(), authErr)
+			assert.True(suite.T(), authErr.SendErrorToClient)
+			assert.Equal(suite.T(), "test-client-id", authErr.ClientID)
+			assert.Equal(suite.T(), tc.expectedMode, authErr.ResponseMode)
+		})
+	}
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_FlowInitError() {
 	app := suite.testApp()
 	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
@@ -326,6 +369,50 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_St
 	assert.Equal(suite.T(), "test-state", authErr.State)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SignedRequestObjectRequired() {
+	app := suite.testApp()
+	app.RequireSignedRequestObject = true
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.testMsg())
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+	assert.False(suite.T(), authErr.SendErrorToClient)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObjectParamsUsed() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: url.Values{
+			"client_id": {"test-client-id"},
+			"request":   {"signed.request.object"},
+		},
+	}
+	resolved := url.Values(suite.testMsg().RequestQueryParams)
+	resolved.Set("state", "signed-state")
+	requestObjects := requestobjectmock.NewRequestObjectResolverInterfaceMock(suite.T())
+	requestObjects.EXPECT().Resolve(mock.Anything, url.Values(msg.RequestQueryParams), app).Return(resolved, "", "")
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything,
+		mock.MatchedBy(func(m *OAuthMessage) bool {
+			params := url.Values(m.RequestQueryParams)
+			return params.Get("state") == "signed-state" && !params.Has("request")
+		}), app).Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	svc := suite.newService()
+	svc.requestObjects = requestObjects
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Success() {
 	app := suite.testApp()
 	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
@@ -371,6 +458,52 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Ex
 	assert.Equal(suite.T(), "https://api.example.com", captured.RuntimeData[flowcm.RuntimeKeyResourceServerIdentifier])
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InvalidAuthorizationDetails() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams[oauth2const.RequestParamAuthorizationDetails] = []string{`[{"actions":["read"]}]`}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidAuthorizationDetails, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_AuthorizationDetailsInRuntimeData() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
+		Return(false, "", "")
+	suite.mockResourceService.EXPECT().GetResourceServerByIdentifier(mock.Anything, "https://api.example.com").
+		Return(&providers.ResourceServer{ID: "rs-api", Identifier: "https://api.example.com"}, nil)
+	suite.mockResourceService.EXPECT().ValidateAuthorizationDetails(mock.Anything, "rs-api", mock.Anything).
+		Return(nil)
+	suite.mockResourceService.EXPECT().ValidatePermissions(mock.Anything, "rs-api", mock.Anything).
+		Return([]string{}, nil)
+
+	var captured *flowexec.FlowInitContext
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, ic *flowexec.FlowInitContext) { captured = ic }).
+		Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	msg := suite.testMsg()
+	msg.Resources = []string{"https://api.example.com"}
+	msg.RequestQueryParams[oauth2const.RequestParamAuthorizationDetails] = []string{`[{"type":"payment_initiation"}]`}
+
+	svc := suite.newService()
+	_, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	suite.Require().Nil(authErr)
+	suite.Require().NotNil(captured)
+	assert.Equal(suite.T(), `[{"type":"payment_initiation"}]`,
+		captured.RuntimeData[flowcm.RuntimeKeyAuthorizationDetails])
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_DefaultResourceServerFallback() {
 	app := suite.testApp()
 	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
@@ -811,6 +944,65 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_WithStat
 	assert.Contains(suite.T(), redirectURI, "iss=https%3A%2F%2Flocalhost%3A8090")
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_FragmentResponseMode() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
+			State:        "test-state-123",
+			ResponseMode: string(providers.ResponseModeFragment),
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, "", "").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+
+	svc := suite.newService()
+	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+
+	assert.Nil(suite.T(), authErr)
+	parsed, err := url.Parse(redirectURI)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), parsed.RawQuery)
+	fragment, err := url.ParseQuery(parsed.Fragment)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), fragment.Get("code"))
+	assert.Equal(suite.T(), "test-state-123", fragment.Get(oauth2const.RequestParamState))
+	assert.Equal(suite.T(), "https://localhost:8090", fragment.Get(oauth2const.RequestParamIss))
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_FormPostResponseMode() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:     "test-client",
+			RedirectURI:  "https://client.example.com/callback",
+			ResponseMode: string(providers.ResponseModeFormPost),
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, "", "").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+
+	svc := suite.newService()
+	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+
+	assert.Nil(suite.T(), authErr)
+	parsed, err := url.Parse(redirectURI)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), oauth2const.OAuth2AuthorizationResponseEndpoint, parsed.Path)
+	assert.NotContains(suite.T(), redirectURI, "code=")
+
+	// The parked response carries the authorization code for the client's redirect URI.
+	encoded, err := svc.responseEncoder.TakeFormPostResponse(context.Background(),
+		parsed.Query().Get(authzResponseKeyParam))
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), encoded.FormPost)
+	assert.Equal(suite.T(), "https://client.example.com/callback", encoded.RedirectURI)
+	assert.NotEmpty(suite.T(), encoded.FormParams["code"])
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_EmptyAuthorizedPermissions() {
 	// svcJWTWithIat has no authorized_permissions claim.
 	// Permission scopes in the auth context should be cleared.
@@ -989,6 +1181,21 @@ func (suite *AuthorizeServiceTestSuite) TestCreateAuthorizationCode_PreservesInc
 	assert.Equal(suite.T(), "tfid-from-sso", code.TokenFamilyID)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestCreateAuthorizationCode_CarriesSessionID() {
+	authCtx := &authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:    "test-client",
+			RedirectURI: "https://client.example.com/callback",
+		},
+	}
+	claims := &assertionClaims{userID: "user-1", sessionID: "sess-1"}
+
+	code, err := createAuthorizationCode(authorizeServiceCfgFromRuntime(), authCtx, claims, time.Now())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "sess-1", code.SessionID)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_Success() {
 	record := &AuthorizationCode{
 		CodeID:           "code-id-123",
@@ -1786,7 +1993,7 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_NoOpenIDScope(
 }
 
 func (suite *AuthorizeServiceTestSuite) TestResolveScopeAttributes_UnknownScope() {
-	result := resolveScopeAttributes("unknown_scope", map[string][]string{
+	result := oauth2utils.ResolveScopeAttributes("unknown_scope", map[string][]string{
 		"custom": {"email"},
 	})
 
@@ -1970,8 +2177,8 @@ func determineClaimsForTokens(oidcScopes []string, claimsRequest *oauth2model.Cl
 		return accessTokenClaims, idTokenClaims, userInfoClaims
 	}
 
-	idTokenAllowedSet := buildIDTokenAllowedSet(app.Token.IDToken)
-	userInfoAllowedSet := buildUserInfoAllowedSet(app.UserInfo)
+	idTokenAllowedSet := oauth2utils.BuildIDTokenAllowedSet(app.Token.IDToken)
+	userInfoAllowedSet := oauth2utils.BuildUserInfoAllowedSet(app.UserInfo)
 
 	if claimsRequest != nil {
 		if claimsRequest.IDToken != nil && idTokenAllowedSet != nil {
@@ -1991,7 +2198,7 @@ func determineClaimsForTokens(oidcScopes []string, claimsRequest *oauth2model.Cl
 	}
 
 	for _, scope := range oidcScopes {
-		scopeAttributes := resolveScopeAttributes(scope, app.ScopeClaims)
+		scopeAttributes := oauth2utils.ResolveScopeAttributes(scope, app.ScopeClaims)
 		for _, attribute := range scopeAttributes {
 			if responseType == string(providers.ResponseTypeIDToken) {
 				if idTokenAllowedSet != nil && idTokenAllowedSet[attribute] {
@@ -12,11 +12,13 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	oauth2authz "github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -37,22 +39,25 @@ type flowCallbackRequest struct {
 
 // callbackDispatcher dispatches flow assertion callbacks to the appropriate grant-type handler.
 type callbackDispatcher struct {
-	cfg          oauthconfig.Config
-	authZService oauth2authz.AuthorizeServiceInterface
-	cibaService  ciba.CIBAServiceInterface
-	logger       *log.Logger
+	cfg           oauthconfig.Config
+	authZService  oauth2authz.AuthorizeServiceInterface
+	cibaService   ciba.CIBAServiceInterface
+	deviceService device.DeviceServiceInterface
+	logger        *log.Logger
 }
 
 func newCallbackDispatcher(
 	cfg oauthconfig.Config,
 	authZService oauth2authz.AuthorizeServiceInterface,
 	cibaService ciba.CIBAServiceInterface,
+	deviceService device.DeviceServiceInterface,
 ) *callbackDispatcher {
 	return &callbackDispatcher{
-		cfg:          cfg,
-		authZService: authZService,
-		cibaService:  cibaService,
-		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CallbackHandler")),
+		cfg:           cfg,
+		authZService:  authZService,
+		cibaService:   cibaService,
+		deviceService: deviceService,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CallbackHandler")),
 	}
 }
 
@@ -61,9 +66,10 @@ func Initialize(
 	mux *http.ServeMux,
 	authZService oauth2authz.AuthorizeServiceInterface,
 	cibaService ciba.CIBAServiceInterface,
+	deviceService device.DeviceServiceInterface,
 	cfg oauthconfig.Config,
 ) {
-	d := newCallbackDispatcher(cfg, authZService, cibaService)
+	d := newCallbackDispatcher(cfg, authZService, cibaService, deviceService)
 	corsOpts := middleware.CORSOptions{
 		AllowedMethods:   []string{"POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
@@ -131,6 +137,19 @@ func (d *callbackDispatcher) handleFlowCallback(w http.ResponseWriter, r *http.R
 		}
 		utils.WriteSuccessResponse(ctx, w, http.StatusOK, map[string]string{"status": "OK"})
 
+	case string(providers.GrantTypeDeviceCode):
+		if d.deviceService == nil {
+			utils.WriteJSONError(ctx, w, oauth2const.ErrorInvalidRequest,
+				"Unsupported callback type", http.StatusBadRequest, nil)
+			return
+		}
+		state, deviceErr := d.deviceService.HandleCallback(ctx, req.AuthID, req.Assertion)
+		if deviceErr != nil {
+			d.writeErrorPageRedirect(ctx, w, deviceErr.Code, deviceErr.Message, "")
+			return
+		}
+		d.writeDevicePageRedirect(ctx, w, state)
+
 	default:
 		utils.WriteJSONError(ctx, w, oauth2const.ErrorInvalidRequest,
 			"Unsupported callback type", http.StatusBadRequest, nil)
@@ -159,6 +178,26 @@ func (
 	utils.WriteSuccessResponse(ctx, w, http.StatusOK, oauth2authz.AuthZPostResponse{RedirectURI: redirectURI})
 }
 
+// writeDevicePageRedirect sends the user back to the Gate device page with the outcome of the device
+// verification, so the page can tell the user to return to their device.
+func (d *callbackDispatcher) writeDevicePageRedirect(ctx context.Context, w http.ResponseWriter,
+	state device.DeviceRequestState) {
+	gateClientConfig := d.cfg.GateClient
+	devicePageURL := (&url.URL{
+		Scheme: gateClientConfig.Scheme,
+		Host:   fmt.Sprintf("%s:%d", gateClientConfig.Hostname, gateClientConfig.Port),
+		Path:   gateClientConfig.DevicePath,
+	}).String()
+	redirectURI, err := oauth2utils.GetURIWithQueryParams(devicePageURL, map[string]string{
+		"status": strings.ToLower(string(state)),
+	})
+	if err != nil {
+		http.Error(w, "Failed to redirect to device page", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteSuccessResponse(ctx, w, http.StatusOK, oauth2authz.AuthZPostResponse{RedirectURI: redirectURI})
+}
+
 func (
 	d *callbackDispatcher) writeErrorPageRedirect(ctx context.Context,
 	w http.ResponseWriter,
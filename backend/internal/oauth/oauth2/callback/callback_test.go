@@ -19,9 +19,11 @@ import (
 	oauth2authz "github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/authzmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/cibamock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/devicemock"
 	"github.com/thunder-id/thunderid/tests/testhelpers"
 )
 
@@ -29,6 +31,7 @@ type CallbackDispatcherTestSuite struct {
 	suite.Suite
 	mockAuthZ  *authzmock.AuthorizeServiceInterfaceMock
 	mockCIBA   *cibamock.CIBAServiceInterfaceMock
+	mockDevice *devicemock.DeviceServiceInterfaceMock
 	dispatcher *callbackDispatcher
 }
 
@@ -39,7 +42,9 @@ func TestCallbackDispatcherSuite(t *testing.T) {
 func (suite *CallbackDispatcherTestSuite) SetupTest() {
 	suite.mockAuthZ = authzmock.NewAuthorizeServiceInterfaceMock(suite.T())
 	suite.mockCIBA = cibamock.NewCIBAServiceInterfaceMock(suite.T())
-	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, suite.mockCIBA)
+	suite.mockDevice = devicemock.NewDeviceServiceInterfaceMock(suite.T())
+	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, suite.mockCIBA,
+		suite.mockDevice)
 
 	_ = config.InitializeServerRuntime("test", &config.Config{
 		JWT: engineconfig.JWTConfig{
@@ -241,7 +246,7 @@ func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_CIBA_Error() {
 func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_CIBA_NilCIBAService_ReturnsBadRequest() {
 	// When the CIBA grant type is not in allowed_grant_types, cibaService is nil. A CIBA
 	// callback must be rejected gracefully instead of panicking on the nil service.
-	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, nil)
+	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, nil, nil)
 
 	w := suite.postCallback(
 		`{"authId":"auth-req-1","assertion":"ciba-assertion","type":"urn:openid:params:grant-type:ciba"}`)
@@ -253,6 +258,64 @@ func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_CIBA_NilCIBASer
 	suite.Contains(body["error_description"], "Unsupported callback type")
 }
 
+// --- handleFlowCallback: device authorization path ---
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_Device_Success_RedirectsToDevicePage() {
+	suite.mockDevice.EXPECT().
+		HandleCallback(mock.Anything, "BCDFGHJK", "device-assertion").
+		Return(device.DeviceStateAuthenticated, nil)
+
+	w := suite.postCallback(`{"authId":"BCDFGHJK","assertion":"device-assertion",` +
+		`"type":"urn:ietf:params:oauth:grant-type:device_code"}`)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp oauth2authz.AuthZPostResponse
+	suite.NoError(json.NewDecoder(w.Body).Decode(&resp))
+	suite.Equal("https://localhost:3000/device?status=authenticated", resp.RedirectURI)
+}
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_Device_Denied_RedirectsToDevicePage() {
+	suite.mockDevice.EXPECT().
+		HandleCallback(mock.Anything, "BCDFGHJK", "error-assertion").
+		Return(device.DeviceStateDenied, nil)
+
+	w := suite.postCallback(`{"authId":"BCDFGHJK","assertion":"error-assertion",` +
+		`"type":"urn:ietf:params:oauth:grant-type:device_code"}`)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp oauth2authz.AuthZPostResponse
+	suite.NoError(json.NewDecoder(w.Body).Decode(&resp))
+	suite.Contains(resp.RedirectURI, "status=denied")
+}
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_Device_Error_RedirectsToErrorPage() {
+	suite.mockDevice.EXPECT().
+		HandleCallback(mock.Anything, "BCDFGHJK", "bad-assertion").
+		Return(device.DeviceRequestState(""),
+			&device.DeviceError{Code: oauth2const.ErrorInvalidRequest, Message: "Invalid assertion signature"})
+
+	w := suite.postCallback(`{"authId":"BCDFGHJK","assertion":"bad-assertion",` +
+		`"type":"urn:ietf:params:oauth:grant-type:device_code"}`)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp oauth2authz.AuthZPostResponse
+	suite.NoError(json.NewDecoder(w.Body).Decode(&resp))
+	suite.Contains(resp.RedirectURI, "/error")
+	suite.Contains(resp.RedirectURI, "errorCode="+oauth2const.ErrorInvalidRequest)
+}
+
+func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_Device_NilDeviceService_ReturnsBadRequest() {
+	suite.dispatcher = newCallbackDispatcher(testhelpers.OAuthConfig(), suite.mockAuthZ, suite.mockCIBA, nil)
+
+	w := suite.postCallback(`{"authId":"BCDFGHJK","assertion":"device-assertion",` +
+		`"type":"urn:ietf:params:oauth:grant-type:device_code"}`)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	var body map[string]string
+	suite.NoError(json.NewDecoder(w.Body).Decode(&body))
+	suite.Contains(body["error_description"], "Unsupported callback type")
+}
+
 // --- handleFlowCallback: unsupported type ---
 
 func (suite *CallbackDispatcherTestSuite) TestHandleFlowCallback_UnsupportedType_ReturnsBadRequest() {
@@ -91,6 +91,8 @@ func (h *cibaHandler) HandleBackchannelAuthRequest(w http.ResponseWriter, r *htt
 		ACRValues:       r.FormValue(oauth2const.RequestParamAcrValues),
 		Headers:         utils.SanitizeRawMultiValueStringMap(r.Header),
 		QueryParams:     utils.SanitizeRawMultiValueStringMap(r.URL.Query()),
+
+		AuthorizationDetails: r.FormValue(oauth2const.RequestParamAuthorizationDetails),
 	}
 
 	response, cibaErr := h.cibaService.InitiateBackchannelAuth(r.Context(), request, clientInfo.OAuthApp)
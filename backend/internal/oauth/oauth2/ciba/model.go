@@ -6,6 +6,8 @@ package ciba
 
 import (
 	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
 // CIBARequestState represents the lifecycle state of a CIBA authentication request.
@@ -29,6 +31,8 @@ const (
 // CIBAAuthRequest represents a persisted CIBA authentication request.
 // UserID is empty at creation and populated by MarkAuthenticated once the user completes
 // authentication and the callback verifies the assertion.
+// AuthorizationDetails holds the validated RFC 9396 authorization details; consent to them is
+// all-or-nothing, so a request that reaches AUTHENTICATED has had them approved.
 type CIBAAuthRequest struct {
 	AuthReqID        string
 	ClientID         string
@@ -42,6 +46,8 @@ type CIBAAuthRequest struct {
 	AuthTime         time.Time
 	LastPolledAt     time.Time
 	ExpiryTime       time.Time
+
+	AuthorizationDetails []providers.AuthorizationDetail
 }
 
 // BackchannelAuthResponse represents the response body for a successful backchannel authentication request.
@@ -68,6 +74,8 @@ type BackchannelAuthRequest struct {
 	ACRValues       string
 	Headers         map[string][]string
 	QueryParams     map[string][]string
+
+	AuthorizationDetails string
 }
 
 // assertionClaims represents the claims extracted from the flow assertion JWT.
@@ -17,7 +17,9 @@ import (
 	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
@@ -56,6 +58,7 @@ type cibaService struct {
 	jwtService      jwt.JWTServiceInterface
 	inboundClient   providers.ActorProvider
 	resourceService providers.ResourceServerProvider
+	pairwiseService pairwise.PairwiseServiceInterface
 	logger          *log.Logger
 }
 
@@ -66,6 +69,7 @@ func newCIBAService(
 	jwtService jwt.JWTServiceInterface,
 	actorProvider providers.ActorProvider,
 	resourceService providers.ResourceServerProvider,
+	pairwiseService pairwise.PairwiseServiceInterface,
 	cfg oauthconfig.Config,
 ) CIBAServiceInterface {
 	return &cibaService{
@@ -75,6 +79,7 @@ func newCIBAService(
 		jwtService:      jwtService,
 		inboundClient:   actorProvider,
 		resourceService: resourceService,
+		pairwiseService: pairwiseService,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CIBAService")),
 	}
 }
@@ -114,8 +119,12 @@ func (s *cibaService) InitiateBackchannelAuth(
 	// Bind the request to a single target resource server before consent, mirroring authorization_code.
 	// OIDC-only (no resource, no permission scopes) stays unbound; a permission-bearing request resolves
 	// an explicit resource or the configured default, rejecting with invalid_target when none applies.
-	targetRS, rsErr := resourceindicators.ResolveAudienceBinding(
-		ctx, s.resourceService, request.Resources, permissionScopes)
+	authorizationDetails, adErr := authorizationdetails.Parse(request.AuthorizationDetails)
+	if adErr != nil {
+		return nil, &CIBAError{Code: adErr.Error, Message: adErr.ErrorDescription}
+	}
+	targetRS, rsErr := authorizationdetails.ResolveAudienceBinding(
+		ctx, s.resourceService, request.Resources, permissionScopes, authorizationDetails)
 	if rsErr != nil {
 		return nil, &CIBAError{Code: rsErr.Error, Message: rsErr.ErrorDescription}
 	}
@@ -151,12 +160,15 @@ func (s *cibaService) InitiateBackchannelAuth(
 		flowcm.RuntimeKeyRequestedPermissions:        utils.StringifyStringArray(permissionScopes, " "),
 		flowcm.RuntimeKeyResourceServerIdentifier:    resourceServerIdentifier,
 		flowcm.RuntimeKeyRequiredEssentialAttributes: "",
-		flowcm.RuntimeKeyRequiredOptionalAttributes: getRequiredOptionalAttributes(
+		flowcm.RuntimeKeyRequiredOptionalAttributes: oauth2utils.GetRequiredOptionalAttributes(
 			append(oidcScopes, permissionScopes...), oauthApp),
 		flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: cacheTTL,
 		flowcm.RuntimeKeyBindingMessage:                bindingMessage,
 		flowcm.RuntimeKeyForceConsentReprompt:          "true",
 	}
+	if len(authorizationDetails) > 0 {
+		runtimeData[flowcm.RuntimeKeyAuthorizationDetails] = authorizationdetails.Encode(authorizationDetails)
+	}
 	if request.ACRValues != "" {
 		runtimeData[flowcm.RuntimeKeyRequestedAuthClasses] = request.ACRValues
 	}
@@ -204,6 +216,8 @@ func (s *cibaService) InitiateBackchannelAuth(
 		Resources:      effectiveResources,
 		State:          CIBAStatePending,
 		ExpiryTime:     now.Add(time.Duration(expiresIn) * time.Second),
+
+		AuthorizationDetails: authorizationDetails,
 	}
 	if storeErr := s.store.Add(ctx, cibaRequest); storeErr != nil {
 		s.logger.Error(ctx, "Failed to store CIBA authentication request", log.Error(storeErr))
@@ -484,7 +498,41 @@ func (s *cibaService) validateIDTokenHint(ctx context.Context, idTokenHint strin
 		}
 	}
 
-	return sub, nil
+	return s.resolveIDTokenHintSubject(ctx, payload, sub)
+}
+
+// resolveIDTokenHintSubject maps the sub of an id_token_hint back to the user id. A hint issued to a
+// pairwise client carries that client's pairwise subject rather than the user id.
+func (s *cibaService) resolveIDTokenHintSubject(
+	ctx context.Context, payload map[string]interface{}, sub string,
+) (string, *CIBAError) {
+	if s.pairwiseService == nil {
+		return sub, nil
+	}
+	clientID, _ := payload[oauth2const.ClaimAzp].(string)
+	if clientID == "" {
+		switch aud := payload[oauth2const.ClaimAud].(type) {
+		case string:
+			clientID = aud
+		case []interface{}:
+			if len(aud) > 0 {
+				clientID, _ = aud[0].(string)
+			}
+		}
+	}
+	if clientID == "" {
+		return sub, nil
+	}
+
+	userID, err := s.pairwiseService.ResolveUserID(ctx, clientID, sub)
+	if err != nil {
+		s.logger.Debug(ctx, "Failed to resolve id_token_hint subject", log.Error(err))
+		return "", &CIBAError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "id_token_hint subject could not be resolved",
+		}
+	}
+	return userID, nil
 }
 
 // validateBackchannelAuthRequest validates the required parameters of a backchannel authentication request.
@@ -30,6 +30,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
 	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 	"github.com/thunder-id/thunderid/tests/testhelpers"
 )
@@ -64,7 +65,7 @@ func (suite *CIBAServiceTestSuite) SetupTest() {
 	suite.mockResourceSvc = resourcemock.NewResourceServiceInterfaceMock(suite.T())
 	actorProv := actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil)
 	suite.service = newCIBAService(suite.mockStore, suite.mockFlowExec,
-		suite.mockJWTService, actorProv, suite.mockResourceSvc, testhelpers.OAuthConfig())
+		suite.mockJWTService, actorProv, suite.mockResourceSvc, nil, testhelpers.OAuthConfig())
 	suite.oauthApp = &providers.OAuthClient{
 		ID:         "app-1",
 		ClientID:   "client-1",
@@ -292,6 +293,54 @@ func (suite *CIBAServiceTestSuite) TestInitiate_ExplicitResourceBindsAndDownscop
 	suite.Equal([]string{"https://api.example.com"}, stored.Resources)
 }
 
+func (suite *CIBAServiceTestSuite) TestInitiate_AuthorizationDetailsValidatedAndStored() {
+	suite.mockResourceSvc.EXPECT().GetResourceServerByIdentifier(mock.Anything, "https://api.example.com").
+		Return(&providers.ResourceServer{ID: "rs-1", Identifier: "https://api.example.com"}, nil)
+	suite.mockResourceSvc.EXPECT().ValidateAuthorizationDetails(mock.Anything, "rs-1", mock.Anything).
+		Return(nil)
+	suite.mockResourceSvc.EXPECT().ValidatePermissions(mock.Anything, "rs-1", mock.Anything).
+		Return([]string{}, nil)
+	suite.mockFlowExec.EXPECT().InitiateAndExecute(mock.Anything, mock.MatchedBy(
+		func(initCtx *flowexec.FlowInitContext) bool {
+			return initCtx.RuntimeData[flowcm.RuntimeKeyAuthorizationDetails] == `[{"type":"payment_initiation"}]`
+		})).Return(&flowexec.FlowStep{ExecutionID: "exec-1", Status: providers.FlowStatusIncomplete}, nil)
+
+	var stored *CIBAAuthRequest
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.MatchedBy(func(r *CIBAAuthRequest) bool {
+		stored = r
+		return true
+	})).Return(nil)
+
+	_, cibaErr := suite.service.InitiateBackchannelAuth(context.Background(), &BackchannelAuthRequest{
+		LoginHint:            "alice",
+		Scope:                "openid read:things",
+		Resources:            []string{"https://api.example.com"},
+		AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+	}, suite.oauthApp)
+
+	suite.Nil(cibaErr)
+	suite.Len(stored.AuthorizationDetails, 1)
+	suite.Equal("payment_initiation", stored.AuthorizationDetails[0].Type())
+}
+
+func (suite *CIBAServiceTestSuite) TestInitiate_AuthorizationDetailsRejected() {
+	suite.mockResourceSvc.EXPECT().GetResourceServerByIdentifier(mock.Anything, "https://api.example.com").
+		Return(&providers.ResourceServer{ID: "rs-1", Identifier: "https://api.example.com"}, nil)
+	suite.mockResourceSvc.EXPECT().ValidateAuthorizationDetails(mock.Anything, "rs-1", mock.Anything).
+		Return(&tidcommon.ServiceError{Type: tidcommon.ClientErrorType, Code: "RES-1025"})
+
+	resp, cibaErr := suite.service.InitiateBackchannelAuth(context.Background(), &BackchannelAuthRequest{
+		LoginHint:            "alice",
+		Scope:                "openid",
+		Resources:            []string{"https://api.example.com"},
+		AuthorizationDetails: `[{"type":"unknown"}]`,
+	}, suite.oauthApp)
+
+	suite.Nil(resp)
+	suite.Require().NotNil(cibaErr)
+	suite.Equal(oauth2const.ErrorInvalidAuthorizationDetails, cibaErr.Code)
+}
+
 func (suite *CIBAServiceTestSuite) TestInitiate_SetsResourceServerIDInRuntimeData() {
 	suite.mockResourceSvc.EXPECT().GetResourceServerByIdentifier(mock.Anything, "https://api.example.com").
 		Return(&providers.ResourceServer{ID: "rs-1", Identifier: "https://api.example.com"}, nil)
@@ -675,76 +724,6 @@ func (suite *CIBAServiceTestSuite) TestResolveExpectedAudience_NilApp() {
 	suite.Nil(cibaErr)
 }
 
-// -------------------------------------------------------------------
-// getRequiredOptionalAttributes tests
-// -------------------------------------------------------------------
-
-func (suite *CIBAServiceTestSuite) TestGetRequiredOptionalAttributes_NilApp() {
-	suite.Empty(getRequiredOptionalAttributes([]string{"openid", "profile"}, nil))
-}
-
-func (suite *CIBAServiceTestSuite) TestGetRequiredOptionalAttributes_AccessTokenAttributesOnly() {
-	app := &providers.OAuthClient{
-		Token: &providers.OAuthTokenConfig{
-			AccessToken: &providers.AccessTokenConfig{
-				UserConfig: &providers.AccessTokenSubConfig{Attributes: []string{"user_id", "role"}},
-			},
-		},
-	}
-	suite.ElementsMatch([]string{"user_id", "role"}, strings.Fields(getRequiredOptionalAttributes([]string{}, app)))
-}
-
-func (suite *CIBAServiceTestSuite) TestGetRequiredOptionalAttributes_ScopeDerivedFilteredByUserInfo() {
-	app := &providers.OAuthClient{
-		Token: &providers.OAuthTokenConfig{
-			AccessToken: &providers.AccessTokenConfig{
-				UserConfig: &providers.AccessTokenSubConfig{Attributes: []string{"user_id"}},
-			},
-		},
-		UserInfo: &providers.UserInfoConfig{
-			UserAttributes: []string{"email", "name"},
-		},
-	}
-	optional := getRequiredOptionalAttributes([]string{"openid", "email", "profile"}, app)
-	suite.ElementsMatch([]string{"user_id", "email", "name"}, strings.Fields(optional))
-}
-
-func (suite *CIBAServiceTestSuite) TestGetRequiredOptionalAttributes_ScopeDerivedFromIDTokenAllowList() {
-	// Regression: a scope attribute allow-listed only for the ID token (and not for UserInfo) must
-	// still be resolved and cached so the CIBA-issued ID token can surface it.
-	app := &providers.OAuthClient{
-		Token: &providers.OAuthTokenConfig{
-			IDToken: &providers.IDTokenConfig{
-				UserAttributes: []string{"email", "email_verified"},
-			},
-		},
-	}
-	optional := getRequiredOptionalAttributes([]string{"openid", "email"}, app)
-	suite.ElementsMatch([]string{"email", "email_verified"}, strings.Fields(optional))
-}
-
-func (suite *CIBAServiceTestSuite) TestGetRequiredOptionalAttributes_ScopeDerivedSkippedWithoutOpenID() {
-	app := &providers.OAuthClient{
-		UserInfo: &providers.UserInfoConfig{
-			UserAttributes: []string{"email", "name"},
-		},
-	}
-	suite.Empty(getRequiredOptionalAttributes([]string{"profile", "email"}, app))
-}
-
-func (suite *CIBAServiceTestSuite) TestGetRequiredOptionalAttributes_UsesAppScopeClaimsMapping() {
-	app := &providers.OAuthClient{
-		UserInfo: &providers.UserInfoConfig{
-			UserAttributes: []string{"custom_attr"},
-		},
-		ScopeClaims: map[string][]string{
-			"profile": {"custom_attr"},
-		},
-	}
-	suite.ElementsMatch([]string{"custom_attr"},
-		strings.Fields(getRequiredOptionalAttributes([]string{"openid", "profile"}, app)))
-}
-
 // -------------------------------------------------------------------
 // HandleCallback tests
 // -------------------------------------------------------------------
@@ -963,7 +942,7 @@ func (suite *CIBAServiceTestSuite) withIssuer() {
 	cfg.JWT.Issuer = testIssuer
 	actorProv := actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil)
 	suite.service = newCIBAService(suite.mockStore, suite.mockFlowExec,
-		suite.mockJWTService, actorProv, suite.mockResourceSvc, cfg)
+		suite.mockJWTService, actorProv, suite.mockResourceSvc, nil, cfg)
 }
 
 func (suite *CIBAServiceTestSuite) validIDTokenHint() string {
@@ -994,6 +973,58 @@ func (suite *CIBAServiceTestSuite) TestInitiate_WithIDTokenHint_Success() {
 	suite.NotNil(resp)
 }
 
+func (suite *CIBAServiceTestSuite) TestInitiate_WithIDTokenHint_PairwiseSubject() {
+	suite.withIssuer()
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(suite.T())
+	suite.service.(*cibaService).pairwiseService = pairwiseService
+	hint := buildTestAssertion(map[string]interface{}{
+		"iss": testIssuer,
+		"aud": "client-1",
+		"sub": "pairwise-sub",
+		"exp": float64(time.Now().Add(10 * time.Minute).Unix()),
+	})
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(mock.Anything, hint).Return(nil)
+	pairwiseService.On("ResolveUserID", mock.Anything, "client-1", "pairwise-sub").Return(testEntityID, nil)
+	suite.mockFlowExec.EXPECT().InitiateAndExecute(mock.Anything, mock.MatchedBy(
+		func(initCtx *flowexec.FlowInitContext) bool {
+			return initCtx.InitialInputs[oauth2const.RequestParamLoginHint] == testEntityID
+		})).Return(&flowexec.FlowStep{ExecutionID: "exec-1", Status: providers.FlowStatusIncomplete}, nil)
+	suite.expectStoreAddSuccess()
+
+	resp, cibaErr := suite.service.InitiateBackchannelAuth(context.Background(), &BackchannelAuthRequest{
+		IDTokenHint: hint,
+		Scope:       "openid",
+	}, suite.oauthApp)
+
+	suite.Nil(cibaErr)
+	suite.NotNil(resp)
+}
+
+func (suite *CIBAServiceTestSuite) TestInitiate_WithIDTokenHint_PairwiseSubjectUnknown() {
+	suite.withIssuer()
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(suite.T())
+	suite.service.(*cibaService).pairwiseService = pairwiseService
+	hint := buildTestAssertion(map[string]interface{}{
+		"iss": testIssuer,
+		"azp": "client-1",
+		"aud": []interface{}{"client-1"},
+		"sub": "unknown-sub",
+		"exp": float64(time.Now().Add(10 * time.Minute).Unix()),
+	})
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(mock.Anything, hint).Return(nil)
+	pairwiseService.On("ResolveUserID", mock.Anything, "client-1", "unknown-sub").
+		Return("", errors.New("pairwise subject not found"))
+
+	resp, cibaErr := suite.service.InitiateBackchannelAuth(context.Background(), &BackchannelAuthRequest{
+		IDTokenHint: hint,
+		Scope:       "openid",
+	}, suite.oauthApp)
+
+	suite.Nil(resp)
+	suite.NotNil(cibaErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, cibaErr.Code)
+}
+
 func (suite *CIBAServiceTestSuite) TestInitiate_WithIDTokenHint_InvalidJWT() {
 	suite.withIssuer()
 
@@ -1258,7 +1289,7 @@ func (suite *CIBAServiceTestSuite) serviceWithServerErrorReporting(enabled bool)
 	cfg.OAuth.SendServerErrorsToClient = &enabled
 	actorProv := actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr(), nil)
 	return newCIBAService(suite.mockStore, suite.mockFlowExec,
-		suite.mockJWTService, actorProv, suite.mockResourceSvc, cfg)
+		suite.mockJWTService, actorProv, suite.mockResourceSvc, nil, cfg)
 }
 
 // TestHandleCallback_Failure_ServerErrorsNotReported verifies that with
@@ -130,6 +130,12 @@ func authenticate(
 		return nil, errInvalidClientCredentials
 	}
 
+	// Mutual-TLS clients authenticate with the certificate presented on the connection, so a request
+	// that carries only the client_id is attributed to the client's registered mutual-TLS method.
+	if detectedMethod == providers.TokenEndpointAuthMethodNone && oauthApp.TokenEndpointAuthMethod.IsMutualTLS() {
+		detectedMethod = oauthApp.TokenEndpointAuthMethod
+	}
+
 	if oauthApp.TokenEndpointAuthMethod != detectedMethod {
 		// No credentials presented for a client that requires authentication.
 		if detectedMethod == providers.TokenEndpointAuthMethodNone {
@@ -147,6 +153,12 @@ func authenticate(
 			logger.Debug(ctx, "Invalid client assertion: "+err.Error())
 			return nil, errInvalidClientAssertion
 		}
+	case providers.TokenEndpointAuthMethodTLSClientAuth,
+		providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
+		if err := validateClientCertificate(ctx, r, oauthApp, jwtService); err != nil {
+			logger.Debug(ctx, "Invalid client certificate: "+err.Error())
+			return nil, errInvalidClientCertificate
+		}
 	case providers.TokenEndpointAuthMethodClientSecretBasic,
 		providers.TokenEndpointAuthMethodClientSecretPost:
 		_, _, authnErr := authnProvider.AuthenticateUser(ctx,
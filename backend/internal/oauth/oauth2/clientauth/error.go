@@ -62,6 +62,11 @@ var (
 		"Invalid client assertion",
 		http.StatusUnauthorized,
 	)
+	errInvalidClientCertificate = newAuthError(
+		constants.ErrorInvalidClient,
+		"Invalid client certificate",
+		http.StatusUnauthorized,
+	)
 	errClientAuthRequired = newAuthError(
 		constants.ErrorInvalidClient,
 		"Client authentication is required",
@@ -0,0 +1,224 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clientauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/cert"
+	"github.com/thunder-id/thunderid/internal/system/jose/jws"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// validateClientCertificate authenticates a client using the certificate it presented over mutual TLS
+// (RFC 8705 §2). For tls_client_auth the certificate must chain to a trusted CA and carry the subject
+// registered for the client; for self_signed_tls_client_auth it must match one of the keys in the
+// client's registered JWKS.
+func validateClientCertificate(ctx context.Context, r *http.Request, oauthApp *providers.OAuthClient,
+	jwtService jwt.JWTServiceInterface) error {
+	chain, err := mtls.ClientCertificateChain(r)
+	if err != nil {
+		return err
+	}
+	if len(chain) == 0 {
+		return errors.New("no client certificate presented")
+	}
+
+	if oauthApp.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth {
+		return matchRegisteredCertificate(ctx, chain[0], oauthApp, jwtService)
+	}
+
+	if err := mtls.VerifyChain(chain); err != nil {
+		return err
+	}
+	return matchRegisteredSubject(chain[0], oauthApp.TLSClientAuth)
+}
+
+// matchRegisteredSubject checks the certificate against the single subject value registered for a
+// tls_client_auth client (RFC 8705 §2.1.2).
+func matchRegisteredSubject(certificate *x509.Certificate, subject *providers.TLSClientAuthConfig) error {
+	if subject == nil {
+		return errors.New("no certificate subject registered for the client")
+	}
+
+	switch {
+	case subject.SubjectDN != "":
+		if normalizeDN(subject.SubjectDN) == normalizeDN(certificate.Subject.String()) {
+			return nil
+		}
+	case subject.SANDNS != "":
+		for _, name := range certificate.DNSNames {
+			if strings.EqualFold(name, subject.SANDNS) {
+				return nil
+			}
+		}
+	case subject.SANURI != "":
+		for _, uri := range certificate.URIs {
+			if uri.String() == subject.SANURI {
+				return nil
+			}
+		}
+	case subject.SANIP != "":
+		registered := net.ParseIP(subject.SANIP)
+		for _, ip := range certificate.IPAddresses {
+			if registered != nil && ip.Equal(registered) {
+				return nil
+			}
+		}
+	case subject.SANEmail != "":
+		for _, email := range certificate.EmailAddresses {
+			if strings.EqualFold(email, subject.SANEmail) {
+				return nil
+			}
+		}
+	default:
+		return errors.New("no certificate subject registered for the client")
+	}
+	return errors.New("client certificate subject does not match the registered subject")
+}
+
+// normalizeDN returns a comparable form of an RFC 4514 distinguished name: whitespace around RDN
+// separators and attribute values is dropped, and the name is case folded.
+func normalizeDN(dn string) string {
+	var rdns []string
+	var current strings.Builder
+	escaped := false
+	for _, c := range dn {
+		switch {
+		case escaped:
+			current.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			current.WriteRune(c)
+			escaped = true
+		case c == ',' || c == ';':
+			rdns = append(rdns, normalizeRDN(current.String()))
+			current.Reset()
+		default:
+			current.WriteRune(c)
+		}
+	}
+	rdns = append(rdns, normalizeRDN(current.String()))
+	return strings.ToLower(strings.Join(rdns, ","))
+}
+
+// normalizeRDN trims whitespace around a relative distinguished name and its attribute type and value.
+func normalizeRDN(rdn string) string {
+	attrType, value, found := strings.Cut(strings.TrimSpace(rdn), "=")
+	if !found {
+		return strings.TrimSpace(rdn)
+	}
+	return strings.TrimSpace(attrType) + "=" + strings.TrimSpace(value)
+}
+
+// matchRegisteredCertificate checks a self-signed certificate against the keys of the client's registered
+// JWKS (RFC 8705 §2.2.2). A key matches when its x5c leaf is the presented certificate, or when it is the
+// certificate's public key.
+func matchRegisteredCertificate(ctx context.Context, certificate *x509.Certificate,
+	oauthApp *providers.OAuthClient, jwtService jwt.JWTServiceInterface) error {
+	if oauthApp.Certificate == nil {
+		return errors.New("no certificate configured for self-signed client certificate authentication")
+	}
+
+	var keys []map[string]any
+	if oauthApp.Certificate.Type == cert.CertificateTypeJWKSURI {
+		fetched, svcErr := jwtService.GetJWKS(ctx, oauthApp.Certificate.Value)
+		if svcErr != nil {
+			return fmt.Errorf("failed to fetch client JWKS: %s", svcErr.Error.DefaultValue)
+		}
+		keys = fetched
+	} else {
+		var jwks struct {
+			Keys []map[string]any `json:"keys"`
+		}
+		if err := json.Unmarshal([]byte(oauthApp.Certificate.Value), &jwks); err != nil {
+			return fmt.Errorf("invalid JWKS certificate format: %w", err)
+		}
+		keys = jwks.Keys
+	}
+
+	certificateJWK, err := publicKeyJWK(certificate.PublicKey)
+	if err != nil {
+		return err
+	}
+	certificateJKT, err := jws.ComputeJKT(certificateJWK)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if x5c, ok := key["x5c"].([]any); ok && len(x5c) > 0 {
+			if leaf, ok := x5c[0].(string); ok {
+				if der, err := base64.StdEncoding.DecodeString(leaf); err == nil &&
+					subtle.ConstantTimeCompare(der, certificate.Raw) == 1 {
+					return nil
+				}
+			}
+		}
+		if jkt, err := jws.ComputeJKT(key); err == nil && jkt == certificateJKT {
+			return nil
+		}
+	}
+	return errors.New("client certificate does not match any registered key")
+}
+
+// publicKeyJWK returns the JWK members of a certificate public key needed to compute its thumbprint.
+func publicKeyJWK(publicKey any) (map[string]any, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return map[string]any{
+			"kty": "RSA",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		var crv string
+		switch key.Curve {
+		case elliptic.P256():
+			crv = "P-256"
+		case elliptic.P384():
+			crv = "P-384"
+		case elliptic.P521():
+			crv = "P-521"
+		default:
+			return nil, errors.New("unsupported elliptic curve in client certificate")
+		}
+		// The uncompressed point is 0x04 || x || y, with both coordinates padded to the curve size.
+		point, err := key.Bytes()
+		if err != nil {
+			return nil, fmt.Errorf("invalid elliptic curve public key: %w", err)
+		}
+		size := (len(point) - 1) / 2
+		return map[string]any{
+			"kty": "EC",
+			"crv": crv,
+			"x":   base64.RawURLEncoding.EncodeToString(point[1 : 1+size]),
+			"y":   base64.RawURLEncoding.EncodeToString(point[1+size:]),
+		}, nil
+	case ed25519.PublicKey:
+		return map[string]any{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"x":   base64.RawURLEncoding.EncodeToString(key),
+		}, nil
+	default:
+		return nil, errors.New("unsupported public key type in client certificate")
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clientauth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/actorprovider"
+	"github.com/thunder-id/thunderid/internal/cert"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+type MutualTLSClientAuthTestSuite struct {
+	suite.Suite
+	mockInboundClient *inboundclientmock.InboundClientServiceInterfaceMock
+	mockJwtService    *jwtmock.JWTServiceInterfaceMock
+	caCert            *x509.Certificate
+	caKey             *ecdsa.PrivateKey
+	clientCert        *x509.Certificate
+	selfSignedCert    *x509.Certificate
+}
+
+func TestMutualTLSClientAuthTestSuite(t *testing.T) {
+	suite.Run(t, new(MutualTLSClientAuthTestSuite))
+}
+
+func (suite *MutualTLSClientAuthTestSuite) SetupTest() {
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
+	suite.mockJwtService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+
+	suite.caCert, suite.caKey = suite.newCertificate(pkix.Name{CommonName: "Test Client CA"}, nil, nil)
+	suite.clientCert, _ = suite.newCertificate(pkix.Name{
+		CommonName:   "client-1",
+		Organization: []string{"Example Corp"},
+		Country:      []string{"US"},
+	}, suite.caCert, suite.caKey)
+	suite.selfSignedCert, _ = suite.newCertificate(pkix.Name{CommonName: "self-signed"}, nil, nil)
+
+	home := suite.T().TempDir()
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: suite.caCert.Raw})
+	suite.Require().NoError(os.WriteFile(filepath.Join(home, "client-ca.pem"), caPEM, 0o600))
+	cfg := &config.Config{}
+	cfg.TLS.ClientCertificate.TrustedCAFile = "client-ca.pem"
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime(home, cfg))
+}
+
+func (suite *MutualTLSClientAuthTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// newCertificate issues a client certificate signed by parent, or a self-signed CA when parent is nil.
+func (suite *MutualTLSClientAuthTestSuite) newCertificate(subject pkix.Name, parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               subject,
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+		DNSNames:              []string{"client.example.com"},
+		EmailAddresses:        []string{"client@example.com"},
+		IPAddresses:           []net.IP{net.ParseIP("192.0.2.10")},
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	suite.Require().NoError(err)
+	certificate, err := x509.ParseCertificate(der)
+	suite.Require().NoError(err)
+	return certificate, key
+}
+
+func (suite *MutualTLSClientAuthTestSuite) authenticate(oauthApp *providers.OAuthClient,
+	presented *x509.Certificate) (*OAuthClientInfo, *authError) {
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+		Return(oauthApp, nil).Once()
+
+	formData := url.Values{}
+	formData.Set("client_id", testClientID)
+	req, _ := http.NewRequest(http.MethodPost, "/oauth2/token", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_ = req.ParseForm()
+	if presented != nil {
+		req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{presented}}
+	}
+
+	actorProv := actorprovider.Initialize(suite.mockInboundClient,
+		entityprovidermock.NewEntityProviderInterfaceMock(suite.T()), noopAuthnMgr(), nil)
+	return authenticate(req.Context(), req, actorProv, nil, suite.mockJwtService, nil, testIssuer, testLeeway)
+}
+
+func tlsClientAuthApp(subject *providers.TLSClientAuthConfig) *providers.OAuthClient {
+	return &providers.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodTLSClientAuth,
+		TLSClientAuth:           subject,
+	}
+}
+
+func selfSignedApp(certificate *providers.Certificate) *providers.OAuthClient {
+	return &providers.OAuthClient{
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodSelfSignedTLSClientAuth,
+		Certificate:             certificate,
+	}
+}
+
+func (suite *MutualTLSClientAuthTestSuite) jwkFor(certificate *x509.Certificate, withX5C bool) map[string]any {
+	jwk, err := publicKeyJWK(certificate.PublicKey)
+	suite.Require().NoError(err)
+	if withX5C {
+		jwk = map[string]any{"kty": "EC", "x5c": []any{base64.StdEncoding.EncodeToString(certificate.Raw)}}
+	}
+	return jwk
+}
+
+func (suite *MutualTLSClientAuthTestSuite) inlineJWKS(keys ...map[string]any) *providers.Certificate {
+	jwks, err := json.Marshal(map[string]any{"keys": keys})
+	suite.Require().NoError(err)
+	return &providers.Certificate{Type: cert.CertificateTypeJWKS, Value: string(jwks)}
+}
+
+func (suite *MutualTLSClientAuthTestSuite) TestTLSClientAuth_Success() {
+	clientInfo, authErr := suite.authenticate(tlsClientAuthApp(&providers.TLSClientAuthConfig{
+		SubjectDN: "cn=client-1, o=Example Corp, c=US",
+	}), suite.clientCert)
+
+	suite.Nil(authErr)
+	suite.Require().NotNil(clientInfo)
+	suite.Equal(testClientID, clientInfo.ClientID)
+}
+
+func (suite *MutualTLSClientAuthTestSuite) TestTLSClientAuth_SubjectMismatch() {
+	_, authErr := suite.authenticate(tlsClientAuthApp(&providers.TLSClientAuthConfig{
+		SANDNS: "other.example.com",
+	}), suite.clientCert)
+
+	suite.Equal(errInvalidClientCertificate, authErr)
+}
+
+func (suite *MutualTLSClientAuthTestSuite) TestTLSClientAuth_UntrustedIssuer() {
+	// The self-signed certificate carries the registered SAN but does not chain to the trusted CA.
+	_, authErr := suite.authenticate(tlsClientAuthApp(&providers.TLSClientAuthConfig{
+		SANDNS: "client.example.com",
+	}), suite.selfSignedCert)
+
+	suite.Equal(errInvalidClientCertificate, authErr)
+}
+
+func (suite *MutualTLSClientAuthTestSuite) TestTLSClientAuth_NoCertificate() {
+	_, authErr := suite.authenticate(tlsClientAuthApp(&providers.TLSClientAuthConfig{
+		SANDNS: "client.example.com",
+	}), nil)
+
+	suite.Equal(errInvalidClientCertificate, authErr)
+}
+
+func (suite *MutualTLSClientAuthTestSuite) TestSelfSignedTLSClientAuth_InlineJWKS() {
+	testCases := []struct {
+		name    string
+		key     map[string]any
+		wantErr bool
+	}{
+		{"PublicKeyMatch", suite.jwkFor(suite.selfSignedCert, false), false},
+		{"X5CMatch", suite.jwkFor(suite.selfSignedCert, true), false},
+		{"NoMatch", suite.jwkFor(suite.clientCert, false), true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			clientInfo, authErr := suite.authenticate(selfSignedApp(suite.inlineJWKS(tc.key)), suite.selfSignedCert)
+			if tc.wantErr {
+				suite.Equal(errInvalidClientCertificate, authErr)
+				return
+			}
+			suite.Nil(authErr)
+			suite.NotNil(clientInfo)
+		})
+	}
+}
+
+func (suite *MutualTLSClientAuthTestSuite) TestSelfSignedTLSClientAuth_JWKSURI() {
+	jwksURI := "https://client.example.com/jwks"
+	suite.mockJwtService.EXPECT().GetJWKS(mock.Anything, jwksURI).
+		Return([]map[string]interface{}{suite.jwkFor(suite.selfSignedCert, false)}, nil).Once()
+
+	clientInfo, authErr := suite.authenticate(selfSignedApp(
+		&providers.Certificate{Type: cert.CertificateTypeJWKSURI, Value: jwksURI}), suite.selfSignedCert)
+
+	suite.Nil(authErr)
+	suite.NotNil(clientInfo)
+}
+
+func (suite *MutualTLSClientAuthTestSuite) TestSelfSignedTLSClientAuth_JWKSURIFetchFails() {
+	jwksURI := "https://client.example.com/jwks"
+	suite.mockJwtService.EXPECT().GetJWKS(mock.Anything, jwksURI).
+		Return(nil, &tidcommon.ServiceError{Error: tidcommon.I18nMessage{DefaultValue: "fetch failed"}}).Once()
+
+	_, authErr := suite.authenticate(selfSignedApp(
+		&providers.Certificate{Type: cert.CertificateTypeJWKSURI, Value: jwksURI}), suite.selfSignedCert)
+
+	suite.Equal(errInvalidClientCertificate, authErr)
+}
+
+func (suite *MutualTLSClientAuthTestSuite) TestMatchRegisteredSubject() {
+	testCases := []struct {
+		name    string
+		subject *providers.TLSClientAuthConfig
+		wantErr bool
+	}{
+		{"SubjectDN", &providers.TLSClientAuthConfig{SubjectDN: "CN=client-1,O=Example Corp,C=US"}, false},
+		{"SubjectDNDifferentOrder", &providers.TLSClientAuthConfig{SubjectDN: "C=US,O=Example Corp,CN=client-1"},
+			true},
+		{"SANDNS", &providers.TLSClientAuthConfig{SANDNS: "CLIENT.example.com"}, false},
+		{"SANIP", &providers.TLSClientAuthConfig{SANIP: "192.0.2.10"}, false},
+		{"SANIPMismatch", &providers.TLSClientAuthConfig{SANIP: "192.0.2.11"}, true},
+		{"SANEmail", &providers.TLSClientAuthConfig{SANEmail: "client@example.com"}, false},
+		{"SANURIMismatch", &providers.TLSClientAuthConfig{SANURI: "spiffe://example.com/client"}, true},
+		{"NotRegistered", nil, true},
+		{"Empty", &providers.TLSClientAuthConfig{}, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := matchRegisteredSubject(suite.clientCert, tc.subject)
+			if tc.wantErr {
+				suite.Error(err)
+				return
+			}
+			suite.NoError(err)
+		})
+	}
+}
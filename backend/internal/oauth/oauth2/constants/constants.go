@@ -49,6 +49,7 @@ const (
 	RequestParamNonce               string = "nonce"
 	RequestParamPrompt              string = "prompt"
 	RequestParamRequestURI          string = "request_uri"
+	RequestParamRequest             string = "request"
 	RequestParamAcrValues           string = "acr_values"
 	RequestParamMaxAge              string = "max_age"
 	RequestParamDPoPJkt             string = "dpop_jkt"
@@ -59,6 +60,8 @@ const (
 	RequestParamBindingMessage      string = "binding_message"
 	RequestParamRequestedExpiry     string = "requested_expiry"
 	RequestParamAuthReqID           string = "auth_req_id"
+	RequestParamDeviceCode          string = "device_code"
+	RequestParamUserCode            string = "user_code"
 )
 
 // OAuth2 HTTP headers.
@@ -71,9 +74,45 @@ const (
 	ResponseModeQuery string = "query"
 )
 
-// IsSupportedResponseMode checks if the response mode is supported.
+// Rich authorization requests (RFC 9396).
+const (
+	// RequestParamAuthorizationDetails is the parameter carrying the requested authorization details.
+	RequestParamAuthorizationDetails string = "authorization_details"
+	// ErrorInvalidAuthorizationDetails is returned when the authorization details are malformed or
+	// not accepted by the target resource server.
+	ErrorInvalidAuthorizationDetails string = "invalid_authorization_details"
+	// ClaimAuthorizationDetails carries the authorization details approved for a token.
+	ClaimAuthorizationDetails string = "authorization_details"
+)
+
+// JWT-secured authorization response (JARM) parameters.
+const (
+	// RequestParamResponse is the parameter carrying a JWT-secured authorization response.
+	RequestParamResponse string = "response"
+	// JARMDefaultValiditySeconds is the lifetime in seconds of a JWT-secured authorization response.
+	JARMDefaultValiditySeconds int64 = 60
+)
+
+// Signed authorization request object (JAR) constants.
+const (
+	// RequestObjectMaxLifetimeSeconds bounds both the lifetime of a request object (exp - nbf) and
+	// how far in the past its nbf may lie, per the FAPI 2.0 Message Signing profile.
+	RequestObjectMaxLifetimeSeconds int64 = 3600
+)
+
+// IsSupportedResponseMode checks if the response mode is supported. An empty response mode
+// resolves to the default mode of the response type and is always supported.
 func IsSupportedResponseMode(responseMode string) bool {
-	return responseMode == "" || responseMode == ResponseModeQuery
+	return responseMode == "" || providers.ResponseMode(responseMode).IsValid()
+}
+
+// GetSupportedResponseModes returns all supported OAuth2 response modes.
+func GetSupportedResponseModes() []string {
+	result := make([]string, len(providers.SupportedResponseModes))
+	for i, rm := range providers.SupportedResponseModes {
+		result[i] = string(rm)
+	}
+	return result
 }
 
 // OIDC prompt parameter values.
@@ -116,6 +155,7 @@ const (
 const (
 	OAuth2TokenEndpoint                   string = "/oauth2/token" // #nosec G101
 	OAuth2AuthorizationEndpoint           string = "/oauth2/authorize"
+	OAuth2AuthorizationResponseEndpoint   string = "/oauth2/authorize/response"
 	OAuth2IntrospectionEndpoint           string = "/oauth2/introspect"
 	OAuth2RevokeEndpoint                  string = "/oauth2/revoke"
 	OAuth2UserInfoEndpoint                string = "/oauth2/userinfo"
@@ -125,6 +165,8 @@ const (
 	OAuth2PAREndpoint                     string = "/oauth2/par"
 	OAuth2BackchannelAuthEndpoint         string = "/oauth2/bc-authorize"
 	OAuth2BackchannelAuthCallbackEndpoint string = "/oauth2/bc-authorize/callback"
+	OAuth2DeviceAuthorizationEndpoint     string = "/oauth2/device_authorization"
+	OAuth2DeviceVerificationEndpoint      string = "/oauth2/device"
 )
 
 // OAuth2 token types.
@@ -196,6 +238,8 @@ const (
 	ErrorExpiredToken             string = "expired_token" // #nosec G101
 	ErrorUnknownUserID            string = "unknown_user_id"
 	ErrorInvalidBindingMessage    string = "invalid_binding_message"
+	ErrorInvalidRequestObject     string = "invalid_request_object"
+	ErrorInvalidRequestURI        string = "invalid_request_uri"
 )
 
 // UnSupportedGrantTypeError is returned when an unsupported grant type is requested.
@@ -278,6 +322,13 @@ const (
 	// family at once. Revocation-only and not a client-managed identifier: it rides the token JWTs
 	// but is not part of any client-facing API.
 	ClaimTokenFamilyID string = "tfid"
+	// ClaimConsentID identifies the consent record a token's grant was issued under. Like tfid it is
+	// revocation-only: withdrawing the consent revokes every token carrying it.
+	ClaimConsentID string = "cnid"
+	// ClaimSessionID identifies the SSO session that authenticated the subject (OIDC Front-Channel and
+	// Back-Channel Logout). It is stamped on ID tokens and logout tokens so a relying party can match a
+	// logout notification to its local session.
+	ClaimSessionID string = "sid"
 )
 
 // SurfaceableClientSystemClaims is the fixed set of entity system-attribute keys that may be
@@ -289,7 +340,8 @@ var SurfaceableClientSystemClaims = map[string]bool{
 
 // OIDC subject types.
 const (
-	SubjectTypePublic string = "public"
+	SubjectTypePublic   string = "public"
+	SubjectTypePairwise string = "pairwise"
 )
 
 // Token-exchange token family modes (oauth.token_exchange.token_family).
@@ -335,6 +387,16 @@ const (
 	CIBAMaxExpiresInSeconds = 600
 )
 
+const (
+	// DeviceCodeDefaultExpiresInSeconds is the default lifetime in seconds of a device authorization request.
+	DeviceCodeDefaultExpiresInSeconds = 600
+	// DeviceCodeDefaultIntervalSeconds is the default minimum interval in seconds between device token polls.
+	DeviceCodeDefaultIntervalSeconds = 5
+	// DeviceCodeSlowDownIncrementSeconds is the number of seconds added to the polling interval on each
+	// slow_down response (RFC 8628 §3.5).
+	DeviceCodeSlowDownIncrementSeconds = 5
+)
+
 // GetSupportedResponseTypes returns all supported OAuth2 response types.
 func GetSupportedResponseTypes(oauthConfig oauthconfig.Config) []string {
 	allowedResponseTypes := oauthConfig.OAuth.AllowedResponseTypes
@@ -376,7 +438,7 @@ func GetSupportedTokenEndpointAuthMethods(oauthConfig oauthconfig.Config) []stri
 
 // GetSupportedSubjectTypes returns all supported OIDC subject types.
 func GetSupportedSubjectTypes() []string {
-	return []string{SubjectTypePublic}
+	return []string{SubjectTypePublic, SubjectTypePairwise}
 }
 
 // GetStandardClaims returns all standard JWT claims that are always included in tokens.
@@ -84,6 +84,21 @@ var (
 		},
 	}
 
+	// ErrorInvalidSectorIdentifierURI is the error returned when the sector_identifier_uri cannot be
+	// fetched or does not list every registered redirect URI
+	ErrorInvalidSectorIdentifierURI = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "invalid_client_metadata",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.dcr.invalid_sector_identifier_uri",
+			DefaultValue: "Invalid sector identifier URI",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.dcr.invalid_sector_identifier_uri_description",
+			DefaultValue: "The sector_identifier_uri must return a JSON array containing every redirect URI",
+		},
+	}
+
 	// ErrorServerError is the standard error for server issues
 	ErrorServerError = tidcommon.ServiceError{
 		Type: tidcommon.ServerErrorType,
@@ -13,6 +13,7 @@ import (
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -35,7 +36,10 @@ func Initialize(
 			"Failed to initialize DCR service", log.Error(wrappedErr))
 		return wrappedErr
 	}
-	dcrService := newDCRService(appService, ouService, i18nService, transactioner)
+	httpClient := syshttp.NewHTTPClientWithCheckRedirect(func(req *http.Request, _ []*http.Request) error {
+		return syshttp.IsSSRFSafeURL(req.URL.String())
+	})
+	dcrService := newDCRService(appService, ouService, i18nService, transactioner, httpClient)
 	dcrHandler := newDCRHandler(dcrService, cfg)
 	registerRoutes(mux, dcrHandler)
 	return nil
@@ -35,13 +35,27 @@ type DCRRegistrationRequest struct {
 	TosURI                  string                            `json:"tos_uri,omitempty"`
 	PolicyURI               string                            `json:"policy_uri,omitempty"`
 
-	RequirePushedAuthorizationRequests bool   `json:"require_pushed_authorization_requests,omitempty"`
-	DPoPBoundAccessTokens              bool   `json:"dpop_bound_access_tokens,omitempty"`
-	UserInfoSignedResponseAlg          string `json:"userinfo_signed_response_alg,omitempty"`
-	UserInfoEncryptedResponseAlg       string `json:"userinfo_encrypted_response_alg,omitempty"`
-	UserInfoEncryptedResponseEnc       string `json:"userinfo_encrypted_response_enc,omitempty"`
-	IDTokenEncryptedResponseAlg        string `json:"id_token_encrypted_response_alg,omitempty"`
-	IDTokenEncryptedResponseEnc        string `json:"id_token_encrypted_response_enc,omitempty"`
+	RequirePushedAuthorizationRequests bool     `json:"require_pushed_authorization_requests,omitempty"`
+	RequireSignedRequestObject         bool     `json:"require_signed_request_object,omitempty"`
+	RequestURIs                        []string `json:"request_uris,omitempty"`
+	BackchannelLogoutURI               string   `json:"backchannel_logout_uri,omitempty"`
+	BackchannelLogoutSessionRequired   bool     `json:"backchannel_logout_session_required,omitempty"`
+	FrontchannelLogoutURI              string   `json:"frontchannel_logout_uri,omitempty"`
+	FrontchannelLogoutSessionRequired  bool     `json:"frontchannel_logout_session_required,omitempty"`
+	DPoPBoundAccessTokens              bool     `json:"dpop_bound_access_tokens,omitempty"`
+	TLSClientAuthSubjectDN             string   `json:"tls_client_auth_subject_dn,omitempty"`
+	TLSClientAuthSANDNS                string   `json:"tls_client_auth_san_dns,omitempty"`
+	TLSClientAuthSANURI                string   `json:"tls_client_auth_san_uri,omitempty"`
+	TLSClientAuthSANIP                 string   `json:"tls_client_auth_san_ip,omitempty"`
+	TLSClientAuthSANEmail              string   `json:"tls_client_auth_san_email,omitempty"`
+	MTLSBoundAccessTokens              bool     `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+	SubjectType                        string   `json:"subject_type,omitempty"`
+	SectorIdentifierURI                string   `json:"sector_identifier_uri,omitempty"`
+	UserInfoSignedResponseAlg          string   `json:"userinfo_signed_response_alg,omitempty"`
+	UserInfoEncryptedResponseAlg       string   `json:"userinfo_encrypted_response_alg,omitempty"`
+	UserInfoEncryptedResponseEnc       string   `json:"userinfo_encrypted_response_enc,omitempty"`
+	IDTokenEncryptedResponseAlg        string   `json:"id_token_encrypted_response_alg,omitempty"`
+	IDTokenEncryptedResponseEnc        string   `json:"id_token_encrypted_response_enc,omitempty"`
 	// Localized variant maps — populated from #-keyed JSON fields (e.g. "client_name#fr").
 	LocalizedClientName map[string]string `json:"-"`
 	LocalizedLogoURI    map[string]string `json:"-"`
@@ -133,13 +147,27 @@ type DCRRegistrationResponse struct {
 	PolicyURI               string                            `json:"policy_uri,omitempty"`
 	AppID                   string                            `json:"app_id,omitempty"`
 
-	RequirePushedAuthorizationRequests bool   `json:"require_pushed_authorization_requests,omitempty"`
-	DPoPBoundAccessTokens              bool   `json:"dpop_bound_access_tokens,omitempty"`
-	UserInfoSignedResponseAlg          string `json:"userinfo_signed_response_alg,omitempty"`
-	UserInfoEncryptedResponseAlg       string `json:"userinfo_encrypted_response_alg,omitempty"`
-	UserInfoEncryptedResponseEnc       string `json:"userinfo_encrypted_response_enc,omitempty"`
-	IDTokenEncryptedResponseAlg        string `json:"id_token_encrypted_response_alg,omitempty"`
-	IDTokenEncryptedResponseEnc        string `json:"id_token_encrypted_response_enc,omitempty"`
+	RequirePushedAuthorizationRequests bool     `json:"require_pushed_authorization_requests,omitempty"`
+	RequireSignedRequestObject         bool     `json:"require_signed_request_object,omitempty"`
+	RequestURIs                        []string `json:"request_uris,omitempty"`
+	BackchannelLogoutURI               string   `json:"backchannel_logout_uri,omitempty"`
+	BackchannelLogoutSessionRequired   bool     `json:"backchannel_logout_session_required,omitempty"`
+	FrontchannelLogoutURI              string   `json:"frontchannel_logout_uri,omitempty"`
+	FrontchannelLogoutSessionRequired  bool     `json:"frontchannel_logout_session_required,omitempty"`
+	DPoPBoundAccessTokens              bool     `json:"dpop_bound_access_tokens,omitempty"`
+	TLSClientAuthSubjectDN             string   `json:"tls_client_auth_subject_dn,omitempty"`
+	TLSClientAuthSANDNS                string   `json:"tls_client_auth_san_dns,omitempty"`
+	TLSClientAuthSANURI                string   `json:"tls_client_auth_san_uri,omitempty"`
+	TLSClientAuthSANIP                 string   `json:"tls_client_auth_san_ip,omitempty"`
+	TLSClientAuthSANEmail              string   `json:"tls_client_auth_san_email,omitempty"`
+	MTLSBoundAccessTokens              bool     `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+	SubjectType                        string   `json:"subject_type,omitempty"`
+	SectorIdentifierURI                string   `json:"sector_identifier_uri,omitempty"`
+	UserInfoSignedResponseAlg          string   `json:"userinfo_signed_response_alg,omitempty"`
+	UserInfoEncryptedResponseAlg       string   `json:"userinfo_encrypted_response_alg,omitempty"`
+	UserInfoEncryptedResponseEnc       string   `json:"userinfo_encrypted_response_enc,omitempty"`
+	IDTokenEncryptedResponseAlg        string   `json:"id_token_encrypted_response_alg,omitempty"`
+	IDTokenEncryptedResponseEnc        string   `json:"id_token_encrypted_response_enc,omitempty"`
 	// Localized variant maps — injected as #-keyed top-level fields during serialization.
 	LocalizedClientName map[string]string `json:"-"`
 	LocalizedLogoURI    map[string]string `json:"-"`
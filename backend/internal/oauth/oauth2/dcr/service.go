@@ -7,6 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,11 +22,15 @@ import (
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	oauthutils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/ou"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
+// maxSectorIdentifierBytes caps the size of a sector_identifier_uri document.
+const maxSectorIdentifierBytes = 64 * 1024
+
 // DCRServiceInterface defines the interface for the DCR service.
 type DCRServiceInterface interface {
 	RegisterClient(
@@ -37,6 +44,7 @@ type dcrService struct {
 	ouService     ou.OrganizationUnitServiceInterface
 	i18nService   i18nmgt.I18nServiceInterface
 	transactioner providers.Transactioner
+	httpClient    syshttp.HTTPClientInterface
 }
 
 // newDCRService creates a new instance of dcrService.
@@ -45,12 +53,14 @@ func newDCRService(
 	ouService ou.OrganizationUnitServiceInterface,
 	i18nService i18nmgt.I18nServiceInterface,
 	transactioner providers.Transactioner,
+	httpClient syshttp.HTTPClientInterface,
 ) DCRServiceInterface {
 	return &dcrService{
 		appService:    appService,
 		ouService:     ouService,
 		i18nService:   i18nService,
 		transactioner: transactioner,
+		httpClient:    httpClient,
 	}
 }
 
@@ -72,6 +82,12 @@ func (ds *dcrService) RegisterClient(ctx context.Context, request *DCRRegistrati
 			return nil, &ErrorInvalidClientMetadata
 		}
 	}
+	if request.SectorIdentifierURI != "" {
+		if svcErr := ds.validateSectorIdentifierURI(ctx, request.SectorIdentifierURI,
+			request.RedirectURIs); svcErr != nil {
+			return nil, svcErr
+		}
+	}
 
 	// TODO: Revisit OU for DCR apps
 	if request.OUID == "" {
@@ -234,7 +250,17 @@ func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest) (
 		PublicClient:                       isPublicClient,
 		PKCERequired:                       isPublicClient,
 		RequirePushedAuthorizationRequests: request.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         request.RequireSignedRequestObject,
+		RequestURIs:                        request.RequestURIs,
+		BackchannelLogoutURI:               request.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   request.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              request.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  request.FrontchannelLogoutSessionRequired,
 		DPoPBoundAccessTokens:              request.DPoPBoundAccessTokens,
+		TLSClientAuth:                      buildTLSClientAuthConfig(request),
+		MTLSBoundAccessTokens:              request.MTLSBoundAccessTokens,
+		SubjectType:                        providers.SubjectType(request.SubjectType),
+		SectorIdentifierURI:                request.SectorIdentifierURI,
 		Scopes:                             scopes,
 		UserInfo:                           buildUserInfoConfig(request),
 		Token:                              buildTokenConfig(request),
@@ -294,6 +320,23 @@ func buildUserInfoConfig(request *DCRRegistrationRequest) *providers.UserInfoCon
 	}
 }
 
+// buildTLSClientAuthConfig maps the tls_client_auth certificate subject fields (RFC 8705 §2.1.2) from a
+// DCR request to a TLSClientAuthConfig.
+func buildTLSClientAuthConfig(request *DCRRegistrationRequest) *providers.TLSClientAuthConfig {
+	if request.TLSClientAuthSubjectDN == "" && request.TLSClientAuthSANDNS == "" &&
+		request.TLSClientAuthSANURI == "" && request.TLSClientAuthSANIP == "" &&
+		request.TLSClientAuthSANEmail == "" {
+		return nil
+	}
+	return &providers.TLSClientAuthConfig{
+		SubjectDN: request.TLSClientAuthSubjectDN,
+		SANDNS:    request.TLSClientAuthSANDNS,
+		SANURI:    request.TLSClientAuthSANURI,
+		SANIP:     request.TLSClientAuthSANIP,
+		SANEmail:  request.TLSClientAuthSANEmail,
+	}
+}
+
 // buildTokenConfig builds the OAuthTokenConfig from DCR request fields.
 func buildTokenConfig(request *DCRRegistrationRequest) *providers.OAuthTokenConfig {
 	idToken := buildIDTokenConfig(request)
@@ -377,13 +420,29 @@ func (ds *dcrService) convertApplicationToDCRResponse(appDTO *model.ApplicationD
 		Contacts:                           appDTO.Contacts,
 		AppID:                              appDTO.ID,
 		RequirePushedAuthorizationRequests: oauthConfig.RequirePushedAuthorizationRequests,
+		RequireSignedRequestObject:         oauthConfig.RequireSignedRequestObject,
+		RequestURIs:                        oauthConfig.RequestURIs,
+		BackchannelLogoutURI:               oauthConfig.BackchannelLogoutURI,
+		BackchannelLogoutSessionRequired:   oauthConfig.BackchannelLogoutSessionRequired,
+		FrontchannelLogoutURI:              oauthConfig.FrontchannelLogoutURI,
+		FrontchannelLogoutSessionRequired:  oauthConfig.FrontchannelLogoutSessionRequired,
 		DPoPBoundAccessTokens:              oauthConfig.DPoPBoundAccessTokens,
+		MTLSBoundAccessTokens:              oauthConfig.MTLSBoundAccessTokens,
+		SubjectType:                        string(oauthConfig.SubjectType),
+		SectorIdentifierURI:                oauthConfig.SectorIdentifierURI,
 		UserInfoSignedResponseAlg:          userInfoSignedAlg,
 		UserInfoEncryptedResponseAlg:       userInfoEncryptedAlg,
 		UserInfoEncryptedResponseEnc:       userInfoEncryptedEnc,
 		IDTokenEncryptedResponseAlg:        idTokenEncryptedAlg,
 		IDTokenEncryptedResponseEnc:        idTokenEncryptedEnc,
 	}
+	if tlsClientAuth := oauthConfig.TLSClientAuth; tlsClientAuth != nil {
+		response.TLSClientAuthSubjectDN = tlsClientAuth.SubjectDN
+		response.TLSClientAuthSANDNS = tlsClientAuth.SANDNS
+		response.TLSClientAuthSANURI = tlsClientAuth.SANURI
+		response.TLSClientAuthSANIP = tlsClientAuth.SANIP
+		response.TLSClientAuthSANEmail = tlsClientAuth.SANEmail
+	}
 
 	return response, nil
 }
@@ -455,6 +514,59 @@ func (ds *dcrService) writeLocalizedVariants(
 	return nil
 }
 
+// validateSectorIdentifierURI fetches the sector_identifier_uri and checks that it lists every
+// redirect URI the client registers, as required by OIDC Core §8.1. The URI must be a publicly
+// reachable HTTPS URL and the document is capped at maxSectorIdentifierBytes.
+func (ds *dcrService) validateSectorIdentifierURI(ctx context.Context, sectorIdentifierURI string,
+	redirectURIs []string) *tidcommon.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService"))
+
+	parsed, err := sysutils.ParseURL(sectorIdentifierURI)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return &ErrorInvalidSectorIdentifierURI
+	}
+	if ds.httpClient == nil {
+		logger.Error(ctx, "HTTP client is not configured for sector identifier validation")
+		return &ErrorServerError
+	}
+	if err := syshttp.IsSSRFSafeURL(sectorIdentifierURI); err != nil {
+		logger.Debug(ctx, "sector_identifier_uri is not SSRF-safe", log.Error(err))
+		return &ErrorInvalidSectorIdentifierURI
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sectorIdentifierURI, nil)
+	if err != nil {
+		return &ErrorInvalidSectorIdentifierURI
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := ds.httpClient.Do(req)
+	if err != nil {
+		logger.Debug(ctx, "Failed to fetch the sector_identifier_uri", log.Error(err))
+		return &ErrorInvalidSectorIdentifierURI
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		logger.Debug(ctx, "sector_identifier_uri returned non-200 status", log.Int("statusCode", resp.StatusCode))
+		return &ErrorInvalidSectorIdentifierURI
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSectorIdentifierBytes+1))
+	if err != nil || len(body) > maxSectorIdentifierBytes {
+		return &ErrorInvalidSectorIdentifierURI
+	}
+
+	var listed []string
+	if err := json.Unmarshal(body, &listed); err != nil {
+		logger.Debug(ctx, "sector_identifier_uri did not return a JSON array of strings")
+		return &ErrorInvalidSectorIdentifierURI
+	}
+	for _, redirectURI := range redirectURIs {
+		if !slices.Contains(listed, redirectURI) {
+			return &ErrorInvalidSectorIdentifierURI
+		}
+	}
+	return nil
+}
+
 // mapApplicationErrorToDCRError maps Application service errors to DCR standard errors.
 func (ds *dcrService) mapApplicationErrorToDCRError(
 	appErr *tidcommon.ServiceError) *tidcommon.ServiceError {
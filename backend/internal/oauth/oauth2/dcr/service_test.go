@@ -5,6 +5,10 @@ package dcr
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
@@ -19,6 +23,7 @@ import (
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/tests/mocks/applicationmock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
 	i18nmock "github.com/thunder-id/thunderid/tests/mocks/i18n/mgtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
 )
@@ -45,12 +50,12 @@ func (m *MockTransactioner) Transact(ctx context.Context, txFunc func(context.Co
 func (s *DCRServiceTestSuite) SetupTest() {
 	s.mockAppService = applicationmock.NewApplicationServiceInterfaceMock(s.T())
 	s.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(s.T())
-	s.service = newDCRService(s.mockAppService, s.mockOUService, nil, &MockTransactioner{})
+	s.service = newDCRService(s.mockAppService, s.mockOUService, nil, &MockTransactioner{}, nil)
 }
 
 // TestNewDCRService tests the service constructor
 func (s *DCRServiceTestSuite) TestNewDCRService() {
-	service := newDCRService(s.mockAppService, s.mockOUService, nil, &MockTransactioner{})
+	service := newDCRService(s.mockAppService, s.mockOUService, nil, &MockTransactioner{}, nil)
 	s.NotNil(service)
 	s.Implements((*DCRServiceInterface)(nil), service)
 }
@@ -156,6 +161,97 @@ func (s *DCRServiceTestSuite) TestRegisterClient_JWKSUriProvided() {
 	s.Equal("https://client.example.com/.well-known/jwks.json", response.JWKSUri)
 }
 
+// TestRegisterClient_SectorIdentifierURI tests sector_identifier_uri validation
+func (s *DCRServiceTestSuite) TestRegisterClient_SectorIdentifierURI() {
+	const sectorURI = "https://client.example.com/sector.json"
+	redirectURIs := []string{"https://a.example.com/callback", "https://b.example.com/callback"}
+
+	testCases := []struct {
+		name       string
+		sectorURI  string
+		status     int
+		body       string
+		fetchErr   error
+		expectCall bool
+		wantErr    bool
+	}{
+		{"AllRedirectURIsListed", sectorURI, http.StatusOK,
+			`["https://a.example.com/callback","https://b.example.com/callback","https://c.example.com/cb"]`,
+			nil, true, false},
+		{"RedirectURIMissing", sectorURI, http.StatusOK, `["https://a.example.com/callback"]`, nil, true, true},
+		{"NotAJSONArray", sectorURI, http.StatusOK, `{"redirect_uris":[]}`, nil, true, true},
+		{"NonOKStatus", sectorURI, http.StatusNotFound, "", nil, true, true},
+		{"FetchFails", sectorURI, 0, "", errors.New("connection refused"), true, true},
+		{"NotHTTPS", "http://client.example.com/sector.json", 0, "", nil, false, true},
+		{"PrivateAddress", "https://127.0.0.1/sector.json", 0, "", nil, false, true},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			httpClient := httpmock.NewHTTPClientInterfaceMock(s.T())
+			service := newDCRService(s.mockAppService, s.mockOUService, nil, &MockTransactioner{}, httpClient)
+			if tc.expectCall {
+				call := httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+					return req.URL.String() == tc.sectorURI
+				}))
+				if tc.fetchErr != nil {
+					call.Return(nil, tc.fetchErr).Once()
+				} else {
+					call.Return(&http.Response{StatusCode: tc.status,
+						Body: io.NopCloser(strings.NewReader(tc.body))}, nil).Once()
+				}
+			}
+
+			svcErr := service.(*dcrService).validateSectorIdentifierURI(context.Background(), tc.sectorURI,
+				redirectURIs)
+
+			if tc.wantErr {
+				s.Equal(&ErrorInvalidSectorIdentifierURI, svcErr)
+				return
+			}
+			s.Nil(svcErr)
+		})
+	}
+}
+
+// TestRegisterClient_PairwiseSubjectType tests that subject_type and sector_identifier_uri round-trip
+func (s *DCRServiceTestSuite) TestRegisterClient_PairwiseSubjectType() {
+	const sectorURI = "https://client.example.com/sector.json"
+	httpClient := httpmock.NewHTTPClientInterfaceMock(s.T())
+	service := newDCRService(s.mockAppService, s.mockOUService, nil, &MockTransactioner{}, httpClient)
+	request := &DCRRegistrationRequest{
+		OUID:                "test-ou-1",
+		RedirectURIs:        []string{"https://client.example.com/callback"},
+		GrantTypes:          []providers.GrantType{providers.GrantTypeAuthorizationCode},
+		SubjectType:         "pairwise",
+		SectorIdentifierURI: sectorURI,
+	}
+
+	httpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: http.StatusOK,
+		Body: io.NopCloser(strings.NewReader(`["https://client.example.com/callback"]`))}, nil).Once()
+	s.mockAppService.On("CreateApplication", mock.Anything, mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+		oauthConfig := dto.InboundAuthConfig[0].OAuthConfig
+		return oauthConfig.SubjectType == providers.SubjectTypePairwise &&
+			oauthConfig.SectorIdentifierURI == sectorURI
+	})).Return(&model.ApplicationDTO{
+		ID: "app-id",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{{
+			Type: providers.OAuthInboundAuthType,
+			OAuthConfig: &providers.OAuthConfigWithSecret{
+				ClientID:            "client-id",
+				SubjectType:         providers.SubjectTypePairwise,
+				SectorIdentifierURI: sectorURI,
+			},
+		}},
+	}, (*tidcommon.ServiceError)(nil))
+
+	response, svcErr := service.RegisterClient(context.Background(), request)
+
+	s.Nil(svcErr)
+	s.Require().NotNil(response)
+	s.Equal("pairwise", response.SubjectType)
+	s.Equal(sectorURI, response.SectorIdentifierURI)
+}
+
 // TestRegisterClient_ApplicationServiceError tests application service error handling
 func (s *DCRServiceTestSuite) TestRegisterClient_ApplicationServiceError() {
 	request := &DCRRegistrationRequest{
@@ -442,7 +538,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_EmptyInboundAuthConfig() {
 // and that the non-tagged default is stored under SystemLanguage.
 func (s *DCRServiceTestSuite) TestRegisterClient_WithLocalizedVariants() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{}, nil)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -497,7 +593,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_WithLocalizedVariants() {
 // client_name is provided (no localized variants), it is stored under SystemLanguage.
 func (s *DCRServiceTestSuite) TestRegisterClient_DefaultOnlyStoresSystemLanguage() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{}, nil)
 
 	request := &DCRRegistrationRequest{
 		OUID:       "test-ou-1",
@@ -542,7 +638,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_DefaultOnlyStoresSystemLanguage
 // default and an explicit #SystemLanguage-tagged variant are provided, the tagged variant wins.
 func (s *DCRServiceTestSuite) TestRegisterClient_TaggedSystemLanguageWinsOverDefault() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{}, nil)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -590,7 +686,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_TaggedSystemLanguageWinsOverDef
 // partial-row cleanup and app compensation delete.
 func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{}, nil)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -641,7 +737,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure()
 // validation must return ErrorInvalidClientMetadata and trigger the compensation rollback.
 func (s *DCRServiceTestSuite) TestRegisterClient_InvalidLocalizedURI() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{}, nil)
 
 	request := &DCRRegistrationRequest{
 		OUID:             "test-ou-1",
@@ -703,6 +799,16 @@ func (s *DCRServiceTestSuite) TestBuildIDTokenConfig_MapsAlgAndEnc() {
 	s.Equal("A256GCM", cfg.EncryptionEnc)
 }
 
+// TestBuildTLSClientAuthConfig verifies that buildTLSClientAuthConfig returns nil when no subject field is
+// set and maps the tls_client_auth subject fields otherwise.
+func (s *DCRServiceTestSuite) TestBuildTLSClientAuthConfig() {
+	s.Nil(buildTLSClientAuthConfig(&DCRRegistrationRequest{}))
+
+	cfg := buildTLSClientAuthConfig(&DCRRegistrationRequest{TLSClientAuthSANDNS: "client.example.com"})
+	s.Require().NotNil(cfg)
+	s.Equal(providers.TLSClientAuthConfig{SANDNS: "client.example.com"}, *cfg)
+}
+
 // TestRegisterClient_WithIDTokenEncryption verifies that DCR registration round-trips
 // IDTokenEncryptedResponseAlg and IDTokenEncryptedResponseEnc correctly.
 func (s *DCRServiceTestSuite) TestRegisterClient_WithIDTokenEncryption() {
@@ -762,7 +868,7 @@ func (s *DCRServiceTestSuite) TestRegisterClient_WithIDTokenEncryption() {
 // i18n error maps to ErrorServerError to avoid leaking internal details to external callers.
 func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure_ClientError() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{}, nil)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
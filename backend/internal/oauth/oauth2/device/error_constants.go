@@ -0,0 +1,12 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import "errors"
+
+// ErrDeviceRequestNotFound is returned when a device authorization request is not found in the store.
+var ErrDeviceRequestNotFound = errors.New("device authorization request not found")
+
+// errUserCodeInUse is returned when a generated user code is already held by another pending request.
+var errUserCodeInUse = errors.New("user code is already in use")
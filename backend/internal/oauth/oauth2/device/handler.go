@@ -0,0 +1,146 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// DeviceHandlerInterface defines the interface for handling device authorization grant requests.
+type DeviceHandlerInterface interface {
+	HandleDeviceAuthorizationRequest(w http.ResponseWriter, r *http.Request)
+	HandleVerificationRequest(w http.ResponseWriter, r *http.Request)
+}
+
+// deviceHandler implements the DeviceHandlerInterface.
+type deviceHandler struct {
+	cfg           oauthconfig.Config
+	deviceService DeviceServiceInterface
+	logger        *log.Logger
+}
+
+// newDeviceHandler creates a new instance of deviceHandler.
+func newDeviceHandler(deviceService DeviceServiceInterface, cfg oauthconfig.Config) DeviceHandlerInterface {
+	return &deviceHandler{
+		cfg:           cfg,
+		deviceService: deviceService,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeviceHandler")),
+	}
+}
+
+// HandleDeviceAuthorizationRequest handles a POST /oauth2/device_authorization request.
+func (h *deviceHandler) HandleDeviceAuthorizationRequest(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		utils.WriteJSONError(r.Context(), w, oauth2const.ErrorInvalidRequest, "Failed to parse request body",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	// Get authenticated client from context (set by ClientAuthMiddleware).
+	clientInfo := clientauth.GetOAuthClient(r.Context())
+	if clientInfo == nil {
+		h.logger.Error(r.Context(),
+			"OAuth client not found in context - ClientAuthMiddleware must be applied")
+		utils.WriteJSONError(r.Context(), w, oauth2const.ErrorServerError, "Something went wrong",
+			http.StatusInternalServerError, nil)
+		return
+	}
+
+	request := &DeviceAuthorizationRequest{
+		Scope:     r.FormValue(oauth2const.RequestParamScope),
+		Resources: r.Form[oauth2const.RequestParamResource],
+	}
+
+	response, deviceErr := h.deviceService.InitiateDeviceAuthorization(r.Context(), request, clientInfo.OAuthApp)
+	if deviceErr != nil {
+		writeDeviceError(r.Context(), w, deviceErr)
+		return
+	}
+
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoStore)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+	utils.WriteSuccessResponse(r.Context(), w, http.StatusOK, response)
+}
+
+// HandleVerificationRequest handles a request to the verification URI (/oauth2/device). Without a
+// user_code the user is sent to the Gate device page to enter one; with a user_code the authentication
+// flow for the matching request is started and the user is sent to the Gate login page.
+func (h *deviceHandler) HandleVerificationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := r.ParseForm(); err != nil {
+		h.redirectToDevicePage(w, r, map[string]string{
+			"errorCode":    oauth2const.ErrorInvalidRequest,
+			"errorMessage": "Failed to parse request",
+		})
+		return
+	}
+
+	userCode := r.FormValue(oauth2const.RequestParamUserCode)
+	if userCode == "" {
+		h.redirectToDevicePage(w, r, nil)
+		return
+	}
+
+	queryParams, deviceErr := h.deviceService.InitiateVerification(ctx, &VerificationRequest{
+		UserCode:    userCode,
+		Headers:     utils.SanitizeRawMultiValueStringMap(r.Header),
+		QueryParams: utils.SanitizeRawMultiValueStringMap(r.URL.Query()),
+	})
+	if deviceErr != nil {
+		h.redirectToDevicePage(w, r, map[string]string{
+			"errorCode":    deviceErr.Code,
+			"errorMessage": deviceErr.Message,
+		})
+		return
+	}
+
+	redirectURI, err := oauth2utils.GetURIWithQueryParams(h.gatePageURL(h.cfg.GateClient.LoginPath), queryParams)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to construct login page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to login page", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, redirectURI, http.StatusFound)
+}
+
+// redirectToDevicePage redirects the user agent to the Gate device page with the given query parameters.
+func (h *deviceHandler) redirectToDevicePage(w http.ResponseWriter, r *http.Request,
+	queryParams map[string]string) {
+	redirectURI, err := oauth2utils.GetURIWithQueryParams(h.gatePageURL(h.cfg.GateClient.DevicePath), queryParams)
+	if err != nil {
+		h.logger.Error(r.Context(), "Failed to construct device page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to device page", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, redirectURI, http.StatusFound)
+}
+
+// gatePageURL builds the absolute URL of a Gate page from its configured path.
+func (h *deviceHandler) gatePageURL(path string) string {
+	return (&url.URL{
+		Scheme: h.cfg.GateClient.Scheme,
+		Host:   fmt.Sprintf("%s:%d", h.cfg.GateClient.Hostname, h.cfg.GateClient.Port),
+		Path:   path,
+	}).String()
+}
+
+// writeDeviceError maps a DeviceError to the appropriate HTTP status code and writes the JSON response.
+func writeDeviceError(ctx context.Context, w http.ResponseWriter, deviceErr *DeviceError) {
+	statusCode := http.StatusBadRequest
+	if deviceErr.Code == oauth2const.ErrorServerError {
+		statusCode = http.StatusInternalServerError
+	}
+	utils.WriteJSONError(ctx, w, deviceErr.Code, deviceErr.Message, statusCode, nil)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package device implements the OAuth 2.0 Device Authorization Grant (RFC 8628).
+package device
+
+import (
+	"time"
+)
+
+// DeviceRequestState represents the lifecycle state of a device authorization request.
+type DeviceRequestState string
+
+const (
+	// DeviceStatePending indicates the user has not yet completed verification.
+	DeviceStatePending DeviceRequestState = "PENDING"
+	// DeviceStateAuthenticated indicates the user has authenticated and tokens may be issued.
+	DeviceStateAuthenticated DeviceRequestState = "AUTHENTICATED"
+	// DeviceStateConsumed indicates the request has already been exchanged for tokens.
+	DeviceStateConsumed DeviceRequestState = "CONSUMED"
+	// DeviceStateDenied indicates the user denied the authorization request.
+	DeviceStateDenied DeviceRequestState = "DENIED"
+	// DeviceStateFailed indicates the verification flow terminated due to a server-side error.
+	DeviceStateFailed DeviceRequestState = "FAILED"
+	// DeviceStateExpired indicates the request expired before completion.
+	DeviceStateExpired DeviceRequestState = "EXPIRED"
+)
+
+// DeviceAuthRequest represents a persisted device authorization request.
+// UserID is empty at creation and populated by MarkAuthenticated once the user completes
+// verification and the callback verifies the assertion. RequestedPermissions holds the permission
+// scopes bound at initiation; they are only handed to the flow once the user opens the verification
+// URI, since that is when the authentication flow is started.
+type DeviceAuthRequest struct {
+	DeviceCode           string
+	UserCode             string
+	ClientID             string
+	UserID               string
+	StandardScopes       string
+	RequestedPermissions string
+	AuthorizedScopes     string
+	Resources            []string
+	State                DeviceRequestState
+	AttributeCacheID     string
+	CompletedACR         string
+	Interval             int64
+	AuthTime             time.Time
+	LastPolledAt         time.Time
+	ExpiryTime           time.Time
+}
+
+// DeviceAuthorizationRequest carries the parsed parameters of a device authorization request.
+type DeviceAuthorizationRequest struct {
+	Scope     string
+	Resources []string
+}
+
+// DeviceAuthorizationResponse represents the response body for a successful device authorization
+// request (RFC 8628 §3.2).
+type DeviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+// VerificationRequest carries the parameters of a user's visit to the verification URI.
+type VerificationRequest struct {
+	UserCode    string
+	Headers     map[string][]string
+	QueryParams map[string][]string
+}
+
+// DeviceError holds structured error information for device authorization and callback failures.
+type DeviceError struct {
+	Code    string
+	Message string
+}
+
+// assertionClaims represents the claims extracted from the flow assertion JWT.
+type assertionClaims struct {
+	userID                string
+	attributeCacheID      string
+	completedACR          string
+	authReqID             string
+	authorizedPermissions string
+	flowErrorType         string
+}
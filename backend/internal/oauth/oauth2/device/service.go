@@ -0,0 +1,564 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// userCodeMaxAttempts bounds how many fresh user codes are tried when a generated one collides with
+// a code already held by another pending request.
+const userCodeMaxAttempts = 5
+
+// DeviceServiceInterface defines the interface for the device authorization grant service.
+// It covers the full lifecycle: initiation, user verification, callback, and token-endpoint polling.
+// The grant handler uses this interface instead of the raw store so the store stays private.
+type DeviceServiceInterface interface {
+	InitiateDeviceAuthorization(
+		ctx context.Context, request *DeviceAuthorizationRequest, oauthApp *providers.OAuthClient,
+	) (*DeviceAuthorizationResponse, *DeviceError)
+	InitiateVerification(ctx context.Context, request *VerificationRequest) (map[string]string, *DeviceError)
+	HandleCallback(ctx context.Context, userCode, assertion string) (DeviceRequestState, *DeviceError)
+
+	// Polling operations used by the device code grant handler at the token endpoint.
+	GetByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuthRequest, error)
+	UpdateLastPolled(ctx context.Context, deviceCode string, polledAt time.Time, interval int64) error
+	UpdateState(ctx context.Context, deviceCode string, state DeviceRequestState) error
+	MarkConsumed(ctx context.Context, deviceCode string) (bool, error)
+}
+
+// deviceService implements the DeviceServiceInterface.
+type deviceService struct {
+	cfg             oauthconfig.Config
+	store           DeviceRequestStoreInterface
+	flowExecService flowexec.FlowExecServiceInterface
+	jwtService      jwt.JWTServiceInterface
+	inboundClient   providers.ActorProvider
+	resourceService providers.ResourceServerProvider
+	logger          *log.Logger
+}
+
+// newDeviceService creates a new instance of deviceService with injected dependencies.
+func newDeviceService(
+	store DeviceRequestStoreInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	actorProvider providers.ActorProvider,
+	resourceService providers.ResourceServerProvider,
+	cfg oauthconfig.Config,
+) DeviceServiceInterface {
+	return &deviceService{
+		cfg:             cfg,
+		store:           store,
+		flowExecService: flowExecService,
+		jwtService:      jwtService,
+		inboundClient:   actorProvider,
+		resourceService: resourceService,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeviceService")),
+	}
+}
+
+// InitiateDeviceAuthorization validates the request, binds it to a resource server, persists a pending
+// device authorization request, and returns the device and user codes with polling metadata. No flow is
+// started here: the user has not been seen yet, so authentication begins at the verification URI.
+func (s *deviceService) InitiateDeviceAuthorization(
+	ctx context.Context, request *DeviceAuthorizationRequest, oauthApp *providers.OAuthClient,
+) (*DeviceAuthorizationResponse, *DeviceError) {
+	if !oauthApp.IsAllowedGrantType(providers.GrantTypeDeviceCode) {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorUnauthorizedClient,
+			Message: "The client is not authorized to use the device code grant type",
+		}
+	}
+
+	if errResp := resourceindicators.ValidateResourceURIs(request.Resources); errResp != nil {
+		return nil, &DeviceError{Code: errResp.Error, Message: errResp.ErrorDescription}
+	}
+
+	// Separate OIDC (standard) and permission scopes — mirrors the authorization_code path exactly.
+	oidcScopes, permissionScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(request.Scope, oauthApp.ScopeClaims)
+
+	// Bind the request to a single target resource server up front, mirroring authorization_code, so
+	// the user is only ever asked to consent to permissions of that resource server.
+	targetRS, rsErr := resourceindicators.ResolveAudienceBinding(
+		ctx, s.resourceService, request.Resources, permissionScopes)
+	if rsErr != nil {
+		return nil, &DeviceError{Code: rsErr.Error, Message: rsErr.ErrorDescription}
+	}
+
+	var effectiveResources []string
+	if targetRS != nil {
+		downscoped, dErr := resourceindicators.DownscopeToResourceServer(
+			ctx, s.resourceService, targetRS.ID, permissionScopes)
+		if dErr != nil {
+			return nil, &DeviceError{Code: dErr.Error, Message: dErr.ErrorDescription}
+		}
+		permissionScopes = downscoped
+		effectiveResources = []string{targetRS.Identifier}
+	}
+
+	deviceCode, err := cryptolib.GenerateSecureToken()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate device_code", log.Error(err))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization request",
+		}
+	}
+
+	expiresIn := s.resolveExpiresIn()
+	interval := s.resolveInterval()
+	deviceRequest := &DeviceAuthRequest{
+		DeviceCode:           deviceCode,
+		ClientID:             oauthApp.ClientID,
+		StandardScopes:       utils.StringifyStringArray(oidcScopes, " "),
+		RequestedPermissions: utils.StringifyStringArray(permissionScopes, " "),
+		Resources:            effectiveResources,
+		State:                DeviceStatePending,
+		Interval:             interval,
+		ExpiryTime:           time.Now().Add(time.Duration(expiresIn) * time.Second),
+	}
+	if storeErr := s.addWithUniqueUserCode(ctx, deviceRequest); storeErr != nil {
+		s.logger.Error(ctx, "Failed to store device authorization request", log.Error(storeErr))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization request",
+		}
+	}
+
+	verificationURI := s.cfg.BaseURL + oauth2const.OAuth2DeviceVerificationEndpoint
+	displayUserCode := formatUserCode(deviceRequest.UserCode)
+	verificationURIComplete, err := oauth2utils.GetURIWithQueryParams(verificationURI, map[string]string{
+		oauth2const.RequestParamUserCode: displayUserCode,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to construct verification_uri_complete", log.Error(err))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization request",
+		}
+	}
+
+	return &DeviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                displayUserCode,
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: verificationURIComplete,
+		ExpiresIn:               expiresIn,
+		Interval:                interval,
+	}, nil
+}
+
+// addWithUniqueUserCode assigns a fresh user code to the request and stores it, retrying with a new
+// code when the generated one is already held by another pending request.
+func (s *deviceService) addWithUniqueUserCode(ctx context.Context, request *DeviceAuthRequest) error {
+	for range userCodeMaxAttempts {
+		userCode, err := generateUserCode()
+		if err != nil {
+			return fmt.Errorf("failed to generate user_code: %w", err)
+		}
+		request.UserCode = userCode
+
+		err = s.store.Add(ctx, request)
+		if errors.Is(err, errUserCodeInUse) {
+			continue
+		}
+		return err
+	}
+	return errors.New("failed to allocate a unique user_code")
+}
+
+// InitiateVerification resolves the pending request for the user code the user entered and starts the
+// authentication flow for it. It returns the query parameters for the Gate login page redirect.
+func (s *deviceService) InitiateVerification(
+	ctx context.Context, request *VerificationRequest) (map[string]string, *DeviceError) {
+	userCode := normalizeUserCode(request.UserCode)
+	if userCode == "" {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "user_code is required",
+		}
+	}
+
+	record, err := s.store.GetByUserCode(ctx, userCode)
+	if err != nil {
+		if errors.Is(err, ErrDeviceRequestNotFound) {
+			return nil, &DeviceError{
+				Code:    oauth2const.ErrorInvalidRequest,
+				Message: "The code you entered is invalid or has expired",
+			}
+		}
+		s.logger.Error(ctx, "Failed to retrieve device authorization request", log.Error(err))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device verification request",
+		}
+	}
+	remaining := time.Until(record.ExpiryTime)
+	if remaining <= 0 {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorExpiredToken,
+			Message: "The code you entered is invalid or has expired",
+		}
+	}
+	if record.State != DeviceStatePending {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "The code you entered has already been used",
+		}
+	}
+
+	app, svcErr := s.inboundClient.GetOAuthClientByClientID(ctx, record.ClientID)
+	if svcErr != nil || app == nil {
+		s.logger.Error(ctx, "Failed to resolve client for device verification",
+			log.String("client_id", record.ClientID))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device verification request",
+		}
+	}
+
+	resourceServerIdentifier := ""
+	if len(record.Resources) > 0 {
+		resourceServerIdentifier = record.Resources[0]
+	}
+	requestedScopes := append(utils.ParseStringArray(record.StandardScopes, " "),
+		utils.ParseStringArray(record.RequestedPermissions, " ")...)
+
+	// The user code is injected as the authorization request ID so the assertion is bound to this
+	// specific device request, and the callback type routes the flow result back to this package. The
+	// user is approving access for a different device, so consent is always shown rather than skipped
+	// on a previously granted consent.
+	runtimeData := map[string]string{
+		flowcm.RuntimeKeyAuthorizationRequestID:        userCode,
+		flowcm.RuntimeKeyCallbackType:                  string(providers.GrantTypeDeviceCode),
+		flowcm.RuntimeKeyClientID:                      record.ClientID,
+		flowcm.RuntimeKeyRequestedPermissions:          record.RequestedPermissions,
+		flowcm.RuntimeKeyResourceServerIdentifier:      resourceServerIdentifier,
+		flowcm.RuntimeKeyRequiredEssentialAttributes:   "",
+		flowcm.RuntimeKeyRequiredOptionalAttributes:    oauth2utils.GetRequiredOptionalAttributes(requestedScopes, app),
+		flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: strconv.FormatInt(s.resolveUserAttributesCacheTTL(app), 10),
+		flowcm.RuntimeKeyForceConsentReprompt:          "true",
+	}
+
+	executionID, flowErr := s.flowExecService.InitiateFlow(ctx, &flowexec.FlowInitContext{
+		ApplicationID: app.ID,
+		FlowType:      string(providers.FlowTypeAuthentication),
+		RuntimeData:   runtimeData,
+		ExpirySeconds: int64(remaining.Seconds()),
+		InitiatorRequest: &providers.InitiatorRequest{
+			Headers:     utils.FilterSensitiveHeaders(request.Headers),
+			QueryParams: request.QueryParams,
+		},
+	})
+	if flowErr != nil {
+		s.logger.Error(ctx, "Failed to initiate device verification flow",
+			log.String("error_code", flowErr.Code))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device verification request",
+		}
+	}
+
+	return map[string]string{
+		oauth2const.AuthID:      userCode,
+		oauth2const.AppID:       app.ID,
+		oauth2const.ExecutionID: executionID,
+	}, nil
+}
+
+// loadPendingRequestForCallback loads the request a flow callback refers to and verifies the assertion
+// against it: the request must exist, still be pending and unexpired, and the assertion must carry a
+// valid signature for the owning client's audience. Shared by the success and failure callbacks.
+func (s *deviceService) loadPendingRequestForCallback(
+	ctx context.Context, userCode, assertion string) (*DeviceAuthRequest, *DeviceError) {
+	if userCode == "" || assertion == "" {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "authId and assertion are required",
+		}
+	}
+
+	record, err := s.store.GetByUserCode(ctx, userCode)
+	if err != nil {
+		if errors.Is(err, ErrDeviceRequestNotFound) {
+			return nil, &DeviceError{
+				Code:    oauth2const.ErrorInvalidRequest,
+				Message: "Invalid user code",
+			}
+		}
+		s.logger.Error(ctx, "Failed to retrieve device authorization request", log.Error(err))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization callback",
+		}
+	}
+
+	if record.State != DeviceStatePending {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Device authorization request is not pending",
+		}
+	}
+	if record.ExpiryTime.Before(time.Now()) {
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorExpiredToken,
+			Message: "Device authorization request has expired",
+		}
+	}
+
+	// Resolve the owning client to obtain the expected audience (the app entity ID used as the
+	// assertion `aud`) for defense-in-depth audience validation during signature verification.
+	expectedAud := s.resolveExpectedAudience(ctx, record.ClientID)
+
+	if verifyErr := s.jwtService.VerifyJWT(ctx, assertion, expectedAud, ""); verifyErr != nil {
+		s.logger.Debug(ctx, "Assertion verification failed",
+			log.String("error", verifyErr.Error.DefaultValue))
+		return nil, &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Invalid assertion signature",
+		}
+	}
+
+	return record, nil
+}
+
+// HandleCallback verifies the flow assertion and applies its outcome to the request, returning the
+// state the request ended up in. The assertion is either an authentication assertion from a completed
+// flow or a signed error assertion minted when the flow terminated in failure; only the latter carries
+// the flow error type claim, so that claim selects the branch.
+func (s *deviceService) HandleCallback(
+	ctx context.Context, userCode, assertion string) (DeviceRequestState, *DeviceError) {
+	userCode = normalizeUserCode(userCode)
+	record, deviceErr := s.loadPendingRequestForCallback(ctx, userCode, assertion)
+	if deviceErr != nil {
+		return "", deviceErr
+	}
+
+	claims, authTime, decodeErr := decodeAttributesFromAssertion(assertion)
+	if decodeErr != nil {
+		s.logger.Error(ctx, "Failed to decode assertion claims", log.Error(decodeErr))
+		return "", &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization callback",
+		}
+	}
+
+	if claims.flowErrorType != "" {
+		// Cannot fail: the same assertion decoded successfully above.
+		errClaims, _ := oauth2utils.DecodeFlowErrorAssertionClaims(assertion)
+		return s.handleFailedCallback(ctx, record, errClaims)
+	}
+
+	if deviceErr := s.handleSuccessCallback(ctx, record, claims, authTime); deviceErr != nil {
+		return "", deviceErr
+	}
+	return DeviceStateAuthenticated, nil
+}
+
+// handleSuccessCallback enforces the user code binding of a verified authentication assertion and
+// marks the request authenticated.
+func (s *deviceService) handleSuccessCallback(ctx context.Context, record *DeviceAuthRequest,
+	claims assertionClaims, authTime time.Time) *DeviceError {
+	// Bind the assertion to this specific device request. The user code is threaded through the flow
+	// runtime data into the assertion as the authorization_request_id claim; requiring it to match the
+	// record prevents an assertion minted for one device request from authorizing another.
+	if claims.authReqID == "" || claims.authReqID != record.UserCode {
+		s.logger.Debug(ctx, "Assertion is not bound to the device authorization request",
+			log.MaskedString("user_code", record.UserCode))
+		return &DeviceError{
+			Code:    oauth2const.ErrorAccessDenied,
+			Message: "Assertion does not match the device authorization request",
+		}
+	}
+
+	if claims.userID == "" {
+		s.logger.Debug(ctx, "Assertion subject is missing",
+			log.MaskedString("user_code", record.UserCode))
+		return &DeviceError{
+			Code:    oauth2const.ErrorAccessDenied,
+			Message: "Assertion subject is missing",
+		}
+	}
+
+	if authTime.IsZero() {
+		authTime = time.Now()
+	}
+
+	// Build AuthorizedScopes — mirrors auth code callback exactly: the OIDC scopes stored at
+	// initiation plus the authorized_permissions from the assertion.
+	permissionScopeList := utils.ParseStringArray(claims.authorizedPermissions, " ")
+	authorizedScopes := utils.StringifyStringArray(
+		append(utils.ParseStringArray(record.StandardScopes, " "), permissionScopeList...),
+		" ")
+
+	if markErr := s.store.MarkAuthenticated(ctx, record.DeviceCode, claims.userID, authorizedScopes,
+		claims.attributeCacheID, claims.completedACR, authTime); markErr != nil {
+		s.logger.Error(ctx, "Failed to mark device authorization request as authenticated",
+			log.Error(markErr))
+		return &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization callback",
+		}
+	}
+
+	return nil
+}
+
+// handleFailedCallback marks the request DENIED (end-user failure) or FAILED (server-side failure) for
+// a verified error assertion, so the polling token endpoint returns access_denied or a 500.
+func (s *deviceService) handleFailedCallback(ctx context.Context, record *DeviceAuthRequest,
+	claims oauth2utils.FlowErrorAssertionClaims) (DeviceRequestState, *DeviceError) {
+	if claims.AuthorizationRequestID != record.UserCode {
+		s.logger.Debug(ctx, "Error assertion is not bound to the device authorization request",
+			log.MaskedString("user_code", record.UserCode))
+		return "", &DeviceError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Error assertion does not match the device authorization request",
+		}
+	}
+
+	targetState := DeviceStateFailed
+	if claims.ErrorType == flowcm.FlowErrorTypeEndUser {
+		targetState = DeviceStateDenied
+	} else if !s.cfg.OAuth.SendServerErrorsToClientEnabled() {
+		// The deployment opts out of reporting server errors. The request is left pending so the user
+		// can retry from the verification URI, or the polling client times out on it.
+		s.logger.Debug(ctx, "Not failing device authorization request on a server error",
+			log.String("flowErrorType", claims.ErrorType))
+		return DeviceStatePending, nil
+	}
+	s.logger.Debug(ctx, "Failing device authorization request",
+		log.String("state", string(targetState)),
+		log.String("flowErrorType", claims.ErrorType),
+		log.String("flowErrorDescription", claims.Description))
+	if err := s.store.UpdateState(ctx, record.DeviceCode, targetState); err != nil {
+		s.logger.Error(ctx, "Failed to update device authorization request state",
+			log.String("state", string(targetState)), log.Error(err))
+		return "", &DeviceError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process device authorization callback",
+		}
+	}
+	return targetState, nil
+}
+
+// resolveExpectedAudience resolves the app entity ID for the given client ID, which the flow uses
+// as the assertion `aud`. It returns an empty string (skipping the audience check) on lookup
+// failure; the authorization_request_id binding remains the primary protection in that case.
+func (s *deviceService) resolveExpectedAudience(ctx context.Context, clientID string) string {
+	app, svcErr := s.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+	if svcErr != nil {
+		s.logger.Warn(ctx, "Failed to resolve client for audience validation; skipping audience check",
+			log.String("error", svcErr.Error.DefaultValue))
+		return ""
+	}
+	if app == nil {
+		return ""
+	}
+	return app.ID
+}
+
+// resolveExpiresIn returns the configured device_code lifetime, falling back to the default.
+func (s *deviceService) resolveExpiresIn() int64 {
+	if s.cfg.OAuth.DeviceCode.ExpiresIn > 0 {
+		return s.cfg.OAuth.DeviceCode.ExpiresIn
+	}
+	return oauth2const.DeviceCodeDefaultExpiresInSeconds
+}
+
+// resolveInterval returns the configured minimum polling interval, falling back to the default.
+func (s *deviceService) resolveInterval() int64 {
+	if s.cfg.OAuth.DeviceCode.Interval > 0 {
+		return s.cfg.OAuth.DeviceCode.Interval
+	}
+	return oauth2const.DeviceCodeDefaultIntervalSeconds
+}
+
+// resolveUserAttributesCacheTTL determines the TTL for caching user attributes during the flow.
+// It mirrors the CIBA path: the largest of the access and refresh token (if allowed) validity periods
+// is the base, plus the device code lifetime to cover the poll window, plus a fixed buffer.
+func (s *deviceService) resolveUserAttributesCacheTTL(app *providers.OAuthClient) int64 {
+	maxTTL := tokenservice.ResolveTokenConfig(
+		s.cfg, app, tokenservice.TokenTypeAccess, app.UserAccessTokenConfig().ValidityPeriodOrZero()).ValidityPeriod
+	if app.IsAllowedGrantType(providers.GrantTypeRefreshToken) {
+		refreshTTL := tokenservice.ResolveTokenConfig(s.cfg, app, tokenservice.TokenTypeRefresh, 0).ValidityPeriod
+		if refreshTTL > maxTTL {
+			maxTTL = refreshTTL
+		}
+	}
+	return maxTTL + s.resolveExpiresIn() + oauth2const.AttributeCacheTTLBufferSeconds
+}
+
+// GetByDeviceCode retrieves a device authorization request by device code. Used by the grant handler
+// at the token endpoint to check request state without exposing the store directly.
+func (s *deviceService) GetByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuthRequest, error) {
+	return s.store.GetByDeviceCode(ctx, deviceCode)
+}
+
+// UpdateLastPolled records the last polled timestamp and polling interval of a device authorization request.
+func (s *deviceService) UpdateLastPolled(ctx context.Context, deviceCode string, polledAt time.Time,
+	interval int64) error {
+	return s.store.UpdateLastPolled(ctx, deviceCode, polledAt, interval)
+}
+
+// UpdateState updates the state of a device authorization request.
+func (s *deviceService) UpdateState(ctx context.Context, deviceCode string, state DeviceRequestState) error {
+	return s.store.UpdateState(ctx, deviceCode, state)
+}
+
+// MarkConsumed atomically transitions an authenticated request to consumed.
+func (s *deviceService) MarkConsumed(ctx context.Context, deviceCode string) (bool, error) {
+	return s.store.MarkConsumed(ctx, deviceCode)
+}
+
+// decodeAttributesFromAssertion decodes claims from the flow assertion JWT using the shared
+// base decoder, then extracts the request binding and permission claims.
+func decodeAttributesFromAssertion(assertion string) (assertionClaims, time.Time, error) {
+	base, payload, err := oauth2utils.DecodeFlowAssertionClaims(assertion)
+	if err != nil {
+		return assertionClaims{}, time.Time{}, fmt.Errorf("failed to decode assertion: %w", err)
+	}
+
+	claims := assertionClaims{
+		userID:           base.UserID,
+		attributeCacheID: base.AttributeCacheID,
+		completedACR:     base.CompletedACR,
+	}
+
+	if v, ok := payload[oauth2const.ClaimAuthorizationRequestID]; ok {
+		strValue, ok := v.(string)
+		if !ok {
+			return assertionClaims{}, time.Time{}, errors.New("JWT 'authorization_request_id' claim is not a string")
+		}
+		claims.authReqID = strValue
+	}
+
+	if v, ok := payload[oauth2const.ClaimAuthorizedPermissions].(string); ok {
+		claims.authorizedPermissions = v
+	}
+
+	if v, ok := payload[flowcm.ClaimFlowErrorType].(string); ok {
+		claims.flowErrorType = v
+	}
+
+	return claims, base.AuthTime, nil
+}
@@ -0,0 +1,522 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/actorprovider"
+	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+	"github.com/thunder-id/thunderid/tests/testhelpers"
+)
+
+const (
+	testUserID     = "user-1"
+	testUserCode   = "BCDFGHJK"
+	testDeviceCode = "device-code-1"
+)
+
+type DeviceServiceTestSuite struct {
+	suite.Suite
+	mockStore          *DeviceRequestStoreInterfaceMock
+	mockFlowExec       *flowexecmock.FlowExecServiceInterfaceMock
+	mockJWTService     *jwtmock.JWTServiceInterfaceMock
+	mockInboundClient  *inboundclientmock.InboundClientServiceInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockResourceSvc    *resourcemock.ResourceServiceInterfaceMock
+	service            DeviceServiceInterface
+	oauthApp           *providers.OAuthClient
+}
+
+func TestDeviceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceServiceTestSuite))
+}
+
+func (suite *DeviceServiceTestSuite) SetupTest() {
+	testConfig := &config.Config{}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	suite.mockStore = NewDeviceRequestStoreInterfaceMock(suite.T())
+	suite.mockFlowExec = flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockResourceSvc = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.service = suite.newService(testhelpers.OAuthConfig())
+	suite.oauthApp = &providers.OAuthClient{
+		ID:         "app-1",
+		ClientID:   "client-1",
+		GrantTypes: []providers.GrantType{providers.GrantTypeDeviceCode},
+	}
+}
+
+func (suite *DeviceServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// newService builds the service under test with the given OAuth configuration.
+func (suite *DeviceServiceTestSuite) newService(cfg oauthconfig.Config) DeviceServiceInterface {
+	actorProv := actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider,
+		&managermock.AuthnProviderManagerMock{}, nil)
+	return newDeviceService(suite.mockStore, suite.mockFlowExec, suite.mockJWTService, actorProv,
+		suite.mockResourceSvc, cfg)
+}
+
+func (suite *DeviceServiceTestSuite) pendingRecord() *DeviceAuthRequest {
+	return &DeviceAuthRequest{
+		DeviceCode:     testDeviceCode,
+		UserCode:       testUserCode,
+		ClientID:       "client-1",
+		StandardScopes: "openid profile",
+		State:          DeviceStatePending,
+		Interval:       oauth2const.DeviceCodeDefaultIntervalSeconds,
+		ExpiryTime:     time.Now().Add(5 * time.Minute),
+	}
+}
+
+func (suite *DeviceServiceTestSuite) expectClientLookup() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-1").
+		Return(suite.oauthApp, nil)
+}
+
+// -------------------------------------------------------------------
+// InitiateDeviceAuthorization tests
+// -------------------------------------------------------------------
+
+func (suite *DeviceServiceTestSuite) TestInitiate_Success() {
+	var stored *DeviceAuthRequest
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.MatchedBy(func(r *DeviceAuthRequest) bool {
+		stored = r
+		return true
+	})).Return(nil)
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid profile"}, suite.oauthApp)
+
+	suite.Nil(deviceErr)
+	suite.Require().NotNil(resp)
+	suite.Require().NotNil(stored)
+	suite.NotEmpty(resp.DeviceCode)
+	suite.Equal(stored.DeviceCode, resp.DeviceCode)
+	suite.Equal(formatUserCode(stored.UserCode), resp.UserCode)
+	suite.Equal("https://thunder.io/oauth2/device", resp.VerificationURI)
+	suite.Equal("https://thunder.io/oauth2/device?user_code="+resp.UserCode, resp.VerificationURIComplete)
+	suite.Equal(int64(oauth2const.DeviceCodeDefaultExpiresInSeconds), resp.ExpiresIn)
+	suite.Equal(int64(oauth2const.DeviceCodeDefaultIntervalSeconds), resp.Interval)
+	suite.Equal("client-1", stored.ClientID)
+	suite.Equal("openid profile", stored.StandardScopes)
+	suite.Equal(DeviceStatePending, stored.State)
+	suite.Empty(stored.Resources)
+	suite.Len(stored.UserCode, userCodeLength)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_UsesConfiguredLifetimeAndInterval() {
+	cfg := testhelpers.OAuthConfig()
+	cfg.OAuth.DeviceCode.ExpiresIn = 900
+	cfg.OAuth.DeviceCode.Interval = 10
+	svc := suite.newService(cfg)
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.MatchedBy(func(r *DeviceAuthRequest) bool {
+		return r.Interval == 10
+	})).Return(nil)
+
+	resp, deviceErr := svc.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid"}, suite.oauthApp)
+
+	suite.Nil(deviceErr)
+	suite.Equal(int64(900), resp.ExpiresIn)
+	suite.Equal(int64(10), resp.Interval)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_RetriesOnUserCodeCollision() {
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.Anything).Return(errUserCodeInUse).Once()
+	var stored *DeviceAuthRequest
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.Anything).Run(
+		func(_ context.Context, r *DeviceAuthRequest) { stored = r }).Return(nil).Once()
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid"}, suite.oauthApp)
+
+	suite.Nil(deviceErr)
+	suite.Require().NotNil(resp)
+	suite.Equal(formatUserCode(stored.UserCode), resp.UserCode)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_UserCodeCollisionsExhausted() {
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.Anything).Return(errUserCodeInUse).
+		Times(userCodeMaxAttempts)
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid"}, suite.oauthApp)
+
+	suite.Nil(resp)
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorServerError, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_StoreError() {
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid"}, suite.oauthApp)
+
+	suite.Nil(resp)
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorServerError, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_UnauthorizedClient() {
+	app := &providers.OAuthClient{
+		ClientID:   "client-1",
+		GrantTypes: []providers.GrantType{providers.GrantTypeAuthorizationCode},
+	}
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{Scope: "openid"}, app)
+
+	suite.Nil(resp)
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorUnauthorizedClient, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_ExplicitResourceBindsAndDownscopes() {
+	suite.mockResourceSvc.EXPECT().GetResourceServerByIdentifier(mock.Anything, "https://api.example.com").
+		Return(&providers.ResourceServer{ID: "rs-1", Identifier: "https://api.example.com"}, nil)
+	suite.mockResourceSvc.EXPECT().ValidatePermissions(mock.Anything, "rs-1", mock.Anything).
+		Return([]string{}, nil)
+
+	var stored *DeviceAuthRequest
+	suite.mockStore.EXPECT().Add(mock.Anything, mock.MatchedBy(func(r *DeviceAuthRequest) bool {
+		stored = r
+		return true
+	})).Return(nil)
+
+	_, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{
+			Scope:     "openid read:things",
+			Resources: []string{"https://api.example.com"},
+		}, suite.oauthApp)
+
+	suite.Nil(deviceErr)
+	suite.Equal([]string{"https://api.example.com"}, stored.Resources)
+	suite.Equal("read:things", stored.RequestedPermissions)
+}
+
+func (suite *DeviceServiceTestSuite) TestInitiate_UnknownResourceRejects() {
+	suite.mockResourceSvc.EXPECT().GetResourceServerByIdentifier(mock.Anything, "https://unknown.example.com").
+		Return(nil, &tidcommon.ServiceError{Type: tidcommon.ClientErrorType, Code: "RS-1001"})
+
+	resp, deviceErr := suite.service.InitiateDeviceAuthorization(context.Background(),
+		&DeviceAuthorizationRequest{
+			Scope:     "openid read",
+			Resources: []string{"https://unknown.example.com"},
+		}, suite.oauthApp)
+
+	suite.Nil(resp)
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidTarget, deviceErr.Code)
+}
+
+// -------------------------------------------------------------------
+// InitiateVerification tests
+// -------------------------------------------------------------------
+
+func (suite *DeviceServiceTestSuite) TestVerification_Success() {
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(suite.pendingRecord(), nil)
+	suite.expectClientLookup()
+	suite.mockFlowExec.EXPECT().InitiateFlow(mock.Anything, mock.MatchedBy(
+		func(initCtx *flowexec.FlowInitContext) bool {
+			return initCtx.ApplicationID == "app-1" &&
+				initCtx.FlowType == string(providers.FlowTypeAuthentication) &&
+				initCtx.ExpirySeconds > 0 && initCtx.ExpirySeconds <= 300 &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyAuthorizationRequestID] == testUserCode &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyCallbackType] == string(providers.GrantTypeDeviceCode) &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyClientID] == "client-1" &&
+				initCtx.RuntimeData[flowcm.RuntimeKeyForceConsentReprompt] == "true"
+		})).Return("exec-1", nil)
+
+	// The user-entered code is normalized before lookup.
+	params, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: "bcdf-ghjk"})
+
+	suite.Nil(deviceErr)
+	suite.Equal(map[string]string{
+		oauth2const.AuthID:      testUserCode,
+		oauth2const.AppID:       "app-1",
+		oauth2const.ExecutionID: "exec-1",
+	}, params)
+}
+
+func (suite *DeviceServiceTestSuite) TestVerification_EmptyUserCode() {
+	params, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: " - "})
+
+	suite.Nil(params)
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestVerification_UnknownUserCode() {
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, "ZZZZZZZZ").Return(nil, ErrDeviceRequestNotFound)
+
+	params, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: "ZZZZ-ZZZZ"})
+
+	suite.Nil(params)
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestVerification_Expired() {
+	record := suite.pendingRecord()
+	record.ExpiryTime = time.Now().Add(-time.Second)
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(record, nil)
+
+	_, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: testUserCode})
+
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorExpiredToken, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestVerification_AlreadyUsed() {
+	record := suite.pendingRecord()
+	record.State = DeviceStateAuthenticated
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(record, nil)
+
+	_, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: testUserCode})
+
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestVerification_StoreError() {
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(nil, errors.New("db error"))
+
+	_, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: testUserCode})
+
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorServerError, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestVerification_FlowInitiationFails() {
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(suite.pendingRecord(), nil)
+	suite.expectClientLookup()
+	suite.mockFlowExec.EXPECT().InitiateFlow(mock.Anything, mock.Anything).
+		Return("", &tidcommon.ServiceError{Code: "FLOW-1"})
+
+	_, deviceErr := suite.service.InitiateVerification(context.Background(),
+		&VerificationRequest{UserCode: testUserCode})
+
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorServerError, deviceErr.Code)
+}
+
+// -------------------------------------------------------------------
+// HandleCallback tests
+// -------------------------------------------------------------------
+
+func (suite *DeviceServiceTestSuite) TestCallback_Success() {
+	iat := time.Now().Unix()
+	assertion := buildTestAssertion(map[string]interface{}{
+		"sub":                      testUserID,
+		"aci":                      "cache-1",
+		"completed_auth_class":     "urn:acr:pwd",
+		"authorization_request_id": testUserCode,
+		"authorized_permissions":   "read",
+		"iat":                      float64(iat),
+	})
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(suite.pendingRecord(), nil)
+	suite.expectClientLookup()
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, assertion, "app-1", "").Return(nil)
+	suite.mockStore.EXPECT().MarkAuthenticated(
+		mock.Anything, testDeviceCode, testUserID, "openid profile read", "cache-1", "urn:acr:pwd",
+		mock.MatchedBy(func(authTime time.Time) bool { return authTime.Unix() == iat })).Return(nil)
+
+	state, deviceErr := suite.service.HandleCallback(context.Background(), "bcdf-ghjk", assertion)
+	suite.Nil(deviceErr)
+	suite.Equal(DeviceStateAuthenticated, state)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_BindingMismatch() {
+	assertion := buildTestAssertion(map[string]interface{}{
+		"sub":                      testUserID,
+		"authorization_request_id": "ZZZZZZZZ",
+		"iat":                      float64(time.Now().Unix()),
+	})
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(suite.pendingRecord(), nil)
+	suite.expectClientLookup()
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, assertion, "app-1", "").Return(nil)
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), testUserCode, assertion)
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorAccessDenied, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_SubMissing() {
+	assertion := buildTestAssertion(map[string]interface{}{
+		"authorization_request_id": testUserCode,
+		"iat":                      float64(time.Now().Unix()),
+	})
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(suite.pendingRecord(), nil)
+	suite.expectClientLookup()
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, assertion, "app-1", "").Return(nil)
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), testUserCode, assertion)
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorAccessDenied, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_MissingParams() {
+	_, deviceErr := suite.service.HandleCallback(context.Background(), "", "assertion")
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+
+	_, deviceErr = suite.service.HandleCallback(context.Background(), testUserCode, "")
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_NotPending() {
+	record := suite.pendingRecord()
+	record.State = DeviceStateConsumed
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(record, nil)
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), testUserCode, "assertion")
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_Expired() {
+	record := suite.pendingRecord()
+	record.ExpiryTime = time.Now().Add(-time.Minute)
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(record, nil)
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), testUserCode, "assertion")
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorExpiredToken, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_BadSignature() {
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(suite.pendingRecord(), nil)
+	suite.expectClientLookup()
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, "bad-assertion", "app-1", "").Return(
+		&tidcommon.ServiceError{Code: "JWT-1"})
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), testUserCode, "bad-assertion")
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_Failure_EndUserDenies() {
+	assertion := buildErrorAssertion(testUserCode, flowcm.FlowErrorTypeEndUser)
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(suite.pendingRecord(), nil)
+	suite.expectClientLookup()
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, assertion, "app-1", "").Return(nil)
+	suite.mockStore.EXPECT().UpdateState(mock.Anything, testDeviceCode, DeviceStateDenied).Return(nil)
+
+	state, deviceErr := suite.service.HandleCallback(context.Background(), testUserCode, assertion)
+	suite.Nil(deviceErr)
+	suite.Equal(DeviceStateDenied, state)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_Failure_ServerErrorReported() {
+	enabled := true
+	cfg := testhelpers.OAuthConfig()
+	cfg.OAuth.SendServerErrorsToClient = &enabled
+	svc := suite.newService(cfg)
+
+	assertion := buildErrorAssertion(testUserCode, flowcm.FlowErrorTypeServer)
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(suite.pendingRecord(), nil)
+	suite.expectClientLookup()
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, assertion, "app-1", "").Return(nil)
+	suite.mockStore.EXPECT().UpdateState(mock.Anything, testDeviceCode, DeviceStateFailed).Return(nil)
+
+	state, deviceErr := svc.HandleCallback(context.Background(), testUserCode, assertion)
+	suite.Nil(deviceErr)
+	suite.Equal(DeviceStateFailed, state)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_Failure_ServerErrorNotReportedLeavesPending() {
+	assertion := buildErrorAssertion(testUserCode, flowcm.FlowErrorTypeServer)
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(suite.pendingRecord(), nil)
+	suite.expectClientLookup()
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, assertion, "app-1", "").Return(nil)
+
+	state, deviceErr := suite.service.HandleCallback(context.Background(), testUserCode, assertion)
+	suite.Nil(deviceErr)
+	suite.Equal(DeviceStatePending, state)
+	suite.mockStore.AssertNotCalled(suite.T(), "UpdateState", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DeviceServiceTestSuite) TestCallback_Failure_BindingMismatch() {
+	assertion := buildErrorAssertion("ZZZZZZZZ", flowcm.FlowErrorTypeEndUser)
+	suite.mockStore.EXPECT().GetByUserCode(mock.Anything, testUserCode).Return(suite.pendingRecord(), nil)
+	suite.expectClientLookup()
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, assertion, "app-1", "").Return(nil)
+
+	_, deviceErr := suite.service.HandleCallback(context.Background(), testUserCode, assertion)
+	suite.NotNil(deviceErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, deviceErr.Code)
+}
+
+// -------------------------------------------------------------------
+// User code helper tests
+// -------------------------------------------------------------------
+
+func (suite *DeviceServiceTestSuite) TestGenerateUserCode_UsesCharset() {
+	code, err := generateUserCode()
+	suite.Require().NoError(err)
+	suite.Len(code, userCodeLength)
+	for _, r := range code {
+		suite.True(strings.ContainsRune(userCodeCharset, r), "unexpected character %q", r)
+	}
+}
+
+func (suite *DeviceServiceTestSuite) TestFormatAndNormalizeUserCode() {
+	suite.Equal("BCDF-GHJK", formatUserCode(testUserCode))
+	suite.Equal(testUserCode, normalizeUserCode("bcdf-ghjk"))
+	suite.Equal(testUserCode, normalizeUserCode(" BCDF GHJK "))
+	suite.Equal(testUserCode, normalizeUserCode(formatUserCode(testUserCode)))
+}
+
+// buildTestAssertion builds a JWT-shaped string (header.payload.signature) for decode-path testing.
+// Signature verification is mocked, so the signature segment is a placeholder.
+func buildTestAssertion(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]interface{}{"alg": "RS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(header) + "." + enc.EncodeToString(payload) + "." + enc.EncodeToString([]byte("sig"))
+}
+
+// buildErrorAssertion builds a flow error assertion bound to userCode.
+func buildErrorAssertion(userCode, errorType string) string {
+	return buildTestAssertion(map[string]interface{}{
+		flowcm.ClaimAuthorizationRequestID: userCode,
+		flowcm.ClaimFlowErrorType:          errorType,
+	})
+}
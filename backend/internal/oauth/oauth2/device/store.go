@@ -0,0 +1,236 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// deviceStateField is the JSON field name that guards the atomic state transitions. It must match the
+// marshaled field name of DeviceAuthRequest.State.
+const deviceStateField = "State"
+
+// userCodeIndex is the document stored under the user code namespace. It maps a user code back to
+// the device code that keys the full request, so the verification URI can look the request up.
+type userCodeIndex struct {
+	DeviceCode string
+}
+
+// DeviceRequestStoreInterface defines the interface for device authorization request storage.
+type DeviceRequestStoreInterface interface {
+	Add(ctx context.Context, request *DeviceAuthRequest) error
+	GetByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuthRequest, error)
+	GetByUserCode(ctx context.Context, userCode string) (*DeviceAuthRequest, error)
+	MarkAuthenticated(ctx context.Context, deviceCode, userID, authorizedScopes, attributeCacheID,
+		completedACR string, authTime time.Time) error
+	MarkConsumed(ctx context.Context, deviceCode string) (bool, error)
+	UpdateLastPolled(ctx context.Context, deviceCode string, polledAt time.Time, interval int64) error
+	UpdateState(ctx context.Context, deviceCode string, state DeviceRequestState) error
+}
+
+// deviceStore adapts a runtime store provider to device authorization request storage. Requests are
+// stored under the device code namespace, keyed by device code, as a serialized DeviceAuthRequest. A
+// second entry under the user code namespace indexes the request by its user code.
+type deviceStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newDeviceStore creates a device request store backed by the given runtime store provider.
+func newDeviceStore(store providers.RuntimeStoreProvider) DeviceRequestStoreInterface {
+	return &deviceStore{store: store}
+}
+
+// Add inserts a new device authorization request and its user code index with a TTL derived from its
+// expiry time. The user code is reserved first and atomically, so a colliding user code is reported
+// as errUserCodeInUse without disturbing the request that already holds it.
+func (s *deviceStore) Add(ctx context.Context, request *DeviceAuthRequest) error {
+	ttl := time.Until(request.ExpiryTime)
+	if ttl <= 0 {
+		return fmt.Errorf("device authorization request already expired")
+	}
+
+	indexData, err := json.Marshal(userCodeIndex{DeviceCode: request.DeviceCode})
+	if err != nil {
+		return fmt.Errorf("failed to marshal user code index: %w", err)
+	}
+	stored, err := s.store.PutIfNotExists(ctx, providers.NamespaceDeviceUserCode, request.UserCode,
+		indexData, int64(ttl.Seconds()))
+	if err != nil {
+		return fmt.Errorf("failed to store user code index: %w", err)
+	}
+	if !stored {
+		return errUserCodeInUse
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device authorization request: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceDeviceCode, request.DeviceCode, data, int64(ttl.Seconds()))
+}
+
+// GetByDeviceCode retrieves a device authorization request by device code. Returns
+// ErrDeviceRequestNotFound if absent.
+func (s *deviceStore) GetByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuthRequest, error) {
+	if deviceCode == "" {
+		return nil, ErrDeviceRequestNotFound
+	}
+
+	data, err := s.store.Get(ctx, providers.NamespaceDeviceCode, deviceCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device authorization request: %w", err)
+	}
+	if data == nil {
+		return nil, ErrDeviceRequestNotFound
+	}
+
+	var request DeviceAuthRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal device authorization request: %w", err)
+	}
+	return &request, nil
+}
+
+// GetByUserCode retrieves a device authorization request by its normalized user code. Returns
+// ErrDeviceRequestNotFound if the user code is unknown or the request it points to is gone.
+func (s *deviceStore) GetByUserCode(ctx context.Context, userCode string) (*DeviceAuthRequest, error) {
+	if userCode == "" {
+		return nil, ErrDeviceRequestNotFound
+	}
+
+	data, err := s.store.Get(ctx, providers.NamespaceDeviceUserCode, userCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user code index: %w", err)
+	}
+	if data == nil {
+		return nil, ErrDeviceRequestNotFound
+	}
+
+	var index userCodeIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user code index: %w", err)
+	}
+	return s.GetByDeviceCode(ctx, index.DeviceCode)
+}
+
+// MarkAuthenticated transitions a pending request to authenticated and records the user ID (from
+// the assertion sub claim), authorized scopes, attribute cache ID, completed ACR, and authentication
+// time. The compare-and-swap on the State field prevents a double-callback race condition.
+func (s *deviceStore) MarkAuthenticated(ctx context.Context, deviceCode, userID,
+	authorizedScopes, attributeCacheID, completedACR string, authTime time.Time) error {
+	record, err := s.GetByDeviceCode(ctx, deviceCode)
+	if err != nil {
+		return err
+	}
+	if record.State != DeviceStatePending {
+		return errors.New("device authorization request is not pending")
+	}
+
+	record.State = DeviceStateAuthenticated
+	record.UserID = userID
+	record.AuthorizedScopes = authorizedScopes
+	record.AttributeCacheID = attributeCacheID
+	record.CompletedACR = completedACR
+	record.AuthTime = authTime
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device authorization request: %w", err)
+	}
+
+	swapped, err := s.store.CompareFieldAndSwap(ctx, providers.NamespaceDeviceCode, deviceCode,
+		deviceStateField, string(DeviceStatePending), data)
+	if err != nil {
+		return fmt.Errorf("failed to mark device authorization request as authenticated: %w", err)
+	}
+	if !swapped {
+		return errors.New("device authorization request is not pending")
+	}
+	return nil
+}
+
+// MarkConsumed atomically transitions an authenticated request to consumed. It returns false when
+// the request is not in the AUTHENTICATED state (already consumed or otherwise terminal), enabling
+// one-time-use enforcement under concurrent polling.
+func (s *deviceStore) MarkConsumed(ctx context.Context, deviceCode string) (bool, error) {
+	record, err := s.GetByDeviceCode(ctx, deviceCode)
+	if errors.Is(err, ErrDeviceRequestNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if record.State != DeviceStateAuthenticated {
+		return false, nil
+	}
+
+	record.State = DeviceStateConsumed
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal device authorization request: %w", err)
+	}
+
+	swapped, err := s.store.CompareFieldAndSwap(ctx, providers.NamespaceDeviceCode, deviceCode,
+		deviceStateField, string(DeviceStateAuthenticated), data)
+	if err != nil {
+		return false, fmt.Errorf("failed to consume device authorization request: %w", err)
+	}
+	return swapped, nil
+}
+
+// UpdateLastPolled records the last polled timestamp and the polling interval in effect for a device
+// authorization request. The interval grows each time the client is told to slow down. The write is
+// conditioned on the request still being pending, so a poll racing the user's callback cannot revert
+// the authenticated state; in that case the poll metadata no longer matters and is dropped.
+func (s *deviceStore) UpdateLastPolled(ctx context.Context, deviceCode string, polledAt time.Time,
+	interval int64) error {
+	record, err := s.GetByDeviceCode(ctx, deviceCode)
+	if err != nil {
+		return err
+	}
+	record.LastPolledAt = polledAt
+	record.Interval = interval
+
+	if _, err := s.swapPending(ctx, record); err != nil {
+		return fmt.Errorf("failed to update device authorization last polled time: %w", err)
+	}
+	return nil
+}
+
+// UpdateState moves a pending device authorization request to the given state. It fails when the
+// request is no longer pending, so it cannot overwrite a concurrent authentication or consumption.
+func (s *deviceStore) UpdateState(ctx context.Context, deviceCode string, state DeviceRequestState) error {
+	record, err := s.GetByDeviceCode(ctx, deviceCode)
+	if err != nil {
+		return err
+	}
+	record.State = state
+
+	swapped, err := s.swapPending(ctx, record)
+	if err != nil {
+		return fmt.Errorf("failed to update device authorization request state: %w", err)
+	}
+	if !swapped {
+		return errors.New("device authorization request is not pending")
+	}
+	return nil
+}
+
+// swapPending writes the record back to the store only if the stored request is still pending,
+// preserving its TTL. It reports whether the write took place.
+func (s *deviceStore) swapPending(ctx context.Context, record *DeviceAuthRequest) (bool, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal device authorization request: %w", err)
+	}
+	return s.store.CompareFieldAndSwap(ctx, providers.NamespaceDeviceCode, record.DeviceCode,
+		deviceStateField, string(DeviceStatePending), data)
+}
@@ -0,0 +1,256 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+)
+
+const testDeploymentID = "test-deployment"
+
+// DeviceStoreTestSuite exercises the device store adapter against the in-memory runtime store.
+type DeviceStoreTestSuite struct {
+	suite.Suite
+	store DeviceRequestStoreInterface
+	ctx   context.Context
+}
+
+func TestDeviceStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceStoreTestSuite))
+}
+
+func (s *DeviceStoreTestSuite) SetupTest() {
+	s.store = newDeviceStore(inmemory.Initialize(testDeploymentID))
+	s.ctx = context.Background()
+}
+
+func (s *DeviceStoreTestSuite) sampleRequest() *DeviceAuthRequest {
+	return &DeviceAuthRequest{
+		DeviceCode:     "device-code-1",
+		UserCode:       "BCDFGHJK",
+		ClientID:       "client-1",
+		StandardScopes: "openid profile",
+		Resources:      []string{"https://api.example.com"},
+		State:          DeviceStatePending,
+		Interval:       5,
+		ExpiryTime:     time.Now().Add(10 * time.Minute),
+	}
+}
+
+func (s *DeviceStoreTestSuite) TestNewDeviceStore() {
+	s.NotNil(s.store)
+	s.Implements((*DeviceRequestStoreInterface)(nil), s.store)
+}
+
+func (s *DeviceStoreTestSuite) TestAddAndGetByDeviceCode_RoundTrip() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	got, err := s.store.GetByDeviceCode(s.ctx, req.DeviceCode)
+	s.NoError(err)
+	s.Require().NotNil(got)
+	s.Equal(req.DeviceCode, got.DeviceCode)
+	s.Equal(req.UserCode, got.UserCode)
+	s.Equal(req.ClientID, got.ClientID)
+	s.Equal(req.StandardScopes, got.StandardScopes)
+	s.Equal(req.Resources, got.Resources)
+	s.Equal(int64(5), got.Interval)
+	s.Equal(DeviceStatePending, got.State)
+	s.WithinDuration(req.ExpiryTime, got.ExpiryTime, time.Second)
+}
+
+func (s *DeviceStoreTestSuite) TestGetByUserCode_ResolvesIndex() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	got, err := s.store.GetByUserCode(s.ctx, req.UserCode)
+	s.NoError(err)
+	s.Require().NotNil(got)
+	s.Equal(req.DeviceCode, got.DeviceCode)
+}
+
+func (s *DeviceStoreTestSuite) TestGetByUserCode_Missing_ReturnsNotFound() {
+	got, err := s.store.GetByUserCode(s.ctx, "ZZZZZZZZ")
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+	s.Nil(got)
+
+	got, err = s.store.GetByUserCode(s.ctx, "")
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+	s.Nil(got)
+}
+
+func (s *DeviceStoreTestSuite) TestAdd_UserCodeCollision_ReturnsErrUserCodeInUse() {
+	first := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, first))
+
+	second := s.sampleRequest()
+	second.DeviceCode = "device-code-2"
+	err := s.store.Add(s.ctx, second)
+	s.ErrorIs(err, errUserCodeInUse)
+
+	// The original request must still be reachable through its user code.
+	got, err := s.store.GetByUserCode(s.ctx, first.UserCode)
+	s.Require().NoError(err)
+	s.Equal(first.DeviceCode, got.DeviceCode)
+}
+
+func (s *DeviceStoreTestSuite) TestAdd_AlreadyExpired_ReturnsError() {
+	req := s.sampleRequest()
+	req.ExpiryTime = time.Now().Add(-time.Second)
+
+	err := s.store.Add(s.ctx, req)
+	s.Error(err)
+}
+
+func (s *DeviceStoreTestSuite) TestGetByDeviceCode_Missing_ReturnsNotFound() {
+	got, err := s.store.GetByDeviceCode(s.ctx, "no-such-code")
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+	s.Nil(got)
+
+	got, err = s.store.GetByDeviceCode(s.ctx, "")
+	s.ErrorIs(err, ErrDeviceRequestNotFound)
+	s.Nil(got)
+}
+
+func (s *DeviceStoreTestSuite) TestMarkAuthenticated_TransitionsAndRecordsClaims() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	authTime := time.Now().Truncate(time.Second)
+	err := s.store.MarkAuthenticated(s.ctx, req.DeviceCode, "user-1", "openid", "cache-1", "urn:acr", authTime)
+	s.NoError(err)
+
+	got, err := s.store.GetByDeviceCode(s.ctx, req.DeviceCode)
+	s.Require().NoError(err)
+	s.Equal(DeviceStateAuthenticated, got.State)
+	s.Equal("user-1", got.UserID)
+	s.Equal("openid", got.AuthorizedScopes)
+	s.Equal("cache-1", got.AttributeCacheID)
+	s.Equal("urn:acr", got.CompletedACR)
+	s.True(authTime.Equal(got.AuthTime))
+}
+
+func (s *DeviceStoreTestSuite) TestMarkAuthenticated_NotPending_ReturnsError() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+	s.Require().NoError(s.store.UpdateState(s.ctx, req.DeviceCode, DeviceStateDenied))
+
+	err := s.store.MarkAuthenticated(s.ctx, req.DeviceCode, "u", "", "", "", time.Now())
+	s.Error(err)
+}
+
+// TestMarkAuthenticated_ConcurrentCallbacks verifies that only one of several concurrent callbacks
+// may transition a pending request.
+func (s *DeviceStoreTestSuite) TestMarkAuthenticated_ConcurrentCallbacks() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	const workers = 10
+	var wg sync.WaitGroup
+	results := make([]error, workers)
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func(i int) {
+			defer wg.Done()
+			results[i] = s.store.MarkAuthenticated(s.ctx, req.DeviceCode, "user-1", "openid", "cache-1",
+				"urn:acr", time.Now())
+		}(i)
+	}
+	wg.Wait()
+
+	wins := 0
+	for _, err := range results {
+		if err == nil {
+			wins++
+		}
+	}
+	s.Equal(1, wins, "exactly one concurrent callback should win the PENDING->AUTHENTICATED transition")
+}
+
+func (s *DeviceStoreTestSuite) TestMarkConsumed_OnceThenFalse() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+	s.Require().NoError(s.store.MarkAuthenticated(s.ctx, req.DeviceCode, "u", "", "", "", time.Now()))
+
+	consumed, err := s.store.MarkConsumed(s.ctx, req.DeviceCode)
+	s.NoError(err)
+	s.True(consumed)
+
+	again, err := s.store.MarkConsumed(s.ctx, req.DeviceCode)
+	s.NoError(err)
+	s.False(again, "a second consume must not succeed")
+}
+
+func (s *DeviceStoreTestSuite) TestMarkConsumed_NotAuthenticated_ReturnsFalse() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	consumed, err := s.store.MarkConsumed(s.ctx, req.DeviceCode)
+	s.NoError(err)
+	s.False(consumed)
+
+	consumed, err = s.store.MarkConsumed(s.ctx, "no-such-code")
+	s.NoError(err)
+	s.False(consumed)
+}
+
+func (s *DeviceStoreTestSuite) TestUpdateLastPolled_RecordsInterval() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	polledAt := time.Now().Truncate(time.Second)
+	s.Require().NoError(s.store.UpdateLastPolled(s.ctx, req.DeviceCode, polledAt, 10))
+
+	got, err := s.store.GetByDeviceCode(s.ctx, req.DeviceCode)
+	s.Require().NoError(err)
+	s.True(polledAt.Equal(got.LastPolledAt))
+	s.Equal(int64(10), got.Interval)
+}
+
+func (s *DeviceStoreTestSuite) TestUpdateState() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+
+	s.Require().NoError(s.store.UpdateState(s.ctx, req.DeviceCode, DeviceStateExpired))
+
+	got, err := s.store.GetByDeviceCode(s.ctx, req.DeviceCode)
+	s.Require().NoError(err)
+	s.Equal(DeviceStateExpired, got.State)
+}
+
+func (s *DeviceStoreTestSuite) TestUpdateState_NotPending_ReturnsError() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+	s.Require().NoError(s.store.MarkAuthenticated(s.ctx, req.DeviceCode, "u", "", "", "", time.Now()))
+
+	s.Error(s.store.UpdateState(s.ctx, req.DeviceCode, DeviceStateDenied))
+
+	got, err := s.store.GetByDeviceCode(s.ctx, req.DeviceCode)
+	s.Require().NoError(err)
+	s.Equal(DeviceStateAuthenticated, got.State)
+}
+
+// TestUpdateLastPolled_AfterAuthentication_KeepsState verifies that a poll recorded after the user
+// authenticated does not overwrite the authenticated record with the stale pending one.
+func (s *DeviceStoreTestSuite) TestUpdateLastPolled_AfterAuthentication_KeepsState() {
+	req := s.sampleRequest()
+	s.Require().NoError(s.store.Add(s.ctx, req))
+	s.Require().NoError(s.store.MarkAuthenticated(s.ctx, req.DeviceCode, "user-1", "openid", "", "",
+		time.Now()))
+
+	s.NoError(s.store.UpdateLastPolled(s.ctx, req.DeviceCode, time.Now(), 10))
+
+	got, err := s.store.GetByDeviceCode(s.ctx, req.DeviceCode)
+	s.Require().NoError(err)
+	s.Equal(DeviceStateAuthenticated, got.State)
+	s.Equal("user-1", got.UserID)
+	s.Equal(int64(5), got.Interval)
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+// userCodeCharset is the character set user codes are drawn from. It follows RFC 8628 §6.1: base-20
+// consonants only, which avoids vowels (and so accidental words) and characters that are easily
+// confused when read from a screen and typed on another device.
+const userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+
+// userCodeLength is the number of significant characters in a user code. Eight base-20 characters
+// give roughly 34 bits of entropy, which RFC 8628 §5.1 deems sufficient given the short lifetime.
+const userCodeLength = 8
+
+// generateUserCode returns a random user code from userCodeCharset. The code is returned in its
+// normalized (unformatted) form; formatUserCode adds the separator shown to the user.
+func generateUserCode() (string, error) {
+	charsetSize := big.NewInt(int64(len(userCodeCharset)))
+	code := make([]byte, userCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, charsetSize)
+		if err != nil {
+			return "", err
+		}
+		code[i] = userCodeCharset[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// formatUserCode splits a normalized user code into two dash-separated halves for display.
+func formatUserCode(userCode string) string {
+	half := len(userCode) / 2
+	return userCode[:half] + "-" + userCode[half:]
+}
+
+// normalizeUserCode converts a user-entered code into its stored form: upper case with separators and
+// whitespace removed, so that "bcdf-ghjk", "BCDF GHJK" and "BCDFGHJK" all match the same request.
+func normalizeUserCode(userCode string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' || r == '\t' {
+			return -1
+		}
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return r
+	}, strings.TrimSpace(userCode))
+}
@@ -136,6 +136,10 @@ func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata() {
 	// Verify only implemented response types are present
 	assert.Equal(suite.T(), []string{"code"}, metadata.ResponseTypesSupported)
 
+	// Verify the response modes, including the JWT-secured (JARM) variants
+	assert.Equal(suite.T(), []string{"query", "fragment", "form_post", "jwt", "query.jwt", "fragment.jwt",
+		"form_post.jwt"}, metadata.ResponseModesSupported)
+
 	// Verify RFC 9207 advertisement
 	assert.True(suite.T(), metadata.AuthorizationResponseIssParameterSupported)
 }
@@ -150,6 +154,14 @@ func (suite *DiscoveryTestSuite) TestCIBAMetadataAdvertised() {
 	assert.False(suite.T(), metadata.BackchannelUserCodeParameterSupported)
 }
 
+func (suite *DiscoveryTestSuite) TestDeviceAuthorizationEndpointAdvertised() {
+	metadata := suite.discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.Contains(suite.T(), metadata.GrantTypesSupported, string(providers.GrantTypeDeviceCode))
+	assert.Equal(suite.T(), suite.oauthCfg.BaseURL+
+		constants.OAuth2DeviceAuthorizationEndpoint, metadata.DeviceAuthorizationEndpoint)
+}
+
 func (suite *DiscoveryTestSuite) TestOIDCDiscovery() {
 	suite.cryptoMock.EXPECT().GetPublicKeys(mock.Anything, providers.PublicKeyFilter{}).
 		Return([]providers.PublicKeyInfo{{KeyID: "k1", Algorithm: string(cryptolib.AlgorithmRS256)}}, nil)
@@ -173,10 +185,16 @@ func (suite *DiscoveryTestSuite) TestOIDCDiscovery() {
 	assert.NotEmpty(suite.T(), metadata.ScopesSupported)
 	assert.Contains(suite.T(), metadata.ScopesSupported, "openid")
 	assert.NotEmpty(suite.T(), metadata.EndSessionEndpoint)
+	assert.True(suite.T(), metadata.BackchannelLogoutSupported)
+	assert.True(suite.T(), metadata.BackchannelLogoutSessionSupported)
+	assert.True(suite.T(), metadata.FrontchannelLogoutSupported)
+	assert.True(suite.T(), metadata.FrontchannelLogoutSessionSupported)
 
 	// Verify OIDC-specific fields
 	assert.Contains(suite.T(), metadata.SubjectTypesSupported, constants.SubjectTypePublic)
+	assert.Contains(suite.T(), metadata.SubjectTypesSupported, constants.SubjectTypePairwise)
 	assert.Contains(suite.T(), metadata.IDTokenSigningAlgValuesSupported, "RS256")
+	assert.Contains(suite.T(), metadata.AuthorizationSigningAlgValuesSupported, "RS256")
 	assert.Contains(suite.T(), metadata.ClaimsSupported, constants.ClaimSub)
 	assert.Contains(suite.T(), metadata.ClaimsSupported, constants.ClaimIss)
 	assert.Contains(suite.T(), metadata.ClaimsSupported, constants.ClaimAud)
@@ -204,6 +222,17 @@ func (suite *DiscoveryTestSuite) TestDPoPSigningAlgValuesAdvertised() {
 	assert.Equal(suite.T(), expected, oidcMeta.DPoPSigningAlgValuesSupported)
 }
 
+func (suite *DiscoveryTestSuite) TestRequestObjectMetadataAdvertised() {
+	oauth2Meta := suite.discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.True(suite.T(), oauth2Meta.RequestParameterSupported)
+	assert.True(suite.T(), oauth2Meta.TLSClientCertificateBoundAccessTokens)
+	assert.True(suite.T(), oauth2Meta.RequestURIParameterSupported)
+	assert.True(suite.T(), oauth2Meta.RequireRequestURIRegistration)
+	assert.Equal(suite.T(), []string{"ES256", "PS256", "ES384", "ES512", "EdDSA", "RS256"},
+		oauth2Meta.RequestObjectSigningAlgValuesSupported)
+}
+
 func (suite *DiscoveryTestSuite) TestDPoPSigningAlgValuesOmittedWhenUnconfigured() {
 	config.ResetServerRuntime()
 	testConfig := &config.Config{
@@ -245,6 +274,8 @@ func (suite *DiscoveryTestSuite) TestDCRRevocationLogoutEndpointsOmittedWhenDisa
 	oidcMeta, err := svc.GetOIDCMetadata(context.Background())
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), oidcMeta.EndSessionEndpoint)
+	assert.False(suite.T(), oidcMeta.BackchannelLogoutSupported)
+	assert.False(suite.T(), oidcMeta.FrontchannelLogoutSupported)
 
 	body, err := json.Marshal(oauth2Meta)
 	assert.NoError(suite.T(), err)
@@ -319,13 +350,14 @@ func TestGetSupportedGrantTypes(t *testing.T) {
 	supported := constants.GetSupportedGrantTypes(oauthconfig.Config{})
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 6, len(supported))
+	assert.Equal(t, 7, len(supported))
 	assert.Contains(t, supported, "authorization_code")
 	assert.Contains(t, supported, "client_credentials")
 	assert.Contains(t, supported, "refresh_token")
 	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:token-exchange")
 	assert.Contains(t, supported, "urn:openid:params:grant-type:ciba")
 	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:device_code")
 	assert.NotContains(t, supported, "password")
 	assert.NotContains(t, supported, "implicit")
 }
@@ -343,11 +375,13 @@ func TestGetSupportedTokenEndpointAuthMethods(t *testing.T) {
 	supported := constants.GetSupportedTokenEndpointAuthMethods(oauthconfig.Config{})
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 4, len(supported))
+	assert.Equal(t, 6, len(supported))
 	assert.Contains(t, supported, "client_secret_basic")
 	assert.Contains(t, supported, "client_secret_post")
 	assert.Contains(t, supported, "none")
 	assert.Contains(t, supported, "private_key_jwt")
+	assert.Contains(t, supported, "tls_client_auth")
+	assert.Contains(t, supported, "self_signed_tls_client_auth")
 	assert.NotContains(t, supported, "client_secret_jwt")
 }
 
@@ -364,9 +398,9 @@ func TestGetSupportedSubjectTypes(t *testing.T) {
 	supported := constants.GetSupportedSubjectTypes()
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 1, len(supported))
+	assert.Equal(t, 2, len(supported))
 	assert.Contains(t, supported, constants.SubjectTypePublic)
-	assert.Equal(t, []string{"public"}, supported)
+	assert.Equal(t, []string{"public", "pairwise"}, supported)
 }
 
 // TestGetStandardClaims tests the GetStandardClaims function
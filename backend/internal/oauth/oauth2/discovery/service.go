@@ -54,11 +54,17 @@ func (ds *discoveryService) GetOAuth2AuthorizationServerMetadata(
 		PushedAuthorizationRequestEndpoint:         ds.getPAREndpoint(),
 		RequirePushedAuthorizationRequests:         ds.isGlobalPARRequired(),
 		ResponseTypesSupported:                     ds.getSupportedResponseTypes(),
+		ResponseModesSupported:                     ds.getSupportedResponseModes(),
 		GrantTypesSupported:                        ds.getSupportedGrantTypes(),
 		TokenEndpointAuthMethodsSupported:          ds.getSupportedTokenEndpointAuthMethods(),
 		CodeChallengeMethodsSupported:              ds.getSupportedCodeChallengeMethods(),
 		AuthorizationResponseIssParameterSupported: true,
 		DPoPSigningAlgValuesSupported:              ds.getSupportedDPoPSigningAlgs(),
+		TLSClientCertificateBoundAccessTokens:      true,
+		RequestParameterSupported:                  true,
+		RequestURIParameterSupported:               true,
+		RequireRequestURIRegistration:              true,
+		RequestObjectSigningAlgValuesSupported:     ds.getSupportedRequestObjectSigningAlgs(),
 	}
 
 	if slices.Contains(metadata.GrantTypesSupported, string(providers.GrantTypeCIBA)) {
@@ -66,6 +72,9 @@ func (ds *discoveryService) GetOAuth2AuthorizationServerMetadata(
 		metadata.BackchannelTokenDeliveryModesSupported = []string{"poll"}
 		metadata.BackchannelUserCodeParameterSupported = false
 	}
+	if slices.Contains(metadata.GrantTypesSupported, string(providers.GrantTypeDeviceCode)) {
+		metadata.DeviceAuthorizationEndpoint = ds.getDeviceAuthorizationEndpoint()
+	}
 	if ds.cfg.OAuth.TokenRevocation.IsEnabled() {
 		metadata.RevocationEndpoint = ds.getRevocationEndpoint()
 	}
@@ -87,23 +96,32 @@ func (ds *discoveryService) GetOIDCMetadata(ctx context.Context) (*OIDCProviderM
 	encryptionEncs := ds.jweService.SupportedContentEncryptionAlgorithms()
 
 	oidcProviderMetadata := &OIDCProviderMetadata{
-		OAuth2AuthorizationServerMetadata:    *oauth2Meta,
-		UserInfoEndpoint:                     ds.getUserInfoEndpoint(),
-		ScopesSupported:                      ds.getSupportedOIDCScopes(),
-		SubjectTypesSupported:                ds.getSupportedSubjectTypes(),
-		IDTokenSigningAlgValuesSupported:     signingAlgs,
-		UserInfoSigningAlgValuesSupported:    signingAlgs,
-		UserInfoEncryptionAlgValuesSupported: encryptionAlgs,
-		UserInfoEncryptionEncValuesSupported: encryptionEncs,
-		IDTokenEncryptionAlgValuesSupported:  encryptionAlgs,
-		IDTokenEncryptionEncValuesSupported:  encryptionEncs,
-		ClaimsSupported:                      ds.getSupportedClaims(),
-		ClaimsParameterSupported:             true,
-		AcrValuesSupported:                   ds.getSupportedAcrValues(),
+		OAuth2AuthorizationServerMetadata:         *oauth2Meta,
+		UserInfoEndpoint:                          ds.getUserInfoEndpoint(),
+		ScopesSupported:                           ds.getSupportedOIDCScopes(),
+		SubjectTypesSupported:                     ds.getSupportedSubjectTypes(),
+		IDTokenSigningAlgValuesSupported:          signingAlgs,
+		UserInfoSigningAlgValuesSupported:         signingAlgs,
+		UserInfoEncryptionAlgValuesSupported:      encryptionAlgs,
+		UserInfoEncryptionEncValuesSupported:      encryptionEncs,
+		AuthorizationSigningAlgValuesSupported:    signingAlgs,
+		AuthorizationEncryptionAlgValuesSupported: encryptionAlgs,
+		AuthorizationEncryptionEncValuesSupported: encryptionEncs,
+		IDTokenEncryptionAlgValuesSupported:       encryptionAlgs,
+		IDTokenEncryptionEncValuesSupported:       encryptionEncs,
+		ClaimsSupported:                           ds.getSupportedClaims(),
+		ClaimsParameterSupported:                  true,
+		AcrValuesSupported:                        ds.getSupportedAcrValues(),
 	}
 
 	if ds.cfg.OAuth.Logout.IsEnabled() {
 		oidcProviderMetadata.EndSessionEndpoint = ds.getEndSessionEndpoint()
+		// Sessions ended by the logout endpoint notify their participating applications, and the ID
+		// tokens and logout tokens carry the session id (sid).
+		oidcProviderMetadata.BackchannelLogoutSupported = true
+		oidcProviderMetadata.BackchannelLogoutSessionSupported = true
+		oidcProviderMetadata.FrontchannelLogoutSupported = true
+		oidcProviderMetadata.FrontchannelLogoutSessionSupported = true
 	}
 
 	return oidcProviderMetadata, nil
@@ -157,6 +175,10 @@ func (ds *discoveryService) getSupportedResponseTypes() []string {
 	return constants.GetSupportedResponseTypes(ds.cfg)
 }
 
+func (ds *discoveryService) getSupportedResponseModes() []string {
+	return constants.GetSupportedResponseModes()
+}
+
 func (ds *discoveryService) getSupportedGrantTypes() []string {
 	return constants.GetSupportedGrantTypes(ds.cfg)
 }
@@ -177,6 +199,10 @@ func (ds *discoveryService) getBackchannelAuthenticationEndpoint() string {
 	return ds.cfg.BaseURL + constants.OAuth2BackchannelAuthEndpoint
 }
 
+func (ds *discoveryService) getDeviceAuthorizationEndpoint() string {
+	return ds.cfg.BaseURL + constants.OAuth2DeviceAuthorizationEndpoint
+}
+
 func (ds *discoveryService) isGlobalPARRequired() bool {
 	return ds.cfg.OAuth.PAR.RequirePAR
 }
@@ -185,6 +211,10 @@ func (ds *discoveryService) getSupportedDPoPSigningAlgs() []string {
 	return ds.cryptoProvider.GetSupportedSigningAlgorithms()
 }
 
+func (ds *discoveryService) getSupportedRequestObjectSigningAlgs() []string {
+	return ds.cryptoProvider.GetSupportedSigningAlgorithms()
+}
+
 func (ds *discoveryService) getSupportedSubjectTypes() []string {
 	return constants.GetSupportedSubjectTypes()
 }
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
@@ -19,6 +20,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -150,6 +152,13 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		accessTokenScopes = append(accessTokenScopes, downscopedNonOidc...)
 	}
 
+	// The token request may narrow the authorization details approved for the code.
+	authorizationDetails, errResp := authorizationdetails.Narrow(
+		tokenRequest.AuthorizationDetails, authCode.AuthorizationDetails)
+	if errResp != nil {
+		return nil, errResp
+	}
+
 	// Generate access token using tokenBuilder (attributes will be filtered in BuildAccessToken)
 	userSubConfig := oauthApp.UserAccessTokenConfig()
 	accessTokenCtx := &tokenservice.AccessTokenBuildContext{
@@ -165,7 +174,11 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		ClaimsLocales:     authCode.ClaimsLocales,
 		ValidityPeriod:    userSubConfig.ValidityPeriodOrZero(),
 		DPoPJkt:           dpop.GetJkt(ctx),
+		CertThumbprint:    mtls.GetThumbprint(ctx),
 		TokenFamilyID:     authCode.TokenFamilyID,
+		ConsentID:         authCode.ConsentID,
+
+		AuthorizationDetails: authorizationDetails,
 	}
 	if oauthApp.ShouldAppendActorClaim() {
 		accessTokenCtx.ActorClaims = &tokenservice.SubjectTokenClaims{Sub: oauthApp.ID}
@@ -198,6 +211,7 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 			ClaimsRequest:  authCode.ClaimsRequest,
 			Nonce:          authCode.Nonce,
 			CompletedACR:   authCode.CompletedACR,
+			SessionID:      authCode.SessionID,
 		})
 		if err != nil {
 			logger.Error(ctx, "Failed to generate ID token", log.Error(err))
@@ -1580,6 +1580,49 @@ func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_DPoPBoundCo
 	assert.Equal(suite.T(), constants.TokenTypeDPoP, result.AccessToken.TokenType)
 }
 
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_TokenRequestNarrowsAuthorizationDetails() {
+	authzCodeWithDetails := suite.testAuthzCode
+	authzCodeWithDetails.AuthorizationDetails = []providers.AuthorizationDetail{
+		{"type": "account_information"}, {"type": "payment_initiation"},
+	}
+	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
+		Return(&authzCodeWithDetails, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken",
+		mock.Anything,
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return len(ctx.AuthorizationDetails) == 1 && ctx.AuthorizationDetails[0].Type() == "payment_initiation"
+		})).Return(&model.TokenDTO{
+		Token:     "test-jwt-token",
+		TokenType: constants.TokenTypeBearer,
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+		ClientID:  testClientID,
+	}, nil)
+
+	tokenReq := *suite.testTokenReq
+	tokenReq.AuthorizationDetails = `[{"type":"payment_initiation"}]`
+	result, err := suite.handler.HandleGrant(context.Background(), &tokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockTokenBuilder.AssertExpectations(suite.T())
+}
+
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_TokenRequestAuthorizationDetailsNotGranted() {
+	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
+		Return(&suite.testAuthzCode, nil)
+
+	tokenReq := *suite.testTokenReq
+	tokenReq.AuthorizationDetails = `[{"type":"payment_initiation"}]`
+	result, err := suite.handler.HandleGrant(context.Background(), &tokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, err.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything, mock.Anything)
+}
+
 func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_DownscopeValidationError() {
 	authCode := suite.testAuthzCode
 	authCode.Resources = []string{testResourceURL}
@@ -7,12 +7,14 @@ import (
 	"context"
 	"slices"
 
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -71,9 +73,14 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 	// scope. Bind the token to a single resource server (RFC 8707 resource or the configured
 	// default). A request with neither scopes nor a resource is not bound to a resource server: its
 	// audience is the app's configured default audiences (falling back to the client_id) and it
-	// carries no scopes.
-	targetRS, errResp := resourceindicators.ResolveAudienceBinding(
-		ctx, h.resourceService, tokenRequest.Resources, scopes)
+	// carries no scopes. Requested authorization details always bind the token and are validated
+	// against the target resource server; the client is the resource owner, so no consent applies.
+	authorizationDetails, errResp := authorizationdetails.Parse(tokenRequest.AuthorizationDetails)
+	if errResp != nil {
+		return nil, errResp
+	}
+	targetRS, errResp := authorizationdetails.ResolveAudienceBinding(
+		ctx, h.resourceService, tokenRequest.Resources, scopes, authorizationDetails)
 	if errResp != nil {
 		return nil, errResp
 	}
@@ -141,6 +148,9 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		OAuthApp:          oauthApp,
 		ValidityPeriod:    oauthApp.ClientAccessTokenConfig().ValidityPeriodOrZero(),
 		DPoPJkt:           dpop.GetJkt(ctx),
+		CertThumbprint:    mtls.GetThumbprint(ctx),
+
+		AuthorizationDetails: authorizationDetails,
 	})
 	if err != nil {
 		return nil, &model.ErrorResponse{
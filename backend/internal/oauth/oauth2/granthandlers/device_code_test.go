@@ -0,0 +1,268 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package granthandlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/devicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+)
+
+type DeviceCodeGrantHandlerTestSuite struct {
+	suite.Suite
+	handler              GrantHandlerInterface
+	mockDeviceService    *devicemock.DeviceServiceInterfaceMock
+	mockTokenBuilder     *tokenservicemock.TokenBuilderInterfaceMock
+	mockAttrCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockResource         *resourcemock.ResourceServiceInterfaceMock
+	oauthApp             *providers.OAuthClient
+	tokenReq             *model.TokenRequest
+}
+
+func TestDeviceCodeGrantHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceCodeGrantHandlerTestSuite))
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) SetupTest() {
+	suite.mockDeviceService = devicemock.NewDeviceServiceInterfaceMock(suite.T())
+	suite.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
+	suite.mockAttrCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
+	suite.mockResource = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.handler = newDeviceCodeGrantHandler(suite.mockDeviceService, suite.mockTokenBuilder,
+		suite.mockAttrCacheService, suite.mockResource)
+	suite.oauthApp = &providers.OAuthClient{ClientID: "client-1"}
+	suite.tokenReq = &model.TokenRequest{
+		GrantType:  string(providers.GrantTypeDeviceCode),
+		ClientID:   "client-1",
+		DeviceCode: "device-code-1",
+	}
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) pendingRecord() *device.DeviceAuthRequest {
+	return &device.DeviceAuthRequest{
+		DeviceCode:       "device-code-1",
+		UserCode:         "BCDFGHJK",
+		ClientID:         "client-1",
+		UserID:           "user-1",
+		AuthorizedScopes: "openid profile",
+		State:            device.DeviceStatePending,
+		Interval:         constants.DeviceCodeDefaultIntervalSeconds,
+		ExpiryTime:       time.Now().Add(5 * time.Minute),
+	}
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_Success() {
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").
+		Return(suite.pendingRecord(), nil)
+
+	errResp := suite.handler.ValidateGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(errResp)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_WrongGrantType() {
+	req := &model.TokenRequest{GrantType: string(providers.GrantTypeCIBA), DeviceCode: "x"}
+	errResp := suite.handler.ValidateGrant(context.Background(), req, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorUnsupportedGrantType, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_MissingDeviceCode() {
+	req := &model.TokenRequest{GrantType: string(providers.GrantTypeDeviceCode)}
+	errResp := suite.handler.ValidateGrant(context.Background(), req, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidRequest, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_RequestNotFound() {
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").
+		Return(nil, device.ErrDeviceRequestNotFound)
+
+	errResp := suite.handler.ValidateGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_ClientMismatch() {
+	record := suite.pendingRecord()
+	record.ClientID = "other-client"
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").Return(record, nil)
+
+	errResp := suite.handler.ValidateGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant_ResourceMismatch() {
+	record := suite.pendingRecord()
+	record.Resources = []string{"https://api.example.com"}
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").Return(record, nil)
+	suite.tokenReq.Resources = []string{"https://other.example.com"}
+
+	errResp := suite.handler.ValidateGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidTarget, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_Pending() {
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").
+		Return(suite.pendingRecord(), nil)
+	suite.mockDeviceService.EXPECT().UpdateLastPolled(mock.Anything, "device-code-1",
+		mock.AnythingOfType("time.Time"), int64(constants.DeviceCodeDefaultIntervalSeconds)).Return(nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(resp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_SlowDownIncreasesInterval() {
+	record := suite.pendingRecord()
+	record.LastPolledAt = time.Now().Add(-1 * time.Second)
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").Return(record, nil)
+	suite.mockDeviceService.EXPECT().UpdateLastPolled(mock.Anything, "device-code-1",
+		mock.AnythingOfType("time.Time"),
+		int64(constants.DeviceCodeDefaultIntervalSeconds+constants.DeviceCodeSlowDownIncrementSeconds)).
+		Return(nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(resp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorSlowDown, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_IncreasedIntervalIsEnforced() {
+	// After a slow_down the stored interval is 10s; a poll 7s later is still too fast.
+	record := suite.pendingRecord()
+	record.Interval = 10
+	record.LastPolledAt = time.Now().Add(-7 * time.Second)
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").Return(record, nil)
+	suite.mockDeviceService.EXPECT().UpdateLastPolled(mock.Anything, "device-code-1",
+		mock.AnythingOfType("time.Time"), int64(15)).Return(nil)
+
+	_, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorSlowDown, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_Expired() {
+	record := suite.pendingRecord()
+	record.ExpiryTime = time.Now().Add(-1 * time.Minute)
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").Return(record, nil)
+	suite.mockDeviceService.EXPECT().UpdateState(mock.Anything, "device-code-1", device.DeviceStateExpired).
+		Return(nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(resp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorExpiredToken, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_TerminalStates() {
+	testCases := []struct {
+		name          string
+		state         device.DeviceRequestState
+		expectedError string
+	}{
+		{"Denied", device.DeviceStateDenied, constants.ErrorAccessDenied},
+		{"Failed", device.DeviceStateFailed, constants.ErrorServerError},
+		{"Consumed", device.DeviceStateConsumed, constants.ErrorInvalidGrant},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			record := suite.pendingRecord()
+			record.State = tc.state
+			suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").Return(record, nil)
+
+			resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+			suite.Nil(resp)
+			suite.NotNil(errResp)
+			suite.Equal(tc.expectedError, errResp.Error)
+		})
+	}
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_Authenticated_IssuesTokens() {
+	record := suite.pendingRecord()
+	record.State = device.DeviceStateAuthenticated
+	record.AttributeCacheID = "cache-1"
+	record.CompletedACR = "urn:acr:pwd"
+	record.AuthTime = time.Now()
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").Return(record, nil)
+	suite.mockAttrCacheService.EXPECT().GetAttributeCache(mock.Anything, "cache-1").Return(
+		&attributecache.AttributeCache{ID: "cache-1", Attributes: map[string]interface{}{"email": "a@b.c"}},
+		nil)
+	suite.mockTokenBuilder.EXPECT().BuildAccessToken(mock.Anything, mock.MatchedBy(
+		func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return ctx.Subject == "user-1" && ctx.ClientID == "client-1" &&
+				ctx.GrantType == string(providers.GrantTypeDeviceCode)
+		})).Return(&model.TokenDTO{Token: "access-token", TokenType: "Bearer", ExpiresIn: 3600}, nil)
+	suite.mockTokenBuilder.EXPECT().BuildIDToken(mock.Anything, mock.MatchedBy(
+		func(ctx *tokenservice.IDTokenBuildContext) bool {
+			return ctx.Subject == "user-1" && ctx.CompletedACR == "urn:acr:pwd"
+		})).Return(&model.TokenDTO{Token: "id-token"}, nil)
+	suite.mockDeviceService.EXPECT().MarkConsumed(mock.Anything, "device-code-1").Return(true, nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(errResp)
+	suite.NotNil(resp)
+	suite.Equal("access-token", resp.AccessToken.Token)
+	suite.Equal("id-token", resp.IDToken.Token)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_Authenticated_OneTimeUseRace() {
+	record := suite.pendingRecord()
+	record.State = device.DeviceStateAuthenticated
+	record.AuthorizedScopes = constants.ScopeOpenID
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").Return(record, nil)
+	suite.mockTokenBuilder.EXPECT().BuildAccessToken(mock.Anything, mock.Anything).Return(
+		&model.TokenDTO{Token: "access-token", TokenType: "Bearer"}, nil)
+	suite.mockTokenBuilder.EXPECT().BuildIDToken(mock.Anything, mock.Anything).
+		Return(&model.TokenDTO{Token: "id-token"}, nil)
+	suite.mockDeviceService.EXPECT().MarkConsumed(mock.Anything, "device-code-1").Return(false, nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(resp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_UnboundWithPermissionScopes() {
+	record := suite.pendingRecord()
+	record.State = device.DeviceStateAuthenticated
+	record.AuthorizedScopes = "openid read"
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").Return(record, nil)
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(resp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_StoreError() {
+	suite.mockDeviceService.EXPECT().GetByDeviceCode(mock.Anything, "device-code-1").
+		Return(nil, errors.New("db error"))
+
+	resp, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(resp)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorServerError, errResp.Error)
+}
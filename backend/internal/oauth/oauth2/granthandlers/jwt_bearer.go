@@ -15,6 +15,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -179,6 +180,7 @@ func (h *jwtBearerGrantHandler) HandleGrant(ctx context.Context, tokenRequest *m
 		OAuthApp:          oauthApp,
 		SourceIDP:         assertionClaims.Iss,
 		DPoPJkt:           dpop.GetJkt(ctx),
+		CertThumbprint:    mtls.GetThumbprint(ctx),
 	})
 	if err != nil {
 		logger.Error(ctx, "Failed to generate token", log.Error(err))
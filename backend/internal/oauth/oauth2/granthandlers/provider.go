@@ -11,6 +11,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
@@ -30,6 +31,7 @@ type GrantHandlerProvider struct {
 	tokenExchangeGrantHandler     GrantHandlerInterface
 	cibaGrantHandler              GrantHandlerInterface
 	jwtBearerGrantHandler         GrantHandlerInterface
+	deviceCodeGrantHandler        GrantHandlerInterface
 }
 
 // newGrantHandlerProvider creates a new instance of GrantHandlerProvider.
@@ -44,6 +46,7 @@ func newGrantHandlerProvider(
 	actorProvider providers.ActorProvider,
 	resourceService providers.ResourceServerProvider,
 	cibaService ciba.CIBAServiceInterface,
+	deviceService device.DeviceServiceInterface,
 	refreshTokenRevoker revocation.RefreshTokenRevokerInterface,
 	criteriaRevoker revocation.CriteriaRevokerInterface,
 	cfg oauthconfig.Config,
@@ -75,6 +78,10 @@ func newGrantHandlerProvider(
 		grantProvider.jwtBearerGrantHandler = newJWTBearerGrantHandler(
 			tokenBuilder, tokenValidator, resourceService)
 	}
+	if isGrantTypeAllowed(allowedGrantTypes, providers.GrantTypeDeviceCode) {
+		grantProvider.deviceCodeGrantHandler = newDeviceCodeGrantHandler(deviceService, tokenBuilder,
+			attrCacheService, resourceService)
+	}
 	return grantProvider
 }
 
@@ -103,6 +110,8 @@ func (p *GrantHandlerProvider) GetGrantHandler(grantType providers.GrantType) (G
 		handler = p.cibaGrantHandler
 	case providers.GrantTypeJWTBearer:
 		handler = p.jwtBearerGrantHandler
+	case providers.GrantTypeDeviceCode:
+		handler = p.deviceCodeGrantHandler
 	}
 	if handler == nil {
 		return nil, constants.UnSupportedGrantTypeError
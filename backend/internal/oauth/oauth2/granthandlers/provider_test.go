@@ -17,6 +17,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/authzmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/cibamock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/devicemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/revocationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
@@ -37,6 +38,7 @@ type GrantHandlerProviderTestSuite struct {
 	mockEntityProvider   *actorprovidermock.ActorProviderMock
 	mockResourceService  *resourcemock.ResourceServiceInterfaceMock
 	mockCIBAService      *cibamock.CIBAServiceInterfaceMock
+	mockDeviceService    *devicemock.DeviceServiceInterfaceMock
 }
 
 func TestGrantHandlerProviderSuite(t *testing.T) {
@@ -54,6 +56,7 @@ func (suite *GrantHandlerProviderTestSuite) SetupTest() {
 	suite.mockEntityProvider = actorprovidermock.NewActorProviderMock(suite.T())
 	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
 	suite.mockCIBAService = cibamock.NewCIBAServiceInterfaceMock(suite.T())
+	suite.mockDeviceService = devicemock.NewDeviceServiceInterfaceMock(suite.T())
 	suite.provider = newGrantHandlerProvider(
 		suite.mockJWTService,
 		suite.authzService,
@@ -65,6 +68,7 @@ func (suite *GrantHandlerProviderTestSuite) SetupTest() {
 		suite.mockEntityProvider,
 		suite.mockResourceService,
 		suite.mockCIBAService,
+		suite.mockDeviceService,
 		revocationmock.NewRefreshTokenRevokerInterfaceMock(suite.T()),
 		revocationmock.NewCriteriaRevokerInterfaceMock(suite.T()),
 		testhelpers.OAuthConfig(),
@@ -83,6 +87,7 @@ func (suite *GrantHandlerProviderTestSuite) TestNewGrantHandlerProvider() {
 		suite.mockEntityProvider,
 		suite.mockResourceService,
 		suite.mockCIBAService,
+		suite.mockDeviceService,
 		revocationmock.NewRefreshTokenRevokerInterfaceMock(suite.T()),
 		revocationmock.NewCriteriaRevokerInterfaceMock(suite.T()),
 		testhelpers.OAuthConfig(),
@@ -132,6 +137,14 @@ func (suite *GrantHandlerProviderTestSuite) TestGetGrantHandler_CIBA() {
 	assert.Implements(suite.T(), (*GrantHandlerInterface)(nil), handler)
 }
 
+func (suite *GrantHandlerProviderTestSuite) TestGetGrantHandler_DeviceCode() {
+	handler, err := suite.provider.GetGrantHandler(providers.GrantTypeDeviceCode)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), handler)
+	assert.Implements(suite.T(), (*GrantHandlerInterface)(nil), handler)
+}
+
 func (suite *GrantHandlerProviderTestSuite) TestGetGrantHandler_JWTBearer() {
 	handler, err := suite.provider.GetGrantHandler(providers.GrantTypeJWTBearer)
 
@@ -168,6 +181,7 @@ func (suite *GrantHandlerProviderTestSuite) TestGetGrantHandler_AllSupportedType
 		providers.GrantTypeTokenExchange,
 		providers.GrantTypeCIBA,
 		providers.GrantTypeJWTBearer,
+		providers.GrantTypeDeviceCode,
 	}
 
 	for _, grantType := range supportedTypes {
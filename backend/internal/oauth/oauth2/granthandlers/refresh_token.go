@@ -15,6 +15,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
@@ -24,6 +25,7 @@ import (
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 )
 
 // refreshTokenGrantHandler handles the refresh token grant type.
@@ -184,6 +186,13 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		newTokenScopes = append(newTokenScopes, downscopedNonOidc...)
 	}
 
+	// The token request may narrow the authorization details carried on the refresh token.
+	authorizationDetails, detailsErr := authorizationdetails.Narrow(
+		tokenRequest.AuthorizationDetails, refreshTokenClaims.AuthorizationDetails)
+	if detailsErr != nil {
+		return nil, detailsErr
+	}
+
 	// Get user attributes from attribute cache.
 	// cacheEntry is kept so its current TTLSeconds can be compared later.
 	attrs := make(map[string]interface{})
@@ -224,7 +233,11 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		ClaimsLocales:     refreshTokenClaims.ClaimsLocales,
 		ValidityPeriod:    userSubConfig.ValidityPeriodOrZero(),
 		DPoPJkt:           dpop.GetJkt(ctx),
+		CertThumbprint:    mtls.GetThumbprint(ctx),
 		TokenFamilyID:     refreshTokenClaims.TokenFamilyID,
+		ConsentID:         refreshTokenClaims.ConsentID,
+
+		AuthorizationDetails: authorizationDetails,
 	}
 	// Replay the on-behalf-of decision frozen at issuance, sourced from the stored marker
 	// rather than the client's current setting.
@@ -350,6 +363,12 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 	attributeCacheID string,
 	tokenFamilyID string,
 ) *model.ErrorResponse {
+	if tokenResponse == nil {
+		tokenResponse = &model.TokenResponseDTO{}
+	}
+
+	// The refresh token carries the authorization details granted to the access token issued with
+	// it, so a token request that narrows the details also narrows the refreshable grant.
 	tokenCtx := &tokenservice.RefreshTokenBuildContext{
 		ClientID:             oauthApp.ClientID,
 		Scopes:               scopes,
@@ -362,6 +381,8 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 		ClaimsLocales:        claimsLocales,
 		DPoPJkt:              dpopJktForRefresh(ctx, oauthApp),
 		TokenFamilyID:        tokenFamilyID,
+		ConsentID:            tokenResponse.AccessToken.ConsentID,
+		AuthorizationDetails: tokenResponse.AccessToken.AuthorizationDetails,
 	}
 	if oauthApp.ShouldAppendActorClaim() {
 		tokenCtx.ActorSub = oauthApp.ID
@@ -376,9 +397,6 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 		}
 	}
 
-	if tokenResponse == nil {
-		tokenResponse = &model.TokenResponseDTO{}
-	}
 	tokenResponse.RefreshToken = *refreshToken
 	return nil
 }
@@ -19,6 +19,7 @@ import (
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -318,6 +319,7 @@ func (h *tokenExchangeGrantHandler) HandleGrant(ctx context.Context, tokenReques
 		ActorClaims:       actorClaims,
 		ValidityPeriod:    userSubConfig.ValidityPeriodOrZero(),
 		DPoPJkt:           dpop.GetJkt(ctx),
+		CertThumbprint:    mtls.GetThumbprint(ctx),
 		TokenFamilyID:     exchangedTokenFamilyID,
 	})
 	if err != nil {
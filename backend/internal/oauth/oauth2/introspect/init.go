@@ -10,6 +10,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -25,9 +26,10 @@ func Initialize(
 	discoveryService discovery.DiscoveryServiceInterface,
 	tokenValidator tokenservice.TokenValidatorInterface,
 	jtiStore jti.JTIStoreInterface,
+	pairwiseService pairwise.PairwiseServiceInterface,
 	leeway int64,
 ) TokenIntrospectionServiceInterface {
-	introspectionService := newTokenIntrospectionService(tokenValidator)
+	introspectionService := newTokenIntrospectionService(tokenValidator, pairwiseService)
 	introspectHandler := newTokenIntrospectionHandler(introspectionService)
 	registerRoutes(mux, introspectHandler, actorProvider, authnProvider, jwtService, discoveryService,
 		jtiStore, leeway)
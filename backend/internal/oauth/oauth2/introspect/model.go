@@ -3,6 +3,8 @@
 
 package introspect
 
+import "github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
 // IntrospectRequest represents the request to the token introspection endpoint
 type IntrospectRequest struct {
 	Token         string `json:"token" form:"token"`
@@ -24,10 +26,14 @@ type IntrospectResponse struct {
 	Iss       string    `json:"iss,omitempty"`
 	Jti       string    `json:"jti,omitempty"`
 	Cnf       *CnfClaim `json:"cnf,omitempty"`
+
+	AuthorizationDetails []providers.AuthorizationDetail `json:"authorization_details,omitempty"`
 }
 
 // CnfClaim represents the confirmation claim. For DPoP-bound tokens this carries
-// the JWK SHA-256 thumbprint.
+// the JWK SHA-256 thumbprint; for certificate-bound tokens (RFC 8705) the SHA-256
+// thumbprint of the client certificate.
 type CnfClaim struct {
-	Jkt string `json:"jkt,omitempty"`
+	Jkt     string `json:"jkt,omitempty"`
+	X5tS256 string `json:"x5t#S256,omitempty"`
 }
@@ -8,11 +8,14 @@ import (
 	"context"
 	"errors"
 
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 )
 
 // TokenIntrospectionServiceInterface defines the interface for OAuth 2.0 token introspection.
@@ -22,15 +25,18 @@ type TokenIntrospectionServiceInterface interface {
 
 // tokenIntrospectionService implements the TokenIntrospectionServiceInterface.
 type tokenIntrospectionService struct {
-	tokenValidator tokenservice.TokenValidatorInterface
+	tokenValidator  tokenservice.TokenValidatorInterface
+	pairwiseService pairwise.PairwiseServiceInterface
 }
 
 // newTokenIntrospectionService creates a new tokenIntrospectionService instance (internal use).
 func newTokenIntrospectionService(
 	tokenValidator tokenservice.TokenValidatorInterface,
+	pairwiseService pairwise.PairwiseServiceInterface,
 ) TokenIntrospectionServiceInterface {
 	return &tokenIntrospectionService{
-		tokenValidator: tokenValidator,
+		tokenValidator:  tokenValidator,
+		pairwiseService: pairwiseService,
 	}
 }
 
@@ -60,7 +66,29 @@ func (s *tokenIntrospectionService) IntrospectToken(
 		}, nil
 	}
 
-	return s.prepareValidResponse(payload), nil
+	// A token whose certificate binding cannot be read cannot be checked by the resource server.
+	if _, err := mtls.ExtractCnfThumbprint(payload); err != nil {
+		logger.Debug(ctx, "Token has an invalid certificate binding", log.Error(err))
+		return &IntrospectResponse{
+			Active: false,
+		}, nil
+	}
+
+	response := s.prepareValidResponse(payload)
+
+	// A token issued to a pairwise client reports the client's pairwise subject rather than the user id.
+	// Client credentials tokens carry the client id as sub and are left as is.
+	if s.pairwiseService != nil && response.Sub != "" && response.ClientID != "" &&
+		response.Sub != response.ClientID {
+		sub, err := s.pairwiseService.GetSubjectForClient(ctx, response.ClientID, response.Sub)
+		if err != nil {
+			logger.Error(ctx, "Failed to resolve pairwise subject", log.Error(err))
+			return nil, err
+		}
+		response.Sub = sub
+	}
+
+	return response, nil
 }
 
 // prepareValidResponse prepares the response for a valid token introspection.
@@ -74,6 +102,12 @@ func (s *tokenIntrospectionService) prepareValidResponse(payload map[string]inte
 		response.Cnf = &CnfClaim{Jkt: jkt}
 		response.TokenType = constants.TokenTypeDPoP
 	}
+	if thumbprint, _ := mtls.ExtractCnfThumbprint(payload); thumbprint != "" {
+		if response.Cnf == nil {
+			response.Cnf = &CnfClaim{}
+		}
+		response.Cnf.X5tS256 = thumbprint
+	}
 
 	if scope, ok := payload["scope"].(string); ok {
 		response.Scope = scope
@@ -118,6 +152,9 @@ func (s *tokenIntrospectionService) prepareValidResponse(payload map[string]inte
 	if jti, ok := payload["jti"].(string); ok {
 		response.Jti = jti
 	}
+	if details, ok := authorizationdetails.FromClaim(payload[constants.ClaimAuthorizationDetails]); ok {
+		response.AuthorizationDetails = details
+	}
 
 	return response
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
 
 	"github.com/stretchr/testify/assert"
@@ -29,7 +30,7 @@ func TestTokenIntrospectionServiceTestSuite(t *testing.T) {
 
 func (s *TokenIntrospectionServiceTestSuite) SetupTest() {
 	s.tokenValidatorMock = tokenservicemock.NewTokenValidatorInterfaceMock(s.T())
-	s.introspectService = newTokenIntrospectionService(s.tokenValidatorMock)
+	s.introspectService = newTokenIntrospectionService(s.tokenValidatorMock, nil)
 }
 
 func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_EmptyToken() {
@@ -67,6 +68,48 @@ func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_ValidToken_Acti
 	assert.Equal(s.T(), "token-id-123", response.Jti)
 }
 
+// A token issued to a pairwise client reports the client's pairwise subject.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_PairwiseSubject() {
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(s.T())
+	s.introspectService = newTokenIntrospectionService(s.tokenValidatorMock, pairwiseService)
+	claims := map[string]interface{}{"client_id": "client123", "sub": "user123"}
+	s.tokenValidatorMock.On("ValidateToken", mock.Anything, "valid-token").Return(claims, nil)
+	pairwiseService.On("GetSubjectForClient", mock.Anything, "client123", "user123").Return("pairwise-sub", nil)
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), "valid-token", "")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "pairwise-sub", response.Sub)
+}
+
+// A client credentials token carries the client id as sub and is not mapped.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_PairwiseSubject_ClientCredentials() {
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(s.T())
+	s.introspectService = newTokenIntrospectionService(s.tokenValidatorMock, pairwiseService)
+	claims := map[string]interface{}{"client_id": "client123", "sub": "client123"}
+	s.tokenValidatorMock.On("ValidateToken", mock.Anything, "valid-token").Return(claims, nil)
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), "valid-token", "")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "client123", response.Sub)
+}
+
+// A pairwise resolution failure surfaces as a server error.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_PairwiseSubject_Error() {
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(s.T())
+	s.introspectService = newTokenIntrospectionService(s.tokenValidatorMock, pairwiseService)
+	claims := map[string]interface{}{"client_id": "client123", "sub": "user123"}
+	s.tokenValidatorMock.On("ValidateToken", mock.Anything, "valid-token").Return(claims, nil)
+	pairwiseService.On("GetSubjectForClient", mock.Anything, "client123", "user123").
+		Return("", errors.New("store unavailable"))
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), "valid-token", "")
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), response)
+}
+
 // An array audience claim is surfaced as a string slice.
 func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_ArrayAudience() {
 	claims := map[string]interface{}{
@@ -151,3 +194,53 @@ func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_DPoPBoundToken_
 	assert.NotNil(s.T(), response.Cnf)
 	assert.Equal(s.T(), "thumbprint-abc", response.Cnf.Jkt)
 }
+
+// A certificate-bound token surfaces cnf.x5t#S256 and stays a Bearer token.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_CertificateBoundToken_SurfacesThumbprint() {
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"client_id": "client123",
+		"cnf":       map[string]interface{}{"x5t#S256": "cert-thumbprint"},
+	}
+	s.tokenValidatorMock.On("ValidateToken", mock.Anything, "mtls-token").Return(claims, nil)
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), "mtls-token", "")
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), response.Active)
+	assert.Equal(s.T(), constants.TokenTypeBearer, response.TokenType)
+	assert.NotNil(s.T(), response.Cnf)
+	assert.Equal(s.T(), "cert-thumbprint", response.Cnf.X5tS256)
+	assert.Empty(s.T(), response.Cnf.Jkt)
+}
+
+// A malformed certificate binding cannot be checked by the resource server, so the token is inactive.
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_InvalidCertificateBinding_Inactive() {
+	claims := map[string]interface{}{
+		"sub": "user123",
+		"cnf": map[string]interface{}{"x5t#S256": 42},
+	}
+	s.tokenValidatorMock.On("ValidateToken", mock.Anything, "bad-token").Return(claims, nil)
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), "bad-token", "")
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), response.Active)
+}
+
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_SurfacesAuthorizationDetails() {
+	claims := map[string]interface{}{
+		"sub": "user123",
+		"authorization_details": []interface{}{
+			map[string]interface{}{"type": "payment_initiation", "actions": []interface{}{"initiate"}},
+		},
+	}
+	s.tokenValidatorMock.On("ValidateToken", mock.Anything, "rar-token").Return(claims, nil)
+
+	response, err := s.introspectService.IntrospectToken(context.Background(), "rar-token", "")
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), response.Active)
+	assert.Len(s.T(), response.AuthorizationDetails, 1)
+	assert.Equal(s.T(), "payment_initiation", response.AuthorizationDetails[0].Type())
+}
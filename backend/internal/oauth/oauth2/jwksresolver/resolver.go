@@ -1,9 +1,10 @@
 // Copyright 2026 The ThunderID Authors
 // SPDX-License-Identifier: Apache-2.0
 
-// Package jwksresolver provides utilities for resolving RSA encryption keys from
-// a relying party's JWKS (inline or remote URI). It is intentionally RSA- and
-// encryption-specific and is not a general-purpose JWK resolver.
+// Package jwksresolver provides utilities for resolving a relying party's public keys from
+// its JWKS (inline or remote URI): encryption keys for content sent to the relying party, and
+// signing keys for verifying JWSs it sent, such as request objects. It is not a general-purpose
+// JWK resolver.
 package jwksresolver
 
 import (
@@ -59,28 +60,77 @@ func (r *Resolver) ResolveEncryptionKey(
 	encryptionAlg string,
 	policy KeyUsePolicy,
 ) (map[string]interface{}, string, *tidcommon.ServiceError) {
+	jwksData, svcErr := r.loadJWKS(ctx, certificate)
+	if svcErr != nil {
+		return nil, "", svcErr
+	}
+
+	return r.parseEncryptionKeyFromJWKS(ctx, jwksData, encryptionAlg, policy)
+}
+
+// ResolveVerificationKey resolves the RP's public key for verifying a JWS signed by the RP.
+// When kid is set, only the JWK entry with that kid is considered; otherwise the first signing
+// key compatible with signingAlg is returned. A JWK with an absent "use" field is accepted.
+func (r *Resolver) ResolveVerificationKey(
+	ctx context.Context,
+	certificate *inboundmodel.Certificate,
+	signingAlg string,
+	kid string,
+) (map[string]interface{}, *tidcommon.ServiceError) {
+	jwksData, svcErr := r.loadJWKS(ctx, certificate)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var jwksObj struct {
+		Keys []map[string]interface{} `json:"keys"`
+	}
+	if err := json.Unmarshal(jwksData, &jwksObj); err != nil {
+		r.logger.Error(ctx, "Failed to parse JWKS for verification key resolution", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	for _, key := range jwksObj.Keys {
+		if keyID, _ := key["kid"].(string); kid != "" && keyID != kid {
+			continue
+		}
+		if use, _ := key["use"].(string); use != "" && use != "sig" {
+			continue
+		}
+		if keyAlg, _ := key["alg"].(string); keyAlg != "" && keyAlg != signingAlg {
+			continue
+		}
+		if kty, _ := key["kty"].(string); kty != keyTypeForSigningAlg(signingAlg) {
+			continue
+		}
+		return key, nil
+	}
+
+	r.logger.Debug(ctx, "No suitable verification key found in JWKS",
+		log.String("alg", signingAlg), log.String("kid", kid))
+	return nil, &tidcommon.InternalServerError
+}
+
+// loadJWKS returns the JWKS document of the application certificate, fetching it when the
+// certificate is a JWKS URI.
+func (r *Resolver) loadJWKS(
+	ctx context.Context, certificate *inboundmodel.Certificate,
+) ([]byte, *tidcommon.ServiceError) {
 	if certificate == nil || certificate.Type == "" {
-		r.logger.Error(ctx, "No certificate configured for encryption key resolution")
-		return nil, "", &tidcommon.InternalServerError
+		r.logger.Error(ctx, "No certificate configured for key resolution")
+		return nil, &tidcommon.InternalServerError
 	}
 
-	var jwksData []byte
 	switch certificate.Type {
 	case certmodel.CertificateTypeJWKS:
-		jwksData = []byte(certificate.Value)
+		return []byte(certificate.Value), nil
 	case certmodel.CertificateTypeJWKSURI:
-		body, svcErr := r.fetchJWKS(ctx, certificate.Value)
-		if svcErr != nil {
-			return nil, "", svcErr
-		}
-		jwksData = body
+		return r.fetchJWKS(ctx, certificate.Value)
 	default:
-		r.logger.Error(ctx, "Unsupported certificate type for encryption key resolution",
+		r.logger.Error(ctx, "Unsupported certificate type for key resolution",
 			log.String("type", string(certificate.Type)))
-		return nil, "", &tidcommon.InternalServerError
+		return nil, &tidcommon.InternalServerError
 	}
-
-	return r.parseEncryptionKeyFromJWKS(ctx, jwksData, encryptionAlg, policy)
 }
 
 // fetchJWKS fetches the JWKS document from the given URI with SSRF protection and a 1 MB size cap.
@@ -184,6 +234,19 @@ func keyTypeForEncryptionAlg(encryptionAlg string) string {
 	return "RSA"
 }
 
+// keyTypeForSigningAlg returns the JWK "kty" required by the given JWS algorithm: "EC" for
+// ECDSA, "OKP" for EdDSA and "RSA" for the RSASSA variants.
+func keyTypeForSigningAlg(signingAlg string) string {
+	switch {
+	case strings.HasPrefix(signingAlg, "ES"):
+		return "EC"
+	case signingAlg == "EdDSA":
+		return "OKP"
+	default:
+		return "RSA"
+	}
+}
+
 // jwksEndpoint returns the scheme and host of the given URI for safe log output,
 // omitting path, query, and fragment to avoid leaking credentials or tokens.
 func jwksEndpoint(uri string) string {
@@ -357,3 +357,77 @@ func (suite *ResolverTestSuite) TestParseEncryptionKeyFromJWKS_SigUseKey_Lenient
 	assert.Nil(suite.T(), pub) // use="sig" is explicitly non-enc; skipped in both policies
 	assert.NotNil(suite.T(), svcErr)
 }
+
+// ---------------------------------------------------------------------------
+// ResolveVerificationKey
+// ---------------------------------------------------------------------------
+
+func (suite *ResolverTestSuite) TestResolveVerificationKey_NilCertificate() {
+	r := newJWKSResolver(nil)
+	key, svcErr := r.ResolveVerificationKey(context.Background(), nil, "PS256", "")
+	assert.Nil(suite.T(), key)
+	assert.NotNil(suite.T(), svcErr)
+}
+
+func (suite *ResolverTestSuite) TestResolveVerificationKey_MatchesKid() {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	first := rsaKeyEntry(&priv.PublicKey, "k1", "")
+	first["use"] = "sig"
+	second := rsaKeyEntry(&priv.PublicKey, "k2", "")
+	second["use"] = "sig"
+	jwks := multiKeyJWKS(first, second)
+
+	r := newJWKSResolver(nil)
+	cert := &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKS, Value: jwks}
+	key, svcErr := r.ResolveVerificationKey(context.Background(), cert, "PS256", "k2")
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), "k2", key["kid"])
+}
+
+func (suite *ResolverTestSuite) TestResolveVerificationKey_NoKid_AbsentUse() {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	jwks := rsaJWKS(&priv.PublicKey, "", "k1")
+
+	r := newJWKSResolver(nil)
+	cert := &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKS, Value: jwks}
+	key, svcErr := r.ResolveVerificationKey(context.Background(), cert, "RS256", "")
+	assert.NotNil(suite.T(), key)
+	assert.Nil(suite.T(), svcErr)
+}
+
+func (suite *ResolverTestSuite) TestResolveVerificationKey_SkipsIncompatibleKeys() {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	rs256Key := rsaKeyEntry(&priv.PublicKey, "k1", "RS256")
+	rs256Key["use"] = "sig"
+	cases := []struct {
+		name string
+		jwks string
+		alg  string
+		kid  string
+	}{
+		{name: "EncUse", jwks: rsaJWKS(&priv.PublicKey, "enc", "k1"), alg: "PS256"},
+		{name: "UnknownKid", jwks: rsaJWKS(&priv.PublicKey, "sig", "k1"), alg: "PS256", kid: "other"},
+		{name: "KeyTypeMismatch", jwks: rsaJWKS(&priv.PublicKey, "sig", "k1"), alg: "ES256"},
+		{name: "AlgMismatch", jwks: multiKeyJWKS(rs256Key), alg: "PS256"},
+		{name: "InvalidJSON", jwks: "not-json", alg: "PS256"},
+	}
+	r := newJWKSResolver(nil)
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			cert := &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKS, Value: tc.jwks}
+			key, svcErr := r.ResolveVerificationKey(context.Background(), cert, tc.alg, tc.kid)
+			assert.Nil(suite.T(), key)
+			assert.NotNil(suite.T(), svcErr)
+		})
+	}
+}
+
+func (suite *ResolverTestSuite) TestKeyTypeForSigningAlg() {
+	assert.Equal(suite.T(), "RSA", keyTypeForSigningAlg("RS256"))
+	assert.Equal(suite.T(), "RSA", keyTypeForSigningAlg("PS256"))
+	assert.Equal(suite.T(), "EC", keyTypeForSigningAlg("ES256"))
+	assert.Equal(suite.T(), "OKP", keyTypeForSigningAlg("EdDSA"))
+}
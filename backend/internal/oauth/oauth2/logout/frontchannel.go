@@ -0,0 +1,218 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logout
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// frontchannelLogoutEndpointSuffix is appended to the logout endpoint to form the path of the
+// front-channel logout page.
+const frontchannelLogoutEndpointSuffix = "/frontchannel"
+
+// paramFrontchannelLogoutID is the query parameter carrying the id of a front-channel logout page.
+const paramFrontchannelLogoutID = "id"
+
+// frontchannelLogoutContext holds the front-channel logout URIs collected while a sign-out flow
+// terminated sessions, and, once the flow completes, the post-logout redirect URI the page lands on.
+type frontchannelLogoutContext struct {
+	URIs                  []string `json:"uris"`
+	PostLogoutRedirectURI string   `json:"post_logout_redirect_uri,omitempty"`
+}
+
+// frontchannelLogoutStoreInterface stores front-channel logout contexts. Entries are written under the
+// sign-out flow execution id while the flow runs, then moved under a fresh page id on completion.
+type frontchannelLogoutStoreInterface interface {
+	// AppendURIs adds front-channel logout URIs to the context stored under key, creating it if needed.
+	AppendURIs(ctx context.Context, key string, uris []string) error
+	// AddPage persists a context under a newly generated id and returns the id.
+	AddPage(ctx context.Context, value frontchannelLogoutContext) (string, error)
+	// TakeContext returns and removes the context stored under key, reporting whether one was found.
+	TakeContext(ctx context.Context, key string) (bool, frontchannelLogoutContext, error)
+}
+
+// frontchannelLogoutStore persists front-channel logout contexts in the runtime store.
+type frontchannelLogoutStore struct {
+	runtimeStore   providers.RuntimeStoreProvider
+	validityPeriod time.Duration
+}
+
+func newFrontchannelLogoutStore(runtimeStore providers.RuntimeStoreProvider) frontchannelLogoutStoreInterface {
+	return &frontchannelLogoutStore{
+		runtimeStore:   runtimeStore,
+		validityPeriod: 10 * time.Minute,
+	}
+}
+
+func (s *frontchannelLogoutStore) AppendURIs(ctx context.Context, key string, uris []string) error {
+	if key == "" || len(uris) == 0 {
+		return nil
+	}
+	value, err := s.get(ctx, key)
+	if err != nil {
+		return err
+	}
+	value.URIs = append(value.URIs, uris...)
+	return s.put(ctx, key, value)
+}
+
+func (s *frontchannelLogoutStore) AddPage(ctx context.Context, value frontchannelLogoutContext) (string, error) {
+	key, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate UUID: %w", err)
+	}
+	if err := s.put(ctx, key, value); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+func (s *frontchannelLogoutStore) TakeContext(
+	ctx context.Context, key string,
+) (bool, frontchannelLogoutContext, error) {
+	if key == "" {
+		return false, frontchannelLogoutContext{}, nil
+	}
+	value, err := s.get(ctx, key)
+	if err != nil {
+		return false, frontchannelLogoutContext{}, err
+	}
+	if len(value.URIs) == 0 {
+		return false, frontchannelLogoutContext{}, nil
+	}
+	if err := s.runtimeStore.Delete(ctx, providers.NamespaceLogoutFrontchannel, key); err != nil {
+		return false, frontchannelLogoutContext{}, fmt.Errorf("failed to delete front-channel logout context: %w", err)
+	}
+	return true, value, nil
+}
+
+func (s *frontchannelLogoutStore) get(ctx context.Context, key string) (frontchannelLogoutContext, error) {
+	data, err := s.runtimeStore.Get(ctx, providers.NamespaceLogoutFrontchannel, key)
+	if err != nil {
+		return frontchannelLogoutContext{}, fmt.Errorf("failed to get front-channel logout context: %w", err)
+	}
+	var value frontchannelLogoutContext
+	if data == nil {
+		return value, nil
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return frontchannelLogoutContext{}, fmt.Errorf("failed to unmarshal front-channel logout context: %w", err)
+	}
+	return value, nil
+}
+
+func (s *frontchannelLogoutStore) put(ctx context.Context, key string, value frontchannelLogoutContext) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal front-channel logout context: %w", err)
+	}
+	ttlSeconds := int64(s.validityPeriod.Seconds())
+	if err := s.runtimeStore.Put(ctx, providers.NamespaceLogoutFrontchannel, key, data, ttlSeconds); err != nil {
+		return fmt.Errorf("failed to store front-channel logout context: %w", err)
+	}
+	return nil
+}
+
+// frontchannelLogoutTemplate renders the OIDC Front-Channel Logout page: one hidden iframe per
+// relying party logout URI, then a redirect to the post-logout redirect URI once every iframe has
+// loaded or a short timeout has passed, so an unresponsive relying party cannot hold the browser.
+var frontchannelLogoutTemplate = template.Must(template.New("frontchannel_logout").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Signing Out</title></head>
+<body>
+<p>Signing out...</p>
+{{- range .URIs}}
+<iframe src="{{.}}" style="display:none" width="0" height="0"></iframe>
+{{- end}}
+{{- if .RedirectURI}}
+<noscript><a href="{{.RedirectURI}}">Continue</a></noscript>
+<script nonce="{{.Nonce}}">
+(function () {
+  var target = {{.RedirectURI}};
+  var frames = document.getElementsByTagName("iframe");
+  var pending = frames.length;
+  var done = false;
+  function finish() { if (!done) { done = true; window.location.replace(target); } }
+  for (var i = 0; i < frames.length; i++) {
+    frames[i].addEventListener("load", function () { if (--pending <= 0) { finish(); } });
+  }
+  setTimeout(finish, 5000);
+})();
+</script>
+{{- end}}
+</body>
+</html>
+`))
+
+// frontchannelLogoutPage is the data rendered into frontchannelLogoutTemplate.
+type frontchannelLogoutPage struct {
+	URIs        []string
+	RedirectURI string
+	Nonce       string
+}
+
+// writeFrontchannelLogoutPage renders the front-channel logout page. Its Content-Security-Policy
+// allows framing only the relying party origins being logged out and only the page's own script.
+func writeFrontchannelLogoutPage(w http.ResponseWriter, value frontchannelLogoutContext) error {
+	nonceBytes := make([]byte, 16)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return fmt.Errorf("failed to generate script nonce: %w", err)
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(nonceBytes)
+
+	page := frontchannelLogoutPage{
+		URIs:        value.URIs,
+		RedirectURI: value.PostLogoutRedirectURI,
+		Nonce:       nonce,
+	}
+	var body bytes.Buffer
+	if err := frontchannelLogoutTemplate.Execute(&body, page); err != nil {
+		return fmt.Errorf("failed to render front-channel logout page: %w", err)
+	}
+
+	w.Header().Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeHTML)
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
+	w.Header().Set("Content-Security-Policy", fmt.Sprintf(
+		"default-src 'none'; frame-src %s; script-src 'nonce-%s'; base-uri 'none'; frame-ancestors 'none'",
+		frameSources(value.URIs), nonce))
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(body.Bytes())
+	return err
+}
+
+// frameSources returns the distinct origins of the given URIs as a CSP source list.
+func frameSources(uris []string) string {
+	seen := make(map[string]struct{}, len(uris))
+	origins := make([]string, 0, len(uris))
+	for _, uri := range uris {
+		parsed, err := url.Parse(uri)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			continue
+		}
+		origin := parsed.Scheme + "://" + parsed.Host
+		if _, ok := seen[origin]; ok {
+			continue
+		}
+		seen[origin] = struct{}{}
+		origins = append(origins, origin)
+	}
+	if len(origins) == 0 {
+		return "'none'"
+	}
+	return strings.Join(origins, " ")
+}
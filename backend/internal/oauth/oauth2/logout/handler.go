@@ -124,6 +124,24 @@ func (h *logoutHandler) HandleLogoutCallback(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// HandleFrontchannelLogout renders the front-channel logout page the completion callback routed the
+// browser to. The page is single-use; an unknown or already rendered id is rejected.
+func (h *logoutHandler) HandleFrontchannelLogout(w http.ResponseWriter, r *http.Request) {
+	found, page, err := h.service.TakeFrontchannelLogout(r.Context(), r.URL.Query().Get(paramFrontchannelLogoutID))
+	if err != nil {
+		h.logger.Error(r.Context(), "Failed to load front-channel logout page", log.Error(err))
+		http.Error(w, "logout failed", http.StatusInternalServerError)
+		return
+	}
+	if !found {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	if err := writeFrontchannelLogoutPage(w, page); err != nil {
+		h.logger.Error(r.Context(), "Failed to write front-channel logout page", log.Error(err))
+	}
+}
+
 // getSignOutPageRedirectURI builds the gate sign-out page URL with the given query params.
 func getSignOutPageRedirectURI(cfg oauthconfig.Config, queryParams map[string]string) (string, error) {
 	signOutPageURL := (&url.URL{
@@ -76,8 +76,11 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogout_InitiatesFlowAndRedirectsT
 
 	store := newLogoutRequestStoreInterfaceMock(suite.T())
 	store.EXPECT().AddRequest(mock.Anything, mock.Anything).Return("logout-1", nil)
+	store.EXPECT().UpdateRequest(mock.Anything, "logout-1", mock.Anything).Return(nil)
 	handler := newLogoutHandler(
-		newLogoutService(jwtSvc, actor, flowSvc, store, testIssuer), gateConfig())
+		newLogoutService(jwtSvc, actor, flowSvc, store, newFrontchannelLogoutStoreInterfaceMock(suite.T()),
+			testIssuer, testBaseURL),
+		gateConfig())
 
 	req := httptest.NewRequest(http.MethodGet,
 		"/oauth2/logout?id_token_hint="+token+"&post_logout_redirect_uri=https://rp.example/after&state=xyz", nil)
@@ -103,7 +106,8 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogout_InvalidRequestRejected() {
 	flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	// The request is rejected during resolution, so the store is never touched.
 	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()), actor, flowSvc,
-		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer)
+		newLogoutRequestStoreInterfaceMock(suite.T()), newFrontchannelLogoutStoreInterfaceMock(suite.T()),
+		testIssuer, testBaseURL)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout", nil)
@@ -139,7 +143,7 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogout_FlowInitiationError_MapsSt
 			store := newLogoutRequestStoreInterfaceMock(suite.T())
 			store.EXPECT().AddRequest(mock.Anything, mock.Anything).Return("logout-1", nil)
 			svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()), actor, flowSvc,
-				store, testIssuer)
+				store, newFrontchannelLogoutStoreInterfaceMock(suite.T()), testIssuer, testBaseURL)
 			handler := newLogoutHandler(svc, gateConfig())
 
 			req := httptest.NewRequest(tc.method, "/oauth2/logout?client_id=client-x", nil)
@@ -169,8 +173,9 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogout_POSTReadsFormBodyAndRedire
 		})
 	store := newLogoutRequestStoreInterfaceMock(suite.T())
 	store.EXPECT().AddRequest(mock.Anything, mock.Anything).Return("logout-1", nil)
+	store.EXPECT().UpdateRequest(mock.Anything, "logout-1", mock.Anything).Return(nil)
 	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()), actor, flowSvc,
-		store, testIssuer)
+		store, newFrontchannelLogoutStoreInterfaceMock(suite.T()), testIssuer, testBaseURL)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	body := url.Values{
@@ -201,7 +206,8 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogoutCallback_ReturnsRedirect()
 	store.EXPECT().ClearRequest(mock.Anything, "logout-1").Return(nil)
 	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
 		actorprovidermock.NewActorProviderMock(suite.T()),
-		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), store, testIssuer)
+		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), store, newFrontchannelLogoutStoreInterfaceMock(suite.T()),
+		testIssuer, testBaseURL)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout/callback",
@@ -224,7 +230,8 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogout_MalformedFormRejected() {
 	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
 		actorprovidermock.NewActorProviderMock(suite.T()),
 		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()),
-		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer)
+		newLogoutRequestStoreInterfaceMock(suite.T()), newFrontchannelLogoutStoreInterfaceMock(suite.T()),
+		testIssuer, testBaseURL)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout?%zz", nil)
@@ -242,7 +249,8 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogoutCallback_CompletionError()
 		Return(false, logoutRequestContext{}, fmt.Errorf("store down"))
 	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
 		actorprovidermock.NewActorProviderMock(suite.T()),
-		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), store, testIssuer)
+		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()), store, newFrontchannelLogoutStoreInterfaceMock(suite.T()),
+		testIssuer, testBaseURL)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout/callback",
@@ -259,7 +267,8 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogoutCallback_InvalidRequest() {
 	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
 		actorprovidermock.NewActorProviderMock(suite.T()),
 		flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()),
-		newLogoutRequestStoreInterfaceMock(suite.T()), testIssuer)
+		newLogoutRequestStoreInterfaceMock(suite.T()), newFrontchannelLogoutStoreInterfaceMock(suite.T()),
+		testIssuer, testBaseURL)
 	handler := newLogoutHandler(svc, gateConfig())
 
 	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout/callback", strings.NewReader(`{}`))
@@ -269,3 +278,35 @@ func (suite *LogoutHandlerTestSuite) TestHandleLogoutCallback_InvalidRequest() {
 
 	suite.Equal(http.StatusBadRequest, rec.Code)
 }
+
+func (suite *LogoutHandlerTestSuite) TestHandleFrontchannelLogout_RendersIframesOnce() {
+	frontchannel := newFrontchannelLogoutStoreInterfaceMock(suite.T())
+	frontchannel.EXPECT().TakeContext(mock.Anything, "page-1").Return(true, frontchannelLogoutContext{
+		URIs:                  []string{"https://rp-f.example/logout?sid=s1", "https://rp-g.example/fc"},
+		PostLogoutRedirectURI: "https://rp.example/after",
+	}, nil).Once()
+	frontchannel.EXPECT().TakeContext(mock.Anything, "page-1").
+		Return(false, frontchannelLogoutContext{}, nil).Once()
+	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
+		actorprovidermock.NewActorProviderMock(suite.T()), flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()),
+		newLogoutRequestStoreInterfaceMock(suite.T()), frontchannel, testIssuer, testBaseURL)
+	handler := newLogoutHandler(svc, gateConfig())
+	pageURL := "/oauth2/logout/frontchannel?id=page-1"
+
+	rec := httptest.NewRecorder()
+	handler.HandleFrontchannelLogout(rec, httptest.NewRequest(http.MethodGet, pageURL, nil))
+
+	suite.Equal(http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	suite.Contains(body, `<iframe src="https://rp-f.example/logout?sid=s1"`)
+	suite.Contains(body, `<iframe src="https://rp-g.example/fc"`)
+	suite.Contains(body, `var target = "https://rp.example/after";`)
+	csp := rec.Header().Get("Content-Security-Policy")
+	suite.Contains(csp, "frame-src https://rp-f.example https://rp-g.example;")
+	suite.Contains(csp, "frame-ancestors 'none'")
+
+	// The page is single-use.
+	rec = httptest.NewRecorder()
+	handler.HandleFrontchannelLogout(rec, httptest.NewRequest(http.MethodGet, pageURL, nil))
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
@@ -7,30 +7,48 @@ import (
 	"net/http"
 
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	flowsession "github.com/thunder-id/thunderid/internal/flow/session"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
-// Initialize wires the RP-initiated logout feature and registers the end_session_endpoint.
+// Initialize wires the RP-initiated logout feature and registers the end_session_endpoint. It also
+// registers the back-channel and front-channel logout notifier with the session service, so every
+// session termination notifies the participating applications.
 func Initialize(
 	mux *http.ServeMux,
 	jwtService jwt.JWTServiceInterface,
 	actorProvider providers.ActorProvider,
 	flowExecService flowexec.FlowExecServiceInterface,
+	sessionService flowsession.Service,
 	runtimeStore providers.RuntimeStoreProvider,
+	httpClient syshttp.HTTPClientInterface,
+	observabilitySvc providers.ObservabilityProvider,
+	pairwiseService pairwise.PairwiseServiceInterface,
 	cfg oauthconfig.Config,
 ) {
 	store := newLogoutRequestStore(runtimeStore)
-	service := newLogoutService(jwtService, actorProvider, flowExecService, store, cfg.JWT.Issuer)
+	frontchannel := newFrontchannelLogoutStore(runtimeStore)
+	service := newLogoutService(jwtService, actorProvider, flowExecService, store, frontchannel,
+		cfg.JWT.Issuer, cfg.BaseURL)
 	handler := newLogoutHandler(service, cfg)
 	registerRoutes(mux, handler)
+
+	// The embedded engine runs without SSO sessions, so there is nothing to notify.
+	if sessionService != nil {
+		sessionService.SetLogoutNotifier(newSessionLogoutNotifier(jwtService, actorProvider, httpClient,
+			observabilitySvc, frontchannel, pairwiseService, cfg.JWT.Issuer))
+	}
 }
 
-// registerRoutes registers the GET/POST/OPTIONS routes for the logout endpoint and its completion
-// callback (POST /oauth2/logout/callback), which the gate calls once the sign-out flow finishes.
+// registerRoutes registers the GET/POST/OPTIONS routes for the logout endpoint, its completion
+// callback (POST /oauth2/logout/callback), which the gate calls once the sign-out flow finishes, and the
+// front-channel logout page (GET /oauth2/logout/frontchannel) the callback may route the browser to.
 func registerRoutes(mux *http.ServeMux, handler *logoutHandler) {
 	opts := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
@@ -44,6 +62,8 @@ func registerRoutes(mux *http.ServeMux, handler *logoutHandler) {
 	mux.HandleFunc(middleware.WithCORS("GET "+constants.OAuth2LogoutEndpoint, handler.HandleLogout, opts))
 	mux.HandleFunc(middleware.WithCORS("POST "+constants.OAuth2LogoutEndpoint, handler.HandleLogout, opts))
 	mux.HandleFunc(middleware.WithCORS("POST "+callbackEndpoint, handler.HandleLogoutCallback, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+constants.OAuth2LogoutEndpoint+frontchannelLogoutEndpointSuffix,
+		handler.HandleFrontchannelLogout, opts))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS "+constants.OAuth2LogoutEndpoint,
 		func(w http.ResponseWriter, _ *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
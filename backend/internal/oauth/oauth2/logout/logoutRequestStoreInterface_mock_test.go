@@ -222,8 +222,8 @@ func (_c *logoutRequestStoreInterfaceMock_GetRequest_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *logoutRequestStoreInterfaceMock_GetRequest_Call) Return(b bool, logoutRequestContextMoqParam logoutRequestContext, err error) *logoutRequestStoreInterfaceMock_GetRequest_Call {
-	_c.Call.Return(b, logoutRequestContextMoqParam, err)
+func (_c *logoutRequestStoreInterfaceMock_GetRequest_Call) Return(b bool, logoutRequestContext logoutRequestContext, err error) *logoutRequestStoreInterfaceMock_GetRequest_Call {
+	_c.Call.Return(b, logoutRequestContext, err)
 	return _c
 }
 
@@ -231,3 +231,66 @@ func (_c *logoutRequestStoreInterfaceMock_GetRequest_Call) RunAndReturn(run func
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateRequest provides a mock function for the type logoutRequestStoreInterfaceMock
+func (_mock *logoutRequestStoreInterfaceMock) UpdateRequest(ctx context.Context, key string, value logoutRequestContext) error {
+	ret := _mock.Called(ctx, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, logoutRequestContext) error); ok {
+		r0 = returnFunc(ctx, key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// logoutRequestStoreInterfaceMock_UpdateRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRequest'
+type logoutRequestStoreInterfaceMock_UpdateRequest_Call struct {
+	*mock.Call
+}
+
+// UpdateRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value logoutRequestContext
+func (_e *logoutRequestStoreInterfaceMock_Expecter) UpdateRequest(ctx interface{}, key interface{}, value interface{}) *logoutRequestStoreInterfaceMock_UpdateRequest_Call {
+	return &logoutRequestStoreInterfaceMock_UpdateRequest_Call{Call: _e.mock.On("UpdateRequest", ctx, key, value)}
+}
+
+func (_c *logoutRequestStoreInterfaceMock_UpdateRequest_Call) Run(run func(ctx context.Context, key string, value logoutRequestContext)) *logoutRequestStoreInterfaceMock_UpdateRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 logoutRequestContext
+		if args[2] != nil {
+			arg2 = args[2].(logoutRequestContext)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *logoutRequestStoreInterfaceMock_UpdateRequest_Call) Return(err error) *logoutRequestStoreInterfaceMock_UpdateRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *logoutRequestStoreInterfaceMock_UpdateRequest_Call) RunAndReturn(run func(ctx context.Context, key string, value logoutRequestContext) error) *logoutRequestStoreInterfaceMock_UpdateRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
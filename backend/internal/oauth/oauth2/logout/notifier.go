@@ -0,0 +1,280 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	flowsession "github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const (
+	// logoutTokenType is the typ header of a logout token (OIDC Back-Channel Logout 1.0 §2.4).
+	logoutTokenType = "logout+jwt"
+	// logoutTokenValidity is the lifetime of a logout token in seconds. It only has to survive delivery.
+	logoutTokenValidity int64 = 120
+	// backchannelLogoutEvent is the member of the events claim that marks a JWT as a logout token.
+	backchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+	// paramLogoutToken is the form parameter carrying the logout token.
+	paramLogoutToken = "logout_token"
+
+	claimEvents = "events"
+
+	defaultBackchannelMaxAttempts    = 3
+	defaultBackchannelInitialBackoff = time.Second
+)
+
+// sessionLogoutNotifier implements flowsession.LogoutNotifier. When sessions end it POSTs a signed
+// logout token to the back-channel logout URI of every participating application that registered
+// one, and records the front-channel logout URIs of the rest against the sign-out flow execution so
+// the completion callback can render them in iframes. Back-channel delivery runs off the request
+// path and is retried with exponential backoff; each retry and the final result is published as an
+// observability event.
+type sessionLogoutNotifier struct {
+	jwtService       jwt.JWTServiceInterface
+	actorProvider    providers.ActorProvider
+	httpClient       syshttp.HTTPClientInterface
+	observabilitySvc providers.ObservabilityProvider
+	frontchannel     frontchannelLogoutStoreInterface
+	pairwiseService  pairwise.PairwiseServiceInterface
+	issuer           string
+	maxAttempts      int
+	initialBackoff   time.Duration
+	// dispatch runs a back-channel delivery. It starts a goroutine by default; tests run it inline.
+	dispatch func(func())
+	logger   *log.Logger
+}
+
+func newSessionLogoutNotifier(jwtService jwt.JWTServiceInterface, actorProvider providers.ActorProvider,
+	httpClient syshttp.HTTPClientInterface, observabilitySvc providers.ObservabilityProvider,
+	frontchannel frontchannelLogoutStoreInterface, pairwiseService pairwise.PairwiseServiceInterface,
+	issuer string) *sessionLogoutNotifier {
+	return &sessionLogoutNotifier{
+		jwtService:       jwtService,
+		actorProvider:    actorProvider,
+		httpClient:       httpClient,
+		observabilitySvc: observabilitySvc,
+		frontchannel:     frontchannel,
+		pairwiseService:  pairwiseService,
+		issuer:           issuer,
+		maxAttempts:      defaultBackchannelMaxAttempts,
+		initialBackoff:   defaultBackchannelInitialBackoff,
+		dispatch:         func(f func()) { go f() },
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LogoutNotifier")),
+	}
+}
+
+// SessionsEnded implements flowsession.LogoutNotifier.
+func (n *sessionLogoutNotifier) SessionsEnded(ctx context.Context, ended []flowsession.EndedSession) {
+	for _, endedSession := range ended {
+		var frontchannelURIs []string
+		for _, participant := range endedSession.Participants {
+			client := n.resolveClient(ctx, participant.AppID)
+			if client == nil {
+				continue
+			}
+			switch {
+			case client.BackchannelLogoutURI != "":
+				n.sendBackchannelLogout(ctx, client, endedSession.Session)
+			case client.FrontchannelLogoutURI != "":
+				frontchannelURIs = append(frontchannelURIs, n.frontchannelLogoutURI(client, endedSession.Session))
+			}
+		}
+		if len(frontchannelURIs) == 0 {
+			continue
+		}
+		// Front-channel logout needs the browser; without a sign-out flow execution to attach the
+		// URIs to (e.g. an administrative termination) there is no page to render them on.
+		if endedSession.ExecutionID == "" {
+			n.logger.Debug(ctx, "Skipping front-channel logout for a session ended outside a flow",
+				log.Int("clientCount", len(frontchannelURIs)))
+			continue
+		}
+		if err := n.frontchannel.AppendURIs(ctx, endedSession.ExecutionID, frontchannelURIs); err != nil {
+			n.logger.Error(ctx, "Failed to store front-channel logout URIs", log.Error(err))
+		}
+	}
+}
+
+// resolveClient returns the OAuth client of a participating application, or nil when the application
+// has no OAuth client or cannot be resolved.
+func (n *sessionLogoutNotifier) resolveClient(ctx context.Context, appID string) *providers.OAuthClient {
+	entity, svcErr := n.actorProvider.GetActor(appID)
+	if svcErr != nil || entity == nil || len(entity.SystemAttributes) == 0 {
+		n.logger.Debug(ctx, "Skipping logout notification for unresolvable application",
+			log.String("appID", appID))
+		return nil
+	}
+	var attrs map[string]interface{}
+	if err := json.Unmarshal(entity.SystemAttributes, &attrs); err != nil {
+		return nil
+	}
+	clientID, _ := attrs["clientId"].(string)
+	if clientID == "" {
+		return nil
+	}
+	client, svcErr := n.actorProvider.GetOAuthClientByClientID(ctx, clientID)
+	if svcErr != nil || client == nil {
+		n.logger.Debug(ctx, "Skipping logout notification for unresolvable client",
+			log.String("clientId", clientID))
+		return nil
+	}
+	return client
+}
+
+// frontchannelLogoutURI returns the client's front-channel logout URI, with the iss and sid query
+// parameters appended when the client requires them (OIDC Front-Channel Logout 1.0 §2).
+func (n *sessionLogoutNotifier) frontchannelLogoutURI(
+	client *providers.OAuthClient, session flowsession.Session,
+) string {
+	if !client.FrontchannelLogoutSessionRequired {
+		return client.FrontchannelLogoutURI
+	}
+	parsed, err := url.Parse(client.FrontchannelLogoutURI)
+	if err != nil {
+		return client.FrontchannelLogoutURI
+	}
+	query := parsed.Query()
+	query.Set(constants.ClaimIss, n.issuer)
+	query.Set(constants.ClaimSessionID, session.SessionID)
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+// sendBackchannelLogout builds the logout token for a client and dispatches its delivery. The token
+// is signed on the caller's goroutine; only the HTTP delivery and its retries run asynchronously,
+// detached from the request's cancellation.
+func (n *sessionLogoutNotifier) sendBackchannelLogout(
+	ctx context.Context, client *providers.OAuthClient, session flowsession.Session,
+) {
+	// The logout token carries the same sub the client saw in its ID tokens.
+	subject := session.SubjectID
+	if n.pairwiseService != nil {
+		pairwiseSubject, err := n.pairwiseService.GetSubject(ctx, client, subject)
+		if err != nil {
+			n.logger.Error(ctx, "Failed to resolve logout token subject",
+				log.String("clientId", client.ClientID), log.Error(err))
+			n.publishBackchannelEvent(ctx, event.EventTypeBackchannelLogoutFailed, providers.StatusFailure,
+				client.ClientID, session.SubjectID, 0, "failed to resolve logout token subject")
+			return
+		}
+		subject = pairwiseSubject
+	}
+
+	claims := map[string]interface{}{
+		constants.ClaimAud:       client.ClientID,
+		constants.ClaimSessionID: session.SessionID,
+		claimEvents:              map[string]interface{}{backchannelLogoutEvent: map[string]interface{}{}},
+	}
+	logoutToken, _, svcErr := n.jwtService.GenerateJWT(ctx, subject, n.issuer, logoutTokenValidity,
+		claims, logoutTokenType, "")
+	if svcErr != nil {
+		n.logger.Error(ctx, "Failed to generate logout token",
+			log.String("clientId", client.ClientID), log.String("error", svcErr.Error.DefaultValue))
+		n.publishBackchannelEvent(ctx, event.EventTypeBackchannelLogoutFailed, providers.StatusFailure,
+			client.ClientID, session.SubjectID, 0, "failed to generate logout token")
+		return
+	}
+
+	detached := context.WithoutCancel(ctx)
+	clientID := client.ClientID
+	logoutURI := client.BackchannelLogoutURI
+	n.dispatch(func() {
+		n.deliverBackchannelLogout(detached, clientID, session.SubjectID, logoutURI, logoutToken)
+	})
+}
+
+// deliverBackchannelLogout POSTs the logout token until the relying party accepts it or the attempts
+// are exhausted. A 4xx response is final: the relying party rejected the token and a retry would be
+// rejected again.
+func (n *sessionLogoutNotifier) deliverBackchannelLogout(
+	ctx context.Context, clientID, subjectID, logoutURI, logoutToken string,
+) {
+	backoff := n.initialBackoff
+	for attempt := 1; ; attempt++ {
+		retryable, err := n.postLogoutToken(logoutURI, logoutToken)
+		if err == nil {
+			n.publishBackchannelEvent(ctx, event.EventTypeBackchannelLogoutDelivered, providers.StatusSuccess,
+				clientID, subjectID, attempt, "")
+			return
+		}
+		if !retryable || attempt >= n.maxAttempts {
+			n.logger.Warn(ctx, "Back-channel logout delivery failed",
+				log.String("clientId", clientID), log.Int("attempt", attempt), log.Error(err))
+			n.publishBackchannelEvent(ctx, event.EventTypeBackchannelLogoutFailed, providers.StatusFailure,
+				clientID, subjectID, attempt, err.Error())
+			return
+		}
+		n.publishBackchannelEvent(ctx, event.EventTypeBackchannelLogoutRetried, providers.StatusFailure,
+			clientID, subjectID, attempt, err.Error())
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// postLogoutToken makes a single delivery attempt, reporting whether a failure is worth retrying.
+func (n *sessionLogoutNotifier) postLogoutToken(logoutURI, logoutToken string) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, logoutURI,
+		strings.NewReader(url.Values{paramLogoutToken: {logoutToken}}.Encode()))
+	if err != nil {
+		return false, fmt.Errorf("failed to build back-channel logout request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("back-channel logout request failed: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	retryable := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+	return retryable, fmt.Errorf("back-channel logout endpoint responded with status %d", resp.StatusCode)
+}
+
+// publishBackchannelEvent emits a back-channel logout delivery event.
+func (n *sessionLogoutNotifier) publishBackchannelEvent(ctx context.Context, eventType providers.EventType,
+	status, clientID, subjectID string, attempt int, message string) {
+	if n.observabilitySvc == nil || !n.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(
+		syscontext.GetTraceID(ctx),
+		string(eventType),
+		event.ComponentAuthHandler,
+	).
+		WithStatus(status).
+		WithData(event.DataKey.ClientID, clientID).
+		WithData(event.DataKey.UserID, subjectID)
+	if attempt > 0 {
+		evt = evt.WithData(event.DataKey.AttemptNumber, strconv.Itoa(attempt))
+	}
+	if message != "" {
+		evt = evt.WithData(event.DataKey.Error, message)
+	}
+
+	n.observabilitySvc.PublishEvent(ctx, evt)
+}
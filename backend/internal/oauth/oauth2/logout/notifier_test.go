@@ -0,0 +1,222 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	flowsession "github.com/thunder-id/thunderid/internal/flow/session"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
+	"github.com/thunder-id/thunderid/tests/mocks/observabilityprovidermock"
+)
+
+type SessionLogoutNotifierTestSuite struct {
+	suite.Suite
+	jwtSvc       *jwtmock.JWTServiceInterfaceMock
+	actor        *actorprovidermock.ActorProviderMock
+	httpClient   *httpmock.HTTPClientInterfaceMock
+	obs          *observabilityprovidermock.ObservabilityProviderMock
+	frontchannel *frontchannelLogoutStoreInterfaceMock
+	notifier     *sessionLogoutNotifier
+	events       []*providers.Event
+}
+
+func TestSessionLogoutNotifierTestSuite(t *testing.T) {
+	suite.Run(t, new(SessionLogoutNotifierTestSuite))
+}
+
+func (suite *SessionLogoutNotifierTestSuite) SetupTest() {
+	suite.Require().NoError(config.InitializeServerRuntime(suite.T().TempDir(), &config.Config{}))
+	suite.jwtSvc = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.actor = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.httpClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.obs = observabilityprovidermock.NewObservabilityProviderMock(suite.T())
+	suite.frontchannel = newFrontchannelLogoutStoreInterfaceMock(suite.T())
+	suite.notifier = newSessionLogoutNotifier(suite.jwtSvc, suite.actor, suite.httpClient, suite.obs,
+		suite.frontchannel, nil, testIssuer)
+	suite.notifier.dispatch = func(f func()) { f() }
+	suite.notifier.initialBackoff = 0
+	suite.events = nil
+}
+
+func (suite *SessionLogoutNotifierTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *SessionLogoutNotifierTestSuite) expectClient(appID string, client *providers.OAuthClient) {
+	attrs, _ := json.Marshal(map[string]string{"clientId": client.ClientID})
+	suite.actor.EXPECT().GetActor(appID).Return(&providers.Entity{ID: appID, SystemAttributes: attrs}, nil)
+	suite.actor.EXPECT().GetOAuthClientByClientID(mock.Anything, client.ClientID).Return(client, nil)
+}
+
+func (suite *SessionLogoutNotifierTestSuite) expectEvents() {
+	suite.obs.EXPECT().IsEnabled().Return(true)
+	suite.obs.EXPECT().PublishEvent(mock.Anything, mock.Anything).Run(
+		func(_ context.Context, evt *providers.Event) {
+			suite.events = append(suite.events, evt)
+		}).Return()
+}
+
+func (suite *SessionLogoutNotifierTestSuite) expectLogoutToken() {
+	suite.jwtSvc.EXPECT().GenerateJWT(mock.Anything, "user-1", testIssuer, logoutTokenValidity,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			events, _ := claims[claimEvents].(map[string]interface{})
+			_, hasEvent := events[backchannelLogoutEvent]
+			return claims["aud"] == "client-b" && claims["sid"] == "sess-1" && hasEvent
+		}), logoutTokenType, "").Return("logout.token.jwt", int64(0), nil)
+}
+
+func endedSession(executionID string, appIDs ...string) []flowsession.EndedSession {
+	participants := make([]flowsession.Participant, 0, len(appIDs))
+	for _, appID := range appIDs {
+		participants = append(participants, flowsession.Participant{SessionID: "sess-1", AppID: appID})
+	}
+	return []flowsession.EndedSession{{
+		Session:      flowsession.Session{SessionID: "sess-1", SubjectID: "user-1"},
+		Participants: participants,
+		ExecutionID:  executionID,
+	}}
+}
+
+func httpResponse(status int) *http.Response {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func (suite *SessionLogoutNotifierTestSuite) eventTypes() []string {
+	types := make([]string, 0, len(suite.events))
+	for _, evt := range suite.events {
+		types = append(types, evt.Type)
+	}
+	return types
+}
+
+func (suite *SessionLogoutNotifierTestSuite) TestBackchannelLogout_Delivered() {
+	suite.expectClient("app-b", &providers.OAuthClient{ClientID: "client-b",
+		BackchannelLogoutURI: "https://rp-b.example/logout"})
+	suite.expectLogoutToken()
+	suite.expectEvents()
+	suite.httpClient.EXPECT().Do(mock.Anything).RunAndReturn(func(req *http.Request) (*http.Response, error) {
+		suite.Equal(http.MethodPost, req.Method)
+		suite.Equal("https://rp-b.example/logout", req.URL.String())
+		suite.Equal("application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(req.Body)
+		form, _ := url.ParseQuery(string(body))
+		suite.Equal("logout.token.jwt", form.Get(paramLogoutToken))
+		return httpResponse(http.StatusOK), nil
+	}).Once()
+
+	suite.notifier.SessionsEnded(context.Background(), endedSession("exec-1", "app-b"))
+
+	suite.Equal([]string{string(event.EventTypeBackchannelLogoutDelivered)}, suite.eventTypes())
+	suite.Equal("client-b", suite.events[0].Data[event.DataKey.ClientID])
+	suite.Equal("1", suite.events[0].Data[event.DataKey.AttemptNumber])
+}
+
+func (suite *SessionLogoutNotifierTestSuite) TestBackchannelLogout_RetriesThenFails() {
+	suite.expectClient("app-b", &providers.OAuthClient{ClientID: "client-b",
+		BackchannelLogoutURI: "https://rp-b.example/logout"})
+	suite.expectLogoutToken()
+	suite.expectEvents()
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(httpResponse(http.StatusServiceUnavailable), nil).Once()
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(httpResponse(http.StatusBadGateway), nil).Once()
+
+	suite.notifier.SessionsEnded(context.Background(), endedSession("exec-1", "app-b"))
+
+	suite.Equal([]string{
+		string(event.EventTypeBackchannelLogoutRetried),
+		string(event.EventTypeBackchannelLogoutRetried),
+		string(event.EventTypeBackchannelLogoutFailed),
+	}, suite.eventTypes())
+	suite.Equal("3", suite.events[2].Data[event.DataKey.AttemptNumber])
+	suite.Equal(providers.StatusFailure, suite.events[2].Status)
+}
+
+func (suite *SessionLogoutNotifierTestSuite) TestBackchannelLogout_ClientErrorIsNotRetried() {
+	suite.expectClient("app-b", &providers.OAuthClient{ClientID: "client-b",
+		BackchannelLogoutURI: "https://rp-b.example/logout"})
+	suite.expectLogoutToken()
+	suite.expectEvents()
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(httpResponse(http.StatusBadRequest), nil).Once()
+
+	suite.notifier.SessionsEnded(context.Background(), endedSession("exec-1", "app-b"))
+
+	suite.Equal([]string{string(event.EventTypeBackchannelLogoutFailed)}, suite.eventTypes())
+}
+
+func (suite *SessionLogoutNotifierTestSuite) TestBackchannelLogout_PairwiseSubject() {
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(suite.T())
+	suite.notifier.pairwiseService = pairwiseService
+	client := &providers.OAuthClient{ClientID: "client-b", BackchannelLogoutURI: "https://rp-b.example/logout",
+		SubjectType: providers.SubjectTypePairwise}
+	suite.expectClient("app-b", client)
+	pairwiseService.On("GetSubject", mock.Anything, client, "user-1").Return("pairwise-sub", nil)
+	suite.jwtSvc.EXPECT().GenerateJWT(mock.Anything, "pairwise-sub", testIssuer, logoutTokenValidity,
+		mock.Anything, logoutTokenType, "").Return("logout.token.jwt", int64(0), nil)
+	suite.expectEvents()
+	suite.httpClient.EXPECT().Do(mock.Anything).Return(httpResponse(http.StatusOK), nil).Once()
+
+	suite.notifier.SessionsEnded(context.Background(), endedSession("exec-1", "app-b"))
+
+	suite.Equal([]string{string(event.EventTypeBackchannelLogoutDelivered)}, suite.eventTypes())
+}
+
+func (suite *SessionLogoutNotifierTestSuite) TestBackchannelLogout_PairwiseSubjectError() {
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(suite.T())
+	suite.notifier.pairwiseService = pairwiseService
+	client := &providers.OAuthClient{ClientID: "client-b", BackchannelLogoutURI: "https://rp-b.example/logout",
+		SubjectType: providers.SubjectTypePairwise}
+	suite.expectClient("app-b", client)
+	pairwiseService.On("GetSubject", mock.Anything, client, "user-1").Return("", errors.New("store unavailable"))
+	suite.expectEvents()
+
+	suite.notifier.SessionsEnded(context.Background(), endedSession("exec-1", "app-b"))
+
+	suite.Equal([]string{string(event.EventTypeBackchannelLogoutFailed)}, suite.eventTypes())
+}
+
+func (suite *SessionLogoutNotifierTestSuite) TestFrontchannelLogout_StoresURIsForExecution() {
+	suite.expectClient("app-f", &providers.OAuthClient{ClientID: "client-f",
+		FrontchannelLogoutURI: "https://rp-f.example/logout?x=1", FrontchannelLogoutSessionRequired: true})
+	suite.expectClient("app-g", &providers.OAuthClient{ClientID: "client-g",
+		FrontchannelLogoutURI: "https://rp-g.example/logout"})
+	suite.frontchannel.EXPECT().AppendURIs(mock.Anything, "exec-1", []string{
+		"https://rp-f.example/logout?iss=" + url.QueryEscape(testIssuer) + "&sid=sess-1&x=1",
+		"https://rp-g.example/logout",
+	}).Return(nil)
+
+	suite.notifier.SessionsEnded(context.Background(), endedSession("exec-1", "app-f", "app-g"))
+}
+
+func (suite *SessionLogoutNotifierTestSuite) TestFrontchannelLogout_SkippedWithoutExecution() {
+	suite.expectClient("app-f", &providers.OAuthClient{ClientID: "client-f",
+		FrontchannelLogoutURI: "https://rp-f.example/logout"})
+
+	// An administrative termination has no browser to render the page in; nothing is stored.
+	suite.notifier.SessionsEnded(context.Background(), endedSession("", "app-f"))
+}
+
+func (suite *SessionLogoutNotifierTestSuite) TestUnregisteredApplicationIsSkipped() {
+	suite.expectClient("app-n", &providers.OAuthClient{ClientID: "client-n"})
+	suite.actor.EXPECT().GetActor("app-gone").Return(nil, &tidcommon.ServiceError{Type: tidcommon.ClientErrorType})
+
+	suite.notifier.SessionsEnded(context.Background(), endedSession("exec-1", "app-n", "app-gone"))
+}
@@ -66,30 +66,37 @@ type LogoutServiceInterface interface {
 	Resolve(ctx context.Context, req LogoutRequest) (*LogoutResolution, error)
 	InitiateSignOutFlow(ctx context.Context, resolution *LogoutResolution) (*SignOutInitiation, *tidcommon.ServiceError)
 	CompleteSignOut(ctx context.Context, logoutID string) (string, error)
+	TakeFrontchannelLogout(ctx context.Context, pageID string) (bool, frontchannelLogoutContext, error)
 }
 
 // logoutService is the default LogoutServiceInterface implementation. It verifies the id_token_hint,
 // resolves the target client (and its post-logout redirect allow-list) via the actor provider, drives
 // the application's sign-out flow through the flow-exec service, and persists the in-progress logout
-// request in its store so the completion callback can issue the post-logout redirect.
+// request in its store so the completion callback can issue the post-logout redirect. When the flow
+// ended sessions of applications registered for front-channel logout, the completion callback routes
+// the browser through the front-channel logout page first.
 type logoutService struct {
 	jwtService      jwt.JWTServiceInterface
 	actorProvider   providers.ActorProvider
 	flowExecService flowexec.FlowExecServiceInterface
 	store           logoutRequestStoreInterface
+	frontchannel    frontchannelLogoutStoreInterface
 	issuer          string
+	baseURL         string
 	logger          *log.Logger
 }
 
 func newLogoutService(jwtService jwt.JWTServiceInterface, actorProvider providers.ActorProvider,
 	flowExecService flowexec.FlowExecServiceInterface, store logoutRequestStoreInterface,
-	issuer string) *logoutService {
+	frontchannel frontchannelLogoutStoreInterface, issuer, baseURL string) *logoutService {
 	return &logoutService{
 		jwtService:      jwtService,
 		actorProvider:   actorProvider,
 		flowExecService: flowExecService,
 		store:           store,
+		frontchannel:    frontchannel,
 		issuer:          issuer,
+		baseURL:         baseURL,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LogoutService")),
 	}
 }
@@ -101,11 +108,12 @@ func newLogoutService(jwtService jwt.JWTServiceInterface, actorProvider provider
 func (s *logoutService) InitiateSignOutFlow(
 	ctx context.Context, resolution *LogoutResolution,
 ) (*SignOutInitiation, *tidcommon.ServiceError) {
-	logoutID, err := s.store.AddRequest(ctx, logoutRequestContext{
+	reqCtx := logoutRequestContext{
 		AppID:                 resolution.AppID,
 		PostLogoutRedirectURI: resolution.PostLogoutRedirectURI,
 		State:                 resolution.State,
-	})
+	}
+	logoutID, err := s.store.AddRequest(ctx, reqCtx)
 	if err != nil {
 		s.logger.Error(ctx, "Failed to persist logout request", log.Error(err))
 		return nil, &tidcommon.InternalServerError
@@ -133,13 +141,22 @@ func (s *logoutService) InitiateSignOutFlow(
 		return nil, svcErr
 	}
 
+	// Record the execution so the completion callback can find the front-channel logout URIs the flow
+	// collects. Losing it only skips front-channel logout, so it does not fail the sign-out.
+	reqCtx.ExecutionID = executionID
+	if err := s.store.UpdateRequest(ctx, logoutID, reqCtx); err != nil {
+		s.logger.Warn(ctx, "Failed to record sign-out flow execution on logout request", log.Error(err))
+	}
+
 	return &SignOutInitiation{LogoutID: logoutID, ExecutionID: executionID}, nil
 }
 
 // CompleteSignOut is invoked after the sign-out flow completes. It consumes the stored logout request and
 // returns the post-logout redirect URI (with state appended), or "" when the RP supplied none or the
 // request is unknown/expired. Protocol-level actions that must run on sign-out (e.g. token revocation)
-// belong here — the OAuth layer regains control at this point, which it cannot inside the flow.
+// belong here — the OAuth layer regains control at this point, which it cannot inside the flow. When
+// the flow collected front-channel logout URIs, the front-channel logout page URL is returned instead;
+// the page lands on the post-logout redirect URI once the relying parties have been notified.
 func (s *logoutService) CompleteSignOut(ctx context.Context, logoutID string) (string, error) {
 	found, reqCtx, err := s.store.GetRequest(ctx, logoutID)
 	if err != nil {
@@ -153,18 +170,62 @@ func (s *logoutService) CompleteSignOut(ctx context.Context, logoutID string) (s
 		s.logger.Warn(ctx, "Failed to clear logout request", log.Error(clearErr))
 	}
 
+	redirectURI, err := buildPostLogoutRedirectURI(reqCtx)
+	if err != nil {
+		return "", err
+	}
+	return s.routeThroughFrontchannelLogout(ctx, reqCtx.ExecutionID, redirectURI), nil
+}
+
+// TakeFrontchannelLogout returns and consumes the front-channel logout page stored under pageID.
+func (s *logoutService) TakeFrontchannelLogout(
+	ctx context.Context, pageID string,
+) (bool, frontchannelLogoutContext, error) {
+	return s.frontchannel.TakeContext(ctx, pageID)
+}
+
+// routeThroughFrontchannelLogout returns the front-channel logout page URL when the sign-out flow
+// execution collected front-channel logout URIs, and the given redirect URI otherwise. Failures fall
+// back to the redirect URI: the session is already terminated, so only the notification is lost.
+func (s *logoutService) routeThroughFrontchannelLogout(ctx context.Context, executionID, redirectURI string) string {
+	if executionID == "" {
+		return redirectURI
+	}
+	found, fcCtx, err := s.frontchannel.TakeContext(ctx, executionID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to load front-channel logout URIs", log.Error(err))
+		return redirectURI
+	}
+	if !found {
+		return redirectURI
+	}
+	fcCtx.PostLogoutRedirectURI = redirectURI
+	pageID, err := s.frontchannel.AddPage(ctx, fcCtx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to store front-channel logout page", log.Error(err))
+		return redirectURI
+	}
+	pageURI, err := oauth2utils.GetURIWithQueryParams(
+		s.baseURL+constants.OAuth2LogoutEndpoint+frontchannelLogoutEndpointSuffix,
+		map[string]string{paramFrontchannelLogoutID: pageID})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to build front-channel logout page URL", log.Error(err))
+		return redirectURI
+	}
+	return pageURI
+}
+
+// buildPostLogoutRedirectURI returns the post-logout redirect URI of a logout request with its state
+// appended, or "" when the relying party supplied none.
+func buildPostLogoutRedirectURI(reqCtx logoutRequestContext) (string, error) {
 	if reqCtx.PostLogoutRedirectURI == "" {
 		return "", nil
 	}
 	if reqCtx.State == "" {
 		return reqCtx.PostLogoutRedirectURI, nil
 	}
-	redirectURI, err := oauth2utils.GetURIWithQueryParams(
+	return oauth2utils.GetURIWithQueryParams(
 		reqCtx.PostLogoutRedirectURI, map[string]string{constants.RequestParamState: reqCtx.State})
-	if err != nil {
-		return "", err
-	}
-	return redirectURI, nil
 }
 
 // Resolve identifies the client from id_token_hint (preferred) or the client_id parameter, validates
@@ -26,6 +26,7 @@ import (
 const (
 	testIssuer      = "https://issuer.test"
 	testExecutionID = "exec-1"
+	testBaseURL     = "https://server.test"
 )
 
 type LogoutServiceTestSuite struct {
@@ -50,14 +51,16 @@ func (suite *LogoutServiceTestSuite) newService() (*logoutService, *jwtmock.JWTS
 	actor := actorprovidermock.NewActorProviderMock(suite.T())
 	flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	store := newLogoutRequestStoreInterfaceMock(suite.T())
-	return newLogoutService(jwtSvc, actor, flowSvc, store, testIssuer), jwtSvc, actor
+	return newLogoutService(jwtSvc, actor, flowSvc, store, newFrontchannelLogoutStoreInterfaceMock(suite.T()),
+		testIssuer, testBaseURL), jwtSvc, actor
 }
 
 func (suite *LogoutServiceTestSuite) newServiceWithStore(
 	store logoutRequestStoreInterface, flowSvc *flowexecmock.FlowExecServiceInterfaceMock,
 ) *logoutService {
 	return newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
-		actorprovidermock.NewActorProviderMock(suite.T()), flowSvc, store, testIssuer)
+		actorprovidermock.NewActorProviderMock(suite.T()), flowSvc, store, newFrontchannelLogoutStoreInterfaceMock(suite.T()),
+		testIssuer, testBaseURL)
 }
 
 func (suite *LogoutServiceTestSuite) TestInitiateSignOutFlow_StoresContextAndInitiates() {
@@ -66,6 +69,11 @@ func (suite *LogoutServiceTestSuite) TestInitiateSignOutFlow_StoresContextAndIni
 	store.EXPECT().AddRequest(mock.Anything, logoutRequestContext{
 		AppID: "app-1", PostLogoutRedirectURI: "https://rp.example/after", State: "xyz",
 	}).Return("logout-1", nil)
+	// The execution is recorded afterwards so the callback can find the front-channel logout URIs.
+	store.EXPECT().UpdateRequest(mock.Anything, "logout-1", logoutRequestContext{
+		AppID: "app-1", PostLogoutRedirectURI: "https://rp.example/after", State: "xyz",
+		ExecutionID: testExecutionID,
+	}).Return(nil)
 	flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	var captured *flowexec.FlowInitContext
 	flowSvc.EXPECT().InitiateFlow(mock.Anything, mock.Anything).RunAndReturn(
@@ -93,6 +101,7 @@ func (suite *LogoutServiceTestSuite) TestInitiateSignOutFlow_StoresContextAndIni
 func (suite *LogoutServiceTestSuite) TestInitiateSignOutFlow_PromptRequiredSetsRuntimeData() {
 	store := newLogoutRequestStoreInterfaceMock(suite.T())
 	store.EXPECT().AddRequest(mock.Anything, mock.Anything).Return("logout-1", nil)
+	store.EXPECT().UpdateRequest(mock.Anything, "logout-1", mock.Anything).Return(nil)
 	flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	var captured *flowexec.FlowInitContext
 	flowSvc.EXPECT().InitiateFlow(mock.Anything, mock.Anything).RunAndReturn(
@@ -152,6 +161,49 @@ func (suite *LogoutServiceTestSuite) TestCompleteSignOut_UnknownID() {
 	suite.Empty(redirectURI)
 }
 
+func (suite *LogoutServiceTestSuite) TestCompleteSignOut_RoutesThroughFrontchannelLogoutPage() {
+	store := newLogoutRequestStoreInterfaceMock(suite.T())
+	store.EXPECT().GetRequest(mock.Anything, "logout-1").Return(true, logoutRequestContext{
+		AppID: "app-1", PostLogoutRedirectURI: "https://rp.example/after", ExecutionID: testExecutionID,
+	}, nil)
+	store.EXPECT().ClearRequest(mock.Anything, "logout-1").Return(nil)
+	frontchannel := newFrontchannelLogoutStoreInterfaceMock(suite.T())
+	frontchannel.EXPECT().TakeContext(mock.Anything, testExecutionID).
+		Return(true, frontchannelLogoutContext{URIs: []string{"https://rp-f.example/logout"}}, nil)
+	// The collected URIs move to a fresh page id, together with where the page lands afterwards.
+	frontchannel.EXPECT().AddPage(mock.Anything, frontchannelLogoutContext{
+		URIs:                  []string{"https://rp-f.example/logout"},
+		PostLogoutRedirectURI: "https://rp.example/after",
+	}).Return("page-1", nil)
+	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
+		actorprovidermock.NewActorProviderMock(suite.T()), flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()),
+		store, frontchannel, testIssuer, testBaseURL)
+
+	redirectURI, err := svc.CompleteSignOut(context.Background(), "logout-1")
+
+	suite.Require().NoError(err)
+	suite.Equal(testBaseURL+"/oauth2/logout/frontchannel?id=page-1", redirectURI)
+}
+
+func (suite *LogoutServiceTestSuite) TestCompleteSignOut_NoFrontchannelURIsRedirectsDirectly() {
+	store := newLogoutRequestStoreInterfaceMock(suite.T())
+	store.EXPECT().GetRequest(mock.Anything, "logout-1").Return(true, logoutRequestContext{
+		AppID: "app-1", PostLogoutRedirectURI: "https://rp.example/after", ExecutionID: testExecutionID,
+	}, nil)
+	store.EXPECT().ClearRequest(mock.Anything, "logout-1").Return(nil)
+	frontchannel := newFrontchannelLogoutStoreInterfaceMock(suite.T())
+	frontchannel.EXPECT().TakeContext(mock.Anything, testExecutionID).
+		Return(false, frontchannelLogoutContext{}, nil)
+	svc := newLogoutService(jwtmock.NewJWTServiceInterfaceMock(suite.T()),
+		actorprovidermock.NewActorProviderMock(suite.T()), flowexecmock.NewFlowExecServiceInterfaceMock(suite.T()),
+		store, frontchannel, testIssuer, testBaseURL)
+
+	redirectURI, err := svc.CompleteSignOut(context.Background(), "logout-1")
+
+	suite.Require().NoError(err)
+	suite.Equal("https://rp.example/after", redirectURI)
+}
+
 func (suite *LogoutServiceTestSuite) TestInitiateSignOutFlow_StripsIDTokenHintFromInitiatorRequest() {
 	// id_token_hint has already been consumed by the OAuth layer at Resolve() to identify the
 	// target client; it must not be persisted into the flow context store as part of the initiator
@@ -159,6 +211,7 @@ func (suite *LogoutServiceTestSuite) TestInitiateSignOutFlow_StripsIDTokenHintFr
 	// credential-bearing and must be preserved.
 	store := newLogoutRequestStoreInterfaceMock(suite.T())
 	store.EXPECT().AddRequest(mock.Anything, mock.Anything).Return("logout-1", nil)
+	store.EXPECT().UpdateRequest(mock.Anything, "logout-1", mock.Anything).Return(nil)
 	flowSvc := flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	var captured *flowexec.FlowInitContext
 	flowSvc.EXPECT().InitiateFlow(mock.Anything, mock.Anything).RunAndReturn(
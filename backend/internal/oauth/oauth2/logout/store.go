@@ -17,6 +17,7 @@ const (
 	jsonKeyLogoutAppID       = "app_id"
 	jsonKeyLogoutRedirectURI = "post_logout_redirect_uri"
 	jsonKeyLogoutState       = "state"
+	jsonKeyLogoutExecutionID = "execution_id"
 )
 
 // logoutRequestContext is the validated RP-initiated logout target held server-side between the
@@ -27,12 +28,17 @@ type logoutRequestContext struct {
 	AppID                 string
 	PostLogoutRedirectURI string
 	State                 string
+	// ExecutionID is the sign-out flow execution id, recorded once the flow is initiated. Front-channel
+	// logout URIs collected while the flow terminates sessions are stored under it.
+	ExecutionID string
 }
 
 // logoutRequestStoreInterface stores and retrieves logout request contexts.
 type logoutRequestStoreInterface interface {
 	// AddRequest persists a logout request context and returns its generated id.
 	AddRequest(ctx context.Context, value logoutRequestContext) (string, error)
+	// UpdateRequest replaces the logout request context stored under an existing id.
+	UpdateRequest(ctx context.Context, key string, value logoutRequestContext) error
 	// GetRequest returns the context for an id, reporting whether a live (unexpired) entry was found.
 	GetRequest(ctx context.Context, key string) (bool, logoutRequestContext, error)
 	// ClearRequest removes the entry for an id so it cannot be replayed.
@@ -58,19 +64,31 @@ func (s *logoutRequestStore) AddRequest(ctx context.Context, value logoutRequest
 	if err != nil {
 		return "", fmt.Errorf("failed to generate UUID: %w", err)
 	}
+	if err := s.putRequest(ctx, key, value); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+func (s *logoutRequestStore) UpdateRequest(ctx context.Context, key string, value logoutRequestContext) error {
+	return s.putRequest(ctx, key, value)
+}
+
+func (s *logoutRequestStore) putRequest(ctx context.Context, key string, value logoutRequestContext) error {
 	jsonDataBytes, err := json.Marshal(map[string]interface{}{
 		jsonKeyLogoutAppID:       value.AppID,
 		jsonKeyLogoutRedirectURI: value.PostLogoutRedirectURI,
 		jsonKeyLogoutState:       value.State,
+		jsonKeyLogoutExecutionID: value.ExecutionID,
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal logout request context to JSON: %w", err)
+		return fmt.Errorf("failed to marshal logout request context to JSON: %w", err)
 	}
 	ttlSeconds := int64(s.validityPeriod.Seconds())
 	if err := s.runtimeStore.Put(ctx, providers.NamespaceLogoutReq, key, jsonDataBytes, ttlSeconds); err != nil {
-		return "", fmt.Errorf("failed to store logout request: %w", err)
+		return fmt.Errorf("failed to store logout request: %w", err)
 	}
-	return key, nil
+	return nil
 }
 
 func (s *logoutRequestStore) GetRequest(ctx context.Context, key string) (bool, logoutRequestContext, error) {
@@ -117,5 +135,8 @@ func unmarshalLogoutRequestContext(dataBytes []byte) (logoutRequestContext, erro
 	if s, ok := data[jsonKeyLogoutState].(string); ok {
 		value.State = s
 	}
+	if s, ok := data[jsonKeyLogoutExecutionID].(string); ok {
+		value.ExecutionID = s
+	}
 	return value, nil
 }
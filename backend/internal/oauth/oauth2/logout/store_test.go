@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
@@ -118,3 +120,36 @@ func (suite *LogoutRequestStoreTestSuite) TestClearRequest_DeleteError() {
 
 	suite.Require().Error(err)
 }
+
+func TestFrontchannelLogoutStore_AppendThenTakeConsumes(t *testing.T) {
+	store := newFrontchannelLogoutStore(inmemory.Initialize("test-deployment"))
+	ctx := context.Background()
+
+	require.NoError(t, store.AppendURIs(ctx, "exec-1", []string{"https://a.example/fc"}))
+	require.NoError(t, store.AppendURIs(ctx, "exec-1", []string{"https://b.example/fc"}))
+
+	found, value, err := store.TakeContext(ctx, "exec-1")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"https://a.example/fc", "https://b.example/fc"}, value.URIs)
+
+	found, _, err = store.TakeContext(ctx, "exec-1")
+	require.NoError(t, err)
+	assert.False(t, found, "a taken front-channel logout context must not be retrievable")
+}
+
+func TestFrontchannelLogoutStore_AddPageRoundTrips(t *testing.T) {
+	store := newFrontchannelLogoutStore(inmemory.Initialize("test-deployment"))
+	want := frontchannelLogoutContext{
+		URIs:                  []string{"https://a.example/fc"},
+		PostLogoutRedirectURI: "https://rp.example/after",
+	}
+
+	id, err := store.AddPage(context.Background(), want)
+	require.NoError(t, err)
+
+	found, got, err := store.TakeContext(context.Background(), id)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, want, got)
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
 // OAuthParameters represents the parameters required for OAuth2 authorization.
@@ -19,6 +20,7 @@ type OAuthParameters struct {
 	RedirectURI         string
 	RedirectURIProvided bool
 	ResponseType        string
+	ResponseMode        string
 	StandardScopes      []string
 	PermissionScopes    []string
 	CodeChallenge       string
@@ -31,6 +33,8 @@ type OAuthParameters struct {
 	MaxAge              string
 	DPoPJkt             string
 	Prompt              string
+	// AuthorizationDetails are the authorization details (RFC 9396) requested by the client.
+	AuthorizationDetails []providers.AuthorizationDetail
 }
 
 // VerifiedClaimsMember is the OIDC Identity Assurance member name that may appear in the
@@ -4,6 +4,8 @@
 // Package model defines the data structures used in the OAuth2 module.
 package model
 
+import "github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
 // TokenRequest represents the OAuth2 token request.
 type TokenRequest struct {
 	GrantType          string   `json:"grant_type"`
@@ -25,6 +27,9 @@ type TokenRequest struct {
 	Audiences          []string `json:"audiences,omitempty"`
 	AuthReqID          string   `json:"auth_req_id,omitempty"`
 	Assertion          string   `json:"assertion,omitempty"`
+	DeviceCode         string   `json:"device_code,omitempty"`
+	// AuthorizationDetails is the raw authorization_details parameter (RFC 9396).
+	AuthorizationDetails string `json:"authorization_details,omitempty"`
 }
 
 // TokenResponse represents the OAuth2 token response.
@@ -36,6 +41,8 @@ type TokenResponse struct {
 	Scope           string `json:"scope,omitempty"`
 	IDToken         string `json:"id_token,omitempty"`
 	IssuedTokenType string `json:"issued_token_type,omitempty"`
+	// AuthorizationDetails are the authorization details (RFC 9396) granted for the access token.
+	AuthorizationDetails []providers.AuthorizationDetail `json:"authorization_details,omitempty"`
 }
 
 // TokenDTO represents the data transfer object for tokens.
@@ -56,6 +63,11 @@ type TokenDTO struct {
 	// TokenFamilyID is the token family id (tfid) stamped on the token, carried here so the refresh
 	// token issued alongside an access token can be stamped with the same family id.
 	TokenFamilyID string
+	// ConsentID is the consent record the token's grant was issued under, carried here so the refresh
+	// token issued alongside an access token is stamped with it too.
+	ConsentID string
+	// AuthorizationDetails are the authorization details (RFC 9396) embedded in the token.
+	AuthorizationDetails []providers.AuthorizationDetail
 }
 
 // TokenResponseDTO represents the data transfer object for token responses.
@@ -0,0 +1,19 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pairwise issues pairwise subject identifiers (OIDC Core §8.1) to clients registered with the
+// pairwise subject type, and resolves them back to the internal user when they are presented again as
+// an id_token_hint or a token exchange subject_token.
+package pairwise
+
+import (
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize constructs the pairwise subject service backed by the runtime persistent database. When no
+// salt is configured, the service uses the salt stored for the deployment, generating it on first use.
+func Initialize(actorProvider providers.ActorProvider, cfg oauthconfig.Config) PairwiseServiceInterface {
+	return newPairwiseService(newPairwiseStore(cfg.DeploymentID), actorProvider,
+		cfg.OAuth.PairwiseSubject.Salt)
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// generatedSaltBytes is the number of random bytes in a generated pairwise salt.
+const generatedSaltBytes = 32
+
+// ErrSubjectNotFound is returned when a pairwise subject was never issued in the client's sector.
+var ErrSubjectNotFound = errors.New("pairwise subject not found")
+
+// PairwiseServiceInterface maps between internal user ids and the sub values clients see. Public clients
+// see the user id itself; pairwise clients see an identifier that is stable within their sector but
+// differs across sectors.
+type PairwiseServiceInterface interface {
+	// GetSubject returns the sub value the client sees for the user.
+	GetSubject(ctx context.Context, oauthApp *providers.OAuthClient, userID string) (string, error)
+	// GetSubjectForClient returns the sub value the client with the given client id sees for the user.
+	GetSubjectForClient(ctx context.Context, clientID, userID string) (string, error)
+	// ResolveUserID maps a sub value issued to the client with the given client id back to the user id.
+	ResolveUserID(ctx context.Context, clientID, subject string) (string, error)
+}
+
+// pairwiseService implements PairwiseServiceInterface.
+type pairwiseService struct {
+	store         pairwiseStoreInterface
+	actorProvider providers.ActorProvider
+
+	saltMu sync.Mutex
+	salt   string
+}
+
+// newPairwiseService creates a new pairwiseService. An empty salt is resolved from the store on first use.
+func newPairwiseService(store pairwiseStoreInterface, actorProvider providers.ActorProvider,
+	salt string) PairwiseServiceInterface {
+	return &pairwiseService{
+		store:         store,
+		actorProvider: actorProvider,
+		salt:          salt,
+	}
+}
+
+// GetSubject returns the user id for public clients. For pairwise clients it returns the pairwise
+// subject of the client's sector and records it so that it can be resolved back later.
+func (s *pairwiseService) GetSubject(ctx context.Context, oauthApp *providers.OAuthClient,
+	userID string) (string, error) {
+	if !oauthApp.IsPairwise() || userID == "" {
+		return userID, nil
+	}
+
+	sector, err := oauthApp.SectorIdentifier()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve sector for client %s: %w", oauthApp.ClientID, err)
+	}
+	salt, err := s.getSalt(ctx)
+	if err != nil {
+		return "", err
+	}
+	subject := computeSubject(sector, userID, salt)
+	if err := s.store.InsertSubject(ctx, sector, subject, userID); err != nil {
+		return "", err
+	}
+	return subject, nil
+}
+
+// GetSubjectForClient resolves the client and returns the sub value it sees for the user.
+func (s *pairwiseService) GetSubjectForClient(ctx context.Context, clientID, userID string) (string, error) {
+	oauthApp, err := s.getClient(ctx, clientID)
+	if err != nil {
+		return "", err
+	}
+	return s.GetSubject(ctx, oauthApp, userID)
+}
+
+// ResolveUserID returns the subject unchanged for public clients. For pairwise clients it looks up the
+// user the subject was issued for in the client's sector.
+func (s *pairwiseService) ResolveUserID(ctx context.Context, clientID, subject string) (string, error) {
+	oauthApp, err := s.getClient(ctx, clientID)
+	if err != nil {
+		return "", err
+	}
+	if !oauthApp.IsPairwise() {
+		return subject, nil
+	}
+
+	sector, err := oauthApp.SectorIdentifier()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve sector for client %s: %w", clientID, err)
+	}
+	userID, err := s.store.GetUserID(ctx, sector, subject)
+	if err != nil {
+		return "", err
+	}
+	if userID == "" {
+		return "", ErrSubjectNotFound
+	}
+	return userID, nil
+}
+
+// getSalt returns the configured salt, or else the salt stored for the deployment. A random salt is
+// generated and stored when there is none, so pairwise subjects never rest on a public value.
+func (s *pairwiseService) getSalt(ctx context.Context) (string, error) {
+	s.saltMu.Lock()
+	defer s.saltMu.Unlock()
+	if s.salt != "" {
+		return s.salt, nil
+	}
+
+	salt, err := loadOrCreateSalt(ctx, s.store)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the pairwise subject salt: %w", err)
+	}
+	s.salt = salt
+	return salt, nil
+}
+
+// getClient resolves an OAuth client by its client id.
+func (s *pairwiseService) getClient(ctx context.Context, clientID string) (*providers.OAuthClient, error) {
+	oauthApp, svcErr := s.actorProvider.GetOAuthClientByClientID(ctx, clientID)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to resolve client %s: %s", clientID, svcErr.Error.DefaultValue)
+	}
+	if oauthApp == nil {
+		return nil, fmt.Errorf("client %s not found", clientID)
+	}
+	return oauthApp, nil
+}
+
+// computeSubject derives the pairwise subject of a user within a sector as described in OIDC Core §8.1:
+// the base64url-encoded SHA-256 hash of the sector identifier, the user id and the salt.
+func computeSubject(sector, userID, salt string) string {
+	sum := sha256.Sum256([]byte(sector + userID + salt))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// loadOrCreateSalt returns the stored pairwise salt of the deployment, generating and storing a random one
+// when there is none. The salt is read back after the insert so that server nodes racing to create it
+// agree on the one that was stored first.
+func loadOrCreateSalt(ctx context.Context, store pairwiseStoreInterface) (string, error) {
+	salt, err := store.GetSalt(ctx)
+	if err != nil || salt != "" {
+		return salt, err
+	}
+
+	randomBytes := make([]byte, generatedSaltBytes)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", fmt.Errorf("failed to generate pairwise salt: %w", err)
+	}
+	if err := store.InsertSalt(ctx, base64.RawURLEncoding.EncodeToString(randomBytes)); err != nil {
+		return "", err
+	}
+
+	salt, err = store.GetSalt(ctx)
+	if err != nil {
+		return "", err
+	}
+	if salt == "" {
+		return "", errors.New("pairwise salt was not stored")
+	}
+	return salt, nil
+}
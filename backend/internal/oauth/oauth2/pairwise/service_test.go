@@ -0,0 +1,234 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
+)
+
+const (
+	testSalt     = "test-salt"
+	testUserID   = "user-1"
+	testClientID = "client-1"
+)
+
+type PairwiseServiceTestSuite struct {
+	suite.Suite
+	mockStore         *pairwiseStoreInterfaceMock
+	mockActorProvider *actorprovidermock.ActorProviderMock
+	service           PairwiseServiceInterface
+	pairwiseApp       *providers.OAuthClient
+}
+
+func TestPairwiseServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PairwiseServiceTestSuite))
+}
+
+func (suite *PairwiseServiceTestSuite) SetupTest() {
+	suite.mockStore = newPairwiseStoreInterfaceMock(suite.T())
+	suite.mockActorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.service = newPairwiseService(suite.mockStore, suite.mockActorProvider, testSalt)
+	suite.pairwiseApp = &providers.OAuthClient{
+		ClientID:     testClientID,
+		SubjectType:  providers.SubjectTypePairwise,
+		RedirectURIs: []string{"https://rp.example.com/callback"},
+	}
+}
+
+func (suite *PairwiseServiceTestSuite) TestGetSubject_PublicClient() {
+	for _, app := range []*providers.OAuthClient{nil, {ClientID: testClientID},
+		{ClientID: testClientID, SubjectType: providers.SubjectTypePublic}} {
+		subject, err := suite.service.GetSubject(context.Background(), app, testUserID)
+		suite.NoError(err)
+		suite.Equal(testUserID, subject)
+	}
+}
+
+func (suite *PairwiseServiceTestSuite) TestGetSubject_PairwiseClient() {
+	expected := computeSubject("rp.example.com", testUserID, testSalt)
+	suite.mockStore.EXPECT().InsertSubject(mock.Anything, "rp.example.com", expected, testUserID).Return(nil)
+
+	subject, err := suite.service.GetSubject(context.Background(), suite.pairwiseApp, testUserID)
+	suite.NoError(err)
+	suite.Equal(expected, subject)
+	suite.NotEqual(testUserID, subject)
+}
+
+func (suite *PairwiseServiceTestSuite) TestGetSubject_SectorIdentifierURISharesSubjectAcrossHosts() {
+	// Two clients in the same sector see the same subject even though their redirect hosts differ.
+	sectorURI := "https://sector.example.com/redirect_uris.json"
+	appA := &providers.OAuthClient{ClientID: "a", SubjectType: providers.SubjectTypePairwise,
+		SectorIdentifierURI: sectorURI, RedirectURIs: []string{"https://a.example.com/cb"}}
+	appB := &providers.OAuthClient{ClientID: "b", SubjectType: providers.SubjectTypePairwise,
+		SectorIdentifierURI: sectorURI, RedirectURIs: []string{"https://b.example.com/cb"}}
+	suite.mockStore.EXPECT().InsertSubject(mock.Anything, "sector.example.com", mock.Anything, testUserID).
+		Return(nil).Twice()
+
+	subjectA, err := suite.service.GetSubject(context.Background(), appA, testUserID)
+	suite.Require().NoError(err)
+	subjectB, err := suite.service.GetSubject(context.Background(), appB, testUserID)
+	suite.Require().NoError(err)
+	suite.Equal(subjectA, subjectB)
+}
+
+func (suite *PairwiseServiceTestSuite) TestGetSubject_StoreError() {
+	suite.mockStore.EXPECT().InsertSubject(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("store error"))
+
+	_, err := suite.service.GetSubject(context.Background(), suite.pairwiseApp, testUserID)
+	suite.Error(err)
+}
+
+func (suite *PairwiseServiceTestSuite) TestGetSubject_NoSector() {
+	app := &providers.OAuthClient{ClientID: testClientID, SubjectType: providers.SubjectTypePairwise}
+
+	_, err := suite.service.GetSubject(context.Background(), app, testUserID)
+	suite.Error(err)
+}
+
+func (suite *PairwiseServiceTestSuite) TestGetSubjectForClient() {
+	suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(suite.pairwiseApp, nil)
+	suite.mockStore.EXPECT().InsertSubject(mock.Anything, mock.Anything, mock.Anything, testUserID).Return(nil)
+
+	subject, err := suite.service.GetSubjectForClient(context.Background(), testClientID, testUserID)
+	suite.NoError(err)
+	suite.Equal(computeSubject("rp.example.com", testUserID, testSalt), subject)
+}
+
+func (suite *PairwiseServiceTestSuite) TestGetSubjectForClient_ClientNotFound() {
+	suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(nil, &tidcommon.ServiceError{Type: tidcommon.ClientErrorType,
+			Error: tidcommon.I18nMessage{DefaultValue: "not found"}})
+
+	_, err := suite.service.GetSubjectForClient(context.Background(), testClientID, testUserID)
+	suite.Error(err)
+}
+
+func (suite *PairwiseServiceTestSuite) TestResolveUserID_PublicClient() {
+	suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(&providers.OAuthClient{ClientID: testClientID}, nil)
+
+	userID, err := suite.service.ResolveUserID(context.Background(), testClientID, testUserID)
+	suite.NoError(err)
+	suite.Equal(testUserID, userID)
+}
+
+func (suite *PairwiseServiceTestSuite) TestResolveUserID_PairwiseClient() {
+	suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(suite.pairwiseApp, nil)
+	suite.mockStore.EXPECT().GetUserID(mock.Anything, "rp.example.com", "pairwise-sub").Return(testUserID, nil)
+
+	userID, err := suite.service.ResolveUserID(context.Background(), testClientID, "pairwise-sub")
+	suite.NoError(err)
+	suite.Equal(testUserID, userID)
+}
+
+func (suite *PairwiseServiceTestSuite) TestResolveUserID_UnknownSubject() {
+	suite.mockActorProvider.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(suite.pairwiseApp, nil)
+	suite.mockStore.EXPECT().GetUserID(mock.Anything, "rp.example.com", testUserID).Return("", nil)
+
+	// A pairwise client cannot present the internal user id in place of its pairwise subject.
+	_, err := suite.service.ResolveUserID(context.Background(), testClientID, testUserID)
+	suite.ErrorIs(err, ErrSubjectNotFound)
+}
+
+func (suite *PairwiseServiceTestSuite) TestComputeSubject() {
+	subject := computeSubject("rp.example.com", testUserID, testSalt)
+	suite.Len(subject, 43)
+	suite.Equal(subject, computeSubject("rp.example.com", testUserID, testSalt))
+	suite.NotEqual(subject, computeSubject("other.example.com", testUserID, testSalt))
+	suite.NotEqual(subject, computeSubject("rp.example.com", testUserID, "other-salt"))
+}
+
+func (suite *PairwiseServiceTestSuite) TestLoadOrCreateSalt_Stored() {
+	suite.mockStore.EXPECT().GetSalt(mock.Anything).Return("stored-salt", nil).Once()
+
+	salt, err := loadOrCreateSalt(context.Background(), suite.mockStore)
+	suite.NoError(err)
+	suite.Equal("stored-salt", salt)
+	suite.mockStore.AssertNotCalled(suite.T(), "InsertSalt", mock.Anything, mock.Anything)
+}
+
+func (suite *PairwiseServiceTestSuite) TestLoadOrCreateSalt_GeneratesWhenMissing() {
+	var inserted string
+	suite.mockStore.EXPECT().GetSalt(mock.Anything).Return("", nil).Once()
+	suite.mockStore.EXPECT().InsertSalt(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, salt string) { inserted = salt }).Return(nil).Once()
+	suite.mockStore.EXPECT().GetSalt(mock.Anything).
+		RunAndReturn(func(context.Context) (string, error) { return inserted, nil }).Once()
+
+	salt, err := loadOrCreateSalt(context.Background(), suite.mockStore)
+	suite.NoError(err)
+	suite.NotEmpty(salt)
+	suite.Equal(inserted, salt)
+}
+
+// TestLoadOrCreateSalt_KeepsConcurrentlyStoredSalt verifies that a node losing the race to store the
+// salt uses the salt stored by the winner.
+func (suite *PairwiseServiceTestSuite) TestLoadOrCreateSalt_KeepsConcurrentlyStoredSalt() {
+	suite.mockStore.EXPECT().GetSalt(mock.Anything).Return("", nil).Once()
+	suite.mockStore.EXPECT().InsertSalt(mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockStore.EXPECT().GetSalt(mock.Anything).Return("winner-salt", nil).Once()
+
+	salt, err := loadOrCreateSalt(context.Background(), suite.mockStore)
+	suite.NoError(err)
+	suite.Equal("winner-salt", salt)
+}
+
+func (suite *PairwiseServiceTestSuite) TestLoadOrCreateSalt_StoreError() {
+	suite.mockStore.EXPECT().GetSalt(mock.Anything).Return("", errors.New("db error")).Once()
+
+	_, err := loadOrCreateSalt(context.Background(), suite.mockStore)
+	suite.Error(err)
+}
+
+func (suite *PairwiseServiceTestSuite) TestLoadOrCreateSalt_InsertError() {
+	suite.mockStore.EXPECT().GetSalt(mock.Anything).Return("", nil).Once()
+	suite.mockStore.EXPECT().InsertSalt(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+	_, err := loadOrCreateSalt(context.Background(), suite.mockStore)
+	suite.Error(err)
+}
+
+func (suite *PairwiseServiceTestSuite) TestGetSubject_ResolvesStoredSaltOnce() {
+	app := &providers.OAuthClient{
+		ClientID:     testClientID,
+		SubjectType:  providers.SubjectTypePairwise,
+		RedirectURIs: []string{"https://rp.example.com/callback"},
+	}
+	service := newPairwiseService(suite.mockStore, nil, "")
+	suite.mockStore.EXPECT().GetSalt(mock.Anything).Return("stored-salt", nil).Once()
+	suite.mockStore.EXPECT().InsertSubject(mock.Anything, "rp.example.com",
+		computeSubject("rp.example.com", testUserID, "stored-salt"), testUserID).Return(nil).Twice()
+
+	for i := 0; i < 2; i++ {
+		subject, err := service.GetSubject(context.Background(), app, testUserID)
+		suite.NoError(err)
+		suite.Equal(computeSubject("rp.example.com", testUserID, "stored-salt"), subject)
+	}
+}
+
+func (suite *PairwiseServiceTestSuite) TestGetSubject_SaltError() {
+	app := &providers.OAuthClient{
+		ClientID:     testClientID,
+		SubjectType:  providers.SubjectTypePairwise,
+		RedirectURIs: []string{"https://rp.example.com/callback"},
+	}
+	service := newPairwiseService(suite.mockStore, nil, "")
+	suite.mockStore.EXPECT().GetSalt(mock.Anything).Return("", errors.New("db error")).Once()
+
+	_, err := service.GetSubject(context.Background(), app, testUserID)
+	suite.ErrorContains(err, "pairwise subject salt")
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// pairwiseStoreInterface persists the mapping from a pairwise subject back to the user it was issued for.
+type pairwiseStoreInterface interface {
+	// InsertSubject records the user a pairwise subject was issued for. The write is idempotent.
+	InsertSubject(ctx context.Context, sector, subject, userID string) error
+	// GetUserID returns the user a pairwise subject was issued for, or an empty string when the subject
+	// was never issued in the sector.
+	GetUserID(ctx context.Context, sector, subject string) (string, error)
+	// InsertSalt records the pairwise salt of the deployment unless one is already stored.
+	InsertSalt(ctx context.Context, salt string) error
+	// GetSalt returns the stored pairwise salt of the deployment, or an empty string when there is none.
+	GetSalt(ctx context.Context) (string, error)
+}
+
+// pairwiseStore implements pairwiseStoreInterface against the runtime persistent database.
+type pairwiseStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newPairwiseStore creates a new pairwiseStore.
+func newPairwiseStore(deploymentID string) pairwiseStoreInterface {
+	return &pairwiseStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// InsertSubject records the user a pairwise subject was issued for. A duplicate subject is a no-op.
+func (s *pairwiseStore) InsertSubject(ctx context.Context, sector, subject, userID string) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryInsertPairwiseSubject, sector, subject, userID,
+		time.Now().UTC(), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("error inserting pairwise subject: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserID returns the user a pairwise subject was issued for, or an empty string when there is none.
+func (s *pairwiseStore) GetUserID(ctx context.Context, sector, subject string) (string, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPairwiseSubjectUser, sector, subject, s.deploymentID)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving pairwise subject: %w", err)
+	}
+	if len(results) == 0 {
+		return "", nil
+	}
+
+	userID, ok := results[0]["user_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("failed to parse user_id as string")
+	}
+	return userID, nil
+}
+
+// InsertSalt records the pairwise salt of the deployment. An already stored salt is kept.
+func (s *pairwiseStore) InsertSalt(ctx context.Context, salt string) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryInsertPairwiseSalt, s.deploymentID, salt, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("error inserting pairwise salt: %w", err)
+	}
+
+	return nil
+}
+
+// GetSalt returns the stored pairwise salt of the deployment, or an empty string when there is none.
+func (s *pairwiseStore) GetSalt(ctx context.Context) (string, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPairwiseSalt, s.deploymentID)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving pairwise salt: %w", err)
+	}
+	if len(results) == 0 {
+		return "", nil
+	}
+
+	salt, ok := results[0]["salt"].(string)
+	if !ok {
+		return "", fmt.Errorf("failed to parse salt as string")
+	}
+	return salt, nil
+}
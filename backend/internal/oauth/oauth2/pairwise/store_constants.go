@@ -0,0 +1,39 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+// queryInsertPairwiseSubject records the user a pairwise subject was issued for. The subject is a
+// deterministic function of the sector and user, so a repeated issuance is a no-op.
+var queryInsertPairwiseSubject = dbmodel.DBQuery{
+	ID: "PWQ-PSS-01",
+	Query: `INSERT INTO "PAIRWISE_SUBJECT" (SECTOR_IDENTIFIER, SUBJECT, USER_ID, CREATED_AT, DEPLOYMENT_ID) ` +
+		`VALUES ($1, $2, $3, $4, $5) ON CONFLICT (DEPLOYMENT_ID, SECTOR_IDENTIFIER, SUBJECT) DO NOTHING`,
+	MySQLQuery: `INSERT INTO "PAIRWISE_SUBJECT" (SECTOR_IDENTIFIER, SUBJECT, USER_ID, CREATED_AT, DEPLOYMENT_ID) ` +
+		`VALUES ($1, $2, $3, $4, $5) ON DUPLICATE KEY UPDATE SUBJECT = SUBJECT`,
+}
+
+// queryGetPairwiseSubjectUser looks up the user a pairwise subject was issued for within a sector.
+var queryGetPairwiseSubjectUser = dbmodel.DBQuery{
+	ID: "PWQ-PSS-02",
+	Query: `SELECT USER_ID FROM "PAIRWISE_SUBJECT" ` +
+		`WHERE SECTOR_IDENTIFIER = $1 AND SUBJECT = $2 AND DEPLOYMENT_ID = $3`,
+}
+
+// queryInsertPairwiseSalt records the generated pairwise salt of the deployment. When another server
+// node stored one first, the existing salt is kept.
+var queryInsertPairwiseSalt = dbmodel.DBQuery{
+	ID: "PWQ-PSS-03",
+	Query: `INSERT INTO "PAIRWISE_SALT" (DEPLOYMENT_ID, SALT, CREATED_AT) VALUES ($1, $2, $3) ` +
+		`ON CONFLICT (DEPLOYMENT_ID) DO NOTHING`,
+	MySQLQuery: `INSERT INTO "PAIRWISE_SALT" (DEPLOYMENT_ID, SALT, CREATED_AT) VALUES ($1, $2, $3) ` +
+		`ON DUPLICATE KEY UPDATE SALT = SALT`,
+}
+
+// queryGetPairwiseSalt retrieves the stored pairwise salt of the deployment.
+var queryGetPairwiseSalt = dbmodel.DBQuery{
+	ID:    "PWQ-PSS-04",
+	Query: `SELECT SALT FROM "PAIRWISE_SALT" WHERE DEPLOYMENT_ID = $1`,
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pairwise
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type PairwiseStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *pairwiseStore
+}
+
+func TestPairwiseStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(PairwiseStoreTestSuite))
+}
+
+func (suite *PairwiseStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &pairwiseStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *PairwiseStoreTestSuite) TestInsertSubject_Success() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertPairwiseSubject,
+		"rp.example.com", "pairwise-sub", "user-1", mock.Anything, testDeploymentID).
+		Return(int64(1), nil)
+
+	err := suite.store.InsertSubject(context.Background(), "rp.example.com", "pairwise-sub", "user-1")
+	suite.NoError(err)
+}
+
+func (suite *PairwiseStoreTestSuite) TestInsertSubject_ExecError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertPairwiseSubject,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("execute error"))
+
+	err := suite.store.InsertSubject(context.Background(), "rp.example.com", "pairwise-sub", "user-1")
+	suite.ErrorContains(err, "error inserting pairwise subject")
+}
+
+func (suite *PairwiseStoreTestSuite) TestInsertSubject_DBClientError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(nil, errors.New("db client error"))
+
+	err := suite.store.InsertSubject(context.Background(), "rp.example.com", "pairwise-sub", "user-1")
+	suite.ErrorContains(err, "db client error")
+}
+
+func (suite *PairwiseStoreTestSuite) TestGetUserID_Found() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetPairwiseSubjectUser,
+		"rp.example.com", "pairwise-sub", testDeploymentID).
+		Return([]map[string]interface{}{{"user_id": "user-1"}}, nil)
+
+	userID, err := suite.store.GetUserID(context.Background(), "rp.example.com", "pairwise-sub")
+	suite.NoError(err)
+	suite.Equal("user-1", userID)
+}
+
+func (suite *PairwiseStoreTestSuite) TestGetUserID_NotFound() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetPairwiseSubjectUser,
+		"rp.example.com", "pairwise-sub", testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	userID, err := suite.store.GetUserID(context.Background(), "rp.example.com", "pairwise-sub")
+	suite.NoError(err)
+	suite.Empty(userID)
+}
+
+func (suite *PairwiseStoreTestSuite) TestGetUserID_QueryError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetPairwiseSubjectUser,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return([]map[string]interface{}(nil), errors.New("query error"))
+
+	_, err := suite.store.GetUserID(context.Background(), "rp.example.com", "pairwise-sub")
+	suite.ErrorContains(err, "error retrieving pairwise subject")
+}
+
+func (suite *PairwiseStoreTestSuite) TestInsertSalt_Success() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertPairwiseSalt,
+		testDeploymentID, "salt-1", mock.Anything).
+		Return(int64(1), nil)
+
+	suite.NoError(suite.store.InsertSalt(context.Background(), "salt-1"))
+}
+
+func (suite *PairwiseStoreTestSuite) TestInsertSalt_ExecError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertPairwiseSalt,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("execute error"))
+
+	err := suite.store.InsertSalt(context.Background(), "salt-1")
+	suite.ErrorContains(err, "error inserting pairwise salt")
+}
+
+func (suite *PairwiseStoreTestSuite) TestGetSalt_Found() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetPairwiseSalt, testDeploymentID).
+		Return([]map[string]interface{}{{"salt": "salt-1"}}, nil)
+
+	salt, err := suite.store.GetSalt(context.Background())
+	suite.NoError(err)
+	suite.Equal("salt-1", salt)
+}
+
+func (suite *PairwiseStoreTestSuite) TestGetSalt_NotFound() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetPairwiseSalt, testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	salt, err := suite.store.GetSalt(context.Background())
+	suite.NoError(err)
+	suite.Empty(salt)
+}
+
+func (suite *PairwiseStoreTestSuite) TestGetSalt_QueryError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetPairwiseSalt, testDeploymentID).
+		Return([]map[string]interface{}(nil), errors.New("query error"))
+
+	_, err := suite.store.GetSalt(context.Background())
+	suite.ErrorContains(err, "error retrieving pairwise salt")
+}
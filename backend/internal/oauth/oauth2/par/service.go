@@ -11,9 +11,11 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz/requestvalidator"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -46,6 +48,7 @@ type PARServiceInterface interface {
 type parService struct {
 	store           parStoreInterface
 	resourceService providers.ResourceServerProvider
+	requestObjects  requestobject.RequestObjectResolverInterface
 	cfg             oauthconfig.Config
 	logger          *log.Logger
 }
@@ -53,11 +56,12 @@ type parService struct {
 // newPARService creates a new PAR service instance.
 func newPARService(
 	store parStoreInterface, resourceService providers.ResourceServerProvider,
-	cfg oauthconfig.Config,
+	requestObjects requestobject.RequestObjectResolverInterface, cfg oauthconfig.Config,
 ) PARServiceInterface {
 	return &parService{
 		store:           store,
 		resourceService: resourceService,
+		requestObjects:  requestObjects,
 		cfg:             cfg,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PARService")),
 	}
@@ -75,6 +79,12 @@ func (s *parService) HandlePushedAuthorizationRequest(
 			"request_uri parameter must not be included in a pushed authorization request"
 	}
 
+	// Resolve a signed request object, whose parameters take precedence over the plain ones.
+	params, resources, errCode, errMsg := s.resolveRequestObject(ctx, params, resources, oauthApp)
+	if errCode != "" {
+		return nil, errCode, errMsg
+	}
+
 	// Validate the redirect URI.
 	redirectURI := params[oauth2const.RequestParamRedirectURI]
 	if err := oauthApp.ValidateRedirectURI(ctx, redirectURI); err != nil {
@@ -86,7 +96,7 @@ func (s *parService) HandlePushedAuthorizationRequest(
 	for k, v := range params {
 		parParams.Set(k, v)
 	}
-	errCode, errMsg := requestvalidator.ValidateAuthorizationRequestParams(parParams, oauthApp, dpopHeaderJkt)
+	errCode, errMsg = requestvalidator.ValidateAuthorizationRequestParams(parParams, oauthApp, dpopHeaderJkt)
 	if errCode != "" {
 		return nil, errCode, errMsg
 	}
@@ -120,8 +130,12 @@ func (s *parService) HandlePushedAuthorizationRequest(
 	// here at push time. The authoritative binding and per-resource-server downscoping still happen
 	// when the pushed request is redeemed at the authorization endpoint, so both standard and
 	// PAR-based requests bind identically.
-	if _, errResp := resourceindicators.ResolveAudienceBinding(
-		ctx, s.resourceService, resources, nonOidcScopes); errResp != nil {
+	authorizationDetails, errResp := authorizationdetails.Parse(params[oauth2const.RequestParamAuthorizationDetails])
+	if errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+	if _, errResp := authorizationdetails.ResolveAudienceBinding(
+		ctx, s.resourceService, resources, nonOidcScopes, authorizationDetails); errResp != nil {
 		return nil, errResp.Error, errResp.ErrorDescription
 	}
 
@@ -136,6 +150,7 @@ func (s *parService) HandlePushedAuthorizationRequest(
 		RedirectURI:         redirectURI,
 		RedirectURIProvided: redirectURIProvided,
 		ResponseType:        params[oauth2const.RequestParamResponseType],
+		ResponseMode:        params[oauth2const.RequestParamResponseMode],
 		StandardScopes:      oidcScopes,
 		PermissionScopes:    nonOidcScopes,
 		CodeChallenge:       params[oauth2const.RequestParamCodeChallenge],
@@ -147,6 +162,8 @@ func (s *parService) HandlePushedAuthorizationRequest(
 		AcrValues:           params[oauth2const.RequestParamAcrValues],
 		DPoPJkt:             resolveDPoPJkt(params[oauth2const.RequestParamDPoPJkt], dpopHeaderJkt),
 		Prompt:              params[oauth2const.RequestParamPrompt],
+
+		AuthorizationDetails: authorizationDetails,
 	}
 
 	initiatorQueryParams := make(map[string][]string, len(params)+1)
@@ -182,6 +199,34 @@ func (s *parService) HandlePushedAuthorizationRequest(
 	}, "", ""
 }
 
+// resolveRequestObject resolves the signed request object of a pushed authorization request, if
+// any, and returns the resulting single-valued parameters and resource indicators.
+func (s *parService) resolveRequestObject(
+	ctx context.Context, params map[string]string, resources []string, oauthApp *providers.OAuthClient,
+) (map[string]string, []string, string, string) {
+	requestParams := make(url.Values, len(params)+1)
+	for k, v := range params {
+		requestParams.Set(k, v)
+	}
+	if len(resources) > 0 {
+		requestParams[oauth2const.RequestParamResource] = resources
+	}
+
+	resolved, errCode, errMsg := s.requestObjects.Resolve(ctx, requestParams, oauthApp)
+	if errCode != "" {
+		return nil, nil, errCode, errMsg
+	}
+
+	resolvedParams := make(map[string]string, len(resolved))
+	for k := range resolved {
+		if k == oauth2const.RequestParamResource {
+			continue
+		}
+		resolvedParams[k] = resolved.Get(k)
+	}
+	return resolvedParams, resolved[oauth2const.RequestParamResource], "", ""
+}
+
 // resolveDPoPJkt picks the effective DPoP key thumbprint. The proof-derived thumbprint
 // takes precedence; the validator has already enforced equality when both are present.
 func resolveDPoPJkt(paramJkt, headerJkt string) string {
@@ -191,6 +236,12 @@ func resolveDPoPJkt(paramJkt, headerJkt string) string {
 	return paramJkt
 }
 
+// IsPushedAuthorizationRequestURI reports whether the request_uri references a pushed
+// authorization request issued by this server, rather than an external request object.
+func IsPushedAuthorizationRequestURI(requestURI string) bool {
+	return strings.HasPrefix(requestURI, requestURIPrefix)
+}
+
 // ResolvePushedAuthorizationRequest retrieves and consumes a stored PAR request.
 // Returns the stored OAuth parameters on success, or an error if the request_uri is invalid.
 func (s *parService) ResolvePushedAuthorizationRequest(
@@ -6,6 +6,7 @@ package par
 import (
 	"context"
 	"errors"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -21,7 +22,9 @@ import (
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/requestobjectmock"
 	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 	"github.com/thunder-id/thunderid/tests/testhelpers"
 )
@@ -33,8 +36,9 @@ const (
 
 type ServiceTestSuite struct {
 	suite.Suite
-	ctx     context.Context
-	testCfg oauthconfig.Config
+	ctx            context.Context
+	testCfg        oauthconfig.Config
+	requestObjects requestobject.RequestObjectResolverInterface
 }
 
 func TestServiceTestSuite(t *testing.T) {
@@ -53,6 +57,7 @@ func (s *ServiceTestSuite) SetupTest() {
 	s.ctx = context.Background()
 	s.testCfg = testhelpers.OAuthConfig()
 	s.testCfg.OAuth.PAR.ExpiresIn = 60
+	s.requestObjects = requestobject.Initialize(nil, nil, nil, s.testCfg)
 }
 
 func (s *ServiceTestSuite) TearDownTest() {
@@ -97,7 +102,7 @@ func (s *ServiceTestSuite) newValidParams() map[string]string {
 func (s *ServiceTestSuite) TestHandlePAR_Success() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 
@@ -111,7 +116,7 @@ func (s *ServiceTestSuite) TestHandlePAR_Success() {
 
 func (s *ServiceTestSuite) TestHandlePAR_RejectsRequestURIInBody() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamRequestURI] = "urn:ietf:params:oauth:request_uri:test"
@@ -125,7 +130,7 @@ func (s *ServiceTestSuite) TestHandlePAR_RejectsRequestURIInBody() {
 
 func (s *ServiceTestSuite) TestHandlePAR_MissingResponseType() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	delete(params, oauth2const.RequestParamResponseType)
@@ -138,7 +143,7 @@ func (s *ServiceTestSuite) TestHandlePAR_MissingResponseType() {
 
 func (s *ServiceTestSuite) TestHandlePAR_InvalidRedirectURI() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamRedirectURI] = "https://evil.com/callback"
@@ -151,7 +156,7 @@ func (s *ServiceTestSuite) TestHandlePAR_InvalidRedirectURI() {
 
 func (s *ServiceTestSuite) TestHandlePAR_UnauthorizedGrantType() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	app.GrantTypes = []providers.GrantType{providers.GrantTypeClientCredentials}
 	params := s.newValidParams()
@@ -164,7 +169,7 @@ func (s *ServiceTestSuite) TestHandlePAR_UnauthorizedGrantType() {
 
 func (s *ServiceTestSuite) TestHandlePAR_UnsupportedResponseType() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamResponseType] = "token"
@@ -177,7 +182,7 @@ func (s *ServiceTestSuite) TestHandlePAR_UnsupportedResponseType() {
 
 func (s *ServiceTestSuite) TestHandlePAR_PKCERequired() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	app.PKCERequired = true
 	params := s.newValidParams()
@@ -192,7 +197,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PKCERequired() {
 func (s *ServiceTestSuite) TestHandlePAR_StoreError() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("store error"))
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 
@@ -204,7 +209,7 @@ func (s *ServiceTestSuite) TestHandlePAR_StoreError() {
 
 func (s *ServiceTestSuite) TestHandlePAR_PromptNone_LoginRequired() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamPrompt] = "none"
@@ -218,7 +223,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PromptNone_LoginRequired() {
 
 func (s *ServiceTestSuite) TestHandlePAR_PromptInvalid() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamPrompt] = "invalid_value"
@@ -232,7 +237,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PromptInvalid() {
 func (s *ServiceTestSuite) TestHandlePAR_PromptLogin_Success() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamPrompt] = "login"
@@ -245,7 +250,7 @@ func (s *ServiceTestSuite) TestHandlePAR_PromptLogin_Success() {
 
 func (s *ServiceTestSuite) TestHandlePAR_ResourceWithFragment() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://api.example.com/resource#fragment"}
@@ -258,7 +263,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ResourceWithFragment() {
 
 func (s *ServiceTestSuite) TestHandlePAR_ResourceMissingScheme() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"api.example.com/resource"}
@@ -272,7 +277,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ResourceMissingScheme() {
 func (s *ServiceTestSuite) TestHandlePAR_ValidResource_Success() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://api.example.com/resource"}
@@ -291,7 +296,7 @@ func (s *ServiceTestSuite) TestHandlePAR_UnregisteredResource_InvalidTarget() {
 			Type: tidcommon.ClientErrorType,
 			Code: "RES-1001",
 		})
-	svc := newPARService(store, rsMock, s.testCfg)
+	svc := newPARService(store, rsMock, s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://unknown.example.com"}
@@ -310,7 +315,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ResourceResolutionServerError() {
 			Type: tidcommon.ServerErrorType,
 			Code: "RES-5000",
 		})
-	svc := newPARService(store, rsMock, s.testCfg)
+	svc := newPARService(store, rsMock, s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://api.example.com/resource"}
@@ -334,7 +339,7 @@ func (s *ServiceTestSuite) TestHandlePAR_ValidatesResourceAndStoresRawScopes() {
 		Return(&providers.ResourceServer{ID: "rs-1", Identifier: "https://api.example.com"},
 			(*tidcommon.ServiceError)(nil))
 
-	svc := newPARService(store, rsMock, s.testCfg)
+	svc := newPARService(store, rsMock, s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamScope] = "read write"
@@ -359,7 +364,7 @@ func (s *ServiceTestSuite) TestHandlePAR_NoResourceNoDefaultRejectsAtPush() {
 			Code: "RES-1003",
 		})
 
-	svc := newPARService(store, rsMock, s.testCfg)
+	svc := newPARService(store, rsMock, s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	// Permission scope with no explicit resource and no default configured: reject up front.
@@ -384,7 +389,7 @@ func (s *ServiceTestSuite) TestHandlePAR_NoResourceWithDefaultSucceedsAtPush() {
 		Return(&providers.ResourceServer{ID: "rs-default", Identifier: "https://default.example.com"},
 			(*tidcommon.ServiceError)(nil))
 
-	svc := newPARService(store, rsMock, s.testCfg)
+	svc := newPARService(store, rsMock, s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamScope] = "openid read"
@@ -407,7 +412,7 @@ func (s *ServiceTestSuite) TestHandlePAR_FiltersOIDCScopesByAppScopes() {
 			captured = req
 		}).Return("test-uri", nil)
 
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	app.Scopes = []string{"profile"}
 	params := s.newValidParams()
@@ -428,7 +433,7 @@ func (s *ServiceTestSuite) TestHandlePAR_AcrValuesPropagated() {
 			captured = req
 		}).Return("test-uri", nil)
 
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamAcrValues] = "urn:thunder:acr:password urn:thunder:acr:generated-code"
@@ -442,6 +447,83 @@ func (s *ServiceTestSuite) TestHandlePAR_AcrValuesPropagated() {
 		captured.OAuthParameters.AcrValues)
 }
 
+func (s *ServiceTestSuite) TestHandlePAR_AuthorizationDetailsValidatedAndStored() {
+	store := newParStoreInterfaceMock(s.T())
+	var captured pushedAuthorizationRequest
+	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, req pushedAuthorizationRequest, _ int64) {
+			captured = req
+		}).Return("test-uri", nil)
+
+	rsMock := s.newPermissiveResourceMock()
+	rsMock.On("ValidateAuthorizationDetails", mock.Anything, "https://api.example.com", mock.Anything).
+		Return((*tidcommon.ServiceError)(nil))
+
+	svc := newPARService(store, rsMock, s.requestObjects, s.testCfg)
+	params := s.newValidParams()
+	params[oauth2const.RequestParamAuthorizationDetails] = `[{"type":"payment_initiation"}]`
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params,
+		[]string{"https://api.example.com"}, s.newTestApp(), "")
+
+	assert.Empty(s.T(), errCode)
+	assert.NotNil(s.T(), resp)
+	assert.Len(s.T(), captured.OAuthParameters.AuthorizationDetails, 1)
+	assert.Equal(s.T(), "payment_initiation", captured.OAuthParameters.AuthorizationDetails[0].Type())
+}
+
+func (s *ServiceTestSuite) TestHandlePAR_MalformedAuthorizationDetails() {
+	svc := newPARService(newParStoreInterfaceMock(s.T()), s.newPermissiveResourceMock(), s.requestObjects,
+		s.testCfg)
+	params := s.newValidParams()
+	params[oauth2const.RequestParamAuthorizationDetails] = `{"type":"payment_initiation"}`
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, s.newTestApp(), "")
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), oauth2const.ErrorInvalidAuthorizationDetails, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandlePAR_RequestObjectParamsStored() {
+	store := newParStoreInterfaceMock(s.T())
+	var captured pushedAuthorizationRequest
+	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, req pushedAuthorizationRequest, _ int64) {
+			captured = req
+		}).Return("test-uri", nil)
+	app := s.newTestApp()
+	params := map[string]string{oauth2const.RequestParamRequest: "signed.request.object"}
+	requestObjects := requestobjectmock.NewRequestObjectResolverInterfaceMock(s.T())
+	requestObjects.EXPECT().Resolve(mock.Anything, url.Values{
+		oauth2const.RequestParamRequest: {"signed.request.object"},
+	}, app).Return(url.Values{
+		oauth2const.RequestParamClientID:     {"test-client"},
+		oauth2const.RequestParamResponseType: {"code"},
+		oauth2const.RequestParamRedirectURI:  {"https://example.com/callback"},
+		oauth2const.RequestParamScope:        {"openid"},
+		oauth2const.RequestParamState:        {"signed-state"},
+	}, "", "")
+
+	svc := newPARService(store, s.newPermissiveResourceMock(), requestObjects, s.testCfg)
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, app, "")
+
+	assert.Empty(s.T(), errCode)
+	assert.NotNil(s.T(), resp)
+	assert.Equal(s.T(), "signed-state", captured.OAuthParameters.State)
+}
+
+func (s *ServiceTestSuite) TestHandlePAR_SignedRequestObjectRequired() {
+	store := newParStoreInterfaceMock(s.T())
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
+	app := s.newTestApp()
+	app.RequireSignedRequestObject = true
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, s.newValidParams(), nil, app, "")
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), oauth2const.ErrorInvalidRequest, errCode)
+}
+
 func (s *ServiceTestSuite) TestHandlePAR_DPoPHeaderJkt_PersistedOnRequest() {
 	var captured pushedAuthorizationRequest
 	store := newParStoreInterfaceMock(s.T())
@@ -449,7 +531,7 @@ func (s *ServiceTestSuite) TestHandlePAR_DPoPHeaderJkt_PersistedOnRequest() {
 		Run(func(_ context.Context, req pushedAuthorizationRequest, _ int64) {
 			captured = req
 		}).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 
@@ -467,7 +549,7 @@ func (s *ServiceTestSuite) TestHandlePAR_DPoPJktParam_PersistedWhenNoHeader() {
 		Run(func(_ context.Context, req pushedAuthorizationRequest, _ int64) {
 			captured = req
 		}).Return("test-uri", nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamDPoPJkt] = testJKT
@@ -481,7 +563,7 @@ func (s *ServiceTestSuite) TestHandlePAR_DPoPJktParam_PersistedWhenNoHeader() {
 
 func (s *ServiceTestSuite) TestHandlePAR_DPoPJktParam_HeaderMismatch_Rejected() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamDPoPJkt] = testJKT
@@ -494,7 +576,7 @@ func (s *ServiceTestSuite) TestHandlePAR_DPoPJktParam_HeaderMismatch_Rejected()
 
 func (s *ServiceTestSuite) TestHandlePAR_NonceTooLong() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamNonce] = strings.Repeat("a", oauth2const.MaxNonceLength+1)
@@ -513,7 +595,7 @@ func (s *ServiceTestSuite) TestHandlePAR_StripsCredentialsFromInitiatorQueryPara
 			captured = req
 		}).Return("test-uri", nil)
 
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	params[oauth2const.RequestParamClientSecret] = "super-secret"
@@ -544,7 +626,7 @@ func (s *ServiceTestSuite) TestResolvePAR_Success() {
 	}
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).Return(storedRequest, true, nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 
 	result, _, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"test-uri", "test-client")
@@ -557,7 +639,7 @@ func (s *ServiceTestSuite) TestResolvePAR_Success() {
 
 func (s *ServiceTestSuite) TestResolvePAR_InvalidURIFormat() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 
 	result, _, err := svc.ResolvePushedAuthorizationRequest(s.ctx, "invalid-uri", "test-client")
 
@@ -568,7 +650,7 @@ func (s *ServiceTestSuite) TestResolvePAR_InvalidURIFormat() {
 func (s *ServiceTestSuite) TestResolvePAR_NotFound() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).Return(pushedAuthorizationRequest{}, false, nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 
 	result, _, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"nonexistent", "test-client")
@@ -586,7 +668,7 @@ func (s *ServiceTestSuite) TestResolvePAR_ClientIDMismatch() {
 	}
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).Return(storedRequest, true, nil)
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 
 	result, _, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"test-uri", "client-b")
@@ -599,7 +681,7 @@ func (s *ServiceTestSuite) TestResolvePAR_ConsumeError() {
 	store := newParStoreInterfaceMock(s.T())
 	store.EXPECT().Consume(mock.Anything, mock.Anything).
 		Return(pushedAuthorizationRequest{}, false, errors.New("cache error"))
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 
 	result, _, err := svc.ResolvePushedAuthorizationRequest(
 		s.ctx, requestURIPrefix+"test-uri", "test-client")
@@ -610,7 +692,7 @@ func (s *ServiceTestSuite) TestResolvePAR_ConsumeError() {
 
 func (s *ServiceTestSuite) TestHandlePAR_MultipleResources_InvalidTarget() {
 	store := newParStoreInterfaceMock(s.T())
-	svc := newPARService(store, s.newPermissiveResourceMock(), s.testCfg)
+	svc := newPARService(store, s.newPermissiveResourceMock(), s.requestObjects, s.testCfg)
 	app := s.newTestApp()
 	params := s.newValidParams()
 	resources := []string{"https://a.example.com", "https://b.example.com"}
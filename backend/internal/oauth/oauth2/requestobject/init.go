@@ -0,0 +1,20 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package requestobject
+
+import (
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+)
+
+// Initialize creates the request object resolver shared by the authorization and PAR endpoints.
+// httpClient must be pre-configured with timeouts and SSRF-safe redirect handling.
+func Initialize(
+	jwtService jwt.JWTServiceInterface, jwksResolver *jwksresolver.Resolver,
+	httpClient syshttp.HTTPClientInterface, cfg oauthconfig.Config,
+) RequestObjectResolverInterface {
+	return newRequestObjectResolver(jwtService, jwksResolver, httpClient, cfg)
+}
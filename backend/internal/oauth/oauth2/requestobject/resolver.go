@@ -0,0 +1,312 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package requestobject resolves signed authorization request objects (JAR, RFC 9101) passed by
+// value in the request parameter or by reference in the request_uri parameter.
+package requestobject
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// maxRequestObjectBytes caps the size of a request object fetched from a request_uri.
+const maxRequestObjectBytes = 64 << 10
+
+// reservedClaims are the request object claims that describe the JWT itself and are not
+// authorization request parameters.
+var reservedClaims = map[string]bool{
+	"iss": true, "aud": true, "exp": true, "nbf": true, "iat": true, "jti": true,
+}
+
+// RequestObjectResolverInterface defines the interface for resolving the authorization request
+// parameters carried in a signed request object.
+type RequestObjectResolverInterface interface {
+	Resolve(ctx context.Context, params url.Values, oauthApp *providers.OAuthClient) (url.Values, string, string)
+}
+
+// requestObjectResolver implements RequestObjectResolverInterface.
+type requestObjectResolver struct {
+	jwtService   jwt.JWTServiceInterface
+	jwksResolver *jwksresolver.Resolver
+	httpClient   syshttp.HTTPClientInterface
+	cfg          oauthconfig.Config
+	now          func() time.Time
+	logger       *log.Logger
+}
+
+// newRequestObjectResolver creates a new request object resolver. httpClient is used to fetch
+// request objects passed by reference and must be pre-configured with timeouts.
+func newRequestObjectResolver(
+	jwtService jwt.JWTServiceInterface, jwksResolver *jwksresolver.Resolver,
+	httpClient syshttp.HTTPClientInterface, cfg oauthconfig.Config,
+) RequestObjectResolverInterface {
+	return &requestObjectResolver{
+		jwtService:   jwtService,
+		jwksResolver: jwksResolver,
+		httpClient:   httpClient,
+		cfg:          cfg,
+		now:          time.Now,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "RequestObjectResolver")),
+	}
+}
+
+// Resolve returns the effective authorization request parameters. When the request carries a
+// request object, it is verified against the client's certificate and its claims take precedence
+// over the plain request parameters. For a client that requires signed request objects only the
+// signed parameters are used, and a request without a request object is rejected. A request
+// without a request object is otherwise returned unchanged. Returns (errorCode, errorDescription)
+// on failure.
+//
+// The caller must have already resolved a request_uri that references a pushed authorization
+// request; any request_uri seen here is treated as a reference to a request object and is only
+// fetched when it is registered for the client.
+func (r *requestObjectResolver) Resolve(
+	ctx context.Context, params url.Values, oauthApp *providers.OAuthClient,
+) (url.Values, string, string) {
+	requestObject := params.Get(oauth2const.RequestParamRequest)
+	requestURI := params.Get(oauth2const.RequestParamRequestURI)
+
+	if requestObject != "" && requestURI != "" {
+		return nil, oauth2const.ErrorInvalidRequest, "request and request_uri parameters must not both be present"
+	}
+	if requestObject == "" && requestURI == "" {
+		if oauthApp.RequireSignedRequestObject {
+			return nil, oauth2const.ErrorInvalidRequest, "A signed request object is required for this client"
+		}
+		return params, "", ""
+	}
+
+	if requestURI != "" {
+		if !isRegisteredRequestURI(requestURI, oauthApp.RequestURIs) {
+			r.logger.Debug(ctx, "request_uri is not registered for the client",
+				log.MaskedString("clientID", oauthApp.ClientID))
+			return nil, oauth2const.ErrorInvalidRequestURI, "The request_uri is not registered for this client"
+		}
+		fetched, ok := r.fetchRequestObject(ctx, requestURI)
+		if !ok {
+			return nil, oauth2const.ErrorInvalidRequestURI, "Failed to retrieve the request object from request_uri"
+		}
+		requestObject = fetched
+	}
+
+	claims, errCode, errMsg := r.verifyRequestObject(ctx, requestObject, oauthApp)
+	if errCode != "" {
+		return nil, errCode, errMsg
+	}
+
+	// The client_id parameter identifies the client whose keys verified the request object, so
+	// the request object must not name a different client.
+	if clientID, ok := claims[oauth2const.RequestParamClientID]; ok && clientID != oauthApp.ClientID {
+		return nil, oauth2const.ErrorInvalidRequestObject, "client_id in the request object does not match"
+	}
+
+	signedParams, ok := claimsToParams(claims)
+	if !ok {
+		return nil, oauth2const.ErrorInvalidRequestObject, "The request object contains invalid parameters"
+	}
+
+	resolved := url.Values{}
+	if !oauthApp.RequireSignedRequestObject {
+		for key, values := range params {
+			resolved[key] = values
+		}
+	}
+	delete(resolved, oauth2const.RequestParamRequest)
+	delete(resolved, oauth2const.RequestParamRequestURI)
+	for key, values := range signedParams {
+		resolved[key] = values
+	}
+	resolved.Set(oauth2const.RequestParamClientID, oauthApp.ClientID)
+	return resolved, "", ""
+}
+
+// verifyRequestObject verifies the signature and the standard claims of a request object and
+// returns its claims. The request object must be signed by the client and audience-restricted to
+// this server.
+func (r *requestObjectResolver) verifyRequestObject(
+	ctx context.Context, requestObject string, oauthApp *providers.OAuthClient,
+) (map[string]interface{}, string, string) {
+	if strings.Count(requestObject, ".") != 2 {
+		return nil, oauth2const.ErrorInvalidRequestObject, "The request object must be a signed JWT"
+	}
+	header, err := jwt.DecodeJWTHeader(requestObject)
+	if err != nil {
+		return nil, oauth2const.ErrorInvalidRequestObject, "The request object header is malformed"
+	}
+	alg, _ := header["alg"].(string)
+	if alg == "" || strings.EqualFold(alg, "none") {
+		return nil, oauth2const.ErrorInvalidRequestObject, "The request object must be signed"
+	}
+	kid, _ := header["kid"].(string)
+
+	if oauthApp.Certificate == nil || oauthApp.Certificate.Type == "" {
+		r.logger.Debug(ctx, "No certificate configured to verify the request object",
+			log.MaskedString("clientID", oauthApp.ClientID))
+		return nil, oauth2const.ErrorInvalidRequestObject, "The request object signature cannot be verified"
+	}
+	key, svcErr := r.jwksResolver.ResolveVerificationKey(ctx, oauthApp.Certificate, alg, kid)
+	if svcErr != nil {
+		return nil, oauth2const.ErrorInvalidRequestObject, "No key found to verify the request object signature"
+	}
+
+	if svcErr := r.jwtService.VerifyJWTWithPublicKey(ctx, requestObject,
+		providers.KeyRef{PublicKeyJWK: key}, r.cfg.JWT.Issuer, oauthApp.ClientID); svcErr != nil {
+		r.logger.Debug(ctx, "Request object verification failed",
+			log.MaskedString("clientID", oauthApp.ClientID), log.String("error", svcErr.Error.DefaultValue))
+		return nil, oauth2const.ErrorInvalidRequestObject, "The request object is invalid"
+	}
+
+	claims, err := jwt.DecodeJWTPayload(requestObject)
+	if err != nil {
+		return nil, oauth2const.ErrorInvalidRequestObject, "The request object payload is malformed"
+	}
+	if errMsg := r.validateLifetime(claims, oauthApp.RequireSignedRequestObject); errMsg != "" {
+		return nil, oauth2const.ErrorInvalidRequestObject, errMsg
+	}
+	if _, ok := claims[oauth2const.RequestParamRequest]; ok {
+		return nil, oauth2const.ErrorInvalidRequestObject, "The request object must not contain a request parameter"
+	}
+	if _, ok := claims[oauth2const.RequestParamRequestURI]; ok {
+		return nil, oauth2const.ErrorInvalidRequestObject,
+			"The request object must not contain a request_uri parameter"
+	}
+	return claims, "", ""
+}
+
+// validateLifetime bounds the lifetime of a request object. exp is already enforced by the JWT
+// verification. When nbf is present it must not lie too far in the past and the request object must
+// not be valid for longer than the maximum lifetime; nbf is required when requireNbf is set.
+func (r *requestObjectResolver) validateLifetime(claims map[string]interface{}, requireNbf bool) string {
+	nbfRaw, ok := claims["nbf"]
+	if !ok {
+		if requireNbf {
+			return "The request object must contain an nbf claim"
+		}
+		return ""
+	}
+	nbf, isNumber := nbfRaw.(float64)
+	exp, expIsNumber := claims["exp"].(float64)
+	if !isNumber || !expIsNumber {
+		return "The request object lifetime claims are invalid"
+	}
+	maxLifetime := oauth2const.RequestObjectMaxLifetimeSeconds
+	if int64(exp)-int64(nbf) > maxLifetime || r.now().Unix()-int64(nbf) > maxLifetime+r.cfg.JWT.Leeway {
+		return "The request object lifetime exceeds the allowed maximum"
+	}
+	return ""
+}
+
+// isRegisteredRequestURI reports whether requestURI is one of the client's registered request URIs.
+// The fragment is ignored on both sides, since a client may vary it to bust caches (OIDC Core 6.2).
+func isRegisteredRequestURI(requestURI string, registered []string) bool {
+	requestURI, _, _ = strings.Cut(requestURI, "#")
+	for _, registeredURI := range registered {
+		registeredURI, _, _ = strings.Cut(registeredURI, "#")
+		if registeredURI == requestURI {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchRequestObject fetches a request object passed by reference. The request_uri must be a
+// publicly reachable HTTPS URL and the response body is capped at maxRequestObjectBytes. It does
+// not log the request object or the full request_uri.
+func (r *requestObjectResolver) fetchRequestObject(ctx context.Context, requestURI string) (string, bool) {
+	if r.httpClient == nil {
+		r.logger.Error(ctx, "HTTP client is not configured for the request object resolver")
+		return "", false
+	}
+	if err := syshttp.IsSSRFSafeURL(requestURI); err != nil {
+		r.logger.Debug(ctx, "request_uri is not SSRF-safe", log.Error(err))
+		return "", false
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURI, nil)
+	if err != nil {
+		r.logger.Debug(ctx, "Failed to build the request object request", log.Error(err))
+		return "", false
+	}
+	req.Header.Set("Accept", "application/oauth-authz-req+jwt")
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		r.logger.Debug(ctx, "Failed to fetch the request object", log.Error(err))
+		return "", false
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		r.logger.Debug(ctx, "request_uri returned non-200 status", log.Int("statusCode", resp.StatusCode))
+		return "", false
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRequestObjectBytes+1))
+	if err != nil || len(body) > maxRequestObjectBytes {
+		r.logger.Debug(ctx, "Failed to read the request object or it exceeds the size limit")
+		return "", false
+	}
+	return strings.TrimSpace(string(body)), true
+}
+
+// claimsToParams converts request object claims into authorization request parameters. String,
+// number and boolean claims map to single values, an array of strings maps to a repeated
+// parameter (such as resource), and any other value, such as the claims parameter, is carried as
+// its JSON encoding.
+func claimsToParams(claims map[string]interface{}) (url.Values, bool) {
+	params := make(url.Values, len(claims))
+	for name, value := range claims {
+		if reservedClaims[name] {
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			params.Set(name, v)
+		case float64:
+			params.Set(name, strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			params.Set(name, strconv.FormatBool(v))
+		case nil:
+			continue
+		default:
+			if values, ok := stringValues(v); ok {
+				params[name] = values
+				continue
+			}
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, false
+			}
+			params.Set(name, string(encoded))
+		}
+	}
+	return params, true
+}
+
+// stringValues returns the elements of value when it is a non-empty array of strings.
+func stringValues(value interface{}) ([]string, bool) {
+	items, ok := value.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, false
+	}
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, s)
+	}
+	return values, true
+}
@@ -0,0 +1,380 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package requestobject
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	certmodel "github.com/thunder-id/thunderid/internal/cert"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/testhelpers"
+)
+
+const (
+	testClientID   = "test-client"
+	testRequestURI = "https://client.example.com/request.jwt"
+)
+
+type RequestObjectResolverTestSuite struct {
+	suite.Suite
+	jwtMock  *jwtmock.JWTServiceInterfaceMock
+	httpMock *httpmock.HTTPClientInterfaceMock
+	cfg      oauthconfig.Config
+	resolver *requestObjectResolver
+	app      *providers.OAuthClient
+}
+
+func TestRequestObjectResolverTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestObjectResolverTestSuite))
+}
+
+func (suite *RequestObjectResolverTestSuite) SetupTest() {
+	suite.jwtMock = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.httpMock = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.cfg = testhelpers.OAuthConfig()
+	suite.cfg.JWT.Issuer = "https://as.example.com"
+	suite.resolver = newRequestObjectResolver(suite.jwtMock, jwksresolver.Initialize(nil),
+		suite.httpMock, suite.cfg).(*requestObjectResolver)
+
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	jwks, err := json.Marshal(map[string]interface{}{"keys": []interface{}{map[string]interface{}{
+		"kty": "RSA",
+		"use": "sig",
+		"kid": "k1",
+		"n":   base64.RawURLEncoding.EncodeToString(priv.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(priv.E)).Bytes()),
+	}}})
+	suite.Require().NoError(err)
+	suite.app = &providers.OAuthClient{
+		ClientID:    testClientID,
+		Certificate: &inboundmodel.Certificate{Type: certmodel.CertificateTypeJWKS, Value: string(jwks)},
+		RequestURIs: []string{testRequestURI},
+	}
+}
+
+// buildRequestObject builds a compact JWS with the given header and claims. The signature is not
+// meaningful; signature verification is mocked.
+func buildRequestObject(header, claims map[string]interface{}) string {
+	h, _ := json.Marshal(header)
+	c, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c) + ".c2ln"
+}
+
+func (suite *RequestObjectResolverTestSuite) signedHeader() map[string]interface{} {
+	return map[string]interface{}{"alg": "PS256", "kid": "k1", "typ": "oauth-authz-req+jwt"}
+}
+
+func (suite *RequestObjectResolverTestSuite) validClaims() map[string]interface{} {
+	now := time.Now().Unix()
+	return map[string]interface{}{
+		"iss":           testClientID,
+		"aud":           suite.cfg.JWT.Issuer,
+		"exp":           now + 300,
+		"nbf":           now,
+		"client_id":     testClientID,
+		"response_type": "code",
+		"redirect_uri":  "https://client.example.com/callback",
+		"scope":         "openid profile",
+		"state":         "signed-state",
+	}
+}
+
+func (suite *RequestObjectResolverTestSuite) expectVerified(token string) {
+	suite.jwtMock.EXPECT().VerifyJWTWithPublicKey(mock.Anything, token, mock.Anything,
+		suite.cfg.JWT.Issuer, testClientID).Return(nil).Once()
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_NoRequestObject() {
+	params := url.Values{"client_id": {testClientID}, "state": {"s1"}}
+
+	resolved, errCode, _ := suite.resolver.Resolve(context.Background(), params, suite.app)
+
+	assert.Empty(suite.T(), errCode)
+	assert.Equal(suite.T(), params, resolved)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_NoRequestObject_RequiredForClient() {
+	suite.app.RequireSignedRequestObject = true
+	params := url.Values{"client_id": {testClientID}, "state": {"s1"}}
+
+	resolved, errCode, _ := suite.resolver.Resolve(context.Background(), params, suite.app)
+
+	assert.Nil(suite.T(), resolved)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_RequestAndRequestURI() {
+	params := url.Values{"request": {"a.b.c"}, "request_uri": {testRequestURI}}
+
+	_, errCode, _ := suite.resolver.Resolve(context.Background(), params, suite.app)
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_SignedParamsTakePrecedence() {
+	claims := suite.validClaims()
+	claims["resource"] = []interface{}{"https://rs.example.com"}
+	claims["max_age"] = 300
+	claims["claims"] = map[string]interface{}{"id_token": map[string]interface{}{"acr": nil}}
+	token := buildRequestObject(suite.signedHeader(), claims)
+	suite.expectVerified(token)
+	params := url.Values{
+		"client_id": {testClientID},
+		"request":   {token},
+		"state":     {"unsigned-state"},
+		"prompt":    {"login"},
+	}
+
+	resolved, errCode, errMsg := suite.resolver.Resolve(context.Background(), params, suite.app)
+
+	suite.Require().Empty(errCode, errMsg)
+	assert.Equal(suite.T(), "signed-state", resolved.Get("state"))
+	assert.Equal(suite.T(), "login", resolved.Get("prompt"))
+	assert.Equal(suite.T(), "300", resolved.Get("max_age"))
+	assert.Equal(suite.T(), []string{"https://rs.example.com"}, resolved["resource"])
+	assert.JSONEq(suite.T(), `{"id_token":{"acr":null}}`, resolved.Get("claims"))
+	assert.False(suite.T(), resolved.Has("request"))
+	assert.False(suite.T(), resolved.Has("iss"))
+	assert.False(suite.T(), resolved.Has("exp"))
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_RequiredForClient_UsesOnlySignedParams() {
+	suite.app.RequireSignedRequestObject = true
+	token := buildRequestObject(suite.signedHeader(), suite.validClaims())
+	suite.expectVerified(token)
+	params := url.Values{"client_id": {testClientID}, "request": {token}, "prompt": {"login"}}
+
+	resolved, errCode, _ := suite.resolver.Resolve(context.Background(), params, suite.app)
+
+	suite.Require().Empty(errCode)
+	assert.False(suite.T(), resolved.Has("prompt"))
+	assert.Equal(suite.T(), testClientID, resolved.Get("client_id"))
+	assert.Equal(suite.T(), "signed-state", resolved.Get("state"))
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_RejectsInvalidRequestObjects() {
+	noneHeader := map[string]interface{}{"alg": "none"}
+	otherClient := suite.validClaims()
+	otherClient["client_id"] = "other-client"
+	nested := suite.validClaims()
+	nested["request_uri"] = testRequestURI
+	staleNbf := suite.validClaims()
+	staleNbf["nbf"] = time.Now().Unix() - 2*oauth2const.RequestObjectMaxLifetimeSeconds
+	staleNbf["exp"] = time.Now().Unix() + 60
+	longLived := suite.validClaims()
+	longLived["exp"] = time.Now().Unix() + 2*oauth2const.RequestObjectMaxLifetimeSeconds
+
+	cases := []struct {
+		name     string
+		token    string
+		verified bool
+	}{
+		{name: "NotAJWS", token: "not-a-jwt"},
+		{name: "Encrypted", token: "a.b.c.d.e"},
+		{name: "AlgNone", token: buildRequestObject(noneHeader, suite.validClaims())},
+		{name: "ClientIDMismatch", token: buildRequestObject(suite.signedHeader(), otherClient), verified: true},
+		{name: "NestedRequestURI", token: buildRequestObject(suite.signedHeader(), nested), verified: true},
+		{name: "StaleNbf", token: buildRequestObject(suite.signedHeader(), staleNbf), verified: true},
+		{name: "LifetimeTooLong", token: buildRequestObject(suite.signedHeader(), longLived), verified: true},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			if tc.verified {
+				suite.expectVerified(tc.token)
+			}
+			params := url.Values{"client_id": {testClientID}, "request": {tc.token}}
+
+			resolved, errCode, _ := suite.resolver.Resolve(context.Background(), params, suite.app)
+
+			assert.Nil(suite.T(), resolved)
+			assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, errCode)
+		})
+	}
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_RequiredForClient_MissingNbf() {
+	suite.app.RequireSignedRequestObject = true
+	claims := suite.validClaims()
+	delete(claims, "nbf")
+	token := buildRequestObject(suite.signedHeader(), claims)
+	suite.expectVerified(token)
+
+	_, errCode, _ := suite.resolver.Resolve(context.Background(),
+		url.Values{"client_id": {testClientID}, "request": {token}}, suite.app)
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_SignatureVerificationFails() {
+	token := buildRequestObject(suite.signedHeader(), suite.validClaims())
+	suite.jwtMock.EXPECT().VerifyJWTWithPublicKey(mock.Anything, token, mock.Anything,
+		suite.cfg.JWT.Issuer, testClientID).Return(&jwt.ErrorInvalidTokenSignature)
+
+	_, errCode, _ := suite.resolver.Resolve(context.Background(),
+		url.Values{"client_id": {testClientID}, "request": {token}}, suite.app)
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_NoVerificationKey() {
+	header := suite.signedHeader()
+	header["kid"] = "unknown-kid"
+	token := buildRequestObject(header, suite.validClaims())
+
+	_, errCode, _ := suite.resolver.Resolve(context.Background(),
+		url.Values{"client_id": {testClientID}, "request": {token}}, suite.app)
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_NoCertificate() {
+	suite.app.Certificate = nil
+	token := buildRequestObject(suite.signedHeader(), suite.validClaims())
+
+	_, errCode, _ := suite.resolver.Resolve(context.Background(),
+		url.Values{"client_id": {testClientID}, "request": {token}}, suite.app)
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, errCode)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ByReference() {
+	token := buildRequestObject(suite.signedHeader(), suite.validClaims())
+	suite.httpMock.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == testRequestURI && req.Method == http.MethodGet
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(token + "\n")),
+	}, nil)
+	suite.expectVerified(token)
+
+	resolved, errCode, _ := suite.resolver.Resolve(context.Background(),
+		url.Values{"client_id": {testClientID}, "request_uri": {testRequestURI}}, suite.app)
+
+	suite.Require().Empty(errCode)
+	assert.Equal(suite.T(), "signed-state", resolved.Get("state"))
+	assert.False(suite.T(), resolved.Has("request_uri"))
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ByReference_IgnoresFragment() {
+	token := buildRequestObject(suite.signedHeader(), suite.validClaims())
+	suite.httpMock.EXPECT().Do(mock.Anything).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(token)),
+	}, nil)
+	suite.expectVerified(token)
+
+	_, errCode, _ := suite.resolver.Resolve(context.Background(),
+		url.Values{"client_id": {testClientID}, "request_uri": {testRequestURI + "#v2"}}, suite.app)
+
+	suite.Empty(errCode)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ByReference_UnregisteredRequestURI() {
+	cases := []struct {
+		name       string
+		requestURI string
+		registered []string
+	}{
+		{name: "NoneRegistered", requestURI: testRequestURI, registered: nil},
+		{name: "NotListed", requestURI: "https://attacker.example.com/request.jwt",
+			registered: []string{testRequestURI}},
+		{name: "DifferentQuery", requestURI: testRequestURI + "?id=1", registered: []string{testRequestURI}},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.app.RequestURIs = tc.registered
+
+			resolved, errCode, errMsg := suite.resolver.Resolve(context.Background(),
+				url.Values{"client_id": {testClientID}, "request_uri": {tc.requestURI}}, suite.app)
+
+			assert.Nil(suite.T(), resolved)
+			assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestURI, errCode)
+			assert.Contains(suite.T(), errMsg, "not registered")
+			suite.httpMock.AssertNotCalled(suite.T(), "Do", mock.Anything)
+		})
+	}
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ByReference_FetchFailures() {
+	cases := []struct {
+		name       string
+		requestURI string
+		setup      func()
+	}{
+		{name: "NotHTTPS", requestURI: "http://client.example.com/request.jwt", setup: func() {}},
+		{name: "PrivateAddress", requestURI: "https://127.0.0.1/request.jwt", setup: func() {}},
+		{name: "TransportError", requestURI: testRequestURI, setup: func() {
+			suite.httpMock.EXPECT().Do(mock.Anything).Return(nil, errors.New("connection refused")).Once()
+		}},
+		{name: "Non200", requestURI: testRequestURI, setup: func() {
+			suite.httpMock.EXPECT().Do(mock.Anything).Return(&http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil).Once()
+		}},
+		{name: "TooLarge", requestURI: testRequestURI, setup: func() {
+			suite.httpMock.EXPECT().Do(mock.Anything).Return(&http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(strings.Repeat("a", maxRequestObjectBytes+1))),
+			}, nil).Once()
+		}},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.app.RequestURIs = []string{tc.requestURI}
+			tc.setup()
+
+			resolved, errCode, _ := suite.resolver.Resolve(context.Background(),
+				url.Values{"client_id": {testClientID}, "request_uri": {tc.requestURI}}, suite.app)
+
+			assert.Nil(suite.T(), resolved)
+			assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestURI, errCode)
+		})
+	}
+}
+
+func TestClaimsToParams(t *testing.T) {
+	params, ok := claimsToParams(map[string]interface{}{
+		"iss":                   "client",
+		"state":                 "s1",
+		"max_age":               float64(60),
+		"include_granted":       true,
+		"resource":              []interface{}{"https://a.example.com", "https://b.example.com"},
+		"authorization_details": []interface{}{map[string]interface{}{"type": "payment"}},
+		"acr_values":            nil,
+	})
+
+	assert.True(t, ok)
+	assert.False(t, params.Has("iss"))
+	assert.False(t, params.Has("acr_values"))
+	assert.Equal(t, "s1", params.Get("state"))
+	assert.Equal(t, "60", params.Get("max_age"))
+	assert.Equal(t, "true", params.Get("include_granted"))
+	assert.Equal(t, []string{"https://a.example.com", "https://b.example.com"}, params["resource"])
+	assert.JSONEq(t, `[{"type":"payment"}]`, params.Get("authorization_details"))
+}
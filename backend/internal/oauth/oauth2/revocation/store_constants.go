@@ -31,6 +31,8 @@ var queryInsertRevokedToken = dbmodel.DBQuery{
 	ID: "RVQ-RTS-01",
 	Query: `INSERT INTO "REVOKED_TOKEN" (ID, JTI, REVOCATION_REASON, REVOKED_AT, EXPIRY_TIME, ` +
 		`DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (DEPLOYMENT_ID, JTI) DO NOTHING`,
+	MySQLQuery: `INSERT INTO "REVOKED_TOKEN" (ID, JTI, REVOCATION_REASON, REVOKED_AT, EXPIRY_TIME, ` +
+		`DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6) ON DUPLICATE KEY UPDATE JTI = JTI`,
 }
 
 // queryIsTokenRevoked checks whether a non-expired deny-list entry exists for the given JTI.
@@ -42,6 +44,8 @@ var queryIsTokenRevoked = dbmodel.DBQuery{
 // queryInsertRevocationCriterion records a criteria-based (many-token) revocation. The write is
 // idempotent: re-revoking the same (deployment, type, value) refreshes the reason and time bounds
 // rather than inserting a duplicate, enforced by the unique index backing the conflict target.
+// MySQL applies ON DUPLICATE KEY UPDATE assignments in order, each seeing the ones before it, so its
+// variant assigns REASON last so that the other columns are decided on the stored reason.
 var queryInsertRevocationCriterion = dbmodel.DBQuery{
 	ID: "RVQ-RCS-01",
 	Query: fmt.Sprintf(`INSERT INTO "REVOCATION_CRITERIA" (ID, CRITERION_TYPE, CRITERION_VALUE, REASON, `+
@@ -56,6 +60,14 @@ var queryInsertRevocationCriterion = dbmodel.DBQuery{
 		`EXPIRY_TIME = CASE WHEN "REVOCATION_CRITERIA".EXPIRY_TIME > excluded.EXPIRY_TIME `+
 		`THEN "REVOCATION_CRITERIA".EXPIRY_TIME ELSE excluded.EXPIRY_TIME END`,
 		boundaryReasonSQLList(), boundaryReasonSQLList()),
+	MySQLQuery: fmt.Sprintf(`INSERT INTO "REVOCATION_CRITERIA" (ID, CRITERION_TYPE, CRITERION_VALUE, REASON, `+
+		`REVOKED_AT, EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7) `+
+		`ON DUPLICATE KEY UPDATE `+
+		`REVOKED_AT = CASE WHEN REASON NOT IN (%s) THEN REVOKED_AT ELSE VALUES(REVOKED_AT) END, `+
+		`EXPIRY_TIME = CASE WHEN EXPIRY_TIME > VALUES(EXPIRY_TIME) `+
+		`THEN EXPIRY_TIME ELSE VALUES(EXPIRY_TIME) END, `+
+		`REASON = CASE WHEN REASON NOT IN (%s) THEN REASON ELSE VALUES(REASON) END`,
+		boundaryReasonSQLList(), boundaryReasonSQLList()),
 }
 
 // criteriaRevokedQueryID identifies the criteria deny-list existence check. The query text varies
@@ -13,6 +13,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -68,6 +69,9 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 	if len(dpopHeaders) == 1 {
 		ctx = dpop.WithProof(ctx, dpopHeaders[0])
 	}
+	if certificate := mtls.ClientCertificate(r); certificate != nil {
+		ctx = mtls.WithCertificate(ctx, certificate)
+	}
 
 	// Get authenticated client from context (set by ClientAuthMiddleware).
 	clientInfo := clientauth.GetOAuthClient(r.Context())
@@ -99,6 +103,9 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 		Audiences:          r.Form[constants.RequestParamAudience],
 		AuthReqID:          r.FormValue(constants.RequestParamAuthReqID),
 		Assertion:          r.FormValue(constants.RequestParamAssertion),
+		DeviceCode:         r.FormValue(constants.RequestParamDeviceCode),
+
+		AuthorizationDetails: r.FormValue(constants.RequestParamAuthorizationDetails),
 	}
 
 	// Delegate all business logic to the token service.
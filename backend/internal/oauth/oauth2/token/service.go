@@ -18,6 +18,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/internal/system/observability/event"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -153,6 +154,12 @@ func (ts *tokenService) ProcessTokenRequest(
 		return nil, dpopErr
 	}
 
+	if bindErr := ts.bindClientCertificate(&ctx, oauthApp); bindErr != nil {
+		publishTokenIssuanceFailedEvent(ts.observabilitySvc, ctx, clientID, grantTypeStr, scopeStr,
+			400, bindErr.ErrorDescription, startTime)
+		return nil, bindErr
+	}
+
 	// Delegate to the grant handler for token generation.
 	tokenRespDTO, tokenError := grantHandler.HandleGrant(ctx, tokenRequest, oauthApp)
 	if tokenError != nil {
@@ -237,6 +244,8 @@ func (ts *tokenService) ProcessTokenRequest(
 		RefreshToken: tokenRespDTO.RefreshToken.Token,
 		Scope:        scopes,
 		IDToken:      tokenRespDTO.IDToken.Token,
+
+		AuthorizationDetails: tokenRespDTO.AccessToken.AuthorizationDetails,
 	}
 
 	// For token exchange, determine the issued_token_type from the request.
@@ -295,6 +304,25 @@ func (ts *tokenService) verifyDPoPProof(ctx *context.Context, oauthApp *provider
 	return nil
 }
 
+// bindClientCertificate stores the thumbprint of the client certificate presented on the request in ctx
+// so grant handlers bind the issued tokens to it (RFC 8705 §3). Binding applies only to clients that
+// registered for certificate-bound access tokens; for those a request without a certificate is rejected.
+func (ts *tokenService) bindClientCertificate(ctx *context.Context,
+	oauthApp *providers.OAuthClient) *model.ErrorResponse {
+	if oauthApp == nil || !oauthApp.MTLSBoundAccessTokens {
+		return nil
+	}
+	certificate := mtls.GetCertificate(*ctx)
+	if certificate == nil {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "A client certificate is required for this client",
+		}
+	}
+	*ctx = mtls.WithThumbprint(*ctx, mtls.Thumbprint(certificate))
+	return nil
+}
+
 // publishTokenIssuanceStartedEvent publishes an event indicating that token issuance has started.
 func (ts *tokenService) publishTokenIssuanceStartedEvent(ctx context.Context, clientID, grantType, scope string) {
 	if ts.observabilitySvc == nil || !ts.observabilitySvc.IsEnabled() {
@@ -5,6 +5,7 @@ package token
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"testing"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/dpopmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/granthandlersmock"
@@ -468,6 +470,71 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_NoDPoPProof_GlobalRe
 	suite.mockDPoPVerifier.AssertNotCalled(suite.T(), "Verify", mock.Anything, mock.Anything)
 }
 
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_CertificateBound_PropagatesThumbprintToHandler() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeAuthorizationCode),
+		Code:      "test-code",
+		Scope:     "openid",
+	}
+	app := &providers.OAuthClient{
+		ClientID:              "test-client-id",
+		GrantTypes:            []providers.GrantType{providers.GrantTypeAuthorizationCode},
+		MTLSBoundAccessTokens: true,
+	}
+	certificate := &x509.Certificate{Raw: []byte("client-certificate")}
+
+	suite.mockGrantProvider.ExpectedCalls = nil
+	suite.mockGrantProvider.
+		On("GetGrantHandler", providers.GrantTypeAuthorizationCode).
+		Return(suite.mockGrantHandler, nil)
+	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+	suite.mockGrantHandler.
+		On("HandleGrant",
+			mock.MatchedBy(func(ctx context.Context) bool {
+				return mtls.GetThumbprint(ctx) == mtls.Thumbprint(certificate)
+			}),
+			mock.Anything, app).
+		Return(&model.TokenResponseDTO{
+			AccessToken: model.TokenDTO{Token: "at", TokenType: constants.TokenTypeBearer, ExpiresIn: 3600},
+		}, nil)
+
+	svc := suite.newService()
+	resp, errResp := svc.ProcessTokenRequest(mtls.WithCertificate(context.Background(), certificate), req, app)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.TokenTypeBearer, resp.TokenType)
+}
+
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_CertificateBound_NoCertificate_Rejected() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeAuthorizationCode),
+		Code:      "test-code",
+		Scope:     "openid",
+	}
+	app := &providers.OAuthClient{
+		ClientID:              "test-client-id",
+		GrantTypes:            []providers.GrantType{providers.GrantTypeAuthorizationCode},
+		MTLSBoundAccessTokens: true,
+	}
+
+	suite.mockGrantProvider.ExpectedCalls = nil
+	suite.mockGrantProvider.
+		On("GetGrantHandler", providers.GrantTypeAuthorizationCode).
+		Return(suite.mockGrantHandler, nil)
+	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+
+	svc := suite.newService()
+	_, errResp := svc.ProcessTokenRequest(context.Background(), req, app)
+
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidRequest, errResp.Error)
+	suite.mockGrantHandler.AssertNotCalled(suite.T(), "HandleGrant", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *TokenServiceTestSuite) TestProcessTokenRequest_WithRefreshToken() {
 	req := &model.TokenRequest{
 		ClientID:  "test-client-id",
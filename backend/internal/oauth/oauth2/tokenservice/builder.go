@@ -12,9 +12,11 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -28,10 +30,11 @@ type TokenBuilderInterface interface {
 
 // TokenBuilder implements TokenBuilderInterface.
 type tokenBuilder struct {
-	cfg          oauthconfig.Config
-	jwtService   jwt.JWTServiceInterface
-	jweService   jwe.JWEServiceInterface
-	jwksResolver *jwksresolver.Resolver
+	cfg             oauthconfig.Config
+	jwtService      jwt.JWTServiceInterface
+	jweService      jwe.JWEServiceInterface
+	jwksResolver    *jwksresolver.Resolver
+	pairwiseService pairwise.PairwiseServiceInterface
 }
 
 // newTokenBuilder creates a new TokenBuilder instance.
@@ -40,12 +43,14 @@ func newTokenBuilder(
 	jwtService jwt.JWTServiceInterface,
 	jweService jwe.JWEServiceInterface,
 	resolver *jwksresolver.Resolver,
+	pairwiseService pairwise.PairwiseServiceInterface,
 ) TokenBuilderInterface {
 	return &tokenBuilder{
-		cfg:          cfg,
-		jwtService:   jwtService,
-		jweService:   jweService,
-		jwksResolver: resolver,
+		cfg:             cfg,
+		jwtService:      jwtService,
+		jweService:      jweService,
+		jwksResolver:    resolver,
+		pairwiseService: pairwiseService,
 	}
 }
 
@@ -82,6 +87,9 @@ func (tb *tokenBuilder) BuildAccessToken(
 		ClaimsRequest:    tokenCtx.ClaimsRequest,
 		ClaimsLocales:    tokenCtx.ClaimsLocales,
 		TokenFamilyID:    tokenCtx.TokenFamilyID,
+		ConsentID:        tokenCtx.ConsentID,
+
+		AuthorizationDetails: tokenCtx.AuthorizationDetails,
 	}
 
 	token, iat, err := tb.jwtService.GenerateJWT(
@@ -226,11 +234,22 @@ func (tb *tokenBuilder) buildAccessTokenClaims(
 	}
 
 	dpop.SetCnfJkt(claims, ctx.DPoPJkt)
+	// Merged into the cnf claim set above, so a token can carry both bindings.
+	mtls.SetCnfThumbprint(claims, ctx.CertThumbprint)
 
 	if ctx.TokenFamilyID != "" {
 		claims[constants.ClaimTokenFamilyID] = ctx.TokenFamilyID
 	}
 
+	if ctx.ConsentID != "" {
+		claims[constants.ClaimConsentID] = ctx.ConsentID
+	}
+
+	// Set after merging subject attributes so they cannot overwrite the approved details.
+	if len(ctx.AuthorizationDetails) > 0 {
+		claims[constants.ClaimAuthorizationDetails] = ctx.AuthorizationDetails
+	}
+
 	return claims, nil
 }
 
@@ -342,6 +361,14 @@ func (tb *tokenBuilder) buildRefreshTokenClaims(ctx *RefreshTokenBuildContext) (
 		claims[constants.ClaimTokenFamilyID] = ctx.TokenFamilyID
 	}
 
+	if ctx.ConsentID != "" {
+		claims[constants.ClaimConsentID] = ctx.ConsentID
+	}
+
+	if len(ctx.AuthorizationDetails) > 0 {
+		claims["access_token_authorization_details"] = ctx.AuthorizationDetails
+	}
+
 	return claims, nil
 }
 
@@ -356,13 +383,23 @@ func (tb *tokenBuilder) BuildIDToken(
 
 	tokenConfig := ResolveTokenConfig(tb.cfg, tokenCtx.OAuthApp, TokenTypeID, 0)
 
+	// A pairwise client sees its own subject identifier for the user rather than the user id.
+	subject := tokenCtx.Subject
+	if tb.pairwiseService != nil {
+		pairwiseSubject, err := tb.pairwiseService.GetSubject(ctx, tokenCtx.OAuthApp, subject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve ID token subject: %w", err)
+		}
+		subject = pairwiseSubject
+	}
+
 	jwtClaims := tb.buildIDTokenClaims(tokenCtx)
 
 	tokenDTO := &oauth2model.TokenDTO{
 		ExpiresIn: tokenConfig.ValidityPeriod,
 		Scopes:    tokenCtx.Scopes,
 		ClientID:  tokenCtx.Audience,
-		Subject:   tokenCtx.Subject,
+		Subject:   subject,
 		Audiences: []string{tokenCtx.Audience},
 	}
 
@@ -370,7 +407,7 @@ func (tb *tokenBuilder) BuildIDToken(
 
 	token, iat, err := tb.jwtService.GenerateJWT(
 		ctx,
-		tokenCtx.Subject,
+		subject,
 		tokenConfig.Issuer,
 		tokenConfig.ValidityPeriod,
 		jwtClaims,
@@ -435,6 +472,10 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 		claims["acr"] = ctx.CompletedACR
 	}
 
+	if ctx.SessionID != "" {
+		claims[constants.ClaimSessionID] = ctx.SessionID
+	}
+
 	userAttributes := ctx.UserAttributes
 	if userAttributes == nil {
 		userAttributes = make(map[string]interface{})
@@ -7,6 +7,7 @@ import (
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
@@ -23,8 +24,9 @@ func Initialize(
 	idpService providers.IDPProvider,
 	enforcementService revocation.EnforcementServiceInterface,
 	jtiStore jti.JTIStoreInterface,
+	pairwiseService pairwise.PairwiseServiceInterface,
 ) (TokenBuilderInterface, TokenValidatorInterface) {
-	tokenBuilder := newTokenBuilder(cfg, jwtService, jweService, resolver)
-	tokenValidator := newTokenValidator(cfg, jwtService, idpService, enforcementService, jtiStore)
+	tokenBuilder := newTokenBuilder(cfg, jwtService, jweService, resolver, pairwiseService)
+	tokenValidator := newTokenValidator(cfg, jwtService, idpService, enforcementService, jtiStore, pairwiseService)
 	return tokenBuilder, tokenValidator
 }
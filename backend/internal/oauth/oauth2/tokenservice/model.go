@@ -52,6 +52,9 @@ type AccessTokenBuildContext struct {
 	// DPoPJkt, when set, sender-constrains the access token to the supplied JWK thumbprint.
 	// The token receives a `cnf.jkt` claim and is issued with `token_type=DPoP`.
 	DPoPJkt string
+	// CertThumbprint, when set, binds the access token to the client certificate with the supplied
+	// SHA-256 thumbprint (RFC 8705). The token receives a `cnf.x5t#S256` claim and stays a Bearer token.
+	CertThumbprint string
 	// SourceIDP, when set, records the issuer of the external identity provider that authenticated the
 	// subject (used by the jwt-bearer/ID-JAG grant). It is emitted as the `idp` claim so downstream
 	// consumers can distinguish a federated principal from a local one.
@@ -59,6 +62,12 @@ type AccessTokenBuildContext struct {
 	// TokenFamilyID, when set, is stamped as the `tfid` claim so the token can be revoked as part of
 	// its authorization grant's family. It is constant across refresh rotation.
 	TokenFamilyID string
+	// ConsentID, when set, is stamped as the `cnid` claim so the token is revoked when the consent its
+	// grant was issued under is withdrawn.
+	ConsentID string
+	// AuthorizationDetails, when set, are the authorization details (RFC 9396) approved for the token.
+	// They are emitted as the `authorization_details` claim.
+	AuthorizationDetails []providers.AuthorizationDetail
 }
 
 // RefreshTokenBuildContext contains all the information needed to build a refresh token.
@@ -77,6 +86,12 @@ type RefreshTokenBuildContext struct {
 	// TokenFamilyID, when set, is stamped as the `tfid` claim on the refresh token. It is copied
 	// unchanged across rotation so every token of the grant shares one family id.
 	TokenFamilyID string
+	// ConsentID, when set, is stamped as the `cnid` claim on the refresh token. Like the family id it
+	// is copied unchanged across rotation.
+	ConsentID string
+	// AuthorizationDetails are the authorization details (RFC 9396) granted to the access tokens
+	// minted from this refresh token.
+	AuthorizationDetails []providers.AuthorizationDetail
 }
 
 // IDJAGBuildContext contains all the information needed to build an ID-JAG (Identity Assertion
@@ -103,6 +118,9 @@ type IDTokenBuildContext struct {
 	ClaimsRequest  *oauth2model.ClaimsRequest
 	Nonce          string
 	CompletedACR   string
+	// SessionID, when set, is the SSO session that authenticated the subject. It is emitted as the
+	// `sid` claim (OIDC Front-Channel and Back-Channel Logout).
+	SessionID string
 }
 
 // RefreshTokenClaims represents the validated claims from a refresh token.
@@ -126,6 +144,11 @@ type RefreshTokenClaims struct {
 	// tokens minted during rotation so the family stays intact, and used to revoke the whole family on
 	// reuse. Empty for pre-rollout tokens.
 	TokenFamilyID string
+	// ConsentID is the consent record the refresh token's grant was issued under, copied onto the
+	// tokens minted during rotation. Empty when the grant was not issued under a consent.
+	ConsentID string
+	// AuthorizationDetails are the authorization details (RFC 9396) carried on the refresh token.
+	AuthorizationDetails []providers.AuthorizationDetail
 }
 
 // SubjectTokenClaims represents the validated claims from a subject token (for token exchange).
@@ -11,10 +11,12 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/idp"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authorizationdetails"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
@@ -59,6 +61,7 @@ type tokenValidator struct {
 	idpService         providers.IDPProvider
 	enforcementService revocation.EnforcementServiceInterface
 	jtiStore           jti.JTIStoreInterface
+	pairwiseService    pairwise.PairwiseServiceInterface
 }
 
 // NewTokenValidator creates a new TokenValidator instance.
@@ -68,6 +71,7 @@ func newTokenValidator(
 	idpService providers.IDPProvider,
 	enforcementService revocation.EnforcementServiceInterface,
 	jtiStore jti.JTIStoreInterface,
+	pairwiseService pairwise.PairwiseServiceInterface,
 ) TokenValidatorInterface {
 	return &tokenValidator{
 		cfg:                cfg,
@@ -75,6 +79,7 @@ func newTokenValidator(
 		idpService:         idpService,
 		enforcementService: enforcementService,
 		jtiStore:           jtiStore,
+		pairwiseService:    pairwiseService,
 	}
 }
 
@@ -170,6 +175,7 @@ func (tv *tokenValidator) ValidateRefreshToken(
 	actorSub, _ := extractStringClaim(claims, "act_sub")
 	jti, _ := extractStringClaim(claims, "jti")
 	tokenFamilyID, _ := extractStringClaim(claims, constants.ClaimTokenFamilyID)
+	consentID, _ := extractStringClaim(claims, constants.ClaimConsentID)
 
 	// Extract claims request if present
 	var claimsRequest *oauth2model.ClaimsRequest
@@ -185,6 +191,16 @@ func (tv *tokenValidator) ValidateRefreshToken(
 	// Extract claims_locales if present
 	claimsLocales, _ := extractStringClaim(claims, "access_token_claims_locales")
 
+	// Extract authorization details if present
+	var authorizationDetails []providers.AuthorizationDetail
+	if v, exists := claims["access_token_authorization_details"]; exists {
+		details, ok := authorizationdetails.FromClaim(v)
+		if !ok {
+			return nil, fmt.Errorf("invalid 'access_token_authorization_details' claim in refresh token")
+		}
+		authorizationDetails = details
+	}
+
 	var dpopJkt string
 	if _, exists := claims["dpop_jkt"]; exists {
 		s, err := extractStringClaim(claims, "dpop_jkt")
@@ -213,6 +229,9 @@ func (tv *tokenValidator) ValidateRefreshToken(
 		JTI:              jti,
 		Exp:              exp,
 		TokenFamilyID:    tokenFamilyID,
+		ConsentID:        consentID,
+
+		AuthorizationDetails: authorizationDetails,
 	}, nil
 }
 
@@ -247,6 +266,9 @@ func (tv *tokenValidator) ValidateSubjectToken(
 		if err := tv.verifyTokenSignatureByIssuer(ctx, token, iss); err != nil {
 			return nil, fmt.Errorf("invalid subject token signature: %w", err)
 		}
+		if err := tv.resolvePairwiseSubject(ctx, header, claims); err != nil {
+			return nil, err
+		}
 		selfClaims, err := tv.extractSubjectTokenClaims(token, iss, claims, oauthApp, nil)
 		if err != nil {
 			return nil, err
@@ -691,6 +713,44 @@ func (tv *tokenValidator) validateOAuth2RefreshClaims(claims map[string]interfac
 	return nil
 }
 
+// resolvePairwiseSubject maps the sub claim of a self-issued ID token back to the internal user id.
+// ID tokens issued to pairwise clients carry a sector-specific subject, while access tokens, refresh
+// tokens and auth assertions always carry the user id. The claims map is updated in place so that
+// claim extraction and revocation enforcement both see the user id.
+func (tv *tokenValidator) resolvePairwiseSubject(ctx context.Context, header map[string]interface{},
+	claims map[string]interface{}) error {
+	if tv.pairwiseService == nil {
+		return nil
+	}
+	if typ, _ := header["typ"].(string); typ != jwt.TokenTypeJWT {
+		return nil
+	}
+	if _, isRefreshToken := claims[constants.ClaimAccessTokenSubject]; isRefreshToken || tv.isAuthAssertion(claims) {
+		return nil
+	}
+
+	sub, err := extractStringClaim(claims, constants.ClaimSub)
+	if err != nil {
+		return nil
+	}
+	clientID, _ := extractStringClaim(claims, constants.ClaimAzp)
+	if clientID == "" {
+		if auds, audErr := extractAudiences(claims); audErr == nil && len(auds) > 0 {
+			clientID = auds[0]
+		}
+	}
+	if clientID == "" {
+		return nil
+	}
+
+	userID, err := tv.pairwiseService.ResolveUserID(ctx, clientID, sub)
+	if err != nil {
+		return fmt.Errorf("failed to resolve subject token subject: %w", err)
+	}
+	claims[constants.ClaimSub] = userID
+	return nil
+}
+
 // isAuthAssertion determines if a JWT token is an auth assertion.
 func (tv *tokenValidator) isAuthAssertion(
 	claims map[string]interface{},
@@ -714,13 +774,13 @@ func (tv *tokenValidator) ensureNotRevoked(ctx context.Context,
 
 // revocationIdentity extracts the trusted token attributes used by criteria enforcement.
 //
-// Only the dimensions a writer actually records are enforced here: the token family and the subject.
-// The remaining criterion types the revocation service accepts have no writer yet, and adding them
-// speculatively would widen the deny-list query on every token validation for rows that cannot
-// exist. Extend this alongside the write path, not ahead of it, and keep it in step with the
+// Only the dimensions a writer actually records are enforced here: the token family, the subject and
+// the consent the grant was issued under. The remaining criterion types the revocation service
+// accepts have no writer yet, and adding them speculatively would widen the deny-list query on every
+// token validation for rows that cannot exist. Extend this alongside the write path, not ahead of it, and keep it in step with the
 // Resource Server cache so both enforcement points cover the same dimensions.
 func revocationIdentity(claims map[string]interface{}, jti, tokenFamilyID string) revocation.RevocationIdentity {
-	criteria := make([]revocation.Criterion, 0, 2)
+	criteria := make([]revocation.Criterion, 0, 3)
 	if tokenFamilyID != "" {
 		criteria = append(criteria,
 			revocation.Criterion{Type: revocation.CriterionTypeTokenFamily, Value: tokenFamilyID})
@@ -733,6 +793,9 @@ func revocationIdentity(claims map[string]interface{}, jti, tokenFamilyID string
 	if subject != "" {
 		criteria = append(criteria, revocation.Criterion{Type: revocation.CriterionTypeSubject, Value: subject})
 	}
+	if consentID, _ := extractStringClaim(claims, constants.ClaimConsentID); consentID != "" {
+		criteria = append(criteria, revocation.Criterion{Type: revocation.CriterionTypeConsent, Value: consentID})
+	}
 
 	var establishedAt time.Time
 	if issuedAt, ok := claims[constants.ClaimIat].(float64); ok {
@@ -11,6 +11,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -29,6 +30,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/jtimock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/revocationmock"
 )
 
@@ -164,6 +166,51 @@ func (suite *TokenValidatorTestSuite) TestValidateSubjectToken_Success_BasicToke
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenValidatorTestSuite) TestValidateSubjectToken_PairwiseIDToken() {
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(suite.T())
+	suite.validator.pairwiseService = pairwiseService
+
+	now := time.Now().Unix()
+	token := suite.createTestJWT(map[string]interface{}{
+		"sub": "pairwise-sub",
+		"iss": "https://example.com",
+		"aud": "pairwise-client",
+		"exp": float64(now + 3600),
+		"nbf": float64(now - 60),
+	})
+
+	suite.mockJWTService.On("VerifyJWTSignature", mock.Anything, token).Return(nil)
+	pairwiseService.On("ResolveUserID", mock.Anything, "pairwise-client", "pairwise-sub").Return("user123", nil)
+
+	result, err := suite.validator.ValidateSubjectToken(context.Background(), token, suite.oauthApp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "user123", result.Sub)
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateSubjectToken_PairwiseIDToken_Unresolvable() {
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(suite.T())
+	suite.validator.pairwiseService = pairwiseService
+
+	now := time.Now().Unix()
+	token := suite.createTestJWT(map[string]interface{}{
+		"sub": "unknown-sub",
+		"iss": "https://example.com",
+		"aud": "pairwise-client",
+		"azp": "pairwise-client",
+		"exp": float64(now + 3600),
+	})
+
+	suite.mockJWTService.On("VerifyJWTSignature", mock.Anything, token).Return(nil)
+	pairwiseService.On("ResolveUserID", mock.Anything, "pairwise-client", "unknown-sub").
+		Return("", errors.New("pairwise subject not found"))
+
+	result, err := suite.validator.ValidateSubjectToken(context.Background(), token, suite.oauthApp)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
 func (suite *TokenValidatorTestSuite) TestValidateSubjectToken_Success_WithTokenConfig() {
 	// App with token config should still validate using server-level issuer from config
 	customOAuthApp := &providers.OAuthClient{
@@ -2061,6 +2108,17 @@ func (suite *TokenValidatorTestSuite) TestRevocationIdentity_RefreshTokenUsesAcc
 	})
 }
 
+func (suite *TokenValidatorTestSuite) TestRevocationIdentity_IncludesConsentCriterion() {
+	identity := revocationIdentity(map[string]interface{}{
+		"sub":  "user-123",
+		"cnid": "consent-1",
+	}, "jti", "")
+
+	assert.Contains(suite.T(), identity.Criteria, revocation.Criterion{
+		Type: revocation.CriterionTypeConsent, Value: "consent-1",
+	})
+}
+
 // When the deny list cannot be consulted, the validator surfaces revocation.ErrEnforcementUnavailable
 // (fail-closed) rather than returning claims.
 func (suite *TokenValidatorTestSuite) TestValidateAccessToken_EnforcementUnavailable() {
@@ -16,6 +16,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 )
 
@@ -72,7 +73,7 @@ func (h *userInfoHandler) handleBearerRequest(
 		return
 	}
 
-	ctx := r.Context()
+	ctx := withClientCertificate(r)
 	result, svcErr := h.service.GetUserInfo(ctx, accessToken)
 	if svcErr != nil {
 		h.writeServiceErrorResponse(ctx, w, svcErr, svcErr == &errorBearerDowngrade)
@@ -99,7 +100,7 @@ func (h *userInfoHandler) handleDPoPRequest(
 		return
 	}
 
-	ctx := r.Context()
+	ctx := withClientCertificate(r)
 	result, svcErr := h.service.GetUserInfoForDPoP(
 		ctx, accessToken, dpopHeaders[0], r.Method, h.userInfoEndpoint)
 	if svcErr != nil {
@@ -110,6 +111,15 @@ func (h *userInfoHandler) handleDPoPRequest(
 	h.writeUserInfoResponse(ctx, w, result)
 }
 
+// withClientCertificate returns the request context carrying the client certificate presented on the
+// request, if any, so certificate-bound access tokens can be checked against it.
+func withClientCertificate(r *http.Request) context.Context {
+	if certificate := mtls.ClientCertificate(r); certificate != nil {
+		return mtls.WithCertificate(r.Context(), certificate)
+	}
+	return r.Context()
+}
+
 func (h *userInfoHandler) writeUserInfoResponse(ctx context.Context, w http.ResponseWriter, result *UserInfoResponse) {
 	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
 	w.Header().Set(serverconst.PragmaHeaderName, serverconst.PragmaNoCache)
@@ -18,12 +18,14 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pairwise"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -46,6 +48,7 @@ type userInfoService struct {
 	inboundClient     providers.ActorProvider
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface
 	dpopVerifier      dpop.VerifierInterface
+	pairwiseService   pairwise.PairwiseServiceInterface
 	logger            *log.Logger
 }
 
@@ -58,6 +61,7 @@ func newUserInfoService(
 	actorProvider providers.ActorProvider,
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
 	dpopVerifier dpop.VerifierInterface,
+	pairwiseService pairwise.PairwiseServiceInterface,
 	cfg oauthconfig.Config,
 ) userInfoServiceInterface {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, serviceLoggerComponentName))
@@ -70,12 +74,14 @@ func newUserInfoService(
 		inboundClient:     actorProvider,
 		attributeCacheSvc: attributeCacheSvc,
 		dpopVerifier:      dpopVerifier,
+		pairwiseService:   pairwiseService,
 		logger:            logger,
 	}
 }
 
 // GetUserInfo validates the access token under the Bearer scheme. A DPoP-bound
-// access token presented under Bearer is rejected as a downgrade.
+// access token presented under Bearer is rejected as a downgrade, and a
+// certificate-bound one must arrive with the client certificate it is bound to.
 func (s *userInfoService) GetUserInfo(
 	ctx context.Context, accessToken string,
 ) (*UserInfoResponse, *tidcommon.ServiceError) {
@@ -97,6 +103,10 @@ func (s *userInfoService) GetUserInfo(
 		s.logger.Debug(ctx, "DPoP-bound access token presented under Bearer scheme")
 		return nil, &errorBearerDowngrade
 	}
+	if bindErr := mtls.VerifyBinding(accessTokenClaims.Claims, mtls.GetCertificate(ctx)); bindErr != nil {
+		s.logger.Debug(ctx, "Certificate-bound access token presented without its certificate", log.Error(bindErr))
+		return nil, &errorInvalidAccessToken
+	}
 
 	return s.buildResponseFromClaims(ctx, accessTokenClaims)
 }
@@ -140,6 +150,10 @@ func (s *userInfoService) GetUserInfoForDPoP(
 		s.logger.Debug(ctx, "DPoP proof verification failed", log.Error(dpopErr))
 		return nil, &errorDPoPProofInvalid
 	}
+	if bindErr := mtls.VerifyBinding(accessTokenClaims.Claims, mtls.GetCertificate(ctx)); bindErr != nil {
+		s.logger.Debug(ctx, "Certificate-bound access token presented without its certificate", log.Error(bindErr))
+		return nil, &errorInvalidAccessToken
+	}
 
 	return s.buildResponseFromClaims(ctx, accessTokenClaims)
 }
@@ -164,6 +178,17 @@ func (s *userInfoService) buildResponseFromClaims(
 
 	oauthApp := s.getOAuthApp(ctx, tokenClaims)
 
+	// Pairwise clients see the same sector-specific sub that was issued in their ID tokens.
+	if s.pairwiseService != nil {
+		pairwiseSub, err := s.pairwiseService.GetSubject(ctx, oauthApp, sub)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to resolve pairwise subject",
+				log.MaskedString(log.LoggerKeyUserID, sub), log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		sub = pairwiseSub
+	}
+
 	// Extract allowed user attributes
 	var allowedUserAttributes []string
 	if oauthApp != nil && oauthApp.UserInfo != nil {
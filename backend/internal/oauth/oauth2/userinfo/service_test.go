@@ -9,6 +9,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -32,12 +33,14 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/dpopmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/pairwisemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
 )
 
@@ -73,7 +76,7 @@ func (s *UserInfoServiceTestSuite) SetupTest() {
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
 		actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr(), nil),
-		s.mockAttributeCacheService, nil,
+		s.mockAttributeCacheService, nil, nil,
 		oauthconfig.Config{JWT: engineconfig.JWTConfig{Issuer: testUserInfoIssuer, ValidityPeriod: 600}},
 	)
 
@@ -140,7 +143,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_RevocationUnavailable(
 	actorProv := actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr(), nil)
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
-		actorProv, s.mockAttributeCacheService, verifier, userInfoTestConfig())
+		actorProv, s.mockAttributeCacheService, verifier, nil, userInfoTestConfig())
 
 	token := "token.revocation.unavailable"
 	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
@@ -344,6 +347,51 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_StandardScopes() {
 	s.mockInboundClient.AssertExpectations(s.T())
 }
 
+// TestGetUserInfo_Success_PairwiseSubject tests that a pairwise client receives its pairwise sub.
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_PairwiseSubject() {
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(s.T())
+	s.userInfoService.(*userInfoService).pairwiseService = pairwiseService
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"scope":     "openid",
+		"client_id": "client123",
+	}
+	token := s.createToken(claims)
+	oauthApp := &providers.OAuthClient{ClientID: "client123", SubjectType: providers.SubjectTypePairwise}
+
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
+		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
+	s.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, "client123").Return(oauthApp, nil)
+	pairwiseService.On("GetSubject", mock.Anything, mock.Anything, "user123").Return("pairwise-sub", nil)
+
+	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
+	assert.Nil(s.T(), svcErr)
+	assert.Equal(s.T(), "pairwise-sub", response.JSONBody["sub"])
+}
+
+// TestGetUserInfo_PairwiseSubjectError tests that a pairwise resolution failure is a server error.
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_PairwiseSubjectError() {
+	pairwiseService := pairwisemock.NewPairwiseServiceInterfaceMock(s.T())
+	s.userInfoService.(*userInfoService).pairwiseService = pairwiseService
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"scope":     "openid",
+		"client_id": "client123",
+	}
+	token := s.createToken(claims)
+	oauthApp := &providers.OAuthClient{ClientID: "client123", SubjectType: providers.SubjectTypePairwise}
+
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
+		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
+	s.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, "client123").Return(oauthApp, nil)
+	pairwiseService.On("GetSubject", mock.Anything, mock.Anything, "user123").
+		Return("", errors.New("store unavailable"))
+
+	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
+	assert.Nil(s.T(), response)
+	assert.Equal(s.T(), tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
 // TestGetUserInfo_Success_WithGroups tests successful response with groups
 func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_WithGroups() {
 	claims := map[string]interface{}{
@@ -1166,6 +1214,31 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_BearerScheme_DPoPBoundToken_R
 	s.mockTokenValidator.AssertExpectations(s.T())
 }
 
+// TestGetUserInfo_CertificateBoundToken_WithoutMatchingCertificate_Rejected verifies that a
+// certificate-bound access token is rejected unless it arrives with the certificate it is bound to.
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_CertificateBoundToken_WithoutMatchingCertificate_Rejected() {
+	boundCert := &x509.Certificate{Raw: []byte("bound-certificate")}
+	claims := map[string]any{
+		"sub":   "user123",
+		"scope": "openid",
+		"cnf":   map[string]any{"x5t#S256": mtls.Thumbprint(boundCert)},
+	}
+	token := s.createToken(claims)
+
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
+		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
+
+	for _, ctx := range []context.Context{
+		context.Background(),
+		mtls.WithCertificate(context.Background(), &x509.Certificate{Raw: []byte("other-certificate")}),
+	} {
+		response, svcErr := s.userInfoService.GetUserInfo(ctx, token)
+		assert.NotNil(s.T(), svcErr)
+		assert.Equal(s.T(), errorInvalidAccessToken.Code, svcErr.Code)
+		assert.Nil(s.T(), response)
+	}
+}
+
 // TestGetUserInfoForDPoP_NotBoundToken_Rejected verifies that a non-bound access token
 // presented under the DPoP scheme is rejected.
 func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_NotBoundToken_Rejected() {
@@ -1173,7 +1246,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_NotBoundToken_Rejected
 	actorProv := actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr(), nil)
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
-		actorProv, s.mockAttributeCacheService, verifier, userInfoTestConfig())
+		actorProv, s.mockAttributeCacheService, verifier, nil, userInfoTestConfig())
 
 	claims := map[string]any{
 		"sub":   "user123",
@@ -1199,7 +1272,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_VerifierFails_Rejected
 	actorProv := actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr(), nil)
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
-		actorProv, s.mockAttributeCacheService, verifier, userInfoTestConfig())
+		actorProv, s.mockAttributeCacheService, verifier, nil, userInfoTestConfig())
 
 	claims := map[string]any{
 		"sub":   "user123",
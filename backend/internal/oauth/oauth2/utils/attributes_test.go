@@ -0,0 +1,99 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type AttributesTestSuite struct {
+	suite.Suite
+}
+
+func TestAttributesSuite(t *testing.T) {
+	suite.Run(t, new(AttributesTestSuite))
+}
+
+// -------------------------------------------------------------------
+// GetRequiredOptionalAttributes tests
+// -------------------------------------------------------------------
+
+func (suite *AttributesTestSuite) TestGetRequiredOptionalAttributes_NilApp() {
+	suite.Empty(GetRequiredOptionalAttributes([]string{"openid", "profile"}, nil))
+}
+
+func (suite *AttributesTestSuite) TestGetRequiredOptionalAttributes_AccessTokenAttributesOnly() {
+	app := &providers.OAuthClient{
+		Token: &providers.OAuthTokenConfig{
+			AccessToken: &providers.AccessTokenConfig{
+				UserConfig: &providers.AccessTokenSubConfig{Attributes: []string{"user_id", "role"}},
+			},
+		},
+	}
+	suite.ElementsMatch([]string{"user_id", "role"}, strings.Fields(GetRequiredOptionalAttributes([]string{}, app)))
+}
+
+func (suite *AttributesTestSuite) TestGetRequiredOptionalAttributes_ScopeDerivedFilteredByUserInfo() {
+	app := &providers.OAuthClient{
+		Token: &providers.OAuthTokenConfig{
+			AccessToken: &providers.AccessTokenConfig{
+				UserConfig: &providers.AccessTokenSubConfig{Attributes: []string{"user_id"}},
+			},
+		},
+		UserInfo: &providers.UserInfoConfig{
+			UserAttributes: []string{"email", "name"},
+		},
+	}
+	optional := GetRequiredOptionalAttributes([]string{"openid", "email", "profile"}, app)
+	suite.ElementsMatch([]string{"user_id", "email", "name"}, strings.Fields(optional))
+}
+
+func (suite *AttributesTestSuite) TestGetRequiredOptionalAttributes_ScopeDerivedFromIDTokenAllowList() {
+	// Regression: a scope attribute allow-listed only for the ID token (and not for UserInfo) must
+	// still be resolved and cached so the CIBA-issued ID token can surface it.
+	app := &providers.OAuthClient{
+		Token: &providers.OAuthTokenConfig{
+			IDToken: &providers.IDTokenConfig{
+				UserAttributes: []string{"email", "email_verified"},
+			},
+		},
+	}
+	optional := GetRequiredOptionalAttributes([]string{"openid", "email"}, app)
+	suite.ElementsMatch([]string{"email", "email_verified"}, strings.Fields(optional))
+}
+
+func (suite *AttributesTestSuite) TestGetRequiredOptionalAttributes_ScopeDerivedSkippedWithoutOpenID() {
+	app := &providers.OAuthClient{
+		UserInfo: &providers.UserInfoConfig{
+			UserAttributes: []string{"email", "name"},
+		},
+	}
+	suite.Empty(GetRequiredOptionalAttributes([]string{"profile", "email"}, app))
+}
+
+func (suite *AttributesTestSuite) TestGetRequiredOptionalAttributes_UsesAppScopeClaimsMapping() {
+	app := &providers.OAuthClient{
+		UserInfo: &providers.UserInfoConfig{
+			UserAttributes: []string{"custom_attr"},
+		},
+		ScopeClaims: map[string][]string{
+			"profile": {"custom_attr"},
+		},
+	}
+	suite.ElementsMatch([]string{"custom_attr"},
+		strings.Fields(GetRequiredOptionalAttributes([]string{"openid", "profile"}, app)))
+}
+
+func (suite *AttributesTestSuite) TestResolveScopeAttributes_StandardScope() {
+	suite.ElementsMatch([]string{"email", "email_verified"}, ResolveScopeAttributes("email", nil))
+}
+
+func (suite *AttributesTestSuite) TestResolveScopeAttributes_UnknownScope() {
+	suite.Nil(ResolveScopeAttributes("unknown_scope", map[string][]string{"custom": {"email"}}))
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -1467,6 +1468,46 @@ func (_c *ConfigurableOUServiceMock_IsParent_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// SetAuditRecorder provides a mock function for the type ConfigurableOUServiceMock
+func (_mock *ConfigurableOUServiceMock) SetAuditRecorder(recorder *audit.Recorder) {
+	_mock.Called(recorder)
+	return
+}
+
+// ConfigurableOUServiceMock_SetAuditRecorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAuditRecorder'
+type ConfigurableOUServiceMock_SetAuditRecorder_Call struct {
+	*mock.Call
+}
+
+// SetAuditRecorder is a helper method to define mock.On call
+//   - recorder *audit.Recorder
+func (_e *ConfigurableOUServiceMock_Expecter) SetAuditRecorder(recorder interface{}) *ConfigurableOUServiceMock_SetAuditRecorder_Call {
+	return &ConfigurableOUServiceMock_SetAuditRecorder_Call{Call: _e.mock.On("SetAuditRecorder", recorder)}
+}
+
+func (_c *ConfigurableOUServiceMock_SetAuditRecorder_Call) Run(run func(recorder *audit.Recorder)) *ConfigurableOUServiceMock_SetAuditRecorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *audit.Recorder
+		if args[0] != nil {
+			arg0 = args[0].(*audit.Recorder)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ConfigurableOUServiceMock_SetAuditRecorder_Call) Return() *ConfigurableOUServiceMock_SetAuditRecorder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ConfigurableOUServiceMock_SetAuditRecorder_Call) RunAndReturn(run func(recorder *audit.Recorder)) *ConfigurableOUServiceMock_SetAuditRecorder_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetDependencyRegistry provides a mock function for the type ConfigurableOUServiceMock
 func (_mock *ConfigurableOUServiceMock) SetDependencyRegistry(r resourcedependency.Registry) {
 	_mock.Called(r)
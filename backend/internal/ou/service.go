@@ -14,6 +14,7 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
@@ -94,6 +95,7 @@ type ConfigurableOUService interface {
 	SetOURoleResolver(resolver OURoleResolver)
 	SetOUFlowResolver(resolver ouFlowResolver)
 	SetDependencyRegistry(r resourcedependency.Registry)
+	SetAuditRecorder(recorder *audit.Recorder)
 	GetResourceDependencies(
 		ctx context.Context, resourceType, id string) ([]resourcedependency.ResourceDependency, error)
 }
@@ -108,6 +110,7 @@ type organizationUnitService struct {
 	roleResolver       OURoleResolver
 	flowResolver       ouFlowResolver
 	dependencyRegistry resourcedependency.Registry
+	auditRecorder      *audit.Recorder
 }
 
 func (ous *organizationUnitService) SetOUUserResolver(resolver OUUserResolver) {
@@ -120,6 +123,12 @@ func (ous *organizationUnitService) SetDependencyRegistry(r resourcedependency.R
 	ous.dependencyRegistry = r
 }
 
+// SetAuditRecorder injects the recorder that organization unit mutations are audited through. Called
+// by servicemanager once the audit service is initialized.
+func (ous *organizationUnitService) SetAuditRecorder(recorder *audit.Recorder) {
+	ous.auditRecorder = recorder
+}
+
 func (ous *organizationUnitService) SetOUGroupResolver(resolver OUGroupResolver) {
 	ous.groupResolver = resolver
 }
@@ -416,6 +425,7 @@ func (ous *organizationUnitService) CreateOrganizationUnit(
 	}
 
 	logger.Debug(ctx, "Successfully created organization unit", log.String("ouID", createdOU.ID))
+	ous.auditRecorder.RecordCreate(ctx, audit.ResourceTypeOU, createdOU.ID, createdOU)
 
 	return createdOU, nil
 }
@@ -536,7 +546,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnit(
 		return providers.OrganizationUnit{}, svcErr
 	}
 
-	var updatedOU providers.OrganizationUnit
+	var previousOU, updatedOU providers.OrganizationUnit
 	var capturedSvcErr *tidcommon.ServiceError
 
 	err := ous.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -548,6 +558,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnit(
 			}
 			return err
 		}
+		previousOU = existingOU
 
 		var svcErr *tidcommon.ServiceError
 		updatedOU, svcErr = ous.updateOUInternal(txCtx, id, request, existingOU, logger)
@@ -567,6 +578,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnit(
 	}
 
 	logger.Debug(ctx, "Successfully updated organization unit", log.String("ouID", id))
+	ous.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeOU, id, previousOU, updatedOU)
 	return updatedOU, nil
 }
 
@@ -582,7 +594,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnitByPath(
 		return providers.OrganizationUnit{}, serviceError
 	}
 
-	var updatedOU providers.OrganizationUnit
+	var previousOU, updatedOU providers.OrganizationUnit
 	var capturedSvcErr *tidcommon.ServiceError
 
 	err := ous.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -594,6 +606,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnitByPath(
 			}
 			return err
 		}
+		previousOU = existingOU
 
 		if svcErr := ous.checkOUAccess(txCtx, security.ActionUpdateOU, existingOU.ID); svcErr != nil {
 			capturedSvcErr = svcErr
@@ -625,6 +638,7 @@ func (ous *organizationUnitService) UpdateOrganizationUnitByPath(
 	}
 
 	logger.Debug(ctx, "Successfully updated organization unit by path", log.String("ouID", updatedOU.ID))
+	ous.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeOU, updatedOU.ID, previousOU, updatedOU)
 	return updatedOU, nil
 }
 
@@ -770,6 +784,7 @@ func (ous *organizationUnitService) DeleteOrganizationUnit(
 	}
 
 	logger.Debug(ctx, "Successfully deleted organization unit", log.String("ouID", id))
+	ous.auditRecorder.RecordDelete(ctx, audit.ResourceTypeOU, id, nil)
 	return nil
 }
 
@@ -786,6 +801,7 @@ func (ous *organizationUnitService) DeleteOrganizationUnitByPath(
 	}
 
 	var ouID string
+	var deletedOU providers.OrganizationUnit
 	var capturedSvcErr *tidcommon.ServiceError
 
 	err := ous.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -798,6 +814,7 @@ func (ous *organizationUnitService) DeleteOrganizationUnitByPath(
 			return err
 		}
 		ouID = existingOU.ID
+		deletedOU = existingOU
 
 		if svcErr := ous.checkOUAccess(txCtx, security.ActionDeleteOU, ouID); svcErr != nil {
 			capturedSvcErr = svcErr
@@ -828,6 +845,7 @@ func (ous *organizationUnitService) DeleteOrganizationUnitByPath(
 	}
 
 	logger.Debug(ctx, "Successfully deleted organization unit by path", log.String("ouID", ouID))
+	ous.auditRecorder.RecordDelete(ctx, audit.ResourceTypeOU, ouID, deletedOU)
 	return nil
 }
 
@@ -267,14 +267,16 @@ func buildGetOrganizationUnitsByIDsQuery(ids []string) dbmodel.DBQuery {
 		sqlitePlaceholders[i] = "?"
 	}
 	sqliteInClause := strings.Join(sqlitePlaceholders, ", ")
+	sqliteQuery := `SELECT OU_ID, HANDLE, NAME, DESCRIPTION, METADATA, CREATED_AT, UPDATED_AT ` +
+		`FROM "ORGANIZATION_UNIT" ` +
+		`WHERE OU_ID IN (` + sqliteInClause + `) AND DEPLOYMENT_ID = ? ORDER BY NAME`
 
 	return dbmodel.DBQuery{
 		ID: "OUQ-OU_MGT-21",
 		PostgresQuery: `SELECT OU_ID, HANDLE, NAME, DESCRIPTION, METADATA, CREATED_AT, UPDATED_AT ` +
 			`FROM "ORGANIZATION_UNIT" ` +
 			`WHERE OU_ID IN (` + pgInClause + `) AND DEPLOYMENT_ID = ` + deploymentIDParam + ` ORDER BY NAME`,
-		SQLiteQuery: `SELECT OU_ID, HANDLE, NAME, DESCRIPTION, METADATA, CREATED_AT, UPDATED_AT ` +
-			`FROM "ORGANIZATION_UNIT" ` +
-			`WHERE OU_ID IN (` + sqliteInClause + `) AND DEPLOYMENT_ID = ? ORDER BY NAME`,
+		SQLiteQuery: sqliteQuery,
+		MySQLQuery:  sqliteQuery,
 	}
 }
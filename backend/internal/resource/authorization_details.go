@@ -0,0 +1,119 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// ValidateAuthorizationDetails checks that each authorization details object (RFC 9396) has a type
+// registered on the resource server and conforms to that type's JSON Schema. Returns
+// ErrorInvalidAuthorizationDetails when any object is rejected.
+func (rs *resourceService) ValidateAuthorizationDetails(
+	ctx context.Context,
+	resourceServerID string,
+	details []providers.AuthorizationDetail,
+) *tidcommon.ServiceError {
+	if len(details) == 0 {
+		return nil
+	}
+
+	resourceServer, svcErr := rs.validateAndGetResourceServer(ctx, resourceServerID)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			return &ErrorInvalidAuthorizationDetails
+		}
+		return svcErr
+	}
+
+	types := make(map[string]providers.AuthorizationDetailsType, len(resourceServer.AuthorizationDetailsTypes))
+	for _, t := range resourceServer.AuthorizationDetailsTypes {
+		types[t.Type] = t
+	}
+
+	for i, detail := range details {
+		detailType, ok := types[detail.Type()]
+		if !ok {
+			rs.logger.Debug(ctx, "Authorization details type is not registered on the resource server",
+				log.String("resourceServerId", resourceServerID), log.String("type", detail.Type()))
+			return &ErrorInvalidAuthorizationDetails
+		}
+		if len(detailType.Schema) == 0 {
+			continue
+		}
+		resolved, err := compileAuthorizationDetailsSchema(detailType.Schema)
+		if err != nil {
+			rs.logger.Error(ctx, "Registered authorization details schema is invalid",
+				log.String("resourceServerId", resourceServerID), log.String("type", detailType.Type),
+				log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		instance, err := toJSONValue(detail)
+		if err != nil {
+			return &ErrorInvalidAuthorizationDetails
+		}
+		if err := resolved.Validate(instance); err != nil {
+			rs.logger.Debug(ctx, "Authorization details object does not conform to its schema",
+				log.String("type", detailType.Type), log.Int("index", i), log.Error(err))
+			return &ErrorInvalidAuthorizationDetails
+		}
+	}
+	return nil
+}
+
+// validateAuthorizationDetailsTypes validates the authorization details types declared on a resource
+// server: each type must be named, unique, and carry a schema that compiles.
+func validateAuthorizationDetailsTypes(types []providers.AuthorizationDetailsType) *tidcommon.ServiceError {
+	seen := make(map[string]bool, len(types))
+	for _, t := range types {
+		if t.Type == "" || seen[t.Type] {
+			return &ErrorInvalidAuthorizationDetailsType
+		}
+		seen[t.Type] = true
+		if len(t.Schema) == 0 {
+			continue
+		}
+		if _, err := compileAuthorizationDetailsSchema(t.Schema); err != nil {
+			return &ErrorInvalidAuthorizationDetailsType
+		}
+	}
+	return nil
+}
+
+// compileAuthorizationDetailsSchema compiles a JSON Schema held as a generic map. Remote references
+// are not resolved.
+func compileAuthorizationDetailsSchema(schema map[string]interface{}) (*jsonschema.Resolved, error) {
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode schema: %w", err)
+	}
+	var s jsonschema.Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to decode schema: %w", err)
+	}
+	return s.Resolve(nil)
+}
+
+// toJSONValue normalizes a value to the generic form produced by encoding/json, which the schema
+// validator expects.
+func toJSONValue(value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
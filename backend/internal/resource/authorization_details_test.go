@@ -0,0 +1,170 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+
+	"github.com/stretchr/testify/mock"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// paymentDetailsType returns an authorization details type that requires an amount and a currency.
+func paymentDetailsType() providers.AuthorizationDetailsType {
+	return providers.AuthorizationDetailsType{
+		Type:        "payment_initiation",
+		Description: "Initiate a payment",
+		Schema: map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"instructedAmount"},
+			"properties": map[string]interface{}{
+				"instructedAmount": map[string]interface{}{
+					"type":     "object",
+					"required": []interface{}{"currency", "amount"},
+					"properties": map[string]interface{}{
+						"currency": map[string]interface{}{"type": "string"},
+						"amount":   map[string]interface{}{"type": "string"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (suite *ResourceServiceTestSuite) TestValidateAuthorizationDetails() {
+	rsWithTypes := providers.ResourceServer{
+		ID: "rs-123",
+		AuthorizationDetailsTypes: []providers.AuthorizationDetailsType{
+			paymentDetailsType(),
+			{Type: "account_information"},
+		},
+	}
+	validPayment := providers.AuthorizationDetail{
+		"type": "payment_initiation",
+		"instructedAmount": map[string]interface{}{
+			"currency": "EUR",
+			"amount":   "123.50",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		details       []providers.AuthorizationDetail
+		setupMocks    func()
+		expectedError *tidcommon.ServiceError
+	}{
+		{
+			name:       "Success_Empty",
+			details:    nil,
+			setupMocks: func() {},
+		},
+		{
+			name:    "Success_ConformsToSchema",
+			details: []providers.AuthorizationDetail{validPayment},
+			setupMocks: func() {
+				suite.mockStore.On("GetResourceServer", mock.Anything, "rs-123").Return(rsWithTypes, nil).Once()
+			},
+		},
+		{
+			name: "Success_TypeWithoutSchema",
+			details: []providers.AuthorizationDetail{
+				{"type": "account_information", "actions": []interface{}{"list_accounts"}},
+			},
+			setupMocks: func() {
+				suite.mockStore.On("GetResourceServer", mock.Anything, "rs-123").Return(rsWithTypes, nil).Once()
+			},
+		},
+		{
+			name:    "Error_TypeNotRegistered",
+			details: []providers.AuthorizationDetail{validPayment, {"type": "unknown"}},
+			setupMocks: func() {
+				suite.mockStore.On("GetResourceServer", mock.Anything, "rs-123").Return(rsWithTypes, nil).Once()
+			},
+			expectedError: &ErrorInvalidAuthorizationDetails,
+		},
+		{
+			name: "Error_DoesNotConformToSchema",
+			details: []providers.AuthorizationDetail{
+				{"type": "payment_initiation", "instructedAmount": map[string]interface{}{"currency": "EUR"}},
+			},
+			setupMocks: func() {
+				suite.mockStore.On("GetResourceServer", mock.Anything, "rs-123").Return(rsWithTypes, nil).Once()
+			},
+			expectedError: &ErrorInvalidAuthorizationDetails,
+		},
+		{
+			name:    "Error_ResourceServerNotFound",
+			details: []providers.AuthorizationDetail{validPayment},
+			setupMocks: func() {
+				suite.mockStore.On("GetResourceServer", mock.Anything, "rs-123").
+					Return(providers.ResourceServer{}, errResourceServerNotFound).Once()
+			},
+			expectedError: &ErrorInvalidAuthorizationDetails,
+		},
+		{
+			name:    "Error_StoreFailure",
+			details: []providers.AuthorizationDetail{validPayment},
+			setupMocks: func() {
+				suite.mockStore.On("GetResourceServer", mock.Anything, "rs-123").
+					Return(providers.ResourceServer{}, errors.New("database error")).Once()
+			},
+			expectedError: &tidcommon.InternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.setupMocks()
+
+			svcErr := suite.service.ValidateAuthorizationDetails(context.Background(), "rs-123", tc.details)
+
+			if tc.expectedError != nil {
+				suite.Require().NotNil(svcErr)
+				suite.Equal(tc.expectedError.Code, svcErr.Code)
+			} else {
+				suite.Nil(svcErr)
+			}
+		})
+	}
+}
+
+func (suite *ResourceServiceTestSuite) TestValidateAuthorizationDetailsTypes() {
+	suite.Nil(validateAuthorizationDetailsTypes(nil))
+	suite.Nil(validateAuthorizationDetailsTypes([]providers.AuthorizationDetailsType{
+		paymentDetailsType(), {Type: "account_information"},
+	}))
+
+	err := validateAuthorizationDetailsTypes([]providers.AuthorizationDetailsType{{Type: ""}})
+	suite.Equal(ErrorInvalidAuthorizationDetailsType.Code, err.Code)
+
+	err = validateAuthorizationDetailsTypes([]providers.AuthorizationDetailsType{
+		{Type: "account_information"}, {Type: "account_information"},
+	})
+	suite.Equal(ErrorInvalidAuthorizationDetailsType.Code, err.Code)
+
+	err = validateAuthorizationDetailsTypes([]providers.AuthorizationDetailsType{
+		{Type: "bad_schema", Schema: map[string]interface{}{"type": 42}},
+	})
+	suite.Equal(ErrorInvalidAuthorizationDetailsType.Code, err.Code)
+}
+
+func (suite *ResourceServiceTestSuite) TestCreateResourceServer_InvalidAuthorizationDetailsTypes() {
+	rs := providers.ResourceServer{
+		Name:       "Payments",
+		Identifier: "https://payments.example.com",
+		OUID:       "ou-123",
+		AuthorizationDetailsTypes: []providers.AuthorizationDetailsType{
+			{Type: "payment_initiation"}, {Type: "payment_initiation"},
+		},
+	}
+
+	result, err := suite.service.CreateResourceServer(context.Background(), rs)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorInvalidAuthorizationDetailsType.Code, err.Code)
+}
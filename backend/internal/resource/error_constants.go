@@ -286,6 +286,36 @@ var (
 			DefaultValue: "A resource server with the specified ID already exists",
 		},
 	}
+	// ErrorInvalidAuthorizationDetailsType is returned when an authorization details type declared on a
+	// resource server is unnamed, duplicated, or carries an invalid JSON Schema.
+	ErrorInvalidAuthorizationDetailsType = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "RES-1024",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.resourceservice.invalid_authorization_details_type",
+			DefaultValue: "Invalid authorization details type",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.resourceservice.invalid_authorization_details_type_description",
+			DefaultValue: "Each authorization details type must have a unique name and a valid " +
+				"JSON Schema",
+		},
+	}
+	// ErrorInvalidAuthorizationDetails is returned when an authorization details object is not accepted
+	// by the resource server.
+	ErrorInvalidAuthorizationDetails = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "RES-1025",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.resourceservice.invalid_authorization_details",
+			DefaultValue: "Invalid authorization details",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.resourceservice.invalid_authorization_details_description",
+			DefaultValue: "The authorization details type is not supported by the resource server or the " +
+				"object does not conform to its schema",
+		},
+	}
 )
 
 // Internal error constants.
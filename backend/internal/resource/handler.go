@@ -73,6 +73,8 @@ func (h *resourceHandler) HandleResourceServerPostRequest(w http.ResponseWriter,
 		Type:        sanitized.Type,
 		OUID:        sanitized.OUID,
 		Delimiter:   sanitized.Delimiter,
+
+		AuthorizationDetailsTypes: sanitized.AuthorizationDetailsTypes,
 	}
 
 	result, svcErr := h.resourceService.CreateResourceServer(ctx, serviceReq)
@@ -120,6 +122,8 @@ func (h *resourceHandler) HandleResourceServerPutRequest(w http.ResponseWriter,
 		Description: sanitized.Description,
 		Identifier:  sanitized.Identifier,
 		OUID:        sanitized.OUID,
+
+		AuthorizationDetailsTypes: sanitized.AuthorizationDetailsTypes,
 	}
 
 	result, svcErr := h.resourceService.UpdateResourceServer(ctx, id, serviceReq)
@@ -601,6 +605,8 @@ func sanitizeCreateResourceServerRequest(req *CreateResourceServerRequest) Creat
 		Type:        req.Type,
 		OUID:        sysutils.SanitizeString(req.OUID),
 		Delimiter:   sysutils.SanitizeString(req.Delimiter),
+
+		AuthorizationDetailsTypes: sanitizeAuthorizationDetailsTypes(req.AuthorizationDetailsTypes),
 	}
 }
 
@@ -611,9 +617,30 @@ func sanitizeUpdateResourceServerRequest(req *UpdateResourceServerRequest) Updat
 		Description: sysutils.SanitizeString(req.Description),
 		Identifier:  sysutils.SanitizeString(req.Identifier),
 		OUID:        sysutils.SanitizeString(req.OUID),
+
+		AuthorizationDetailsTypes: sanitizeAuthorizationDetailsTypes(req.AuthorizationDetailsTypes),
 	}
 }
 
+// sanitizeAuthorizationDetailsTypes sanitizes the type names and descriptions of authorization details
+// types. Schemas are kept as given since they are validated when compiled.
+func sanitizeAuthorizationDetailsTypes(
+	types []providers.AuthorizationDetailsType,
+) []providers.AuthorizationDetailsType {
+	if types == nil {
+		return nil
+	}
+	sanitized := make([]providers.AuthorizationDetailsType, len(types))
+	for i, t := range types {
+		sanitized[i] = providers.AuthorizationDetailsType{
+			Type:        sysutils.SanitizeString(t.Type),
+			Description: sysutils.SanitizeString(t.Description),
+			Schema:      t.Schema,
+		}
+	}
+	return sanitized
+}
+
 // sanitizeCreateResourceRequest sanitizes input for creating a resource.
 func sanitizeCreateResourceRequest(req *CreateResourceRequest) CreateResourceRequest {
 	sanitized := CreateResourceRequest{
@@ -674,6 +701,8 @@ func toResourceServerResponse(rs *providers.ResourceServer) *ResourceServerRespo
 		OUID:        rs.OUID,
 		Delimiter:   rs.Delimiter,
 		IsReadOnly:  rs.IsReadOnly,
+
+		AuthorizationDetailsTypes: rs.AuthorizationDetailsTypes,
 	}
 }
 
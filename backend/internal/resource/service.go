@@ -93,6 +93,9 @@ type ResourceServiceInterface interface {
 	ValidatePermissions(
 		ctx context.Context, resourceServerID string, permissions []string,
 	) ([]string, *tidcommon.ServiceError)
+	ValidateAuthorizationDetails(
+		ctx context.Context, resourceServerID string, details []providers.AuthorizationDetail,
+	) *tidcommon.ServiceError
 
 	// ResolveResourceServerOUHandle resolves ou_handle to an OU ID on the given resource server
 	// in-place. Called by the declarative loader validator so that file-based resource servers
@@ -292,13 +295,14 @@ func (rs *resourceService) CreateResourceServer(
 		}
 
 		createdRS = &providers.ResourceServer{
-			ID:          id,
-			Name:        resourceServer.Name,
-			Description: resourceServer.Description,
-			Identifier:  resourceServer.Identifier,
-			Type:        resourceServer.Type,
-			OUID:        resourceServer.OUID,
-			Delimiter:   resourceServer.Delimiter,
+			ID:                        id,
+			Name:                      resourceServer.Name,
+			Description:               resourceServer.Description,
+			Identifier:                resourceServer.Identifier,
+			Type:                      resourceServer.Type,
+			OUID:                      resourceServer.OUID,
+			Delimiter:                 resourceServer.Delimiter,
+			AuthorizationDetailsTypes: resourceServer.AuthorizationDetailsTypes,
 		}
 		return nil
 	}); err != nil {
@@ -469,13 +473,14 @@ func (rs *resourceService) UpdateResourceServer(
 		}
 
 		updatedRS = &providers.ResourceServer{
-			ID:          id,
-			Name:        resourceServer.Name,
-			Description: resourceServer.Description,
-			Identifier:  resourceServer.Identifier,
-			Type:        resourceServer.Type,
-			OUID:        resourceServer.OUID,
-			Delimiter:   resourceServer.Delimiter,
+			ID:                        id,
+			Name:                      resourceServer.Name,
+			Description:               resourceServer.Description,
+			Identifier:                resourceServer.Identifier,
+			Type:                      resourceServer.Type,
+			OUID:                      resourceServer.OUID,
+			Delimiter:                 resourceServer.Delimiter,
+			AuthorizationDetailsTypes: resourceServer.AuthorizationDetailsTypes,
 		}
 		return nil
 	}); err != nil {
@@ -1349,7 +1354,7 @@ func (rs *resourceService) validateResourceServerCreate(
 			return err
 		}
 	}
-	return nil
+	return validateAuthorizationDetailsTypes(resourceServer.AuthorizationDetailsTypes)
 }
 
 // validateResourceServerUpdate validates the input for updating a resource server.
@@ -1362,7 +1367,7 @@ func (rs *resourceService) validateResourceServerUpdate(
 	if resourceServer.OUID == "" {
 		return &ErrorInvalidRequestFormat
 	}
-	return nil
+	return validateAuthorizationDetailsTypes(resourceServer.AuthorizationDetailsTypes)
 }
 
 // validateResourceCreate validates the input for creating a resource.
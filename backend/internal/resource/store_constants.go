@@ -294,6 +294,13 @@ var (
 		          AND json_extract(a.PROPERTIES, '$.kind') = $5
 		          AND a.DEPLOYMENT_ID = $6
 		        ORDER BY a.CREATED_AT DESC LIMIT $3 OFFSET $4`,
+		MySQLQuery: `SELECT a.ID, a.NAME, a.HANDLE, a.DESCRIPTION, a.PERMISSION, a.PROPERTIES
+		        FROM "ACTION" a
+		        WHERE a.RESOURCE_SERVER_ID = $1
+		          AND (a.RESOURCE_ID = $2 OR (a.RESOURCE_ID IS NULL AND $2 IS NULL))
+		          AND JSON_UNQUOTE(JSON_EXTRACT(a.PROPERTIES, '$.kind')) = $5
+		          AND a.DEPLOYMENT_ID = $6
+		        ORDER BY a.CREATED_AT DESC LIMIT $3 OFFSET $4`,
 	}
 
 	// queryGetActionListCount retrieves count of actions.
@@ -321,6 +328,12 @@ var (
 		          AND (a.RESOURCE_ID = $2 OR (a.RESOURCE_ID IS NULL AND $2 IS NULL))
 		          AND json_extract(a.PROPERTIES, '$.kind') = $3
 		          AND a.DEPLOYMENT_ID = $4`,
+		MySQLQuery: `SELECT COUNT(*) as total
+		        FROM "ACTION" a
+		        WHERE a.RESOURCE_SERVER_ID = $1
+		          AND (a.RESOURCE_ID = $2 OR (a.RESOURCE_ID IS NULL AND $2 IS NULL))
+		          AND JSON_UNQUOTE(JSON_EXTRACT(a.PROPERTIES, '$.kind')) = $3
+		          AND a.DEPLOYMENT_ID = $4`,
 	}
 
 	// queryUpdateAction updates an action.
@@ -416,5 +429,22 @@ var (
 		              AND a.DEPLOYMENT_ID = $2
 		              AND a.PERMISSION = p.value
 		        )`,
+		// MySQL version using JSON_TABLE()
+		MySQLQuery: `SELECT p.value AS permission
+		        FROM JSON_TABLE($3, '$[*]' COLUMNS (value VARCHAR(1024) PATH '$')) AS p
+		        WHERE NOT EXISTS (
+		            SELECT 1
+		            FROM "RESOURCE" r
+		            WHERE r.RESOURCE_SERVER_ID = $1
+		              AND r.DEPLOYMENT_ID = $2
+		              AND r.PERMISSION = p.value
+		        )
+		        AND NOT EXISTS (
+		            SELECT 1
+		            FROM "ACTION" a
+		            WHERE a.RESOURCE_SERVER_ID = $1
+		              AND a.DEPLOYMENT_ID = $2
+		              AND a.PERMISSION = p.value
+		        )`,
 	}
 )
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
@@ -561,3 +562,43 @@ func (_c *RoleAssignmentServiceInterfaceMock_RemoveAssignments_Call) RunAndRetur
 	_c.Call.Return(run)
 	return _c
 }
+
+// SetAuditRecorder provides a mock function for the type RoleAssignmentServiceInterfaceMock
+func (_mock *RoleAssignmentServiceInterfaceMock) SetAuditRecorder(recorder *audit.Recorder) {
+	_mock.Called(recorder)
+	return
+}
+
+// RoleAssignmentServiceInterfaceMock_SetAuditRecorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAuditRecorder'
+type RoleAssignmentServiceInterfaceMock_SetAuditRecorder_Call struct {
+	*mock.Call
+}
+
+// SetAuditRecorder is a helper method to define mock.On call
+//   - recorder *audit.Recorder
+func (_e *RoleAssignmentServiceInterfaceMock_Expecter) SetAuditRecorder(recorder interface{}) *RoleAssignmentServiceInterfaceMock_SetAuditRecorder_Call {
+	return &RoleAssignmentServiceInterfaceMock_SetAuditRecorder_Call{Call: _e.mock.On("SetAuditRecorder", recorder)}
+}
+
+func (_c *RoleAssignmentServiceInterfaceMock_SetAuditRecorder_Call) Run(run func(recorder *audit.Recorder)) *RoleAssignmentServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *audit.Recorder
+		if args[0] != nil {
+			arg0 = args[0].(*audit.Recorder)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *RoleAssignmentServiceInterfaceMock_SetAuditRecorder_Call) Return() *RoleAssignmentServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RoleAssignmentServiceInterfaceMock_SetAuditRecorder_Call) RunAndReturn(run func(recorder *audit.Recorder)) *RoleAssignmentServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Run(run)
+	return _c
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/utils"
@@ -35,6 +36,7 @@ type RoleAssignmentServiceInterface interface {
 	GetResourceDependencies(
 		ctx context.Context, resourceType, id string) ([]resourcedependency.ResourceDependency, error)
 	CascadeDeleteDependencies(ctx context.Context, resourceType, id string) (int, error)
+	SetAuditRecorder(recorder *audit.Recorder)
 }
 
 // roleAssignmentService is the default implementation of RoleAssignmentServiceInterface.
@@ -44,6 +46,7 @@ type roleAssignmentService struct {
 	groupService      group.GroupServiceInterface
 	entityTypeService entitytype.EntityTypeServiceInterface
 	transactioner     providers.Transactioner
+	auditRecorder     *audit.Recorder
 }
 
 // newRoleAssignmentService creates a new instance of roleAssignmentService.
@@ -236,23 +239,42 @@ func (as *roleAssignmentService) getAssignmentsByEntityCategory(
 // Assignments can be added to both mutable (DB-backed) and declarative (file-backed) roles.
 func (as *roleAssignmentService) AddAssignments(
 	ctx context.Context, id string, assignments []RoleAssignment) *tidcommon.ServiceError {
+	normalized, svcErr := as.addAssignments(ctx, id, assignments)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	as.recordAddedAssignments(ctx, id, normalized)
+	return nil
+}
+
+// addAssignments validates and adds assignments to a role, returning the normalized assignments added.
+func (as *roleAssignmentService) addAssignments(
+	ctx context.Context, id string, assignments []RoleAssignment) ([]RoleAssignment, *tidcommon.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, assignmentLoggerComponentName))
 	logger.Debug(ctx, "Adding assignments to role", log.String("id", id))
 
 	normalized, svcErr := as.prepareAssignments(ctx, id, assignments)
 	if svcErr != nil {
-		return svcErr
+		return nil, svcErr
 	}
 
 	if err := as.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		return as.roleStore.AddAssignments(txCtx, id, normalized)
 	}); err != nil {
 		logger.Error(ctx, "Failed to add assignments to role", log.String("id", id), log.Error(err))
-		return &tidcommon.InternalServerError
+		return nil, &tidcommon.InternalServerError
 	}
 
 	logger.Debug(ctx, "Successfully added assignments to role", log.String("id", id))
-	return nil
+	return normalized, nil
+}
+
+// recordAddedAssignments audits the assignments added to a role.
+func (as *roleAssignmentService) recordAddedAssignments(
+	ctx context.Context, id string, assignments []RoleAssignment) {
+	as.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeRole, id, nil,
+		map[string]interface{}{"addedAssignments": assignments})
 }
 
 // RemoveAssignments removes assignments from a role.
@@ -273,6 +295,8 @@ func (as *roleAssignmentService) RemoveAssignments(
 		logger.Error(ctx, "Failed to remove assignments from role", log.String("id", id), log.Error(err))
 		return &tidcommon.InternalServerError
 	}
+	as.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeRole, id, nil,
+		map[string]interface{}{"removedAssignments": normalized})
 
 	logger.Debug(ctx, "Successfully removed assignments from role", log.String("id", id))
 	return nil
@@ -290,12 +314,15 @@ func (as *roleAssignmentService) AddAssigneesToRoles(
 	}
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, assignmentLoggerComponentName))
 	var capturedSvcErr *tidcommon.ServiceError
+	added := make(map[string][]RoleAssignment, len(roleIDs))
 	err := as.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		for _, rid := range roleIDs {
-			if svcErr := as.AddAssignments(txCtx, rid, assignments); svcErr != nil {
+			normalized, svcErr := as.addAssignments(txCtx, rid, assignments)
+			if svcErr != nil {
 				capturedSvcErr = svcErr
 				return fmt.Errorf("failed to assign role %s: %s", rid, svcErr.Error.DefaultValue)
 			}
+			added[rid] = normalized
 		}
 		return nil
 	})
@@ -306,6 +333,11 @@ func (as *roleAssignmentService) AddAssigneesToRoles(
 		logger.Error(ctx, "Failed to add assignees to roles", log.Error(err))
 		return &tidcommon.InternalServerError
 	}
+
+	// Recorded only once the transaction commits, so a rolled back assignment leaves no trace.
+	for _, rid := range roleIDs {
+		as.recordAddedAssignments(ctx, rid, added[rid])
+	}
 	return nil
 }
 
@@ -614,3 +646,9 @@ func (as *roleAssignmentService) CascadeDeleteDependencies(
 	}
 	return int(deleted), nil
 }
+
+// SetAuditRecorder injects the recorder that role assignment changes are audited through. Called by
+// servicemanager once the audit service is initialized.
+func (as *roleAssignmentService) SetAuditRecorder(recorder *audit.Recorder) {
+	as.auditRecorder = recorder
+}
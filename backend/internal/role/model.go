@@ -140,19 +140,19 @@ type RoleCreationDetail struct {
 
 // RoleWithPermissionsAndAssignments represents the parameters for creating a role.
 type RoleWithPermissionsAndAssignments struct {
-	ID          string
-	Name        string
-	Description string
-	OUID        string
-	OUHandle    string
-	Permissions []ResourcePermissions
-	Assignments []RoleAssignment
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	OUID        string                `json:"ouId"`
+	OUHandle    string                `json:"ouHandle,omitempty"`
+	Permissions []ResourcePermissions `json:"permissions"`
+	Assignments []RoleAssignment      `json:"assignments,omitempty"`
 }
 
 // RoleAssignment represents an assignment used internally by the service layer.
 type RoleAssignment struct {
-	ID   string       `yaml:"id"`
-	Type AssigneeType `yaml:"type"`
+	ID   string       `json:"id"   yaml:"id"`
+	Type AssigneeType `json:"type" yaml:"type"`
 }
 
 // RoleAssignmentWithDisplay represents an assignment used internally by the service layer.
@@ -174,12 +174,12 @@ type Role struct {
 
 // RoleWithPermissions represents complete role details used internally by the service layer.
 type RoleWithPermissions struct {
-	ID          string
-	Name        string
-	Description string
-	OUID        string
-	OUHandle    string
-	Permissions []ResourcePermissions
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	OUID        string                `json:"ouId"`
+	OUHandle    string                `json:"ouHandle,omitempty"`
+	Permissions []ResourcePermissions `json:"permissions"`
 }
 
 // RoleUpdateDetail represents the parameters for creating a role.
@@ -15,6 +15,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/group"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
 	resourcepkg "github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -40,6 +41,7 @@ type RoleServiceInterface interface {
 	ResolveRoleOUHandle(
 		ctx context.Context, role *RoleWithPermissionsAndAssignments,
 	) *tidcommon.ServiceError
+	SetAuditRecorder(recorder *audit.Recorder)
 }
 
 // roleService is the default implementation of the RoleServiceInterface.
@@ -50,6 +52,7 @@ type roleService struct {
 	ouService       oupkg.OrganizationUnitServiceInterface
 	resourceService resourcepkg.ResourceServiceInterface
 	transactioner   providers.Transactioner
+	auditRecorder   *audit.Recorder
 }
 
 // newRoleService creates a new instance of RoleService with injected dependencies.
@@ -227,6 +230,8 @@ func (rs *roleService) CreateRole(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.RecordCreate(ctx, audit.ResourceTypeRole, id, serviceRole)
+
 	logger.Debug(ctx, "Successfully created role", log.String("id", id), log.String("name", role.Name))
 	return serviceRole, nil
 }
@@ -283,15 +288,15 @@ func (rs *roleService) UpdateRoleWithPermissions(
 		return nil, err
 	}
 
-	exists, err := rs.roleStore.IsRoleExist(ctx, id)
+	existingRole, err := rs.roleStore.GetRole(ctx, id)
 	if err != nil {
+		if errors.Is(err, ErrRoleNotFound) {
+			logger.Debug(ctx, "Role not found", log.String("id", id))
+			return nil, &ErrorRoleNotFound
+		}
 		logger.Error(ctx, "Failed to check role existence", log.String("id", id), log.Error(err))
 		return nil, &tidcommon.InternalServerError
 	}
-	if !exists {
-		logger.Debug(ctx, "Role not found", log.String("id", id))
-		return nil, &ErrorRoleNotFound
-	}
 
 	// Check if role is declarative - cannot modify declarative roles
 	if rs.isRoleDeclarative(ctx, id) {
@@ -332,15 +337,20 @@ func (rs *roleService) UpdateRoleWithPermissions(
 		return nil, &tidcommon.InternalServerError
 	}
 
-	logger.Debug(ctx, "Successfully updated role", log.String("id", id), log.String("name", role.Name))
-	return &RoleWithPermissions{
+	updatedRole := &RoleWithPermissions{
 		ID:          id,
 		Name:        role.Name,
 		Description: role.Description,
 		OUID:        role.OUID,
 		OUHandle:    ou.Handle,
 		Permissions: role.Permissions,
-	}, nil
+	}
+	// The stored role carries no OU handle, so the handle is left out of the recorded change.
+	existingRole.OUHandle = updatedRole.OUHandle
+	rs.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeRole, id, existingRole, updatedRole)
+
+	logger.Debug(ctx, "Successfully updated role", log.String("id", id), log.String("name", role.Name))
+	return updatedRole, nil
 }
 
 // DeleteRole delete the specified role by its id.
@@ -352,15 +362,15 @@ func (rs *roleService) DeleteRole(ctx context.Context, id string) *tidcommon.Ser
 		return &ErrorMissingRoleID
 	}
 
-	exists, err := rs.roleStore.IsRoleExist(ctx, id)
+	existingRole, err := rs.roleStore.GetRole(ctx, id)
 	if err != nil {
+		if errors.Is(err, ErrRoleNotFound) {
+			logger.Debug(ctx, "Role not found", log.String("id", id))
+			return nil
+		}
 		logger.Error(ctx, "Failed to check role existence", log.String("id", id), log.Error(err))
 		return &tidcommon.InternalServerError
 	}
-	if !exists {
-		logger.Debug(ctx, "Role not found", log.String("id", id))
-		return nil
-	}
 
 	// Check if role is declarative - cannot delete declarative roles
 	if rs.isRoleDeclarative(ctx, id) {
@@ -382,6 +392,8 @@ func (rs *roleService) DeleteRole(ctx context.Context, id string) *tidcommon.Ser
 		return &tidcommon.InternalServerError
 	}
 
+	rs.auditRecorder.RecordDelete(ctx, audit.ResourceTypeRole, id, existingRole)
+
 	logger.Debug(ctx, "Successfully deleted role", log.String("id", id))
 	return nil
 }
@@ -470,6 +482,12 @@ func (rs *roleService) IsRoleDeclarative(ctx context.Context, id string) (bool,
 	return isDeclarative, nil
 }
 
+// SetAuditRecorder injects the recorder that role mutations are audited through. Called by
+// servicemanager once the audit service is initialized.
+func (rs *roleService) SetAuditRecorder(recorder *audit.Recorder) {
+	rs.auditRecorder = recorder
+}
+
 // ResolveRoleOUHandle resolves ou_handle to an OU ID on the given role in-place.
 // Called by the declarative loader validator so that file-based roles support ou_handle.
 // If both ou_id and ou_handle are provided, ou_id wins and a warning is logged.
@@ -73,6 +73,8 @@ var (
 		ID: "RLQ-ROLE_MGT-10",
 		Query: `INSERT INTO "ROLE_ASSIGNMENT" (ROLE_ID, ASSIGNEE_TYPE, ASSIGNEE_ID, DEPLOYMENT_ID)
 			VALUES ($1, $2, $3, $4) ON CONFLICT (ROLE_ID, DEPLOYMENT_ID, ASSIGNEE_TYPE, ASSIGNEE_ID) DO NOTHING`,
+		MySQLQuery: `INSERT INTO "ROLE_ASSIGNMENT" (ROLE_ID, ASSIGNEE_TYPE, ASSIGNEE_ID, DEPLOYMENT_ID)
+			VALUES ($1, $2, $3, $4) ON DUPLICATE KEY UPDATE ASSIGNEE_ID = ASSIGNEE_ID`,
 	}
 
 	// queryGetRoleAssignments retrieves all assignments for a role with pagination.
@@ -9,12 +9,13 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
-// Initialize creates and returns a new DBStore instance for the given deployment.
-func Initialize(deploymentID string) (providers.RuntimeStoreProvider, providers.Transactioner, error) {
+// Initialize creates and returns a new DBStore instance for the given deployment, using the
+// statements suited to the runtime transient database type.
+func Initialize(dbType, deploymentID string) (providers.RuntimeStoreProvider, providers.Transactioner, error) {
 	dbProvider := provider.GetDBProvider()
 	transactioner, error := dbProvider.GetRuntimeTransientDBTransactioner()
 	if error != nil {
 		return nil, nil, error
 	}
-	return newDBStore(dbProvider, deploymentID), transactioner, nil
+	return newDBStore(dbProvider, dbType, deploymentID), transactioner, nil
 }
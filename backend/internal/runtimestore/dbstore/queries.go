@@ -15,6 +15,10 @@ var queryPutRuntimeStore = dbmodel.DBQuery{
 		`VALUES ($1, $2, $3, $4, $5) ` +
 		`ON CONFLICT (DEPLOYMENT_ID, NAMESPACE, KEY) ` +
 		`DO UPDATE SET VALUE = EXCLUDED.VALUE, EXPIRY_TIME = EXCLUDED.EXPIRY_TIME, UPDATED_AT = CURRENT_TIMESTAMP`,
+	MySQLQuery: `INSERT INTO "RUNTIME_STORE" (DEPLOYMENT_ID, NAMESPACE, "KEY", VALUE, EXPIRY_TIME) ` +
+		`VALUES ($1, $2, $3, $4, $5) ` +
+		`ON DUPLICATE KEY UPDATE VALUE = VALUES(VALUE), EXPIRY_TIME = VALUES(EXPIRY_TIME), ` +
+		`UPDATED_AT = CURRENT_TIMESTAMP`,
 }
 
 // queryGetRuntimeStore fetches a non-expired value.
@@ -23,6 +27,9 @@ var queryGetRuntimeStore = dbmodel.DBQuery{
 	Query: `SELECT VALUE FROM "RUNTIME_STORE" ` +
 		`WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND KEY = $3 ` +
 		`AND (EXPIRY_TIME IS NULL OR EXPIRY_TIME > $4)`,
+	MySQLQuery: `SELECT VALUE FROM "RUNTIME_STORE" ` +
+		`WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND "KEY" = $3 ` +
+		`AND (EXPIRY_TIME IS NULL OR EXPIRY_TIME > $4)`,
 }
 
 // queryUpdateRuntimeStore replaces the value of an existing, non-expired entry, preserving its TTL.
@@ -31,16 +38,21 @@ var queryUpdateRuntimeStore = dbmodel.DBQuery{
 	Query: `UPDATE "RUNTIME_STORE" SET VALUE = $4, UPDATED_AT = CURRENT_TIMESTAMP ` +
 		`WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND KEY = $3 ` +
 		`AND (EXPIRY_TIME IS NULL OR EXPIRY_TIME > $5)`,
+	MySQLQuery: `UPDATE "RUNTIME_STORE" SET VALUE = $4, UPDATED_AT = CURRENT_TIMESTAMP ` +
+		`WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND "KEY" = $3 ` +
+		`AND (EXPIRY_TIME IS NULL OR EXPIRY_TIME > $5)`,
 }
 
-// queryDeleteRuntimeStore removes an entry. Used by Delete.
+// queryDeleteRuntimeStore removes an entry. Used by Delete, and by Take on MySQL.
 var queryDeleteRuntimeStore = dbmodel.DBQuery{
-	ID:    "RTS-04",
-	Query: `DELETE FROM "RUNTIME_STORE" WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND KEY = $3`,
+	ID:         "RTS-04",
+	Query:      `DELETE FROM "RUNTIME_STORE" WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND KEY = $3`,
+	MySQLQuery: `DELETE FROM "RUNTIME_STORE" WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND "KEY" = $3`,
 }
 
 // queryTakeRuntimeStore atomically deletes a non-expired entry and returns its value in a single
 // statement, so a concurrent writer cannot slip a new value in between the read and the delete.
+// MySQL has no RETURNING clause and uses queryLockRuntimeStore followed by a delete instead.
 var queryTakeRuntimeStore = dbmodel.DBQuery{
 	ID: "RTS-05",
 	Query: `DELETE FROM "RUNTIME_STORE" ` +
@@ -55,11 +67,16 @@ var queryExtendTTLRuntimeStore = dbmodel.DBQuery{
 	Query: `UPDATE "RUNTIME_STORE" SET EXPIRY_TIME = $4, UPDATED_AT = CURRENT_TIMESTAMP ` +
 		`WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND KEY = $3 ` +
 		`AND (EXPIRY_TIME IS NULL OR EXPIRY_TIME > $5)`,
+	MySQLQuery: `UPDATE "RUNTIME_STORE" SET EXPIRY_TIME = $4, UPDATED_AT = CURRENT_TIMESTAMP ` +
+		`WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND "KEY" = $3 ` +
+		`AND (EXPIRY_TIME IS NULL OR EXPIRY_TIME > $5)`,
 }
 
 // queryPutIfNotExistsRuntimeStore inserts an entry, or overwrites it in place if the existing entry
 // has already expired. The conflicting row is left untouched (and no row is returned) when it is
 // still live, so the caller can tell a fresh claim from a blocked one by whether a row came back.
+// MySQL has no RETURNING clause and uses queryDeleteExpiredRuntimeStore followed by
+// queryInsertIfAbsentRuntimeStore instead.
 var queryPutIfNotExistsRuntimeStore = dbmodel.DBQuery{
 	ID: "RTS-07",
 	Query: `INSERT INTO "RUNTIME_STORE" (DEPLOYMENT_ID, NAMESPACE, KEY, VALUE, EXPIRY_TIME) ` +
@@ -83,4 +100,34 @@ var queryCompareFieldAndSwapRuntimeStore = dbmodel.DBQuery{
 		`WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND KEY = $3 ` +
 		`AND (EXPIRY_TIME IS NULL OR EXPIRY_TIME > $5) ` +
 		`AND json_extract(VALUE, '$.' || $6) = $7`,
+	MySQLQuery: `UPDATE "RUNTIME_STORE" SET VALUE = $4, UPDATED_AT = CURRENT_TIMESTAMP ` +
+		`WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND "KEY" = $3 ` +
+		`AND (EXPIRY_TIME IS NULL OR EXPIRY_TIME > $5) ` +
+		`AND JSON_UNQUOTE(JSON_EXTRACT(VALUE, CONCAT('$.', $6))) = $7`,
+}
+
+// queryLockRuntimeStore reads a non-expired entry and locks it until the surrounding transaction ends,
+// so that Take on MySQL can delete the entry it read without a concurrent caller consuming it too.
+var queryLockRuntimeStore = dbmodel.DBQuery{
+	ID: "RTS-09",
+	MySQLQuery: `SELECT VALUE FROM "RUNTIME_STORE" ` +
+		`WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND "KEY" = $3 ` +
+		`AND (EXPIRY_TIME IS NULL OR EXPIRY_TIME > $4) FOR UPDATE`,
+}
+
+// queryDeleteExpiredRuntimeStore removes an entry only if it has expired, clearing the way for
+// queryInsertIfAbsentRuntimeStore to claim the key on MySQL.
+var queryDeleteExpiredRuntimeStore = dbmodel.DBQuery{
+	ID: "RTS-10",
+	MySQLQuery: `DELETE FROM "RUNTIME_STORE" ` +
+		`WHERE DEPLOYMENT_ID = $1 AND NAMESPACE = $2 AND "KEY" = $3 ` +
+		`AND EXPIRY_TIME IS NOT NULL AND EXPIRY_TIME <= $4`,
+}
+
+// queryInsertIfAbsentRuntimeStore inserts an entry unless the key already exists. The primary key makes
+// the insert the point of serialization, so of two concurrent callers exactly one sees a row affected.
+var queryInsertIfAbsentRuntimeStore = dbmodel.DBQuery{
+	ID: "RTS-11",
+	MySQLQuery: `INSERT IGNORE INTO "RUNTIME_STORE" (DEPLOYMENT_ID, NAMESPACE, "KEY", VALUE, EXPIRY_TIME) ` +
+		`VALUES ($1, $2, $3, $4, $5)`,
 }
@@ -17,13 +17,15 @@ import (
 // dbStore implements the RuntimeStoreProvider interface using the database as the backend.
 type dbStore struct {
 	dbProvider   provider.DBProviderInterface
+	dbType       string
 	deploymentID string
 	logger       *log.Logger
 }
 
-func newDBStore(dbProvider provider.DBProviderInterface, deploymentID string) providers.RuntimeStoreProvider {
+func newDBStore(dbProvider provider.DBProviderInterface, dbType, deploymentID string) providers.RuntimeStoreProvider {
 	return &dbStore{
 		dbProvider:   dbProvider,
+		dbType:       dbType,
 		deploymentID: deploymentID,
 		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DBStore")),
 	}
@@ -68,20 +70,48 @@ func (d *dbStore) PutIfNotExists(ctx context.Context, namespace providers.Runtim
 		expiryTime = now.Add(time.Duration(ttlSeconds) * time.Second)
 	}
 
-	results, err := dbClient.QueryContext(
-		ctx, queryPutIfNotExistsRuntimeStore, d.deploymentID, string(namespace), key, value, expiryTime, now,
-	)
-	if err != nil {
-		return false, fmt.Errorf("failed to store in database: %w", err)
-	}
-	if len(results) == 0 {
-		return false, nil
+	if d.dbType == provider.DataSourceTypeMySQL {
+		stored, err := d.putIfNotExistsWithoutReturning(ctx, dbClient, namespace, key, value, expiryTime, now)
+		if err != nil || !stored {
+			return false, err
+		}
+	} else {
+		results, err := dbClient.QueryContext(
+			ctx, queryPutIfNotExistsRuntimeStore, d.deploymentID, string(namespace), key, value, expiryTime, now,
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to store in database: %w", err)
+		}
+		if len(results) == 0 {
+			return false, nil
+		}
 	}
 
 	d.logger.Debug(ctx, "Stored in database", log.String("key", key))
 	return true, nil
 }
 
+// putIfNotExistsWithoutReturning claims the key on databases without a RETURNING clause. An expired
+// entry is removed first, after which an insert that skips existing keys either claims the key or
+// reports that a live entry holds it.
+func (d *dbStore) putIfNotExistsWithoutReturning(ctx context.Context, dbClient provider.DBClientInterface,
+	namespace providers.RuntimeStoreNamespace, key string, value []byte, expiryTime interface{},
+	now time.Time) (bool, error) {
+	if _, err := dbClient.ExecuteContext(
+		ctx, queryDeleteExpiredRuntimeStore, d.deploymentID, string(namespace), key, now,
+	); err != nil {
+		return false, fmt.Errorf("failed to remove expired entry from database: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(
+		ctx, queryInsertIfAbsentRuntimeStore, d.deploymentID, string(namespace), key, value, expiryTime,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to store in database: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
 // Get retrieves a value from the database runtime store by its key.
 // Returns (nil, nil) when the key is missing or expired.
 func (d *dbStore) Get(ctx context.Context, namespace providers.RuntimeStoreNamespace,
@@ -142,8 +172,7 @@ func (d *dbStore) Delete(ctx context.Context, namespace providers.RuntimeStoreNa
 }
 
 // Take retrieves and removes a value from the database runtime store by its key.
-// The fetch and delete run as a single atomic statement, so a concurrent caller cannot
-// consume the same value twice. Returns (nil, nil) when the key is missing or expired.
+// The fetch and delete run atomically, so a concurrent caller cannot consume the same value twice. Returns (nil, nil) when the key is missing or expired.
 func (d *dbStore) Take(ctx context.Context, namespace providers.RuntimeStoreNamespace,
 	key string) ([]byte, error) {
 	dbClient, err := d.dbProvider.GetRuntimeTransientDBClient()
@@ -151,9 +180,14 @@ func (d *dbStore) Take(ctx context.Context, namespace providers.RuntimeStoreName
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(
-		ctx, queryTakeRuntimeStore, d.deploymentID, string(namespace), key, time.Now().UTC(),
-	)
+	var results []map[string]interface{}
+	if d.dbType == provider.DataSourceTypeMySQL {
+		results, err = d.takeWithLock(ctx, dbClient, namespace, key)
+	} else {
+		results, err = dbClient.QueryContext(
+			ctx, queryTakeRuntimeStore, d.deploymentID, string(namespace), key, time.Now().UTC(),
+		)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to take data from database: %w", err)
 	}
@@ -165,6 +199,35 @@ func (d *dbStore) Take(ctx context.Context, namespace providers.RuntimeStoreName
 	return parseStoreValue(results[0])
 }
 
+// takeWithLock takes an entry on databases without a RETURNING clause. The entry is read and locked,
+// then deleted, within one transaction, so a concurrent caller blocks until the entry is gone.
+func (d *dbStore) takeWithLock(ctx context.Context, dbClient provider.DBClientInterface,
+	namespace providers.RuntimeStoreNamespace, key string) ([]map[string]interface{}, error) {
+	transactioner, err := d.dbProvider.GetRuntimeTransientDBTransactioner()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []map[string]interface{}
+	err = transactioner.Transact(ctx, func(txCtx context.Context) error {
+		var queryErr error
+		results, queryErr = dbClient.QueryContext(
+			txCtx, queryLockRuntimeStore, d.deploymentID, string(namespace), key, time.Now().UTC(),
+		)
+		if queryErr != nil || len(results) == 0 {
+			return queryErr
+		}
+		_, queryErr = dbClient.ExecuteContext(
+			txCtx, queryDeleteRuntimeStore, d.deploymentID, string(namespace), key,
+		)
+		return queryErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // ExtendTTL extends the TTL of an existing, non-expired entry in the database runtime store.
 func (d *dbStore) ExtendTTL(ctx context.Context, namespace providers.RuntimeStoreNamespace,
 	key string, ttlSeconds int64) error {
@@ -6,15 +6,23 @@ package dbstore
 import (
 	"context"
 	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"regexp"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+	"github.com/thunder-id/thunderid/tests/mocks/transactionmock"
 )
 
 const (
@@ -494,3 +502,146 @@ func (s *DBStoreTestSuite) TestCompareFieldAndSwap_ExecuteError() {
 	s.False(swapped)
 	s.Contains(err.Error(), "failed to compare-and-swap in database")
 }
+
+// MySQL
+
+func (s *DBStoreTestSuite) TestPutIfNotExists_MySQL_Claimed() {
+	s.store.dbType = provider.DataSourceTypeMySQL
+	s.mockDBProvider.On("GetRuntimeTransientDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteExpiredRuntimeStore,
+		testDeploymentID, string(testNamespace), testKey, mock.Anything,
+	).Return(int64(1), nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertIfAbsentRuntimeStore,
+		testDeploymentID, string(testNamespace), testKey, testValue, mock.Anything,
+	).Return(int64(1), nil)
+
+	ok, err := s.store.PutIfNotExists(s.ctx, testNamespace, testKey, testValue, 60)
+
+	s.NoError(err)
+	s.True(ok)
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
+func (s *DBStoreTestSuite) TestPutIfNotExists_MySQL_Blocked() {
+	s.store.dbType = provider.DataSourceTypeMySQL
+	s.mockDBProvider.On("GetRuntimeTransientDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteExpiredRuntimeStore,
+		testDeploymentID, string(testNamespace), testKey, mock.Anything,
+	).Return(int64(0), nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertIfAbsentRuntimeStore,
+		testDeploymentID, string(testNamespace), testKey, testValue, mock.Anything,
+	).Return(int64(0), nil)
+
+	ok, err := s.store.PutIfNotExists(s.ctx, testNamespace, testKey, testValue, 60)
+
+	s.NoError(err)
+	s.False(ok)
+}
+
+func (s *DBStoreTestSuite) TestPutIfNotExists_MySQL_DeleteExpiredError() {
+	s.store.dbType = provider.DataSourceTypeMySQL
+	s.mockDBProvider.On("GetRuntimeTransientDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteExpiredRuntimeStore,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("delete failed"))
+
+	ok, err := s.store.PutIfNotExists(s.ctx, testNamespace, testKey, testValue, 60)
+
+	s.Error(err)
+	s.False(ok)
+}
+
+func (s *DBStoreTestSuite) TestTake_MySQL_LocksAndDeletes() {
+	s.store.dbType = provider.DataSourceTypeMySQL
+	mockTransactioner := transactionmock.NewTransactionerMock(s.T())
+	mockTransactioner.EXPECT().Transact(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+	s.mockDBProvider.On("GetRuntimeTransientDBClient").Return(s.mockDBClient, nil)
+	s.mockDBProvider.On("GetRuntimeTransientDBTransactioner").Return(mockTransactioner, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryLockRuntimeStore,
+		testDeploymentID, string(testNamespace), testKey, mock.Anything,
+	).Return([]map[string]interface{}{{columnNameValue: string(testValue)}}, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteRuntimeStore,
+		testDeploymentID, string(testNamespace), testKey,
+	).Return(int64(1), nil)
+
+	value, err := s.store.Take(s.ctx, testNamespace, testKey)
+
+	s.NoError(err)
+	s.Equal(testValue, value)
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
+func (s *DBStoreTestSuite) TestTake_MySQL_Miss() {
+	s.store.dbType = provider.DataSourceTypeMySQL
+	mockTransactioner := transactionmock.NewTransactionerMock(s.T())
+	mockTransactioner.EXPECT().Transact(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) })
+	s.mockDBProvider.On("GetRuntimeTransientDBClient").Return(s.mockDBClient, nil)
+	s.mockDBProvider.On("GetRuntimeTransientDBTransactioner").Return(mockTransactioner, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryLockRuntimeStore,
+		testDeploymentID, string(testNamespace), testKey, mock.Anything,
+	).Return([]map[string]interface{}{}, nil)
+
+	value, err := s.store.Take(s.ctx, testNamespace, testKey)
+
+	s.NoError(err)
+	s.Nil(value)
+	s.mockDBClient.AssertNotCalled(s.T(), "ExecuteContext", mock.Anything, queryDeleteRuntimeStore,
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Schema
+
+// TestPostgresSchema_PartitionPerNamespace fails when a providers.RuntimeStoreNamespace constant has no
+// partition in the Postgres runtime store schema, where writes to an unpartitioned namespace fail.
+func (s *DBStoreTestSuite) TestPostgresSchema_PartitionPerNamespace() {
+	script, err := os.ReadFile("../../../dbscripts/runtime_transient/postgres.sql")
+	s.Require().NoError(err)
+	partitioned := make(map[string]bool)
+	partitionPattern := regexp.MustCompile(`PARTITION OF "RUNTIME_STORE" FOR VALUES IN \('([^']+)'\)`)
+	for _, match := range partitionPattern.FindAllStringSubmatch(string(script), -1) {
+		partitioned[match[1]] = true
+	}
+
+	namespaces := runtimeStoreNamespaces(s)
+	s.Require().NotEmpty(namespaces)
+	for name, namespace := range namespaces {
+		s.Truef(partitioned[namespace], "providers.%s (%q) has no partition in runtime_transient/postgres.sql",
+			name, namespace)
+	}
+}
+
+// runtimeStoreNamespaces parses the providers package and returns its RuntimeStoreNamespace constants by
+// name. Go offers no reflection over constants, so the source is read instead.
+func runtimeStoreNamespaces(s *DBStoreTestSuite) map[string]string {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "../../../pkg/thunderidengine/providers/constants.go", nil, 0)
+	s.Require().NoError(err)
+
+	namespaces := make(map[string]string)
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			typeIdent, ok := valueSpec.Type.(*ast.Ident)
+			if !ok || typeIdent.Name != "RuntimeStoreNamespace" {
+				continue
+			}
+			for i, name := range valueSpec.Names {
+				literal, ok := valueSpec.Values[i].(*ast.BasicLit)
+				s.Require().True(ok, "namespace %s is not a string literal", name.Name)
+				value, err := strconv.Unquote(literal.Value)
+				s.Require().NoError(err)
+				namespaces[name.Name] = value
+			}
+		}
+	}
+	return namespaces
+}
@@ -18,5 +18,5 @@ func Initialize(runtimeTransientDBType, deploymentID string) (
 	if runtimeTransientDBType == dbprovider.DataSourceTypeRedis {
 		return redisstore.Initialize(deploymentID)
 	}
-	return dbstore.Initialize(deploymentID)
+	return dbstore.Initialize(runtimeTransientDBType, deploymentID)
 }
@@ -26,5 +26,8 @@ var (
 			`VALUES ($1, $2, $3) ` +
 			`ON CONFLICT (DEPLOYMENT_ID, NAME) ` +
 			`DO UPDATE SET VALUE = excluded.VALUE, UPDATED_AT = datetime('now')`,
+		MySQLQuery: `INSERT INTO "SERVER_CONFIG" (NAME, VALUE, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3) ` +
+			`ON DUPLICATE KEY UPDATE VALUE = VALUES(VALUE), UPDATED_AT = CURRENT_TIMESTAMP`,
 	}
 )
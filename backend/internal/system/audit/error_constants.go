@@ -0,0 +1,52 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for audit event search operations.
+var (
+	// ErrorInvalidLimit is the error returned when the limit parameter is invalid.
+	ErrorInvalidLimit = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUD-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.auditservice.invalid_limit_parameter",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.auditservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when the offset parameter is invalid.
+	ErrorInvalidOffset = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUD-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.auditservice.invalid_offset_parameter",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.auditservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+	// ErrorInvalidFilter is the error returned when the filter parameter is invalid.
+	ErrorInvalidFilter = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUD-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.auditservice.invalid_filter",
+			DefaultValue: "Invalid filter parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.auditservice.invalid_filter_description",
+			DefaultValue: "The filter parameter is invalid. Use format: attribute (eq|gt|lt) \"value\" on " +
+				"action, resourceType, resourceId, actorId, correlationId, sourceIp or timestamp",
+		},
+	}
+)
@@ -0,0 +1,103 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/filter"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const handlerLoggerComponentName = "AuditHandler"
+
+// auditHandler handles the audit event search API requests.
+type auditHandler struct {
+	service AuditServiceInterface
+}
+
+// newAuditHandler creates a new instance of auditHandler.
+func newAuditHandler(service AuditServiceInterface) *auditHandler {
+	return &auditHandler{service: service}
+}
+
+// HandleAuditEventListRequest searches the audit trail.
+func (h *auditHandler) HandleAuditEventListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	if limit == 0 {
+		limit = serverconst.DefaultPageSize
+	}
+
+	f, err := filter.ParseFilterParam(r.URL.Query())
+	if err != nil {
+		handleError(ctx, w, &ErrorInvalidFilter)
+		return
+	}
+
+	eventList, svcErr := h.service.SearchEvents(ctx, limit, offset, f)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, eventList)
+
+	logger.Debug(ctx, "Audit event search response sent", log.Int("limit", limit), log.Int("offset", offset),
+		log.Int("totalResults", eventList.TotalResults))
+}
+
+// parsePaginationParams parses the limit and offset query parameters.
+func parsePaginationParams(query url.Values) (int, int, *tidcommon.ServiceError) {
+	limit := 0
+	offset := 0
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidLimit
+		}
+		limit = parsedLimit
+	}
+
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidOffset
+		}
+		offset = parsedOffset
+	}
+
+	return limit, offset, nil
+}
+
+// handleError writes the HTTP error response for the given service error.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+}
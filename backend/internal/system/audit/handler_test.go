@@ -0,0 +1,98 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type AuditHandlerTestSuite struct {
+	suite.Suite
+	mockService *AuditServiceInterfaceMock
+	handler     *auditHandler
+}
+
+func TestAuditHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditHandlerTestSuite))
+}
+
+func (suite *AuditHandlerTestSuite) SetupTest() {
+	suite.mockService = NewAuditServiceInterfaceMock(suite.T())
+	suite.handler = newAuditHandler(suite.mockService)
+}
+
+func (suite *AuditHandlerTestSuite) TestHandleAuditEventListRequest_Success() {
+	resp := &AuditEventListResponse{TotalResults: 1, StartIndex: 1, Count: 1,
+		Events: []AuditEvent{{ID: "event-1", Action: ActionCreate}}}
+	suite.mockService.On("SearchEvents", mock.Anything, 5, 0, mock.MatchedBy(func(f *tidcommon.FilterGroup) bool {
+		return f != nil && len(f.Clauses) == 1 && f.Clauses[0].Expr.Attribute == "resourceType" &&
+			f.Clauses[0].Expr.Value == "user"
+	})).Return(resp, nil)
+
+	req := httptest.NewRequest(http.MethodGet, `/audit-events?limit=5&filter=resourceType%20eq%20%22user%22`, nil)
+	w := httptest.NewRecorder()
+	suite.handler.HandleAuditEventListRequest(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var body AuditEventListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &body))
+	suite.Equal("event-1", body.Events[0].ID)
+}
+
+func (suite *AuditHandlerTestSuite) TestHandleAuditEventListRequest_DefaultLimit() {
+	suite.mockService.On("SearchEvents", mock.Anything, 30, 0, (*tidcommon.FilterGroup)(nil)).
+		Return(&AuditEventListResponse{Events: []AuditEvent{}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit-events", nil)
+	w := httptest.NewRecorder()
+	suite.handler.HandleAuditEventListRequest(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+}
+
+func (suite *AuditHandlerTestSuite) TestHandleAuditEventListRequest_BadRequest() {
+	testCases := []struct {
+		name         string
+		url          string
+		expectedCode string
+	}{
+		{name: "InvalidLimit", url: "/audit-events?limit=abc", expectedCode: ErrorInvalidLimit.Code},
+		{name: "InvalidOffset", url: "/audit-events?offset=abc", expectedCode: ErrorInvalidOffset.Code},
+		{name: "InvalidFilter", url: "/audit-events?filter=resourceType", expectedCode: ErrorInvalidFilter.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			w := httptest.NewRecorder()
+			suite.handler.HandleAuditEventListRequest(w, req)
+
+			suite.Equal(http.StatusBadRequest, w.Code)
+			var errResp apierror.ErrorResponse
+			suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+			suite.Equal(tc.expectedCode, errResp.Code)
+		})
+	}
+}
+
+func (suite *AuditHandlerTestSuite) TestHandleAuditEventListRequest_ServiceError() {
+	suite.mockService.On("SearchEvents", mock.Anything, 30, 0, mock.Anything).
+		Return(nil, &tidcommon.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit-events", nil)
+	w := httptest.NewRecorder()
+	suite.handler.HandleAuditEventListRequest(w, req)
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
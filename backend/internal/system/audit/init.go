@@ -0,0 +1,42 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit provides the audit trail of management API mutations: the recorder the management
+// services report mutations through, the append-only store the trail is persisted in and the audit
+// event search API.
+package audit
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize constructs the audit service, registers the audit event search route and returns the
+// recorder to inject into the management services.
+func Initialize(
+	mux *http.ServeMux,
+	deploymentID string,
+	observabilitySvc providers.ObservabilityProvider,
+) (AuditServiceInterface, *Recorder) {
+	auditService := newAuditService(newAuditStore(deploymentID), observabilitySvc)
+
+	auditHandler := newAuditHandler(auditService)
+	registerRoutes(mux, auditHandler)
+	return auditService, NewRecorder(auditService)
+}
+
+// registerRoutes registers the audit event routes.
+func registerRoutes(mux *http.ServeMux, auditHandler *auditHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /audit-events", auditHandler.HandleAuditEventListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /audit-events", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts))
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// Action identifies the kind of mutation an audit event records.
+type Action string
+
+const (
+	// ActionCreate records the creation of a resource.
+	ActionCreate Action = "CREATE"
+	// ActionUpdate records a change to an existing resource.
+	ActionUpdate Action = "UPDATE"
+	// ActionDelete records the deletion of a resource.
+	ActionDelete Action = "DELETE"
+)
+
+// ResourceType identifies the kind of resource an audit event targets.
+type ResourceType string
+
+const (
+	// ResourceTypeUser identifies a user.
+	ResourceTypeUser ResourceType = "user"
+	// ResourceTypeGroup identifies a group.
+	ResourceTypeGroup ResourceType = "group"
+	// ResourceTypeRole identifies a role.
+	ResourceTypeRole ResourceType = "role"
+	// ResourceTypeApplication identifies an application.
+	ResourceTypeApplication ResourceType = "application"
+	// ResourceTypeIdentityProvider identifies an identity provider.
+	ResourceTypeIdentityProvider ResourceType = "identity-provider"
+	// ResourceTypeFlow identifies a flow definition.
+	ResourceTypeFlow ResourceType = "flow"
+	// ResourceTypeOU identifies an organization unit.
+	ResourceTypeOU ResourceType = "organization-unit"
+)
+
+// FieldChange holds the value of a top-level resource field before and after a mutation. A side is
+// omitted when the field did not exist on it. Credential values are redacted.
+type FieldChange struct {
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// AuditEvent is a recorded management API mutation.
+type AuditEvent struct {
+	ID            string                 `json:"id"`
+	Timestamp     time.Time              `json:"timestamp"`
+	Action        Action                 `json:"action"`
+	ResourceType  ResourceType           `json:"resourceType"`
+	ResourceID    string                 `json:"resourceId"`
+	ActorID       string                 `json:"actorId,omitempty"`
+	CorrelationID string                 `json:"correlationId,omitempty"`
+	SourceIP      string                 `json:"sourceIp,omitempty"`
+	Changes       map[string]FieldChange `json:"changes,omitempty"`
+}
+
+// AuditEventListResponse is the response body of an audit event search.
+type AuditEventListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	StartIndex   int          `json:"startIndex"`
+	Count        int          `json:"count"`
+	Events       []AuditEvent `json:"events"`
+	Links        []utils.Link `json:"links"`
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+const recorderLoggerComponentName = "AuditRecorder"
+
+// RedactedValue replaces the value of a credential field in the recorded changes. Services that build
+// their own recorded states use it for credentials whose field names do not mark them as such.
+const RedactedValue = "[REDACTED]"
+
+// sensitiveKeyFragments are the lowercase fragments that mark a field name as holding a credential.
+var sensitiveKeyFragments = []string{"password", "secret", "credential", "privatekey", "apikey", "passphrase"}
+
+// Recorder records the audit events of management API mutations on behalf of the management
+// services. The actor, correlation ID and source IP are taken from the request context. Recording is
+// best effort: a failure is logged and never fails the mutation, which has already been applied.
+//
+// A nil Recorder records nothing, so a service constructed without one needs no special handling.
+type Recorder struct {
+	service AuditServiceInterface
+	logger  *log.Logger
+}
+
+// NewRecorder creates a Recorder that records events through the given audit service.
+func NewRecorder(service AuditServiceInterface) *Recorder {
+	return &Recorder{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, recorderLoggerComponentName)),
+	}
+}
+
+// RecordCreate records the creation of a resource with its initial state.
+func (r *Recorder) RecordCreate(ctx context.Context, resourceType ResourceType, resourceID string,
+	after interface{}) {
+	r.record(ctx, ActionCreate, resourceType, resourceID, nil, after)
+}
+
+// RecordUpdate records a change to a resource. Only the top-level fields whose values differ between
+// the before and after states are recorded.
+func (r *Recorder) RecordUpdate(ctx context.Context, resourceType ResourceType, resourceID string,
+	before, after interface{}) {
+	r.record(ctx, ActionUpdate, resourceType, resourceID, before, after)
+}
+
+// RecordDelete records the deletion of a resource with its final state.
+func (r *Recorder) RecordDelete(ctx context.Context, resourceType ResourceType, resourceID string,
+	before interface{}) {
+	r.record(ctx, ActionDelete, resourceType, resourceID, before, nil)
+}
+
+// record builds the audit event for a mutation and hands it to the audit service.
+func (r *Recorder) record(ctx context.Context, action Action, resourceType ResourceType, resourceID string,
+	before, after interface{}) {
+	if r == nil {
+		return
+	}
+
+	event := &AuditEvent{
+		Action:        action,
+		ResourceType:  resourceType,
+		ResourceID:    resourceID,
+		ActorID:       security.GetSubject(ctx),
+		CorrelationID: sysContext.GetTraceID(ctx),
+		SourceIP:      sysContext.GetClientIP(ctx),
+	}
+
+	changes, err := computeChanges(before, after)
+	if err != nil {
+		// The event is still recorded so that the trail shows the mutation took place.
+		r.logger.Error(ctx, "Failed to compute audit event changes", log.String("resourceType",
+			string(resourceType)), log.String("resourceId", resourceID), log.Error(err))
+	}
+	event.Changes = changes
+
+	if svcErr := r.service.RecordEvent(ctx, event); svcErr != nil {
+		r.logger.Error(ctx, "Failed to record audit event", log.String("action", string(action)),
+			log.String("resourceType", string(resourceType)), log.String("resourceId", resourceID),
+			log.String("code", svcErr.Code))
+	}
+}
+
+// computeChanges returns the top-level fields that differ between the before and after states, with
+// credential values redacted. A nil state has no fields.
+func computeChanges(before, after interface{}) (map[string]FieldChange, error) {
+	beforeFields, err := toFieldMap(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := toFieldMap(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]FieldChange)
+	for key, beforeValue := range beforeFields {
+		afterValue, ok := afterFields[key]
+		if ok && reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+		change := FieldChange{Before: redact(key, beforeValue)}
+		if ok {
+			change.After = redact(key, afterValue)
+		}
+		changes[key] = change
+	}
+	for key, afterValue := range afterFields {
+		if _, ok := beforeFields[key]; !ok {
+			changes[key] = FieldChange{After: redact(key, afterValue)}
+		}
+	}
+	return changes, nil
+}
+
+// toFieldMap converts a resource state into its JSON fields. A state that is not a JSON object is
+// recorded under the "value" field.
+func toFieldMap(state interface{}) (map[string]interface{}, error) {
+	if state == nil {
+		return map[string]interface{}{}, nil
+	}
+	v := reflect.ValueOf(state)
+	if kind := v.Kind(); (kind == reflect.Pointer || kind == reflect.Map || kind == reflect.Slice) && v.IsNil() {
+		return map[string]interface{}{}, nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	if fields, ok := decoded.(map[string]interface{}); ok {
+		return fields, nil
+	}
+	return map[string]interface{}{"value": decoded}, nil
+}
+
+// redact replaces credential values, at any depth, with RedactedValue.
+func redact(key string, value interface{}) interface{} {
+	if value != nil && isSensitiveKey(key) {
+		return RedactedValue
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for k, nested := range v {
+			redacted[k] = redact(k, nested)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, nested := range v {
+			redacted[i] = redact("", nested)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// isSensitiveKey reports whether a field name marks a credential.
+func isSensitiveKey(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+type testResource struct {
+	Name     string            `json:"name"`
+	Password string            `json:"password,omitempty"`
+	Config   map[string]string `json:"config,omitempty"`
+}
+
+type RecorderTestSuite struct {
+	suite.Suite
+	mockService *AuditServiceInterfaceMock
+	recorder    *Recorder
+}
+
+func TestRecorderTestSuite(t *testing.T) {
+	suite.Run(t, new(RecorderTestSuite))
+}
+
+func (suite *RecorderTestSuite) SetupTest() {
+	suite.mockService = NewAuditServiceInterfaceMock(suite.T())
+	suite.recorder = NewRecorder(suite.mockService)
+}
+
+func (suite *RecorderTestSuite) requestContext() context.Context {
+	ctx := security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest("admin-1", "ou-1", "token", nil, nil))
+	ctx = sysContext.WithTraceID(ctx, "trace-1")
+	return sysContext.WithClientIP(ctx, "10.0.0.1")
+}
+
+func (suite *RecorderTestSuite) TestRecordCreate_CapturesRequestContext() {
+	var recorded *AuditEvent
+	suite.mockService.On("RecordEvent", mock.Anything, mock.AnythingOfType("*audit.AuditEvent")).
+		Run(func(args mock.Arguments) { recorded = args.Get(1).(*AuditEvent) }).
+		Return(nil)
+
+	suite.recorder.RecordCreate(suite.requestContext(), ResourceTypeUser, "user-1",
+		testResource{Name: "alice", Password: "s3cret"})
+
+	suite.Require().NotNil(recorded)
+	suite.Equal(ActionCreate, recorded.Action)
+	suite.Equal(ResourceTypeUser, recorded.ResourceType)
+	suite.Equal("user-1", recorded.ResourceID)
+	suite.Equal("admin-1", recorded.ActorID)
+	suite.Equal("trace-1", recorded.CorrelationID)
+	suite.Equal("10.0.0.1", recorded.SourceIP)
+	suite.Equal(FieldChange{After: "alice"}, recorded.Changes["name"])
+	suite.Equal(FieldChange{After: RedactedValue}, recorded.Changes["password"])
+}
+
+func (suite *RecorderTestSuite) TestRecordUpdate_RecordsOnlyChangedFields() {
+	var recorded *AuditEvent
+	suite.mockService.On("RecordEvent", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { recorded = args.Get(1).(*AuditEvent) }).
+		Return(nil)
+
+	before := &testResource{Name: "alice", Config: map[string]string{"clientSecret": "a", "mode": "x"}}
+	after := &testResource{Name: "alice", Config: map[string]string{"clientSecret": "b", "mode": "y"}}
+	suite.recorder.RecordUpdate(suite.requestContext(), ResourceTypeIdentityProvider, "idp-1", before, after)
+
+	suite.Require().NotNil(recorded)
+	suite.Equal(ActionUpdate, recorded.Action)
+	suite.NotContains(recorded.Changes, "name")
+	suite.Equal(FieldChange{
+		Before: map[string]interface{}{"clientSecret": RedactedValue, "mode": "x"},
+		After:  map[string]interface{}{"clientSecret": RedactedValue, "mode": "y"},
+	}, recorded.Changes["config"])
+}
+
+func (suite *RecorderTestSuite) TestRecordDelete_RecordsFinalState() {
+	var recorded *AuditEvent
+	suite.mockService.On("RecordEvent", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { recorded = args.Get(1).(*AuditEvent) }).
+		Return(nil)
+
+	suite.recorder.RecordDelete(suite.requestContext(), ResourceTypeGroup, "group-1", testResource{Name: "admins"})
+
+	suite.Require().NotNil(recorded)
+	suite.Equal(ActionDelete, recorded.Action)
+	suite.Equal(FieldChange{Before: "admins"}, recorded.Changes["name"])
+}
+
+func (suite *RecorderTestSuite) TestRecord_ServiceErrorIsNotPropagated() {
+	suite.mockService.On("RecordEvent", mock.Anything, mock.Anything).Return(&tidcommon.InternalServerError)
+
+	suite.NotPanics(func() {
+		suite.recorder.RecordDelete(context.Background(), ResourceTypeRole, "role-1", nil)
+	})
+}
+
+func (suite *RecorderTestSuite) TestRecord_NilRecorder() {
+	var recorder *Recorder
+
+	suite.NotPanics(func() {
+		recorder.RecordCreate(context.Background(), ResourceTypeFlow, "flow-1", testResource{Name: "login"})
+	})
+}
+
+func (suite *RecorderTestSuite) TestComputeChanges_NonObjectState() {
+	changes, err := computeChanges(nil, []string{"user-1"})
+
+	suite.NoError(err)
+	suite.Equal(map[string]FieldChange{"value": {After: []interface{}{"user-1"}}}, changes)
+}
+
+func (suite *RecorderTestSuite) TestIsSensitiveKey() {
+	for _, key := range []string{"password", "client_secret", "privateKey", "API-KEY", "credentials"} {
+		suite.True(isSensitiveKey(key), key)
+	}
+	for _, key := range []string{"name", "email", "clientId", ""} {
+		suite.False(isSensitiveKey(key), key)
+	}
+}
+
+func (suite *RecorderTestSuite) TestComputeChanges_NilStates() {
+	var nilResource *testResource
+	var nilMap map[string]interface{}
+
+	changes, err := computeChanges(nilResource, nilMap)
+
+	suite.NoError(err)
+	suite.Empty(changes)
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const loggerComponentName = "AuditService"
+
+// auditEventTypes maps each audit action to the observability event type it is published as.
+var auditEventTypes = map[Action]providers.EventType{
+	ActionCreate: event.EventTypeResourceCreated,
+	ActionUpdate: event.EventTypeResourceUpdated,
+	ActionDelete: event.EventTypeResourceDeleted,
+}
+
+// AuditServiceInterface defines the operations on the audit trail of management API mutations.
+type AuditServiceInterface interface {
+	// RecordEvent appends an audit event to the trail and publishes it to the observability system.
+	// The event id and timestamp are assigned when not set.
+	RecordEvent(ctx context.Context, auditEvent *AuditEvent) *tidcommon.ServiceError
+	// SearchEvents returns a page of the audit events matching the filter, newest first.
+	SearchEvents(
+		ctx context.Context, limit, offset int, f *tidcommon.FilterGroup,
+	) (*AuditEventListResponse, *tidcommon.ServiceError)
+}
+
+// auditService is the default implementation of AuditServiceInterface.
+type auditService struct {
+	store            auditStoreInterface
+	observabilitySvc providers.ObservabilityProvider
+	logger           *log.Logger
+}
+
+// newAuditService creates a new instance of auditService.
+func newAuditService(
+	store auditStoreInterface, observabilitySvc providers.ObservabilityProvider,
+) AuditServiceInterface {
+	return &auditService{
+		store:            store,
+		observabilitySvc: observabilitySvc,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// RecordEvent appends an audit event to the trail and publishes it to the observability system.
+func (s *auditService) RecordEvent(ctx context.Context, auditEvent *AuditEvent) *tidcommon.ServiceError {
+	if auditEvent.ID == "" {
+		eventID, err := utils.GenerateUUIDv7()
+		if err != nil {
+			s.logger.Error(ctx, "Failed to generate audit event id", log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		auditEvent.ID = eventID
+	}
+	if auditEvent.Timestamp.IsZero() {
+		auditEvent.Timestamp = time.Now().UTC()
+	}
+
+	if err := s.store.InsertEvent(ctx, auditEvent); err != nil {
+		s.logger.Error(ctx, "Failed to store audit event", log.String("eventId", auditEvent.ID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	s.publishAuditEvent(ctx, auditEvent)
+	return nil
+}
+
+// SearchEvents returns a page of the audit events matching the filter, newest first.
+func (s *auditService) SearchEvents(
+	ctx context.Context, limit, offset int, f *tidcommon.FilterGroup,
+) (*AuditEventListResponse, *tidcommon.ServiceError) {
+	if limit < 1 || limit > serverconst.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return nil, &ErrorInvalidOffset
+	}
+	if svcErr := normalizeFilter(f); svcErr != nil {
+		return nil, svcErr
+	}
+
+	totalCount, err := s.store.CountEvents(ctx, f)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to count audit events", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	events, err := s.store.ListEvents(ctx, limit, offset, f)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list audit events", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return &AuditEventListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(events),
+		Events:       events,
+		Links:        utils.BuildPaginationLinks("/audit-events", limit, offset, totalCount, ""),
+	}, nil
+}
+
+// normalizeFilter checks that every filter clause targets a searchable attribute and converts
+// timestamp values to times, so that they compare correctly in every database.
+func normalizeFilter(f *tidcommon.FilterGroup) *tidcommon.ServiceError {
+	if f == nil {
+		return nil
+	}
+
+	for i := range f.Clauses {
+		expr := &f.Clauses[i].Expr
+		if _, ok := auditFilterableColumns[expr.Attribute]; !ok {
+			return &ErrorInvalidFilter
+		}
+		if expr.Attribute != timestampAttribute {
+			continue
+		}
+		value, ok := expr.Value.(string)
+		if !ok {
+			return &ErrorInvalidFilter
+		}
+		timestamp, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return &ErrorInvalidFilter
+		}
+		expr.Value = timestamp.UTC()
+	}
+	return nil
+}
+
+// publishAuditEvent publishes a recorded audit event to the observability system. The recorded
+// changes are not published, as observability outputs are not access controlled like the trail.
+func (s *auditService) publishAuditEvent(ctx context.Context, auditEvent *AuditEvent) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(auditEvent.CorrelationID, string(auditEventTypes[auditEvent.Action]),
+		event.ComponentManagementAPI).
+		WithStatus(providers.StatusSuccess).
+		WithData(event.DataKey.AuditEventID, auditEvent.ID).
+		WithData(event.DataKey.ResourceType, string(auditEvent.ResourceType)).
+		WithData(event.DataKey.ResourceID, auditEvent.ResourceID)
+	if auditEvent.ActorID != "" {
+		evt.WithData(event.DataKey.ActorID, auditEvent.ActorID)
+	}
+	if auditEvent.SourceIP != "" {
+		evt.WithData(event.DataKey.ClientIP, auditEvent.SourceIP)
+	}
+
+	s.observabilitySvc.PublishEvent(ctx, evt)
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/tests/mocks/observabilityprovidermock"
+)
+
+type AuditServiceTestSuite struct {
+	suite.Suite
+	mockStore         *auditStoreInterfaceMock
+	mockObservability *observabilityprovidermock.ObservabilityProviderMock
+	service           AuditServiceInterface
+}
+
+func TestAuditServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditServiceTestSuite))
+}
+
+func (suite *AuditServiceTestSuite) SetupTest() {
+	suite.mockStore = NewauditStoreInterfaceMock(suite.T())
+	suite.mockObservability = observabilityprovidermock.NewObservabilityProviderMock(suite.T())
+	suite.service = newAuditService(suite.mockStore, suite.mockObservability)
+}
+
+func (suite *AuditServiceTestSuite) TestRecordEvent_AssignsIDAndTimestampAndPublishes() {
+	auditEvent := &AuditEvent{
+		Action:        ActionCreate,
+		ResourceType:  ResourceTypeApplication,
+		ResourceID:    "app-1",
+		ActorID:       "admin-1",
+		CorrelationID: "trace-1",
+		SourceIP:      "10.0.0.1",
+		Changes:       map[string]FieldChange{"name": {After: "portal"}},
+	}
+	suite.mockStore.On("InsertEvent", mock.Anything, auditEvent).Return(nil)
+	suite.mockObservability.On("IsEnabled").Return(true)
+	suite.mockObservability.On("PublishEvent", mock.Anything, mock.MatchedBy(func(evt *providers.Event) bool {
+		_, hasChanges := evt.Data["changes"]
+		return evt.Type == string(event.EventTypeResourceCreated) &&
+			evt.TraceID == "trace-1" &&
+			evt.Data[event.DataKey.ResourceType] == "application" &&
+			evt.Data[event.DataKey.ResourceID] == "app-1" &&
+			evt.Data[event.DataKey.ActorID] == "admin-1" &&
+			evt.Data[event.DataKey.ClientIP] == "10.0.0.1" &&
+			!hasChanges
+	})).Return()
+
+	svcErr := suite.service.RecordEvent(context.Background(), auditEvent)
+
+	suite.Nil(svcErr)
+	suite.NotEmpty(auditEvent.ID)
+	suite.False(auditEvent.Timestamp.IsZero())
+}
+
+func (suite *AuditServiceTestSuite) TestRecordEvent_ObservabilityDisabled() {
+	suite.mockStore.On("InsertEvent", mock.Anything, mock.Anything).Return(nil)
+	suite.mockObservability.On("IsEnabled").Return(false)
+
+	svcErr := suite.service.RecordEvent(context.Background(), &AuditEvent{Action: ActionDelete})
+
+	suite.Nil(svcErr)
+	suite.mockObservability.AssertNotCalled(suite.T(), "PublishEvent", mock.Anything, mock.Anything)
+}
+
+func (suite *AuditServiceTestSuite) TestRecordEvent_StoreError() {
+	suite.mockStore.On("InsertEvent", mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	svcErr := suite.service.RecordEvent(context.Background(), &AuditEvent{Action: ActionUpdate})
+
+	suite.Equal(&tidcommon.InternalServerError, svcErr)
+	suite.mockObservability.AssertNotCalled(suite.T(), "IsEnabled")
+}
+
+func (suite *AuditServiceTestSuite) TestSearchEvents_Success() {
+	events := []AuditEvent{{ID: "event-2"}, {ID: "event-1"}}
+	suite.mockStore.On("CountEvents", mock.Anything, (*tidcommon.FilterGroup)(nil)).Return(12, nil)
+	suite.mockStore.On("ListEvents", mock.Anything, 2, 4, (*tidcommon.FilterGroup)(nil)).Return(events, nil)
+
+	resp, svcErr := suite.service.SearchEvents(context.Background(), 2, 4, nil)
+
+	suite.Nil(svcErr)
+	suite.Equal(12, resp.TotalResults)
+	suite.Equal(5, resp.StartIndex)
+	suite.Equal(2, resp.Count)
+	suite.Equal(events, resp.Events)
+	suite.NotEmpty(resp.Links)
+}
+
+func (suite *AuditServiceTestSuite) TestSearchEvents_ConvertsTimestampFilter() {
+	f := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{
+			Attribute: "timestamp", Operator: tidcommon.OperatorGt, Value: "2026-01-01T05:30:00+05:30"}},
+	}}
+	suite.mockStore.On("CountEvents", mock.Anything, f).Return(0, nil)
+	suite.mockStore.On("ListEvents", mock.Anything, 10, 0, f).Return([]AuditEvent{}, nil)
+
+	_, svcErr := suite.service.SearchEvents(context.Background(), 10, 0, f)
+
+	suite.Nil(svcErr)
+	suite.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), f.Clauses[0].Expr.Value)
+}
+
+func (suite *AuditServiceTestSuite) TestSearchEvents_InvalidParameters() {
+	testCases := []struct {
+		name     string
+		limit    int
+		offset   int
+		filter   *tidcommon.FilterGroup
+		expected *tidcommon.ServiceError
+	}{
+		{name: "ZeroLimit", limit: 0, expected: &ErrorInvalidLimit},
+		{name: "LimitAboveMax", limit: 101, expected: &ErrorInvalidLimit},
+		{name: "NegativeOffset", limit: 10, offset: -1, expected: &ErrorInvalidOffset},
+		{name: "UnknownAttribute", limit: 10, expected: &ErrorInvalidFilter,
+			filter: &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+				{Expr: tidcommon.FilterExpression{Attribute: "changes", Operator: tidcommon.OperatorEq, Value: "x"}},
+			}}},
+		{name: "MalformedTimestamp", limit: 10, expected: &ErrorInvalidFilter,
+			filter: &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+				{Expr: tidcommon.FilterExpression{Attribute: "timestamp", Operator: tidcommon.OperatorLt,
+					Value: "yesterday"}},
+			}}},
+		{name: "NumericTimestamp", limit: 10, expected: &ErrorInvalidFilter,
+			filter: &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+				{Expr: tidcommon.FilterExpression{Attribute: "timestamp", Operator: tidcommon.OperatorLt,
+					Value: int64(1700000000)}},
+			}}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			resp, svcErr := suite.service.SearchEvents(context.Background(), tc.limit, tc.offset, tc.filter)
+
+			suite.Nil(resp)
+			suite.Equal(tc.expected, svcErr)
+		})
+	}
+}
+
+func (suite *AuditServiceTestSuite) TestSearchEvents_StoreError() {
+	suite.mockStore.On("CountEvents", mock.Anything, mock.Anything).Return(0, errors.New("db error"))
+
+	resp, svcErr := suite.service.SearchEvents(context.Background(), 10, 0, nil)
+
+	suite.Nil(resp)
+	suite.Equal(&tidcommon.InternalServerError, svcErr)
+}
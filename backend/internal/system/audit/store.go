@@ -0,0 +1,172 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// auditStoreInterface persists the audit trail. It is append-only: recorded events cannot be changed
+// or removed.
+type auditStoreInterface interface {
+	// InsertEvent appends an audit event.
+	InsertEvent(ctx context.Context, event *AuditEvent) error
+	// CountEvents returns the number of audit events matching the filter.
+	CountEvents(ctx context.Context, f *tidcommon.FilterGroup) (int, error)
+	// ListEvents returns a page of the audit events matching the filter, newest first.
+	ListEvents(ctx context.Context, limit, offset int, f *tidcommon.FilterGroup) ([]AuditEvent, error)
+}
+
+// auditStore implements auditStoreInterface against the runtime persistent database.
+type auditStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAuditStore creates a new auditStore.
+func newAuditStore(deploymentID string) auditStoreInterface {
+	return &auditStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// InsertEvent appends an audit event.
+func (s *auditStore) InsertEvent(ctx context.Context, event *AuditEvent) error {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	var changes interface{}
+	if len(event.Changes) > 0 {
+		changesJSON, err := json.Marshal(event.Changes)
+		if err != nil {
+			return fmt.Errorf("failed to marshal audit event changes: %w", err)
+		}
+		changes = string(changesJSON)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryInsertAuditEvent, event.ID, event.Timestamp.UTC(),
+		string(event.Action), string(event.ResourceType), event.ResourceID, event.ActorID, event.CorrelationID,
+		event.SourceIP, changes, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("error inserting audit event: %w", err)
+	}
+
+	return nil
+}
+
+// CountEvents returns the number of audit events matching the filter.
+func (s *auditStore) CountEvents(ctx context.Context, f *tidcommon.FilterGroup) (int, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	query, filterArgs, err := buildAuditEventCountQuery(f)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build count query: %w", err)
+	}
+	args := append([]interface{}{s.deploymentID}, filterArgs...)
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute count query: %w", err)
+	}
+
+	var total int
+	if len(results) > 0 {
+		count, ok := results[0]["total"].(int64)
+		if !ok {
+			return 0, fmt.Errorf("unexpected type for total: %T", results[0]["total"])
+		}
+		total = int(count)
+	}
+
+	return total, nil
+}
+
+// ListEvents returns a page of the audit events matching the filter, newest first.
+func (s *auditStore) ListEvents(
+	ctx context.Context, limit, offset int, f *tidcommon.FilterGroup,
+) ([]AuditEvent, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+
+	query, filterArgs, err := buildAuditEventListQuery(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build list query: %w", err)
+	}
+	args := append([]interface{}{limit, offset, s.deploymentID}, filterArgs...)
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	events := make([]AuditEvent, 0, len(results))
+	for _, row := range results {
+		event, err := buildAuditEventFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build audit event: %w", err)
+		}
+		events = append(events, *event)
+	}
+
+	return events, nil
+}
+
+// buildAuditEventFromResultRow constructs an AuditEvent from a database result row.
+func buildAuditEventFromResultRow(row map[string]interface{}) (*AuditEvent, error) {
+	eventID, ok := row["event_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse event_id as string")
+	}
+	timestamp, err := sysutils.ParseDBTimeField(row["event_time"], "event_time")
+	if err != nil {
+		return nil, err
+	}
+
+	event := &AuditEvent{
+		ID:            eventID,
+		Timestamp:     timestamp.UTC(),
+		Action:        Action(stringColumn(row, "action")),
+		ResourceType:  ResourceType(stringColumn(row, "resource_type")),
+		ResourceID:    stringColumn(row, "resource_id"),
+		ActorID:       stringColumn(row, "actor_id"),
+		CorrelationID: stringColumn(row, "correlation_id"),
+		SourceIP:      stringColumn(row, "source_ip"),
+	}
+
+	var changesJSON []byte
+	switch v := row["changes"].(type) {
+	case string:
+		changesJSON = []byte(v)
+	case []byte:
+		changesJSON = v
+	}
+	if len(changesJSON) > 0 {
+		if err := json.Unmarshal(changesJSON, &event.Changes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal audit event changes: %w", err)
+		}
+	}
+
+	return event, nil
+}
+
+// stringColumn returns a nullable string column, or an empty string when it is null.
+func stringColumn(row map[string]interface{}, column string) string {
+	value, _ := row[column].(string)
+	return value
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// timestampAttribute is the filter attribute for the time an event was recorded. Its values are
+// RFC 3339 timestamps.
+const timestampAttribute = "timestamp"
+
+// auditFilterableColumns maps API attribute names to AUDIT_EVENT table column names.
+var auditFilterableColumns = map[string]string{
+	"action":           "ACTION",
+	"resourceType":     "RESOURCE_TYPE",
+	"resourceId":       "RESOURCE_ID",
+	"actorId":          "ACTOR_ID",
+	"correlationId":    "CORRELATION_ID",
+	"sourceIp":         "SOURCE_IP",
+	timestampAttribute: "EVENT_TIME",
+}
+
+// queryInsertAuditEvent appends an audit event. The table is append-only; there are no update or
+// delete queries.
+var queryInsertAuditEvent = dbmodel.DBQuery{
+	ID: "AUQ-AUD-01",
+	Query: `INSERT INTO "AUDIT_EVENT" (EVENT_ID, EVENT_TIME, ACTION, RESOURCE_TYPE, RESOURCE_ID, ACTOR_ID, ` +
+		`CORRELATION_ID, SOURCE_IP, CHANGES, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+}
+
+// buildAuditFilterGroup generates a SQL WHERE fragment for a FilterGroup and returns the bound args.
+// startParamIdx is the positional parameter index for the first filter value.
+// Returns an empty string and no args when g is nil.
+func buildAuditFilterGroup(g *tidcommon.FilterGroup, startParamIdx int) (string, []interface{}, error) {
+	if g == nil || len(g.Clauses) == 0 {
+		return "", nil, nil
+	}
+
+	var sb strings.Builder
+	args := make([]interface{}, 0, len(g.Clauses))
+	idx := startParamIdx
+
+	for i, clause := range g.Clauses {
+		col, ok := auditFilterableColumns[clause.Expr.Attribute]
+		if !ok {
+			return "", nil, fmt.Errorf("attribute %q is not filterable", clause.Expr.Attribute)
+		}
+
+		var clauseCond string
+		switch clause.Expr.Operator {
+		case tidcommon.OperatorEq:
+			clauseCond = fmt.Sprintf("%s = $%d", col, idx)
+		case tidcommon.OperatorGt:
+			clauseCond = fmt.Sprintf("%s > $%d", col, idx)
+		case tidcommon.OperatorLt:
+			clauseCond = fmt.Sprintf("%s < $%d", col, idx)
+		default:
+			return "", nil, fmt.Errorf("unsupported operator %q", clause.Expr.Operator)
+		}
+
+		if i > 0 {
+			sb.WriteString(" ")
+			sb.WriteString(string(clause.Connector))
+			sb.WriteString(" ")
+		}
+		sb.WriteString(clauseCond)
+		args = append(args, clause.Expr.Value)
+		idx++
+	}
+
+	return " AND (" + sb.String() + ")", args, nil
+}
+
+// buildAuditEventCountQuery constructs the count query for an audit event search.
+// Args order: deploymentID=$1 [, filterArgs...]
+func buildAuditEventCountQuery(g *tidcommon.FilterGroup) (dbmodel.DBQuery, []interface{}, error) {
+	cond, filterArgs, err := buildAuditFilterGroup(g, 2)
+	if err != nil {
+		return dbmodel.DBQuery{}, nil, err
+	}
+
+	query := `SELECT COUNT(*) as total FROM "AUDIT_EVENT" WHERE DEPLOYMENT_ID = $1` + cond
+	return dbmodel.DBQuery{ID: "AUQ-AUD-02", Query: query}, filterArgs, nil
+}
+
+// buildAuditEventListQuery constructs the paginated audit event search query, newest first.
+// Args order: limit=$1, offset=$2, deploymentID=$3 [, filterArgs...]
+func buildAuditEventListQuery(g *tidcommon.FilterGroup) (dbmodel.DBQuery, []interface{}, error) {
+	cond, filterArgs, err := buildAuditFilterGroup(g, 4)
+	if err != nil {
+		return dbmodel.DBQuery{}, nil, err
+	}
+
+	query := `SELECT EVENT_ID, EVENT_TIME, ACTION, RESOURCE_TYPE, RESOURCE_ID, ACTOR_ID, CORRELATION_ID, ` +
+		`SOURCE_IP, CHANGES FROM "AUDIT_EVENT" WHERE DEPLOYMENT_ID = $3` + cond +
+		` ORDER BY EVENT_TIME DESC, EVENT_ID DESC LIMIT $1 OFFSET $2`
+	return dbmodel.DBQuery{ID: "AUQ-AUD-03", Query: query}, filterArgs, nil
+}
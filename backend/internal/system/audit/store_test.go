@@ -0,0 +1,155 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type AuditStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *auditStore
+}
+
+func TestAuditStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditStoreTestSuite))
+}
+
+func (suite *AuditStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &auditStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *AuditStoreTestSuite) TestInsertEvent_Success() {
+	eventTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertAuditEvent,
+		"event-1", eventTime, "UPDATE", "user", "user-1", "admin-1", "trace-1", "10.0.0.1",
+		`{"name":{"before":"a","after":"b"}}`, testDeploymentID).
+		Return(int64(1), nil)
+
+	err := suite.store.InsertEvent(context.Background(), &AuditEvent{
+		ID: "event-1", Timestamp: eventTime, Action: ActionUpdate, ResourceType: ResourceTypeUser,
+		ResourceID: "user-1", ActorID: "admin-1", CorrelationID: "trace-1", SourceIP: "10.0.0.1",
+		Changes: map[string]FieldChange{"name": {Before: "a", After: "b"}},
+	})
+	suite.NoError(err)
+}
+
+func (suite *AuditStoreTestSuite) TestInsertEvent_WithoutChanges() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertAuditEvent,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, nil, testDeploymentID).
+		Return(int64(1), nil)
+
+	err := suite.store.InsertEvent(context.Background(), &AuditEvent{ID: "event-1", Action: ActionDelete})
+	suite.NoError(err)
+}
+
+func (suite *AuditStoreTestSuite) TestInsertEvent_ExecError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertAuditEvent,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("execute error"))
+
+	err := suite.store.InsertEvent(context.Background(), &AuditEvent{ID: "event-1"})
+	suite.ErrorContains(err, "error inserting audit event")
+}
+
+func (suite *AuditStoreTestSuite) TestInsertEvent_DBClientError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(nil, errors.New("db client error"))
+
+	err := suite.store.InsertEvent(context.Background(), &AuditEvent{ID: "event-1"})
+	suite.ErrorContains(err, "db client error")
+}
+
+func (suite *AuditStoreTestSuite) TestCountEvents_WithFilter() {
+	f := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "resourceType", Operator: tidcommon.OperatorEq, Value: "user"}},
+	}}
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, mock.Anything, testDeploymentID, "user").
+		Return([]map[string]interface{}{{"total": int64(3)}}, nil)
+
+	total, err := suite.store.CountEvents(context.Background(), f)
+	suite.NoError(err)
+	suite.Equal(3, total)
+}
+
+func (suite *AuditStoreTestSuite) TestCountEvents_InvalidFilter() {
+	f := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "changes", Operator: tidcommon.OperatorEq, Value: "x"}},
+	}}
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+
+	_, err := suite.store.CountEvents(context.Background(), f)
+	suite.ErrorContains(err, "failed to build count query")
+}
+
+func (suite *AuditStoreTestSuite) TestListEvents_Success() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, mock.Anything, 10, 0, testDeploymentID).
+		Return([]map[string]interface{}{{
+			"event_id":       "event-1",
+			"event_time":     "2026-01-02 03:04:05",
+			"action":         "CREATE",
+			"resource_type":  "role",
+			"resource_id":    "role-1",
+			"actor_id":       "admin-1",
+			"correlation_id": "trace-1",
+			"source_ip":      nil,
+			"changes":        []byte(`{"name":{"after":"viewer"}}`),
+		}}, nil)
+
+	events, err := suite.store.ListEvents(context.Background(), 10, 0, nil)
+	suite.NoError(err)
+	suite.Require().Len(events, 1)
+	suite.Equal("event-1", events[0].ID)
+	suite.Equal(ActionCreate, events[0].Action)
+	suite.Equal(ResourceTypeRole, events[0].ResourceType)
+	suite.Equal("", events[0].SourceIP)
+	suite.Equal(FieldChange{After: "viewer"}, events[0].Changes["name"])
+}
+
+func (suite *AuditStoreTestSuite) TestListEvents_QueryError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, mock.Anything, 10, 0, testDeploymentID).
+		Return(nil, errors.New("query error"))
+
+	_, err := suite.store.ListEvents(context.Background(), 10, 0, nil)
+	suite.ErrorContains(err, "failed to execute query")
+}
+
+func (suite *AuditStoreTestSuite) TestBuildAuditEventListQuery_WithFilter() {
+	f := &tidcommon.FilterGroup{Clauses: []tidcommon.FilterClause{
+		{Expr: tidcommon.FilterExpression{Attribute: "actorId", Operator: tidcommon.OperatorEq, Value: "admin-1"}},
+		{Connector: tidcommon.LogicalAnd, Expr: tidcommon.FilterExpression{
+			Attribute: "timestamp", Operator: tidcommon.OperatorGt, Value: "2026-01-01T00:00:00Z"}},
+	}}
+
+	query, args, err := buildAuditEventListQuery(f)
+	suite.NoError(err)
+	suite.Contains(query.Query, "WHERE DEPLOYMENT_ID = $3 AND (ACTOR_ID = $4 AND EVENT_TIME > $5)")
+	suite.Equal([]interface{}{"admin-1", "2026-01-01T00:00:00Z"}, args)
+}
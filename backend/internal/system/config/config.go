@@ -26,9 +26,23 @@ import (
 
 // TLSConfig holds the TLS configuration details.
 type TLSConfig struct {
-	MinVersion string `yaml:"min_version" json:"min_version"`
-	CertFile   string `yaml:"cert_file"   json:"cert_file"`
-	KeyFile    string `yaml:"key_file"    json:"key_file"`
+	MinVersion        string                  `yaml:"min_version"        json:"min_version"`
+	CertFile          string                  `yaml:"cert_file"          json:"cert_file"`
+	KeyFile           string                  `yaml:"key_file"           json:"key_file"`
+	ClientCertificate ClientCertificateConfig `yaml:"client_certificate" json:"client_certificate"`
+}
+
+// ClientCertificateConfig holds the mutual-TLS client certificate settings (RFC 8705).
+type ClientCertificateConfig struct {
+	// Request asks clients for a certificate during the TLS handshake. The handshake does not verify
+	// the certificate; it is checked against the client's registration when it is used.
+	Request bool `yaml:"request"          json:"request"`
+	// ForwardedHeader names the request header in which a trusted TLS-terminating proxy forwards the
+	// client certificate. The proxy must strip the header from incoming requests. Empty disables it.
+	ForwardedHeader string `yaml:"forwarded_header" json:"forwarded_header"`
+	// TrustedCAFile is a PEM bundle, relative to the server home, of the certificate authorities that
+	// issue tls_client_auth client certificates. tls_client_auth is refused when empty.
+	TrustedCAFile string `yaml:"trusted_ca_file"  json:"trusted_ca_file"`
 }
 
 // DataSource holds the individual database connection details.
@@ -38,6 +52,7 @@ type DataSource struct {
 	Type     string             `yaml:"type"     json:"type"`
 	Postgres PostgresDataSource `yaml:"postgres" json:"postgres"`
 	SQLite   SQLiteDataSource   `yaml:"sqlite"   json:"sqlite"`
+	MySQL    MySQLDataSource    `yaml:"mysql"    json:"mysql"`
 	Redis    RedisDataSource    `yaml:"redis"    json:"redis"`
 }
 
@@ -57,6 +72,23 @@ type PostgresDataSource struct {
 	MaxRetryBackoffMS int    `yaml:"max_retry_backoff_ms" json:"max_retry_backoff_ms"`
 }
 
+// MySQLDataSource holds MySQL and MariaDB specific connection details.
+type MySQLDataSource struct {
+	Hostname          string `yaml:"hostname"             json:"hostname"`
+	Port              int    `yaml:"port"                 json:"port"`
+	Name              string `yaml:"name"                 json:"name"`
+	Username          string `yaml:"username"             json:"username"`
+	Password          string `yaml:"password"             json:"password"`
+	TLS               string `yaml:"tls"                  json:"tls"`
+	Options           string `yaml:"options"              json:"options"`
+	MaxOpenConns      int    `yaml:"max_open_conns"       json:"max_open_conns"`
+	MaxIdleConns      int    `yaml:"max_idle_conns"       json:"max_idle_conns"`
+	ConnMaxLifetime   int    `yaml:"conn_max_lifetime"    json:"conn_max_lifetime"`
+	MaxRetries        int    `yaml:"max_retries"          json:"max_retries"`
+	MinRetryBackoffMS int    `yaml:"min_retry_backoff_ms" json:"min_retry_backoff_ms"`
+	MaxRetryBackoffMS int    `yaml:"max_retry_backoff_ms" json:"max_retry_backoff_ms"`
+}
+
 // SQLiteDataSource holds SQLite-specific connection details.
 type SQLiteDataSource struct {
 	Path              string `yaml:"path"                 json:"path"`
@@ -181,6 +213,84 @@ type PasskeyConfig struct {
 	AllowedOrigins []string `yaml:"allowed_origins" json:"allowed_origins"`
 }
 
+// TOTPConfig holds the configuration of TOTP authenticator-app credentials. Zero values fall back
+// to the defaults of the TOTP service.
+type TOTPConfig struct {
+	Issuer            string `yaml:"issuer"              json:"issuer"`
+	Digits            int    `yaml:"digits"              json:"digits"`
+	PeriodSeconds     int    `yaml:"period_seconds"      json:"period_seconds"`
+	Skew              int    `yaml:"skew"                json:"skew"`
+	RecoveryCodeCount int    `yaml:"recovery_code_count" json:"recovery_code_count"`
+}
+
+// AccountLockoutConfig holds the failed-attempt counting and lockout settings of credential-based
+// authentication. Failures are counted per user and per client IP. Each lockout of the same user or
+// IP lasts BackoffMultiplier times longer than the previous one, up to MaxLockoutDurationSeconds.
+// Zero values fall back to the defaults of the lockout service.
+type AccountLockoutConfig struct {
+	Enabled                   bool    `yaml:"enabled"                      json:"enabled"`
+	MaxFailedAttempts         int     `yaml:"max_failed_attempts"          json:"max_failed_attempts"`
+	IPMaxFailedAttempts       int     `yaml:"ip_max_failed_attempts"       json:"ip_max_failed_attempts"`
+	FailureWindowSeconds      int     `yaml:"failure_window_seconds"       json:"failure_window_seconds"`
+	LockoutDurationSeconds    int     `yaml:"lockout_duration_seconds"     json:"lockout_duration_seconds"`
+	MaxLockoutDurationSeconds int     `yaml:"max_lockout_duration_seconds" json:"max_lockout_duration_seconds"`
+	BackoffMultiplier         float64 `yaml:"backoff_multiplier"           json:"backoff_multiplier"`
+}
+
+// PasswordPolicyConfig holds the password policies enforced when a user sets or changes a password.
+// Default applies to every user. UserTypes and OrganizationUnits replace it for users of the given
+// user type or organization unit ID, with organization units taking precedence over user types.
+type PasswordPolicyConfig struct {
+	Enabled             bool                           `yaml:"enabled"              json:"enabled"`
+	CredentialAttribute string                         `yaml:"credential_attribute" json:"credential_attribute"`
+	Default             PasswordPolicyRules            `yaml:"default"              json:"default"`
+	UserTypes           map[string]PasswordPolicyRules `yaml:"user_types"           json:"user_types"`
+	OrganizationUnits   map[string]PasswordPolicyRules `yaml:"organization_units"   json:"organization_units"`
+	BreachedPassword    BreachedPasswordConfig         `yaml:"breached_password"    json:"breached_password"`
+}
+
+// PasswordPolicyRules holds the rules of a single password policy. Zero values disable the rule.
+type PasswordPolicyRules struct {
+	MinLength     int  `yaml:"min_length"      json:"min_length"`
+	MaxLength     int  `yaml:"max_length"      json:"max_length"`
+	MinUppercase  int  `yaml:"min_uppercase"   json:"min_uppercase"`
+	MinLowercase  int  `yaml:"min_lowercase"   json:"min_lowercase"`
+	MinDigits     int  `yaml:"min_digits"      json:"min_digits"`
+	MinSpecial    int  `yaml:"min_special"     json:"min_special"`
+	HistoryCount  int  `yaml:"history_count"   json:"history_count"`
+	MinAgeSeconds int  `yaml:"min_age_seconds" json:"min_age_seconds"`
+	MaxAgeSeconds int  `yaml:"max_age_seconds" json:"max_age_seconds"`
+	CheckBreached bool `yaml:"check_breached"  json:"check_breached"`
+}
+
+// BreachedPasswordConfig holds the breached-password corpus queried with the SHA-1 k-anonymity
+// model. Source is either "file", a local file of "HASH:COUNT" lines, or "range_api", an HTTP API
+// that returns the hash suffixes for a five-character hash prefix appended to RangeAPIURL. The file
+// is indexed in memory at startup, and a file larger than MaxFileSizeMB is rejected.
+type BreachedPasswordConfig struct {
+	Source         string `yaml:"source"           json:"source"`
+	FilePath       string `yaml:"file_path"        json:"file_path"`
+	MaxFileSizeMB  int    `yaml:"max_file_size_mb" json:"max_file_size_mb"`
+	RangeAPIURL    string `yaml:"range_api_url"    json:"range_api_url"`
+	TimeoutSeconds int    `yaml:"timeout_seconds"  json:"timeout_seconds"`
+}
+
+// CaptchaConfig holds the built-in captcha validation provider used by the captcha interceptor.
+// Provider is one of "recaptcha_v3", "hcaptcha" or "turnstile"; an empty provider disables the
+// built-in provider. MinScore and StepUpScore apply to providers that return a score (reCAPTCHA
+// v3): tokens scoring below MinScore are rejected, and tokens scoring below StepUpScore are
+// accepted but flagged for step-up in the flow context.
+type CaptchaConfig struct {
+	Provider          string   `yaml:"provider"           json:"provider"`
+	SecretKey         string   `yaml:"secret_key"         json:"secret_key"`
+	VerifyURL         string   `yaml:"verify_url"         json:"verify_url"`
+	MinScore          float64  `yaml:"min_score"          json:"min_score"`
+	StepUpScore       float64  `yaml:"step_up_score"      json:"step_up_score"`
+	ExpectedHostnames []string `yaml:"expected_hostnames" json:"expected_hostnames"`
+	ExpectedAction    string   `yaml:"expected_action"    json:"expected_action"`
+	TimeoutSeconds    int      `yaml:"timeout_seconds"    json:"timeout_seconds"`
+}
+
 // AttestationConfig holds engine-level platform attestation configuration shared across
 // applications.
 type AttestationConfig struct {
@@ -403,6 +513,22 @@ type RoleConfig struct {
 	Store string `yaml:"store" json:"store"`
 }
 
+// AuthorizationConfig holds the authorization engine configuration.
+type AuthorizationConfig struct {
+	// Engines lists the authorization engines that evaluate access requests.
+	// Valid values: "rbac", "abac", "rebac". Defaults to ["rbac"] when not specified.
+	Engines []string `yaml:"engines" json:"engines"`
+	// CombiningAlgorithm defines how the decisions of multiple engines are combined.
+	// Valid values: "deny-overrides", "permit-overrides". Defaults to "deny-overrides".
+	CombiningAlgorithm string `yaml:"combining_algorithm" json:"combining_algorithm"`
+	// Store defines the storage mode for ABAC policies.
+	// Valid values: "mutable", "declarative", "composite" (hybrid mode)
+	// If not specified, falls back to global DeclarativeResources.Enabled setting:
+	//   - If DeclarativeResources.Enabled = true: behaves as "declarative"
+	//   - If DeclarativeResources.Enabled = false: behaves as "mutable"
+	Store string `yaml:"store" json:"store"`
+}
+
 // ThemeConfig holds the theme service configuration.
 type ThemeConfig struct {
 	// Store defines the storage mode for themes.
@@ -594,6 +720,10 @@ type Config struct {
 	EntityType           EntityTypeConfig                  `yaml:"user_type"             json:"user_type"`
 	Observability        engineconfig.ObservabilityConfig  `yaml:"observability"         json:"observability"`
 	Passkey              PasskeyConfig                     `yaml:"passkey"               json:"passkey"`
+	TOTP                 TOTPConfig                        `yaml:"totp"                  json:"totp"`
+	AccountLockout       AccountLockoutConfig              `yaml:"account_lockout"       json:"account_lockout"`
+	PasswordPolicy       PasswordPolicyConfig              `yaml:"password_policy"       json:"password_policy"`
+	Captcha              CaptchaConfig                     `yaml:"captcha"               json:"captcha"`
 	Attestation          AttestationConfig                 `yaml:"attestation"           json:"attestation"`
 	OpenID4VP            OpenID4VPConfig                   `yaml:"openid4vp"             json:"openid4vp"`
 	OpenID4VCI           OpenID4VCIConfig                  `yaml:"openid4vci"            json:"openid4vci"`
@@ -602,6 +732,7 @@ type Config struct {
 	EntityProvider       EntityProviderConfig              `yaml:"entity_provider"       json:"entity_provider"`
 	Group                GroupConfig                       `yaml:"group"                 json:"group"`
 	Role                 RoleConfig                        `yaml:"role"                  json:"role"`
+	Authorization        AuthorizationConfig               `yaml:"authorization"         json:"authorization"`
 	Theme                ThemeConfig                       `yaml:"theme"                 json:"theme"`
 	Layout               LayoutConfig                      `yaml:"layout"                json:"layout"`
 	Translation          TranslationConfig                 `yaml:"translation"           json:"translation"`
@@ -683,6 +814,9 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 		if cfg.GateClient.CallbackPath == "" {
 			cfg.GateClient.CallbackPath = urlpath.Join(cfg.GateClient.Path, "callback")
 		}
+		if cfg.GateClient.DevicePath == "" {
+			cfg.GateClient.DevicePath = urlpath.Join(cfg.GateClient.Path, "device")
+		}
 	}
 
 	// Derive JWT issuer from server config if not set
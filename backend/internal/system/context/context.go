@@ -18,6 +18,9 @@ const (
 
 	// CSPNonceKey is the context key for storing the per-request Content-Security-Policy nonce.
 	CSPNonceKey contextKey = "csp_nonce"
+
+	// ClientIPKey is the context key for storing the IP address of the client that sent the request.
+	ClientIPKey contextKey = "client_ip"
 )
 
 // ============================================================================
@@ -106,3 +109,24 @@ func WithCSPNonce(ctx context.Context, nonce string) context.Context {
 	}
 	return context.WithValue(ctx, CSPNonceKey, nonce)
 }
+
+// ============================================================================
+// Client IP Functions
+// ============================================================================
+
+// GetClientIP retrieves the IP address of the requesting client from the context. Returns "" if absent.
+func GetClientIP(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	clientIP, _ := ctx.Value(ClientIPKey).(string)
+	return clientIP
+}
+
+// WithClientIP adds the IP address of the requesting client to the context.
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ClientIPKey, clientIP)
+}
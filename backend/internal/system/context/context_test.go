@@ -264,3 +264,21 @@ func (s *ContextTestSuite) TestWithCSPNonce_NilContext() {
 	ctx := WithCSPNonce(nil, "abc123") //nolint:staticcheck // Testing nil context handling
 	s.Equal("abc123", GetCSPNonce(ctx))
 }
+
+func (s *ContextTestSuite) TestGetClientIP_WithNilContext() {
+	s.Equal("", GetClientIP(nil)) //nolint:staticcheck // Testing nil context handling
+}
+
+func (s *ContextTestSuite) TestGetClientIP_WithEmptyContext() {
+	s.Equal("", GetClientIP(context.Background()))
+}
+
+func (s *ContextTestSuite) TestWithClientIP() {
+	ctx := WithClientIP(context.Background(), "192.0.2.10")
+	s.Equal("192.0.2.10", GetClientIP(ctx))
+}
+
+func (s *ContextTestSuite) TestWithClientIP_NilContext() {
+	ctx := WithClientIP(nil, "192.0.2.10") //nolint:staticcheck // Testing nil context handling
+	s.Equal("192.0.2.10", GetClientIP(ctx))
+}
@@ -11,10 +11,13 @@ import (
 	"crypto/subtle"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"hash"
 
 	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
 )
 
 const (
@@ -32,14 +35,21 @@ const (
 	PBKDF2 CredAlgorithm = "PBKDF2"
 	// ARGON2ID represents the Argon2id key derivation function.
 	ARGON2ID CredAlgorithm = "ARGON2ID"
+	// BCRYPT represents the bcrypt password hashing function. It is supported for verification only.
+	BCRYPT CredAlgorithm = "BCRYPT"
+	// SCRYPT represents the scrypt key derivation function. It is supported for verification only.
+	SCRYPT CredAlgorithm = "SCRYPT"
 )
 
-// CredParameters holds the parameters for credential hashing algorithms.
+// CredParameters holds the parameters for credential hashing algorithms. For scrypt, Iterations is
+// the CPU/memory cost N, BlockSize is r and Parallelism is p. Bcrypt hashes carry their own cost and
+// salt, so no parameters are needed.
 type CredParameters struct {
 	Iterations  int
 	Parallelism int
 	Memory      int
 	KeySize     int
+	BlockSize   int
 	Salt        string
 }
 
@@ -75,9 +85,15 @@ type HashConfig struct {
 }
 
 // HashServiceInterface defines the interface for credential hashing services.
+// Generate always uses the configured algorithm, while Verify accepts a reference credential hashed
+// with any supported algorithm so that credentials created under an earlier configuration, or
+// imported from another system, keep working.
 type HashServiceInterface interface {
 	Generate(credentialValue []byte) (Credential, error)
 	Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error)
+	// NeedsRehash reports whether the reference credential was hashed with a different algorithm
+	// or parameters than the ones Generate currently uses.
+	NeedsRehash(referenceCredential Credential) bool
 }
 
 // Initialize returns a HashServiceInterface configured according to cfg.
@@ -86,6 +102,19 @@ func Initialize(cfg HashConfig) (HashServiceInterface, error) {
 	return newHashService(cfg)
 }
 
+// credentialHasher generates and verifies credentials for a single algorithm.
+type credentialHasher interface {
+	Generate(credentialValue []byte) (Credential, error)
+	Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error)
+}
+
+// hashService generates credentials with the configured algorithm and verifies credentials of
+// every supported algorithm.
+type hashService struct {
+	config    HashConfig
+	generator credentialHasher
+}
+
 type sha256HashProvider struct {
 	SaltSize int
 }
@@ -105,6 +134,60 @@ type argon2idHashProvider struct {
 }
 
 func newHashService(cfg HashConfig) (HashServiceInterface, error) {
+	generator, err := newGenerator(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &hashService{config: cfg, generator: generator}, nil
+}
+
+func (h *hashService) Generate(credentialValue []byte) (Credential, error) {
+	return h.generator.Generate(credentialValue)
+}
+
+// Verify verifies the credential value with the algorithm and parameters of the reference credential.
+func (h *hashService) Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	var verifier credentialHasher
+	switch referenceCredential.Algorithm {
+	case SHA256:
+		verifier = &sha256HashProvider{}
+	case PBKDF2:
+		verifier = &pbkdf2HashProvider{}
+	case ARGON2ID:
+		verifier = &argon2idHashProvider{}
+	case BCRYPT:
+		return verifyBcrypt(credentialValueToVerify, referenceCredential)
+	case SCRYPT:
+		return verifyScrypt(credentialValueToVerify, referenceCredential)
+	default:
+		return false, fmt.Errorf("unsupported hash algorithm: %s", referenceCredential.Algorithm)
+	}
+	return verifier.Verify(credentialValueToVerify, referenceCredential)
+}
+
+// NeedsRehash reports whether the reference credential differs from what Generate would produce in
+// algorithm, salt size or algorithm parameters.
+func (h *hashService) NeedsRehash(referenceCredential Credential) bool {
+	if referenceCredential.Algorithm != h.config.Algorithm {
+		return true
+	}
+	params := referenceCredential.Parameters
+	if len(params.Salt) != hex.EncodedLen(h.config.SaltSize) {
+		return true
+	}
+	switch h.config.Algorithm {
+	case PBKDF2:
+		return params.Iterations != h.config.Iterations || params.KeySize != h.config.KeySize
+	case ARGON2ID:
+		return params.Iterations != h.config.Iterations || params.Memory != h.config.Memory ||
+			params.Parallelism != h.config.Parallelism || params.KeySize != h.config.KeySize
+	default:
+		return false
+	}
+}
+
+// newGenerator validates cfg and returns the hasher for the configured algorithm.
+func newGenerator(cfg HashConfig) (credentialHasher, error) {
 	switch cfg.Algorithm {
 	case SHA256:
 		if err := validatePositiveInt(cfg.SaltSize, "salt size"); err != nil {
@@ -139,6 +222,8 @@ func newHashService(cfg HashConfig) (HashServiceInterface, error) {
 			return nil, err
 		}
 		return newArgon2idProvider(cfg.SaltSize, cfg.Memory, cfg.Iterations, cfg.Parallelism, cfg.KeySize), nil
+	case BCRYPT, SCRYPT:
+		return nil, fmt.Errorf("hash algorithm %s is supported for verification only", cfg.Algorithm)
 	default:
 		return nil, fmt.Errorf("unsupported hash algorithm: %s", cfg.Algorithm)
 	}
@@ -315,6 +400,52 @@ func (a *argon2idHashProvider) Verify(credentialValueToVerify []byte, referenceC
 	return subtle.ConstantTimeCompare(h, referenceHash) == 1, nil
 }
 
+// verifyBcrypt verifies the credential value against a bcrypt hash in modular crypt format
+// (for example $2a$10$...), which embeds the cost and salt.
+func verifyBcrypt(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(referenceCredential.Hash), credentialValueToVerify)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, err
+}
+
+// verifyScrypt verifies the credential value against a hex encoded scrypt key.
+func verifyScrypt(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	cost, err := requirePositiveInt(referenceCredential.Parameters.Iterations, "iterations")
+	if err != nil {
+		return false, err
+	}
+	blockSize, err := requirePositiveInt(referenceCredential.Parameters.BlockSize, "block size")
+	if err != nil {
+		return false, err
+	}
+	parallelism, err := requirePositiveInt(referenceCredential.Parameters.Parallelism, "parallelism")
+	if err != nil {
+		return false, err
+	}
+	keySize, err := requirePositiveInt(referenceCredential.Parameters.KeySize, "key size")
+	if err != nil {
+		return false, err
+	}
+	saltBytes, err := decodeSalt(referenceCredential.Parameters.Salt)
+	if err != nil {
+		return false, err
+	}
+	h, err := scrypt.Key(credentialValueToVerify, saltBytes, cost, blockSize, parallelism, keySize)
+	if err != nil {
+		return false, err
+	}
+	referenceHash, err := hex.DecodeString(referenceCredential.Hash)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare(h, referenceHash) == 1, nil
+}
+
 // GenerateThumbprint generates a SHA-256 thumbprint for the given data.
 func GenerateThumbprint(data []byte) string {
 	h := sha256.Sum256(data)
@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
 )
@@ -1137,6 +1139,115 @@ func (suite *HashServiceTestSuite) TestUnsupportedAlgorithmVerify_Failure() {
 	assert.False(suite.T(), ok)
 }
 
+func (suite *HashServiceTestSuite) TestVerifyBcrypt() {
+	hashService, err := Initialize(HashConfig{Algorithm: SHA256, SaltSize: defaultSaltSize})
+	require.NoError(suite.T(), err)
+	legacyHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(suite.T(), err)
+	credential := Credential{Algorithm: BCRYPT, Hash: string(legacyHash)}
+
+	ok, err := hashService.Verify([]byte("password"), credential)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+
+	ok, err = hashService.Verify([]byte("wrong-password"), credential)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+
+	ok, err = hashService.Verify([]byte("password"), Credential{Algorithm: BCRYPT, Hash: "not-a-bcrypt-hash"})
+	assert.Error(suite.T(), err)
+	assert.False(suite.T(), ok)
+}
+
+func (suite *HashServiceTestSuite) TestVerifyScrypt() {
+	hashService, err := Initialize(HashConfig{Algorithm: SHA256, SaltSize: defaultSaltSize})
+	require.NoError(suite.T(), err)
+	salt := "36d2dde7dfbafe8e04ea49450f659b1c"
+	saltBytes, err := hex.DecodeString(salt)
+	require.NoError(suite.T(), err)
+	key, err := scrypt.Key([]byte("password"), saltBytes, 1024, 8, 1, 32)
+	require.NoError(suite.T(), err)
+	credential := Credential{
+		Algorithm: SCRYPT,
+		Hash:      hex.EncodeToString(key),
+		Parameters: CredParameters{
+			Iterations:  1024,
+			BlockSize:   8,
+			Parallelism: 1,
+			KeySize:     32,
+			Salt:        salt,
+		},
+	}
+
+	ok, err := hashService.Verify([]byte("password"), credential)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+
+	ok, err = hashService.Verify([]byte("wrong-password"), credential)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+
+	credential.Parameters.BlockSize = 0
+	ok, err = hashService.Verify([]byte("password"), credential)
+	assert.Error(suite.T(), err)
+	assert.False(suite.T(), ok)
+}
+
+func (suite *HashServiceTestSuite) TestVerifyCredentialOfAnotherAlgorithm() {
+	hashService, err := Initialize(HashConfig{
+		Algorithm:   ARGON2ID,
+		SaltSize:    defaultSaltSize,
+		Memory:      defaultArgon2idMemory,
+		Iterations:  defaultArgon2idIterations,
+		Parallelism: defaultArgon2idParallelism,
+		KeySize:     defaultArgon2idKeySize,
+	})
+	require.NoError(suite.T(), err)
+
+	ok, err := hashService.Verify([]byte("password"), Credential{
+		Algorithm:  SHA256,
+		Hash:       sha256Hex("password", "12f4576d7432bd8020db7202b6492a37"),
+		Parameters: CredParameters{Salt: "12f4576d7432bd8020db7202b6492a37"},
+	})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+}
+
+func (suite *HashServiceTestSuite) TestInitializeVerificationOnlyAlgorithm_Failure() {
+	for _, alg := range []CredAlgorithm{BCRYPT, SCRYPT} {
+		_, err := Initialize(HashConfig{Algorithm: alg, SaltSize: defaultSaltSize})
+		assert.Error(suite.T(), err, "%s should not be usable for generating credentials", alg)
+	}
+}
+
+func (suite *HashServiceTestSuite) TestNeedsRehash() {
+	hashService, err := Initialize(HashConfig{
+		Algorithm:  PBKDF2,
+		SaltSize:   defaultSaltSize,
+		Iterations: defaultPBKDF2Iterations,
+		KeySize:    defaultPBKDF2KeySize,
+	})
+	require.NoError(suite.T(), err)
+	current := Credential{
+		Algorithm: PBKDF2,
+		Parameters: CredParameters{
+			Iterations: defaultPBKDF2Iterations,
+			KeySize:    defaultPBKDF2KeySize,
+			Salt:       "36d2dde7dfbafe8e04ea49450f659b1c",
+		},
+	}
+
+	fewerIterations := current
+	fewerIterations.Parameters.Iterations = 10000
+	shorterSalt := current
+	shorterSalt.Parameters.Salt = "36d2dde7"
+
+	assert.False(suite.T(), hashService.NeedsRehash(current))
+	assert.True(suite.T(), hashService.NeedsRehash(fewerIterations))
+	assert.True(suite.T(), hashService.NeedsRehash(shorterSalt))
+	assert.True(suite.T(), hashService.NeedsRehash(Credential{Algorithm: BCRYPT, Hash: "$2a$10$abc"}))
+}
+
 type InitTestSuite struct {
 	suite.Suite
 }
@@ -0,0 +1,38 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+// Names of the server databases. Each name is also the directory that holds the scripts of the database
+// under dbscripts.
+const (
+	DatabaseConfig            = "configdb"
+	DatabaseRuntimeTransient  = "runtime_transient"
+	DatabaseEntity            = "entitydb"
+	DatabaseRuntimePersistent = "runtime_persistent"
+)
+
+// baselineVersion is the schema of the databases created before schema versioning was introduced.
+// Unversioned databases are recorded at this version and brought up to date by the migrations; the
+// full-schema sqlite.sql, postgres.sql and mysql.sql scripts record every version up to the required one.
+const baselineVersion = 1
+
+// Schema versions this build of the server runs against. Bump the version of a database together with
+// the migration scripts that bring its schema to that version and the baseline insert of its full-schema
+// scripts.
+const (
+	configDBSchemaVersion            = 6
+	runtimeTransientDBSchemaVersion  = 2
+	entityDBSchemaVersion            = 1
+	runtimePersistentDBSchemaVersion = 5
+)
+
+// migrationsDir is the directory, relative to the scripts of a database, that holds its migrations.
+// Migrations are kept in a sub directory per database type, e.g. migrations/postgres/0002_add_x.up.sql.
+const migrationsDir = "migrations"
+
+// Suffixes of the migration script files.
+const (
+	upScriptSuffix   = ".up.sql"
+	downScriptSuffix = ".down.sql"
+)
@@ -0,0 +1,100 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package migration applies versioned schema migrations to the server databases and checks that each
+// database is at the schema version the server requires.
+package migration
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// Initialize creates the migration service for the SQL databases configured for the server. scriptsDir
+// is the dbscripts directory that holds the migrations of each database. A Redis runtime transient
+// store and an unconfigured runtime persistent database are not managed. A MySQL database is migrated
+// through a dedicated client that allows multiple statements per query, so the clients used at
+// runtime keep running a single statement per query. The caller must close the service.
+func Initialize(scriptsDir string) (MigrationServiceInterface, error) {
+	dbConfig := config.GetServerRuntime().Config.Database
+	dbProvider := provider.GetDBProvider()
+	definitions := []struct {
+		name            string
+		requiredVersion int
+		dataSource      config.DataSource
+		getClient       func() (provider.DBClientInterface, error)
+	}{
+		{DatabaseConfig, configDBSchemaVersion, dbConfig.Config, dbProvider.GetConfigDBClient},
+		{DatabaseRuntimeTransient, runtimeTransientDBSchemaVersion, dbConfig.RuntimeTransient,
+			dbProvider.GetRuntimeTransientDBClient},
+		{DatabaseEntity, entityDBSchemaVersion, dbConfig.Entity, dbProvider.GetEntityDBClient},
+		{DatabaseRuntimePersistent, runtimePersistentDBSchemaVersion, dbConfig.RuntimePersistent,
+			dbProvider.GetRuntimePersistentDBClient},
+	}
+
+	scripts := os.DirFS(scriptsDir)
+	databases := make([]managedDatabase, 0, len(definitions))
+	for _, def := range definitions {
+		if def.dataSource.Type == "" || def.dataSource.Type == provider.DataSourceTypeRedis {
+			continue
+		}
+
+		migrations, err := loadMigrations(scripts, path.Join(def.name, migrationsDir, def.dataSource.Type))
+		if err != nil {
+			closeScriptClients(databases)
+			return nil, fmt.Errorf("failed to load the migrations of %s: %w", def.name, err)
+		}
+		db, err := newManagedDatabase(def.name, def.requiredVersion, migrations, def.dataSource, def.getClient)
+		if err != nil {
+			closeScriptClients(databases)
+			return nil, err
+		}
+		databases = append(databases, *db)
+	}
+	return newMigrationService(databases), nil
+}
+
+// newManagedDatabase creates the managed database of a data source. A MySQL database gets a dedicated
+// script client; the other databases run their scripts on the client of the database provider.
+func newManagedDatabase(name string, requiredVersion int, migrations []Migration, dataSource config.DataSource,
+	getClient func() (provider.DBClientInterface, error)) (*managedDatabase, error) {
+	var dbClient provider.DBClientInterface
+	var closeClient func() error
+	if dataSource.Type == provider.DataSourceTypeMySQL {
+		scriptClient, err := provider.OpenScriptDBClient(dataSource, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open the %s script client: %w", name, err)
+		}
+		dbClient, closeClient = scriptClient, scriptClient.Close
+	} else {
+		providerClient, err := getClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the %s database client: %w", name, err)
+		}
+		dbClient = providerClient
+	}
+
+	transactioner, err := dbClient.GetTransactioner()
+	if err != nil {
+		if closeClient != nil {
+			_ = closeClient()
+		}
+		return nil, fmt.Errorf("failed to get the %s transactioner: %w", name, err)
+	}
+	return &managedDatabase{
+		name:            name,
+		requiredVersion: requiredVersion,
+		migrations:      migrations,
+		store:           newSchemaVersionStore(name, dbClient, transactioner),
+		closeClient:     closeClient,
+	}, nil
+}
+
+// closeScriptClients closes the dedicated clients of databases that were set up before a failure.
+func closeScriptClients(databases []managedDatabase) {
+	_ = newMigrationService(databases).Close()
+}
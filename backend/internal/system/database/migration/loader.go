@@ -0,0 +1,97 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// loadMigrations reads the migrations in dir of fsys, ordered by version. Each migration is an
+// NNNN_description.up.sql script with an optional NNNN_description.down.sql counterpart. A missing
+// directory means the database has no migrations.
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read migrations directory %s: %w", dir, err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	downScripts := make(map[int]string)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".sql") {
+			continue
+		}
+
+		isDown := strings.HasSuffix(name, downScriptSuffix)
+		if !isDown && !strings.HasSuffix(name, upScriptSuffix) {
+			return nil, fmt.Errorf("migration %s must end with %s or %s", name, upScriptSuffix, downScriptSuffix)
+		}
+		version, description, err := parseMigrationName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", name, err)
+		}
+
+		if isDown {
+			if _, exists := downScripts[version]; exists {
+				return nil, fmt.Errorf("duplicate down migration for version %d", version)
+			}
+			downScripts[version] = string(content)
+			continue
+		}
+		if _, exists := byVersion[version]; exists {
+			return nil, fmt.Errorf("duplicate migration for version %d", version)
+		}
+		byVersion[version] = &Migration{Version: version, Description: description, Up: string(content)}
+	}
+
+	for version, down := range downScripts {
+		m, exists := byVersion[version]
+		if !exists {
+			return nil, fmt.Errorf("down migration for version %d has no up migration", version)
+		}
+		m.Down = down
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// parseMigrationName extracts the version and description from a migration file name such as
+// 0002_add_user_index.up.sql. Versions start after the baseline version.
+func parseMigrationName(name string) (int, string, error) {
+	base := strings.TrimSuffix(strings.TrimSuffix(name, upScriptSuffix), downScriptSuffix)
+	versionPart, descriptionPart, found := strings.Cut(base, "_")
+	if !found || descriptionPart == "" {
+		return 0, "", fmt.Errorf("migration %s must be named NNNN_description%s", name, upScriptSuffix)
+	}
+
+	version, err := strconv.Atoi(versionPart)
+	if err != nil {
+		return 0, "", fmt.Errorf("migration %s has an invalid version: %w", name, err)
+	}
+	if version <= baselineVersion {
+		return 0, "", fmt.Errorf("migration %s must have a version greater than the baseline version %d",
+			name, baselineVersion)
+	}
+	return version, strings.ReplaceAll(descriptionPart, "_", " "), nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LoaderTestSuite struct {
+	suite.Suite
+}
+
+func TestLoaderTestSuite(t *testing.T) {
+	suite.Run(t, new(LoaderTestSuite))
+}
+
+func (suite *LoaderTestSuite) TestLoadMigrations_OrdersAndPairsScripts() {
+	fsys := fstest.MapFS{
+		"configdb/migrations/sqlite/0003_add_index.up.sql":        {Data: []byte("CREATE INDEX i ON t (c);")},
+		"configdb/migrations/sqlite/0002_add_table.up.sql":        {Data: []byte("CREATE TABLE t (c TEXT);")},
+		"configdb/migrations/sqlite/0002_add_table.down.sql":      {Data: []byte("DROP TABLE t;")},
+		"configdb/migrations/sqlite/README.md":                    {Data: []byte("notes")},
+		"configdb/migrations/postgres/0002_add_table.up.sql":      {Data: []byte("CREATE TABLE t (c TEXT);")},
+		"configdb/migrations/postgres/0004_not_for_sqlite.up.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	migrations, err := loadMigrations(fsys, "configdb/migrations/sqlite")
+	suite.Require().NoError(err)
+	suite.Require().Len(migrations, 2)
+	suite.Equal(2, migrations[0].Version)
+	suite.Equal("add table", migrations[0].Description)
+	suite.Equal("DROP TABLE t;", migrations[0].Down)
+	suite.Equal(3, migrations[1].Version)
+	suite.Empty(migrations[1].Down)
+}
+
+func (suite *LoaderTestSuite) TestLoadMigrations_MissingDirectory() {
+	migrations, err := loadMigrations(fstest.MapFS{}, "configdb/migrations/sqlite")
+	suite.NoError(err)
+	suite.Empty(migrations)
+}
+
+func (suite *LoaderTestSuite) TestLoadMigrations_InvalidScripts() {
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+	}{
+		{"MissingDescription", fstest.MapFS{"m/0002.up.sql": {}}},
+		{"InvalidVersion", fstest.MapFS{"m/two_add_table.up.sql": {}}},
+		{"BaselineVersion", fstest.MapFS{"m/0001_baseline.up.sql": {}}},
+		{"MissingDirection", fstest.MapFS{"m/0002_add_table.sql": {}}},
+		{"DuplicateVersion", fstest.MapFS{"m/0002_add_table.up.sql": {}, "m/0002_add_index.up.sql": {}}},
+		{"DownWithoutUp", fstest.MapFS{"m/0002_add_table.down.sql": {}}},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			_, err := loadMigrations(tc.files, "m")
+			suite.Error(err)
+		})
+	}
+}
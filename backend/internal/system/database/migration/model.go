@@ -0,0 +1,62 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Migration is one versioned change to the schema of a database.
+type Migration struct {
+	Version     int
+	Description string
+	// Up holds the SQL that applies the migration.
+	Up string
+	// Down holds the SQL that reverts the migration. It is empty when the migration cannot be reverted.
+	Down string
+}
+
+// Checksum returns the hex encoded SHA-256 hash of the up script. It is recorded when the migration is
+// applied so that later edits to an applied script are detected.
+func (m Migration) Checksum() string {
+	sum := sha256.Sum256([]byte(m.Up))
+	return hex.EncodeToString(sum[:])
+}
+
+// AppliedVersion is a schema version recorded in the SCHEMA_VERSION table of a database.
+type AppliedVersion struct {
+	Version     int
+	Description string
+	// Checksum is the checksum of the applied up script. It is empty for the baseline version, which is
+	// created by the full-schema scripts rather than by a migration.
+	Checksum  string
+	AppliedAt time.Time
+}
+
+// DatabaseStatus describes the schema version of a database relative to the version the server requires.
+type DatabaseStatus struct {
+	Database string
+	// Versioned is false when the database has no SCHEMA_VERSION table.
+	Versioned       bool
+	CurrentVersion  int
+	RequiredVersion int
+	// Pending lists the migrations that bring the database to the required version.
+	Pending []Migration
+}
+
+// State returns a short description of how the current schema version relates to the required one.
+func (s DatabaseStatus) State() string {
+	switch {
+	case !s.Versioned:
+		return "unversioned"
+	case s.CurrentVersion < s.RequiredVersion:
+		return "outdated"
+	case s.CurrentVersion > s.RequiredVersion:
+		return "newer than supported"
+	default:
+		return "up to date"
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"database/sql"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	_ "modernc.org/sqlite"
+)
+
+// dbScriptsDir is the location of the dbscripts directory relative to this package.
+const dbScriptsDir = "../../../../dbscripts"
+
+// baselineScriptsDir holds the SQLite schemas of the databases at the baseline version.
+const baselineScriptsDir = "testdata/baseline"
+
+// whitespace matches runs of whitespace in schema definitions.
+var whitespace = regexp.MustCompile(`\s+`)
+
+// ScriptsTestSuite checks that the shipped schema scripts agree with the schema versions the server
+// requires.
+type ScriptsTestSuite struct {
+	suite.Suite
+}
+
+func TestScriptsTestSuite(t *testing.T) {
+	suite.Run(t, new(ScriptsTestSuite))
+}
+
+func (suite *ScriptsTestSuite) requiredVersions() map[string]int {
+	return map[string]int{
+		DatabaseConfig:            configDBSchemaVersion,
+		DatabaseRuntimeTransient:  runtimeTransientDBSchemaVersion,
+		DatabaseEntity:            entityDBSchemaVersion,
+		DatabaseRuntimePersistent: runtimePersistentDBSchemaVersion,
+	}
+}
+
+func (suite *ScriptsTestSuite) TestMigrationsReachRequiredVersion() {
+	for database, requiredVersion := range suite.requiredVersions() {
+		for _, dbType := range []string{"sqlite", "postgres", "mysql"} {
+			suite.Run(database+"/"+dbType, func() {
+				migrations, err := loadMigrations(os.DirFS(dbScriptsDir), path.Join(database, migrationsDir, dbType))
+				suite.Require().NoError(err)
+
+				version := baselineVersion
+				for _, m := range migrations {
+					suite.Equal(version+1, m.Version, "migration versions must be consecutive")
+					version = m.Version
+				}
+				suite.Equal(requiredVersion, version)
+			})
+		}
+	}
+}
+
+func (suite *ScriptsTestSuite) TestFullSchemaRecordsRequiredVersion() {
+	for database, requiredVersion := range suite.requiredVersions() {
+		suite.Run(database, func() {
+			script, err := os.ReadFile(path.Join(dbScriptsDir, database, "sqlite.sql"))
+			suite.Require().NoError(err)
+
+			db, err := sql.Open("sqlite", ":memory:")
+			suite.Require().NoError(err)
+			defer func() { _ = db.Close() }()
+
+			_, err = db.Exec(string(script))
+			suite.Require().NoError(err)
+
+			var version int
+			suite.Require().NoError(db.QueryRow(`SELECT MAX(VERSION) FROM "SCHEMA_VERSION"`).Scan(&version))
+			suite.Equal(requiredVersion, version)
+		})
+	}
+}
+
+func (suite *ScriptsTestSuite) TestMigrationsUpgradeBaselineSchema() {
+	for database := range suite.requiredVersions() {
+		suite.Run(database, func() {
+			migrations, err := loadMigrations(os.DirFS(dbScriptsDir), path.Join(database, migrationsDir, "sqlite"))
+			suite.Require().NoError(err)
+
+			migrated := suite.openScript(path.Join(baselineScriptsDir, database, "sqlite.sql"))
+			defer func() { _ = migrated.Close() }()
+			baseline := suite.schemaOf(migrated)
+			for _, m := range migrations {
+				suite.execScript(migrated, m.Up, "migration %d", m.Version)
+			}
+
+			current := suite.openScript(path.Join(dbScriptsDir, database, "sqlite.sql"))
+			defer func() { _ = current.Close() }()
+			suite.Equal(suite.schemaOf(current), suite.schemaOf(migrated),
+				"the migrations must bring the baseline schema to the full schema")
+
+			for i := len(migrations) - 1; i >= 0; i-- {
+				suite.execScript(migrated, migrations[i].Down, "down migration %d", migrations[i].Version)
+			}
+			suite.Equal(baseline, suite.schemaOf(migrated), "the down migrations must restore the baseline schema")
+		})
+	}
+}
+
+// openScript opens an in-memory SQLite database created by the script at the given path.
+func (suite *ScriptsTestSuite) openScript(scriptPath string) *sql.DB {
+	script, err := os.ReadFile(scriptPath)
+	suite.Require().NoError(err)
+
+	db, err := sql.Open("sqlite", ":memory:")
+	suite.Require().NoError(err)
+	db.SetMaxOpenConns(1)
+	suite.execScript(db, string(script), "%s", scriptPath)
+	return db
+}
+
+// execScript runs a script that has statements.
+func (suite *ScriptsTestSuite) execScript(db *sql.DB, script string, msgAndArgs ...interface{}) {
+	if !hasStatements(script) {
+		return
+	}
+	_, err := db.Exec(script)
+	suite.Require().NoError(err, msgAndArgs...)
+}
+
+// schemaOf returns the definitions of the tables, indexes and triggers of a database, keyed by type and
+// name. The SCHEMA_VERSION table is left out as it is created outside of the migrations.
+func (suite *ScriptsTestSuite) schemaOf(db *sql.DB) map[string]string {
+	rows, err := db.Query(`SELECT TYPE, NAME, SQL FROM SQLITE_MASTER WHERE SQL IS NOT NULL ` +
+		`AND TBL_NAME <> 'SCHEMA_VERSION'`)
+	suite.Require().NoError(err)
+	defer func() { _ = rows.Close() }()
+
+	schema := make(map[string]string)
+	for rows.Next() {
+		var objectType, name, definition string
+		suite.Require().NoError(rows.Scan(&objectType, &name, &definition))
+		schema[objectType+" "+name] = whitespace.ReplaceAllString(strings.TrimSpace(definition), " ")
+	}
+	suite.Require().NoError(rows.Err())
+	return schema
+}
@@ -0,0 +1,281 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MigrationServiceInterface inspects and migrates the schemas of the server databases.
+type MigrationServiceInterface interface {
+	// Status returns the schema status of each database.
+	Status(ctx context.Context) ([]DatabaseStatus, error)
+	// Up brings each database to the schema version the server requires. Unversioned databases are
+	// first recorded at the baseline version. It returns the migrations applied so far, also on error.
+	Up(ctx context.Context) ([]MigrationResult, error)
+	// Down reverts the latest steps migrations of a database. It never reverts the baseline version.
+	Down(ctx context.Context, database string, steps int) (*MigrationResult, error)
+	// CheckCompatibility returns an error describing each database that is not at the schema version
+	// the server requires.
+	CheckCompatibility(ctx context.Context) error
+	// Close closes the database clients the service opened for running schema scripts.
+	Close() error
+}
+
+// MigrationResult lists the migrations applied to or reverted from a database.
+type MigrationResult struct {
+	Database string
+	// Baselined is true when the SCHEMA_VERSION table was created and the baseline version recorded.
+	Baselined  bool
+	Migrations []Migration
+}
+
+// managedDatabase is a database whose schema is managed by the migration service.
+type managedDatabase struct {
+	name            string
+	requiredVersion int
+	migrations      []Migration
+	store           schemaVersionStoreInterface
+	// closeClient closes the dedicated client the store runs on. It is nil when the store uses a
+	// client of the database provider.
+	closeClient func() error
+}
+
+// migrationService is the default implementation of MigrationServiceInterface.
+type migrationService struct {
+	databases []managedDatabase
+}
+
+// newMigrationService creates a migration service for the given databases.
+func newMigrationService(databases []managedDatabase) MigrationServiceInterface {
+	return &migrationService{databases: databases}
+}
+
+// Status returns the schema status of each database.
+func (s *migrationService) Status(ctx context.Context) ([]DatabaseStatus, error) {
+	statuses := make([]DatabaseStatus, 0, len(s.databases))
+	for _, db := range s.databases {
+		status, _, err := s.status(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, *status)
+	}
+	return statuses, nil
+}
+
+// Close closes the database clients the service opened for running schema scripts.
+func (s *migrationService) Close() error {
+	var errs []error
+	for _, db := range s.databases {
+		if db.closeClient == nil {
+			continue
+		}
+		if err := db.closeClient(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close the %s script client: %w", db.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Up brings each database to the schema version the server requires.
+func (s *migrationService) Up(ctx context.Context) ([]MigrationResult, error) {
+	results := make([]MigrationResult, 0, len(s.databases))
+	for _, db := range s.databases {
+		result, err := s.up(ctx, db)
+		if result != nil {
+			results = append(results, *result)
+		}
+		if err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// up brings a database to its required schema version.
+func (s *migrationService) up(ctx context.Context, db managedDatabase) (*MigrationResult, error) {
+	status, _, err := s.status(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if status.CurrentVersion > db.requiredVersion {
+		return nil, newerVersionError(status)
+	}
+
+	current := status.CurrentVersion
+	if !status.Versioned {
+		current = baselineVersion
+	}
+	for version := current + 1; version <= db.requiredVersion; version++ {
+		if _, found := findMigration(db.migrations, version); !found {
+			return nil, fmt.Errorf("migration %d of %s is required but its script was not found", version, db.name)
+		}
+	}
+
+	result := &MigrationResult{Database: db.name}
+	if !status.Versioned {
+		if err := db.store.InitializeVersioning(ctx); err != nil {
+			return nil, err
+		}
+		result.Baselined = true
+	}
+	for _, migration := range status.Pending {
+		if err := db.store.ApplyMigration(ctx, migration); err != nil {
+			return result, err
+		}
+		result.Migrations = append(result.Migrations, migration)
+	}
+	return result, nil
+}
+
+// Down reverts the latest steps migrations of a database.
+func (s *migrationService) Down(ctx context.Context, database string, steps int) (*MigrationResult, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf("the number of migrations to revert must be at least 1")
+	}
+	db, found := s.findDatabase(database)
+	if !found {
+		return nil, fmt.Errorf("database %q is not a configured SQL database", database)
+	}
+
+	status, applied, err := s.status(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if !status.Versioned {
+		return nil, fmt.Errorf("%s has no schema version to revert", db.name)
+	}
+
+	revertible := 0
+	for _, version := range applied {
+		if version.Version > baselineVersion {
+			revertible++
+		}
+	}
+	if steps > revertible {
+		return nil, fmt.Errorf("cannot revert %d migrations of %s: %d migrations are applied above the baseline",
+			steps, db.name, revertible)
+	}
+
+	toRevert := make([]Migration, 0, steps)
+	for i := len(applied) - 1; i >= len(applied)-steps; i-- {
+		migration, found := findMigration(db.migrations, applied[i].Version)
+		if !found {
+			return nil, fmt.Errorf("script of migration %d of %s was not found", applied[i].Version, db.name)
+		}
+		if migration.Down == "" {
+			return nil, fmt.Errorf("migration %d of %s has no down script", migration.Version, db.name)
+		}
+		toRevert = append(toRevert, migration)
+	}
+
+	result := &MigrationResult{Database: db.name}
+	for _, migration := range toRevert {
+		if err := db.store.RevertMigration(ctx, migration); err != nil {
+			return result, err
+		}
+		result.Migrations = append(result.Migrations, migration)
+	}
+	return result, nil
+}
+
+// CheckCompatibility returns an error describing each database that is not at the required version.
+func (s *migrationService) CheckCompatibility(ctx context.Context) error {
+	var errs []error
+	for _, db := range s.databases {
+		status, _, err := s.status(ctx, db)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		switch {
+		case !status.Versioned:
+			errs = append(errs, fmt.Errorf("%s has no schema version; run the migrate up command to record it",
+				status.Database))
+		case status.CurrentVersion < status.RequiredVersion:
+			errs = append(errs, fmt.Errorf("%s is at schema version %d but version %d is required; "+
+				"run the migrate up command", status.Database, status.CurrentVersion, status.RequiredVersion))
+		case status.CurrentVersion > status.RequiredVersion:
+			errs = append(errs, newerVersionError(status))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// status reads the schema status of a database and the versions recorded in it, and verifies that no
+// applied migration script has changed since it was applied.
+func (s *migrationService) status(ctx context.Context, db managedDatabase) (
+	*DatabaseStatus, []AppliedVersion, error) {
+	status := &DatabaseStatus{Database: db.name, RequiredVersion: db.requiredVersion}
+	versioned, err := db.store.IsVersioned(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var applied []AppliedVersion
+	if versioned {
+		applied, err = db.store.ListAppliedVersions(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := verifyChecksums(db, applied); err != nil {
+			return nil, nil, err
+		}
+		status.Versioned = true
+		if len(applied) > 0 {
+			status.CurrentVersion = applied[len(applied)-1].Version
+		}
+	}
+
+	for _, migration := range db.migrations {
+		if migration.Version > status.CurrentVersion && migration.Version <= db.requiredVersion {
+			status.Pending = append(status.Pending, migration)
+		}
+	}
+	return status, applied, nil
+}
+
+// findDatabase returns the managed database with the given name.
+func (s *migrationService) findDatabase(name string) (managedDatabase, bool) {
+	for _, db := range s.databases {
+		if db.name == name {
+			return db, true
+		}
+	}
+	return managedDatabase{}, false
+}
+
+// verifyChecksums returns an error when the script of an applied migration no longer matches the
+// checksum recorded when it was applied. Versions without a script, such as the baseline, are skipped.
+func verifyChecksums(db managedDatabase, applied []AppliedVersion) error {
+	for _, version := range applied {
+		if version.Checksum == "" {
+			continue
+		}
+		migration, found := findMigration(db.migrations, version.Version)
+		if found && migration.Checksum() != version.Checksum {
+			return fmt.Errorf("migration %d of %s was modified after it was applied", version.Version, db.name)
+		}
+	}
+	return nil
+}
+
+// findMigration returns the migration with the given version.
+func findMigration(migrations []Migration, version int) (Migration, bool) {
+	for _, migration := range migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+// newerVersionError describes a database whose schema is newer than the server supports.
+func newerVersionError(status *DatabaseStatus) error {
+	return fmt.Errorf("%s is at schema version %d, which is newer than version %d supported by this server",
+		status.Database, status.CurrentVersion, status.RequiredVersion)
+}
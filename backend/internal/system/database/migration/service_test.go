@@ -0,0 +1,209 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MigrationServiceTestSuite struct {
+	suite.Suite
+	mockStore  *schemaVersionStoreInterfaceMock
+	migrations []Migration
+}
+
+func TestMigrationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrationServiceTestSuite))
+}
+
+func (suite *MigrationServiceTestSuite) SetupTest() {
+	suite.mockStore = NewschemaVersionStoreInterfaceMock(suite.T())
+	suite.migrations = []Migration{
+		{Version: 2, Description: "add table", Up: "CREATE TABLE t (c TEXT);", Down: "DROP TABLE t;"},
+		{Version: 3, Description: "add index", Up: "CREATE INDEX i ON t (c);"},
+	}
+}
+
+func (suite *MigrationServiceTestSuite) newService(requiredVersion int) MigrationServiceInterface {
+	return newMigrationService([]managedDatabase{{
+		name:            DatabaseConfig,
+		requiredVersion: requiredVersion,
+		migrations:      suite.migrations,
+		store:           suite.mockStore,
+	}})
+}
+
+func (suite *MigrationServiceTestSuite) applied(versions ...int) []AppliedVersion {
+	result := []AppliedVersion{{Version: baselineVersion, Description: "baseline"}}
+	for _, version := range versions {
+		m, _ := findMigration(suite.migrations, version)
+		result = append(result, AppliedVersion{Version: version, Description: m.Description, Checksum: m.Checksum()})
+	}
+	return result
+}
+
+func (suite *MigrationServiceTestSuite) TestStatus() {
+	suite.mockStore.On("IsVersioned", mock.Anything).Return(true, nil)
+	suite.mockStore.On("ListAppliedVersions", mock.Anything).Return(suite.applied(2), nil)
+
+	statuses, err := suite.newService(3).Status(context.Background())
+	suite.Require().NoError(err)
+	suite.Require().Len(statuses, 1)
+	suite.Equal(2, statuses[0].CurrentVersion)
+	suite.Equal(3, statuses[0].RequiredVersion)
+	suite.Equal("outdated", statuses[0].State())
+	suite.Require().Len(statuses[0].Pending, 1)
+	suite.Equal(3, statuses[0].Pending[0].Version)
+}
+
+func (suite *MigrationServiceTestSuite) TestStatus_ModifiedMigration() {
+	applied := suite.applied(2)
+	applied[1].Checksum = "changed"
+	suite.mockStore.On("IsVersioned", mock.Anything).Return(true, nil)
+	suite.mockStore.On("ListAppliedVersions", mock.Anything).Return(applied, nil)
+
+	_, err := suite.newService(3).Status(context.Background())
+	suite.ErrorContains(err, "modified after it was applied")
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_BaselinesUnversionedDatabase() {
+	suite.mockStore.On("IsVersioned", mock.Anything).Return(false, nil)
+	suite.mockStore.On("InitializeVersioning", mock.Anything).Return(nil)
+	suite.mockStore.On("ApplyMigration", mock.Anything, suite.migrations[0]).Return(nil).Once()
+	suite.mockStore.On("ApplyMigration", mock.Anything, suite.migrations[1]).Return(nil).Once()
+
+	results, err := suite.newService(3).Up(context.Background())
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 1)
+	suite.True(results[0].Baselined)
+	suite.Len(results[0].Migrations, 2)
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_StopsAtRequiredVersion() {
+	suite.mockStore.On("IsVersioned", mock.Anything).Return(true, nil)
+	suite.mockStore.On("ListAppliedVersions", mock.Anything).Return(suite.applied(), nil)
+	suite.mockStore.On("ApplyMigration", mock.Anything, suite.migrations[0]).Return(nil).Once()
+
+	results, err := suite.newService(2).Up(context.Background())
+	suite.Require().NoError(err)
+	suite.False(results[0].Baselined)
+	suite.Len(results[0].Migrations, 1)
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_ReportsAppliedMigrationsOnFailure() {
+	suite.mockStore.On("IsVersioned", mock.Anything).Return(true, nil)
+	suite.mockStore.On("ListAppliedVersions", mock.Anything).Return(suite.applied(), nil)
+	suite.mockStore.On("ApplyMigration", mock.Anything, suite.migrations[0]).Return(nil).Once()
+	suite.mockStore.On("ApplyMigration", mock.Anything, suite.migrations[1]).Return(errors.New("db error")).Once()
+
+	results, err := suite.newService(3).Up(context.Background())
+	suite.Error(err)
+	suite.Require().Len(results, 1)
+	suite.Len(results[0].Migrations, 1)
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_MissingScript() {
+	suite.mockStore.On("IsVersioned", mock.Anything).Return(true, nil)
+	suite.mockStore.On("ListAppliedVersions", mock.Anything).Return(suite.applied(2, 3), nil)
+
+	_, err := suite.newService(4).Up(context.Background())
+	suite.ErrorContains(err, "migration 4 of configdb is required")
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_NewerDatabase() {
+	suite.mockStore.On("IsVersioned", mock.Anything).Return(true, nil)
+	suite.mockStore.On("ListAppliedVersions", mock.Anything).Return(suite.applied(2), nil)
+
+	_, err := suite.newService(1).Up(context.Background())
+	suite.ErrorContains(err, "newer than version 1")
+}
+
+func (suite *MigrationServiceTestSuite) TestDown() {
+	suite.mockStore.On("IsVersioned", mock.Anything).Return(true, nil)
+	suite.mockStore.On("ListAppliedVersions", mock.Anything).Return(suite.applied(2), nil)
+	suite.mockStore.On("RevertMigration", mock.Anything, suite.migrations[0]).Return(nil).Once()
+
+	result, err := suite.newService(2).Down(context.Background(), DatabaseConfig, 1)
+	suite.Require().NoError(err)
+	suite.Len(result.Migrations, 1)
+}
+
+func (suite *MigrationServiceTestSuite) TestDown_Errors() {
+	tests := []struct {
+		name     string
+		database string
+		steps    int
+		applied  []int
+		expected string
+	}{
+		{"InvalidSteps", DatabaseConfig, 0, nil, "at least 1"},
+		{"UnknownDatabase", "unknown", 1, nil, "not a configured SQL database"},
+		{"BelowBaseline", DatabaseConfig, 2, []int{2}, "1 migrations are applied above the baseline"},
+		{"NoDownScript", DatabaseConfig, 1, []int{2, 3}, "migration 3 of configdb has no down script"},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.mockStore = NewschemaVersionStoreInterfaceMock(suite.T())
+			if tc.applied != nil {
+				suite.mockStore.On("IsVersioned", mock.Anything).Return(true, nil)
+				suite.mockStore.On("ListAppliedVersions", mock.Anything).Return(suite.applied(tc.applied...), nil)
+			}
+
+			_, err := suite.newService(3).Down(context.Background(), tc.database, tc.steps)
+			suite.ErrorContains(err, tc.expected)
+		})
+	}
+}
+
+func (suite *MigrationServiceTestSuite) TestCheckCompatibility() {
+	tests := []struct {
+		name            string
+		versioned       bool
+		applied         []int
+		requiredVersion int
+		expected        string
+	}{
+		{"Compatible", true, []int{2}, 2, ""},
+		{"Unversioned", false, nil, 1, "has no schema version"},
+		{"TooOld", true, []int{2}, 3, "is at schema version 2 but version 3 is required"},
+		{"TooNew", true, []int{2, 3}, 2, "newer than version 2"},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.mockStore = NewschemaVersionStoreInterfaceMock(suite.T())
+			suite.mockStore.On("IsVersioned", mock.Anything).Return(tc.versioned, nil)
+			if tc.versioned {
+				suite.mockStore.On("ListAppliedVersions", mock.Anything).Return(suite.applied(tc.applied...), nil)
+			}
+
+			err := suite.newService(tc.requiredVersion).CheckCompatibility(context.Background())
+			if tc.expected == "" {
+				suite.NoError(err)
+			} else {
+				suite.ErrorContains(err, tc.expected)
+			}
+		})
+	}
+}
+
+func (suite *MigrationServiceTestSuite) TestClose_ClosesScriptClients() {
+	closed := 0
+	service := newMigrationService([]managedDatabase{
+		{name: DatabaseConfig, store: suite.mockStore, closeClient: func() error { closed++; return nil }},
+		{name: DatabaseEntity, store: suite.mockStore},
+		{name: DatabaseRuntimeTransient, store: suite.mockStore,
+			closeClient: func() error { closed++; return errors.New("connection reset") }},
+	})
+
+	err := service.Close()
+	suite.ErrorContains(err, "failed to close the runtime_transient script client")
+	suite.Equal(2, closed)
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// schemaVersionStoreInterface applies migrations to a database and tracks its schema version in the
+// SCHEMA_VERSION table.
+type schemaVersionStoreInterface interface {
+	// IsVersioned reports whether the database has a SCHEMA_VERSION table.
+	IsVersioned(ctx context.Context) (bool, error)
+	// InitializeVersioning creates the SCHEMA_VERSION table and records the baseline version.
+	InitializeVersioning(ctx context.Context) error
+	// ListAppliedVersions returns the recorded schema versions, oldest first.
+	ListAppliedVersions(ctx context.Context) ([]AppliedVersion, error)
+	// ApplyMigration runs the up script of a migration and records its version in one transaction.
+	ApplyMigration(ctx context.Context, migration Migration) error
+	// RevertMigration runs the down script of a migration and removes its version in one transaction.
+	RevertMigration(ctx context.Context, migration Migration) error
+}
+
+// schemaVersionStore is the SQL implementation of schemaVersionStoreInterface.
+type schemaVersionStore struct {
+	database      string
+	dbClient      provider.DBClientInterface
+	transactioner providers.Transactioner
+}
+
+// newSchemaVersionStore creates a schema version store for the named database.
+func newSchemaVersionStore(database string, dbClient provider.DBClientInterface,
+	transactioner providers.Transactioner) schemaVersionStoreInterface {
+	return &schemaVersionStore{
+		database:      database,
+		dbClient:      dbClient,
+		transactioner: transactioner,
+	}
+}
+
+// IsVersioned reports whether the database has a SCHEMA_VERSION table.
+func (s *schemaVersionStore) IsVersioned(ctx context.Context) (bool, error) {
+	results, err := s.dbClient.QueryContext(ctx, queryCheckSchemaVersionTable)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up the schema version table of %s: %w", s.database, err)
+	}
+	return len(results) > 0, nil
+}
+
+// InitializeVersioning creates the SCHEMA_VERSION table and records the baseline version.
+func (s *schemaVersionStore) InitializeVersioning(ctx context.Context) error {
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if _, err := s.dbClient.ExecuteContext(txCtx, queryCreateSchemaVersionTable); err != nil {
+			return fmt.Errorf("failed to create the schema version table of %s: %w", s.database, err)
+		}
+		if _, err := s.dbClient.ExecuteContext(txCtx, queryInsertSchemaVersion,
+			baselineVersion, "baseline", ""); err != nil {
+			return fmt.Errorf("failed to record the baseline version of %s: %w", s.database, err)
+		}
+		return nil
+	})
+}
+
+// ListAppliedVersions returns the recorded schema versions, oldest first.
+func (s *schemaVersionStore) ListAppliedVersions(ctx context.Context) ([]AppliedVersion, error) {
+	results, err := s.dbClient.QueryContext(ctx, queryListSchemaVersions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the schema versions of %s: %w", s.database, err)
+	}
+
+	versions := make([]AppliedVersion, 0, len(results))
+	for _, row := range results {
+		version, err := buildAppliedVersionFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, *version)
+	}
+	return versions, nil
+}
+
+// ApplyMigration runs the up script of a migration and records its version in one transaction. The
+// primary key on VERSION makes a concurrent run of the same migration fail instead of applying it twice.
+// A script with only comments records the version without running anything.
+func (s *schemaVersionStore) ApplyMigration(ctx context.Context, migration Migration) error {
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if hasStatements(migration.Up) {
+			script := dbmodel.DBQuery{ID: fmt.Sprintf("MGQ-UP-%04d", migration.Version), Query: migration.Up}
+			if _, err := s.dbClient.ExecuteContext(txCtx, script); err != nil {
+				return fmt.Errorf("failed to apply migration %d of %s: %w", migration.Version, s.database, err)
+			}
+		}
+		if _, err := s.dbClient.ExecuteContext(txCtx, queryInsertSchemaVersion,
+			migration.Version, migration.Description, migration.Checksum()); err != nil {
+			return fmt.Errorf("failed to record migration %d of %s: %w", migration.Version, s.database, err)
+		}
+		return nil
+	})
+}
+
+// RevertMigration runs the down script of a migration and removes its version in one transaction.
+func (s *schemaVersionStore) RevertMigration(ctx context.Context, migration Migration) error {
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if hasStatements(migration.Down) {
+			script := dbmodel.DBQuery{ID: fmt.Sprintf("MGQ-DOWN-%04d", migration.Version), Query: migration.Down}
+			if _, err := s.dbClient.ExecuteContext(txCtx, script); err != nil {
+				return fmt.Errorf("failed to revert migration %d of %s: %w", migration.Version, s.database, err)
+			}
+		}
+		rows, err := s.dbClient.ExecuteContext(txCtx, queryDeleteSchemaVersion, migration.Version)
+		if err != nil {
+			return fmt.Errorf("failed to remove migration %d of %s: %w", migration.Version, s.database, err)
+		}
+		if rows == 0 {
+			return fmt.Errorf("migration %d of %s is not applied", migration.Version, s.database)
+		}
+		return nil
+	})
+}
+
+// hasStatements reports whether a script has anything besides blank lines and line comments. Some
+// migrations change the schema of only some database types and are comments elsewhere.
+func hasStatements(script string) bool {
+	for _, line := range strings.Split(script, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return true
+		}
+	}
+	return false
+}
+
+// buildAppliedVersionFromRow constructs an AppliedVersion from a result row.
+func buildAppliedVersionFromRow(row map[string]interface{}) (*AppliedVersion, error) {
+	version, ok := row["version"].(int64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type for version")
+	}
+	appliedAt, err := sysutils.ParseDBTimeField(row["applied_at"], "applied_at")
+	if err != nil {
+		return nil, err
+	}
+	return &AppliedVersion{
+		Version:     int(version),
+		Description: columnString(row["description"]),
+		Checksum:    columnString(row["checksum"]),
+		AppliedAt:   appliedAt,
+	}, nil
+}
+
+// columnString coerces a result-row value to a string, tolerating []byte/string.
+func columnString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// DBQuery definitions for the SCHEMA_VERSION table. The table is database wide and is therefore not
+// scoped by deployment.
+var (
+	queryCheckSchemaVersionTable = dbmodel.DBQuery{
+		ID: "MGQ-SV-01",
+		PostgresQuery: `SELECT TABLE_NAME FROM INFORMATION_SCHEMA.TABLES ` +
+			`WHERE TABLE_SCHEMA = CURRENT_SCHEMA() AND TABLE_NAME = 'SCHEMA_VERSION'`,
+		SQLiteQuery: `SELECT NAME FROM SQLITE_MASTER WHERE TYPE = 'table' AND NAME = 'SCHEMA_VERSION'`,
+		MySQLQuery: `SELECT TABLE_NAME FROM INFORMATION_SCHEMA.TABLES ` +
+			`WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'SCHEMA_VERSION'`,
+	}
+	queryCreateSchemaVersionTable = dbmodel.DBQuery{
+		ID: "MGQ-SV-02",
+		PostgresQuery: `CREATE TABLE IF NOT EXISTS "SCHEMA_VERSION" (` +
+			`VERSION INTEGER PRIMARY KEY, DESCRIPTION VARCHAR(255) NOT NULL, CHECKSUM VARCHAR(64) NOT NULL, ` +
+			`APPLIED_AT TIMESTAMPTZ DEFAULT NOW())`,
+		SQLiteQuery: `CREATE TABLE IF NOT EXISTS "SCHEMA_VERSION" (` +
+			`VERSION INTEGER PRIMARY KEY, DESCRIPTION VARCHAR(255) NOT NULL, CHECKSUM VARCHAR(64) NOT NULL, ` +
+			`APPLIED_AT TEXT DEFAULT (datetime('now')))`,
+		MySQLQuery: `CREATE TABLE IF NOT EXISTS "SCHEMA_VERSION" (` +
+			`VERSION INTEGER PRIMARY KEY, DESCRIPTION VARCHAR(255) NOT NULL, CHECKSUM VARCHAR(64) NOT NULL, ` +
+			`APPLIED_AT DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6))`,
+	}
+	queryListSchemaVersions = dbmodel.DBQuery{
+		ID:    "MGQ-SV-03",
+		Query: `SELECT VERSION, DESCRIPTION, CHECKSUM, APPLIED_AT FROM "SCHEMA_VERSION" ORDER BY VERSION`,
+	}
+	queryInsertSchemaVersion = dbmodel.DBQuery{
+		ID:    "MGQ-SV-04",
+		Query: `INSERT INTO "SCHEMA_VERSION" (VERSION, DESCRIPTION, CHECKSUM) VALUES ($1, $2, $3)`,
+	}
+	queryDeleteSchemaVersion = dbmodel.DBQuery{
+		ID:    "MGQ-SV-05",
+		Query: `DELETE FROM "SCHEMA_VERSION" WHERE VERSION = $1`,
+	}
+)
@@ -0,0 +1,118 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+	"github.com/thunder-id/thunderid/tests/mocks/transactionmock"
+)
+
+type SchemaVersionStoreTestSuite struct {
+	suite.Suite
+	mockDBClient      *providermock.DBClientInterfaceMock
+	mockTransactioner *transactionmock.TransactionerMock
+	store             schemaVersionStoreInterface
+	migration         Migration
+}
+
+func TestSchemaVersionStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(SchemaVersionStoreTestSuite))
+}
+
+func (suite *SchemaVersionStoreTestSuite) SetupTest() {
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.mockTransactioner = transactionmock.NewTransactionerMock(suite.T())
+	suite.mockTransactioner.EXPECT().Transact(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, txFunc func(context.Context) error) error {
+			return txFunc(ctx)
+		}).Maybe()
+	suite.store = newSchemaVersionStore(DatabaseConfig, suite.mockDBClient, suite.mockTransactioner)
+	suite.migration = Migration{Version: 2, Description: "add table", Up: "CREATE TABLE t (c TEXT);",
+		Down: "DROP TABLE t;"}
+}
+
+func (suite *SchemaVersionStoreTestSuite) isScript(script string) interface{} {
+	return mock.MatchedBy(func(query dbmodel.DBQuery) bool { return query.Query == script })
+}
+
+func (suite *SchemaVersionStoreTestSuite) TestIsVersioned() {
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryCheckSchemaVersionTable).
+		Return([]map[string]interface{}{{"name": "SCHEMA_VERSION"}}, nil).Once()
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryCheckSchemaVersionTable).
+		Return([]map[string]interface{}{}, nil).Once()
+
+	versioned, err := suite.store.IsVersioned(context.Background())
+	suite.NoError(err)
+	suite.True(versioned)
+
+	versioned, err = suite.store.IsVersioned(context.Background())
+	suite.NoError(err)
+	suite.False(versioned)
+}
+
+func (suite *SchemaVersionStoreTestSuite) TestInitializeVersioning() {
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateSchemaVersionTable).Return(int64(0), nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertSchemaVersion, baselineVersion, "baseline", "").
+		Return(int64(1), nil)
+
+	suite.NoError(suite.store.InitializeVersioning(context.Background()))
+}
+
+func (suite *SchemaVersionStoreTestSuite) TestListAppliedVersions() {
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListSchemaVersions).Return([]map[string]interface{}{
+		{"version": int64(1), "description": "baseline", "checksum": "", "applied_at": "2026-01-02 03:04:05"},
+		{"version": int64(2), "description": []byte("add table"), "checksum": "abc",
+			"applied_at": time.Date(2026, 2, 3, 4, 5, 6, 0, time.UTC)},
+	}, nil)
+
+	versions, err := suite.store.ListAppliedVersions(context.Background())
+	suite.Require().NoError(err)
+	suite.Require().Len(versions, 2)
+	suite.Equal(1, versions[0].Version)
+	suite.Equal(2026, versions[0].AppliedAt.Year())
+	suite.Equal("add table", versions[1].Description)
+	suite.Equal("abc", versions[1].Checksum)
+}
+
+func (suite *SchemaVersionStoreTestSuite) TestApplyMigration() {
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, suite.isScript(suite.migration.Up)).Return(int64(0), nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertSchemaVersion, 2, "add table",
+		suite.migration.Checksum()).Return(int64(1), nil)
+
+	suite.NoError(suite.store.ApplyMigration(context.Background(), suite.migration))
+}
+
+func (suite *SchemaVersionStoreTestSuite) TestApplyMigration_CommentOnlyScriptRecordsVersion() {
+	migration := Migration{Version: 2, Description: "no change", Up: "-- Nothing to change here.\n"}
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertSchemaVersion, 2, "no change",
+		migration.Checksum()).Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.ApplyMigration(context.Background(), migration))
+}
+
+func (suite *SchemaVersionStoreTestSuite) TestApplyMigration_ScriptError() {
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, suite.isScript(suite.migration.Up)).
+		Return(int64(0), errors.New("syntax error"))
+
+	err := suite.store.ApplyMigration(context.Background(), suite.migration)
+	suite.ErrorContains(err, "failed to apply migration 2 of configdb")
+}
+
+func (suite *SchemaVersionStoreTestSuite) TestRevertMigration_NotApplied() {
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, suite.isScript(suite.migration.Down)).Return(int64(0), nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteSchemaVersion, 2).Return(int64(0), nil)
+
+	err := suite.store.RevertMigration(context.Background(), suite.migration)
+	suite.ErrorContains(err, "is not applied")
+}
@@ -18,6 +18,7 @@ type DBQuery struct {
 	Query         string `json:"query"`
 	PostgresQuery string `json:"postgres_query,omitempty"`
 	SQLiteQuery   string `json:"sqlite_query,omitempty"`
+	MySQLQuery    string `json:"mysql_query,omitempty"`
 }
 
 // GetID returns the unique identifier for the query.
@@ -36,6 +37,10 @@ func (d *DBQuery) GetQuery(dbType string) string {
 		if d.SQLiteQuery != "" {
 			return d.SQLiteQuery
 		}
+	case "mysql":
+		if d.MySQLQuery != "" {
+			return d.MySQLQuery
+		}
 	}
 	// Fall back to the default query
 	return d.Query
@@ -99,3 +99,17 @@ func (suite *DBQueryTestSuite) TestGetQuery_UnknownDBType() {
 func (suite *DBQueryTestSuite) TestDBQuery_ImplementsInterface() {
 	var _ DBQueryInterface = (*DBQuery)(nil)
 }
+
+func (suite *DBQueryTestSuite) TestGetQuery_MySQLQuery() {
+	query := DBQuery{
+		ID:            "TEST-007",
+		Query:         "INSERT INTO t (a) VALUES ($1) ON CONFLICT (a) DO NOTHING",
+		SQLiteQuery:   "INSERT OR IGNORE INTO t (a) VALUES ($1)",
+		MySQLQuery:    "INSERT IGNORE INTO t (a) VALUES ($1)",
+		PostgresQuery: "",
+	}
+
+	suite.Equal("INSERT IGNORE INTO t (a) VALUES ($1)", query.GetQuery("mysql"))
+	suite.Equal("INSERT OR IGNORE INTO t (a) VALUES ($1)", query.GetQuery("sqlite"))
+	suite.Equal("INSERT INTO t (a) VALUES ($1) ON CONFLICT (a) DO NOTHING", query.GetQuery("postgres"))
+}
@@ -90,12 +90,12 @@ func (t *Tx) Rollback() error {
 
 // Exec executes a query with the given arguments.
 func (t *Tx) Exec(query DBQuery, args ...any) (sql.Result, error) {
-	sqlQuery := query.GetQuery(t.dbType)
+	sqlQuery, args := BindPlaceholders(t.dbType, query.GetQuery(t.dbType), args)
 	return t.internal.Exec(sqlQuery, args...)
 }
 
 // Query executes a query that returns rows, typically a SELECT, and returns the result as *sql.Rows.
 func (t *Tx) Query(query DBQuery, args ...any) (*sql.Rows, error) {
-	sqlQuery := query.GetQuery(t.dbType)
+	sqlQuery, args := BindPlaceholders(t.dbType, query.GetQuery(t.dbType), args)
 	return t.internal.Query(sqlQuery, args...)
 }
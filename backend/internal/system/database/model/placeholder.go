@@ -0,0 +1,95 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"strconv"
+	"strings"
+)
+
+// dbTypeMySQL is the database type that only understands positional "?" placeholders.
+const dbTypeMySQL = "mysql"
+
+// BindPlaceholders adapts a query written with numbered "$N" placeholders to the given database type.
+//
+// PostgreSQL and SQLite accept "$N" natively, so the query and arguments are returned unchanged for them.
+// MySQL only accepts "?", where every placeholder consumes the next argument. For MySQL each "$N" is
+// replaced with "?" and the arguments are reordered, and repeated where a placeholder is reused, to
+// match. Placeholders inside string literals, quoted identifiers and comments are left untouched, so
+// JSON paths such as '$.email' are preserved. Queries that already use "?" are returned unchanged.
+func BindPlaceholders(dbType, query string, args []any) (string, []any) {
+	if dbType != dbTypeMySQL || !strings.Contains(query, "$") {
+		return query, args
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(query))
+	boundArgs := make([]any, 0, len(args))
+	rebound := false
+
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end := skipQuoted(query, i, c)
+			sb.WriteString(query[i:end])
+			i = end - 1
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query) - i
+			}
+			sb.WriteString(query[i : i+end])
+			i += end - 1
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				end = len(query) - i - 2
+			} else {
+				end += 2
+			}
+			sb.WriteString(query[i : i+2+end])
+			i += 1 + end
+		case c == '$':
+			j := i + 1
+			for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+			position, err := strconv.Atoi(query[i+1 : j])
+			if err != nil || position < 1 || position > len(args) {
+				sb.WriteByte(c)
+				continue
+			}
+			sb.WriteByte('?')
+			boundArgs = append(boundArgs, args[position-1])
+			rebound = true
+			i = j - 1
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	if !rebound {
+		return query, args
+	}
+	return sb.String(), boundArgs
+}
+
+// skipQuoted returns the index just past the quoted section that starts at start. A doubled quote
+// character inside the section is treated as an escaped quote, as is a backslash-escaped character.
+func skipQuoted(query string, start int, quote byte) int {
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case quote:
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(query)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BindPlaceholdersTestSuite struct {
+	suite.Suite
+}
+
+func TestBindPlaceholdersTestSuite(t *testing.T) {
+	suite.Run(t, new(BindPlaceholdersTestSuite))
+}
+
+func (suite *BindPlaceholdersTestSuite) TestNonMySQLUnchanged() {
+	query := "SELECT * FROM t WHERE a = $2 AND b = $1"
+	args := []any{"x", "y"}
+
+	for _, dbType := range []string{"postgres", "sqlite"} {
+		boundQuery, boundArgs := BindPlaceholders(dbType, query, args)
+		suite.Equal(query, boundQuery)
+		suite.Equal(args, boundArgs)
+	}
+}
+
+func (suite *BindPlaceholdersTestSuite) TestMySQLReordersAndRepeatsArguments() {
+	query, args := BindPlaceholders("mysql",
+		"UPDATE t SET a = $3 WHERE id = $1 AND (b = $2 OR c = $2)", []any{"id", "b", "a"})
+
+	suite.Equal("UPDATE t SET a = ? WHERE id = ? AND (b = ? OR c = ?)", query)
+	suite.Equal([]any{"a", "id", "b", "b"}, args)
+}
+
+func (suite *BindPlaceholdersTestSuite) TestMySQLMultiDigitPlaceholders() {
+	args := make([]any, 12)
+	for i := range args {
+		args[i] = i + 1
+	}
+
+	query, bound := BindPlaceholders("mysql", "VALUES ($12, $1, $10)", args)
+
+	suite.Equal("VALUES (?, ?, ?)", query)
+	suite.Equal([]any{12, 1, 10}, bound)
+}
+
+func (suite *BindPlaceholdersTestSuite) TestMySQLSkipsLiteralsAndComments() {
+	query, args := BindPlaceholders("mysql",
+		"SELECT JSON_EXTRACT(\"$1\", '$.a''$2') -- $1\nFROM t /* $2 */ WHERE a = $1", []any{"v", "w"})
+
+	suite.Equal("SELECT JSON_EXTRACT(\"$1\", '$.a''$2') -- $1\nFROM t /* $2 */ WHERE a = ?", query)
+	suite.Equal([]any{"v"}, args)
+}
+
+func (suite *BindPlaceholdersTestSuite) TestMySQLQuestionMarkQueryUnchanged() {
+	args := []any{"v"}
+	query, bound := BindPlaceholders("mysql", "SELECT * FROM t WHERE json_extract(c, '$.a') = ?", args)
+
+	suite.Equal("SELECT * FROM t WHERE json_extract(c, '$.a') = ?", query)
+	suite.Equal(args, bound)
+}
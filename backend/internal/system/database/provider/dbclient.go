@@ -14,6 +14,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
+	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/lib/pq"
 	_ "modernc.org/sqlite"
 )
@@ -67,7 +68,7 @@ func (client *DBClient) QueryContext(
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DBClient"))
 	logger.Debug(ctx, "Executing query", log.String("queryID", query.GetID()))
 
-	sqlQuery := query.GetQuery(client.dbType)
+	sqlQuery, args := model.BindPlaceholders(client.dbType, query.GetQuery(client.dbType), args)
 
 	// Check if there's a transaction in the context for this database
 	var rows *sql.Rows
@@ -97,6 +98,11 @@ func (client *DBClient) QueryContext(
 		return nil, err
 	}
 
+	textColumns, err := client.getTextColumns(rows)
+	if err != nil {
+		return nil, err
+	}
+
 	var results []map[string]interface{}
 	for rows.Next() {
 		row := make([]interface{}, len(columns))
@@ -112,7 +118,13 @@ func (client *DBClient) QueryContext(
 		result := map[string]interface{}{}
 		for i, col := range columns {
 			// Normalize column names to lowercase for consistency.
-			result[strings.ToLower(col)] = row[i]
+			value := row[i]
+			if textColumns != nil && textColumns[i] {
+				if raw, ok := value.([]byte); ok {
+					value = string(raw)
+				}
+			}
+			result[strings.ToLower(col)] = value
 		}
 		results = append(results, result)
 	}
@@ -124,6 +136,29 @@ func (client *DBClient) QueryContext(
 	return results, nil
 }
 
+// getTextColumns reports which result columns hold text for MySQL connections. The MySQL driver returns
+// text and JSON columns as []byte, whereas the stores expect them as strings the way the PostgreSQL and
+// SQLite drivers return them. Binary columns are left as []byte. It returns nil for other databases.
+func (client *DBClient) getTextColumns(rows *sql.Rows) ([]bool, error) {
+	if client.dbType != DataSourceTypeMySQL {
+		return nil, nil
+	}
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	textColumns := make([]bool, len(columnTypes))
+	for i, columnType := range columnTypes {
+		switch columnType.DatabaseTypeName() {
+		case "CHAR", "VARCHAR", "TEXT", "TINYTEXT", "MEDIUMTEXT", "LONGTEXT", "JSON", "ENUM", "SET":
+			textColumns[i] = true
+		}
+	}
+	return textColumns, nil
+}
+
 // Execute executes a sql query without returning data in any rows, and returns number of rows affected.
 func (client *DBClient) Execute(query model.DBQuery, args ...interface{}) (int64, error) {
 	return client.ExecuteContext(context.Background(), query, args...)
@@ -135,7 +170,7 @@ func (client *DBClient) ExecuteContext(ctx context.Context, query model.DBQuery,
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DBClient"))
 	logger.Debug(ctx, "Executing query", log.String("queryID", query.GetID()))
 
-	sqlQuery := query.GetQuery(client.dbType)
+	sqlQuery, args := model.BindPlaceholders(client.dbType, query.GetQuery(client.dbType), args)
 
 	// Check if there's a transaction in the context for this database
 	var res sql.Result
@@ -173,6 +208,6 @@ func (client *DBClient) GetTransactioner() (providers.Transactioner, error) {
 }
 
 // Close closes the database connection.
-func (client *DBClient) close() error {
+func (client *DBClient) Close() error {
 	return client.db.Close()
 }
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	"github.com/lib/pq"
 
 	"github.com/thunder-id/thunderid/internal/system/database/model"
@@ -461,6 +462,9 @@ func (suite *DBClientTestSuite) TestIsRetryableDBError() {
 	assert.True(suite.T(), isRetryableDBError(driver.ErrBadConn))
 	assert.True(suite.T(), isRetryableDBError(context.DeadlineExceeded))
 	assert.True(suite.T(), isRetryableDBError(&pq.Error{Code: "40P01"}))
+	assert.True(suite.T(), isRetryableDBError(&mysql.MySQLError{Number: 1213}))
+	assert.True(suite.T(), isRetryableDBError(mysql.ErrInvalidConn))
+	assert.False(suite.T(), isRetryableDBError(&mysql.MySQLError{Number: 1062}))
 	assert.True(suite.T(), isRetryableDBError(&net.OpError{
 		Op:  "dial",
 		Net: "tcp",
@@ -469,3 +473,42 @@ func (suite *DBClientTestSuite) TestIsRetryableDBError() {
 	assert.False(suite.T(), isRetryableDBError(sql.ErrNoRows))
 	assert.False(suite.T(), isRetryableDBError(errors.New("syntax error near FROM")))
 }
+
+func (suite *DBClientTestSuite) TestMySQLQueryContextBindsPlaceholdersAndReturnsText() {
+	client := NewDBClient(model.NewDB(suite.mockDB), DataSourceTypeMySQL, "test", retryConfig{})
+	testQuery := model.DBQuery{
+		ID:    "test_mysql_query",
+		Query: "SELECT NAME, DATA FROM users WHERE ID = $2 AND ORG = $1 AND OWNER = $2",
+	}
+
+	rows := sqlmock.NewRowsWithColumnDefinition(
+		sqlmock.NewColumn("NAME").OfType("VARCHAR", ""),
+		sqlmock.NewColumn("DATA").OfType("BLOB", nil),
+	).AddRow([]byte("Alice"), []byte{0x01})
+	suite.mock.ExpectQuery(`SELECT NAME, DATA FROM users WHERE ID = \? AND ORG = \? AND OWNER = \?`).
+		WithArgs("u1", "o1", "u1").
+		WillReturnRows(rows)
+
+	results, err := client.QueryContext(context.Background(), testQuery, "o1", "u1")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Alice", results[0]["name"])
+	assert.Equal(suite.T(), []byte{0x01}, results[0]["data"])
+}
+
+func (suite *DBClientTestSuite) TestMySQLExecuteContextBindsPlaceholders() {
+	client := NewDBClient(model.NewDB(suite.mockDB), DataSourceTypeMySQL, "test", retryConfig{})
+	testQuery := model.DBQuery{
+		ID:    "test_mysql_execute",
+		Query: "UPDATE users SET NAME = $2 WHERE ID = $1",
+	}
+
+	suite.mock.ExpectExec(`UPDATE users SET NAME = \? WHERE ID = \?`).
+		WithArgs("Bob", "u1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	rowsAffected, err := client.ExecuteContext(context.Background(), testQuery, "u1", "Bob")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), rowsAffected)
+}
@@ -9,10 +9,15 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"path"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/go-sql-driver/mysql"
+
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/database/model"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -24,16 +29,26 @@ const (
 	dataSourceTypePostgres = "postgres"
 	dataSourceTypeSQLite   = "sqlite"
 
+	// mysqlSQLMode makes MySQL and MariaDB read double quotes as identifier quotes and || as string
+	// concatenation, as the shared queries rely on, and rejects invalid values instead of truncating them.
+	mysqlSQLMode = "'ANSI_QUOTES,PIPES_AS_CONCAT,STRICT_TRANS_TABLES,NO_ZERO_DATE,NO_ENGINE_SUBSTITUTION'"
+	// mysqlParamMultiStatements is the MySQL driver parameter that allows several statements per query.
+	mysqlParamMultiStatements = "multiStatements"
+
 	dbNameConfig            = "config"
 	dbNameRuntimeTransient  = "runtime_transient"
 	dbNameEntity            = "entity"
 	dbNameRuntimePersistent = "runtime_persistent"
 )
 
+// DataSourceTypeMySQL is the type identifier for a MySQL or MariaDB data source.
+const DataSourceTypeMySQL = "mysql"
+
 // dbConfig represents the local database configuration.
 type dbConfig struct {
 	dsn        string
 	driverName string
+	err        error
 }
 
 // DBProviderInterface defines the interface for getting database clients and transactioners.
@@ -238,11 +253,33 @@ func (d *dbProvider) getOrInitClient(
 
 // initializeClient initializes a database client and assigns it to the provided pointer.
 func (d *dbProvider) initializeClient(clientPtr *DBClientInterface, dataSource config.DataSource, dbName string) error {
-	dbConfig := d.getDBConfig(dataSource)
+	client, err := openClient(dataSource, dbName, false)
+	if err != nil {
+		return err
+	}
+	*clientPtr = client
+	return nil
+}
+
+// OpenScriptDBClient opens a dedicated client for running schema scripts, which may hold several
+// statements. On MySQL it is the only client with multiple statements enabled; the clients of the
+// provider run a single statement per query. The caller owns the client and must close it.
+func OpenScriptDBClient(dataSource config.DataSource, dbName string) (*DBClient, error) {
+	return openClient(dataSource, dbName, true)
+}
+
+// openClient opens a database connection pool for the data source and wraps it in a client.
+// multiStatements allows a single query to run several statements on MySQL.
+func openClient(dataSource config.DataSource, dbName string, multiStatements bool) (*DBClient, error) {
+	dbConfig := getDBConfig(dataSource, multiStatements)
+
+	if dbConfig.err != nil {
+		return nil, fmt.Errorf("invalid configuration for database %s: %w", dbName, dbConfig.err)
+	}
 
 	db, err := sql.Open(dbConfig.driverName, dbConfig.dsn)
 	if err != nil {
-		return fmt.Errorf("failed to connect to database %s: %w", dbName, err)
+		return nil, fmt.Errorf("failed to connect to database %s: %w", dbName, err)
 	}
 
 	// Configure connection pool using values from the type-specific sub-config.
@@ -256,6 +293,10 @@ func (d *dbProvider) initializeClient(clientPtr *DBClientInterface, dataSource c
 		maxOpenConns = dataSource.SQLite.MaxOpenConns
 		maxIdleConns = dataSource.SQLite.MaxIdleConns
 		connMaxLifetime = dataSource.SQLite.ConnMaxLifetime
+	case DataSourceTypeMySQL:
+		maxOpenConns = dataSource.MySQL.MaxOpenConns
+		maxIdleConns = dataSource.MySQL.MaxIdleConns
+		connMaxLifetime = dataSource.MySQL.ConnMaxLifetime
 	}
 	db.SetMaxOpenConns(maxOpenConns)
 	db.SetMaxIdleConns(maxIdleConns)
@@ -264,9 +305,9 @@ func (d *dbProvider) initializeClient(clientPtr *DBClientInterface, dataSource c
 	// Test the database connection.
 	if err := db.Ping(); err != nil {
 		if closeErr := db.Close(); closeErr != nil {
-			return fmt.Errorf("failed to ping database %s: %w (close error: %w)", dbName, err, closeErr)
+			return nil, fmt.Errorf("failed to ping database %s: %w (close error: %w)", dbName, err, closeErr)
 		}
-		return fmt.Errorf("failed to ping database %s: %w", dbName, err)
+		return nil, fmt.Errorf("failed to ping database %s: %w", dbName, err)
 	}
 
 	// Enable foreign key constraints for SQLite databases
@@ -274,10 +315,10 @@ func (d *dbProvider) initializeClient(clientPtr *DBClientInterface, dataSource c
 		_, err := db.Exec("PRAGMA foreign_keys = ON;")
 		if err != nil {
 			if closeErr := db.Close(); closeErr != nil {
-				return fmt.Errorf("failed to enable foreign key constraints for %s: %w (close error: %w)",
+				return nil, fmt.Errorf("failed to enable foreign key constraints for %s: %w (close error: %w)",
 					dbName, err, closeErr)
 			}
-			return fmt.Errorf("failed to enable foreign key constraints for %s: %w", dbName, err)
+			return nil, fmt.Errorf("failed to enable foreign key constraints for %s: %w", dbName, err)
 		}
 	}
 
@@ -295,14 +336,25 @@ func (d *dbProvider) initializeClient(clientPtr *DBClientInterface, dataSource c
 			MinBackoff:  time.Duration(dataSource.SQLite.MinRetryBackoffMS) * time.Millisecond,
 			MaxBackoff:  time.Duration(dataSource.SQLite.MaxRetryBackoffMS) * time.Millisecond,
 		}
+	case DataSourceTypeMySQL:
+		rc = retryConfig{
+			MaxAttempts: dataSource.MySQL.MaxRetries,
+			MinBackoff:  time.Duration(dataSource.MySQL.MinRetryBackoffMS) * time.Millisecond,
+			MaxBackoff:  time.Duration(dataSource.MySQL.MaxRetryBackoffMS) * time.Millisecond,
+		}
 	}
 
-	*clientPtr = NewDBClient(model.NewDB(db), dbConfig.driverName, dbName, rc)
-	return nil
+	return &DBClient{
+		db:          model.NewDB(db),
+		dbType:      dbConfig.driverName,
+		dbName:      dbName,
+		retryConfig: normalizeRetryConfig(rc),
+	}, nil
 }
 
-// getDBConfig returns the database configuration based on the provided data source.
-func (d *dbProvider) getDBConfig(dataSource config.DataSource) dbConfig {
+// getDBConfig returns the database configuration based on the provided data source. multiStatements
+// allows a single query to run several statements on MySQL.
+func getDBConfig(dataSource config.DataSource, multiStatements bool) dbConfig {
 	var dbConfig dbConfig
 
 	switch dataSource.Type {
@@ -319,11 +371,53 @@ func (d *dbProvider) getDBConfig(dataSource config.DataSource) dbConfig {
 			options = "?" + options
 		}
 		dbConfig.dsn = fmt.Sprintf("%s%s", path.Join(config.GetServerRuntime().ServerHome, sl.Path), options)
+	case DataSourceTypeMySQL:
+		dbConfig.driverName = DataSourceTypeMySQL
+		dbConfig.dsn, dbConfig.err = getMySQLDSN(dataSource.MySQL, multiStatements)
 	}
 
 	return dbConfig
 }
 
+// getMySQLDSN builds the MySQL driver DSN for the given data source. Besides the connection details, it
+// fixes the session settings the stores depend on: timestamps are parsed into time.Time in UTC, affected
+// row counts include matched but unchanged rows as they do on PostgreSQL and SQLite, and the SQL mode
+// matches the shared query syntax. Multiple statements are only allowed when multiStatements is set,
+// which is reserved for the client that runs schema scripts. Additional driver parameters can be
+// supplied through the options field as a URL query string, except the multiStatements parameter.
+func getMySQLDSN(ms config.MySQLDataSource, multiStatements bool) (string, error) {
+	cfg := mysql.NewConfig()
+	cfg.User = ms.Username
+	cfg.Passwd = ms.Password
+	cfg.Net = "tcp"
+	cfg.Addr = net.JoinHostPort(ms.Hostname, strconv.Itoa(ms.Port))
+	cfg.DBName = ms.Name
+	cfg.TLSConfig = ms.TLS
+	cfg.Loc = time.UTC
+	cfg.ParseTime = true
+	cfg.ClientFoundRows = true
+	cfg.MultiStatements = multiStatements
+	cfg.Params = map[string]string{
+		"sql_mode":  mysqlSQLMode,
+		"time_zone": "'+00:00'",
+	}
+
+	if ms.Options != "" {
+		options, err := url.ParseQuery(ms.Options)
+		if err != nil {
+			return "", fmt.Errorf("invalid mysql options: %w", err)
+		}
+		for key := range options {
+			if key == mysqlParamMultiStatements {
+				return "", fmt.Errorf("mysql option %s is not supported", mysqlParamMultiStatements)
+			}
+			cfg.Params[key] = options.Get(key)
+		}
+	}
+
+	return cfg.FormatDSN(), nil
+}
+
 // Close closes the database connections. This should only be called by the lifecycle manager during shutdown.
 func (d *dbProvider) Close() error {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DBProvider"))
@@ -350,7 +444,7 @@ func (d *dbProvider) closeClient(clientPtr *DBClientInterface, mutex *sync.RWMut
 	defer mutex.Unlock()
 	if *clientPtr != nil {
 		if client, ok := (*clientPtr).(*DBClient); ok {
-			if err := client.close(); err != nil {
+			if err := client.Close(); err != nil {
 				return fmt.Errorf("failed to close %s client: %w", clientName, err)
 			}
 		}
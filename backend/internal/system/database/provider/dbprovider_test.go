@@ -5,8 +5,10 @@ package provider
 
 import (
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -111,3 +113,48 @@ func (suite *DBProviderTestSuite) TestGetRuntimePersistentDBTransactioner_Succes
 	suite.NoError(err)
 	suite.NotNil(txer)
 }
+
+func (suite *DBProviderTestSuite) TestGetMySQLDSN() {
+	dsn, err := getMySQLDSN(config.MySQLDataSource{
+		Hostname: "db.example.com",
+		Port:     3306,
+		Name:     "configdb",
+		Username: "thunderid",
+		Password: "secret",
+		TLS:      "true",
+		Options:  "innodb_lock_wait_timeout=10&readTimeout=30s",
+	}, false)
+	suite.Require().NoError(err)
+
+	cfg, err := mysql.ParseDSN(dsn)
+	suite.Require().NoError(err)
+	suite.Equal("thunderid", cfg.User)
+	suite.Equal("secret", cfg.Passwd)
+	suite.Equal("db.example.com:3306", cfg.Addr)
+	suite.Equal("configdb", cfg.DBName)
+	suite.Equal("true", cfg.TLSConfig)
+	suite.True(cfg.ParseTime)
+	suite.True(cfg.ClientFoundRows)
+	suite.False(cfg.MultiStatements)
+	suite.Equal(30*time.Second, cfg.ReadTimeout)
+	suite.Contains(cfg.Params["sql_mode"], "ANSI_QUOTES")
+	suite.Equal("10", cfg.Params["innodb_lock_wait_timeout"])
+}
+
+func (suite *DBProviderTestSuite) TestGetMySQLDSN_InvalidOptions() {
+	_, err := getMySQLDSN(config.MySQLDataSource{Hostname: "localhost", Port: 3306, Options: "%zz"}, false)
+	suite.Error(err)
+}
+
+func (suite *DBProviderTestSuite) TestGetMySQLDSN_MultiStatementsOnlyForScripts() {
+	dsn, err := getMySQLDSN(config.MySQLDataSource{Hostname: "localhost", Port: 3306}, true)
+	suite.Require().NoError(err)
+	cfg, err := mysql.ParseDSN(dsn)
+	suite.Require().NoError(err)
+	suite.True(cfg.MultiStatements)
+
+	_, err = getMySQLDSN(config.MySQLDataSource{
+		Hostname: "localhost", Port: 3306, Options: "multiStatements=true",
+	}, false)
+	suite.ErrorContains(err, "multiStatements")
+}
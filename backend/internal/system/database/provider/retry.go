@@ -18,6 +18,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/lib/pq"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -191,6 +192,18 @@ func isRetryableDBError(err error) bool {
 		}
 	}
 
+	// MySQL and MariaDB: deadlock, lock wait timeout and too many connections.
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		if mysqlErr.Number == 1213 || mysqlErr.Number == 1205 || mysqlErr.Number == 1040 {
+			return true
+		}
+	}
+
+	if errors.Is(err, mysql.ErrInvalidConn) {
+		return true
+	}
+
 	if isTransientNetworkError(err) {
 		return true
 	}
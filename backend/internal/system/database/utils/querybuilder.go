@@ -34,9 +34,11 @@ func BuildFilterQuery(
 
 	postgresQuery := baseQuery
 	sqliteQuery := baseQuery
+	mysqlQuery := baseQuery
 	for i, key := range keys {
 		postgresQuery += BuildPostgresJSONCondition(columnName, key, i+1)
 		sqliteQuery += BuildSQLiteJSONCondition(columnName, key)
+		mysqlQuery += BuildMySQLJSONCondition(columnName, key)
 		args = append(args, filters[key])
 	}
 
@@ -45,6 +47,7 @@ func BuildFilterQuery(
 		Query:         postgresQuery,
 		PostgresQuery: postgresQuery,
 		SQLiteQuery:   sqliteQuery,
+		MySQLQuery:    mysqlQuery,
 	}
 
 	return resultQuery, args, nil
@@ -56,6 +59,7 @@ func AppendDeploymentIDToFilterQuery(
 ) (model.DBQuery, []interface{}) {
 	postgresQuery := fmt.Sprintf("%s AND DEPLOYMENT_ID = $%d", query.PostgresQuery, len(args)+1)
 	sqliteQuery := fmt.Sprintf("%s AND DEPLOYMENT_ID = ?", query.SQLiteQuery)
+	mysqlQuery := fmt.Sprintf("%s AND DEPLOYMENT_ID = ?", query.MySQLQuery)
 
 	argsWithDeploymentID := make([]interface{}, 0, len(args)+1)
 	argsWithDeploymentID = append(argsWithDeploymentID, args...)
@@ -66,6 +70,7 @@ func AppendDeploymentIDToFilterQuery(
 		Query:         postgresQuery,
 		PostgresQuery: postgresQuery,
 		SQLiteQuery:   sqliteQuery,
+		MySQLQuery:    mysqlQuery,
 	}
 
 	return *updatedQuery, argsWithDeploymentID
@@ -91,6 +96,13 @@ func BuildSQLiteJSONCondition(columnName, key string) string {
 	return fmt.Sprintf(" AND json_extract(%s, '$.%s') = ?", columnName, key)
 }
 
+// BuildMySQLJSONCondition builds a MySQL JSON filter condition.
+// For both nested and simple paths, it extracts the value with a dot-notation path and unquotes it so
+// that it compares as text.
+func BuildMySQLJSONCondition(columnName, key string) string {
+	return fmt.Sprintf(" AND JSON_UNQUOTE(JSON_EXTRACT(%s, '$.%s')) = ?", columnName, key)
+}
+
 // ValidateKey ensures that the provided key contains only safe characters (alphanumeric, underscores, and dots).
 // This validation prevents SQL injection by ensuring keys can be safely used in queries.
 func ValidateKey(key string) error {
@@ -184,9 +184,17 @@ func (suite *QueryBuilderTestSuite) TestBuildFilterQueryDatabaseSpecificQueries(
 		" AND json_extract(ATTRIBUTES, '$.name') = ?"
 	assert.Equal(suite.T(), expectedSQLite, sqliteQuery)
 
-	// Test that both queries are stored in the struct
+	// Test MySQL-specific query
+	mysqlQuery := query.GetQuery("mysql")
+	expectedMySQL := testUserBaseQuery +
+		" AND JSON_UNQUOTE(JSON_EXTRACT(ATTRIBUTES, '$.email')) = ?" +
+		" AND JSON_UNQUOTE(JSON_EXTRACT(ATTRIBUTES, '$.name')) = ?"
+	assert.Equal(suite.T(), expectedMySQL, mysqlQuery)
+
+	// Test that all queries are stored in the struct
 	assert.Equal(suite.T(), expectedPostgres, query.PostgresQuery)
 	assert.Equal(suite.T(), expectedSQLite, query.SQLiteQuery)
+	assert.Equal(suite.T(), expectedMySQL, query.MySQLQuery)
 	assert.Equal(suite.T(), expectedPostgres, query.Query) // Default should be PostgreSQL
 }
 
@@ -389,6 +397,13 @@ func (suite *QueryBuilderTestSuite) TestAppendDeploymentIDToFilterQueryWithExist
 		" AND json_extract(ATTRIBUTES, '$.role') = ?" +
 		" AND DEPLOYMENT_ID = ?"
 	assert.Equal(suite.T(), expectedSQLite, updatedQuery.SQLiteQuery)
+
+	// Verify MySQL query
+	expectedMySQL := testUserBaseQuery +
+		" AND JSON_UNQUOTE(JSON_EXTRACT(ATTRIBUTES, '$.email')) = ?" +
+		" AND JSON_UNQUOTE(JSON_EXTRACT(ATTRIBUTES, '$.role')) = ?" +
+		" AND DEPLOYMENT_ID = ?"
+	assert.Equal(suite.T(), expectedMySQL, updatedQuery.MySQLQuery)
 }
 
 func (suite *QueryBuilderTestSuite) TestAppendDeploymentIDToFilterQueryWithSingleFilter() {
@@ -37,6 +37,7 @@ const (
 	KeyTypePresentationDefinition  KeyType = "presentation-definition"
 	KeyTypeCredentialConfiguration KeyType = "credential-configuration" //nolint:gosec
 	KeyTypeServerConfig            KeyType = "server-config"
+	KeyTypeAuthorizationPolicy     KeyType = "authorization-policy"
 )
 
 // String returns the string representation of KeyType
@@ -51,7 +52,7 @@ func (kt KeyType) IsValid() bool {
 		KeyTypeEntityType, KeyTypeOU, KeyTypeFlow, KeyTypeTranslation, KeyTypeTheme, KeyTypeLayout,
 		KeyTypeResourceServer, KeyTypeResource, KeyTypeAction, KeyTypeRole, KeyTypeUser, KeyTypeTemplate,
 		KeyTypeInboundAuth, KeyTypeGroup, KeyTypePresentationDefinition, KeyTypeCredentialConfiguration,
-		KeyTypeServerConfig,
+		KeyTypeServerConfig, KeyTypeAuthorizationPolicy,
 		KeyTypeEntity:
 		return true
 	default:
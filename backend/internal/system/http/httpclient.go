@@ -77,9 +77,16 @@ func NewHTTPClientWithTimeout(timeout time.Duration) HTTPClientInterface {
 // ssrfSafeDialContext is wired in to block hostnames that DNS-resolve to private/loopback addresses
 // and to pin the TCP connection to the first validated IP (prevents DNS rebinding).
 func NewHTTPClientWithCheckRedirect(checkRedirect func(*http.Request, []*http.Request) error) HTTPClientInterface {
+	return NewHTTPClientWithTimeoutAndCheckRedirect(30*time.Second, checkRedirect)
+}
+
+// NewHTTPClientWithTimeoutAndCheckRedirect creates an HTTPClient with a custom timeout and redirect
+// policy. It applies the same SSRF-safe dialing as NewHTTPClientWithCheckRedirect.
+func NewHTTPClientWithTimeoutAndCheckRedirect(timeout time.Duration,
+	checkRedirect func(*http.Request, []*http.Request) error) HTTPClientInterface {
 	return &HTTPClient{
 		client: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: timeout,
 			Transport: &http.Transport{
 				DialContext: ssrfSafeDialContext,
 				// #nosec G402 -- Min TLS version is TLS 1.2 or higher based on config
@@ -20,6 +20,20 @@ var defaultMessages = map[string]string{
 	"design.resolve.error.missing_id_description": "The 'id' query parameter is required",
 	"design.resolve.error.unsupported_type": "Unsupported resolve type",
 	"design.resolve.error.unsupported_type_description": "The specified resolve type is not yet supported. Currently only 'APP' type is supported",
+	"error.accountsecurityservice.authentication_required": "Authentication required",
+	"error.accountsecurityservice.authentication_required_description": "The request must be made by an authenticated user",
+	"error.accountsecurityservice.credential_not_found": "Credential not found",
+	"error.accountsecurityservice.credential_not_found_description": "The user has no enrolled credential with the specified id",
+	"error.accountsecurityservice.credential_not_renamable": "Credential cannot be renamed",
+	"error.accountsecurityservice.credential_not_renamable_description": "Only passkeys can be given a name",
+	"error.accountsecurityservice.invalid_credential_name": "Invalid credential name",
+	"error.accountsecurityservice.invalid_credential_name_description": "The credential name must be non-empty and at most 64 characters long",
+	"error.accountsecurityservice.invalid_request_format": "Invalid request format",
+	"error.accountsecurityservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.accountsecurityservice.session_not_found": "Session not found",
+	"error.accountsecurityservice.session_not_found_description": "The user has no session with the specified id",
+	"error.accountsecurityservice.user_not_found": "User not found",
+	"error.accountsecurityservice.user_not_found_description": "The user with the specified id does not exist",
 	"error.actor_not_found": "Actor not found",
 	"error.actor_not_found_description": "The requested actor does not exist",
 	"error.agentservice.agent_already_exists_with_client_id": "Client ID already in use",
@@ -83,6 +97,7 @@ var defaultMessages = map[string]string{
 	"error.agentservice.invalid_registration_flow_id_description": "The provided registration flow ID is invalid",
 	"error.agentservice.invalid_request_format": "Invalid request format",
 	"error.agentservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.agentservice.invalid_request_uri_description": "request URIs must be absolute https URIs",
 	"error.agentservice.invalid_response_type": "Invalid response type",
 	"error.agentservice.invalid_response_type_description": "One or more provided response types are invalid",
 	"error.agentservice.invalid_subject_attribute_mapping": "Invalid subject attribute mapping",
@@ -108,6 +123,10 @@ var defaultMessages = map[string]string{
 	"error.agentservice.pkce_requires_authorization_code_description": "PKCE can only be enabled when the authorization_code grant type is selected",
 	"error.agentservice.private_key_jwt_cannot_have_client_secret_description": "private_key_jwt authentication method cannot have a client secret",
 	"error.agentservice.private_key_jwt_requires_certificate_description": "private_key_jwt authentication method requires a certificate",
+	"error.agentservice.tls_client_auth_requires_subject_description": "tls_client_auth authentication method requires exactly one certificate subject",
+	"error.agentservice.invalid_tls_client_auth_subject_description": "tls_client_auth certificate subject is malformed",
+	"error.agentservice.self_signed_tls_client_auth_requires_certificate_description": "self_signed_tls_client_auth authentication method requires a certificate",
+	"error.agentservice.mutual_tls_cannot_have_client_secret_description": "mutual-TLS authentication methods cannot have a client secret",
 	"error.agentservice.public_client_must_have_pkce_description": "Public clients must have PKCE required set to true",
 	"error.agentservice.public_client_must_use_none_auth_description": "Public clients must use 'none' as token endpoint authentication method",
 	"error.agentservice.redirect_uri_fragment_not_allowed_description": "Redirect URIs must not contain a fragment component",
@@ -115,6 +134,11 @@ var defaultMessages = map[string]string{
 	"error.agentservice.response_types_require_authorization_code_description": "Response types can only be configured with the authorization_code grant type",
 	"error.agentservice.schema_validation_failed": "Schema validation failed",
 	"error.agentservice.schema_validation_failed_description": "The provided attributes failed schema validation",
+	"error.agentservice.signed_request_object_requires_certificate_description": "requiring signed request objects needs a certificate to verify them",
+	"error.agentservice.invalid_logout_uri_description": "logout URIs must be absolute http or https URIs without a fragment",
+	"error.agentservice.invalid_subject_type_description": "subject type must be either 'public' or 'pairwise'",
+	"error.agentservice.invalid_sector_identifier_uri_description": "sector identifier URI must be an absolute https URI without a fragment",
+	"error.agentservice.pairwise_requires_sector_identifier_description": "pairwise subject type requires a sector identifier URI unless all redirect URIs share one host",
 	"error.agentservice.theme_not_found": "Theme not found",
 	"error.agentservice.theme_not_found_description": "The specified theme does not exist",
 	"error.agentservice.userinfo_alg_requires_response_type_description": "userinfo responseType is required when signingAlg or encryptionAlg is set",
@@ -146,6 +170,13 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.application_with_client_id_already_exists_description": "An application with the same client ID already exists",
 	"error.applicationservice.auth_code_requires_code_response_type_description": "authorization_code grant type requires 'code' response type",
 	"error.applicationservice.auth_code_requires_redirect_uris_description": "authorization_code grant type requires redirect URIs",
+	"error.applicationservice.authorization_response_encryption_alg_requires_enc_description": "authorizationResponse encryptionEnc is required when encryptionAlg is set",
+	"error.applicationservice.authorization_response_encryption_enc_requires_alg_description": "authorizationResponse encryptionAlg is required when encryptionEnc is set",
+	"error.applicationservice.authorization_response_encryption_requires_certificate_description": "a certificate (JWKS or JWKS_URI) is required when authorization response encryption is configured",
+	"error.applicationservice.authorization_response_jwks_uri_not_ssrf_safe_description": "authorization response JWKS URI must be a publicly reachable HTTPS URL",
+	"error.applicationservice.authorization_response_unsupported_encryption_alg_description": "authorization response encryption algorithm is not supported",
+	"error.applicationservice.authorization_response_unsupported_encryption_enc_description": "authorization response content-encryption algorithm is not supported",
+	"error.applicationservice.authorization_response_unsupported_signing_alg_description": "authorization response signing algorithm is not supported",
 	"error.applicationservice.cannot_modify_declarative_resource": "Cannot modify declarative resource",
 	"error.applicationservice.cannot_modify_declarative_resource_description": "The application is declarative and cannot be modified or deleted",
 	"error.applicationservice.certificate_operation_failed": "Certificate operation failed",
@@ -205,6 +236,8 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_registration_flow_id_description": "The provided registration flow ID is invalid",
 	"error.applicationservice.invalid_request_format": "Invalid request format",
 	"error.applicationservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.applicationservice.invalid_request_uri_description": "request URIs must be absolute https URIs",
+	"error.applicationservice.invalid_response_mode_description": "One or more provided response modes are invalid",
 	"error.applicationservice.invalid_response_type": "Invalid response type",
 	"error.applicationservice.invalid_response_type_description": "One or more provided response types are invalid",
 	"error.applicationservice.invalid_subject_attribute_mapping": "Invalid subject attribute mapping",
@@ -229,12 +262,21 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.pkce_requires_authorization_code_description": "PKCE can only be enabled when the authorization_code grant type is selected",
 	"error.applicationservice.private_key_jwt_cannot_have_client_secret_description": "private_key_jwt authentication method cannot have a client secret",
 	"error.applicationservice.private_key_jwt_requires_certificate_description": "private_key_jwt authentication method requires a certificate",
+	"error.applicationservice.tls_client_auth_requires_subject_description": "tls_client_auth authentication method requires exactly one certificate subject",
+	"error.applicationservice.invalid_tls_client_auth_subject_description": "tls_client_auth certificate subject is malformed",
+	"error.applicationservice.self_signed_tls_client_auth_requires_certificate_description": "self_signed_tls_client_auth authentication method requires a certificate",
+	"error.applicationservice.mutual_tls_cannot_have_client_secret_description": "mutual-TLS authentication methods cannot have a client secret",
 	"error.applicationservice.public_client_must_have_pkce_description": "Public clients must have PKCE required set to true",
 	"error.applicationservice.public_client_must_use_none_auth_description": "Public clients must use 'none' as token endpoint authentication method",
 	"error.applicationservice.redirect_uri_fragment_not_allowed_description": "Redirect URIs must not contain a fragment component",
 	"error.applicationservice.refresh_token_requires_token_issuing_grant_description": "refresh_token grant type requires a token-issuing grant type",
 	"error.applicationservice.response_types_require_authorization_code_description": "Response types can only be configured with the authorization_code grant type",
 	"error.applicationservice.result_limit_exceeded": "Result limit exceeded",
+	"error.applicationservice.signed_request_object_requires_certificate_description": "requiring signed request objects needs a certificate to verify them",
+	"error.applicationservice.invalid_logout_uri_description": "logout URIs must be absolute http or https URIs without a fragment",
+	"error.applicationservice.invalid_subject_type_description": "subject type must be either 'public' or 'pairwise'",
+	"error.applicationservice.invalid_sector_identifier_uri_description": "sector identifier URI must be an absolute https URI without a fragment",
+	"error.applicationservice.pairwise_requires_sector_identifier_description": "pairwise subject type requires a sector identifier URI unless all redirect URIs share one host",
 	"error.applicationservice.theme_not_found": "Theme not found",
 	"error.applicationservice.theme_not_found_description": "The specified theme configuration does not exist",
 	"error.applicationservice.userinfo_alg_requires_response_type_description": "userinfo responseType is required when signingAlg or encryptionAlg is set",
@@ -266,12 +308,20 @@ var defaultMessages = map[string]string{
 	"error.attributecache.missing_attributes_description": "Attributes are required",
 	"error.attributecache.missing_cache_id": "Missing cache ID",
 	"error.attributecache.missing_cache_id_description": "Cache ID is required",
+	"error.auditservice.invalid_filter": "Invalid filter parameter",
+	"error.auditservice.invalid_filter_description": "The filter parameter is invalid. Use format: attribute (eq|gt|lt) \"value\" on action, resourceType, resourceId, actorId, correlationId, sourceIp or timestamp",
+	"error.auditservice.invalid_limit_parameter": "Invalid limit parameter",
+	"error.auditservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.auditservice.invalid_offset_parameter": "Invalid offset parameter",
+	"error.auditservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
 	"error.auth.forbidden": "Forbidden",
 	"error.auth.forbidden_description": "You do not have sufficient permissions to access this resource",
 	"error.auth.unauthorized": "Unauthorized",
 	"error.auth.unauthorized_description": "Authentication is required to access this resource",
 	"error.authncredservice.invalid_request_format": "Invalid request format",
 	"error.authncredservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.authnmgrservice.account_locked": "Account locked",
+	"error.authnmgrservice.account_locked_description": "The account is temporarily locked due to repeated failed authentication attempts",
 	"error.authnmgrservice.ambiguous_user": "Ambiguous user",
 	"error.authnmgrservice.ambiguous_user_description": "Multiple users found matching the provided identifiers",
 	"error.authnmgrservice.authentication_failed": "Authentication failed",
@@ -302,6 +352,8 @@ var defaultMessages = map[string]string{
 	"error.authnotpservice.invalid_session_token_description": "The provided session token is invalid or empty",
 	"error.authnotpservice.unsupported_channel": "Unsupported channel",
 	"error.authnotpservice.unsupported_channel_description": "The provided channel is not supported for OTP authentication",
+	"error.authnservice.account_locked": "Account locked",
+	"error.authnservice.account_locked_description": "The account is temporarily locked due to repeated failed authentication attempts",
 	"error.authnservice.ambiguous_user": "Ambiguous user",
 	"error.authnservice.ambiguous_user_description": "Multiple users match the provided attributes",
 	"error.authnservice.assertion_subject_mismatch": "Assertion subject mismatch",
@@ -387,6 +439,8 @@ var defaultMessages = map[string]string{
 	"error.authzen.missing_resource_id_description": "Resource id is required",
 	"error.authzen.missing_subject": "Missing subject",
 	"error.authzen.missing_subject_description": "Subject id is required",
+	"error.captchaservice.internal_server_error": "Internal server error",
+	"error.captchaservice.verification_unavailable_description": "The captcha token could not be verified with the captcha provider",
 	"error.certservice.certificate_already_exists": "Certificate already exists",
 	"error.certservice.certificate_already_exists_description": "A certificate with the same reference type and ID already exists",
 	"error.certservice.certificate_not_found": "Certificate not found",
@@ -425,6 +479,8 @@ var defaultMessages = map[string]string{
 	"error.consentenforcerservice.purpose_fetch_failed_description": "Error while fetching consent purposes from the consent service",
 	"error.consentenforcerservice.purpose_update_failed": "Failed to update consent purpose",
 	"error.consentenforcerservice.purpose_update_failed_description": "Error while updating consent purpose in the consent service",
+	"error.consentservice.authentication_required": "Authentication required",
+	"error.consentservice.authentication_required_description": "The request must be made by an authenticated user",
 	"error.consentservice.consent_not_found": "Consent not found",
 	"error.consentservice.consent_not_found_description": "The consent with the specified id does not exist",
 	"error.consentservice.invalid_authorization_status": "Invalid authorization status",
@@ -445,6 +501,8 @@ var defaultMessages = map[string]string{
 	"error.dcr.invalid_redirect_uri_description": "One or more redirect URIs are invalid",
 	"error.dcr.invalid_request_format": "Invalid request format",
 	"error.dcr.invalid_request_format_description": "The request body is missing or has an invalid format",
+	"error.dcr.invalid_sector_identifier_uri": "Invalid sector identifier URI",
+	"error.dcr.invalid_sector_identifier_uri_description": "The sector_identifier_uri must return a JSON array containing every redirect URI",
 	"error.dcr.jwks_configuration_conflict": "JWKS configuration conflict",
 	"error.dcr.jwks_configuration_conflict_description": "Cannot specify both 'jwks' and 'jwks_uri' parameters",
 	"error.dcr.server_error": "Server error",
@@ -522,6 +580,14 @@ var defaultMessages = map[string]string{
 	"error.flow.graphbuilder.invalid_flow_data": "Invalid flow data",
 	"error.flow.graphbuilder.invalid_flow_data_description": "The flow definition contains invalid data",
 	"error.flow.graphbuilder.invalid_flow_data_nil_or_empty_description": "Flow definition is nil or has no nodes",
+	"error.flowanalyticsservice.invalid_interval": "Invalid interval",
+	"error.flowanalyticsservice.invalid_interval_description": "The interval must be either hour or day",
+	"error.flowanalyticsservice.invalid_time_range": "Invalid time range",
+	"error.flowanalyticsservice.invalid_time_range_description": "The from and to parameters must be RFC 3339 timestamps and from must be before to",
+	"error.flowanalyticsservice.invalid_version": "Invalid version",
+	"error.flowanalyticsservice.invalid_version_description": "The version must be a positive integer",
+	"error.flowanalyticsservice.time_range_too_large": "Time range too large",
+	"error.flowanalyticsservice.time_range_too_large_description": "The time range spans more than 744 buckets; use a shorter range or a larger interval",
 	"error.flowexecservice.administration_authentication_required": "Authentication required",
 	"error.flowexecservice.administration_authentication_required_description": "Administrative flows require an authenticated caller",
 	"error.flowexecservice.administration_permission_required": "Insufficient permissions",
@@ -554,12 +620,24 @@ var defaultMessages = map[string]string{
 	"error.flowexecservice.invalid_node_response_description": "Error response received from the node",
 	"error.flowexecservice.invalid_request_payload": "Invalid request payload",
 	"error.flowexecservice.invalid_request_payload_description": "Failed to decode request payload",
+	"error.flowexecservice.invalid_simulation_scenario": "Invalid simulation scenario",
+	"error.flowexecservice.invalid_simulation_scenario_description": "The simulation scenario is invalid",
+	"error.flowexecservice.invalid_simulation_scenario_stub_redirect_description": "A stub with the EXTERNAL_REDIRECTION status must have a redirect URL",
+	"error.flowexecservice.invalid_simulation_scenario_stub_status_description": "Unsupported stub status {{param(status)}}",
+	"error.flowexecservice.invalid_simulation_scenario_stub_target_description": "Each stub must target either a node or an executor",
+	"error.flowexecservice.invalid_simulation_scenario_stub_user_description": "A stub user must have an ID",
+	"error.flowexecservice.invalid_simulation_scenario_too_many_steps_description": "The scenario has more than {{param(max)}} steps",
 	"error.flowexecservice.max_call_depth_exceeded": "Maximum call depth exceeded",
 	"error.flowexecservice.max_call_depth_exceeded_description": "The maximum allowed call depth has been exceeded during flow execution",
 	"error.flowexecservice.recovery_not_allowed": "Recovery not allowed",
 	"error.flowexecservice.recovery_not_allowed_description": "Recovery flow is disabled for the application",
 	"error.flowexecservice.registration_not_allowed": "Registration not allowed",
 	"error.flowexecservice.registration_not_allowed_description": "Registration flow is disabled for the application",
+	"error.flowexecservice.simulated_executor_failure": "Simulated failure",
+	"error.flowexecservice.simulated_executor_failure_description": "The executor stub reported a failure",
+	"error.flowexecservice.simulated_executor_failure_reason_description": "{{param(reason)}}",
+	"error.flowexecservice.simulation_limit_exceeded": "Simulation limit exceeded",
+	"error.flowexecservice.simulation_limit_exceeded_description": "The simulated run exceeded the maximum number of node executions",
 	"error.flowmetaservice.application_fetch_failed_description": "Failed to retrieve application information",
 	"error.flowmetaservice.application_not_found_description": "The specified application does not exist",
 	"error.flowmetaservice.internal_server_error": "Internal server error",
@@ -588,6 +666,13 @@ var defaultMessages = map[string]string{
 	"error.flowmgtservice.checkpoint_ref_not_session_description": "Node '{{param(nodeID)}}': checkpointRef must reference a SessionExecutor node, got '{{param(targetNodeID)}}'",
 	"error.flowmgtservice.checkpoint_ref_not_string_description": "Node '{{param(nodeID)}}': checkpointRef must be a string",
 	"error.flowmgtservice.companion_executor_missing_description": "Executor '{{param(executorName)}}' requires executor '{{param(companionExecutorName)}}' in the same flow",
+	"error.flowmgtservice.decision_branch_duplicate_label_description": "DECISION node '{{param(nodeID)}}' has more than one branch labeled '{{param(label)}}'",
+	"error.flowmgtservice.decision_branch_incomplete_description": "Every branch of DECISION node '{{param(nodeID)}}' must have a label and a next node",
+	"error.flowmgtservice.decision_branch_invalid_expression_description": "Branch '{{param(label)}}' of DECISION node '{{param(nodeID)}}' has an invalid expression: {{param(error)}}",
+	"error.flowmgtservice.decision_node_has_inapplicable_properties_description": "DECISION node '{{param(nodeID)}}' must only route through its decision branches and default; executor, prompts, flow, onSuccess, onFailure, onIncomplete and next are not allowed",
+	"error.flowmgtservice.decision_node_missing_branches_description": "DECISION node '{{param(nodeID)}}' must have at least one decision branch",
+	"error.flowmgtservice.decision_node_missing_default_description": "DECISION node '{{param(nodeID)}}' must have a default node",
+	"error.flowmgtservice.decision_on_non_decision_node_description": "Node '{{param(nodeID)}}' must not have decision unless it is a DECISION node",
 	"error.flowmgtservice.duplicate_end_node_description": "Flow definition must have exactly one END node, found multiple",
 	"error.flowmgtservice.duplicate_flow_handle": "Duplicate flow handle",
 	"error.flowmgtservice.duplicate_flow_handle_description": "A flow with this handle already exists for the given flow type",
@@ -670,6 +755,8 @@ var defaultMessages = map[string]string{
 	"error.flowmgtservice.prompt_node_missing_prompts_or_next_description": "PROMPT node '{{param(nodeID)}}' must have either prompts or next",
 	"error.flowmgtservice.regex_rule_value_not_string_description": "Node '{{param(nodeID)}}': input '{{param(inputID)}}' regex validation rule value must be a string",
 	"error.flowmgtservice.required_executor_missing_description": "Flow type {{param(flowType)}} requires executor '{{param(executorName)}}'",
+	"error.flowmgtservice.script_executor_invalid_script_description": "ScriptExecutor node '{{param(nodeID)}}' has an invalid script: {{param(error)}}",
+	"error.flowmgtservice.script_executor_invalid_timeout_description": "ScriptExecutor node '{{param(nodeID)}}' has an invalid timeoutMs: {{param(error)}}",
 	"error.flowmgtservice.start_node_has_executor_description": "START node '{{param(nodeID)}}' must not have an executor",
 	"error.flowmgtservice.start_node_has_on_failure_description": "START node '{{param(nodeID)}}' must not have onFailure",
 	"error.flowmgtservice.start_node_has_on_incomplete_description": "START node '{{param(nodeID)}}' must not have onIncomplete",
@@ -682,6 +769,26 @@ var defaultMessages = map[string]string{
 	"error.flowmgtservice.unsupported_executor_flow_type_description": "Node '{{param(nodeID)}}': executor '{{param(executorName)}}' is not compatible with flow type '{{param(flowType)}}'",
 	"error.flowmgtservice.unsupported_executor_mode_description": "Node '{{param(nodeID)}}': executor '{{param(executorName)}}' does not support mode '{{param(mode)}}'",
 	"error.flowmgtservice.unsupported_executor_property_description": "Node '{{param(nodeID)}}': executor '{{param(executorName)}}' does not support property '{{param(propertyKey)}}'",
+	"error.flowrolloutservice.invalid_percentage": "Invalid rollout percentage",
+	"error.flowrolloutservice.invalid_percentage_description": "The percentage must be between 0 and 100",
+	"error.flowrolloutservice.invalid_request_format": "Invalid request format",
+	"error.flowrolloutservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.flowrolloutservice.invalid_versions": "Invalid rollout versions",
+	"error.flowrolloutservice.invalid_versions_description": "The stable version is required and the candidate version must differ from it",
+	"error.flowrolloutservice.rollout_not_found": "Flow rollout not found",
+	"error.flowrolloutservice.rollout_not_found_description": "No rollout is in progress for the flow",
+	"error.flowsimulationservice.invalid_flow_selection": "Invalid flow selection",
+	"error.flowsimulationservice.invalid_flow_selection_description": "Provide either a flow version or a draft definition, not both",
+	"error.flowsimulationservice.invalid_request_format": "Invalid request format",
+	"error.flowsimulationservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.flowsimulationservice.invalid_scenario_name": "Invalid scenario name",
+	"error.flowsimulationservice.invalid_scenario_name_description": "The name is required and at most 255 characters; the description is at most 1024",
+	"error.flowsimulationservice.invalid_scenario_selection": "Invalid scenario selection",
+	"error.flowsimulationservice.invalid_scenario_selection_description": "Provide either an inline scenario or the id of a saved scenario",
+	"error.flowsimulationservice.scenario_not_found": "Simulation scenario not found",
+	"error.flowsimulationservice.scenario_not_found_description": "No simulation scenario exists for the supplied identifier in this flow",
+	"error.flowsimulationservice.scenario_required": "Scenario required",
+	"error.flowsimulationservice.scenario_required_description": "A saved scenario must define its steps and the outcome it expects",
 	"error.groupservice.cannot_create_group_in_declarative_only_mode": "Cannot create group in declarative-only mode",
 	"error.groupservice.cannot_create_group_in_declarative_only_mode_description": "Group creation is not allowed when running in declarative-only mode. Groups must be defined in declarative configuration files",
 	"error.groupservice.cannot_modify_declarative_group": "Cannot modify declarative group",
@@ -926,6 +1033,8 @@ var defaultMessages = map[string]string{
 	"error.passkeyservice.invalid_authenticator_response_description": "The authenticator response is missing required fields (clientDataJSON, authenticatorData, or signature)",
 	"error.passkeyservice.invalid_challenge": "Invalid challenge",
 	"error.passkeyservice.invalid_challenge_description": "The challenge in the response does not match the expected challenge",
+	"error.passkeyservice.invalid_credential_name": "Invalid credential name",
+	"error.passkeyservice.invalid_credential_name_description": "The credential name must be non-empty and at most 64 characters long",
 	"error.passkeyservice.invalid_finish_data": "Invalid finish data",
 	"error.passkeyservice.invalid_finish_data_description": "The finish data cannot be null",
 	"error.passkeyservice.invalid_session_token": "Invalid session token",
@@ -938,6 +1047,46 @@ var defaultMessages = map[string]string{
 	"error.passkeyservice.session_expired_description": "The session has expired. Please start a new session",
 	"error.passkeyservice.user_not_found": "User not found",
 	"error.passkeyservice.user_not_found_description": "The specified user was not found",
+	"error.passwordpolicyservice.password_breached": "Password breached",
+	"error.passwordpolicyservice.password_breached_description": "The password has appeared in a data breach. Please choose a different password",
+	"error.passwordpolicyservice.password_changed_recently": "Password changed recently",
+	"error.passwordpolicyservice.password_changed_recently_description": "The password was changed too recently. Please try again later",
+	"error.passwordpolicyservice.password_reused": "Password reused",
+	"error.passwordpolicyservice.password_reused_description": "The password must not match any of the last {{param(historyCount)}} passwords",
+	"error.passwordpolicyservice.password_too_long": "Password too long",
+	"error.passwordpolicyservice.password_too_long_description": "The password must be at most {{param(maxLength)}} characters long",
+	"error.passwordpolicyservice.password_too_short": "Password too short",
+	"error.passwordpolicyservice.password_too_short_description": "The password must be at least {{param(minLength)}} characters long",
+	"error.passwordpolicyservice.password_too_weak": "Password too weak",
+	"error.passwordpolicyservice.password_too_weak_description": "The password must contain at least {{param(count)}} {{param(characterClass)}} characters",
+	"error.passwordpolicyservice.user_not_found": "User not found",
+	"error.passwordpolicyservice.user_not_found_description": "The specified user does not exist",
+	"error.policyservice.invalid_condition": "Invalid policy condition",
+	"error.policyservice.invalid_condition_description": "A policy condition has an unknown operator, an unsupported attribute or values that do not suit the operator",
+	"error.policyservice.invalid_effect": "Invalid policy effect",
+	"error.policyservice.invalid_effect_description": "The policy effect must be either PERMIT or DENY",
+	"error.policyservice.invalid_request": "Invalid request",
+	"error.policyservice.invalid_request_description": "The policy request is missing required fields or is malformed",
+	"error.policyservice.policy_already_exists": "Policy already exists",
+	"error.policyservice.policy_already_exists_description": "An authorization policy with the supplied handle already exists",
+	"error.policyservice.policy_immutable": "Policy is immutable",
+	"error.policyservice.policy_immutable_description": "The authorization policy is defined in declarative configuration and cannot be modified or deleted",
+	"error.policyservice.policy_not_found": "Policy not found",
+	"error.policyservice.policy_not_found_description": "No authorization policy exists for the supplied identifier",
+	"error.policyservice.result_limit_exceeded": "Result limit exceeded",
+	"error.policyservice.result_limit_exceeded_description": "The number of authorization policies exceeds the supported limit in hybrid mode",
+	"error.rebacservice.invalid_request": "Invalid request",
+	"error.rebacservice.invalid_request_description": "The relationship request is missing required fields or is malformed",
+	"error.rebacservice.invalid_tuple": "Invalid relation tuple",
+	"error.rebacservice.invalid_tuple_description": "The relation does not accept the subject type directly",
+	"error.rebacservice.invalid_type_definition": "Invalid type definition",
+	"error.rebacservice.invalid_type_definition_description": "Relations must have unique names, each rewrite must set exactly one of computedUserset or tupleToUserset, and rewrites must reference relations of the type",
+	"error.rebacservice.resolution_too_deep": "Resolution depth exceeded",
+	"error.rebacservice.resolution_too_deep_description": "Resolving the relation exceeded the maximum depth of nested usersets",
+	"error.rebacservice.type_not_found": "Type not found",
+	"error.rebacservice.type_not_found_description": "The object type is not defined in the authorization model",
+	"error.rebacservice.unknown_relation": "Unknown relation",
+	"error.rebacservice.unknown_relation_description": "The relation is not defined on the object type",
 	"error.resourceservice.action_not_found": "Action not found",
 	"error.resourceservice.action_not_found_description": "The action with the specified id does not exist",
 	"error.resourceservice.cannot_delete": "Cannot delete",
@@ -954,6 +1103,10 @@ var defaultMessages = map[string]string{
 	"error.resourceservice.handle_conflict_description": "The same handle already exists within the specified resource",
 	"error.resourceservice.identifier_conflict": "Identifier conflict",
 	"error.resourceservice.identifier_conflict_description": "A resource server with the same identifier already exists",
+	"error.resourceservice.invalid_authorization_details": "Invalid authorization details",
+	"error.resourceservice.invalid_authorization_details_description": "The authorization details type is not supported by the resource server or the object does not conform to its schema",
+	"error.resourceservice.invalid_authorization_details_type": "Invalid authorization details type",
+	"error.resourceservice.invalid_authorization_details_type_description": "Each authorization details type must have a unique name and a valid JSON Schema",
 	"error.resourceservice.invalid_delimiter": "Invalid delimiter",
 	"error.resourceservice.invalid_delimiter_description": "Delimiter must be a single valid character (a-z A-Z 0-9 . _ : - /)",
 	"error.resourceservice.invalid_handle": "Invalid handle",
@@ -1021,6 +1174,16 @@ var defaultMessages = map[string]string{
 	"error.templateservice.template_not_found": "Template not found",
 	"error.templateservice.template_not_found_description": "The requested template does not exist for the given scenario",
 	"error.themeservice.invalid_limit_value_description": "Limit must be between 1 and {{param(max)}}",
+	"error.totpservice.invalid_code": "Invalid code",
+	"error.totpservice.invalid_code_description": "The provided code is incorrect, expired or has already been used",
+	"error.totpservice.invalid_request": "Invalid request",
+	"error.totpservice.invalid_request_description": "The user ID and a TOTP or recovery code are required",
+	"error.totpservice.not_enrolled": "Authenticator app not enrolled",
+	"error.totpservice.not_enrolled_description": "The user has not enrolled an authenticator app",
+	"error.totpservice.session_expired": "Session expired",
+	"error.totpservice.session_expired_description": "The enrollment session has expired. Please start the enrollment again",
+	"error.totpservice.user_not_found": "User not found",
+	"error.totpservice.user_not_found_description": "The specified user does not exist",
 	"error.unauthorized": "Unauthorized",
 	"error.unauthorized_description": "The caller is not authorized to perform this operation",
 	"error.userinfoservice.client_credentials_not_supported": "Invalid access token",
@@ -1109,6 +1272,28 @@ var defaultMessages = map[string]string{
 	"error.vp.definition_result_limit_exceeded_description": "The number of presentation definitions exceeds the supported limit in hybrid mode. Use search for larger datasets",
 	"error.vp.definition_unsupported_format": "Unsupported credential format",
 	"error.vp.definition_unsupported_format_description": "Only the dc+sd-jwt credential format is supported",
+	"error.webhookservice.delivery_not_found": "Delivery not found",
+	"error.webhookservice.delivery_not_found_description": "No dead-lettered delivery exists for the supplied identifier",
+	"error.webhookservice.invalid_categories": "Invalid event categories",
+	"error.webhookservice.invalid_categories_description": "At least one event category is required, and every category must be a known observability event category",
+	"error.webhookservice.invalid_limit_parameter": "Invalid limit parameter",
+	"error.webhookservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.webhookservice.invalid_name": "Invalid webhook name",
+	"error.webhookservice.invalid_name_description": "The webhook name is required and must be at most 255 characters",
+	"error.webhookservice.invalid_offset_parameter": "Invalid offset parameter",
+	"error.webhookservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
+	"error.webhookservice.invalid_request_format": "Invalid request format",
+	"error.webhookservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.webhookservice.invalid_secret": "Invalid webhook secret",
+	"error.webhookservice.invalid_secret_description": "HMAC_SHA256 signed webhooks require a secret of at least 32 characters",
+	"error.webhookservice.invalid_signature_type": "Invalid signature type",
+	"error.webhookservice.invalid_signature_type_description": "The signature type must be either HMAC_SHA256 or JWS",
+	"error.webhookservice.invalid_url": "Invalid webhook URL",
+	"error.webhookservice.invalid_url_description": "The webhook URL must be an absolute https URL that does not name a private address",
+	"error.webhookservice.webhook_not_found": "Webhook not found",
+	"error.webhookservice.webhook_not_found_description": "No webhook endpoint exists for the supplied identifier",
+	"flows.executor.errors.account_locked": "Account locked",
+	"flows.executor.errors.account_locked_desc": "The account is temporarily locked due to repeated failed sign-in attempts. Try again later",
 	"flows.executor.errors.ambiguous_user_identity": "Ambiguous user identity",
 	"flows.executor.errors.ambiguous_user_identity_desc": "User identity is ambiguous and cannot be determined",
 	"flows.executor.errors.attribute_collect_failed": "Failed to update user attributes",
@@ -1189,6 +1374,8 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.invalid_passkey_desc": "The passkey credentials provided are invalid",
 	"flows.executor.errors.invalid_revocation_mode": "Invalid revocation mode",
 	"flows.executor.errors.invalid_revocation_mode_desc": "The requested revocation mode is not supported for this action",
+	"flows.executor.errors.invalid_totp_code": "Invalid code",
+	"flows.executor.errors.invalid_totp_code_desc": "The authenticator app or recovery code provided is invalid",
 	"flows.executor.errors.invalid_user_type": "Invalid user type",
 	"flows.executor.errors.invalid_user_type_desc": "The provided user type is not valid",
 	"flows.executor.errors.invite_token_generation_failed": "Failed to generate invite token",
@@ -1197,6 +1384,8 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.magic_link_generation_failed_desc": "Failed to generate the magic link",
 	"flows.executor.errors.max_otp_attempts_reached": "Maximum OTP attempts reached",
 	"flows.executor.errors.max_otp_attempts_reached_desc": "The maximum number of OTP verification attempts has been reached",
+	"flows.executor.errors.max_totp_attempts_reached": "Maximum code attempts reached",
+	"flows.executor.errors.max_totp_attempts_reached_desc": "The maximum number of authenticator app or recovery code attempts has been reached",
 	"flows.executor.errors.no_live_sso_session": "No live SSO session",
 	"flows.executor.errors.no_live_sso_session_desc": "No live, compatible SSO session exists for this flow; full authentication is required",
 	"flows.executor.errors.no_registered_passkeys": "No registered passkeys found",
@@ -1231,6 +1420,8 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.passkey_auth_failed_desc": "An error occurred while authenticating with the passkey",
 	"flows.executor.errors.passkey_registration_failed": "Passkey registration failed",
 	"flows.executor.errors.passkey_registration_failed_desc": "An error occurred while registering the passkey",
+	"flows.executor.errors.password_policy_violation": "Password policy violation",
+	"flows.executor.errors.password_policy_violation_desc": "The password does not meet the password policy",
 	"flows.executor.errors.prerequisites_failed": "Prerequisites validation failed",
 	"flows.executor.errors.prerequisites_failed_desc": "The prerequisites for this operation have not been met",
 	"flows.executor.errors.provisioning_assignment_failed": "Failed to assign groups and roles",
@@ -1241,6 +1432,14 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.provisioning_failed_desc": "An error occurred while provisioning the user",
 	"flows.executor.errors.provisioning_user_attrs_missing": "No user attributes provided for provisioning",
 	"flows.executor.errors.provisioning_user_attrs_missing_desc": "User attributes are required to provision a new user",
+	"flows.executor.errors.script_config_invalid": "Configuration error",
+	"flows.executor.errors.script_config_invalid_desc": "The script executor configuration is invalid",
+	"flows.executor.errors.script_execution_failed": "Script execution failed",
+	"flows.executor.errors.script_execution_failed_desc": "The script could not be completed",
+	"flows.executor.errors.script_failed": "Request denied",
+	"flows.executor.errors.script_failed_desc": "{{param(message)}}",
+	"flows.executor.errors.script_failure_outcome": "Script failure outcome",
+	"flows.executor.errors.script_failure_outcome_desc": "The script chose the failure outcome",
 	"flows.executor.errors.self_reg_disabled_for_user_type": "Self-registration is disabled for the user type",
 	"flows.executor.errors.self_reg_disabled_for_user_type_desc": "Self-registration is not enabled for the selected user type",
 	"flows.executor.errors.self_reg_not_available_for_app": "Self-registration not available for this application",
@@ -1255,6 +1454,8 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.sms_recipient_missing_desc": "An SMS recipient must be provided to send the notification",
 	"flows.executor.errors.sms_template_missing": "SMS template is required",
 	"flows.executor.errors.sms_template_missing_desc": "An SMS template must be provided to send the notification",
+	"flows.executor.errors.totp_enrollment_failed": "Authenticator app enrollment failed",
+	"flows.executor.errors.totp_enrollment_failed_desc": "An error occurred while enrolling the authenticator app",
 	"flows.executor.errors.user_already_exists": "User already exists",
 	"flows.executor.errors.user_already_exists_desc": "A user already exists with the provided attributes",
 	"flows.executor.errors.user_already_exists_in_target_ou": "User already exists in the target organization",
@@ -54,6 +54,9 @@ var (
 			`VALUES ($1, $2, $3, $4, $5) ` +
 			`ON CONFLICT (DEPLOYMENT_ID, NAMESPACE, MESSAGE_KEY, LANGUAGE_CODE) ` +
 			`DO UPDATE SET VALUE = excluded.VALUE, UPDATED_AT = datetime('now')`,
+		MySQLQuery: `INSERT INTO "TRANSLATION" (MESSAGE_KEY, LANGUAGE_CODE, NAMESPACE, VALUE, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5) ` +
+			`ON DUPLICATE KEY UPDATE VALUE = VALUES(VALUE), UPDATED_AT = CURRENT_TIMESTAMP`,
 	}
 
 	// queryDeleteTranslation deletes a translation by language, key, and namespace.
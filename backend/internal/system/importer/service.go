@@ -987,13 +987,25 @@ func applicationRequestToDTO(req *appmodel.ApplicationRequestWithID) *appmodel.A
 					PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
 					GrantTypes:                         config.OAuthConfig.GrantTypes,
 					ResponseTypes:                      config.OAuthConfig.ResponseTypes,
+					ResponseModes:                      config.OAuthConfig.ResponseModes,
 					TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 					PKCERequired:                       config.OAuthConfig.PKCERequired,
 					PublicClient:                       config.OAuthConfig.PublicClient,
 					RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+					RequireSignedRequestObject:         config.OAuthConfig.RequireSignedRequestObject,
+					RequestURIs:                        config.OAuthConfig.RequestURIs,
+					BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+					BackchannelLogoutSessionRequired:   config.OAuthConfig.BackchannelLogoutSessionRequired,
+					FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
+					FrontchannelLogoutSessionRequired:  config.OAuthConfig.FrontchannelLogoutSessionRequired,
+					TLSClientAuth:                      config.OAuthConfig.TLSClientAuth,
+					MTLSBoundAccessTokens:              config.OAuthConfig.MTLSBoundAccessTokens,
+					SubjectType:                        config.OAuthConfig.SubjectType,
+					SectorIdentifierURI:                config.OAuthConfig.SectorIdentifierURI,
 					Token:                              config.OAuthConfig.Token,
 					Scopes:                             config.OAuthConfig.Scopes,
 					UserInfo:                           config.OAuthConfig.UserInfo,
+					AuthorizationResponse:              config.OAuthConfig.AuthorizationResponse,
 					ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 					Certificate:                        config.OAuthConfig.Certificate,
 					AcrValues:                          config.OAuthConfig.AcrValues,
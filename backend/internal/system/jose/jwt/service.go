@@ -39,6 +39,7 @@ type JWTServiceInterface interface {
 	VerifyJWTSignatureWithPublicKey(ctx context.Context, jwtToken string,
 		keyRef providers.KeyRef) *tidcommon.ServiceError
 	VerifyJWTSignatureWithJWKS(ctx context.Context, jwtToken string, jwksURL string) *tidcommon.ServiceError
+	GetJWKS(ctx context.Context, jwksURL string) ([]map[string]interface{}, *tidcommon.ServiceError)
 }
 
 // jwksCacheEntry holds a cached JWKS response with its expiry time.
@@ -375,6 +376,13 @@ func (js *jwtService) VerifyJWTSignatureWithJWKS(
 	return nil
 }
 
+// GetJWKS returns the keys of the JWK Set (JWKS) published at the given URL. Responses are cached for the
+// configured JWKS cache TTL.
+func (js *jwtService) GetJWKS(
+	ctx context.Context, jwksURL string) ([]map[string]interface{}, *tidcommon.ServiceError) {
+	return js.getJWKSKeys(ctx, jwksURL)
+}
+
 // getJWKSKeys returns JWKS keys for the given URL, using a TTL-based cache.
 func (js *jwtService) getJWKSKeys(
 	ctx context.Context, jwksURL string) ([]map[string]interface{}, *tidcommon.ServiceError) {
@@ -124,6 +124,21 @@ func (m *MockJWTService) VerifyJWTSignatureWithJWKS(
 	return args.Get(0).(*tidcommon.ServiceError)
 }
 
+func (m *MockJWTService) GetJWKS(
+	ctx context.Context,
+	jwksURL string,
+) ([]map[string]interface{}, *tidcommon.ServiceError) {
+	args := m.Called(ctx, jwksURL)
+	var keys []map[string]interface{}
+	if args.Get(0) != nil {
+		keys = args.Get(0).([]map[string]interface{})
+	}
+	if args.Get(1) == nil {
+		return keys, nil
+	}
+	return keys, args.Get(1).(*tidcommon.ServiceError)
+}
+
 type TokenVerifierTestSuite struct {
 	suite.Suite
 }
@@ -0,0 +1,97 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+// forwardedForHeader is the header in which proxies append the address of the client they received
+// the request from.
+const forwardedForHeader = "X-Forwarded-For"
+
+// ClientIPMiddleware stores the IP address of the requesting client in the request context, for
+// per-client controls such as failed-attempt throttling. The address is taken from the connection
+// (RemoteAddr). When the connection comes from one of trustedProxies, the X-Forwarded-For header is
+// read from right to left, skipping trusted proxies, and the first untrusted address is the client.
+// Entries added before an untrusted hop are client controlled and are never used.
+func ClientIPMiddleware(trustedProxies []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if clientIP := extractClientIP(r, trustedProxies); clientIP != "" {
+				r = r.WithContext(sysContext.WithClientIP(r.Context(), clientIP))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ParseTrustedProxies parses the configured trusted proxies. Each entry is an IP address or a CIDR
+// range.
+func ParseTrustedProxies(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// extractClientIP returns the address of the client that sent the request, resolved through the
+// trusted proxies in front of the server.
+func extractClientIP(r *http.Request, trustedProxies []netip.Prefix) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer, err := netip.ParseAddr(host)
+	if err != nil || !isTrustedProxy(peer, trustedProxies) {
+		return host
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values(forwardedForHeader), ","), ",")
+	clientIP := peer.Unmap().String()
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			// A malformed entry cannot be attributed; the last trusted hop is the best known client.
+			break
+		}
+		clientIP = hop.Unmap().String()
+		if !isTrustedProxy(hop, trustedProxies) {
+			break
+		}
+	}
+	return clientIP
+}
+
+// isTrustedProxy reports whether addr is within one of the trusted proxy ranges.
+func isTrustedProxy(addr netip.Addr, trustedProxies []netip.Prefix) bool {
+	addr = addr.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+func TestClientIPMiddleware_StoresRemoteAddrHost(t *testing.T) {
+	var clientIP string
+	handler := ClientIPMiddleware(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientIP = sysContext.GetClientIP(r.Context())
+	}))
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = "192.0.2.10:54321"
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if clientIP != "192.0.2.10" {
+		t.Errorf("Expected client IP 192.0.2.10, got %q", clientIP)
+	}
+}
+
+func TestClientIPMiddleware_IPv6RemoteAddr(t *testing.T) {
+	var clientIP string
+	handler := ClientIPMiddleware(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientIP = sysContext.GetClientIP(r.Context())
+	}))
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = "[2001:db8::1]:443"
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if clientIP != "2001:db8::1" {
+		t.Errorf("Expected client IP 2001:db8::1, got %q", clientIP)
+	}
+}
+
+func TestClientIPMiddleware_IgnoresForwardedHeader(t *testing.T) {
+	var clientIP string
+	handler := ClientIPMiddleware(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientIP = sysContext.GetClientIP(r.Context())
+	}))
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = "192.0.2.10:54321"
+	req.Header.Set("X-Forwarded-For", "198.51.100.7")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if clientIP != "192.0.2.10" {
+		t.Errorf("Expected client IP 192.0.2.10, got %q", clientIP)
+	}
+}
+
+func TestClientIPMiddleware_TrustedProxies(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		expectedIP   string
+	}{
+		{"untrusted peer ignores header", "198.51.100.1:443", []string{"203.0.113.5"}, "198.51.100.1"},
+		{"trusted peer uses forwarded client", "10.1.2.3:443", []string{"203.0.113.5"}, "203.0.113.5"},
+		{"skips trusted hops", "10.1.2.3:443", []string{"203.0.113.5, 192.0.2.1", "10.4.5.6"}, "203.0.113.5"},
+		{"ignores spoofed entries before the client", "10.1.2.3:443", []string{"1.1.1.1, 203.0.113.5"},
+			"203.0.113.5"},
+		{"trusted peer without header", "10.1.2.3:443", nil, "10.1.2.3"},
+		{"malformed entry stops at last trusted hop", "10.1.2.3:443", []string{"203.0.113.5, bogus"},
+			"10.1.2.3"},
+		{"all hops trusted", "10.1.2.3:443", []string{"10.9.9.9"}, "10.9.9.9"},
+		{"ipv4-mapped peer", "[::ffff:10.1.2.3]:443", []string{"203.0.113.5"}, "203.0.113.5"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var clientIP string
+			handler := ClientIPMiddleware(trustedProxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				clientIP = sysContext.GetClientIP(r.Context())
+			}))
+
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.RemoteAddr = tc.remoteAddr
+			for _, value := range tc.forwardedFor {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if clientIP != tc.expectedIP {
+				t.Errorf("Expected client IP %s, got %q", tc.expectedIP, clientIP)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies_Invalid(t *testing.T) {
+	for _, value := range []string{"10.0.0.0/33", "not-an-ip", ""} {
+		if _, err := ParseTrustedProxies([]string{value}); err == nil {
+			t.Errorf("Expected an error for trusted proxy %q", value)
+		}
+	}
+}
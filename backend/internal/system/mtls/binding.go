@@ -0,0 +1,90 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+)
+
+const (
+	// claimConfirmation is the confirmation claim (RFC 7800) that carries a token's binding.
+	claimConfirmation = "cnf"
+	// confirmationX5tS256 is the confirmation member holding the SHA-256 thumbprint of the certificate
+	// a token is bound to (RFC 8705 §3.1).
+	confirmationX5tS256 = "x5t#S256"
+)
+
+var (
+	// ErrCertificateRequired is returned when a certificate-bound token is presented without a client
+	// certificate.
+	ErrCertificateRequired = errors.New("client certificate required for certificate-bound token")
+	// ErrCertificateMismatch is returned when a certificate-bound token is presented with a client
+	// certificate other than the one it is bound to.
+	ErrCertificateMismatch = errors.New("client certificate does not match token binding")
+)
+
+// Thumbprint returns the base64url encoded SHA-256 thumbprint of the DER encoding of a certificate.
+func Thumbprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// SetCnfThumbprint binds a token claims map to a certificate thumbprint under cnf.x5t#S256, keeping any
+// other confirmation members already present. No-op when thumbprint is empty.
+func SetCnfThumbprint(claims map[string]any, thumbprint string) {
+	if thumbprint == "" {
+		return
+	}
+	if cnf, ok := claims[claimConfirmation].(map[string]any); ok {
+		cnf[confirmationX5tS256] = thumbprint
+		return
+	}
+	claims[claimConfirmation] = map[string]any{confirmationX5tS256: thumbprint}
+}
+
+// ExtractCnfThumbprint returns the certificate thumbprint from the cnf.x5t#S256 confirmation claim of a
+// token claims map. Returns "" with no error when the token is not certificate-bound. Returns an error
+// when cnf is present but not an object, or when x5t#S256 is present but not a non-empty string.
+func ExtractCnfThumbprint(claims map[string]any) (string, error) {
+	cnfRaw, exists := claims[claimConfirmation]
+	if !exists {
+		return "", nil
+	}
+	cnf, ok := cnfRaw.(map[string]any)
+	if !ok {
+		return "", errors.New("invalid 'cnf' claim: must be an object")
+	}
+	thumbprintRaw, exists := cnf[confirmationX5tS256]
+	if !exists {
+		return "", nil
+	}
+	thumbprint, ok := thumbprintRaw.(string)
+	if !ok || thumbprint == "" {
+		return "", errors.New("invalid 'cnf.x5t#S256' claim")
+	}
+	return thumbprint, nil
+}
+
+// VerifyBinding checks that a token's certificate binding, if any, is satisfied by the client certificate
+// presented with it. A token without a cnf.x5t#S256 member is not certificate-bound and always passes.
+func VerifyBinding(claims map[string]any, cert *x509.Certificate) error {
+	boundThumbprint, err := ExtractCnfThumbprint(claims)
+	if err != nil {
+		return err
+	}
+	if boundThumbprint == "" {
+		return nil
+	}
+	if cert == nil {
+		return ErrCertificateRequired
+	}
+	if subtle.ConstantTimeCompare([]byte(Thumbprint(cert)), []byte(boundThumbprint)) != 1 {
+		return ErrCertificateMismatch
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BindingTestSuite struct {
+	suite.Suite
+	cert *x509.Certificate
+}
+
+func TestBindingTestSuite(t *testing.T) {
+	suite.Run(t, new(BindingTestSuite))
+}
+
+func (suite *BindingTestSuite) SetupTest() {
+	suite.cert = &x509.Certificate{Raw: []byte("certificate-der")}
+}
+
+func (suite *BindingTestSuite) TestThumbprint() {
+	sum := sha256.Sum256([]byte("certificate-der"))
+	suite.Equal(base64.RawURLEncoding.EncodeToString(sum[:]), Thumbprint(suite.cert))
+}
+
+func (suite *BindingTestSuite) TestSetCnfThumbprint_MergesExistingConfirmation() {
+	claims := map[string]any{"cnf": map[string]any{"jkt": "key-thumbprint"}}
+	SetCnfThumbprint(claims, "cert-thumbprint")
+
+	suite.Equal(map[string]any{"jkt": "key-thumbprint", "x5t#S256": "cert-thumbprint"}, claims["cnf"])
+}
+
+func (suite *BindingTestSuite) TestSetCnfThumbprint_EmptyIsNoOp() {
+	claims := map[string]any{}
+	SetCnfThumbprint(claims, "")
+
+	suite.NotContains(claims, "cnf")
+}
+
+func (suite *BindingTestSuite) TestExtractCnfThumbprint() {
+	testCases := []struct {
+		name     string
+		claims   map[string]any
+		expected string
+		wantErr  bool
+	}{
+		{"NotBound", map[string]any{}, "", false},
+		{"DPoPBoundOnly", map[string]any{"cnf": map[string]any{"jkt": "x"}}, "", false},
+		{"CertificateBound", map[string]any{"cnf": map[string]any{"x5t#S256": "abc"}}, "abc", false},
+		{"CnfNotObject", map[string]any{"cnf": "abc"}, "", true},
+		{"ThumbprintNotString", map[string]any{"cnf": map[string]any{"x5t#S256": 1}}, "", true},
+		{"ThumbprintEmpty", map[string]any{"cnf": map[string]any{"x5t#S256": ""}}, "", true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			thumbprint, err := ExtractCnfThumbprint(tc.claims)
+			if tc.wantErr {
+				suite.Error(err)
+				return
+			}
+			suite.NoError(err)
+			suite.Equal(tc.expected, thumbprint)
+		})
+	}
+}
+
+func (suite *BindingTestSuite) TestVerifyBinding() {
+	bound := map[string]any{"cnf": map[string]any{"x5t#S256": Thumbprint(suite.cert)}}
+
+	suite.NoError(VerifyBinding(map[string]any{}, nil))
+	suite.NoError(VerifyBinding(bound, suite.cert))
+	suite.ErrorIs(VerifyBinding(bound, nil), ErrCertificateRequired)
+	suite.ErrorIs(VerifyBinding(bound, &x509.Certificate{Raw: []byte("other")}), ErrCertificateMismatch)
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mtls provides the mutual-TLS helpers used for OAuth 2.0 client authentication and
+// certificate-bound access tokens (RFC 8705): reading the client certificate presented on a request,
+// verifying it against the trusted client certificate authorities, and computing the certificate
+// thumbprint tokens are bound to.
+package mtls
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// ErrInvalidForwardedCertificate is returned when the forwarded client certificate header cannot be parsed.
+var ErrInvalidForwardedCertificate = errors.New("invalid forwarded client certificate")
+
+// ClientCertificateChain returns the certificate chain the client presented, leaf first. The chain is
+// read from the TLS connection, or, when the server sits behind a TLS-terminating proxy, from the
+// configured forwarded certificate header. It returns an empty chain when no certificate was presented.
+func ClientCertificateChain(r *http.Request) ([]*x509.Certificate, error) {
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		return r.TLS.PeerCertificates, nil
+	}
+	header := forwardedHeaderName()
+	if header == "" {
+		return nil, nil
+	}
+	value := r.Header.Get(header)
+	if value == "" {
+		return nil, nil
+	}
+	return parseForwardedCertificate(value)
+}
+
+// ClientCertificate returns the leaf certificate the client presented, or nil when none was presented
+// or the forwarded certificate cannot be parsed.
+func ClientCertificate(r *http.Request) *x509.Certificate {
+	chain, err := ClientCertificateChain(r)
+	if err != nil || len(chain) == 0 {
+		return nil
+	}
+	return chain[0]
+}
+
+// forwardedHeaderName returns the configured forwarded certificate header, or "" when none is configured.
+func forwardedHeaderName() string {
+	if !config.IsServerRuntimeInitialized() {
+		return ""
+	}
+	return strings.TrimSpace(config.GetServerRuntime().Config.TLS.ClientCertificate.ForwardedHeader)
+}
+
+// parseForwardedCertificate parses a forwarded client certificate. It accepts the RFC 9440 Client-Cert
+// byte sequence (":<base64 DER>:"), a PEM chain that may be URL-encoded (as forwarded by NGINX and most
+// load balancers), and a bare base64 DER certificate.
+func parseForwardedCertificate(value string) ([]*x509.Certificate, error) {
+	value = strings.TrimSpace(value)
+	if len(value) > 1 && strings.HasPrefix(value, ":") && strings.HasSuffix(value, ":") {
+		return parseBase64DER(value[1 : len(value)-1])
+	}
+	if strings.Contains(value, "%") {
+		unescaped, err := url.PathUnescape(value)
+		if err != nil {
+			return nil, ErrInvalidForwardedCertificate
+		}
+		value = unescaped
+	}
+	if strings.Contains(value, "-----BEGIN") {
+		return parsePEMChain(value)
+	}
+	return parseBase64DER(value)
+}
+
+// parseBase64DER parses a base64 encoded DER certificate.
+func parseBase64DER(value string) ([]*x509.Certificate, error) {
+	der, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidForwardedCertificate
+	}
+	certs, err := x509.ParseCertificates(der)
+	if err != nil || len(certs) == 0 {
+		return nil, ErrInvalidForwardedCertificate
+	}
+	return certs, nil
+}
+
+// parsePEMChain parses the CERTIFICATE blocks of a PEM chain, leaf first.
+func parsePEMChain(value string) ([]*x509.Certificate, error) {
+	rest := []byte(value)
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, ErrInvalidForwardedCertificate
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, ErrInvalidForwardedCertificate
+	}
+	return certs, nil
+}
+
+// VerifyChain verifies that the leaf of a client certificate chain is valid for client authentication
+// and chains to a trusted client certificate authority. The remaining certificates of the chain are
+// used as intermediates.
+func VerifyChain(chain []*x509.Certificate) error {
+	if len(chain) == 0 {
+		return errors.New("no client certificate presented")
+	}
+	roots, err := trustedRoots()
+	if err != nil {
+		return err
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return fmt.Errorf("client certificate verification failed: %w", err)
+	}
+	return nil
+}
+
+// trustedRootsCache holds the trusted client certificate authorities, loaded once per configured file.
+var trustedRootsCache struct {
+	sync.Mutex
+	path string
+	pool *x509.CertPool
+}
+
+// trustedRoots returns the trusted client certificate authorities from the configured CA bundle. The
+// system trust store is never used: any public CA could then issue a certificate with a registered
+// subject, so tls_client_auth is refused until a bundle is configured.
+func trustedRoots() (*x509.CertPool, error) {
+	var caFile, serverHome string
+	if config.IsServerRuntimeInitialized() {
+		runtime := config.GetServerRuntime()
+		caFile = runtime.Config.TLS.ClientCertificate.TrustedCAFile
+		serverHome = runtime.ServerHome
+	}
+	if caFile == "" {
+		return nil, errors.New("no trusted client CA file is configured")
+	}
+	if !filepath.IsAbs(caFile) {
+		caFile = filepath.Join(serverHome, caFile)
+	}
+
+	trustedRootsCache.Lock()
+	defer trustedRootsCache.Unlock()
+	if trustedRootsCache.path == caFile && trustedRootsCache.pool != nil {
+		return trustedRootsCache.pool, nil
+	}
+	data, err := os.ReadFile(filepath.Clean(caFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trusted client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New("trusted client CA file contains no certificates")
+	}
+	trustedRootsCache.path = caFile
+	trustedRootsCache.pool = pool
+	return pool, nil
+}
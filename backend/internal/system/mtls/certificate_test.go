@@ -0,0 +1,157 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const testForwardedHeader = "X-Client-Cert"
+
+type CertificateTestSuite struct {
+	suite.Suite
+	caCert   *x509.Certificate
+	caKey    *ecdsa.PrivateKey
+	leafCert *x509.Certificate
+	caPath   string
+}
+
+func TestCertificateTestSuite(t *testing.T) {
+	suite.Run(t, new(CertificateTestSuite))
+}
+
+func (suite *CertificateTestSuite) SetupTest() {
+	home := suite.T().TempDir()
+	suite.caCert, suite.caKey = suite.newCertificate("Test CA", nil, nil, true)
+	suite.leafCert, _ = suite.newCertificate("client-1", suite.caCert, suite.caKey, false)
+
+	suite.caPath = "client-ca.pem"
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: suite.caCert.Raw})
+	suite.Require().NoError(os.WriteFile(filepath.Join(home, suite.caPath), caPEM, 0o600))
+
+	cfg := &config.Config{}
+	cfg.TLS.ClientCertificate.ForwardedHeader = testForwardedHeader
+	cfg.TLS.ClientCertificate.TrustedCAFile = suite.caPath
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime(home, cfg))
+}
+
+func (suite *CertificateTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+	trustedRootsCache.Lock()
+	trustedRootsCache.path = ""
+	trustedRootsCache.pool = nil
+	trustedRootsCache.Unlock()
+}
+
+// newCertificate issues a certificate signed by parent, or a self-signed one when parent is nil.
+func (suite *CertificateTestSuite) newCertificate(commonName string, parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey, isCA bool) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	suite.Require().NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	suite.Require().NoError(err)
+	return cert, key
+}
+
+func (suite *CertificateTestSuite) TestClientCertificate_FromTLSConnection() {
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{suite.leafCert}}
+	// The connection certificate takes precedence over a forwarded one.
+	req.Header.Set(testForwardedHeader, ":"+base64.StdEncoding.EncodeToString(suite.caCert.Raw)+":")
+
+	suite.Equal(suite.leafCert.Raw, ClientCertificate(req).Raw)
+}
+
+func (suite *CertificateTestSuite) TestClientCertificate_FromForwardedHeader() {
+	leafPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: suite.leafCert.Raw}))
+	testCases := []struct {
+		name  string
+		value string
+	}{
+		{"RFC9440", ":" + base64.StdEncoding.EncodeToString(suite.leafCert.Raw) + ":"},
+		{"PEM", leafPEM},
+		{"URLEncodedPEM", url.PathEscape(leafPEM)},
+		{"Base64DER", base64.StdEncoding.EncodeToString(suite.leafCert.Raw)},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+			req.Header.Set(testForwardedHeader, tc.value)
+			cert := ClientCertificate(req)
+			suite.Require().NotNil(cert)
+			suite.Equal(suite.leafCert.Raw, cert.Raw)
+		})
+	}
+}
+
+func (suite *CertificateTestSuite) TestClientCertificateChain_InvalidForwardedHeader() {
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	req.Header.Set(testForwardedHeader, "not-a-certificate")
+
+	_, err := ClientCertificateChain(req)
+	suite.ErrorIs(err, ErrInvalidForwardedCertificate)
+	suite.Nil(ClientCertificate(req))
+}
+
+func (suite *CertificateTestSuite) TestClientCertificate_ForwardedHeaderNotConfigured() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime(suite.T().TempDir(), &config.Config{}))
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	req.Header.Set(testForwardedHeader, ":"+base64.StdEncoding.EncodeToString(suite.leafCert.Raw)+":")
+
+	suite.Nil(ClientCertificate(req))
+}
+
+func (suite *CertificateTestSuite) TestVerifyChain() {
+	suite.NoError(VerifyChain([]*x509.Certificate{suite.leafCert}))
+
+	otherCA, otherKey := suite.newCertificate("Other CA", nil, nil, true)
+	untrusted, _ := suite.newCertificate("client-2", otherCA, otherKey, false)
+	suite.Error(VerifyChain([]*x509.Certificate{untrusted}))
+
+	suite.Error(VerifyChain(nil))
+}
+
+func (suite *CertificateTestSuite) TestVerifyChain_NoTrustedCAFile() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime(suite.T().TempDir(), &config.Config{}))
+
+	suite.Error(VerifyChain([]*x509.Certificate{suite.leafCert}))
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"context"
+	"crypto/x509"
+)
+
+// contextKey is a private type for mutual-TLS context value keys to avoid collisions.
+type contextKey string
+
+// Context keys for mutual-TLS values propagated across the request pipeline.
+const (
+	certificateKey contextKey = "mtls_certificate"
+	thumbprintKey  contextKey = "mtls_thumbprint"
+)
+
+// WithCertificate attaches the client certificate presented on the request to the context.
+func WithCertificate(ctx context.Context, cert *x509.Certificate) context.Context {
+	return context.WithValue(ctx, certificateKey, cert)
+}
+
+// GetCertificate returns the client certificate previously attached via WithCertificate, or nil.
+func GetCertificate(ctx context.Context) *x509.Certificate {
+	if ctx == nil {
+		return nil
+	}
+	if v, ok := ctx.Value(certificateKey).(*x509.Certificate); ok {
+		return v
+	}
+	return nil
+}
+
+// WithThumbprint attaches the thumbprint of the certificate the issued tokens are to be bound to, so
+// grant handlers can sender-constrain them.
+func WithThumbprint(ctx context.Context, thumbprint string) context.Context {
+	return context.WithValue(ctx, thumbprintKey, thumbprint)
+}
+
+// GetThumbprint returns the certificate thumbprint previously attached via WithThumbprint, or "".
+func GetThumbprint(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if v, ok := ctx.Value(thumbprintKey).(string); ok {
+		return v
+	}
+	return ""
+}
@@ -36,6 +36,9 @@ const (
 	// CategoryFlows groups all flow orchestration events for tracing end-to-end flows.
 	CategoryFlows EventCategory = "observability.flows"
 
+	// CategoryAudit groups the audit events of management API mutations.
+	CategoryAudit EventCategory = "observability.audit"
+
 	// CategoryAll is a special category that matches all events.
 	// Subscribers to this category receive all events regardless of type.
 	CategoryAll EventCategory = "observability.all"
@@ -62,6 +65,16 @@ var eventTypeToCategory = map[providers.EventType]EventCategory{
 	EventTypeTokenIssuanceFailed:            CategoryAuthentication,
 	EventTypeTokenRevoked:                   CategoryAuthentication,
 	EventTypeRuntimePersistentDBUnavailable: CategoryAuthentication,
+	EventTypeBackchannelLogoutDelivered:     CategoryAuthentication,
+	EventTypeBackchannelLogoutRetried:       CategoryAuthentication,
+	EventTypeBackchannelLogoutFailed:        CategoryAuthentication,
+	EventTypeAccountLocked:                  CategoryAuthentication,
+	EventTypeAccountUnlocked:                CategoryAuthentication,
+
+	// Audit events
+	EventTypeResourceCreated: CategoryAudit,
+	EventTypeResourceUpdated: CategoryAudit,
+	EventTypeResourceDeleted: CategoryAudit,
 
 	// Flow events
 	EventTypeFlowStarted:                CategoryFlows,
@@ -90,6 +103,7 @@ func GetAllCategories() []EventCategory {
 		CategoryAuthentication,
 		CategoryAuthorization,
 		CategoryFlows,
+		CategoryAudit,
 	}
 }
 
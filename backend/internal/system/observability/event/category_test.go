@@ -34,6 +34,11 @@ func TestGetCategory(t *testing.T) {
 			eventType:    EventTypeTokenIssuanceFailed,
 			wantCategory: CategoryAuthentication,
 		},
+		{
+			name:         "back-channel logout failed",
+			eventType:    EventTypeBackchannelLogoutFailed,
+			wantCategory: CategoryAuthentication,
+		},
 
 		// Flow events
 		{
@@ -157,6 +162,7 @@ func TestGetAllCategories(t *testing.T) {
 		CategoryAuthentication: false,
 		CategoryAuthorization:  false,
 		CategoryFlows:          false,
+		CategoryAudit:          false,
 	}
 
 	for _, cat := range categories {
@@ -201,6 +207,11 @@ func TestIsValidCategory(t *testing.T) {
 			category: CategoryFlows,
 			want:     true,
 		},
+		{
+			name:     "valid audit category",
+			category: CategoryAudit,
+			want:     true,
+		},
 		{
 			name:     "valid CategoryAll",
 			category: CategoryAll,
@@ -267,6 +278,13 @@ func TestEventTypeToCategoryMapping_Comprehensive(t *testing.T) {
 		EventTypeTokenIssuanceStarted,
 		EventTypeTokenIssued,
 		EventTypeTokenIssuanceFailed,
+		EventTypeAccountLocked,
+		EventTypeAccountUnlocked,
+
+		// Audit
+		EventTypeResourceCreated,
+		EventTypeResourceUpdated,
+		EventTypeResourceDeleted,
 
 		// Flows
 		EventTypeFlowStarted,
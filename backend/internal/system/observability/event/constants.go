@@ -13,6 +13,9 @@ const (
 
 	// ComponentAuthHandler identifies events from authentication handlers.
 	ComponentAuthHandler = "AuthHandler"
+
+	// ComponentManagementAPI identifies audit events from the management services.
+	ComponentManagementAPI = "ManagementAPI"
 )
 
 // Authentication and Authorization Event Types
@@ -35,6 +38,37 @@ const (
 	// deny-list (revocation) check becomes unavailable and enforcement fails closed.
 	EventTypeRuntimePersistentDBUnavailable providers.EventType = "RUNTIME_PERSISTENT_DB_UNAVAILABLE"
 
+	// Account Lockout Events
+
+	// EventTypeAccountLocked is triggered when repeated failed credential attempts lock an account.
+	EventTypeAccountLocked providers.EventType = "ACCOUNT_LOCKED"
+
+	// EventTypeAccountUnlocked is triggered when an administrator unlocks a locked account.
+	EventTypeAccountUnlocked providers.EventType = "ACCOUNT_UNLOCKED"
+
+	// Logout Events
+
+	// EventTypeBackchannelLogoutDelivered is triggered when a relying party accepts a back-channel logout token.
+	EventTypeBackchannelLogoutDelivered providers.EventType = "BACKCHANNEL_LOGOUT_DELIVERED"
+
+	// EventTypeBackchannelLogoutRetried is triggered when a back-channel logout delivery attempt fails and
+	// is scheduled for another attempt.
+	EventTypeBackchannelLogoutRetried providers.EventType = "BACKCHANNEL_LOGOUT_RETRIED"
+
+	// EventTypeBackchannelLogoutFailed is triggered when back-channel logout delivery is abandoned.
+	EventTypeBackchannelLogoutFailed providers.EventType = "BACKCHANNEL_LOGOUT_FAILED"
+
+	// Audit Events
+
+	// EventTypeResourceCreated is triggered when a management API creates a resource.
+	EventTypeResourceCreated providers.EventType = "RESOURCE_CREATED"
+
+	// EventTypeResourceUpdated is triggered when a management API updates a resource.
+	EventTypeResourceUpdated providers.EventType = "RESOURCE_UPDATED"
+
+	// EventTypeResourceDeleted is triggered when a management API deletes a resource.
+	EventTypeResourceDeleted providers.EventType = "RESOURCE_DELETED"
+
 	// Flow Execution Events
 
 	// EventTypeFlowStarted is triggered when a flow execution begins.
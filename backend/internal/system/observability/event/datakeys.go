@@ -16,20 +16,24 @@ var DataKey = struct {
 	Username string
 	ClientID string
 	EntityID string
+	ClientIP string
 
 	// Flow Execution Keys
-	ExecutionID   string
-	FlowType      string
-	NodeID        string
-	NodeType      string
-	NodeStatus    string
-	ExecutorName  string
-	ExecutorType  string
-	StepNumber    string
-	AttemptNumber string
-	AuthMethod    string
-	RedirectTo    string
-	FailedStep    string
+	ExecutionID    string
+	FlowType       string
+	FlowID         string
+	FlowVersion    string
+	RolloutVariant string
+	NodeID         string
+	NodeType       string
+	NodeStatus     string
+	ExecutorName   string
+	ExecutorType   string
+	StepNumber     string
+	AttemptNumber  string
+	AuthMethod     string
+	RedirectTo     string
+	FailedStep     string
 
 	// OAuth/Token Keys
 	Scope            string
@@ -37,6 +41,16 @@ var DataKey = struct {
 	JTI              string
 	RevocationReason string
 
+	// Account Lockout Keys
+	LockedUntil string
+	LockCount   string
+
+	// Audit Keys
+	ActorID      string
+	ResourceType string
+	ResourceID   string
+	AuditEventID string
+
 	// Event Metadata Keys
 	Message     string
 	Error       string
@@ -53,20 +67,24 @@ var DataKey = struct {
 	Username: "username",
 	ClientID: "client_id",
 	EntityID: "app_id",
+	ClientIP: "client_ip",
 
 	// Flow Execution Keys
-	ExecutionID:   "execution_id",
-	FlowType:      "flow_type",
-	NodeID:        "node_id",
-	NodeType:      "node_type",
-	NodeStatus:    "node_status",
-	ExecutorName:  "executor_name",
-	ExecutorType:  "executor_type",
-	StepNumber:    "step_number",
-	AttemptNumber: "attempt_number",
-	AuthMethod:    "auth_method",
-	RedirectTo:    "redirect_to",
-	FailedStep:    "failed_step",
+	ExecutionID:    "execution_id",
+	FlowType:       "flow_type",
+	FlowID:         "flow_id",
+	FlowVersion:    "flow_version",
+	RolloutVariant: "rollout_variant",
+	NodeID:         "node_id",
+	NodeType:       "node_type",
+	NodeStatus:     "node_status",
+	ExecutorName:   "executor_name",
+	ExecutorType:   "executor_type",
+	StepNumber:     "step_number",
+	AttemptNumber:  "attempt_number",
+	AuthMethod:     "auth_method",
+	RedirectTo:     "redirect_to",
+	FailedStep:     "failed_step",
 
 	// OAuth/Token Keys
 	Scope:            "scope",
@@ -74,6 +92,16 @@ var DataKey = struct {
 	JTI:              "jti",
 	RevocationReason: "revocation_reason",
 
+	// Account Lockout Keys
+	LockedUntil: "locked_until",
+	LockCount:   "lock_count",
+
+	// Audit Keys
+	ActorID:      "actor_id",
+	ResourceType: "resource_type",
+	ResourceID:   "resource_id",
+	AuditEventID: "audit_event_id",
+
 	// Event Metadata Keys
 	Message:     "message",
 	Error:       "error",
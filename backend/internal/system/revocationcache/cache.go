@@ -17,6 +17,7 @@ type revokedCache struct {
 	tokens   map[string]time.Time
 	families map[string]time.Time
 	subjects map[string]revokedEntry
+	consents map[string]revokedEntry
 }
 
 // newRevokedCache creates an empty cache. It holds nothing until the first snapshot is loaded.
@@ -25,6 +26,7 @@ func newRevokedCache() *revokedCache {
 		tokens:   make(map[string]time.Time),
 		families: make(map[string]time.Time),
 		subjects: make(map[string]revokedEntry),
+		consents: make(map[string]revokedEntry),
 	}
 }
 
@@ -34,10 +36,12 @@ func (c *revokedCache) replace(snapshot revokedSnapshot) {
 	tokens := indexByValue(snapshot.Tokens)
 	families := indexByValue(snapshot.Families)
 	subjects := indexEntriesByValue(snapshot.Subjects)
+	consents := indexEntriesByValue(snapshot.Consents)
 	c.mu.Lock()
 	c.tokens = tokens
 	c.families = families
 	c.subjects = subjects
+	c.consents = consents
 	c.mu.Unlock()
 }
 
@@ -45,8 +49,21 @@ func (c *revokedCache) isSubjectRevoked(subject string, establishedAt time.Time)
 	c.mu.RLock()
 	entry, ok := c.subjects[subject]
 	c.mu.RUnlock()
-	return ok && time.Now().Before(entry.ExpiryTime) &&
-		(!entry.Boundary || establishedAt.IsZero() || !establishedAt.After(entry.RevokedAt))
+	return ok && entry.revokes(establishedAt)
+}
+
+// isConsentRevoked reports whether a token issued under consentID at establishedAt is revoked.
+func (c *revokedCache) isConsentRevoked(consentID string, establishedAt time.Time) bool {
+	c.mu.RLock()
+	entry, ok := c.consents[consentID]
+	c.mu.RUnlock()
+	return ok && entry.revokes(establishedAt)
+}
+
+// revokes reports whether the entry is still live and covers a token established at establishedAt.
+func (e revokedEntry) revokes(establishedAt time.Time) bool {
+	return time.Now().Before(e.ExpiryTime) &&
+		(!e.Boundary || establishedAt.IsZero() || !establishedAt.After(e.RevokedAt))
 }
 
 // indexEntriesByValue builds a value-to-entry map while preserving criterion metadata.
@@ -68,6 +68,18 @@ func TestRevokedCache_SubjectBoundary(t *testing.T) {
 	assert.True(t, c.isSubjectRevoked("deleted-user", now.Add(time.Minute)))
 }
 
+func TestRevokedCache_ConsentBoundary(t *testing.T) {
+	now := time.Now().UTC()
+	c := newRevokedCache()
+	c.replace(revokedSnapshot{Consents: []revokedEntry{
+		{Value: "consent-1", ExpiryTime: now.Add(time.Hour), RevokedAt: now, Boundary: true},
+	}})
+
+	assert.True(t, c.isConsentRevoked("consent-1", now.Add(-time.Minute)))
+	assert.False(t, c.isConsentRevoked("consent-1", now.Add(time.Minute)))
+	assert.False(t, c.isConsentRevoked("consent-2", now.Add(-time.Minute)))
+}
+
 func TestRevokedCache_ConcurrentAccess(t *testing.T) {
 	c := newRevokedCache()
 	future := time.Now().Add(time.Hour)
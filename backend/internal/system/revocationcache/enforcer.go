@@ -10,7 +10,7 @@ import (
 )
 
 // EnforcerInterface answers revocation checks for the Resource Server enforcement point. A token is
-// rejected when its JTI, token family, or ThunderID subject is cached as revoked.
+// rejected when its JTI, token family, ThunderID subject, or consent is cached as revoked.
 type EnforcerInterface interface {
 	// EnsureNotRevoked returns nil when the token may proceed.
 	EnsureNotRevoked(ctx context.Context, identity security.RevocationIdentity) error
@@ -37,6 +37,9 @@ func (e *enforcer) EnsureNotRevoked(_ context.Context, identity security.Revocat
 	if identity.Subject != "" && e.cache.isSubjectRevoked(identity.Subject, identity.EstablishedAt) {
 		return errTokenRevoked
 	}
+	if identity.ConsentID != "" && e.cache.isConsentRevoked(identity.ConsentID, identity.EstablishedAt) {
+		return errTokenRevoked
+	}
 	return nil
 }
 
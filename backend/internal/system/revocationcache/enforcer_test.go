@@ -19,6 +19,7 @@ func TestEnforcer_EnsureNotRevoked(t *testing.T) {
 		Tokens:   []revokedEntry{{Value: "revoked-jti", ExpiryTime: time.Now().Add(time.Hour)}},
 		Families: []revokedEntry{{Value: "revoked-tfid", ExpiryTime: time.Now().Add(time.Hour)}},
 		Subjects: []revokedEntry{{Value: "revoked-user", ExpiryTime: time.Now().Add(time.Hour)}},
+		Consents: []revokedEntry{{Value: "revoked-consent", ExpiryTime: time.Now().Add(time.Hour)}},
 	})
 	e := newEnforcer(cache)
 
@@ -38,6 +39,9 @@ func TestEnforcer_EnsureNotRevoked(t *testing.T) {
 	assert.ErrorIs(t, e.EnsureNotRevoked(context.Background(), security.RevocationIdentity{
 		Subject: "revoked-user",
 	}), errTokenRevoked, "a token whose subject is revoked is rejected")
+	assert.ErrorIs(t, e.EnsureNotRevoked(context.Background(), security.RevocationIdentity{
+		Subject: "active-user", ConsentID: "revoked-consent",
+	}), errTokenRevoked, "a token issued under a revoked consent is rejected")
 }
 
 func TestNoopEnforcer_AlwaysAllows(t *testing.T) {
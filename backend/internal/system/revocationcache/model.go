@@ -26,4 +26,6 @@ type revokedSnapshot struct {
 	Families []revokedEntry
 	// Subjects holds revoked user-subject entries.
 	Subjects []revokedEntry
+	// Consents holds revoked consent entries (keyed by consent id).
+	Consents []revokedEntry
 }
@@ -27,3 +27,11 @@ var querySnapshotRevokedSubjects = dbmodel.DBQuery{
 	Query: `SELECT CRITERION_VALUE, REASON, REVOKED_AT, EXPIRY_TIME FROM "REVOCATION_CRITERIA" ` +
 		`WHERE CRITERION_TYPE = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
 }
+
+// querySnapshotRevokedConsents reads consent criteria together with the reason and action boundary, so
+// tokens issued under a consent after it was revoked and granted again are not rejected.
+var querySnapshotRevokedConsents = dbmodel.DBQuery{
+	ID: "RVC-SRC-04",
+	Query: `SELECT CRITERION_VALUE, REASON, REVOKED_AT, EXPIRY_TIME FROM "REVOCATION_CRITERIA" ` +
+		`WHERE CRITERION_TYPE = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
+}
@@ -24,6 +24,7 @@ const (
 	// duplicated here (not imported) so this read-only RS package stays decoupled from the write path.
 	criterionTypeTokenFamily = "token_family"
 	criterionTypeSubject     = "subject"
+	criterionTypeConsent     = "consent.id"
 )
 
 // dbSource reads the deny-list snapshot from the runtime persistent database. It is the only source today; it
@@ -74,16 +75,26 @@ func (s *dbSource) Snapshot(ctx context.Context) (revokedSnapshot, error) {
 	if err != nil {
 		return revokedSnapshot{}, fmt.Errorf("error reading revoked subject snapshot: %w", err)
 	}
-	subjects, err := parseSubjectEntries(subjectRows)
+	subjects, err := parseBoundedEntries(subjectRows)
 	if err != nil {
 		return revokedSnapshot{}, err
 	}
 
-	return revokedSnapshot{Tokens: tokens, Families: families, Subjects: subjects}, nil
+	consentRows, err := dbClient.QueryContext(ctx, querySnapshotRevokedConsents,
+		criterionTypeConsent, now, s.deploymentID)
+	if err != nil {
+		return revokedSnapshot{}, fmt.Errorf("error reading revoked consent snapshot: %w", err)
+	}
+	consents, err := parseBoundedEntries(consentRows)
+	if err != nil {
+		return revokedSnapshot{}, err
+	}
+
+	return revokedSnapshot{Tokens: tokens, Families: families, Subjects: subjects, Consents: consents}, nil
 }
 
-// parseSubjectEntries maps subject criteria and retains their token-establishment cutoff.
-func parseSubjectEntries(rows []map[string]interface{}) ([]revokedEntry, error) {
+// parseBoundedEntries maps subject or consent criteria and retains their token-establishment cutoff.
+func parseBoundedEntries(rows []map[string]interface{}) ([]revokedEntry, error) {
 	entries, err := parseEntries(rows, columnNameCriterionValue)
 	if err != nil {
 		return nil, err
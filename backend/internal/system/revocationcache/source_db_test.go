@@ -59,6 +59,12 @@ func (suite *DBSourceTestSuite) TestSnapshot_Success() {
 			{"criterion_value": "user-1", "reason": "role_assignment_removed",
 				"revoked_at": revokedAt, "expiry_time": expiry},
 		}, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, querySnapshotRevokedConsents,
+		criterionTypeConsent, mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{
+			{"criterion_value": "consent-1", "reason": "consent_revoked",
+				"revoked_at": revokedAt, "expiry_time": expiry},
+		}, nil)
 
 	snapshot, err := suite.source.Snapshot(context.Background())
 
@@ -72,6 +78,9 @@ func (suite *DBSourceTestSuite) TestSnapshot_Success() {
 	assert.Equal(suite.T(), "user-1", snapshot.Subjects[0].Value)
 	assert.Equal(suite.T(), revokedAt, snapshot.Subjects[0].RevokedAt)
 	assert.True(suite.T(), snapshot.Subjects[0].Boundary)
+	assert.Len(suite.T(), snapshot.Consents, 1)
+	assert.Equal(suite.T(), "consent-1", snapshot.Consents[0].Value)
+	assert.True(suite.T(), snapshot.Consents[0].Boundary)
 }
 
 func (suite *DBSourceTestSuite) TestSnapshot_Empty() {
@@ -85,6 +94,9 @@ func (suite *DBSourceTestSuite) TestSnapshot_Empty() {
 	suite.mockDBClient.On("QueryContext", mock.Anything, querySnapshotRevokedSubjects,
 		criterionTypeSubject, mock.Anything, testDeploymentID).
 		Return([]map[string]interface{}{}, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, querySnapshotRevokedConsents,
+		criterionTypeConsent, mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
 
 	snapshot, err := suite.source.Snapshot(context.Background())
 
@@ -92,6 +104,7 @@ func (suite *DBSourceTestSuite) TestSnapshot_Empty() {
 	assert.Empty(suite.T(), snapshot.Tokens)
 	assert.Empty(suite.T(), snapshot.Families)
 	assert.Empty(suite.T(), snapshot.Subjects)
+	assert.Empty(suite.T(), snapshot.Consents)
 }
 
 func (suite *DBSourceTestSuite) TestSnapshot_SubjectQueryError() {
@@ -110,6 +123,24 @@ func (suite *DBSourceTestSuite) TestSnapshot_SubjectQueryError() {
 	assert.Contains(suite.T(), err.Error(), "error reading revoked subject snapshot")
 }
 
+func (suite *DBSourceTestSuite) TestSnapshot_ConsentQueryError() {
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, querySnapshotRevokedTokens,
+		mock.Anything, testDeploymentID).Return([]map[string]interface{}{}, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, querySnapshotRevokedTokenFamilies,
+		criterionTypeTokenFamily, mock.Anything, testDeploymentID).Return([]map[string]interface{}{}, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, querySnapshotRevokedSubjects,
+		criterionTypeSubject, mock.Anything, testDeploymentID).Return([]map[string]interface{}{}, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, querySnapshotRevokedConsents,
+		criterionTypeConsent, mock.Anything, testDeploymentID).Return(nil, errors.New("query error"))
+
+	snapshot, err := suite.source.Snapshot(context.Background())
+
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), snapshot.Consents)
+	assert.Contains(suite.T(), err.Error(), "error reading revoked consent snapshot")
+}
+
 func (suite *DBSourceTestSuite) TestSnapshot_DBClientError() {
 	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(nil, errors.New("db client error"))
 
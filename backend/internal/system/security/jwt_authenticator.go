@@ -12,6 +12,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 )
 
@@ -38,6 +39,10 @@ const (
 	// claimAccessTokenSubject carries the end user behind a token minted for a delegated call. When
 	// present it, not sub, is the subject the deny list is evaluated against.
 	claimAccessTokenSubject = "access_token_sub"
+
+	// claimConsentID names the consent record the token's grant was issued under, so a token is
+	// rejected once the user withdraws that consent.
+	claimConsentID = "cnid"
 )
 
 // jwtAuthenticator handles authentication and authorization using JWT Bearer tokens.
@@ -86,6 +91,11 @@ func (h *jwtAuthenticator) Authenticate(r *http.Request) (*SecurityContext, erro
 		return nil, errInvalidToken
 	}
 
+	// A certificate-bound token (RFC 8705) is only accepted with the client certificate it is bound to.
+	if err := mtls.VerifyBinding(attributes, mtls.ClientCertificate(r)); err != nil {
+		return nil, errInvalidToken
+	}
+
 	// Step 4: Extract subject information and build SecurityContext
 	subject := ""
 	if sub, ok := attributes["sub"].(string); ok && sub != "" {
@@ -109,6 +119,7 @@ func (h *jwtAuthenticator) Authenticate(r *http.Request) (*SecurityContext, erro
 		if accessTokenSubject, _ := attributes[claimAccessTokenSubject].(string); accessTokenSubject != "" {
 			securityCtx.revocationSubject = accessTokenSubject
 		}
+		securityCtx.revocationConsentID = extractAttribute(attributes, claimConsentID)
 		if issuedAt, ok := attributes[claimIssuedAt].(float64); ok {
 			securityCtx.establishedAt = time.Unix(int64(issuedAt), 0).UTC()
 		}
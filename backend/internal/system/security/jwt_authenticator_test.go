@@ -5,6 +5,8 @@ package security
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"net/http"
@@ -21,6 +23,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/mtls"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 )
 
@@ -950,3 +953,53 @@ func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_UnknownIssuerUnderFeder
 	mockJWT.AssertNotCalled(suite.T(), "VerifyJWT")
 	mockJWT.AssertNotCalled(suite.T(), "VerifyJWTWithJWKS")
 }
+
+func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_CertificateBoundToken() {
+	// A certificate-bound token (RFC 8705) is only accepted over a connection presenting the
+	// client certificate whose thumbprint it carries in cnf.x5t#S256.
+	config.ResetServerRuntime()
+	defer config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("", federatedConfigWithLocalIssuer())
+
+	boundCert := &x509.Certificate{Raw: []byte("bound-certificate")}
+	token := buildFakeJWT(
+		map[string]interface{}{"alg": "RS256", "kid": "local-kid"},
+		map[string]interface{}{
+			"sub": "service-app",
+			"iss": testLocalIssuer,
+			"cnf": map[string]interface{}{"x5t#S256": mtls.Thumbprint(boundCert)},
+		},
+	)
+
+	mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	mockJWT.On("VerifyJWT", mock.Anything, token, "", "").Return(nil)
+	auth := newJWTAuthenticator(mockJWT)
+
+	testCases := []struct {
+		name    string
+		cert    *x509.Certificate
+		wantErr bool
+	}{
+		{name: "no certificate", wantErr: true},
+		{name: "other certificate", cert: &x509.Certificate{Raw: []byte("other-certificate")}, wantErr: true},
+		{name: "bound certificate", cert: boundCert},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/users", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			if tc.cert != nil {
+				req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{tc.cert}}
+			}
+
+			authCtx, err := auth.Authenticate(req)
+			if tc.wantErr {
+				assert.ErrorIs(suite.T(), err, errInvalidToken)
+				assert.Nil(suite.T(), authCtx)
+				return
+			}
+			assert.NoError(suite.T(), err)
+			assert.NotNil(suite.T(), authCtx)
+		})
+	}
+}
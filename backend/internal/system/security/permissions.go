@@ -227,6 +227,9 @@ func InitSystemPermissions(handle string) {
 		{"PUT /users/me", ""},
 		{"GET /users/me/**", ""},
 		{"PUT /users/me/**", ""},
+		{"DELETE /users/me/consents/*", ""},
+		{"DELETE /users/me/credentials/*", ""},
+		{"DELETE /users/me/sessions/*", ""},
 		{"POST /users/me/update-credentials", ""},
 		{"GET /register/passkey/**", ""},
 		{"POST /register/passkey/**", ""},
@@ -248,6 +251,10 @@ func InitSystemPermissions(handle string) {
 		{"PUT /users/**", p.User},
 		{"DELETE /users/**", p.User},
 
+		// Consent APIs.
+		{"GET /consents", p.UserView},
+		{"DELETE /consents/*", p.User},
+
 		// Group APIs.
 		{"GET /groups", p.GroupView},
 		{"POST /groups", p.Group},
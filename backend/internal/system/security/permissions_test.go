@@ -352,6 +352,18 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 			path:     "/users/me/update-credentials",
 			wantPerm: "",
 		},
+		{
+			name:   "DELETE /users/me/credentials/{id} self-service",
+			method: http.MethodDelete, path: "/users/me/credentials/cred-1", wantPerm: "",
+		},
+		{
+			name:   "DELETE /users/me/sessions/{id} self-service",
+			method: http.MethodDelete, path: "/users/me/sessions/sess-1", wantPerm: "",
+		},
+		{
+			name:   "DELETE /users/{id}/sessions/{id} requires user permission",
+			method: http.MethodDelete, path: "/users/u1/sessions/sess-1", wantPerm: p.User,
+		},
 		{
 			name:   "GET /register/passkey/start self-service",
 			method: http.MethodGet, path: "/register/passkey/start", wantPerm: "",
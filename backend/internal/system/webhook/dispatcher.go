@@ -0,0 +1,459 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const (
+	dispatcherComponentName = "WebhookDispatcher"
+
+	defaultMaxAttempts             = 8
+	defaultInitialBackoff          = 30 * time.Second
+	defaultMaxBackoff              = time.Hour
+	defaultTimeout                 = 10 * time.Second
+	defaultPollInterval            = 15 * time.Second
+	defaultDeadLetterRetentionDays = 14
+
+	// dueDeliveryBatchSize is the number of due deliveries an endpoint worker loads at a time.
+	dueDeliveryBatchSize = 50
+)
+
+// dispatchSettings holds the resolved delivery settings of the dispatcher.
+type dispatchSettings struct {
+	maxAttempts         int
+	initialBackoff      time.Duration
+	maxBackoff          time.Duration
+	timeout             time.Duration
+	pollInterval        time.Duration
+	deadLetterRetention time.Duration
+}
+
+// newDispatchSettings resolves the delivery settings from the configuration, falling back to the
+// defaults for unset values.
+func newDispatchSettings(cfg engineconfig.ObservabilityWebhookConfig) dispatchSettings {
+	settings := dispatchSettings{
+		maxAttempts:         defaultMaxAttempts,
+		initialBackoff:      defaultInitialBackoff,
+		maxBackoff:          defaultMaxBackoff,
+		timeout:             defaultTimeout,
+		pollInterval:        defaultPollInterval,
+		deadLetterRetention: defaultDeadLetterRetentionDays * 24 * time.Hour,
+	}
+	if cfg.MaxAttempts > 0 {
+		settings.maxAttempts = cfg.MaxAttempts
+	}
+	if cfg.InitialBackoffSeconds > 0 {
+		settings.initialBackoff = time.Duration(cfg.InitialBackoffSeconds) * time.Second
+	}
+	if cfg.MaxBackoffSeconds > 0 {
+		settings.maxBackoff = time.Duration(cfg.MaxBackoffSeconds) * time.Second
+	}
+	if cfg.TimeoutSeconds > 0 {
+		settings.timeout = time.Duration(cfg.TimeoutSeconds) * time.Second
+	}
+	if cfg.PollIntervalSeconds > 0 {
+		settings.pollInterval = time.Duration(cfg.PollIntervalSeconds) * time.Second
+	}
+	if cfg.DeadLetterRetentionDays > 0 {
+		settings.deadLetterRetention = time.Duration(cfg.DeadLetterRetentionDays) * 24 * time.Hour
+	}
+	return settings
+}
+
+// backoff returns the delay before the attempt that follows the given failed attempt: the initial
+// backoff doubled for every earlier failure, capped at the maximum backoff.
+func (s dispatchSettings) backoff(failedAttempt int) time.Duration {
+	delay := s.initialBackoff
+	for i := 1; i < failedAttempt && delay < s.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, s.maxBackoff)
+}
+
+// webhookDispatcher is the observability subscriber that delivers events to the registered webhook
+// endpoints. It queues a delivery in the runtime database for every enabled endpoint subscribed to
+// the event's category, and runs one worker per endpoint that POSTs the endpoint's due deliveries
+// in order. A slow or failing endpoint therefore only delays its own queue.
+type webhookDispatcher struct {
+	id            string
+	endpointStore endpointStoreInterface
+	deliveryStore deliveryStoreInterface
+	sender        *webhookSender
+	settings      dispatchSettings
+	now           func() time.Time
+	logger        *log.Logger
+
+	mu        sync.RWMutex
+	endpoints []WebhookEndpoint
+	workers   map[string]*endpointWorker
+	stop      chan struct{}
+	closed    bool
+	wg        sync.WaitGroup
+}
+
+// endpointWorker delivers the queued deliveries of one endpoint.
+type endpointWorker struct {
+	endpoint WebhookEndpoint
+	notify   chan struct{}
+	stop     chan struct{}
+}
+
+// newWebhookDispatcher creates a new webhookDispatcher. Call Initialize to start delivering.
+func newWebhookDispatcher(endpointStore endpointStoreInterface, deliveryStore deliveryStoreInterface,
+	sender *webhookSender, settings dispatchSettings) *webhookDispatcher {
+	return &webhookDispatcher{
+		endpointStore: endpointStore,
+		deliveryStore: deliveryStore,
+		sender:        sender,
+		settings:      settings,
+		now:           time.Now,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, dispatcherComponentName)),
+		workers:       make(map[string]*endpointWorker),
+		stop:          make(chan struct{}),
+	}
+}
+
+// GetID returns the unique identifier of the dispatcher.
+func (d *webhookDispatcher) GetID() string {
+	return d.id
+}
+
+// GetCategories returns CategoryAll. Endpoints are registered at runtime, so the dispatcher filters
+// events by the categories of each endpoint itself.
+func (d *webhookDispatcher) GetCategories() []event.EventCategory {
+	return []event.EventCategory{event.CategoryAll}
+}
+
+// IsEnabled always returns true; the dispatcher is only created when webhooks are enabled.
+func (d *webhookDispatcher) IsEnabled() bool {
+	return true
+}
+
+// Initialize loads the endpoints, starts their workers and the poll loop that picks up retries and
+// endpoint changes made on other server nodes.
+func (d *webhookDispatcher) Initialize() error {
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate webhook dispatcher id: %w", err)
+	}
+	d.id = id
+
+	// The dispatcher starts during application startup, outside any request.
+	if err := d.reloadEndpoints(context.Background()); err != nil {
+		return err
+	}
+
+	d.wg.Add(1)
+	go d.pollLoop()
+	return nil
+}
+
+// OnEvent queues the event for every enabled endpoint subscribed to its category.
+func (d *webhookDispatcher) OnEvent(evt *providers.Event) error {
+	if evt == nil {
+		return fmt.Errorf("event is nil")
+	}
+	category, err := event.GetCategory(providers.EventType(evt.Type))
+	if err != nil {
+		return nil
+	}
+
+	// Subscribers run in detached goroutines after the request context may be cancelled, so derive
+	// a logging context from the event's trace ID.
+	ctx := syscontext.WithTraceID(context.Background(), evt.TraceID)
+
+	var payload []byte
+	for _, endpoint := range d.subscribedEndpoints(category) {
+		if payload == nil {
+			if payload, err = json.Marshal(evt); err != nil {
+				return fmt.Errorf("failed to marshal event: %w", err)
+			}
+		}
+		deliveryID, err := utils.GenerateUUIDv7()
+		if err != nil {
+			return fmt.Errorf("failed to generate webhook delivery id: %w", err)
+		}
+		delivery := &Delivery{
+			ID:         deliveryID,
+			EndpointID: endpoint.ID,
+			EventID:    evt.EventID,
+			EventType:  evt.Type,
+			Payload:    payload,
+		}
+		if err := d.deliveryStore.InsertDelivery(ctx, delivery, d.now()); err != nil {
+			d.logger.Error(ctx, "Failed to queue webhook delivery", log.String("endpointId", endpoint.ID),
+				log.String("eventId", evt.EventID), log.Error(err))
+			continue
+		}
+		d.notify(endpoint.ID)
+	}
+	return nil
+}
+
+// Close stops the poll loop and the endpoint workers and waits for in-flight attempts to finish.
+// Queued deliveries stay in the runtime database and are delivered after the next start.
+func (d *webhookDispatcher) Close() error {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return nil
+	}
+	d.closed = true
+	close(d.stop)
+	for id, worker := range d.workers {
+		close(worker.stop)
+		delete(d.workers, id)
+	}
+	d.mu.Unlock()
+
+	d.wg.Wait()
+	return nil
+}
+
+// subscribedEndpoints returns the enabled endpoints subscribed to the category.
+func (d *webhookDispatcher) subscribedEndpoints(category event.EventCategory) []WebhookEndpoint {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.closed {
+		return nil
+	}
+	subscribed := make([]WebhookEndpoint, 0, len(d.endpoints))
+	for _, endpoint := range d.endpoints {
+		if slices.Contains(endpoint.Categories, string(category)) ||
+			slices.Contains(endpoint.Categories, string(event.CategoryAll)) {
+			subscribed = append(subscribed, endpoint)
+		}
+	}
+	return subscribed
+}
+
+// reloadEndpoints reloads the endpoints from the store, starting workers for new and re-enabled
+// endpoints and stopping the workers of deleted and disabled ones.
+func (d *webhookDispatcher) reloadEndpoints(ctx context.Context) error {
+	endpoints, err := d.endpointStore.ListEndpoints(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load webhook endpoints: %w", err)
+	}
+	enabled := make([]WebhookEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if endpoint.Enabled {
+			enabled = append(enabled, endpoint)
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return nil
+	}
+
+	d.endpoints = enabled
+	active := make(map[string]bool, len(enabled))
+	for _, endpoint := range enabled {
+		active[endpoint.ID] = true
+		if worker, exists := d.workers[endpoint.ID]; exists {
+			// Restart the worker so it picks up the changed URL, signature type or secret.
+			if workerEndpointChanged(worker.endpoint, endpoint) {
+				close(worker.stop)
+				d.startWorker(endpoint)
+			}
+			continue
+		}
+		d.startWorker(endpoint)
+	}
+	for id, worker := range d.workers {
+		if !active[id] {
+			close(worker.stop)
+			delete(d.workers, id)
+		}
+	}
+	return nil
+}
+
+// startWorker starts the worker of an endpoint. The caller must hold the write lock.
+func (d *webhookDispatcher) startWorker(endpoint WebhookEndpoint) {
+	worker := &endpointWorker{
+		endpoint: endpoint,
+		notify:   make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+	}
+	d.workers[endpoint.ID] = worker
+	// Wake the worker immediately to deliver anything queued while it was not running.
+	worker.notify <- struct{}{}
+
+	d.wg.Add(1)
+	go d.runWorker(worker)
+}
+
+// workerEndpointChanged reports whether an endpoint changed in a way its worker must pick up.
+func workerEndpointChanged(current, updated WebhookEndpoint) bool {
+	return current.URL != updated.URL || current.SignatureType != updated.SignatureType ||
+		current.Secret != updated.Secret
+}
+
+// notify wakes the worker of an endpoint. A worker that is already awake picks the delivery up in
+// its current pass.
+func (d *webhookDispatcher) notify(endpointID string) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if worker, exists := d.workers[endpointID]; exists {
+		select {
+		case worker.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// pollLoop periodically reloads the endpoints and wakes every worker, so that retries become due
+// and endpoint changes made on other server nodes are picked up.
+func (d *webhookDispatcher) pollLoop() {
+	defer d.wg.Done()
+	ticker := time.NewTicker(d.settings.pollInterval)
+	defer ticker.Stop()
+
+	// Polling runs in the background, outside any request.
+	ctx := context.Background()
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+			if err := d.reloadEndpoints(ctx); err != nil {
+				d.logger.Error(ctx, "Failed to reload webhook endpoints", log.Error(err))
+			}
+			d.notifyAll()
+		}
+	}
+}
+
+// notifyAll wakes every worker.
+func (d *webhookDispatcher) notifyAll() {
+	d.mu.RLock()
+	ids := make([]string, 0, len(d.workers))
+	for id := range d.workers {
+		ids = append(ids, id)
+	}
+	d.mu.RUnlock()
+
+	for _, id := range ids {
+		d.notify(id)
+	}
+}
+
+// runWorker delivers the due deliveries of an endpoint every time it is woken, until stopped.
+func (d *webhookDispatcher) runWorker(worker *endpointWorker) {
+	defer d.wg.Done()
+
+	// Workers run in the background, outside any request.
+	ctx := context.Background()
+	for {
+		select {
+		case <-worker.stop:
+			return
+		case <-worker.notify:
+			d.deliverDue(ctx, worker)
+		}
+	}
+}
+
+// deliverDue attempts the due deliveries of the worker's endpoint until none are left or the
+// worker is stopped.
+func (d *webhookDispatcher) deliverDue(ctx context.Context, worker *endpointWorker) {
+	for {
+		deliveries, err := d.deliveryStore.ListDueDeliveries(ctx, worker.endpoint.ID, d.now(),
+			dueDeliveryBatchSize)
+		if err != nil {
+			d.logger.Error(ctx, "Failed to load due webhook deliveries",
+				log.String("endpointId", worker.endpoint.ID), log.Error(err))
+			return
+		}
+		if len(deliveries) == 0 {
+			return
+		}
+		attempted := false
+		for i := range deliveries {
+			select {
+			case <-worker.stop:
+				return
+			default:
+			}
+			if d.attempt(ctx, &worker.endpoint, &deliveries[i]) {
+				attempted = true
+			}
+		}
+		// Stop when the batch was the last one, or when none of it could be claimed, so that a
+		// failing store cannot keep the worker spinning.
+		if len(deliveries) < dueDeliveryBatchSize || !attempted {
+			return
+		}
+	}
+}
+
+// attempt claims a delivery and makes one delivery attempt. A delivered event is removed from the
+// queue; a failed attempt is retried with exponential backoff until the attempts are exhausted, and
+// then moved to the dead-letter list. It reports whether the delivery was claimed.
+func (d *webhookDispatcher) attempt(ctx context.Context, endpoint *WebhookEndpoint, delivery *Delivery) bool {
+	// Lease the delivery for longer than the request can take, so that another node only retries it
+	// when this one died mid-attempt.
+	now := d.now()
+	claimed, err := d.deliveryStore.ClaimDelivery(ctx, delivery.ID, delivery.Attempts,
+		now.Add(2*d.settings.timeout), now)
+	if err != nil {
+		d.logger.Error(ctx, "Failed to claim webhook delivery", log.String("deliveryId", delivery.ID),
+			log.Error(err))
+		return false
+	}
+	if !claimed {
+		return false
+	}
+	attempt := delivery.Attempts + 1
+
+	statusCode, sendErr := d.sender.send(ctx, endpoint, delivery.ID, delivery.EventType, delivery.Payload)
+	now = d.now()
+	if sendErr == nil {
+		if err := d.deliveryStore.DeleteDelivery(ctx, delivery.ID); err != nil {
+			d.logger.Error(ctx, "Failed to remove delivered webhook delivery",
+				log.String("deliveryId", delivery.ID), log.Error(err))
+		}
+		return true
+	}
+
+	if attempt >= d.settings.maxAttempts {
+		d.logger.Warn(ctx, "Webhook delivery failed, moving it to the dead-letter list",
+			log.String("endpointId", endpoint.ID), log.String("deliveryId", delivery.ID),
+			log.Int("attempt", attempt), log.Error(sendErr))
+		if err := d.deliveryStore.MarkDeliveryDead(ctx, delivery.ID, statusCode, sendErr.Error(), now,
+			now.Add(d.settings.deadLetterRetention)); err != nil {
+			d.logger.Error(ctx, "Failed to dead-letter webhook delivery",
+				log.String("deliveryId", delivery.ID), log.Error(err))
+		}
+		return true
+	}
+
+	d.logger.Debug(ctx, "Webhook delivery failed, scheduling a retry",
+		log.String("endpointId", endpoint.ID), log.String("deliveryId", delivery.ID),
+		log.Int("attempt", attempt), log.Error(sendErr))
+	if err := d.deliveryStore.RescheduleDelivery(ctx, delivery.ID, now.Add(d.settings.backoff(attempt)),
+		statusCode, sendErr.Error(), now); err != nil {
+		d.logger.Error(ctx, "Failed to reschedule webhook delivery",
+			log.String("deliveryId", delivery.ID), log.Error(err))
+	}
+	return true
+}
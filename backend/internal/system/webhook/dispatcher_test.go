@@ -0,0 +1,241 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+type WebhookDispatcherTestSuite struct {
+	suite.Suite
+	mockEndpointStore *endpointStoreInterfaceMock
+	mockDeliveryStore *deliveryStoreInterfaceMock
+	mockCrypto        *cryptomock.ConfigCryptoProviderMock
+	mockHTTPClient    *httpmock.HTTPClientInterfaceMock
+	dispatcher        *webhookDispatcher
+	now               time.Time
+}
+
+func TestWebhookDispatcherTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookDispatcherTestSuite))
+}
+
+func (suite *WebhookDispatcherTestSuite) SetupTest() {
+	suite.mockEndpointStore = NewendpointStoreInterfaceMock(suite.T())
+	suite.mockDeliveryStore = NewdeliveryStoreInterfaceMock(suite.T())
+	suite.mockCrypto = cryptomock.NewConfigCryptoProviderMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.now = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	sender := newWebhookSender(suite.mockHTTPClient, nil, suite.mockCrypto, "https://issuer", false)
+	sender.now = func() time.Time { return suite.now }
+	settings := newDispatchSettings(engineconfig.ObservabilityWebhookConfig{MaxAttempts: 3})
+	suite.dispatcher = newWebhookDispatcher(suite.mockEndpointStore, suite.mockDeliveryStore, sender, settings)
+	suite.dispatcher.now = func() time.Time { return suite.now }
+}
+
+func (suite *WebhookDispatcherTestSuite) hmacEndpoint() *WebhookEndpoint {
+	return &WebhookEndpoint{
+		ID: "wh-1", URL: "https://example.com/hook", SignatureType: SignatureTypeHMAC, Secret: "encrypted",
+		Categories: []string{string(event.CategoryAuthentication)}, Enabled: true,
+	}
+}
+
+func (suite *WebhookDispatcherTestSuite) respondWith(statusCode int) {
+	suite.mockCrypto.On("Decrypt", mock.Anything, []byte("encrypted")).Return([]byte(testSecret), nil)
+	suite.mockHTTPClient.On("Do", mock.Anything).
+		Return(&http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(""))}, nil)
+}
+
+func (suite *WebhookDispatcherTestSuite) TestNewDispatchSettings_Defaults() {
+	settings := newDispatchSettings(engineconfig.ObservabilityWebhookConfig{})
+	suite.Equal(defaultMaxAttempts, settings.maxAttempts)
+	suite.Equal(defaultInitialBackoff, settings.initialBackoff)
+	suite.Equal(defaultMaxBackoff, settings.maxBackoff)
+	suite.Equal(defaultTimeout, settings.timeout)
+	suite.Equal(defaultPollInterval, settings.pollInterval)
+	suite.Equal(defaultDeadLetterRetentionDays*24*time.Hour, settings.deadLetterRetention)
+}
+
+func (suite *WebhookDispatcherTestSuite) TestBackoff() {
+	settings := newDispatchSettings(engineconfig.ObservabilityWebhookConfig{
+		InitialBackoffSeconds: 10, MaxBackoffSeconds: 60,
+	})
+	suite.Equal(10*time.Second, settings.backoff(1))
+	suite.Equal(20*time.Second, settings.backoff(2))
+	suite.Equal(40*time.Second, settings.backoff(3))
+	suite.Equal(60*time.Second, settings.backoff(4))
+	suite.Equal(60*time.Second, settings.backoff(20))
+}
+
+func (suite *WebhookDispatcherTestSuite) TestOnEvent_QueuesForSubscribedEndpoints() {
+	suite.dispatcher.endpoints = []WebhookEndpoint{
+		*suite.hmacEndpoint(),
+		{ID: "wh-2", Categories: []string{string(event.CategoryFlows)}, Enabled: true},
+		{ID: "wh-3", Categories: []string{string(event.CategoryAll)}, Enabled: true},
+	}
+	suite.mockDeliveryStore.On("InsertDelivery", mock.Anything, mock.MatchedBy(func(d *Delivery) bool {
+		return d.EndpointID == "wh-1" && d.EventID == "evt-1"
+	}), suite.now).Return(nil).Once()
+	suite.mockDeliveryStore.On("InsertDelivery", mock.Anything, mock.MatchedBy(func(d *Delivery) bool {
+		return d.EndpointID == "wh-3"
+	}), suite.now).Return(errors.New("db error")).Once()
+
+	err := suite.dispatcher.OnEvent(&providers.Event{
+		EventID: "evt-1", Type: string(event.EventTypeTokenRevoked), TraceID: "trace-1",
+	})
+	suite.NoError(err)
+}
+
+func (suite *WebhookDispatcherTestSuite) TestOnEvent_UnknownEventType() {
+	suite.dispatcher.endpoints = []WebhookEndpoint{*suite.hmacEndpoint()}
+
+	suite.NoError(suite.dispatcher.OnEvent(&providers.Event{EventID: "evt-1", Type: "UNKNOWN"}))
+	suite.Error(suite.dispatcher.OnEvent(nil))
+}
+
+func (suite *WebhookDispatcherTestSuite) TestAttempt_Delivered() {
+	suite.respondWith(http.StatusNoContent)
+	suite.mockDeliveryStore.On("ClaimDelivery", mock.Anything, "dlv-1", 0, suite.now.Add(2*defaultTimeout),
+		suite.now).Return(true, nil)
+	suite.mockDeliveryStore.On("DeleteDelivery", mock.Anything, "dlv-1").Return(nil)
+
+	suite.True(suite.dispatcher.attempt(context.Background(), suite.hmacEndpoint(), &Delivery{ID: "dlv-1"}))
+}
+
+func (suite *WebhookDispatcherTestSuite) TestAttempt_FailureIsRescheduled() {
+	suite.respondWith(http.StatusBadGateway)
+	suite.mockDeliveryStore.On("ClaimDelivery", mock.Anything, "dlv-1", 1, mock.Anything, suite.now).
+		Return(true, nil)
+	suite.mockDeliveryStore.On("RescheduleDelivery", mock.Anything, "dlv-1",
+		suite.now.Add(2*defaultInitialBackoff), http.StatusBadGateway, mock.Anything, suite.now).Return(nil)
+
+	suite.True(suite.dispatcher.attempt(context.Background(), suite.hmacEndpoint(),
+		&Delivery{ID: "dlv-1", Attempts: 1}))
+}
+
+func (suite *WebhookDispatcherTestSuite) TestAttempt_LastFailureIsDeadLettered() {
+	suite.respondWith(http.StatusInternalServerError)
+	suite.mockDeliveryStore.On("ClaimDelivery", mock.Anything, "dlv-1", 2, mock.Anything, suite.now).
+		Return(true, nil)
+	suite.mockDeliveryStore.On("MarkDeliveryDead", mock.Anything, "dlv-1", http.StatusInternalServerError,
+		mock.Anything, suite.now, suite.now.Add(defaultDeadLetterRetentionDays*24*time.Hour)).Return(nil)
+
+	suite.True(suite.dispatcher.attempt(context.Background(), suite.hmacEndpoint(),
+		&Delivery{ID: "dlv-1", Attempts: 2}))
+}
+
+func (suite *WebhookDispatcherTestSuite) TestAttempt_ClaimedElsewhere() {
+	suite.mockDeliveryStore.On("ClaimDelivery", mock.Anything, "dlv-1", 0, mock.Anything, suite.now).
+		Return(false, nil)
+
+	suite.False(suite.dispatcher.attempt(context.Background(), suite.hmacEndpoint(), &Delivery{ID: "dlv-1"}))
+}
+
+func (suite *WebhookDispatcherTestSuite) TestReloadEndpoints_SyncsWorkers() {
+	disabled := *suite.hmacEndpoint()
+	disabled.ID = "wh-2"
+	disabled.Enabled = false
+	suite.mockEndpointStore.On("ListEndpoints", mock.Anything).
+		Return([]WebhookEndpoint{*suite.hmacEndpoint(), disabled}, nil).Once()
+	suite.mockEndpointStore.On("ListEndpoints", mock.Anything).Return([]WebhookEndpoint{}, nil).Once()
+	suite.mockDeliveryStore.On("ListDueDeliveries", mock.Anything, "wh-1", suite.now, dueDeliveryBatchSize).
+		Return([]Delivery{}, nil).Maybe()
+
+	suite.NoError(suite.dispatcher.reloadEndpoints(context.Background()))
+	suite.Len(suite.dispatcher.workers, 1)
+	suite.Contains(suite.dispatcher.workers, "wh-1")
+
+	suite.NoError(suite.dispatcher.reloadEndpoints(context.Background()))
+	suite.Empty(suite.dispatcher.workers)
+	suite.NoError(suite.dispatcher.Close())
+	suite.NoError(suite.dispatcher.Close())
+}
+
+func (suite *WebhookDispatcherTestSuite) TestWorkerEndpointChanged() {
+	current := *suite.hmacEndpoint()
+	renamed := current
+	renamed.Name = "Renamed"
+	suite.False(workerEndpointChanged(current, renamed))
+
+	moved := current
+	moved.URL = "https://example.com/other"
+	suite.True(workerEndpointChanged(current, moved))
+}
+
+type WebhookSenderTestSuite struct {
+	suite.Suite
+}
+
+func TestWebhookSenderTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookSenderTestSuite))
+}
+
+func (suite *WebhookSenderTestSuite) TestSend_HMACSignature() {
+	mockCrypto := cryptomock.NewConfigCryptoProviderMock(suite.T())
+	mockHTTPClient := httpmock.NewHTTPClientInterfaceMock(suite.T())
+	sender := newWebhookSender(mockHTTPClient, nil, mockCrypto, "https://issuer", false)
+	sender.now = func() time.Time { return time.Unix(1700000000, 0) }
+	payload := []byte(`{"eventId":"evt-1"}`)
+	expected := "t=1700000000,v1=" + computeHMACSignature([]byte(testSecret), "1700000000", payload)
+
+	mockCrypto.On("Decrypt", mock.Anything, []byte("encrypted")).Return([]byte(testSecret), nil)
+	mockHTTPClient.On("Do", mock.MatchedBy(func(r *http.Request) bool {
+		return r.Method == http.MethodPost && r.Header.Get(headerSignature) == expected &&
+			r.Header.Get(headerDeliveryID) == "dlv-1" && r.Header.Get("Content-Type") == "application/json"
+	})).Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil)
+
+	statusCode, err := sender.send(context.Background(), &WebhookEndpoint{
+		ID: "wh-1", URL: "https://example.com/hook", SignatureType: SignatureTypeHMAC, Secret: "encrypted",
+	}, "dlv-1", "TOKEN_ISSUED", payload)
+	suite.NoError(err)
+	suite.Equal(http.StatusOK, statusCode)
+}
+
+func (suite *WebhookSenderTestSuite) TestSend_RequestError() {
+	mockCrypto := cryptomock.NewConfigCryptoProviderMock(suite.T())
+	mockHTTPClient := httpmock.NewHTTPClientInterfaceMock(suite.T())
+	sender := newWebhookSender(mockHTTPClient, nil, mockCrypto, "https://issuer", false)
+
+	mockCrypto.On("Decrypt", mock.Anything, mock.Anything).Return([]byte(testSecret), nil)
+	mockHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("connection refused"))
+
+	statusCode, err := sender.send(context.Background(), &WebhookEndpoint{
+		ID: "wh-1", URL: "https://example.com/hook", SignatureType: SignatureTypeHMAC, Secret: "encrypted",
+	}, "dlv-1", "TOKEN_ISSUED", []byte(`{}`))
+	suite.ErrorContains(err, "connection refused")
+	suite.Zero(statusCode)
+}
+
+func (suite *WebhookSenderTestSuite) TestSend_RejectsPrivateAddress() {
+	sender := newWebhookSender(httpmock.NewHTTPClientInterfaceMock(suite.T()), nil,
+		cryptomock.NewConfigCryptoProviderMock(suite.T()), "https://issuer", false)
+
+	statusCode, err := sender.send(context.Background(), &WebhookEndpoint{
+		ID: "wh-1", URL: "http://127.0.0.1:8080/hook", SignatureType: SignatureTypeHMAC, Secret: "encrypted",
+	}, "dlv-1", "TOKEN_ISSUED", []byte(`{}`))
+	suite.ErrorContains(err, "webhook URL is not allowed")
+	suite.Zero(statusCode)
+}
+
+func (suite *WebhookSenderTestSuite) TestComputeHMACSignature() {
+	// HMAC-SHA256 of "1.body" keyed with "key".
+	suite.Equal("91b5374b153842ad05b2c4eab9349b8321b14703165bd3fb8b034dfb8be98ae5",
+		computeHMACSignature([]byte("key"), "1", []byte("body")))
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"errors"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Internal store errors.
+var (
+	// ErrNotFound is returned when the requested endpoint or delivery does not exist.
+	ErrNotFound = errors.New("webhook resource not found")
+)
+
+// Client errors for webhook management operations.
+var (
+	// ErrorInvalidRequestFormat indicates a malformed request body.
+	ErrorInvalidRequestFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorWebhookNotFound indicates the webhook endpoint does not exist.
+	ErrorWebhookNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.webhook_not_found",
+			DefaultValue: "Webhook not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.webhook_not_found_description",
+			DefaultValue: "No webhook endpoint exists for the supplied identifier",
+		},
+	}
+	// ErrorInvalidName indicates a missing or over-long endpoint name.
+	ErrorInvalidName = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_name",
+			DefaultValue: "Invalid webhook name",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_name_description",
+			DefaultValue: "The webhook name is required and must be at most 255 characters",
+		},
+	}
+	// ErrorInvalidURL indicates an endpoint URL that is not an absolute https URL or that names a
+	// loopback, link-local, or private address.
+	ErrorInvalidURL = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_url",
+			DefaultValue: "Invalid webhook URL",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_url_description",
+			DefaultValue: "The webhook URL must be an absolute https URL that does not name a private address",
+		},
+	}
+	// ErrorInvalidCategories indicates missing or unknown event categories.
+	ErrorInvalidCategories = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_categories",
+			DefaultValue: "Invalid event categories",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_categories_description",
+			DefaultValue: "At least one event category is required, and every category must be a known observability event category",
+		},
+	}
+	// ErrorInvalidSignatureType indicates an unsupported signature type.
+	ErrorInvalidSignatureType = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_signature_type",
+			DefaultValue: "Invalid signature type",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_signature_type_description",
+			DefaultValue: "The signature type must be either HMAC_SHA256 or JWS",
+		},
+	}
+	// ErrorInvalidSecret indicates a missing or too short HMAC secret.
+	ErrorInvalidSecret = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_secret",
+			DefaultValue: "Invalid webhook secret",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_secret_description",
+			DefaultValue: "HMAC_SHA256 signed webhooks require a secret of at least 32 characters",
+		},
+	}
+	// ErrorDeliveryNotFound indicates the dead-lettered delivery does not exist.
+	ErrorDeliveryNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1008",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.delivery_not_found",
+			DefaultValue: "Delivery not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.delivery_not_found_description",
+			DefaultValue: "No dead-lettered delivery exists for the supplied identifier",
+		},
+	}
+	// ErrorInvalidLimit indicates an invalid limit parameter.
+	ErrorInvalidLimit = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1009",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_limit_parameter",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidOffset indicates an invalid offset parameter.
+	ErrorInvalidOffset = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "WHK-1010",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_offset_parameter",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.webhookservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+)
@@ -0,0 +1,191 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const webhooksPath = "/webhooks"
+
+// webhookHandler serves the webhook management API.
+type webhookHandler struct {
+	service WebhookServiceInterface
+}
+
+// newWebhookHandler creates a new instance of webhookHandler.
+func newWebhookHandler(service WebhookServiceInterface) *webhookHandler {
+	return &webhookHandler{service: service}
+}
+
+// HandleCreate registers a webhook endpoint.
+func (h *webhookHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	req, err := sysutils.DecodeJSONBody[WebhookRequest](r)
+	if err != nil {
+		writeWebhookError(r.Context(), w, &ErrorInvalidRequestFormat)
+		return
+	}
+	endpoint, svcErr := h.service.CreateWebhook(r.Context(), sanitizeRequest(req))
+	if svcErr != nil {
+		writeWebhookError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusCreated, endpoint)
+}
+
+// HandleList returns all webhook endpoints.
+func (h *webhookHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	endpoints, svcErr := h.service.ListWebhooks(r.Context())
+	if svcErr != nil {
+		writeWebhookError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, endpoints)
+}
+
+// HandleGet returns a webhook endpoint.
+func (h *webhookHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(r.PathValue("id"))
+	endpoint, svcErr := h.service.GetWebhook(r.Context(), id)
+	if svcErr != nil {
+		writeWebhookError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, endpoint)
+}
+
+// HandleUpdate replaces a webhook endpoint.
+func (h *webhookHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(r.PathValue("id"))
+	req, err := sysutils.DecodeJSONBody[WebhookRequest](r)
+	if err != nil {
+		writeWebhookError(r.Context(), w, &ErrorInvalidRequestFormat)
+		return
+	}
+	endpoint, svcErr := h.service.UpdateWebhook(r.Context(), id, sanitizeRequest(req))
+	if svcErr != nil {
+		writeWebhookError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, endpoint)
+}
+
+// HandleDelete removes a webhook endpoint.
+func (h *webhookHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(r.PathValue("id"))
+	if svcErr := h.service.DeleteWebhook(r.Context(), id); svcErr != nil {
+		writeWebhookError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// HandleTest sends a test event to a webhook endpoint.
+func (h *webhookHandler) HandleTest(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(r.PathValue("id"))
+	result, svcErr := h.service.TestWebhook(r.Context(), id)
+	if svcErr != nil {
+		writeWebhookError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, result)
+}
+
+// HandleDeadLetterList returns a page of the dead-lettered deliveries of a webhook endpoint.
+func (h *webhookHandler) HandleDeadLetterList(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(r.PathValue("id"))
+	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	if svcErr != nil {
+		writeWebhookError(r.Context(), w, svcErr)
+		return
+	}
+	if limit == 0 {
+		limit = serverconst.DefaultPageSize
+	}
+
+	deadLetters, svcErr := h.service.ListDeadLetters(r.Context(), id, limit, offset)
+	if svcErr != nil {
+		writeWebhookError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, deadLetters)
+}
+
+// HandleDeadLetterRetry moves a dead-lettered delivery back to the delivery queue.
+func (h *webhookHandler) HandleDeadLetterRetry(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(r.PathValue("id"))
+	deliveryID := strings.TrimSpace(r.PathValue("deliveryId"))
+	if svcErr := h.service.RetryDeadLetter(r.Context(), id, deliveryID); svcErr != nil {
+		writeWebhookError(r.Context(), w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// sanitizeRequest returns a copy of the request with its free-text fields sanitized. The URL and
+// secret are used verbatim.
+func sanitizeRequest(req *WebhookRequest) *WebhookRequest {
+	categories := make([]string, 0, len(req.Categories))
+	for _, category := range req.Categories {
+		categories = append(categories, sysutils.SanitizeString(category))
+	}
+	return &WebhookRequest{
+		Name:          sysutils.SanitizeString(req.Name),
+		URL:           strings.TrimSpace(req.URL),
+		Categories:    categories,
+		SignatureType: SignatureType(strings.ToUpper(sysutils.SanitizeString(string(req.SignatureType)))),
+		Secret:        req.Secret,
+		Enabled:       req.Enabled,
+	}
+}
+
+// parsePaginationParams parses the limit and offset query parameters.
+func parsePaginationParams(query url.Values) (int, int, *tidcommon.ServiceError) {
+	limit := 0
+	offset := 0
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidLimit
+		}
+		limit = parsedLimit
+	}
+
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidOffset
+		}
+		offset = parsedOffset
+	}
+
+	return limit, offset, nil
+}
+
+// writeWebhookError maps a service error to an HTTP status and writes the corresponding error response.
+func writeWebhookError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	status := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		status = http.StatusBadRequest
+		if svcErr.Code == ErrorWebhookNotFound.Code || svcErr.Code == ErrorDeliveryNotFound.Code {
+			status = http.StatusNotFound
+		}
+	}
+	sysutils.WriteErrorResponse(ctx, w, status, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
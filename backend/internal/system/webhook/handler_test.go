@@ -0,0 +1,140 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type WebhookHandlerTestSuite struct {
+	suite.Suite
+	mockService *WebhookServiceInterfaceMock
+	handler     *webhookHandler
+}
+
+func TestWebhookHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookHandlerTestSuite))
+}
+
+func (suite *WebhookHandlerTestSuite) SetupTest() {
+	suite.mockService = NewWebhookServiceInterfaceMock(suite.T())
+	suite.handler = newWebhookHandler(suite.mockService)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleCreate_Success() {
+	suite.mockService.On("CreateWebhook", mock.Anything, mock.MatchedBy(func(req *WebhookRequest) bool {
+		return req.Name == "Hook" && req.URL == "https://example.com/hook" && req.SignatureType == SignatureTypeJWS
+	})).Return(&WebhookEndpoint{ID: "wh-1", Name: "Hook", Secret: "encrypted"}, nil)
+
+	body := `{"name":"Hook","url":" https://example.com/hook ","categories":["observability.all"],` +
+		`"signatureType":"jws"}`
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	suite.handler.HandleCreate(w, req)
+
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.NotContains(w.Body.String(), "encrypted")
+	var endpoint WebhookEndpoint
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &endpoint))
+	suite.Equal("wh-1", endpoint.ID)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleCreate_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader("{"))
+	w := httptest.NewRecorder()
+	suite.handler.HandleCreate(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleGet_NotFound() {
+	suite.mockService.On("GetWebhook", mock.Anything, "wh-1").Return(nil, &ErrorWebhookNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/wh-1", nil)
+	req.SetPathValue("id", "wh-1")
+	w := httptest.NewRecorder()
+	suite.handler.HandleGet(w, req)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleDelete_InternalError() {
+	suite.mockService.On("DeleteWebhook", mock.Anything, "wh-1").Return(&tidcommon.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodDelete, "/webhooks/wh-1", nil)
+	req.SetPathValue("id", "wh-1")
+	w := httptest.NewRecorder()
+	suite.handler.HandleDelete(w, req)
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleTest_Success() {
+	suite.mockService.On("TestWebhook", mock.Anything, "wh-1").
+		Return(&TestDeliveryResponse{Delivered: true, StatusCode: http.StatusOK}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/wh-1/test", nil)
+	req.SetPathValue("id", "wh-1")
+	w := httptest.NewRecorder()
+	suite.handler.HandleTest(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var result TestDeliveryResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	suite.True(result.Delivered)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleDeadLetterList_DefaultLimit() {
+	suite.mockService.On("ListDeadLetters", mock.Anything, "wh-1", 30, 5).
+		Return(&DeadLetterListResponse{Deliveries: []Delivery{}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/wh-1/dead-letters?offset=5", nil)
+	req.SetPathValue("id", "wh-1")
+	w := httptest.NewRecorder()
+	suite.handler.HandleDeadLetterList(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleDeadLetterList_InvalidLimit() {
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/wh-1/dead-letters?limit=abc", nil)
+	req.SetPathValue("id", "wh-1")
+	w := httptest.NewRecorder()
+	suite.handler.HandleDeadLetterList(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *WebhookHandlerTestSuite) TestHandleDeadLetterRetry() {
+	suite.mockService.On("RetryDeadLetter", mock.Anything, "wh-1", "dlv-1").Return(nil).Once()
+	suite.mockService.On("RetryDeadLetter", mock.Anything, "wh-1", "dlv-2").Return(&ErrorDeliveryNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/wh-1/dead-letters/dlv-1/retry", nil)
+	req.SetPathValue("id", "wh-1")
+	req.SetPathValue("deliveryId", "dlv-1")
+	w := httptest.NewRecorder()
+	suite.handler.HandleDeadLetterRetry(w, req)
+	suite.Equal(http.StatusNoContent, w.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/webhooks/wh-1/dead-letters/dlv-2/retry", nil)
+	req.SetPathValue("id", "wh-1")
+	req.SetPathValue("deliveryId", "dlv-2")
+	w = httptest.NewRecorder()
+	suite.handler.HandleDeadLetterRetry(w, req)
+	suite.Equal(http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhook provides the webhook observability subscriber, which delivers observability events
+// to registered HTTP endpoints with signed, retried deliveries, and the management API of the
+// endpoints and their dead-lettered deliveries.
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+)
+
+// Initialize constructs the webhook service and registers the webhook management routes. When
+// observability.output.webhook is enabled, it also starts the dispatcher and subscribes it to the
+// observability publisher; the publisher closes it on shutdown.
+func Initialize(
+	mux *http.ServeMux,
+	deploymentID string,
+	jwtService jwt.JWTServiceInterface,
+	configCrypto kmprovider.ConfigCryptoProvider,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) WebhookServiceInterface {
+	runtime := config.GetServerRuntime()
+	webhookConfig := runtime.Config.Observability.Output.Webhook
+	settings := newDispatchSettings(webhookConfig)
+
+	endpointStore := newEndpointStore(deploymentID)
+	deliveryStore := newDeliveryStore(deploymentID)
+	httpClient := newSSRFSafeHTTPClient(settings.timeout)
+	if webhookConfig.AllowInsecureURLs {
+		httpClient = syshttp.NewHTTPClientWithTimeout(settings.timeout)
+	}
+	sender := newWebhookSender(httpClient, jwtService, configCrypto, runtime.Config.JWT.Issuer,
+		webhookConfig.AllowInsecureURLs)
+
+	var dispatcher *webhookDispatcher
+	if webhookConfig.Enabled {
+		dispatcher = startDispatcher(endpointStore, deliveryStore, sender, settings, observabilitySvc)
+	}
+
+	webhookService := newWebhookService(endpointStore, deliveryStore, sender, configCrypto, dispatcher)
+	registerRoutes(mux, newWebhookHandler(webhookService))
+	return webhookService
+}
+
+// startDispatcher starts the webhook dispatcher and subscribes it to the observability publisher.
+// It returns nil when the dispatcher cannot run.
+func startDispatcher(endpointStore endpointStoreInterface, deliveryStore deliveryStoreInterface,
+	sender *webhookSender, settings dispatchSettings,
+	observabilitySvc observability.ObservabilityServiceInterface) *webhookDispatcher {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, dispatcherComponentName))
+	// The dispatcher starts during application startup, outside any request.
+	ctx := context.Background()
+
+	if observabilitySvc == nil || observabilitySvc.GetPublisher() == nil {
+		logger.Warn(ctx, "Webhook delivery is enabled but observability is disabled; no events will be delivered")
+		return nil
+	}
+
+	dispatcher := newWebhookDispatcher(endpointStore, deliveryStore, sender, settings)
+	if err := dispatcher.Initialize(); err != nil {
+		logger.Error(ctx, "Failed to start the webhook dispatcher", log.Error(err))
+		return nil
+	}
+	observabilitySvc.GetPublisher().Subscribe(dispatcher)
+	return dispatcher
+}
+
+// registerRoutes registers the webhook management routes.
+func registerRoutes(mux *http.ServeMux, h *webhookHandler) {
+	collectionOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	resourceOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	actionOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	listOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	resourcePath := webhooksPath + "/{id}"
+	testPath := resourcePath + "/test"
+	deadLettersPath := resourcePath + "/dead-letters"
+	retryPath := deadLettersPath + "/{deliveryId}/retry"
+
+	mux.HandleFunc(middleware.WithCORS("POST "+webhooksPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleCreate)).ServeHTTP, collectionOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+webhooksPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleList)).ServeHTTP, collectionOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+resourcePath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleGet)).ServeHTTP, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT "+resourcePath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleUpdate)).ServeHTTP, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+resourcePath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleDelete)).ServeHTTP, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+testPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleTest)).ServeHTTP, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("GET "+deadLettersPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleDeadLetterList)).ServeHTTP, listOpts))
+	mux.HandleFunc(middleware.WithCORS("POST "+retryPath,
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(h.HandleDeadLetterRetry)).ServeHTTP, actionOpts))
+
+	noContent := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) }
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+webhooksPath, noContent, collectionOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+resourcePath, noContent, resourceOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+testPath, noContent, actionOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+deadLettersPath, noContent, listOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+retryPath, noContent, actionOpts))
+}
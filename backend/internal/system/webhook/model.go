@@ -0,0 +1,94 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// SignatureType identifies how the deliveries to a webhook endpoint are signed.
+type SignatureType string
+
+const (
+	// SignatureTypeHMAC signs deliveries with an HMAC-SHA256 of the timestamp and body, keyed with
+	// the endpoint secret.
+	SignatureTypeHMAC SignatureType = "HMAC_SHA256"
+	// SignatureTypeJWS signs deliveries with a JWT issued by the server that carries the SHA-256 hash
+	// of the body, verifiable with the server JWKS.
+	SignatureTypeJWS SignatureType = "JWS"
+)
+
+// DeliveryStatus is the state of a queued webhook delivery.
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending marks a delivery that is waiting for its next attempt.
+	DeliveryStatusPending DeliveryStatus = "PENDING"
+	// DeliveryStatusDead marks a delivery that exhausted its attempts and is on the dead-letter list.
+	DeliveryStatusDead DeliveryStatus = "DEAD"
+)
+
+// WebhookEndpoint is a registered webhook endpoint.
+type WebhookEndpoint struct {
+	ID            string        `json:"id"`
+	Name          string        `json:"name"`
+	URL           string        `json:"url"`
+	Categories    []string      `json:"categories"`
+	SignatureType SignatureType `json:"signatureType"`
+	Enabled       bool          `json:"enabled"`
+	// Secret is the HMAC signing secret, encrypted with the configuration crypto provider. It is
+	// never returned by the API.
+	Secret string `json:"-"`
+}
+
+// WebhookRequest is the request body for registering or updating a webhook endpoint.
+type WebhookRequest struct {
+	Name          string        `json:"name"`
+	URL           string        `json:"url"`
+	Categories    []string      `json:"categories"`
+	SignatureType SignatureType `json:"signatureType,omitempty"`
+	// Secret is the HMAC signing secret. On update, an empty secret keeps the current one.
+	Secret  string `json:"secret,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+// WebhookListResponse is the response body for listing webhook endpoints.
+type WebhookListResponse struct {
+	TotalResults int               `json:"totalResults"`
+	Webhooks     []WebhookEndpoint `json:"webhooks"`
+}
+
+// Delivery is an observability event queued for delivery to a webhook endpoint.
+type Delivery struct {
+	ID             string         `json:"id"`
+	EndpointID     string         `json:"endpointId"`
+	EventID        string         `json:"eventId"`
+	EventType      string         `json:"eventType"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int            `json:"attempts"`
+	LastStatusCode int            `json:"lastStatusCode,omitempty"`
+	LastError      string         `json:"lastError,omitempty"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	UpdatedAt      time.Time      `json:"updatedAt"`
+	NextAttemptAt  time.Time      `json:"-"`
+	Payload        []byte         `json:"-"`
+}
+
+// DeadLetterListResponse is the response body for listing the dead-lettered deliveries of an endpoint.
+type DeadLetterListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	StartIndex   int          `json:"startIndex"`
+	Count        int          `json:"count"`
+	Deliveries   []Delivery   `json:"deliveries"`
+	Links        []utils.Link `json:"links"`
+}
+
+// TestDeliveryResponse is the result of sending a test event to a webhook endpoint.
+type TestDeliveryResponse struct {
+	Delivered  bool   `json:"delivered"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
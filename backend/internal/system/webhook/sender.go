@@ -0,0 +1,170 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+)
+
+const (
+	// headerSignature carries the delivery signature: "t=<unix time>,v1=<hex HMAC-SHA256>" for HMAC
+	// signed endpoints and a compact JWS for JWS signed endpoints.
+	headerSignature = "X-ThunderID-Signature"
+	// headerDeliveryID carries the delivery id. It is stable across retries, so receivers can use it
+	// to discard duplicates.
+	headerDeliveryID = "X-ThunderID-Delivery-Id"
+	// headerEventType carries the observability event type.
+	headerEventType = "X-ThunderID-Event-Type"
+
+	// signatureTokenType is the typ header of the JWS signature.
+	signatureTokenType = "webhook+jwt"
+	// signatureTokenValidity is the lifetime of a JWS signature in seconds. It only has to survive
+	// delivery.
+	signatureTokenValidity int64 = 300
+
+	claimBodyHash = "body_sha256"
+	claimJTI      = "jti"
+)
+
+// webhookSender signs an event payload for an endpoint and POSTs it.
+type webhookSender struct {
+	httpClient   syshttp.HTTPClientInterface
+	jwtService   jwt.JWTServiceInterface
+	configCrypto kmprovider.ConfigCryptoProvider
+	issuer       string
+	// allowInsecureURLs accepts http endpoint URLs and hosts in private address ranges.
+	allowInsecureURLs bool
+	now               func() time.Time
+}
+
+// newWebhookSender creates a new webhookSender.
+func newWebhookSender(httpClient syshttp.HTTPClientInterface, jwtService jwt.JWTServiceInterface,
+	configCrypto kmprovider.ConfigCryptoProvider, issuer string, allowInsecureURLs bool) *webhookSender {
+	return &webhookSender{
+		httpClient:        httpClient,
+		jwtService:        jwtService,
+		configCrypto:      configCrypto,
+		issuer:            issuer,
+		allowInsecureURLs: allowInsecureURLs,
+		now:               time.Now,
+	}
+}
+
+// newSSRFSafeHTTPClient creates the HTTP client used to deliver webhooks. It refuses to connect to
+// hosts that resolve to private addresses and re-checks every redirect target.
+func newSSRFSafeHTTPClient(timeout time.Duration) syshttp.HTTPClientInterface {
+	return syshttp.NewHTTPClientWithTimeoutAndCheckRedirect(timeout,
+		func(req *http.Request, _ []*http.Request) error {
+			return syshttp.IsSSRFSafeURL(req.URL.String())
+		})
+}
+
+// checkURL returns an error when rawURL is not an acceptable webhook endpoint URL. Unless insecure
+// URLs are allowed, the URL must use https and must not name a loopback, link-local, or private
+// address.
+func (s *webhookSender) checkURL(rawURL string) error {
+	if rawURL == "" || len(rawURL) > maxURLLength {
+		return errors.New("URL is empty or too long")
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	if (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return errors.New("URL must be an absolute http or https URL")
+	}
+	if s.allowInsecureURLs {
+		return nil
+	}
+	return syshttp.IsSSRFSafeURL(rawURL)
+}
+
+// send makes a single delivery attempt and returns the response status code, or 0 when no response
+// was received. Any response other than 2xx is returned as an error.
+func (s *webhookSender) send(ctx context.Context, endpoint *WebhookEndpoint, deliveryID, eventType string,
+	payload []byte) (int, error) {
+	// The URL is checked again because endpoints may predate the current URL policy.
+	if err := s.checkURL(endpoint.URL); err != nil {
+		return 0, fmt.Errorf("webhook URL is not allowed: %w", err)
+	}
+	signature, err := s.sign(ctx, endpoint, deliveryID, payload)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerSignature, signature)
+	req.Header.Set(headerDeliveryID, deliveryID)
+	req.Header.Set(headerEventType, eventType)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// sign returns the signature header value of a delivery.
+func (s *webhookSender) sign(ctx context.Context, endpoint *WebhookEndpoint, deliveryID string,
+	payload []byte) (string, error) {
+	if endpoint.SignatureType == SignatureTypeJWS {
+		bodyHash := sha256.Sum256(payload)
+		claims := map[string]interface{}{
+			"aud":         endpoint.URL,
+			claimJTI:      deliveryID,
+			claimBodyHash: base64.RawURLEncoding.EncodeToString(bodyHash[:]),
+		}
+		token, _, svcErr := s.jwtService.GenerateJWT(ctx, endpoint.ID, s.issuer, signatureTokenValidity,
+			claims, signatureTokenType, "")
+		if svcErr != nil {
+			return "", fmt.Errorf("failed to generate webhook signature: %s", svcErr.Error.DefaultValue)
+		}
+		return token, nil
+	}
+
+	secret, err := s.configCrypto.Decrypt(ctx, []byte(endpoint.Secret))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt webhook secret: %w", err)
+	}
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	return "t=" + timestamp + ",v1=" + computeHMACSignature(secret, timestamp, payload), nil
+}
+
+// computeHMACSignature returns the hex encoded HMAC-SHA256 of "<timestamp>.<payload>". Binding the
+// timestamp into the signature lets receivers reject replayed deliveries.
+func computeHMACSignature(secret []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,327 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	loggerComponentName = "WebhookService"
+
+	// maxNameLength is the size of the NAME column.
+	maxNameLength = 255
+	// maxURLLength is the size of the URL column.
+	maxURLLength = 2048
+	// minSecretLength is the minimum length of an HMAC signing secret.
+	minSecretLength = 32
+
+	// testEventType is the type of the event sent by the webhook test API.
+	testEventType = "WEBHOOK_TEST"
+	// componentWebhookService identifies the webhook service as the source of test events.
+	componentWebhookService = "WebhookService"
+)
+
+// WebhookServiceInterface defines the webhook endpoint management operations.
+type WebhookServiceInterface interface {
+	// CreateWebhook registers a webhook endpoint.
+	CreateWebhook(ctx context.Context, req *WebhookRequest) (*WebhookEndpoint, *tidcommon.ServiceError)
+	// GetWebhook returns a webhook endpoint.
+	GetWebhook(ctx context.Context, id string) (*WebhookEndpoint, *tidcommon.ServiceError)
+	// ListWebhooks returns all webhook endpoints.
+	ListWebhooks(ctx context.Context) (*WebhookListResponse, *tidcommon.ServiceError)
+	// UpdateWebhook replaces a webhook endpoint. An empty secret keeps the current one.
+	UpdateWebhook(ctx context.Context, id string, req *WebhookRequest) (*WebhookEndpoint, *tidcommon.ServiceError)
+	// DeleteWebhook removes a webhook endpoint together with its queued and dead-lettered deliveries.
+	DeleteWebhook(ctx context.Context, id string) *tidcommon.ServiceError
+	// TestWebhook sends a signed test event to a webhook endpoint and reports the outcome. The test
+	// event is not queued or retried.
+	TestWebhook(ctx context.Context, id string) (*TestDeliveryResponse, *tidcommon.ServiceError)
+	// ListDeadLetters returns a page of the dead-lettered deliveries of a webhook endpoint.
+	ListDeadLetters(ctx context.Context, id string, limit, offset int) (
+		*DeadLetterListResponse, *tidcommon.ServiceError)
+	// RetryDeadLetter moves a dead-lettered delivery back to the queue of its webhook endpoint.
+	RetryDeadLetter(ctx context.Context, id, deliveryID string) *tidcommon.ServiceError
+}
+
+// webhookService is the default implementation of WebhookServiceInterface.
+type webhookService struct {
+	endpointStore endpointStoreInterface
+	deliveryStore deliveryStoreInterface
+	sender        *webhookSender
+	configCrypto  kmprovider.ConfigCryptoProvider
+	// dispatcher is nil when webhook delivery is disabled.
+	dispatcher *webhookDispatcher
+	logger     *log.Logger
+}
+
+// newWebhookService creates a new instance of webhookService.
+func newWebhookService(endpointStore endpointStoreInterface, deliveryStore deliveryStoreInterface,
+	sender *webhookSender, configCrypto kmprovider.ConfigCryptoProvider,
+	dispatcher *webhookDispatcher) WebhookServiceInterface {
+	return &webhookService{
+		endpointStore: endpointStore,
+		deliveryStore: deliveryStore,
+		sender:        sender,
+		configCrypto:  configCrypto,
+		dispatcher:    dispatcher,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// CreateWebhook registers a webhook endpoint.
+func (s *webhookService) CreateWebhook(
+	ctx context.Context, req *WebhookRequest,
+) (*WebhookEndpoint, *tidcommon.ServiceError) {
+	endpoint, svcErr := s.buildEndpoint(ctx, req, nil)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate webhook id", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	endpoint.ID = id
+
+	if err := s.endpointStore.CreateEndpoint(ctx, endpoint); err != nil {
+		s.logger.Error(ctx, "Failed to create webhook endpoint", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	s.reloadDispatcher(ctx)
+	return endpoint, nil
+}
+
+// GetWebhook returns a webhook endpoint.
+func (s *webhookService) GetWebhook(ctx context.Context, id string) (*WebhookEndpoint, *tidcommon.ServiceError) {
+	endpoint, err := s.endpointStore.GetEndpoint(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, &ErrorWebhookNotFound
+		}
+		s.logger.Error(ctx, "Failed to get webhook endpoint", log.String("id", id), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return endpoint, nil
+}
+
+// ListWebhooks returns all webhook endpoints.
+func (s *webhookService) ListWebhooks(ctx context.Context) (*WebhookListResponse, *tidcommon.ServiceError) {
+	endpoints, err := s.endpointStore.ListEndpoints(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list webhook endpoints", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return &WebhookListResponse{TotalResults: len(endpoints), Webhooks: endpoints}, nil
+}
+
+// UpdateWebhook replaces a webhook endpoint.
+func (s *webhookService) UpdateWebhook(
+	ctx context.Context, id string, req *WebhookRequest,
+) (*WebhookEndpoint, *tidcommon.ServiceError) {
+	existing, svcErr := s.GetWebhook(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	endpoint, svcErr := s.buildEndpoint(ctx, req, existing)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	endpoint.ID = id
+
+	if err := s.endpointStore.UpdateEndpoint(ctx, endpoint); err != nil {
+		s.logger.Error(ctx, "Failed to update webhook endpoint", log.String("id", id), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	s.reloadDispatcher(ctx)
+	return endpoint, nil
+}
+
+// DeleteWebhook removes a webhook endpoint together with its queued and dead-lettered deliveries.
+func (s *webhookService) DeleteWebhook(ctx context.Context, id string) *tidcommon.ServiceError {
+	if _, svcErr := s.GetWebhook(ctx, id); svcErr != nil {
+		return svcErr
+	}
+	if err := s.endpointStore.DeleteEndpoint(ctx, id); err != nil {
+		s.logger.Error(ctx, "Failed to delete webhook endpoint", log.String("id", id), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	s.reloadDispatcher(ctx)
+	if err := s.deliveryStore.DeleteEndpointDeliveries(ctx, id); err != nil {
+		// The endpoint is gone, so its leftover deliveries are never attempted again; they only take
+		// space until the next deletion attempt or a manual cleanup.
+		s.logger.Warn(ctx, "Failed to delete the deliveries of a deleted webhook endpoint",
+			log.String("id", id), log.Error(err))
+	}
+	return nil
+}
+
+// TestWebhook sends a signed test event to a webhook endpoint and reports the outcome.
+func (s *webhookService) TestWebhook(ctx context.Context, id string) (*TestDeliveryResponse, *tidcommon.ServiceError) {
+	endpoint, svcErr := s.GetWebhook(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	testEvent := event.NewEvent(syscontext.GetTraceID(ctx), testEventType, componentWebhookService).
+		WithData(event.DataKey.Message, "Test event sent from the webhook management API")
+	payload, err := json.Marshal(testEvent)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to marshal webhook test event", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	statusCode, err := s.sender.send(ctx, endpoint, testEvent.EventID, testEventType, payload)
+	if err != nil {
+		return &TestDeliveryResponse{Delivered: false, StatusCode: statusCode, Error: err.Error()}, nil
+	}
+	return &TestDeliveryResponse{Delivered: true, StatusCode: statusCode}, nil
+}
+
+// ListDeadLetters returns a page of the dead-lettered deliveries of a webhook endpoint.
+func (s *webhookService) ListDeadLetters(
+	ctx context.Context, id string, limit, offset int,
+) (*DeadLetterListResponse, *tidcommon.ServiceError) {
+	if limit < 1 || limit > serverconst.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return nil, &ErrorInvalidOffset
+	}
+	if _, svcErr := s.GetWebhook(ctx, id); svcErr != nil {
+		return nil, svcErr
+	}
+
+	totalCount, err := s.deliveryStore.CountDeadLetters(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to count dead-lettered webhook deliveries", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	deliveries, err := s.deliveryStore.ListDeadLetters(ctx, id, limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list dead-lettered webhook deliveries", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return &DeadLetterListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(deliveries),
+		Deliveries:   deliveries,
+		Links: utils.BuildPaginationLinks("/webhooks/"+id+"/dead-letters", limit, offset,
+			totalCount, ""),
+	}, nil
+}
+
+// RetryDeadLetter moves a dead-lettered delivery back to the queue of its webhook endpoint.
+func (s *webhookService) RetryDeadLetter(ctx context.Context, id, deliveryID string) *tidcommon.ServiceError {
+	if _, svcErr := s.GetWebhook(ctx, id); svcErr != nil {
+		return svcErr
+	}
+	requeued, err := s.deliveryStore.RequeueDeadLetter(ctx, id, deliveryID, time.Now())
+	if err != nil {
+		s.logger.Error(ctx, "Failed to requeue dead-lettered webhook delivery",
+			log.String("deliveryId", deliveryID), log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if !requeued {
+		return &ErrorDeliveryNotFound
+	}
+	if s.dispatcher != nil {
+		s.dispatcher.notify(id)
+	}
+	return nil
+}
+
+// buildEndpoint validates a webhook request and builds the endpoint it describes. existing is the
+// endpoint being updated, or nil on create; its secret is kept when the request has none.
+func (s *webhookService) buildEndpoint(
+	ctx context.Context, req *WebhookRequest, existing *WebhookEndpoint,
+) (*WebhookEndpoint, *tidcommon.ServiceError) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > maxNameLength {
+		return nil, &ErrorInvalidName
+	}
+	if err := s.sender.checkURL(req.URL); err != nil {
+		return nil, &ErrorInvalidURL
+	}
+	if !areValidCategories(req.Categories) {
+		return nil, &ErrorInvalidCategories
+	}
+	signatureType := req.SignatureType
+	if signatureType == "" {
+		signatureType = SignatureTypeHMAC
+	}
+	if signatureType != SignatureTypeHMAC && signatureType != SignatureTypeJWS {
+		return nil, &ErrorInvalidSignatureType
+	}
+
+	endpoint := &WebhookEndpoint{
+		Name:          name,
+		URL:           req.URL,
+		Categories:    req.Categories,
+		SignatureType: signatureType,
+		Enabled:       req.Enabled == nil || *req.Enabled,
+	}
+
+	if signatureType == SignatureTypeHMAC {
+		switch {
+		case req.Secret != "":
+			if len(req.Secret) < minSecretLength {
+				return nil, &ErrorInvalidSecret
+			}
+			encrypted, err := s.configCrypto.Encrypt(ctx, []byte(req.Secret))
+			if err != nil {
+				s.logger.Error(ctx, "Failed to encrypt webhook secret", log.Error(err))
+				return nil, &tidcommon.InternalServerError
+			}
+			endpoint.Secret = string(encrypted)
+		case existing != nil && existing.Secret != "":
+			endpoint.Secret = existing.Secret
+		default:
+			return nil, &ErrorInvalidSecret
+		}
+	}
+	return endpoint, nil
+}
+
+// reloadDispatcher makes the dispatcher pick up an endpoint change. Other server nodes pick it up
+// on their next poll.
+func (s *webhookService) reloadDispatcher(ctx context.Context) {
+	if s.dispatcher == nil {
+		return
+	}
+	if err := s.dispatcher.reloadEndpoints(ctx); err != nil {
+		s.logger.Error(ctx, "Failed to reload webhook endpoints", log.Error(err))
+	}
+}
+
+// areValidCategories reports whether categories is a non-empty list of known event categories.
+func areValidCategories(categories []string) bool {
+	if len(categories) == 0 {
+		return false
+	}
+	for _, category := range categories {
+		if category != string(event.CategoryAll) &&
+			!slices.Contains(event.GetAllCategories(), event.EventCategory(category)) {
+			return false
+		}
+	}
+	return true
+}
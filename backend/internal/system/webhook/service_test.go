@@ -0,0 +1,236 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+type WebhookServiceTestSuite struct {
+	suite.Suite
+	mockEndpointStore *endpointStoreInterfaceMock
+	mockDeliveryStore *deliveryStoreInterfaceMock
+	mockCrypto        *cryptomock.ConfigCryptoProviderMock
+	mockHTTPClient    *httpmock.HTTPClientInterfaceMock
+	service           WebhookServiceInterface
+}
+
+func TestWebhookServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookServiceTestSuite))
+}
+
+func (suite *WebhookServiceTestSuite) SetupTest() {
+	suite.mockEndpointStore = NewendpointStoreInterfaceMock(suite.T())
+	suite.mockDeliveryStore = NewdeliveryStoreInterfaceMock(suite.T())
+	suite.mockCrypto = cryptomock.NewConfigCryptoProviderMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	sender := newWebhookSender(suite.mockHTTPClient, nil, suite.mockCrypto, "https://issuer", false)
+	suite.service = newWebhookService(suite.mockEndpointStore, suite.mockDeliveryStore, sender,
+		suite.mockCrypto, nil)
+}
+
+func (suite *WebhookServiceTestSuite) validRequest() *WebhookRequest {
+	return &WebhookRequest{
+		Name:       "Hook",
+		URL:        "https://example.com/hook",
+		Categories: []string{"observability.authentication"},
+		Secret:     testSecret,
+	}
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook_Success() {
+	suite.mockCrypto.On("Encrypt", mock.Anything, []byte(testSecret)).Return([]byte("encrypted"), nil)
+	suite.mockEndpointStore.On("CreateEndpoint", mock.Anything, mock.MatchedBy(func(e *WebhookEndpoint) bool {
+		return e.ID != "" && e.Secret == "encrypted" && e.SignatureType == SignatureTypeHMAC && e.Enabled
+	})).Return(nil)
+
+	endpoint, svcErr := suite.service.CreateWebhook(context.Background(), suite.validRequest())
+	suite.Nil(svcErr)
+	suite.Equal("Hook", endpoint.Name)
+	suite.Equal(SignatureTypeHMAC, endpoint.SignatureType)
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook_ValidationErrors() {
+	tests := []struct {
+		name     string
+		modify   func(req *WebhookRequest)
+		expected string
+	}{
+		{"EmptyName", func(req *WebhookRequest) { req.Name = "  " }, ErrorInvalidName.Code},
+		{"RelativeURL", func(req *WebhookRequest) { req.URL = "/hook" }, ErrorInvalidURL.Code},
+		{"UnsupportedScheme", func(req *WebhookRequest) { req.URL = "ftp://example.com" }, ErrorInvalidURL.Code},
+		{"PlainHTTP", func(req *WebhookRequest) { req.URL = "http://example.com/hook" }, ErrorInvalidURL.Code},
+		{"Loopback", func(req *WebhookRequest) { req.URL = "https://127.0.0.1/hook" }, ErrorInvalidURL.Code},
+		{"CloudMetadata", func(req *WebhookRequest) { req.URL = "https://169.254.169.254/latest" },
+			ErrorInvalidURL.Code},
+		{"PrivateAddress", func(req *WebhookRequest) { req.URL = "https://10.0.0.5/hook" }, ErrorInvalidURL.Code},
+		{"NoCategories", func(req *WebhookRequest) { req.Categories = nil }, ErrorInvalidCategories.Code},
+		{"UnknownCategory", func(req *WebhookRequest) { req.Categories = []string{"unknown"} },
+			ErrorInvalidCategories.Code},
+		{"UnknownSignatureType", func(req *WebhookRequest) { req.SignatureType = "RSA" },
+			ErrorInvalidSignatureType.Code},
+		{"ShortSecret", func(req *WebhookRequest) { req.Secret = "short" }, ErrorInvalidSecret.Code},
+		{"MissingSecret", func(req *WebhookRequest) { req.Secret = "" }, ErrorInvalidSecret.Code},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			req := suite.validRequest()
+			tc.modify(req)
+			_, svcErr := suite.service.CreateWebhook(context.Background(), req)
+			suite.NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook_AllowInsecureURLs() {
+	sender := newWebhookSender(suite.mockHTTPClient, nil, suite.mockCrypto, "https://issuer", true)
+	service := newWebhookService(suite.mockEndpointStore, suite.mockDeliveryStore, sender, suite.mockCrypto, nil)
+	suite.mockCrypto.On("Encrypt", mock.Anything, []byte(testSecret)).Return([]byte("encrypted"), nil)
+	suite.mockEndpointStore.On("CreateEndpoint", mock.Anything, mock.Anything).Return(nil)
+
+	req := suite.validRequest()
+	req.URL = "http://localhost:9000/hook"
+	endpoint, svcErr := service.CreateWebhook(context.Background(), req)
+	suite.Nil(svcErr)
+	suite.Equal("http://localhost:9000/hook", endpoint.URL)
+}
+
+func (suite *WebhookServiceTestSuite) TestUpdateWebhook_PrivateAddress() {
+	suite.mockEndpointStore.On("GetEndpoint", mock.Anything, "wh-1").Return(&WebhookEndpoint{
+		ID: "wh-1", URL: "https://example.com/hook", SignatureType: SignatureTypeHMAC, Secret: "encrypted",
+	}, nil)
+
+	req := suite.validRequest()
+	req.URL = "https://192.168.1.10/hook"
+	_, svcErr := suite.service.UpdateWebhook(context.Background(), "wh-1", req)
+	suite.NotNil(svcErr)
+	suite.Equal(ErrorInvalidURL.Code, svcErr.Code)
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook_JWSDropsSecret() {
+	req := suite.validRequest()
+	req.SignatureType = SignatureTypeJWS
+	req.Categories = []string{"observability.all"}
+	suite.mockEndpointStore.On("CreateEndpoint", mock.Anything, mock.MatchedBy(func(e *WebhookEndpoint) bool {
+		return e.Secret == "" && e.SignatureType == SignatureTypeJWS
+	})).Return(nil)
+
+	_, svcErr := suite.service.CreateWebhook(context.Background(), req)
+	suite.Nil(svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhook_StoreError() {
+	suite.mockCrypto.On("Encrypt", mock.Anything, mock.Anything).Return([]byte("encrypted"), nil)
+	suite.mockEndpointStore.On("CreateEndpoint", mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	_, svcErr := suite.service.CreateWebhook(context.Background(), suite.validRequest())
+	suite.Equal(&tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestGetWebhook_NotFound() {
+	suite.mockEndpointStore.On("GetEndpoint", mock.Anything, "wh-1").Return(nil, ErrNotFound)
+
+	_, svcErr := suite.service.GetWebhook(context.Background(), "wh-1")
+	suite.Equal(&ErrorWebhookNotFound, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestUpdateWebhook_KeepsExistingSecret() {
+	suite.mockEndpointStore.On("GetEndpoint", mock.Anything, "wh-1").
+		Return(&WebhookEndpoint{ID: "wh-1", SignatureType: SignatureTypeHMAC, Secret: "existing"}, nil)
+	suite.mockEndpointStore.On("UpdateEndpoint", mock.Anything, mock.MatchedBy(func(e *WebhookEndpoint) bool {
+		return e.ID == "wh-1" && e.Secret == "existing" && !e.Enabled
+	})).Return(nil)
+
+	disabled := false
+	req := suite.validRequest()
+	req.Secret = ""
+	req.Enabled = &disabled
+	endpoint, svcErr := suite.service.UpdateWebhook(context.Background(), "wh-1", req)
+	suite.Nil(svcErr)
+	suite.False(endpoint.Enabled)
+}
+
+func (suite *WebhookServiceTestSuite) TestDeleteWebhook_DeliveryCleanupFailureIsIgnored() {
+	suite.mockEndpointStore.On("GetEndpoint", mock.Anything, "wh-1").Return(&WebhookEndpoint{ID: "wh-1"}, nil)
+	suite.mockEndpointStore.On("DeleteEndpoint", mock.Anything, "wh-1").Return(nil)
+	suite.mockDeliveryStore.On("DeleteEndpointDeliveries", mock.Anything, "wh-1").Return(errors.New("db error"))
+
+	svcErr := suite.service.DeleteWebhook(context.Background(), "wh-1")
+	suite.Nil(svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestTestWebhook() {
+	suite.mockEndpointStore.On("GetEndpoint", mock.Anything, "wh-1").Return(&WebhookEndpoint{
+		ID: "wh-1", URL: "https://example.com/hook", SignatureType: SignatureTypeHMAC, Secret: "encrypted",
+	}, nil)
+	suite.mockCrypto.On("Decrypt", mock.Anything, []byte("encrypted")).Return([]byte(testSecret), nil)
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(r *http.Request) bool {
+		return r.Header.Get(headerEventType) == testEventType &&
+			strings.HasPrefix(r.Header.Get(headerSignature), "t=")
+	})).Return(&http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader(""))},
+		nil)
+
+	result, svcErr := suite.service.TestWebhook(context.Background(), "wh-1")
+	suite.Nil(svcErr)
+	suite.False(result.Delivered)
+	suite.Equal(http.StatusServiceUnavailable, result.StatusCode)
+	suite.Contains(result.Error, "503")
+}
+
+func (suite *WebhookServiceTestSuite) TestListDeadLetters_Success() {
+	suite.mockEndpointStore.On("GetEndpoint", mock.Anything, "wh-1").Return(&WebhookEndpoint{ID: "wh-1"}, nil)
+	suite.mockDeliveryStore.On("CountDeadLetters", mock.Anything, "wh-1").Return(3, nil)
+	suite.mockDeliveryStore.On("ListDeadLetters", mock.Anything, "wh-1", 2, 0).
+		Return([]Delivery{{ID: "dlv-1"}, {ID: "dlv-2"}}, nil)
+
+	response, svcErr := suite.service.ListDeadLetters(context.Background(), "wh-1", 2, 0)
+	suite.Nil(svcErr)
+	suite.Equal(3, response.TotalResults)
+	suite.Equal(1, response.StartIndex)
+	suite.Equal(2, response.Count)
+	suite.NotEmpty(response.Links)
+}
+
+func (suite *WebhookServiceTestSuite) TestListDeadLetters_InvalidPagination() {
+	_, svcErr := suite.service.ListDeadLetters(context.Background(), "wh-1", 0, 0)
+	suite.Equal(&ErrorInvalidLimit, svcErr)
+
+	_, svcErr = suite.service.ListDeadLetters(context.Background(), "wh-1", 10, -1)
+	suite.Equal(&ErrorInvalidOffset, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestRetryDeadLetter_NotFound() {
+	suite.mockEndpointStore.On("GetEndpoint", mock.Anything, "wh-1").Return(&WebhookEndpoint{ID: "wh-1"}, nil)
+	suite.mockDeliveryStore.On("RequeueDeadLetter", mock.Anything, "wh-1", "dlv-1", mock.Anything).
+		Return(false, nil)
+
+	svcErr := suite.service.RetryDeadLetter(context.Background(), "wh-1", "dlv-1")
+	suite.Equal(&ErrorDeliveryNotFound, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestRetryDeadLetter_Success() {
+	suite.mockEndpointStore.On("GetEndpoint", mock.Anything, "wh-1").Return(&WebhookEndpoint{ID: "wh-1"}, nil)
+	suite.mockDeliveryStore.On("RequeueDeadLetter", mock.Anything, "wh-1", "dlv-1", mock.Anything).
+		Return(true, nil)
+
+	svcErr := suite.service.RetryDeadLetter(context.Background(), "wh-1", "dlv-1")
+	suite.Nil(svcErr)
+}
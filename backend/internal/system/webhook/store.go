@@ -0,0 +1,421 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// maxLastErrorLength is the size of the LAST_ERROR column; longer errors are truncated.
+const maxLastErrorLength = 1024
+
+// endpointStoreInterface persists the registered webhook endpoints in the config database.
+type endpointStoreInterface interface {
+	// CreateEndpoint inserts a webhook endpoint.
+	CreateEndpoint(ctx context.Context, endpoint *WebhookEndpoint) error
+	// GetEndpoint returns the webhook endpoint with the given id, or ErrNotFound.
+	GetEndpoint(ctx context.Context, id string) (*WebhookEndpoint, error)
+	// ListEndpoints returns all webhook endpoints, oldest first.
+	ListEndpoints(ctx context.Context) ([]WebhookEndpoint, error)
+	// UpdateEndpoint persists changes to a webhook endpoint.
+	UpdateEndpoint(ctx context.Context, endpoint *WebhookEndpoint) error
+	// DeleteEndpoint removes a webhook endpoint.
+	DeleteEndpoint(ctx context.Context, id string) error
+}
+
+// deliveryStoreInterface persists the webhook delivery queue and dead-letter list in the runtime
+// persistent database.
+type deliveryStoreInterface interface {
+	// InsertDelivery queues a delivery for its first attempt at the given time.
+	InsertDelivery(ctx context.Context, delivery *Delivery, now time.Time) error
+	// ListDueDeliveries returns up to limit pending deliveries of an endpoint whose next attempt is due.
+	ListDueDeliveries(ctx context.Context, endpointID string, now time.Time, limit int) ([]Delivery, error)
+	// ClaimDelivery leases a pending delivery for its next attempt until leaseUntil. attempts is the
+	// attempt count the delivery was read with; the claim fails when another node claimed it first.
+	ClaimDelivery(ctx context.Context, id string, attempts int, leaseUntil, now time.Time) (bool, error)
+	// DeleteDelivery removes a delivered delivery.
+	DeleteDelivery(ctx context.Context, id string) error
+	// RescheduleDelivery records a failed attempt and schedules the next one.
+	RescheduleDelivery(ctx context.Context, id string, nextAttemptAt time.Time, statusCode int,
+		lastError string, now time.Time) error
+	// MarkDeliveryDead records the last failed attempt and moves the delivery to the dead-letter list
+	// until expiry.
+	MarkDeliveryDead(ctx context.Context, id string, statusCode int, lastError string,
+		now, expiry time.Time) error
+	// CountDeadLetters returns the number of dead-lettered deliveries of an endpoint.
+	CountDeadLetters(ctx context.Context, endpointID string) (int, error)
+	// ListDeadLetters returns a page of the dead-lettered deliveries of an endpoint, most recent first.
+	ListDeadLetters(ctx context.Context, endpointID string, limit, offset int) ([]Delivery, error)
+	// RequeueDeadLetter moves a dead-lettered delivery back to the queue. It reports false when the
+	// endpoint has no such dead-lettered delivery.
+	RequeueDeadLetter(ctx context.Context, endpointID, id string, now time.Time) (bool, error)
+	// DeleteEndpointDeliveries removes the queued and dead-lettered deliveries of an endpoint.
+	DeleteEndpointDeliveries(ctx context.Context, endpointID string) error
+}
+
+// endpointStore implements endpointStoreInterface against the config database.
+type endpointStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newEndpointStore creates a new endpointStore.
+func newEndpointStore(deploymentID string) endpointStoreInterface {
+	return &endpointStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// CreateEndpoint inserts a webhook endpoint.
+func (s *endpointStore) CreateEndpoint(ctx context.Context, endpoint *WebhookEndpoint) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	categoriesJSON, err := json.Marshal(endpoint.Categories)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook categories: %w", err)
+	}
+	_, err = dbClient.ExecuteContext(ctx, queryCreateEndpoint, endpoint.ID, endpoint.Name, endpoint.URL,
+		string(categoriesJSON), string(endpoint.SignatureType), endpoint.Secret, endpoint.Enabled, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// GetEndpoint returns the webhook endpoint with the given id, or ErrNotFound.
+func (s *endpointStore) GetEndpoint(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryGetEndpoint, id, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook endpoint: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+	return buildEndpointFromRow(results[0])
+}
+
+// ListEndpoints returns all webhook endpoints, oldest first.
+func (s *endpointStore) ListEndpoints(ctx context.Context) ([]WebhookEndpoint, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryListEndpoints, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	endpoints := make([]WebhookEndpoint, 0, len(results))
+	for _, row := range results {
+		endpoint, err := buildEndpointFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, *endpoint)
+	}
+	return endpoints, nil
+}
+
+// UpdateEndpoint persists changes to a webhook endpoint.
+func (s *endpointStore) UpdateEndpoint(ctx context.Context, endpoint *WebhookEndpoint) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	categoriesJSON, err := json.Marshal(endpoint.Categories)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook categories: %w", err)
+	}
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateEndpoint, endpoint.ID, endpoint.Name, endpoint.URL,
+		string(categoriesJSON), string(endpoint.SignatureType), endpoint.Secret, endpoint.Enabled, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// DeleteEndpoint removes a webhook endpoint.
+func (s *endpointStore) DeleteEndpoint(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteEndpoint, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// deliveryStore implements deliveryStoreInterface against the runtime persistent database.
+type deliveryStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newDeliveryStore creates a new deliveryStore.
+func newDeliveryStore(deploymentID string) deliveryStoreInterface {
+	return &deliveryStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// InsertDelivery queues a delivery for its first attempt at the given time.
+func (s *deliveryStore) InsertDelivery(ctx context.Context, delivery *Delivery, now time.Time) error {
+	_, err := s.execute(ctx, queryInsertDelivery, delivery.ID, delivery.EndpointID, delivery.EventID,
+		delivery.EventType, string(delivery.Payload), now.UTC(), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// ListDueDeliveries returns up to limit pending deliveries of an endpoint whose next attempt is due.
+func (s *deliveryStore) ListDueDeliveries(
+	ctx context.Context, endpointID string, now time.Time, limit int,
+) ([]Delivery, error) {
+	return s.list(ctx, queryListDueDeliveries, endpointID, now.UTC(), s.deploymentID, limit)
+}
+
+// ClaimDelivery leases a pending delivery for its next attempt until leaseUntil.
+func (s *deliveryStore) ClaimDelivery(
+	ctx context.Context, id string, attempts int, leaseUntil, now time.Time,
+) (bool, error) {
+	rows, err := s.execute(ctx, queryClaimDelivery, id, attempts, leaseUntil.UTC(), now.UTC(), s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim webhook delivery: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// DeleteDelivery removes a delivered delivery.
+func (s *deliveryStore) DeleteDelivery(ctx context.Context, id string) error {
+	if _, err := s.execute(ctx, queryDeleteDelivery, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// RescheduleDelivery records a failed attempt and schedules the next one.
+func (s *deliveryStore) RescheduleDelivery(ctx context.Context, id string, nextAttemptAt time.Time,
+	statusCode int, lastError string, now time.Time) error {
+	_, err := s.execute(ctx, queryRescheduleDelivery, id, nextAttemptAt.UTC(), nullableStatusCode(statusCode),
+		truncateError(lastError), now.UTC(), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to reschedule webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// MarkDeliveryDead records the last failed attempt and moves the delivery to the dead-letter list.
+func (s *deliveryStore) MarkDeliveryDead(ctx context.Context, id string, statusCode int, lastError string,
+	now, expiry time.Time) error {
+	_, err := s.execute(ctx, queryMarkDeliveryDead, id, nullableStatusCode(statusCode), truncateError(lastError),
+		now.UTC(), expiry.UTC(), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to dead-letter webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// CountDeadLetters returns the number of dead-lettered deliveries of an endpoint.
+func (s *deliveryStore) CountDeadLetters(ctx context.Context, endpointID string) (int, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, queryCountDeadLetters, endpointID, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count dead-lettered webhook deliveries: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+	total, ok := results[0]["total"].(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected type for total: %T", results[0]["total"])
+	}
+	return int(total), nil
+}
+
+// ListDeadLetters returns a page of the dead-lettered deliveries of an endpoint, most recent first.
+func (s *deliveryStore) ListDeadLetters(
+	ctx context.Context, endpointID string, limit, offset int,
+) ([]Delivery, error) {
+	return s.list(ctx, queryListDeadLetters, limit, offset, endpointID, s.deploymentID)
+}
+
+// RequeueDeadLetter moves a dead-lettered delivery back to the queue.
+func (s *deliveryStore) RequeueDeadLetter(
+	ctx context.Context, endpointID, id string, now time.Time,
+) (bool, error) {
+	rows, err := s.execute(ctx, queryRequeueDeadLetter, id, endpointID, now.UTC(), s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to requeue webhook delivery: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// DeleteEndpointDeliveries removes the queued and dead-lettered deliveries of an endpoint.
+func (s *deliveryStore) DeleteEndpointDeliveries(ctx context.Context, endpointID string) error {
+	if _, err := s.execute(ctx, queryDeleteEndpointDeliveries, endpointID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// execute runs a statement against the runtime persistent database and returns the affected rows.
+func (s *deliveryStore) execute(ctx context.Context, query dbmodel.DBQuery, args ...interface{}) (int64, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+	return dbClient.ExecuteContext(ctx, query, args...)
+}
+
+// list runs a delivery query and returns the resulting deliveries.
+func (s *deliveryStore) list(ctx context.Context, query dbmodel.DBQuery, args ...interface{}) ([]Delivery, error) {
+	dbClient, err := s.dbProvider.GetRuntimePersistentDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runtime persistent database client: %w", err)
+	}
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+	deliveries := make([]Delivery, 0, len(results))
+	for _, row := range results {
+		delivery, err := buildDeliveryFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+	return deliveries, nil
+}
+
+// buildEndpointFromRow constructs a WebhookEndpoint from a result row.
+func buildEndpointFromRow(row map[string]interface{}) (*WebhookEndpoint, error) {
+	endpoint := &WebhookEndpoint{
+		ID:            columnString(row["id"]),
+		Name:          columnString(row["name"]),
+		URL:           columnString(row["url"]),
+		SignatureType: SignatureType(columnString(row["signature_type"])),
+		Secret:        columnString(row["secret"]),
+		Enabled:       columnBool(row["enabled"]),
+	}
+	if categoriesJSON := columnBytes(row["categories"]); len(categoriesJSON) > 0 {
+		if err := json.Unmarshal(categoriesJSON, &endpoint.Categories); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal webhook categories: %w", err)
+		}
+	}
+	return endpoint, nil
+}
+
+// buildDeliveryFromRow constructs a Delivery from a result row.
+func buildDeliveryFromRow(row map[string]interface{}) (*Delivery, error) {
+	nextAttemptAt, err := sysutils.ParseDBTimeField(row["next_attempt_at"], "next_attempt_at")
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := sysutils.ParseDBTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := sysutils.ParseDBTimeField(row["updated_at"], "updated_at")
+	if err != nil {
+		return nil, err
+	}
+	return &Delivery{
+		ID:             columnString(row["id"]),
+		EndpointID:     columnString(row["endpoint_id"]),
+		EventID:        columnString(row["event_id"]),
+		EventType:      columnString(row["event_type"]),
+		Payload:        columnBytes(row["payload"]),
+		Status:         DeliveryStatus(columnString(row["status"])),
+		Attempts:       columnInt(row["attempts"]),
+		LastStatusCode: columnInt(row["last_status_code"]),
+		LastError:      columnString(row["last_error"]),
+		NextAttemptAt:  nextAttemptAt,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}, nil
+}
+
+// nullableStatusCode maps a missing HTTP status code (the request never got a response) to NULL.
+func nullableStatusCode(statusCode int) interface{} {
+	if statusCode == 0 {
+		return nil
+	}
+	return statusCode
+}
+
+// truncateError shortens an error message to fit the LAST_ERROR column.
+func truncateError(message string) string {
+	if len(message) > maxLastErrorLength {
+		return message[:maxLastErrorLength]
+	}
+	return message
+}
+
+// columnString coerces a result-row value to a string, tolerating string/[]byte.
+func columnString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	default:
+		return ""
+	}
+}
+
+// columnBytes coerces a result-row value to bytes, tolerating []byte/string.
+func columnBytes(v interface{}) []byte {
+	switch t := v.(type) {
+	case []byte:
+		return t
+	case string:
+		return []byte(t)
+	default:
+		return nil
+	}
+}
+
+// columnInt coerces a nullable integer result-row value to an int.
+func columnInt(v interface{}) int {
+	switch t := v.(type) {
+	case int64:
+		return int(t)
+	case int:
+		return t
+	default:
+		return 0
+	}
+}
+
+// columnBool coerces a boolean result-row value, stored as an integer in SQLite, to a bool.
+func columnBool(v interface{}) bool {
+	switch t := v.(type) {
+	case bool:
+		return t
+	case int64:
+		return t != 0
+	default:
+		return false
+	}
+}
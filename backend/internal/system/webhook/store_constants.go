@@ -0,0 +1,99 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// DBQuery definitions for the webhook endpoint config store.
+var (
+	queryCreateEndpoint = dbmodel.DBQuery{
+		ID: "WHQ-EP-01",
+		Query: `INSERT INTO "WEBHOOK_ENDPOINT" ` +
+			`(ID, NAME, URL, CATEGORIES, SIGNATURE_TYPE, SECRET, ENABLED, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+	queryGetEndpoint = dbmodel.DBQuery{
+		ID: "WHQ-EP-02",
+		Query: `SELECT ID, NAME, URL, CATEGORIES, SIGNATURE_TYPE, SECRET, ENABLED ` +
+			`FROM "WEBHOOK_ENDPOINT" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	queryListEndpoints = dbmodel.DBQuery{
+		ID: "WHQ-EP-03",
+		Query: `SELECT ID, NAME, URL, CATEGORIES, SIGNATURE_TYPE, SECRET, ENABLED ` +
+			`FROM "WEBHOOK_ENDPOINT" WHERE DEPLOYMENT_ID = $1 ORDER BY CREATED_AT, ID`,
+	}
+	queryUpdateEndpoint = dbmodel.DBQuery{
+		ID: "WHQ-EP-04",
+		Query: `UPDATE "WEBHOOK_ENDPOINT" SET NAME = $2, URL = $3, CATEGORIES = $4, SIGNATURE_TYPE = $5, ` +
+			`SECRET = $6, ENABLED = $7, UPDATED_AT = CURRENT_TIMESTAMP WHERE ID = $1 AND DEPLOYMENT_ID = $8`,
+	}
+	queryDeleteEndpoint = dbmodel.DBQuery{
+		ID:    "WHQ-EP-05",
+		Query: `DELETE FROM "WEBHOOK_ENDPOINT" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
+
+// DBQuery definitions for the webhook delivery queue in the runtime persistent database.
+var (
+	queryInsertDelivery = dbmodel.DBQuery{
+		ID: "WHQ-DLV-01",
+		Query: `INSERT INTO "WEBHOOK_DELIVERY" (ID, ENDPOINT_ID, EVENT_ID, EVENT_TYPE, PAYLOAD, STATUS, ATTEMPTS, ` +
+			`NEXT_ATTEMPT_AT, CREATED_AT, UPDATED_AT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, 'PENDING', 0, $6, $6, $6, $7)`,
+	}
+	queryListDueDeliveries = dbmodel.DBQuery{
+		ID: "WHQ-DLV-02",
+		Query: `SELECT ID, ENDPOINT_ID, EVENT_ID, EVENT_TYPE, PAYLOAD, STATUS, ATTEMPTS, NEXT_ATTEMPT_AT, ` +
+			`LAST_STATUS_CODE, LAST_ERROR, CREATED_AT, UPDATED_AT FROM "WEBHOOK_DELIVERY" ` +
+			`WHERE ENDPOINT_ID = $1 AND STATUS = 'PENDING' AND NEXT_ATTEMPT_AT <= $2 AND DEPLOYMENT_ID = $3 ` +
+			`ORDER BY NEXT_ATTEMPT_AT, ID LIMIT $4`,
+	}
+	// queryClaimDelivery leases a pending delivery for one attempt. The attempt count doubles as the
+	// row version, so only one server node claims each attempt.
+	queryClaimDelivery = dbmodel.DBQuery{
+		ID: "WHQ-DLV-03",
+		Query: `UPDATE "WEBHOOK_DELIVERY" SET ATTEMPTS = ATTEMPTS + 1, NEXT_ATTEMPT_AT = $3, UPDATED_AT = $4 ` +
+			`WHERE ID = $1 AND ATTEMPTS = $2 AND STATUS = 'PENDING' AND DEPLOYMENT_ID = $5`,
+	}
+	queryDeleteDelivery = dbmodel.DBQuery{
+		ID:    "WHQ-DLV-04",
+		Query: `DELETE FROM "WEBHOOK_DELIVERY" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	queryRescheduleDelivery = dbmodel.DBQuery{
+		ID: "WHQ-DLV-05",
+		Query: `UPDATE "WEBHOOK_DELIVERY" SET NEXT_ATTEMPT_AT = $2, LAST_STATUS_CODE = $3, LAST_ERROR = $4, ` +
+			`UPDATED_AT = $5 WHERE ID = $1 AND DEPLOYMENT_ID = $6`,
+	}
+	queryMarkDeliveryDead = dbmodel.DBQuery{
+		ID: "WHQ-DLV-06",
+		Query: `UPDATE "WEBHOOK_DELIVERY" SET STATUS = 'DEAD', LAST_STATUS_CODE = $2, LAST_ERROR = $3, ` +
+			`UPDATED_AT = $4, EXPIRY_TIME = $5 WHERE ID = $1 AND DEPLOYMENT_ID = $6`,
+	}
+	queryCountDeadLetters = dbmodel.DBQuery{
+		ID: "WHQ-DLV-07",
+		Query: `SELECT COUNT(*) as total FROM "WEBHOOK_DELIVERY" ` +
+			`WHERE ENDPOINT_ID = $1 AND STATUS = 'DEAD' AND DEPLOYMENT_ID = $2`,
+	}
+	queryListDeadLetters = dbmodel.DBQuery{
+		ID: "WHQ-DLV-08",
+		Query: `SELECT ID, ENDPOINT_ID, EVENT_ID, EVENT_TYPE, PAYLOAD, STATUS, ATTEMPTS, NEXT_ATTEMPT_AT, ` +
+			`LAST_STATUS_CODE, LAST_ERROR, CREATED_AT, UPDATED_AT FROM "WEBHOOK_DELIVERY" ` +
+			`WHERE ENDPOINT_ID = $3 AND STATUS = 'DEAD' AND DEPLOYMENT_ID = $4 ` +
+			`ORDER BY UPDATED_AT DESC, ID DESC LIMIT $1 OFFSET $2`,
+	}
+	// queryRequeueDeadLetter moves a dead-lettered delivery back to the queue with a fresh set of
+	// attempts.
+	queryRequeueDeadLetter = dbmodel.DBQuery{
+		ID: "WHQ-DLV-09",
+		Query: `UPDATE "WEBHOOK_DELIVERY" SET STATUS = 'PENDING', ATTEMPTS = 0, NEXT_ATTEMPT_AT = $3, ` +
+			`UPDATED_AT = $3, EXPIRY_TIME = NULL ` +
+			`WHERE ID = $1 AND ENDPOINT_ID = $2 AND STATUS = 'DEAD' AND DEPLOYMENT_ID = $4`,
+	}
+	queryDeleteEndpointDeliveries = dbmodel.DBQuery{
+		ID:    "WHQ-DLV-10",
+		Query: `DELETE FROM "WEBHOOK_DELIVERY" WHERE ENDPOINT_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,228 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type EndpointStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *endpointStore
+}
+
+func TestEndpointStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(EndpointStoreTestSuite))
+}
+
+func (suite *EndpointStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &endpointStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func (suite *EndpointStoreTestSuite) TestCreateEndpoint_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateEndpoint, "wh-1", "Hook",
+		"https://example.com/hook", `["TOKEN"]`, "HMAC_SHA256", "encrypted", true, testDeploymentID).
+		Return(int64(1), nil)
+
+	err := suite.store.CreateEndpoint(context.Background(), &WebhookEndpoint{
+		ID: "wh-1", Name: "Hook", URL: "https://example.com/hook", Categories: []string{"TOKEN"},
+		SignatureType: SignatureTypeHMAC, Secret: "encrypted", Enabled: true,
+	})
+	suite.NoError(err)
+}
+
+func (suite *EndpointStoreTestSuite) TestCreateEndpoint_DBClientError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("db error"))
+
+	err := suite.store.CreateEndpoint(context.Background(), &WebhookEndpoint{ID: "wh-1"})
+	suite.ErrorContains(err, "failed to get database client")
+}
+
+func (suite *EndpointStoreTestSuite) TestGetEndpoint_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetEndpoint, "wh-1", testDeploymentID).
+		Return([]map[string]interface{}{{
+			"id": "wh-1", "name": "Hook", "url": "https://example.com/hook",
+			"categories": []byte(`["TOKEN","FLOW"]`), "signature_type": "JWS", "secret": "",
+			"enabled": int64(1),
+		}}, nil)
+
+	endpoint, err := suite.store.GetEndpoint(context.Background(), "wh-1")
+	suite.NoError(err)
+	suite.Equal(&WebhookEndpoint{
+		ID: "wh-1", Name: "Hook", URL: "https://example.com/hook", Categories: []string{"TOKEN", "FLOW"},
+		SignatureType: SignatureTypeJWS, Enabled: true,
+	}, endpoint)
+}
+
+func (suite *EndpointStoreTestSuite) TestGetEndpoint_NotFound() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetEndpoint, "wh-1", testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	_, err := suite.store.GetEndpoint(context.Background(), "wh-1")
+	suite.ErrorIs(err, ErrNotFound)
+}
+
+func (suite *EndpointStoreTestSuite) TestListEndpoints_InvalidCategories() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListEndpoints, testDeploymentID).
+		Return([]map[string]interface{}{{"id": "wh-1", "categories": "not-json"}}, nil)
+
+	_, err := suite.store.ListEndpoints(context.Background())
+	suite.ErrorContains(err, "failed to unmarshal webhook categories")
+}
+
+func (suite *EndpointStoreTestSuite) TestDeleteEndpoint_ExecError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteEndpoint, "wh-1", testDeploymentID).
+		Return(int64(0), errors.New("execute error"))
+
+	err := suite.store.DeleteEndpoint(context.Background(), "wh-1")
+	suite.ErrorContains(err, "failed to delete webhook endpoint")
+}
+
+type DeliveryStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *deliveryStore
+	now            time.Time
+}
+
+func TestDeliveryStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryStoreTestSuite))
+}
+
+func (suite *DeliveryStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &deliveryStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+	suite.now = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.On("GetRuntimePersistentDBClient").Return(suite.mockDBClient, nil).Maybe()
+}
+
+func (suite *DeliveryStoreTestSuite) TestInsertDelivery_Success() {
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertDelivery, "dlv-1", "wh-1", "evt-1",
+		"TOKEN_REVOKED", `{"a":1}`, suite.now, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.InsertDelivery(context.Background(), &Delivery{
+		ID: "dlv-1", EndpointID: "wh-1", EventID: "evt-1", EventType: "TOKEN_REVOKED", Payload: []byte(`{"a":1}`),
+	}, suite.now)
+	suite.NoError(err)
+}
+
+func (suite *DeliveryStoreTestSuite) TestListDueDeliveries_Success() {
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListDueDeliveries, "wh-1", suite.now,
+		testDeploymentID, 50).Return([]map[string]interface{}{{
+		"id": "dlv-1", "endpoint_id": "wh-1", "event_id": "evt-1", "event_type": "TOKEN_REVOKED",
+		"payload": `{"a":1}`, "status": "PENDING", "attempts": int64(2), "last_status_code": int64(503),
+		"last_error": "unavailable", "next_attempt_at": suite.now, "created_at": suite.now,
+		"updated_at": suite.now,
+	}}, nil)
+
+	deliveries, err := suite.store.ListDueDeliveries(context.Background(), "wh-1", suite.now, 50)
+	suite.NoError(err)
+	suite.Len(deliveries, 1)
+	suite.Equal("dlv-1", deliveries[0].ID)
+	suite.Equal(DeliveryStatusPending, deliveries[0].Status)
+	suite.Equal(2, deliveries[0].Attempts)
+	suite.Equal(503, deliveries[0].LastStatusCode)
+	suite.Equal([]byte(`{"a":1}`), deliveries[0].Payload)
+	suite.True(suite.now.Equal(deliveries[0].NextAttemptAt))
+}
+
+func (suite *DeliveryStoreTestSuite) TestClaimDelivery() {
+	lease := suite.now.Add(20 * time.Second)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryClaimDelivery, "dlv-1", 2, lease, suite.now,
+		testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryClaimDelivery, "dlv-2", 2, lease, suite.now,
+		testDeploymentID).Return(int64(0), nil).Once()
+
+	claimed, err := suite.store.ClaimDelivery(context.Background(), "dlv-1", 2, lease, suite.now)
+	suite.NoError(err)
+	suite.True(claimed)
+
+	claimed, err = suite.store.ClaimDelivery(context.Background(), "dlv-2", 2, lease, suite.now)
+	suite.NoError(err)
+	suite.False(claimed)
+}
+
+func (suite *DeliveryStoreTestSuite) TestRescheduleDelivery_NoResponse() {
+	next := suite.now.Add(time.Minute)
+	longError := strings.Repeat("x", maxLastErrorLength+10)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryRescheduleDelivery, "dlv-1", next, nil,
+		longError[:maxLastErrorLength], suite.now, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.RescheduleDelivery(context.Background(), "dlv-1", next, 0, longError, suite.now)
+	suite.NoError(err)
+}
+
+func (suite *DeliveryStoreTestSuite) TestMarkDeliveryDead_ExecError() {
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryMarkDeliveryDead, "dlv-1", 500, "boom",
+		suite.now, suite.now.Add(time.Hour), testDeploymentID).Return(int64(0), errors.New("execute error"))
+
+	err := suite.store.MarkDeliveryDead(context.Background(), "dlv-1", 500, "boom", suite.now,
+		suite.now.Add(time.Hour))
+	suite.ErrorContains(err, "failed to dead-letter webhook delivery")
+}
+
+func (suite *DeliveryStoreTestSuite) TestCountDeadLetters_Success() {
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryCountDeadLetters, "wh-1", testDeploymentID).
+		Return([]map[string]interface{}{{"total": int64(3)}}, nil)
+
+	total, err := suite.store.CountDeadLetters(context.Background(), "wh-1")
+	suite.NoError(err)
+	suite.Equal(3, total)
+}
+
+func (suite *DeliveryStoreTestSuite) TestCountDeadLetters_UnexpectedType() {
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryCountDeadLetters, "wh-1", testDeploymentID).
+		Return([]map[string]interface{}{{"total": "3"}}, nil)
+
+	_, err := suite.store.CountDeadLetters(context.Background(), "wh-1")
+	suite.ErrorContains(err, "unexpected type for total")
+}
+
+func (suite *DeliveryStoreTestSuite) TestListDeadLetters_InvalidTime() {
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListDeadLetters, 10, 0, "wh-1", testDeploymentID).
+		Return([]map[string]interface{}{{"id": "dlv-1", "next_attempt_at": 42}}, nil)
+
+	_, err := suite.store.ListDeadLetters(context.Background(), "wh-1", 10, 0)
+	suite.Error(err)
+}
+
+func (suite *DeliveryStoreTestSuite) TestRequeueDeadLetter_NotDead() {
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryRequeueDeadLetter, "dlv-1", "wh-1", suite.now,
+		testDeploymentID).Return(int64(0), nil)
+
+	requeued, err := suite.store.RequeueDeadLetter(context.Background(), "wh-1", "dlv-1", suite.now)
+	suite.NoError(err)
+	suite.False(requeued)
+}
+
+func (suite *DeliveryStoreTestSuite) TestDeleteEndpointDeliveries_DBClientError() {
+	mockDBProvider := providermock.NewDBProviderInterfaceMock(suite.T())
+	mockDBProvider.On("GetRuntimePersistentDBClient").Return(nil, errors.New("db error"))
+	store := &deliveryStore{dbProvider: mockDBProvider, deploymentID: testDeploymentID}
+
+	err := store.DeleteEndpointDeliveries(context.Background(), "wh-1")
+	suite.ErrorContains(err, "failed to get runtime persistent database client")
+}
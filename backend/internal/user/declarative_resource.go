@@ -326,16 +326,10 @@ func parseCredentials(credentialsMap map[string]interface{}) (Credentials, error
 			}
 
 			credential := Credential{
-				StorageType: "hash",
-				StorageAlgo: hashedCred.Algorithm,
-				StorageAlgoParams: cryptolib.CredParameters{
-					Iterations:  hashedCred.Parameters.Iterations,
-					Memory:      hashedCred.Parameters.Memory,
-					Parallelism: hashedCred.Parameters.Parallelism,
-					KeySize:     hashedCred.Parameters.KeySize,
-					Salt:        hashedCred.Parameters.Salt,
-				},
-				Value: hashedCred.Hash,
+				StorageType:       "hash",
+				StorageAlgo:       hashedCred.Algorithm,
+				StorageAlgoParams: hashedCred.Parameters,
+				Value:             hashedCred.Hash,
 			}
 
 			credentials[credentialType] = []Credential{credential}
@@ -410,16 +404,10 @@ func parseCredentialObject(
 		}
 
 		return Credential{
-			StorageType: "hash",
-			StorageAlgo: hashedCred.Algorithm,
-			StorageAlgoParams: cryptolib.CredParameters{
-				Iterations:  hashedCred.Parameters.Iterations,
-				Memory:      hashedCred.Parameters.Memory,
-				Parallelism: hashedCred.Parameters.Parallelism,
-				KeySize:     hashedCred.Parameters.KeySize,
-				Salt:        hashedCred.Parameters.Salt,
-			},
-			Value: hashedCred.Hash,
+			StorageType:       "hash",
+			StorageAlgo:       hashedCred.Algorithm,
+			StorageAlgoParams: hashedCred.Parameters,
+			Value:             hashedCred.Hash,
 		}, nil
 	}
 
@@ -438,6 +426,9 @@ func parseCredentialObject(
 	}
 
 	iterations, _ := paramsMap["iterations"].(int)
+	memory, _ := paramsMap["memory"].(int)
+	parallelism, _ := paramsMap["parallelism"].(int)
+	blockSize, _ := paramsMap["blockSize"].(int)
 	keySize, _ := paramsMap["keySize"].(int)
 	salt, _ := paramsMap["salt"].(string)
 
@@ -445,9 +436,12 @@ func parseCredentialObject(
 		StorageType: storageType,
 		StorageAlgo: cryptolib.CredAlgorithm(storageAlgo),
 		StorageAlgoParams: cryptolib.CredParameters{
-			Iterations: iterations,
-			KeySize:    keySize,
-			Salt:       salt,
+			Iterations:  iterations,
+			Memory:      memory,
+			Parallelism: parallelism,
+			BlockSize:   blockSize,
+			KeySize:     keySize,
+			Salt:        salt,
 		},
 		Value: value,
 	}, nil
@@ -528,6 +528,26 @@ func (uh *userHandler) HandleUserCredentialUpdateRequest(w http.ResponseWriter,
 		log.MaskedString(log.LoggerKeyUserID, id))
 }
 
+// HandleUserUnlockRequest handles lifting the account lockout of a user by an admin.
+func (uh *userHandler) HandleUserUnlockRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		handleError(ctx, w, &ErrorMissingUserID)
+		return
+	}
+
+	if svcErr := uh.userService.UnlockUser(ctx, id); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+	logger.Debug(ctx, "User unlock response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
 // parsePaginationParams parses limit and offset query parameters from the request.
 func parsePaginationParams(query url.Values) (int, int, *tidcommon.ServiceError) {
 	limit := 0
@@ -302,6 +302,43 @@ func TestHandleUserCredentialUpdateRequest_Success(t *testing.T) {
 	require.Equal(t, 0, rr.Body.Len())
 }
 
+func TestHandleUserUnlockRequest_Success(t *testing.T) {
+	userID := testUserID789
+
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("UnlockUser", mock.Anything, userID).Return(nil)
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodPost, "/users/"+userID+"/unlock", nil)
+	req.SetPathValue("id", userID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserUnlockRequest(rr, req)
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+	require.Equal(t, 0, rr.Body.Len())
+}
+
+func TestHandleUserUnlockRequest_UserNotFound(t *testing.T) {
+	userID := testUserID789
+
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("UnlockUser", mock.Anything, userID).Return(&ErrorUserNotFound)
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodPost, "/users/"+userID+"/unlock", nil)
+	req.SetPathValue("id", userID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserUnlockRequest(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	require.Equal(t, ErrorUserNotFound.Code, errResp.Code)
+}
+
 func TestHandleUserCredentialUpdateRequest_MissingUserID(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
 	handler := newUserHandler(mockSvc)
@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/thunder-id/thunderid/internal/authn/lockout"
+	"github.com/thunder-id/thunderid/internal/authn/passwordpolicy"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
@@ -24,9 +26,13 @@ func Initialize(
 	ouService oupkg.OrganizationUnitServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	lockoutService lockout.LockoutServiceInterface,
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface,
+	subResources *SubResourceRouter,
 ) (UserServiceInterface, oupkg.OUUserResolver, declarativeresource.ResourceExporter, error) {
 	// Step 1: Create service with entity service
-	userService := newUserService(authzService, entityService, ouService, entityTypeService)
+	userService := newUserService(authzService, entityService, ouService, entityTypeService, lockoutService,
+		passwordPolicy)
 
 	// Step 2: Load user-specific indexed attributes into the entity store.
 	if err := entityService.LoadIndexedAttributes(getUserIndexedAttributes()); err != nil {
@@ -42,7 +48,7 @@ func Initialize(
 	}
 
 	userHandler := newUserHandler(userService)
-	registerRoutes(mux, userHandler)
+	registerRoutes(mux, userHandler, subResources)
 
 	// Create resolver for OU package to query user data without cross-DB access
 	ouUserResolver := newOUUserResolver(entityService, entityTypeService)
@@ -70,8 +76,9 @@ func getUserIndexedAttributes() []string {
 	return config.GetServerRuntime().Config.User.IndexedAttributes
 }
 
-// registerRoutes registers the routes for user management operations.
-func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
+// registerRoutes registers the routes for user management operations. Requests for user sub-resources
+// the user package does not own are dispatched to subResources.
+func registerRoutes(mux *http.ServeMux, userHandler *userHandler, subResources *SubResourceRouter) {
 	opts1 := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
@@ -102,21 +109,33 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 				userHandler.HandleUserGroupsGetRequest(w, r)
 			} else if len(segments) == 2 && segments[1] == "usages" {
 				userHandler.HandleUserUsagesGetRequest(w, r)
-			} else {
+			} else if !subResources.serve(w, r, segments[1:]) {
 				http.NotFound(w, r)
 			}
 		}, opts2))
 	mux.HandleFunc(middleware.WithCORS("PUT /users/",
 		func(w http.ResponseWriter, r *http.Request) {
 			path := strings.TrimPrefix(r.URL.Path, "/users/")
-			r.SetPathValue("id", strings.Split(path, "/")[0])
-			userHandler.HandleUserPutRequest(w, r)
+			segments := strings.Split(path, "/")
+			r.SetPathValue("id", segments[0])
+
+			if len(segments) == 1 {
+				userHandler.HandleUserPutRequest(w, r)
+			} else if !subResources.serve(w, r, segments[1:]) {
+				http.NotFound(w, r)
+			}
 		}, opts2))
 	mux.HandleFunc(middleware.WithCORS("DELETE /users/",
 		func(w http.ResponseWriter, r *http.Request) {
 			path := strings.TrimPrefix(r.URL.Path, "/users/")
-			r.SetPathValue("id", strings.Split(path, "/")[0])
-			userHandler.HandleUserDeleteRequest(w, r)
+			segments := strings.Split(path, "/")
+			r.SetPathValue("id", segments[0])
+
+			if len(segments) == 1 {
+				userHandler.HandleUserDeleteRequest(w, r)
+			} else if !subResources.serve(w, r, segments[1:]) {
+				http.NotFound(w, r)
+			}
 		}, opts2))
 	mux.HandleFunc(middleware.WithCORS("POST /users/",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -125,6 +144,9 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 			if len(segments) == 2 && segments[1] == "update-credentials" {
 				r.SetPathValue("id", segments[0])
 				userHandler.HandleUserCredentialUpdateRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == "unlock" {
+				r.SetPathValue("id", segments[0])
+				userHandler.HandleUserUnlockRequest(w, r)
 			} else {
 				http.NotFound(w, r)
 			}
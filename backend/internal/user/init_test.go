@@ -26,7 +26,7 @@ func TestRegisterRoutes_SelfUserMetadata(t *testing.T) {
 	mockSvc.On("GetUserMetadata", mock.Anything, testUserID123).Return(expectedSchema, nil)
 
 	handler := newUserHandler(mockSvc)
-	registerRoutes(mux, handler)
+	registerRoutes(mux, handler, nil)
 
 	// Test GET /users/me/meta
 	authCtx := security.NewSecurityContextForTest(testUserID123, "", "", nil, nil)
@@ -46,3 +46,25 @@ func TestRegisterRoutes_SelfUserMetadata(t *testing.T) {
 	mux.ServeHTTP(optionsRr, optionsReq)
 	require.Equal(t, http.StatusNoContent, optionsRr.Code)
 }
+
+func TestRegisterRoutes_SubResourceDispatch(t *testing.T) {
+	mux := http.NewServeMux()
+	subResources := NewSubResourceRouter()
+	var gotUserID, gotItemID string
+	subResources.Handle("DELETE credentials/{itemId}", func(w http.ResponseWriter, r *http.Request) {
+		gotUserID, gotItemID = r.PathValue("id"), r.PathValue("itemId")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	registerRoutes(mux, newUserHandler(NewUserServiceInterfaceMock(t)), subResources)
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/users/user-456/credentials/cred-1", nil))
+	require.Equal(t, http.StatusNoContent, rr.Code)
+	require.Equal(t, "user-456", gotUserID)
+	require.Equal(t, "cred-1", gotItemID)
+
+	// Unregistered sub-resources and methods are not found.
+	rr = httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/users/user-456/credentials/cred-1", nil))
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -16,9 +16,12 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
+	"github.com/thunder-id/thunderid/internal/authn/lockout"
+	"github.com/thunder-id/thunderid/internal/authn/passwordpolicy"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
@@ -47,10 +50,12 @@ type UserServiceInterface interface {
 	GetUserMetadata(ctx context.Context, userID string) (*entitytype.EntityType, *tidcommon.ServiceError)
 	UpdateUserCredentials(ctx context.Context, userID string,
 		credentials json.RawMessage) *tidcommon.ServiceError
+	UnlockUser(ctx context.Context, userID string) *tidcommon.ServiceError
 	DeleteUser(ctx context.Context, userID string) *tidcommon.ServiceError
 	ValidateDeleteUser(ctx context.Context, userID string) *tidcommon.ServiceError
 	ResolveUserOUHandle(ctx context.Context, user *User) *tidcommon.ServiceError
 	SetDependencyRegistry(r resourcedependency.Registry)
+	SetAuditRecorder(recorder *audit.Recorder)
 	GetUserUsages(ctx context.Context, userID string) (
 		*resourcedependency.DependenciesResponse, *tidcommon.ServiceError)
 }
@@ -61,8 +66,11 @@ type userService struct {
 	entityService      entity.EntityServiceInterface
 	ouService          oupkg.OrganizationUnitServiceInterface
 	entityTypeService  entitytype.EntityTypeServiceInterface
+	lockoutService     lockout.LockoutServiceInterface
+	passwordPolicy     passwordpolicy.PasswordPolicyServiceInterface
 	uuidGenerator      func() (string, error)
 	dependencyRegistry resourcedependency.Registry
+	auditRecorder      *audit.Recorder
 }
 
 // newUserService creates a new instance of userService with injected dependencies.
@@ -71,12 +79,16 @@ func newUserService(
 	entityService entity.EntityServiceInterface,
 	ouService oupkg.OrganizationUnitServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
+	lockoutService lockout.LockoutServiceInterface,
+	passwordPolicy passwordpolicy.PasswordPolicyServiceInterface,
 ) UserServiceInterface {
 	return &userService{
 		authzService:      authzService,
 		entityService:     entityService,
 		ouService:         ouService,
 		entityTypeService: entityTypeService,
+		lockoutService:    lockoutService,
+		passwordPolicy:    passwordPolicy,
 		uuidGenerator:     utils.GenerateUUIDv7,
 	}
 }
@@ -334,6 +346,8 @@ func (us *userService) CreateUser(ctx context.Context, user *User) (*User, *tidc
 	// Sync cleaned attributes back — entity service removed credential fields from Attributes.
 	user.Attributes = created.Attributes
 
+	us.auditRecorder.RecordCreate(ctx, audit.ResourceTypeUser, user.ID, user)
+
 	logger.Debug(ctx, "Successfully created user", log.MaskedString(log.LoggerKeyUserID, user.ID))
 	return user, nil
 }
@@ -596,6 +610,7 @@ func (us *userService) UpdateUser(
 
 	// Sync cleaned attributes back — entity service removed credential fields from Attributes.
 	user.Attributes = updated.Attributes
+	us.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeUser, userID, existingUser, user)
 	logger.Debug(ctx, "Successfully updated user", log.MaskedString(log.LoggerKeyUserID, userID))
 	return user, nil
 }
@@ -669,6 +684,7 @@ func (us *userService) UpdateUserAttributes(
 		return nil, svcErr
 	}
 
+	previousUser := existingUser
 	existingUser.Attributes = attributes
 
 	if err := us.entityService.UpdateAttributes(ctx, userID, attributes); err != nil {
@@ -678,11 +694,51 @@ func (us *userService) UpdateUserAttributes(
 		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to update user attributes", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
+	us.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeUser, userID, previousUser, existingUser)
 
 	logger.Debug(ctx, "Successfully updated user attributes", log.MaskedString(log.LoggerKeyUserID, userID))
 	return &existingUser, nil
 }
 
+// UnlockUser lifts the account lockout of a user and clears its failed authentication attempts.
+func (us *userService) UnlockUser(ctx context.Context, userID string) *tidcommon.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug(ctx, "Unlocking user", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	if strings.TrimSpace(userID) == "" {
+		return &ErrorMissingUserID
+	}
+
+	existingEntity, err := us.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			logger.Debug(ctx, "User not found", log.MaskedString(log.LoggerKeyUserID, userID))
+			return &ErrorUserNotFound
+		}
+		return logErrorAndReturnServerError(ctx, logger, "Failed to retrieve user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if existingEntity.Category != providers.EntityCategoryUser {
+		return &ErrorUserNotFound
+	}
+
+	if svcErr := us.checkUserAccess(
+		ctx, security.ActionUpdateUser, existingEntity.OUID, userID); svcErr != nil {
+		return svcErr
+	}
+
+	if us.lockoutService == nil {
+		return nil
+	}
+	if svcErr := us.lockoutService.Unlock(ctx, userID); svcErr != nil {
+		return svcErr
+	}
+	us.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeUser, userID, nil, map[string]bool{"locked": false})
+
+	logger.Debug(ctx, "Successfully unlocked user", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
 // UpdateUserCredentials updates schema-defined credentials for a user.
 func (us *userService) UpdateUserCredentials(
 	ctx context.Context,
@@ -759,6 +815,15 @@ func (us *userService) UpdateUserCredentials(
 		plaintextCreds[credTypeStr] = stringValue
 	}
 
+	// The minimum password age only applies when users change their own password.
+	if us.passwordPolicy != nil {
+		enforceMinAge := security.GetSubject(ctx) == userID
+		if svcErr := us.passwordPolicy.ValidateCredentials(
+			ctx, userID, plaintextCreds, enforceMinAge); svcErr != nil {
+			return svcErr
+		}
+	}
+
 	plaintextJSON, err := json.Marshal(plaintextCreds)
 	if err != nil {
 		return logErrorAndReturnServerError(ctx, logger, "Failed to marshal credentials", err,
@@ -771,6 +836,16 @@ func (us *userService) UpdateUserCredentials(
 		return logErrorAndReturnServerError(ctx, logger, "Failed to update user credentials", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
+	// Only the fact that the credentials changed is recorded; their values are redacted.
+	us.auditRecorder.RecordUpdate(ctx, audit.ResourceTypeUser, userID, nil,
+		map[string]interface{}{"credentials": plaintextCreds})
+
+	if us.passwordPolicy != nil {
+		if svcErr := us.passwordPolicy.RecordCredentialChange(ctx, userID, plaintextCreds); svcErr != nil {
+			logger.Warn(ctx, "Failed to record password change in the password history",
+				log.MaskedString(log.LoggerKeyUserID, userID), log.String("errorCode", svcErr.Code))
+		}
+	}
 
 	logger.Debug(ctx, "Successfully updated user credentials",
 		log.MaskedString(log.LoggerKeyUserID, userID),
@@ -863,6 +938,8 @@ func (us *userService) DeleteUser(ctx context.Context, userID string) *tidcommon
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
 
+	us.auditRecorder.RecordDelete(ctx, audit.ResourceTypeUser, userID, existingUser)
+
 	logger.Debug(ctx, "Successfully deleted user", log.MaskedString(log.LoggerKeyUserID, userID))
 	return nil
 }
@@ -932,6 +1009,12 @@ func (us *userService) SetDependencyRegistry(r resourcedependency.Registry) {
 	us.dependencyRegistry = r
 }
 
+// SetAuditRecorder injects the recorder that user mutations are audited through. Called by
+// servicemanager once the audit service is initialized.
+func (us *userService) SetAuditRecorder(recorder *audit.Recorder) {
+	us.auditRecorder = recorder
+}
+
 // GetUserUsages returns the resources that reference this user, such as agents that list the user
 // as their owner. It is informational — it drives the pre-delete confirmation dialog and does not
 // gate deletion on the server.
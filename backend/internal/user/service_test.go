@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/thunder-id/thunderid/internal/authn/passwordpolicy"
 	entitypkg "github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
@@ -23,6 +24,8 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/lockoutmock"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/passwordpolicymock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
@@ -613,6 +616,116 @@ func TestUserService_UpdateUserCredentials_Succeeds(t *testing.T) {
 	userStoreMock.AssertNumberOfCalls(t, "UpdateCredentials", 1)
 }
 
+func TestUserService_UpdateUserCredentials_PasswordPolicy(t *testing.T) {
+	newEntityMock := func(t *testing.T) *entitymock.EntityServiceInterfaceMock {
+		entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+		entityMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+		entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+			Return(&providers.Entity{Category: providers.EntityCategoryUser, ID: svcTestUserID1}, nil).Once()
+		return entityMock
+	}
+	credentials := map[string]string{"password": "newpassword"}
+
+	t.Run("RecordsChange", func(t *testing.T) {
+		entityMock := newEntityMock(t)
+		entityMock.On("UpdateCredentials", mock.Anything, svcTestUserID1, mock.Anything).Return(nil).Once()
+		policyMock := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(t)
+		policyMock.On("ValidateCredentials", mock.Anything, svcTestUserID1, credentials, false).Return(nil).Once()
+		policyMock.On("RecordCredentialChange", mock.Anything, svcTestUserID1, credentials).Return(nil).Once()
+		service := &userService{
+			entityService:  entityMock,
+			authzService:   newAllowAllAuthz(t),
+			passwordPolicy: policyMock,
+		}
+
+		svcErr := service.UpdateUserCredentials(context.Background(), svcTestUserID1,
+			json.RawMessage(`{"password":"newpassword"}`))
+		require.Nil(t, svcErr)
+	})
+
+	t.Run("RejectsViolation", func(t *testing.T) {
+		entityMock := newEntityMock(t)
+		policyMock := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(t)
+		policyMock.On("ValidateCredentials", mock.Anything, svcTestUserID1, credentials, false).
+			Return(&passwordpolicy.ErrorPasswordBreached).Once()
+		service := &userService{
+			entityService:  entityMock,
+			authzService:   newAllowAllAuthz(t),
+			passwordPolicy: policyMock,
+		}
+
+		svcErr := service.UpdateUserCredentials(context.Background(), svcTestUserID1,
+			json.RawMessage(`{"password":"newpassword"}`))
+		require.NotNil(t, svcErr)
+		require.Equal(t, passwordpolicy.ErrorPasswordBreached.Code, svcErr.Code)
+		entityMock.AssertNotCalled(t, "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserService_UnlockUser_Succeeds(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&providers.Entity{Category: providers.EntityCategoryUser, ID: svcTestUserID1}, nil).Once()
+	lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+	lockoutMock.On("Unlock", mock.Anything, svcTestUserID1).Return(nil).Once()
+
+	service := &userService{
+		entityService:  entityMock,
+		authzService:   newAllowAllAuthz(t),
+		lockoutService: lockoutMock,
+	}
+
+	require.Nil(t, service.UnlockUser(context.Background(), svcTestUserID1))
+}
+
+func TestUserService_UnlockUser_Rejections(t *testing.T) {
+	t.Run("MissingUserID", func(t *testing.T) {
+		service := &userService{}
+		svcErr := service.UnlockUser(context.Background(), " ")
+		require.NotNil(t, svcErr)
+		require.Equal(t, ErrorMissingUserID, *svcErr)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+		entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+			Return((*providers.Entity)(nil), entitypkg.ErrEntityNotFound).Once()
+		service := &userService{entityService: entityMock}
+
+		svcErr := service.UnlockUser(context.Background(), svcTestUserID1)
+		require.NotNil(t, svcErr)
+		require.Equal(t, ErrorUserNotFound, *svcErr)
+	})
+
+	t.Run("NotAUser", func(t *testing.T) {
+		entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+		entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+			Return(&providers.Entity{Category: providers.EntityCategoryApp, ID: svcTestUserID1}, nil).Once()
+		service := &userService{entityService: entityMock}
+
+		svcErr := service.UnlockUser(context.Background(), svcTestUserID1)
+		require.NotNil(t, svcErr)
+		require.Equal(t, ErrorUserNotFound, *svcErr)
+	})
+
+	t.Run("LockoutStoreError", func(t *testing.T) {
+		entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+		entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+			Return(&providers.Entity{Category: providers.EntityCategoryUser, ID: svcTestUserID1}, nil).Once()
+		lockoutMock := lockoutmock.NewLockoutServiceInterfaceMock(t)
+		lockoutMock.On("Unlock", mock.Anything, svcTestUserID1).Return(&tidcommon.InternalServerError).Once()
+		service := &userService{
+			entityService:  entityMock,
+			authzService:   newAllowAllAuthz(t),
+			lockoutService: lockoutMock,
+		}
+
+		svcErr := service.UnlockUser(context.Background(), svcTestUserID1)
+		require.NotNil(t, svcErr)
+		require.Equal(t, tidcommon.InternalServerError.Code, svcErr.Code)
+	})
+}
+
 func TestUserService_UpdateUserCredentials_Rejections(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1852,7 +1965,7 @@ func TestUserService_GetUsersByPath_WithIncludeDisplay_BatchFetchError(t *testin
 }
 
 func TestNewFunctions(t *testing.T) {
-	svc := newUserService(nil, nil, nil, nil)
+	svc := newUserService(nil, nil, nil, nil, nil, nil)
 	require.NotNil(t, svc)
 
 	handler := newUserHandler(svc)
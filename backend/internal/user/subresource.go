@@ -0,0 +1,55 @@
+// Copyright 2026 The ThunderID Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"net/http"
+)
+
+// itemPathValue is the path value that carries the item id of a sub-resource item route.
+const itemPathValue = "itemId"
+
+// SubResourceRouter routes requests for sub-resources of a user that other packages own, such as the
+// user's enrolled credentials and sessions. Such routes cannot be registered on the mux as
+// /users/{id}/... because those patterns conflict with /users/tree/{path...}; the /users/ catch-all
+// handlers dispatch to this router instead. Handlers read the user id from the "id" path value and,
+// for item routes, the item id from "itemId".
+type SubResourceRouter struct {
+	handlers map[string]http.HandlerFunc
+}
+
+// NewSubResourceRouter creates an empty SubResourceRouter.
+func NewSubResourceRouter() *SubResourceRouter {
+	return &SubResourceRouter{handlers: make(map[string]http.HandlerFunc)}
+}
+
+// Handle registers the handler for a pattern of the form "METHOD resource" for the collection, or
+// "METHOD resource/{itemId}" for one of its items, e.g. "DELETE credentials/{itemId}".
+func (sr *SubResourceRouter) Handle(pattern string, handler http.HandlerFunc) {
+	sr.handlers[pattern] = handler
+}
+
+// serve dispatches the request for the path segments following the user id. It reports false when
+// no handler matches, leaving the response unwritten.
+func (sr *SubResourceRouter) serve(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if sr == nil || len(segments) == 0 || len(segments) > 2 || segments[0] == "" {
+		return false
+	}
+
+	pattern := r.Method + " " + segments[0]
+	if len(segments) == 2 {
+		if segments[1] == "" {
+			return false
+		}
+		pattern += "/{" + itemPathValue + "}"
+		r.SetPathValue(itemPathValue, segments[1])
+	}
+
+	handler, ok := sr.handlers[pattern]
+	if !ok {
+		return false
+	}
+	handler(w, r)
+	return true
+}
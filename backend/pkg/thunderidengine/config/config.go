@@ -46,6 +46,10 @@ type SecurityConfig struct {
 	// When set, callers must present this value in the Direct-Auth-Secret header; when empty, those
 	// endpoints are blocked (secure by default).
 	DirectAuthSecret string `yaml:"direct_auth_secret" json:"direct_auth_secret"`
+	// TrustedProxies lists the IP addresses and CIDR ranges of the reverse proxies and load balancers
+	// in front of the server. The client IP address of a request they forward is read from the
+	// X-Forwarded-For header. When empty, the client IP address is the address of the connection.
+	TrustedProxies []string `yaml:"trusted_proxies" json:"trusted_proxies"`
 }
 
 // TokenRevocationConfig configures the Resource Server's token-revocation enforcement: an in-memory
@@ -136,6 +140,7 @@ type GateClientConfig struct {
 	SignOutPath  string `yaml:"signout_path"   json:"signout_path"`
 	ErrorPath    string `yaml:"error_path"    json:"error_path"`
 	CallbackPath string `yaml:"callback_path" json:"callback_path"`
+	DevicePath   string `yaml:"device_path"   json:"device_path"`
 }
 
 // EncryptionConfig holds the encryption configuration details.
@@ -227,6 +232,14 @@ type CIBAConfig struct {
 	IDTokenHintMaxAgeDays int `yaml:"id_token_hint_max_age_days" json:"id_token_hint_max_age_days"`
 }
 
+// DeviceCodeConfig holds the OAuth 2.0 Device Authorization Grant (RFC 8628) configuration.
+type DeviceCodeConfig struct {
+	// ExpiresIn is the lifetime in seconds of a device_code and its user_code.
+	ExpiresIn int64 `yaml:"expires_in" json:"expires_in"`
+	// Interval is the minimum number of seconds a client must wait between token polls.
+	Interval int64 `yaml:"interval"   json:"interval"`
+}
+
 // OAuthConfig holds the OAuth configuration details.
 type OAuthConfig struct {
 	RefreshToken         RefreshTokenConfig         `yaml:"refresh_token"               json:"refresh_token"`
@@ -237,8 +250,10 @@ type OAuthConfig struct {
 	DPoP                 DPoPConfig                 `yaml:"dpop"                        json:"dpop"`
 	AuthClass            AuthClassConfig            `yaml:"auth_class"                  json:"auth_class"`
 	CIBA                 CIBAConfig                 `yaml:"ciba"                        json:"ciba"`
+	DeviceCode           DeviceCodeConfig           `yaml:"device_code"                 json:"device_code"`
 	Revocation           RevocationConfig           `yaml:"revocation"                  json:"revocation"`
 	TokenExchange        TokenExchangeConfig        `yaml:"token_exchange"              json:"token_exchange"`
+	PairwiseSubject      PairwiseSubjectConfig      `yaml:"pairwise_subject"            json:"pairwise_subject"`
 	// AllowWildcardRedirectURI enables wildcard pattern matching for redirect URIs.
 	// When false (default), only exact redirect URI matching is performed.
 	AllowWildcardRedirectURI bool `yaml:"allow_wildcard_redirect_uri" json:"allow_wildcard_redirect_uri"`
@@ -338,6 +353,14 @@ type TokenExchangeConfig struct {
 	TokenFamily string `yaml:"token_family" json:"token_family"`
 }
 
+// PairwiseSubjectConfig holds the settings for pairwise subject identifiers (OIDC Core §8.1).
+type PairwiseSubjectConfig struct {
+	// Salt is the secret mixed into every pairwise sub value so that it cannot be recomputed from the
+	// sector and user id alone. Changing it changes every pairwise sub issued from then on. When unset,
+	// a random salt is generated on first use and stored in the runtime persistent database.
+	Salt string `yaml:"salt" json:"salt"`
+}
+
 // FlowConfig holds the configuration details for the flow service.
 type FlowConfig struct {
 	MaxVersionHistory     int    `yaml:"max_version_history" json:"max_version_history"`
@@ -379,9 +402,11 @@ type ObservabilityConfig struct {
 
 // ObservabilityOutputConfig holds observability output configuration.
 type ObservabilityOutputConfig struct {
-	File          ObservabilityFileConfig    `yaml:"file"          json:"file"`
-	Console       ObservabilityConsoleConfig `yaml:"console"       json:"console"`
-	OpenTelemetry ObservabilityOTelConfig    `yaml:"opentelemetry" json:"opentelemetry"`
+	File          ObservabilityFileConfig          `yaml:"file"           json:"file"`
+	Console       ObservabilityConsoleConfig       `yaml:"console"        json:"console"`
+	OpenTelemetry ObservabilityOTelConfig          `yaml:"opentelemetry"  json:"opentelemetry"`
+	Webhook       ObservabilityWebhookConfig       `yaml:"webhook"        json:"webhook"`
+	FlowAnalytics ObservabilityFlowAnalyticsConfig `yaml:"flow_analytics" json:"flow_analytics"`
 }
 
 // ObservabilityFileConfig captures file sink settings for observability events.
@@ -415,6 +440,34 @@ type ObservabilityOTelConfig struct {
 	Insecure bool `yaml:"insecure"        json:"insecure"`
 }
 
+// ObservabilityWebhookConfig holds the delivery settings of the webhook subscriber. The endpoints
+// themselves are managed through the webhook management API. A failed delivery is retried after
+// InitialBackoffSeconds, doubling on each further failure up to MaxBackoffSeconds, until
+// MaxAttempts is reached; the delivery is then moved to the dead-letter list, where it is kept for
+// DeadLetterRetentionDays. Zero values fall back to the defaults of the webhook service.
+type ObservabilityWebhookConfig struct {
+	Enabled                 bool `yaml:"enabled"                    json:"enabled"`
+	MaxAttempts             int  `yaml:"max_attempts"               json:"max_attempts"`
+	InitialBackoffSeconds   int  `yaml:"initial_backoff_seconds"    json:"initial_backoff_seconds"`
+	MaxBackoffSeconds       int  `yaml:"max_backoff_seconds"        json:"max_backoff_seconds"`
+	TimeoutSeconds          int  `yaml:"timeout_seconds"            json:"timeout_seconds"`
+	PollIntervalSeconds     int  `yaml:"poll_interval_seconds"      json:"poll_interval_seconds"`
+	DeadLetterRetentionDays int  `yaml:"dead_letter_retention_days" json:"dead_letter_retention_days"`
+	// AllowInsecureURLs accepts http endpoint URLs and hosts in private address ranges. Use it
+	// only in development.
+	AllowInsecureURLs bool `yaml:"allow_insecure_urls" json:"allow_insecure_urls"`
+}
+
+// ObservabilityFlowAnalyticsConfig holds the settings of the flow analytics subscriber, which rolls the
+// flow events up into per-version funnel counters in the runtime database. Counters are kept in memory
+// and flushed every FlushIntervalSeconds, and each hourly bucket is kept for RetentionDays. Zero values
+// fall back to the defaults of the flow analytics service.
+type ObservabilityFlowAnalyticsConfig struct {
+	Enabled              bool `yaml:"enabled"                json:"enabled"`
+	FlushIntervalSeconds int  `yaml:"flush_interval_seconds" json:"flush_interval_seconds"`
+	RetentionDays        int  `yaml:"retention_days"         json:"retention_days"`
+}
+
 // LogConfig configures the engine's internal logger.
 type LogConfig struct {
 	// Level is the minimum log level: "debug", "info", "warn", or "error".
@@ -134,6 +134,8 @@ const (
 	// GrantTypeJWTBearer represents the JWT bearer grant type used to present an ID-JAG assertion
 	// (draft-ietf-oauth-identity-assertion-authz-grant) issued by a trusted external IdP.
 	GrantTypeJWTBearer GrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer" //nolint:gosec
+	// GrantTypeDeviceCode represents the OAuth 2.0 Device Authorization Grant (RFC 8628) grant type.
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 )
 
 // DefaultIDJAGValidityPeriod is the default validity period, in seconds, of an issued ID-JAG when the
@@ -150,6 +152,29 @@ const (
 	ResponseTypeIDToken ResponseType = "id_token"
 )
 
+// ResponseMode defines a type for OAuth2 authorization response modes.
+type ResponseMode string
+
+const (
+	// ResponseModeQuery returns the authorization response parameters in the redirect URI query string.
+	ResponseModeQuery ResponseMode = "query"
+	// ResponseModeFragment returns the authorization response parameters in the redirect URI fragment.
+	ResponseModeFragment ResponseMode = "fragment"
+	// ResponseModeFormPost returns the authorization response parameters as an auto-submitted HTML form
+	// (OAuth 2.0 Form Post Response Mode).
+	ResponseModeFormPost ResponseMode = "form_post"
+	// ResponseModeJWT returns a JWT-secured authorization response (JARM) using the default
+	// transport for the response type.
+	ResponseModeJWT ResponseMode = "jwt"
+	// ResponseModeQueryJWT returns a JWT-secured authorization response (JARM) in the query string.
+	ResponseModeQueryJWT ResponseMode = "query.jwt"
+	// ResponseModeFragmentJWT returns a JWT-secured authorization response (JARM) in the fragment.
+	ResponseModeFragmentJWT ResponseMode = "fragment.jwt"
+	// ResponseModeFormPostJWT returns a JWT-secured authorization response (JARM) as an auto-submitted
+	// HTML form.
+	ResponseModeFormPostJWT ResponseMode = "form_post.jwt"
+)
+
 // TokenEndpointAuthMethod defines a type for token endpoint authentication methods.
 type TokenEndpointAuthMethod string
 
@@ -163,6 +188,22 @@ const (
 	TokenEndpointAuthMethodPrivateKeyJWT TokenEndpointAuthMethod = "private_key_jwt"
 	// TokenEndpointAuthMethodNone represents no authentication method.
 	TokenEndpointAuthMethodNone TokenEndpointAuthMethod = "none"
+	// TokenEndpointAuthMethodTLSClientAuth represents PKI mutual-TLS client authentication (RFC 8705 §2.1).
+	TokenEndpointAuthMethodTLSClientAuth TokenEndpointAuthMethod = "tls_client_auth"
+	// TokenEndpointAuthMethodSelfSignedTLSClientAuth represents self-signed certificate mutual-TLS client
+	// authentication (RFC 8705 §2.2).
+	TokenEndpointAuthMethodSelfSignedTLSClientAuth TokenEndpointAuthMethod = "self_signed_tls_client_auth"
+)
+
+// SubjectType defines a type for the OIDC subject identifier types a client can be registered with.
+type SubjectType string
+
+const (
+	// SubjectTypePublic provides the same sub value to all clients (OIDC Core §8).
+	SubjectTypePublic SubjectType = "public"
+	// SubjectTypePairwise provides a different sub value to each sector, so that clients in different
+	// sectors cannot correlate the End-User's activities (OIDC Core §8.1).
+	SubjectTypePairwise SubjectType = "pairwise"
 )
 
 // SupportedGrantTypes lists all the supported grant types.
@@ -173,6 +214,7 @@ var SupportedGrantTypes = []GrantType{
 	GrantTypeTokenExchange,
 	GrantTypeCIBA,
 	GrantTypeJWTBearer,
+	GrantTypeDeviceCode,
 }
 
 // IsValid checks if the GrantType is valid.
@@ -189,6 +231,7 @@ func (gt GrantType) IsValid() bool {
 var refreshTokenIssuingGrantTypes = []GrantType{
 	GrantTypeAuthorizationCode,
 	GrantTypeCIBA,
+	GrantTypeDeviceCode,
 }
 
 // IssuesRefreshToken reports whether this grant type can issue a refresh token.
@@ -218,12 +261,54 @@ func (rt ResponseType) IsValid() bool {
 	return false
 }
 
+// SupportedResponseModes lists all the supported response modes.
+var SupportedResponseModes = []ResponseMode{
+	ResponseModeQuery,
+	ResponseModeFragment,
+	ResponseModeFormPost,
+	ResponseModeJWT,
+	ResponseModeQueryJWT,
+	ResponseModeFragmentJWT,
+	ResponseModeFormPostJWT,
+}
+
+// IsValid checks if the ResponseMode is valid.
+func (rm ResponseMode) IsValid() bool {
+	return slices.Contains(SupportedResponseModes, rm)
+}
+
+// IsJWTSecured reports whether the response mode returns a JWT-secured authorization response (JARM).
+func (rm ResponseMode) IsJWTSecured() bool {
+	switch rm {
+	case ResponseModeJWT, ResponseModeQueryJWT, ResponseModeFragmentJWT, ResponseModeFormPostJWT:
+		return true
+	default:
+		return false
+	}
+}
+
+// Transport returns the plain response mode used to deliver the response to the client. A JWT-secured
+// mode is delivered using its base mode, with the bare jwt mode defaulting to query as the default
+// mode of the code response type. An empty mode resolves to query.
+func (rm ResponseMode) Transport() ResponseMode {
+	switch rm {
+	case ResponseModeFragment, ResponseModeFragmentJWT:
+		return ResponseModeFragment
+	case ResponseModeFormPost, ResponseModeFormPostJWT:
+		return ResponseModeFormPost
+	default:
+		return ResponseModeQuery
+	}
+}
+
 // SupportedTokenEndpointAuthMethods lists all the supported token endpoint authentication methods.
 var SupportedTokenEndpointAuthMethods = []TokenEndpointAuthMethod{
 	TokenEndpointAuthMethodClientSecretBasic,
 	TokenEndpointAuthMethodClientSecretPost,
 	TokenEndpointAuthMethodPrivateKeyJWT,
 	TokenEndpointAuthMethodNone,
+	TokenEndpointAuthMethodTLSClientAuth,
+	TokenEndpointAuthMethodSelfSignedTLSClientAuth,
 }
 
 // IsValid checks if the TokenEndpointAuthMethod is valid.
@@ -236,6 +321,22 @@ func (tam TokenEndpointAuthMethod) IsValid() bool {
 	return false
 }
 
+// IsMutualTLS reports whether the method authenticates the client with its TLS client certificate.
+func (tam TokenEndpointAuthMethod) IsMutualTLS() bool {
+	return tam == TokenEndpointAuthMethodTLSClientAuth || tam == TokenEndpointAuthMethodSelfSignedTLSClientAuth
+}
+
+// SupportedSubjectTypes lists all the supported subject identifier types.
+var SupportedSubjectTypes = []SubjectType{
+	SubjectTypePublic,
+	SubjectTypePairwise,
+}
+
+// IsValid checks if the SubjectType is valid.
+func (st SubjectType) IsValid() bool {
+	return slices.Contains(SupportedSubjectTypes, st)
+}
+
 // EntityCategory represents the category of an entity (e.g., user, application, agent).
 type EntityCategory string
 
@@ -514,18 +615,24 @@ type RuntimeStoreNamespace string
 
 // Namespace constants for the runtime store. All namespaces follow the <category>:<type> format.
 const (
-	NamespaceAttributeCache RuntimeStoreNamespace = "attribute:cache"
-	NamespaceFlow           RuntimeStoreNamespace = "flow:state"
-	NamespaceAuthzCode      RuntimeStoreNamespace = "authz:code"
-	NamespaceAuthzReq       RuntimeStoreNamespace = "authz:req"
-	NamespaceLogoutReq      RuntimeStoreNamespace = "logout:req"
-	NamespacePAR            RuntimeStoreNamespace = "par:req"
-	NamespaceCIBA           RuntimeStoreNamespace = "ciba:req"
-	NamespaceJTI            RuntimeStoreNamespace = "jti:token"
-	NamespaceVCINonce       RuntimeStoreNamespace = "vci:nonce"
-	NamespaceVCIOffer       RuntimeStoreNamespace = "vci:offer"
-	NamespaceVPState        RuntimeStoreNamespace = "vp:state"
-	NamespaceWebAuthn       RuntimeStoreNamespace = "webauthn:session"
+	NamespaceAttributeCache     RuntimeStoreNamespace = "attribute:cache"
+	NamespaceFlow               RuntimeStoreNamespace = "flow:state"
+	NamespaceAuthzCode          RuntimeStoreNamespace = "authz:code"
+	NamespaceAuthzReq           RuntimeStoreNamespace = "authz:req"
+	NamespaceAuthzResp          RuntimeStoreNamespace = "authz:resp"
+	NamespaceLogoutReq          RuntimeStoreNamespace = "logout:req"
+	NamespaceLogoutFrontchannel RuntimeStoreNamespace = "logout:frontchannel"
+	NamespacePAR                RuntimeStoreNamespace = "par:req"
+	NamespaceCIBA               RuntimeStoreNamespace = "ciba:req"
+	NamespaceDeviceCode         RuntimeStoreNamespace = "device:code"
+	NamespaceDeviceUserCode     RuntimeStoreNamespace = "device:user_code"
+	NamespaceJTI                RuntimeStoreNamespace = "jti:token"
+	NamespaceVCINonce           RuntimeStoreNamespace = "vci:nonce"
+	NamespaceVCIOffer           RuntimeStoreNamespace = "vci:offer"
+	NamespaceVPState            RuntimeStoreNamespace = "vp:state"
+	NamespaceWebAuthn           RuntimeStoreNamespace = "webauthn:session"
+	NamespaceTOTPEnrollment     RuntimeStoreNamespace = "totp:enrollment"
+	NamespaceLockout            RuntimeStoreNamespace = "lockout:counter"
 )
 
 // Error constants
@@ -41,6 +41,7 @@ func (suite *ConstantsTestSuite) TestGrantType_IsValid() {
 func (suite *ConstantsTestSuite) TestGrantType_IssuesRefreshToken() {
 	assert.True(suite.T(), GrantTypeAuthorizationCode.IssuesRefreshToken())
 	assert.True(suite.T(), GrantTypeCIBA.IssuesRefreshToken())
+	assert.True(suite.T(), GrantTypeDeviceCode.IssuesRefreshToken())
 	assert.False(suite.T(), GrantTypeClientCredentials.IssuesRefreshToken())
 	assert.False(suite.T(), GrantTypeRefreshToken.IssuesRefreshToken())
 	assert.False(suite.T(), GrantTypeTokenExchange.IssuesRefreshToken())
@@ -61,20 +62,65 @@ func (suite *ConstantsTestSuite) TestResponseType_IsValid() {
 	assert.False(suite.T(), ResponseType("").IsValid())
 }
 
+func (suite *ConstantsTestSuite) TestResponseMode_IsValid() {
+	for _, rm := range SupportedResponseModes {
+		assert.True(suite.T(), rm.IsValid())
+	}
+	assert.False(suite.T(), ResponseMode("web_message").IsValid())
+	assert.False(suite.T(), ResponseMode("").IsValid())
+}
+
+func (suite *ConstantsTestSuite) TestResponseMode_IsJWTSecured() {
+	assert.False(suite.T(), ResponseModeQuery.IsJWTSecured())
+	assert.False(suite.T(), ResponseModeFragment.IsJWTSecured())
+	assert.False(suite.T(), ResponseModeFormPost.IsJWTSecured())
+	assert.True(suite.T(), ResponseModeJWT.IsJWTSecured())
+	assert.True(suite.T(), ResponseModeQueryJWT.IsJWTSecured())
+	assert.True(suite.T(), ResponseModeFragmentJWT.IsJWTSecured())
+	assert.True(suite.T(), ResponseModeFormPostJWT.IsJWTSecured())
+}
+
+func (suite *ConstantsTestSuite) TestResponseMode_Transport() {
+	assert.Equal(suite.T(), ResponseModeQuery, ResponseMode("").Transport())
+	assert.Equal(suite.T(), ResponseModeQuery, ResponseModeQuery.Transport())
+	assert.Equal(suite.T(), ResponseModeQuery, ResponseModeJWT.Transport())
+	assert.Equal(suite.T(), ResponseModeQuery, ResponseModeQueryJWT.Transport())
+	assert.Equal(suite.T(), ResponseModeFragment, ResponseModeFragment.Transport())
+	assert.Equal(suite.T(), ResponseModeFragment, ResponseModeFragmentJWT.Transport())
+	assert.Equal(suite.T(), ResponseModeFormPost, ResponseModeFormPost.Transport())
+	assert.Equal(suite.T(), ResponseModeFormPost, ResponseModeFormPostJWT.Transport())
+}
+
 func (suite *ConstantsTestSuite) TestTokenEndpointAuthMethod_IsValid() {
 	valid := []TokenEndpointAuthMethod{
 		TokenEndpointAuthMethodClientSecretBasic,
 		TokenEndpointAuthMethodClientSecretPost,
 		TokenEndpointAuthMethodPrivateKeyJWT,
+		TokenEndpointAuthMethodTLSClientAuth,
+		TokenEndpointAuthMethodSelfSignedTLSClientAuth,
 		TokenEndpointAuthMethodNone,
 	}
 	for _, m := range valid {
 		assert.True(suite.T(), m.IsValid(), "expected %q to be valid", m)
 	}
-	assert.False(suite.T(), TokenEndpointAuthMethod("tls_client_auth").IsValid())
+	assert.False(suite.T(), TokenEndpointAuthMethod("client_secret_jwt").IsValid())
 	assert.False(suite.T(), TokenEndpointAuthMethod("").IsValid())
 }
 
+func (suite *ConstantsTestSuite) TestTokenEndpointAuthMethod_IsMutualTLS() {
+	assert.True(suite.T(), TokenEndpointAuthMethodTLSClientAuth.IsMutualTLS())
+	assert.True(suite.T(), TokenEndpointAuthMethodSelfSignedTLSClientAuth.IsMutualTLS())
+	assert.False(suite.T(), TokenEndpointAuthMethodPrivateKeyJWT.IsMutualTLS())
+	assert.False(suite.T(), TokenEndpointAuthMethodNone.IsMutualTLS())
+}
+
+func (suite *ConstantsTestSuite) TestSubjectType_IsValid() {
+	assert.True(suite.T(), SubjectTypePublic.IsValid())
+	assert.True(suite.T(), SubjectTypePairwise.IsValid())
+	assert.False(suite.T(), SubjectType("ephemeral").IsValid())
+	assert.False(suite.T(), SubjectType("").IsValid())
+}
+
 func (suite *ConstantsTestSuite) TestEntityCategory_String() {
 	assert.Equal(suite.T(), "user", EntityCategoryUser.String())
 	assert.Equal(suite.T(), "app", EntityCategoryApp.String())
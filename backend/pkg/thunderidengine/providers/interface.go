@@ -121,6 +121,9 @@ type ResourceServerProvider interface {
 	ValidatePermissions(
 		ctx context.Context, resourceServerID string, permissions []string,
 	) ([]string, *common.ServiceError)
+	ValidateAuthorizationDetails(
+		ctx context.Context, resourceServerID string, details []AuthorizationDetail,
+	) *common.ServiceError
 }
 
 // IDPProvider defines the interface for the identity provider provider.
@@ -150,6 +153,10 @@ type ConsentProvider interface {
 		decisions *ConsentDecisions, sessionToken string, validityPeriod int64,
 		runtimeMetadata map[string][]string) (
 		*Consent, *common.ServiceError)
+
+	// GetActiveConsentID returns the ID of the user's active consent record for the given application,
+	// or an empty string when the user holds none.
+	GetActiveConsentID(ctx context.Context, appID, userID string) (string, *common.ServiceError)
 }
 
 // CaptchaValidationProvider defines the contract for verifying captcha tokens.
@@ -201,6 +201,29 @@ type ResourceServer struct {
 	Delimiter   string             `yaml:"delimiter,omitempty"   json:"delimiter,omitempty"   yamlfmt:"quoted"`
 	IsReadOnly  bool               `yaml:"-"                     json:"-"`
 	Resources   []Resource         `yaml:"resources,omitempty"   json:"resources,omitempty"`
+	// AuthorizationDetailsTypes lists the authorization details types (RFC 9396) the resource server
+	// accepts in authorization and token requests.
+	AuthorizationDetailsTypes []AuthorizationDetailsType `yaml:"authorizationDetailsTypes,omitempty" json:"authorizationDetailsTypes,omitempty"`
+}
+
+// AuthorizationDetailsType declares an authorization details type (RFC 9396) accepted by a resource
+// server. Schema, when set, is a JSON Schema that each authorization details object of the type must
+// conform to.
+type AuthorizationDetailsType struct {
+	Type        string                 `yaml:"type"                  json:"type"`
+	Description string                 `yaml:"description,omitempty" json:"description,omitempty"`
+	Schema      map[string]interface{} `yaml:"schema,omitempty"      json:"schema,omitempty"`
+}
+
+// AuthorizationDetail is a single authorization details object (RFC 9396). The type member
+// identifies the kind of authorization; the other members are defined by the type.
+type AuthorizationDetail map[string]interface{}
+
+// Type returns the type member of the authorization details object, or an empty string when it is
+// missing or not a string.
+func (d AuthorizationDetail) Type() string {
+	t, _ := d["type"].(string)
+	return t
 }
 
 // CompleteFlowDefinition represents a complete flow definition with all details.
@@ -247,7 +270,7 @@ type NodePosition struct {
 // NodeDefinition represents a single node in a flow definition.
 type NodeDefinition struct {
 	ID           string                   `json:"id"                     yaml:"id"                     jsonschema:"Unique node identifier within the flow. Example: 'start', 'username-password', 'end'"`
-	Type         string                   `json:"type"                   yaml:"type"                   jsonschema:"Node type: 'START' (entry point), 'END' (exit point), 'TASK_EXECUTION' (backend logic), 'PROMPT' (user input), or 'CALL' (invoke another flow)"`
+	Type         string                   `json:"type"                   yaml:"type"                   jsonschema:"Node type: 'START' (entry point), 'END' (exit point), 'TASK_EXECUTION' (backend logic), 'PROMPT' (user input), 'CALL' (invoke another flow), or 'DECISION' (branch on expressions)"`
 	Layout       *NodeLayout              `json:"layout,omitempty"       yaml:"layout,omitempty"       jsonschema:"Optional UI layout information for flow composer (position and size on canvas)"`
 	Meta         interface{}              `json:"meta,omitempty"         yaml:"meta,omitempty"         jsonschema:"Optional metadata. For PROMPT nodes, must include 'components' array for UI rendering. See existing flows for examples."`
 	Prompts      []PromptDefinition       `json:"prompts,omitempty"      yaml:"prompts,omitempty"      jsonschema:"For PROMPT nodes: defines user inputs and actions. Each prompt has inputs (form fields) and an action (what happens on submit)."`
@@ -261,6 +284,21 @@ type NodeDefinition struct {
 	OnIncomplete string                   `json:"onIncomplete,omitempty" yaml:"onIncomplete,omitempty" jsonschema:"For TASK_EXECUTION nodes: ID of the PROMPT node to forward to when user input is required."`
 	Condition    *ConditionDefinition     `json:"condition,omitempty"    yaml:"condition,omitempty"    jsonschema:"Optional condition to determine if this node should execute"`
 	Flow         *FlowReferenceDefinition `json:"flow,omitempty"       yaml:"flow,omitempty"         jsonschema:"For CALL nodes: identifies the target flow to invoke by its ID."`
+	Decision     *DecisionDefinition      `json:"decision,omitempty"     yaml:"decision,omitempty"     jsonschema:"For DECISION nodes: the labeled branches to evaluate in order and the default node."`
+}
+
+// DecisionDefinition configures the branches of a DECISION node. Branches are evaluated in order
+// and the first whose expression is true is taken; when none is, the flow continues at Default.
+type DecisionDefinition struct {
+	Branches []DecisionBranchDefinition `json:"branches" yaml:"branches" jsonschema:"Ordered branches. The first branch whose expression evaluates to true is taken."`
+	Default  string                     `json:"default"  yaml:"default"  jsonschema:"ID of the node to continue at when no branch matches."`
+}
+
+// DecisionBranchDefinition is a single labeled branch of a DECISION node.
+type DecisionBranchDefinition struct {
+	Label      string `json:"label"      yaml:"label"      jsonschema:"Unique label of the branch. Example: 'high-risk'"`
+	Expression string `json:"expression" yaml:"expression" jsonschema:"Boolean expression over inputs, runtime, claims, request and app. Example: 'double(runtime.captchaScore) < 0.5'"`
+	Next       string `json:"next"       yaml:"next"       jsonschema:"ID of the node to continue at when the expression is true."`
 }
 
 // FlowReferenceDefinition identifies the target flow for a CALL node.
@@ -538,26 +576,38 @@ func (a *AuthUser) UnmarshalJSON(b []byte) error {
 
 // OAuthClient is the resolved runtime view.
 type OAuthClient struct {
-	ID                                 string                  `yaml:"id,omitempty"`
-	OUID                               string                  `yaml:"ouId,omitempty"`
-	ClientID                           string                  `yaml:"clientId,omitempty"`
-	RedirectURIs                       []string                `yaml:"redirectUris,omitempty"`
-	PostLogoutRedirectURIs             []string                `yaml:"postLogoutRedirectUris,omitempty"`
-	GrantTypes                         []GrantType             `yaml:"grantTypes,omitempty"`
-	ResponseTypes                      []ResponseType          `yaml:"responseTypes,omitempty"`
-	TokenEndpointAuthMethod            TokenEndpointAuthMethod `yaml:"tokenEndpointAuthMethod,omitempty"`
-	PKCERequired                       bool                    `yaml:"pkceRequired,omitempty"`
-	PublicClient                       bool                    `yaml:"publicClient,omitempty"`
-	RequirePushedAuthorizationRequests bool                    `yaml:"requirePushedAuthorizationRequests,omitempty"`
-	DPoPBoundAccessTokens              bool                    `yaml:"dpopBoundAccessTokens,omitempty"`
-	IncludeActClaim                    bool                    `yaml:"includeActClaim,omitempty"`
-	EntityCategory                     EntityCategory          `yaml:"entityCategory,omitempty"`
-	Token                              *OAuthTokenConfig       `yaml:"token,omitempty"`
-	Scopes                             []string                `yaml:"scopes,omitempty"`
-	UserInfo                           *UserInfoConfig         `yaml:"userInfo,omitempty"`
-	ScopeClaims                        map[string][]string     `yaml:"scopeClaims,omitempty"`
-	Certificate                        *Certificate            `yaml:"certificate,omitempty"`
-	AcrValues                          []string                `yaml:"acrValues,omitempty"`
+	ID                                 string                       `yaml:"id,omitempty"`
+	OUID                               string                       `yaml:"ouId,omitempty"`
+	ClientID                           string                       `yaml:"clientId,omitempty"`
+	RedirectURIs                       []string                     `yaml:"redirectUris,omitempty"`
+	PostLogoutRedirectURIs             []string                     `yaml:"postLogoutRedirectUris,omitempty"`
+	GrantTypes                         []GrantType                  `yaml:"grantTypes,omitempty"`
+	ResponseTypes                      []ResponseType               `yaml:"responseTypes,omitempty"`
+	ResponseModes                      []ResponseMode               `yaml:"responseModes,omitempty"`
+	TokenEndpointAuthMethod            TokenEndpointAuthMethod      `yaml:"tokenEndpointAuthMethod,omitempty"`
+	PKCERequired                       bool                         `yaml:"pkceRequired,omitempty"`
+	PublicClient                       bool                         `yaml:"publicClient,omitempty"`
+	RequirePushedAuthorizationRequests bool                         `yaml:"requirePushedAuthorizationRequests,omitempty"`
+	RequireSignedRequestObject         bool                         `yaml:"requireSignedRequestObject,omitempty"`
+	RequestURIs                        []string                     `yaml:"requestUris,omitempty"`
+	BackchannelLogoutURI               string                       `yaml:"backchannelLogoutUri,omitempty"`
+	BackchannelLogoutSessionRequired   bool                         `yaml:"backchannelLogoutSessionRequired,omitempty"`
+	FrontchannelLogoutURI              string                       `yaml:"frontchannelLogoutUri,omitempty"`
+	FrontchannelLogoutSessionRequired  bool                         `yaml:"frontchannelLogoutSessionRequired,omitempty"`
+	DPoPBoundAccessTokens              bool                         `yaml:"dpopBoundAccessTokens,omitempty"`
+	TLSClientAuth                      *TLSClientAuthConfig         `yaml:"tlsClientAuth,omitempty"`
+	MTLSBoundAccessTokens              bool                         `yaml:"mtlsBoundAccessTokens,omitempty"`
+	SubjectType                        SubjectType                  `yaml:"subjectType,omitempty"`
+	SectorIdentifierURI                string                       `yaml:"sectorIdentifierUri,omitempty"`
+	IncludeActClaim                    bool                         `yaml:"includeActClaim,omitempty"`
+	EntityCategory                     EntityCategory               `yaml:"entityCategory,omitempty"`
+	Token                              *OAuthTokenConfig            `yaml:"token,omitempty"`
+	Scopes                             []string                     `yaml:"scopes,omitempty"`
+	UserInfo                           *UserInfoConfig              `yaml:"userInfo,omitempty"`
+	AuthorizationResponse              *AuthorizationResponseConfig `yaml:"authorizationResponse,omitempty"`
+	ScopeClaims                        map[string][]string          `yaml:"scopeClaims,omitempty"`
+	Certificate                        *Certificate                 `yaml:"certificate,omitempty"`
+	AcrValues                          []string                     `yaml:"acrValues,omitempty"`
 }
 
 // OAuthTokenConfig wraps access and ID token configs.
@@ -624,12 +674,30 @@ type UserInfoConfig struct {
 	EncryptionEnc  string               `json:"encryptionEnc,omitempty"  yaml:"encryptionEnc,omitempty"  jsonschema:"JWE content-encryption algorithm (e.g. A256GCM). Required when encryptionAlg is set."`
 }
 
+// AuthorizationResponseConfig is the JWT-secured authorization response (JARM) configuration.
+type AuthorizationResponseConfig struct {
+	SigningAlg    string `json:"signingAlg,omitempty"    yaml:"signingAlg,omitempty"    jsonschema:"JWS algorithm for signed authorization responses (e.g. RS256). Defaults to the server signing algorithm."`
+	EncryptionAlg string `json:"encryptionAlg,omitempty" yaml:"encryptionAlg,omitempty" jsonschema:"JWE key-management algorithm for encrypted authorization responses (e.g. RSA-OAEP-256)."`
+	EncryptionEnc string `json:"encryptionEnc,omitempty" yaml:"encryptionEnc,omitempty" jsonschema:"JWE content-encryption algorithm (e.g. A256GCM). Required when encryptionAlg is set."`
+}
+
 // Certificate is a user-supplied certificate input.
 type Certificate struct {
 	Type  CertificateType `json:"type,omitempty"  yaml:"type,omitempty"  jsonschema:"Certificate type (PEM, JWK, etc.)."`
 	Value string          `json:"value,omitempty" yaml:"value,omitempty" jsonschema:"Certificate value in the format specified by type."`
 }
 
+// TLSClientAuthConfig identifies the certificate a client using the tls_client_auth authentication method
+// must present (RFC 8705 §2.1.2). Exactly one field is set; the certificate must carry a matching subject
+// distinguished name or subject alternative name and chain to a trusted certificate authority.
+type TLSClientAuthConfig struct {
+	SubjectDN string `json:"subjectDn,omitempty" yaml:"subjectDn,omitempty" jsonschema:"Expected subject distinguished name in RFC 4514 string form, e.g. CN=client,O=Example."`
+	SANDNS    string `json:"sanDns,omitempty"    yaml:"sanDns,omitempty"    jsonschema:"Expected dNSName subject alternative name."`
+	SANURI    string `json:"sanUri,omitempty"    yaml:"sanUri,omitempty"    jsonschema:"Expected uniformResourceIdentifier subject alternative name."`
+	SANIP     string `json:"sanIp,omitempty"     yaml:"sanIp,omitempty"     jsonschema:"Expected iPAddress subject alternative name."`
+	SANEmail  string `json:"sanEmail,omitempty"  yaml:"sanEmail,omitempty"  jsonschema:"Expected rfc822Name subject alternative name."`
+}
+
 // AttestationConfig holds per-application platform attestation settings used to verify the binary
 // identity of a mobile client when it initiates a flow directly over HTTP.
 type AttestationConfig struct {
@@ -673,22 +741,34 @@ func (c *AttestationConfig) WithoutCredentials() *AttestationConfig {
 
 // OAuthProfile is the persistence shape (OAUTH_PROFILE JSONB column).
 type OAuthProfile struct {
-	RedirectURIs                       []string            `json:"redirectUris"`
-	PostLogoutRedirectURIs             []string            `json:"postLogoutRedirectUris,omitempty"`
-	GrantTypes                         []string            `json:"grantTypes"`
-	ResponseTypes                      []string            `json:"responseTypes"`
-	TokenEndpointAuthMethod            string              `json:"tokenEndpointAuthMethod"`
-	PKCERequired                       bool                `json:"pkceRequired"`
-	PublicClient                       bool                `json:"publicClient"`
-	RequirePushedAuthorizationRequests bool                `json:"requirePushedAuthorizationRequests"`
-	DPoPBoundAccessTokens              bool                `json:"dpopBoundAccessTokens"`
-	IncludeActClaim                    bool                `json:"includeActClaim"`
-	Token                              *OAuthTokenConfig   `json:"token,omitempty"`
-	Scopes                             []string            `json:"scopes,omitempty"`
-	UserInfo                           *UserInfoConfig     `json:"userInfo,omitempty"`
-	ScopeClaims                        map[string][]string `json:"scopeClaims,omitempty"`
-	Certificate                        *Certificate        `json:"certificate,omitempty"`
-	AcrValues                          []string            `json:"acrValues,omitempty"`
+	RedirectURIs                       []string                     `json:"redirectUris"`
+	PostLogoutRedirectURIs             []string                     `json:"postLogoutRedirectUris,omitempty"`
+	GrantTypes                         []string                     `json:"grantTypes"`
+	ResponseTypes                      []string                     `json:"responseTypes"`
+	ResponseModes                      []string                     `json:"responseModes,omitempty"`
+	TokenEndpointAuthMethod            string                       `json:"tokenEndpointAuthMethod"`
+	PKCERequired                       bool                         `json:"pkceRequired"`
+	PublicClient                       bool                         `json:"publicClient"`
+	RequirePushedAuthorizationRequests bool                         `json:"requirePushedAuthorizationRequests"`
+	RequireSignedRequestObject         bool                         `json:"requireSignedRequestObject"`
+	RequestURIs                        []string                     `json:"requestUris,omitempty"`
+	BackchannelLogoutURI               string                       `json:"backchannelLogoutUri,omitempty"`
+	BackchannelLogoutSessionRequired   bool                         `json:"backchannelLogoutSessionRequired"`
+	FrontchannelLogoutURI              string                       `json:"frontchannelLogoutUri,omitempty"`
+	FrontchannelLogoutSessionRequired  bool                         `json:"frontchannelLogoutSessionRequired"`
+	DPoPBoundAccessTokens              bool                         `json:"dpopBoundAccessTokens"`
+	TLSClientAuth                      *TLSClientAuthConfig         `json:"tlsClientAuth,omitempty"`
+	MTLSBoundAccessTokens              bool                         `json:"mtlsBoundAccessTokens"`
+	SubjectType                        string                       `json:"subjectType,omitempty"`
+	SectorIdentifierURI                string                       `json:"sectorIdentifierUri,omitempty"`
+	IncludeActClaim                    bool                         `json:"includeActClaim"`
+	Token                              *OAuthTokenConfig            `json:"token,omitempty"`
+	Scopes                             []string                     `json:"scopes,omitempty"`
+	UserInfo                           *UserInfoConfig              `json:"userInfo,omitempty"`
+	AuthorizationResponse              *AuthorizationResponseConfig `json:"authorizationResponse,omitempty"`
+	ScopeClaims                        map[string][]string          `json:"scopeClaims,omitempty"`
+	Certificate                        *Certificate                 `json:"certificate,omitempty"`
+	AcrValues                          []string                     `json:"acrValues,omitempty"`
 }
 
 // InboundClient is the persistence shape for protocol-agnostic inbound client record.
@@ -1042,24 +1122,36 @@ type InboundAuthProfile struct {
 // OAuthConfigWithSecret is the wire input shape and the create/update echo response shape.
 // Carries ClientSecret (omitempty) so it appears only when freshly issued.
 type OAuthConfigWithSecret struct {
-	ClientID                           string                  `json:"clientId,omitempty"                 yaml:"clientId,omitempty"                 jsonschema:"OAuth client ID (auto-generated if not provided)"`
-	ClientSecret                       string                  `json:"clientSecret,omitempty"             yaml:"clientSecret,omitempty"             jsonschema:"OAuth client secret (auto-generated if not provided)"`
-	RedirectURIs                       []string                `json:"redirectUris,omitempty"             yaml:"redirectUris,omitempty"             jsonschema:"Allowed redirect URIs. Required for Public (SPA/Mobile) and Confidential (Server) clients. Omit for M2M."`
-	PostLogoutRedirectURIs             []string                `json:"postLogoutRedirectUris,omitempty"   yaml:"postLogoutRedirectUris,omitempty"   jsonschema:"Allowed post-logout redirect URIs. Optional. A post_logout_redirect_uri supplied to the logout endpoint must match one of these."`
-	GrantTypes                         []GrantType             `json:"grantTypes,omitempty"               yaml:"grantTypes,omitempty"               jsonschema:"OAuth grant types. Common: [authorization_code, refresh_token] for user apps, [client_credentials] for M2M."`
-	ResponseTypes                      []ResponseType          `json:"responseTypes,omitempty"            yaml:"responseTypes,omitempty"            jsonschema:"OAuth response types. Common: [code] for user apps. Omit for M2M."`
-	TokenEndpointAuthMethod            TokenEndpointAuthMethod `json:"tokenEndpointAuthMethod,omitempty"  yaml:"tokenEndpointAuthMethod,omitempty"  jsonschema:"Client authentication method. Use 'none' for Public clients, 'client_secret_basic' for Confidential/M2M."`
-	PKCERequired                       bool                    `json:"pkceRequired"                       yaml:"pkceRequired"                       jsonschema:"Require PKCE for security. Recommended for all user-interactive flows."`
-	PublicClient                       bool                    `json:"publicClient"                       yaml:"publicClient"                       jsonschema:"Identify if client is public (cannot store secrets). Set true for SPA/Mobile."`
-	RequirePushedAuthorizationRequests bool                    `json:"requirePushedAuthorizationRequests" yaml:"requirePushedAuthorizationRequests" jsonschema:"Require Pushed Authorization Requests (PAR) per RFC 9126."`
-	DPoPBoundAccessTokens              bool                    `json:"dpopBoundAccessTokens"              yaml:"dpopBoundAccessTokens"              jsonschema:"Require DPoP-bound access tokens (RFC 9449)."`
-	IncludeActClaim                    bool                    `json:"includeActClaim"                    yaml:"includeActClaim"                    jsonschema:"Include an implicit on-behalf-of 'act' claim (identifying the application entity) in access tokens issued through this client's authorization code flow. Agents always include it regardless of this setting."`
-	Token                              *OAuthTokenConfig       `json:"token,omitempty"                    yaml:"token,omitempty"                    jsonschema:"Token configuration for access tokens and ID tokens"`
-	Scopes                             []string                `json:"scopes,omitempty"                   yaml:"scopes,omitempty"                   jsonschema:"Allowed OAuth scopes. Add custom scopes as needed for your application."`
-	UserInfo                           *UserInfoConfig         `json:"userInfo,omitempty"                 yaml:"userInfo,omitempty"                 jsonschema:"UserInfo endpoint configuration. Configure user attributes returned from the OIDC userinfo endpoint."`
-	ScopeClaims                        map[string][]string     `json:"scopeClaims,omitempty"              yaml:"scopeClaims,omitempty"              jsonschema:"Scope-to-claims mapping. Maps OAuth scopes to user claims for both ID token and userinfo."`
-	Certificate                        *Certificate            `json:"certificate,omitempty"              yaml:"certificate,omitempty"              jsonschema:"Application certificate. Optional. For certificate-based authentication or JWT validation."`
-	AcrValues                          []string                `json:"acrValues,omitempty"                yaml:"acrValues,omitempty"                jsonschema:"Default ACR values applied when the request does not specify acr_values."`
+	ClientID                           string                       `json:"clientId,omitempty"                 yaml:"clientId,omitempty"                 jsonschema:"OAuth client ID (auto-generated if not provided)"`
+	ClientSecret                       string                       `json:"clientSecret,omitempty"             yaml:"clientSecret,omitempty"             jsonschema:"OAuth client secret (auto-generated if not provided)"`
+	RedirectURIs                       []string                     `json:"redirectUris,omitempty"             yaml:"redirectUris,omitempty"             jsonschema:"Allowed redirect URIs. Required for Public (SPA/Mobile) and Confidential (Server) clients. Omit for M2M."`
+	PostLogoutRedirectURIs             []string                     `json:"postLogoutRedirectUris,omitempty"   yaml:"postLogoutRedirectUris,omitempty"   jsonschema:"Allowed post-logout redirect URIs. Optional. A post_logout_redirect_uri supplied to the logout endpoint must match one of these."`
+	GrantTypes                         []GrantType                  `json:"grantTypes,omitempty"               yaml:"grantTypes,omitempty"               jsonschema:"OAuth grant types. Common: [authorization_code, refresh_token] for user apps, [client_credentials] for M2M."`
+	ResponseTypes                      []ResponseType               `json:"responseTypes,omitempty"            yaml:"responseTypes,omitempty"            jsonschema:"OAuth response types. Common: [code] for user apps. Omit for M2M."`
+	ResponseModes                      []ResponseMode               `json:"responseModes,omitempty"            yaml:"responseModes,omitempty"            jsonschema:"Allowed authorization response modes (query, fragment, form_post, jwt, query.jwt, fragment.jwt, form_post.jwt). Omit to allow all supported modes."`
+	TokenEndpointAuthMethod            TokenEndpointAuthMethod      `json:"tokenEndpointAuthMethod,omitempty"  yaml:"tokenEndpointAuthMethod,omitempty"  jsonschema:"Client authentication method. Use 'none' for Public clients, 'client_secret_basic' for Confidential/M2M."`
+	PKCERequired                       bool                         `json:"pkceRequired"                       yaml:"pkceRequired"                       jsonschema:"Require PKCE for security. Recommended for all user-interactive flows."`
+	PublicClient                       bool                         `json:"publicClient"                       yaml:"publicClient"                       jsonschema:"Identify if client is public (cannot store secrets). Set true for SPA/Mobile."`
+	RequirePushedAuthorizationRequests bool                         `json:"requirePushedAuthorizationRequests" yaml:"requirePushedAuthorizationRequests" jsonschema:"Require Pushed Authorization Requests (PAR) per RFC 9126."`
+	RequireSignedRequestObject         bool                         `json:"requireSignedRequestObject"         yaml:"requireSignedRequestObject"         jsonschema:"Require signed request objects (JAR) per RFC 9101. Authorization requests must carry the parameters in a request object signed with a key from the application certificate."`
+	RequestURIs                        []string                     `json:"requestUris,omitempty"              yaml:"requestUris,omitempty"              jsonschema:"Registered request_uri values (RFC 9101). Optional. A request object is only fetched from a request_uri listed here. Each must be an https URI."`
+	BackchannelLogoutURI               string                       `json:"backchannelLogoutUri,omitempty"     yaml:"backchannelLogoutUri,omitempty"     jsonschema:"OIDC back-channel logout URI. When set, a signed logout token is POSTed to this URI whenever a session the application participates in ends."`
+	BackchannelLogoutSessionRequired   bool                         `json:"backchannelLogoutSessionRequired"   yaml:"backchannelLogoutSessionRequired"   jsonschema:"Require the sid claim in back-channel logout tokens. The sid claim is always included."`
+	FrontchannelLogoutURI              string                       `json:"frontchannelLogoutUri,omitempty"    yaml:"frontchannelLogoutUri,omitempty"    jsonschema:"OIDC front-channel logout URI. When set, this URI is rendered in an iframe on the logout page whenever a session the application participates in ends."`
+	FrontchannelLogoutSessionRequired  bool                         `json:"frontchannelLogoutSessionRequired"  yaml:"frontchannelLogoutSessionRequired"  jsonschema:"Append the iss and sid query parameters to the front-channel logout URI."`
+	DPoPBoundAccessTokens              bool                         `json:"dpopBoundAccessTokens"              yaml:"dpopBoundAccessTokens"              jsonschema:"Require DPoP-bound access tokens (RFC 9449)."`
+	TLSClientAuth                      *TLSClientAuthConfig         `json:"tlsClientAuth,omitempty"            yaml:"tlsClientAuth,omitempty"            jsonschema:"Expected client certificate subject for the tls_client_auth authentication method (RFC 8705). Set exactly one field."`
+	MTLSBoundAccessTokens              bool                         `json:"mtlsBoundAccessTokens"              yaml:"mtlsBoundAccessTokens"              jsonschema:"Require access tokens bound to the client's TLS certificate (RFC 8705). The token endpoint must be called over mutual TLS."`
+	SubjectType                        SubjectType                  `json:"subjectType,omitempty"              yaml:"subjectType,omitempty"              jsonschema:"OIDC subject identifier type (public or pairwise). Pairwise gives this application its own sub value for each user so it cannot be correlated with other applications. Defaults to public."`
+	SectorIdentifierURI                string                       `json:"sectorIdentifierUri,omitempty"      yaml:"sectorIdentifierUri,omitempty"      jsonschema:"Sector identifier URI for pairwise subject identifiers. Its host is the sector that pairwise sub values are computed for. Required for pairwise applications whose redirect URIs span more than one host."`
+	IncludeActClaim                    bool                         `json:"includeActClaim"                    yaml:"includeActClaim"                    jsonschema:"Include an implicit on-behalf-of 'act' claim (identifying the application entity) in access tokens issued through this client's authorization code flow. Agents always include it regardless of this setting."`
+	Token                              *OAuthTokenConfig            `json:"token,omitempty"                    yaml:"token,omitempty"                    jsonschema:"Token configuration for access tokens and ID tokens"`
+	Scopes                             []string                     `json:"scopes,omitempty"                   yaml:"scopes,omitempty"                   jsonschema:"Allowed OAuth scopes. Add custom scopes as needed for your application."`
+	UserInfo                           *UserInfoConfig              `json:"userInfo,omitempty"                 yaml:"userInfo,omitempty"                 jsonschema:"UserInfo endpoint configuration. Configure user attributes returned from the OIDC userinfo endpoint."`
+	AuthorizationResponse              *AuthorizationResponseConfig `json:"authorizationResponse,omitempty" yaml:"authorizationResponse,omitempty" jsonschema:"JWT-secured authorization response (JARM) configuration. Configure signing and encryption of authorization responses."`
+	ScopeClaims                        map[string][]string          `json:"scopeClaims,omitempty"              yaml:"scopeClaims,omitempty"              jsonschema:"Scope-to-claims mapping. Maps OAuth scopes to user claims for both ID token and userinfo."`
+	Certificate                        *Certificate                 `json:"certificate,omitempty"              yaml:"certificate,omitempty"              jsonschema:"Application certificate. Optional. For certificate-based authentication or JWT validation."`
+	AcrValues                          []string                     `json:"acrValues,omitempty"                yaml:"acrValues,omitempty"                jsonschema:"Default ACR values applied when the request does not specify acr_values."`
 }
 
 // InboundAuthConfigWithSecret is the wire input wrapper and create/update echo response wrapper.
@@ -1198,6 +1290,13 @@ type AccessEvaluationResourceServer struct {
 	Properties map[string]interface{} `json:"properties,omitempty"`
 }
 
+// AccessEvaluationResource identifies the individual object an access evaluation is about, such as a
+// document or project. It is optional; engines that reason about specific objects use it.
+type AccessEvaluationResource struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
 // Permission identifies the permission string being evaluated.
 type Permission struct {
 	Name       string                 `json:"name"`
@@ -1208,6 +1307,7 @@ type Permission struct {
 type AccessEvaluationRequest struct {
 	Subject        Subject                        `json:"subject"`
 	ResourceServer AccessEvaluationResourceServer `json:"resourceServer"`
+	Resource       *AccessEvaluationResource      `json:"resource,omitempty"`
 	Permission     Permission                     `json:"permission"`
 	Context        map[string]interface{}         `json:"context,omitempty"`
 }
@@ -1319,6 +1419,16 @@ func (e *Event) Validate() error {
 type CaptchaVerificationResult struct {
 	// Success reports whether the provider accepted the token as valid.
 	Success bool
+	// Score is the risk score reported by score-based providers, from 0.0 (likely a bot) to 1.0
+	// (likely a human). Nil when the provider does not score tokens.
+	Score *float64
+	// Action is the action name the token was issued for, when reported by the provider.
+	Action string
+	// Hostname is the hostname of the site the token was issued on, when reported by the provider.
+	Hostname string
+	// StepUpRequired reports whether the token was accepted with a score low enough to warrant
+	// additional verification.
+	StepUpRequired bool
 }
 
 // CustomAuthnProvider pairs a provider instance with the credential keys it handles.
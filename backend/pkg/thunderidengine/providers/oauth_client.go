@@ -31,6 +31,19 @@ func IsAllowedResponseType(responseTypes []ResponseType, responseType string) bo
 	return slices.Contains(responseTypes, ResponseType(responseType))
 }
 
+// IsAllowedResponseMode reports whether the given response mode is allowed by the allowed list. An
+// empty mode always resolves to the default mode and is allowed. An empty allowed list permits every
+// supported response mode.
+func IsAllowedResponseMode(responseModes []ResponseMode, responseMode string) bool {
+	if responseMode == "" {
+		return true
+	}
+	if !ResponseMode(responseMode).IsValid() {
+		return false
+	}
+	return len(responseModes) == 0 || slices.Contains(responseModes, ResponseMode(responseMode))
+}
+
 // IsAllowedGrantType reports whether the given grant type is allowed for this client.
 func (o *OAuthClient) IsAllowedGrantType(grantType GrantType) bool {
 	return IsAllowedGrantType(o.GrantTypes, grantType)
@@ -41,6 +54,11 @@ func (o *OAuthClient) IsAllowedResponseType(responseType string) bool {
 	return IsAllowedResponseType(o.ResponseTypes, responseType)
 }
 
+// IsAllowedResponseMode reports whether the given response mode is allowed for this client.
+func (o *OAuthClient) IsAllowedResponseMode(responseMode string) bool {
+	return IsAllowedResponseMode(o.ResponseModes, responseMode)
+}
+
 // IsAllowedTokenEndpointAuthMethod reports whether the given auth method is the one configured for this client.
 func (o *OAuthClient) IsAllowedTokenEndpointAuthMethod(method TokenEndpointAuthMethod) bool {
 	return o.TokenEndpointAuthMethod == method
@@ -103,6 +121,46 @@ func (o *OAuthClient) ResolveDefaultAudience(clientID string) string {
 	return clientID
 }
 
+// IsPairwise reports whether this client receives pairwise subject identifiers.
+func (o *OAuthClient) IsPairwise() bool {
+	return o != nil && o.SubjectType == SubjectTypePairwise
+}
+
+// SectorIdentifier returns the sector this client's pairwise subject identifiers are computed for.
+func (o *OAuthClient) SectorIdentifier() (string, error) {
+	return SectorIdentifier(o.SectorIdentifierURI, o.RedirectURIs)
+}
+
+// SectorIdentifier resolves the sector for pairwise subject identifiers (OIDC Core §8.1): the host of the
+// sector identifier URI when one is registered, otherwise the single host shared by every redirect URI.
+// It returns an error when neither yields exactly one concrete host.
+func SectorIdentifier(sectorIdentifierURI string, redirectURIs []string) (string, error) {
+	if sectorIdentifierURI != "" {
+		parsedURI, err := url.Parse(sectorIdentifierURI)
+		if err != nil || parsedURI.Hostname() == "" {
+			return "", fmt.Errorf("invalid sector identifier URI")
+		}
+		return strings.ToLower(parsedURI.Hostname()), nil
+	}
+
+	sector := ""
+	for _, redirectURI := range redirectURIs {
+		parsedURI, err := url.Parse(redirectURI)
+		if err != nil || parsedURI.Hostname() == "" || strings.Contains(parsedURI.Hostname(), "*") {
+			return "", fmt.Errorf("redirect URI %q does not identify a sector", redirectURI)
+		}
+		host := strings.ToLower(parsedURI.Hostname())
+		if sector != "" && host != sector {
+			return "", fmt.Errorf("redirect URIs span more than one host; a sector identifier URI is required")
+		}
+		sector = host
+	}
+	if sector == "" {
+		return "", fmt.Errorf("a sector identifier URI or redirect URI is required")
+	}
+	return sector, nil
+}
+
 // ValidateRedirectURI validates the provided redirect URI against the registered list.
 func ValidateRedirectURI(ctx context.Context, redirectURIs []string, redirectURI string) error {
 	logger := log.GetLogger()
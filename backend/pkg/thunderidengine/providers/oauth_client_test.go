@@ -60,6 +60,21 @@ func (suite *OAuthClientTestSuite) TestIsAllowedResponseType() {
 	assert.False(suite.T(), IsAllowedResponseType(responseTypes, ""))
 }
 
+// ----- IsAllowedResponseMode (package-level) -----
+
+func (suite *OAuthClientTestSuite) TestIsAllowedResponseMode() {
+	responseModes := []ResponseMode{ResponseModeFormPost, ResponseModeFormPostJWT}
+	assert.True(suite.T(), IsAllowedResponseMode(responseModes, string(ResponseModeFormPost)))
+	assert.True(suite.T(), IsAllowedResponseMode(responseModes, ""))
+	assert.False(suite.T(), IsAllowedResponseMode(responseModes, string(ResponseModeQuery)))
+	assert.False(suite.T(), IsAllowedResponseMode(responseModes, "web_message"))
+}
+
+func (suite *OAuthClientTestSuite) TestIsAllowedResponseMode_EmptyListAllowsSupportedModes() {
+	assert.True(suite.T(), IsAllowedResponseMode(nil, string(ResponseModeFragmentJWT)))
+	assert.False(suite.T(), IsAllowedResponseMode(nil, "web_message"))
+}
+
 // ----- OAuthClient methods -----
 
 func (suite *OAuthClientTestSuite) TestOAuthClient_IsAllowedGrantType() {
@@ -76,6 +91,12 @@ func (suite *OAuthClientTestSuite) TestOAuthClient_IsAllowedResponseType() {
 	assert.False(suite.T(), client.IsAllowedResponseType(string(ResponseTypeIDToken)))
 }
 
+func (suite *OAuthClientTestSuite) TestOAuthClient_IsAllowedResponseMode() {
+	client := &OAuthClient{ResponseModes: []ResponseMode{ResponseModeQueryJWT}}
+	assert.True(suite.T(), client.IsAllowedResponseMode(string(ResponseModeQueryJWT)))
+	assert.False(suite.T(), client.IsAllowedResponseMode(string(ResponseModeFormPost)))
+}
+
 func (suite *OAuthClientTestSuite) TestOAuthClient_IsAllowedTokenEndpointAuthMethod() {
 	client := &OAuthClient{TokenEndpointAuthMethod: TokenEndpointAuthMethodClientSecretBasic}
 	assert.True(suite.T(), client.IsAllowedTokenEndpointAuthMethod(TokenEndpointAuthMethodClientSecretBasic))
@@ -94,6 +115,43 @@ func (suite *OAuthClientTestSuite) TestOAuthClient_RequiresPKCE() {
 	})
 }
 
+func (suite *OAuthClientTestSuite) TestOAuthClient_IsPairwise() {
+	assert.True(suite.T(), (&OAuthClient{SubjectType: SubjectTypePairwise}).IsPairwise())
+	assert.False(suite.T(), (&OAuthClient{SubjectType: SubjectTypePublic}).IsPairwise())
+	assert.False(suite.T(), (&OAuthClient{}).IsPairwise())
+	assert.False(suite.T(), (*OAuthClient)(nil).IsPairwise())
+}
+
+func (suite *OAuthClientTestSuite) TestSectorIdentifier() {
+	testCases := []struct {
+		name                string
+		sectorIdentifierURI string
+		redirectURIs        []string
+		expected            string
+		wantErr             bool
+	}{
+		{"SectorIdentifierURI", "https://Sector.example.com/ids.json",
+			[]string{"https://a.example.com/cb", "https://b.example.com/cb"}, "sector.example.com", false},
+		{"SingleRedirectHost", "", []string{"https://app.example.com/cb", "https://app.example.com:8443/cb2"},
+			"app.example.com", false},
+		{"MultipleRedirectHosts", "", []string{"https://a.example.com/cb", "https://b.example.com/cb"}, "", true},
+		{"WildcardRedirectHost", "", []string{"https://*.example.com/cb"}, "", true},
+		{"NoRedirectURIs", "", nil, "", true},
+		{"InvalidSectorIdentifierURI", "not-a-uri", nil, "", true},
+	}
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			sector, err := SectorIdentifier(tc.sectorIdentifierURI, tc.redirectURIs)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, sector)
+		})
+	}
+}
+
 func (suite *OAuthClientTestSuite) TestOAuthClient_ShouldAppendActorClaim() {
 	suite.T().Run("agent always appends act claim", func(t *testing.T) {
 		assert.True(t, (&OAuthClient{EntityCategory: EntityCategoryAgent}).ShouldAppendActorClaim())
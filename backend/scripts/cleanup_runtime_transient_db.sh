@@ -20,6 +20,10 @@ set -euo pipefail
 #   ./cleanup_runtime_transient_db.sh -type postgres -host localhost -port 5432 \
 #       -name thunderidruntime -username thunderid -password secret
 #
+#   # MySQL / MariaDB
+#   ./cleanup_runtime_transient_db.sh -type mysql -host localhost -port 3306 \
+#       -name thunderidruntime -username thunderid -password secret
+#
 #   # With options
 #   ./cleanup_runtime_transient_db.sh -type sqlite -path /path/to/runtime_transient.db \
 #       -batch_size 500 -grace_period 120 -deployment_id my-deployment
@@ -66,13 +70,13 @@ print_help() {
   echo "  $0 [OPTIONS]"
   echo ""
   echo "Options:"
-  printf "  %-18s %s\n" "-type"          "Type of the database: postgres or sqlite [required]"
-  printf "  %-18s %s\n" "-host"          "Database host (required for postgres)"
-  printf "  %-18s %s\n" "-port"          "Database port (required for postgres)"
-  printf "  %-18s %s\n" "-name"          "Database name (required for postgres)"
+  printf "  %-18s %s\n" "-type"          "Type of the database: postgres, mysql or sqlite [required]"
+  printf "  %-18s %s\n" "-host"          "Database host (required for postgres and mysql)"
+  printf "  %-18s %s\n" "-port"          "Database port (required for postgres and mysql)"
+  printf "  %-18s %s\n" "-name"          "Database name (required for postgres and mysql)"
   printf "  %-18s %s\n" "-path"          "Path to the SQLite database file (required for sqlite)"
-  printf "  %-18s %s\n" "-username"      "Database username (required for postgres)"
-  printf "  %-18s %s\n" "-password"      "Database password (required for postgres)"
+  printf "  %-18s %s\n" "-username"      "Database username (required for postgres and mysql)"
+  printf "  %-18s %s\n" "-password"      "Database password (required for postgres and mysql)"
   printf "  %-18s %s\n" "-batch_size"    "Rows to delete per batch iteration (default: 1000)"
   printf "  %-18s %s\n" "-grace_period"  "Seconds buffer before now for expiry cutoff (default: 60)"
   printf "  %-18s %s\n" "-deployment_id" "Scope cleanup to a specific DEPLOYMENT_ID (optional)"
@@ -125,6 +129,13 @@ validate_inputs() {
       echo "Use -h or --help for usage information."
       exit 1
     fi
+  elif [ "$TYPE" = "mysql" ]; then
+    if [ -z "$HOST" ] || [ -z "$PORT" ] || [ -z "$NAME" ] || [ -z "$USERNAME" ] || [ -z "$PASSWORD" ]; then
+      echo "Error: MySQL connection details are required."
+      echo "Please provide -host, -port, -name, -username, and -password."
+      echo "Use -h or --help for usage information."
+      exit 1
+    fi
   elif [ "$TYPE" = "sqlite" ]; then
     if [ -z "$DB_PATH" ]; then
       echo "Error: SQLite database path is required. Please provide it using -path."
@@ -277,6 +288,68 @@ postgres_cleanup_table() {
   done
 }
 
+# -----------------------------------------------------------------------------
+# MySQL cleanup functions
+# -----------------------------------------------------------------------------
+
+# Helper to run a mysql command and return the output.
+# Stderr is intentionally not suppressed so DB errors surface to the caller.
+# The password is passed through the environment to keep it off the process list.
+# Arguments: $1 = SQL query
+mysql_exec() {
+  MYSQL_PWD="$PASSWORD" mysql -h "$HOST" -P "$PORT" -u "$USERNAME" -D "$NAME" \
+    --batch --skip-column-names -e "SET SESSION sql_mode = 'ANSI_QUOTES'; $1"
+}
+
+# Get the count of expired rows for a table in MySQL.
+# Arguments: $1 = table name
+mysql_count_expired() {
+  local table="$1"
+  local deployment_filter=""
+  local safe_deployment_id=""
+  if [ -n "$DEPLOYMENT_ID" ]; then
+    safe_deployment_id="${DEPLOYMENT_ID//\\/\\\\}"
+    safe_deployment_id="${safe_deployment_id//\'/\'\'}"
+    deployment_filter="AND DEPLOYMENT_ID = '${safe_deployment_id}'"
+  fi
+
+  local count
+  if ! count=$(mysql_exec "SELECT COUNT(*) FROM \"${table}\" WHERE EXPIRY_TIME < UTC_TIMESTAMP(6) - INTERVAL ${GRACE_PERIOD} SECOND ${deployment_filter};"); then
+    echo "Error: mysql COUNT query failed for table ${table}" >&2
+    exit 1
+  fi
+  echo "$count"
+}
+
+# Delete expired rows from a table in MySQL using batch deletes.
+# Arguments: $1 = table name
+# Returns: total rows deleted via TOTAL_TABLE_DELETED variable.
+mysql_cleanup_table() {
+  local table="$1"
+  local deployment_filter=""
+  local safe_deployment_id=""
+  if [ -n "$DEPLOYMENT_ID" ]; then
+    safe_deployment_id="${DEPLOYMENT_ID//\\/\\\\}"
+    safe_deployment_id="${safe_deployment_id//\'/\'\'}"
+    deployment_filter="AND DEPLOYMENT_ID = '${safe_deployment_id}'"
+  fi
+
+  TOTAL_TABLE_DELETED=0
+  while true; do
+    local deleted
+    if ! deleted=$(mysql_exec \
+      "DELETE FROM \"${table}\" WHERE EXPIRY_TIME < UTC_TIMESTAMP(6) - INTERVAL ${GRACE_PERIOD} SECOND ${deployment_filter} ORDER BY EXPIRY_TIME LIMIT ${BATCH_SIZE}; SELECT ROW_COUNT();"); then
+      echo "Error: mysql DELETE query failed for table ${table}" >&2
+      exit 1
+    fi
+
+    if [ -z "$deleted" ] || [ "$deleted" -eq 0 ]; then
+      break
+    fi
+    TOTAL_TABLE_DELETED=$((TOTAL_TABLE_DELETED + deleted))
+  done
+}
+
 # -----------------------------------------------------------------------------
 # Cleanup orchestration
 # -----------------------------------------------------------------------------
@@ -292,6 +365,8 @@ cleanup_table() {
     local count
     if [ "$TYPE" = "sqlite" ]; then
       count=$(sqlite_count_expired "$table")
+    elif [ "$TYPE" = "mysql" ]; then
+      count=$(mysql_count_expired "$table")
     else
       count=$(postgres_count_expired "$table")
     fi
@@ -301,6 +376,8 @@ cleanup_table() {
 
   if [ "$TYPE" = "sqlite" ]; then
     sqlite_cleanup_table "$table"
+  elif [ "$TYPE" = "mysql" ]; then
+    mysql_cleanup_table "$table"
   else
     postgres_cleanup_table "$table"
   fi
@@ -40,6 +40,71 @@ func (_m *PasskeyServiceInterfaceMock) EXPECT() *PasskeyServiceInterfaceMock_Exp
 	return &PasskeyServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// DeleteCredential provides a mock function for the type PasskeyServiceInterfaceMock
+func (_mock *PasskeyServiceInterfaceMock) DeleteCredential(ctx context.Context, userID string, credentialID string) *common0.ServiceError {
+	ret := _mock.Called(ctx, userID, credentialID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCredential")
+	}
+
+	var r0 *common0.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *common0.ServiceError); ok {
+		r0 = returnFunc(ctx, userID, credentialID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common0.ServiceError)
+		}
+	}
+	return r0
+}
+
+// PasskeyServiceInterfaceMock_DeleteCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCredential'
+type PasskeyServiceInterfaceMock_DeleteCredential_Call struct {
+	*mock.Call
+}
+
+// DeleteCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - credentialID string
+func (_e *PasskeyServiceInterfaceMock_Expecter) DeleteCredential(ctx interface{}, userID interface{}, credentialID interface{}) *PasskeyServiceInterfaceMock_DeleteCredential_Call {
+	return &PasskeyServiceInterfaceMock_DeleteCredential_Call{Call: _e.mock.On("DeleteCredential", ctx, userID, credentialID)}
+}
+
+func (_c *PasskeyServiceInterfaceMock_DeleteCredential_Call) Run(run func(ctx context.Context, userID string, credentialID string)) *PasskeyServiceInterfaceMock_DeleteCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *PasskeyServiceInterfaceMock_DeleteCredential_Call) Return(serviceError *common0.ServiceError) *PasskeyServiceInterfaceMock_DeleteCredential_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *PasskeyServiceInterfaceMock_DeleteCredential_Call) RunAndReturn(run func(ctx context.Context, userID string, credentialID string) *common0.ServiceError) *PasskeyServiceInterfaceMock_DeleteCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FinishAuthentication provides a mock function for the type PasskeyServiceInterfaceMock
 func (_mock *PasskeyServiceInterfaceMock) FinishAuthentication(ctx context.Context, req *passkey.PasskeyAuthenticationFinishRequest) (*common.AuthnResult, *common0.ServiceError) {
 	ret := _mock.Called(ctx, req)
@@ -180,6 +245,147 @@ func (_c *PasskeyServiceInterfaceMock_FinishRegistration_Call) RunAndReturn(run
 	return _c
 }
 
+// ListCredentials provides a mock function for the type PasskeyServiceInterfaceMock
+func (_mock *PasskeyServiceInterfaceMock) ListCredentials(ctx context.Context, userID string) ([]passkey.PasskeyCredential, *common0.ServiceError) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCredentials")
+	}
+
+	var r0 []passkey.PasskeyCredential
+	var r1 *common0.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]passkey.PasskeyCredential, *common0.ServiceError)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []passkey.PasskeyCredential); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]passkey.PasskeyCredential)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common0.ServiceError); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common0.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// PasskeyServiceInterfaceMock_ListCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCredentials'
+type PasskeyServiceInterfaceMock_ListCredentials_Call struct {
+	*mock.Call
+}
+
+// ListCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *PasskeyServiceInterfaceMock_Expecter) ListCredentials(ctx interface{}, userID interface{}) *PasskeyServiceInterfaceMock_ListCredentials_Call {
+	return &PasskeyServiceInterfaceMock_ListCredentials_Call{Call: _e.mock.On("ListCredentials", ctx, userID)}
+}
+
+func (_c *PasskeyServiceInterfaceMock_ListCredentials_Call) Run(run func(ctx context.Context, userID string)) *PasskeyServiceInterfaceMock_ListCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PasskeyServiceInterfaceMock_ListCredentials_Call) Return(passkeyCredentials []passkey.PasskeyCredential, serviceError *common0.ServiceError) *PasskeyServiceInterfaceMock_ListCredentials_Call {
+	_c.Call.Return(passkeyCredentials, serviceError)
+	return _c
+}
+
+func (_c *PasskeyServiceInterfaceMock_ListCredentials_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]passkey.PasskeyCredential, *common0.ServiceError)) *PasskeyServiceInterfaceMock_ListCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameCredential provides a mock function for the type PasskeyServiceInterfaceMock
+func (_mock *PasskeyServiceInterfaceMock) RenameCredential(ctx context.Context, userID string, credentialID string, name string) *common0.ServiceError {
+	ret := _mock.Called(ctx, userID, credentialID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameCredential")
+	}
+
+	var r0 *common0.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *common0.ServiceError); ok {
+		r0 = returnFunc(ctx, userID, credentialID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common0.ServiceError)
+		}
+	}
+	return r0
+}
+
+// PasskeyServiceInterfaceMock_RenameCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCredential'
+type PasskeyServiceInterfaceMock_RenameCredential_Call struct {
+	*mock.Call
+}
+
+// RenameCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - credentialID string
+//   - name string
+func (_e *PasskeyServiceInterfaceMock_Expecter) RenameCredential(ctx interface{}, userID interface{}, credentialID interface{}, name interface{}) *PasskeyServiceInterfaceMock_RenameCredential_Call {
+	return &PasskeyServiceInterfaceMock_RenameCredential_Call{Call: _e.mock.On("RenameCredential", ctx, userID, credentialID, name)}
+}
+
+func (_c *PasskeyServiceInterfaceMock_RenameCredential_Call) Run(run func(ctx context.Context, userID string, credentialID string, name string)) *PasskeyServiceInterfaceMock_RenameCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *PasskeyServiceInterfaceMock_RenameCredential_Call) Return(serviceError *common0.ServiceError) *PasskeyServiceInterfaceMock_RenameCredential_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *PasskeyServiceInterfaceMock_RenameCredential_Call) RunAndReturn(run func(ctx context.Context, userID string, credentialID string, name string) *common0.ServiceError) *PasskeyServiceInterfaceMock_RenameCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartAuthentication provides a mock function for the type PasskeyServiceInterfaceMock
 func (_mock *PasskeyServiceInterfaceMock) StartAuthentication(ctx context.Context, req *passkey.PasskeyAuthenticationStartRequest) (*passkey.PasskeyAuthenticationStartData, *common0.ServiceError) {
 	ret := _mock.Called(ctx, req)
@@ -39,6 +39,80 @@ func (_m *ConsentProviderMock) EXPECT() *ConsentProviderMock_Expecter {
 	return &ConsentProviderMock_Expecter{mock: &_m.Mock}
 }
 
+// GetActiveConsentID provides a mock function for the type ConsentProviderMock
+func (_mock *ConsentProviderMock) GetActiveConsentID(ctx context.Context, appID string, userID string) (string, *common.ServiceError) {
+	ret := _mock.Called(ctx, appID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveConsentID")
+	}
+
+	var r0 string
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, *common.ServiceError)); ok {
+		return returnFunc(ctx, appID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, appID, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, appID, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ConsentProviderMock_GetActiveConsentID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveConsentID'
+type ConsentProviderMock_GetActiveConsentID_Call struct {
+	*mock.Call
+}
+
+// GetActiveConsentID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - userID string
+func (_e *ConsentProviderMock_Expecter) GetActiveConsentID(ctx interface{}, appID interface{}, userID interface{}) *ConsentProviderMock_GetActiveConsentID_Call {
+	return &ConsentProviderMock_GetActiveConsentID_Call{Call: _e.mock.On("GetActiveConsentID", ctx, appID, userID)}
+}
+
+func (_c *ConsentProviderMock_GetActiveConsentID_Call) Run(run func(ctx context.Context, appID string, userID string)) *ConsentProviderMock_GetActiveConsentID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ConsentProviderMock_GetActiveConsentID_Call) Return(s string, serviceError *common.ServiceError) *ConsentProviderMock_GetActiveConsentID_Call {
+	_c.Call.Return(s, serviceError)
+	return _c
+}
+
+func (_c *ConsentProviderMock_GetActiveConsentID_Call) RunAndReturn(run func(ctx context.Context, appID string, userID string) (string, *common.ServiceError)) *ConsentProviderMock_GetActiveConsentID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecordConsent provides a mock function for the type ConsentProviderMock
 func (_mock *ConsentProviderMock) RecordConsent(ctx context.Context, ouID string, appID string, userID string, decisions *providers.ConsentDecisions, sessionToken string, validityPeriod int64, runtimeMetadata map[string][]string) (*providers.Consent, *common.ServiceError) {
 	ret := _mock.Called(ctx, ouID, appID, userID, decisions, sessionToken, validityPeriod, runtimeMetadata)
@@ -96,6 +96,57 @@ func (_c *HashServiceInterfaceMock_Generate_Call) RunAndReturn(run func(credenti
 	return _c
 }
 
+// NeedsRehash provides a mock function for the type HashServiceInterfaceMock
+func (_mock *HashServiceInterfaceMock) NeedsRehash(referenceCredential cryptolib.Credential) bool {
+	ret := _mock.Called(referenceCredential)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NeedsRehash")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(cryptolib.Credential) bool); ok {
+		r0 = returnFunc(referenceCredential)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// HashServiceInterfaceMock_NeedsRehash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NeedsRehash'
+type HashServiceInterfaceMock_NeedsRehash_Call struct {
+	*mock.Call
+}
+
+// NeedsRehash is a helper method to define mock.On call
+//   - referenceCredential cryptolib.Credential
+func (_e *HashServiceInterfaceMock_Expecter) NeedsRehash(referenceCredential interface{}) *HashServiceInterfaceMock_NeedsRehash_Call {
+	return &HashServiceInterfaceMock_NeedsRehash_Call{Call: _e.mock.On("NeedsRehash", referenceCredential)}
+}
+
+func (_c *HashServiceInterfaceMock_NeedsRehash_Call) Run(run func(referenceCredential cryptolib.Credential)) *HashServiceInterfaceMock_NeedsRehash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 cryptolib.Credential
+		if args[0] != nil {
+			arg0 = args[0].(cryptolib.Credential)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *HashServiceInterfaceMock_NeedsRehash_Call) Return(b bool) *HashServiceInterfaceMock_NeedsRehash_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *HashServiceInterfaceMock_NeedsRehash_Call) RunAndReturn(run func(referenceCredential cryptolib.Credential) bool) *HashServiceInterfaceMock_NeedsRehash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Verify provides a mock function for the type HashServiceInterfaceMock
 func (_mock *HashServiceInterfaceMock) Verify(credentialValueToVerify []byte, referenceCredential cryptolib.Credential) (bool, error) {
 	ret := _mock.Called(credentialValueToVerify, referenceCredential)
@@ -568,6 +568,65 @@ func (_c *EntityProviderInterfaceMock_IdentifyEntity_Call) RunAndReturn(run func
 	return _c
 }
 
+// RehashCredentials provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) RehashCredentials(entityID string, credentials map[string]interface{}) *entityprovider.EntityProviderError {
+	ret := _mock.Called(entityID, credentials)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RehashCredentials")
+	}
+
+	var r0 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(string, map[string]interface{}) *entityprovider.EntityProviderError); ok {
+		r0 = returnFunc(entityID, credentials)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0
+}
+
+// EntityProviderInterfaceMock_RehashCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RehashCredentials'
+type EntityProviderInterfaceMock_RehashCredentials_Call struct {
+	*mock.Call
+}
+
+// RehashCredentials is a helper method to define mock.On call
+//   - entityID string
+//   - credentials map[string]interface{}
+func (_e *EntityProviderInterfaceMock_Expecter) RehashCredentials(entityID interface{}, credentials interface{}) *EntityProviderInterfaceMock_RehashCredentials_Call {
+	return &EntityProviderInterfaceMock_RehashCredentials_Call{Call: _e.mock.On("RehashCredentials", entityID, credentials)}
+}
+
+func (_c *EntityProviderInterfaceMock_RehashCredentials_Call) Run(run func(entityID string, credentials map[string]interface{})) *EntityProviderInterfaceMock_RehashCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 map[string]interface{}
+		if args[1] != nil {
+			arg1 = args[1].(map[string]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_RehashCredentials_Call) Return(entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_RehashCredentials_Call {
+	_c.Call.Return(entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_RehashCredentials_Call) RunAndReturn(run func(entityID string, credentials map[string]interface{}) *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_RehashCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchEntities provides a mock function for the type EntityProviderInterfaceMock
 func (_mock *EntityProviderInterfaceMock) SearchEntities(filters map[string]interface{}) ([]*providers.Entity, *entityprovider.EntityProviderError) {
 	ret := _mock.Called(filters)
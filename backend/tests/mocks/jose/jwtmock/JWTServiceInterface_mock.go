@@ -143,6 +143,76 @@ func (_c *JWTServiceInterfaceMock_GenerateJWT_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// GetJWKS provides a mock function for the type JWTServiceInterfaceMock
+func (_mock *JWTServiceInterfaceMock) GetJWKS(ctx context.Context, jwksURL string) ([]map[string]interface{}, *common.ServiceError) {
+	ret := _mock.Called(ctx, jwksURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJWKS")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]map[string]interface{}, *common.ServiceError)); ok {
+		return returnFunc(ctx, jwksURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []map[string]interface{}); ok {
+		r0 = returnFunc(ctx, jwksURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, jwksURL)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// JWTServiceInterfaceMock_GetJWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJWKS'
+type JWTServiceInterfaceMock_GetJWKS_Call struct {
+	*mock.Call
+}
+
+// GetJWKS is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jwksURL string
+func (_e *JWTServiceInterfaceMock_Expecter) GetJWKS(ctx interface{}, jwksURL interface{}) *JWTServiceInterfaceMock_GetJWKS_Call {
+	return &JWTServiceInterfaceMock_GetJWKS_Call{Call: _e.mock.On("GetJWKS", ctx, jwksURL)}
+}
+
+func (_c *JWTServiceInterfaceMock_GetJWKS_Call) Run(run func(ctx context.Context, jwksURL string)) *JWTServiceInterfaceMock_GetJWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *JWTServiceInterfaceMock_GetJWKS_Call) Return(sToIfaceVals []map[string]interface{}, serviceError *common.ServiceError) *JWTServiceInterfaceMock_GetJWKS_Call {
+	_c.Call.Return(sToIfaceVals, serviceError)
+	return _c
+}
+
+func (_c *JWTServiceInterfaceMock_GetJWKS_Call) RunAndReturn(run func(ctx context.Context, jwksURL string) ([]map[string]interface{}, *common.ServiceError)) *JWTServiceInterfaceMock_GetJWKS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyJWT provides a mock function for the type JWTServiceInterfaceMock
 func (_mock *JWTServiceInterfaceMock) VerifyJWT(ctx context.Context, jwtToken string, expectedAud string, expectedIss string) *common.ServiceError {
 	ret := _mock.Called(ctx, jwtToken, expectedAud, expectedIss)
@@ -179,6 +179,71 @@ func (_c *ResourceServerProviderMock_GetResourceServerByIdentifier_Call) RunAndR
 	return _c
 }
 
+// ValidateAuthorizationDetails provides a mock function for the type ResourceServerProviderMock
+func (_mock *ResourceServerProviderMock) ValidateAuthorizationDetails(ctx context.Context, resourceServerID string, details []providers.AuthorizationDetail) *common.ServiceError {
+	ret := _mock.Called(ctx, resourceServerID, details)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateAuthorizationDetails")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []providers.AuthorizationDetail) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, resourceServerID, details)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// ResourceServerProviderMock_ValidateAuthorizationDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateAuthorizationDetails'
+type ResourceServerProviderMock_ValidateAuthorizationDetails_Call struct {
+	*mock.Call
+}
+
+// ValidateAuthorizationDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceServerID string
+//   - details []providers.AuthorizationDetail
+func (_e *ResourceServerProviderMock_Expecter) ValidateAuthorizationDetails(ctx interface{}, resourceServerID interface{}, details interface{}) *ResourceServerProviderMock_ValidateAuthorizationDetails_Call {
+	return &ResourceServerProviderMock_ValidateAuthorizationDetails_Call{Call: _e.mock.On("ValidateAuthorizationDetails", ctx, resourceServerID, details)}
+}
+
+func (_c *ResourceServerProviderMock_ValidateAuthorizationDetails_Call) Run(run func(ctx context.Context, resourceServerID string, details []providers.AuthorizationDetail)) *ResourceServerProviderMock_ValidateAuthorizationDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []providers.AuthorizationDetail
+		if args[2] != nil {
+			arg2 = args[2].([]providers.AuthorizationDetail)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ResourceServerProviderMock_ValidateAuthorizationDetails_Call) Return(serviceError *common.ServiceError) *ResourceServerProviderMock_ValidateAuthorizationDetails_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *ResourceServerProviderMock_ValidateAuthorizationDetails_Call) RunAndReturn(run func(ctx context.Context, resourceServerID string, details []providers.AuthorizationDetail) *common.ServiceError) *ResourceServerProviderMock_ValidateAuthorizationDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidatePermissions provides a mock function for the type ResourceServerProviderMock
 func (_mock *ResourceServerProviderMock) ValidatePermissions(ctx context.Context, resourceServerID string, permissions []string) ([]string, *common.ServiceError) {
 	ret := _mock.Called(ctx, resourceServerID, permissions)
@@ -245,8 +310,8 @@ func (_c *ResourceServerProviderMock_ValidatePermissions_Call) Run(run func(ctx
 	return _c
 }
 
-func (_c *ResourceServerProviderMock_ValidatePermissions_Call) Return(strings []string, serviceError *common.ServiceError) *ResourceServerProviderMock_ValidatePermissions_Call {
-	_c.Call.Return(strings, serviceError)
+func (_c *ResourceServerProviderMock_ValidatePermissions_Call) Return(ss []string, serviceError *common.ServiceError) *ResourceServerProviderMock_ValidatePermissions_Call {
+	_c.Call.Return(ss, serviceError)
 	return _c
 }
 
@@ -9,6 +9,7 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/audit"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
 
@@ -605,6 +606,46 @@ func (_c *RoleServiceInterfaceMock_ResolveRoleOUHandle_Call) RunAndReturn(run fu
 	return _c
 }
 
+// SetAuditRecorder provides a mock function for the type RoleServiceInterfaceMock
+func (_mock *RoleServiceInterfaceMock) SetAuditRecorder(recorder *audit.Recorder) {
+	_mock.Called(recorder)
+	return
+}
+
+// RoleServiceInterfaceMock_SetAuditRecorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAuditRecorder'
+type RoleServiceInterfaceMock_SetAuditRecorder_Call struct {
+	*mock.Call
+}
+
+// SetAuditRecorder is a helper method to define mock.On call
+//   - recorder *audit.Recorder
+func (_e *RoleServiceInterfaceMock_Expecter) SetAuditRecorder(recorder interface{}) *RoleServiceInterfaceMock_SetAuditRecorder_Call {
+	return &RoleServiceInterfaceMock_SetAuditRecorder_Call{Call: _e.mock.On("SetAuditRecorder", recorder)}
+}
+
+func (_c *RoleServiceInterfaceMock_SetAuditRecorder_Call) Run(run func(recorder *audit.Recorder)) *RoleServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *audit.Recorder
+		if args[0] != nil {
+			arg0 = args[0].(*audit.Recorder)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *RoleServiceInterfaceMock_SetAuditRecorder_Call) Return() *RoleServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RoleServiceInterfaceMock_SetAuditRecorder_Call) RunAndReturn(run func(recorder *audit.Recorder)) *RoleServiceInterfaceMock_SetAuditRecorder_Call {
+	_c.Run(run)
+	return _c
+}
+
 // UpdateRoleWithPermissions provides a mock function for the type RoleServiceInterfaceMock
 func (_mock *RoleServiceInterfaceMock) UpdateRoleWithPermissions(ctx context.Context, id string, role1 role.RoleUpdateDetail) (*role.RoleWithPermissions, *common.ServiceError) {
 	ret := _mock.Called(ctx, id, role1)
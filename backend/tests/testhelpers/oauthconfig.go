@@ -40,11 +40,12 @@ func OAuthConfig() oauthconfig.Config {
 			},
 		},
 		GateClient: engineconfig.GateClientConfig{
-			Scheme:    "https",
-			Hostname:  "localhost",
-			Port:      3000,
-			LoginPath: "/login",
-			ErrorPath: "/error",
+			Scheme:     "https",
+			Hostname:   "localhost",
+			Port:       3000,
+			LoginPath:  "/login",
+			ErrorPath:  "/error",
+			DevicePath: "/device",
 		},
 	}
 }
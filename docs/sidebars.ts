@@ -638,6 +638,16 @@ const sidebars: SidebarsConfig = {
           id: 'guides/consent',
           label: 'Consent',
         },
+        {
+          type: 'doc',
+          id: 'guides/audit-trail',
+          label: 'Audit Trail',
+        },
+        {
+          type: 'doc',
+          id: 'guides/webhooks',
+          label: 'Webhooks',
+        },
         {
           type: 'doc',
           id: 'guides/trusted-issuer',
@@ -1052,6 +1062,11 @@ const sidebars: SidebarsConfig = {
           id: 'deployment/production-guidelines',
           label: 'Production Guidelines',
         },
+        {
+          type: 'doc',
+          id: 'deployment/database-migrations',
+          label: 'Database Migrations',
+        },
         {
           type: 'doc',
           id: 'deployment/observability',
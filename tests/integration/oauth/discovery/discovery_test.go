@@ -173,6 +173,7 @@ func (ts *DiscoveryTestSuite) TestOIDCDiscovery_GET_Success() {
 	// Verify OIDC-specific fields
 	ts.NotEmpty(metadata.SubjectTypesSupported, "SubjectTypesSupported should not be empty")
 	ts.Contains(metadata.SubjectTypesSupported, "public", "Should support public subject type")
+	ts.Contains(metadata.SubjectTypesSupported, "pairwise", "Should support pairwise subject type")
 
 	ts.NotEmpty(metadata.IDTokenSigningAlgValuesSupported, "IDTokenSigningAlgValuesSupported should not be empty")
 	ts.Contains(metadata.IDTokenSigningAlgValuesSupported, "RS256", "Should support RS256 signing algorithm")